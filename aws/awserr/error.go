@@ -0,0 +1,65 @@
+// Package awserr provides the error types returned for failed AWS requests.
+package awserr
+
+// An Error is an error returned by an AWS service or the SDK, identified by a
+// code callers can branch on:
+//
+//	if aerr, ok := err.(awserr.Error); ok && aerr.Code() == "ThrottlingException" {
+//	    // back off and retry
+//	}
+type Error interface {
+	error
+
+	// Code returns the error code, such as "ValidationError".
+	Code() string
+
+	// Message returns the error's detailed message.
+	Message() string
+
+	// OrigErr returns the error the SDK encountered, if there was one.
+	OrigErr() error
+}
+
+// A RequestFailure is an Error for a request the service responded to,
+// carrying the response's status code and request ID.
+type RequestFailure interface {
+	Error
+
+	// StatusCode returns the HTTP status code of the response.
+	StatusCode() int
+
+	// RequestID returns the ID the service assigned to the request, which
+	// may be empty.
+	RequestID() string
+}
+
+// A RequestAttemptsFailure is a RequestFailure for a request which failed
+// after all of its attempts, carrying diagnostics of the last response.
+type RequestAttemptsFailure interface {
+	RequestFailure
+
+	// Attempts returns the number of times the request was sent.
+	Attempts() int
+
+	// HostID returns the ID of the host which handled the last attempt, as
+	// returned by S3 alongside the request ID. It may be empty.
+	HostID() string
+}
+
+// New returns an Error with the code, message and original error. origErr,
+// which may itself be an Error, is kept as the cause.
+func New(code, message string, origErr error) Error {
+	return &baseError{code: code, message: message, origErr: origErr}
+}
+
+// NewRequestFailure returns a RequestFailure wrapping err with the response's
+// status code and request ID.
+func NewRequestFailure(err Error, statusCode int, requestID string) RequestFailure {
+	return &requestError{awsError: err, statusCode: statusCode, requestID: requestID}
+}
+
+// NewRequestAttemptsFailure returns a RequestAttemptsFailure wrapping err with
+// the number of attempts made and the last response's host ID.
+func NewRequestAttemptsFailure(err RequestFailure, attempts int, hostID string) RequestAttemptsFailure {
+	return &attemptsError{RequestFailure: err, attempts: attempts, hostID: hostID}
+}
@@ -0,0 +1,81 @@
+package awserr
+
+import "fmt"
+
+// sprintError formats an error's code and message, followed by extra details
+// and the original error when they are set.
+func sprintError(code, message, extra string, origErr error) string {
+	msg := fmt.Sprintf("%s: %s", code, message)
+	if extra != "" {
+		msg = fmt.Sprintf("%s\n\t%s", msg, extra)
+	}
+	if origErr != nil {
+		msg = fmt.Sprintf("%s\ncaused by: %s", msg, origErr.Error())
+	}
+	return msg
+}
+
+type baseError struct {
+	code    string
+	message string
+	origErr error
+}
+
+func (b baseError) Error() string {
+	return sprintError(b.code, b.message, "", b.origErr)
+}
+
+func (b baseError) Code() string {
+	return b.code
+}
+
+func (b baseError) Message() string {
+	return b.message
+}
+
+func (b baseError) OrigErr() error {
+	return b.origErr
+}
+
+// awsError aliases Error so that requestError can embed it while defining
+// its own Error method.
+type awsError Error
+
+type requestError struct {
+	awsError
+	statusCode int
+	requestID  string
+}
+
+func (r requestError) Error() string {
+	extra := fmt.Sprintf("status code: %d, request id: %s", r.statusCode, r.requestID)
+	return sprintError(r.Code(), r.Message(), extra, r.OrigErr())
+}
+
+func (r requestError) StatusCode() int {
+	return r.statusCode
+}
+
+func (r requestError) RequestID() string {
+	return r.requestID
+}
+
+type attemptsError struct {
+	RequestFailure
+	attempts int
+	hostID   string
+}
+
+func (a attemptsError) Error() string {
+	extra := fmt.Sprintf("status code: %d, request id: %s, host id: %s, attempts: %d",
+		a.StatusCode(), a.RequestID(), a.hostID, a.attempts)
+	return sprintError(a.Code(), a.Message(), extra, a.OrigErr())
+}
+
+func (a attemptsError) Attempts() int {
+	return a.attempts
+}
+
+func (a attemptsError) HostID() string {
+	return a.hostID
+}
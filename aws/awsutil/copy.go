@@ -0,0 +1,110 @@
+package awsutil
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"reflect"
+)
+
+var (
+	readerType      = reflect.TypeOf((*io.Reader)(nil)).Elem()
+	bytesReaderType = reflect.TypeOf((*bytes.Reader)(nil))
+)
+
+// CopyOf returns a deep copy of v, such as the params of a request, sharing
+// no pointers, slices or maps with it. Readers, such as the body of an
+// upload, are copied into a *bytes.Reader of their remaining content if they
+// are io.ReadSeekers the copy can hold one in, and are left at the offset
+// they were at. Other readers cannot be copied and are shared by the copy.
+func CopyOf(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	src := reflect.ValueOf(v)
+	dst := reflect.New(src.Type()).Elem()
+	rcopy(dst, src)
+	return dst.Interface()
+}
+
+func rcopy(dst, src reflect.Value) {
+	if !src.IsValid() {
+		return
+	}
+
+	switch src.Kind() {
+	case reflect.Ptr:
+		if src.IsNil() {
+			return
+		}
+		if src.Type().Implements(readerType) {
+			dst.Set(copyReader(src, dst.Type()))
+			return
+		}
+		e := reflect.New(src.Type().Elem())
+		rcopy(e.Elem(), src.Elem())
+		dst.Set(e)
+	case reflect.Interface:
+		if src.IsNil() {
+			return
+		}
+		if src.Elem().Type().Implements(readerType) {
+			dst.Set(copyReader(src, dst.Type()))
+			return
+		}
+		e := reflect.New(src.Elem().Type()).Elem()
+		rcopy(e, src.Elem())
+		dst.Set(e)
+	case reflect.Struct:
+		// unexported fields, such as those of time.Time, are copied as they
+		// are, and exported ones deeply
+		dst.Set(src)
+		for i := 0; i < src.NumField(); i++ {
+			if src.Type().Field(i).PkgPath == "" {
+				rcopy(dst.Field(i), src.Field(i))
+			}
+		}
+	case reflect.Slice:
+		if src.IsNil() {
+			return
+		}
+		s := reflect.MakeSlice(src.Type(), src.Len(), src.Len())
+		for i := 0; i < src.Len(); i++ {
+			rcopy(s.Index(i), src.Index(i))
+		}
+		dst.Set(s)
+	case reflect.Map:
+		if src.IsNil() {
+			return
+		}
+		m := reflect.MakeMap(src.Type())
+		for _, k := range src.MapKeys() {
+			e := reflect.New(src.Type().Elem()).Elem()
+			rcopy(e, src.MapIndex(k))
+			m.SetMapIndex(k, e)
+		}
+		dst.Set(m)
+	default:
+		dst.Set(src)
+	}
+}
+
+// copyReader returns a *bytes.Reader of the remaining content of the reader
+// src, if it is an io.ReadSeeker and a value of type t can hold one, or else
+// src itself. src is seeked back to the offset it was at.
+func copyReader(src reflect.Value, t reflect.Type) reflect.Value {
+	r, ok := src.Interface().(io.ReadSeeker)
+	if !ok || !bytesReaderType.AssignableTo(t) {
+		return src
+	}
+
+	start, err := r.Seek(0, 1)
+	if err != nil {
+		return src
+	}
+	b, err := ioutil.ReadAll(r)
+	if _, serr := r.Seek(start, 0); err != nil || serr != nil {
+		return src
+	}
+	return reflect.ValueOf(bytes.NewReader(b))
+}
@@ -0,0 +1,84 @@
+package awsutil_test
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type copyShape struct {
+	Name   *string
+	Time   *time.Time
+	List   []*copyShape
+	Map    map[string]*string
+	Blob   []byte
+	Body   *bytes.Reader
+	Stream io.ReadSeeker
+	Pipe   io.Reader
+	Nested *copyShape
+
+	hidden string
+}
+
+func TestCopyOf(t *testing.T) {
+	name, value := "name", "value"
+	now := time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)
+	body := bytes.NewReader([]byte("body"))
+	stream := bytes.NewReader([]byte("stream"))
+	stream.Seek(2, 0)
+	pipe, _ := io.Pipe()
+	src := &copyShape{
+		Name:   &name,
+		Time:   &now,
+		List:   []*copyShape{{Name: &name}},
+		Map:    map[string]*string{"key": &value},
+		Blob:   []byte("blob"),
+		Body:   body,
+		Stream: stream,
+		Pipe:   pipe,
+		Nested: &copyShape{Blob: []byte("nested")},
+		hidden: "hidden",
+	}
+
+	dst := awsutil.CopyOf(src).(*copyShape)
+	assert.Equal(t, "name", *dst.Name)
+	assert.Equal(t, now, *dst.Time)
+	assert.Equal(t, "name", *dst.List[0].Name)
+	assert.Equal(t, "value", *dst.Map["key"])
+	assert.Equal(t, []byte("blob"), dst.Blob)
+	assert.Equal(t, []byte("nested"), dst.Nested.Blob)
+	assert.Equal(t, "hidden", dst.hidden)
+
+	// io.ReadSeekers are copied from their offset, and left at it
+	b, _ := ioutil.ReadAll(dst.Body)
+	assert.Equal(t, []byte("body"), b)
+	b, _ = ioutil.ReadAll(dst.Stream)
+	assert.Equal(t, []byte("ream"), b)
+	n, _ := stream.Seek(0, 1)
+	assert.Equal(t, int64(2), n)
+
+	// nothing but other readers is shared
+	*dst.Name = "changed"
+	*dst.Map["key"] = "changed"
+	dst.Blob[0] = 'B'
+	dst.Nested.Blob[0] = 'N'
+	assert.Equal(t, "name", name)
+	assert.Equal(t, "name", *src.List[0].Name)
+	assert.Equal(t, "value", value)
+	assert.Equal(t, []byte("blob"), src.Blob)
+	assert.Equal(t, []byte("nested"), src.Nested.Blob)
+	assert.True(t, dst.Body != body)
+	assert.True(t, dst.Stream != stream)
+	assert.True(t, dst.Pipe == pipe)
+}
+
+func TestCopyOfNil(t *testing.T) {
+	assert.Nil(t, awsutil.CopyOf(nil))
+	var s *copyShape
+	assert.Nil(t, awsutil.CopyOf(s).(*copyShape))
+}
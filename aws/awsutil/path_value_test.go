@@ -34,6 +34,13 @@ func TestValueAtPathSuccess(t *testing.T) {
 	assert.Equal(t, []interface{}{"initial"}, awsutil.ValuesAtPath(data, "A.D.X || C"))
 }
 
+func TestValueAtPathMapValues(t *testing.T) {
+	m := struct {
+		M *map[string]*Struct
+	}{&map[string]*Struct{"a": &Struct{C: "value"}}}
+	assert.Equal(t, []interface{}{"value"}, awsutil.ValuesAtPath(m, "M.*.C"))
+}
+
 func TestValueAtPathFailure(t *testing.T) {
 	assert.Equal(t, []interface{}(nil), awsutil.ValuesAtPath(data, "C.x"))
 	assert.Equal(t, []interface{}(nil), awsutil.ValuesAtPath(data, ".x"))
@@ -7,6 +7,13 @@ import (
 	"strings"
 )
 
+// sensitivePlaceholder replaces the values of members with the sensitive
+// trait, such as passwords, in string representations.
+const sensitivePlaceholder = "<sensitive>"
+
+// StringValue returns the string representation of a shape, such as the
+// params or output of a request, with the values of its sensitive members
+// replaced by a placeholder.
 func StringValue(i interface{}) string {
 	var buf bytes.Buffer
 	stringValue(reflect.ValueOf(i), 0, &buf)
@@ -39,7 +46,11 @@ func stringValue(v reflect.Value, indent int, buf *bytes.Buffer) {
 			val := v.FieldByName(n)
 			buf.WriteString(strings.Repeat(" ", indent+2))
 			buf.WriteString(n + ": ")
-			stringValue(val, indent+2, buf)
+			if f, _ := v.Type().FieldByName(n); f.Tag.Get("sensitive") == "true" {
+				buf.WriteString(sensitivePlaceholder)
+			} else {
+				stringValue(val, indent+2, buf)
+			}
 
 			if i < len(names)-1 {
 				buf.WriteString(",\n")
@@ -0,0 +1,36 @@
+package awsutil_test
+
+import (
+	"testing"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type credentials struct {
+	UserName *string `type:"string"`
+	Password *string `type:"string" sensitive:"true"`
+	Seed     []byte  `type:"blob" sensitive:"true"`
+	Nested   *credentials
+}
+
+func TestStringValue(t *testing.T) {
+	s := awsutil.StringValue(&credentials{UserName: aws.String("bob")})
+	assert.Equal(t, "{\n  UserName: \"bob\"\n}", s)
+}
+
+func TestStringValueSensitive(t *testing.T) {
+	s := awsutil.StringValue(&credentials{
+		UserName: aws.String("bob"),
+		Password: aws.String("hunter2"),
+		Seed:     []byte("seed"),
+		Nested:   &credentials{Password: aws.String("nested-secret")},
+	})
+	assert.Contains(t, s, `UserName: "bob"`)
+	assert.Contains(t, s, "Password: <sensitive>")
+	assert.Contains(t, s, "Seed: <sensitive>")
+	assert.NotContains(t, s, "hunter2")
+	assert.NotContains(t, s, "nested-secret")
+	assert.NotContains(t, s, "[115 101 101 100]")
+}
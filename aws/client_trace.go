@@ -0,0 +1,16 @@
+package aws
+
+import "net/http/httptrace"
+
+// ClientTraceHandler attaches the httptrace.ClientTrace returned by the
+// Config's ClientTrace for the attempt to the request's HTTP request. The
+// trace is added to the request's context, rather than to the previous
+// attempt's, so each attempt reports to its own trace only, and the
+// request is still canceled with its context.
+func ClientTraceHandler(r *Request) {
+	trace := r.Service.Config.ClientTrace(r)
+	if trace == nil {
+		return
+	}
+	r.HTTPRequest = r.HTTPRequest.WithContext(httptrace.WithClientTrace(r.Context(), trace))
+}
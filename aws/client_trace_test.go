@@ -0,0 +1,147 @@
+package aws
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httptrace"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// attemptTrace records the events traced for an attempt.
+type attemptTrace struct {
+	m      sync.Mutex
+	events []string
+}
+
+func (a *attemptTrace) record(event string) {
+	a.m.Lock()
+	defer a.m.Unlock()
+	a.events = append(a.events, event)
+}
+
+func (a *attemptTrace) clientTrace() *httptrace.ClientTrace {
+	return &httptrace.ClientTrace{
+		GetConn:              func(string) { a.record("GetConn") },
+		ConnectDone:          func(string, string, error) { a.record("ConnectDone") },
+		GotConn:              func(httptrace.GotConnInfo) { a.record("GotConn") },
+		WroteRequest:         func(httptrace.WroteRequestInfo) { a.record("WroteRequest") },
+		GotFirstResponseByte: func() { a.record("GotFirstResponseByte") },
+	}
+}
+
+func TestClientTrace(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":"valid"}`))
+	}))
+	defer server.Close()
+
+	traces := []*attemptTrace{}
+	s := NewService(&Config{
+		Endpoint: server.URL,
+		ClientTrace: func(r *Request) *httptrace.ClientTrace {
+			a := &attemptTrace{}
+			traces = append(traces, a)
+			return a.clientTrace()
+		},
+	})
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+
+	out := &testData{}
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, out)
+	assert.NoError(t, r.Send())
+	assert.Equal(t, "valid", out.Data)
+
+	assert.Equal(t, 1, len(traces))
+	for _, e := range []string{"GetConn", "ConnectDone", "GotConn", "WroteRequest", "GotFirstResponseByte"} {
+		assert.Contains(t, traces[0].events, e)
+	}
+}
+
+func TestClientTracePerAttempt(t *testing.T) {
+	defer func(fn func(time.Duration)) { sleepDelay = fn }(sleepDelay)
+	sleepDelay = func(time.Duration) {}
+
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(500)
+			w.Write([]byte(`{"__type":"InternalError","message":"retry"}`))
+			return
+		}
+		w.Write([]byte(`{"data":"valid"}`))
+	}))
+	defer server.Close()
+
+	traces := []*attemptTrace{}
+	s := NewService(&Config{
+		Endpoint:   server.URL,
+		MaxRetries: DEFAULT_RETRIES,
+		ClientTrace: func(r *Request) *httptrace.ClientTrace {
+			a := &attemptTrace{}
+			traces = append(traces, a)
+			return a.clientTrace()
+		},
+	})
+	s.DefaultMaxRetries = 2
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, &testData{})
+	assert.NoError(t, r.Send())
+	assert.Equal(t, 2, len(traces))
+	for _, a := range traces {
+		assert.Equal(t, 1, countOf(a.events, "WroteRequest"))
+	}
+}
+
+func countOf(events []string, event string) int {
+	n := 0
+	for _, e := range events {
+		if e == event {
+			n++
+		}
+	}
+	return n
+}
+
+type traceContextKey struct{}
+
+func TestClientTraceKeepsContext(t *testing.T) {
+	s := NewService(&Config{
+		Region: "mock-region",
+		ClientTrace: func(r *Request) *httptrace.ClientTrace {
+			return &httptrace.ClientTrace{}
+		},
+	})
+	s.Handlers.Send.SwapNamed(StubSendHandler(StubResponse{StatusCode: 200, Body: `{"data":"valid"}`}))
+
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), traceContextKey{}, "value"))
+	defer cancel()
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.SetContext(ctx)
+	assert.NoError(t, r.Send())
+	assert.Equal(t, "value", r.HTTPRequest.Context().Value(traceContextKey{}))
+	assert.NotNil(t, httptrace.ContextClientTrace(r.HTTPRequest.Context()))
+
+	cancel()
+	<-r.HTTPRequest.Context().Done()
+}
+
+func TestClientTraceNil(t *testing.T) {
+	s := NewService(&Config{
+		Region:      "mock-region",
+		ClientTrace: func(r *Request) *httptrace.ClientTrace { return nil },
+	})
+	s.Handlers.Send.SwapNamed(StubSendHandler(StubResponse{StatusCode: 200}))
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	assert.NoError(t, r.Send())
+	assert.Nil(t, httptrace.ContextClientTrace(r.HTTPRequest.Context()))
+}
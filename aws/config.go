@@ -1,37 +1,186 @@
 package aws
 
 import (
-	"io"
 	"net/http"
+	"net/http/httptrace"
 	"os"
+	"time"
 )
 
 const DEFAULT_RETRIES = -1
 
+// DefaultHTTPClient is the HTTP client shared by services whose Config does
+// not set an HTTPClient. Configure a custom transport by setting a new client
+// on the Config rather than modifying this one, which would affect every
+// service using the default.
+var DefaultHTTPClient = &http.Client{Transport: newTransport(nil)}
+
 var DefaultConfig = &Config{
-	Credentials:            DefaultCreds(),
-	Endpoint:               "",
-	Region:                 os.Getenv("AWS_REGION"),
-	DisableSSL:             false,
-	ManualSend:             false,
-	HTTPClient:             http.DefaultClient,
-	LogLevel:               0,
-	Logger:                 os.Stdout,
-	MaxRetries:             DEFAULT_RETRIES,
-	DisableParamValidation: false,
+	Credentials:                DefaultCreds(),
+	Endpoint:                   "",
+	EndpointResolver:           nil,
+	Region:                     os.Getenv("AWS_REGION"),
+	DisableSSL:                 false,
+	ManualSend:                 false,
+	HTTPClient:                 DefaultHTTPClient,
+	LogLevel:                   LogOff,
+	Logger:                     NewDefaultLogger(os.Stdout),
+	MaxRetries:                 DEFAULT_RETRIES,
+	Retryer:                    nil,
+	RateLimiter:                nil,
+	DisableParamValidation:     false,
+	DisableClockSkewCorrection: false,
+	DecompressGzipResponses:    false,
+	UseDualStack:               false,
+	ValidateResponseChecksums:  false,
+	S3ForcePathStyle:           false,
+	S3UnsignedPayload:          false,
+	MaxResponseBodySize:        0,
+	UserAgentComponents:        nil,
+	RequestCompressionMinSize:  0,
+	RequireResponseHeaders:     false,
+	MetricsCollector:           nil,
+	SaveResponseBody:           false,
+	ClientTrace:                nil,
+	Clock:                      nil,
+	EndpointValidator:          nil,
+	ExpectContinueMinSize:      0,
+	ValidateEnumValues:         false,
 }
 
 type Config struct {
-	Credentials            CredentialsProvider
-	Endpoint               string
-	Region                 string
-	DisableSSL             bool
+	// Credentials provides the credentials requests are signed with. A
+	// provider shared by the Configs of several clients refreshes their
+	// credentials once for all of them.
+	Credentials CredentialsProvider
+	Endpoint    string
+
+	// EndpointResolver computes the endpoint of services, taking precedence
+	// over the SDK's endpoints, but not over Endpoint. It returns the URL of
+	// the service in the region, and the region requests to it are signed
+	// for, which defaults to Region when empty.
+	EndpointResolver func(service, region string) (url string, signingRegion string, err error)
+
+	Region string
+
+	// DisableSSL resolves endpoints with an http scheme instead of https,
+	// for example to send requests to a local mock of the service. An
+	// Endpoint set with an explicit scheme keeps its scheme.
+	DisableSSL bool
+
 	ManualSend             bool
 	HTTPClient             *http.Client
-	LogLevel               uint
-	Logger                 io.Writer
+	LogLevel               LogLevelType
+	Logger                 Logger
 	MaxRetries             int
+	Retryer                Retryer
 	DisableParamValidation bool
+
+	// RateLimiter gates the attempts of the requests sent with the Config,
+	// taking a token for each attempt, and more for retries of throttled
+	// attempts. Tokens are given back when an attempt is not throttled. Share
+	// a RateLimiter between Configs to limit them together.
+	RateLimiter RateLimiter
+
+	// DisableClockSkewCorrection stops requests rejected because of a skewed
+	// local clock from being re-signed with the server's time and retried.
+	DisableClockSkewCorrection bool
+
+	// DecompressGzipResponses enables transparently decompressing response
+	// bodies sent with a gzip Content-Encoding before they are unmarshaled.
+	// It is disabled by default, as services such as S3 return objects
+	// stored with a gzip encoding as they are.
+	DecompressGzipResponses bool
+
+	// UseDualStack resolves endpoints to their IPv6 enabled dualstack
+	// variant for services supporting one. Other services keep using their
+	// standard endpoint.
+	UseDualStack bool
+
+	// ValidateResponseChecksums enables validating response bodies against
+	// the checksum headers sent with them. A body not matching its checksum
+	// fails the request with a ChecksumMismatch error, or, for operations
+	// streaming their output, fails the read reaching its end.
+	ValidateResponseChecksums bool
+
+	// S3ForcePathStyle addresses S3 buckets in the path of request URLs, as
+	// in https://s3.amazonaws.com/bucket/key, instead of in their host name,
+	// as in https://bucket.s3.amazonaws.com/key. Buckets whose names cannot
+	// be part of a host name are always addressed in the path.
+	S3ForcePathStyle bool
+
+	// S3UnsignedPayload signs S3 requests sent over HTTPS with the literal
+	// UNSIGNED-PAYLOAD as their payload hash, rather than hashing the whole
+	// body before sending it. The body is still protected by TLS. It has no
+	// effect on other services, which require the payload to be hashed.
+	S3UnsignedPayload bool
+
+	// MaxResponseBodySize bounds the size in bytes of response bodies read
+	// by the SDK, failing requests whose body is larger with a
+	// ResponseBodyTooLarge error rather than buffering it. Zero, the default,
+	// is unlimited. Bodies streamed to the caller, such as S3 objects, are not
+	// bounded.
+	MaxResponseBodySize int64
+
+	// UserAgentComponents are appended, in order, to the User-Agent of every
+	// request, after the SDK's own product token.
+	UserAgentComponents []UserAgentComponent
+
+	// RequestCompressionMinSize enables gzip compressing the bodies of
+	// requests to operations accepting compressed requests, such as
+	// CloudWatch's PutMetricData, when they are at least this many bytes
+	// long. Zero, the default, sends every body uncompressed.
+	RequestCompressionMinSize int64
+
+	// RequireResponseHeaders fails the unmarshaling of successful responses
+	// missing a header their operation's output requires, such as the
+	// Location of a created Route 53 hosted zone, with a SerializationError,
+	// rather than leaving the member nil. It is disabled by default, as some
+	// services omit headers their models require.
+	RequireResponseHeaders bool
+
+	// MetricsCollector records the attempts, retries and latency of the
+	// requests sent with the Config. No metrics are collected when it is
+	// nil, the default.
+	MetricsCollector MetricsCollector
+
+	// SaveResponseBody keeps a copy of each response body as it is read, for
+	// example by unmarshaling, so that it can be logged or parsed again with
+	// the request's ResponseBody when unmarshaling fails. As the whole body is
+	// held in memory, it is disabled by default. The bodies of operations
+	// streaming their output, such as S3's GetObject, are not saved.
+	SaveResponseBody bool
+
+	// ClientTrace returns the httptrace.ClientTrace reporting the DNS
+	// lookups, connections, TLS handshakes and first response byte of an
+	// attempt of a request, such as to break down its latency. It is called
+	// before each attempt is sent, and may return nil to trace none of it.
+	ClientTrace func(r *Request) *httptrace.ClientTrace
+
+	// Clock returns the current time, which requests are signed at. It is
+	// time.Now when nil, the default, and may be set to a fixed time to
+	// assert exact signatures in tests.
+	Clock func() time.Time
+
+	// EndpointValidator checks the endpoint of services once it has been
+	// resolved, such as with EndpointDomainValidator when Endpoint or
+	// EndpointResolver come from untrusted configuration. Requests of a
+	// service whose endpoint it rejects fail with an InvalidEndpoint error.
+	EndpointValidator func(endpoint string) error
+
+	// ExpectContinueMinSize sends requests whose body is at least this many
+	// bytes long, such as large S3 PutObject uploads, with an Expect:
+	// 100-continue header, holding back their body until the service
+	// accepts the request. Zero, the default, sends every body right away.
+	// The transport of the HTTPClient must set an ExpectContinueTimeout, as
+	// the DefaultHTTPClient's does, for the body to be held back.
+	ExpectContinueMinSize int64
+
+	// ValidateEnumValues fails the validation of params with a string member
+	// which is not one of the values of its enum, such as a misspelled
+	// instance type. It is disabled by default, as services add values to
+	// their enums which this version of the SDK may not know of.
+	ValidateEnumValues bool
 }
 
 func (c Config) Merge(newcfg *Config) *Config {
@@ -49,6 +198,12 @@ func (c Config) Merge(newcfg *Config) *Config {
 		cfg.Endpoint = c.Endpoint
 	}
 
+	if newcfg != nil && newcfg.EndpointResolver != nil {
+		cfg.EndpointResolver = newcfg.EndpointResolver
+	} else {
+		cfg.EndpointResolver = c.EndpointResolver
+	}
+
 	if newcfg != nil && newcfg.Region != "" {
 		cfg.Region = newcfg.Region
 	} else {
@@ -91,11 +246,125 @@ func (c Config) Merge(newcfg *Config) *Config {
 		cfg.MaxRetries = c.MaxRetries
 	}
 
+	if newcfg != nil && newcfg.Retryer != nil {
+		cfg.Retryer = newcfg.Retryer
+	} else {
+		cfg.Retryer = c.Retryer
+	}
+
+	if newcfg != nil && newcfg.RateLimiter != nil {
+		cfg.RateLimiter = newcfg.RateLimiter
+	} else {
+		cfg.RateLimiter = c.RateLimiter
+	}
+
 	if newcfg != nil && newcfg.DisableParamValidation {
 		cfg.DisableParamValidation = newcfg.DisableParamValidation
 	} else {
 		cfg.DisableParamValidation = c.DisableParamValidation
 	}
 
+	if newcfg != nil && newcfg.DisableClockSkewCorrection {
+		cfg.DisableClockSkewCorrection = newcfg.DisableClockSkewCorrection
+	} else {
+		cfg.DisableClockSkewCorrection = c.DisableClockSkewCorrection
+	}
+
+	if newcfg != nil && newcfg.DecompressGzipResponses {
+		cfg.DecompressGzipResponses = newcfg.DecompressGzipResponses
+	} else {
+		cfg.DecompressGzipResponses = c.DecompressGzipResponses
+	}
+
+	if newcfg != nil && newcfg.UseDualStack {
+		cfg.UseDualStack = newcfg.UseDualStack
+	} else {
+		cfg.UseDualStack = c.UseDualStack
+	}
+
+	if newcfg != nil && newcfg.ValidateResponseChecksums {
+		cfg.ValidateResponseChecksums = newcfg.ValidateResponseChecksums
+	} else {
+		cfg.ValidateResponseChecksums = c.ValidateResponseChecksums
+	}
+
+	if newcfg != nil && newcfg.S3ForcePathStyle {
+		cfg.S3ForcePathStyle = newcfg.S3ForcePathStyle
+	} else {
+		cfg.S3ForcePathStyle = c.S3ForcePathStyle
+	}
+
+	if newcfg != nil && newcfg.S3UnsignedPayload {
+		cfg.S3UnsignedPayload = newcfg.S3UnsignedPayload
+	} else {
+		cfg.S3UnsignedPayload = c.S3UnsignedPayload
+	}
+
+	if newcfg != nil && newcfg.MaxResponseBodySize != 0 {
+		cfg.MaxResponseBodySize = newcfg.MaxResponseBodySize
+	} else {
+		cfg.MaxResponseBodySize = c.MaxResponseBodySize
+	}
+
+	if newcfg != nil && newcfg.UserAgentComponents != nil {
+		cfg.UserAgentComponents = newcfg.UserAgentComponents
+	} else {
+		cfg.UserAgentComponents = c.UserAgentComponents
+	}
+
+	if newcfg != nil && newcfg.RequestCompressionMinSize != 0 {
+		cfg.RequestCompressionMinSize = newcfg.RequestCompressionMinSize
+	} else {
+		cfg.RequestCompressionMinSize = c.RequestCompressionMinSize
+	}
+
+	if newcfg != nil && newcfg.RequireResponseHeaders {
+		cfg.RequireResponseHeaders = newcfg.RequireResponseHeaders
+	} else {
+		cfg.RequireResponseHeaders = c.RequireResponseHeaders
+	}
+
+	if newcfg != nil && newcfg.MetricsCollector != nil {
+		cfg.MetricsCollector = newcfg.MetricsCollector
+	} else {
+		cfg.MetricsCollector = c.MetricsCollector
+	}
+
+	if newcfg != nil && newcfg.SaveResponseBody {
+		cfg.SaveResponseBody = newcfg.SaveResponseBody
+	} else {
+		cfg.SaveResponseBody = c.SaveResponseBody
+	}
+
+	if newcfg != nil && newcfg.ClientTrace != nil {
+		cfg.ClientTrace = newcfg.ClientTrace
+	} else {
+		cfg.ClientTrace = c.ClientTrace
+	}
+
+	if newcfg != nil && newcfg.Clock != nil {
+		cfg.Clock = newcfg.Clock
+	} else {
+		cfg.Clock = c.Clock
+	}
+
+	if newcfg != nil && newcfg.EndpointValidator != nil {
+		cfg.EndpointValidator = newcfg.EndpointValidator
+	} else {
+		cfg.EndpointValidator = c.EndpointValidator
+	}
+
+	if newcfg != nil && newcfg.ExpectContinueMinSize != 0 {
+		cfg.ExpectContinueMinSize = newcfg.ExpectContinueMinSize
+	} else {
+		cfg.ExpectContinueMinSize = c.ExpectContinueMinSize
+	}
+
+	if newcfg != nil && newcfg.ValidateEnumValues {
+		cfg.ValidateEnumValues = newcfg.ValidateEnumValues
+	} else {
+		cfg.ValidateEnumValues = c.ValidateEnumValues
+	}
+
 	return &cfg
 }
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
@@ -25,6 +26,13 @@ type Credentials struct {
 }
 
 // A CredentialsProvider is a provider of credentials.
+//
+// The SDK's providers are safe for concurrent use, and cache the credentials
+// they retrieve until they expire. Passing the same provider to the Configs
+// of several clients shares its cache between them, so expired credentials
+// are refreshed once, by the first request needing them, while concurrent
+// requests wait for the refresh rather than each retrieving their own. The
+// credentials returned are a copy, not changed by a later refresh.
 type CredentialsProvider interface {
 	// Credentials returns a set of credentials (or an error if no credentials
 	// could be provided).
@@ -34,13 +42,120 @@ type CredentialsProvider interface {
 var (
 	// ErrAccessKeyIDNotFound is returned when the AWS Access Key ID can't be
 	// found in the process's environment.
-	ErrAccessKeyIDNotFound = fmt.Errorf("AWS_ACCESS_KEY_ID or AWS_ACCESS_KEY not found in environment")
+	ErrAccessKeyIDNotFound = fmt.Errorf("AWS_ACCESS_KEY_ID, AWS_ACCESS_KEY or AMAZON_ACCESS_KEY_ID not found in environment")
 	// ErrSecretAccessKeyNotFound is returned when the AWS Secret Access Key
 	// can't be found in the process's environment.
-	ErrSecretAccessKeyNotFound = fmt.Errorf("AWS_SECRET_ACCESS_KEY or AWS_SECRET_KEY not found in environment")
+	ErrSecretAccessKeyNotFound = fmt.Errorf("AWS_SECRET_ACCESS_KEY, AWS_SECRET_KEY or AMAZON_SECRET_ACCESS_KEY not found in environment")
+	// ErrStaticAccessKeyIDEmpty is returned by a static provider whose
+	// access key ID is empty.
+	ErrStaticAccessKeyIDEmpty = fmt.Errorf("static credentials have an empty access key ID")
+	// ErrStaticSecretAccessKeyEmpty is returned by a static provider whose
+	// secret access key is empty.
+	ErrStaticSecretAccessKeyEmpty = fmt.Errorf("static credentials have an empty secret access key")
 )
 
+// A ChainProvider tries each of its Providers in order, returning the
+// credentials of the first provider which does not return an error. The
+// successful provider is remembered until its credentials expire.
+type ChainProvider struct {
+	Providers []CredentialsProvider
+
+	curr CredentialsProvider
+	m    sync.Mutex
+}
+
+// A ChainProviderError is returned by a ChainProvider when none of its
+// providers could provide credentials. Errors holds each provider's failure,
+// in the order the providers were tried.
+type ChainProviderError struct {
+	Errors []error
+}
+
+func (e ChainProviderError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	return "no valid credentials providers in chain:\n- " + strings.Join(msgs, "\n- ")
+}
+
+// Credentials returns credentials from the first provider in the chain that
+// is able to provide them.
+func (c *ChainProvider) Credentials() (*Credentials, error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	if c.curr != nil && !isExpired(c.curr) {
+		if creds, err := c.curr.Credentials(); err == nil {
+			return creds, nil
+		}
+	}
+	c.curr = nil
+
+	errs := []error{}
+	for _, p := range c.Providers {
+		creds, err := p.Credentials()
+		if err == nil {
+			c.curr = p
+			return creds, nil
+		}
+		errs = append(errs, fmt.Errorf("%T: %s", p, err))
+	}
+
+	return nil, ChainProviderError{Errors: errs}
+}
+
+// IsExpired returns whether the credentials of the provider which last
+// succeeded are expired. It returns true if no provider has succeeded yet.
+func (c *ChainProvider) IsExpired() bool {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	if c.curr == nil {
+		return true
+	}
+	return isExpired(c.curr)
+}
+
+// isExpired returns whether the provider's cached credentials are expired.
+// Providers which do not track expiry never expire.
+func isExpired(p CredentialsProvider) bool {
+	if e, ok := p.(interface {
+		IsExpired() bool
+	}); ok {
+		return e.IsExpired()
+	}
+	return false
+}
+
+// DefaultExpiryWindow is the expiry window of providers which refresh
+// credentials before they expire, such as those of the ec2rolecreds and
+// stscreds packages, when they do not set one.
+const DefaultExpiryWindow = 5 * time.Minute
+
+// ExpiredWithin returns whether credentials expiring at expiration are, at
+// now, expired or within window of expiring, so that in-flight requests are
+// not signed with credentials which are about to become invalid. A zero
+// window is the DefaultExpiryWindow, and a negative one uses credentials
+// until their actual expiration.
+func ExpiredWithin(expiration, now time.Time, window time.Duration) bool {
+	switch {
+	case window < 0:
+		window = 0
+	case window == 0:
+		window = DefaultExpiryWindow
+	}
+	return !expiration.Add(-window).After(now)
+}
+
+// A DefaultCredentialsProvider returns credentials from the environment, the
+// default profile, or the EC2 instance's IAM role, in that order. The profile
+// and IAM providers are kept between calls, so their credentials are cached
+// and shared by every client using the provider.
 type DefaultCredentialsProvider struct {
+	m       sync.Mutex
+	profile CredentialsProvider
+	iam     CredentialsProvider
 }
 
 func (p *DefaultCredentialsProvider) Credentials() (*Credentials, error) {
@@ -49,15 +164,24 @@ func (p *DefaultCredentialsProvider) Credentials() (*Credentials, error) {
 		return env.Credentials()
 	}
 
-	profile, err := ProfileCreds("", "", 10*time.Minute)
-	if err == nil {
-		profileCreds, err := profile.Credentials()
-		if err == nil {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	if p.profile == nil {
+		if profile, err := ProfileCreds("", "", 10*time.Minute); err == nil {
+			p.profile = profile
+		}
+	}
+	if p.profile != nil {
+		if profileCreds, err := p.profile.Credentials(); err == nil {
 			return profileCreds, nil
 		}
 	}
 
-	return IAMCreds().Credentials()
+	if p.iam == nil {
+		p.iam = IAMCreds()
+	}
+	return p.iam.Credentials()
 }
 
 func DefaultCreds() CredentialsProvider {
@@ -99,18 +223,23 @@ func DetectCreds(accessKeyID, secretAccessKey, sessionToken string) CredentialsP
 	return profile
 }
 
+// Environment variables EnvCreds reads credentials from, in order of
+// precedence. The AWS_ACCESS_KEY, AWS_SECRET_KEY and AMAZON_ prefixed names
+// are accepted for compatibility with older tools.
+var (
+	envAccessKeyIDNames     = []string{"AWS_ACCESS_KEY_ID", "AWS_ACCESS_KEY", "AMAZON_ACCESS_KEY_ID"}
+	envSecretAccessKeyNames = []string{"AWS_SECRET_ACCESS_KEY", "AWS_SECRET_KEY", "AMAZON_SECRET_ACCESS_KEY"}
+	envSessionTokenNames    = []string{"AWS_SESSION_TOKEN", "AMAZON_SESSION_TOKEN"}
+)
+
 // EnvCreds returns a static provider of AWS credentials from the process's
-// environment, or an error if none are found.
+// environment, or an error if none are found. Each value is read from the
+// first of its variables which is set, so the canonical AWS_ names take
+// precedence over the legacy ones. An access key ID without a secret access
+// key, or the reverse, is an error rather than partial credentials.
 func EnvCreds() (CredentialsProvider, error) {
-	id := os.Getenv("AWS_ACCESS_KEY_ID")
-	if id == "" {
-		id = os.Getenv("AWS_ACCESS_KEY")
-	}
-
-	secret := os.Getenv("AWS_SECRET_ACCESS_KEY")
-	if secret == "" {
-		secret = os.Getenv("AWS_SECRET_KEY")
-	}
+	id := getenv(envAccessKeyIDNames)
+	secret := getenv(envSecretAccessKeyNames)
 
 	if id == "" {
 		return nil, ErrAccessKeyIDNotFound
@@ -120,10 +249,24 @@ func EnvCreds() (CredentialsProvider, error) {
 		return nil, ErrSecretAccessKeyNotFound
 	}
 
-	return Creds(id, secret, os.Getenv("AWS_SESSION_TOKEN")), nil
+	return Creds(id, secret, getenv(envSessionTokenNames)), nil
 }
 
-// Creds returns a static provider of credentials.
+// getenv returns the value of the first of the environment variables which
+// is not empty.
+func getenv(names []string) string {
+	for _, name := range names {
+		if v := os.Getenv(name); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// Creds returns a static provider of credentials. The provider returns an
+// error if the access key ID or secret access key is empty, so that missing
+// credentials fail before a request is signed with them. The session token
+// is optional.
 func Creds(accessKeyID, secretAccessKey, sessionToken string) CredentialsProvider {
 	return staticCredentialsProvider{
 		creds: Credentials{
@@ -142,28 +285,24 @@ func IAMCreds() CredentialsProvider {
 
 // ProfileCreds returns a provider which pulls credentials from the profile
 // configuration file.
+//
+// If filename is empty, the file named by the AWS_SHARED_CREDENTIALS_FILE
+// environment variable is used, falling back to ~/.aws/credentials. If
+// profile is empty, the profile named by the AWS_PROFILE environment variable
+// is used, falling back to "default". The environment is read each time
+// credentials are loaded.
 func ProfileCreds(filename, profile string, expiry time.Duration) (CredentialsProvider, error) {
-	if filename == "" {
-		homeDir := os.Getenv("HOME") // *nix
-		if homeDir == "" {           // Windows
-			homeDir = os.Getenv("USERPROFILE")
-		}
-		if homeDir == "" {
-			return nil, errors.New("User home directory not found.")
-		}
-
-		filename = filepath.Join(homeDir, ".aws", "credentials")
+	p := &profileProvider{
+		filename: filename,
+		profile:  profile,
+		expiry:   expiry,
 	}
 
-	if profile == "" {
-		profile = "default"
+	if _, err := p.resolveFilename(); err != nil {
+		return nil, err
 	}
 
-	return &profileProvider{
-		filename: filename,
-		profile:  profile,
-		expiry:   expiry,
-	}, nil
+	return p, nil
 }
 
 type profileProvider struct {
@@ -176,28 +315,45 @@ type profileProvider struct {
 	expiration time.Time
 }
 
+func (p *profileProvider) IsExpired() bool {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	return !p.expiration.After(currentTime())
+}
+
 func (p *profileProvider) Credentials() (*Credentials, error) {
 	p.m.Lock()
 	defer p.m.Unlock()
 
 	if p.expiration.After(currentTime()) {
-		return &p.creds, nil
+		creds := p.creds
+		return &creds, nil
 	}
 
-	config, err := ini.LoadFile(p.filename)
+	filename, err := p.resolveFilename()
 	if err != nil {
 		return nil, err
 	}
-	profile := config.Section(p.profile)
+	profileName := p.resolveProfile()
+
+	config, err := ini.LoadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	profile, ok := config[profileName]
+	if !ok {
+		return nil, fmt.Errorf("profile %s not found in %s", profileName, filename)
+	}
 
 	accessKeyID, ok := profile["aws_access_key_id"]
 	if !ok {
-		return nil, fmt.Errorf("profile %s in %s did not contain aws_access_key_id", p.profile, p.filename)
+		return nil, fmt.Errorf("profile %s in %s did not contain aws_access_key_id", profileName, filename)
 	}
 
 	secretAccessKey, ok := profile["aws_secret_access_key"]
 	if !ok {
-		return nil, fmt.Errorf("profile %s in %s did not contain aws_secret_access_key", p.profile, p.filename)
+		return nil, fmt.Errorf("profile %s in %s did not contain aws_secret_access_key", profileName, filename)
 	}
 
 	sessionToken := profile["aws_session_token"]
@@ -209,7 +365,41 @@ func (p *profileProvider) Credentials() (*Credentials, error) {
 	}
 	p.expiration = currentTime().Add(p.expiry)
 
-	return &p.creds, nil
+	creds := p.creds
+	return &creds, nil
+}
+
+// resolveFilename returns the configured filename, or the one named by the
+// environment, or the default location in the user's home directory.
+func (p *profileProvider) resolveFilename() (string, error) {
+	if p.filename != "" {
+		return p.filename, nil
+	}
+	if filename := os.Getenv("AWS_SHARED_CREDENTIALS_FILE"); filename != "" {
+		return filename, nil
+	}
+
+	homeDir := os.Getenv("HOME") // *nix
+	if homeDir == "" {           // Windows
+		homeDir = os.Getenv("USERPROFILE")
+	}
+	if homeDir == "" {
+		return "", errors.New("User home directory not found.")
+	}
+
+	return filepath.Join(homeDir, ".aws", "credentials"), nil
+}
+
+// resolveProfile returns the configured profile, or the one named by the
+// environment, or the default profile.
+func (p *profileProvider) resolveProfile() string {
+	if p.profile != "" {
+		return p.profile
+	}
+	if profile := os.Getenv("AWS_PROFILE"); profile != "" {
+		return profile
+	}
+	return "default"
 }
 
 type iamProvider struct {
@@ -223,7 +413,15 @@ var metadataCredentialsEndpoint = "http://169.254.169.254/latest/meta-data/iam/s
 // IAMClient is the HTTP client used to query the metadata endpoint for IAM
 // credentials.
 var IAMClient = http.Client{
-	Timeout: 1 * time.Second,
+	Timeout:       1 * time.Second,
+	CheckRedirect: MetadataCheckRedirect,
+}
+
+func (p *iamProvider) IsExpired() bool {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	return !p.expiration.After(currentTime())
 }
 
 func (p *iamProvider) Credentials() (*Credentials, error) {
@@ -231,52 +429,96 @@ func (p *iamProvider) Credentials() (*Credentials, error) {
 	defer p.m.Unlock()
 
 	if p.expiration.After(currentTime()) {
-		return &p.creds, nil
+		creds := p.creds
+		return &creds, nil
+	}
+
+	creds, expiration, err := EC2RoleCredentials(&IAMClient, metadataCredentialsEndpoint)
+	if err != nil {
+		return nil, err
 	}
+	p.creds, p.expiration = *creds, expiration
+
+	return creds, nil
+}
 
-	var body struct {
+// EC2RoleCredentials retrieves the temporary credentials of the IAM role
+// attached to the EC2 instance, and their expiration, from the instance
+// metadata service's listing of security credentials at endpoint. It is the
+// retrieval of both IAMCreds and the ec2rolecreds package's provider, which
+// cache the credentials it returns. A client without a CheckRedirect is
+// given MetadataCheckRedirect.
+func EC2RoleCredentials(client *http.Client, endpoint string) (*Credentials, time.Time, error) {
+	if client.CheckRedirect == nil {
+		c := *client
+		c.CheckRedirect = MetadataCheckRedirect
+		client = &c
+	}
+	if !strings.HasSuffix(endpoint, "/") {
+		endpoint += "/"
+	}
+
+	role, err := ec2RoleName(client, endpoint)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	var doc struct {
+		Code            string
+		Message         string
 		Expiration      time.Time
-		AccessKeyID     string
+		AccessKeyID     string `json:"AccessKeyId"`
 		SecretAccessKey string
 		Token           string
 	}
 
-	resp, err := IAMClient.Get(metadataCredentialsEndpoint)
+	resp, err := client.Get(endpoint + role)
 	if err != nil {
-		return nil, fmt.Errorf("listing IAM credentials")
+		return nil, time.Time{}, fmt.Errorf("getting %s EC2 role credentials: %s", role, err)
 	}
-	defer func() {
-		_ = resp.Body.Close()
-	}()
+	defer resp.Body.Close()
 
-	// Take the first line of the body of the metadata endpoint
-	s := bufio.NewScanner(resp.Body)
-	if !s.Scan() {
-		return nil, fmt.Errorf("unable to find default IAM credentials")
-	} else if s.Err() != nil {
-		return nil, fmt.Errorf("%s listing IAM credentials", s.Err())
+	if resp.StatusCode != http.StatusOK {
+		return nil, time.Time{}, fmt.Errorf("getting %s EC2 role credentials: %s", role, resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return nil, time.Time{}, fmt.Errorf("decoding %s EC2 role credentials: %s", role, err)
+	}
+	if doc.Code != "" && doc.Code != "Success" {
+		return nil, time.Time{}, fmt.Errorf("getting %s EC2 role credentials: %s %s", role, doc.Code, doc.Message)
 	}
 
-	resp, err = IAMClient.Get(metadataCredentialsEndpoint + s.Text())
+	return &Credentials{
+		AccessKeyID:     doc.AccessKeyID,
+		SecretAccessKey: doc.SecretAccessKey,
+		SessionToken:    doc.Token,
+	}, doc.Expiration, nil
+}
+
+// ec2RoleName returns the name of the role attached to the instance, which
+// is the first line listed by the metadata endpoint.
+func ec2RoleName(client *http.Client, endpoint string) (string, error) {
+	resp, err := client.Get(endpoint)
 	if err != nil {
-		return nil, fmt.Errorf("getting %s IAM credentials", s.Text())
+		return "", fmt.Errorf("listing EC2 instance roles: %s", err)
 	}
-	defer func() {
-		_ = resp.Body.Close()
-	}()
+	defer resp.Body.Close()
 
-	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
-		return nil, fmt.Errorf("decoding %s IAM credentials", s.Text())
+	if resp.StatusCode == http.StatusNotFound {
+		return "", fmt.Errorf("no IAM role attached to the EC2 instance")
 	}
-
-	p.creds = Credentials{
-		AccessKeyID:     body.AccessKeyID,
-		SecretAccessKey: body.SecretAccessKey,
-		SessionToken:    body.Token,
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("listing EC2 instance roles: %s", resp.Status)
 	}
-	p.expiration = body.Expiration
 
-	return &p.creds, nil
+	s := bufio.NewScanner(resp.Body)
+	if !s.Scan() {
+		if s.Err() != nil {
+			return "", fmt.Errorf("listing EC2 instance roles: %s", s.Err())
+		}
+		return "", fmt.Errorf("no IAM role attached to the EC2 instance")
+	}
+	return strings.TrimSpace(s.Text()), nil
 }
 
 type staticCredentialsProvider struct {
@@ -284,5 +526,11 @@ type staticCredentialsProvider struct {
 }
 
 func (p staticCredentialsProvider) Credentials() (*Credentials, error) {
+	if p.creds.AccessKeyID == "" {
+		return nil, ErrStaticAccessKeyIDEmpty
+	}
+	if p.creds.SecretAccessKey == "" {
+		return nil, ErrStaticSecretAccessKeyEmpty
+	}
 	return &p.creds, nil
 }
@@ -1,10 +1,14 @@
 package aws
 
 import (
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -82,6 +86,154 @@ func TestEnvCredsAlternateNames(t *testing.T) {
 	}
 }
 
+func TestEnvCredsAmazonNames(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("AMAZON_ACCESS_KEY_ID", "access")
+	os.Setenv("AMAZON_SECRET_ACCESS_KEY", "secret")
+	os.Setenv("AMAZON_SESSION_TOKEN", "token")
+
+	prov, err := EnvCreds()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	creds, err := prov.Credentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v, want := creds.AccessKeyID, "access"; v != want {
+		t.Errorf("Access key ID was %v, expected %v", v, want)
+	}
+
+	if v, want := creds.SecretAccessKey, "secret"; v != want {
+		t.Errorf("Secret access key was %v, expected %v", v, want)
+	}
+
+	if v, want := creds.SessionToken, "token"; v != want {
+		t.Errorf("Security token was %v, expected %v", v, want)
+	}
+}
+
+func TestEnvCredsPrecedence(t *testing.T) {
+	cases := []struct {
+		env                 map[string]string
+		accessKeyID, secret string
+		token               string
+	}{
+		{
+			env: map[string]string{
+				"AWS_ACCESS_KEY_ID":        "canonical-access",
+				"AWS_ACCESS_KEY":           "legacy-access",
+				"AMAZON_ACCESS_KEY_ID":     "amazon-access",
+				"AWS_SECRET_ACCESS_KEY":    "canonical-secret",
+				"AWS_SECRET_KEY":           "legacy-secret",
+				"AMAZON_SECRET_ACCESS_KEY": "amazon-secret",
+				"AWS_SESSION_TOKEN":        "canonical-token",
+				"AMAZON_SESSION_TOKEN":     "amazon-token",
+			},
+			accessKeyID: "canonical-access", secret: "canonical-secret", token: "canonical-token",
+		},
+		{
+			env: map[string]string{
+				"AWS_ACCESS_KEY":           "legacy-access",
+				"AMAZON_ACCESS_KEY_ID":     "amazon-access",
+				"AWS_SECRET_KEY":           "legacy-secret",
+				"AMAZON_SECRET_ACCESS_KEY": "amazon-secret",
+				"AMAZON_SESSION_TOKEN":     "amazon-token",
+			},
+			accessKeyID: "legacy-access", secret: "legacy-secret", token: "amazon-token",
+		},
+		{
+			env: map[string]string{
+				"AWS_ACCESS_KEY_ID":        "canonical-access",
+				"AMAZON_SECRET_ACCESS_KEY": "amazon-secret",
+			},
+			accessKeyID: "canonical-access", secret: "amazon-secret",
+		},
+	}
+
+	for i, c := range cases {
+		os.Clearenv()
+		for k, v := range c.env {
+			os.Setenv(k, v)
+		}
+
+		prov, err := EnvCreds()
+		if err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+
+		creds, err := prov.Credentials()
+		if err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+
+		if v, want := creds.AccessKeyID, c.accessKeyID; v != want {
+			t.Errorf("%d: Access key ID was %v, expected %v", i, v, want)
+		}
+
+		if v, want := creds.SecretAccessKey, c.secret; v != want {
+			t.Errorf("%d: Secret access key was %v, expected %v", i, v, want)
+		}
+
+		if v, want := creds.SessionToken, c.token; v != want {
+			t.Errorf("%d: Security token was %v, expected %v", i, v, want)
+		}
+	}
+}
+
+func TestEnvCredsPartialAmazonNames(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("AMAZON_ACCESS_KEY_ID", "access")
+
+	prov, err := EnvCreds()
+	if err != ErrSecretAccessKeyNotFound {
+		t.Fatalf("ErrSecretAccessKeyNotFound expected, but was %#v/%#v", prov, err)
+	}
+
+	os.Clearenv()
+	os.Setenv("AMAZON_SECRET_ACCESS_KEY", "secret")
+
+	prov, err = EnvCreds()
+	if err != ErrAccessKeyIDNotFound {
+		t.Fatalf("ErrAccessKeyIDNotFound expected, but was %#v/%#v", prov, err)
+	}
+}
+
+func TestStaticCreds(t *testing.T) {
+	creds, err := Creds("access", "secret", "").Credentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v, want := creds.AccessKeyID, "access"; v != want {
+		t.Errorf("Access key ID was %v, expected %v", v, want)
+	}
+
+	if v, want := creds.SecretAccessKey, "secret"; v != want {
+		t.Errorf("Secret access key was %v, expected %v", v, want)
+	}
+
+	if v, want := creds.SessionToken, ""; v != want {
+		t.Errorf("Security token was %v, expected %v", v, want)
+	}
+}
+
+func TestStaticCredsEmptyAccessKeyID(t *testing.T) {
+	creds, err := Creds("", "secret", "token").Credentials()
+	if err != ErrStaticAccessKeyIDEmpty {
+		t.Fatalf("ErrStaticAccessKeyIDEmpty expected, but was %#v/%#v", creds, err)
+	}
+}
+
+func TestStaticCredsEmptySecretAccessKey(t *testing.T) {
+	creds, err := Creds("access", "", "token").Credentials()
+	if err != ErrStaticSecretAccessKeyEmpty {
+		t.Fatalf("ErrStaticSecretAccessKeyEmpty expected, but was %#v/%#v", creds, err)
+	}
+}
+
 func TestIAMCreds(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.RequestURI == "/" {
@@ -128,6 +280,112 @@ func TestIAMCreds(t *testing.T) {
 	}
 }
 
+func TestIAMCredsSharedRefresh(t *testing.T) {
+	var fetches int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.RequestURI == "/" {
+			fmt.Fprintln(w, "/creds")
+			return
+		}
+		n := atomic.AddInt32(&fetches, 1)
+		fmt.Fprintf(w, `{
+  "AccessKeyId" : "accessKey%d",
+  "SecretAccessKey" : "secret",
+  "Token" : "token",
+  "Expiration" : "2014-12-16T0%d:00:00Z"
+}`, n, n)
+	}))
+	defer server.Close()
+
+	defer func(s string) {
+		metadataCredentialsEndpoint = s
+	}(metadataCredentialsEndpoint)
+	metadataCredentialsEndpoint = server.URL
+
+	defer func() {
+		currentTime = time.Now
+	}()
+
+	// the same provider is shared by the configs of many clients
+	prov := IAMCreds()
+	configs := make([]*Config, 50)
+	for i := range configs {
+		configs[i] = DefaultConfig.Merge(&Config{Credentials: prov})
+	}
+
+	getAll := func() []string {
+		keys := make([]string, len(configs))
+		var wg sync.WaitGroup
+		for i, cfg := range configs {
+			wg.Add(1)
+			go func(i int, cfg *Config) {
+				defer wg.Done()
+				creds, err := cfg.Credentials.Credentials()
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				keys[i] = creds.AccessKeyID
+			}(i, cfg)
+		}
+		wg.Wait()
+		return keys
+	}
+
+	// just before the credentials expire
+	currentTime = func() time.Time {
+		return time.Date(2014, 12, 16, 0, 59, 59, 0, time.UTC)
+	}
+	for _, k := range getAll() {
+		if k != "accessKey1" {
+			t.Errorf("AccessKeyID was %v, but expected accessKey1", k)
+		}
+	}
+	if n := atomic.LoadInt32(&fetches); n != 1 {
+		t.Errorf("credentials were retrieved %d times, but expected once", n)
+	}
+
+	// once they have expired
+	currentTime = func() time.Time {
+		return time.Date(2014, 12, 16, 1, 0, 0, 0, time.UTC)
+	}
+	for _, k := range getAll() {
+		if k != "accessKey2" {
+			t.Errorf("AccessKeyID was %v, but expected accessKey2", k)
+		}
+	}
+	if n := atomic.LoadInt32(&fetches); n != 2 {
+		t.Errorf("credentials were retrieved %d times, but expected twice", n)
+	}
+}
+
+func TestDefaultCredsEC2RoleFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.RequestURI == "/" {
+			fmt.Fprintln(w, "RoleName")
+		} else {
+			fmt.Fprintln(w, `{"Code": "AssumeRoleUnauthorizedAccess", "Message": "denied"}`)
+		}
+	}))
+	defer server.Close()
+
+	defer func(s string) {
+		metadataCredentialsEndpoint = s
+	}(metadataCredentialsEndpoint)
+	metadataCredentialsEndpoint = server.URL
+
+	os.Clearenv()
+	os.Setenv("AWS_SHARED_CREDENTIALS_FILE", "missing.ini")
+
+	creds, err := DefaultCreds().Credentials()
+	if err == nil {
+		t.Fatalf("expected an error, but got %#v", creds)
+	}
+	if v, want := err.Error(), "getting RoleName EC2 role credentials: AssumeRoleUnauthorizedAccess denied"; v != want {
+		t.Errorf("error was %q, but expected %q", v, want)
+	}
+}
+
 func TestProfileCreds(t *testing.T) {
 	prov, err := ProfileCreds("example.ini", "", 10*time.Minute)
 	if err != nil {
@@ -176,6 +434,100 @@ func TestProfileCredsWithoutToken(t *testing.T) {
 	}
 }
 
+func writeCredentialsFile(t *testing.T, contents string) string {
+	f, err := ioutil.TempFile("", "aws-credentials")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if _, err := f.WriteString(contents); err != nil {
+		t.Fatal(err)
+	}
+	return f.Name()
+}
+
+func TestProfileCredsEnvironmentOverrides(t *testing.T) {
+	filename := writeCredentialsFile(t, "[other]\naws_access_key_id = otherKey\naws_secret_access_key = otherSecret\n")
+	defer os.Remove(filename)
+
+	os.Clearenv()
+	os.Setenv("AWS_SHARED_CREDENTIALS_FILE", filename)
+	os.Setenv("AWS_PROFILE", "other")
+
+	prov, err := ProfileCreds("", "", 10*time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	creds, err := prov.Credentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v, want := creds.AccessKeyID, "otherKey"; v != want {
+		t.Errorf("AcccessKeyID was %v, but expected %v", v, want)
+	}
+
+	if v, want := creds.SecretAccessKey, "otherSecret"; v != want {
+		t.Errorf("SecretAccessKey was %v, but expected %v", v, want)
+	}
+}
+
+func TestProfileCredsExplicitOverridesEnvironment(t *testing.T) {
+	filename := writeCredentialsFile(t, "[other]\naws_access_key_id = otherKey\naws_secret_access_key = otherSecret\n")
+	defer os.Remove(filename)
+
+	os.Clearenv()
+	os.Setenv("AWS_SHARED_CREDENTIALS_FILE", filename)
+	os.Setenv("AWS_PROFILE", "other")
+
+	prov, err := ProfileCreds("example.ini", "no_token", 10*time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	creds, err := prov.Credentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v, want := creds.AccessKeyID, "accessKey"; v != want {
+		t.Errorf("AcccessKeyID was %v, but expected %v", v, want)
+	}
+
+	if v, want := creds.SessionToken, ""; v != want {
+		t.Errorf("SessionToken was %v, but expected %v", v, want)
+	}
+}
+
+func TestProfileCredsMissingProfile(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("AWS_PROFILE", "missing")
+
+	prov, err := ProfileCreds("example.ini", "", 10*time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = prov.Credentials()
+	if err == nil {
+		t.Fatal("Expected an error for a missing profile")
+	}
+
+	if v, want := err.Error(), "profile missing not found in example.ini"; v != want {
+		t.Errorf("Error was %q, expected %q", v, want)
+	}
+}
+
+func TestProfileCredsNoHomeDirectory(t *testing.T) {
+	os.Clearenv()
+
+	if _, err := ProfileCreds("", "", 10*time.Minute); err == nil {
+		t.Error("Expected an error when no credentials file can be located")
+	}
+}
+
 func BenchmarkProfileCreds(b *testing.B) {
 	prov, err := ProfileCreds("example.ini", "", 10*time.Minute)
 	if err != nil {
@@ -234,3 +586,109 @@ func BenchmarkIAMCreds(b *testing.B) {
 		}
 	})
 }
+
+type stubProvider struct {
+	creds   Credentials
+	err     error
+	expired bool
+	calls   int
+}
+
+func (s *stubProvider) Credentials() (*Credentials, error) {
+	s.calls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &s.creds, nil
+}
+
+func (s *stubProvider) IsExpired() bool {
+	return s.expired
+}
+
+func TestChainProviderAllFail(t *testing.T) {
+	prov := &ChainProvider{Providers: []CredentialsProvider{
+		&stubProvider{err: errors.New("no env credentials")},
+		&stubProvider{err: errors.New("no shared credentials")},
+		&stubProvider{err: errors.New("no instance role")},
+	}}
+
+	creds, err := prov.Credentials()
+	if creds != nil {
+		t.Errorf("Expected no credentials, but got %v", creds)
+	}
+
+	chainErr, ok := err.(ChainProviderError)
+	if !ok {
+		t.Fatalf("Expected ChainProviderError, but was %#v", err)
+	}
+	if v, want := len(chainErr.Errors), 3; v != want {
+		t.Errorf("Error count was %v, expected %v", v, want)
+	}
+
+	want := "no valid credentials providers in chain:\n" +
+		"- *aws.stubProvider: no env credentials\n" +
+		"- *aws.stubProvider: no shared credentials\n" +
+		"- *aws.stubProvider: no instance role"
+	if v := err.Error(); v != want {
+		t.Errorf("Error was %q, expected %q", v, want)
+	}
+
+	if !prov.IsExpired() {
+		t.Errorf("Expected chain with no successful provider to be expired")
+	}
+}
+
+func TestChainProviderMidChainSuccess(t *testing.T) {
+	first := &stubProvider{err: errors.New("no env credentials")}
+	second := &stubProvider{creds: Credentials{AccessKeyID: "access", SecretAccessKey: "secret"}}
+	third := &stubProvider{creds: Credentials{AccessKeyID: "other"}}
+	prov := &ChainProvider{Providers: []CredentialsProvider{first, second, third}}
+
+	creds, err := prov.Credentials()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, want := creds.AccessKeyID, "access"; v != want {
+		t.Errorf("Access key ID was %v, expected %v", v, want)
+	}
+	if v, want := third.calls, 0; v != want {
+		t.Errorf("Providers after a success were called %v times, expected %v", v, want)
+	}
+
+	// the successful provider is reused while it is not expired
+	if _, err := prov.Credentials(); err != nil {
+		t.Fatal(err)
+	}
+	if v, want := first.calls, 1; v != want {
+		t.Errorf("First provider was called %v times, expected %v", v, want)
+	}
+	if v, want := second.calls, 2; v != want {
+		t.Errorf("Second provider was called %v times, expected %v", v, want)
+	}
+}
+
+func TestChainProviderIsExpired(t *testing.T) {
+	stub := &stubProvider{creds: Credentials{AccessKeyID: "access"}}
+	prov := &ChainProvider{Providers: []CredentialsProvider{stub}}
+
+	if _, err := prov.Credentials(); err != nil {
+		t.Fatal(err)
+	}
+	if prov.IsExpired() {
+		t.Errorf("Expected chain to not be expired")
+	}
+
+	stub.expired = true
+	if !prov.IsExpired() {
+		t.Errorf("Expected chain to delegate expiry to the successful provider")
+	}
+
+	static := &ChainProvider{Providers: []CredentialsProvider{Creds("access", "secret", "")}}
+	if _, err := static.Credentials(); err != nil {
+		t.Fatal(err)
+	}
+	if static.IsExpired() {
+		t.Errorf("Expected chain of static credentials to never expire")
+	}
+}
@@ -0,0 +1,84 @@
+package aws
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+var metadataRegionEndpoint = "http://169.254.169.254/latest/meta-data/placement/availability-zone"
+
+// metadataRegionClient is the HTTP client used to query the metadata endpoint
+// for the instance's region. Its short timeout bounds the delay added to
+// clients created on instances whose metadata service is unreachable.
+var metadataRegionClient = &http.Client{
+	Timeout:       500 * time.Millisecond,
+	CheckRedirect: MetadataCheckRedirect,
+}
+
+// ec2DMIFiles are the files identifying a Linux host as an EC2 instance, with
+// the prefix of their content on one.
+var ec2DMIFiles = map[string]string{
+	"/sys/hypervisor/uuid":                        "ec2",
+	"/sys/devices/virtual/dmi/id/sys_vendor":      "Amazon EC2",
+	"/sys/devices/virtual/dmi/id/board_asset_tag": "i-",
+}
+
+// onEC2 returns whether the host is an EC2 instance, so that the metadata
+// service is not queried elsewhere.
+var onEC2 = func() bool {
+	for file, prefix := range ec2DMIFiles {
+		if b, err := ioutil.ReadFile(file); err == nil && strings.HasPrefix(strings.ToLower(string(b)), strings.ToLower(prefix)) {
+			return true
+		}
+	}
+	return false
+}
+
+// ec2Region caches the region discovered from the instance metadata, which
+// is looked up at most once per process.
+var ec2Region struct {
+	sync.Once
+	region string
+}
+
+// EC2MetadataRegion returns the region of the EC2 instance the process runs
+// on, derived from its availability zone. It returns an empty string when not
+// running on EC2 or if the metadata service cannot be reached. The metadata
+// service is only queried on the first call.
+func EC2MetadataRegion() string {
+	ec2Region.Do(func() {
+		if onEC2() {
+			ec2Region.region, _ = metadataRegion()
+		}
+	})
+	return ec2Region.region
+}
+
+// metadataRegion queries the metadata service for the instance's availability
+// zone, such as "us-west-2a", and strips its trailing zone letter.
+func metadataRegion() (string, error) {
+	resp, err := metadataRegionClient.Get(metadataRegionEndpoint)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unable to get availability zone from metadata: %s", resp.Status)
+	}
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	zone := strings.TrimSpace(string(b))
+	if len(zone) < 2 {
+		return "", fmt.Errorf("invalid availability zone from metadata: %q", zone)
+	}
+	return zone[:len(zone)-1], nil
+}
@@ -0,0 +1,54 @@
+package aws
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mockEC2Region(t *testing.T, zone string, ec2 bool) func() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/latest/meta-data/placement/availability-zone", r.URL.Path)
+		w.Write([]byte(zone))
+	}))
+
+	origEndpoint, origOnEC2 := metadataRegionEndpoint, onEC2
+	metadataRegionEndpoint = server.URL + "/latest/meta-data/placement/availability-zone"
+	onEC2 = func() bool { return ec2 }
+	ec2Region.Once, ec2Region.region = sync.Once{}, ""
+
+	return func() {
+		server.Close()
+		metadataRegionEndpoint, onEC2 = origEndpoint, origOnEC2
+		ec2Region.Once, ec2Region.region = sync.Once{}, ""
+	}
+}
+
+func TestEC2MetadataRegion(t *testing.T) {
+	defer mockEC2Region(t, "us-west-2a", true)()
+
+	assert.Equal(t, "us-west-2", EC2MetadataRegion())
+
+	cfg := &Config{}
+	s := &Service{Config: cfg, ServiceName: "dynamodb"}
+	s.Initialize()
+	assert.Equal(t, "us-west-2", s.Config.Region)
+	assert.Equal(t, "https://dynamodb.us-west-2.amazonaws.com", s.Endpoint)
+	assert.Equal(t, "", cfg.Region) // the caller's Config is left as it is
+}
+
+func TestEC2MetadataRegionOffEC2(t *testing.T) {
+	defer mockEC2Region(t, "us-west-2a", false)()
+
+	assert.Equal(t, "", EC2MetadataRegion())
+}
+
+func TestEC2MetadataRegionNotOverridingConfig(t *testing.T) {
+	defer mockEC2Region(t, "us-west-2a", true)()
+
+	s := NewService(&Config{Region: "eu-west-1"})
+	assert.Equal(t, "eu-west-1", s.Config.Region)
+}
@@ -0,0 +1,104 @@
+// Package ec2rolecreds provides a credential provider which retrieves the
+// credentials of the IAM role attached to an EC2 instance. It retrieves them
+// with aws.EC2RoleCredentials, as aws.IAMCreds and the DefaultCreds do, and
+// adds a configurable client, endpoint and expiry window to their caching.
+package ec2rolecreds
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+)
+
+var currentTime = time.Now
+
+// DefaultEndpoint is the instance metadata URL listing the instance's
+// security credentials.
+const DefaultEndpoint = "http://169.254.169.254/latest/meta-data/iam/security-credentials/"
+
+// DefaultClient is the HTTP client used to query the instance metadata
+// service when the provider does not have one set.
+var DefaultClient = &http.Client{
+	Timeout:       1 * time.Second,
+	CheckRedirect: aws.MetadataCheckRedirect,
+}
+
+// An EC2RoleProvider retrieves the temporary credentials of the IAM role
+// attached to the EC2 instance from the instance metadata service.
+// Credentials are cached until they are within ExpiryWindow of their
+// expiration.
+//
+//	creds := &ec2rolecreds.EC2RoleProvider{
+//	    ExpiryWindow: 10 * time.Minute,
+//	}
+//	svc := s3.New(&aws.Config{Credentials: creds})
+type EC2RoleProvider struct {
+	// HTTP client used to query the metadata service. Defaults to
+	// DefaultClient. Clients without a CheckRedirect are given
+	// aws.MetadataCheckRedirect.
+	Client *http.Client
+
+	// URL listing the instance's security credentials. Defaults to
+	// DefaultEndpoint.
+	Endpoint string
+
+	// ExpiryWindow refreshes credentials this long before they actually
+	// expire, so that in-flight requests are not signed with credentials
+	// which are about to become invalid. Defaults to
+	// aws.DefaultExpiryWindow. A negative window uses credentials until
+	// their actual expiration.
+	ExpiryWindow time.Duration
+
+	creds      aws.Credentials
+	m          sync.Mutex
+	expiration time.Time
+}
+
+// Credentials returns the cached role credentials, retrieving them from the
+// metadata service again if they have expired.
+func (p *EC2RoleProvider) Credentials() (*aws.Credentials, error) {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	if !p.isExpired() {
+		creds := p.creds
+		return &creds, nil
+	}
+
+	creds, expiration, err := aws.EC2RoleCredentials(p.client(), p.endpoint())
+	if err != nil {
+		return nil, err
+	}
+	p.creds, p.expiration = *creds, expiration
+
+	return creds, nil
+}
+
+// IsExpired returns whether the cached credentials are expired, or within the
+// provider's ExpiryWindow of expiring.
+func (p *EC2RoleProvider) IsExpired() bool {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	return p.isExpired()
+}
+
+func (p *EC2RoleProvider) isExpired() bool {
+	return aws.ExpiredWithin(p.expiration, currentTime(), p.ExpiryWindow)
+}
+
+func (p *EC2RoleProvider) client() *http.Client {
+	if p.Client == nil {
+		return DefaultClient
+	}
+	return p.Client
+}
+
+func (p *EC2RoleProvider) endpoint() string {
+	if p.Endpoint == "" {
+		return DefaultEndpoint
+	}
+	return p.Endpoint
+}
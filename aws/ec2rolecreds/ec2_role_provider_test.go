@@ -0,0 +1,173 @@
+package ec2rolecreds
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/stretchr/testify/assert"
+)
+
+// metadataServer emulates the instance metadata credentials endpoint. Each
+// credentials request returns a new access key, expiring at expiry.
+func metadataServer(role string, expiry time.Time, requests *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/latest/meta-data/iam/security-credentials/":
+			if role == "" {
+				http.NotFound(w, r)
+				return
+			}
+			fmt.Fprintln(w, role)
+		case "/latest/meta-data/iam/security-credentials/" + role:
+			*requests++
+			fmt.Fprintf(w, `{
+				"Code": "Success",
+				"LastUpdated": "2015-01-01T00:00:00Z",
+				"Type": "AWS-HMAC",
+				"AccessKeyId": "accessKey%d",
+				"SecretAccessKey": "secret",
+				"Token": "token",
+				"Expiration": %q
+			}`, *requests, expiry.Format(time.RFC3339))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+func setTime(t time.Time) {
+	currentTime = func() time.Time { return t }
+}
+
+func TestEC2RoleProvider(t *testing.T) {
+	now := time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)
+	setTime(now)
+	requests := 0
+	server := metadataServer("RoleName", now.Add(time.Hour), &requests)
+	defer server.Close()
+
+	p := &EC2RoleProvider{
+		Client:   server.Client(),
+		Endpoint: server.URL + "/latest/meta-data/iam/security-credentials/",
+	}
+
+	assert.True(t, p.IsExpired())
+	creds, err := p.Credentials()
+	assert.NoError(t, err)
+	assert.Equal(t, "accessKey1", creds.AccessKeyID)
+	assert.Equal(t, "secret", creds.SecretAccessKey)
+	assert.Equal(t, "token", creds.SessionToken)
+	assert.False(t, p.IsExpired())
+
+	creds, err = p.Credentials()
+	assert.NoError(t, err)
+	assert.Equal(t, "accessKey1", creds.AccessKeyID)
+	assert.Equal(t, 1, requests)
+}
+
+func TestEC2RoleProviderRefreshesOnExpiry(t *testing.T) {
+	now := time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)
+	setTime(now)
+	requests := 0
+	server := metadataServer("RoleName", now.Add(time.Hour), &requests)
+	defer server.Close()
+
+	p := &EC2RoleProvider{
+		Client:       server.Client(),
+		Endpoint:     server.URL + "/latest/meta-data/iam/security-credentials",
+		ExpiryWindow: 10 * time.Minute,
+	}
+
+	_, err := p.Credentials()
+	assert.NoError(t, err)
+
+	setTime(now.Add(49 * time.Minute))
+	assert.False(t, p.IsExpired())
+
+	setTime(now.Add(51 * time.Minute)) // within the expiry window
+	assert.True(t, p.IsExpired())
+	creds, err := p.Credentials()
+	assert.NoError(t, err)
+	assert.Equal(t, "accessKey2", creds.AccessKeyID)
+	assert.Equal(t, 2, requests)
+}
+
+func TestEC2RoleProviderNoRole(t *testing.T) {
+	setTime(time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC))
+	requests := 0
+	server := metadataServer("", time.Time{}, &requests)
+	defer server.Close()
+
+	p := &EC2RoleProvider{
+		Client:   server.Client(),
+		Endpoint: server.URL + "/latest/meta-data/iam/security-credentials/",
+	}
+
+	creds, err := p.Credentials()
+	assert.Nil(t, creds)
+	assert.Error(t, err)
+	assert.Equal(t, "no IAM role attached to the EC2 instance", err.Error())
+	assert.True(t, p.IsExpired())
+}
+
+func TestEC2RoleProviderFailedDocument(t *testing.T) {
+	setTime(time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC))
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/creds/" {
+			fmt.Fprintln(w, "RoleName")
+			return
+		}
+		fmt.Fprint(w, `{"Code": "AssumeRoleUnauthorizedAccess", "Message": "denied"}`)
+	}))
+	defer server.Close()
+
+	p := &EC2RoleProvider{Client: server.Client(), Endpoint: server.URL + "/creds/"}
+	_, err := p.Credentials()
+	assert.Error(t, err)
+	assert.Equal(t, "getting RoleName EC2 role credentials: AssumeRoleUnauthorizedAccess denied", err.Error())
+}
+
+func TestEC2RoleProviderDefaultExpiryWindow(t *testing.T) {
+	now := time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)
+	setTime(now)
+	requests := 0
+	server := metadataServer("RoleName", now.Add(time.Hour), &requests)
+	defer server.Close()
+
+	p := &EC2RoleProvider{
+		Client:   server.Client(),
+		Endpoint: server.URL + "/latest/meta-data/iam/security-credentials/",
+	}
+	_, err := p.Credentials()
+	assert.NoError(t, err)
+
+	setTime(now.Add(time.Hour - aws.DefaultExpiryWindow - time.Second))
+	assert.False(t, p.IsExpired())
+	setTime(now.Add(time.Hour - aws.DefaultExpiryWindow))
+	assert.True(t, p.IsExpired())
+
+	p.ExpiryWindow = -1 // no window
+	assert.False(t, p.IsExpired())
+	setTime(now.Add(time.Hour))
+	assert.True(t, p.IsExpired())
+}
+
+func TestEC2RoleProviderRejectsExternalRedirect(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "http://example.com"+r.URL.Path, http.StatusFound)
+	}))
+	defer server.Close()
+
+	p := &EC2RoleProvider{
+		Client:   server.Client(),
+		Endpoint: server.URL + "/latest/meta-data/iam/security-credentials/",
+	}
+
+	_, err := p.Credentials()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "refusing redirect of metadata request to example.com")
+}
@@ -0,0 +1,64 @@
+package aws
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// DefaultEndpointDomains are the domains of the SDK's endpoints.
+var DefaultEndpointDomains = []string{"amazonaws.com", "amazonaws.com.cn"}
+
+// EndpointDomainValidator returns an EndpointValidator accepting endpoints
+// whose host is one of domains, or a subdomain of one. It accepts the
+// DefaultEndpointDomains when called without domains.
+func EndpointDomainValidator(domains ...string) func(endpoint string) error {
+	if len(domains) == 0 {
+		domains = DefaultEndpointDomains
+	}
+	return func(endpoint string) error {
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return err
+		}
+		host := strings.ToLower(hostname(u.Host))
+		for _, d := range domains {
+			d = strings.ToLower(strings.TrimPrefix(d, "."))
+			if host == d || strings.HasSuffix(host, "."+d) {
+				return nil
+			}
+		}
+		return fmt.Errorf("host %q is not within %s", host, strings.Join(domains, ", "))
+	}
+}
+
+// MetadataCheckRedirect is the CheckRedirect of the HTTP clients querying the
+// EC2 instance metadata service. It refuses redirects to hosts other than
+// link-local IP addresses, so that a metadata request cannot be redirected to
+// an arbitrary server.
+func MetadataCheckRedirect(req *http.Request, via []*http.Request) error {
+	if !IsLinkLocalHost(req.URL.Host) {
+		return fmt.Errorf("refusing redirect of metadata request to %s", req.URL.Host)
+	}
+	if len(via) >= 10 {
+		return fmt.Errorf("stopped after 10 redirects")
+	}
+	return nil
+}
+
+// IsLinkLocalHost returns whether host, with or without a port, is a
+// link-local IP address, such as the 169.254.169.254 of the metadata service.
+func IsLinkLocalHost(host string) bool {
+	ip := net.ParseIP(hostname(host))
+	return ip != nil && ip.IsLinkLocalUnicast()
+}
+
+// hostname strips the port, and the brackets of IPv6 addresses, from host.
+func hostname(host string) string {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		return h
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(host, "["), "]")
+}
@@ -0,0 +1,54 @@
+package aws
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEndpointDomainValidator(t *testing.T) {
+	validate := EndpointDomainValidator()
+	assert.NoError(t, validate("https://s3.amazonaws.com"))
+	assert.NoError(t, validate("https://ec2.cn-north-1.amazonaws.com.cn:443/path"))
+	assert.Error(t, validate("https://s3.amazonaws.com.attacker.example"))
+	assert.Error(t, validate("https://notamazonaws.com"))
+	assert.Error(t, validate("http://169.254.169.254"))
+
+	validate = EndpointDomainValidator("localhost", "internal.example")
+	assert.NoError(t, validate("http://localhost:8000"))
+	assert.NoError(t, validate("https://s3.internal.example"))
+	assert.Error(t, validate("https://s3.amazonaws.com"))
+}
+
+func TestIsLinkLocalHost(t *testing.T) {
+	assert.True(t, IsLinkLocalHost("169.254.169.254"))
+	assert.True(t, IsLinkLocalHost("169.254.170.2:80"))
+	assert.True(t, IsLinkLocalHost("[fe80::1]:80"))
+	assert.False(t, IsLinkLocalHost("10.0.0.1"))
+	assert.False(t, IsLinkLocalHost("169.254.169.254.example.com"))
+	assert.False(t, IsLinkLocalHost("example.com"))
+}
+
+func TestMetadataCheckRedirect(t *testing.T) {
+	redirect := func(location string) error {
+		req, _ := http.NewRequest("GET", location, nil)
+		return MetadataCheckRedirect(req, []*http.Request{{}})
+	}
+	assert.NoError(t, redirect("http://169.254.169.254/latest/meta-data/placement/availability-zone"))
+	assert.Error(t, redirect("http://example.com/latest/meta-data/placement/availability-zone"))
+}
+
+func TestMetadataRegionRejectsExternalRedirect(t *testing.T) {
+	defer func(endpoint string) { metadataRegionEndpoint = endpoint }(metadataRegionEndpoint)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "http://example.com/us-west-2a", http.StatusFound)
+	}))
+	defer server.Close()
+	metadataRegionEndpoint = server.URL
+
+	_, err := metadataRegion()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "refusing redirect of metadata request to example.com")
+}
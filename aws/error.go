@@ -1,6 +1,10 @@
 package aws
 
-import "time"
+import (
+	"time"
+
+	"github.com/awslabs/aws-sdk-go/aws/awserr"
+)
 
 // An APIError is an error returned by an AWS API.
 type APIError struct {
@@ -8,6 +12,7 @@ type APIError struct {
 	Code       string
 	Message    string
 	RequestID  string
+	HostID     string // S3's x-amz-id-2, identifying the host of the response
 	Retryable  bool
 	RetryDelay time.Duration
 	RetryCount uint
@@ -17,10 +22,37 @@ func (e APIError) Error() string {
 	return e.Message
 }
 
+// Attempts returns the number of times the failed request was sent.
+func (e APIError) Attempts() int {
+	return int(e.RetryCount) + 1
+}
+
+// Error returns e as an APIError, or nil if it is not one. Errors built by
+// the protocol unmarshalers as an awserr.Error are converted to an APIError
+// with the same code, message, status code and request ID.
 func Error(e error) *APIError {
-	if err, ok := e.(APIError); ok {
+	switch err := e.(type) {
+	case APIError:
 		return &err
-	} else {
+	case awserr.RequestAttemptsFailure:
+		return &APIError{
+			StatusCode: err.StatusCode(),
+			Code:       err.Code(),
+			Message:    err.Message(),
+			RequestID:  err.RequestID(),
+			HostID:     err.HostID(),
+			RetryCount: uint(err.Attempts() - 1),
+		}
+	case awserr.RequestFailure:
+		return &APIError{
+			StatusCode: err.StatusCode(),
+			Code:       err.Code(),
+			Message:    err.Message(),
+			RequestID:  err.RequestID(),
+		}
+	case awserr.Error:
+		return &APIError{Code: err.Code(), Message: err.Message()}
+	default:
 		return nil
 	}
 }
@@ -0,0 +1,35 @@
+package aws
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/awslabs/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorFromRequestFailure(t *testing.T) {
+	err := awserr.NewRequestFailure(awserr.New("Throttling", "Rate exceeded", nil), 400, "request-id")
+
+	apiErr := Error(err)
+	assert.NotNil(t, apiErr)
+	assert.Equal(t, "Throttling", apiErr.Code)
+	assert.Equal(t, "Rate exceeded", apiErr.Message)
+	assert.Equal(t, 400, apiErr.StatusCode)
+	assert.Equal(t, "request-id", apiErr.RequestID)
+}
+
+func TestErrorFromOtherErrors(t *testing.T) {
+	orig := errors.New("connection reset")
+	err := awserr.New("SerializationError", "failed to decode response", orig)
+	assert.Equal(t, orig, err.OrigErr())
+	assert.Equal(t, "SerializationError", Error(err).Code)
+
+	assert.Nil(t, Error(orig))
+
+	// errors which are already an awserr.Error are wrapped too
+	wrapped := awserr.New("RequestError", "send request failed", err)
+	assert.Equal(t, "RequestError", wrapped.Code())
+	assert.Equal(t, "send request failed", wrapped.Message())
+	assert.Equal(t, err, wrapped.OrigErr())
+}
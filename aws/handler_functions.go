@@ -1,8 +1,18 @@
 package aws
 
 import (
+	"bytes"
+	"compress/gzip"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"fmt"
+	"hash"
 	"io"
+	"net/http"
+	"reflect"
+	"strings"
 	"time"
 )
 
@@ -14,6 +24,13 @@ type lener interface {
 	Len() int
 }
 
+// BuildContentLength sets the Content-Length header of the request to the
+// length of its body, unless it has already been set. The length of a
+// seekable body is what remains of it from its current offset, found by
+// seeking to its end and back. Bodies which cannot be seeked, such as a
+// ReadSeekCloser wrapping a pipe, are sent with chunked transfer encoding,
+// unless the service requires a Content-Length, such as S3, which fails the
+// request with a ContentLengthRequired error.
 func BuildContentLength(r *Request) {
 	if r.HTTPRequest.Header.Get("Content-Length") != "" {
 		return
@@ -25,54 +42,461 @@ func BuildContentLength(r *Request) {
 		length = 0
 	case lener:
 		length = int64(body.Len())
-	case io.Seeker:
-		cur, _ := body.Seek(0, 1)
-		end, _ := body.Seek(0, 2)
-		body.Seek(cur, 0) // make sure to seek back to original location
-		length = end - cur
 	default:
-		panic("Cannot get length of body, must provide `ContentLength`")
+		if !r.bodyRewindable() {
+			if r.Service.ContentLengthRequired {
+				r.Error = APIError{
+					Code:    "ContentLengthRequired",
+					Message: "request body must be an io.Seeker or have its Content-Length set",
+				}
+				return
+			}
+			r.HTTPRequest.ContentLength = -1 // sent chunked
+			return
+		}
+
+		var err error
+		if length, err = remainingLength(body); err != nil {
+			r.Error = err
+			return
+		}
 	}
 
 	r.HTTPRequest.ContentLength = length
 	r.HTTPRequest.Header.Set("Content-Length", fmt.Sprintf("%d", length))
 }
 
-func UserAgentHandler(r *Request) {
-	r.HTTPRequest.Header.Set("User-Agent", SDKName+"/"+SDKVersion)
+// ExpectContinueHandler sets the Expect: 100-continue header of requests
+// whose body is at least the Config's ExpectContinueMinSize bytes long, so
+// that the body is only sent once the service has accepted the request's
+// headers, and not wasted on requests it rejects, such as for bad
+// credentials. It runs after BuildContentLength, and skips bodies sent
+// chunked, whose length is unknown.
+func ExpectContinueHandler(r *Request) {
+	if r.Body == nil || r.HTTPRequest.ContentLength < r.Service.Config.ExpectContinueMinSize {
+		return
+	}
+	r.HTTPRequest.Header.Set("Expect", "100-continue")
+}
+
+// remainingLength returns the number of bytes of s after its current
+// offset, leaving s at that offset.
+func remainingLength(s io.Seeker) (int64, error) {
+	cur, err := s.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0, err
+	}
+	end, err := s.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := s.Seek(cur, io.SeekStart); err != nil {
+		return 0, err
+	}
+	return end - cur, nil
 }
 
+// SendHandler sends the request with the Service's HTTPClient. The request
+// is not sent if a handler before it in the Send list failed it.
 func SendHandler(r *Request) {
-	r.HTTPResponse, r.Error = r.Service.Config.HTTPClient.Do(r.HTTPRequest)
+	if r.Error != nil {
+		return
+	}
+	r.HTTPResponse, r.Error = r.Service.HTTPClient().Do(r.HTTPRequest)
 }
 
+// gzipReadCloser closes both the gzip reader and the body it reads from.
+type gzipReadCloser struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+func (g gzipReadCloser) Close() error {
+	g.Reader.Close()
+	return g.body.Close()
+}
+
+// GzipResponseHandler wraps the body of a response sent with a gzip
+// Content-Encoding in a gzip reader, so that it is decompressed as the
+// request's unmarshal handlers read it. The response's headers are left as
+// they were sent. Empty bodies, such as those of HEAD responses, are left as
+// they are.
+func GzipResponseHandler(r *Request) {
+	if r.HTTPResponse == nil || r.HTTPResponse.Body == nil || r.HTTPResponse.ContentLength == 0 {
+		return
+	}
+	if r.HTTPRequest != nil && r.HTTPRequest.Method == "HEAD" {
+		return
+	}
+	if !strings.EqualFold(r.HTTPResponse.Header.Get("Content-Encoding"), "gzip") {
+		return
+	}
+
+	gz, err := gzip.NewReader(r.HTTPResponse.Body)
+	if err == io.EOF {
+		return // an empty body of unknown length
+	}
+	if err != nil {
+		r.Error = err
+		return
+	}
+	r.HTTPResponse.Body = gzipReadCloser{gz, r.HTTPResponse.Body}
+}
+
+// GzipRequestHandler compresses the body of a request to an operation
+// accepting compressed requests with gzip, when the body is at least the
+// Config's RequestCompressionMinSize bytes long, and adds gzip to its
+// Content-Encoding. It runs before the request is signed, so the compressed
+// body is what is hashed, and compresses the body only once when a retry
+// signs the request again. Bodies which cannot be seeked are sent as they
+// are.
+func GzipRequestHandler(r *Request) {
+	if r.bodyCompressed || !r.Operation.RequestCompression || r.Body == nil || !r.bodyRewindable() {
+		return
+	}
+
+	start, err := r.Body.Seek(0, 1)
+	if err != nil {
+		return
+	}
+	end, err := r.Body.Seek(0, 2)
+	if err != nil {
+		return
+	}
+	if _, err := r.Body.Seek(start, 0); err != nil {
+		r.Error = err
+		return
+	}
+	if end-start < r.Service.Config.RequestCompressionMinSize {
+		return
+	}
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	if _, err := io.Copy(gz, r.Body); err != nil {
+		r.Error = err
+		return
+	}
+	if err := gz.Close(); err != nil {
+		r.Error = err
+		return
+	}
+
+	r.SetBufferBody(buf.Bytes())
+	if enc := r.HTTPRequest.Header.Get("Content-Encoding"); enc != "" {
+		r.HTTPRequest.Header.Set("Content-Encoding", enc+", gzip")
+	} else {
+		r.HTTPRequest.Header.Set("Content-Encoding", "gzip")
+	}
+	r.HTTPRequest.Header.Del("Content-Length") // recomputed for the compressed body
+	r.bodyCompressed = true
+}
+
+// checksumReadCloser computes the digest of a response body as it is read,
+// and fails the read reaching the end of the body if the digest does not
+// match the one sent by the server. Decoders may stop reading before the end
+// of the body, so when drain is set the rest of the body is digested when it
+// is closed, to be verified once the response has been unmarshaled.
+type checksumReadCloser struct {
+	body     io.ReadCloser
+	hash     hash.Hash
+	expected []byte
+	header   string
+	drain    bool
+	done     bool // whether all of the body has been digested
+}
+
+func (c *checksumReadCloser) Read(p []byte) (int, error) {
+	n, err := c.body.Read(p)
+	c.hash.Write(p[:n])
+	if err == io.EOF {
+		c.done = true
+		if err := c.verify(); err != nil {
+			return n, err
+		}
+	}
+	return n, err
+}
+
+func (c *checksumReadCloser) Close() error {
+	if c.drain && !c.done {
+		if _, err := io.Copy(c.hash, c.body); err == nil {
+			c.done = true
+		}
+	}
+	return c.body.Close()
+}
+
+// verify digests the part of the body not read yet, and returns an error if
+// the digest of the body does not match the expected one.
+func (c *checksumReadCloser) verify() error {
+	if !c.done {
+		if _, err := io.Copy(c.hash, c.body); err != nil {
+			return err
+		}
+		c.done = true
+	}
+	if actual := c.hash.Sum(nil); !bytes.Equal(actual, c.expected) {
+		return APIError{
+			Code: "ChecksumMismatch",
+			Message: fmt.Sprintf("response body does not match its %s checksum",
+				c.header),
+		}
+	}
+	return nil
+}
+
+// ChecksumResponseHandler validates the body of a successful response
+// against its Content-MD5, or X-Amz-Content-Sha256, header. The body is
+// streamed through a digest as it is read rather than buffered. The bodies
+// of operations streaming their output, such as S3's GetObject, fail with
+// the mismatch on the read reaching their end. Other bodies are digested to
+// their end, even if their unmarshaler stopped short of it, and the request
+// fails with the mismatch once they have been unmarshaled. Responses without
+// either header are left unchanged.
+func ChecksumResponseHandler(r *Request) {
+	if r.HTTPResponse == nil || r.HTTPResponse.Body == nil {
+		return
+	}
+	if r.HTTPResponse.StatusCode < 200 || r.HTTPResponse.StatusCode > 299 {
+		return
+	}
+
+	c := &checksumReadCloser{body: r.HTTPResponse.Body}
+	var err error
+	if v := r.HTTPResponse.Header.Get("Content-MD5"); v != "" {
+		c.hash, c.header = md5.New(), "Content-MD5"
+		c.expected, err = base64.StdEncoding.DecodeString(v)
+	} else if v := r.HTTPResponse.Header.Get("X-Amz-Content-Sha256"); v != "" && v != "UNSIGNED-PAYLOAD" {
+		c.hash, c.header = sha256.New(), "X-Amz-Content-Sha256"
+		c.expected, err = hex.DecodeString(v)
+	} else {
+		return
+	}
+
+	if err != nil {
+		r.Error = APIError{
+			Code:    "ChecksumMismatch",
+			Message: fmt.Sprintf("invalid %s checksum header: %v", c.header, err),
+		}
+		return
+	}
+	r.HTTPResponse.Body = c
+
+	if !streamsOutput(r) {
+		// run after the protocol's unmarshaler, replacing the handler of a
+		// previous attempt
+		c.drain = true
+		h := NamedHandler{"aws.VerifyResponseChecksum", func(r *Request) {
+			if r.Error == nil {
+				r.Error = c.verify()
+			}
+		}}
+		if !r.Handlers.Unmarshal.SwapNamed(h) {
+			r.Handlers.Unmarshal.PushBackNamed(h)
+		}
+	}
+}
+
+// limitedReadCloser fails reads once more than limit bytes of a response
+// body have been read. The error is kept, so that reads after the limit was
+// exceeded fail with it too.
+type limitedReadCloser struct {
+	body  io.ReadCloser
+	limit int64
+	read  int64
+	err   error
+}
+
+func (l *limitedReadCloser) Read(p []byte) (int, error) {
+	if l.err != nil {
+		return 0, l.err
+	}
+	if max := l.limit - l.read + 1; int64(len(p)) > max {
+		p = p[:max] // read one byte past the limit to detect exceeding it
+	}
+
+	n, err := l.body.Read(p)
+	l.read += int64(n)
+	if l.read > l.limit {
+		l.err = APIError{
+			Code:    "ResponseBodyTooLarge",
+			Message: fmt.Sprintf("response body exceeds the maximum size of %d bytes", l.limit),
+		}
+		if n -= int(l.read - l.limit); n < 0 {
+			n = 0
+		}
+		return n, l.err
+	}
+	return n, err
+}
+
+func (l *limitedReadCloser) Close() error {
+	return l.body.Close()
+}
+
+// MaxResponseBodySizeHandler bounds the body of a response to the Config's
+// MaxResponseBodySize, so that reading a larger body, as unmarshaling does,
+// fails with a ResponseBodyTooLarge error instead of buffering all of it. The
+// bodies of operations streaming their output, such as S3's GetObject, are
+// returned to the caller unread and are not bounded.
+func MaxResponseBodySizeHandler(r *Request) {
+	if r.HTTPResponse == nil || r.HTTPResponse.Body == nil {
+		return
+	}
+	if r.Service.Config.MaxResponseBodySize <= 0 || streamsOutput(r) {
+		return
+	}
+
+	r.HTTPResponse.Body = &limitedReadCloser{
+		body:  r.HTTPResponse.Body,
+		limit: r.Service.Config.MaxResponseBodySize,
+	}
+}
+
+// savingReadCloser copies a response body into a buffer as it is read.
+type savingReadCloser struct {
+	io.Reader
+	body io.ReadCloser
+}
+
+func (s savingReadCloser) Close() error {
+	return s.body.Close()
+}
+
+// SaveResponseBodyHandler copies the body of a response into the request as
+// it is read, for ResponseBody to return. Each attempt's response replaces
+// the body saved from the previous one. The bodies of operations streaming
+// their output are not saved.
+func SaveResponseBodyHandler(r *Request) {
+	if r.HTTPResponse == nil || r.HTTPResponse.Body == nil {
+		return
+	}
+	if streamsOutput(r) {
+		r.savedBody = nil
+		return
+	}
+
+	r.savedBody = &bytes.Buffer{}
+	r.HTTPResponse.Body = savingReadCloser{
+		Reader: io.TeeReader(r.HTTPResponse.Body, r.savedBody),
+		body:   r.HTTPResponse.Body,
+	}
+}
+
+// readerType is the type of io.Reader.
+var readerType = reflect.TypeOf((*io.Reader)(nil)).Elem()
+
+// streamsOutput returns whether the request's output payload is a reader,
+// which the response body is returned to the caller as.
+func streamsOutput(r *Request) bool {
+	if r.Data == nil {
+		return false
+	}
+	t := reflect.TypeOf(r.Data)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+
+	field, ok := t.FieldByName("SDKShapeTraits")
+	if !ok {
+		return false
+	}
+	payload, ok := t.FieldByName(field.Tag.Get("payload"))
+	return ok && payload.Type.Kind() == reflect.Interface && payload.Type.Implements(readerType)
+}
+
+// ValidateResponseHandler fails requests whose response has an error status
+// code. Whether they are retried is decided once UnmarshalError has read the
+// error's code from the response body.
 func ValidateResponseHandler(r *Request) {
 	if r.HTTPResponse.StatusCode == 0 || r.HTTPResponse.StatusCode >= 400 {
-		err := APIError{
+		r.Error = APIError{
 			StatusCode: r.HTTPResponse.StatusCode,
 			RetryCount: r.RetryCount,
 		}
-		r.Error = err
-		err.Retryable = r.Service.ShouldRetry(r)
-		err.RetryDelay = r.Service.RetryRules(r)
-		r.Error = err
 	}
 }
 
+// MaxClockSkew bounds the clock skew correction applied to requests, so that
+// a broken or malicious server date cannot push signing times arbitrarily far.
+const MaxClockSkew = 1 * time.Hour
+
+// clockSkewThreshold is the offset from the server's clock above which a
+// rejected request is assumed to have failed because of clock skew.
+const clockSkewThreshold = 5 * time.Minute
+
+// ClockSkewHandler detects requests rejected because the local clock is
+// skewed from the server's. Services report skew with different error codes,
+// so it is detected by comparing the Date header of a 400 or 403 response
+// with the local clock. The offset, bounded by MaxClockSkew, is
+// stored in the request's ClockSkew and the request is marked retryable.
+func ClockSkewHandler(r *Request) {
+	err := Error(r.Error)
+	if err == nil || r.HTTPResponse == nil {
+		return
+	}
+	if err.StatusCode != 400 && err.StatusCode != 403 {
+		return
+	}
+
+	serverTime, perr := http.ParseTime(r.HTTPResponse.Header.Get("Date"))
+	if perr != nil {
+		return
+	}
+
+	// the offset is measured from the current time, not the signing time,
+	// because the server's Date reflects when the response was sent.
+	skew := serverTime.Sub(r.Service.now())
+	if skew > -clockSkewThreshold && skew < clockSkewThreshold {
+		return
+	}
+	if skew > MaxClockSkew {
+		skew = MaxClockSkew
+	} else if skew < -MaxClockSkew {
+		skew = -MaxClockSkew
+	}
+
+	r.ClockSkew = skew
+	err.Retryable = true
+	r.Error = *err
+}
+
+// runRetryScheduled runs the request's RetryScheduled handlers for the retry
+// just scheduled, restoring the Error and RetryCount they observe.
+func (r *Request) runRetryScheduled() {
+	err, retryCount := r.Error, r.RetryCount
+	r.Handlers.RetryScheduled.Run(r)
+	r.Error, r.RetryCount = err, retryCount
+}
+
 func AfterRetryHandler(r *Request) {
 	delay := 0 * time.Second
 	willRetry := false
 
 	if err := Error(r.Error); err != nil {
 		delay = err.RetryDelay
-		if err.Retryable && r.RetryCount < r.Service.MaxRetries() {
+		if err.Retryable && r.RetryCount < r.Service.MaxRetries() && !r.exceedsDeadline(delay) {
 			r.RetryCount++
 			willRetry = true
 		}
 	}
 
 	if willRetry {
+		r.runRetryScheduled()
 		r.Error = nil
-		sleepDelay(delay)
+		if r.ctx != nil { // wake up early if the request is cancelled
+			t := time.NewTimer(delay)
+			select {
+			case <-t.C:
+			case <-r.ctx.Done():
+				t.Stop()
+			}
+		} else {
+			sleepDelay(delay)
+		}
 	}
 }
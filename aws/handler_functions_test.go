@@ -0,0 +1,564 @@
+package aws
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httptrace"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type gzipOutput struct {
+	Name  string
+	Count int
+}
+
+func gzipServer(body string) *httptest.Server {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	gz.Write([]byte(body))
+	gz.Close()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Write(buf.Bytes())
+	}))
+}
+
+func gzipService(endpoint string, decompress bool) *Service {
+	s := NewService(&Config{
+		Endpoint:                endpoint,
+		DecompressGzipResponses: decompress,
+		HTTPClient:              &http.Client{Transport: &http.Transport{DisableCompression: true}},
+	})
+	s.Handlers.Unmarshal.PushBack(func(r *Request) {
+		defer r.HTTPResponse.Body.Close()
+		r.Error = xml.NewDecoder(r.HTTPResponse.Body).Decode(r.Data)
+	})
+	return s
+}
+
+func TestGzipResponseHandler(t *testing.T) {
+	server := gzipServer(`<Output><Name>gzipped</Name><Count>3</Count></Output>`)
+	defer server.Close()
+
+	out := &gzipOutput{}
+	r := NewRequest(gzipService(server.URL, true), &Operation{Name: "Operation"}, nil, out)
+	err := r.Send()
+	assert.NoError(t, err)
+	assert.Equal(t, "gzipped", out.Name)
+	assert.Equal(t, 3, out.Count)
+}
+
+func TestGzipResponseHandlerDisabled(t *testing.T) {
+	server := gzipServer(`<Output><Name>gzipped</Name></Output>`)
+	defer server.Close()
+
+	out := &gzipOutput{}
+	r := NewRequest(gzipService(server.URL, false), &Operation{Name: "Operation"}, nil, out)
+	err := r.Send()
+	assert.Error(t, err)
+	assert.Equal(t, "", out.Name)
+}
+
+func TestGzipResponseHandlerIgnoresIdentity(t *testing.T) {
+	r := &Request{HTTPResponse: &http.Response{Header: http.Header{}, Body: body("plain")}}
+	GzipResponseHandler(r)
+	assert.NoError(t, r.Error)
+	b := make([]byte, 5)
+	r.HTTPResponse.Body.Read(b)
+	assert.Equal(t, "plain", string(b))
+}
+
+func TestGzipResponseHandlerEmptyBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+	}))
+	defer server.Close()
+
+	s := NewService(&Config{
+		Endpoint:                server.URL,
+		DecompressGzipResponses: true,
+		HTTPClient:              &http.Client{Transport: &http.Transport{DisableCompression: true}},
+	})
+	for _, method := range []string{"HEAD", "GET"} {
+		r := NewRequest(s, &Operation{Name: "Operation", HTTPMethod: method}, nil, &gzipOutput{})
+		assert.NoError(t, r.Send(), method)
+	}
+
+	// a body of unknown length which turns out to be empty
+	r := &Request{HTTPResponse: &http.Response{
+		Header:        http.Header{"Content-Encoding": []string{"gzip"}},
+		ContentLength: -1,
+		Body:          body(""),
+	}}
+	GzipResponseHandler(r)
+	assert.NoError(t, r.Error)
+}
+
+func checksumServer(body, checksum string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-MD5", checksum)
+		w.Write([]byte(body))
+	}))
+}
+
+func checksumService(endpoint string) *Service {
+	s := NewService(&Config{Endpoint: endpoint, ValidateResponseChecksums: true})
+	s.Handlers.Unmarshal.PushBack(func(r *Request) {
+		defer r.HTTPResponse.Body.Close()
+		_, r.Error = ioutil.ReadAll(r.HTTPResponse.Body)
+	})
+	return s
+}
+
+func md5Of(s string) string {
+	sum := md5.Sum([]byte(s))
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+func TestChecksumResponseHandler(t *testing.T) {
+	server := checksumServer("object data", md5Of("object data"))
+	defer server.Close()
+
+	r := NewRequest(checksumService(server.URL), &Operation{Name: "Operation"}, nil, nil)
+	assert.NoError(t, r.Send())
+}
+
+func TestChecksumResponseHandlerMismatch(t *testing.T) {
+	server := checksumServer("corrupted data", md5Of("object data"))
+	defer server.Close()
+
+	r := NewRequest(checksumService(server.URL), &Operation{Name: "Operation"}, nil, nil)
+	err := r.Send()
+	assert.Error(t, err)
+	assert.Equal(t, "ChecksumMismatch", Error(err).Code)
+}
+
+func TestChecksumResponseHandlerPartialRead(t *testing.T) {
+	data := `{"data":"valid"}` + strings.Repeat(" ", 1<<16)
+	for _, c := range []struct {
+		body  string
+		valid bool
+	}{
+		{data, true},
+		{data + "corrupted", false},
+	} {
+		server := checksumServer(c.body, md5Of(data))
+
+		// the decoder stops reading once it has decoded the value
+		s := NewService(&Config{Endpoint: server.URL, ValidateResponseChecksums: true})
+		s.Handlers.Unmarshal.PushBack(unmarshal)
+		r := NewRequest(s, &Operation{Name: "Operation"}, nil, &testData{})
+		err := r.Send()
+		server.Close()
+
+		assert.Equal(t, "valid", r.Data.(*testData).Data)
+		if c.valid {
+			assert.NoError(t, err)
+		} else {
+			assert.Equal(t, "ChecksumMismatch", Error(err).Code)
+		}
+	}
+}
+
+func TestChecksumResponseHandlerRetry(t *testing.T) {
+	r := &Request{Data: &testData{}}
+	for i := 0; i < 2; i++ {
+		r.HTTPResponse = &http.Response{StatusCode: 200, Header: http.Header{}, Body: body("object data")}
+		r.HTTPResponse.Header.Set("Content-MD5", md5Of("object data"))
+		ChecksumResponseHandler(r)
+	}
+	assert.Equal(t, 1, r.Handlers.Unmarshal.Len())
+}
+
+func TestChecksumResponseHandlerSha256(t *testing.T) {
+	sum := sha256.Sum256([]byte("object data"))
+	r := &Request{HTTPResponse: &http.Response{
+		StatusCode: 200,
+		Header:     http.Header{"X-Amz-Content-Sha256": []string{hex.EncodeToString(sum[:])}},
+		Body:       body("object data"),
+	}}
+	ChecksumResponseHandler(r)
+	assert.NoError(t, r.Error)
+	_, err := ioutil.ReadAll(r.HTTPResponse.Body)
+	assert.NoError(t, err)
+
+	r.HTTPResponse.Body = body("corrupted data")
+	ChecksumResponseHandler(r)
+	_, err = ioutil.ReadAll(r.HTTPResponse.Body)
+	assert.Error(t, err)
+}
+
+func bodySizeService(endpoint string, max int64) *Service {
+	s := NewService(&Config{Endpoint: endpoint, MaxResponseBodySize: max})
+	s.Handlers.Unmarshal.PushBack(func(r *Request) {
+		defer r.HTTPResponse.Body.Close()
+		_, r.Error = ioutil.ReadAll(r.HTTPResponse.Body)
+	})
+	return s
+}
+
+func bodyServer(body string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(body))
+	}))
+}
+
+type streamingOutput struct {
+	Body io.ReadCloser `type:"blob"`
+
+	metadataStreamingOutput `json:"-" xml:"-"`
+}
+
+type metadataStreamingOutput struct {
+	SDKShapeTraits bool `type:"structure" payload:"Body"`
+}
+
+func TestMaxResponseBodySizeUnderLimit(t *testing.T) {
+	server := bodyServer("0123456789")
+	defer server.Close()
+
+	r := NewRequest(bodySizeService(server.URL, 10), &Operation{Name: "Operation"}, nil, nil)
+	assert.NoError(t, r.Send())
+}
+
+func TestMaxResponseBodySizeOverLimit(t *testing.T) {
+	server := bodyServer("0123456789a")
+	defer server.Close()
+
+	r := NewRequest(bodySizeService(server.URL, 10), &Operation{Name: "Operation"}, nil, nil)
+	err := r.Send()
+	assert.Error(t, err)
+	assert.Equal(t, "ResponseBodyTooLarge", Error(err).Code)
+}
+
+func TestMaxResponseBodySizeUnlimited(t *testing.T) {
+	server := bodyServer(strings.Repeat("x", 1<<16))
+	defer server.Close()
+
+	r := NewRequest(bodySizeService(server.URL, 0), &Operation{Name: "Operation"}, nil, nil)
+	assert.NoError(t, r.Send())
+}
+
+func TestMaxResponseBodySizeSkipsStreamingOutput(t *testing.T) {
+	r := &Request{
+		Service:      &Service{Config: &Config{MaxResponseBodySize: 1}},
+		HTTPResponse: &http.Response{Body: body("object data")},
+		Data:         &streamingOutput{},
+	}
+	MaxResponseBodySizeHandler(r)
+	b, err := ioutil.ReadAll(r.HTTPResponse.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, "object data", string(b))
+}
+
+func TestMaxResponseBodySizeReadsUpToLimit(t *testing.T) {
+	r := &Request{
+		Service:      &Service{Config: &Config{MaxResponseBodySize: 4}},
+		HTTPResponse: &http.Response{Body: body("object data")},
+	}
+	MaxResponseBodySizeHandler(r)
+	b, err := ioutil.ReadAll(r.HTTPResponse.Body)
+	assert.Error(t, err)
+	assert.Equal(t, "obje", string(b))
+}
+
+func TestMaxResponseBodySizeReadsAfterLimit(t *testing.T) {
+	r := &Request{
+		Service:      &Service{Config: &Config{MaxResponseBodySize: 4}},
+		HTTPResponse: &http.Response{Body: body("object data")},
+	}
+	MaxResponseBodySizeHandler(r)
+
+	p := make([]byte, 8)
+	n, err := r.HTTPResponse.Body.Read(p)
+	assert.Equal(t, 4, n)
+	assert.Equal(t, "ResponseBodyTooLarge", Error(err).Code)
+
+	// later reads fail with the same error without reading
+	for i := 0; i < 2; i++ {
+		n, err = r.HTTPResponse.Body.Read(p)
+		assert.Equal(t, 0, n)
+		assert.Equal(t, "ResponseBodyTooLarge", Error(err).Code)
+	}
+
+	// readers relying on the io.Reader contract do not panic
+	buf := &bytes.Buffer{}
+	_, err = buf.ReadFrom(r.HTTPResponse.Body)
+	assert.Error(t, err)
+}
+
+func saveBodyService(endpoint string, save bool) *Service {
+	s := NewService(&Config{Endpoint: endpoint, SaveResponseBody: save})
+	s.Handlers.Unmarshal.PushBack(func(r *Request) {
+		defer r.HTTPResponse.Body.Close()
+		_, r.Error = ioutil.ReadAll(r.HTTPResponse.Body)
+	})
+	return s
+}
+
+func TestSaveResponseBody(t *testing.T) {
+	server := bodyServer(`{"Name":"value"}`)
+	defer server.Close()
+
+	r := NewRequest(saveBodyService(server.URL, true), &Operation{Name: "Operation"}, nil, nil)
+	assert.NoError(t, r.Send())
+	assert.Equal(t, `{"Name":"value"}`, string(r.ResponseBody()))
+}
+
+func TestSaveResponseBodyDisabled(t *testing.T) {
+	server := bodyServer(`{"Name":"value"}`)
+	defer server.Close()
+
+	r := NewRequest(saveBodyService(server.URL, false), &Operation{Name: "Operation"}, nil, nil)
+	assert.NoError(t, r.Send())
+	assert.Nil(t, r.ResponseBody())
+}
+
+func TestSaveResponseBodySkipsStreamingOutput(t *testing.T) {
+	r := &Request{
+		Service:      &Service{Config: &Config{SaveResponseBody: true}},
+		HTTPResponse: &http.Response{Body: body("object data")},
+		Data:         &streamingOutput{},
+	}
+	SaveResponseBodyHandler(r)
+	b, err := ioutil.ReadAll(r.HTTPResponse.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, "object data", string(b))
+	assert.Nil(t, r.ResponseBody())
+}
+
+// compressionRequest returns a request to an operation accepting compressed
+// bodies of a service compressing bodies of at least 100 bytes, recording the
+// body each time the request is signed.
+func compressionRequest(body string, signed *[][]byte) *Request {
+	s := NewService(&Config{Region: "mock-region", RequestCompressionMinSize: 100})
+	s.Handlers.Sign.PushBack(func(r *Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		r.Body.Seek(0, 0)
+		*signed = append(*signed, b)
+	})
+
+	r := NewRequest(s, &Operation{Name: "Operation", RequestCompression: true}, nil, nil)
+	r.SetBufferBody([]byte(body))
+	return r
+}
+
+func gunzip(t *testing.T, b []byte) string {
+	gz, err := gzip.NewReader(bytes.NewReader(b))
+	assert.NoError(t, err)
+	out, err := ioutil.ReadAll(gz)
+	assert.NoError(t, err)
+	return string(out)
+}
+
+func TestGzipRequestHandler(t *testing.T) {
+	body := strings.Repeat("Action=PutMetricData&", 10)
+	signed := [][]byte{}
+	r := compressionRequest(body, &signed)
+
+	assert.NoError(t, r.Sign())
+	assert.Equal(t, "gzip", r.HTTPRequest.Header.Get("Content-Encoding"))
+	assert.Equal(t, 1, len(signed))
+	assert.Equal(t, body, gunzip(t, signed[0]))
+	assert.Equal(t, int64(len(signed[0])), r.HTTPRequest.ContentLength)
+	assert.True(t, len(signed[0]) < len(body))
+
+	// signing the request again for a retry does not compress it twice
+	assert.NoError(t, r.resign())
+	assert.Equal(t, "gzip", r.HTTPRequest.Header.Get("Content-Encoding"))
+	assert.Equal(t, signed[0], signed[1])
+}
+
+func TestGzipRequestHandlerBelowMinSize(t *testing.T) {
+	body := strings.Repeat("a", 99)
+	signed := [][]byte{}
+	r := compressionRequest(body, &signed)
+
+	assert.NoError(t, r.Sign())
+	assert.Equal(t, "", r.HTTPRequest.Header.Get("Content-Encoding"))
+	assert.Equal(t, body, string(signed[0]))
+}
+
+func TestGzipRequestHandlerOperationWithoutCompression(t *testing.T) {
+	body := strings.Repeat("a", 200)
+	signed := [][]byte{}
+	r := compressionRequest(body, &signed)
+	r.Operation.RequestCompression = false
+
+	assert.NoError(t, r.Sign())
+	assert.Equal(t, "", r.HTTPRequest.Header.Get("Content-Encoding"))
+	assert.Equal(t, body, string(signed[0]))
+}
+
+func TestGzipRequestHandlerAppendsContentEncoding(t *testing.T) {
+	signed := [][]byte{}
+	r := compressionRequest(strings.Repeat("a", 200), &signed)
+	r.HTTPRequest.Header.Set("Content-Encoding", "custom")
+
+	assert.NoError(t, r.Sign())
+	assert.Equal(t, "custom, gzip", r.HTTPRequest.Header.Get("Content-Encoding"))
+}
+
+func TestGzipRequestHandlerDisabled(t *testing.T) {
+	s := NewService(&Config{Region: "mock-region"})
+	r := NewRequest(s, &Operation{Name: "Operation", RequestCompression: true}, nil, nil)
+	r.SetBufferBody([]byte(strings.Repeat("a", 20000)))
+
+	assert.NoError(t, r.Sign())
+	assert.Equal(t, "", r.HTTPRequest.Header.Get("Content-Encoding"))
+}
+
+// seekOnlyReader is an io.ReadSeeker without a Len method, such as a file.
+type seekOnlyReader struct {
+	r *bytes.Reader
+}
+
+func (s seekOnlyReader) Read(p []byte) (int, error) { return s.r.Read(p) }
+
+func (s seekOnlyReader) Seek(offset int64, whence int) (int64, error) {
+	return s.r.Seek(offset, whence)
+}
+
+func TestBuildContentLengthSeekableOffset(t *testing.T) {
+	body := seekOnlyReader{bytes.NewReader([]byte("skipped:request body"))}
+	body.Seek(int64(len("skipped:")), io.SeekStart)
+
+	r := NewRequest(NewService(&Config{}), &Operation{Name: "Operation"}, nil, nil)
+	r.SetReaderBody(body)
+	BuildContentLength(r)
+	assert.NoError(t, r.Error)
+
+	assert.Equal(t, int64(len("request body")), r.HTTPRequest.ContentLength)
+	assert.Equal(t, "12", r.HTTPRequest.Header.Get("Content-Length"))
+	b, _ := ioutil.ReadAll(r.HTTPRequest.Body)
+	assert.Equal(t, "request body", string(b))
+}
+
+func TestBuildContentLengthSet(t *testing.T) {
+	r := NewRequest(NewService(&Config{}), &Operation{Name: "Operation"}, nil, nil)
+	r.SetReaderBody(ReadSeekCloser(body("streamed body")))
+	r.HTTPRequest.Header.Set("Content-Length", "13")
+	BuildContentLength(r)
+	assert.NoError(t, r.Error)
+	assert.Equal(t, "13", r.HTTPRequest.Header.Get("Content-Length"))
+}
+
+func TestBuildContentLengthUnseekableChunked(t *testing.T) {
+	r := NewRequest(NewService(&Config{}), &Operation{Name: "Operation"}, nil, nil)
+	r.SetReaderBody(ReadSeekCloser(body("streamed body")))
+	BuildContentLength(r)
+	assert.NoError(t, r.Error)
+
+	assert.Equal(t, int64(-1), r.HTTPRequest.ContentLength)
+	assert.Equal(t, "", r.HTTPRequest.Header.Get("Content-Length"))
+}
+
+func TestBuildContentLengthUnseekableRequired(t *testing.T) {
+	s := NewService(&Config{})
+	s.ContentLengthRequired = true
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.SetReaderBody(ReadSeekCloser(body("streamed body")))
+	BuildContentLength(r)
+	assert.Equal(t, "ContentLengthRequired", Error(r.Error).Code)
+
+	r = NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.SetReaderBody(ReadSeekCloser(bytes.NewReader([]byte("seekable body"))))
+	BuildContentLength(r)
+	assert.NoError(t, r.Error)
+	assert.Equal(t, int64(len("seekable body")), r.HTTPRequest.ContentLength)
+}
+
+// countingReader counts the bytes read of its body by the transport.
+type countingReader struct {
+	r    *bytes.Reader
+	read int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	atomic.AddInt64(&c.read, int64(n))
+	return n, err
+}
+
+func (c *countingReader) Seek(offset int64, whence int) (int64, error) {
+	return c.r.Seek(offset, whence)
+}
+
+func expectContinueRequest(t *testing.T, handler http.HandlerFunc, body *countingReader) (*Request, bool) {
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	got100 := false
+	s := NewService(&Config{
+		Endpoint:              server.URL,
+		ExpectContinueMinSize: 8,
+		ClientTrace: func(r *Request) *httptrace.ClientTrace {
+			return &httptrace.ClientTrace{Got100Continue: func() { got100 = true }}
+		},
+	})
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+
+	r := NewRequest(s, &Operation{Name: "PutObject", HTTPMethod: "PUT", HTTPPath: "/bucket/key"}, nil, nil)
+	r.SetReaderBody(body)
+	r.Send()
+	return r, got100
+}
+
+func TestExpectContinue(t *testing.T) {
+	received := ""
+	body := &countingReader{r: bytes.NewReader([]byte("object data"))}
+	r, got100 := expectContinueRequest(t, func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "100-continue", req.Header.Get("Expect"))
+		b, _ := ioutil.ReadAll(req.Body) // reading the body responds 100
+		received = string(b)
+	}, body)
+
+	assert.NoError(t, r.Error)
+	assert.True(t, got100)
+	assert.Equal(t, "object data", received)
+	assert.Equal(t, int64(len("object data")), atomic.LoadInt64(&body.read))
+}
+
+func TestExpectContinueRejectedBeforeBody(t *testing.T) {
+	body := &countingReader{r: bytes.NewReader([]byte("object data"))}
+	r, got100 := expectContinueRequest(t, func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(403)
+		w.Write([]byte(`{"__type":"AccessDenied","message":"Access Denied"}`))
+	}, body)
+
+	assert.Error(t, r.Error)
+	assert.Equal(t, "AccessDenied", Error(r.Error).Code)
+	assert.False(t, got100)
+	assert.Equal(t, int64(0), atomic.LoadInt64(&body.read))
+}
+
+func TestExpectContinueMinSize(t *testing.T) {
+	s := NewService(&Config{ExpectContinueMinSize: 1024})
+
+	r := NewRequest(s, &Operation{Name: "PutObject"}, nil, nil)
+	r.SetBufferBody([]byte("small"))
+	BuildContentLength(r)
+	ExpectContinueHandler(r)
+	assert.Equal(t, "", r.HTTPRequest.Header.Get("Expect"))
+
+	r = NewRequest(s, &Operation{Name: "PutObject"}, nil, nil)
+	r.SetBufferBody(make([]byte, 1024))
+	BuildContentLength(r)
+	ExpectContinueHandler(r)
+	assert.Equal(t, "100-continue", r.HTTPRequest.Header.Get("Expect"))
+}
@@ -2,9 +2,20 @@ package aws
 
 import "container/list"
 
+// Handlers are the lists of handlers run in each phase of a request. The
+// phases run in the order the fields are declared, except that Unmarshal
+// runs only for successful responses, UnmarshalError, Retry and AfterRetry
+// only when the response is an error, and RetryScheduled only when
+// AfterRetry schedules a retry.
 type Handlers struct {
-	Validate         HandlerList
-	Build            HandlerList
+	Validate HandlerList
+
+	// Build serializes the request's parameters into HTTPRequest. It runs
+	// once, after Validate and before Sign, so handlers pushed to the back
+	// of the list see the serialized request and may add headers to it which
+	// are then covered by the request's signature.
+	Build HandlerList
+
 	Sign             HandlerList
 	Send             HandlerList
 	ValidateResponse HandlerList
@@ -13,6 +24,14 @@ type Handlers struct {
 	UnmarshalError   HandlerList
 	Retry            HandlerList
 	AfterRetry       HandlerList
+
+	// RetryScheduled runs each time AfterRetryHandler schedules a retry,
+	// before waiting its delay, for example to log flaky requests. Its
+	// handlers see the error which triggered the retry as the request's
+	// Error, whose RetryDelay is the delay, and the number of the retry as
+	// its RetryCount. They only observe the decision: what they change of
+	// either is restored once they have run.
+	RetryScheduled HandlerList
 }
 
 func (h *Handlers) copy() Handlers {
@@ -27,6 +46,7 @@ func (h *Handlers) copy() Handlers {
 		UnmarshalMeta:    h.UnmarshalMeta.copy(),
 		Retry:            h.Retry.copy(),
 		AfterRetry:       h.AfterRetry.copy(),
+		RetryScheduled:   h.RetryScheduled.copy(),
 	}
 }
 
@@ -42,24 +62,70 @@ func (h *Handlers) Clear() {
 	h.ValidateResponse.Init()
 	h.Retry.Init()
 	h.AfterRetry.Init()
+	h.RetryScheduled.Init()
 }
 
 type HandlerList struct {
 	list.List
 }
 
+// A NamedHandler is a handler function with a name, which allows it to be
+// found in a HandlerList to be swapped or removed.
+type NamedHandler struct {
+	Name string
+	Fn   func(*Request)
+}
+
 func (l HandlerList) copy() HandlerList {
 	var n HandlerList
 	for e := l.Front(); e != nil; e = e.Next() {
-		h := e.Value.(func(*Request))
-		n.PushBack(h)
+		n.PushBack(e.Value)
 	}
 	return n
 }
 
 func (l *HandlerList) Run(r *Request) {
 	for e := l.Front(); e != nil; e = e.Next() {
-		h := e.Value.(func(*Request))
-		h(r)
+		switch h := e.Value.(type) {
+		case func(*Request):
+			h(r)
+		case NamedHandler:
+			h.Fn(r)
+		}
+	}
+}
+
+// PushBackNamed adds a named handler to the back of the list.
+func (l *HandlerList) PushBackNamed(n NamedHandler) {
+	l.PushBack(n)
+}
+
+// PushFrontNamed adds a named handler to the front of the list.
+func (l *HandlerList) PushFrontNamed(n NamedHandler) {
+	l.PushFront(n)
+}
+
+// SwapNamed replaces each handler in the list with the same name as n with
+// n, keeping its position. It returns whether any handler was replaced.
+func (l *HandlerList) SwapNamed(n NamedHandler) bool {
+	swapped := false
+	for e := l.Front(); e != nil; e = e.Next() {
+		if h, ok := e.Value.(NamedHandler); ok && h.Name == n.Name {
+			e.Value = n
+			swapped = true
+		}
+	}
+	return swapped
+}
+
+// Remove removes each handler in the list with the same name as n. The
+// order of the remaining handlers is preserved.
+func (l *HandlerList) Remove(n NamedHandler) {
+	for e := l.Front(); e != nil; {
+		next := e.Next()
+		if h, ok := e.Value.(NamedHandler); ok && h.Name == n.Name {
+			l.List.Remove(e)
+		}
+		e = next
 	}
 }
@@ -1,6 +1,9 @@
 package aws
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 func TestHandlerList(t *testing.T) {
 	r := &Request{}
@@ -22,3 +25,93 @@ func TestMultipleHandlers(t *testing.T) {
 		t.Error("Expected handler to execute")
 	}
 }
+
+func TestNamedHandlers(t *testing.T) {
+	l := HandlerList{}
+	named := NamedHandler{Name: "Name", Fn: func(r *Request) {}}
+	named2 := NamedHandler{Name: "NotName", Fn: func(r *Request) {}}
+	l.PushBackNamed(named)
+	l.PushBackNamed(named)
+	l.PushBackNamed(named2)
+	l.PushBack(func(r *Request) {})
+	if e, a := 4, l.Len(); e != a {
+		t.Errorf("expect %d list length, got %d", e, a)
+	}
+	l.Remove(named)
+	if e, a := 2, l.Len(); e != a {
+		t.Errorf("expect %d list length, got %d", e, a)
+	}
+}
+
+func TestSwapNamedHandlers(t *testing.T) {
+	order := []string{}
+	handler := func(name string) NamedHandler {
+		return NamedHandler{Name: name, Fn: func(r *Request) { order = append(order, name) }}
+	}
+
+	l := HandlerList{}
+	l.PushBackNamed(handler("first"))
+	l.PushBackNamed(handler("second"))
+	l.PushBackNamed(handler("third"))
+
+	swapped := l.SwapNamed(NamedHandler{Name: "second", Fn: func(r *Request) {
+		order = append(order, "swapped")
+	}})
+	if !swapped {
+		t.Error("expect handler to be swapped")
+	}
+	if l.SwapNamed(handler("missing")) {
+		t.Error("expect no handler to be swapped")
+	}
+
+	l.Run(&Request{})
+	if e, a := "first,swapped,third", strings.Join(order, ","); e != a {
+		t.Errorf("expect %q execution order, got %q", e, a)
+	}
+
+	order = []string{}
+	l.Remove(handler("swapped"))
+	l.Remove(handler("second"))
+	l.Run(&Request{})
+	if e, a := "first,third", strings.Join(order, ","); e != a {
+		t.Errorf("expect %q execution order, got %q", e, a)
+	}
+}
+
+func TestNamedHandlersCopied(t *testing.T) {
+	ran := false
+	h := Handlers{}
+	h.Sign.PushBackNamed(NamedHandler{Name: "sign", Fn: func(r *Request) { ran = true }})
+
+	c := h.copy()
+	c.Sign.Run(&Request{})
+	if !ran {
+		t.Error("expect copied named handler to run")
+	}
+}
+
+func TestHandlerPhaseOrder(t *testing.T) {
+	s := NewService(&Config{})
+	s.Handlers.Clear()
+
+	phases := []string{}
+	record := func(name string) func(*Request) {
+		return func(r *Request) { phases = append(phases, name) }
+	}
+	s.Handlers.Validate.PushBack(record("Validate"))
+	s.Handlers.Build.PushBack(record("Build"))
+	s.Handlers.Sign.PushBack(record("Sign"))
+	s.Handlers.Send.PushBack(record("Send"))
+	s.Handlers.UnmarshalMeta.PushBack(record("UnmarshalMeta"))
+	s.Handlers.ValidateResponse.PushBack(record("ValidateResponse"))
+	s.Handlers.Unmarshal.PushBack(record("Unmarshal"))
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	if err := r.Send(); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	expected := "Validate,Build,Sign,Send,UnmarshalMeta,ValidateResponse,Unmarshal"
+	if a := strings.Join(phases, ","); a != expected {
+		t.Errorf("expect %s phases, got %s", expected, a)
+	}
+}
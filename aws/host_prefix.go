@@ -0,0 +1,86 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// reHostLabelPlaceholder matches the {Name} placeholders of a host prefix.
+var reHostLabelPlaceholder = regexp.MustCompile(`\{([^}]+)\}`)
+
+// reHostLabel matches host labels which are a valid DNS label.
+var reHostLabel = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9\-]{0,61}[a-zA-Z0-9])?$`)
+
+// HostPrefixHandler prepends the operation's HostPrefix to the request's
+// host, as in data.service.us-east-1.amazonaws.com. Placeholders of the
+// prefix, such as {AccountId}., are replaced with the request's params
+// members tagged hostLabel, which must be non-empty DNS labels.
+func HostPrefixHandler(r *Request) {
+	if r.Operation.HostPrefix == "" {
+		return
+	}
+
+	prefix, err := expandHostPrefix(r.Operation.HostPrefix, hostLabelsOf(r))
+	if err != nil {
+		r.Error = err
+		return
+	}
+
+	u := r.HTTPRequest.URL
+	host := u.Host
+	u.Host = prefix + host
+	if strings.HasPrefix(u.Opaque, "//"+host) { // keep opaque paths built by rest protocols in sync
+		u.Opaque = "//" + u.Host + strings.TrimPrefix(u.Opaque, "//"+host)
+	}
+}
+
+// hostLabelsOf returns the request's params members tagged hostLabel, keyed
+// by their location name.
+func hostLabelsOf(r *Request) map[string]string {
+	labels := map[string]string{}
+	if !r.ParamsFilled() {
+		return labels
+	}
+
+	v := reflect.Indirect(reflect.ValueOf(r.Params))
+	if v.Kind() != reflect.Struct {
+		return labels
+	}
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if field.Tag.Get("hostLabel") == "" {
+			continue
+		}
+
+		name := field.Tag.Get("locationName")
+		if name == "" {
+			name = field.Name
+		}
+		if s, ok := v.Field(i).Interface().(*string); ok && s != nil {
+			labels[name] = *s
+		}
+	}
+	return labels
+}
+
+// expandHostPrefix replaces the placeholders of prefix with their labels.
+func expandHostPrefix(prefix string, labels map[string]string) (string, error) {
+	var err error
+	expanded := reHostLabelPlaceholder.ReplaceAllStringFunc(prefix, func(p string) string {
+		name := p[1 : len(p)-1]
+		label := labels[name]
+		if err == nil && !reHostLabel.MatchString(label) {
+			err = APIError{
+				Code:    "InvalidParameter",
+				Message: fmt.Sprintf("host label %s must be a non-empty DNS label, got %q", name, label),
+			}
+		}
+		return label
+	})
+	if err != nil {
+		return "", err
+	}
+	return expanded, nil
+}
@@ -0,0 +1,54 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type hostLabelInput struct {
+	AccountID *string `location:"uri" locationName:"AccountId" type:"string" hostLabel:"true"`
+	Name      *string `type:"string"`
+}
+
+func hostPrefixRequest(prefix string, params interface{}) *Request {
+	s := NewService(&Config{Endpoint: "https://service.us-east-1.amazonaws.com"})
+	return NewRequest(s, &Operation{Name: "Operation", HTTPPath: "/path", HostPrefix: prefix}, params, nil)
+}
+
+func TestHostPrefixStatic(t *testing.T) {
+	r := hostPrefixRequest("data.", &hostLabelInput{})
+	assert.NoError(t, r.Build())
+	assert.Equal(t, "data.service.us-east-1.amazonaws.com", r.HTTPRequest.URL.Host)
+	assert.Equal(t, "https://data.service.us-east-1.amazonaws.com/path", r.HTTPRequest.URL.String())
+}
+
+func TestHostPrefixLabel(t *testing.T) {
+	r := hostPrefixRequest("{AccountId}.control.", &hostLabelInput{AccountID: String("123456789012")})
+	assert.NoError(t, r.Build())
+	assert.Equal(t, "123456789012.control.service.us-east-1.amazonaws.com", r.HTTPRequest.URL.Host)
+}
+
+func TestHostPrefixOpaquePath(t *testing.T) {
+	r := hostPrefixRequest("data.", nil)
+	r.HTTPRequest.URL.Opaque = "//service.us-east-1.amazonaws.com/path"
+	HostPrefixHandler(r)
+	assert.NoError(t, r.Error)
+	assert.Equal(t, "//data.service.us-east-1.amazonaws.com/path", r.HTTPRequest.URL.Opaque)
+}
+
+func TestHostPrefixInvalidLabel(t *testing.T) {
+	for _, label := range []*string{nil, String(""), String("bad.label"), String("bad_label"), String("-dash")} {
+		r := hostPrefixRequest("{AccountId}.", &hostLabelInput{AccountID: label})
+		err := r.Build()
+		assert.Error(t, err)
+		assert.Equal(t, "InvalidParameter", Error(err).Code)
+		assert.Equal(t, "service.us-east-1.amazonaws.com", r.HTTPRequest.URL.Host)
+	}
+}
+
+func TestHostPrefixNotSet(t *testing.T) {
+	r := hostPrefixRequest("", &hostLabelInput{AccountID: String("123456789012")})
+	assert.NoError(t, r.Build())
+	assert.Equal(t, "service.us-east-1.amazonaws.com", r.HTTPRequest.URL.Host)
+}
@@ -0,0 +1,57 @@
+package aws
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"time"
+)
+
+// newTransport returns a transport with the SDK's default dial, proxy and
+// connection pool settings, whose connections use tlsConfig.
+func newTransport(tlsConfig *tls.Config) *http.Transport {
+	return &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		// dialed with the request's context, so that dialing is canceled with
+		// it and traced by its ClientTrace
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		TLSClientConfig:     tlsConfig,
+		TLSHandshakeTimeout: 10 * time.Second,
+		MaxIdleConnsPerHost: 10,
+		// how long bodies of requests expecting 100-continue are held back
+		// waiting for the server's response, before being sent anyway
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+}
+
+// DefaultMinTLSVersion is the minimum TLS version of clients returned by
+// NewTLSHTTPClient whose tls.Config does not set one.
+const DefaultMinTLSVersion = tls.VersionTLS12
+
+// NewTLSHTTPClient returns an HTTP client with the DefaultHTTPClient's
+// transport settings, whose connections are negotiated with a copy of
+// tlsConfig, so that a minimum TLS version or set of cipher suites is
+// enforced for all of a service's traffic. A zero MinVersion requires
+// DefaultMinTLSVersion, and renegotiation is disabled unless tlsConfig
+// enables it. Set the client as the HTTPClient of a Config to use it:
+//
+//	client := aws.NewTLSHTTPClient(&tls.Config{
+//	    MinVersion:   tls.VersionTLS12,
+//	    CipherSuites: []uint16{tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256},
+//	})
+//	svc := s3.New(&aws.Config{HTTPClient: client})
+func NewTLSHTTPClient(tlsConfig *tls.Config) *http.Client {
+	var c *tls.Config
+	if tlsConfig != nil {
+		c = tlsConfig.Clone()
+	} else {
+		c = &tls.Config{}
+	}
+	if c.MinVersion == 0 {
+		c.MinVersion = DefaultMinTLSVersion
+	}
+	return &http.Client{Transport: newTransport(c)}
+}
@@ -0,0 +1,43 @@
+package aws
+
+import (
+	"crypto/tls"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func clientTLSConfig(c *http.Client) *tls.Config {
+	return c.Transport.(*http.Transport).TLSClientConfig
+}
+
+func TestNewTLSHTTPClient(t *testing.T) {
+	suites := []uint16{tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256}
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS13, CipherSuites: suites}
+	client := NewTLSHTTPClient(tlsConfig)
+
+	c := clientTLSConfig(client)
+	assert.Equal(t, uint16(tls.VersionTLS13), c.MinVersion)
+	assert.Equal(t, suites, c.CipherSuites)
+	assert.Equal(t, tls.RenegotiateNever, c.Renegotiation)
+	assert.True(t, c != tlsConfig)
+
+	// the rest of the transport matches the default client's
+	transport := client.Transport.(*http.Transport)
+	defaultTransport := DefaultHTTPClient.Transport.(*http.Transport)
+	assert.Equal(t, defaultTransport.TLSHandshakeTimeout, transport.TLSHandshakeTimeout)
+	assert.Equal(t, defaultTransport.MaxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+	assert.Nil(t, defaultTransport.TLSClientConfig)
+}
+
+func TestNewTLSHTTPClientDefaultMinVersion(t *testing.T) {
+	assert.Equal(t, uint16(tls.VersionTLS12), clientTLSConfig(NewTLSHTTPClient(nil)).MinVersion)
+	assert.Equal(t, uint16(tls.VersionTLS12), clientTLSConfig(NewTLSHTTPClient(&tls.Config{})).MinVersion)
+}
+
+func TestNewTLSHTTPClientConfig(t *testing.T) {
+	client := NewTLSHTTPClient(&tls.Config{MinVersion: tls.VersionTLS12})
+	s := NewService(&Config{Region: "mock-region", HTTPClient: client})
+	assert.True(t, s.HTTPClient() == client)
+}
@@ -0,0 +1,74 @@
+package aws
+
+import (
+	"crypto/rand"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// IdempotencyTokenHandler fills the members of the request's params tagged
+// with the idempotencyToken trait which were left empty with a random UUID,
+// so that retries of the request are recognized as such by the service.
+// Tokens set by the caller are left untouched.
+func IdempotencyTokenHandler(r *Request) {
+	if r.ParamsFilled() {
+		if err := fillIdempotencyTokens(reflect.ValueOf(r.Params)); err != nil {
+			r.Error = err
+		}
+	}
+}
+
+func fillIdempotencyTokens(value reflect.Value) error {
+	value = reflect.Indirect(value)
+	if !value.IsValid() {
+		return nil
+	}
+
+	switch value.Kind() {
+	case reflect.Struct:
+		t := value.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if strings.ToLower(f.Name[0:1]) == f.Name[0:1] {
+				continue
+			}
+			fvalue := value.Field(i)
+
+			if f.Tag.Get("idempotencyToken") != "" && fvalue.Type() == reflect.TypeOf((*string)(nil)) {
+				if fvalue.IsNil() || fvalue.Elem().String() == "" {
+					token, err := UUIDVersion4()
+					if err != nil {
+						return err
+					}
+					fvalue.Set(reflect.ValueOf(&token))
+				}
+				continue
+			}
+
+			if err := fillIdempotencyTokens(fvalue); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice:
+		for i := 0; i < value.Len(); i++ {
+			if err := fillIdempotencyTokens(value.Index(i)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// UUIDVersion4 returns a random, version 4, UUID in its canonical form, such
+// as "f47ac10b-58cc-4372-a567-0e02b2c3d479".
+func UUIDVersion4() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	b[6] = (b[6] & 0x0f) | 0x40 // version 4
+	b[8] = (b[8] & 0x3f) | 0x80 // RFC 4122 variant
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:]), nil
+}
@@ -0,0 +1,45 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type idempotencyInput struct {
+	ClientToken *string `type:"string" idempotencyToken:"true"`
+	Name        *string `type:"string"`
+	Nested      *idempotencyNested
+}
+
+type idempotencyNested struct {
+	Token *string `type:"string" idempotencyToken:"true"`
+}
+
+const uuidPattern = `^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`
+
+func TestIdempotencyTokenFilled(t *testing.T) {
+	in := &idempotencyInput{Nested: &idempotencyNested{Token: String("")}}
+	r := &Request{Params: in}
+	IdempotencyTokenHandler(r)
+
+	assert.NoError(t, r.Error)
+	assert.NotNil(t, in.ClientToken)
+	assert.Regexp(t, uuidPattern, *in.ClientToken)
+	assert.Regexp(t, uuidPattern, *in.Nested.Token)
+	assert.Nil(t, in.Name)
+
+	// tokens are random, not derived from the request
+	other := &idempotencyInput{}
+	IdempotencyTokenHandler(&Request{Params: other})
+	assert.NotEqual(t, *in.ClientToken, *other.ClientToken)
+}
+
+func TestIdempotencyTokenProvided(t *testing.T) {
+	in := &idempotencyInput{ClientToken: String("my-token")}
+	r := &Request{Params: in}
+	IdempotencyTokenHandler(r)
+
+	assert.NoError(t, r.Error)
+	assert.Equal(t, "my-token", *in.ClientToken)
+}
@@ -0,0 +1,61 @@
+package aws
+
+import (
+	"io"
+	"log"
+)
+
+// A LogLevelType is the level of debug logging of a Service's requests.
+type LogLevelType uint
+
+// Debug log levels. The levels above LogDebug add to what it logs.
+const (
+	// LogOff disables logging.
+	LogOff LogLevelType = 0
+
+	// LogDebug logs each request's method, URL and headers, and each
+	// response's status and headers. Authorization headers and session
+	// tokens are redacted.
+	LogDebug LogLevelType = 1
+
+	// LogDebugWithSigning additionally logs the values of signatures and
+	// session tokens, and the signer's canonical string and string to sign.
+	LogDebugWithSigning = LogDebug | 1<<1
+
+	// LogDebugWithHTTPBody additionally logs request and response bodies.
+	// Those of requests with sensitive members are logged as the request's
+	// params, with those members redacted, and of such responses not at all.
+	LogDebugWithHTTPBody = LogDebug | 1<<2
+)
+
+// Matches returns whether the level includes everything logged at level v.
+func (l LogLevelType) Matches(v LogLevelType) bool {
+	return l&v == v
+}
+
+// A Logger writes the SDK's debug log messages.
+type Logger interface {
+	Log(...interface{})
+}
+
+// A LoggerFunc is a function which implements the Logger interface.
+type LoggerFunc func(...interface{})
+
+// Log calls f with the log message's values.
+func (f LoggerFunc) Log(args ...interface{}) {
+	f(args...)
+}
+
+// NewDefaultLogger returns a Logger which writes messages to w in the format
+// of the standard log package.
+func NewDefaultLogger(w io.Writer) Logger {
+	return &defaultLogger{logger: log.New(w, "", log.LstdFlags)}
+}
+
+type defaultLogger struct {
+	logger *log.Logger
+}
+
+func (l defaultLogger) Log(args ...interface{}) {
+	l.logger.Println(args...)
+}
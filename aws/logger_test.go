@@ -0,0 +1,160 @@
+package aws
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// sendLogged sends a request with a body and signing headers at the given
+// log level, returning the logged messages.
+func sendLogged(t *testing.T, level LogLevelType) string {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		w.Write(append([]byte("response-body:"), b...))
+	}))
+	defer server.Close()
+
+	logged := []string{}
+	s := NewService(&Config{
+		Endpoint: server.URL,
+		LogLevel: level,
+		Logger: LoggerFunc(func(args ...interface{}) {
+			logged = append(logged, fmt.Sprint(args...))
+		}),
+	})
+	s.ServiceName = "mock"
+	s.Handlers.Sign.PushBack(func(r *Request) {
+		r.HTTPRequest.Header.Set("Authorization", "AWS4-HMAC-SHA256 Signature=secret")
+		r.HTTPRequest.Header.Set("X-Amz-Security-Token", "session-token")
+	})
+
+	r := NewRequest(s, &Operation{Name: "Operation", HTTPMethod: "PUT", HTTPPath: "/path"}, nil, nil)
+	r.SetBufferBody([]byte("request-body"))
+	assert.NoError(t, r.Send())
+	return strings.Join(logged, "\n")
+}
+
+func TestLogOff(t *testing.T) {
+	assert.Equal(t, "", sendLogged(t, LogOff))
+}
+
+func TestLogDebug(t *testing.T) {
+	out := sendLogged(t, LogDebug)
+	assert.Contains(t, out, "DEBUG: Request mock/Operation Details:")
+	assert.Contains(t, out, "PUT /path HTTP/1.1")
+	assert.Contains(t, out, "Authorization: <redacted>")
+	assert.Contains(t, out, "X-Amz-Security-Token: <redacted>")
+	assert.Contains(t, out, "DEBUG: Response mock/Operation Details:")
+	assert.Contains(t, out, "HTTP/1.1 200 OK")
+	assert.NotContains(t, out, "secret")
+	assert.NotContains(t, out, "session-token")
+	assert.NotContains(t, out, "request-body")
+	assert.NotContains(t, out, "response-body")
+}
+
+func TestLogDebugWithSigning(t *testing.T) {
+	out := sendLogged(t, LogDebugWithSigning)
+	assert.Contains(t, out, "Authorization: AWS4-HMAC-SHA256 Signature=secret")
+	assert.Contains(t, out, "X-Amz-Security-Token: session-token")
+	assert.NotContains(t, out, "request-body")
+}
+
+func TestLogDebugWithHTTPBody(t *testing.T) {
+	out := sendLogged(t, LogDebugWithHTTPBody)
+	assert.Contains(t, out, "Authorization: <redacted>")
+	assert.Contains(t, out, "request-body")
+	assert.Contains(t, out, "response-body:request-body") // body still sent
+}
+
+func TestLogLevelMatches(t *testing.T) {
+	assert.True(t, LogDebugWithHTTPBody.Matches(LogDebug))
+	assert.False(t, LogDebugWithHTTPBody.Matches(LogDebugWithSigning))
+	assert.False(t, LogDebug.Matches(LogDebugWithHTTPBody))
+	assert.True(t, LogOff.Matches(LogOff))
+}
+
+type sensitiveInput struct {
+	UserName *string   `type:"string"`
+	Password *string   `type:"string" sensitive:"true"`
+	PIN      *int64    `type:"integer" sensitive:"true"`
+	Token    *string   `location:"querystring" locationName:"token" type:"string" sensitive:"true"`
+	Secret   *string   `location:"uri" locationName:"Secret" type:"string" sensitive:"true"`
+	Key      []byte    `location:"header" locationName:"x-amz-key" type:"blob" sensitive:"true"`
+	Keys     *[]string `type:"list" sensitive:"true"`
+
+	metadataSensitiveInput `json:"-" xml:"-"`
+}
+
+type metadataSensitiveInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+type sensitiveOutput struct {
+	SecretAccessKey *string `type:"string" sensitive:"true"`
+
+	metadataSensitiveOutput `json:"-" xml:"-"`
+}
+
+type metadataSensitiveOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+func TestLogDebugWithHTTPBodySensitive(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"SecretAccessKey":"response-secret"}`))
+	}))
+	defer server.Close()
+
+	logged := []string{}
+	s := NewService(&Config{
+		Endpoint: server.URL,
+		LogLevel: LogDebugWithHTTPBody,
+		Logger: LoggerFunc(func(args ...interface{}) {
+			logged = append(logged, fmt.Sprint(args...))
+		}),
+	})
+	s.ServiceName = "mock"
+	s.Handlers.Build.PushBack(func(r *Request) {
+		p := r.Params.(*sensitiveInput)
+		r.SetBufferBody([]byte(fmt.Sprintf(`{"UserName":%q,"Password":%q,"PIN":%d,"Keys":["key-1"]}`,
+			*p.UserName, *p.Password, *p.PIN)))
+		r.HTTPRequest.URL.RawQuery = "page=2&token=" + *p.Token
+		r.HTTPRequest.URL.Opaque = "//" + r.HTTPRequest.URL.Host + "/secrets/s%2Fcr%20t"
+		r.HTTPRequest.Header.Set("X-Amz-Key", "a2V5")
+	})
+
+	params := &sensitiveInput{
+		UserName: String("bob"),
+		Password: String("p&ss"),
+		PIN:      Long(1234),
+		Token:    String("tok"),
+		Secret:   String("s/cr t"),
+		Key:      []byte("key"),
+		Keys:     &[]string{"key-1"},
+	}
+	r := NewRequest(s, &Operation{Name: "Operation", HTTPMethod: "POST", HTTPPath: "/"}, params, &sensitiveOutput{})
+	assert.NoError(t, r.Send())
+
+	out := strings.Join(logged, "\n")
+	assert.Contains(t, out, `UserName: "bob"`)
+	assert.Contains(t, out, "Password: <sensitive>")
+	assert.Contains(t, out, "PIN: <sensitive>")
+	assert.Contains(t, out, "Keys: <sensitive>")
+	assert.Contains(t, out, "/secrets/<sensitive>?page=2&token=<sensitive>")
+	assert.Contains(t, out, "X-Amz-Key: <sensitive>")
+	assert.NotContains(t, out, "p&ss")
+	assert.NotContains(t, out, "1234")
+	assert.NotContains(t, out, "token=tok")
+	assert.NotContains(t, out, "s%2Fcr%20t")
+	assert.NotContains(t, out, "a2V5")
+	assert.NotContains(t, out, "key-1")
+	assert.Contains(t, out, "DEBUG: Response mock/Operation Details:")
+	assert.Contains(t, out, "<sensitive>\n---")
+	assert.NotContains(t, out, "response-secret")
+}
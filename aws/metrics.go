@@ -0,0 +1,52 @@
+package aws
+
+import "time"
+
+// A MetricsCollector records metrics of the requests sent with a Config,
+// such as to publish them to a monitoring system. Its methods are called
+// from the goroutines sending requests, so they must be safe for concurrent
+// use, and should not block.
+type MetricsCollector interface {
+	// RecordAttempt is called after each attempt to send a request, with the
+	// attempt's number, starting at 1.
+	RecordAttempt(service, operation string, attempt uint)
+
+	// RecordRetry is called each time a request is retried, with its retry
+	// count, starting at 1.
+	RecordRetry(service, operation string, retryCount uint)
+
+	// RecordLatency is called once a request completes, with the time it took,
+	// including all its attempts and the delays between them, and the code of
+	// the error it failed with. The code is empty if the request succeeded,
+	// and RequestError if its error has no code, such as a network error.
+	RecordLatency(service, operation string, latency time.Duration, errCode string)
+}
+
+// NoOpMetricsCollector is a MetricsCollector which records nothing. Embed it
+// in a collector to implement only some of its methods.
+type NoOpMetricsCollector struct{}
+
+// RecordAttempt does nothing.
+func (NoOpMetricsCollector) RecordAttempt(service, operation string, attempt uint) {}
+
+// RecordRetry does nothing.
+func (NoOpMetricsCollector) RecordRetry(service, operation string, retryCount uint) {}
+
+// RecordLatency does nothing.
+func (NoOpMetricsCollector) RecordLatency(service, operation string, latency time.Duration, errCode string) {
+}
+
+// MetricsAttemptHandler records an attempt to send the request with the
+// Config's MetricsCollector.
+func MetricsAttemptHandler(r *Request) {
+	r.Service.Config.MetricsCollector.RecordAttempt(r.Service.ServiceName, r.Operation.Name, r.RetryCount+1)
+}
+
+// MetricsRetryHandler records a retry of the request with the Config's
+// MetricsCollector. It runs after AfterRetryHandler, which clears the
+// request's error when the request is retried.
+func MetricsRetryHandler(r *Request) {
+	if r.Error == nil {
+		r.Service.Config.MetricsCollector.RecordRetry(r.Service.ServiceName, r.Operation.Name, r.RetryCount)
+	}
+}
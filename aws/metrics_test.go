@@ -0,0 +1,92 @@
+package aws
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeMetricsCollector struct {
+	m         sync.Mutex
+	attempts  []uint
+	retries   []uint
+	latencies []time.Duration
+	errCodes  []string
+}
+
+func (c *fakeMetricsCollector) RecordAttempt(service, operation string, attempt uint) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.attempts = append(c.attempts, attempt)
+}
+
+func (c *fakeMetricsCollector) RecordRetry(service, operation string, retryCount uint) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.retries = append(c.retries, retryCount)
+}
+
+func (c *fakeMetricsCollector) RecordLatency(service, operation string, latency time.Duration, errCode string) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.latencies = append(c.latencies, latency)
+	c.errCodes = append(c.errCodes, errCode)
+}
+
+func metricsService(c MetricsCollector, responses ...StubResponse) *Service {
+	s := NewService(&Config{Region: "mock-region", MaxRetries: DEFAULT_RETRIES, MetricsCollector: c})
+	s.DefaultMaxRetries = 2
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.SwapNamed(StubSendHandler(responses...))
+	return s
+}
+
+func TestMetricsCollectorRetriedTwice(t *testing.T) {
+	defer func(fn func(time.Duration)) { sleepDelay = fn }(sleepDelay)
+	defer func(fn func() time.Time) { currentTime = fn }(currentTime)
+	now := time.Unix(0, 0)
+	sleepDelay = func(d time.Duration) { now = now.Add(time.Second) }
+	currentTime = func() time.Time { return now }
+
+	c := &fakeMetricsCollector{}
+	s := metricsService(c,
+		StubResponse{StatusCode: 500, Body: `{"__type":"InternalError","message":"retry"}`},
+		StubResponse{StatusCode: 503, Body: `{"__type":"ServiceUnavailable","message":"retry"}`},
+		StubResponse{StatusCode: 200, Body: `{"data":"valid"}`},
+	)
+	s.ServiceName = "mock"
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, &testData{})
+	assert.NoError(t, r.Send())
+
+	assert.Equal(t, []uint{1, 2, 3}, c.attempts)
+	assert.Equal(t, []uint{1, 2}, c.retries)
+	assert.Equal(t, []time.Duration{2 * time.Second}, c.latencies)
+	assert.Equal(t, []string{""}, c.errCodes)
+}
+
+func TestMetricsCollectorErrorCode(t *testing.T) {
+	c := &fakeMetricsCollector{}
+	s := metricsService(c, StubResponse{StatusCode: 400, Body: `{"__type":"ValidationException","message":"invalid"}`})
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, &testData{})
+	assert.Error(t, r.Send())
+
+	assert.Equal(t, []uint{1}, c.attempts)
+	assert.Equal(t, 0, len(c.retries))
+	assert.Equal(t, []string{"ValidationException"}, c.errCodes)
+}
+
+func TestMetricsCollectorUnset(t *testing.T) {
+	s := NewService(&Config{Region: "mock-region"})
+	for _, l := range []HandlerList{s.Handlers.Send, s.Handlers.AfterRetry} {
+		for e := l.Front(); e != nil; e = e.Next() {
+			assert.NotContains(t, e.Value.(NamedHandler).Name, "Metrics")
+		}
+	}
+
+	var _ MetricsCollector = NoOpMetricsCollector{}
+}
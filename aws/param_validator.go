@@ -3,12 +3,19 @@ package aws
 import (
 	"fmt"
 	"reflect"
+	"regexp"
+	"strconv"
 	"strings"
+	"sync"
 )
 
+// ValidateParameters checks the request's params against the constraints of
+// their shapes. It is skipped when the Config's DisableParamValidation is set,
+// unless the request overrides it with SetDisableParamValidation. Enum
+// values are only checked when the Config's ValidateEnumValues is set.
 func ValidateParameters(r *Request) {
-	if r.ParamsFilled() {
-		v := validator{errors: []string{}}
+	if r.ParamsFilled() && !r.paramValidationDisabled() {
+		v := validator{errors: []string{}, enums: r.Service.Config.ValidateEnumValues}
 		v.validateAny(reflect.ValueOf(r.Params), "")
 
 		if count := len(v.errors); count > 0 {
@@ -21,6 +28,7 @@ func ValidateParameters(r *Request) {
 
 type validator struct {
 	errors []string
+	enums  bool
 }
 
 func (v *validator) validateAny(value reflect.Value, path string) {
@@ -74,7 +82,112 @@ func (v *validator) validateStruct(value reflect.Value, path string) {
 			msg := "missing required parameter: " + path + prefix + f.Name
 			v.errors = append(v.errors, msg)
 		} else {
+			v.validateBounds(f.Tag, fvalue, path+prefix+f.Name)
+			v.validatePattern(f.Tag, fvalue, path+prefix+f.Name)
+			v.validateEnum(f.Tag, fvalue, path+prefix+f.Name)
 			v.validateAny(fvalue, path+prefix+f.Name)
 		}
 	}
 }
+
+// validateBounds checks a member against its min and max traits, which bound
+// the length of strings, lists and maps, and the value of numbers.
+func (v *validator) validateBounds(tag reflect.StructTag, value reflect.Value, path string) {
+	value = reflect.Indirect(value)
+	if !value.IsValid() {
+		return
+	}
+	if (value.Kind() == reflect.Slice || value.Kind() == reflect.Map) && value.IsNil() {
+		return // unset, rather than empty
+	}
+
+	var n float64
+	kind := "value"
+	switch value.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map:
+		n, kind = float64(value.Len()), "length"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n = float64(value.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n = float64(value.Uint())
+	case reflect.Float32, reflect.Float64:
+		n = value.Float()
+	default:
+		return
+	}
+
+	if min, err := strconv.ParseFloat(tag.Get("min"), 64); err == nil && n < min {
+		msg := fmt.Sprintf("parameter %s must have a minimum %s of %v", path, kind, min)
+		v.errors = append(v.errors, msg)
+	}
+	if max, err := strconv.ParseFloat(tag.Get("max"), 64); err == nil && n > max {
+		msg := fmt.Sprintf("parameter %s must have a maximum %s of %v", path, kind, max)
+		v.errors = append(v.errors, msg)
+	}
+}
+
+// validatePattern checks a string member against its pattern trait, which
+// the whole string must match.
+func (v *validator) validatePattern(tag reflect.StructTag, value reflect.Value, path string) {
+	value = reflect.Indirect(value)
+	if !value.IsValid() || value.Kind() != reflect.String {
+		return
+	}
+
+	pattern := tag.Get("pattern")
+	if pattern == "" {
+		return
+	}
+	if re := compilePattern(pattern); re != nil && !re.MatchString(value.String()) {
+		msg := fmt.Sprintf("parameter %s must match pattern %s", path, pattern)
+		v.errors = append(v.errors, msg)
+	}
+}
+
+// validateEnum checks a string member against the values of its enum trait,
+// when enum validation is enabled.
+func (v *validator) validateEnum(tag reflect.StructTag, value reflect.Value, path string) {
+	value = reflect.Indirect(value)
+	if !v.enums || !value.IsValid() || value.Kind() != reflect.String {
+		return
+	}
+
+	enum := tag.Get("enum")
+	if enum == "" {
+		return
+	}
+	values := strings.Split(enum, ",")
+	for _, e := range values {
+		if value.String() == e {
+			return
+		}
+	}
+	msg := fmt.Sprintf("parameter %s must be one of %s", path, strings.Join(values, ", "))
+	v.errors = append(v.errors, msg)
+}
+
+// patterns caches the compiled pattern traits, by their pattern.
+var patterns = struct {
+	sync.Mutex
+	m map[string]*regexp.Regexp
+}{m: map[string]*regexp.Regexp{}}
+
+// javaEscapeRE matches the \uXXXX escapes of the models' Java regular
+// expressions, which are written \x{XXXX} in Go's.
+var javaEscapeRE = regexp.MustCompile(`\\u([0-9a-fA-F]{4})`)
+
+// compilePattern returns the regular expression a string must match whole
+// to satisfy the pattern trait pattern. Patterns Go cannot compile, such as
+// those with lookaheads, are not validated, and return nil.
+func compilePattern(pattern string) *regexp.Regexp {
+	patterns.Lock()
+	defer patterns.Unlock()
+
+	re, ok := patterns.m[pattern]
+	if !ok {
+		expr := javaEscapeRE.ReplaceAllString(pattern, `\x{$1}`)
+		re, _ = regexp.Compile("^(?:" + expr + ")$")
+		patterns.m[pattern] = re
+	}
+	return re
+}
@@ -1,6 +1,9 @@
 package aws_test
 
 import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
 	"testing"
 
 	"github.com/awslabs/aws-sdk-go/aws"
@@ -83,3 +86,184 @@ func TestNestedMissingRequiredParameters(t *testing.T) {
 	assert.Equal(t, "3 validation errors:\n- missing required parameter: RequiredList[0].Name\n- missing required parameter: RequiredMap[\"key2\"].Name\n- missing required parameter: OptionalStruct.Name", err.Message)
 
 }
+
+type BoundedShape struct {
+	Name   *string         `min:"3" max:"10"`
+	Count  *int64          `min:"1" max:"5"`
+	Items  []*BoundedShape `max:"2"`
+	Tags   []*string       `min:"1"`
+	Nested *BoundedShape
+}
+
+func TestBoundedParameters(t *testing.T) {
+	input := &BoundedShape{
+		Name:  aws.String("abc"),
+		Count: aws.Long(5),
+		Items: []*BoundedShape{&BoundedShape{Name: aws.String("0123456789")}},
+	}
+
+	req := aws.NewRequest(service, &aws.Operation{}, input, nil)
+	aws.ValidateParameters(req)
+	assert.NoError(t, req.Error)
+}
+
+func TestBoundedParametersViolations(t *testing.T) {
+	input := &BoundedShape{
+		Name:  aws.String("ab"),
+		Count: aws.Long(6),
+		Items: []*BoundedShape{
+			&BoundedShape{}, &BoundedShape{Name: aws.String("01234567890")}, &BoundedShape{},
+		},
+		Tags:   []*string{},
+		Nested: &BoundedShape{Count: aws.Long(0)},
+	}
+
+	req := aws.NewRequest(service, &aws.Operation{}, input, nil)
+	aws.ValidateParameters(req)
+	err := aws.Error(req.Error)
+
+	assert.Error(t, err)
+	assert.Equal(t, "InvalidParameter", err.Code)
+	assert.Equal(t, "6 validation errors:\n"+
+		"- parameter Name must have a minimum length of 3\n"+
+		"- parameter Count must have a maximum value of 5\n"+
+		"- parameter Items must have a maximum length of 2\n"+
+		"- parameter Items[1].Name must have a maximum length of 10\n"+
+		"- parameter Tags must have a minimum length of 1\n"+
+		"- parameter Nested.Count must have a minimum value of 1", err.Message)
+}
+
+type PatternShape struct {
+	RoleARN   *string  `pattern:"arn:aws:iam::\\d{12}:role/[\\w+=,.@-]+"`
+	Name      *string  `pattern:"[\\u0020-\\u00FF]+"`
+	Bucket    *string  `pattern:"^[a-z0-9](([a-z0-9]|-(?!-))*[a-z0-9])?$"`
+	Threshold *float64 `min:"0.5"`
+}
+
+func TestPatternParameters(t *testing.T) {
+	input := &PatternShape{
+		RoleARN:   aws.String("arn:aws:iam::123456789012:role/admin"),
+		Name:      aws.String("name with spaces"),
+		Bucket:    aws.String("not--checked"), // Go regexps have no lookahead
+		Threshold: aws.Double(0.5),
+	}
+
+	req := aws.NewRequest(service, &aws.Operation{}, input, nil)
+	aws.ValidateParameters(req)
+	assert.NoError(t, req.Error)
+}
+
+func TestPatternParametersViolations(t *testing.T) {
+	input := &PatternShape{
+		RoleARN:   aws.String("arn:aws:iam::123456789012:role/admin\nextra"),
+		Name:      aws.String("tab\tseparated"),
+		Threshold: aws.Double(0.25),
+	}
+
+	req := aws.NewRequest(service, &aws.Operation{}, input, nil)
+	aws.ValidateParameters(req)
+	err := aws.Error(req.Error)
+
+	assert.Error(t, err)
+	assert.Equal(t, "3 validation errors:\n"+
+		"- parameter RoleARN must match pattern arn:aws:iam::\\d{12}:role/[\\w+=,.@-]+\n"+
+		"- parameter Name must match pattern [\\u0020-\\u00FF]+\n"+
+		"- parameter Threshold must have a minimum value of 0.5", err.Message)
+}
+
+type EnumShape struct {
+	ACL          *string `type:"string" enum:"private,public-read"`
+	InstanceType *string `type:"string" enum:"t1.micro,m1.small"`
+}
+
+func TestEnumParameters(t *testing.T) {
+	s := aws.NewService(&aws.Config{Region: "mock-region", ValidateEnumValues: true})
+	input := &EnumShape{ACL: aws.String("public-read"), InstanceType: aws.String("t1.micro")}
+
+	req := aws.NewRequest(s, &aws.Operation{}, input, nil)
+	aws.ValidateParameters(req)
+	assert.NoError(t, req.Error)
+}
+
+func TestEnumParametersViolations(t *testing.T) {
+	s := aws.NewService(&aws.Config{Region: "mock-region", ValidateEnumValues: true})
+	input := &EnumShape{ACL: aws.String("public"), InstanceType: aws.String("t1.mirco")}
+
+	req := aws.NewRequest(s, &aws.Operation{}, input, nil)
+	aws.ValidateParameters(req)
+	err := aws.Error(req.Error)
+
+	assert.Error(t, err)
+	assert.Equal(t, "2 validation errors:\n"+
+		"- parameter ACL must be one of private, public-read\n"+
+		"- parameter InstanceType must be one of t1.micro, m1.small", err.Message)
+}
+
+func TestEnumParametersNotValidatedByDefault(t *testing.T) {
+	input := &EnumShape{InstanceType: aws.String("x9.future")} // added after this SDK
+
+	req := aws.NewRequest(service, &aws.Operation{}, input, nil)
+	aws.ValidateParameters(req)
+	assert.NoError(t, req.Error)
+}
+
+func TestValidationErrorStopsSend(t *testing.T) {
+	s := aws.NewService(&aws.Config{Region: "mock-region"})
+	s.Handlers.Send.Init()
+	sent := false
+	s.Handlers.Send.PushBack(func(r *aws.Request) { sent = true })
+
+	req := aws.NewRequest(s, &aws.Operation{Name: "Operation"}, &BoundedShape{Name: aws.String("ab")}, nil)
+	err := req.Send()
+	assert.Error(t, err)
+	assert.False(t, sent)
+
+	s = aws.NewService(&aws.Config{Region: "mock-region", DisableParamValidation: true})
+	s.Handlers.Send.Init()
+	s.Handlers.Send.PushBack(func(r *aws.Request) {
+		sent = true
+		r.HTTPResponse = &http.Response{StatusCode: 200, Body: ioutil.NopCloser(bytes.NewReader(nil))}
+	})
+
+	req = aws.NewRequest(s, &aws.Operation{Name: "Operation"}, &BoundedShape{Name: aws.String("ab")}, nil)
+	req.Send()
+	assert.True(t, sent)
+}
+
+func TestDisableParamValidationPerRequest(t *testing.T) {
+	newService := func(disable bool, sent *bool) *aws.Service {
+		s := aws.NewService(&aws.Config{Region: "mock-region", DisableParamValidation: disable})
+		s.Handlers.Send.Init()
+		s.Handlers.Send.PushBack(func(r *aws.Request) {
+			*sent = true
+			r.HTTPResponse = &http.Response{StatusCode: 200, Body: ioutil.NopCloser(bytes.NewReader(nil))}
+		})
+		return s
+	}
+
+	cases := []struct {
+		configDisable  bool
+		requestDisable bool
+		sent           bool
+	}{
+		{false, true, true},
+		{true, false, false},
+		{true, true, true},
+		{false, false, false},
+	}
+
+	for _, c := range cases {
+		sent := false
+		s := newService(c.configDisable, &sent)
+		req := aws.NewRequest(s, &aws.Operation{Name: "Operation"}, &BoundedShape{Name: aws.String("ab")}, nil)
+		req.SetDisableParamValidation(c.requestDisable)
+		err := req.Send()
+
+		assert.Equal(t, c.sent, sent)
+		if c.sent {
+			assert.NoError(t, err)
+		} else {
+			assert.Equal(t, "InvalidParameter", err.(aws.APIError).Code)
+		}
+	}
+}
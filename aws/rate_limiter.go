@@ -0,0 +1,187 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// A RateLimiter gates the attempts a client sends, so that a client sending
+// too many requests slows down before the service throttles it.
+type RateLimiter interface {
+	// GetToken takes cost tokens for an attempt, blocking until they are
+	// available or ctx is done, or returns an error if they cannot be taken.
+	// Calling the returned function gives the tokens back.
+	GetToken(ctx context.Context, cost uint) (release func(), err error)
+}
+
+const (
+	// RateLimitAttemptCost is the number of tokens taken for each attempt of
+	// a request.
+	RateLimitAttemptCost = 1
+
+	// RateLimitThrottleRetryCost is the number of tokens taken for a retry of
+	// a throttled attempt, so that a throttled client backs off across all of
+	// its requests.
+	RateLimitThrottleRetryCost = 5
+)
+
+// RateLimitHandler takes tokens from the Config's RateLimiter before each
+// attempt of the request is sent. The request fails without being sent if
+// the tokens cannot be taken.
+func RateLimitHandler(r *Request) {
+	cost := uint(RateLimitAttemptCost)
+	if r.rateLimitThrottled {
+		cost = RateLimitThrottleRetryCost
+	}
+
+	release, err := r.Service.Config.RateLimiter.GetToken(r.Context(), cost)
+	if err != nil {
+		r.Error = err
+		return
+	}
+	r.rateLimitRelease = release
+}
+
+// RateLimitReleaseHandler gives the tokens taken for an attempt back to the
+// Config's RateLimiter once its response is validated as successful. The
+// tokens of failed responses are given back by RateLimitThrottleHandler,
+// once their error code tells whether they were throttled.
+func RateLimitReleaseHandler(r *Request) {
+	if r.Error == nil {
+		r.rateLimitThrottled = false
+		r.releaseRateLimitToken()
+	}
+}
+
+// RateLimitThrottleHandler gives the tokens taken for a failed attempt back
+// to the Config's RateLimiter, recording whether the attempt was throttled,
+// as told by its unmarshaled error code or status code, so that its retry
+// costs RateLimitThrottleRetryCost tokens.
+func RateLimitThrottleHandler(r *Request) {
+	r.rateLimitThrottled = isThrottleError(r.Error)
+	r.releaseRateLimitToken()
+}
+
+// releaseRateLimitToken gives back the tokens the request holds for its
+// attempt, if any.
+func (r *Request) releaseRateLimitToken() {
+	if release := r.rateLimitRelease; release != nil {
+		r.rateLimitRelease = nil
+		release()
+	}
+}
+
+// A TokenBucket is a RateLimiter holding up to a capacity of tokens. Taken
+// tokens are recovered when they are given back, and by refilling the bucket
+// at a steady rate.
+type TokenBucket struct {
+	capacity   float64
+	refillRate float64
+	failFast   bool
+
+	m        sync.Mutex
+	tokens   float64
+	lastFill time.Time
+	notify   chan struct{}
+}
+
+// NewTokenBucket returns a full TokenBucket of capacity tokens, refilled at
+// refillRate tokens per second. Zero refillRate only recovers tokens given
+// back. When the bucket does not hold enough tokens, GetToken waits for them
+// to be recovered, or fails immediately if failFast is set.
+func NewTokenBucket(capacity uint, refillRate float64, failFast bool) *TokenBucket {
+	return &TokenBucket{
+		capacity:   float64(capacity),
+		refillRate: refillRate,
+		failFast:   failFast,
+		tokens:     float64(capacity),
+		lastFill:   currentTime(),
+		notify:     make(chan struct{}),
+	}
+}
+
+// GetToken takes cost tokens from the bucket. It returns a RateLimitExceeded
+// error if they are not available and the bucket fails fast, or if cost
+// exceeds the bucket's capacity. Otherwise it waits for the tokens, returning
+// ctx's error if ctx is done first.
+func (b *TokenBucket) GetToken(ctx context.Context, cost uint) (func(), error) {
+	if float64(cost) > b.capacity {
+		return nil, APIError{
+			Code:    "RateLimitExceeded",
+			Message: fmt.Sprintf("request cost %d exceeds the rate limit capacity of %v", cost, b.capacity),
+		}
+	}
+
+	for {
+		b.m.Lock()
+		b.refill()
+		if b.tokens >= float64(cost) {
+			b.tokens -= float64(cost)
+			b.m.Unlock()
+
+			var once sync.Once
+			return func() { once.Do(func() { b.put(cost) }) }, nil
+		}
+
+		if b.failFast {
+			b.m.Unlock()
+			return nil, APIError{
+				Code:    "RateLimitExceeded",
+				Message: fmt.Sprintf("rate limit exceeded, %d tokens needed", cost),
+			}
+		}
+
+		notify := b.notify
+		var timer *time.Timer
+		var refilled <-chan time.Time
+		if b.refillRate > 0 {
+			wait := (float64(cost) - b.tokens) / b.refillRate * float64(time.Second)
+			timer = time.NewTimer(time.Duration(wait))
+			refilled = timer.C
+		}
+		b.m.Unlock()
+
+		select {
+		case <-notify:
+		case <-refilled:
+		case <-ctx.Done():
+			err := ctx.Err()
+			if timer != nil {
+				timer.Stop()
+			}
+			return nil, err
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+	}
+}
+
+// refill adds the tokens refilled since the bucket was last refilled. It must
+// be called with the bucket locked.
+func (b *TokenBucket) refill() {
+	now := currentTime()
+	if b.refillRate > 0 {
+		b.tokens += now.Sub(b.lastFill).Seconds() * b.refillRate
+		if b.tokens > b.capacity {
+			b.tokens = b.capacity
+		}
+	}
+	b.lastFill = now
+}
+
+// put gives cost tokens back to the bucket and wakes up waiting callers.
+func (b *TokenBucket) put(cost uint) {
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	b.refill()
+	b.tokens += float64(cost)
+	if b.tokens > b.capacity {
+		b.tokens = b.capacity
+	}
+	close(b.notify)
+	b.notify = make(chan struct{})
+}
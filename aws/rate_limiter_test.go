@@ -0,0 +1,250 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenBucketFailFast(t *testing.T) {
+	b := NewTokenBucket(2, 0, true)
+
+	_, err := b.GetToken(context.Background(), 1)
+	assert.Nil(t, err)
+	release, err := b.GetToken(context.Background(), 1)
+	assert.Nil(t, err)
+
+	_, err = b.GetToken(context.Background(), 1)
+	assert.NotNil(t, err)
+	assert.Equal(t, "RateLimitExceeded", Error(err).Code)
+
+	release()
+	release() // tokens are only given back once
+	_, err = b.GetToken(context.Background(), 1)
+	assert.Nil(t, err)
+	_, err = b.GetToken(context.Background(), 1)
+	assert.NotNil(t, err)
+}
+
+func TestTokenBucketCostExceedsCapacity(t *testing.T) {
+	b := NewTokenBucket(2, 0, false)
+
+	_, err := b.GetToken(context.Background(), 3)
+	assert.NotNil(t, err)
+	assert.Equal(t, "RateLimitExceeded", Error(err).Code)
+}
+
+func TestTokenBucketBlocksUntilReleased(t *testing.T) {
+	b := NewTokenBucket(1, 0, false)
+	release, err := b.GetToken(context.Background(), 1)
+	assert.Nil(t, err)
+
+	done := make(chan error)
+	go func() {
+		_, err := b.GetToken(context.Background(), 1)
+		done <- err
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("expected GetToken to block until a token is released")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	release()
+	select {
+	case err := <-done:
+		assert.Nil(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("expected GetToken to return once a token is released")
+	}
+}
+
+func TestTokenBucketBlockingContextDone(t *testing.T) {
+	b := NewTokenBucket(1, 0, false)
+	_, err := b.GetToken(context.Background(), 1)
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = b.GetToken(ctx, 1)
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestTokenBucketRefill(t *testing.T) {
+	now := time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)
+	defer func(f func() time.Time) { currentTime = f }(currentTime)
+	currentTime = func() time.Time { return now }
+
+	b := NewTokenBucket(10, 1, true)
+	_, err := b.GetToken(context.Background(), 10)
+	assert.Nil(t, err)
+
+	now = now.Add(3 * time.Second)
+	_, err = b.GetToken(context.Background(), 3)
+	assert.Nil(t, err)
+	_, err = b.GetToken(context.Background(), 1)
+	assert.NotNil(t, err)
+
+	now = now.Add(time.Hour) // refills up to the capacity
+	_, err = b.GetToken(context.Background(), 10)
+	assert.Nil(t, err)
+	_, err = b.GetToken(context.Background(), 1)
+	assert.NotNil(t, err)
+}
+
+func TestRequestRateLimitFailFast(t *testing.T) {
+	b := NewTokenBucket(1, 0, true)
+	_, err := b.GetToken(context.Background(), 1)
+	assert.Nil(t, err)
+
+	sent := 0
+	s := NewService(&Config{MaxRetries: -1, RateLimiter: b})
+	s.Handlers.Send.SwapNamed(NamedHandler{"aws.SendHandler", func(r *Request) {
+		if r.Error != nil {
+			return
+		}
+		sent++
+		r.HTTPResponse = &http.Response{StatusCode: 200, Body: body(`{}`)}
+	}})
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	err = r.Send()
+	assert.NotNil(t, err)
+	assert.Equal(t, "RateLimitExceeded", Error(err).Code)
+	assert.Equal(t, 0, sent)
+	assert.Equal(t, 0, int(r.RetryCount))
+}
+
+func TestRequestRateLimitReleasesTokens(t *testing.T) {
+	b := NewTokenBucket(1, 0, true)
+
+	s := NewService(&Config{MaxRetries: -1, RateLimiter: b})
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	s.Handlers.Send.SwapNamed(NamedHandler{"aws.SendHandler", func(r *Request) {
+		r.HTTPResponse = &http.Response{StatusCode: 200, Body: body(`{"data":"valid"}`)}
+	}})
+
+	for i := 0; i < 3; i++ {
+		out := &testData{}
+		r := NewRequest(s, &Operation{Name: "Operation"}, nil, out)
+		assert.Nil(t, r.Send())
+		assert.Equal(t, "valid", out.Data)
+	}
+}
+
+func TestRequestRateLimitThrottleRetryCost(t *testing.T) {
+	delays := []time.Duration{}
+	sleepDelay = func(delay time.Duration) {
+		delays = append(delays, delay)
+	}
+	defer func() { sleepDelay = func(delay time.Duration) { time.Sleep(delay) } }()
+
+	b := NewTokenBucket(10, 0, true)
+
+	reqNum := 0
+	reqs := []http.Response{
+		http.Response{StatusCode: 429, Body: body(`{"__type":"ThrottlingException","message":"Rate exceeded"}`)},
+		http.Response{StatusCode: 200, Body: body(`{"data":"valid"}`)},
+	}
+
+	s := NewService(&Config{MaxRetries: -1, RateLimiter: b})
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.SwapNamed(NamedHandler{"aws.SendHandler", func(r *Request) {
+		r.HTTPResponse = &reqs[reqNum]
+		reqNum++
+	}})
+
+	out := &testData{}
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, out)
+	assert.Nil(t, r.Send())
+	assert.Equal(t, 1, int(r.RetryCount))
+	assert.Equal(t, "valid", out.Data)
+
+	// the tokens of the throttled attempt and of its retry are given back
+	_, err := b.GetToken(context.Background(), 10)
+	assert.Nil(t, err)
+}
+
+func TestRequestRateLimitThrottleRetryBlocked(t *testing.T) {
+	delays := []time.Duration{}
+	sleepDelay = func(delay time.Duration) {
+		delays = append(delays, delay)
+	}
+	defer func() { sleepDelay = func(delay time.Duration) { time.Sleep(delay) } }()
+
+	// a token held elsewhere leaves too few for the throttled retry
+	b := NewTokenBucket(RateLimitThrottleRetryCost, 0, true)
+	_, err := b.GetToken(context.Background(), 1)
+	assert.Nil(t, err)
+
+	sent := 0
+	s := NewService(&Config{MaxRetries: -1, RateLimiter: b})
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.SwapNamed(NamedHandler{"aws.SendHandler", func(r *Request) {
+		if r.Error != nil {
+			return
+		}
+		sent++
+		r.HTTPResponse = &http.Response{StatusCode: 429, Body: body(`{"__type":"ThrottlingException","message":"Rate exceeded"}`)}
+	}})
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	err = r.Send()
+	assert.NotNil(t, err)
+	assert.Equal(t, "RateLimitExceeded", Error(err).Code)
+	assert.Equal(t, 1, sent)
+	assert.Equal(t, 1, len(delays))
+}
+
+func TestRequestRateLimitThrottleErrorCode(t *testing.T) {
+	defer func(fn func(time.Duration)) { sleepDelay = fn }(sleepDelay)
+	sleepDelay = func(time.Duration) {}
+
+	b := NewTokenBucket(RateLimitThrottleRetryCost, 0, true)
+	_, err := b.GetToken(context.Background(), 1)
+	assert.Nil(t, err)
+
+	s := NewService(&Config{MaxRetries: -1, RateLimiter: b})
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.SwapNamed(StubSendHandler(
+		StubResponse{StatusCode: 400, Body: `{"__type":"ThrottlingException","message":"Rate exceeded"}`},
+		StubResponse{StatusCode: 200, Body: `{}`},
+	))
+
+	// throttled by its code, the retry costs more tokens than are left
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	err = r.Send()
+	assert.NotNil(t, err)
+	assert.Equal(t, "RateLimitExceeded", Error(err).Code)
+}
+
+func TestRequestRateLimitReleasesTokensOfFailures(t *testing.T) {
+	b := NewTokenBucket(1, 0, true)
+
+	s := NewService(&Config{MaxRetries: 0, RateLimiter: b})
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.SwapNamed(NamedHandler{"aws.SendHandler", func(r *Request) {
+		if r.Error == nil {
+			r.Error = errors.New("connection refused")
+		}
+	}})
+	for i := 0; i < 3; i++ {
+		err := NewRequest(s, &Operation{Name: "Operation"}, nil, nil).Send()
+		assert.Equal(t, "connection refused", err.Error())
+	}
+
+	s.Handlers.Send.SwapNamed(StubSendHandler(
+		StubResponse{StatusCode: 400, Body: `{"__type":"ValidationException","message":"bad"}`},
+		StubResponse{StatusCode: 400, Body: `{"__type":"ValidationException","message":"bad"}`},
+	))
+	for i := 0; i < 2; i++ {
+		err := NewRequest(s, &Operation{Name: "Operation"}, nil, nil).Send()
+		assert.Equal(t, "ValidationException", Error(err).Code)
+	}
+}
@@ -2,12 +2,16 @@ package aws
 
 import (
 	"bytes"
+	"context"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"reflect"
 	"time"
+
+	"github.com/awslabs/aws-sdk-go/aws/awserr"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 type Request struct {
@@ -25,24 +29,75 @@ type Request struct {
 	RequestID    string
 	RetryCount   uint
 
-	built bool
+	// ClockSkew is the detected offset of the server's clock from the local
+	// clock. When non-zero, retries are signed at the corrected time.
+	ClockSkew time.Duration
+
+	built     bool
+	builtPath string
+	ctx       context.Context
+	timeout   time.Duration
+	bodyStart int64
+
+	rateLimitRelease   func()
+	rateLimitThrottled bool
+
+	userAgent []UserAgentComponent
+
+	disableParamValidation *bool
+
+	bodyCompressed bool
+	resignRetries  bool
+
+	values map[interface{}]interface{}
+
+	headers     http.Header
+	queryParams url.Values
+
+	savedBody *bytes.Buffer
 }
 
+// An Operation describes an API operation. Each Request has its own copy of
+// its Operation, so the HTTPMethod and HTTPPath of a request may be changed
+// before it is built, for example by a Validate handler, to send it through
+// a gateway or proxy. The path's {Member} placeholders are still substituted
+// with the request's params. Protocols may serialize different members into
+// the body depending on the method.
 type Operation struct {
 	Name       string
 	HTTPMethod string
 	HTTPPath   string
+
+	// HostPrefix is prepended to the host of the operation's requests. It
+	// may contain {Name} placeholders for the input's hostLabel members.
+	HostPrefix string
+
+	// RequestCompression is set for operations accepting gzip compressed
+	// request bodies.
+	RequestCompression bool
+
+	// AuthType is the operation's authtype trait, selecting how its
+	// requests are signed. The default, "", signs them with the service's
+	// signer.
+	AuthType string
+
+	*Paginator
 }
 
+// The authtype traits of operations.
+const (
+	// AuthTypeNone is the authtype of operations whose requests are not
+	// signed, such as public operations rejecting credentials.
+	AuthTypeNone = "none"
+
+	// AuthTypeV4UnsignedBody is the authtype of operations whose requests
+	// are signed with signature version 4 without signing their bodies.
+	AuthTypeV4UnsignedBody = "v4-unsigned-body"
+)
+
 func NewRequest(service *Service, operation *Operation, params interface{}, data interface{}) *Request {
-	method := operation.HTTPMethod
-	if method == "" {
-		method = "POST"
-	}
-	p := operation.HTTPPath
-	if p == "" {
-		p = "/"
-	}
+	op := *operation
+	method, p := op.method(), op.path()
 
 	httpReq, _ := http.NewRequest(method, "", nil)
 	httpReq.URL, _ = url.Parse(service.Endpoint + p)
@@ -50,9 +105,9 @@ func NewRequest(service *Service, operation *Operation, params interface{}, data
 	r := &Request{
 		Service:     service,
 		Handlers:    service.Handlers.copy(),
-		Time:        time.Now(),
+		Time:        service.now(),
 		ExpireTime:  0,
-		Operation:   operation,
+		Operation:   &op,
 		HTTPRequest: httpReq,
 		Body:        nil,
 		Params:      params,
@@ -60,10 +115,99 @@ func NewRequest(service *Service, operation *Operation, params interface{}, data
 		Data:        data,
 	}
 	r.SetBufferBody([]byte{})
+	r.builtPath = p
 
 	return r
 }
 
+// Copy returns a copy of the request as it was before it was built, which
+// can be changed and sent independently of the request, including
+// concurrently with it. The copy has its own handler lists, Operation and
+// deep copy of the params, and a new value of the type of the request's Data
+// to unmarshal its response into. Headers and query values set on the
+// request's HTTPRequest are copied if it has not been built yet, as is the
+// body set on it. Bodies, in the params or set on the request, are copied as
+// awsutil.CopyOf copies readers: io.ReadSeekers are copied into a reader of
+// the copy's own, and other readers are shared, so each copy sent
+// concurrently must be given its own.
+func (r *Request) Copy() *Request {
+	var data interface{}
+	if r.Data != nil {
+		if t := reflect.TypeOf(r.Data); t.Kind() == reflect.Ptr {
+			data = reflect.New(t.Elem()).Interface()
+		}
+	}
+
+	c := NewRequest(r.Service, r.Operation, awsutil.CopyOf(r.Params), data)
+	c.Handlers = r.Handlers.copy()
+	c.ExpireTime = r.ExpireTime
+	c.ClockSkew = r.ClockSkew
+	c.timeout = r.timeout
+	c.userAgent = append([]UserAgentComponent(nil), r.userAgent...)
+	c.disableParamValidation = r.disableParamValidation
+	for k, v := range r.values {
+		c.SetValue(k, v)
+	}
+	for k, v := range r.headers {
+		c.SetHeader(k, v[0])
+	}
+	for k, v := range r.queryParams {
+		for _, s := range v {
+			c.AddQueryParam(k, s)
+		}
+	}
+	if r.ctx != nil {
+		c.SetContext(r.ctx)
+	}
+
+	if !r.built {
+		c.HTTPRequest.Header = http.Header{}
+		for k, v := range r.HTTPRequest.Header {
+			c.HTTPRequest.Header[k] = append([]string(nil), v...)
+		}
+		u := *r.HTTPRequest.URL
+		c.HTTPRequest.URL = &u
+		c.builtPath = r.builtPath
+		if body, ok := awsutil.CopyOf(r.Body).(io.ReadSeeker); ok {
+			c.SetReaderBody(body)
+		}
+	}
+
+	return c
+}
+
+// method returns the operation's HTTP method, which defaults to POST.
+func (o *Operation) method() string {
+	if o.HTTPMethod == "" {
+		return "POST"
+	}
+	return o.HTTPMethod
+}
+
+// path returns the operation's HTTP path, which defaults to /.
+func (o *Operation) path() string {
+	if o.HTTPPath == "" {
+		return "/"
+	}
+	return o.HTTPPath
+}
+
+// applyOperation updates the HTTP request with the method and path of the
+// request's Operation, if they were changed after the request was created.
+func (r *Request) applyOperation() {
+	r.HTTPRequest.Method = r.Operation.method()
+	if p := r.Operation.path(); p != r.builtPath {
+		u, err := url.Parse(r.Service.Endpoint + p)
+		if err != nil {
+			r.Error = err
+			return
+		}
+		u.RawQuery = r.HTTPRequest.URL.RawQuery
+		r.HTTPRequest.URL = u
+		r.builtPath = p
+	}
+}
+
 func (r *Request) ParamsFilled() bool {
 	return r.Params != nil && reflect.ValueOf(r.Params).Elem().IsValid()
 }
@@ -81,7 +225,307 @@ func (r *Request) SetReaderBody(reader io.ReadSeeker) {
 	r.Body = reader
 }
 
+// SetContext sets the context used to cancel the request. A cancelled
+// context stops the request before its next attempt, interrupts any retry
+// delay, and is passed on to the underlying HTTP request.
+func (r *Request) SetContext(ctx context.Context) {
+	if ctx == nil {
+		panic("context cannot be nil")
+	}
+	r.ctx = ctx
+	r.HTTPRequest = r.HTTPRequest.WithContext(ctx)
+}
+
+// Context returns the request's context, or context.Background if none has
+// been set.
+func (r *Request) Context() context.Context {
+	if r.ctx == nil {
+		return context.Background()
+	}
+	return r.ctx
+}
+
+// SetTimeout bounds the time Send may take, including every retry and the
+// delays between them, unlike an http.Client's Timeout which bounds a single
+// attempt. An attempt in flight when the timeout expires is aborted, and no
+// retry is started if its delay would exceed the remaining time.
+func (r *Request) SetTimeout(d time.Duration) {
+	r.timeout = d
+}
+
+// SetDisableParamValidation overrides the Config's DisableParamValidation for
+// the request, skipping the validation of its params when disable is true, or
+// validating them even though the Config disables it when false.
+func (r *Request) SetDisableParamValidation(disable bool) {
+	r.disableParamValidation = &disable
+}
+
+// SetResignRetries signs each retry of the request again when resign is
+// true, rather than only when correcting ClockSkew, for signers which encode
+// the body as it is sent and must encode it again for the retry, such as
+// the chunk signing of S3 uploads.
+func (r *Request) SetResignRetries(resign bool) {
+	r.resignRetries = resign
+}
+
+// paramValidationDisabled returns whether the request's params are sent
+// without being validated.
+func (r *Request) paramValidationDisabled() bool {
+	if r.disableParamValidation != nil {
+		return *r.disableParamValidation
+	}
+	return r.Service != nil && r.Service.Config != nil && r.Service.Config.DisableParamValidation
+}
+
+// SetValue stores val on the request under key, for a later handler of the
+// request, such as an AfterRetry or Unmarshal handler, to read with
+// GetValue. Values are kept across the request's attempts, so handlers can
+// use them to measure the latency of each attempt or count them. As with
+// context values, keys should be of a type unexported by the package
+// setting them, to avoid collisions.
+func (r *Request) SetValue(key, val interface{}) {
+	if r.values == nil {
+		r.values = map[interface{}]interface{}{}
+	}
+	r.values[key] = val
+}
+
+// GetValue returns the value stored on the request under key by SetValue,
+// or nil if no value is stored under key.
+func (r *Request) GetValue(key interface{}) interface{} {
+	return r.values[key]
+}
+
+// ResponseBody returns the part of the body of the request's last response
+// read so far, such as by its unmarshaler, or nil if the Config's
+// SaveResponseBody is not set.
+func (r *Request) ResponseBody() []byte {
+	if r.savedBody == nil {
+		return nil
+	}
+	return r.savedBody.Bytes()
+}
+
+// SetHeader sets the header k of the request to v. Unlike a header set on
+// HTTPRequest directly, it is applied after the request's Build handlers
+// run, so it is neither overwritten by them nor missed by the signer. A
+// header set after the request was signed, such as after Presign, is only
+// signed when the request is signed again, as by Sign or Send.
+func (r *Request) SetHeader(k, v string) {
+	if r.headers == nil {
+		r.headers = http.Header{}
+	}
+	r.headers.Set(k, v)
+	if r.built {
+		r.HTTPRequest.Header.Set(k, v)
+	}
+}
+
+// AddQueryParam adds the value v to the query parameter k of the request's
+// URL. As with SetHeader, it is applied after the request's Build handlers
+// run, and is only signed by signing the request after it was added.
+func (r *Request) AddQueryParam(k, v string) {
+	if r.queryParams == nil {
+		r.queryParams = url.Values{}
+	}
+	r.queryParams.Add(k, v)
+	if r.built {
+		r.addQuery(url.Values{k: {v}})
+	}
+}
+
+// applyHeadersAndQuery applies the headers and query parameters set with
+// SetHeader and AddQueryParam to the built HTTP request.
+func (r *Request) applyHeadersAndQuery() {
+	for k, v := range r.headers {
+		r.HTTPRequest.Header.Set(k, v[0])
+	}
+	if len(r.queryParams) > 0 {
+		r.addQuery(r.queryParams)
+	}
+}
+
+// addQuery adds the values of params to the query of the request's URL.
+func (r *Request) addQuery(params url.Values) {
+	query := r.HTTPRequest.URL.Query()
+	for k, v := range params {
+		query[k] = append(query[k], v...)
+	}
+	r.HTTPRequest.URL.RawQuery = query.Encode()
+}
+
+// exceedsDeadline returns whether waiting for delay would take the request
+// past its context's deadline, leaving no time for another attempt.
+func (r *Request) exceedsDeadline(delay time.Duration) bool {
+	if r.ctx == nil {
+		return false
+	}
+	deadline, ok := r.ctx.Deadline()
+	return ok && !time.Now().Add(delay).Before(deadline)
+}
+
+// canceledError returns an error if the request's context is done.
+func (r *Request) canceledError() error {
+	if r.ctx == nil || r.ctx.Err() == nil {
+		return nil
+	}
+	if r.ctx.Err() == context.DeadlineExceeded {
+		return APIError{
+			Code:       "RequestDeadlineExceeded",
+			Message:    "request deadline exceeded: " + r.ctx.Err().Error(),
+			RetryCount: r.RetryCount,
+		}
+	}
+	return APIError{
+		Code:       "RequestCanceled",
+		Message:    "request context canceled: " + r.ctx.Err().Error(),
+		RetryCount: r.RetryCount,
+	}
+}
+
+// resign signs the request again for its next attempt, using a signing time
+// corrected by the request's ClockSkew.
+func (r *Request) resign() error {
+	r.Time = r.Service.now().Add(r.ClockSkew)
+	r.Handlers.Sign.Run(r)
+	return r.Error
+}
+
+// bodyRewindable returns whether the request's body can be seeked back to be
+// sent again.
+func (r *Request) bodyRewindable() bool {
+	if b, ok := r.Body.(ReaderSeekerCloser); ok {
+		return b.seekable()
+	}
+	return true
+}
+
+// ResetBody seeks the request's body back to the offset it was at when the
+// request was sent, so that a retry sends the same bytes as the first
+// attempt. It returns an error if the body is not an io.Seeker, as the bytes
+// already sent cannot be read again.
+func (r *Request) ResetBody() error {
+	if r.Body == nil {
+		return nil
+	}
+	if !r.bodyRewindable() {
+		return APIError{
+			Code:       "RequestBodyNotRewindable",
+			Message:    "request body must be an io.Seeker to be sent again by a retry",
+			RetryCount: r.RetryCount,
+		}
+	}
+	if _, err := r.Body.Seek(r.bodyStart, 0); err != nil {
+		return err
+	}
+	r.HTTPRequest.Body = ioutil.NopCloser(r.Body)
+	return nil
+}
+
+// retryError returns the error of a failed response, as unmarshaled, as the
+// APIError its retry is decided on: with the response's status code, and
+// marked Retryable with the delay before the retry by the Service's retry
+// policy.
+func (r *Request) retryError(err error) APIError {
+	e := APIError{Message: err.Error()}
+	if ae := Error(err); ae != nil {
+		e = *ae
+	}
+	e.StatusCode = r.HTTPResponse.StatusCode
+	e.RetryCount = r.RetryCount
+
+	r.Error = e
+	e.Retryable = r.Service.ShouldRetry(r)
+	e.RetryDelay = r.Service.RetryRules(r)
+	return e
+}
+
+// retrySendError prepares the request for another attempt when sending it
+// failed with an error its Service's retry policy retries, such as a reset
+// connection. The attempt may have consumed part of the body, so the request
+// is only retried if its body can be rewound. The send error is kept when
+// the request is not retried.
+func (r *Request) retrySendError() bool {
+	if !r.bodyRewindable() {
+		return false
+	}
+	if !r.Service.ShouldRetry(r) {
+		return false
+	}
+
+	sendErr := r.Error
+	r.Error = APIError{
+		Code:       "RequestError",
+		Message:    sendErr.Error(),
+		Retryable:  true,
+		RetryDelay: r.Service.RetryRules(r),
+		RetryCount: r.RetryCount,
+	}
+	r.Handlers.Retry.Run(r)
+	r.Handlers.AfterRetry.Run(r)
+	if r.Error != nil {
+		r.Error = sendErr
+		return false
+	}
+
+	if err := r.ResetBody(); err != nil {
+		r.Error = sendErr
+		return false
+	}
+	return true
+}
+
+// attemptsError records the number of attempts made, and the IDs of the last
+// response, in the error the request failed with. Errors other than
+// RequestFailures and APIErrors, such as those of failed sends, are returned
+// as an APIError with the RequestError code and the error's message.
+func (r *Request) attemptsError(err error) error {
+	hostID := ""
+	if r.HTTPResponse != nil {
+		hostID = r.HTTPResponse.Header.Get("X-Amz-Id-2")
+	}
+	switch e := err.(type) {
+	case awserr.RequestFailure:
+		if r.RequestID == "" {
+			r.RequestID = e.RequestID()
+		}
+		return awserr.NewRequestAttemptsFailure(e, int(r.RetryCount)+1, hostID)
+	case APIError:
+		if e.RequestID == "" {
+			e.RequestID = r.RequestID
+		}
+		e.HostID = hostID
+		e.RetryCount = r.RetryCount
+		return e
+	default:
+		return APIError{
+			Code:       "RequestError",
+			Message:    err.Error(),
+			RequestID:  r.RequestID,
+			HostID:     hostID,
+			RetryCount: r.RetryCount,
+		}
+	}
+}
+
+// The shortest and longest durations a presigned URL may be valid.
+const (
+	MinPresignExpireTime = time.Second
+	MaxPresignExpireTime = 7 * 24 * time.Hour
+)
+
+// Presign signs the request's parameters into its URL's query string instead
+// of its headers, and returns the URL without sending the request. The URL is
+// valid for expireTime, which must be between MinPresignExpireTime and
+// MaxPresignExpireTime.
 func (r *Request) Presign(expireTime time.Duration) (string, error) {
+	if expireTime < MinPresignExpireTime || expireTime > MaxPresignExpireTime {
+		return "", APIError{
+			Code:    "InvalidPresignExpire",
+			Message: "presign expiry must be between 1 second and 7 days, got " + expireTime.String(),
+		}
+	}
+
 	r.ExpireTime = expireTime
 	r.Sign()
 	if r.Error != nil {
@@ -91,6 +535,12 @@ func (r *Request) Presign(expireTime time.Duration) (string, error) {
 	}
 }
 
+// Build runs the request's Validate and Build handlers, once, serializing the
+// request's parameters into its HTTPRequest. Changes to the method and path of
+// the request's Operation made until then are applied before the Build
+// handlers run. It is run by Sign, so changes made to HTTPRequest by Build
+// handlers are signed, as are the headers and query parameters set with
+// SetHeader and AddQueryParam, which are applied after them.
 func (r *Request) Build() error {
 	if !r.built {
 		r.Error = nil
@@ -98,13 +548,22 @@ func (r *Request) Build() error {
 		if r.Error != nil {
 			return r.Error
 		}
+		r.applyOperation()
+		if r.Error != nil {
+			return r.Error
+		}
 		r.Handlers.Build.Run(r)
+		if r.Error == nil {
+			r.applyHeadersAndQuery()
+		}
 		r.built = true
 	}
 
 	return r.Error
 }
 
+// Sign builds the request, if it has not been built yet, and runs its Sign
+// handlers.
 func (r *Request) Sign() error {
 	r.Build()
 	if r.Error != nil {
@@ -116,26 +575,91 @@ func (r *Request) Sign() error {
 }
 
 func (r *Request) Send() error {
+	if m := r.Service.Config.MetricsCollector; m != nil {
+		start := currentTime()
+		defer func() {
+			code := ""
+			if err := Error(r.Error); err != nil {
+				code = err.Code
+			} else if r.Error != nil {
+				code = "RequestError"
+			}
+			m.RecordLatency(r.Service.ServiceName, r.Operation.Name, currentTime().Sub(start), code)
+		}()
+	}
+
+	if r.timeout > 0 {
+		parent := r.ctx
+		ctx, cancel := context.WithTimeout(r.Context(), r.timeout)
+		r.SetContext(ctx)
+		defer func() {
+			// a successful response's body is read after Send returns, so the
+			// deadline is only released early on failure
+			if r.Error != nil {
+				cancel()
+			}
+			r.ctx = parent
+		}()
+	}
+
+	// the tokens of an attempt which failed to be sent, or whose error was
+	// not retried, are given back when the request completes
+	defer r.releaseRateLimitToken()
+
 	r.Sign()
 	if r.Error != nil {
 		return r.Error
 	}
 
+	// retries send the body again from where the first attempt started
+	r.bodyStart = 0
+	if r.Body != nil && r.bodyRewindable() {
+		r.bodyStart, _ = r.Body.Seek(0, 1)
+	}
+
 	for {
+		if err := r.canceledError(); err != nil {
+			r.Error = err
+			return r.Error
+		}
+
 		r.Handlers.Send.Run(r)
 		if r.Error != nil {
+			if err := r.canceledError(); err != nil {
+				r.Error = err
+				return r.Error
+			}
+			if r.retrySendError() {
+				continue
+			}
+			r.Error = r.attemptsError(r.Error)
 			return r.Error
 		}
 
 		r.Handlers.UnmarshalMeta.Run(r)
 		r.Handlers.ValidateResponse.Run(r)
 		if r.Error != nil {
+			// the error is unmarshaled before the retry is decided, as
+			// throttling and other retryable errors are told by their code
+			r.Handlers.UnmarshalError.Run(r)
+			err := r.Error
+			r.Error = r.retryError(err)
 			r.Handlers.Retry.Run(r)
 			r.Handlers.AfterRetry.Run(r)
 			if r.Error != nil {
-				r.Handlers.UnmarshalError.Run(r)
+				r.Error = r.attemptsError(err)
+				return r.Error
+			}
+			if err := r.ResetBody(); err != nil {
+				r.Error = r.attemptsError(err)
 				return r.Error
 			}
+			if r.ClockSkew != 0 || r.resignRetries {
+				if err := r.resign(); err != nil {
+					r.Error = r.attemptsError(err)
+					return r.Error
+				}
+			}
 			continue
 		}
 
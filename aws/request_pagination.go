@@ -0,0 +1,197 @@
+package aws
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
+)
+
+// A Paginator describes how the results of an operation are split into
+// pages. Each output token is copied into the input token at the same index
+// of the next page's parameters.
+type Paginator struct {
+	InputTokens     []string
+	OutputTokens    []string
+	LimitToken      string
+	TruncationToken string
+}
+
+// HasNextPage returns true if the request's output has another page of
+// results after it.
+func (r *Request) HasNextPage() bool {
+	return r.nextPageTokens() != nil
+}
+
+// nextPageTokens returns the output tokens of the request's output, or nil
+// if there are no more pages.
+func (r *Request) nextPageTokens() []interface{} {
+	if r.Operation.Paginator == nil || !r.DataFilled() {
+		return nil
+	}
+
+	if r.Operation.TruncationToken != "" {
+		v := awsutil.ValuesAtPath(r.Data, r.Operation.TruncationToken)
+		if len(v) == 0 {
+			return nil
+		}
+		if truncated, ok := v[0].(bool); ok && !truncated {
+			return nil
+		}
+	}
+
+	found := false
+	tokens := make([]interface{}, len(r.Operation.OutputTokens))
+	for i, outtok := range r.Operation.OutputTokens {
+		v := awsutil.ValuesAtPath(r.Data, outtok)
+		if len(v) == 0 {
+			continue
+		}
+		if s, ok := v[0].(string); ok && s == "" {
+			continue
+		}
+		tokens[i] = v[0]
+		found = true
+	}
+	if !found {
+		return nil
+	}
+	return tokens
+}
+
+// NextPage returns a new Request which retrieves the page of results after
+// this request's, or nil if this request's output is the last page. The
+// request's parameters are deep copied rather than modified.
+func (r *Request) NextPage() *Request {
+	tokens := r.nextPageTokens()
+	if tokens == nil {
+		return nil
+	}
+
+	data := reflect.New(reflect.TypeOf(r.Data).Elem()).Interface()
+	params := reflect.New(reflect.TypeOf(r.Params).Elem())
+	if v := reflect.ValueOf(r.Params); !v.IsNil() {
+		params = reflect.ValueOf(awsutil.CopyOf(r.Params))
+	}
+	for i, intok := range r.Operation.InputTokens {
+		setInputToken(params.Elem(), intok, tokens[i])
+	}
+
+	nr := NewRequest(r.Service, r.Operation, params.Interface(), data)
+	nr.Handlers = r.Handlers.copy()
+	if r.ctx != nil {
+		nr.SetContext(r.ctx)
+	}
+	nr.timeout = r.timeout
+	return nr
+}
+
+// setInputToken sets the member of params at the dotted path name to a new
+// pointer to token, creating the structures on its path as needed. A nil
+// token clears the member.
+func setInputToken(params reflect.Value, name string, token interface{}) {
+	path := strings.Split(name, ".")
+	for _, p := range path[:len(path)-1] {
+		params = params.FieldByName(p)
+		if !params.IsValid() {
+			return
+		}
+		if params.Kind() == reflect.Ptr {
+			if params.IsNil() {
+				if token == nil {
+					return // nothing to clear
+				}
+				params.Set(reflect.New(params.Type().Elem()))
+			}
+			params = params.Elem()
+		}
+		if params.Kind() != reflect.Struct {
+			return
+		}
+	}
+
+	field := params.FieldByName(path[len(path)-1])
+	if !field.IsValid() {
+		return
+	}
+	if token == nil {
+		field.Set(reflect.Zero(field.Type()))
+		return
+	}
+
+	v := reflect.ValueOf(token)
+	if field.Kind() == reflect.Ptr {
+		ptr := reflect.New(field.Type().Elem())
+		ptr.Elem().Set(v)
+		v = ptr
+	}
+	field.Set(v)
+}
+
+// EachPage sends the request and each page after it, calling fn with the
+// output of each page. Iteration stops when fn returns false, when the last
+// page has been handled, or when a request fails, in which case its error is
+// returned. fn should have the following signature, where data is the
+// operation's output struct:
+//
+//	func(data interface{}, lastPage bool) (shouldContinue bool)
+func (r *Request) EachPage(fn func(data interface{}, lastPage bool) (shouldContinue bool)) error {
+	for page := r; page != nil; page = page.NextPage() {
+		if err := page.Send(); err != nil {
+			return err
+		}
+		if !fn(page.Data, !page.HasNextPage()) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// A Page is a page of results received from the channel returned by Pages.
+type Page struct {
+	// Data is the operation's output struct of the page.
+	Data interface{}
+
+	// LastPage is true for the last page of results.
+	LastPage bool
+}
+
+// Pages sends the request and each page after it from a goroutine, sending
+// the pages in order on the returned page channel, which callers may range
+// over instead of passing EachPage a callback:
+//
+//	pages, errs := req.Pages()
+//	for p := range pages {
+//	    // use p.Data.(*s3.ListObjectsOutput)
+//	}
+//	if err := <-errs; err != nil {
+//	    // handle the error
+//	}
+//
+// The error of the first request which fails is sent on the error channel.
+// Both channels are closed once the last page has been received or a request
+// failed, so receiving from the error channel after the pages returns nil
+// when every page was retrieved. Callers which stop receiving pages early
+// should cancel the request's context, which stops further requests and
+// fails with a RequestCanceled error.
+func (r *Request) Pages() (<-chan Page, <-chan error) {
+	pages, errs := make(chan Page), make(chan error, 1)
+	go func() {
+		defer close(errs)
+		defer close(pages)
+
+		for page := r; page != nil; page = page.NextPage() {
+			if err := page.Send(); err != nil {
+				errs <- err
+				return
+			}
+			select {
+			case pages <- Page{Data: page.Data, LastPage: !page.HasNextPage()}:
+			case <-page.Context().Done():
+				errs <- page.canceledError()
+				return
+			}
+		}
+	}()
+	return pages, errs
+}
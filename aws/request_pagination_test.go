@@ -0,0 +1,290 @@
+package aws
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type paginationInput struct {
+	NextToken *string
+	Limit     *int64
+}
+
+type paginationOutput struct {
+	NextToken *string
+	Items     []*string
+}
+
+type multiTokenInput struct {
+	StartName *string
+	StartType *string
+	MaxItems  *string
+}
+
+type multiTokenOutput struct {
+	NextName    *string
+	NextType    *string
+	IsTruncated *bool
+	Items       []*string
+}
+
+// pagedService returns a Service whose requests receive the given outputs in
+// order, recording the params each request was sent with.
+func pagedService(outputs []interface{}, params *[]interface{}) *Service {
+	s := NewService(&Config{MaxRetries: -1})
+	s.Handlers.Validate.Init()
+	s.Handlers.Send.Init() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = &http.Response{StatusCode: 200, Body: body("")}
+	})
+	s.Handlers.Unmarshal.PushBack(func(r *Request) {
+		i := len(*params)
+		*params = append(*params, r.Params)
+		reflect.ValueOf(r.Data).Elem().Set(reflect.ValueOf(outputs[i]).Elem())
+	})
+	return s
+}
+
+var opPaginated = &Operation{
+	Name: "List",
+	Paginator: &Paginator{
+		InputTokens:  []string{"NextToken"},
+		OutputTokens: []string{"NextToken"},
+		LimitToken:   "Limit",
+	},
+}
+
+func TestEachPage(t *testing.T) {
+	outputs := []interface{}{
+		&paginationOutput{NextToken: String("token1"), Items: []*string{String("a"), String("b")}},
+		&paginationOutput{Items: []*string{String("c")}},
+	}
+	params := []interface{}{}
+	s := pagedService(outputs, &params)
+
+	in := &paginationInput{Limit: Long(2)}
+	r := NewRequest(s, opPaginated, in, &paginationOutput{})
+
+	items, lastPages := []string{}, []bool{}
+	err := r.EachPage(func(p interface{}, lastPage bool) bool {
+		for _, item := range p.(*paginationOutput).Items {
+			items = append(items, *item)
+		}
+		lastPages = append(lastPages, lastPage)
+		return true
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, items)
+	assert.Equal(t, []bool{false, true}, lastPages)
+	assert.Len(t, params, 2)
+	assert.Nil(t, params[0].(*paginationInput).NextToken)
+	assert.Equal(t, "token1", *params[1].(*paginationInput).NextToken)
+	assert.Equal(t, int64(2), *params[1].(*paginationInput).Limit)
+	assert.Nil(t, in.NextToken) // original params are not modified
+}
+
+func TestEachPageStopsEarly(t *testing.T) {
+	outputs := []interface{}{
+		&paginationOutput{NextToken: String("token1")},
+		&paginationOutput{NextToken: String("token2")},
+	}
+	params := []interface{}{}
+	s := pagedService(outputs, &params)
+	r := NewRequest(s, opPaginated, &paginationInput{}, &paginationOutput{})
+
+	pages := 0
+	err := r.EachPage(func(p interface{}, lastPage bool) bool {
+		pages++
+		return false
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, pages)
+	assert.Len(t, params, 1)
+}
+
+func TestEachPageError(t *testing.T) {
+	s := NewService(&Config{MaxRetries: -1})
+	s.Handlers.Send.Init() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = &http.Response{StatusCode: 400, Body: body(`{"__type":"BadRequest","message":"bad"}`)}
+	})
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	r := NewRequest(s, opPaginated, &paginationInput{}, &paginationOutput{})
+
+	called := false
+	err := r.EachPage(func(p interface{}, lastPage bool) bool {
+		called = true
+		return true
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, "BadRequest", Error(err).Code)
+	assert.False(t, called)
+}
+
+func TestEachPageMultipleTokens(t *testing.T) {
+	op := &Operation{
+		Name: "ListRecords",
+		Paginator: &Paginator{
+			InputTokens:     []string{"StartName", "StartType"},
+			OutputTokens:    []string{"NextName", "NextType"},
+			LimitToken:      "MaxItems",
+			TruncationToken: "IsTruncated",
+		},
+	}
+	outputs := []interface{}{
+		&multiTokenOutput{NextName: String("b"), NextType: String("A"), IsTruncated: Boolean(true)},
+		&multiTokenOutput{NextName: String("c"), IsTruncated: Boolean(true)},
+		&multiTokenOutput{NextName: String("d"), NextType: String("MX"), IsTruncated: Boolean(false)},
+	}
+	params := []interface{}{}
+	s := pagedService(outputs, &params)
+	in := &multiTokenInput{StartName: String("a"), StartType: String("CNAME")}
+	r := NewRequest(s, op, in, &multiTokenOutput{})
+
+	pages := 0
+	err := r.EachPage(func(p interface{}, lastPage bool) bool {
+		pages++
+		assert.Equal(t, pages == 3, lastPage)
+		return true
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, pages)
+	assert.Len(t, params, 3)
+	assert.Equal(t, "b", *params[1].(*multiTokenInput).StartName)
+	assert.Equal(t, "A", *params[1].(*multiTokenInput).StartType)
+	assert.Equal(t, "c", *params[2].(*multiTokenInput).StartName)
+	assert.Nil(t, params[2].(*multiTokenInput).StartType)
+	assert.Equal(t, "a", *in.StartName)
+}
+
+type nestedTokenInput struct {
+	Start *multiTokenInput
+}
+
+func TestEachPageNestedTokens(t *testing.T) {
+	op := &Operation{
+		Name: "ListRecords",
+		Paginator: &Paginator{
+			InputTokens:     []string{"Start.StartName", "Start.StartType"},
+			OutputTokens:    []string{"NextName", "NextType"},
+			TruncationToken: "IsTruncated",
+		},
+	}
+	outputs := []interface{}{
+		&multiTokenOutput{NextName: String("b"), NextType: String("A"), IsTruncated: Boolean(true)},
+		&multiTokenOutput{NextName: String("c"), IsTruncated: Boolean(true)},
+		&multiTokenOutput{IsTruncated: Boolean(false)},
+	}
+	params := []interface{}{}
+	s := pagedService(outputs, &params)
+	in := &nestedTokenInput{Start: &multiTokenInput{StartName: String("a"), StartType: String("CNAME")}}
+	r := NewRequest(s, op, in, &multiTokenOutput{})
+
+	assert.NoError(t, r.EachPage(func(p interface{}, lastPage bool) bool { return true }))
+	assert.Len(t, params, 3)
+	assert.Equal(t, "b", *params[1].(*nestedTokenInput).Start.StartName)
+	assert.Equal(t, "A", *params[1].(*nestedTokenInput).Start.StartType)
+	assert.Equal(t, "c", *params[2].(*nestedTokenInput).Start.StartName)
+	assert.Nil(t, params[2].(*nestedTokenInput).Start.StartType)
+
+	// the original params' nested structure is not modified
+	assert.Equal(t, "a", *in.Start.StartName)
+	assert.Equal(t, "CNAME", *in.Start.StartType)
+}
+
+func TestHasNextPageWithoutPaginator(t *testing.T) {
+	r := NewRequest(NewService(&Config{}), &Operation{Name: "Get"}, &paginationInput{}, &paginationOutput{NextToken: String("token")})
+	assert.False(t, r.HasNextPage())
+	assert.Nil(t, r.NextPage())
+}
+
+func TestPages(t *testing.T) {
+	outputs := []interface{}{
+		&paginationOutput{NextToken: String("token1"), Items: []*string{String("a"), String("b")}},
+		&paginationOutput{NextToken: String("token2"), Items: []*string{String("c")}},
+		&paginationOutput{Items: []*string{String("d")}},
+	}
+	params := []interface{}{}
+	s := pagedService(outputs, &params)
+	r := NewRequest(s, opPaginated, &paginationInput{}, &paginationOutput{})
+
+	items, lastPages := []string{}, []bool{}
+	pages, errs := r.Pages()
+	for p := range pages {
+		for _, item := range p.Data.(*paginationOutput).Items {
+			items = append(items, *item)
+		}
+		lastPages = append(lastPages, p.LastPage)
+	}
+
+	assert.NoError(t, <-errs)
+	assert.Equal(t, []string{"a", "b", "c", "d"}, items)
+	assert.Equal(t, []bool{false, false, true}, lastPages)
+	assert.Len(t, params, 3)
+	assert.Equal(t, "token2", *params[2].(*paginationInput).NextToken)
+}
+
+func TestPagesError(t *testing.T) {
+	outputs := []interface{}{
+		&paginationOutput{NextToken: String("token1"), Items: []*string{String("a")}},
+		&paginationOutput{Items: []*string{String("b")}},
+	}
+	params := []interface{}{}
+	s := pagedService(outputs, &params)
+	s.Handlers.Send.Init()
+	s.Handlers.Send.PushBackNamed(StubSendHandler(
+		StubResponse{StatusCode: 200},
+		StubResponse{StatusCode: 400, Body: `{"__type":"BadRequest","message":"bad"}`},
+	))
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	r := NewRequest(s, opPaginated, &paginationInput{}, &paginationOutput{})
+
+	items := []string{}
+	pages, errs := r.Pages()
+	for p := range pages {
+		for _, item := range p.Data.(*paginationOutput).Items {
+			items = append(items, *item)
+		}
+	}
+
+	err := <-errs
+	assert.Error(t, err)
+	assert.Equal(t, "BadRequest", Error(err).Code)
+	assert.Equal(t, []string{"a"}, items)
+
+	_, ok := <-errs
+	assert.False(t, ok)
+}
+
+func TestPagesCanceled(t *testing.T) {
+	outputs := []interface{}{
+		&paginationOutput{NextToken: String("token1"), Items: []*string{String("a")}},
+		&paginationOutput{NextToken: String("token2"), Items: []*string{String("b")}},
+		&paginationOutput{Items: []*string{String("c")}},
+	}
+	params := []interface{}{}
+	s := pagedService(outputs, &params)
+	r := NewRequest(s, opPaginated, &paginationInput{}, &paginationOutput{})
+	ctx, cancel := context.WithCancel(context.Background())
+	r.SetContext(ctx)
+
+	pages, errs := r.Pages()
+	p := <-pages
+	assert.Equal(t, "a", *p.Data.(*paginationOutput).Items[0])
+	cancel()
+
+	for range pages { // a page sent before the cancellation was seen
+	}
+	err := <-errs
+	assert.Error(t, err)
+	assert.Equal(t, "RequestCanceled", Error(err).Code)
+	assert.True(t, len(params) < 3)
+}
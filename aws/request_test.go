@@ -2,14 +2,23 @@ package aws
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
+	"net"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
 	"reflect"
+	"syscall"
 	"testing"
 	"time"
 
+	"github.com/awslabs/aws-sdk-go/aws/awserr"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -84,6 +93,9 @@ func TestRequestRecoverRetry(t *testing.T) {
 }
 
 func TestRequestExhaustRetries(t *testing.T) {
+	defer func(f func(int64) int64) { retryJitter = f }(retryJitter)
+	retryJitter = func(n int64) int64 { return n } // retry at the backoff's upper bound
+
 	delays := []time.Duration{}
 	sleepDelay = func(delay time.Duration) {
 		delays = append(delays, delay)
@@ -116,3 +128,734 @@ func TestRequestExhaustRetries(t *testing.T) {
 	assert.Equal(t, 3, int(r.RetryCount))
 	assert.True(t, reflect.DeepEqual([]time.Duration{30 * time.Millisecond, 60 * time.Millisecond, 120 * time.Millisecond}, delays))
 }
+
+type testRetryer struct {
+	maxRetries uint
+	retries    int
+}
+
+func (r *testRetryer) MaxRetries() uint {
+	return r.maxRetries
+}
+
+func (r *testRetryer) RetryRules(req *Request) time.Duration {
+	return time.Duration(req.RetryCount+1) * time.Second
+}
+
+func (r *testRetryer) ShouldRetry(req *Request) bool {
+	r.retries++
+	return true
+}
+
+func TestRequestCustomRetryer(t *testing.T) {
+	delays := []time.Duration{}
+	sleepDelay = func(delay time.Duration) {
+		delays = append(delays, delay)
+	}
+
+	reqNum := 0
+	retryer := &testRetryer{maxRetries: 5}
+	s := NewService(&Config{Retryer: retryer})
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.Init() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = &http.Response{StatusCode: 400, Body: body(`{"__type":"MockError","message":"An error occurred."}`)}
+		reqNum++
+	})
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	err := r.Send()
+	apiErr := Error(err)
+	assert.NotNil(t, apiErr)
+	assert.Equal(t, "MockError", apiErr.Code)
+	assert.Equal(t, 6, reqNum)
+	assert.Equal(t, 6, retryer.retries)
+	assert.Equal(t, 5, int(r.RetryCount))
+	assert.Equal(t, []time.Duration{1 * time.Second, 2 * time.Second, 3 * time.Second, 4 * time.Second, 5 * time.Second}, delays)
+}
+
+func TestRequestDefaultRetryer(t *testing.T) {
+	s := NewService(&Config{MaxRetries: DEFAULT_RETRIES})
+	assert.Equal(t, DefaultRetryer{}, s.Retryer)
+	assert.Equal(t, 3, int(s.MaxRetries()))
+
+	s = NewService(&Config{MaxRetries: 1, Retryer: &testRetryer{maxRetries: 5}})
+	assert.Equal(t, 5, int(s.MaxRetries()))
+}
+
+func TestRequestContextCanceledDuringRetry(t *testing.T) {
+	reqNum := 0
+	s := NewService(&Config{Retryer: &testRetryer{maxRetries: 5}})
+	s.RetryRules = func(r *Request) time.Duration { return 10 * time.Second }
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.Init() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = &http.Response{StatusCode: 500, Body: body(`{"__type":"UnknownError","message":"An error occurred."}`)}
+		reqNum++
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.SetContext(ctx)
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	start := time.Now()
+	err := r.Send()
+	assert.True(t, time.Since(start) < 5*time.Second)
+
+	apiErr := Error(err)
+	assert.NotNil(t, apiErr)
+	assert.Equal(t, "RequestCanceled", apiErr.Code)
+	assert.Equal(t, 1, reqNum)
+	assert.Equal(t, ctx, r.HTTPRequest.Context())
+}
+
+func TestRequestContextCanceledBeforeSend(t *testing.T) {
+	reqNum := 0
+	s := NewService(&Config{})
+	s.Handlers.Send.Init() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		reqNum++
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.SetContext(ctx)
+
+	err := r.Send()
+	apiErr := Error(err)
+	assert.NotNil(t, apiErr)
+	assert.Equal(t, "RequestCanceled", apiErr.Code)
+	assert.Equal(t, 0, reqNum)
+}
+
+func TestRequestTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(500 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	s := NewService(&Config{Endpoint: server.URL})
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.SetTimeout(50 * time.Millisecond)
+
+	start := time.Now()
+	err := r.Send()
+	assert.True(t, time.Since(start) < 400*time.Millisecond)
+
+	apiErr := Error(err)
+	assert.NotNil(t, apiErr)
+	assert.Equal(t, "RequestDeadlineExceeded", apiErr.Code)
+}
+
+func TestRequestTimeoutSkipsRetryPastDeadline(t *testing.T) {
+	reqNum := 0
+	s := NewService(&Config{Retryer: &testRetryer{maxRetries: 5}})
+	s.RetryRules = func(r *Request) time.Duration { return 10 * time.Second }
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.Init() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = &http.Response{StatusCode: 500, Body: body(`{"__type":"UnknownError","message":"An error occurred."}`)}
+		reqNum++
+	})
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.SetTimeout(time.Second)
+
+	start := time.Now()
+	err := r.Send()
+	assert.True(t, time.Since(start) < time.Second)
+
+	apiErr := Error(err)
+	assert.NotNil(t, apiErr)
+	assert.Equal(t, "UnknownError", apiErr.Code)
+	assert.Equal(t, 1, reqNum)
+	assert.Equal(t, 0, int(r.RetryCount))
+}
+
+func connectionResetError() error {
+	return &url.Error{Op: "Post", URL: "https://example.com", Err: &net.OpError{
+		Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET),
+	}}
+}
+
+func TestRequestRetryConnectionReset(t *testing.T) {
+	delays := []time.Duration{}
+	sleepDelay = func(delay time.Duration) {
+		delays = append(delays, delay)
+	}
+	defer func() { sleepDelay = func(delay time.Duration) { time.Sleep(delay) } }()
+
+	bodies := []string{}
+	s := NewService(&Config{MaxRetries: -1})
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	s.Handlers.Send.Init() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		b, _ := ioutil.ReadAll(r.HTTPRequest.Body)
+		bodies = append(bodies, string(b))
+		if len(bodies) == 1 {
+			r.Error = connectionResetError()
+			return
+		}
+		r.HTTPResponse = &http.Response{StatusCode: 200, Body: body(`{"data":"valid"}`)}
+	})
+
+	out := &testData{}
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, out)
+	r.SetBufferBody([]byte("request body"))
+	err := r.Send()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, int(r.RetryCount))
+	assert.Equal(t, 1, len(delays))
+	assert.Equal(t, "valid", out.Data)
+	assert.Equal(t, []string{"request body", "request body"}, bodies)
+}
+
+func TestRequestRetryConnectionResetExhausted(t *testing.T) {
+	sleepDelay = func(time.Duration) {}
+	defer func() { sleepDelay = func(delay time.Duration) { time.Sleep(delay) } }()
+
+	attempts := 0
+	s := NewService(&Config{MaxRetries: -1})
+	s.Handlers.Send.Init() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		attempts++
+		r.Error = connectionResetError()
+	})
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	err := r.Send()
+	assert.Equal(t, 4, attempts)
+	assert.Equal(t, "RequestError", Error(err).Code)
+	assert.Equal(t, connectionResetError().Error(), err.Error())
+	assert.Equal(t, 4, Error(err).Attempts())
+}
+
+func TestRequestNoRetryUnrewindableBody(t *testing.T) {
+	attempts := 0
+	s := NewService(&Config{MaxRetries: -1})
+	s.Handlers.Send.Init() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		attempts++
+		r.Error = connectionResetError()
+	})
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.SetReaderBody(ReadSeekCloser(body("streamed body")))
+	err := r.Send()
+	assert.Error(t, err)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestRequestNoRetryClientError(t *testing.T) {
+	attempts := 0
+	s := NewService(&Config{MaxRetries: -1})
+	s.Handlers.Send.Init() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		attempts++
+		r.Error = errors.New("unsupported protocol scheme")
+	})
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	err := r.Send()
+	assert.Equal(t, "unsupported protocol scheme", err.Error())
+	assert.Equal(t, 1, attempts)
+}
+
+func TestRequestRetrySendsSameBody(t *testing.T) {
+	sleepDelay = func(time.Duration) {}
+	defer func() { sleepDelay = func(delay time.Duration) { time.Sleep(delay) } }()
+
+	bodies := []string{}
+	s := NewService(&Config{MaxRetries: -1})
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.Init() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		b, _ := ioutil.ReadAll(r.HTTPRequest.Body)
+		bodies = append(bodies, string(b))
+		if len(bodies) == 1 {
+			r.HTTPResponse = &http.Response{StatusCode: 500, Body: body(`{"__type":"UnknownError"}`)}
+			return
+		}
+		r.HTTPResponse = &http.Response{StatusCode: 200, Body: body(`{"data":"valid"}`)}
+	})
+
+	reader := bytes.NewReader([]byte("skipped:request body"))
+	reader.Seek(int64(len("skipped:")), 0)
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, &testData{})
+	r.SetReaderBody(reader)
+	assert.NoError(t, r.Send())
+	assert.Equal(t, []string{"request body", "request body"}, bodies)
+}
+
+func TestRequestRetryUnrewindableBody(t *testing.T) {
+	sleepDelay = func(time.Duration) {}
+	defer func() { sleepDelay = func(delay time.Duration) { time.Sleep(delay) } }()
+
+	attempts := 0
+	s := NewService(&Config{MaxRetries: -1})
+	s.Handlers.Send.Init() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		attempts++
+		ioutil.ReadAll(r.HTTPRequest.Body)
+		r.HTTPResponse = &http.Response{StatusCode: 500, Body: body(``)}
+	})
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.SetReaderBody(ReadSeekCloser(body("streamed body")))
+	err := r.Send()
+	assert.Equal(t, "RequestBodyNotRewindable", Error(err).Code)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestRequestResetBody(t *testing.T) {
+	r := NewRequest(NewService(&Config{}), &Operation{Name: "Operation"}, nil, nil)
+	r.SetBufferBody([]byte("request body"))
+	ioutil.ReadAll(r.HTTPRequest.Body)
+
+	assert.NoError(t, r.ResetBody())
+	b, _ := ioutil.ReadAll(r.HTTPRequest.Body)
+	assert.Equal(t, "request body", string(b))
+
+	r.SetReaderBody(ReadSeekCloser(body("streamed body")))
+	assert.Error(t, r.ResetBody())
+}
+
+func TestRequestErrorReportsAttempts(t *testing.T) {
+	sleepDelay = func(time.Duration) {}
+	defer func() { sleepDelay = func(delay time.Duration) { time.Sleep(delay) } }()
+
+	attempts := 0
+	s := NewService(&Config{MaxRetries: 2})
+	s.Handlers.Send.Init() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		attempts++
+		r.HTTPResponse = &http.Response{
+			StatusCode: 500,
+			Header: http.Header{
+				"X-Amz-Request-Id": []string{fmt.Sprintf("request-%d", attempts)},
+				"X-Amz-Id-2":       []string{fmt.Sprintf("host-%d", attempts)},
+			},
+			Body: body(""),
+		}
+	})
+	s.Handlers.UnmarshalError.PushBack(func(r *Request) {
+		r.Error = awserr.NewRequestFailure(awserr.New("InternalError", "internal error", nil),
+			r.HTTPResponse.StatusCode, r.HTTPResponse.Header.Get("X-Amz-Request-Id"))
+	})
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	err := r.Send()
+	assert.Equal(t, 3, attempts)
+
+	aerr, ok := err.(awserr.RequestAttemptsFailure)
+	assert.True(t, ok, "expect a RequestAttemptsFailure")
+	assert.Equal(t, "InternalError", aerr.Code())
+	assert.Equal(t, 3, aerr.Attempts())
+	assert.Equal(t, "request-3", aerr.RequestID())
+	assert.Equal(t, "host-3", aerr.HostID())
+	assert.Equal(t, "request-3", r.RequestID)
+
+	apiErr := Error(err)
+	assert.Equal(t, 3, apiErr.Attempts())
+	assert.Equal(t, "host-3", apiErr.HostID)
+}
+
+func TestRequestAPIErrorReportsAttempts(t *testing.T) {
+	sleepDelay = func(time.Duration) {}
+	defer func() { sleepDelay = func(delay time.Duration) { time.Sleep(delay) } }()
+
+	s := NewService(&Config{MaxRetries: 2})
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.Init() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = &http.Response{StatusCode: 500, Body: body(`{"__type":"UnknownError"}`)}
+	})
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	err := r.Send()
+	assert.Equal(t, 3, Error(err).Attempts())
+}
+
+func TestRequestResignErrorReportsAttempts(t *testing.T) {
+	sleepDelay = func(time.Duration) {}
+	defer func() { sleepDelay = func(delay time.Duration) { time.Sleep(delay) } }()
+
+	s := NewService(&Config{MaxRetries: 2})
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.Init() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.HTTPResponse = &http.Response{StatusCode: 500, Body: body(`{"__type":"UnknownError"}`)}
+	})
+	signs := 0
+	s.Handlers.Sign.PushBack(func(r *Request) {
+		if signs++; signs > 1 {
+			r.Error = errors.New("credentials expired")
+		}
+	})
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.ClockSkew = time.Minute
+	err := r.Send()
+	assert.Equal(t, err, r.Error)
+	assert.Equal(t, "RequestError", Error(err).Code)
+	assert.Equal(t, "credentials expired", err.Error())
+	assert.Equal(t, 2, Error(err).Attempts())
+}
+
+func TestRequestClockSkewCorrection(t *testing.T) {
+	defer func() { currentTime = time.Now }()
+	now := time.Date(2015, 1, 1, 12, 0, 0, 0, time.UTC)
+	currentTime = func() time.Time { return now }
+	sleepDelay = func(time.Duration) {}
+
+	serverTime := now.Add(30 * time.Minute)
+	var signedTimes []time.Time
+
+	s := NewService(&Config{MaxRetries: -1})
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Sign.PushBack(func(r *Request) {
+		signedTimes = append(signedTimes, r.Time)
+	})
+	s.Handlers.Send.Init() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		if len(signedTimes) == 1 {
+			r.HTTPResponse = &http.Response{
+				StatusCode: 403,
+				Header:     http.Header{"Date": []string{serverTime.Format(http.TimeFormat)}},
+				Body:       body(`{"__type":"RequestTimeTooSkewed","message":"skewed"}`),
+			}
+			return
+		}
+		r.HTTPResponse = &http.Response{StatusCode: 200, Body: body(`{"data":"valid"}`)}
+	})
+	out := &testData{}
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, out)
+	r.Time = now
+	err := r.Send()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, int(r.RetryCount))
+	assert.Equal(t, 30*time.Minute, r.ClockSkew)
+	assert.Equal(t, []time.Time{now, serverTime}, signedTimes)
+	assert.Equal(t, "valid", out.Data)
+}
+
+func TestRequestClockSkewBounded(t *testing.T) {
+	defer func() { currentTime = time.Now }()
+	now := time.Date(2015, 1, 1, 12, 0, 0, 0, time.UTC)
+	currentTime = func() time.Time { return now }
+
+	cases := []struct {
+		serverTime time.Time
+		skew       time.Duration
+	}{
+		{now.Add(2 * time.Minute), 0},
+		{now.Add(-20 * time.Minute), -20 * time.Minute},
+		{now.Add(48 * time.Hour), MaxClockSkew},
+		{now.Add(-48 * time.Hour), -MaxClockSkew},
+	}
+	for _, c := range cases {
+		r := NewRequest(NewService(&Config{}), &Operation{Name: "Operation"}, nil, nil)
+		r.HTTPResponse = &http.Response{
+			StatusCode: 403,
+			Header:     http.Header{"Date": []string{c.serverTime.Format(http.TimeFormat)}},
+		}
+		r.Error = APIError{StatusCode: 403}
+		ClockSkewHandler(r)
+		assert.Equal(t, c.skew, r.ClockSkew)
+		assert.Equal(t, c.skew != 0, Error(r.Error).Retryable)
+	}
+}
+
+func TestRequestClockSkewCorrectionDisabled(t *testing.T) {
+	s := NewService(&Config{DisableClockSkewCorrection: true})
+	assert.Equal(t, 0, s.Handlers.Retry.Len())
+
+	s = NewService(&Config{})
+	assert.Equal(t, 1, s.Handlers.Retry.Len())
+}
+
+func TestRequestOperationOverride(t *testing.T) {
+	op := &Operation{Name: "GetThing", HTTPMethod: "GET", HTTPPath: "/things"}
+	s := NewService(&Config{Endpoint: "https://example.com"})
+	s.Handlers.Validate.PushBack(func(r *Request) {
+		r.Operation.HTTPMethod = "POST"
+		r.Operation.HTTPPath = "/proxy/things"
+	})
+
+	r := NewRequest(s, op, nil, nil)
+	r.HTTPRequest.URL.RawQuery = "a=b"
+	assert.NoError(t, r.Build())
+	assert.Equal(t, "POST", r.HTTPRequest.Method)
+	assert.Equal(t, "https://example.com/proxy/things?a=b", r.HTTPRequest.URL.String())
+
+	// the operation shared by the service's requests is unchanged
+	assert.Equal(t, "GET", op.HTTPMethod)
+	assert.Equal(t, "/things", op.HTTPPath)
+}
+
+type copyParams struct {
+	Name *string
+	Tags map[string]*string
+	List []*string
+}
+
+type copyOutput struct {
+	Name   string
+	Region string
+}
+
+func TestRequestCopy(t *testing.T) {
+	s := NewService(&Config{Endpoint: "https://example.com"})
+	s.Handlers.Build.PushBack(func(r *Request) {
+		b, _ := json.Marshal(r.Params)
+		r.SetBufferBody(b)
+	})
+	s.Handlers.Send.Init()
+	s.Handlers.Send.PushBack(func(r *Request) {
+		var p copyParams
+		json.NewDecoder(r.Body).Decode(&p)
+		b, _ := json.Marshal(copyOutput{Name: *p.Name, Region: r.HTTPRequest.Header.Get("X-Region")})
+		r.HTTPResponse = &http.Response{StatusCode: 200, Body: ioutil.NopCloser(bytes.NewReader(b))}
+	})
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+
+	params := &copyParams{
+		Name: String("base"),
+		Tags: map[string]*string{"a": String("1")},
+		List: []*string{String("x")},
+	}
+	base := NewRequest(s, &Operation{Name: "Operation"}, params, &copyOutput{})
+	base.HTTPRequest.Header.Set("X-Region", "base")
+	base.Handlers.Build.PushBack(func(r *Request) {
+		r.HTTPRequest.Header.Add("X-Built", "1")
+	})
+
+	copies := make([]*Request, 20)
+	for i := range copies {
+		c := base.Copy()
+		p := c.Params.(*copyParams)
+		p.Name = String(fmt.Sprintf("copy-%d", i))
+		*p.Tags["a"] = fmt.Sprintf("%d", i)
+		*p.List[0] = "y"
+		c.HTTPRequest.Header.Set("X-Region", fmt.Sprintf("region-%d", i))
+		copies[i] = c
+	}
+
+	done := make(chan error, len(copies))
+	for _, c := range copies {
+		go func(c *Request) { done <- c.Send() }(c)
+	}
+	for range copies {
+		assert.NoError(t, <-done)
+	}
+
+	for i, c := range copies {
+		out := c.Data.(*copyOutput)
+		assert.Equal(t, fmt.Sprintf("copy-%d", i), out.Name)
+		assert.Equal(t, fmt.Sprintf("region-%d", i), out.Region)
+		assert.Equal(t, 1, len(c.HTTPRequest.Header["X-Built"]))
+	}
+
+	// the base request is unchanged by its copies
+	assert.Equal(t, "base", *params.Name)
+	assert.Equal(t, "1", *params.Tags["a"])
+	assert.Equal(t, "x", *params.List[0])
+	assert.Equal(t, "base", base.HTTPRequest.Header.Get("X-Region"))
+	assert.NoError(t, base.Send())
+	assert.Equal(t, "base", base.Data.(*copyOutput).Name)
+}
+
+type copyBodyParams struct {
+	Body io.ReadSeeker
+}
+
+func TestRequestCopyBody(t *testing.T) {
+	s := NewService(&Config{Endpoint: "https://example.com"})
+	s.Handlers.Build.PushBack(func(r *Request) {
+		if p, ok := r.Params.(*copyBodyParams); ok {
+			r.SetReaderBody(p.Body)
+		}
+	})
+	var sent []string
+	s.Handlers.Send.Init()
+	s.Handlers.Send.PushBack(func(r *Request) {
+		b, _ := ioutil.ReadAll(r.HTTPRequest.Body)
+		sent = append(sent, string(b))
+		r.HTTPResponse = &http.Response{StatusCode: 200, Body: ioutil.NopCloser(bytes.NewReader(nil))}
+	})
+
+	// bodies in the params
+	r := NewRequest(s, &Operation{Name: "Operation"}, &copyBodyParams{Body: bytes.NewReader([]byte("params"))}, nil)
+	c := r.Copy()
+	assert.NoError(t, r.Send())
+	assert.NoError(t, c.Send())
+	assert.True(t, r.Body != c.Body)
+
+	// bodies set on the request before it is built
+	r = NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	r.SetBufferBody([]byte("request"))
+	c = r.Copy()
+	assert.NoError(t, r.Send())
+	assert.NoError(t, c.Send())
+	assert.True(t, r.Body != c.Body)
+
+	assert.Equal(t, []string{"params", "params", "request", "request"}, sent)
+}
+
+type attemptStartKey struct{}
+
+func TestRequestValues(t *testing.T) {
+	reqNum := 0
+	reqs := []http.Response{
+		{StatusCode: 500, Body: body(`{"__type":"UnknownError","message":"An error occurred."}`)},
+		{StatusCode: 200, Body: body(`{"data":"valid"}`)},
+	}
+
+	defer func(f func(time.Duration)) { sleepDelay = f }(sleepDelay)
+	sleepDelay = func(time.Duration) {}
+
+	now := time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := NewService(&Config{MaxRetries: 2})
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.Init()
+	s.Handlers.Send.PushBack(func(r *Request) {
+		r.SetValue(attemptStartKey{}, now)
+		now = now.Add(time.Second)
+		r.HTTPResponse = &reqs[reqNum]
+		reqNum++
+	})
+
+	starts := []time.Time{}
+	s.Handlers.AfterRetry.PushBack(func(r *Request) {
+		starts = append(starts, r.GetValue(attemptStartKey{}).(time.Time))
+	})
+	s.Handlers.Unmarshal.PushBack(func(r *Request) {
+		starts = append(starts, r.GetValue(attemptStartKey{}).(time.Time))
+	})
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, &testData{})
+	assert.Nil(t, r.GetValue(attemptStartKey{}))
+	assert.NoError(t, r.Send())
+
+	assert.Equal(t, []time.Time{
+		time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2015, 1, 1, 0, 0, 1, 0, time.UTC),
+	}, starts)
+	assert.Nil(t, r.GetValue("missing"))
+
+	// copies of the request start with its values
+	c := r.Copy()
+	assert.Equal(t, time.Date(2015, 1, 1, 0, 0, 1, 0, time.UTC), c.GetValue(attemptStartKey{}))
+	c.SetValue(attemptStartKey{}, now)
+	assert.Equal(t, time.Date(2015, 1, 1, 0, 0, 1, 0, time.UTC), r.GetValue(attemptStartKey{}))
+}
+
+func TestRequestSetHeaderAndQueryParam(t *testing.T) {
+	s := NewService(&Config{Region: "mock-region", Endpoint: "https://example.com"})
+	s.Handlers.Build.PushBack(func(r *Request) {
+		// protocols replace the query and headers they serialize
+		r.HTTPRequest.URL.RawQuery = "member=value"
+		r.HTTPRequest.Header = http.Header{"X-Amz-Member": {"value"}}
+	})
+	var signedQuery url.Values
+	var signedHeader string
+	s.Handlers.Sign.PushBack(func(r *Request) {
+		signedQuery = r.HTTPRequest.URL.Query()
+		signedHeader = r.HTTPRequest.Header.Get("X-Trace-Id")
+	})
+
+	r := NewRequest(s, &Operation{Name: "Operation", HTTPMethod: "GET", HTTPPath: "/"}, nil, nil)
+	r.SetHeader("x-trace-id", "trace")
+	r.AddQueryParam("tag", "a")
+	r.AddQueryParam("tag", "b")
+	c := r.Copy()
+
+	for _, req := range []*Request{r, c} {
+		assert.NoError(t, req.Sign())
+		assert.Equal(t, "trace", signedHeader)
+		assert.Equal(t, []string{"a", "b"}, signedQuery["tag"])
+		assert.Equal(t, "value", signedQuery.Get("member"))
+		assert.Equal(t, "value", req.HTTPRequest.Header.Get("X-Amz-Member"))
+	}
+
+	r.SetHeader("X-Trace-Id", "other")
+	r.AddQueryParam("late", "1")
+	assert.NoError(t, r.Sign())
+	assert.Equal(t, "other", signedHeader)
+	assert.Equal(t, "1", signedQuery.Get("late"))
+	assert.Equal(t, "trace", c.HTTPRequest.Header.Get("X-Trace-Id"))
+}
+
+func TestRequestClock(t *testing.T) {
+	now := time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC)
+	s := NewService(&Config{Region: "mock-region", Clock: func() time.Time { return now }})
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	assert.Equal(t, now, r.Time)
+
+	r.ClockSkew = time.Minute
+	now = now.Add(time.Hour)
+	assert.NoError(t, r.resign())
+	assert.Equal(t, now.Add(time.Minute), r.Time)
+}
+
+func TestRequestRetryScheduledHandlers(t *testing.T) {
+	defer func(fn func(time.Duration)) { sleepDelay = fn }(sleepDelay)
+	slept := []time.Duration{}
+	sleepDelay = func(d time.Duration) { slept = append(slept, d) }
+
+	s := NewService(&Config{Region: "mock-region", MaxRetries: DEFAULT_RETRIES})
+	s.DefaultMaxRetries = 2
+	s.RetryRules = func(r *Request) time.Duration { return time.Duration(r.RetryCount+1) * time.Second }
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.SwapNamed(StubSendHandler(
+		StubResponse{StatusCode: 500, Body: `{"__type":"InternalError","message":"first"}`},
+		StubResponse{StatusCode: 503, Body: `{"__type":"ServiceUnavailable","message":"second"}`},
+		StubResponse{StatusCode: 200, Body: `{"data":"valid"}`},
+	))
+
+	retries, statuses, delays := []uint{}, []int{}, []time.Duration{}
+	s.Handlers.RetryScheduled.PushBack(func(r *Request) {
+		err := Error(r.Error)
+		retries = append(retries, r.RetryCount)
+		statuses = append(statuses, err.StatusCode)
+		delays = append(delays, err.RetryDelay)
+
+		// changes to the decision are discarded
+		r.Error = nil
+		r.RetryCount = 100
+	})
+
+	out := &testData{}
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, out)
+	assert.NoError(t, r.Send())
+	assert.Equal(t, "valid", out.Data)
+
+	assert.Equal(t, []uint{1, 2}, retries)
+	assert.Equal(t, []int{500, 503}, statuses)
+	assert.Equal(t, []time.Duration{1 * time.Second, 2 * time.Second}, delays)
+	assert.Equal(t, delays, slept)
+	assert.Equal(t, uint(2), r.RetryCount)
+}
+
+func TestRequestRetryScheduledNotRunWithoutRetry(t *testing.T) {
+	s := NewService(&Config{Region: "mock-region", MaxRetries: 0})
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.SwapNamed(StubSendHandler(
+		StubResponse{StatusCode: 500, Body: `{"__type":"InternalError","message":"failed"}`},
+	))
+
+	runs := 0
+	s.Handlers.RetryScheduled.PushBack(func(r *Request) { runs++ })
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	assert.Error(t, r.Send())
+	assert.Equal(t, 0, runs)
+}
@@ -0,0 +1,159 @@
+package aws
+
+import (
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// A Retryer provides the retry policy used by a Service when a request fails.
+type Retryer interface {
+	// MaxRetries returns the number of times a failed request will be
+	// retried before its error is returned.
+	MaxRetries() uint
+
+	// RetryRules returns the delay to wait before retrying the request.
+	RetryRules(*Request) time.Duration
+
+	// ShouldRetry returns whether the request's error can be retried.
+	ShouldRetry(*Request) bool
+}
+
+const (
+	// DefaultRetryerMinRetryDelay is the base delay of retries of transient
+	// errors when a DefaultRetryer's MinRetryDelay is not set.
+	DefaultRetryerMinRetryDelay = 30 * time.Millisecond
+
+	// DefaultRetryerMinThrottleDelay is the base delay of retries of
+	// throttled requests when a DefaultRetryer's MinThrottleDelay is not set.
+	DefaultRetryerMinThrottleDelay = 500 * time.Millisecond
+
+	// DefaultRetryerMaxRetryDelay caps the delay of retries when a
+	// DefaultRetryer's MaxRetryDelay is not set.
+	DefaultRetryerMaxRetryDelay = 20 * time.Second
+)
+
+// DefaultRetryer implements the SDK's default retry policy. Requests failing
+// with a 5xx status code, a throttling error, a retryable error code or a
+// connection error, such as a reset connection, are retried up to three
+// times. Other 4xx errors are not retried.
+//
+// The delay before each retry grows exponentially with the retry count from
+// a base delay, which is longer for throttled requests, up to a cap. The
+// delay applied is picked at random between zero and that bound, so that
+// clients throttled together do not retry in lockstep. Zero fields use the
+// DefaultRetryer constants.
+type DefaultRetryer struct {
+	MinRetryDelay    time.Duration
+	MinThrottleDelay time.Duration
+	MaxRetryDelay    time.Duration
+}
+
+// MaxRetries returns the default number of retries.
+func (d DefaultRetryer) MaxRetries() uint {
+	return 3
+}
+
+// RetryRules returns a random delay bounded by an exponential backoff of the
+// request's retry count.
+func (d DefaultRetryer) RetryRules(r *Request) time.Duration {
+	base := d.MinRetryDelay
+	if base == 0 {
+		base = DefaultRetryerMinRetryDelay
+	}
+	if isThrottleError(r.Error) {
+		base = d.MinThrottleDelay
+		if base == 0 {
+			base = DefaultRetryerMinThrottleDelay
+		}
+	}
+	max := d.MaxRetryDelay
+	if max == 0 {
+		max = DefaultRetryerMaxRetryDelay
+	}
+
+	bound := base
+	for i := uint(0); i < r.RetryCount && bound < max; i++ {
+		bound *= 2
+	}
+	if bound > max {
+		bound = max
+	}
+	return time.Duration(retryJitter(int64(bound)))
+}
+
+// ShouldRetry returns true for 5xx, throttling, retryable and connection
+// errors.
+func (d DefaultRetryer) ShouldRetry(r *Request) bool {
+	if isConnectionError(r.Error) {
+		return true
+	}
+
+	err := Error(r.Error)
+	if err == nil {
+		return false
+	}
+	if isThrottleError(r.Error) || retryableCodes[err.Code] {
+		return true
+	}
+	return err.StatusCode >= 500
+}
+
+// throttleCodes are the error codes returned for throttled requests.
+var throttleCodes = map[string]bool{
+	"Throttling":                             true,
+	"ThrottlingException":                    true,
+	"ThrottledException":                     true,
+	"RequestThrottledException":              true,
+	"TooManyRequestsException":               true,
+	"ProvisionedThroughputExceededException": true,
+	"RequestLimitExceeded":                   true,
+	"SlowDown":                               true,
+}
+
+// retryableCodes are the error codes of transient failures which are
+// retried regardless of the response's status code.
+var retryableCodes = map[string]bool{
+	"RequestTimeout":          true,
+	"RequestTimeoutException": true,
+}
+
+// isThrottleError returns whether err is an error for a throttled request,
+// identified by its code or a 429 or 503 status code.
+func isThrottleError(e error) bool {
+	err := Error(e)
+	if err == nil {
+		return false
+	}
+	return throttleCodes[err.Code] || err.StatusCode == 429 || err.StatusCode == 503
+}
+
+// isConnectionError returns whether err is a transient failure of the
+// connection a request was sent on, such as a connection reset by the server
+// or closed before the whole response was read.
+func isConnectionError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) || errors.Is(err, syscall.ECONNRESET) {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr)
+}
+
+var retryRand = struct {
+	sync.Mutex
+	*rand.Rand
+}{Rand: rand.New(rand.NewSource(time.Now().UnixNano()))}
+
+// retryJitter returns a random number between 0 and n, inclusive.
+var retryJitter = func(n int64) int64 {
+	retryRand.Lock()
+	defer retryRand.Unlock()
+	return retryRand.Int63n(n + 1)
+}
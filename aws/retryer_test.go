@@ -0,0 +1,165 @@
+package aws
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultRetryerShouldRetry(t *testing.T) {
+	cases := []struct {
+		err   error
+		retry bool
+	}{
+		{APIError{StatusCode: 500}, true},
+		{APIError{StatusCode: 503}, true},
+		{APIError{StatusCode: 429}, true},
+		{APIError{StatusCode: 400, Code: "Throttling"}, true},
+		{APIError{StatusCode: 400, Code: "ThrottlingException"}, true},
+		{APIError{StatusCode: 400, Code: "ProvisionedThroughputExceededException"}, true},
+		{APIError{StatusCode: 400, Code: "RequestTimeout"}, true},
+		{APIError{StatusCode: 400}, false},
+		{APIError{StatusCode: 403, Code: "AccessDenied"}, false},
+		{APIError{StatusCode: 400, Code: "ExpiredTokenException"}, false},
+		{nil, false},
+	}
+
+	for i, c := range cases {
+		r := &Request{Error: c.err}
+		assert.Equal(t, c.retry, DefaultRetryer{}.ShouldRetry(r), "case %d", i)
+	}
+}
+
+func TestDefaultRetryerRetryRules(t *testing.T) {
+	defer func(f func(int64) int64) { retryJitter = f }(retryJitter)
+	retryJitter = func(n int64) int64 { return n } // always the upper bound
+
+	d := DefaultRetryer{}
+	r := &Request{Error: APIError{StatusCode: 500}}
+
+	var last time.Duration
+	for i := uint(0); i < 12; i++ {
+		r.RetryCount = i
+		delay := d.RetryRules(r)
+		assert.True(t, delay >= last, "delay %d should not shrink, %s < %s", i, delay, last)
+		assert.True(t, delay <= DefaultRetryerMaxRetryDelay, "delay %d above cap: %s", i, delay)
+		last = delay
+	}
+	assert.Equal(t, DefaultRetryerMaxRetryDelay, last)
+
+	r.RetryCount = 0
+	assert.Equal(t, DefaultRetryerMinRetryDelay, d.RetryRules(r))
+	r.RetryCount = 2
+	assert.Equal(t, 4*DefaultRetryerMinRetryDelay, d.RetryRules(r))
+
+	// throttled requests back off from a longer base delay
+	r.Error = APIError{StatusCode: 400, Code: "Throttling"}
+	assert.Equal(t, 4*DefaultRetryerMinThrottleDelay, d.RetryRules(r))
+}
+
+func TestDefaultRetryerCustomDelays(t *testing.T) {
+	defer func(f func(int64) int64) { retryJitter = f }(retryJitter)
+	retryJitter = func(n int64) int64 { return n }
+
+	d := DefaultRetryer{MinRetryDelay: time.Second, MaxRetryDelay: 5 * time.Second}
+	r := &Request{Error: APIError{StatusCode: 500}, RetryCount: 2}
+	assert.Equal(t, 4*time.Second, d.RetryRules(r))
+	r.RetryCount = 3
+	assert.Equal(t, 5*time.Second, d.RetryRules(r))
+}
+
+func TestDefaultRetryerJitter(t *testing.T) {
+	d := DefaultRetryer{}
+	r := &Request{Error: APIError{StatusCode: 500}, RetryCount: 3}
+
+	for i := 0; i < 100; i++ {
+		delay := d.RetryRules(r)
+		assert.True(t, delay >= 0 && delay <= 8*DefaultRetryerMinRetryDelay, "delay out of bounds: %s", delay)
+	}
+}
+
+func TestDefaultRetryerShouldRetryConnectionErrors(t *testing.T) {
+	reset := &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}
+	cases := []struct {
+		err   error
+		retry bool
+	}{
+		{&url.Error{Op: "Post", URL: "https://example.com", Err: reset}, true},
+		{&url.Error{Op: "Post", URL: "https://example.com", Err: io.EOF}, true},
+		{io.ErrUnexpectedEOF, true},
+		{syscall.ECONNRESET, true},
+		{&net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}, true},
+		{&url.Error{Op: "Post", URL: "https://example.com", Err: errors.New("unsupported protocol scheme")}, false},
+		{errors.New("invalid request"), false},
+	}
+
+	for i, c := range cases {
+		r := &Request{Error: c.err}
+		assert.Equal(t, c.retry, DefaultRetryer{}.ShouldRetry(r), "case %d", i)
+	}
+}
+
+func TestRequestRetriesErrorCode(t *testing.T) {
+	defer func(fn func(time.Duration)) { sleepDelay = fn }(sleepDelay)
+	sleepDelay = func(time.Duration) {}
+
+	s := NewService(&Config{Region: "mock-region", MaxRetries: DEFAULT_RETRIES})
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.SwapNamed(StubSendHandler(
+		StubResponse{StatusCode: 400, Body: `{"__type":"ProvisionedThroughputExceededException","message":"slow down"}`},
+		StubResponse{StatusCode: 400, Body: `{"__type":"RequestTimeout","message":"timed out"}`},
+		StubResponse{StatusCode: 200, Body: `{"data":"valid"}`},
+	))
+
+	out := &testData{}
+	r := NewRequest(s, &Operation{Name: "PutItem"}, nil, out)
+	assert.NoError(t, r.Send())
+	assert.Equal(t, "valid", out.Data)
+	assert.Equal(t, uint(2), r.RetryCount)
+}
+
+func TestRequestDoesNotRetryClientErrorCode(t *testing.T) {
+	s := NewService(&Config{Region: "mock-region", MaxRetries: DEFAULT_RETRIES})
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.SwapNamed(StubSendHandler(
+		StubResponse{StatusCode: 400, Body: `{"__type":"ValidationException","message":"bad item"}`},
+	))
+
+	r := NewRequest(s, &Operation{Name: "PutItem"}, nil, nil)
+	err := r.Send()
+	assert.Error(t, err)
+	assert.Equal(t, "ValidationException", Error(err).Code)
+	assert.Equal(t, 400, Error(err).StatusCode)
+	assert.Equal(t, uint(0), r.RetryCount)
+}
+
+func TestRequestThrottleDelayFromErrorCode(t *testing.T) {
+	defer func(fn func(time.Duration)) { sleepDelay = fn }(sleepDelay)
+	defer func(f func(int64) int64) { retryJitter = f }(retryJitter)
+	retryJitter = func(n int64) int64 { return n } // always the upper bound
+
+	delays := []time.Duration{}
+	sleepDelay = func(d time.Duration) { delays = append(delays, d) }
+
+	s := NewService(&Config{Region: "mock-region", MaxRetries: DEFAULT_RETRIES})
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	s.Handlers.Send.SwapNamed(StubSendHandler(
+		StubResponse{StatusCode: 400, Body: `{"__type":"ThrottlingException","message":"Rate exceeded"}`},
+		StubResponse{StatusCode: 400, Body: `{"__type":"RequestTimeout","message":"timed out"}`},
+		StubResponse{StatusCode: 200, Body: `{}`},
+	))
+
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	assert.NoError(t, r.Send())
+
+	// the throttled attempt backs off from the longer throttle delay
+	assert.Equal(t, []time.Duration{DefaultRetryerMinThrottleDelay, 2 * DefaultRetryerMinRetryDelay}, delays)
+}
@@ -0,0 +1,148 @@
+package aws
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+// sensitivePlaceholder replaces the values of members with the sensitive
+// trait, such as passwords and secret keys, in logged requests.
+const sensitivePlaceholder = "<sensitive>"
+
+// redactSensitiveLocations replaces the headers, query parameters and path
+// labels of req which the sensitive members of params are serialized in, per
+// their location traits, with sensitivePlaceholder. As the body of a request with
+// sensitive members is not logged, only these locations remain to redact.
+func redactSensitiveLocations(req *http.Request, params interface{}) {
+	v := reflect.Indirect(reflect.ValueOf(params))
+	if v.Kind() != reflect.Struct {
+		return
+	}
+
+	u := *req.URL
+	query := u.Query()
+	redactedURL, redactedQuery := false, false
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" || f.Tag.Get("sensitive") != "true" {
+			continue
+		}
+		name := f.Tag.Get("locationName")
+		if name == "" {
+			name = f.Name
+		}
+
+		switch f.Tag.Get("location") {
+		case "header":
+			if req.Header.Get(name) != "" {
+				req.Header.Set(name, sensitivePlaceholder)
+			}
+		case "headers":
+			for k := range req.Header {
+				if strings.HasPrefix(strings.ToLower(k), strings.ToLower(name)) {
+					req.Header.Set(k, sensitivePlaceholder)
+				}
+			}
+		case "querystring":
+			if _, ok := query[name]; ok {
+				query.Set(name, sensitivePlaceholder)
+				redactedQuery = true
+			}
+		case "uri":
+			if value := sensitiveValue(v.Field(i)); value != "" {
+				// the label may be in the path as it is or escaped, and in
+				// the path of the opaque URI of a built REST request
+				host, opaquePath := splitOpaque(u.Opaque)
+				for _, s := range []string{value, escapeLabel(value, true), escapeLabel(value, false)} {
+					u.Path = strings.Replace(u.Path, s, sensitivePlaceholder, -1)
+					opaquePath = strings.Replace(opaquePath, s, sensitivePlaceholder, -1)
+				}
+				u.Opaque = host + opaquePath
+				redactedURL = true
+			}
+		}
+	}
+
+	if redactedQuery {
+		u.RawQuery = strings.Replace(query.Encode(), url.QueryEscape(sensitivePlaceholder), sensitivePlaceholder, -1)
+	}
+	if redactedURL || redactedQuery {
+		req.URL = &u
+	}
+}
+
+// sensitiveValue returns the string form of the path label member v, or ""
+// if it is not set.
+func sensitiveValue(v reflect.Value) string {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.String, reflect.Bool, reflect.Int64, reflect.Float64:
+		return fmt.Sprint(v.Interface())
+	}
+	return ""
+}
+
+// splitOpaque splits an opaque URI of the form "//host/path" into its host
+// and path parts.
+func splitOpaque(opaque string) (string, string) {
+	if !strings.HasPrefix(opaque, "//") {
+		return "", opaque
+	}
+	if i := strings.Index(opaque[2:], "/"); i >= 0 {
+		return opaque[:i+2], opaque[i+2:]
+	}
+	return opaque, ""
+}
+
+// escapeLabel escapes a path label as REST protocols do, escaping every
+// character except unreserved ones, and slashes unless encodeSep is set.
+func escapeLabel(label string, encodeSep bool) string {
+	var buf bytes.Buffer
+	for i := 0; i < len(label); i++ {
+		c := label[i]
+		if (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') ||
+			c == '-' || c == '.' || c == '_' || c == '~' || (c == '/' && !encodeSep) {
+			buf.WriteByte(c)
+		} else {
+			fmt.Fprintf(&buf, "%%%02X", c)
+		}
+	}
+	return buf.String()
+}
+
+// hasSensitiveMembers returns whether the shape t, or one nested in it, has
+// a member with the sensitive trait.
+func hasSensitiveMembers(t reflect.Type) bool {
+	return hasSensitive(t, map[reflect.Type]bool{})
+}
+
+func hasSensitive(t reflect.Type, seen map[reflect.Type]bool) bool {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Map {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || seen[t] {
+		return false
+	}
+	seen[t] = true
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+		if f.Tag.Get("sensitive") == "true" || hasSensitive(f.Type, seen) {
+			return true
+		}
+	}
+	return false
+}
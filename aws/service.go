@@ -2,12 +2,14 @@ package aws
 
 import (
 	"fmt"
-	"math"
 	"net/http"
 	"net/http/httputil"
+	"reflect"
 	"regexp"
 	"time"
 
+	"github.com/awslabs/aws-sdk-go/aws/awserr"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 	"github.com/awslabs/aws-sdk-go/internal/endpoints"
 )
 
@@ -18,56 +20,155 @@ type Service struct {
 	ServiceName       string
 	APIVersion        string
 	Endpoint          string
+	SigningRegion     string
 	JSONVersion       string
 	TargetPrefix      string
+	Retryer           Retryer
 	RetryRules        func(*Request) time.Duration
 	ShouldRetry       func(*Request) bool
 	DefaultMaxRetries uint
+
+	// ContentLengthRequired fails requests whose body is not seekable and
+	// whose Content-Length is not set, rather than sending them chunked, for
+	// services which do not accept chunked transfer encoding.
+	ContentLengthRequired bool
 }
 
 var schemeRE = regexp.MustCompile("^([^:]+)://")
 
+// now returns the current time of the service's Config's Clock, which its
+// requests are signed at.
+func (s *Service) now() time.Time {
+	if s.Config != nil && s.Config.Clock != nil {
+		return s.Config.Clock()
+	}
+	return currentTime()
+}
+
 func NewService(config *Config) *Service {
 	svc := &Service{Config: config}
 	svc.Initialize()
 	return svc
 }
 
+// Initialize sets up the service's default handlers, endpoint and retry
+// policy. A Retryer set on the service before it is initialized, such as a
+// client's policy tuned to its service, replaces the DefaultRetryer. A
+// Retryer set on the Config takes precedence over both.
 func (s *Service) Initialize() {
 	if s.Config == nil {
 		s.Config = &Config{}
 	}
-	if s.Config.HTTPClient == nil {
-		s.Config.HTTPClient = http.DefaultClient
+	if s.Config.Retryer != nil {
+		s.Retryer = s.Config.Retryer
+	} else if s.Retryer == nil {
+		s.Retryer = DefaultRetryer{}
 	}
 
 	if s.RetryRules == nil {
-		s.RetryRules = retryRules
+		s.RetryRules = s.Retryer.RetryRules
 	}
 
 	if s.ShouldRetry == nil {
-		s.ShouldRetry = shouldRetry
+		s.ShouldRetry = s.Retryer.ShouldRetry
 	}
 
-	s.DefaultMaxRetries = 3
-	s.Handlers.Build.PushBack(UserAgentHandler)
-	s.Handlers.Sign.PushBack(BuildContentLength)
-	s.Handlers.Send.PushBack(SendHandler)
-	s.Handlers.AfterRetry.PushBack(AfterRetryHandler)
-	s.Handlers.ValidateResponse.PushBack(ValidateResponseHandler)
+	s.DefaultMaxRetries = s.Retryer.MaxRetries()
+	s.Handlers.Build.PushBackNamed(NamedHandler{"aws.UserAgentHandler", UserAgentHandler})
+	s.Handlers.Build.PushBackNamed(NamedHandler{"aws.HostPrefixHandler", HostPrefixHandler})
+	s.Handlers.Sign.PushBackNamed(NamedHandler{"aws.BuildContentLength", BuildContentLength})
+	s.Handlers.Send.PushBackNamed(NamedHandler{"aws.SendHandler", SendHandler})
+	s.Handlers.AfterRetry.PushBackNamed(NamedHandler{"aws.AfterRetryHandler", AfterRetryHandler})
+	s.Handlers.ValidateResponse.PushBackNamed(NamedHandler{"aws.ValidateResponseHandler", ValidateResponseHandler})
 	s.AddDebugHandlers()
+
+	// fall back to the region of the EC2 instance the client runs on, set in
+	// the service's own copy of the Config, as the caller's may be shared
+	if s.Config.Region == "" && s.Config.Endpoint == "" {
+		cfg := *s.Config
+		cfg.Region = EC2MetadataRegion()
+		s.Config = &cfg
+	}
 	s.buildEndpoint()
 
-	if !s.Config.DisableParamValidation {
-		s.Handlers.Validate.PushBack(ValidateParameters)
+	// tokens are filled before validation, as they may be required
+	s.Handlers.Validate.PushBackNamed(NamedHandler{"aws.IdempotencyTokenHandler", IdempotencyTokenHandler})
+
+	// validation may be re-enabled for a single request, so the handler is
+	// always installed and checks the Config itself
+	s.Handlers.Validate.PushBackNamed(NamedHandler{"aws.ValidateParameters", ValidateParameters})
+
+	// checksums are computed from the body as sent, before decompression
+	if s.Config.ValidateResponseChecksums {
+		s.Handlers.UnmarshalMeta.PushBackNamed(NamedHandler{"aws.ChecksumResponseHandler", ChecksumResponseHandler})
+	}
+
+	if s.Config.DecompressGzipResponses {
+		s.Handlers.UnmarshalMeta.PushBackNamed(NamedHandler{"aws.GzipResponseHandler", GzipResponseHandler})
+	}
+
+	// the size is bounded after decompression, as that is what is buffered
+	if s.Config.MaxResponseBodySize > 0 {
+		s.Handlers.UnmarshalMeta.PushBackNamed(NamedHandler{"aws.MaxResponseBodySizeHandler", MaxResponseBodySizeHandler})
+	}
+
+	// bodies are saved as the unmarshalers read them, after decompression
+	if s.Config.SaveResponseBody {
+		s.Handlers.UnmarshalMeta.PushBackNamed(NamedHandler{"aws.SaveResponseBodyHandler", SaveResponseBodyHandler})
+	}
+
+	// bodies are compressed before their length is computed and they are
+	// hashed by the signer
+	if s.Config.RequestCompressionMinSize > 0 {
+		s.Handlers.Sign.PushFrontNamed(NamedHandler{"aws.GzipRequestHandler", GzipRequestHandler})
+	}
+
+	// the header is set from the length of the body, and before it is signed
+	if s.Config.ExpectContinueMinSize > 0 {
+		s.Handlers.Sign.PushBackNamed(NamedHandler{"aws.ExpectContinueHandler", ExpectContinueHandler})
+	}
+
+	// tokens are taken before each attempt is logged and sent
+	if s.Config.RateLimiter != nil {
+		s.Handlers.Send.PushFrontNamed(NamedHandler{"aws.RateLimitHandler", RateLimitHandler})
+		s.Handlers.ValidateResponse.PushBackNamed(NamedHandler{"aws.RateLimitReleaseHandler", RateLimitReleaseHandler})
+		s.Handlers.Retry.PushFrontNamed(NamedHandler{"aws.RateLimitThrottleHandler", RateLimitThrottleHandler})
+	}
+
+	// attempts are recorded whether or not they could be sent, and retries
+	// once AfterRetryHandler has decided to retry
+	if s.Config.MetricsCollector != nil {
+		s.Handlers.Send.PushBackNamed(NamedHandler{"aws.MetricsAttemptHandler", MetricsAttemptHandler})
+		s.Handlers.AfterRetry.PushBackNamed(NamedHandler{"aws.MetricsRetryHandler", MetricsRetryHandler})
+	}
+
+	// each attempt is traced by a trace of its own
+	if s.Config.ClientTrace != nil {
+		s.Handlers.Send.PushFrontNamed(NamedHandler{"aws.ClientTraceHandler", ClientTraceHandler})
+	}
+
+	if !s.Config.DisableClockSkewCorrection {
+		s.Handlers.Retry.PushBackNamed(NamedHandler{"aws.ClockSkewHandler", ClockSkewHandler})
 	}
 }
 
 func (s *Service) buildEndpoint() {
 	if s.Config.Endpoint != "" {
 		s.Endpoint = s.Config.Endpoint
+	} else if s.Config.EndpointResolver != nil {
+		endpoint, signingRegion, err := s.Config.EndpointResolver(s.ServiceName, s.Config.Region)
+		if err != nil {
+			s.failRequests("aws.EndpointResolutionError", awserr.New("EndpointResolutionError", fmt.Sprintf(
+				"failed to resolve the endpoint of %s in %s", s.ServiceName, s.Config.Region), err))
+			return
+		}
+		s.Endpoint, s.SigningRegion = endpoint, signingRegion
+	} else if s.Config.UseDualStack {
+		s.Endpoint = endpoints.DualStackEndpointForRegion(s.ServiceName, s.Config.Region)
+		s.SigningRegion = endpoints.SigningRegion(s.ServiceName, s.Config.Region)
 	} else {
 		s.Endpoint = endpoints.EndpointForRegion(s.ServiceName, s.Config.Region)
+		s.SigningRegion = endpoints.SigningRegion(s.ServiceName, s.Config.Region)
 	}
 
 	if !schemeRE.MatchString(s.Endpoint) {
@@ -77,66 +178,126 @@ func (s *Service) buildEndpoint() {
 		}
 		s.Endpoint = scheme + "://" + s.Endpoint
 	}
+
+	if s.Config.EndpointValidator != nil {
+		if err := s.Config.EndpointValidator(s.Endpoint); err != nil {
+			s.failRequests("aws.InvalidEndpoint", awserr.New("InvalidEndpoint", fmt.Sprintf(
+				"endpoint %s of %s is not allowed", s.Endpoint, s.ServiceName), err))
+		}
+	}
+}
+
+// failRequests fails the service's requests with err before they are sent.
+func (s *Service) failRequests(name string, err error) {
+	s.Handlers.Validate.PushFrontNamed(NamedHandler{name, func(r *Request) {
+		r.Error = err
+	}})
 }
 
+// redactedHeaders are the request headers whose values are only logged at
+// the LogDebugWithSigning level.
+var redactedHeaders = []string{"Authorization", "X-Amz-Security-Token"}
+
+// AddDebugHandlers adds the handlers logging requests and responses at the
+// Config's LogLevel to the Config's Logger.
 func (s *Service) AddDebugHandlers() {
-	out := s.Config.Logger
-	if s.Config.LogLevel == 0 {
+	if s.Config.LogLevel == LogOff || s.Config.Logger == nil {
 		return
 	}
 
-	s.Handlers.Sign.PushBack(func(r *Request) {
-		dumpedBody, _ := httputil.DumpRequest(r.HTTPRequest, true)
-
-		fmt.Fprintf(out, "=> [%s] %s.%s(%+v)\n", r.Time,
-			r.Service.ServiceName, r.Operation.Name, r.Params)
-		fmt.Fprintf(out, "---[ REQUEST PRE-SIGN ]------------------------------\n")
-		fmt.Fprintf(out, "%s\n", string(dumpedBody))
-		fmt.Fprintf(out, "-----------------------------------------------------\n")
-	})
-	s.Handlers.Send.PushFront(func(r *Request) {
-		dumpedBody, _ := httputil.DumpRequest(r.HTTPRequest, true)
-
-		fmt.Fprintf(out, "---[ REQUEST POST-SIGN ]-----------------------------\n")
-		fmt.Fprintf(out, "%s\n", string(dumpedBody))
-		fmt.Fprintf(out, "-----------------------------------------------------\n")
-	})
-	s.Handlers.Send.PushBack(func(r *Request) {
-		fmt.Fprintf(out, "---[ RESPONSE ]--------------------------------------\n")
-		if r.HTTPResponse != nil {
-			dumpedBody, _ := httputil.DumpResponse(r.HTTPResponse, true)
-			fmt.Fprintf(out, "%s\n", string(dumpedBody))
-		} else if r.Error != nil {
-			fmt.Fprintf(out, "%s\n", r.Error)
-		}
-		fmt.Fprintf(out, "-----------------------------------------------------\n")
-	})
+	s.Handlers.Send.PushFrontNamed(NamedHandler{"aws.LogRequestHandler", logRequest})
+	s.Handlers.Send.PushBackNamed(NamedHandler{"aws.LogResponseHandler", logResponse})
 }
 
-func (s *Service) MaxRetries() uint {
-	if s.Config.MaxRetries < 0 {
-		return s.DefaultMaxRetries
-	} else {
-		return uint(s.Config.MaxRetries)
+func logRequest(r *Request) {
+	level, logger := r.Service.Config.LogLevel, r.Service.Config.Logger
+	withBody := level.Matches(LogDebugWithHTTPBody)
+
+	req := *r.HTTPRequest
+	req.Header = http.Header{}
+	for k, v := range r.HTTPRequest.Header {
+		req.Header[k] = v
+	}
+	if !level.Matches(LogDebugWithSigning) {
+		for _, h := range redactedHeaders {
+			if req.Header.Get(h) != "" {
+				req.Header.Set(h, "<redacted>")
+			}
+		}
 	}
+
+	// the serialized body of params with sensitive members is not logged,
+	// but the params are, as a string with those members redacted
+	sensitive := r.Params != nil && hasSensitiveMembers(reflect.TypeOf(r.Params))
+	if sensitive {
+		redactSensitiveLocations(&req, r.Params)
+	}
+
+	dumped, err := httputil.DumpRequestOut(&req, withBody && !sensitive)
+	if withBody && !sensitive {
+		// the dump replaces the copy's body with one that can be read again
+		r.HTTPRequest.Body = req.Body
+	}
+	if err != nil {
+		logger.Log(fmt.Sprintf("DEBUG: failed to dump request %s/%s: %v",
+			r.Service.ServiceName, r.Operation.Name, err))
+		return
+	}
+	if withBody && sensitive {
+		dumped = append(dumped, awsutil.StringValue(r.Params)...)
+	}
+
+	logger.Log(fmt.Sprintf("DEBUG: Request %s/%s Details:\n"+
+		"---[ REQUEST ]---------------------------------------\n%s\n"+
+		"-----------------------------------------------------",
+		r.Service.ServiceName, r.Operation.Name, string(dumped)))
 }
 
-func retryRules(r *Request) time.Duration {
-	delay := time.Duration(math.Pow(2, float64(r.RetryCount))) * 30
-	return delay * time.Millisecond
+func logResponse(r *Request) {
+	level, logger := r.Service.Config.LogLevel, r.Service.Config.Logger
+
+	if r.HTTPResponse == nil {
+		logger.Log(fmt.Sprintf("DEBUG: Response %s/%s failed: %v",
+			r.Service.ServiceName, r.Operation.Name, r.Error))
+		return
+	}
+
+	// the values of the output's sensitive members are only known once the
+	// body is unmarshaled, so bodies which may hold them are not logged
+	withBody := level.Matches(LogDebugWithHTTPBody)
+	sensitive := withBody && r.Data != nil && hasSensitiveMembers(reflect.TypeOf(r.Data))
+
+	dumped, err := httputil.DumpResponse(r.HTTPResponse, withBody && !sensitive)
+	if err != nil {
+		logger.Log(fmt.Sprintf("DEBUG: failed to dump response %s/%s: %v",
+			r.Service.ServiceName, r.Operation.Name, err))
+		return
+	}
+	if sensitive {
+		dumped = append(dumped, sensitivePlaceholder...)
+	}
+
+	logger.Log(fmt.Sprintf("DEBUG: Response %s/%s Details:\n"+
+		"---[ RESPONSE ]--------------------------------------\n%s\n"+
+		"-----------------------------------------------------",
+		r.Service.ServiceName, r.Operation.Name, string(dumped)))
 }
 
-func shouldRetry(r *Request) bool {
-	if err := Error(r.Error); err != nil {
-		if err.StatusCode >= 500 {
-			return true
-		}
+// HTTPClient returns the HTTP client requests are sent with: the Config's
+// HTTPClient when set, DefaultHTTPClient otherwise.
+func (s *Service) HTTPClient() *http.Client {
+	if s.Config.HTTPClient != nil {
+		return s.Config.HTTPClient
+	}
+	return DefaultHTTPClient
+}
 
-		switch err.Code {
-		case "ExpiredTokenException":
-		case "ProvisionedThroughputExceededException", "Throttling":
-			return true
-		}
+// MaxRetries returns the number of times a failed request will be retried.
+// A Retryer set on the Config takes precedence over Config.MaxRetries.
+func (s *Service) MaxRetries() uint {
+	if s.Config.MaxRetries < 0 || s.Config.Retryer != nil {
+		return s.DefaultMaxRetries
+	} else {
+		return uint(s.Config.MaxRetries)
 	}
-	return false
 }
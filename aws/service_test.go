@@ -0,0 +1,203 @@
+package aws
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+func TestServiceEndpointResolved(t *testing.T) {
+	s := &Service{ServiceName: "dynamodb", Config: &Config{Region: "us-west-2"}}
+	s.Initialize()
+
+	if e, a := "https://dynamodb.us-west-2.amazonaws.com", s.Endpoint; e != a {
+		t.Errorf("expected endpoint %s, got %s", e, a)
+	}
+}
+
+func TestServiceEndpointOverride(t *testing.T) {
+	s := &Service{ServiceName: "dynamodb", Config: &Config{
+		Region:   "cn-north-1",
+		Endpoint: "localhost:8000",
+	}}
+	s.Initialize()
+
+	if e, a := "https://localhost:8000", s.Endpoint; e != a {
+		t.Errorf("expected endpoint %s, got %s", e, a)
+	}
+
+	s = &Service{ServiceName: "dynamodb", Config: &Config{
+		Region:   "us-west-2",
+		Endpoint: "http://localhost:8000",
+	}}
+	s.Initialize()
+
+	if e, a := "http://localhost:8000", s.Endpoint; e != a {
+		t.Errorf("expected endpoint %s, got %s", e, a)
+	}
+}
+
+func TestServiceEndpointDisableSSL(t *testing.T) {
+	s := &Service{ServiceName: "dynamodb", Config: &Config{Region: "us-west-2", DisableSSL: true}}
+	s.Initialize()
+
+	if e, a := "http://dynamodb.us-west-2.amazonaws.com", s.Endpoint; e != a {
+		t.Errorf("expected endpoint %s, got %s", e, a)
+	}
+
+	s = &Service{ServiceName: "dynamodb", Config: &Config{
+		Region:     "us-west-2",
+		Endpoint:   "localhost:8000",
+		DisableSSL: true,
+	}}
+	s.Initialize()
+
+	if e, a := "http://localhost:8000", s.Endpoint; e != a {
+		t.Errorf("expected endpoint %s, got %s", e, a)
+	}
+
+	s = &Service{ServiceName: "dynamodb", Config: &Config{
+		Region:     "us-west-2",
+		Endpoint:   "https://localhost:8000",
+		DisableSSL: true,
+	}}
+	s.Initialize()
+
+	if e, a := "https://localhost:8000", s.Endpoint; e != a {
+		t.Errorf("expected endpoint %s, got %s", e, a)
+	}
+}
+
+func fipsResolver(service, region string) (string, string, error) {
+	if region != "fips-us-gov-west-1" {
+		return "", "", errors.New("unknown region " + region)
+	}
+	return "https://" + service + "-fips.us-gov-west-1.amazonaws.com", "us-gov-west-1", nil
+}
+
+func TestServiceEndpointResolver(t *testing.T) {
+	s := &Service{ServiceName: "dynamodb", Config: &Config{
+		Region:           "fips-us-gov-west-1",
+		EndpointResolver: fipsResolver,
+	}}
+	s.Initialize()
+
+	if e, a := "https://dynamodb-fips.us-gov-west-1.amazonaws.com", s.Endpoint; e != a {
+		t.Errorf("expected endpoint %s, got %s", e, a)
+	}
+	if e, a := "us-gov-west-1", s.SigningRegion; e != a {
+		t.Errorf("expected signing region %s, got %s", e, a)
+	}
+
+	s = &Service{ServiceName: "dynamodb", Config: &Config{
+		Region:           "fips-us-gov-west-1",
+		Endpoint:         "https://localhost:8000",
+		EndpointResolver: fipsResolver,
+	}}
+	s.Initialize()
+
+	if e, a := "https://localhost:8000", s.Endpoint; e != a {
+		t.Errorf("expected endpoint %s, got %s", e, a)
+	}
+}
+
+func TestServiceEndpointResolverError(t *testing.T) {
+	s := &Service{ServiceName: "dynamodb", Config: &Config{
+		Region:           "us-west-2",
+		EndpointResolver: fipsResolver,
+	}}
+	s.Initialize()
+
+	err := NewRequest(s, &Operation{Name: "Operation"}, nil, nil).Send()
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+	if e, a := "EndpointResolutionError", Error(err).Code; e != a {
+		t.Errorf("expected %s error, got %s", e, a)
+	}
+}
+
+func TestServiceEndpointDualStack(t *testing.T) {
+	s := &Service{ServiceName: "s3", Config: &Config{Region: "us-east-1", UseDualStack: true}}
+	s.Initialize()
+
+	if e, a := "https://s3.dualstack.us-east-1.amazonaws.com", s.Endpoint; e != a {
+		t.Errorf("expected endpoint %s, got %s", e, a)
+	}
+
+	s = &Service{ServiceName: "sqs", Config: &Config{Region: "us-east-1", UseDualStack: true}}
+	s.Initialize()
+
+	if e, a := "https://sqs.us-east-1.amazonaws.com", s.Endpoint; e != a {
+		t.Errorf("expected endpoint %s, got %s", e, a)
+	}
+}
+
+type recordingTransport struct {
+	requests int
+}
+
+func (t *recordingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	t.requests++
+	return &http.Response{
+		StatusCode: 200,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+	}, nil
+}
+
+func TestServiceCustomHTTPClient(t *testing.T) {
+	transport := &recordingTransport{}
+	custom := &Service{ServiceName: "mock", Config: &Config{
+		Region:      "mock-region",
+		Credentials: DetectCreds("AKID", "SECRET", ""),
+		HTTPClient:  &http.Client{Transport: transport},
+	}}
+	custom.Initialize()
+	other := &Service{ServiceName: "mock", Config: &Config{Region: "mock-region"}}
+	other.Initialize()
+
+	r := NewRequest(custom, &Operation{Name: "Operation"}, nil, nil)
+	if err := r.Send(); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if transport.requests != 1 {
+		t.Errorf("expected the custom transport to send 1 request, got %d", transport.requests)
+	}
+	if other.HTTPClient() != DefaultHTTPClient {
+		t.Errorf("expected other services to keep using the default client")
+	}
+	if DefaultHTTPClient.Transport == transport {
+		t.Errorf("expected the default client to be left unchanged")
+	}
+}
+
+func TestServiceEndpointValidator(t *testing.T) {
+	s := &Service{ServiceName: "dynamodb", Config: &Config{
+		Region:            "us-west-2",
+		EndpointValidator: EndpointDomainValidator(),
+	}}
+	s.Initialize()
+
+	if e, a := "https://dynamodb.us-west-2.amazonaws.com", s.Endpoint; e != a {
+		t.Errorf("expected endpoint %s, got %s", e, a)
+	}
+
+	s = &Service{ServiceName: "dynamodb", Config: &Config{
+		Region:            "us-west-2",
+		Endpoint:          "http://169.254.169.254/latest/meta-data",
+		EndpointValidator: EndpointDomainValidator(),
+	}}
+	s.Initialize()
+
+	err := NewRequest(s, &Operation{Name: "Operation"}, nil, nil).Send()
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+	if e, a := "InvalidEndpoint", Error(err).Code; e != a {
+		t.Errorf("expected %s error, got %s", e, a)
+	}
+}
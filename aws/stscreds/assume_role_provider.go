@@ -0,0 +1,132 @@
+// Package stscreds provides credential providers backed by the AWS Security
+// Token Service.
+package stscreds
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/service/sts"
+)
+
+var currentTime = time.Now
+
+// AssumeRoler represents the subset of the STS client used by the
+// AssumeRoleProvider.
+type AssumeRoler interface {
+	AssumeRole(input *sts.AssumeRoleInput) (*sts.AssumeRoleOutput, error)
+}
+
+// DefaultDuration is the default amount of time assumed role credentials are
+// valid for.
+const DefaultDuration = 15 * time.Minute
+
+// An AssumeRoleProvider retrieves temporary credentials by assuming an IAM
+// role with STS. Credentials are cached until they are within ExpiryWindow
+// of their expiration.
+//
+//	creds := &stscreds.AssumeRoleProvider{
+//	    RoleARN: "arn:aws:iam::123456789012:role/example",
+//	}
+//	svc := s3.New(&aws.Config{Credentials: creds})
+type AssumeRoleProvider struct {
+	// STS client used to assume the role. Defaults to a client created with
+	// the default config.
+	Client AssumeRoler
+
+	// Role to be assumed.
+	RoleARN string
+
+	// Session name, if you wish to reuse the credentials elsewhere.
+	// Defaults to a name derived from the current time.
+	RoleSessionName string
+
+	// Optional external ID to pass to the AssumeRole API call.
+	ExternalID *string
+
+	// Expiry duration of the STS credentials. Defaults to DefaultDuration.
+	Duration time.Duration
+
+	// ExpiryWindow refreshes credentials this long before they actually
+	// expire, so that in-flight requests are not signed with credentials
+	// which are about to become invalid. Defaults to
+	// aws.DefaultExpiryWindow. A negative window uses credentials until
+	// their actual expiration.
+	ExpiryWindow time.Duration
+
+	creds      aws.Credentials
+	m          sync.Mutex
+	expiration time.Time
+}
+
+// Credentials returns the cached role credentials, assuming the role again
+// if they have expired.
+func (p *AssumeRoleProvider) Credentials() (*aws.Credentials, error) {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	if !p.isExpired() {
+		creds := p.creds
+		return &creds, nil
+	}
+
+	if p.Client == nil {
+		p.Client = sts.New(nil)
+	}
+	if p.RoleSessionName == "" {
+		p.RoleSessionName = fmt.Sprintf("%d", currentTime().UnixNano())
+	}
+	if p.Duration == 0 {
+		p.Duration = DefaultDuration
+	}
+
+	resp, err := p.Client.AssumeRole(&sts.AssumeRoleInput{
+		DurationSeconds: aws.Long(int64(p.Duration / time.Second)),
+		RoleARN:         aws.String(p.RoleARN),
+		RoleSessionName: aws.String(p.RoleSessionName),
+		ExternalID:      p.ExternalID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Credentials == nil {
+		return nil, fmt.Errorf("no credentials returned assuming role %s", p.RoleARN)
+	}
+
+	c := resp.Credentials
+	p.creds = aws.Credentials{
+		AccessKeyID:     stringValue(c.AccessKeyID),
+		SecretAccessKey: stringValue(c.SecretAccessKey),
+		SessionToken:    stringValue(c.SessionToken),
+	}
+	if c.Expiration != nil {
+		p.expiration = *c.Expiration
+	} else {
+		p.expiration = time.Time{}
+	}
+
+	creds := p.creds
+	return &creds, nil
+}
+
+// IsExpired returns whether the cached credentials are expired, or within the
+// provider's ExpiryWindow of expiring.
+func (p *AssumeRoleProvider) IsExpired() bool {
+	p.m.Lock()
+	defer p.m.Unlock()
+
+	return p.isExpired()
+}
+
+func (p *AssumeRoleProvider) isExpired() bool {
+	return aws.ExpiredWithin(p.expiration, currentTime(), p.ExpiryWindow)
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,126 @@
+package stscreds
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/service/sts"
+	"github.com/stretchr/testify/assert"
+)
+
+type stubSTS struct {
+	calls  int
+	inputs []*sts.AssumeRoleInput
+	err    error
+}
+
+func (s *stubSTS) AssumeRole(input *sts.AssumeRoleInput) (*sts.AssumeRoleOutput, error) {
+	s.calls++
+	s.inputs = append(s.inputs, input)
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	expiry := time.Date(2015, 1, 1, 1, 0, 0, 0, time.UTC)
+	return &sts.AssumeRoleOutput{
+		Credentials: &sts.Credentials{
+			AccessKeyID:     aws.String("accessKey"),
+			SecretAccessKey: aws.String("secret"),
+			SessionToken:    aws.String("token"),
+			Expiration:      &expiry,
+		},
+	}, nil
+}
+
+func setTime(t time.Time) {
+	currentTime = func() time.Time { return t }
+}
+
+func TestAssumeRoleProvider(t *testing.T) {
+	setTime(time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC))
+	stub := &stubSTS{}
+	p := &AssumeRoleProvider{
+		Client:          stub,
+		RoleARN:         "roleARN",
+		RoleSessionName: "session",
+		ExternalID:      aws.String("external"),
+		Duration:        time.Hour,
+	}
+
+	assert.True(t, p.IsExpired())
+	creds, err := p.Credentials()
+	assert.NoError(t, err)
+	assert.Equal(t, "accessKey", creds.AccessKeyID)
+	assert.Equal(t, "secret", creds.SecretAccessKey)
+	assert.Equal(t, "token", creds.SessionToken)
+	assert.False(t, p.IsExpired())
+
+	input := stub.inputs[0]
+	assert.Equal(t, "roleARN", *input.RoleARN)
+	assert.Equal(t, "session", *input.RoleSessionName)
+	assert.Equal(t, "external", *input.ExternalID)
+	assert.Equal(t, int64(3600), *input.DurationSeconds)
+
+	// cached until expiry
+	_, err = p.Credentials()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, stub.calls)
+}
+
+func TestAssumeRoleProviderExpiryWindow(t *testing.T) {
+	setTime(time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC))
+	stub := &stubSTS{}
+	p := &AssumeRoleProvider{
+		Client:       stub,
+		RoleARN:      "roleARN",
+		ExpiryWindow: 5 * time.Minute,
+	}
+
+	_, err := p.Credentials()
+	assert.NoError(t, err)
+	assert.NotEqual(t, "", *stub.inputs[0].RoleSessionName)
+	assert.Equal(t, int64(900), *stub.inputs[0].DurationSeconds)
+
+	setTime(time.Date(2015, 1, 1, 0, 54, 59, 0, time.UTC))
+	assert.False(t, p.IsExpired())
+
+	setTime(time.Date(2015, 1, 1, 0, 55, 0, 0, time.UTC))
+	assert.True(t, p.IsExpired())
+
+	_, err = p.Credentials()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, stub.calls)
+}
+
+func TestAssumeRoleProviderError(t *testing.T) {
+	stub := &stubSTS{err: errors.New("access denied")}
+	p := &AssumeRoleProvider{Client: stub, RoleARN: "roleARN"}
+
+	creds, err := p.Credentials()
+	assert.Nil(t, creds)
+	assert.Equal(t, "access denied", err.Error())
+	assert.True(t, p.IsExpired())
+}
+
+func TestAssumeRoleProviderDefaultExpiryWindow(t *testing.T) {
+	setTime(time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC))
+	p := &AssumeRoleProvider{Client: &stubSTS{}, RoleARN: "roleARN", Duration: time.Hour}
+
+	_, err := p.Credentials()
+	assert.NoError(t, err)
+
+	setTime(time.Date(2015, 1, 1, 0, 54, 59, 0, time.UTC))
+	assert.False(t, p.IsExpired())
+	setTime(time.Date(2015, 1, 1, 0, 55, 0, 0, time.UTC))
+	assert.True(t, p.IsExpired())
+
+	p.ExpiryWindow = 30 * time.Minute
+	setTime(time.Date(2015, 1, 1, 0, 30, 0, 0, time.UTC))
+	assert.True(t, p.IsExpired())
+
+	p.ExpiryWindow = -1 // no window
+	setTime(time.Date(2015, 1, 1, 0, 59, 59, 0, time.UTC))
+	assert.False(t, p.IsExpired())
+}
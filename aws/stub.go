@@ -0,0 +1,76 @@
+package aws
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+)
+
+// A StubResponse is a canned response returned by a StubSendHandler in place
+// of sending a request. If Err is set, the attempt fails with Err as if the
+// request could not be sent, otherwise it receives a response with the
+// StatusCode, Header and Body, which is unmarshaled as usual.
+type StubResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       string
+	Err        error
+}
+
+// StubSendHandler returns a Send handler which, instead of sending requests,
+// answers each attempt with the next of responses, for unit testing code
+// using a service without a network or HTTP server. Attempts after the last
+// response fail with a StubResponsesExhausted error. As it has the name of
+// the SendHandler, it replaces it in a service's handlers with SwapNamed:
+//
+//	svc.Handlers.Send.SwapNamed(aws.StubSendHandler(
+//		aws.StubResponse{StatusCode: 200, Body: `{"TableNames":[]}`},
+//		aws.StubResponse{StatusCode: 400, Body: `{"__type":"ValidationException"}`},
+//	))
+//
+// The responses are shared by every request of the service, including retries,
+// which each consume a response.
+func StubSendHandler(responses ...StubResponse) NamedHandler {
+	var m sync.Mutex
+	next := 0
+
+	return NamedHandler{Name: "aws.SendHandler", Fn: func(r *Request) {
+		if r.Error != nil {
+			return
+		}
+
+		m.Lock()
+		if next >= len(responses) {
+			m.Unlock()
+			r.Error = APIError{
+				Code:       "StubResponsesExhausted",
+				Message:    "no stub response left for " + r.Operation.Name,
+				RetryCount: r.RetryCount,
+			}
+			return
+		}
+		resp := responses[next]
+		next++
+		m.Unlock()
+
+		if resp.Err != nil {
+			r.Error = resp.Err
+			return
+		}
+
+		header := http.Header{}
+		for k, v := range resp.Header {
+			header[k] = append([]string(nil), v...)
+		}
+		r.HTTPResponse = &http.Response{
+			Status:        fmt.Sprintf("%d %s", resp.StatusCode, http.StatusText(resp.StatusCode)),
+			StatusCode:    resp.StatusCode,
+			Header:        header,
+			Body:          ioutil.NopCloser(bytes.NewReader([]byte(resp.Body))),
+			ContentLength: int64(len(resp.Body)),
+			Request:       r.HTTPRequest,
+		}
+	}}
+}
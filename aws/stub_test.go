@@ -0,0 +1,66 @@
+package aws
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func stubService(t *testing.T, responses ...StubResponse) *Service {
+	s := NewService(&Config{Region: "mock-region", MaxRetries: DEFAULT_RETRIES})
+	s.DefaultMaxRetries = 1
+	s.Handlers.Unmarshal.PushBack(unmarshal)
+	s.Handlers.UnmarshalError.PushBack(unmarshalError)
+	assert.True(t, s.Handlers.Send.SwapNamed(StubSendHandler(responses...)))
+	return s
+}
+
+func TestStubSendHandler(t *testing.T) {
+	defer func(fn func(time.Duration)) { sleepDelay = fn }(sleepDelay)
+	sleepDelay = func(time.Duration) {}
+
+	s := stubService(t,
+		StubResponse{StatusCode: 200, Body: `{"data":"valid"}`},
+		StubResponse{StatusCode: 400, Body: `{"__type":"ValidationException","message":"invalid"}`},
+		StubResponse{Err: errors.New("connection reset")},
+	)
+	send := func() (*Request, *testData) {
+		out := &testData{}
+		r := NewRequest(s, &Operation{Name: "Operation"}, nil, out)
+		r.Send()
+		return r, out
+	}
+
+	r, out := send()
+	assert.NoError(t, r.Error)
+	assert.Equal(t, "valid", out.Data)
+
+	r, _ = send()
+	err := Error(r.Error)
+	assert.Equal(t, "ValidationException", err.Code)
+	assert.Equal(t, 400, err.StatusCode)
+	assert.Equal(t, "invalid", err.Message)
+
+	r, _ = send()
+	assert.Equal(t, "connection reset", r.Error.Error())
+
+	r, _ = send()
+	assert.Equal(t, "StubResponsesExhausted", Error(r.Error).Code)
+}
+
+func TestStubSendHandlerRetries(t *testing.T) {
+	defer func(fn func(time.Duration)) { sleepDelay = fn }(sleepDelay)
+	sleepDelay = func(time.Duration) {}
+
+	s := stubService(t,
+		StubResponse{StatusCode: 500, Body: `{"__type":"InternalError","message":"retry"}`},
+		StubResponse{StatusCode: 200, Body: `{"data":"valid"}`},
+	)
+	out := &testData{}
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, out)
+	assert.NoError(t, r.Send())
+	assert.Equal(t, uint(1), r.RetryCount)
+	assert.Equal(t, "valid", out.Data)
+}
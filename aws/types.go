@@ -30,6 +30,11 @@ func Time(t time.Time) *time.Time {
 	return &t
 }
 
+// JSONValue is an arbitrary JSON document, used for members modeled with the
+// jsonvalue trait. It is marshaled as JSON, and base64 encoded when sent in
+// a header.
+type JSONValue map[string]interface{}
+
 func ReadSeekCloser(r io.Reader) ReaderSeekerCloser {
 	return ReaderSeekerCloser{r}
 }
@@ -54,6 +59,12 @@ func (r ReaderSeekerCloser) Seek(offset int64, whence int) (int64, error) {
 	return int64(0), nil
 }
 
+// seekable returns whether the wrapped reader can be seeked.
+func (r ReaderSeekerCloser) seekable() bool {
+	_, ok := r.r.(io.Seeker)
+	return ok
+}
+
 func (r ReaderSeekerCloser) Close() error {
 	switch t := r.r.(type) {
 	case io.Closer:
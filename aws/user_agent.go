@@ -0,0 +1,73 @@
+package aws
+
+import "strings"
+
+// A UserAgentComponent is a product token, such as my-cli/1.2.3, appended to
+// the User-Agent of requests to identify tools built on the SDK.
+type UserAgentComponent struct {
+	Name    string
+	Version string
+}
+
+// String returns the component as an RFC 7231 product token, name/version,
+// with the characters not allowed in a token replaced with a dash.
+func (c UserAgentComponent) String() string {
+	if c.Version == "" {
+		return sanitizeUserAgentToken(c.Name)
+	}
+	return sanitizeUserAgentToken(c.Name) + "/" + sanitizeUserAgentToken(c.Version)
+}
+
+// sanitizeUserAgentToken replaces the characters of s which are not token
+// characters, such as spaces, slashes and control characters, with a dash.
+func sanitizeUserAgentToken(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		case strings.ContainsRune("!#$%&'*+-.^_`|~", r):
+			return r
+		default:
+			return '-'
+		}
+	}, s)
+}
+
+// AppendUserAgent appends a name/version product token to the User-Agent of
+// the request, after the SDK's and the Config's UserAgentComponents.
+// Components are appended in the order they are added, including once the
+// request has been built.
+func (r *Request) AppendUserAgent(name, version string) {
+	c := UserAgentComponent{Name: name, Version: version}
+	r.userAgent = append(r.userAgent, c)
+
+	if ua := r.HTTPRequest.Header.Get("User-Agent"); ua != "" && name != "" {
+		r.HTTPRequest.Header.Set("User-Agent", ua+" "+c.String())
+	}
+}
+
+// UserAgentComponentHandler returns a handler appending a name/version
+// product token to the User-Agent of each request, for tools adding their
+// token to a Service's handlers rather than to its Config.
+func UserAgentComponentHandler(name, version string) NamedHandler {
+	return NamedHandler{
+		Name: "aws.UserAgentComponentHandler." + name,
+		Fn: func(r *Request) {
+			r.AppendUserAgent(name, version)
+		},
+	}
+}
+
+// UserAgentHandler sets the User-Agent of the request to the SDK's product
+// token followed by the Config's UserAgentComponents and those appended to
+// the request, separated by spaces. Components without a name are skipped.
+func UserAgentHandler(r *Request) {
+	tokens := []string{SDKName + "/" + SDKVersion}
+	components := append(append([]UserAgentComponent{}, r.Service.Config.UserAgentComponents...), r.userAgent...)
+	for _, c := range components {
+		if c.Name != "" {
+			tokens = append(tokens, c.String())
+		}
+	}
+	r.HTTPRequest.Header.Set("User-Agent", strings.Join(tokens, " "))
+}
@@ -0,0 +1,60 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func buildUserAgent(t *testing.T, s *Service, fn func(*Request)) string {
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	if fn != nil {
+		fn(r)
+	}
+	assert.NoError(t, r.Build())
+	return r.HTTPRequest.Header.Get("User-Agent")
+}
+
+func TestUserAgentDefault(t *testing.T) {
+	s := NewService(&Config{})
+	assert.Equal(t, SDKName+"/"+SDKVersion, buildUserAgent(t, s, nil))
+}
+
+func TestUserAgentComponents(t *testing.T) {
+	s := NewService(&Config{UserAgentComponents: []UserAgentComponent{
+		{Name: "my-cli", Version: "1.2.3"},
+		{Name: "framework"},
+	}})
+
+	ua := buildUserAgent(t, s, func(r *Request) {
+		r.AppendUserAgent("plugin", "0.1")
+		r.AppendUserAgent("", "ignored")
+	})
+	assert.Equal(t, SDKName+"/"+SDKVersion+" my-cli/1.2.3 framework plugin/0.1", ua)
+}
+
+func TestUserAgentComponentsSanitized(t *testing.T) {
+	s := NewService(&Config{})
+
+	ua := buildUserAgent(t, s, func(r *Request) {
+		r.AppendUserAgent("my cli\r\n", "1.2/3\x00")
+	})
+	assert.Equal(t, SDKName+"/"+SDKVersion+" my-cli--/1.2-3-", ua)
+}
+
+func TestUserAgentAppendedAfterBuild(t *testing.T) {
+	s := NewService(&Config{})
+	r := NewRequest(s, &Operation{Name: "Operation"}, nil, nil)
+	assert.NoError(t, r.Build())
+	r.AppendUserAgent("late", "1.0")
+	assert.Equal(t, SDKName+"/"+SDKVersion+" late/1.0", r.HTTPRequest.Header.Get("User-Agent"))
+}
+
+func TestUserAgentComponentHandler(t *testing.T) {
+	s := NewService(&Config{UserAgentComponents: []UserAgentComponent{{Name: "config", Version: "1"}}})
+	s.Handlers.Validate.PushBackNamed(UserAgentComponentHandler("handler", "2"))
+	s.Handlers.Build.PushBackNamed(UserAgentComponentHandler("built", "3"))
+
+	ua := buildUserAgent(t, s, nil)
+	assert.Equal(t, SDKName+"/"+SDKVersion+" config/1 handler/2 built/3", ua)
+}
@@ -0,0 +1,137 @@
+package aws
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
+)
+
+// Waiter acceptor states.
+const (
+	WaiterStateSuccess = "success"
+	WaiterStateFailure = "failure"
+	WaiterStateRetry   = "retry"
+)
+
+// Waiter acceptor matchers.
+const (
+	// WaiterMatchPath matches when the value at the argument's path of the
+	// output equals the expected value.
+	WaiterMatchPath = "path"
+	// WaiterMatchPathAll matches when every value at the argument's path of
+	// the output equals the expected value.
+	WaiterMatchPathAll = "pathAll"
+	// WaiterMatchPathAny matches when any value at the argument's path of the
+	// output equals the expected value.
+	WaiterMatchPathAny = "pathAny"
+	// WaiterMatchError matches when the request fails with the expected
+	// error code.
+	WaiterMatchError = "error"
+	// WaiterMatchStatus matches when the response has the expected HTTP
+	// status code.
+	WaiterMatchStatus = "status"
+)
+
+// A WaiterAcceptor decides the state a waiter moves to when a response
+// matches it.
+type WaiterAcceptor struct {
+	State    string
+	Matcher  string
+	Argument string
+	Expected interface{}
+}
+
+// A Waiter polls an operation until its response matches a success or
+// failure acceptor, or the maximum number of attempts is used up.
+type Waiter struct {
+	Delay       time.Duration
+	MaxAttempts int
+	Acceptors   []WaiterAcceptor
+
+	// NewRequest returns the request sent by each attempt.
+	NewRequest func() *Request
+}
+
+// WaitUntil sends the waiter's request until it matches an acceptor in a
+// terminal state, waiting Delay between attempts. It returns nil if the
+// success state is reached, a "ResourceNotReady" APIError if the failure
+// state is reached or the attempts are exhausted, and the request's error if
+// it fails without matching any acceptor.
+func (w *Waiter) WaitUntil() error {
+	for attempt := 1; ; attempt++ {
+		req := w.NewRequest()
+		err := req.Send()
+
+		state := ""
+		for _, a := range w.Acceptors {
+			if a.match(req, err) {
+				state = a.State
+				break
+			}
+		}
+
+		switch state {
+		case WaiterStateSuccess:
+			return nil
+		case WaiterStateFailure:
+			return APIError{
+				Code:    "ResourceNotReady",
+				Message: "failed waiting for successful resource state",
+			}
+		case "":
+			if err != nil {
+				return err
+			}
+		}
+
+		if attempt >= w.MaxAttempts {
+			return APIError{
+				Code:    "ResourceNotReady",
+				Message: fmt.Sprintf("exceeded %d wait attempts", w.MaxAttempts),
+			}
+		}
+		sleepDelay(w.Delay)
+	}
+}
+
+// match returns whether the acceptor matches the sent request. Values are
+// compared by their string forms, so that an expected 200 matches a status
+// code or an int64 member alike.
+func (a WaiterAcceptor) match(req *Request, err error) bool {
+	expected := fmt.Sprint(a.Expected)
+
+	switch a.Matcher {
+	case WaiterMatchStatus:
+		return req.HTTPResponse != nil && fmt.Sprint(req.HTTPResponse.StatusCode) == expected
+	case WaiterMatchError:
+		if apiErr := Error(err); apiErr != nil {
+			return apiErr.Code == expected
+		}
+		return false
+	}
+
+	if err != nil {
+		return false
+	}
+
+	vals := awsutil.ValuesAtPath(req.Data, a.Argument)
+	switch a.Matcher {
+	case WaiterMatchPath:
+		return len(vals) == 1 && fmt.Sprint(vals[0]) == expected
+	case WaiterMatchPathAll:
+		for _, v := range vals {
+			if fmt.Sprint(v) != expected {
+				return false
+			}
+		}
+		return len(vals) > 0
+	case WaiterMatchPathAny:
+		for _, v := range vals {
+			if fmt.Sprint(v) == expected {
+				return true
+			}
+		}
+	}
+	return false
+}
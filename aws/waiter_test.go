@@ -0,0 +1,156 @@
+package aws
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type waiterResource struct {
+	Status *string
+}
+
+type waiterOutput struct {
+	Resource  *waiterResource
+	Resources []*waiterResource
+}
+
+// waiterService returns a Service whose requests receive the given outputs in
+// order, or the given status code with an error when an output is nil.
+func waiterService(statuses []int, outputs []*waiterOutput, sent *int) *Service {
+	s := NewService(&Config{MaxRetries: 0})
+	s.Handlers.Send.Init() // mock sending
+	s.Handlers.Send.PushBack(func(r *Request) {
+		i := *sent
+		*sent++
+		r.HTTPResponse = &http.Response{StatusCode: statuses[i], Body: body(`{}`)}
+		if outputs[i] != nil {
+			*r.Data.(*waiterOutput) = *outputs[i]
+		}
+	})
+	s.Handlers.UnmarshalError.PushBack(func(r *Request) {
+		r.Error = APIError{StatusCode: r.HTTPResponse.StatusCode, Code: "ResourceNotFound"}
+	})
+	return s
+}
+
+func newWaiter(s *Service, acceptors []WaiterAcceptor) *Waiter {
+	return &Waiter{
+		Delay:       5 * time.Second,
+		MaxAttempts: 4,
+		Acceptors:   acceptors,
+		NewRequest: func() *Request {
+			return NewRequest(s, &Operation{Name: "Describe"}, nil, &waiterOutput{})
+		},
+	}
+}
+
+func status(s string) *waiterOutput {
+	return &waiterOutput{Resource: &waiterResource{Status: String(s)}}
+}
+
+func TestWaiterPathTransitions(t *testing.T) {
+	delays := []time.Duration{}
+	defer func(f func(time.Duration)) { sleepDelay = f }(sleepDelay)
+	sleepDelay = func(delay time.Duration) {
+		delays = append(delays, delay)
+	}
+
+	sent := 0
+	s := waiterService([]int{200, 200, 200},
+		[]*waiterOutput{status("pending"), status("pending"), status("ready")}, &sent)
+	w := newWaiter(s, []WaiterAcceptor{
+		{State: WaiterStateSuccess, Matcher: WaiterMatchPath, Argument: "Resource.Status", Expected: "ready"},
+		{State: WaiterStateFailure, Matcher: WaiterMatchPath, Argument: "Resource.Status", Expected: "failed"},
+	})
+
+	assert.NoError(t, w.WaitUntil())
+	assert.Equal(t, 3, sent)
+	assert.Equal(t, []time.Duration{5 * time.Second, 5 * time.Second}, delays)
+}
+
+func TestWaiterFailureState(t *testing.T) {
+	defer func(f func(time.Duration)) { sleepDelay = f }(sleepDelay)
+	sleepDelay = func(time.Duration) {}
+
+	sent := 0
+	s := waiterService([]int{200, 200}, []*waiterOutput{status("pending"), status("failed")}, &sent)
+	w := newWaiter(s, []WaiterAcceptor{
+		{State: WaiterStateSuccess, Matcher: WaiterMatchPath, Argument: "Resource.Status", Expected: "ready"},
+		{State: WaiterStateFailure, Matcher: WaiterMatchPath, Argument: "Resource.Status", Expected: "failed"},
+	})
+
+	err := w.WaitUntil()
+	assert.Equal(t, "ResourceNotReady", Error(err).Code)
+	assert.Equal(t, 2, sent)
+}
+
+func TestWaiterMaxAttempts(t *testing.T) {
+	defer func(f func(time.Duration)) { sleepDelay = f }(sleepDelay)
+	sleepDelay = func(time.Duration) {}
+
+	sent := 0
+	pending := status("pending")
+	s := waiterService([]int{200, 200, 200, 200},
+		[]*waiterOutput{pending, pending, pending, pending}, &sent)
+	w := newWaiter(s, []WaiterAcceptor{
+		{State: WaiterStateSuccess, Matcher: WaiterMatchPath, Argument: "Resource.Status", Expected: "ready"},
+	})
+
+	err := w.WaitUntil()
+	assert.Equal(t, "ResourceNotReady", Error(err).Code)
+	assert.Equal(t, "exceeded 4 wait attempts", err.Error())
+	assert.Equal(t, 4, sent)
+}
+
+func TestWaiterPathAllAndAny(t *testing.T) {
+	defer func(f func(time.Duration)) { sleepDelay = f }(sleepDelay)
+	sleepDelay = func(time.Duration) {}
+
+	list := func(states ...string) *waiterOutput {
+		out := &waiterOutput{}
+		for _, s := range states {
+			out.Resources = append(out.Resources, &waiterResource{Status: String(s)})
+		}
+		return out
+	}
+
+	sent := 0
+	s := waiterService([]int{200, 200}, []*waiterOutput{list("ready", "pending"), list("ready", "ready")}, &sent)
+	w := newWaiter(s, []WaiterAcceptor{
+		{State: WaiterStateSuccess, Matcher: WaiterMatchPathAll, Argument: "Resources[].Status", Expected: "ready"},
+		{State: WaiterStateFailure, Matcher: WaiterMatchPathAny, Argument: "Resources[].Status", Expected: "failed"},
+	})
+	assert.NoError(t, w.WaitUntil())
+	assert.Equal(t, 2, sent)
+
+	sent = 0
+	s = waiterService([]int{200}, []*waiterOutput{list("ready", "failed")}, &sent)
+	w = newWaiter(s, w.Acceptors)
+	assert.Equal(t, "ResourceNotReady", Error(w.WaitUntil()).Code)
+}
+
+func TestWaiterErrorAndStatusMatchers(t *testing.T) {
+	defer func(f func(time.Duration)) { sleepDelay = f }(sleepDelay)
+	sleepDelay = func(time.Duration) {}
+
+	sent := 0
+	s := waiterService([]int{404, 404, 200}, []*waiterOutput{nil, nil, status("ready")}, &sent)
+	w := newWaiter(s, []WaiterAcceptor{
+		{State: WaiterStateSuccess, Matcher: WaiterMatchStatus, Expected: 200},
+		{State: WaiterStateRetry, Matcher: WaiterMatchError, Expected: "ResourceNotFound"},
+	})
+	assert.NoError(t, w.WaitUntil())
+	assert.Equal(t, 3, sent)
+
+	sent = 0
+	s = waiterService([]int{404}, []*waiterOutput{nil}, &sent)
+	w = newWaiter(s, []WaiterAcceptor{
+		{State: WaiterStateSuccess, Matcher: WaiterMatchStatus, Expected: 200},
+	})
+	err := w.WaitUntil()
+	assert.Equal(t, "ResourceNotFound", Error(err).Code) // unmatched errors are returned
+	assert.Equal(t, 1, sent)
+}
@@ -2,9 +2,73 @@ package endpoints
 
 //go:generate go run ../model/cli/gen-endpoints/main.go endpoints.json endpoints_map.go
 
-import "strings"
+import (
+	"fmt"
+	"strings"
+)
+
+// Resolve returns the HTTPS endpoint URL of a service in a region, such as
+// "https://dynamodb.us-west-2.amazonaws.com". Services with a global
+// endpoint resolve to it regardless of the region. An error is returned if
+// the service's endpoint depends on a region and none is given.
+func Resolve(svcName, region string) (string, error) {
+	if svcName == "" {
+		return "", fmt.Errorf("cannot resolve endpoint without a service name")
+	}
+
+	entry, ok := lookup(svcName, region)
+	if !ok {
+		return "", fmt.Errorf("no endpoint known for %s in %q", svcName, region)
+	}
+	if region == "" && strings.Contains(entry.Endpoint, "{region}") {
+		return "", fmt.Errorf("cannot resolve endpoint for %s without a region", svcName)
+	}
+
+	return "https://" + expand(entry.Endpoint, svcName, region), nil
+}
 
 func EndpointForRegion(svcName, region string) string {
+	if entry, ok := lookup(svcName, region); ok {
+		return expand(entry.Endpoint, svcName, region)
+	}
+	return ""
+}
+
+// SigningRegion returns the region requests to a service's endpoint in a
+// region are signed for, such as "us-east-1" for the global endpoints of
+// IAM, Route 53 and CloudFront, or "" if they are signed for the region
+// itself.
+func SigningRegion(svcName, region string) string {
+	if entry, ok := lookup(svcName, region); ok {
+		return entry.SigningRegion
+	}
+	return ""
+}
+
+// dualStackServices are the services with an IPv6 enabled dualstack
+// endpoint.
+var dualStackServices = map[string]bool{
+	"s3": true,
+}
+
+// DualStackEndpointForRegion returns the dualstack endpoint of a service in a
+// region, such as "s3.dualstack.us-east-1.amazonaws.com". It falls back to
+// the standard endpoint for services without dualstack support.
+func DualStackEndpointForRegion(svcName, region string) string {
+	if !dualStackServices[svcName] || region == "" {
+		return EndpointForRegion(svcName, region)
+	}
+
+	suffix := ".amazonaws.com"
+	if strings.HasPrefix(region, "cn-") {
+		suffix = ".amazonaws.com.cn"
+	}
+	return svcName + ".dualstack." + region + suffix
+}
+
+// lookup returns the most specific endpoint entry matching the service and
+// region.
+func lookup(svcName, region string) (endpointEntry, bool) {
 	derivedKeys := []string{
 		region + "/" + svcName,
 		region + "/*",
@@ -14,11 +78,14 @@ func EndpointForRegion(svcName, region string) string {
 
 	for _, key := range derivedKeys {
 		if val, ok := endpointsMap.Endpoints[key]; ok {
-			ep := val.Endpoint
-			ep = strings.Replace(ep, "{region}", region, -1)
-			ep = strings.Replace(ep, "{service}", svcName, -1)
-			return ep
+			return val, true
 		}
 	}
-	return ""
+	return endpointEntry{}, false
+}
+
+func expand(ep, svcName, region string) string {
+	ep = strings.Replace(ep, "{region}", region, -1)
+	ep = strings.Replace(ep, "{service}", svcName, -1)
+	return ep
 }
@@ -8,7 +8,8 @@ type endpointStruct struct {
 }
 
 type endpointEntry struct {
-	Endpoint string
+	Endpoint      string
+	SigningRegion string
 }
 
 var endpointsMap = endpointStruct{
@@ -18,19 +19,23 @@ var endpointsMap = endpointStruct{
 			Endpoint: "{service}.{region}.amazonaws.com",
 		},
 		"*/cloudfront": endpointEntry{
-			Endpoint: "cloudfront.amazonaws.com",
+			Endpoint:      "cloudfront.amazonaws.com",
+			SigningRegion: "us-east-1",
 		},
 		"*/iam": endpointEntry{
-			Endpoint: "iam.amazonaws.com",
+			Endpoint:      "iam.amazonaws.com",
+			SigningRegion: "us-east-1",
 		},
 		"*/importexport": endpointEntry{
 			Endpoint: "importexport.amazonaws.com",
 		},
 		"*/route53": endpointEntry{
-			Endpoint: "route53.amazonaws.com",
+			Endpoint:      "route53.amazonaws.com",
+			SigningRegion: "us-east-1",
 		},
 		"*/sts": endpointEntry{
-			Endpoint: "sts.amazonaws.com",
+			Endpoint:      "sts.amazonaws.com",
+			SigningRegion: "us-east-1",
 		},
 		"ap-northeast-1/s3": endpointEntry{
 			Endpoint: "s3-{region}.amazonaws.com",
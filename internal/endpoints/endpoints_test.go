@@ -23,3 +23,81 @@ func TestServicesInCN(t *testing.T) {
 		}
 	}
 }
+
+func TestResolveRegionalService(t *testing.T) {
+	ep, err := Resolve("dynamodb", "us-west-2")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if ep != "https://dynamodb.us-west-2.amazonaws.com" {
+		t.Errorf("expected regional endpoint, got %s", ep)
+	}
+}
+
+func TestResolveGlobalService(t *testing.T) {
+	for _, region := range []string{"us-west-2", "eu-central-1", ""} {
+		ep, err := Resolve("iam", region)
+		if err != nil {
+			t.Fatalf("expected no error for %q, got %v", region, err)
+		}
+		if ep != "https://iam.amazonaws.com" {
+			t.Errorf("expected global endpoint for %q, got %s", region, ep)
+		}
+	}
+}
+
+func TestResolveChinaPartition(t *testing.T) {
+	ep, err := Resolve("dynamodb", "cn-north-1")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if ep != "https://dynamodb.cn-north-1.amazonaws.com.cn" {
+		t.Errorf("expected China endpoint, got %s", ep)
+	}
+}
+
+func TestResolveMissingRegion(t *testing.T) {
+	if _, err := Resolve("dynamodb", ""); err == nil {
+		t.Errorf("expected an error resolving a regional service without a region")
+	}
+	if _, err := Resolve("", "us-west-2"); err == nil {
+		t.Errorf("expected an error resolving without a service name")
+	}
+}
+
+func TestDualStackEndpoints(t *testing.T) {
+	if ep := DualStackEndpointForRegion("s3", "us-east-1"); ep != "s3.dualstack.us-east-1.amazonaws.com" {
+		t.Errorf("expected dualstack endpoint for s3, got %s", ep)
+	}
+	if ep := DualStackEndpointForRegion("s3", "cn-north-1"); ep != "s3.dualstack.cn-north-1.amazonaws.com.cn" {
+		t.Errorf("expected China dualstack endpoint for s3, got %s", ep)
+	}
+}
+
+func TestDualStackFallback(t *testing.T) {
+	if ep := DualStackEndpointForRegion("sqs", "us-east-1"); ep != "sqs.us-east-1.amazonaws.com" {
+		t.Errorf("expected standard endpoint for sqs, got %s", ep)
+	}
+	if ep := DualStackEndpointForRegion("iam", "us-east-1"); ep != "iam.amazonaws.com" {
+		t.Errorf("expected global endpoint for iam, got %s", ep)
+	}
+}
+
+func TestSigningRegion(t *testing.T) {
+	for _, name := range []string{"cloudfront", "iam", "route53", "sts"} {
+		if r := SigningRegion(name, "eu-west-1"); r != "us-east-1" {
+			t.Errorf("expected %s to be signed for us-east-1, got %q", name, r)
+		}
+	}
+
+	for svc, region := range map[string]string{
+		"dynamodb": "eu-west-1",
+		"s3":       "us-west-2",
+		"iam":      "us-gov-west-1",
+		"route53":  "cn-north-1",
+	} {
+		if r := SigningRegion(svc, region); r != "" {
+			t.Errorf("expected %s in %s to be signed for its region, got %q", svc, region, r)
+		}
+	}
+}
@@ -50,6 +50,7 @@ var _ xml.Attr
 var _ = ioutil.Discard
 var _ = util.Trim("")
 var _ = url.Values{}
+var _ = awsutil.StringValue
 `
 
 var reStripSpace = regexp.MustCompile(`\s(\w)`)
@@ -69,6 +70,7 @@ var extraImports = []string{
 	"testing",
 	"time",
 	"net/url",
+	"github.com/awslabs/aws-sdk-go/aws/awsutil",
 	"github.com/awslabs/aws-sdk-go/internal/protocol/xml/xmlutil",
 	"github.com/awslabs/aws-sdk-go/internal/util",
 	"github.com/stretchr/testify/assert",
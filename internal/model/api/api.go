@@ -15,6 +15,8 @@ type API struct {
 	Metadata   Metadata
 	Operations map[string]*Operation
 	Shapes     map[string]*Shape
+	Paginators map[string]*Paginator `json:"pagination"`
+	Waiters    map[string]*Waiter    `json:"waiters"`
 
 	// Disables inflection checks. Only use this when generating tests
 	NoInflections bool
@@ -161,12 +163,13 @@ var tplAPI = template.Must(template.New("api").Parse(`
 {{ end }}
 
 {{ range $_, $s := .ShapeList }}
-{{ if eq $s.Type "structure" }}{{ $s.GoCode }}{{ end }}
+{{ if eq $s.Type "structure" }}{{ $s.GoCode }}{{ else if $s.IsEnum }}{{ $s.EnumGoCode }}{{ end }}
 
 {{ end }}
 `))
 
 func (a *API) APIGoCode() string {
+	a.checkPaginators()
 	a.resetImports()
 	var buf bytes.Buffer
 	err := tplAPI.Execute(&buf, a)
@@ -201,7 +204,7 @@ func New(config *aws.Config) *{{ .StructName }} {
   service.Initialize()
 
   // Handlers
-  service.Handlers.Sign.PushBack(v4.Sign)
+  service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
   service.Handlers.Build.PushBack({{ .ProtocolPackage }}.Build)
   service.Handlers.Unmarshal.PushBack({{ .ProtocolPackage }}.Unmarshal)
   service.Handlers.UnmarshalMeta.PushBack({{ .ProtocolPackage }}.UnmarshalMeta)
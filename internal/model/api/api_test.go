@@ -40,3 +40,106 @@ func TestStructNameForExceptions(t *testing.T) {
 	}
 	assert.Equal(t, a.StructName(), "ConfigService")
 }
+
+func TestGoTagsIdempotencyToken(t *testing.T) {
+	a := &API{Metadata: Metadata{Protocol: "ec2"}}
+	ref := &ShapeRef{API: a, LocationName: "clientToken", IdempotencyToken: true,
+		Shape: &Shape{API: a, Type: "string"}}
+	assert.Equal(t, "`locationName:\"clientToken\" type:\"string\" idempotencyToken:\"true\"`",
+		ref.GoTags(false, false))
+}
+
+func TestGoTypeJSONValue(t *testing.T) {
+	a := &API{Metadata: Metadata{Protocol: "rest-json"}}
+	ref := &ShapeRef{API: a, Location: "header", LocationName: "x-amz-document", JSONValue: true,
+		Shape: &Shape{API: a, Type: "string"}}
+	assert.Equal(t, "aws.JSONValue", ref.GoType())
+	assert.Equal(t, "`location:\"header\" locationName:\"x-amz-document\" type:\"string\" jsonvalue:\"true\"`",
+		ref.GoTags(false, false))
+}
+
+func TestGoTagsHostLabel(t *testing.T) {
+	a := &API{Metadata: Metadata{Protocol: "rest-json"}}
+	ref := &ShapeRef{API: a, Location: "uri", LocationName: "AccountId", HostLabel: true,
+		Shape: &Shape{API: a, Type: "string"}}
+	assert.Equal(t, "`location:\"uri\" locationName:\"AccountId\" type:\"string\" hostLabel:\"true\"`",
+		ref.GoTags(false, false))
+}
+
+func TestGoTagsSensitive(t *testing.T) {
+	a := &API{Metadata: Metadata{Protocol: "query"}}
+	ref := &ShapeRef{API: a, Shape: &Shape{API: a, Type: "string", Sensitive: true}}
+	assert.Equal(t, "`type:\"string\" sensitive:\"true\" required:\"true\"`", ref.GoTags(false, true))
+
+	ref = &ShapeRef{API: a, Sensitive: true, Shape: &Shape{API: a, Type: "blob"}}
+	assert.Equal(t, "`type:\"blob\" sensitive:\"true\"`", ref.GoTags(false, false))
+}
+
+func TestGoCodeStringMethod(t *testing.T) {
+	a := &API{Metadata: Metadata{Protocol: "query"}}
+	a.resetImports()
+	s := &Shape{API: a, ShapeName: "CreateUserInput", Type: "structure"}
+	assert.Contains(t, s.GoCode(), "func (s CreateUserInput) String() string {\n\treturn awsutil.StringValue(s)\n}")
+	assert.True(t, a.imports["github.com/awslabs/aws-sdk-go/aws/awsutil"])
+}
+
+func TestAPIGoCodeNestedStringMethod(t *testing.T) {
+	a := API{}
+	a.AttachString(`{
+		"metadata": { "protocol": "query", "serviceFullName": "Amazon Things" },
+		"operations": {
+			"Login": { "name": "Login", "input": { "shape": "LoginRequest" } }
+		},
+		"shapes": {
+			"LoginRequest": {
+				"type": "structure",
+				"members": { "credentials": { "shape": "Credentials" } }
+			},
+			"Credentials": {
+				"type": "structure",
+				"members": { "password": { "shape": "Password" } }
+			},
+			"Password": { "type": "string", "sensitive": true }
+		}
+	}`)
+
+	code := a.APIGoCode()
+	assert.Contains(t, code, "func (s LoginInput) String() string {")
+	assert.Contains(t, code, "func (s Credentials) String() string {")
+}
+
+func TestGoTagsPattern(t *testing.T) {
+	a := &API{Metadata: Metadata{Protocol: "query"}}
+	ref := &ShapeRef{API: a, Shape: &Shape{API: a, Type: "string", Max: 64, Pattern: `[\w+=,.@-]*`}}
+	assert.Equal(t, "`type:\"string\" max:\"64\" pattern:\"[\\\\w+=,.@-]*\"`", ref.GoTags(false, false))
+
+	ref = &ShapeRef{API: a, Shape: &Shape{API: a, Type: "string", Pattern: "[^`]*"}}
+	assert.Equal(t, "`type:\"string\"`", ref.GoTags(false, false))
+}
+
+func TestGoTagsEnum(t *testing.T) {
+	a := &API{Metadata: Metadata{Protocol: "query"}}
+	ref := &ShapeRef{API: a, Shape: &Shape{API: a, Type: "string", Enum: []string{"private", "public-read"}}}
+	assert.Equal(t, "`type:\"string\" enum:\"private,public-read\"`", ref.GoTags(false, false))
+
+	ref = &ShapeRef{API: a, Shape: &Shape{API: a, Type: "string", Enum: []string{"a,b", "c"}}}
+	assert.Equal(t, "`type:\"string\"`", ref.GoTags(false, false))
+}
+
+func TestEnumGoCode(t *testing.T) {
+	a := &API{Metadata: Metadata{Protocol: "query"}}
+	a.resetImports()
+	s := &Shape{API: a, ShapeName: "InstanceType", Type: "string", Enum: []string{"t1.micro", "m3.2xlarge"}}
+	assert.True(t, s.IsEnum())
+
+	code := s.EnumGoCode()
+	assert.Contains(t, code, "type InstanceType string")
+	assert.Contains(t, code, "InstanceTypeT1Micro   InstanceType = \"t1.micro\"")
+	assert.Contains(t, code, "InstanceTypeM32xlarge InstanceType = \"m3.2xlarge\"")
+	assert.Contains(t, code, "func InstanceTypeValues() []InstanceType {")
+	assert.Contains(t, code, "func (v InstanceType) String() string {")
+	assert.Contains(t, code, "func ParseInstanceType(str string) (InstanceType, error) {")
+	assert.True(t, a.imports["fmt"])
+
+	assert.False(t, (&Shape{API: a, Type: "string"}).IsEnum())
+}
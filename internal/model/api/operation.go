@@ -17,8 +17,12 @@ type Operation struct {
 	Name          string
 	Documentation string
 	HTTP          HTTPInfo
+	Endpoint      EndpointTrait
 	InputRef      ShapeRef `json:"input"`
 	OutputRef     ShapeRef `json:"output"`
+	AuthType      string   `json:"authtype"`
+
+	RequestCompression *RequestCompressionTrait
 }
 
 type HTTPInfo struct {
@@ -27,6 +31,31 @@ type HTTPInfo struct {
 	ResponseCode uint
 }
 
+// EndpointTrait is the endpoint customization of an operation.
+type EndpointTrait struct {
+	HostPrefix string
+}
+
+// RequestCompressionTrait lists the encodings an operation accepts its
+// request bodies compressed with.
+type RequestCompressionTrait struct {
+	Encodings []string
+}
+
+// AcceptsGzip returns whether the operation accepts gzip compressed request
+// bodies.
+func (o *Operation) AcceptsGzip() bool {
+	if o.RequestCompression == nil {
+		return false
+	}
+	for _, e := range o.RequestCompression.Encodings {
+		if e == "gzip" {
+			return true
+		}
+	}
+	return false
+}
+
 func (o *Operation) HasInput() bool {
 	return o.InputRef.ShapeName != ""
 }
@@ -51,6 +80,16 @@ func (c *{{ .API.StructName }}) {{ .ExportedName }}Request(` +
 			Name:       "{{ .Name }}",
 			{{ if ne .HTTP.Method "" }}HTTPMethod: "{{ .HTTP.Method }}",
 			{{ end }}{{ if ne .HTTP.RequestURI "" }}HTTPPath:   "{{ .HTTP.RequestURI }}",
+			{{ end }}{{ if ne .Endpoint.HostPrefix "" }}HostPrefix: "{{ .Endpoint.HostPrefix }}",
+			{{ end }}{{ if .AcceptsGzip }}RequestCompression: true,
+			{{ end }}{{ if ne .AuthType "" }}AuthType: "{{ .AuthType }}",
+			{{ end }}{{ with .Paginator }}Paginator: &aws.Paginator{
+				InputTokens:     {{ .InputTokensGoCode }},
+				OutputTokens:    {{ .OutputTokensGoCode }},
+				{{ if ne .LimitToken "" }}LimitToken:      "{{ .LimitToken }}",
+				{{ end }}{{ if ne .TruncationToken "" }}TruncationToken: "{{ .TruncationToken }}",
+				{{ end }}
+			},
 			{{ end }}
 		}
 	}
@@ -68,7 +107,18 @@ func (c *{{ .API.StructName }}) {{ .ExportedName }}Request(` +
 	err = req.Send()
 	return
 }
-
+{{ if .Paginator }}
+// {{ .ExportedName }}Pages iterates over the pages of a {{ .ExportedName }} operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *{{ .API.StructName }}) {{ .ExportedName }}Pages(` +
+	`input {{ .InputRef.GoType }}, fn func(p {{ .OutputRef.GoType }}, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.{{ .ExportedName }}Request(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.({{ .OutputRef.GoType }}), lastPage)
+	})
+}
+{{ end }}
 var op{{ .ExportedName }} *aws.Operation
 `))
 
@@ -0,0 +1,125 @@
+package api
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// A Paginator is the pagination definition of an operation, loaded from the
+// API's paginators file. Tokens may be a single name or a list of names.
+type Paginator struct {
+	InputTokens  interface{} `json:"input_token"`
+	OutputTokens interface{} `json:"output_token"`
+	LimitKey     string      `json:"limit_key"`
+	MoreResults  string      `json:"more_results"`
+	ResultKey    interface{} `json:"result_key"`
+
+	op *Operation
+}
+
+// Paginator returns the operation's pagination definition, or nil if the
+// operation is not paginated.
+func (o *Operation) Paginator() *Paginator {
+	p, ok := o.API.Paginators[o.modelName()]
+	if !ok || p.InputTokens == nil || p.OutputTokens == nil {
+		return nil
+	}
+	p.op = o
+	return p
+}
+
+// modelName returns the name the paginators and waiters files know the
+// operation by: its name without the API version some services, such as
+// CloudFront, append to it, as in ListDistributions2014_11_06.
+func (o *Operation) modelName() string {
+	version := strings.Replace(o.API.Metadata.APIVersion, "-", "_", -1)
+	if version == "" {
+		return o.Name
+	}
+	return strings.TrimSuffix(o.Name, version)
+}
+
+// operationByModelName returns the operation known by name in the
+// paginators and waiters files, or nil if there is none.
+func (a *API) operationByModelName(name string) *Operation {
+	for _, o := range a.OperationList() {
+		if o.modelName() == name {
+			return o
+		}
+	}
+	return nil
+}
+
+// checkPaginators panics if a paginator names an operation the API does not
+// have, as it would otherwise be silently left out.
+func (a *API) checkPaginators() {
+	names := []string{}
+	for n := range a.Paginators {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	for _, n := range names {
+		if a.operationByModelName(n) == nil {
+			panic(fmt.Sprintf("paginator names unknown operation %s in API %s", n, a.PackageName()))
+		}
+	}
+}
+
+// InputTokensGoCode returns the Go code for the paginator's input tokens.
+func (p *Paginator) InputTokensGoCode() string {
+	return p.tokensGoCode(p.InputTokens)
+}
+
+// OutputTokensGoCode returns the Go code for the paginator's output tokens.
+func (p *Paginator) OutputTokensGoCode() string {
+	return p.tokensGoCode(p.OutputTokens)
+}
+
+// LimitToken returns the exported name of the paginator's limit member.
+func (p *Paginator) LimitToken() string {
+	return p.op.API.exportPath(p.LimitKey)
+}
+
+// TruncationToken returns the exported path of the paginator's more results
+// member.
+func (p *Paginator) TruncationToken() string {
+	return p.op.API.exportPath(p.MoreResults)
+}
+
+func (p *Paginator) tokensGoCode(tokens interface{}) string {
+	var list []string
+	switch t := tokens.(type) {
+	case string:
+		list = []string{t}
+	case []interface{}:
+		for _, v := range t {
+			list = append(list, v.(string))
+		}
+	}
+
+	code := make([]string, len(list))
+	for i, v := range list {
+		code[i] = fmt.Sprintf("%q", p.op.API.exportPath(v))
+	}
+	return "[]string{" + strings.Join(code, ", ") + "}"
+}
+
+// exportPath converts each member name of a path, such as
+// "NextMarker || Contents[-1].Key", to its exported Go name.
+func (a *API) exportPath(path string) string {
+	alts := strings.Split(path, "||")
+	for i, alt := range alts {
+		parts := strings.Split(strings.TrimSpace(alt), ".")
+		for j, part := range parts {
+			index := ""
+			if n := strings.Index(part, "["); n >= 0 {
+				part, index = part[:n], part[n:]
+			}
+			parts[j] = a.ExportableName(part) + index
+		}
+		alts[i] = strings.Join(parts, ".")
+	}
+	return strings.Join(alts, " || ")
+}
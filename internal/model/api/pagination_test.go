@@ -0,0 +1,107 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaginators(t *testing.T) {
+	json := `{
+		"metadata": { "serviceFullName": "Amazon Things" },
+		"operations": {
+			"ListItems": {
+				"name": "ListItems",
+				"input": { "shape": "ListItemsRequest" },
+				"output": { "shape": "ListItemsResult" }
+			},
+			"GetItem": { "name": "GetItem" }
+		},
+		"shapes": {
+			"ListItemsRequest": {
+				"type": "structure",
+				"members": {
+					"nextToken": { "shape": "String" },
+					"startName": { "shape": "String" },
+					"maxItems": { "shape": "String" }
+				}
+			},
+			"ListItemsResult": {
+				"type": "structure",
+				"members": {
+					"nextToken": { "shape": "String" },
+					"nextName": { "shape": "String" },
+					"isTruncated": { "shape": "Boolean" }
+				}
+			},
+			"String": { "type": "string" },
+			"Boolean": { "type": "boolean" }
+		}
+	}`
+	paginators := `{
+		"pagination": {
+			"ListItems": {
+				"input_token": ["nextToken", "startName"],
+				"output_token": ["nextToken", "Items[-1].nextName"],
+				"limit_key": "maxItems",
+				"more_results": "isTruncated"
+			}
+		}
+	}`
+	a := API{}
+	a.AttachString(json)
+	a.AttachString(paginators)
+
+	assert.Nil(t, a.Operations["GetItem"].Paginator())
+
+	p := a.Operations["ListItems"].Paginator()
+	assert.NotNil(t, p)
+	assert.Equal(t, `[]string{"NextToken", "StartName"}`, p.InputTokensGoCode())
+	assert.Equal(t, `[]string{"NextToken", "Items[-1].NextName"}`, p.OutputTokensGoCode())
+	assert.Equal(t, "MaxItems", p.LimitToken())
+	assert.Equal(t, "IsTruncated", p.TruncationToken())
+	assert.Contains(t, a.Operations["ListItems"].GoCode(), "func (c *Things) ListItemsPages(")
+}
+
+func TestPaginatorsVersionedOperationName(t *testing.T) {
+	json := `{
+		"metadata": { "apiVersion": "2014-11-06", "serviceFullName": "Amazon Things" },
+		"operations": {
+			"ListItems": {
+				"name": "ListItems2014_11_06",
+				"input": { "shape": "ListItemsRequest" },
+				"output": { "shape": "ListItemsResult" }
+			}
+		},
+		"shapes": {
+			"ListItemsRequest": {
+				"type": "structure",
+				"members": { "marker": { "shape": "String" } }
+			},
+			"ListItemsResult": {
+				"type": "structure",
+				"members": { "nextMarker": { "shape": "String" } }
+			},
+			"String": { "type": "string" }
+		}
+	}`
+	a := API{}
+	a.AttachString(json)
+	a.AttachString(`{"pagination": {"ListItems": {"input_token": "marker", "output_token": "nextMarker"}}}`)
+
+	p := a.Operations["ListItems"].Paginator()
+	assert.NotNil(t, p)
+	assert.Equal(t, `[]string{"Marker"}`, p.InputTokensGoCode())
+	assert.Nil(t, panicValue(func() { a.APIGoCode() }))
+
+	a.AttachString(`{"pagination": {"ListThings": {"input_token": "marker", "output_token": "nextMarker"}}}`)
+	assert.Equal(t, "paginator names unknown operation ListThings in API things",
+		panicValue(func() { a.APIGoCode() }))
+}
+
+// panicValue returns the value fn panics with, or nil if it does not.
+func panicValue(fn func()) (v interface{}) {
+	defer func() { v = recover() }()
+	fn()
+	return nil
+}
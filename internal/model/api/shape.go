@@ -3,6 +3,7 @@ package api
 import (
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/awslabs/aws-sdk-go/internal/util"
@@ -21,6 +22,11 @@ type ShapeRef struct {
 	XMLAttribute  bool
 	XMLNamespace  XMLInfo
 	Payload       string
+
+	IdempotencyToken bool
+	JSONValue        bool
+	HostLabel        bool
+	Sensitive        bool
 }
 
 type XMLInfo struct {
@@ -46,6 +52,10 @@ type Shape struct {
 	Location      string
 	LocationName  string
 	XMLNamespace  XMLInfo
+	Min           float64
+	Max           float64
+	Pattern       string
+	Sensitive     bool
 
 	refs []*ShapeRef
 }
@@ -101,6 +111,10 @@ func (ref *ShapeRef) GoType() string {
 		panic(fmt.Errorf("missing shape definition on reference for %#v", ref))
 	}
 
+	if ref.JSONValue {
+		return "aws.JSONValue"
+	}
+
 	return ref.Shape.GoType()
 }
 
@@ -170,6 +184,40 @@ func (ref *ShapeRef) GoTags(toplevel bool, isRequired bool) string {
 		code += `xmlAttribute:"true" `
 	}
 
+	if ref.Shape.Min != 0 {
+		code += `min:"` + strconv.FormatFloat(ref.Shape.Min, 'f', -1, 64) + `" `
+	}
+	if ref.Shape.Max != 0 {
+		code += `max:"` + strconv.FormatFloat(ref.Shape.Max, 'f', -1, 64) + `" `
+	}
+
+	// patterns with a backtick cannot be written in the raw string of a tag
+	if ref.Shape.Pattern != "" && !strings.Contains(ref.Shape.Pattern, "`") {
+		code += `pattern:` + strconv.Quote(ref.Shape.Pattern) + ` `
+	}
+
+	// values are listed comma separated, so those with a comma cannot be,
+	// nor can a backtick be written in the raw string of a tag
+	if ref.Shape.IsEnum() && !strings.ContainsAny(strings.Join(ref.Shape.Enum, ""), ",`") {
+		code += `enum:` + strconv.Quote(strings.Join(ref.Shape.Enum, ",")) + ` `
+	}
+
+	if ref.IdempotencyToken {
+		code += `idempotencyToken:"true" `
+	}
+
+	if ref.JSONValue {
+		code += `jsonvalue:"true" `
+	}
+
+	if ref.HostLabel {
+		code += `hostLabel:"true" `
+	}
+
+	if ref.Sensitive || ref.Shape.Sensitive {
+		code += `sensitive:"true" `
+	}
+
 	if isRequired {
 		code += `required:"true"`
 	}
@@ -233,6 +281,13 @@ func (s *Shape) GoCode() string {
 		code += "}\n\n"
 		code += "type " + metaStruct + " struct {\n"
 		code += "SDKShapeTraits bool " + ref.GoTags(true, false)
+		code += "}\n\n"
+
+		// shapes are printed, and logged, with their sensitive members redacted
+		s.API.imports["github.com/awslabs/aws-sdk-go/aws/awsutil"] = true
+		code += "// String returns the string representation of the " + s.ShapeName + ".\n"
+		code += "func (s " + s.ShapeName + ") String() string {\n"
+		code += "return awsutil.StringValue(s)\n"
 		code += "}"
 	default:
 		panic("Cannot generate toplevel shape for " + s.Type)
@@ -241,6 +296,74 @@ func (s *Shape) GoCode() string {
 	return util.GoFmt(code)
 }
 
+// IsEnum returns whether the shape is a string restricted to the values of
+// its enum trait.
+func (s *Shape) IsEnum() bool {
+	return s.Type == "string" && len(s.Enum) > 0
+}
+
+// EnumGoCode returns the Go code of the enum type of a string shape with an
+// enum trait: its constants, the list of its values, and the conversions to
+// and from the strings of members.
+func (s *Shape) EnumGoCode() string {
+	s.API.imports["fmt"] = true
+
+	code := "// " + s.ShapeName + " is an enum of the values of " + s.ShapeName + " members.\n"
+	code += "type " + s.ShapeName + " string\n\n"
+
+	names, seen := []string{}, map[string]bool{}
+	code += "// The values of " + s.ShapeName + ".\n"
+	code += "const (\n"
+	for _, v := range s.Enum {
+		n := s.ShapeName + enumValueName(v)
+		if n == s.ShapeName || seen[n] {
+			continue // values without a distinct name are only listed by value
+		}
+		seen[n] = true
+		names = append(names, n)
+		code += n + " " + s.ShapeName + " = " + strconv.Quote(v) + "\n"
+	}
+	code += ")\n\n"
+
+	code += "// " + s.ShapeName + "Values returns the values of " + s.ShapeName + " known to this\n"
+	code += "// version of the SDK. The service may accept values added since.\n"
+	code += "func " + s.ShapeName + "Values() []" + s.ShapeName + " {\n"
+	code += "return []" + s.ShapeName + "{\n"
+	for _, v := range s.Enum {
+		code += s.ShapeName + "(" + strconv.Quote(v) + "),\n"
+	}
+	code += "}\n}\n\n"
+
+	code += "// String returns the " + s.ShapeName + " as the string of a member.\n"
+	code += "func (v " + s.ShapeName + ") String() string {\n"
+	code += "return string(v)\n"
+	code += "}\n\n"
+
+	code += "// Parse" + s.ShapeName + " returns the " + s.ShapeName + " of str, failing if it is not\n"
+	code += "// one of the " + s.ShapeName + "Values.\n"
+	code += "func Parse" + s.ShapeName + "(str string) (" + s.ShapeName + ", error) {\n"
+	code += "for _, v := range " + s.ShapeName + "Values() {\n"
+	code += "if string(v) == str {\n"
+	code += "return v, nil\n"
+	code += "}\n}\n"
+	code += "return \"\", fmt.Errorf(\"invalid " + s.ShapeName + " value %q\", str)\n"
+	code += "}"
+
+	return util.GoFmt(code)
+}
+
+// enumValueName returns the suffix of the name of the constant of an enum
+// value, such as T1Micro for t1.micro.
+func enumValueName(v string) string {
+	name := ""
+	for _, part := range strings.FieldsFunc(v, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	}) {
+		name += strings.ToUpper(part[0:1]) + part[1:]
+	}
+	return name
+}
+
 func (s *Shape) IsRequired(member string) bool {
 	for _, n := range s.Required {
 		if n == member {
@@ -0,0 +1,131 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/awslabs/aws-sdk-go/internal/util"
+)
+
+// A Waiter is the definition of a waiter, loaded from the API's waiters
+// file.
+type Waiter struct {
+	Name          string
+	Delay         int
+	MaxAttempts   int
+	OperationName string `json:"operation"`
+	Acceptors     []WaiterAcceptor
+
+	API       *API       `json:"-"`
+	Operation *Operation `json:"-"`
+}
+
+// A WaiterAcceptor is the definition of one of a waiter's acceptors.
+type WaiterAcceptor struct {
+	State    string
+	Matcher  string
+	Argument string
+	Expected interface{}
+}
+
+// WaiterList returns the API's waiters sorted by name. Waiters whose
+// acceptors use path expressions that cannot be evaluated are skipped. It
+// panics if a waiter names an operation the API does not have.
+func (a *API) WaiterList() []*Waiter {
+	names := []string{}
+	for n := range a.Waiters {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	list := []*Waiter{}
+	for _, n := range names {
+		w := a.Waiters[n]
+		w.Name, w.API = n, a
+		w.Operation = a.operationByModelName(w.OperationName)
+		if w.Operation == nil {
+			panic(fmt.Sprintf("waiter %s names unknown operation %s in API %s", n, w.OperationName, a.PackageName()))
+		}
+		if w.supported() {
+			list = append(list, w)
+		}
+	}
+	return list
+}
+
+// supported returns false if any acceptor uses a JMESPath function or
+// comparison, which path matching does not support.
+func (w *Waiter) supported() bool {
+	for _, a := range w.Acceptors {
+		if strings.ContainsAny(a.Argument, "()<>=`") {
+			return false
+		}
+	}
+	return true
+}
+
+// AcceptorsGoCode returns the Go code for the waiter's acceptors.
+func (w *Waiter) AcceptorsGoCode() string {
+	code := []string{}
+	for _, a := range w.Acceptors {
+		c := fmt.Sprintf("{State: %q, Matcher: %q, ", a.State, a.Matcher)
+		if a.Argument != "" {
+			c += fmt.Sprintf("Argument: %q, ", w.API.exportPath(a.Argument))
+		}
+		c += fmt.Sprintf("Expected: %#v},", a.Expected)
+		code = append(code, c)
+	}
+	return strings.Join(code, "\n")
+}
+
+var tplWaiter = template.Must(template.New("waiter").Parse(`
+// WaitUntil{{ .Name }} polls the {{ .Operation.ExportedName }} operation every
+// {{ .Delay }} seconds until the {{ .Name }} state is reached. An error is returned
+// if a failure state is reached or the state is not reached within
+// {{ .MaxAttempts }} attempts.
+func (c *{{ .API.StructName }}) WaitUntil{{ .Name }}(input {{ .Operation.InputRef.GoType }}) error {
+	w := &aws.Waiter{
+		Delay:       {{ .Delay }} * time.Second,
+		MaxAttempts: {{ .MaxAttempts }},
+		Acceptors: []aws.WaiterAcceptor{
+			{{ .AcceptorsGoCode }}
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.{{ .Operation.ExportedName }}Request(input)
+			return req
+		},
+	}
+	return w.WaitUntil()
+}
+`))
+
+// GoCode returns the Go code of the waiter's WaitUntil method.
+func (w *Waiter) GoCode() string {
+	var buf bytes.Buffer
+	if err := tplWaiter.Execute(&buf, w); err != nil {
+		panic(err)
+	}
+	return strings.TrimSpace(util.GoFmt(buf.String()))
+}
+
+// WaitersGoCode returns the Go code of the API's waiters, or an empty string
+// if the API has none.
+func (a *API) WaitersGoCode() string {
+	waiters := a.WaiterList()
+	if len(waiters) == 0 {
+		return ""
+	}
+
+	code := []string{}
+	for _, w := range waiters {
+		code = append(code, w.GoCode())
+	}
+	return util.GoFmt(fmt.Sprintf("import (\n%q\n\n%q\n)\n\n%s",
+		"time",
+		"github.com/awslabs/aws-sdk-go/aws",
+		strings.Join(code, "\n\n"),
+	))
+}
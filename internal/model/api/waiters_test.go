@@ -0,0 +1,87 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaiters(t *testing.T) {
+	json := `{
+		"metadata": { "serviceFullName": "Amazon Things" },
+		"operations": {
+			"GetItem": {
+				"name": "GetItem",
+				"input": { "shape": "GetItemRequest" },
+				"output": { "shape": "GetItemResult" }
+			}
+		},
+		"shapes": {
+			"GetItemRequest": {
+				"type": "structure",
+				"members": { "name": { "shape": "String" } }
+			},
+			"GetItemResult": {
+				"type": "structure",
+				"members": { "items": { "shape": "ItemList" } }
+			},
+			"ItemList": { "type": "list", "member": { "shape": "Item" } },
+			"Item": {
+				"type": "structure",
+				"members": { "state": { "shape": "String" } }
+			},
+			"String": { "type": "string" }
+		}
+	}`
+	waiters := `{
+		"version": 2,
+		"waiters": {
+			"ItemReady": {
+				"delay": 15,
+				"operation": "GetItem",
+				"maxAttempts": 40,
+				"acceptors": [
+					{ "expected": "ready", "matcher": "pathAll", "state": "success", "argument": "items[].state" },
+					{ "expected": 404, "matcher": "status", "state": "retry" }
+				]
+			},
+			"ItemHasLength": {
+				"delay": 15,
+				"operation": "GetItem",
+				"maxAttempts": 40,
+				"acceptors": [
+					{ "expected": true, "matcher": "path", "state": "success", "argument": "length(items) > ` + "`0`" + `" }
+				]
+			}
+		}
+	}`
+	a := API{}
+	a.AttachString(json)
+	a.AttachString(waiters)
+
+	list := a.WaiterList()
+	assert.Len(t, list, 1)
+	assert.Equal(t, "ItemReady", list[0].Name)
+	assert.Equal(t, `{State: "success", Matcher: "pathAll", Argument: "Items[].State", Expected: "ready"},`+"\n"+
+		`{State: "retry", Matcher: "status", Expected: 404},`, list[0].AcceptorsGoCode())
+
+	code := a.WaitersGoCode()
+	assert.Contains(t, code, "func (c *Things) WaitUntilItemReady(input *GetItemInput) error {")
+	assert.Contains(t, code, "Delay:       15 * time.Second,")
+	assert.Contains(t, code, "req, _ := c.GetItemRequest(input)")
+}
+
+func TestWaitersUnknownOperation(t *testing.T) {
+	a := API{}
+	a.AttachString(`{
+		"metadata": { "apiVersion": "2014-11-06", "serviceFullName": "Amazon Things" },
+		"operations": { "GetItem": { "name": "GetItem2014_11_06" } },
+		"shapes": {}
+	}`)
+	a.AttachString(`{"waiters": {"ItemReady": {"delay": 1, "operation": "GetItem", "maxAttempts": 1, "acceptors": []}}}`)
+	assert.Equal(t, "GetItem", a.WaiterList()[0].Operation.ExportedName)
+
+	a.AttachString(`{"waiters": {"Unknown": {"delay": 1, "operation": "Missing", "maxAttempts": 1, "acceptors": []}}}`)
+	assert.Equal(t, "waiter Unknown names unknown operation Missing in API things",
+		panicValue(func() { a.WaiterList() }))
+}
@@ -28,6 +28,13 @@ func newGenerateInfo(modelFile, svcPath string, forceService bool) *generateInfo
 	g := &generateInfo{API: &api.API{}, ForceService: forceService}
 	g.API.Attach(modelFile)
 
+	for _, ext := range []string{".paginators.json", ".waiters.json"} {
+		file := strings.Replace(modelFile, ".normal.json", ext, 1)
+		if _, err := os.Stat(file); err == nil {
+			g.API.Attach(file)
+		}
+	}
+
 	// ensure the directory exists
 	pkgDir := filepath.Join(svcPath, g.API.PackageName())
 	os.MkdirAll(pkgDir, 0775)
@@ -63,16 +70,20 @@ func main() {
 	m := map[string]bool{}
 	r := sync.Mutex{}
 	w := sync.WaitGroup{}
+	failed := false
 	for i := range files {
 		w.Add(1)
 		file := files[len(files)-1-i]
 		go func() {
 			defer func() {
-				w.Done()
-				if r := recover(); r != nil {
+				if e := recover(); e != nil {
 					fmtStr := "Error generating %s\n%s\n%s\n"
-					fmt.Fprintf(os.Stderr, fmtStr, file, r, debug.Stack())
+					fmt.Fprintf(os.Stderr, fmtStr, file, e, debug.Stack())
+					r.Lock()
+					failed = true
+					r.Unlock()
 				}
+				w.Done()
 			}()
 
 			g := newGenerateInfo(file, svcPath, forceService)
@@ -88,9 +99,14 @@ func main() {
 			g.writeAPIFile()
 			g.writeExamplesFile()
 			g.writeServiceFile()
+			g.writeWaitersFile()
 		}()
 	}
 	w.Wait()
+
+	if failed {
+		os.Exit(1)
+	}
 }
 
 func (g *generateInfo) writeExamplesFile() {
@@ -105,6 +121,15 @@ func (g *generateInfo) writeServiceFile() {
 	}
 }
 
+func (g *generateInfo) writeWaitersFile() {
+	code := g.API.WaitersGoCode()
+	if code == "" {
+		return
+	}
+	file := filepath.Join(g.PackageDir, "waiters.go")
+	ioutil.WriteFile(file, []byte(note+"\n\npackage "+g.API.PackageName()+"\n\n"+code), 0664)
+}
+
 const note = "// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT."
 
 func (g *generateInfo) writeAPIFile() {
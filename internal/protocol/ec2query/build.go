@@ -6,6 +6,7 @@ import (
 	"net/url"
 
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/internal/protocol"
 	"github.com/awslabs/aws-sdk-go/internal/protocol/query/queryutil"
 )
 
@@ -20,6 +21,6 @@ func Build(r *aws.Request) {
 	}
 
 	r.HTTPRequest.Method = "POST"
-	r.HTTPRequest.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	r.HTTPRequest.Header.Set("Content-Type", protocol.FormURLEncodedContentType)
 	r.SetBufferBody([]byte(body.Encode()))
 }
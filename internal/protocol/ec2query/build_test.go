@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 	"github.com/awslabs/aws-sdk-go/internal/protocol/xml/xmlutil"
 	"github.com/awslabs/aws-sdk-go/internal/util"
 	"github.com/stretchr/testify/assert"
@@ -27,6 +28,7 @@ var _ xml.Attr
 var _ = ioutil.Discard
 var _ = util.Trim("")
 var _ = url.Values{}
+var _ = awsutil.StringValue
 
 // InputService1ProtocolTest is a client for InputService1ProtocolTest.
 type InputService1ProtocolTest struct {
@@ -47,7 +49,7 @@ func NewInputService1ProtocolTest(config *aws.Config) *InputService1ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(ec2query.Build)
 	service.Handlers.Unmarshal.PushBack(ec2query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(ec2query.UnmarshalMeta)
@@ -87,6 +89,11 @@ type metadataInputService1TestShapeInputService1TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService1TestShapeInputService1TestCaseOperation1Output.
+func (s InputService1TestShapeInputService1TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService1TestShapeInputShape struct {
 	Bar *string `type:"string"`
 
@@ -99,6 +106,11 @@ type metadataInputService1TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService1TestShapeInputShape.
+func (s InputService1TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService2ProtocolTest is a client for InputService2ProtocolTest.
 type InputService2ProtocolTest struct {
 	*aws.Service
@@ -118,7 +130,7 @@ func NewInputService2ProtocolTest(config *aws.Config) *InputService2ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(ec2query.Build)
 	service.Handlers.Unmarshal.PushBack(ec2query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(ec2query.UnmarshalMeta)
@@ -158,6 +170,11 @@ type metadataInputService2TestShapeInputService2TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService2TestShapeInputService2TestCaseOperation1Output.
+func (s InputService2TestShapeInputService2TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService2TestShapeInputShape struct {
 	Bar *string `locationName:"barLocationName" type:"string"`
 
@@ -172,6 +189,11 @@ type metadataInputService2TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService2TestShapeInputShape.
+func (s InputService2TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService3ProtocolTest is a client for InputService3ProtocolTest.
 type InputService3ProtocolTest struct {
 	*aws.Service
@@ -191,7 +213,7 @@ func NewInputService3ProtocolTest(config *aws.Config) *InputService3ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(ec2query.Build)
 	service.Handlers.Unmarshal.PushBack(ec2query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(ec2query.UnmarshalMeta)
@@ -231,6 +253,11 @@ type metadataInputService3TestShapeInputService3TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService3TestShapeInputService3TestCaseOperation1Output.
+func (s InputService3TestShapeInputService3TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService3TestShapeInputShape struct {
 	StructArg *InputService3TestShapeStructType `locationName:"Struct" type:"structure"`
 
@@ -241,6 +268,11 @@ type metadataInputService3TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService3TestShapeInputShape.
+func (s InputService3TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService3TestShapeStructType struct {
 	ScalarArg *string `locationName:"Scalar" type:"string"`
 
@@ -251,6 +283,11 @@ type metadataInputService3TestShapeStructType struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService3TestShapeStructType.
+func (s InputService3TestShapeStructType) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService4ProtocolTest is a client for InputService4ProtocolTest.
 type InputService4ProtocolTest struct {
 	*aws.Service
@@ -270,7 +307,7 @@ func NewInputService4ProtocolTest(config *aws.Config) *InputService4ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(ec2query.Build)
 	service.Handlers.Unmarshal.PushBack(ec2query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(ec2query.UnmarshalMeta)
@@ -310,6 +347,11 @@ type metadataInputService4TestShapeInputService4TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService4TestShapeInputService4TestCaseOperation1Output.
+func (s InputService4TestShapeInputService4TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService4TestShapeInputShape struct {
 	ListArg []*string `type:"list"`
 
@@ -320,6 +362,11 @@ type metadataInputService4TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService4TestShapeInputShape.
+func (s InputService4TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService5ProtocolTest is a client for InputService5ProtocolTest.
 type InputService5ProtocolTest struct {
 	*aws.Service
@@ -339,7 +386,7 @@ func NewInputService5ProtocolTest(config *aws.Config) *InputService5ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(ec2query.Build)
 	service.Handlers.Unmarshal.PushBack(ec2query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(ec2query.UnmarshalMeta)
@@ -379,6 +426,11 @@ type metadataInputService5TestShapeInputService5TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService5TestShapeInputService5TestCaseOperation1Output.
+func (s InputService5TestShapeInputService5TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService5TestShapeInputShape struct {
 	ListArg []*string `locationName:"ListMemberName" locationNameList:"item" type:"list"`
 
@@ -389,6 +441,11 @@ type metadataInputService5TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService5TestShapeInputShape.
+func (s InputService5TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService6ProtocolTest is a client for InputService6ProtocolTest.
 type InputService6ProtocolTest struct {
 	*aws.Service
@@ -408,7 +465,7 @@ func NewInputService6ProtocolTest(config *aws.Config) *InputService6ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(ec2query.Build)
 	service.Handlers.Unmarshal.PushBack(ec2query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(ec2query.UnmarshalMeta)
@@ -448,6 +505,11 @@ type metadataInputService6TestShapeInputService6TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService6TestShapeInputService6TestCaseOperation1Output.
+func (s InputService6TestShapeInputService6TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService6TestShapeInputShape struct {
 	ListArg []*string `locationName:"ListMemberName" queryName:"ListQueryName" locationNameList:"item" type:"list"`
 
@@ -458,6 +520,11 @@ type metadataInputService6TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService6TestShapeInputShape.
+func (s InputService6TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService7ProtocolTest is a client for InputService7ProtocolTest.
 type InputService7ProtocolTest struct {
 	*aws.Service
@@ -477,7 +544,7 @@ func NewInputService7ProtocolTest(config *aws.Config) *InputService7ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(ec2query.Build)
 	service.Handlers.Unmarshal.PushBack(ec2query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(ec2query.UnmarshalMeta)
@@ -517,6 +584,11 @@ type metadataInputService7TestShapeInputService7TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService7TestShapeInputService7TestCaseOperation1Output.
+func (s InputService7TestShapeInputService7TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService7TestShapeInputShape struct {
 	BlobArg []byte `type:"blob"`
 
@@ -527,6 +599,11 @@ type metadataInputService7TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService7TestShapeInputShape.
+func (s InputService7TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService8ProtocolTest is a client for InputService8ProtocolTest.
 type InputService8ProtocolTest struct {
 	*aws.Service
@@ -546,7 +623,7 @@ func NewInputService8ProtocolTest(config *aws.Config) *InputService8ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(ec2query.Build)
 	service.Handlers.Unmarshal.PushBack(ec2query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(ec2query.UnmarshalMeta)
@@ -586,6 +663,11 @@ type metadataInputService8TestShapeInputService8TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService8TestShapeInputService8TestCaseOperation1Output.
+func (s InputService8TestShapeInputService8TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService8TestShapeInputShape struct {
 	TimeArg *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
@@ -596,6 +678,11 @@ type metadataInputService8TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService8TestShapeInputShape.
+func (s InputService8TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 //
 // Tests begin here
 //
@@ -3,18 +3,38 @@ package ec2query
 //go:generate go run ../../fixtures/protocol/generate.go ../../fixtures/protocol/output/ec2.json unmarshal_test.go
 
 import (
+	"bytes"
 	"encoding/xml"
 	"io"
+	"io/ioutil"
 
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awserr"
 	"github.com/awslabs/aws-sdk-go/internal/protocol/xml/xmlutil"
 )
 
+// xmlResponseMeta is the metadata EC2 appends to the body of every response.
+type xmlResponseMeta struct {
+	RequestID string `xml:"requestId"`
+}
+
 func Unmarshal(r *aws.Request) {
 	defer r.HTTPResponse.Body.Close()
+
+	b, err := ioutil.ReadAll(r.HTTPResponse.Body)
+	if err != nil {
+		r.Error = err
+		return
+	}
+
+	meta := xmlResponseMeta{}
+	if err := xml.Unmarshal(b, &meta); err == nil {
+		r.RequestID = meta.RequestID
+	}
+
 	if r.DataFilled() {
-		decoder := xml.NewDecoder(r.HTTPResponse.Body)
-		err := xmlutil.UnmarshalXML(r.Data, decoder, "")
+		decoder := xml.NewDecoder(bytes.NewReader(b))
+		err := xmlutil.UnmarshalUnwrappedListsXML(r.Data, decoder, "")
 		if err != nil {
 			r.Error = err
 			return
@@ -23,14 +43,14 @@ func Unmarshal(r *aws.Request) {
 }
 
 func UnmarshalMeta(r *aws.Request) {
-	// TODO implement unmarshaling of request IDs
+	// the request ID is part of the response body, and is read by Unmarshal
 }
 
 type xmlErrorResponse struct {
 	XMLName   xml.Name `xml:"Response"`
 	Code      string   `xml:"Errors>Error>Code"`
 	Message   string   `xml:"Errors>Error>Message"`
-	RequestID string   `xml:"RequestId"`
+	RequestID string   `xml:"RequestID"`
 }
 
 func UnmarshalError(r *aws.Request) {
@@ -39,12 +59,12 @@ func UnmarshalError(r *aws.Request) {
 	resp := &xmlErrorResponse{}
 	err := xml.NewDecoder(r.HTTPResponse.Body).Decode(resp)
 	if err != nil && err != io.EOF {
-		r.Error = err
+		r.Error = awserr.New("SerializationError", "failed to decode EC2 XML error response", err)
 	} else {
-		r.Error = aws.APIError{
-			StatusCode: r.HTTPResponse.StatusCode,
-			Code:       resp.Code,
-			Message:    resp.Message,
-		}
+		r.Error = awserr.NewRequestFailure(
+			awserr.New(resp.Code, resp.Message, nil),
+			r.HTTPResponse.StatusCode,
+			resp.RequestID,
+		)
 	}
 }
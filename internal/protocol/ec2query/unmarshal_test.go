@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 	"github.com/awslabs/aws-sdk-go/internal/protocol/xml/xmlutil"
 	"github.com/awslabs/aws-sdk-go/internal/util"
 	"github.com/stretchr/testify/assert"
@@ -27,6 +28,7 @@ var _ xml.Attr
 var _ = ioutil.Discard
 var _ = util.Trim("")
 var _ = url.Values{}
+var _ = awsutil.StringValue
 
 // OutputService1ProtocolTest is a client for OutputService1ProtocolTest.
 type OutputService1ProtocolTest struct {
@@ -47,7 +49,7 @@ func NewOutputService1ProtocolTest(config *aws.Config) *OutputService1ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(ec2query.Build)
 	service.Handlers.Unmarshal.PushBack(ec2query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(ec2query.UnmarshalMeta)
@@ -87,6 +89,11 @@ type metadataOutputService1TestShapeOutputService1TestShapeOutputService1TestCas
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService1TestShapeOutputService1TestShapeOutputService1TestCaseOperation1Input.
+func (s OutputService1TestShapeOutputService1TestShapeOutputService1TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService1TestShapeOutputShape struct {
 	Char *string `type:"character"`
 
@@ -111,6 +118,11 @@ type metadataOutputService1TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService1TestShapeOutputShape.
+func (s OutputService1TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // OutputService2ProtocolTest is a client for OutputService2ProtocolTest.
 type OutputService2ProtocolTest struct {
 	*aws.Service
@@ -130,7 +142,7 @@ func NewOutputService2ProtocolTest(config *aws.Config) *OutputService2ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(ec2query.Build)
 	service.Handlers.Unmarshal.PushBack(ec2query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(ec2query.UnmarshalMeta)
@@ -170,6 +182,11 @@ type metadataOutputService2TestShapeOutputService2TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService2TestShapeOutputService2TestCaseOperation1Input.
+func (s OutputService2TestShapeOutputService2TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService2TestShapeOutputShape struct {
 	Blob []byte `type:"blob"`
 
@@ -180,6 +197,11 @@ type metadataOutputService2TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService2TestShapeOutputShape.
+func (s OutputService2TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // OutputService3ProtocolTest is a client for OutputService3ProtocolTest.
 type OutputService3ProtocolTest struct {
 	*aws.Service
@@ -199,7 +221,7 @@ func NewOutputService3ProtocolTest(config *aws.Config) *OutputService3ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(ec2query.Build)
 	service.Handlers.Unmarshal.PushBack(ec2query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(ec2query.UnmarshalMeta)
@@ -239,6 +261,11 @@ type metadataOutputService3TestShapeOutputService3TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService3TestShapeOutputService3TestCaseOperation1Input.
+func (s OutputService3TestShapeOutputService3TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService3TestShapeOutputShape struct {
 	ListMember []*string `type:"list"`
 
@@ -249,6 +276,11 @@ type metadataOutputService3TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService3TestShapeOutputShape.
+func (s OutputService3TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // OutputService4ProtocolTest is a client for OutputService4ProtocolTest.
 type OutputService4ProtocolTest struct {
 	*aws.Service
@@ -268,7 +300,7 @@ func NewOutputService4ProtocolTest(config *aws.Config) *OutputService4ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(ec2query.Build)
 	service.Handlers.Unmarshal.PushBack(ec2query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(ec2query.UnmarshalMeta)
@@ -308,6 +340,11 @@ type metadataOutputService4TestShapeOutputService4TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService4TestShapeOutputService4TestCaseOperation1Input.
+func (s OutputService4TestShapeOutputService4TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService4TestShapeOutputShape struct {
 	ListMember []*string `locationNameList:"item" type:"list"`
 
@@ -318,6 +355,11 @@ type metadataOutputService4TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService4TestShapeOutputShape.
+func (s OutputService4TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // OutputService5ProtocolTest is a client for OutputService5ProtocolTest.
 type OutputService5ProtocolTest struct {
 	*aws.Service
@@ -337,7 +379,7 @@ func NewOutputService5ProtocolTest(config *aws.Config) *OutputService5ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(ec2query.Build)
 	service.Handlers.Unmarshal.PushBack(ec2query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(ec2query.UnmarshalMeta)
@@ -377,6 +419,11 @@ type metadataOutputService5TestShapeOutputService5TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService5TestShapeOutputService5TestCaseOperation1Input.
+func (s OutputService5TestShapeOutputService5TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService5TestShapeOutputShape struct {
 	ListMember []*string `type:"list" flattened:"true"`
 
@@ -387,6 +434,11 @@ type metadataOutputService5TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService5TestShapeOutputShape.
+func (s OutputService5TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // OutputService6ProtocolTest is a client for OutputService6ProtocolTest.
 type OutputService6ProtocolTest struct {
 	*aws.Service
@@ -406,7 +458,7 @@ func NewOutputService6ProtocolTest(config *aws.Config) *OutputService6ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(ec2query.Build)
 	service.Handlers.Unmarshal.PushBack(ec2query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(ec2query.UnmarshalMeta)
@@ -446,6 +498,11 @@ type metadataOutputService6TestShapeOutputService6TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService6TestShapeOutputService6TestCaseOperation1Input.
+func (s OutputService6TestShapeOutputService6TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService6TestShapeOutputShape struct {
 	Map *map[string]*OutputService6TestShapeStructureType `type:"map"`
 
@@ -456,6 +513,11 @@ type metadataOutputService6TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService6TestShapeOutputShape.
+func (s OutputService6TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService6TestShapeStructureType struct {
 	Foo *string `locationName:"foo" type:"string"`
 
@@ -466,6 +528,11 @@ type metadataOutputService6TestShapeStructureType struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService6TestShapeStructureType.
+func (s OutputService6TestShapeStructureType) String() string {
+	return awsutil.StringValue(s)
+}
+
 // OutputService7ProtocolTest is a client for OutputService7ProtocolTest.
 type OutputService7ProtocolTest struct {
 	*aws.Service
@@ -485,7 +552,7 @@ func NewOutputService7ProtocolTest(config *aws.Config) *OutputService7ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(ec2query.Build)
 	service.Handlers.Unmarshal.PushBack(ec2query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(ec2query.UnmarshalMeta)
@@ -525,6 +592,11 @@ type metadataOutputService7TestShapeOutputService7TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService7TestShapeOutputService7TestCaseOperation1Input.
+func (s OutputService7TestShapeOutputService7TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService7TestShapeOutputShape struct {
 	Map *map[string]*string `type:"map" flattened:"true"`
 
@@ -535,6 +607,11 @@ type metadataOutputService7TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService7TestShapeOutputShape.
+func (s OutputService7TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // OutputService8ProtocolTest is a client for OutputService8ProtocolTest.
 type OutputService8ProtocolTest struct {
 	*aws.Service
@@ -554,7 +631,7 @@ func NewOutputService8ProtocolTest(config *aws.Config) *OutputService8ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(ec2query.Build)
 	service.Handlers.Unmarshal.PushBack(ec2query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(ec2query.UnmarshalMeta)
@@ -594,6 +671,11 @@ type metadataOutputService8TestShapeOutputService8TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService8TestShapeOutputService8TestCaseOperation1Input.
+func (s OutputService8TestShapeOutputService8TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService8TestShapeOutputShape struct {
 	Map *map[string]*string `locationNameKey:"foo" locationNameValue:"bar" type:"map" flattened:"true"`
 
@@ -604,6 +686,201 @@ type metadataOutputService8TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService8TestShapeOutputShape.
+func (s OutputService8TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// OutputService9ProtocolTest is a client for OutputService9ProtocolTest.
+type OutputService9ProtocolTest struct {
+	*aws.Service
+}
+
+// New returns a new OutputService9ProtocolTest client.
+func NewOutputService9ProtocolTest(config *aws.Config) *OutputService9ProtocolTest {
+	if config == nil {
+		config = &aws.Config{}
+	}
+
+	service := &aws.Service{
+		Config:      aws.DefaultConfig.Merge(config),
+		ServiceName: "outputservice9protocoltest",
+		APIVersion:  "",
+	}
+	service.Initialize()
+
+	// Handlers
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBack(ec2query.Build)
+	service.Handlers.Unmarshal.PushBack(ec2query.Unmarshal)
+	service.Handlers.UnmarshalMeta.PushBack(ec2query.UnmarshalMeta)
+	service.Handlers.UnmarshalError.PushBack(ec2query.UnmarshalError)
+
+	return &OutputService9ProtocolTest{service}
+}
+
+// OutputService9TestCaseOperation1Request generates a request for the OutputService9TestCaseOperation1 operation.
+func (c *OutputService9ProtocolTest) OutputService9TestCaseOperation1Request(input *OutputService9TestShapeOutputService9TestCaseOperation1Input) (req *aws.Request, output *OutputService9TestShapeOutputShape) {
+	if opOutputService9TestCaseOperation1 == nil {
+		opOutputService9TestCaseOperation1 = &aws.Operation{
+			Name: "OperationName",
+		}
+	}
+
+	req = aws.NewRequest(c.Service, opOutputService9TestCaseOperation1, input, output)
+	output = &OutputService9TestShapeOutputShape{}
+	req.Data = output
+	return
+}
+
+func (c *OutputService9ProtocolTest) OutputService9TestCaseOperation1(input *OutputService9TestShapeOutputService9TestCaseOperation1Input) (output *OutputService9TestShapeOutputShape, err error) {
+	req, out := c.OutputService9TestCaseOperation1Request(input)
+	output = out
+	err = req.Send()
+	return
+}
+
+var opOutputService9TestCaseOperation1 *aws.Operation
+
+type OutputService9TestShapeOutputService9TestCaseOperation1Input struct {
+	metadataOutputService9TestShapeOutputService9TestCaseOperation1Input `json:"-", xml:"-"`
+}
+
+type metadataOutputService9TestShapeOutputService9TestCaseOperation1Input struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the OutputService9TestShapeOutputService9TestCaseOperation1Input.
+func (s OutputService9TestShapeOutputService9TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+type OutputService9TestShapeOutputShape struct {
+	ListMember []*string `locationName:"item" type:"list"`
+
+	metadataOutputService9TestShapeOutputShape `json:"-", xml:"-"`
+}
+
+type metadataOutputService9TestShapeOutputShape struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the OutputService9TestShapeOutputShape.
+func (s OutputService9TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+// OutputService10ProtocolTest is a client for OutputService10ProtocolTest.
+type OutputService10ProtocolTest struct {
+	*aws.Service
+}
+
+// New returns a new OutputService10ProtocolTest client.
+func NewOutputService10ProtocolTest(config *aws.Config) *OutputService10ProtocolTest {
+	if config == nil {
+		config = &aws.Config{}
+	}
+
+	service := &aws.Service{
+		Config:      aws.DefaultConfig.Merge(config),
+		ServiceName: "outputservice10protocoltest",
+		APIVersion:  "",
+	}
+	service.Initialize()
+
+	// Handlers
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+	service.Handlers.Build.PushBack(ec2query.Build)
+	service.Handlers.Unmarshal.PushBack(ec2query.Unmarshal)
+	service.Handlers.UnmarshalMeta.PushBack(ec2query.UnmarshalMeta)
+	service.Handlers.UnmarshalError.PushBack(ec2query.UnmarshalError)
+
+	return &OutputService10ProtocolTest{service}
+}
+
+// OutputService10TestCaseOperation1Request generates a request for the OutputService10TestCaseOperation1 operation.
+func (c *OutputService10ProtocolTest) OutputService10TestCaseOperation1Request(input *OutputService10TestShapeOutputService10TestCaseOperation1Input) (req *aws.Request, output *OutputService10TestShapeOutputShape) {
+	if opOutputService10TestCaseOperation1 == nil {
+		opOutputService10TestCaseOperation1 = &aws.Operation{
+			Name: "OperationName",
+		}
+	}
+
+	req = aws.NewRequest(c.Service, opOutputService10TestCaseOperation1, input, output)
+	output = &OutputService10TestShapeOutputShape{}
+	req.Data = output
+	return
+}
+
+func (c *OutputService10ProtocolTest) OutputService10TestCaseOperation1(input *OutputService10TestShapeOutputService10TestCaseOperation1Input) (output *OutputService10TestShapeOutputShape, err error) {
+	req, out := c.OutputService10TestCaseOperation1Request(input)
+	output = out
+	err = req.Send()
+	return
+}
+
+var opOutputService10TestCaseOperation1 *aws.Operation
+
+type OutputService10TestShapeInstance struct {
+	InstanceId *string `locationName:"instanceId" type:"string"`
+
+	metadataOutputService10TestShapeInstance `json:"-", xml:"-"`
+}
+
+type metadataOutputService10TestShapeInstance struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the OutputService10TestShapeInstance.
+func (s OutputService10TestShapeInstance) String() string {
+	return awsutil.StringValue(s)
+}
+
+type OutputService10TestShapeOutputService10TestCaseOperation1Input struct {
+	metadataOutputService10TestShapeOutputService10TestCaseOperation1Input `json:"-", xml:"-"`
+}
+
+type metadataOutputService10TestShapeOutputService10TestCaseOperation1Input struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the OutputService10TestShapeOutputService10TestCaseOperation1Input.
+func (s OutputService10TestShapeOutputService10TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
+type OutputService10TestShapeOutputShape struct {
+	Reservations []*OutputService10TestShapeReservation `locationName:"reservationSet" locationNameList:"item" type:"list"`
+
+	metadataOutputService10TestShapeOutputShape `json:"-", xml:"-"`
+}
+
+type metadataOutputService10TestShapeOutputShape struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the OutputService10TestShapeOutputShape.
+func (s OutputService10TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
+type OutputService10TestShapeReservation struct {
+	Instances []*OutputService10TestShapeInstance `locationName:"instancesSet" locationNameList:"item" type:"list"`
+
+	ReservationId *string `locationName:"reservationId" type:"string"`
+
+	metadataOutputService10TestShapeReservation `json:"-", xml:"-"`
+}
+
+type metadataOutputService10TestShapeReservation struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the OutputService10TestShapeReservation.
+func (s OutputService10TestShapeReservation) String() string {
+	return awsutil.StringValue(s)
+}
+
 //
 // Tests begin here
 //
@@ -781,3 +1058,48 @@ func TestOutputService8ProtocolTestNamedMapCase1(t *testing.T) {
 
 }
 
+func TestOutputService9ProtocolTestListWithoutMemberWrapperCase1(t *testing.T) {
+	svc := NewOutputService9ProtocolTest(nil)
+
+	buf := bytes.NewReader([]byte("<OperationNameResponse><requestId>requestid</requestId><item>abc</item><item>123</item></OperationNameResponse>"))
+	req, out := svc.OutputService9TestCaseOperation1Request(nil)
+	req.HTTPResponse = &http.Response{StatusCode: 200, Body: ioutil.NopCloser(buf), Header: http.Header{}}
+
+	// set headers
+
+	// unmarshal response
+	ec2query.UnmarshalMeta(req)
+	ec2query.Unmarshal(req)
+	assert.NoError(t, req.Error)
+
+	// assert response
+	assert.NotNil(t, out) // ensure out variable is used
+	assert.Equal(t, "abc", *out.ListMember[0])
+	assert.Equal(t, "123", *out.ListMember[1])
+
+}
+
+func TestOutputService10ProtocolTestNestedStructureListsCase1(t *testing.T) {
+	svc := NewOutputService10ProtocolTest(nil)
+
+	buf := bytes.NewReader([]byte("<OperationNameResponse><requestId>requestid</requestId><reservationSet><item><reservationId>r-1</reservationId><instancesSet><item><instanceId>i-1</instanceId></item><item><instanceId>i-2</instanceId></item></instancesSet></item><item><reservationId>r-2</reservationId><instancesSet><item><instanceId>i-3</instanceId></item></instancesSet></item></reservationSet></OperationNameResponse>"))
+	req, out := svc.OutputService10TestCaseOperation1Request(nil)
+	req.HTTPResponse = &http.Response{StatusCode: 200, Body: ioutil.NopCloser(buf), Header: http.Header{}}
+
+	// set headers
+
+	// unmarshal response
+	ec2query.UnmarshalMeta(req)
+	ec2query.Unmarshal(req)
+	assert.NoError(t, req.Error)
+
+	// assert response
+	assert.NotNil(t, out) // ensure out variable is used
+	assert.Equal(t, "i-1", *out.Reservations[0].Instances[0].InstanceId)
+	assert.Equal(t, "i-2", *out.Reservations[0].Instances[1].InstanceId)
+	assert.Equal(t, "r-1", *out.Reservations[0].ReservationId)
+	assert.Equal(t, "i-3", *out.Reservations[1].Instances[0].InstanceId)
+	assert.Equal(t, "r-2", *out.Reservations[1].ReservationId)
+
+}
+
@@ -0,0 +1,159 @@
+package eventstream
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+
+	"github.com/awslabs/aws-sdk-go/aws/awserr"
+)
+
+// A Decoder reads the messages of an event stream.
+type Decoder struct {
+	r io.Reader
+}
+
+// NewDecoder returns a Decoder reading messages from r.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{r: r}
+}
+
+// Decode reads the next message of the stream. Its prelude and message
+// checksums are validated before its headers are parsed. Decode returns
+// io.EOF when the stream ends between messages, and an error if it ends
+// within one.
+func (d *Decoder) Decode() (Message, error) {
+	prelude := make([]byte, preludeLen)
+	if _, err := io.ReadFull(d.r, prelude); err != nil {
+		if err == io.EOF {
+			return Message{}, io.EOF
+		}
+		return Message{}, serializationError("failed to read event stream message prelude", err)
+	}
+
+	totalLen := binary.BigEndian.Uint32(prelude[0:4])
+	headersLen := binary.BigEndian.Uint32(prelude[4:8])
+	if sum := crc32.ChecksumIEEE(prelude[0:8]); sum != binary.BigEndian.Uint32(prelude[8:12]) {
+		return Message{}, checksumError("prelude", sum, binary.BigEndian.Uint32(prelude[8:12]))
+	}
+
+	if totalLen < minMessageLen || totalLen > maxMessageLen {
+		return Message{}, serializationError(fmt.Sprintf("invalid event stream message length %d", totalLen), nil)
+	}
+	if headersLen > maxHeadersLen || headersLen > totalLen-minMessageLen {
+		return Message{}, serializationError(fmt.Sprintf("invalid event stream headers length %d", headersLen), nil)
+	}
+
+	msg := make([]byte, totalLen)
+	copy(msg, prelude)
+	if _, err := io.ReadFull(d.r, msg[preludeLen:]); err != nil {
+		return Message{}, serializationError("failed to read event stream message", err)
+	}
+
+	end := totalLen - 4
+	if sum := crc32.ChecksumIEEE(msg[:end]); sum != binary.BigEndian.Uint32(msg[end:]) {
+		return Message{}, checksumError("message", sum, binary.BigEndian.Uint32(msg[end:]))
+	}
+
+	headers, err := decodeHeaders(msg[preludeLen : preludeLen+headersLen])
+	if err != nil {
+		return Message{}, err
+	}
+
+	return Message{Headers: headers, Payload: msg[preludeLen+headersLen : end]}, nil
+}
+
+// decodeHeaders parses the encoded headers of a message.
+func decodeHeaders(b []byte) (Headers, error) {
+	r := bytes.NewReader(b)
+	headers := Headers{}
+	for r.Len() > 0 {
+		nameLen, err := r.ReadByte()
+		if err != nil {
+			return nil, headerError(err)
+		}
+		name := make([]byte, nameLen)
+		if _, err := io.ReadFull(r, name); err != nil {
+			return nil, headerError(err)
+		}
+
+		value, err := decodeHeaderValue(r)
+		if err != nil {
+			return nil, headerError(err)
+		}
+		headers = append(headers, Header{Name: string(name), Value: value})
+	}
+	return headers, nil
+}
+
+// decodeHeaderValue parses the type and value of a header.
+func decodeHeaderValue(r *bytes.Reader) (interface{}, error) {
+	t, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+
+	switch t {
+	case trueValueType:
+		return true, nil
+	case falseValueType:
+		return false, nil
+	case int8ValueType:
+		var v int8
+		err := binary.Read(r, binary.BigEndian, &v)
+		return v, err
+	case int16ValueType:
+		var v int16
+		err := binary.Read(r, binary.BigEndian, &v)
+		return v, err
+	case int32ValueType:
+		var v int32
+		err := binary.Read(r, binary.BigEndian, &v)
+		return v, err
+	case int64ValueType:
+		var v int64
+		err := binary.Read(r, binary.BigEndian, &v)
+		return v, err
+	case bytesValueType, stringValueType:
+		var n uint16
+		if err := binary.Read(r, binary.BigEndian, &n); err != nil {
+			return nil, err
+		}
+		v := make([]byte, n)
+		if _, err := io.ReadFull(r, v); err != nil {
+			return nil, err
+		}
+		if t == stringValueType {
+			return string(v), nil
+		}
+		return v, nil
+	case timestampValueType:
+		var ms int64
+		err := binary.Read(r, binary.BigEndian, &ms)
+		return timestampValue(ms), err
+	case uuidValueType:
+		var v UUID
+		_, err := io.ReadFull(r, v[:])
+		return v, err
+	default:
+		return nil, fmt.Errorf("unknown header value type %d", t)
+	}
+}
+
+func serializationError(msg string, err error) error {
+	return awserr.New("SerializationError", msg, err)
+}
+
+func headerError(err error) error {
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return serializationError("failed to decode event stream message headers", err)
+}
+
+func checksumError(part string, actual, expected uint32) error {
+	return awserr.New("ChecksumMismatch", fmt.Sprintf(
+		"event stream %s checksum mismatch, computed %08x, expected %08x", part, actual, expected), nil)
+}
@@ -0,0 +1,90 @@
+// Package eventstream decodes the application/vnd.amazon.eventstream framing
+// of streaming operations' responses, a sequence of length prefixed,
+// checksummed messages each carrying typed headers and a payload.
+package eventstream
+
+import (
+	"fmt"
+	"time"
+)
+
+// ContentType is the content type of event stream response bodies.
+const ContentType = "application/vnd.amazon.eventstream"
+
+const (
+	// preludeLen is the length of a message's total length, headers length
+	// and prelude checksum.
+	preludeLen = 12
+
+	// minMessageLen is the length of a message without headers or payload.
+	minMessageLen = preludeLen + 4
+
+	// maxMessageLen bounds the length of a message.
+	maxMessageLen = 16 * 1024 * 1024
+
+	// maxHeadersLen bounds the length of a message's headers.
+	maxHeadersLen = 128 * 1024
+)
+
+// A Message is a message of an event stream.
+type Message struct {
+	Headers Headers
+	Payload []byte
+}
+
+// A Header is a named, typed value of a message's headers. Values are decoded
+// as a bool, int8, int16, int32, int64, []byte, string, time.Time or UUID.
+type Header struct {
+	Name  string
+	Value interface{}
+}
+
+// Headers are the headers of a message, in the order they were encoded.
+type Headers []Header
+
+// Get returns the value of the header named name, or nil if the message has
+// no such header.
+func (hs Headers) Get(name string) interface{} {
+	for _, h := range hs {
+		if h.Name == name {
+			return h.Value
+		}
+	}
+	return nil
+}
+
+// String returns the value of the string header named name, or an empty
+// string if the message has no such string header. It is used to read the
+// headers identifying an event, such as :message-type and :event-type.
+func (hs Headers) String(name string) string {
+	s, _ := hs.Get(name).(string)
+	return s
+}
+
+// A UUID is the value of a uuid typed header.
+type UUID [16]byte
+
+// String returns the UUID in its canonical hyphenated form.
+func (u UUID) String() string {
+	return fmt.Sprintf("%x-%x-%x-%x-%x", u[0:4], u[4:6], u[6:8], u[8:10], u[10:])
+}
+
+// the types of header values, as encoded in messages
+const (
+	trueValueType = iota
+	falseValueType
+	int8ValueType
+	int16ValueType
+	int32ValueType
+	int64ValueType
+	bytesValueType
+	stringValueType
+	timestampValueType
+	uuidValueType
+)
+
+// timestampValue converts the milliseconds since the epoch encoded by a
+// timestamp header into a time.
+func timestampValue(ms int64) time.Time {
+	return time.Unix(ms/1000, (ms%1000)*int64(time.Millisecond)).UTC()
+}
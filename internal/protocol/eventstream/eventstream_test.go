@@ -0,0 +1,204 @@
+package eventstream_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awserr"
+	"github.com/awslabs/aws-sdk-go/internal/protocol/eventstream"
+	"github.com/awslabs/aws-sdk-go/internal/protocol/restjson"
+	"github.com/stretchr/testify/assert"
+)
+
+// recorded event stream messages
+const (
+	emptyMessage = "000000100000000005c248eb7d98c8ff"
+
+	allHeadersMessage = "00000090000000737d28d8ff0474727565000566616c736501046279746502ff" +
+		"0573686f727403fed403696e740400011170046c6f6e6705000001000000000005" +
+		"627974657306000301020306737472696e6707000568656c6c6f0474696d6508" +
+		"0000014b201ac47b047575696409000102030405060708090a0b0c0d0e0f7b2266" +
+		"6f6f223a22626172227d1a9feaf9"
+
+	eventMessage = "000000430000002c48a991130d3a6d6573736167652d74797065070005657665" +
+		"6e740b3a6576656e742d747970650700075265636f7264737061796c6f61645301f870"
+
+	errorMessage = "0000005a0000004a8188a08d0d3a6d6573736167652d747970650700056572726f" +
+		"720b3a6572726f722d636f646507000d496e7465726e616c4572726f720e3a6572" +
+		"726f722d6d6573736167650700066661696c6564f13f75e3"
+)
+
+func fixture(t *testing.T, messages ...string) []byte {
+	var buf bytes.Buffer
+	for _, m := range messages {
+		b, err := hex.DecodeString(m)
+		assert.NoError(t, err)
+		buf.Write(b)
+	}
+	return buf.Bytes()
+}
+
+func TestDecodeEmptyMessage(t *testing.T) {
+	d := eventstream.NewDecoder(bytes.NewReader(fixture(t, emptyMessage)))
+
+	msg, err := d.Decode()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(msg.Headers))
+	assert.Equal(t, 0, len(msg.Payload))
+
+	_, err = d.Decode()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestDecodeHeaderTypes(t *testing.T) {
+	d := eventstream.NewDecoder(bytes.NewReader(fixture(t, allHeadersMessage)))
+
+	msg, err := d.Decode()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"foo":"bar"}`, string(msg.Payload))
+	assert.Equal(t, 10, len(msg.Headers))
+	assert.Equal(t, "true", msg.Headers[0].Name)
+
+	assert.Equal(t, true, msg.Headers.Get("true"))
+	assert.Equal(t, false, msg.Headers.Get("false"))
+	assert.Equal(t, int8(-1), msg.Headers.Get("byte"))
+	assert.Equal(t, int16(-300), msg.Headers.Get("short"))
+	assert.Equal(t, int32(70000), msg.Headers.Get("int"))
+	assert.Equal(t, int64(1<<40), msg.Headers.Get("long"))
+	assert.Equal(t, []byte{1, 2, 3}, msg.Headers.Get("bytes"))
+	assert.Equal(t, "hello", msg.Headers.String("string"))
+	assert.Equal(t, time.Unix(1422172800, 123*int64(time.Millisecond)).UTC(), msg.Headers.Get("time"))
+	assert.Equal(t, "00010203-0405-0607-0809-0a0b0c0d0e0f", msg.Headers.Get("uuid").(eventstream.UUID).String())
+	assert.Nil(t, msg.Headers.Get("missing"))
+}
+
+func TestDecodeMultipleMessages(t *testing.T) {
+	d := eventstream.NewDecoder(bytes.NewReader(fixture(t, eventMessage, emptyMessage, eventMessage)))
+
+	for i := 0; i < 3; i++ {
+		_, err := d.Decode()
+		assert.NoError(t, err)
+	}
+	_, err := d.Decode()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestDecodeBadPreludeChecksum(t *testing.T) {
+	b := fixture(t, eventMessage)
+	b[11] ^= 0xff
+
+	_, err := eventstream.NewDecoder(bytes.NewReader(b)).Decode()
+	assert.Error(t, err)
+	assert.Equal(t, "ChecksumMismatch", err.(awserr.Error).Code())
+	assert.Contains(t, err.Error(), "prelude")
+}
+
+func TestDecodeBadMessageChecksum(t *testing.T) {
+	b := fixture(t, eventMessage)
+	b[len(b)-10] ^= 0xff // corrupt the payload
+
+	_, err := eventstream.NewDecoder(bytes.NewReader(b)).Decode()
+	assert.Error(t, err)
+	assert.Equal(t, "ChecksumMismatch", err.(awserr.Error).Code())
+	assert.Contains(t, err.Error(), "message checksum")
+}
+
+func TestDecodeTruncatedMessage(t *testing.T) {
+	b := fixture(t, eventMessage)
+
+	_, err := eventstream.NewDecoder(bytes.NewReader(b[:len(b)-5])).Decode()
+	assert.Error(t, err)
+	assert.Equal(t, "SerializationError", err.(awserr.Error).Code())
+}
+
+func TestEventStream(t *testing.T) {
+	body := ioutil.NopCloser(bytes.NewReader(fixture(t, eventMessage, eventMessage)))
+	s := eventstream.NewEventStream(body)
+	defer s.Close()
+
+	events := []eventstream.Message{}
+	for e := range s.Events {
+		events = append(events, e)
+	}
+	assert.NoError(t, s.Err())
+	assert.Equal(t, 2, len(events))
+	assert.Equal(t, "Records", events[0].Headers.String(":event-type"))
+	assert.Equal(t, "payload", string(events[1].Payload))
+}
+
+func TestEventStreamErrorMessage(t *testing.T) {
+	body := ioutil.NopCloser(bytes.NewReader(fixture(t, eventMessage, errorMessage, eventMessage)))
+	s := eventstream.NewEventStream(body)
+	defer s.Close()
+
+	n := 0
+	for range s.Events {
+		n++
+	}
+	assert.Equal(t, 1, n)
+	err := s.Err().(awserr.Error)
+	assert.Equal(t, "InternalError", err.Code())
+	assert.Equal(t, "failed", err.Message())
+}
+
+func TestEventStreamBadChecksum(t *testing.T) {
+	b := fixture(t, eventMessage, eventMessage)
+	b[len(b)-1] ^= 0xff
+
+	s := eventstream.NewEventStream(ioutil.NopCloser(bytes.NewReader(b)))
+	defer s.Close()
+
+	n := 0
+	for range s.Events {
+		n++
+	}
+	assert.Equal(t, 1, n)
+	assert.Equal(t, "ChecksumMismatch", s.Err().(awserr.Error).Code())
+}
+
+func TestEventStreamClose(t *testing.T) {
+	body := ioutil.NopCloser(bytes.NewReader(fixture(t, eventMessage, eventMessage, eventMessage)))
+	s := eventstream.NewEventStream(body)
+
+	<-s.Events
+	assert.NoError(t, s.Close())
+	assert.NoError(t, s.Close())
+	for range s.Events { // drains until the decoder stops
+	}
+	assert.NoError(t, s.Err())
+}
+
+type eventStreamOutput struct {
+	Stream *eventstream.EventStream `type:"eventstream"`
+
+	metadataEventStreamOutput `json:"-" xml:"-"`
+}
+
+type metadataEventStreamOutput struct {
+	SDKShapeTraits bool `type:"structure" payload:"Stream"`
+}
+
+func TestUnmarshalEventStreamPayload(t *testing.T) {
+	out := &eventStreamOutput{}
+	req := aws.NewRequest(aws.NewService(&aws.Config{}), &aws.Operation{Name: "Operation"}, nil, out)
+	req.HTTPResponse = &http.Response{
+		StatusCode: 200,
+		Header:     http.Header{"Content-Type": []string{eventstream.ContentType}},
+		Body:       ioutil.NopCloser(bytes.NewReader(fixture(t, eventMessage))),
+	}
+	restjson.UnmarshalMeta(req)
+	restjson.Unmarshal(req)
+	assert.NoError(t, req.Error)
+	defer out.Stream.Close()
+
+	e := <-out.Stream.Events
+	assert.Equal(t, "Records", e.Headers.String(":event-type"))
+	_, ok := <-out.Stream.Events
+	assert.False(t, ok)
+}
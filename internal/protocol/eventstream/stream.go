@@ -0,0 +1,86 @@
+package eventstream
+
+import (
+	"io"
+	"sync"
+
+	"github.com/awslabs/aws-sdk-go/aws/awserr"
+)
+
+// An EventStream decodes the messages of a streaming operation's response
+// body on a goroutine, sending its events on the Events channel. The channel
+// is closed when the stream ends, fails, or is closed.
+type EventStream struct {
+	Events <-chan Message
+
+	body      io.ReadCloser
+	done      chan struct{}
+	closeOnce sync.Once
+
+	m   sync.Mutex
+	err error
+}
+
+// NewEventStream returns an EventStream decoding the messages of body.
+func NewEventStream(body io.ReadCloser) *EventStream {
+	events := make(chan Message)
+	s := &EventStream{Events: events, body: body, done: make(chan struct{})}
+	go s.decode(events)
+	return s
+}
+
+// decode sends the stream's events until it ends. Error and exception
+// messages end the stream with an error carrying their code and message.
+func (s *EventStream) decode(events chan<- Message) {
+	defer close(events)
+
+	d := NewDecoder(s.body)
+	for {
+		msg, err := d.Decode()
+		if err == io.EOF {
+			return
+		} else if err != nil {
+			s.setErr(err)
+			return
+		}
+
+		switch msg.Headers.String(":message-type") {
+		case "error":
+			s.setErr(awserr.New(msg.Headers.String(":error-code"), msg.Headers.String(":error-message"), nil))
+			return
+		case "exception":
+			s.setErr(awserr.New(msg.Headers.String(":exception-type"), string(msg.Payload), nil))
+			return
+		}
+
+		select {
+		case events <- msg:
+		case <-s.done:
+			return
+		}
+	}
+}
+
+func (s *EventStream) setErr(err error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.err = err
+}
+
+// Err returns the error which ended the stream, or nil if it ended normally
+// or has not ended yet.
+func (s *EventStream) Err() error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	return s.err
+}
+
+// Close stops decoding the stream and closes the response body.
+func (s *EventStream) Close() error {
+	var err error
+	s.closeOnce.Do(func() {
+		close(s.done)
+		err = s.body.Close()
+	})
+	return err
+}
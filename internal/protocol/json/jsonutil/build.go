@@ -3,14 +3,24 @@ package jsonutil
 import (
 	"bytes"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"sort"
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/awslabs/aws-sdk-go/aws"
 )
 
+// BuildJSON serializes v into a JSON document. Members set to a nil pointer,
+// slice or map are omitted, while those pointing at a zero value, such as
+// false, 0 or "", are sent explicitly.
+//
+// The document is deterministic, so that the same input is always signed
+// over the same bytes: structure members are written in the order they are
+// declared, and map keys, including those of JSONValues, in sorted order.
 func BuildJSON(v interface{}) ([]byte, error) {
 	var buf bytes.Buffer
 
@@ -24,6 +34,10 @@ func buildAny(value reflect.Value, buf *bytes.Buffer, tag reflect.StructTag) err
 		return nil
 	}
 
+	if v, ok := value.Interface().(aws.JSONValue); ok {
+		return buildJSONValue(v, buf)
+	}
+
 	vtype := value.Type()
 
 	t := tag.Get("type")
@@ -70,7 +84,7 @@ func buildStruct(value reflect.Value, buf *bytes.Buffer, tag reflect.StructTag)
 	for i := 0; i < t.NumField(); i++ {
 		field := t.Field(i)
 		member := value.FieldByName(field.Name)
-		if (member.Kind() == reflect.Ptr || member.Kind() == reflect.Slice) && member.IsNil() {
+		if (member.Kind() == reflect.Ptr || member.Kind() == reflect.Slice || member.Kind() == reflect.Map) && member.IsNil() {
 			continue // ignore unset fields
 		}
 		if c := field.Name[0:1]; strings.ToLower(c) == c {
@@ -113,7 +127,9 @@ func buildList(value reflect.Value, buf *bytes.Buffer, tag reflect.StructTag) er
 	buf.WriteString("[")
 
 	for i := 0; i < value.Len(); i++ {
-		buildAny(value.Index(i), buf, "")
+		if err := buildAny(value.Index(i), buf, ""); err != nil {
+			return err
+		}
 
 		if i < value.Len()-1 {
 			buf.WriteString(",")
@@ -128,15 +144,20 @@ func buildList(value reflect.Value, buf *bytes.Buffer, tag reflect.StructTag) er
 func buildMap(value reflect.Value, buf *bytes.Buffer, tag reflect.StructTag) error {
 	buf.WriteString("{")
 
+	// map iteration order is random, so keys are sorted
+	mapKeys := map[string]reflect.Value{}
 	keys := make([]string, value.Len())
 	for i, n := range value.MapKeys() {
 		keys[i] = n.String()
+		mapKeys[keys[i]] = n
 	}
 	sort.Strings(keys)
 
 	for i, k := range keys {
 		buf.WriteString(fmt.Sprintf("%q:", k))
-		buildAny(value.MapIndex(reflect.ValueOf(k)), buf, "")
+		if err := buildAny(value.MapIndex(mapKeys[k]), buf, ""); err != nil {
+			return err
+		}
 
 		if i < len(keys)-1 {
 			buf.WriteString(",")
@@ -148,6 +169,16 @@ func buildMap(value reflect.Value, buf *bytes.Buffer, tag reflect.StructTag) err
 	return nil
 }
 
+// buildJSONValue embeds an arbitrary JSON document in the body as it is.
+func buildJSONValue(v aws.JSONValue, buf *bytes.Buffer) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	buf.Write(b)
+	return nil
+}
+
 func buildScalar(value reflect.Value, buf *bytes.Buffer, tag reflect.StructTag) error {
 	switch converted := value.Interface().(type) {
 	case string:
@@ -0,0 +1,87 @@
+package jsonutil_test
+
+import (
+	"testing"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/internal/protocol/json/jsonutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type zeroValueShape struct {
+	Bool    *bool              `type:"boolean"`
+	Long    *int64             `type:"long"`
+	Double  *float64           `type:"double"`
+	String  *string            `type:"string"`
+	List    []*string          `type:"list"`
+	Map     *map[string]string `type:"map"`
+	Omitted *bool              `type:"boolean"`
+
+	metadataZeroValueShape `json:"-" xml:"-"`
+}
+
+type metadataZeroValueShape struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+func TestBuildJSONExplicitZeroValues(t *testing.T) {
+	b, err := jsonutil.BuildJSON(&zeroValueShape{
+		Bool:   aws.Boolean(false),
+		Long:   aws.Long(0),
+		Double: aws.Double(0),
+		String: aws.String(""),
+		List:   []*string{},
+		Map:    &map[string]string{},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Bool":false,"Long":0,"Double":0,"String":"","List":[],"Map":{}}`, string(b))
+}
+
+func TestBuildJSONOmitsNilPointers(t *testing.T) {
+	b, err := jsonutil.BuildJSON(&zeroValueShape{Bool: aws.Boolean(true)})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Bool":true}`, string(b))
+}
+
+type mapShape struct {
+	Name     *string                        `type:"string"`
+	Tags     *map[string]*string            `type:"map"`
+	Nested   *map[string]map[string]*string `type:"map"`
+	Document aws.JSONValue                  `type:"jsonvalue"`
+
+	metadataMapShape `json:"-" xml:"-"`
+}
+
+type metadataMapShape struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+func TestBuildJSONDeterministic(t *testing.T) {
+	v := &mapShape{
+		Name: aws.String("name"),
+		Tags: &map[string]*string{
+			"delta": aws.String("4"), "alpha": aws.String("1"), "echo": aws.String("5"),
+			"charlie": aws.String("3"), "bravo": aws.String("2"), "foxtrot": aws.String("6"),
+		},
+		Nested: &map[string]map[string]*string{
+			"b": {"y": aws.String("2"), "x": aws.String("1")},
+			"a": {"z": aws.String("3")},
+		},
+		Document: aws.JSONValue{"zulu": 1, "yankee": map[string]interface{}{"b": 2, "a": 1}},
+	}
+
+	expected := `{"Name":"name",` +
+		`"Tags":{"alpha":"1","bravo":"2","charlie":"3","delta":"4","echo":"5","foxtrot":"6"},` +
+		`"Nested":{"a":{"z":"3"},"b":{"x":"1","y":"2"}},` +
+		`"Document":{"yankee":{"a":1,"b":2},"zulu":1}}`
+
+	first, err := jsonutil.BuildJSON(v)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, string(first))
+
+	for i := 0; i < 10; i++ {
+		b, err := jsonutil.BuildJSON(v)
+		assert.NoError(t, err)
+		assert.Equal(t, string(first), string(b))
+	}
+}
@@ -1,16 +1,24 @@
 package jsonutil
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
+
+	"github.com/awslabs/aws-sdk-go/aws"
 )
 
+// UnmarshalJSON unmarshals the JSON document read from stream into v.
+// Numbers are decoded into the type of the member they are unmarshaled
+// into, so integers keep the full precision of an int64, even above the
+// 2^53 a float64 holds exactly.
 func UnmarshalJSON(v interface{}, stream io.Reader) error {
 	var out interface{}
 
@@ -19,7 +27,9 @@ func UnmarshalJSON(v interface{}, stream io.Reader) error {
 		return err
 	}
 
-	if err := json.Unmarshal(b, &out); err != nil {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&out); err != nil {
 		return err
 	}
 
@@ -27,6 +37,10 @@ func UnmarshalJSON(v interface{}, stream io.Reader) error {
 }
 
 func unmarshalAny(value reflect.Value, data interface{}, tag reflect.StructTag) error {
+	if _, ok := value.Interface().(aws.JSONValue); ok {
+		return unmarshalJSONValue(value, data)
+	}
+
 	vtype := value.Type()
 	if vtype.Kind() == reflect.Ptr {
 		vtype = vtype.Elem() // check kind of actual element type
@@ -168,6 +182,46 @@ func unmarshalMap(value reflect.Value, data interface{}, tag reflect.StructTag)
 	return nil
 }
 
+// unmarshalJSONValue sets an arbitrary JSON document member from either the
+// embedded document, or a string containing it.
+func unmarshalJSONValue(value reflect.Value, data interface{}) error {
+	switch d := data.(type) {
+	case nil:
+		return nil
+	case map[string]interface{}:
+		value.Set(reflect.ValueOf(aws.JSONValue(documentValue(d).(map[string]interface{}))))
+	case string:
+		v := aws.JSONValue{}
+		if err := json.Unmarshal([]byte(d), &v); err != nil {
+			return err
+		}
+		value.Set(reflect.ValueOf(v))
+	default:
+		return fmt.Errorf("JSON value is not a document (%#v)", data)
+	}
+	return nil
+}
+
+// documentValue returns the value of a JSON document decoded with its numbers
+// as json.Number, with the numbers converted to the float64 json.Unmarshal
+// decodes them into.
+func documentValue(v interface{}) interface{} {
+	switch d := v.(type) {
+	case json.Number:
+		f, _ := d.Float64()
+		return f
+	case map[string]interface{}:
+		for k, e := range d {
+			d[k] = documentValue(e)
+		}
+	case []interface{}:
+		for i, e := range d {
+			d[i] = documentValue(e)
+		}
+	}
+	return v
+}
+
 func unmarshalScalar(value reflect.Value, data interface{}, tag reflect.StructTag) error {
 	errf := func() error {
 		return fmt.Errorf("unsupported value: %v (%s)", value.Interface(), value.Type())
@@ -189,15 +243,30 @@ func unmarshalScalar(value reflect.Value, data interface{}, tag reflect.StructTa
 		default:
 			return errf()
 		}
-	case float64:
+	case json.Number:
 		switch value.Interface().(type) {
 		case *int64:
-			di := int64(d)
+			di, err := strconv.ParseInt(d.String(), 10, 64)
+			if err != nil { // an integer in exponent or decimal form
+				f, ferr := d.Float64()
+				if ferr != nil {
+					return err
+				}
+				di = int64(f)
+			}
 			value.Set(reflect.ValueOf(&di))
 		case *float64:
-			value.Set(reflect.ValueOf(&d))
+			f, err := d.Float64()
+			if err != nil {
+				return err
+			}
+			value.Set(reflect.ValueOf(&f))
 		case *time.Time:
-			t := time.Unix(int64(d), 0).UTC()
+			f, err := d.Float64()
+			if err != nil {
+				return err
+			}
+			t := time.Unix(int64(f), 0).UTC()
 			value.Set(reflect.ValueOf(&t))
 		default:
 			return errf()
@@ -0,0 +1,52 @@
+package jsonutil_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/internal/protocol/json/jsonutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type numberShape struct {
+	Long     *int64             `type:"long"`
+	Double   *float64           `type:"double"`
+	Time     *time.Time         `type:"timestamp" timestampFormat:"unixTimestamp"`
+	Longs    []*int64           `type:"list"`
+	LongMap  *map[string]*int64 `type:"map"`
+	Document aws.JSONValue      `type:"jsonvalue"`
+
+	metadataNumberShape `json:"-" xml:"-"`
+}
+
+type metadataNumberShape struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+func TestUnmarshalJSONLargeIntegers(t *testing.T) {
+	out := &numberShape{}
+	err := jsonutil.UnmarshalJSON(out, strings.NewReader(
+		`{"Long":9007199254740993,"Longs":[9223372036854775807,-9223372036854775808],"LongMap":{"a":9007199254740993}}`))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(9007199254740993), *out.Long)
+	assert.Equal(t, int64(9223372036854775807), *out.Longs[0])
+	assert.Equal(t, int64(-9223372036854775808), *out.Longs[1])
+	assert.Equal(t, int64(9007199254740993), *(*out.LongMap)["a"])
+
+	b, err := jsonutil.BuildJSON(&numberShape{Long: out.Long})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Long":9007199254740993}`, string(b))
+}
+
+func TestUnmarshalJSONNumberForms(t *testing.T) {
+	out := &numberShape{}
+	err := jsonutil.UnmarshalJSON(out, strings.NewReader(
+		`{"Long":1e3,"Double":1.5,"Time":1422172800.5,"Document":{"count":2}}`))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1000), *out.Long)
+	assert.Equal(t, 1.5, *out.Double)
+	assert.Equal(t, time.Unix(1422172800, 0).UTC(), *out.Time)
+	assert.Equal(t, float64(2), out.Document["count"])
+}
@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 	"github.com/awslabs/aws-sdk-go/internal/protocol/xml/xmlutil"
 	"github.com/awslabs/aws-sdk-go/internal/util"
 	"github.com/stretchr/testify/assert"
@@ -27,6 +28,7 @@ var _ xml.Attr
 var _ = ioutil.Discard
 var _ = util.Trim("")
 var _ = url.Values{}
+var _ = awsutil.StringValue
 
 // InputService1ProtocolTest is a client for InputService1ProtocolTest.
 type InputService1ProtocolTest struct {
@@ -49,7 +51,7 @@ func NewInputService1ProtocolTest(config *aws.Config) *InputService1ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(jsonrpc.Build)
 	service.Handlers.Unmarshal.PushBack(jsonrpc.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(jsonrpc.UnmarshalMeta)
@@ -90,6 +92,11 @@ type metadataInputService1TestShapeInputService1TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService1TestShapeInputService1TestCaseOperation1Output.
+func (s InputService1TestShapeInputService1TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService1TestShapeInputShape struct {
 	Name *string `type:"string"`
 
@@ -100,6 +107,11 @@ type metadataInputService1TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService1TestShapeInputShape.
+func (s InputService1TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService2ProtocolTest is a client for InputService2ProtocolTest.
 type InputService2ProtocolTest struct {
 	*aws.Service
@@ -121,7 +133,7 @@ func NewInputService2ProtocolTest(config *aws.Config) *InputService2ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(jsonrpc.Build)
 	service.Handlers.Unmarshal.PushBack(jsonrpc.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(jsonrpc.UnmarshalMeta)
@@ -161,6 +173,11 @@ type metadataInputService2TestShapeInputService2TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService2TestShapeInputService2TestCaseOperation1Output.
+func (s InputService2TestShapeInputService2TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService2TestShapeInputShape struct {
 	TimeArg *time.Time `type:"timestamp" timestampFormat:"unix"`
 
@@ -171,6 +188,11 @@ type metadataInputService2TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService2TestShapeInputShape.
+func (s InputService2TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService3ProtocolTest is a client for InputService3ProtocolTest.
 type InputService3ProtocolTest struct {
 	*aws.Service
@@ -192,7 +214,7 @@ func NewInputService3ProtocolTest(config *aws.Config) *InputService3ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(jsonrpc.Build)
 	service.Handlers.Unmarshal.PushBack(jsonrpc.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(jsonrpc.UnmarshalMeta)
@@ -255,6 +277,11 @@ type metadataInputService3TestShapeInputService3TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService3TestShapeInputService3TestCaseOperation1Output.
+func (s InputService3TestShapeInputService3TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService3TestShapeInputService3TestCaseOperation2Output struct {
 	metadataInputService3TestShapeInputService3TestCaseOperation2Output `json:"-", xml:"-"`
 }
@@ -263,6 +290,11 @@ type metadataInputService3TestShapeInputService3TestCaseOperation2Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService3TestShapeInputService3TestCaseOperation2Output.
+func (s InputService3TestShapeInputService3TestCaseOperation2Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService3TestShapeInputShape struct {
 	BlobArg []byte `type:"blob"`
 
@@ -275,6 +307,11 @@ type metadataInputService3TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService3TestShapeInputShape.
+func (s InputService3TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService4ProtocolTest is a client for InputService4ProtocolTest.
 type InputService4ProtocolTest struct {
 	*aws.Service
@@ -296,7 +333,7 @@ func NewInputService4ProtocolTest(config *aws.Config) *InputService4ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(jsonrpc.Build)
 	service.Handlers.Unmarshal.PushBack(jsonrpc.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(jsonrpc.UnmarshalMeta)
@@ -337,6 +374,11 @@ type metadataInputService4TestShapeInputService4TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService4TestShapeInputService4TestCaseOperation1Output.
+func (s InputService4TestShapeInputService4TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService4TestShapeInputShape struct {
 	ListParam [][]byte `type:"list"`
 
@@ -347,6 +389,11 @@ type metadataInputService4TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService4TestShapeInputShape.
+func (s InputService4TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService5ProtocolTest is a client for InputService5ProtocolTest.
 type InputService5ProtocolTest struct {
 	*aws.Service
@@ -368,7 +415,7 @@ func NewInputService5ProtocolTest(config *aws.Config) *InputService5ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(jsonrpc.Build)
 	service.Handlers.Unmarshal.PushBack(jsonrpc.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(jsonrpc.UnmarshalMeta)
@@ -523,6 +570,11 @@ type metadataInputService5TestShapeInputService5TestCaseOperation2Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService5TestShapeInputService5TestCaseOperation2Output.
+func (s InputService5TestShapeInputService5TestCaseOperation2Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService5TestShapeInputService5TestCaseOperation3Output struct {
 	metadataInputService5TestShapeInputService5TestCaseOperation3Output `json:"-", xml:"-"`
 }
@@ -531,6 +583,11 @@ type metadataInputService5TestShapeInputService5TestCaseOperation3Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService5TestShapeInputService5TestCaseOperation3Output.
+func (s InputService5TestShapeInputService5TestCaseOperation3Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService5TestShapeInputService5TestCaseOperation4Output struct {
 	metadataInputService5TestShapeInputService5TestCaseOperation4Output `json:"-", xml:"-"`
 }
@@ -539,6 +596,11 @@ type metadataInputService5TestShapeInputService5TestCaseOperation4Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService5TestShapeInputService5TestCaseOperation4Output.
+func (s InputService5TestShapeInputService5TestCaseOperation4Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService5TestShapeInputService5TestCaseOperation5Output struct {
 	metadataInputService5TestShapeInputService5TestCaseOperation5Output `json:"-", xml:"-"`
 }
@@ -547,6 +609,11 @@ type metadataInputService5TestShapeInputService5TestCaseOperation5Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService5TestShapeInputService5TestCaseOperation5Output.
+func (s InputService5TestShapeInputService5TestCaseOperation5Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService5TestShapeInputService5TestCaseOperation6Output struct {
 	metadataInputService5TestShapeInputService5TestCaseOperation6Output `json:"-", xml:"-"`
 }
@@ -555,6 +622,11 @@ type metadataInputService5TestShapeInputService5TestCaseOperation6Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService5TestShapeInputService5TestCaseOperation6Output.
+func (s InputService5TestShapeInputService5TestCaseOperation6Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService5TestShapeInputService5TestShapeInputService5TestCaseOperation1Output struct {
 	metadataInputService5TestShapeInputService5TestShapeInputService5TestCaseOperation1Output `json:"-", xml:"-"`
 }
@@ -563,6 +635,11 @@ type metadataInputService5TestShapeInputService5TestShapeInputService5TestCaseOp
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService5TestShapeInputService5TestShapeInputService5TestCaseOperation1Output.
+func (s InputService5TestShapeInputService5TestShapeInputService5TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService5TestShapeInputService5TestShapeInputShape struct {
 	RecursiveStruct *InputService5TestShapeInputService5TestShapeRecursiveStructType `type:"structure"`
 
@@ -573,6 +650,11 @@ type metadataInputService5TestShapeInputService5TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService5TestShapeInputService5TestShapeInputShape.
+func (s InputService5TestShapeInputService5TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService5TestShapeInputService5TestShapeRecursiveStructType struct {
 	NoRecurse *string `type:"string"`
 
@@ -589,6 +671,11 @@ type metadataInputService5TestShapeInputService5TestShapeRecursiveStructType str
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService5TestShapeInputService5TestShapeRecursiveStructType.
+func (s InputService5TestShapeInputService5TestShapeRecursiveStructType) String() string {
+	return awsutil.StringValue(s)
+}
+
 //
 // Tests begin here
 //
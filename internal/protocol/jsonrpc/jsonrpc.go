@@ -9,6 +9,8 @@ import (
 	"strings"
 
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awserr"
+	"github.com/awslabs/aws-sdk-go/internal/protocol"
 	"github.com/awslabs/aws-sdk-go/internal/protocol/json/jsonutil"
 )
 
@@ -31,13 +33,14 @@ func Build(req *aws.Request) {
 		req.SetBufferBody(buf)
 	}
 
+	// RPC services are told the operation by the target, and default to
+	// JSON 1.0 when their model declares no version
 	if req.Service.TargetPrefix != "" {
 		target := req.Service.TargetPrefix + "." + req.Operation.Name
-		req.HTTPRequest.Header.Add("X-Amz-Target", target)
-	}
-	if req.Service.JSONVersion != "" {
-		jsonVersion := req.Service.JSONVersion
-		req.HTTPRequest.Header.Add("Content-Type", "application/x-amz-json-"+jsonVersion)
+		req.HTTPRequest.Header.Set("X-Amz-Target", target)
+		protocol.SetContentType(req, protocol.JSONContentType(req.Service.JSONVersion))
+	} else if req.Service.JSONVersion != "" {
+		protocol.SetContentType(req, protocol.JSONContentType(req.Service.JSONVersion))
 	}
 }
 
@@ -58,28 +61,49 @@ func UnmarshalMeta(req *aws.Request) {
 func UnmarshalError(req *aws.Request) {
 	bodyBytes, err := ioutil.ReadAll(req.HTTPResponse.Body)
 	if err != nil {
-		req.Error = err
+		req.Error = awserr.New("SerializationError", "failed to read JSON error response", err)
 		return
 	}
+	requestID := req.HTTPResponse.Header.Get("X-Amzn-Requestid")
 	if len(bodyBytes) == 0 {
-		req.Error = aws.APIError{
-			StatusCode: req.HTTPResponse.StatusCode,
-			Message:    req.HTTPResponse.Status,
-		}
+		req.Error = awserr.NewRequestFailure(
+			awserr.New("", req.HTTPResponse.Status, nil),
+			req.HTTPResponse.StatusCode,
+			requestID,
+		)
 		return
 	}
 	var jsonErr jsonErrorResponse
 	if err := json.Unmarshal(bodyBytes, &jsonErr); err != nil {
-		req.Error = err
+		req.Error = awserr.New("SerializationError", "failed to decode JSON error response", err)
 		return
 	}
 
-	codes := strings.SplitN(jsonErr.Code, "#", 2)
-	req.Error = aws.APIError{
-		StatusCode: req.HTTPResponse.StatusCode,
-		Code:       codes[len(codes)-1],
-		Message:    jsonErr.Message,
+	req.Error = awserr.NewRequestFailure(
+		awserr.New(ErrorCode(jsonErr.Code), jsonErr.Message, nil),
+		req.HTTPResponse.StatusCode,
+		requestID,
+	)
+}
+
+// ErrorCode returns the error code of a JSON error type, stripping the URL
+// after its first :, as in ResourceNotFoundException:http://internal/, and
+// then the namespace before its last #, as in
+// com.amazonaws.dynamodb.v20120810#ResourceNotFoundException. The URL is
+// stripped first as it may hold a # of its own. The : of a namespace which
+// is itself a URL, such as http://internal/coral#ValidationException, does
+// not start a URL suffix.
+func ErrorCode(errorType string) string {
+	for i := 0; i < len(errorType); i++ {
+		if errorType[i] == ':' && !strings.HasPrefix(errorType[i+1:], "//") {
+			errorType = errorType[:i]
+			break
+		}
+	}
+	if i := strings.LastIndex(errorType, "#"); i >= 0 {
+		errorType = errorType[i+1:]
 	}
+	return errorType
 }
 
 type jsonErrorResponse struct {
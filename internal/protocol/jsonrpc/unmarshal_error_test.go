@@ -0,0 +1,39 @@
+package jsonrpc_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awserr"
+	"github.com/awslabs/aws-sdk-go/internal/protocol/jsonrpc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalErrorCodeFormats(t *testing.T) {
+	cases := map[string]string{
+		`ResourceNotFoundException`:                                                      "ResourceNotFoundException",
+		`com.amazonaws.dynamodb.v20120810#ConditionalCheckFailedException`:               "ConditionalCheckFailedException",
+		`http://internal.amazon.com/coral/com.amazon.coral.validate#ValidationException`: "ValidationException",
+		`ThrottlingException:http://internal.amazon.com/coral/com.amazon.coral.service/`: "ThrottlingException",
+		`aws.protocoltests#InvalidGreeting:http://internal.amazon.com/coral/com.amazon/`: "InvalidGreeting",
+		`ThrottlingException:http://internal.amazon.com/coral/com.amazon.coral#service`:  "ThrottlingException",
+		`aws.protocoltests#InvalidGreeting:http://internal.amazon.com/coral/com#amazon/`: "InvalidGreeting",
+	}
+
+	for errorType, code := range cases {
+		req := aws.NewRequest(aws.NewService(&aws.Config{}), &aws.Operation{Name: "Operation"}, nil, nil)
+		req.HTTPResponse = &http.Response{
+			StatusCode: 400,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"__type":"` + errorType + `","message":"error"}`))),
+		}
+		jsonrpc.UnmarshalError(req)
+
+		err, ok := req.Error.(awserr.Error)
+		assert.True(t, ok)
+		assert.Equal(t, code, err.Code(), errorType)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 	"github.com/awslabs/aws-sdk-go/internal/protocol/xml/xmlutil"
 	"github.com/awslabs/aws-sdk-go/internal/util"
 	"github.com/stretchr/testify/assert"
@@ -27,6 +28,7 @@ var _ xml.Attr
 var _ = ioutil.Discard
 var _ = util.Trim("")
 var _ = url.Values{}
+var _ = awsutil.StringValue
 
 // OutputService1ProtocolTest is a client for OutputService1ProtocolTest.
 type OutputService1ProtocolTest struct {
@@ -49,7 +51,7 @@ func NewOutputService1ProtocolTest(config *aws.Config) *OutputService1ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(jsonrpc.Build)
 	service.Handlers.Unmarshal.PushBack(jsonrpc.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(jsonrpc.UnmarshalMeta)
@@ -89,6 +91,11 @@ type metadataOutputService1TestShapeOutputService1TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService1TestShapeOutputService1TestCaseOperation1Input.
+func (s OutputService1TestShapeOutputService1TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService1TestShapeOutputShape struct {
 	Char *string `type:"character"`
 
@@ -113,6 +120,11 @@ type metadataOutputService1TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService1TestShapeOutputShape.
+func (s OutputService1TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // OutputService2ProtocolTest is a client for OutputService2ProtocolTest.
 type OutputService2ProtocolTest struct {
 	*aws.Service
@@ -134,7 +146,7 @@ func NewOutputService2ProtocolTest(config *aws.Config) *OutputService2ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(jsonrpc.Build)
 	service.Handlers.Unmarshal.PushBack(jsonrpc.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(jsonrpc.UnmarshalMeta)
@@ -176,6 +188,11 @@ type metadataOutputService2TestShapeBlobContainer struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService2TestShapeBlobContainer.
+func (s OutputService2TestShapeBlobContainer) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService2TestShapeOutputService2TestCaseOperation1Input struct {
 	metadataOutputService2TestShapeOutputService2TestCaseOperation1Input `json:"-", xml:"-"`
 }
@@ -184,6 +201,11 @@ type metadataOutputService2TestShapeOutputService2TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService2TestShapeOutputService2TestCaseOperation1Input.
+func (s OutputService2TestShapeOutputService2TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService2TestShapeOutputShape struct {
 	BlobMember []byte `type:"blob"`
 
@@ -196,6 +218,11 @@ type metadataOutputService2TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService2TestShapeOutputShape.
+func (s OutputService2TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // OutputService3ProtocolTest is a client for OutputService3ProtocolTest.
 type OutputService3ProtocolTest struct {
 	*aws.Service
@@ -217,7 +244,7 @@ func NewOutputService3ProtocolTest(config *aws.Config) *OutputService3ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(jsonrpc.Build)
 	service.Handlers.Unmarshal.PushBack(jsonrpc.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(jsonrpc.UnmarshalMeta)
@@ -257,6 +284,11 @@ type metadataOutputService3TestShapeOutputService3TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService3TestShapeOutputService3TestCaseOperation1Input.
+func (s OutputService3TestShapeOutputService3TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService3TestShapeOutputShape struct {
 	StructMember *OutputService3TestShapeTimeContainer `type:"structure"`
 
@@ -269,6 +301,11 @@ type metadataOutputService3TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService3TestShapeOutputShape.
+func (s OutputService3TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService3TestShapeTimeContainer struct {
 	Foo *time.Time `locationName:"foo" type:"timestamp" timestampFormat:"unix"`
 
@@ -279,6 +316,11 @@ type metadataOutputService3TestShapeTimeContainer struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService3TestShapeTimeContainer.
+func (s OutputService3TestShapeTimeContainer) String() string {
+	return awsutil.StringValue(s)
+}
+
 // OutputService4ProtocolTest is a client for OutputService4ProtocolTest.
 type OutputService4ProtocolTest struct {
 	*aws.Service
@@ -300,7 +342,7 @@ func NewOutputService4ProtocolTest(config *aws.Config) *OutputService4ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(jsonrpc.Build)
 	service.Handlers.Unmarshal.PushBack(jsonrpc.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(jsonrpc.UnmarshalMeta)
@@ -340,6 +382,11 @@ type metadataOutputService4TestShapeOutputService4TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService4TestShapeOutputService4TestCaseOperation1Input.
+func (s OutputService4TestShapeOutputService4TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService4TestShapeOutputShape struct {
 	ListMember []*string `type:"list"`
 
@@ -350,6 +397,11 @@ type metadataOutputService4TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService4TestShapeOutputShape.
+func (s OutputService4TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // OutputService5ProtocolTest is a client for OutputService5ProtocolTest.
 type OutputService5ProtocolTest struct {
 	*aws.Service
@@ -371,7 +423,7 @@ func NewOutputService5ProtocolTest(config *aws.Config) *OutputService5ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(jsonrpc.Build)
 	service.Handlers.Unmarshal.PushBack(jsonrpc.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(jsonrpc.UnmarshalMeta)
@@ -411,6 +463,11 @@ type metadataOutputService5TestShapeOutputService5TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService5TestShapeOutputService5TestCaseOperation1Input.
+func (s OutputService5TestShapeOutputService5TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService5TestShapeOutputShape struct {
 	MapMember *map[string][]*int64 `type:"map"`
 
@@ -421,6 +478,11 @@ type metadataOutputService5TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService5TestShapeOutputShape.
+func (s OutputService5TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // OutputService6ProtocolTest is a client for OutputService6ProtocolTest.
 type OutputService6ProtocolTest struct {
 	*aws.Service
@@ -442,7 +504,7 @@ func NewOutputService6ProtocolTest(config *aws.Config) *OutputService6ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(jsonrpc.Build)
 	service.Handlers.Unmarshal.PushBack(jsonrpc.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(jsonrpc.UnmarshalMeta)
@@ -482,6 +544,11 @@ type metadataOutputService6TestShapeOutputService6TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService6TestShapeOutputService6TestCaseOperation1Input.
+func (s OutputService6TestShapeOutputService6TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService6TestShapeOutputShape struct {
 	StrType *string `type:"string"`
 
@@ -492,6 +559,11 @@ type metadataOutputService6TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService6TestShapeOutputShape.
+func (s OutputService6TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 //
 // Tests begin here
 //
@@ -0,0 +1,33 @@
+// Package protocol holds what the protocol packages share when building
+// requests, such as the content types of their bodies.
+package protocol
+
+import "github.com/awslabs/aws-sdk-go/aws"
+
+// the content types of the protocols' request bodies
+const (
+	FormURLEncodedContentType = "application/x-www-form-urlencoded; charset=utf-8"
+	XMLContentType            = "application/xml"
+	RESTJSONContentType       = "application/json"
+)
+
+// DefaultJSONVersion is the JSON version of JSON-RPC services whose model
+// does not declare one.
+const DefaultJSONVersion = "1.0"
+
+// JSONContentType returns the content type of the bodies of a JSON-RPC
+// service with the JSON version version, such as application/x-amz-json-1.1.
+func JSONContentType(version string) string {
+	if version == "" {
+		version = DefaultJSONVersion
+	}
+	return "application/x-amz-json-" + version
+}
+
+// SetContentType sets the Content-Type header of the request, unless it has
+// already been set, for example by a header member of its params.
+func SetContentType(r *aws.Request, contentType string) {
+	if r.HTTPRequest.Header.Get("Content-Type") == "" {
+		r.HTTPRequest.Header.Set("Content-Type", contentType)
+	}
+}
@@ -0,0 +1,176 @@
+package protocol_test
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/internal/protocol"
+	"github.com/awslabs/aws-sdk-go/internal/protocol/ec2query"
+	"github.com/awslabs/aws-sdk-go/internal/protocol/jsonrpc"
+	"github.com/awslabs/aws-sdk-go/internal/protocol/query"
+	"github.com/awslabs/aws-sdk-go/internal/protocol/restjson"
+	"github.com/awslabs/aws-sdk-go/internal/protocol/restxml"
+	"github.com/stretchr/testify/assert"
+)
+
+type inputShape struct {
+	Name *string `type:"string"`
+
+	metadataInputShape `json:"-" xml:"-"`
+}
+
+type metadataInputShape struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+type headerInputShape struct {
+	ContentType *string `location:"header" locationName:"Content-Type" type:"string"`
+	Name        *string `type:"string"`
+
+	metadataHeaderInputShape `json:"-" xml:"-"`
+}
+
+type metadataHeaderInputShape struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+func build(s *aws.Service, fn func(*aws.Request), params interface{}) *aws.Request {
+	s.Handlers.Build.PushBack(fn)
+	req := aws.NewRequest(s, &aws.Operation{Name: "OperationName", HTTPMethod: "POST", HTTPPath: "/"}, params, nil)
+	req.Build()
+	return req
+}
+
+func TestBuildJSON10RPCContentType(t *testing.T) {
+	s := aws.NewService(&aws.Config{Region: "mock-region"})
+	s.JSONVersion = "1.0"
+	s.TargetPrefix = "Service_20150101"
+
+	req := build(s, jsonrpc.Build, &inputShape{Name: aws.String("foo")})
+	assert.NoError(t, req.Error)
+	assert.Equal(t, "application/x-amz-json-1.0", req.HTTPRequest.Header.Get("Content-Type"))
+	assert.Equal(t, "Service_20150101.OperationName", req.HTTPRequest.Header.Get("X-Amz-Target"))
+	assert.Equal(t, 1, len(req.HTTPRequest.Header["X-Amz-Target"]))
+}
+
+func TestBuildJSON11RPCContentType(t *testing.T) {
+	s := aws.NewService(&aws.Config{Region: "mock-region"})
+	s.JSONVersion = "1.1"
+	s.TargetPrefix = "Service_20150101"
+
+	req := build(s, jsonrpc.Build, nil)
+	assert.NoError(t, req.Error)
+	assert.Equal(t, "application/x-amz-json-1.1", req.HTTPRequest.Header.Get("Content-Type"))
+	assert.Equal(t, "Service_20150101.OperationName", req.HTTPRequest.Header.Get("X-Amz-Target"))
+}
+
+func TestBuildJSONRPCDefaultVersion(t *testing.T) {
+	s := aws.NewService(&aws.Config{Region: "mock-region"})
+	s.TargetPrefix = "Service_20150101"
+
+	req := build(s, jsonrpc.Build, nil)
+	assert.Equal(t, "application/x-amz-json-1.0", req.HTTPRequest.Header.Get("Content-Type"))
+}
+
+func TestBuildRESTJSONContentType(t *testing.T) {
+	s := aws.NewService(&aws.Config{Region: "mock-region"})
+
+	req := build(s, restjson.Build, &inputShape{Name: aws.String("foo")})
+	assert.NoError(t, req.Error)
+	assert.Equal(t, "application/json", req.HTTPRequest.Header.Get("Content-Type"))
+	assert.Equal(t, "", req.HTTPRequest.Header.Get("X-Amz-Target"))
+
+	req = build(aws.NewService(&aws.Config{Region: "mock-region"}), restjson.Build, &inputShape{})
+	assert.Equal(t, "", req.HTTPRequest.Header.Get("Content-Type"))
+}
+
+func TestBuildQueryContentType(t *testing.T) {
+	for _, fn := range []func(*aws.Request){query.Build, ec2query.Build} {
+		s := aws.NewService(&aws.Config{Region: "mock-region"})
+		s.APIVersion = "2015-01-01"
+
+		req := build(s, fn, &inputShape{Name: aws.String("foo")})
+		assert.NoError(t, req.Error)
+		assert.Equal(t, protocol.FormURLEncodedContentType, req.HTTPRequest.Header.Get("Content-Type"))
+		assert.Equal(t, "", req.HTTPRequest.Header.Get("X-Amz-Target"))
+	}
+}
+
+func TestBuildXMLContentType(t *testing.T) {
+	s := aws.NewService(&aws.Config{Region: "mock-region"})
+
+	req := build(s, restxml.Build, &inputShape{Name: aws.String("foo")})
+	assert.NoError(t, req.Error)
+	assert.Equal(t, "application/xml", req.HTTPRequest.Header.Get("Content-Type"))
+
+	req = build(aws.NewService(&aws.Config{Region: "mock-region"}), restxml.Build, &inputShape{})
+	assert.Equal(t, "", req.HTTPRequest.Header.Get("Content-Type"))
+}
+
+func TestBuildContentTypeMemberWins(t *testing.T) {
+	s := aws.NewService(&aws.Config{Region: "mock-region"})
+
+	req := build(s, restxml.Build, &headerInputShape{ContentType: aws.String("text/xml"), Name: aws.String("foo")})
+	assert.NoError(t, req.Error)
+	assert.Equal(t, "text/xml", req.HTTPRequest.Header.Get("Content-Type"))
+}
+
+type blobOutputShape struct {
+	Body        io.ReadCloser `type:"blob"`
+	ContentType *string       `location:"header" locationName:"Content-Type" type:"string"`
+
+	metadataBlobOutputShape `json:"-" xml:"-"`
+}
+
+type metadataBlobOutputShape struct {
+	SDKShapeTraits bool `type:"structure" payload:"Body"`
+}
+
+// pngBytes is the start of a PNG image, which is neither JSON nor XML.
+var pngBytes = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func TestBinaryResponse(t *testing.T) {
+	protocols := map[string][]func(*aws.Request){
+		"rest-json": {restjson.Build, restjson.UnmarshalMeta, restjson.Unmarshal},
+		"rest-xml":  {restxml.Build, restxml.UnmarshalMeta, restxml.Unmarshal},
+	}
+	for name, fns := range protocols {
+		s := aws.NewService(&aws.Config{Region: "mock-region"})
+		s.Handlers.Build.PushBack(fns[0])
+		s.Handlers.UnmarshalMeta.PushBack(fns[1])
+		s.Handlers.Unmarshal.PushBack(fns[2])
+		s.Handlers.Send.SwapNamed(aws.StubSendHandler(aws.StubResponse{
+			StatusCode: 200,
+			Header:     http.Header{"Content-Type": []string{"image/png"}},
+			Body:       string(pngBytes),
+		}))
+
+		out := &blobOutputShape{}
+		req := aws.NewRequest(s, &aws.Operation{Name: "GetImage", HTTPMethod: "GET", HTTPPath: "/image"}, &inputShape{}, out)
+		assert.NoError(t, req.Send(), name)
+		assert.Equal(t, "*/*", req.HTTPRequest.Header.Get("Accept"), name)
+		assert.Equal(t, "image/png", *out.ContentType, name)
+
+		b, err := ioutil.ReadAll(out.Body)
+		assert.NoError(t, err, name)
+		assert.Equal(t, pngBytes, b, name)
+		out.Body.Close()
+	}
+}
+
+func TestBinaryResponseAcceptOverride(t *testing.T) {
+	s := aws.NewService(&aws.Config{Region: "mock-region"})
+	s.Handlers.Build.PushBack(restjson.Build)
+
+	req := aws.NewRequest(s, &aws.Operation{Name: "GetImage", HTTPMethod: "GET", HTTPPath: "/image"}, &inputShape{}, &blobOutputShape{})
+	req.SetHeader("Accept", "image/png")
+	assert.NoError(t, req.Build())
+	assert.Equal(t, "image/png", req.HTTPRequest.Header.Get("Accept"))
+
+	req = aws.NewRequest(s, &aws.Operation{Name: "GetThing", HTTPMethod: "GET", HTTPPath: "/thing"}, &inputShape{}, &inputShape{})
+	assert.NoError(t, req.Build())
+	assert.Equal(t, "", req.HTTPRequest.Header.Get("Accept"))
+}
@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 	"github.com/awslabs/aws-sdk-go/internal/protocol/xml/xmlutil"
 	"github.com/awslabs/aws-sdk-go/internal/util"
 	"github.com/stretchr/testify/assert"
@@ -27,6 +28,7 @@ var _ xml.Attr
 var _ = ioutil.Discard
 var _ = util.Trim("")
 var _ = url.Values{}
+var _ = awsutil.StringValue
 
 // InputService1ProtocolTest is a client for InputService1ProtocolTest.
 type InputService1ProtocolTest struct {
@@ -47,7 +49,7 @@ func NewInputService1ProtocolTest(config *aws.Config) *InputService1ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(query.Build)
 	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
@@ -87,6 +89,11 @@ type metadataInputService1TestShapeInputService1TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService1TestShapeInputService1TestCaseOperation1Output.
+func (s InputService1TestShapeInputService1TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService1TestShapeInputShape struct {
 	Bar *string `type:"string"`
 
@@ -99,6 +106,11 @@ type metadataInputService1TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService1TestShapeInputShape.
+func (s InputService1TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService2ProtocolTest is a client for InputService2ProtocolTest.
 type InputService2ProtocolTest struct {
 	*aws.Service
@@ -118,7 +130,7 @@ func NewInputService2ProtocolTest(config *aws.Config) *InputService2ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(query.Build)
 	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
@@ -158,6 +170,11 @@ type metadataInputService2TestShapeInputService2TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService2TestShapeInputService2TestCaseOperation1Output.
+func (s InputService2TestShapeInputService2TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService2TestShapeInputShape struct {
 	StructArg *InputService2TestShapeStructType `type:"structure"`
 
@@ -168,6 +185,11 @@ type metadataInputService2TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService2TestShapeInputShape.
+func (s InputService2TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService2TestShapeStructType struct {
 	ScalarArg *string `type:"string"`
 
@@ -178,6 +200,11 @@ type metadataInputService2TestShapeStructType struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService2TestShapeStructType.
+func (s InputService2TestShapeStructType) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService3ProtocolTest is a client for InputService3ProtocolTest.
 type InputService3ProtocolTest struct {
 	*aws.Service
@@ -197,7 +224,7 @@ func NewInputService3ProtocolTest(config *aws.Config) *InputService3ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(query.Build)
 	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
@@ -237,6 +264,11 @@ type metadataInputService3TestShapeInputService3TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService3TestShapeInputService3TestCaseOperation1Output.
+func (s InputService3TestShapeInputService3TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService3TestShapeInputShape struct {
 	ListArg []*string `type:"list"`
 
@@ -247,6 +279,11 @@ type metadataInputService3TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService3TestShapeInputShape.
+func (s InputService3TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService4ProtocolTest is a client for InputService4ProtocolTest.
 type InputService4ProtocolTest struct {
 	*aws.Service
@@ -266,7 +303,7 @@ func NewInputService4ProtocolTest(config *aws.Config) *InputService4ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(query.Build)
 	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
@@ -306,6 +343,11 @@ type metadataInputService4TestShapeInputService4TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService4TestShapeInputService4TestCaseOperation1Output.
+func (s InputService4TestShapeInputService4TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService4TestShapeInputShape struct {
 	ListArg []*string `type:"list" flattened:"true"`
 
@@ -318,6 +360,11 @@ type metadataInputService4TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService4TestShapeInputShape.
+func (s InputService4TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService5ProtocolTest is a client for InputService5ProtocolTest.
 type InputService5ProtocolTest struct {
 	*aws.Service
@@ -337,7 +384,7 @@ func NewInputService5ProtocolTest(config *aws.Config) *InputService5ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(query.Build)
 	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
@@ -377,6 +424,11 @@ type metadataInputService5TestShapeInputService5TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService5TestShapeInputService5TestCaseOperation1Output.
+func (s InputService5TestShapeInputService5TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService5TestShapeInputShape struct {
 	MapArg *map[string]*string `type:"map"`
 
@@ -387,6 +439,11 @@ type metadataInputService5TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService5TestShapeInputShape.
+func (s InputService5TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService6ProtocolTest is a client for InputService6ProtocolTest.
 type InputService6ProtocolTest struct {
 	*aws.Service
@@ -406,7 +463,7 @@ func NewInputService6ProtocolTest(config *aws.Config) *InputService6ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(query.Build)
 	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
@@ -446,6 +503,11 @@ type metadataInputService6TestShapeInputService6TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService6TestShapeInputService6TestCaseOperation1Output.
+func (s InputService6TestShapeInputService6TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService6TestShapeInputShape struct {
 	BlobArg []byte `type:"blob"`
 
@@ -456,6 +518,11 @@ type metadataInputService6TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService6TestShapeInputShape.
+func (s InputService6TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService7ProtocolTest is a client for InputService7ProtocolTest.
 type InputService7ProtocolTest struct {
 	*aws.Service
@@ -475,7 +542,7 @@ func NewInputService7ProtocolTest(config *aws.Config) *InputService7ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(query.Build)
 	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
@@ -515,6 +582,11 @@ type metadataInputService7TestShapeInputService7TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService7TestShapeInputService7TestCaseOperation1Output.
+func (s InputService7TestShapeInputService7TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService7TestShapeInputShape struct {
 	TimeArg *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
@@ -525,6 +597,11 @@ type metadataInputService7TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService7TestShapeInputShape.
+func (s InputService7TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService8ProtocolTest is a client for InputService8ProtocolTest.
 type InputService8ProtocolTest struct {
 	*aws.Service
@@ -544,7 +621,7 @@ func NewInputService8ProtocolTest(config *aws.Config) *InputService8ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(query.Build)
 	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
@@ -699,6 +776,11 @@ type metadataInputService8TestShapeInputService8TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService8TestShapeInputService8TestCaseOperation1Output.
+func (s InputService8TestShapeInputService8TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService8TestShapeInputService8TestCaseOperation2Output struct {
 	metadataInputService8TestShapeInputService8TestCaseOperation2Output `json:"-", xml:"-"`
 }
@@ -707,6 +789,11 @@ type metadataInputService8TestShapeInputService8TestCaseOperation2Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService8TestShapeInputService8TestCaseOperation2Output.
+func (s InputService8TestShapeInputService8TestCaseOperation2Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService8TestShapeInputService8TestCaseOperation4Output struct {
 	metadataInputService8TestShapeInputService8TestCaseOperation4Output `json:"-", xml:"-"`
 }
@@ -715,6 +802,11 @@ type metadataInputService8TestShapeInputService8TestCaseOperation4Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService8TestShapeInputService8TestCaseOperation4Output.
+func (s InputService8TestShapeInputService8TestCaseOperation4Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService8TestShapeInputService8TestCaseOperation6Output struct {
 	metadataInputService8TestShapeInputService8TestCaseOperation6Output `json:"-", xml:"-"`
 }
@@ -723,6 +815,11 @@ type metadataInputService8TestShapeInputService8TestCaseOperation6Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService8TestShapeInputService8TestCaseOperation6Output.
+func (s InputService8TestShapeInputService8TestCaseOperation6Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService8TestShapeInputService8TestShapeInputService8TestCaseOperation3Output struct {
 	metadataInputService8TestShapeInputService8TestShapeInputService8TestCaseOperation3Output `json:"-", xml:"-"`
 }
@@ -731,6 +828,11 @@ type metadataInputService8TestShapeInputService8TestShapeInputService8TestCaseOp
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService8TestShapeInputService8TestShapeInputService8TestCaseOperation3Output.
+func (s InputService8TestShapeInputService8TestShapeInputService8TestCaseOperation3Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService8TestShapeInputService8TestShapeInputService8TestCaseOperation5Output struct {
 	metadataInputService8TestShapeInputService8TestShapeInputService8TestCaseOperation5Output `json:"-", xml:"-"`
 }
@@ -739,6 +841,11 @@ type metadataInputService8TestShapeInputService8TestShapeInputService8TestCaseOp
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService8TestShapeInputService8TestShapeInputService8TestCaseOperation5Output.
+func (s InputService8TestShapeInputService8TestShapeInputService8TestCaseOperation5Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService8TestShapeInputService8TestShapeRecursiveStructType struct {
 	NoRecurse *string `type:"string"`
 
@@ -755,6 +862,11 @@ type metadataInputService8TestShapeInputService8TestShapeRecursiveStructType str
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService8TestShapeInputService8TestShapeRecursiveStructType.
+func (s InputService8TestShapeInputService8TestShapeRecursiveStructType) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService8TestShapeInputShape struct {
 	RecursiveStruct *InputService8TestShapeInputService8TestShapeRecursiveStructType `type:"structure"`
 
@@ -765,6 +877,11 @@ type metadataInputService8TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService8TestShapeInputShape.
+func (s InputService8TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 //
 // Tests begin here
 //
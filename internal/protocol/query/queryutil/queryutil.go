@@ -74,8 +74,12 @@ func (q *queryParser) parseStruct(v url.Values, value reflect.Value, prefix stri
 		field := t.Field(i)
 		var name string
 
-		if q.isEC2 {
-			name = field.Tag.Get("queryName")
+		// queryName takes precedence over locationName, which EC2
+		// capitalizes, and then the field name. Flattened lists are named
+		// after their items.
+		name = field.Tag.Get("queryName")
+		if name == "" && field.Tag.Get("flattened") != "" {
+			name = field.Tag.Get("locationNameList")
 		}
 		if name == "" {
 			name = field.Tag.Get("locationName")
@@ -99,9 +103,13 @@ func (q *queryParser) parseStruct(v url.Values, value reflect.Value, prefix stri
 }
 
 func (q *queryParser) parseList(v url.Values, value reflect.Value, prefix string, tag reflect.StructTag) error {
-	// check for unflattened list member
+	// unflattened lists wrap their items, named member by default
 	if !q.isEC2 && tag.Get("flattened") == "" {
-		prefix += ".member"
+		if name := tag.Get("locationNameList"); name != "" {
+			prefix += "." + name
+		} else {
+			prefix += ".member"
+		}
 	}
 
 	for i := 0; i < value.Len(); i++ {
@@ -119,13 +127,13 @@ func (q *queryParser) parseList(v url.Values, value reflect.Value, prefix string
 }
 
 func (q *queryParser) parseMap(v url.Values, value reflect.Value, prefix string, tag reflect.StructTag) error {
-	// check for unflattened list member
+	// check for unflattened map entries
 	if !q.isEC2 && tag.Get("flattened") == "" {
 		prefix += ".entry"
 	}
 
-	// sort keys for improved serialization consistency.
-	// this is not strictly necessary for protocol support.
+	// sort keys so that the same map always serializes, and is signed, the
+	// same way.
 	mapKeyValues := value.MapKeys()
 	mapKeys := map[string]reflect.Value{}
 	mapKeyNames := make([]string, len(mapKeyValues))
@@ -136,31 +144,30 @@ func (q *queryParser) parseMap(v url.Values, value reflect.Value, prefix string,
 	}
 	sort.Strings(mapKeyNames)
 
+	kname, vname := "key", "value"
+	if n := tag.Get("locationNameKey"); n != "" {
+		kname = n
+	}
+	if n := tag.Get("locationNameValue"); n != "" {
+		vname = n
+	}
+
 	for i, mapKeyName := range mapKeyNames {
 		mapKey := mapKeys[mapKeyName]
 		mapValue := value.MapIndex(mapKey)
 
-		// serialize key
-		var keyName string
-		if prefix == "" {
-			keyName = strconv.Itoa(i+1) + ".key"
-		} else {
-			keyName = prefix + "." + strconv.Itoa(i+1) + ".key"
+		entryPrefix := strconv.Itoa(i + 1)
+		if prefix != "" {
+			entryPrefix = prefix + "." + entryPrefix
 		}
 
-		if err := q.parseValue(v, mapKey, keyName, ""); err != nil {
+		// serialize key
+		if err := q.parseValue(v, mapKey, entryPrefix+"."+kname, ""); err != nil {
 			return err
 		}
 
-		// serialize value
-		var valueName string
-		if prefix == "" {
-			valueName = strconv.Itoa(i+1) + ".value"
-		} else {
-			valueName = prefix + "." + strconv.Itoa(i+1) + ".value"
-		}
-
-		if err := q.parseValue(v, mapValue, valueName, ""); err != nil {
+		// serialize value, expanding structures into dotted keys
+		if err := q.parseValue(v, mapValue, entryPrefix+"."+vname, ""); err != nil {
 			return err
 		}
 	}
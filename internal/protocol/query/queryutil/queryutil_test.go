@@ -0,0 +1,174 @@
+package queryutil_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/internal/protocol/query/queryutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type mapEntryShape struct {
+	Name  *string `type:"string"`
+	Count *int64  `type:"integer"`
+}
+
+type mapShape struct {
+	Attributes *map[string]*string        `type:"map"`
+	Flat       *map[string]*string        `type:"map" flattened:"true"`
+	Named      *map[string]*string        `locationName:"Tag" locationNameKey:"Name" locationNameValue:"Val" type:"map" flattened:"true"`
+	Structs    *map[string]*mapEntryShape `type:"map"`
+}
+
+func parse(t *testing.T, v interface{}) url.Values {
+	body := url.Values{}
+	assert.NoError(t, queryutil.Parse(body, v, false))
+	return body
+}
+
+func TestParseMap(t *testing.T) {
+	body := parse(t, &mapShape{
+		Attributes: &map[string]*string{"b": aws.String("2"), "a": aws.String("1")},
+	})
+
+	assert.Equal(t, url.Values{
+		"Attributes.entry.1.key":   []string{"a"},
+		"Attributes.entry.1.value": []string{"1"},
+		"Attributes.entry.2.key":   []string{"b"},
+		"Attributes.entry.2.value": []string{"2"},
+	}, body)
+}
+
+func TestParseMapFlattened(t *testing.T) {
+	body := parse(t, &mapShape{
+		Flat:  &map[string]*string{"b": aws.String("2"), "a": aws.String("1")},
+		Named: &map[string]*string{"c": aws.String("3")},
+	})
+
+	assert.Equal(t, url.Values{
+		"Flat.1.key":   []string{"a"},
+		"Flat.1.value": []string{"1"},
+		"Flat.2.key":   []string{"b"},
+		"Flat.2.value": []string{"2"},
+		"Tag.1.Name":   []string{"c"},
+		"Tag.1.Val":    []string{"3"},
+	}, body)
+}
+
+func TestParseMapStructValues(t *testing.T) {
+	body := parse(t, &mapShape{
+		Structs: &map[string]*mapEntryShape{
+			"y": {Name: aws.String("second")},
+			"x": {Name: aws.String("first"), Count: aws.Long(1)},
+		},
+	})
+
+	assert.Equal(t, url.Values{
+		"Structs.entry.1.key":         []string{"x"},
+		"Structs.entry.1.value.Name":  []string{"first"},
+		"Structs.entry.1.value.Count": []string{"1"},
+		"Structs.entry.2.key":         []string{"y"},
+		"Structs.entry.2.value.Name":  []string{"second"},
+	}, body)
+}
+
+func TestParseMapDeterministic(t *testing.T) {
+	in := &mapShape{Attributes: &map[string]*string{}}
+	for _, k := range []string{"e", "c", "a", "d", "b"} {
+		(*in.Attributes)[k] = aws.String(k)
+	}
+
+	expected := parse(t, in).Encode()
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, expected, parse(t, in).Encode())
+	}
+}
+
+type queryNameShape struct {
+	Both     *string `locationName:"locName" queryName:"QueryName" type:"string"`
+	Location *string `locationName:"locName2" type:"string"`
+	Field    *string `type:"string"`
+}
+
+func TestParseQueryName(t *testing.T) {
+	v := &queryNameShape{
+		Both:     aws.String("a"),
+		Location: aws.String("b"),
+		Field:    aws.String("c"),
+	}
+
+	assert.Equal(t, url.Values{
+		"QueryName": []string{"a"},
+		"locName2":  []string{"b"},
+		"Field":     []string{"c"},
+	}, parse(t, v))
+
+	body := url.Values{}
+	assert.NoError(t, queryutil.Parse(body, v, true))
+	assert.Equal(t, url.Values{
+		"QueryName": []string{"a"},
+		"LocName2":  []string{"b"},
+		"Field":     []string{"c"},
+	}, body)
+}
+
+type listMemberShape struct {
+	Name  *string `type:"string"`
+	Value *string `type:"string"`
+}
+
+type listShape struct {
+	Scalars      []*string          `type:"list"`
+	NamedScalars []*string          `locationNameList:"Item" type:"list"`
+	Flat         []*string          `flattened:"true" type:"list"`
+	NamedFlat    []*string          `locationNameList:"Attribute" flattened:"true" type:"list"`
+	Structs      []*listMemberShape `type:"list"`
+	FlatStructs  []*listMemberShape `locationName:"Entry" flattened:"true" type:"list"`
+	Empty        []*string          `type:"list"`
+}
+
+func TestParseList(t *testing.T) {
+	body := parse(t, &listShape{
+		Scalars:      []*string{aws.String("a"), aws.String("b")},
+		NamedScalars: []*string{aws.String("c")},
+		Empty:        []*string{},
+	})
+
+	assert.Equal(t, url.Values{
+		"Scalars.member.1":    []string{"a"},
+		"Scalars.member.2":    []string{"b"},
+		"NamedScalars.Item.1": []string{"c"},
+	}, body)
+}
+
+func TestParseListFlattened(t *testing.T) {
+	body := parse(t, &listShape{
+		Flat:      []*string{aws.String("a"), aws.String("b")},
+		NamedFlat: []*string{aws.String("c")},
+	})
+
+	assert.Equal(t, url.Values{
+		"Flat.1":      []string{"a"},
+		"Flat.2":      []string{"b"},
+		"Attribute.1": []string{"c"},
+	}, body)
+}
+
+func TestParseListStructs(t *testing.T) {
+	body := parse(t, &listShape{
+		Structs: []*listMemberShape{
+			{Name: aws.String("a"), Value: aws.String("1")},
+			{Name: aws.String("b")},
+		},
+		FlatStructs: []*listMemberShape{{Name: aws.String("c"), Value: aws.String("3")}},
+	})
+
+	assert.Equal(t, url.Values{
+		"Structs.member.1.Name":  []string{"a"},
+		"Structs.member.1.Value": []string{"1"},
+		"Structs.member.2.Name":  []string{"b"},
+		"Entry.1.Name":           []string{"c"},
+		"Entry.1.Value":          []string{"3"},
+	}, body)
+}
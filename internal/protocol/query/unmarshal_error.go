@@ -2,11 +2,19 @@ package query
 
 import (
 	"encoding/xml"
-	"io"
+	"io/ioutil"
 
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awserr"
 )
 
+// xmlErrorResponse is the body of a query error response, which nests the
+// error's code and message in an Error element beside the request ID:
+//
+//	<ErrorResponse>
+//	  <Error><Type>Sender</Type><Code>...</Code><Message>...</Message></Error>
+//	  <RequestId>...</RequestId>
+//	</ErrorResponse>
 type xmlErrorResponse struct {
 	XMLName   xml.Name `xml:"ErrorResponse"`
 	Code      string   `xml:"Error>Code"`
@@ -14,18 +22,40 @@ type xmlErrorResponse struct {
 	RequestID string   `xml:"RequestId"`
 }
 
+// UnmarshalError unmarshals an error response for the query protocol into
+// an awserr.RequestFailure. Responses without a body, such as those of HEAD
+// requests, fail with the response's status as the message, and the request
+// ID of the X-Amzn-Requestid header.
 func UnmarshalError(r *aws.Request) {
 	defer r.HTTPResponse.Body.Close()
 
+	bodyBytes, err := ioutil.ReadAll(r.HTTPResponse.Body)
+	if err != nil {
+		r.Error = awserr.New("SerializationError", "failed to read query XML error response", err)
+		return
+	}
+	requestID := r.HTTPResponse.Header.Get("X-Amzn-Requestid")
+	if len(bodyBytes) == 0 {
+		r.Error = awserr.NewRequestFailure(
+			awserr.New("", r.HTTPResponse.Status, nil),
+			r.HTTPResponse.StatusCode,
+			requestID,
+		)
+		return
+	}
+
 	resp := &xmlErrorResponse{}
-	err := xml.NewDecoder(r.HTTPResponse.Body).Decode(resp)
-	if err != nil && err != io.EOF {
-		r.Error = err
-	} else {
-		r.Error = aws.APIError{
-			StatusCode: r.HTTPResponse.StatusCode,
-			Code:       resp.Code,
-			Message:    resp.Message,
-		}
+	if err := xml.Unmarshal(bodyBytes, resp); err != nil {
+		r.Error = awserr.New("SerializationError", "failed to decode query XML error response", err)
+		return
+	}
+
+	if resp.RequestID != "" {
+		requestID = resp.RequestID
 	}
+	r.Error = awserr.NewRequestFailure(
+		awserr.New(resp.Code, resp.Message, nil),
+		r.HTTPResponse.StatusCode,
+		requestID,
+	)
 }
@@ -0,0 +1,65 @@
+package query_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awserr"
+	"github.com/awslabs/aws-sdk-go/internal/protocol/query"
+	"github.com/stretchr/testify/assert"
+)
+
+// a recorded IAM error response
+const noSuchEntityBody = `<ErrorResponse xmlns="https://iam.amazonaws.com/doc/2010-05-08/">
+  <Error>
+    <Type>Sender</Type>
+    <Code>NoSuchEntity</Code>
+    <Message>The user with name missing cannot be found.</Message>
+  </Error>
+  <RequestId>4a0f2b8e-a501-11e4-8d22-d9e5d8a7c7e1</RequestId>
+</ErrorResponse>
+`
+
+func unmarshalError(status int, header http.Header, body string) error {
+	req := aws.NewRequest(aws.NewService(&aws.Config{}), &aws.Operation{Name: "Operation"}, nil, nil)
+	req.HTTPResponse = &http.Response{
+		StatusCode: status,
+		Status:     http.StatusText(status),
+		Header:     header,
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(body))),
+	}
+	query.UnmarshalError(req)
+	return req.Error
+}
+
+func TestUnmarshalRecordedError(t *testing.T) {
+	err, ok := unmarshalError(404, http.Header{}, noSuchEntityBody).(awserr.RequestFailure)
+	assert.True(t, ok)
+	assert.Equal(t, "NoSuchEntity", err.Code())
+	assert.Equal(t, "The user with name missing cannot be found.", err.Message())
+	assert.Equal(t, 404, err.StatusCode())
+	assert.Equal(t, "4a0f2b8e-a501-11e4-8d22-d9e5d8a7c7e1", err.RequestID())
+}
+
+func TestUnmarshalErrorRequestIDHeader(t *testing.T) {
+	body := `<ErrorResponse><Error><Code>InvalidAction</Code><Message>bad</Message></Error></ErrorResponse>`
+	err := unmarshalError(400, http.Header{"X-Amzn-Requestid": []string{"header-id"}}, body).(awserr.RequestFailure)
+	assert.Equal(t, "InvalidAction", err.Code())
+	assert.Equal(t, "header-id", err.RequestID())
+}
+
+func TestUnmarshalErrorEmptyBody(t *testing.T) {
+	err := unmarshalError(503, http.Header{"X-Amzn-Requestid": []string{"header-id"}}, "").(awserr.RequestFailure)
+	assert.Equal(t, "", err.Code())
+	assert.Equal(t, "Service Unavailable", err.Message())
+	assert.Equal(t, 503, err.StatusCode())
+	assert.Equal(t, "header-id", err.RequestID())
+}
+
+func TestUnmarshalErrorInvalidBody(t *testing.T) {
+	err := unmarshalError(500, http.Header{}, "<html>Internal Server Error</html>").(awserr.Error)
+	assert.Equal(t, "SerializationError", err.Code())
+}
@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 	"github.com/awslabs/aws-sdk-go/internal/protocol/xml/xmlutil"
 	"github.com/awslabs/aws-sdk-go/internal/util"
 	"github.com/stretchr/testify/assert"
@@ -27,6 +28,7 @@ var _ xml.Attr
 var _ = ioutil.Discard
 var _ = util.Trim("")
 var _ = url.Values{}
+var _ = awsutil.StringValue
 
 // OutputService1ProtocolTest is a client for OutputService1ProtocolTest.
 type OutputService1ProtocolTest struct {
@@ -47,7 +49,7 @@ func NewOutputService1ProtocolTest(config *aws.Config) *OutputService1ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(query.Build)
 	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
@@ -87,6 +89,11 @@ type metadataOutputService1TestShapeOutputService1TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService1TestShapeOutputService1TestCaseOperation1Input.
+func (s OutputService1TestShapeOutputService1TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService1TestShapeOutputService1TestShapeOutputShape struct {
 	Char *string `type:"character"`
 
@@ -113,6 +120,11 @@ type metadataOutputService1TestShapeOutputService1TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService1TestShapeOutputService1TestShapeOutputShape.
+func (s OutputService1TestShapeOutputService1TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // OutputService2ProtocolTest is a client for OutputService2ProtocolTest.
 type OutputService2ProtocolTest struct {
 	*aws.Service
@@ -132,7 +144,7 @@ func NewOutputService2ProtocolTest(config *aws.Config) *OutputService2ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(query.Build)
 	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
@@ -172,6 +184,11 @@ type metadataOutputService2TestShapeOutputService2TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService2TestShapeOutputService2TestCaseOperation1Input.
+func (s OutputService2TestShapeOutputService2TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService2TestShapeOutputShape struct {
 	Num *int64 `type:"integer"`
 
@@ -184,6 +201,11 @@ type metadataOutputService2TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService2TestShapeOutputShape.
+func (s OutputService2TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // OutputService3ProtocolTest is a client for OutputService3ProtocolTest.
 type OutputService3ProtocolTest struct {
 	*aws.Service
@@ -203,7 +225,7 @@ func NewOutputService3ProtocolTest(config *aws.Config) *OutputService3ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(query.Build)
 	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
@@ -243,6 +265,11 @@ type metadataOutputService3TestShapeOutputService3TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService3TestShapeOutputService3TestCaseOperation1Input.
+func (s OutputService3TestShapeOutputService3TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService3TestShapeOutputShape struct {
 	Blob []byte `type:"blob"`
 
@@ -253,6 +280,11 @@ type metadataOutputService3TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService3TestShapeOutputShape.
+func (s OutputService3TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // OutputService4ProtocolTest is a client for OutputService4ProtocolTest.
 type OutputService4ProtocolTest struct {
 	*aws.Service
@@ -272,7 +304,7 @@ func NewOutputService4ProtocolTest(config *aws.Config) *OutputService4ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(query.Build)
 	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
@@ -312,6 +344,11 @@ type metadataOutputService4TestShapeOutputService4TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService4TestShapeOutputService4TestCaseOperation1Input.
+func (s OutputService4TestShapeOutputService4TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService4TestShapeOutputShape struct {
 	ListMember []*string `type:"list"`
 
@@ -322,6 +359,11 @@ type metadataOutputService4TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService4TestShapeOutputShape.
+func (s OutputService4TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // OutputService5ProtocolTest is a client for OutputService5ProtocolTest.
 type OutputService5ProtocolTest struct {
 	*aws.Service
@@ -341,7 +383,7 @@ func NewOutputService5ProtocolTest(config *aws.Config) *OutputService5ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(query.Build)
 	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
@@ -381,6 +423,11 @@ type metadataOutputService5TestShapeOutputService5TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService5TestShapeOutputService5TestCaseOperation1Input.
+func (s OutputService5TestShapeOutputService5TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService5TestShapeOutputShape struct {
 	ListMember []*string `locationNameList:"item" type:"list"`
 
@@ -391,6 +438,11 @@ type metadataOutputService5TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService5TestShapeOutputShape.
+func (s OutputService5TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // OutputService6ProtocolTest is a client for OutputService6ProtocolTest.
 type OutputService6ProtocolTest struct {
 	*aws.Service
@@ -410,7 +462,7 @@ func NewOutputService6ProtocolTest(config *aws.Config) *OutputService6ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(query.Build)
 	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
@@ -450,6 +502,11 @@ type metadataOutputService6TestShapeOutputService6TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService6TestShapeOutputService6TestCaseOperation1Input.
+func (s OutputService6TestShapeOutputService6TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService6TestShapeOutputShape struct {
 	ListMember []*string `type:"list" flattened:"true"`
 
@@ -460,6 +517,11 @@ type metadataOutputService6TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService6TestShapeOutputShape.
+func (s OutputService6TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // OutputService7ProtocolTest is a client for OutputService7ProtocolTest.
 type OutputService7ProtocolTest struct {
 	*aws.Service
@@ -479,7 +541,7 @@ func NewOutputService7ProtocolTest(config *aws.Config) *OutputService7ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(query.Build)
 	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
@@ -519,6 +581,11 @@ type metadataOutputService7TestShapeOutputService7TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService7TestShapeOutputService7TestCaseOperation1Input.
+func (s OutputService7TestShapeOutputService7TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService7TestShapeOutputShape struct {
 	ListMember []*string `type:"list" flattened:"true"`
 
@@ -529,6 +596,11 @@ type metadataOutputService7TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService7TestShapeOutputShape.
+func (s OutputService7TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // OutputService8ProtocolTest is a client for OutputService8ProtocolTest.
 type OutputService8ProtocolTest struct {
 	*aws.Service
@@ -548,7 +620,7 @@ func NewOutputService8ProtocolTest(config *aws.Config) *OutputService8ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(query.Build)
 	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
@@ -588,6 +660,11 @@ type metadataOutputService8TestShapeOutputService8TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService8TestShapeOutputService8TestCaseOperation1Input.
+func (s OutputService8TestShapeOutputService8TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService8TestShapeOutputShape struct {
 	List []*OutputService8TestShapeStructureShape `type:"list"`
 
@@ -598,6 +675,11 @@ type metadataOutputService8TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService8TestShapeOutputShape.
+func (s OutputService8TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService8TestShapeStructureShape struct {
 	Bar *string `type:"string"`
 
@@ -612,6 +694,11 @@ type metadataOutputService8TestShapeStructureShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService8TestShapeStructureShape.
+func (s OutputService8TestShapeStructureShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // OutputService9ProtocolTest is a client for OutputService9ProtocolTest.
 type OutputService9ProtocolTest struct {
 	*aws.Service
@@ -631,7 +718,7 @@ func NewOutputService9ProtocolTest(config *aws.Config) *OutputService9ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(query.Build)
 	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
@@ -671,6 +758,11 @@ type metadataOutputService9TestShapeOutputService9TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService9TestShapeOutputService9TestCaseOperation1Input.
+func (s OutputService9TestShapeOutputService9TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService9TestShapeOutputShape struct {
 	List []*OutputService9TestShapeStructureShape `type:"list" flattened:"true"`
 
@@ -681,6 +773,11 @@ type metadataOutputService9TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService9TestShapeOutputShape.
+func (s OutputService9TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService9TestShapeStructureShape struct {
 	Bar *string `type:"string"`
 
@@ -695,6 +792,11 @@ type metadataOutputService9TestShapeStructureShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService9TestShapeStructureShape.
+func (s OutputService9TestShapeStructureShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // OutputService10ProtocolTest is a client for OutputService10ProtocolTest.
 type OutputService10ProtocolTest struct {
 	*aws.Service
@@ -714,7 +816,7 @@ func NewOutputService10ProtocolTest(config *aws.Config) *OutputService10Protocol
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(query.Build)
 	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
@@ -754,6 +856,11 @@ type metadataOutputService10TestShapeOutputService10TestCaseOperation1Input stru
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService10TestShapeOutputService10TestCaseOperation1Input.
+func (s OutputService10TestShapeOutputService10TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService10TestShapeOutputShape struct {
 	List []*string `locationNameList:"NamedList" type:"list" flattened:"true"`
 
@@ -764,6 +871,11 @@ type metadataOutputService10TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService10TestShapeOutputShape.
+func (s OutputService10TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // OutputService11ProtocolTest is a client for OutputService11ProtocolTest.
 type OutputService11ProtocolTest struct {
 	*aws.Service
@@ -783,7 +895,7 @@ func NewOutputService11ProtocolTest(config *aws.Config) *OutputService11Protocol
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(query.Build)
 	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
@@ -823,6 +935,11 @@ type metadataOutputService11TestShapeOutputService11TestCaseOperation1Input stru
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService11TestShapeOutputService11TestCaseOperation1Input.
+func (s OutputService11TestShapeOutputService11TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService11TestShapeOutputShape struct {
 	Map *map[string]*OutputService11TestShapeStructType `type:"map"`
 
@@ -833,6 +950,11 @@ type metadataOutputService11TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService11TestShapeOutputShape.
+func (s OutputService11TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService11TestShapeStructType struct {
 	Foo *string `locationName:"foo" type:"string"`
 
@@ -843,6 +965,11 @@ type metadataOutputService11TestShapeStructType struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService11TestShapeStructType.
+func (s OutputService11TestShapeStructType) String() string {
+	return awsutil.StringValue(s)
+}
+
 // OutputService12ProtocolTest is a client for OutputService12ProtocolTest.
 type OutputService12ProtocolTest struct {
 	*aws.Service
@@ -862,7 +989,7 @@ func NewOutputService12ProtocolTest(config *aws.Config) *OutputService12Protocol
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(query.Build)
 	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
@@ -902,6 +1029,11 @@ type metadataOutputService12TestShapeOutputService12TestCaseOperation1Input stru
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService12TestShapeOutputService12TestCaseOperation1Input.
+func (s OutputService12TestShapeOutputService12TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService12TestShapeOutputShape struct {
 	Map *map[string]*string `type:"map" flattened:"true"`
 
@@ -912,6 +1044,11 @@ type metadataOutputService12TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService12TestShapeOutputShape.
+func (s OutputService12TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // OutputService13ProtocolTest is a client for OutputService13ProtocolTest.
 type OutputService13ProtocolTest struct {
 	*aws.Service
@@ -931,7 +1068,7 @@ func NewOutputService13ProtocolTest(config *aws.Config) *OutputService13Protocol
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(query.Build)
 	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
@@ -971,6 +1108,11 @@ type metadataOutputService13TestShapeOutputService13TestCaseOperation1Input stru
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService13TestShapeOutputService13TestCaseOperation1Input.
+func (s OutputService13TestShapeOutputService13TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService13TestShapeOutputShape struct {
 	Map *map[string]*string `locationName:"Attribute" locationNameKey:"Name" locationNameValue:"Value" type:"map" flattened:"true"`
 
@@ -981,6 +1123,11 @@ type metadataOutputService13TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService13TestShapeOutputShape.
+func (s OutputService13TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // OutputService14ProtocolTest is a client for OutputService14ProtocolTest.
 type OutputService14ProtocolTest struct {
 	*aws.Service
@@ -1000,7 +1147,7 @@ func NewOutputService14ProtocolTest(config *aws.Config) *OutputService14Protocol
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(query.Build)
 	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
@@ -1040,6 +1187,11 @@ type metadataOutputService14TestShapeOutputService14TestCaseOperation1Input stru
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService14TestShapeOutputService14TestCaseOperation1Input.
+func (s OutputService14TestShapeOutputService14TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService14TestShapeOutputShape struct {
 	Map *map[string]*string `locationNameKey:"foo" locationNameValue:"bar" type:"map" flattened:"true"`
 
@@ -1050,6 +1202,11 @@ type metadataOutputService14TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService14TestShapeOutputShape.
+func (s OutputService14TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 //
 // Tests begin here
 //
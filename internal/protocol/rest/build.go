@@ -3,6 +3,7 @@ package rest
 import (
 	"bytes"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/url"
@@ -24,11 +25,27 @@ func Build(r *aws.Request) {
 		buildLocationElements(r, v)
 		buildBody(r, v)
 	}
+	buildAccept(r)
+}
+
+// buildAccept accepts any content type for operations whose output payload
+// is a blob, such as S3's GetObject, as their response bodies are returned
+// as they are rather than decoded by the protocol. An Accept header member
+// of the params, or one set with the request's SetHeader, is kept.
+func buildAccept(r *aws.Request) {
+	if PayloadType(r.Data) == "blob" && r.HTTPRequest.Header.Get("Accept") == "" {
+		r.HTTPRequest.Header.Set("Accept", "*/*")
+	}
 }
 
 func buildLocationElements(r *aws.Request, v reflect.Value) {
 	query := r.HTTPRequest.URL.Query()
 
+	// the path template is cleaned before its labels are substituted, so that
+	// the slashes and dots of greedy labels, such as S3 object keys, are kept
+	// as they are
+	r.HTTPRequest.URL.Path = path.Clean(r.HTTPRequest.URL.Path)
+
 	for i := 0; i < v.NumField(); i++ {
 		m := v.Field(i)
 		if n := v.Type().Field(i).Name; n[0:1] == strings.ToLower(n[0:1]) {
@@ -68,6 +85,10 @@ func buildLocationElements(r *aws.Request, v reflect.Value) {
 	updatePath(r.HTTPRequest.URL, r.HTTPRequest.URL.Path)
 }
 
+// buildBody sets a non-structure payload member, such as the io.ReadSeeker
+// of an uploaded object, as the raw body of the request. Protocols do not
+// serialize the params of such requests into the body, and the body's
+// Content-Length is computed when the request is signed.
 func buildBody(r *aws.Request, v reflect.Value) {
 	if field, ok := v.Type().FieldByName("SDKShapeTraits"); ok {
 		if payloadName := field.Tag.Get("payload"); payloadName != "" {
@@ -112,6 +133,9 @@ func buildHeaderMap(r *aws.Request, v reflect.Value, prefix string) {
 	}
 }
 
+// buildURI substitutes the member into the path's {name} label, escaping
+// every reserved character including slashes, and into its greedy {name+}
+// label, which captures slashes and so leaves them unescaped.
 func buildURI(r *aws.Request, v reflect.Value, name string) {
 	value, err := convertType(v)
 	if err != nil {
@@ -136,9 +160,6 @@ func buildQueryString(r *aws.Request, v reflect.Value, name string, query url.Va
 func updatePath(url *url.URL, urlPath string) {
 	scheme, query := url.Scheme, url.RawQuery
 
-	// clean up path
-	urlPath = path.Clean(urlPath)
-
 	// get formatted URL minus scheme so we can build this into Opaque
 	url.Scheme, url.Path, url.RawQuery = "", "", ""
 	s := url.String()
@@ -151,10 +172,8 @@ func updatePath(url *url.URL, urlPath string) {
 
 // Whether the byte value can be sent without escaping in AWS URLs
 var noEscape [256]bool
-var noEscapeInitialized = false
 
-// initialise noEscape
-func initNoEscape() {
+func init() {
 	for i := range noEscape {
 		// Amazon expects every character except these escaped
 		noEscape[i] = (i >= 'A' && i <= 'Z') ||
@@ -169,19 +188,13 @@ func initNoEscape() {
 
 // escapePath escapes part of a URL path in Amazon style
 func escapePath(path string, encodeSep bool) string {
-	if !noEscapeInitialized {
-		initNoEscape()
-		noEscapeInitialized = true
-	}
-
 	var buf bytes.Buffer
 	for i := 0; i < len(path); i++ {
 		c := path[i]
 		if noEscape[c] || (c == '/' && !encodeSep) {
 			buf.WriteByte(c)
 		} else {
-			buf.WriteByte('%')
-			buf.WriteString(strings.ToUpper(strconv.FormatUint(uint64(c), 16)))
+			fmt.Fprintf(&buf, "%%%02X", c)
 		}
 	}
 	return buf.String()
@@ -207,6 +220,15 @@ func convertType(v reflect.Value) (*string, error) {
 		str = strconv.FormatFloat(value, 'f', -1, 64)
 	case time.Time:
 		str = value.UTC().Format(RFC822)
+	case aws.JSONValue:
+		if value == nil {
+			return nil, nil
+		}
+		b, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		str = base64.StdEncoding.EncodeToString(b)
 	default:
 		err := fmt.Errorf("Unsupported value for param %v (%s)", v.Interface(), v.Type())
 		return nil, err
@@ -0,0 +1,123 @@
+package rest_test
+
+import (
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/internal/protocol/restxml"
+	"github.com/stretchr/testify/assert"
+)
+
+type readerPayloadInput struct {
+	Body io.ReadSeeker `type:"blob"`
+	Key  *string       `location:"uri" locationName:"Key" type:"string"`
+	Name *string       `type:"string"`
+
+	metadataReaderPayloadInput `json:"-" xml:"-"`
+}
+
+type metadataReaderPayloadInput struct {
+	SDKShapeTraits bool `type:"structure" payload:"Body"`
+}
+
+type blobPayloadInput struct {
+	Body []byte  `type:"blob"`
+	Name *string `type:"string"`
+
+	metadataBlobPayloadInput `json:"-" xml:"-"`
+}
+
+type metadataBlobPayloadInput struct {
+	SDKShapeTraits bool `type:"structure" payload:"Body"`
+}
+
+func buildPayload(t *testing.T, params interface{}) *aws.Request {
+	s := aws.NewService(&aws.Config{Endpoint: "https://test"})
+	s.Handlers.Build.PushBack(restxml.Build)
+
+	req := aws.NewRequest(s, &aws.Operation{Name: "PutObject", HTTPMethod: "PUT", HTTPPath: "/{Key}"}, params, nil)
+	assert.NoError(t, req.Sign()) // computes the Content-Length
+	return req
+}
+
+func TestBuildReaderPayload(t *testing.T) {
+	req := buildPayload(t, &readerPayloadInput{
+		Body: strings.NewReader("object data"),
+		Key:  aws.String("key"),
+		Name: aws.String("not serialized"),
+	})
+
+	b, err := ioutil.ReadAll(req.HTTPRequest.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, "object data", string(b))
+	assert.Equal(t, int64(11), req.HTTPRequest.ContentLength)
+	assert.Equal(t, "11", req.HTTPRequest.Header.Get("Content-Length"))
+	assert.Equal(t, "https://test/key", req.HTTPRequest.URL.String())
+}
+
+func TestBuildBlobPayload(t *testing.T) {
+	req := buildPayload(t, &blobPayloadInput{
+		Body: []byte("blob data"),
+		Name: aws.String("not serialized"),
+	})
+
+	b, err := ioutil.ReadAll(req.HTTPRequest.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, "blob data", string(b))
+	assert.Equal(t, int64(9), req.HTTPRequest.ContentLength)
+	assert.Equal(t, "9", req.HTTPRequest.Header.Get("Content-Length"))
+}
+
+func TestBuildEmptyPayload(t *testing.T) {
+	req := buildPayload(t, &blobPayloadInput{})
+
+	b, err := ioutil.ReadAll(req.HTTPRequest.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(b))
+	assert.Equal(t, "0", req.HTTPRequest.Header.Get("Content-Length"))
+}
+
+type uriInput struct {
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string"`
+	Key    *string `location:"uri" locationName:"Key" type:"string"`
+
+	metadataURIInput `json:"-" xml:"-"`
+}
+
+type metadataURIInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+func buildURL(t *testing.T, path string, bucket, key string) string {
+	s := aws.NewService(&aws.Config{Endpoint: "https://test"})
+	s.Handlers.Build.PushBack(restxml.Build)
+
+	req := aws.NewRequest(s, &aws.Operation{Name: "GetObject", HTTPMethod: "GET", HTTPPath: path},
+		&uriInput{Bucket: aws.String(bucket), Key: aws.String(key)}, nil)
+	assert.NoError(t, req.Build())
+	return req.HTTPRequest.URL.String()
+}
+
+func TestBuildGreedyURILabel(t *testing.T) {
+	cases := []struct{ key, url string }{
+		{"key", "https://test/bucket/key"},
+		{"dir/sub dir/my file.txt", "https://test/bucket/dir/sub%20dir/my%20file.txt"},
+		{"dir/", "https://test/bucket/dir/"},
+		{"a//b/./c", "https://test/bucket/a//b/./c"},
+		{"a+b=c&d?e#f", "https://test/bucket/a%2Bb%3Dc%26d%3Fe%23f"},
+		{"tab\tnewline\n", "https://test/bucket/tab%09newline%0A"},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.url, buildURL(t, "/{Bucket}/{Key+}", "bucket", c.key))
+	}
+}
+
+func TestBuildURILabel(t *testing.T) {
+	assert.Equal(t, "https://test/bucket/dir%2Fsub%20dir%2Ffile",
+		buildURL(t, "/{Bucket}/{Key}", "bucket", "dir/sub dir/file"))
+	assert.Equal(t, "https://test/my%20bucket/key",
+		buildURL(t, "/{Bucket}/{Key+}/", "my bucket", "key"))
+}
@@ -2,8 +2,11 @@ package rest
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"math"
 	"net/http"
 	"reflect"
 	"strconv"
@@ -11,6 +14,8 @@ import (
 	"time"
 
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awserr"
+	"github.com/awslabs/aws-sdk-go/internal/protocol/eventstream"
 )
 
 func Unmarshal(r *aws.Request) {
@@ -21,44 +26,81 @@ func Unmarshal(r *aws.Request) {
 	}
 }
 
+// eventStreamType is the type of payloads streaming events, which are read from
+// application/vnd.amazon.eventstream response bodies. Their members are tagged
+// with an eventstream type, so that protocols do not decode the body as a
+// structure.
+var eventStreamType = reflect.TypeOf((*eventstream.EventStream)(nil))
+
+// unmarshalBody unmarshals a response body which is the output's payload
+// member, rather than a structure decoded by the protocol. A streaming
+// payload, such as the Body of S3's GetObject, is given the response body as
+// it is, which is left open for the caller to read and close, while blob and
+// string payloads are read whole and the body closed. The body of an error
+// response is not a payload, and is left for UnmarshalError.
 func unmarshalBody(r *aws.Request, v reflect.Value) {
-	if field, ok := v.Type().FieldByName("SDKShapeTraits"); ok {
-		if payloadName := field.Tag.Get("payload"); payloadName != "" {
-			pfield, _ := v.Type().FieldByName(payloadName)
-			if ptag := pfield.Tag.Get("type"); ptag != "" && ptag != "structure" {
-				payload := reflect.Indirect(v.FieldByName(payloadName))
-				if payload.IsValid() {
-					switch payload.Interface().(type) {
-					case []byte:
-						b, err := ioutil.ReadAll(r.HTTPResponse.Body)
-						if err != nil {
-							r.Error = err
-						} else {
-							payload.Set(reflect.ValueOf(b))
-						}
-					case string:
-						b, err := ioutil.ReadAll(r.HTTPResponse.Body)
-						if err != nil {
-							r.Error = err
-						} else {
-							payload.Set(reflect.ValueOf(string(b)))
-						}
-					default:
-						switch payload.Type().String() {
-						case "io.ReadSeeker":
-							payload.Set(reflect.ValueOf(aws.ReadSeekCloser(r.HTTPResponse.Body)))
-						case "aws.ReadSeekCloser", "io.ReadCloser":
-							payload.Set(reflect.ValueOf(r.HTTPResponse.Body))
-						default:
-							r.Error = fmt.Errorf("unknown payload type %s", payload.Type())
-						}
-					}
-				}
-			}
+	field, ok := v.Type().FieldByName("SDKShapeTraits")
+	if !ok {
+		return
+	}
+	payloadName := field.Tag.Get("payload")
+	if payloadName == "" {
+		return
+	}
+
+	if r.HTTPResponse.StatusCode >= 300 { // the body is the error, left to UnmarshalError
+		return
+	}
+
+	pfield, _ := v.Type().FieldByName(payloadName)
+	payload := v.FieldByName(payloadName)
+	if pfield.Type == eventStreamType { // events are decoded as they are read
+		payload.Set(reflect.ValueOf(eventstream.NewEventStream(r.HTTPResponse.Body)))
+		return
+	}
+	if ptag := pfield.Tag.Get("type"); ptag == "" || ptag == "structure" {
+		return
+	}
+
+	switch payload.Interface().(type) {
+	case []byte:
+		b, err := readBody(r)
+		if err != nil {
+			r.Error = err
+			return
+		}
+		payload.Set(reflect.ValueOf(b))
+	case *string:
+		b, err := readBody(r)
+		if err != nil {
+			r.Error = err
+			return
+		}
+		str := string(b)
+		payload.Set(reflect.ValueOf(&str))
+	default:
+		switch {
+		case pfield.Type == readCloserType:
+			payload.Set(reflect.ValueOf(r.HTTPResponse.Body))
+		case pfield.Type == readSeekerType:
+			payload.Set(reflect.ValueOf(aws.ReadSeekCloser(r.HTTPResponse.Body)))
+		default:
+			r.Error = fmt.Errorf("unknown payload type %s", pfield.Type)
 		}
 	}
 }
 
+var (
+	readCloserType = reflect.TypeOf((*io.ReadCloser)(nil)).Elem()
+	readSeekerType = reflect.TypeOf((*io.ReadSeeker)(nil)).Elem()
+)
+
+// readBody reads the whole response body and closes it.
+func readBody(r *aws.Request) ([]byte, error) {
+	defer r.HTTPResponse.Body.Close()
+	return ioutil.ReadAll(r.HTTPResponse.Body)
+}
+
 func unmarshalLocationElements(r *aws.Request, v reflect.Value) {
 	for i := 0; i < v.NumField(); i++ {
 		m, field := v.Field(i), v.Type().Field(i)
@@ -76,7 +118,12 @@ func unmarshalLocationElements(r *aws.Request, v reflect.Value) {
 			case "statusCode":
 				unmarshalStatusCode(m, r.HTTPResponse.StatusCode)
 			case "header":
-				err := unmarshalHeader(m, r.HTTPResponse.Header.Get(name))
+				if missingRequiredHeader(r, name, field.Tag) {
+					r.Error = awserr.New("SerializationError",
+						fmt.Sprintf("response is missing required header %s", name), nil)
+					break
+				}
+				err := unmarshalHeader(m, r.HTTPResponse.Header.Get(name), field.Tag)
 				if err != nil {
 					r.Error = err
 					break
@@ -96,6 +143,23 @@ func unmarshalLocationElements(r *aws.Request, v reflect.Value) {
 	}
 }
 
+// missingRequiredHeader returns whether the response lacks the header of a
+// required member, when the request's Config enables checking for them.
+// Headers are not checked by default, as some services omit headers their
+// models require.
+func missingRequiredHeader(r *aws.Request, name string, tag reflect.StructTag) bool {
+	if r.Service == nil || r.Service.Config == nil || !r.Service.Config.RequireResponseHeaders {
+		return false
+	}
+	if tag.Get("required") != "true" {
+		return false
+	}
+	_, ok := r.HTTPResponse.Header[http.CanonicalHeaderKey(name)]
+	return !ok
+}
+
+// unmarshalStatusCode sets a member located in the statusCode of responses to
+// the response's HTTP status code.
 func unmarshalStatusCode(v reflect.Value, statusCode int) {
 	if !v.IsValid() {
 		return
@@ -108,23 +172,36 @@ func unmarshalStatusCode(v reflect.Value, statusCode int) {
 	}
 }
 
+// unmarshalHeaderMap collects the headers whose names start with prefix,
+// compared case insensitively, into a map keyed by the rest of their
+// canonical names. Maps are only set if a header matches.
 func unmarshalHeaderMap(r reflect.Value, headers http.Header, prefix string) error {
+	out := map[string]*string{}
+	for k, v := range headers {
+		k = http.CanonicalHeaderKey(k)
+		if len(v) > 0 && strings.HasPrefix(strings.ToLower(k), strings.ToLower(prefix)) {
+			value := v[0]
+			out[k[len(prefix):]] = &value
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+
 	switch r.Interface().(type) {
 	case *map[string]*string: // we only support string map value types
-		out := map[string]*string{}
-		for k, v := range headers {
-			k = http.CanonicalHeaderKey(k)
-			if strings.HasPrefix(strings.ToLower(k), strings.ToLower(prefix)) {
-				out[k[len(prefix):]] = &v[0]
-			}
-		}
 		r.Set(reflect.ValueOf(&out))
+	case map[string]*string:
+		r.Set(reflect.ValueOf(out))
 	}
 	return nil
 }
 
-func unmarshalHeader(v reflect.Value, header string) error {
-	if !v.IsValid() || (header == "" && v.Elem().Kind() != reflect.String) {
+// unmarshalHeader converts the value of a header into the member's type.
+// Blobs are base64 encoded, and timestamps are RFC822 formatted or in the
+// member's timestampFormat.
+func unmarshalHeader(v reflect.Value, header string, tag reflect.StructTag) error {
+	if !v.IsValid() || (header == "" && (v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.String)) {
 		return nil
 	}
 
@@ -136,7 +213,7 @@ func unmarshalHeader(v reflect.Value, header string) error {
 		if err != nil {
 			return err
 		} else {
-			v.Set(reflect.ValueOf(&b))
+			v.Set(reflect.ValueOf(b))
 		}
 	case *bool:
 		b, err := strconv.ParseBool(header)
@@ -160,15 +237,47 @@ func unmarshalHeader(v reflect.Value, header string) error {
 			v.Set(reflect.ValueOf(&f))
 		}
 	case *time.Time:
-		t, err := time.Parse(RFC822, header)
+		t, err := parseHeaderTime(header, tag.Get("timestampFormat"))
 		if err != nil {
 			return err
 		} else {
 			v.Set(reflect.ValueOf(&t))
 		}
+	case aws.JSONValue:
+		b, err := base64.StdEncoding.DecodeString(header)
+		if err != nil {
+			return err
+		}
+		m := aws.JSONValue{}
+		if err := json.Unmarshal(b, &m); err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(m))
 	default:
 		err := fmt.Errorf("Unsupported value for param %v (%s)", v.Interface(), v.Type())
 		return err
 	}
 	return nil
 }
+
+// parseHeaderTime parses a timestamp header into UTC. Headers are RFC822
+// formatted by default, and are otherwise parsed in the timestampFormat of
+// their member.
+func parseHeaderTime(header, format string) (time.Time, error) {
+	t, err := time.Parse(RFC822, header)
+	if err == nil {
+		return t.UTC(), nil
+	}
+
+	switch format {
+	case "iso8601":
+		t, err = time.Parse(time.RFC3339Nano, header)
+	case "unixTimestamp", "unix":
+		var f float64
+		if f, err = strconv.ParseFloat(header, 64); err == nil {
+			sec := math.Floor(f)
+			t = time.Unix(int64(sec), int64((f-sec)*1e9))
+		}
+	}
+	return t.UTC(), err
+}
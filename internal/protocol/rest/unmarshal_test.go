@@ -0,0 +1,307 @@
+package rest_test
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awserr"
+	"github.com/awslabs/aws-sdk-go/internal/protocol/eventstream"
+	"github.com/awslabs/aws-sdk-go/internal/protocol/rest"
+	"github.com/stretchr/testify/assert"
+)
+
+type headerOutput struct {
+	Str       *string             `location:"header" locationName:"x-amz-str" type:"string"`
+	Missing   *string             `location:"header" locationName:"x-amz-missing" type:"string"`
+	Num       *int64              `location:"header" locationName:"x-amz-num" type:"integer"`
+	Flag      *bool               `location:"header" locationName:"x-amz-flag" type:"boolean"`
+	Ratio     *float64            `location:"header" locationName:"x-amz-ratio" type:"double"`
+	Blob      []byte              `location:"header" locationName:"x-amz-blob" type:"blob"`
+	Modified  *time.Time          `location:"header" locationName:"Last-Modified" type:"timestamp"`
+	Expires   *time.Time          `location:"header" locationName:"x-amz-expires" type:"timestamp" timestampFormat:"iso8601"`
+	Epoch     *time.Time          `location:"header" locationName:"x-amz-epoch" type:"timestamp" timestampFormat:"unixTimestamp"`
+	Metadata  *map[string]*string `location:"headers" locationName:"x-amz-meta-" type:"map"`
+	Tags      map[string]*string  `location:"headers" locationName:"X-Amz-Tag-" type:"map"`
+	NoHeaders map[string]*string  `location:"headers" locationName:"x-amz-none-" type:"map"`
+	Status    *int64              `location:"statusCode" type:"integer"`
+
+	metadataHeaderOutput `json:"-" xml:"-"`
+}
+
+type metadataHeaderOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+func unmarshalHeaders(t *testing.T, header http.Header, out interface{}) error {
+	return unmarshalHeadersWithConfig(t, &aws.Config{}, header, out)
+}
+
+func unmarshalHeadersWithConfig(t *testing.T, cfg *aws.Config, header http.Header, out interface{}) error {
+	req := aws.NewRequest(aws.NewService(cfg), &aws.Operation{Name: "Operation"}, nil, out)
+	req.HTTPResponse = &http.Response{
+		StatusCode: 200,
+		Header:     header,
+		Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+	}
+	rest.Unmarshal(req)
+	return req.Error
+}
+
+func TestUnmarshalScalarHeaders(t *testing.T) {
+	out := &headerOutput{}
+	err := unmarshalHeaders(t, http.Header{
+		"X-Amz-Str":   []string{"value"},
+		"X-Amz-Num":   []string{"123"},
+		"X-Amz-Flag":  []string{"true"},
+		"X-Amz-Ratio": []string{"1.5"},
+		"X-Amz-Blob":  []string{"YmxvYg=="},
+	}, out)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "value", *out.Str)
+	assert.Nil(t, out.Missing)
+	assert.Equal(t, int64(123), *out.Num)
+	assert.Equal(t, true, *out.Flag)
+	assert.Equal(t, 1.5, *out.Ratio)
+	assert.Equal(t, []byte("blob"), out.Blob)
+	assert.Equal(t, int64(200), *out.Status)
+}
+
+func TestUnmarshalTimestampHeaders(t *testing.T) {
+	out := &headerOutput{}
+	err := unmarshalHeaders(t, http.Header{
+		"Last-Modified": []string{"Sun, 25 Jan 2015 08:00:00 GMT"},
+		"X-Amz-Expires": []string{"2015-01-25T08:00:00.5Z"},
+		"X-Amz-Epoch":   []string{"1422172800"},
+	}, out)
+	assert.NoError(t, err)
+
+	expected := time.Date(2015, 1, 25, 8, 0, 0, 0, time.UTC)
+	assert.Equal(t, expected, *out.Modified)
+	assert.Equal(t, expected.Add(500*time.Millisecond), *out.Expires)
+	assert.Equal(t, expected, *out.Epoch)
+}
+
+func TestUnmarshalInvalidTimestampHeader(t *testing.T) {
+	err := unmarshalHeaders(t, http.Header{"Last-Modified": []string{"yesterday"}}, &headerOutput{})
+	assert.Error(t, err)
+}
+
+func TestUnmarshalHeaderMap(t *testing.T) {
+	out := &headerOutput{}
+	err := unmarshalHeaders(t, http.Header{
+		"X-Amz-Meta-Foo":  []string{"bar"},
+		"x-amz-meta-baz":  []string{"qux"},
+		"X-Amz-Tag-Color": []string{"blue"},
+		"X-Amz-Other":     []string{"ignored"},
+	}, out)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(*out.Metadata))
+	assert.Equal(t, "bar", *(*out.Metadata)["Foo"])
+	assert.Equal(t, "qux", *(*out.Metadata)["Baz"])
+	assert.Equal(t, 1, len(out.Tags))
+	assert.Equal(t, "blue", *out.Tags["Color"])
+	assert.Nil(t, out.NoHeaders)
+}
+
+type upperPrefixHeaderOutput struct {
+	Metadata map[string]*string `location:"headers" locationName:"X-AMZ-META-" type:"map"`
+
+	metadataUpperPrefixHeaderOutput `json:"-" xml:"-"`
+}
+
+type metadataUpperPrefixHeaderOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+func TestUnmarshalHeaderMapMixedCase(t *testing.T) {
+	// names as sent by servers, not canonicalized by net/http
+	header := http.Header{
+		"X-AMZ-META-OWNER":    []string{"alice"},
+		"x-Amz-Meta-build-id": []string{"42"},
+		"X-amz-meta-Color":    []string{"red"},
+		"X-Amz-Metadata":      []string{"no dash after the prefix"},
+	}
+
+	out := &headerOutput{}
+	assert.NoError(t, unmarshalHeaders(t, header, out))
+	assert.Equal(t, 3, len(*out.Metadata))
+	assert.Equal(t, "alice", *(*out.Metadata)["Owner"])
+	assert.Equal(t, "42", *(*out.Metadata)["Build-Id"])
+	assert.Equal(t, "red", *(*out.Metadata)["Color"])
+
+	upper := &upperPrefixHeaderOutput{}
+	assert.NoError(t, unmarshalHeaders(t, header, upper))
+	assert.Equal(t, 3, len(upper.Metadata))
+	assert.Equal(t, "alice", *upper.Metadata["Owner"])
+	assert.Equal(t, "42", *upper.Metadata["Build-Id"])
+	assert.Equal(t, "red", *upper.Metadata["Color"])
+}
+
+type requiredHeaderOutput struct {
+	ETag     *string `location:"header" locationName:"ETag" type:"string" required:"true"`
+	Optional *string `location:"header" locationName:"x-amz-optional" type:"string"`
+
+	metadataRequiredHeaderOutput `json:"-" xml:"-"`
+}
+
+type metadataRequiredHeaderOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+func TestUnmarshalRequiredHeaderPresent(t *testing.T) {
+	out := &requiredHeaderOutput{}
+	err := unmarshalHeadersWithConfig(t, &aws.Config{RequireResponseHeaders: true},
+		http.Header{"Etag": []string{`"abc"`}}, out)
+	assert.NoError(t, err)
+	assert.Equal(t, `"abc"`, *out.ETag)
+	assert.Nil(t, out.Optional)
+}
+
+func TestUnmarshalRequiredHeaderMissing(t *testing.T) {
+	out := &requiredHeaderOutput{}
+	err := unmarshalHeadersWithConfig(t, &aws.Config{RequireResponseHeaders: true},
+		http.Header{"X-Amz-Optional": []string{"value"}}, out)
+	assert.Error(t, err)
+	assert.Equal(t, "SerializationError", err.(awserr.Error).Code())
+	assert.Contains(t, err.Error(), "ETag")
+	assert.Nil(t, out.ETag)
+}
+
+func TestUnmarshalRequiredHeaderMissingNotRequired(t *testing.T) {
+	out := &requiredHeaderOutput{}
+	err := unmarshalHeaders(t, http.Header{}, out)
+	assert.NoError(t, err)
+	assert.Nil(t, out.ETag)
+}
+
+type statusCodeOutput struct {
+	StatusCode *int64 `location:"statusCode" type:"integer"`
+
+	metadataStatusCodeOutput `json:"-" xml:"-"`
+}
+
+type metadataStatusCodeOutput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+func TestUnmarshalStatusCode(t *testing.T) {
+	s := aws.NewService(&aws.Config{})
+	s.Handlers.Send.Init() // mock sending
+	s.Handlers.Send.PushBack(func(r *aws.Request) {
+		r.HTTPResponse = &http.Response{
+			StatusCode: 204,
+			Header:     http.Header{},
+			Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+		}
+	})
+	s.Handlers.Unmarshal.PushBack(rest.Unmarshal)
+
+	out := &statusCodeOutput{}
+	req := aws.NewRequest(s, &aws.Operation{Name: "Operation", HTTPMethod: "DELETE", HTTPPath: "/"}, nil, out)
+	assert.NoError(t, req.Send())
+	assert.Equal(t, int64(204), *out.StatusCode)
+}
+
+type streamingOutput struct {
+	Body        io.ReadCloser `type:"blob"`
+	ContentType *string       `location:"header" locationName:"Content-Type" type:"string"`
+	ETag        *string       `location:"header" locationName:"ETag" type:"string"`
+
+	metadataStreamingOutput `json:"-" xml:"-"`
+}
+
+type metadataStreamingOutput struct {
+	SDKShapeTraits bool `type:"structure" payload:"Body"`
+}
+
+type stringPayloadOutput struct {
+	Policy *string `type:"string"`
+
+	metadataStringPayloadOutput `json:"-" xml:"-"`
+}
+
+type metadataStringPayloadOutput struct {
+	SDKShapeTraits bool `type:"structure" payload:"Policy"`
+}
+
+type eventStreamOutput struct {
+	Events *eventstream.EventStream `type:"structure"`
+
+	metadataEventStreamOutput `json:"-" xml:"-"`
+}
+
+type metadataEventStreamOutput struct {
+	SDKShapeTraits bool `type:"structure" payload:"Events"`
+}
+
+type trackingBody struct {
+	io.Reader
+	closed bool
+}
+
+func (b *trackingBody) Close() error {
+	b.closed = true
+	return nil
+}
+
+func sendWithBody(t *testing.T, header http.Header, body *trackingBody, out interface{}) {
+	s := aws.NewService(&aws.Config{})
+	s.Handlers.Send.Init() // mock sending
+	s.Handlers.Send.PushBack(func(r *aws.Request) {
+		r.HTTPResponse = &http.Response{StatusCode: 200, Header: header, Body: body}
+	})
+	s.Handlers.UnmarshalMeta.PushBack(rest.Unmarshal)
+
+	req := aws.NewRequest(s, &aws.Operation{Name: "Operation", HTTPMethod: "GET", HTTPPath: "/"}, nil, out)
+	assert.NoError(t, req.Send())
+}
+
+func TestUnmarshalStreamingPayload(t *testing.T) {
+	body := &trackingBody{Reader: bytes.NewReader([]byte("object data"))}
+	out := &streamingOutput{}
+	sendWithBody(t, http.Header{
+		"Etag":         []string{`"abc"`},
+		"Content-Type": []string{"image/png"},
+	}, body, out)
+
+	assert.Equal(t, `"abc"`, *out.ETag)
+	assert.Equal(t, "image/png", *out.ContentType)
+	assert.False(t, body.closed)
+
+	b, err := ioutil.ReadAll(out.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, "object data", string(b))
+	assert.NoError(t, out.Body.Close())
+	assert.True(t, body.closed)
+}
+
+func TestUnmarshalStringPayload(t *testing.T) {
+	body := &trackingBody{Reader: bytes.NewReader([]byte(`{"Statement":[]}`))}
+	out := &stringPayloadOutput{}
+	sendWithBody(t, http.Header{}, body, out)
+
+	assert.Equal(t, `{"Statement":[]}`, *out.Policy)
+	assert.True(t, body.closed)
+}
+
+func TestUnmarshalErrorResponsePayload(t *testing.T) {
+	for _, out := range []interface{}{&stringPayloadOutput{}, &eventStreamOutput{}} {
+		body := &trackingBody{Reader: bytes.NewReader([]byte(`<Error><Code>NoSuchKey</Code></Error>`))}
+		req := aws.NewRequest(aws.NewService(&aws.Config{}), &aws.Operation{Name: "Operation"}, nil, out)
+		req.HTTPResponse = &http.Response{StatusCode: 404, Header: http.Header{}, Body: body}
+		rest.Unmarshal(req)
+		assert.NoError(t, req.Error)
+		assert.False(t, body.closed)
+
+		b, err := ioutil.ReadAll(req.HTTPResponse.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, `<Error><Code>NoSuchKey</Code></Error>`, string(b))
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 	"github.com/awslabs/aws-sdk-go/internal/protocol/xml/xmlutil"
 	"github.com/awslabs/aws-sdk-go/internal/util"
 	"github.com/stretchr/testify/assert"
@@ -27,6 +28,7 @@ var _ xml.Attr
 var _ = ioutil.Discard
 var _ = util.Trim("")
 var _ = url.Values{}
+var _ = awsutil.StringValue
 
 // InputService1ProtocolTest is a client for InputService1ProtocolTest.
 type InputService1ProtocolTest struct {
@@ -47,7 +49,7 @@ func NewInputService1ProtocolTest(config *aws.Config) *InputService1ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restjson.Build)
 	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
@@ -89,6 +91,11 @@ type metadataInputService1TestShapeInputService1TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService1TestShapeInputService1TestCaseOperation1Output.
+func (s InputService1TestShapeInputService1TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService1TestShapeInputShape struct {
 	PipelineId *string `location:"uri" type:"string"`
 
@@ -99,6 +106,11 @@ type metadataInputService1TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService1TestShapeInputShape.
+func (s InputService1TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService2ProtocolTest is a client for InputService2ProtocolTest.
 type InputService2ProtocolTest struct {
 	*aws.Service
@@ -118,7 +130,7 @@ func NewInputService2ProtocolTest(config *aws.Config) *InputService2ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restjson.Build)
 	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
@@ -160,6 +172,11 @@ type metadataInputService2TestShapeInputService2TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService2TestShapeInputService2TestCaseOperation1Output.
+func (s InputService2TestShapeInputService2TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService2TestShapeInputShape struct {
 	Foo *string `location:"uri" locationName:"PipelineId" type:"string"`
 
@@ -170,6 +187,11 @@ type metadataInputService2TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService2TestShapeInputShape.
+func (s InputService2TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService3ProtocolTest is a client for InputService3ProtocolTest.
 type InputService3ProtocolTest struct {
 	*aws.Service
@@ -189,7 +211,7 @@ func NewInputService3ProtocolTest(config *aws.Config) *InputService3ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restjson.Build)
 	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
@@ -231,6 +253,11 @@ type metadataInputService3TestShapeInputService3TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService3TestShapeInputService3TestCaseOperation1Output.
+func (s InputService3TestShapeInputService3TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService3TestShapeInputShape struct {
 	Ascending *string `location:"querystring" locationName:"Ascending" type:"string"`
 
@@ -245,6 +272,11 @@ type metadataInputService3TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService3TestShapeInputShape.
+func (s InputService3TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService4ProtocolTest is a client for InputService4ProtocolTest.
 type InputService4ProtocolTest struct {
 	*aws.Service
@@ -264,7 +296,7 @@ func NewInputService4ProtocolTest(config *aws.Config) *InputService4ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restjson.Build)
 	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
@@ -306,6 +338,11 @@ type metadataInputService4TestShapeInputService4TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService4TestShapeInputService4TestCaseOperation1Output.
+func (s InputService4TestShapeInputService4TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService4TestShapeInputShape struct {
 	Ascending *string `location:"querystring" locationName:"Ascending" type:"string"`
 
@@ -322,6 +359,11 @@ type metadataInputService4TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService4TestShapeInputShape.
+func (s InputService4TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService4TestShapeStructType struct {
 	A *string `type:"string"`
 
@@ -334,6 +376,11 @@ type metadataInputService4TestShapeStructType struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService4TestShapeStructType.
+func (s InputService4TestShapeStructType) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService5ProtocolTest is a client for InputService5ProtocolTest.
 type InputService5ProtocolTest struct {
 	*aws.Service
@@ -353,7 +400,7 @@ func NewInputService5ProtocolTest(config *aws.Config) *InputService5ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restjson.Build)
 	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
@@ -395,6 +442,11 @@ type metadataInputService5TestShapeInputService5TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService5TestShapeInputService5TestCaseOperation1Output.
+func (s InputService5TestShapeInputService5TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService5TestShapeInputShape struct {
 	Ascending *string `location:"querystring" locationName:"Ascending" type:"string"`
 
@@ -413,6 +465,11 @@ type metadataInputService5TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService5TestShapeInputShape.
+func (s InputService5TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService5TestShapeStructType struct {
 	A *string `type:"string"`
 
@@ -425,6 +482,11 @@ type metadataInputService5TestShapeStructType struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService5TestShapeStructType.
+func (s InputService5TestShapeStructType) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService6ProtocolTest is a client for InputService6ProtocolTest.
 type InputService6ProtocolTest struct {
 	*aws.Service
@@ -444,7 +506,7 @@ func NewInputService6ProtocolTest(config *aws.Config) *InputService6ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restjson.Build)
 	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
@@ -486,6 +548,11 @@ type metadataInputService6TestShapeInputService6TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService6TestShapeInputService6TestCaseOperation1Output.
+func (s InputService6TestShapeInputService6TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService6TestShapeInputShape struct {
 	Body []byte `locationName:"body" type:"blob"`
 
@@ -500,6 +567,11 @@ type metadataInputService6TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure" payload:"Body"`
 }
 
+// String returns the string representation of the InputService6TestShapeInputShape.
+func (s InputService6TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService7ProtocolTest is a client for InputService7ProtocolTest.
 type InputService7ProtocolTest struct {
 	*aws.Service
@@ -519,7 +591,7 @@ func NewInputService7ProtocolTest(config *aws.Config) *InputService7ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restjson.Build)
 	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
@@ -586,6 +658,11 @@ type metadataInputService7TestShapeInputService7TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService7TestShapeInputService7TestCaseOperation1Output.
+func (s InputService7TestShapeInputService7TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService7TestShapeInputService7TestCaseOperation2Output struct {
 	metadataInputService7TestShapeInputService7TestCaseOperation2Output `json:"-", xml:"-"`
 }
@@ -594,6 +671,11 @@ type metadataInputService7TestShapeInputService7TestCaseOperation2Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService7TestShapeInputService7TestCaseOperation2Output.
+func (s InputService7TestShapeInputService7TestCaseOperation2Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService7TestShapeInputShape struct {
 	Foo *string `location:"querystring" locationName:"param-name" type:"string"`
 
@@ -604,6 +686,11 @@ type metadataInputService7TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService7TestShapeInputShape.
+func (s InputService7TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService8ProtocolTest is a client for InputService8ProtocolTest.
 type InputService8ProtocolTest struct {
 	*aws.Service
@@ -623,7 +710,7 @@ func NewInputService8ProtocolTest(config *aws.Config) *InputService8ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restjson.Build)
 	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
@@ -790,6 +877,11 @@ type metadataInputService8TestShapeInputService8TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService8TestShapeInputService8TestCaseOperation1Output.
+func (s InputService8TestShapeInputService8TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService8TestShapeInputService8TestCaseOperation2Output struct {
 	metadataInputService8TestShapeInputService8TestCaseOperation2Output `json:"-", xml:"-"`
 }
@@ -798,6 +890,11 @@ type metadataInputService8TestShapeInputService8TestCaseOperation2Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService8TestShapeInputService8TestCaseOperation2Output.
+func (s InputService8TestShapeInputService8TestCaseOperation2Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService8TestShapeInputService8TestCaseOperation3Output struct {
 	metadataInputService8TestShapeInputService8TestCaseOperation3Output `json:"-", xml:"-"`
 }
@@ -806,6 +903,11 @@ type metadataInputService8TestShapeInputService8TestCaseOperation3Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService8TestShapeInputService8TestCaseOperation3Output.
+func (s InputService8TestShapeInputService8TestCaseOperation3Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService8TestShapeInputService8TestCaseOperation5Output struct {
 	metadataInputService8TestShapeInputService8TestCaseOperation5Output `json:"-", xml:"-"`
 }
@@ -814,6 +916,11 @@ type metadataInputService8TestShapeInputService8TestCaseOperation5Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService8TestShapeInputService8TestCaseOperation5Output.
+func (s InputService8TestShapeInputService8TestCaseOperation5Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService8TestShapeInputService8TestShapeInputService8TestCaseOperation4Output struct {
 	metadataInputService8TestShapeInputService8TestShapeInputService8TestCaseOperation4Output `json:"-", xml:"-"`
 }
@@ -822,6 +929,11 @@ type metadataInputService8TestShapeInputService8TestShapeInputService8TestCaseOp
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService8TestShapeInputService8TestShapeInputService8TestCaseOperation4Output.
+func (s InputService8TestShapeInputService8TestShapeInputService8TestCaseOperation4Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService8TestShapeInputService8TestShapeInputService8TestCaseOperation6Output struct {
 	metadataInputService8TestShapeInputService8TestShapeInputService8TestCaseOperation6Output `json:"-", xml:"-"`
 }
@@ -830,6 +942,11 @@ type metadataInputService8TestShapeInputService8TestShapeInputService8TestCaseOp
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService8TestShapeInputService8TestShapeInputService8TestCaseOperation6Output.
+func (s InputService8TestShapeInputService8TestShapeInputService8TestCaseOperation6Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService8TestShapeInputService8TestShapeRecursiveStructType struct {
 	NoRecurse *string `type:"string"`
 
@@ -846,6 +963,11 @@ type metadataInputService8TestShapeInputService8TestShapeRecursiveStructType str
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService8TestShapeInputService8TestShapeRecursiveStructType.
+func (s InputService8TestShapeInputService8TestShapeRecursiveStructType) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService8TestShapeInputShape struct {
 	RecursiveStruct *InputService8TestShapeInputService8TestShapeRecursiveStructType `type:"structure"`
 
@@ -856,6 +978,11 @@ type metadataInputService8TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService8TestShapeInputShape.
+func (s InputService8TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService9ProtocolTest is a client for InputService9ProtocolTest.
 type InputService9ProtocolTest struct {
 	*aws.Service
@@ -875,7 +1002,7 @@ func NewInputService9ProtocolTest(config *aws.Config) *InputService9ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restjson.Build)
 	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
@@ -942,6 +1069,11 @@ type metadataInputService9TestShapeInputService9TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService9TestShapeInputService9TestCaseOperation1Output.
+func (s InputService9TestShapeInputService9TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService9TestShapeInputService9TestCaseOperation2Output struct {
 	metadataInputService9TestShapeInputService9TestCaseOperation2Output `json:"-", xml:"-"`
 }
@@ -950,6 +1082,11 @@ type metadataInputService9TestShapeInputService9TestCaseOperation2Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService9TestShapeInputService9TestCaseOperation2Output.
+func (s InputService9TestShapeInputService9TestCaseOperation2Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService9TestShapeInputShape struct {
 	TimeArg *time.Time `type:"timestamp" timestampFormat:"unix"`
 
@@ -962,6 +1099,11 @@ type metadataInputService9TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService9TestShapeInputShape.
+func (s InputService9TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 //
 // Tests begin here
 //
@@ -0,0 +1,85 @@
+package restjson_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/internal/protocol/restjson"
+	"github.com/stretchr/testify/assert"
+)
+
+type jsonValueShape struct {
+	Document aws.JSONValue `type:"string" jsonvalue:"true"`
+	Header   aws.JSONValue `location:"header" locationName:"X-Amz-Document" type:"string" jsonvalue:"true"`
+	Omitted  aws.JSONValue `type:"string" jsonvalue:"true"`
+
+	metadataJSONValueShape `json:"-" xml:"-"`
+}
+
+type metadataJSONValueShape struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+var jsonValueDocument = aws.JSONValue{
+	"name":   "doc",
+	"nested": map[string]interface{}{"count": float64(2), "tags": []interface{}{"a", "b"}},
+	"list":   []interface{}{map[string]interface{}{"on": true}, nil},
+}
+
+const jsonValueDocumentJSON = `{"list":[{"on":true},null],"name":"doc","nested":{"count":2,"tags":["a","b"]}}`
+
+func TestBuildJSONValue(t *testing.T) {
+	svc := aws.NewService(&aws.Config{Endpoint: "https://test"})
+	input := &jsonValueShape{Document: jsonValueDocument, Header: jsonValueDocument}
+	req := aws.NewRequest(svc, &aws.Operation{Name: "Operation", HTTPPath: "/"}, input, nil)
+	restjson.Build(req)
+	assert.NoError(t, req.Error)
+
+	body, _ := ioutil.ReadAll(req.HTTPRequest.Body)
+	assert.Equal(t, `{"Document":`+jsonValueDocumentJSON+`}`, string(body))
+
+	header, err := base64.StdEncoding.DecodeString(req.HTTPRequest.Header.Get("X-Amz-Document"))
+	assert.NoError(t, err)
+	assert.Equal(t, jsonValueDocumentJSON, string(header))
+}
+
+func TestUnmarshalJSONValue(t *testing.T) {
+	svc := aws.NewService(&aws.Config{Endpoint: "https://test"})
+	out := &jsonValueShape{}
+	req := aws.NewRequest(svc, &aws.Operation{Name: "Operation"}, nil, out)
+	req.HTTPResponse = &http.Response{
+		StatusCode: 200,
+		Header: http.Header{"X-Amz-Document": []string{
+			base64.StdEncoding.EncodeToString([]byte(jsonValueDocumentJSON)),
+		}},
+		Body: ioutil.NopCloser(bytes.NewReader([]byte(`{"Document":` + jsonValueDocumentJSON + `}`))),
+	}
+	restjson.UnmarshalMeta(req)
+	restjson.Unmarshal(req)
+	assert.NoError(t, req.Error)
+
+	assert.Equal(t, jsonValueDocument, out.Document)
+	assert.Equal(t, jsonValueDocument, out.Header)
+	assert.Nil(t, out.Omitted)
+}
+
+func TestUnmarshalJSONValueString(t *testing.T) {
+	svc := aws.NewService(&aws.Config{Endpoint: "https://test"})
+	out := &jsonValueShape{}
+	req := aws.NewRequest(svc, &aws.Operation{Name: "Operation"}, nil, out)
+	req.HTTPResponse = &http.Response{
+		StatusCode: 200,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"Document":"{\"name\":\"doc\"}"}`))),
+	}
+	restjson.UnmarshalMeta(req)
+	restjson.Unmarshal(req)
+	assert.NoError(t, req.Error)
+
+	assert.Equal(t, aws.JSONValue{"name": "doc"}, out.Document)
+	assert.Nil(t, out.Header)
+}
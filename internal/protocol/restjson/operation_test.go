@@ -0,0 +1,39 @@
+package restjson_test
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/internal/protocol/restjson"
+	"github.com/stretchr/testify/assert"
+)
+
+type overrideInput struct {
+	Name  *string `location:"uri" locationName:"Name" type:"string"`
+	Value *string `type:"string"`
+
+	metadataOverrideInput `json:"-" xml:"-"`
+}
+
+type metadataOverrideInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+func TestBuildOperationOverride(t *testing.T) {
+	svc := aws.NewService(&aws.Config{Endpoint: "https://test"})
+	svc.Handlers.Build.PushBack(restjson.Build)
+	svc.Handlers.Validate.PushBack(func(r *aws.Request) {
+		r.Operation.HTTPMethod = "POST"
+		r.Operation.HTTPPath = "/gateway/things/{Name}"
+	})
+
+	input := &overrideInput{Name: aws.String("thing"), Value: aws.String("value")}
+	req := aws.NewRequest(svc, &aws.Operation{Name: "GetThing", HTTPMethod: "GET", HTTPPath: "/things/{Name}"}, input, nil)
+	assert.NoError(t, req.Build())
+
+	assert.Equal(t, "POST", req.HTTPRequest.Method)
+	assert.Equal(t, "https://test/gateway/things/thing", req.HTTPRequest.URL.String())
+	body, _ := ioutil.ReadAll(req.HTTPRequest.Body)
+	assert.Equal(t, `{"Value":"value"}`, string(body))
+}
@@ -4,11 +4,13 @@ package restjson
 //go:generate go run ../../fixtures/protocol/generate.go ../../fixtures/protocol/output/rest-json.json unmarshal_test.go
 
 import (
+	"bytes"
 	"encoding/json"
 	"io/ioutil"
-	"strings"
 
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awserr"
+	"github.com/awslabs/aws-sdk-go/internal/protocol"
 	"github.com/awslabs/aws-sdk-go/internal/protocol/jsonrpc"
 	"github.com/awslabs/aws-sdk-go/internal/protocol/rest"
 )
@@ -18,6 +20,9 @@ func Build(r *aws.Request) {
 
 	if t := rest.PayloadType(r.Params); t == "structure" || t == "" {
 		jsonrpc.Build(r)
+		if b, ok := r.Body.(*bytes.Reader); ok && b.Len() > 0 {
+			protocol.SetContentType(r, protocol.RESTJSONContentType)
+		}
 	}
 }
 
@@ -35,30 +40,39 @@ func UnmarshalError(r *aws.Request) {
 	code := r.HTTPResponse.Header.Get("X-Amzn-Errortype")
 	bodyBytes, err := ioutil.ReadAll(r.HTTPResponse.Body)
 	if err != nil {
-		r.Error = err
+		r.Error = awserr.New("SerializationError", "failed to read JSON error response", err)
 		return
 	}
+	requestID := r.HTTPResponse.Header.Get("X-Amzn-Requestid")
 	if len(bodyBytes) == 0 {
-		r.Error = aws.APIError{
-			StatusCode: r.HTTPResponse.StatusCode,
-			Message:    r.HTTPResponse.Status,
-		}
+		r.Error = awserr.NewRequestFailure(
+			awserr.New("", r.HTTPResponse.Status, nil),
+			r.HTTPResponse.StatusCode,
+			requestID,
+		)
 		return
 	}
 	var jsonErr jsonErrorResponse
 	if err := json.Unmarshal(bodyBytes, &jsonErr); err != nil {
-		r.Error = err
+		r.Error = awserr.New("SerializationError", "failed to decode JSON error response", err)
 		return
 	}
 
-	codes := strings.SplitN(code, ":", 2)
-	r.Error = aws.APIError{
-		StatusCode: r.HTTPResponse.StatusCode,
-		Code:       codes[0],
-		Message:    jsonErr.Message,
+	if code == "" { // fall back to the error type in the body
+		code = jsonErr.Type
+		if code == "" {
+			code = jsonErr.Code
+		}
 	}
+	r.Error = awserr.NewRequestFailure(
+		awserr.New(jsonrpc.ErrorCode(code), jsonErr.Message, nil),
+		r.HTTPResponse.StatusCode,
+		requestID,
+	)
 }
 
 type jsonErrorResponse struct {
+	Type    string `json:"__type"`
+	Code    string `json:"code"`
 	Message string `json:"message"`
 }
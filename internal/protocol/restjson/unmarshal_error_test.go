@@ -0,0 +1,28 @@
+package restjson_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awserr"
+	"github.com/awslabs/aws-sdk-go/internal/protocol/restjson"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalErrorBodyType(t *testing.T) {
+	req := aws.NewRequest(aws.NewService(&aws.Config{}), &aws.Operation{Name: "Operation"}, nil, nil)
+	req.HTTPResponse = &http.Response{
+		StatusCode: 400,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{"__type":"com.amazonaws.lambda#InvalidParameterValueException","message":"bad value"}`))),
+	}
+	restjson.UnmarshalError(req)
+
+	err, ok := req.Error.(awserr.RequestFailure)
+	assert.True(t, ok)
+	assert.Equal(t, "InvalidParameterValueException", err.Code())
+	assert.Equal(t, "bad value", err.Message())
+}
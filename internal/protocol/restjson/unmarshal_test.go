@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 	"github.com/awslabs/aws-sdk-go/internal/protocol/xml/xmlutil"
 	"github.com/awslabs/aws-sdk-go/internal/util"
 	"github.com/stretchr/testify/assert"
@@ -27,6 +28,7 @@ var _ xml.Attr
 var _ = ioutil.Discard
 var _ = util.Trim("")
 var _ = url.Values{}
+var _ = awsutil.StringValue
 
 // OutputService1ProtocolTest is a client for OutputService1ProtocolTest.
 type OutputService1ProtocolTest struct {
@@ -47,7 +49,7 @@ func NewOutputService1ProtocolTest(config *aws.Config) *OutputService1ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restjson.Build)
 	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
@@ -87,6 +89,11 @@ type metadataOutputService1TestShapeOutputService1TestShapeOutputService1TestCas
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService1TestShapeOutputService1TestShapeOutputService1TestCaseOperation1Input.
+func (s OutputService1TestShapeOutputService1TestShapeOutputService1TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService1TestShapeOutputShape struct {
 	Char *string `type:"character"`
 
@@ -117,6 +124,11 @@ type metadataOutputService1TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService1TestShapeOutputShape.
+func (s OutputService1TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // OutputService2ProtocolTest is a client for OutputService2ProtocolTest.
 type OutputService2ProtocolTest struct {
 	*aws.Service
@@ -136,7 +148,7 @@ func NewOutputService2ProtocolTest(config *aws.Config) *OutputService2ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restjson.Build)
 	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
@@ -178,6 +190,11 @@ type metadataOutputService2TestShapeBlobContainer struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService2TestShapeBlobContainer.
+func (s OutputService2TestShapeBlobContainer) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService2TestShapeOutputService2TestCaseOperation1Input struct {
 	metadataOutputService2TestShapeOutputService2TestCaseOperation1Input `json:"-", xml:"-"`
 }
@@ -186,6 +203,11 @@ type metadataOutputService2TestShapeOutputService2TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService2TestShapeOutputService2TestCaseOperation1Input.
+func (s OutputService2TestShapeOutputService2TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService2TestShapeOutputShape struct {
 	BlobMember []byte `type:"blob"`
 
@@ -198,6 +220,11 @@ type metadataOutputService2TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService2TestShapeOutputShape.
+func (s OutputService2TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // OutputService3ProtocolTest is a client for OutputService3ProtocolTest.
 type OutputService3ProtocolTest struct {
 	*aws.Service
@@ -217,7 +244,7 @@ func NewOutputService3ProtocolTest(config *aws.Config) *OutputService3ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restjson.Build)
 	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
@@ -257,6 +284,11 @@ type metadataOutputService3TestShapeOutputService3TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService3TestShapeOutputService3TestCaseOperation1Input.
+func (s OutputService3TestShapeOutputService3TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService3TestShapeOutputShape struct {
 	StructMember *OutputService3TestShapeTimeContainer `type:"structure"`
 
@@ -269,6 +301,11 @@ type metadataOutputService3TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService3TestShapeOutputShape.
+func (s OutputService3TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService3TestShapeTimeContainer struct {
 	Foo *time.Time `locationName:"foo" type:"timestamp" timestampFormat:"unix"`
 
@@ -279,6 +316,11 @@ type metadataOutputService3TestShapeTimeContainer struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService3TestShapeTimeContainer.
+func (s OutputService3TestShapeTimeContainer) String() string {
+	return awsutil.StringValue(s)
+}
+
 // OutputService4ProtocolTest is a client for OutputService4ProtocolTest.
 type OutputService4ProtocolTest struct {
 	*aws.Service
@@ -298,7 +340,7 @@ func NewOutputService4ProtocolTest(config *aws.Config) *OutputService4ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restjson.Build)
 	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
@@ -338,6 +380,11 @@ type metadataOutputService4TestShapeOutputService4TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService4TestShapeOutputService4TestCaseOperation1Input.
+func (s OutputService4TestShapeOutputService4TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService4TestShapeOutputShape struct {
 	ListMember []*string `type:"list"`
 
@@ -348,6 +395,11 @@ type metadataOutputService4TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService4TestShapeOutputShape.
+func (s OutputService4TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // OutputService5ProtocolTest is a client for OutputService5ProtocolTest.
 type OutputService5ProtocolTest struct {
 	*aws.Service
@@ -367,7 +419,7 @@ func NewOutputService5ProtocolTest(config *aws.Config) *OutputService5ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restjson.Build)
 	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
@@ -407,6 +459,11 @@ type metadataOutputService5TestShapeOutputService5TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService5TestShapeOutputService5TestCaseOperation1Input.
+func (s OutputService5TestShapeOutputService5TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService5TestShapeOutputShape struct {
 	ListMember []*OutputService5TestShapeSingleStruct `type:"list"`
 
@@ -417,6 +474,11 @@ type metadataOutputService5TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService5TestShapeOutputShape.
+func (s OutputService5TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService5TestShapeSingleStruct struct {
 	Foo *string `type:"string"`
 
@@ -427,6 +489,11 @@ type metadataOutputService5TestShapeSingleStruct struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService5TestShapeSingleStruct.
+func (s OutputService5TestShapeSingleStruct) String() string {
+	return awsutil.StringValue(s)
+}
+
 // OutputService6ProtocolTest is a client for OutputService6ProtocolTest.
 type OutputService6ProtocolTest struct {
 	*aws.Service
@@ -446,7 +513,7 @@ func NewOutputService6ProtocolTest(config *aws.Config) *OutputService6ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restjson.Build)
 	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
@@ -486,6 +553,11 @@ type metadataOutputService6TestShapeOutputService6TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService6TestShapeOutputService6TestCaseOperation1Input.
+func (s OutputService6TestShapeOutputService6TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService6TestShapeOutputShape struct {
 	MapMember *map[string][]*int64 `type:"map"`
 
@@ -496,6 +568,11 @@ type metadataOutputService6TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService6TestShapeOutputShape.
+func (s OutputService6TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // OutputService7ProtocolTest is a client for OutputService7ProtocolTest.
 type OutputService7ProtocolTest struct {
 	*aws.Service
@@ -515,7 +592,7 @@ func NewOutputService7ProtocolTest(config *aws.Config) *OutputService7ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restjson.Build)
 	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
@@ -555,6 +632,11 @@ type metadataOutputService7TestShapeOutputService7TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService7TestShapeOutputService7TestCaseOperation1Input.
+func (s OutputService7TestShapeOutputService7TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService7TestShapeOutputShape struct {
 	MapMember *map[string]*time.Time `type:"map"`
 
@@ -565,6 +647,11 @@ type metadataOutputService7TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService7TestShapeOutputShape.
+func (s OutputService7TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // OutputService8ProtocolTest is a client for OutputService8ProtocolTest.
 type OutputService8ProtocolTest struct {
 	*aws.Service
@@ -584,7 +671,7 @@ func NewOutputService8ProtocolTest(config *aws.Config) *OutputService8ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restjson.Build)
 	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
@@ -624,6 +711,11 @@ type metadataOutputService8TestShapeOutputService8TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService8TestShapeOutputService8TestCaseOperation1Input.
+func (s OutputService8TestShapeOutputService8TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService8TestShapeOutputShape struct {
 	StrType *string `type:"string"`
 
@@ -634,6 +726,11 @@ type metadataOutputService8TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService8TestShapeOutputShape.
+func (s OutputService8TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // OutputService9ProtocolTest is a client for OutputService9ProtocolTest.
 type OutputService9ProtocolTest struct {
 	*aws.Service
@@ -653,7 +750,7 @@ func NewOutputService9ProtocolTest(config *aws.Config) *OutputService9ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restjson.Build)
 	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
@@ -693,6 +790,11 @@ type metadataOutputService9TestShapeOutputService9TestCaseOperation1Input struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService9TestShapeOutputService9TestCaseOperation1Input.
+func (s OutputService9TestShapeOutputService9TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService9TestShapeOutputShape struct {
 	AllHeaders *map[string]*string `location:"headers" type:"map"`
 
@@ -705,6 +807,11 @@ type metadataOutputService9TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService9TestShapeOutputShape.
+func (s OutputService9TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // OutputService10ProtocolTest is a client for OutputService10ProtocolTest.
 type OutputService10ProtocolTest struct {
 	*aws.Service
@@ -724,7 +831,7 @@ func NewOutputService10ProtocolTest(config *aws.Config) *OutputService10Protocol
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restjson.Build)
 	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
@@ -766,6 +873,11 @@ type metadataOutputService10TestShapeBodyStructure struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService10TestShapeBodyStructure.
+func (s OutputService10TestShapeBodyStructure) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService10TestShapeOutputService10TestCaseOperation1Input struct {
 	metadataOutputService10TestShapeOutputService10TestCaseOperation1Input `json:"-", xml:"-"`
 }
@@ -774,6 +886,11 @@ type metadataOutputService10TestShapeOutputService10TestCaseOperation1Input stru
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService10TestShapeOutputService10TestCaseOperation1Input.
+func (s OutputService10TestShapeOutputService10TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService10TestShapeOutputShape struct {
 	Data *OutputService10TestShapeBodyStructure `type:"structure"`
 
@@ -786,6 +903,11 @@ type metadataOutputService10TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure" payload:"Data"`
 }
 
+// String returns the string representation of the OutputService10TestShapeOutputShape.
+func (s OutputService10TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // OutputService11ProtocolTest is a client for OutputService11ProtocolTest.
 type OutputService11ProtocolTest struct {
 	*aws.Service
@@ -805,7 +927,7 @@ func NewOutputService11ProtocolTest(config *aws.Config) *OutputService11Protocol
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restjson.Build)
 	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
@@ -845,6 +967,11 @@ type metadataOutputService11TestShapeOutputService11TestCaseOperation1Input stru
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputService11TestShapeOutputService11TestCaseOperation1Input.
+func (s OutputService11TestShapeOutputService11TestCaseOperation1Input) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OutputService11TestShapeOutputShape struct {
 	Stream []byte `type:"blob"`
 
@@ -855,6 +982,11 @@ type metadataOutputService11TestShapeOutputShape struct {
 	SDKShapeTraits bool `type:"structure" payload:"Stream"`
 }
 
+// String returns the string representation of the OutputService11TestShapeOutputShape.
+func (s OutputService11TestShapeOutputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 //
 // Tests begin here
 //
@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"encoding/xml"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 	"github.com/awslabs/aws-sdk-go/internal/protocol/xml/xmlutil"
 	"github.com/awslabs/aws-sdk-go/internal/util"
 	"github.com/stretchr/testify/assert"
@@ -27,6 +28,7 @@ var _ xml.Attr
 var _ = ioutil.Discard
 var _ = util.Trim("")
 var _ = url.Values{}
+var _ = awsutil.StringValue
 
 // InputService1ProtocolTest is a client for InputService1ProtocolTest.
 type InputService1ProtocolTest struct {
@@ -47,7 +49,7 @@ func NewInputService1ProtocolTest(config *aws.Config) *InputService1ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restxml.Build)
 	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
@@ -114,6 +116,11 @@ type metadataInputService1TestShapeInputService1TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService1TestShapeInputService1TestCaseOperation1Output.
+func (s InputService1TestShapeInputService1TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService1TestShapeInputService1TestCaseOperation2Output struct {
 	metadataInputService1TestShapeInputService1TestCaseOperation2Output `json:"-", xml:"-"`
 }
@@ -122,6 +129,11 @@ type metadataInputService1TestShapeInputService1TestCaseOperation2Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService1TestShapeInputService1TestCaseOperation2Output.
+func (s InputService1TestShapeInputService1TestCaseOperation2Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService1TestShapeInputShape struct {
 	Description *string `type:"string"`
 
@@ -134,6 +146,11 @@ type metadataInputService1TestShapeInputShape struct {
 	SDKShapeTraits bool `locationName:"OperationRequest" type:"structure" xmlURI:"https://foo/"`
 }
 
+// String returns the string representation of the InputService1TestShapeInputShape.
+func (s InputService1TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService2ProtocolTest is a client for InputService2ProtocolTest.
 type InputService2ProtocolTest struct {
 	*aws.Service
@@ -153,7 +170,7 @@ func NewInputService2ProtocolTest(config *aws.Config) *InputService2ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restxml.Build)
 	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
@@ -195,6 +212,11 @@ type metadataInputService2TestShapeInputService2TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService2TestShapeInputService2TestCaseOperation1Output.
+func (s InputService2TestShapeInputService2TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService2TestShapeInputShape struct {
 	First *bool `type:"boolean"`
 
@@ -211,6 +233,11 @@ type metadataInputService2TestShapeInputShape struct {
 	SDKShapeTraits bool `locationName:"OperationRequest" type:"structure" xmlURI:"https://foo/"`
 }
 
+// String returns the string representation of the InputService2TestShapeInputShape.
+func (s InputService2TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService3ProtocolTest is a client for InputService3ProtocolTest.
 type InputService3ProtocolTest struct {
 	*aws.Service
@@ -230,7 +257,7 @@ func NewInputService3ProtocolTest(config *aws.Config) *InputService3ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restxml.Build)
 	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
@@ -272,6 +299,11 @@ type metadataInputService3TestShapeInputService3TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService3TestShapeInputService3TestCaseOperation1Output.
+func (s InputService3TestShapeInputService3TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService3TestShapeInputShape struct {
 	Description *string `type:"string"`
 
@@ -284,6 +316,11 @@ type metadataInputService3TestShapeInputShape struct {
 	SDKShapeTraits bool `locationName:"OperationRequest" type:"structure" xmlURI:"https://foo/"`
 }
 
+// String returns the string representation of the InputService3TestShapeInputShape.
+func (s InputService3TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService3TestShapeSubStructure struct {
 	Bar *string `type:"string"`
 
@@ -296,6 +333,11 @@ type metadataInputService3TestShapeSubStructure struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService3TestShapeSubStructure.
+func (s InputService3TestShapeSubStructure) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService4ProtocolTest is a client for InputService4ProtocolTest.
 type InputService4ProtocolTest struct {
 	*aws.Service
@@ -315,7 +357,7 @@ func NewInputService4ProtocolTest(config *aws.Config) *InputService4ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restxml.Build)
 	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
@@ -357,6 +399,11 @@ type metadataInputService4TestShapeInputService4TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService4TestShapeInputService4TestCaseOperation1Output.
+func (s InputService4TestShapeInputService4TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService4TestShapeInputShape struct {
 	Description *string `type:"string"`
 
@@ -369,6 +416,11 @@ type metadataInputService4TestShapeInputShape struct {
 	SDKShapeTraits bool `locationName:"OperationRequest" type:"structure" xmlURI:"https://foo/"`
 }
 
+// String returns the string representation of the InputService4TestShapeInputShape.
+func (s InputService4TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService4TestShapeSubStructure struct {
 	Bar *string `type:"string"`
 
@@ -381,6 +433,11 @@ type metadataInputService4TestShapeSubStructure struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService4TestShapeSubStructure.
+func (s InputService4TestShapeSubStructure) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService5ProtocolTest is a client for InputService5ProtocolTest.
 type InputService5ProtocolTest struct {
 	*aws.Service
@@ -400,7 +457,7 @@ func NewInputService5ProtocolTest(config *aws.Config) *InputService5ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restxml.Build)
 	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
@@ -442,6 +499,11 @@ type metadataInputService5TestShapeInputService5TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService5TestShapeInputService5TestCaseOperation1Output.
+func (s InputService5TestShapeInputService5TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService5TestShapeInputShape struct {
 	ListParam []*string `type:"list"`
 
@@ -452,6 +514,11 @@ type metadataInputService5TestShapeInputShape struct {
 	SDKShapeTraits bool `locationName:"OperationRequest" type:"structure" xmlURI:"https://foo/"`
 }
 
+// String returns the string representation of the InputService5TestShapeInputShape.
+func (s InputService5TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService6ProtocolTest is a client for InputService6ProtocolTest.
 type InputService6ProtocolTest struct {
 	*aws.Service
@@ -471,7 +538,7 @@ func NewInputService6ProtocolTest(config *aws.Config) *InputService6ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restxml.Build)
 	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
@@ -513,6 +580,11 @@ type metadataInputService6TestShapeInputService6TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService6TestShapeInputService6TestCaseOperation1Output.
+func (s InputService6TestShapeInputService6TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService6TestShapeInputShape struct {
 	ListParam []*string `locationName:"AlternateName" locationNameList:"NotMember" type:"list"`
 
@@ -523,6 +595,11 @@ type metadataInputService6TestShapeInputShape struct {
 	SDKShapeTraits bool `locationName:"OperationRequest" type:"structure" xmlURI:"https://foo/"`
 }
 
+// String returns the string representation of the InputService6TestShapeInputShape.
+func (s InputService6TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService7ProtocolTest is a client for InputService7ProtocolTest.
 type InputService7ProtocolTest struct {
 	*aws.Service
@@ -542,7 +619,7 @@ func NewInputService7ProtocolTest(config *aws.Config) *InputService7ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restxml.Build)
 	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
@@ -584,6 +661,11 @@ type metadataInputService7TestShapeInputService7TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService7TestShapeInputService7TestCaseOperation1Output.
+func (s InputService7TestShapeInputService7TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService7TestShapeInputShape struct {
 	ListParam []*string `type:"list" flattened:"true"`
 
@@ -594,6 +676,11 @@ type metadataInputService7TestShapeInputShape struct {
 	SDKShapeTraits bool `locationName:"OperationRequest" type:"structure" xmlURI:"https://foo/"`
 }
 
+// String returns the string representation of the InputService7TestShapeInputShape.
+func (s InputService7TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService8ProtocolTest is a client for InputService8ProtocolTest.
 type InputService8ProtocolTest struct {
 	*aws.Service
@@ -613,7 +700,7 @@ func NewInputService8ProtocolTest(config *aws.Config) *InputService8ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restxml.Build)
 	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
@@ -655,6 +742,11 @@ type metadataInputService8TestShapeInputService8TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService8TestShapeInputService8TestCaseOperation1Output.
+func (s InputService8TestShapeInputService8TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService8TestShapeInputShape struct {
 	ListParam []*string `locationName:"item" type:"list" flattened:"true"`
 
@@ -665,6 +757,11 @@ type metadataInputService8TestShapeInputShape struct {
 	SDKShapeTraits bool `locationName:"OperationRequest" type:"structure" xmlURI:"https://foo/"`
 }
 
+// String returns the string representation of the InputService8TestShapeInputShape.
+func (s InputService8TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService9ProtocolTest is a client for InputService9ProtocolTest.
 type InputService9ProtocolTest struct {
 	*aws.Service
@@ -684,7 +781,7 @@ func NewInputService9ProtocolTest(config *aws.Config) *InputService9ProtocolTest
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restxml.Build)
 	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
@@ -726,6 +823,11 @@ type metadataInputService9TestShapeInputService9TestCaseOperation1Output struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService9TestShapeInputService9TestCaseOperation1Output.
+func (s InputService9TestShapeInputService9TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService9TestShapeInputShape struct {
 	ListParam []*InputService9TestShapeSingleFieldStruct `locationName:"item" type:"list" flattened:"true"`
 
@@ -736,6 +838,11 @@ type metadataInputService9TestShapeInputShape struct {
 	SDKShapeTraits bool `locationName:"OperationRequest" type:"structure" xmlURI:"https://foo/"`
 }
 
+// String returns the string representation of the InputService9TestShapeInputShape.
+func (s InputService9TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService9TestShapeSingleFieldStruct struct {
 	Element *string `locationName:"value" type:"string"`
 
@@ -746,6 +853,11 @@ type metadataInputService9TestShapeSingleFieldStruct struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService9TestShapeSingleFieldStruct.
+func (s InputService9TestShapeSingleFieldStruct) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService10ProtocolTest is a client for InputService10ProtocolTest.
 type InputService10ProtocolTest struct {
 	*aws.Service
@@ -765,7 +877,7 @@ func NewInputService10ProtocolTest(config *aws.Config) *InputService10ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restxml.Build)
 	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
@@ -807,6 +919,11 @@ type metadataInputService10TestShapeInputService10TestCaseOperation1Output struc
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService10TestShapeInputService10TestCaseOperation1Output.
+func (s InputService10TestShapeInputService10TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService10TestShapeInputShape struct {
 	StructureParam *InputService10TestShapeStructureShape `type:"structure"`
 
@@ -817,6 +934,11 @@ type metadataInputService10TestShapeInputShape struct {
 	SDKShapeTraits bool `locationName:"OperationRequest" type:"structure" xmlURI:"https://foo/"`
 }
 
+// String returns the string representation of the InputService10TestShapeInputShape.
+func (s InputService10TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService10TestShapeStructureShape struct {
 	B []byte `locationName:"b" type:"blob"`
 
@@ -829,6 +951,11 @@ type metadataInputService10TestShapeStructureShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService10TestShapeStructureShape.
+func (s InputService10TestShapeStructureShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService11ProtocolTest is a client for InputService11ProtocolTest.
 type InputService11ProtocolTest struct {
 	*aws.Service
@@ -848,7 +975,7 @@ func NewInputService11ProtocolTest(config *aws.Config) *InputService11ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restxml.Build)
 	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
@@ -890,6 +1017,11 @@ type metadataInputService11TestShapeInputService11TestCaseOperation1Output struc
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService11TestShapeInputService11TestCaseOperation1Output.
+func (s InputService11TestShapeInputService11TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService11TestShapeInputShape struct {
 	Foo *map[string]*string `location:"headers" locationName:"x-foo-" type:"map"`
 
@@ -900,6 +1032,11 @@ type metadataInputService11TestShapeInputShape struct {
 	SDKShapeTraits bool `locationName:"OperationRequest" type:"structure" xmlURI:"https://foo/"`
 }
 
+// String returns the string representation of the InputService11TestShapeInputShape.
+func (s InputService11TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService12ProtocolTest is a client for InputService12ProtocolTest.
 type InputService12ProtocolTest struct {
 	*aws.Service
@@ -919,7 +1056,7 @@ func NewInputService12ProtocolTest(config *aws.Config) *InputService12ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restxml.Build)
 	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
@@ -961,6 +1098,11 @@ type metadataInputService12TestShapeInputService12TestCaseOperation1Output struc
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService12TestShapeInputService12TestCaseOperation1Output.
+func (s InputService12TestShapeInputService12TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService12TestShapeInputShape struct {
 	Foo *string `locationName:"foo" type:"string"`
 
@@ -971,6 +1113,11 @@ type metadataInputService12TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure" payload:"Foo"`
 }
 
+// String returns the string representation of the InputService12TestShapeInputShape.
+func (s InputService12TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService13ProtocolTest is a client for InputService13ProtocolTest.
 type InputService13ProtocolTest struct {
 	*aws.Service
@@ -990,7 +1137,7 @@ func NewInputService13ProtocolTest(config *aws.Config) *InputService13ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restxml.Build)
 	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
@@ -1057,6 +1204,11 @@ type metadataInputService13TestShapeInputService13TestCaseOperation1Output struc
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService13TestShapeInputService13TestCaseOperation1Output.
+func (s InputService13TestShapeInputService13TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService13TestShapeInputService13TestCaseOperation2Output struct {
 	metadataInputService13TestShapeInputService13TestCaseOperation2Output `json:"-", xml:"-"`
 }
@@ -1065,6 +1217,11 @@ type metadataInputService13TestShapeInputService13TestCaseOperation2Output struc
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService13TestShapeInputService13TestCaseOperation2Output.
+func (s InputService13TestShapeInputService13TestCaseOperation2Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService13TestShapeInputShape struct {
 	Foo []byte `locationName:"foo" type:"blob"`
 
@@ -1075,6 +1232,11 @@ type metadataInputService13TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure" payload:"Foo"`
 }
 
+// String returns the string representation of the InputService13TestShapeInputShape.
+func (s InputService13TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService14ProtocolTest is a client for InputService14ProtocolTest.
 type InputService14ProtocolTest struct {
 	*aws.Service
@@ -1094,7 +1256,7 @@ func NewInputService14ProtocolTest(config *aws.Config) *InputService14ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restxml.Build)
 	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
@@ -1163,6 +1325,11 @@ type metadataInputService14TestShapeFooShape struct {
 	SDKShapeTraits bool `locationName:"foo" type:"structure"`
 }
 
+// String returns the string representation of the InputService14TestShapeFooShape.
+func (s InputService14TestShapeFooShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService14TestShapeInputService14TestCaseOperation1Output struct {
 	metadataInputService14TestShapeInputService14TestCaseOperation1Output `json:"-", xml:"-"`
 }
@@ -1171,6 +1338,11 @@ type metadataInputService14TestShapeInputService14TestCaseOperation1Output struc
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService14TestShapeInputService14TestCaseOperation1Output.
+func (s InputService14TestShapeInputService14TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService14TestShapeInputService14TestCaseOperation2Output struct {
 	metadataInputService14TestShapeInputService14TestCaseOperation2Output `json:"-", xml:"-"`
 }
@@ -1179,6 +1351,11 @@ type metadataInputService14TestShapeInputService14TestCaseOperation2Output struc
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService14TestShapeInputService14TestCaseOperation2Output.
+func (s InputService14TestShapeInputService14TestCaseOperation2Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService14TestShapeInputShape struct {
 	Foo *InputService14TestShapeFooShape `locationName:"foo" type:"structure"`
 
@@ -1189,6 +1366,11 @@ type metadataInputService14TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure" payload:"Foo"`
 }
 
+// String returns the string representation of the InputService14TestShapeInputShape.
+func (s InputService14TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService15ProtocolTest is a client for InputService15ProtocolTest.
 type InputService15ProtocolTest struct {
 	*aws.Service
@@ -1208,7 +1390,7 @@ func NewInputService15ProtocolTest(config *aws.Config) *InputService15ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restxml.Build)
 	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
@@ -1252,6 +1434,11 @@ type metadataInputService15TestShapeGrant struct {
 	SDKShapeTraits bool `locationName:"Grant" type:"structure"`
 }
 
+// String returns the string representation of the InputService15TestShapeGrant.
+func (s InputService15TestShapeGrant) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService15TestShapeGrantee struct {
 	EmailAddress *string `type:"string"`
 
@@ -1264,6 +1451,11 @@ type metadataInputService15TestShapeGrantee struct {
 	SDKShapeTraits bool `type:"structure" xmlPrefix:"xsi" xmlURI:"http://www.w3.org/2001/XMLSchema-instance"`
 }
 
+// String returns the string representation of the InputService15TestShapeGrantee.
+func (s InputService15TestShapeGrantee) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService15TestShapeInputService15TestCaseOperation1Output struct {
 	metadataInputService15TestShapeInputService15TestCaseOperation1Output `json:"-", xml:"-"`
 }
@@ -1272,6 +1464,11 @@ type metadataInputService15TestShapeInputService15TestCaseOperation1Output struc
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService15TestShapeInputService15TestCaseOperation1Output.
+func (s InputService15TestShapeInputService15TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService15TestShapeInputShape struct {
 	Grant *InputService15TestShapeGrant `locationName:"Grant" type:"structure"`
 
@@ -1282,6 +1479,11 @@ type metadataInputService15TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure" payload:"Grant"`
 }
 
+// String returns the string representation of the InputService15TestShapeInputShape.
+func (s InputService15TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService16ProtocolTest is a client for InputService16ProtocolTest.
 type InputService16ProtocolTest struct {
 	*aws.Service
@@ -1301,7 +1503,7 @@ func NewInputService16ProtocolTest(config *aws.Config) *InputService16ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restxml.Build)
 	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
@@ -1343,6 +1545,11 @@ type metadataInputService16TestShapeInputService16TestCaseOperation1Output struc
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService16TestShapeInputService16TestCaseOperation1Output.
+func (s InputService16TestShapeInputService16TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService16TestShapeInputShape struct {
 	Bucket *string `location:"uri" type:"string"`
 
@@ -1355,6 +1562,11 @@ type metadataInputService16TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService16TestShapeInputShape.
+func (s InputService16TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService17ProtocolTest is a client for InputService17ProtocolTest.
 type InputService17ProtocolTest struct {
 	*aws.Service
@@ -1374,7 +1586,7 @@ func NewInputService17ProtocolTest(config *aws.Config) *InputService17ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restxml.Build)
 	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
@@ -1441,6 +1653,11 @@ type metadataInputService17TestShapeInputService17TestCaseOperation1Output struc
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService17TestShapeInputService17TestCaseOperation1Output.
+func (s InputService17TestShapeInputService17TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService17TestShapeInputService17TestCaseOperation2Output struct {
 	metadataInputService17TestShapeInputService17TestCaseOperation2Output `json:"-", xml:"-"`
 }
@@ -1449,6 +1666,11 @@ type metadataInputService17TestShapeInputService17TestCaseOperation2Output struc
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService17TestShapeInputService17TestCaseOperation2Output.
+func (s InputService17TestShapeInputService17TestCaseOperation2Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService17TestShapeInputShape struct {
 	Foo *string `location:"querystring" locationName:"param-name" type:"string"`
 
@@ -1459,6 +1681,11 @@ type metadataInputService17TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService17TestShapeInputShape.
+func (s InputService17TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService18ProtocolTest is a client for InputService18ProtocolTest.
 type InputService18ProtocolTest struct {
 	*aws.Service
@@ -1478,7 +1705,7 @@ func NewInputService18ProtocolTest(config *aws.Config) *InputService18ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restxml.Build)
 	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
@@ -1645,6 +1872,11 @@ type metadataInputService18TestShapeInputService18TestCaseOperation2Output struc
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService18TestShapeInputService18TestCaseOperation2Output.
+func (s InputService18TestShapeInputService18TestCaseOperation2Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService18TestShapeInputService18TestCaseOperation3Output struct {
 	metadataInputService18TestShapeInputService18TestCaseOperation3Output `json:"-", xml:"-"`
 }
@@ -1653,6 +1885,11 @@ type metadataInputService18TestShapeInputService18TestCaseOperation3Output struc
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService18TestShapeInputService18TestCaseOperation3Output.
+func (s InputService18TestShapeInputService18TestCaseOperation3Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService18TestShapeInputService18TestCaseOperation6Output struct {
 	metadataInputService18TestShapeInputService18TestCaseOperation6Output `json:"-", xml:"-"`
 }
@@ -1661,6 +1898,11 @@ type metadataInputService18TestShapeInputService18TestCaseOperation6Output struc
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService18TestShapeInputService18TestCaseOperation6Output.
+func (s InputService18TestShapeInputService18TestCaseOperation6Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService18TestShapeInputService18TestShapeInputService18TestCaseOperation1Output struct {
 	metadataInputService18TestShapeInputService18TestShapeInputService18TestCaseOperation1Output `json:"-", xml:"-"`
 }
@@ -1669,6 +1911,11 @@ type metadataInputService18TestShapeInputService18TestShapeInputService18TestCas
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService18TestShapeInputService18TestShapeInputService18TestCaseOperation1Output.
+func (s InputService18TestShapeInputService18TestShapeInputService18TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService18TestShapeInputService18TestShapeInputService18TestCaseOperation4Output struct {
 	metadataInputService18TestShapeInputService18TestShapeInputService18TestCaseOperation4Output `json:"-", xml:"-"`
 }
@@ -1677,6 +1924,11 @@ type metadataInputService18TestShapeInputService18TestShapeInputService18TestCas
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService18TestShapeInputService18TestShapeInputService18TestCaseOperation4Output.
+func (s InputService18TestShapeInputService18TestShapeInputService18TestCaseOperation4Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService18TestShapeInputService18TestShapeInputService18TestCaseOperation5Output struct {
 	metadataInputService18TestShapeInputService18TestShapeInputService18TestCaseOperation5Output `json:"-", xml:"-"`
 }
@@ -1685,6 +1937,11 @@ type metadataInputService18TestShapeInputService18TestShapeInputService18TestCas
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService18TestShapeInputService18TestShapeInputService18TestCaseOperation5Output.
+func (s InputService18TestShapeInputService18TestShapeInputService18TestCaseOperation5Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService18TestShapeInputShape struct {
 	RecursiveStruct *InputService18TestShapeRecursiveStructType `type:"structure"`
 
@@ -1695,6 +1952,11 @@ type metadataInputService18TestShapeInputShape struct {
 	SDKShapeTraits bool `locationName:"OperationRequest" type:"structure" xmlURI:"https://foo/"`
 }
 
+// String returns the string representation of the InputService18TestShapeInputShape.
+func (s InputService18TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService18TestShapeRecursiveStructType struct {
 	NoRecurse *string `type:"string"`
 
@@ -1711,6 +1973,11 @@ type metadataInputService18TestShapeRecursiveStructType struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService18TestShapeRecursiveStructType.
+func (s InputService18TestShapeRecursiveStructType) String() string {
+	return awsutil.StringValue(s)
+}
+
 // InputService19ProtocolTest is a client for InputService19ProtocolTest.
 type InputService19ProtocolTest struct {
 	*aws.Service
@@ -1730,7 +1997,7 @@ func NewInputService19ProtocolTest(config *aws.Config) *InputService19ProtocolTe
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restxml.Build)
 	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
@@ -1772,6 +2039,11 @@ type metadataInputService19TestShapeInputService19TestCaseOperation1Output struc
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService19TestShapeInputService19TestCaseOperation1Output.
+func (s InputService19TestShapeInputService19TestCaseOperation1Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InputService19TestShapeInputShape struct {
 	TimeArgInHeader *time.Time `location:"header" locationName:"x-amz-timearg" type:"timestamp" timestampFormat:"rfc822"`
 
@@ -1782,6 +2054,11 @@ type metadataInputService19TestShapeInputShape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputService19TestShapeInputShape.
+func (s InputService19TestShapeInputShape) String() string {
+	return awsutil.StringValue(s)
+}
+
 //
 // Tests begin here
 //
@@ -2277,7 +2554,7 @@ func TestInputService15ProtocolTestXMLAttributeCase1(t *testing.T) {
 	// assert body
 	assert.NotNil(t, r.Body)
 	body := util.SortXML(r.Body)
-	assert.Equal(t, util.Trim(`<Grant xmlns:_xmlns="xmlns" _xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:XMLSchema-instance="http://www.w3.org/2001/XMLSchema-instance" XMLSchema-instance:type="CanonicalUser"><Grantee><EmailAddress>foo@example.com</EmailAddress></Grantee></Grant>`), util.Trim(string(body)))
+	assert.Equal(t, util.Trim(`<Grant><Grantee xmlns:_xmlns="xmlns" _xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:_XMLSchema-instance="http://www.w3.org/2001/XMLSchema-instance" _XMLSchema-instance:type="CanonicalUser"><EmailAddress>foo@example.com</EmailAddress></Grantee></Grant>`), util.Trim(string(body)))
 
 	// assert URL
 	assert.Equal(t, "https://test/", r.URL.String())
@@ -8,6 +8,7 @@ import (
 	"encoding/xml"
 
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/internal/protocol"
 	"github.com/awslabs/aws-sdk-go/internal/protocol/query"
 	"github.com/awslabs/aws-sdk-go/internal/protocol/rest"
 	"github.com/awslabs/aws-sdk-go/internal/protocol/xml/xmlutil"
@@ -23,6 +24,9 @@ func Build(r *aws.Request) {
 			r.Error = err
 			return
 		}
+		if buf.Len() > 0 {
+			protocol.SetContentType(r, protocol.XMLContentType)
+		}
 		r.SetBufferBody(buf.Bytes())
 	}
 }
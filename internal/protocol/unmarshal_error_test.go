@@ -0,0 +1,93 @@
+package protocol_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awserr"
+	"github.com/awslabs/aws-sdk-go/internal/protocol/ec2query"
+	"github.com/awslabs/aws-sdk-go/internal/protocol/jsonrpc"
+	"github.com/awslabs/aws-sdk-go/internal/protocol/query"
+	"github.com/awslabs/aws-sdk-go/internal/protocol/restjson"
+	"github.com/awslabs/aws-sdk-go/internal/protocol/restxml"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnmarshalError(t *testing.T) {
+	cases := []struct {
+		name           string
+		unmarshalError func(*aws.Request)
+		status         int
+		header         http.Header
+		body           string
+		code, message  string
+	}{
+		{
+			name:           "ec2query",
+			unmarshalError: ec2query.UnmarshalError,
+			status:         400,
+			header:         http.Header{},
+			body:           `<Response><Errors><Error><Code>InvalidInstanceID.NotFound</Code><Message>The instance ID does not exist</Message></Error></Errors><RequestID>request-id</RequestID></Response>`,
+			code:           "InvalidInstanceID.NotFound",
+			message:        "The instance ID does not exist",
+		},
+		{
+			name:           "jsonrpc",
+			unmarshalError: jsonrpc.UnmarshalError,
+			status:         400,
+			header:         http.Header{"X-Amzn-Requestid": []string{"request-id"}},
+			body:           `{"__type":"com.amazonaws.dynamodb.v20120810#ResourceNotFoundException","message":"Requested resource not found"}`,
+			code:           "ResourceNotFoundException",
+			message:        "Requested resource not found",
+		},
+		{
+			name:           "query",
+			unmarshalError: query.UnmarshalError,
+			status:         400,
+			header:         http.Header{},
+			body:           `<ErrorResponse><Error><Type>Sender</Type><Code>Throttling</Code><Message>Rate exceeded</Message></Error><RequestId>request-id</RequestId></ErrorResponse>`,
+			code:           "Throttling",
+			message:        "Rate exceeded",
+		},
+		{
+			name:           "restjson",
+			unmarshalError: restjson.UnmarshalError,
+			status:         404,
+			header:         http.Header{"X-Amzn-Requestid": []string{"request-id"}, "X-Amzn-Errortype": []string{"ResourceNotFoundException:http://internal.amazon.com/coral/com.amazonaws.lambda/"}},
+			body:           `{"message":"Function not found"}`,
+			code:           "ResourceNotFoundException",
+			message:        "Function not found",
+		},
+		{
+			name:           "restxml",
+			unmarshalError: restxml.UnmarshalError,
+			status:         404,
+			header:         http.Header{},
+			body:           `<ErrorResponse><Error><Type>Sender</Type><Code>NoSuchHostedZone</Code><Message>No hosted zone found</Message></Error><RequestId>request-id</RequestId></ErrorResponse>`,
+			code:           "NoSuchHostedZone",
+			message:        "No hosted zone found",
+		},
+	}
+
+	for _, c := range cases {
+		req := aws.NewRequest(aws.NewService(&aws.Config{}), &aws.Operation{Name: "Operation"}, nil, nil)
+		req.HTTPResponse = &http.Response{
+			StatusCode: c.status,
+			Header:     c.header,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(c.body))),
+		}
+		c.unmarshalError(req)
+
+		err, ok := req.Error.(awserr.RequestFailure)
+		if !assert.True(t, ok, c.name) {
+			continue
+		}
+		assert.Equal(t, c.code, err.Code(), c.name)
+		assert.Equal(t, c.message, err.Message(), c.name)
+		assert.Equal(t, c.status, err.StatusCode(), c.name)
+		assert.Equal(t, "request-id", err.RequestID(), c.name)
+	}
+}
@@ -11,6 +11,23 @@ import (
 	"time"
 )
 
+const (
+	// ISO8601UTC is the ISO8601 timestamp layout used by default in XML bodies
+	ISO8601UTC = "2006-01-02T15:04:05Z"
+
+	// RFC822 is the RFC822 timestamp layout used by AWS protocols
+	RFC822 = "Mon, 2 Jan 2006 15:04:05 GMT"
+)
+
+// A Marshaler is a value that can serialize itself into an XML node. Values
+// implementing Marshaler bypass the reflection based builder, allowing custom
+// types to control their own XML representation.
+type Marshaler interface {
+	// MarshalAWSXML populates the node, which is already named after the
+	// member being serialized, with the value's text, attributes or children.
+	MarshalAWSXML(*XMLNode) error
+}
+
 func BuildXML(params interface{}, e *xml.Encoder) error {
 	b := xmlBuilder{encoder: e, namespaces: map[string]string{}}
 	root := NewXMLElement(xml.Name{})
@@ -25,8 +42,10 @@ func BuildXML(params interface{}, e *xml.Encoder) error {
 	return nil
 }
 
+// elemOf unwraps the pointers and interfaces wrapping value. A nil pointer or
+// interface unwraps to the zero Value, which is skipped by the builder.
 func elemOf(value reflect.Value) reflect.Value {
-	for value.Kind() == reflect.Ptr {
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
 		value = value.Elem()
 	}
 	return value
@@ -45,6 +64,10 @@ func (b *xmlBuilder) buildValue(value reflect.Value, current *XMLNode, tag refle
 		return nil
 	}
 
+	if m, ok := marshalerOf(value); ok {
+		return b.buildMarshaler(m, current, tag)
+	}
+
 	t := tag.Get("type")
 	if t == "" {
 		switch value.Kind() {
@@ -72,6 +95,40 @@ func (b *xmlBuilder) buildValue(value reflect.Value, current *XMLNode, tag refle
 	}
 }
 
+// marshalerOf returns the value as a Marshaler if it, or a pointer to it,
+// implements the interface.
+func marshalerOf(value reflect.Value) (Marshaler, bool) {
+	if value.CanAddr() {
+		if m, ok := value.Addr().Interface().(Marshaler); ok {
+			return m, true
+		}
+	}
+	if value.CanInterface() {
+		if m, ok := value.Interface().(Marshaler); ok {
+			return m, true
+		}
+	}
+	return nil, false
+}
+
+func (b *xmlBuilder) buildMarshaler(m Marshaler, current *XMLNode, tag reflect.StructTag) error {
+	if tag.Get("locationName") == "" { // list and map members marshal into their wrapping element
+		return m.MarshalAWSXML(current)
+	}
+
+	node := NewXMLElement(xml.Name{Local: tag.Get("locationName")})
+	if err := m.MarshalAWSXML(node); err != nil {
+		return err
+	}
+
+	if tag.Get("xmlAttribute") != "" { // put into current node's attribute list
+		current.Attr = append(current.Attr, xml.Attr{Name: node.Name, Value: node.Text})
+	} else {
+		current.AddChild(node)
+	}
+	return nil
+}
+
 func (b *xmlBuilder) buildStruct(value reflect.Value, current *XMLNode, tag reflect.StructTag) error {
 	if !value.IsValid() {
 		return nil
@@ -79,7 +136,9 @@ func (b *xmlBuilder) buildStruct(value reflect.Value, current *XMLNode, tag refl
 
 	fieldAdded := false
 
-	// unwrap payloads
+	// unwrap payloads, which are the root element of the body. They are named
+	// by the member's locationName, falling back to the payload shape's own
+	// and then the member's name, and keep the shape's namespace.
 	if payload := tag.Get("payload"); payload != "" {
 		field, _ := value.Type().FieldByName(payload)
 		tag = field.Tag
@@ -88,6 +147,13 @@ func (b *xmlBuilder) buildStruct(value reflect.Value, current *XMLNode, tag refl
 		if !value.IsValid() {
 			return nil
 		}
+
+		if traits, ok := value.Type().FieldByName("SDKShapeTraits"); ok {
+			tag = tag + reflect.StructTag(" ") + traits.Tag
+		}
+		if tag.Get("locationName") == "" {
+			tag = tag + reflect.StructTag(` locationName:"`+field.Name+`"`)
+		}
 	}
 
 	child := NewXMLElement(xml.Name{Local: tag.Get("locationName")})
@@ -125,6 +191,16 @@ func (b *xmlBuilder) buildStruct(value reflect.Value, current *XMLNode, tag refl
 			memberName = field.Name
 			mTag = reflect.StructTag(string(mTag) + ` locationName:"` + memberName + `"`)
 		}
+		if err := b.declarePrefix(child, memberName); err != nil {
+			return err
+		}
+
+		if !member.IsValid() && mTag.Get("xmlNil") != "" { // explicitly null member
+			b.buildNil(child, memberName)
+			fieldAdded = true
+			continue
+		}
+
 		if err := b.buildValue(member, child, mTag); err != nil {
 			return err
 		}
@@ -139,6 +215,50 @@ func (b *xmlBuilder) buildStruct(value reflect.Value, current *XMLNode, tag refl
 	return nil
 }
 
+// declarePrefix declares the namespace prefix of a member named prefix:name
+// on node, the element of the struct holding the member, unless node already
+// declares it. The prefix must have been registered by the xmlPrefix of the
+// struct or one of its ancestors, otherwise the member cannot be built into
+// valid XML and an error is returned.
+func (b *xmlBuilder) declarePrefix(node *XMLNode, name string) error {
+	i := strings.Index(name, ":")
+	if i < 0 {
+		return nil
+	}
+	prefix := name[:i]
+	if prefix == "xmlns" || prefix == "xml" { // reserved, need no declaration
+		return nil
+	}
+
+	uri, ok := b.namespaces[prefix]
+	if !ok {
+		return fmt.Errorf("undeclared XML namespace prefix %q in member name %s", prefix, name)
+	}
+	for _, a := range node.Attr {
+		if a.Name.Local == "xmlns:"+prefix {
+			return nil
+		}
+	}
+	node.Attr = append(node.Attr, xml.Attr{Name: xml.Name{Local: "xmlns:" + prefix}, Value: uri})
+	return nil
+}
+
+// xsiNamespace is the XML Schema instance namespace used for xsi:nil.
+const xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+// buildNil adds an empty element marked with xsi:nil="true" to current, so
+// that services can distinguish a null member from an omitted one.
+func (b *xmlBuilder) buildNil(current *XMLNode, name string) {
+	b.namespaces["xsi"] = xsiNamespace // register the namespace
+
+	child := NewXMLElement(xml.Name{Local: name})
+	child.Attr = append(child.Attr,
+		xml.Attr{Name: xml.Name{Local: "xmlns:xsi"}, Value: xsiNamespace},
+		xml.Attr{Name: xml.Name{Local: "xsi:nil"}, Value: "true"},
+	)
+	current.AddChild(child)
+}
+
 func (b *xmlBuilder) buildList(value reflect.Value, current *XMLNode, tag reflect.StructTag) error {
 	if value.IsNil() { // don't build omitted lists
 		return nil
@@ -152,6 +272,7 @@ func (b *xmlBuilder) buildList(value reflect.Value, current *XMLNode, tag reflec
 		for i := 0; i < value.Len(); i++ {
 			child := NewXMLElement(xname)
 			current.AddChild(child)
+			b.buildListNamespace(value.Index(i), child, i == 0)
 			if err := b.buildValue(value.Index(i), child, ""); err != nil {
 				return err
 			}
@@ -168,6 +289,7 @@ func (b *xmlBuilder) buildList(value reflect.Value, current *XMLNode, tag reflec
 
 			child := NewXMLElement(xml.Name{Local: iname})
 			list.AddChild(child)
+			b.buildListNamespace(value.Index(i), child, i == 0)
 			if err := b.buildValue(value.Index(i), child, ""); err != nil {
 				return err
 			}
@@ -177,14 +299,48 @@ func (b *xmlBuilder) buildList(value reflect.Value, current *XMLNode, tag reflec
 	return nil
 }
 
+// buildListNamespace declares the namespace of a list member's type on the
+// element wrapping the member. A default namespace is declared on every item,
+// while a prefixed namespace is only declared on the first one.
+func (b *xmlBuilder) buildListNamespace(value reflect.Value, child *XMLNode, first bool) {
+	value = elemOf(value)
+	if value.Kind() != reflect.Struct {
+		return
+	}
+	field, ok := value.Type().FieldByName("SDKShapeTraits")
+	if !ok {
+		return
+	}
+
+	prefix, uri := field.Tag.Get("xmlPrefix"), field.Tag.Get("xmlURI")
+	if uri == "" {
+		return
+	}
+
+	ns := xml.Attr{Name: xml.Name{Local: "xmlns"}, Value: uri}
+	if prefix != "" {
+		if !first {
+			return
+		}
+		b.namespaces[prefix] = uri // register the namespace
+		ns.Name.Local = "xmlns:" + prefix
+	}
+	child.Attr = append(child.Attr, ns)
+}
+
 func (b *xmlBuilder) buildMap(value reflect.Value, current *XMLNode, tag reflect.StructTag) error {
 	if value.IsNil() { // don't build omitted maps
 		return nil
 	}
 
-	maproot := NewXMLElement(xml.Name{Local: tag.Get("locationName")})
-	current.AddChild(maproot)
-	current = maproot
+	xname := xml.Name{Local: tag.Get("locationName")}
+	flattened := tag.Get("flattened") != ""
+
+	maproot := current
+	if !flattened { // flattened maps add entries directly to the parent
+		maproot = NewXMLElement(xname)
+		current.AddChild(maproot)
+	}
 
 	kname, vname := "key", "value"
 	if n := tag.Get("locationNameKey"); n != "" {
@@ -195,28 +351,30 @@ func (b *xmlBuilder) buildMap(value reflect.Value, current *XMLNode, tag reflect
 	}
 
 	// sorting is not required for compliance, but it makes testing easier
+	mapKeys := map[string]reflect.Value{}
 	keys := make([]string, value.Len())
 	for i, k := range value.MapKeys() {
 		keys[i] = k.String()
+		mapKeys[keys[i]] = k
 	}
 	sort.Strings(keys)
 
 	for _, k := range keys {
-		v := value.MapIndex(reflect.ValueOf(k))
-		fmt.Println(k, v.Interface())
-
-		mapcur := current
-		if tag.Get("flattened") == "" { // add "entry" tag to non-flat maps
-			child := NewXMLElement(xml.Name{Local: "entry"})
-			mapcur.AddChild(child)
-			mapcur = child
+		v := value.MapIndex(mapKeys[k])
+
+		var entry *XMLNode
+		if flattened { // each flattened entry is named after the map itself
+			entry = NewXMLElement(xname)
+		} else { // add "entry" tag to non-flat maps
+			entry = NewXMLElement(xml.Name{Local: "entry"})
 		}
+		maproot.AddChild(entry)
 
 		kchild := NewXMLElement(xml.Name{Local: kname})
 		kchild.Text = k
 		vchild := NewXMLElement(xml.Name{Local: vname})
-		mapcur.AddChild(kchild)
-		mapcur.AddChild(vchild)
+		entry.AddChild(kchild)
+		entry.AddChild(vchild)
 
 		if err := b.buildValue(v, vchild, ""); err != nil {
 			return err
@@ -232,20 +390,27 @@ func (b *xmlBuilder) buildScalar(value reflect.Value, current *XMLNode, tag refl
 	case string:
 		str = converted
 	case []byte:
-		str = base64.StdEncoding.EncodeToString(converted)
+		str = blobEncoding(tag).EncodeToString(converted)
 	case bool:
 		str = strconv.FormatBool(converted)
 	case int64:
 		str = strconv.FormatInt(converted, 10)
 	case int:
 		str = strconv.Itoa(converted)
+	case int32:
+		str = strconv.FormatInt(int64(converted), 10)
+	case uint:
+		str = strconv.FormatUint(uint64(converted), 10)
+	case uint32:
+		str = strconv.FormatUint(uint64(converted), 10)
+	case uint64:
+		str = strconv.FormatUint(converted, 10)
 	case float64:
 		str = strconv.FormatFloat(converted, 'f', -1, 64)
 	case float32:
 		str = strconv.FormatFloat(float64(converted), 'f', -1, 32)
 	case time.Time:
-		const ISO8601UTC = "2006-01-02T15:04:05Z"
-		str = converted.UTC().Format(ISO8601UTC)
+		str = formatTime(converted, tag.Get("timestampFormat"))
 	default:
 		return fmt.Errorf("unsupported value for param %s: %v (%s)",
 			tag.Get("locationName"), value.Interface(), value.Type().Name())
@@ -260,3 +425,30 @@ func (b *xmlBuilder) buildScalar(value reflect.Value, current *XMLNode, tag refl
 	}
 	return nil
 }
+
+// formatTime formats t according to the timestampFormat trait of a member,
+// defaulting to ISO8601 when no format is given.
+func formatTime(t time.Time, format string) string {
+	switch format {
+	case "rfc822":
+		return t.UTC().Format(RFC822)
+	case "unixTimestamp", "unix":
+		return strconv.FormatInt(t.UTC().Unix(), 10)
+	default:
+		return t.UTC().Format(ISO8601UTC)
+	}
+}
+
+// blobEncoding returns the base64 encoding selected by the blobEncoding trait
+// of a member: "raw" for unpadded, "url" for URL-safe, and "std", the
+// default, for padded standard encoding.
+func blobEncoding(tag reflect.StructTag) *base64.Encoding {
+	switch tag.Get("blobEncoding") {
+	case "raw":
+		return base64.RawStdEncoding
+	case "url":
+		return base64.URLEncoding
+	default:
+		return base64.StdEncoding
+	}
+}
@@ -0,0 +1,594 @@
+package xmlutil_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"math/big"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/internal/protocol/xml/xmlutil"
+	"github.com/awslabs/aws-sdk-go/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildXML(t *testing.T, v interface{}) string {
+	var buf bytes.Buffer
+	err := xmlutil.BuildXML(v, xml.NewEncoder(&buf))
+	assert.NoError(t, err)
+	return util.SortXML(&buf)
+}
+
+func sortXML(s string) string {
+	return util.SortXML(bytes.NewReader([]byte(s)))
+}
+
+type mapValueShape struct {
+	Name *string `type:"string"`
+}
+
+type mapShape struct {
+	Attributes *map[string]*string            `type:"map"`
+	Custom     *map[string]*string            `locationNameKey:"Name" locationNameValue:"Val" type:"map"`
+	Flat       *map[string]*string            `flattened:"true" type:"map"`
+	Structs    *map[string]*mapValueShape     `type:"map"`
+	Lists      *map[string][]*string          `type:"map"`
+	Empty      *map[string]*string            `type:"map"`
+	Nested     *map[string]*map[string]*int64 `type:"map"`
+
+	metadataMapShape `json:"-" xml:"-"`
+}
+
+type metadataMapShape struct {
+	SDKShapeTraits bool `locationName:"Input" type:"structure"`
+}
+
+func TestBuildMap(t *testing.T) {
+	in := &mapShape{
+		Attributes: &map[string]*string{"b": aws.String("2"), "a": aws.String("1")},
+	}
+
+	expected := `<Input><Attributes>` +
+		`<entry><key>a</key><value>1</value></entry>` +
+		`<entry><key>b</key><value>2</value></entry>` +
+		`</Attributes></Input>`
+	assert.Equal(t, sortXML(expected), buildXML(t, in))
+}
+
+func TestBuildMapLocationNames(t *testing.T) {
+	in := &mapShape{
+		Custom: &map[string]*string{"a": aws.String("1")},
+	}
+
+	expected := `<Input><Custom><entry><Name>a</Name><Val>1</Val></entry></Custom></Input>`
+	assert.Equal(t, sortXML(expected), buildXML(t, in))
+}
+
+func TestBuildMapFlattened(t *testing.T) {
+	in := &mapShape{
+		Flat: &map[string]*string{"b": aws.String("2"), "a": aws.String("1")},
+	}
+
+	expected := `<Input>` +
+		`<Flat><key>a</key><value>1</value></Flat>` +
+		`<Flat><key>b</key><value>2</value></Flat>` +
+		`</Input>`
+	assert.Equal(t, sortXML(expected), buildXML(t, in))
+}
+
+func TestBuildMapDeterministic(t *testing.T) {
+	in := &mapShape{
+		Attributes: &map[string]*string{
+			"e": aws.String("5"), "d": aws.String("4"), "c": aws.String("3"),
+			"b": aws.String("2"), "a": aws.String("1"),
+		},
+	}
+
+	var first bytes.Buffer
+	assert.NoError(t, xmlutil.BuildXML(in, xml.NewEncoder(&first)))
+	for i := 0; i < 10; i++ {
+		var buf bytes.Buffer
+		assert.NoError(t, xmlutil.BuildXML(in, xml.NewEncoder(&buf)))
+		assert.Equal(t, first.String(), buf.String())
+	}
+}
+
+func TestBuildMapComplexValues(t *testing.T) {
+	in := &mapShape{
+		Structs: &map[string]*mapValueShape{"a": &mapValueShape{Name: aws.String("foo")}},
+		Lists:   &map[string][]*string{"b": []*string{aws.String("x"), aws.String("y")}},
+		Nested:  &map[string]*map[string]*int64{"c": &map[string]*int64{"d": aws.Long(1)}},
+	}
+
+	expected := `<Input>` +
+		`<Structs><entry><key>a</key><value><Name>foo</Name></value></entry></Structs>` +
+		`<Lists><entry><key>b</key><value><member>x</member><member>y</member></value></entry></Lists>` +
+		`<Nested><entry><key>c</key><value><entry><key>d</key><value>1</value></entry></value></entry></Nested>` +
+		`</Input>`
+	assert.Equal(t, sortXML(expected), buildXML(t, in))
+}
+
+func TestBuildMapEmpty(t *testing.T) {
+	in := &mapShape{
+		Attributes: &map[string]*string{"a": aws.String("1")},
+		Empty:      &map[string]*string{},
+	}
+
+	expected := `<Input><Attributes><entry><key>a</key><value>1</value></entry></Attributes><Empty></Empty></Input>`
+	assert.Equal(t, sortXML(expected), buildXML(t, in))
+}
+
+type integerShape struct {
+	Int32  *int32  `type:"integer"`
+	Uint   *uint   `type:"integer"`
+	Uint32 *uint32 `type:"integer"`
+	Uint64 *uint64 `type:"long"`
+
+	metadataIntegerShape `json:"-" xml:"-"`
+}
+
+type metadataIntegerShape struct {
+	SDKShapeTraits bool `locationName:"Input" type:"structure"`
+}
+
+func TestBuildIntegerTypes(t *testing.T) {
+	i32, u, u32, u64 := int32(-2147483648), uint(42), uint32(4294967295), uint64(18446744073709551615)
+	in := &integerShape{Int32: &i32, Uint: &u, Uint32: &u32, Uint64: &u64}
+
+	expected := `<Input>` +
+		`<Int32>-2147483648</Int32>` +
+		`<Uint>42</Uint>` +
+		`<Uint32>4294967295</Uint32>` +
+		`<Uint64>18446744073709551615</Uint64>` +
+		`</Input>`
+	assert.Equal(t, sortXML(expected), buildXML(t, in))
+}
+
+type timestampShape struct {
+	Default *time.Time `type:"timestamp"`
+	ISO8601 *time.Time `type:"timestamp" timestampFormat:"iso8601"`
+	RFC822  *time.Time `type:"timestamp" timestampFormat:"rfc822"`
+	Unix    *time.Time `type:"timestamp" timestampFormat:"unixTimestamp"`
+
+	metadataTimestampShape `json:"-" xml:"-"`
+}
+
+type metadataTimestampShape struct {
+	SDKShapeTraits bool `locationName:"Input" type:"structure"`
+}
+
+func TestBuildTimestampFormats(t *testing.T) {
+	ts := time.Unix(1422172800, 0).In(time.FixedZone("PST", -8*60*60))
+	in := &timestampShape{Default: &ts, ISO8601: &ts, RFC822: &ts, Unix: &ts}
+
+	expected := `<Input>` +
+		`<Default>2015-01-25T08:00:00Z</Default>` +
+		`<ISO8601>2015-01-25T08:00:00Z</ISO8601>` +
+		`<RFC822>Sun, 25 Jan 2015 08:00:00 GMT</RFC822>` +
+		`<Unix>1422172800</Unix>` +
+		`</Input>`
+	assert.Equal(t, sortXML(expected), buildXML(t, in))
+}
+
+// hexInt marshals itself as a hexadecimal string instead of a decimal one.
+type hexInt struct {
+	big.Int
+}
+
+func (h *hexInt) MarshalAWSXML(node *xmlutil.XMLNode) error {
+	node.Text = "0x" + strings.ToUpper(h.Text(16))
+	return nil
+}
+
+// coordinate marshals itself as a pair of attributes.
+type coordinate struct {
+	X, Y int64
+}
+
+func (c coordinate) MarshalAWSXML(node *xmlutil.XMLNode) error {
+	node.Attr = append(node.Attr,
+		xml.Attr{Name: xml.Name{Local: "x"}, Value: strconv.FormatInt(c.X, 10)},
+		xml.Attr{Name: xml.Name{Local: "y"}, Value: strconv.FormatInt(c.Y, 10)},
+	)
+	return nil
+}
+
+type failingMarshaler struct{}
+
+func (failingMarshaler) MarshalAWSXML(node *xmlutil.XMLNode) error {
+	return errors.New("marshal failure")
+}
+
+type marshalerShape struct {
+	Big      *hexInt           `type:"structure"`
+	Point    *coordinate       `type:"structure"`
+	Points   []*coordinate     `locationNameList:"Point" type:"list"`
+	Failing  *failingMarshaler `type:"structure"`
+	Attr     *hexInt           `locationName:"attr" xmlAttribute:"true" type:"string"`
+	Standard *string           `type:"string"`
+
+	metadataMarshalerShape `json:"-" xml:"-"`
+}
+
+type metadataMarshalerShape struct {
+	SDKShapeTraits bool `locationName:"Input" type:"structure"`
+}
+
+func TestBuildMarshaler(t *testing.T) {
+	n := &hexInt{}
+	n.SetInt64(48879)
+	in := &marshalerShape{
+		Big:      n,
+		Point:    &coordinate{X: 1, Y: 2},
+		Points:   []*coordinate{&coordinate{X: 3, Y: 4}},
+		Attr:     n,
+		Standard: aws.String("value"),
+	}
+
+	expected := `<Input attr="0xBEEF">` +
+		`<Big>0xBEEF</Big>` +
+		`<Point x="1" y="2"></Point>` +
+		`<Points><Point x="3" y="4"></Point></Points>` +
+		`<Standard>value</Standard>` +
+		`</Input>`
+	var buf bytes.Buffer
+	assert.NoError(t, xmlutil.BuildXML(in, xml.NewEncoder(&buf)))
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestBuildMarshalerError(t *testing.T) {
+	in := &marshalerShape{Failing: &failingMarshaler{}}
+
+	var buf bytes.Buffer
+	err := xmlutil.BuildXML(in, xml.NewEncoder(&buf))
+	assert.Error(t, err)
+	assert.Equal(t, "marshal failure", err.Error())
+}
+
+type nilShape struct {
+	Nillable *string `type:"string" xmlNil:"true"`
+	Omitted  *string `type:"string"`
+	Nested   *nilNestedShape
+
+	metadataNilShape `json:"-" xml:"-"`
+}
+
+type nilNestedShape struct {
+	Value *int64 `type:"integer" xmlNil:"true"`
+}
+
+type metadataNilShape struct {
+	SDKShapeTraits bool `locationName:"Input" type:"structure"`
+}
+
+func TestBuildXMLNil(t *testing.T) {
+	in := &nilShape{Nested: &nilNestedShape{}}
+
+	var buf bytes.Buffer
+	assert.NoError(t, xmlutil.BuildXML(in, xml.NewEncoder(&buf)))
+	expected := `<Input>` +
+		`<Nillable xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></Nillable>` +
+		`<Nested><Value xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></Value></Nested>` +
+		`</Input>`
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestBuildXMLNilWithValue(t *testing.T) {
+	in := &nilShape{Nillable: aws.String("value"), Omitted: aws.String("other")}
+
+	expected := `<Input><Nillable>value</Nillable><Omitted>other</Omitted></Input>`
+	assert.Equal(t, sortXML(expected), buildXML(t, in))
+}
+
+type namespacedMember struct {
+	Name *string `type:"string"`
+
+	metadataNamespacedMember `json:"-" xml:"-"`
+}
+
+type metadataNamespacedMember struct {
+	SDKShapeTraits bool `type:"structure" xmlURI:"http://example.com/item"`
+}
+
+type prefixedMember struct {
+	Name *string `type:"string"`
+
+	metadataPrefixedMember `json:"-" xml:"-"`
+}
+
+type metadataPrefixedMember struct {
+	SDKShapeTraits bool `type:"structure" xmlPrefix:"ex" xmlURI:"http://example.com/item"`
+}
+
+type namespacedListShape struct {
+	Items        []*namespacedMember `locationNameList:"Item" type:"list"`
+	FlatItems    []*namespacedMember `locationName:"FlatItem" type:"list" flattened:"true"`
+	Prefixed     []*prefixedMember   `locationNameList:"Item" type:"list"`
+	FlatPrefixed []*prefixedMember   `locationName:"FlatPrefixed" type:"list" flattened:"true"`
+
+	metadataNamespacedListShape `json:"-" xml:"-"`
+}
+
+type metadataNamespacedListShape struct {
+	SDKShapeTraits bool `locationName:"Input" type:"structure"`
+}
+
+func buildRawXML(t *testing.T, v interface{}) string {
+	var buf bytes.Buffer
+	assert.NoError(t, xmlutil.BuildXML(v, xml.NewEncoder(&buf)))
+	return buf.String()
+}
+
+func TestBuildListNamespace(t *testing.T) {
+	in := &namespacedListShape{
+		Items: []*namespacedMember{{Name: aws.String("a")}, {Name: aws.String("b")}},
+	}
+
+	expected := `<Input><Items>` +
+		`<Item xmlns="http://example.com/item"><Name>a</Name></Item>` +
+		`<Item xmlns="http://example.com/item"><Name>b</Name></Item>` +
+		`</Items></Input>`
+	assert.Equal(t, expected, buildRawXML(t, in))
+}
+
+func TestBuildListNamespaceFlattened(t *testing.T) {
+	in := &namespacedListShape{
+		FlatItems: []*namespacedMember{{Name: aws.String("a")}, {Name: aws.String("b")}},
+	}
+
+	expected := `<Input>` +
+		`<FlatItem xmlns="http://example.com/item"><Name>a</Name></FlatItem>` +
+		`<FlatItem xmlns="http://example.com/item"><Name>b</Name></FlatItem>` +
+		`</Input>`
+	assert.Equal(t, expected, buildRawXML(t, in))
+}
+
+func TestBuildListNamespacePrefixed(t *testing.T) {
+	in := &namespacedListShape{
+		Prefixed: []*prefixedMember{{Name: aws.String("a")}, {Name: aws.String("b")}},
+	}
+
+	expected := `<Input><Prefixed>` +
+		`<Item xmlns:ex="http://example.com/item"><Name>a</Name></Item>` +
+		`<Item><Name>b</Name></Item>` +
+		`</Prefixed></Input>`
+	assert.Equal(t, expected, buildRawXML(t, in))
+}
+
+func TestBuildListNamespacePrefixedFlattened(t *testing.T) {
+	in := &namespacedListShape{
+		FlatPrefixed: []*prefixedMember{{Name: aws.String("a")}, {Name: aws.String("b")}},
+	}
+
+	expected := `<Input>` +
+		`<FlatPrefixed xmlns:ex="http://example.com/item"><Name>a</Name></FlatPrefixed>` +
+		`<FlatPrefixed><Name>b</Name></FlatPrefixed>` +
+		`</Input>`
+	assert.Equal(t, expected, buildRawXML(t, in))
+}
+
+type interfaceShape struct {
+	Member      interface{}
+	Nil         interface{}
+	Members     []interface{} `locationNameList:"Item" type:"list"`
+	PtrToMember **mapValueShape
+
+	metadataInterfaceShape `json:"-" xml:"-"`
+}
+
+type metadataInterfaceShape struct {
+	SDKShapeTraits bool `locationName:"Input" type:"structure"`
+}
+
+func TestBuildInterfaceValues(t *testing.T) {
+	ptr := &mapValueShape{Name: aws.String("ptr")}
+	in := &interfaceShape{
+		Member:      &mapValueShape{Name: aws.String("a")},
+		Members:     []interface{}{&mapValueShape{Name: aws.String("b")}, nil},
+		PtrToMember: &ptr,
+	}
+
+	expected := `<Input>` +
+		`<Member><Name>a</Name></Member>` +
+		`<Members><Item><Name>b</Name></Item><Item></Item></Members>` +
+		`<PtrToMember><Name>ptr</Name></PtrToMember>` +
+		`</Input>`
+	assert.Equal(t, sortXML(expected), buildXML(t, in))
+}
+
+func TestBuildInterfaceRoot(t *testing.T) {
+	var in interface{} = &mapShape{Attributes: &map[string]*string{"a": aws.String("1")}}
+
+	expected := `<Input><Attributes><entry><key>a</key><value>1</value></entry></Attributes></Input>`
+	assert.Equal(t, sortXML(expected), buildXML(t, &in))
+}
+
+type attributeShape struct {
+	Name    *string    `locationName:"name" xmlAttribute:"true" type:"string"`
+	Size    *int64     `locationName:"size" xmlAttribute:"true" type:"integer"`
+	Enabled *bool      `locationName:"enabled" xmlAttribute:"true" type:"boolean"`
+	Ratio   *float64   `locationName:"ratio" xmlAttribute:"true" type:"double"`
+	Data    []byte     `locationName:"data" xmlAttribute:"true" type:"blob"`
+	Created *time.Time `locationName:"created" xmlAttribute:"true" type:"timestamp" timestampFormat:"unixTimestamp"`
+}
+
+type mixedAttributeShape struct {
+	ID      *string `locationName:"id" xmlAttribute:"true" type:"string"`
+	Version *int64  `locationName:"version" xmlAttribute:"true" type:"integer"`
+	Value   *string `type:"string"`
+}
+
+type attributeStructShape struct {
+	Attrs *attributeShape      `type:"structure"`
+	Mixed *mixedAttributeShape `type:"structure"`
+
+	metadataAttributeStructShape `json:"-" xml:"-"`
+}
+
+type metadataAttributeStructShape struct {
+	SDKShapeTraits bool `locationName:"Input" type:"structure"`
+}
+
+func TestBuildAttributesOnlyStruct(t *testing.T) {
+	in := &attributeStructShape{
+		Attrs: &attributeShape{
+			Name:    aws.String("foo"),
+			Size:    aws.Long(123),
+			Enabled: aws.Boolean(true),
+			Ratio:   aws.Double(1.5),
+			Data:    []byte("bar"),
+			Created: aws.Time(time.Unix(1422172800, 0)),
+		},
+	}
+
+	// encoding/xml writes empty elements with an end tag
+	expected := `<Input>` +
+		`<Attrs name="foo" size="123" enabled="true" ratio="1.5" data="YmFy" created="1422172800"></Attrs>` +
+		`</Input>`
+	assert.Equal(t, expected, buildRawXML(t, in))
+}
+
+func TestBuildMixedAttributeStruct(t *testing.T) {
+	in := &attributeStructShape{
+		Mixed: &mixedAttributeShape{
+			ID:      aws.String("abc"),
+			Version: aws.Long(2),
+			Value:   aws.String("text"),
+		},
+	}
+
+	expected := `<Input>` +
+		`<Mixed id="abc" version="2"><Value>text</Value></Mixed>` +
+		`</Input>`
+	assert.Equal(t, expected, buildRawXML(t, in))
+}
+
+type payloadConfigShape struct {
+	LocationConstraint *string `type:"string"`
+
+	metadataPayloadConfigShape `json:"-" xml:"-"`
+}
+
+type metadataPayloadConfigShape struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+type namedPayloadConfigShape struct {
+	LocationConstraint *string `type:"string"`
+
+	metadataNamedPayloadConfigShape `json:"-" xml:"-"`
+}
+
+type metadataNamedPayloadConfigShape struct {
+	SDKShapeTraits bool `type:"structure" locationName:"BucketConfiguration" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+}
+
+type memberNamedPayloadShape struct {
+	Bucket *string             `location:"uri" locationName:"Bucket" type:"string"`
+	Config *payloadConfigShape `locationName:"CreateBucketConfiguration" type:"structure"`
+
+	metadataMemberNamedPayloadShape `json:"-" xml:"-"`
+}
+
+type metadataMemberNamedPayloadShape struct {
+	SDKShapeTraits bool `type:"structure" payload:"Config"`
+}
+
+type shapeNamedPayloadShape struct {
+	Config *namedPayloadConfigShape `type:"structure"`
+
+	metadataShapeNamedPayloadShape `json:"-" xml:"-"`
+}
+
+type metadataShapeNamedPayloadShape struct {
+	SDKShapeTraits bool `type:"structure" payload:"Config"`
+}
+
+type unnamedPayloadShape struct {
+	Config *payloadConfigShape `type:"structure"`
+
+	metadataUnnamedPayloadShape `json:"-" xml:"-"`
+}
+
+type metadataUnnamedPayloadShape struct {
+	SDKShapeTraits bool `type:"structure" payload:"Config"`
+}
+
+func TestBuildPayloadRootName(t *testing.T) {
+	config := &payloadConfigShape{LocationConstraint: aws.String("eu-west-1")}
+
+	assert.Equal(t,
+		`<CreateBucketConfiguration><LocationConstraint>eu-west-1</LocationConstraint></CreateBucketConfiguration>`,
+		buildRawXML(t, &memberNamedPayloadShape{Bucket: aws.String("bucket"), Config: config}))
+
+	assert.Equal(t,
+		`<Config><LocationConstraint>eu-west-1</LocationConstraint></Config>`,
+		buildRawXML(t, &unnamedPayloadShape{Config: config}))
+}
+
+func TestBuildPayloadShapeRootName(t *testing.T) {
+	assert.Equal(t,
+		`<BucketConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">`+
+			`<LocationConstraint>eu-west-1</LocationConstraint></BucketConfiguration>`,
+		buildRawXML(t, &shapeNamedPayloadShape{Config: &namedPayloadConfigShape{LocationConstraint: aws.String("eu-west-1")}}))
+}
+
+type prefixedChildShape struct {
+	Value *string `locationName:"ex:Value" type:"string"`
+
+	metadataPrefixedChildShape `json:"-" xml:"-"`
+}
+
+type metadataPrefixedChildShape struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+type prefixedNameShape struct {
+	Name  *string             `locationName:"ex:Name" type:"string"`
+	ID    *string             `locationName:"ex:id" type:"string" xmlAttribute:"true"`
+	Child *prefixedChildShape `type:"structure"`
+
+	metadataPrefixedNameShape `json:"-" xml:"-"`
+}
+
+type metadataPrefixedNameShape struct {
+	SDKShapeTraits bool `locationName:"Input" type:"structure" xmlPrefix:"ex" xmlURI:"http://example.com/ns"`
+}
+
+type undeclaredPrefixShape struct {
+	Name *string `locationName:"other:Name" type:"string"`
+
+	metadataUndeclaredPrefixShape `json:"-" xml:"-"`
+}
+
+type metadataUndeclaredPrefixShape struct {
+	SDKShapeTraits bool `locationName:"Input" type:"structure" xmlPrefix:"ex" xmlURI:"http://example.com/ns"`
+}
+
+func TestBuildPrefixedMemberName(t *testing.T) {
+	in := &prefixedNameShape{Name: aws.String("name"), ID: aws.String("1")}
+
+	expected := `<Input xmlns:ex="http://example.com/ns" ex:id="1"><ex:Name>name</ex:Name></Input>`
+	assert.Equal(t, expected, buildRawXML(t, in))
+}
+
+func TestBuildPrefixedMemberNameNested(t *testing.T) {
+	in := &prefixedNameShape{Child: &prefixedChildShape{Value: aws.String("value")}}
+
+	expected := `<Input xmlns:ex="http://example.com/ns">` +
+		`<Child xmlns:ex="http://example.com/ns"><ex:Value>value</ex:Value></Child></Input>`
+	assert.Equal(t, expected, buildRawXML(t, in))
+}
+
+func TestBuildPrefixedMemberNameUndeclared(t *testing.T) {
+	err := xmlutil.BuildXML(&undeclaredPrefixShape{Name: aws.String("name")}, xml.NewEncoder(&bytes.Buffer{}))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `"other"`)
+
+	err = xmlutil.BuildXML(&prefixedChildShape{Value: aws.String("value")}, xml.NewEncoder(&bytes.Buffer{}))
+	assert.Error(t, err)
+}
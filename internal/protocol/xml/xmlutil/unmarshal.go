@@ -1,10 +1,10 @@
 package xmlutil
 
 import (
-	"encoding/base64"
 	"encoding/xml"
 	"fmt"
 	"io"
+	"math"
 	"reflect"
 	"strconv"
 	"strings"
@@ -12,6 +12,22 @@ import (
 )
 
 func UnmarshalXML(v interface{}, d *xml.Decoder, wrapper string) error {
+	return unmarshaler{}.unmarshal(v, d, wrapper)
+}
+
+// UnmarshalUnwrappedListsXML unmarshals as UnmarshalXML does, but also reads
+// lists whose items are not wrapped in a member element, such as EC2's, as
+// flattened lists.
+func UnmarshalUnwrappedListsXML(v interface{}, d *xml.Decoder, wrapper string) error {
+	return unmarshaler{unwrappedLists: true}.unmarshal(v, d, wrapper)
+}
+
+// unmarshaler holds the options of an unmarshal.
+type unmarshaler struct {
+	unwrappedLists bool
+}
+
+func (u unmarshaler) unmarshal(v interface{}, d *xml.Decoder, wrapper string) error {
 	n, _ := XMLToStruct(d, nil)
 	if n.Children != nil {
 		for _, root := range n.Children {
@@ -20,7 +36,7 @@ func UnmarshalXML(v interface{}, d *xml.Decoder, wrapper string) error {
 					c = wrappedChild[0] // pull out wrapped element
 				}
 
-				err := parse(reflect.ValueOf(v), c, "")
+				err := u.parse(reflect.ValueOf(v), c, "")
 				if err != nil {
 					if err == io.EOF {
 						return nil
@@ -34,7 +50,7 @@ func UnmarshalXML(v interface{}, d *xml.Decoder, wrapper string) error {
 	return nil
 }
 
-func parse(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
+func (u unmarshaler) parse(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
 	rtype := r.Type()
 	if rtype.Kind() == reflect.Ptr {
 		rtype = rtype.Elem() // check kind of actual element type
@@ -57,17 +73,17 @@ func parse(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
 		if field, ok := rtype.FieldByName("SDKShapeTraits"); ok {
 			tag = field.Tag
 		}
-		return parseStruct(r, node, tag)
+		return u.parseStruct(r, node, tag)
 	case "list":
-		return parseList(r, node, tag)
+		return u.parseList(r, node, tag)
 	case "map":
-		return parseMap(r, node, tag)
+		return u.parseMap(r, node, tag)
 	default:
 		return parseScalar(r, node, tag)
 	}
 }
 
-func parseStruct(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
+func (u unmarshaler) parseStruct(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
 	t := r.Type()
 	if r.Kind() == reflect.Ptr {
 		if r.IsNil() { // create the structure if it's nil
@@ -83,7 +99,7 @@ func parseStruct(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
 	// unwrap any payloads
 	if payload := tag.Get("payload"); payload != "" {
 		field, _ := t.FieldByName(payload)
-		return parseStruct(r.FieldByName(payload), node, field.Tag)
+		return u.parseStruct(r.FieldByName(payload), node, field.Tag)
 	}
 
 	for i := 0; i < t.NumField(); i++ {
@@ -114,7 +130,7 @@ func parseStruct(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
 
 		member := r.FieldByName(field.Name)
 		for _, elem := range elems {
-			err := parse(member, elem, field.Tag)
+			err := u.parse(member, elem, field.Tag)
 			if err != nil {
 				return err
 			}
@@ -123,22 +139,29 @@ func parseStruct(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
 	return nil
 }
 
-func parseList(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
+func (u unmarshaler) parseList(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
 	t := r.Type()
 
-	if tag.Get("flattened") == "" { // look at all item entries
-		mname := "member"
-		if name := tag.Get("locationNameList"); name != "" {
-			mname = name
-		}
+	mname := "member"
+	if name := tag.Get("locationNameList"); name != "" {
+		mname = name
+	}
 
+	// lists, such as EC2's, whose items are not wrapped in a member element
+	// are read as flattened lists when the unmarshaler allows them
+	flattened := tag.Get("flattened") != ""
+	if _, ok := node.Children[mname]; !ok && !flattened && u.unwrappedLists {
+		flattened = strings.TrimSpace(node.Text) != "" || len(node.Children) > 0
+	}
+
+	if !flattened { // look at all item entries
 		if Children, ok := node.Children[mname]; ok {
 			if r.IsNil() {
 				r.Set(reflect.MakeSlice(t, len(Children), len(Children)))
 			}
 
 			for i, c := range Children {
-				err := parse(r.Index(i), c, "")
+				err := u.parse(r.Index(i), c, "")
 				if err != nil {
 					return err
 				}
@@ -151,7 +174,7 @@ func parseList(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
 
 		childR := reflect.Zero(t.Elem())
 		r.Set(reflect.Append(r, childR))
-		err := parse(r.Index(r.Len()-1), node, "")
+		err := u.parse(r.Index(r.Len()-1), node, "")
 		if err != nil {
 			return err
 		}
@@ -160,7 +183,7 @@ func parseList(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
 	return nil
 }
 
-func parseMap(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
+func (u unmarshaler) parseMap(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
 	t := r.Type()
 	if r.Kind() == reflect.Ptr {
 		t = t.Elem()
@@ -170,20 +193,26 @@ func parseMap(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
 		}
 
 		r = r.Elem()
+	} else if r.IsNil() {
+		r.Set(reflect.MakeMap(t))
 	}
 
 	if tag.Get("flattened") == "" { // look at all child entries
 		for _, entry := range node.Children["entry"] {
-			parseMapEntry(r, entry, tag)
+			if err := u.parseMapEntry(r, entry, tag); err != nil {
+				return err
+			}
 		}
 	} else { // this element is itself an entry
-		parseMapEntry(r, node, tag)
+		if err := u.parseMapEntry(r, node, tag); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
-func parseMapEntry(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
+func (u unmarshaler) parseMapEntry(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
 	kname, vname := "key", "value"
 	if n := tag.Get("locationNameKey"); n != "" {
 		kname = n
@@ -192,17 +221,18 @@ func parseMapEntry(r reflect.Value, node *XMLNode, tag reflect.StructTag) error
 		vname = n
 	}
 
-	keys, ok := node.Children[kname]
+	keys := node.Children[kname]
 	values := node.Children[vname]
-	if ok {
-		for i, key := range keys {
-			keyR := reflect.ValueOf(key.Text)
-			value := values[i]
-			valueR := reflect.New(r.Type().Elem()).Elem()
+	for i, key := range keys {
+		keyR := reflect.ValueOf(key.Text)
+		valueR := reflect.New(r.Type().Elem()).Elem()
 
-			parse(valueR, value, "")
-			r.SetMapIndex(keyR, valueR)
+		if i < len(values) { // entries without a value map to the zero value
+			if err := u.parse(valueR, values[i], ""); err != nil {
+				return err
+			}
 		}
+		r.SetMapIndex(keyR, valueR)
 	}
 	return nil
 }
@@ -213,7 +243,7 @@ func parseScalar(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
 		r.Set(reflect.ValueOf(&node.Text))
 		return nil
 	case []byte:
-		b, err := base64.StdEncoding.DecodeString(node.Text)
+		b, err := blobEncoding(tag).DecodeString(node.Text)
 		if err != nil {
 			return err
 		}
@@ -237,8 +267,7 @@ func parseScalar(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
 		}
 		r.Set(reflect.ValueOf(&v))
 	case *time.Time:
-		const ISO8601UTC = "2006-01-02T15:04:05Z"
-		t, err := time.Parse(ISO8601UTC, node.Text)
+		t, err := parseTime(node.Text, tag.Get("timestampFormat"))
 		if err != nil {
 			return err
 		} else {
@@ -249,3 +278,24 @@ func parseScalar(r reflect.Value, node *XMLNode, tag reflect.StructTag) error {
 	}
 	return nil
 }
+
+// parseTime parses a timestamp in the timestampFormat of a member into UTC.
+// ISO8601 timestamps, the default, may have fractional seconds, as may Unix
+// timestamps.
+func parseTime(text, format string) (time.Time, error) {
+	switch format {
+	case "rfc822":
+		t, err := time.Parse(RFC822, text)
+		return t.UTC(), err
+	case "unixTimestamp", "unix":
+		f, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		sec := math.Floor(f)
+		return time.Unix(int64(sec), int64((f-sec)*1e9)).UTC(), nil
+	default:
+		t, err := time.Parse(time.RFC3339Nano, text)
+		return t.UTC(), err
+	}
+}
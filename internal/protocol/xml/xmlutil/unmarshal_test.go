@@ -0,0 +1,260 @@
+package xmlutil_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/internal/protocol/xml/xmlutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func unmarshalXML(t *testing.T, v interface{}, body string) {
+	err := xmlutil.UnmarshalXML(v, xml.NewDecoder(bytes.NewReader([]byte(body))), "")
+	assert.NoError(t, err)
+}
+
+type mapOutputShape struct {
+	Attributes map[string]*string            `type:"map"`
+	Flat       map[string]*string            `flattened:"true" type:"map"`
+	Custom     *map[string]*string           `locationNameKey:"Name" locationNameValue:"Val" flattened:"true" type:"map"`
+	Structs    *map[string]*mapValueShape    `locationName:"Struct" flattened:"true" type:"map"`
+	Nested     map[string]*map[string]*int64 `type:"map"`
+
+	metadataMapOutputShape `json:"-" xml:"-"`
+}
+
+type metadataMapOutputShape struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+func TestUnmarshalMap(t *testing.T) {
+	out := &mapOutputShape{}
+	unmarshalXML(t, out, `<Output><Attributes>`+
+		`<entry><key>a</key><value>1</value></entry>`+
+		`<entry><key>b</key><value>2</value></entry>`+
+		`</Attributes></Output>`)
+
+	assert.Equal(t, 2, len(out.Attributes))
+	assert.Equal(t, "1", *out.Attributes["a"])
+	assert.Equal(t, "2", *out.Attributes["b"])
+}
+
+func TestUnmarshalMapFlattened(t *testing.T) {
+	out := &mapOutputShape{}
+	unmarshalXML(t, out, `<Output>`+
+		`<Flat><key>a</key><value>1</value></Flat>`+
+		`<Flat><key>b</key><value>2</value></Flat>`+
+		`</Output>`)
+
+	assert.Equal(t, 2, len(out.Flat))
+	assert.Equal(t, "1", *out.Flat["a"])
+	assert.Equal(t, "2", *out.Flat["b"])
+}
+
+func TestUnmarshalMapFlattenedLocationNames(t *testing.T) {
+	out := &mapOutputShape{}
+	unmarshalXML(t, out, `<Output>`+
+		`<Custom><Name>a</Name><Val>1</Val></Custom>`+
+		`<Custom><Name>b</Name></Custom>`+
+		`<Struct><key>c</key><value><Name>foo</Name></value></Struct>`+
+		`</Output>`)
+
+	assert.Equal(t, 2, len(*out.Custom))
+	assert.Equal(t, "1", *(*out.Custom)["a"])
+	assert.Nil(t, (*out.Custom)["b"])
+	assert.Equal(t, "foo", *(*out.Structs)["c"].Name)
+}
+
+func TestUnmarshalMapNested(t *testing.T) {
+	out := &mapOutputShape{}
+	unmarshalXML(t, out, `<Output><Nested>`+
+		`<entry><key>a</key><value><entry><key>b</key><value>1</value></entry></value></entry>`+
+		`</Nested></Output>`)
+
+	assert.Equal(t, int64(1), *(*out.Nested["a"])["b"])
+}
+
+func TestUnmarshalMapError(t *testing.T) {
+	out := &mapOutputShape{}
+	err := xmlutil.UnmarshalXML(out, xml.NewDecoder(bytes.NewReader([]byte(`<Output><Nested>`+
+		`<entry><key>a</key><value><entry><key>b</key><value>NaN</value></entry></value></entry>`+
+		`</Nested></Output>`))), "")
+	assert.Error(t, err)
+}
+
+type blobShape struct {
+	Std     []byte `type:"blob"`
+	Default []byte `type:"blob" blobEncoding:"std"`
+	Raw     []byte `type:"blob" blobEncoding:"raw"`
+	URL     []byte `type:"blob" blobEncoding:"url"`
+
+	metadataBlobShape `json:"-" xml:"-"`
+}
+
+type metadataBlobShape struct {
+	SDKShapeTraits bool `locationName:"Input" type:"structure"`
+}
+
+func TestBlobEncodingRoundTrip(t *testing.T) {
+	for _, data := range [][]byte{
+		{0xfb},
+		{0xfb, 0xff},
+		{0xfb, 0xff, 0xbf},
+		{0xfb, 0xff, 0xbf, 0x3e},
+		[]byte("round trip"),
+	} {
+		in := &blobShape{Std: data, Default: data, Raw: data, URL: data}
+		out := &blobShape{}
+		unmarshalXML(t, out, buildXML(t, in))
+
+		assert.Equal(t, data, out.Std)
+		assert.Equal(t, data, out.Default)
+		assert.Equal(t, data, out.Raw)
+		assert.Equal(t, data, out.URL)
+	}
+}
+
+func TestBlobEncodingVariants(t *testing.T) {
+	data := []byte{0xfb, 0xff}
+	out := buildXML(t, &blobShape{Std: data, Raw: data, URL: data})
+
+	assert.Contains(t, out, `<Std>+/8=</Std>`)
+	assert.Contains(t, out, `<Raw>+/8</Raw>`)
+	assert.Contains(t, out, `<URL>-_8=</URL>`)
+}
+
+type timestampOutputShape struct {
+	Default *time.Time `type:"timestamp"`
+	ISO8601 *time.Time `type:"timestamp" timestampFormat:"iso8601"`
+	RFC822  *time.Time `type:"timestamp" timestampFormat:"rfc822"`
+	Unix    *time.Time `type:"timestamp" timestampFormat:"unixTimestamp"`
+}
+
+func TestUnmarshalTimestampFormats(t *testing.T) {
+	cases := []struct {
+		body     string
+		expected time.Time
+	}{
+		{`<Output><Default>2015-01-02T15:04:05Z</Default></Output>`, time.Date(2015, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{`<Output><Default>2015-01-02T15:04:05.123Z</Default></Output>`, time.Date(2015, 1, 2, 15, 4, 5, 123000000, time.UTC)},
+		{`<Output><Default>2015-01-02T07:04:05-08:00</Default></Output>`, time.Date(2015, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{`<Output><ISO8601>2015-01-02T15:04:05.5Z</ISO8601></Output>`, time.Date(2015, 1, 2, 15, 4, 5, 500000000, time.UTC)},
+		{`<Output><RFC822>Fri, 2 Jan 2015 15:04:05 GMT</RFC822></Output>`, time.Date(2015, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{`<Output><Unix>1420211045</Unix></Output>`, time.Date(2015, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{`<Output><Unix>1420211045.25</Unix></Output>`, time.Date(2015, 1, 2, 15, 4, 5, 250000000, time.UTC)},
+	}
+
+	for _, c := range cases {
+		out := &timestampOutputShape{}
+		unmarshalXML(t, out, c.body)
+
+		var actual *time.Time
+		for _, v := range []*time.Time{out.Default, out.ISO8601, out.RFC822, out.Unix} {
+			if v != nil {
+				actual = v
+			}
+		}
+		if assert.NotNil(t, actual, c.body) {
+			assert.Equal(t, c.expected, *actual, c.body)
+			assert.Equal(t, time.UTC, actual.Location(), c.body)
+		}
+	}
+}
+
+func TestUnmarshalTimestampInvalid(t *testing.T) {
+	out := &timestampOutputShape{}
+	err := xmlutil.UnmarshalXML(out, xml.NewDecoder(bytes.NewReader([]byte(
+		`<Output><Default>2015-01-02 15:04:05</Default></Output>`))), "")
+	assert.Error(t, err)
+}
+
+func TestUnmarshalAttributesOnlyStruct(t *testing.T) {
+	out := &attributeStructShape{}
+	unmarshalXML(t, out, `<Output><Attrs name="foo" size="123" enabled="true" ratio="1.5" data="YmFy" created="1422172800"/></Output>`)
+
+	assert.Equal(t, "foo", *out.Attrs.Name)
+	assert.Equal(t, int64(123), *out.Attrs.Size)
+	assert.Equal(t, true, *out.Attrs.Enabled)
+	assert.Equal(t, 1.5, *out.Attrs.Ratio)
+	assert.Equal(t, []byte("bar"), out.Attrs.Data)
+	assert.Equal(t, time.Unix(1422172800, 0).UTC(), *out.Attrs.Created)
+	assert.Nil(t, out.Mixed)
+}
+
+func TestUnmarshalMixedAttributeStruct(t *testing.T) {
+	out := &attributeStructShape{}
+	unmarshalXML(t, out, `<Output><Mixed id="abc" version="2"><Value>text</Value></Mixed><Attrs name="foo"/></Output>`)
+
+	assert.Equal(t, "abc", *out.Mixed.ID)
+	assert.Equal(t, int64(2), *out.Mixed.Version)
+	assert.Equal(t, "text", *out.Mixed.Value)
+	assert.Equal(t, "foo", *out.Attrs.Name)
+	assert.Nil(t, out.Attrs.Size)
+}
+
+func TestAttributeStructRoundTrip(t *testing.T) {
+	in := &attributeStructShape{
+		Attrs: &attributeShape{Name: aws.String("foo"), Size: aws.Long(1)},
+		Mixed: &mixedAttributeShape{ID: aws.String("abc"), Value: aws.String("text")},
+	}
+	var buf bytes.Buffer
+	assert.NoError(t, xmlutil.BuildXML(in, xml.NewEncoder(&buf)))
+
+	out := &attributeStructShape{}
+	unmarshalXML(t, out, buf.String())
+	assert.Equal(t, "foo", *out.Attrs.Name)
+	assert.Equal(t, int64(1), *out.Attrs.Size)
+	assert.Equal(t, "abc", *out.Mixed.ID)
+	assert.Equal(t, "text", *out.Mixed.Value)
+	assert.Nil(t, out.Mixed.Version)
+}
+
+type commentOutputShape struct {
+	Name  *string `type:"string"`
+	Count *int64  `type:"integer"`
+
+	metadataCommentOutputShape `json:"-" xml:"-"`
+}
+
+type metadataCommentOutputShape struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+func TestUnmarshalSkipsCommentsAndProcInsts(t *testing.T) {
+	out := &commentOutputShape{}
+	unmarshalXML(t, out, `<?xml version="1.0" encoding="UTF-8"?>`+
+		`<!-- inserted by a proxy -->`+
+		`<!DOCTYPE Output>`+
+		`<Output><?processing instruction?>`+
+		`<!-- before --><Name>foo<!-- within -->bar</Name>`+
+		`<Count><?pi?>123</Count></Output>`)
+
+	assert.Equal(t, "foobar", *out.Name)
+	assert.Equal(t, int64(123), *out.Count)
+}
+
+type listOutputShape struct {
+	Items []*string `type:"list"`
+
+	metadataListOutputShape `json:"-" xml:"-"`
+}
+
+type metadataListOutputShape struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+func TestUnmarshalUnwrappedList(t *testing.T) {
+	body := `<Output><Items>a</Items><Items>b</Items></Output>`
+
+	out := &listOutputShape{}
+	unmarshalXML(t, out, body)
+	assert.Nil(t, out.Items)
+
+	out = &listOutputShape{}
+	err := xmlutil.UnmarshalUnwrappedListsXML(out, xml.NewDecoder(bytes.NewReader([]byte(body))), "")
+	assert.NoError(t, err)
+	assert.Equal(t, []*string{aws.String("a"), aws.String("b")}, out.Items)
+}
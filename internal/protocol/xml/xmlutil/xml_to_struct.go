@@ -11,6 +11,9 @@ type XMLNode struct {
 	Children map[string][]*XMLNode `json:",omitempty"`
 	Text     string                `json:",omitempty"`
 	Attr     []xml.Attr            `json:",omitempty"`
+
+	// names of children in the order they were first added
+	order []string
 }
 
 func NewXMLElement(name xml.Name) *XMLNode {
@@ -24,6 +27,7 @@ func NewXMLElement(name xml.Name) *XMLNode {
 func (n *XMLNode) AddChild(child *XMLNode) {
 	if _, ok := n.Children[child.Name.Local]; !ok {
 		n.Children[child.Name.Local] = []*XMLNode{}
+		n.order = append(n.order, child.Name.Local)
 	}
 	n.Children[child.Name.Local] = append(n.Children[child.Name.Local], child)
 }
@@ -41,26 +45,24 @@ func XMLToStruct(d *xml.Decoder, s *xml.StartElement) (*XMLNode, error) {
 
 		switch typed := tok.(type) {
 		case xml.CharData:
-			out.Text = string(typed.Copy())
+			// text split by a comment or processing instruction is joined
+			out.Text += string(typed.Copy())
+		case xml.Comment, xml.ProcInst, xml.Directive:
+			// skipped, such as comments inserted by a proxy or the
+			// document's <?xml?> declaration
 		case xml.StartElement:
 			el := typed.Copy()
-			out.Attr = el.Attr
 			if out.Children == nil {
 				out.Children = map[string][]*XMLNode{}
 			}
 
-			name := typed.Name.Local
-			slice := out.Children[name]
-			if slice == nil {
-				slice = []*XMLNode{}
-			}
 			node, e := XMLToStruct(d, &el)
 			if e != nil {
 				return out, e
 			}
 			node.Name = typed.Name
-			slice = append(slice, node)
-			out.Children[name] = slice
+			node.Attr = el.Attr // attributes belong to the element, not its parent
+			out.AddChild(node)
 		case xml.EndElement:
 			if s != nil && s.Name.Local == typed.Name.Local { // matching end token
 				return out, nil
@@ -71,7 +73,7 @@ func XMLToStruct(d *xml.Decoder, s *xml.StartElement) (*XMLNode, error) {
 }
 
 func StructToXML(e *xml.Encoder, node *XMLNode, sorted bool) error {
-	e.EncodeToken(xml.StartElement{Name: node.Name, Attr: node.Attr})
+	e.EncodeToken(xml.StartElement{Name: node.Name, Attr: node.attrs()})
 
 	if node.Text != "" {
 		e.EncodeToken(xml.CharData([]byte(node.Text)))
@@ -88,8 +90,8 @@ func StructToXML(e *xml.Encoder, node *XMLNode, sorted bool) error {
 			}
 		}
 	} else {
-		for _, c := range node.Children {
-			for _, v := range c {
+		for _, k := range node.childNames() {
+			for _, v := range node.Children[k] {
 				StructToXML(e, v, sorted)
 			}
 		}
@@ -98,3 +100,36 @@ func StructToXML(e *xml.Encoder, node *XMLNode, sorted bool) error {
 	e.EncodeToken(xml.EndElement{Name: node.Name})
 	return e.Flush()
 }
+
+// childNames returns the names of the node's children in the order they were
+// added, falling back to map order for nodes whose children were not
+// populated through AddChild.
+func (n *XMLNode) childNames() []string {
+	if len(n.order) == len(n.Children) {
+		return n.order
+	}
+
+	names := []string{}
+	for k := range n.Children {
+		names = append(names, k)
+	}
+	return names
+}
+
+// attrs returns the node's attributes to encode. The encoder declares the
+// namespace of a decoded element's name itself, so a matching default
+// namespace attribute is dropped rather than declared twice.
+func (n *XMLNode) attrs() []xml.Attr {
+	if n.Name.Space == "" {
+		return n.Attr
+	}
+
+	attrs := []xml.Attr{}
+	for _, a := range n.Attr {
+		if a.Name.Space == "" && a.Name.Local == "xmlns" && a.Value == n.Name.Space {
+			continue
+		}
+		attrs = append(attrs, a)
+	}
+	return attrs
+}
@@ -0,0 +1,154 @@
+// Package v2 signs requests with signature version 2, which some legacy
+// endpoints, such as those of older query services and S3 compatible stores,
+// still require.
+//
+// A service signs with version 4 by default. To use version 2 instead, swap
+// its signing handler:
+//
+//	svc.Handlers.Sign.Remove(v4.SignRequestHandler)
+//	svc.Handlers.Sign.PushBackNamed(v2.SignRequestHandler)
+package v2
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+)
+
+const (
+	signatureVersion = "2"
+	signatureMethod  = "HmacSHA256"
+	timeFormat       = "2006-01-02T15:04:05Z"
+)
+
+type signer struct {
+	Request         *http.Request
+	Time            time.Time
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	Query           url.Values
+	Debug           aws.LogLevelType
+	Logger          aws.Logger
+
+	stringToSign string
+	signature    string
+}
+
+// SignRequestHandler is a named request handler which signs requests with
+// signature version 2.
+var SignRequestHandler = aws.NamedHandler{Name: "v2.SignRequestHandler", Fn: Sign}
+
+// Sign requests with signature version 2. The signature and the parameters
+// it covers are added to the form body of POST requests, and to the query
+// string of all others, the Content-Length of a POST being that of the
+// signed form. Requests of operations whose AuthType is
+// aws.AuthTypeNone are left unsigned.
+func Sign(req *aws.Request) {
+	if req.Operation.AuthType == aws.AuthTypeNone {
+		return
+	}
+
+	creds, err := req.Service.Config.Credentials.Credentials()
+	if err != nil {
+		req.Error = err
+		return
+	}
+
+	s := &signer{
+		Request:         req.HTTPRequest,
+		Time:            req.Time,
+		AccessKeyID:     creds.AccessKeyID,
+		SecretAccessKey: creds.SecretAccessKey,
+		SessionToken:    creds.SessionToken,
+		Debug:           req.Service.Config.LogLevel,
+		Logger:          req.Service.Config.Logger,
+	}
+
+	post := req.HTTPRequest.Method == "POST" && req.Body != nil
+	if post {
+		// the form is read from where the body starts, which is its offset
+		// when first signed, or that ResetBody rewinds it to for a retry
+		b, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			req.Error = err
+			return
+		}
+		if s.Query, err = url.ParseQuery(string(b)); err != nil {
+			req.Error = err
+			return
+		}
+	} else {
+		s.Query = req.HTTPRequest.URL.Query()
+	}
+
+	s.sign()
+
+	if post {
+		body := []byte(s.Query.Encode())
+		req.SetBufferBody(body)
+		req.HTTPRequest.ContentLength = int64(len(body))
+		req.HTTPRequest.Header.Set("Content-Length", strconv.Itoa(len(body)))
+	} else {
+		req.HTTPRequest.URL.RawQuery = s.Query.Encode()
+	}
+}
+
+func (v2 *signer) sign() {
+	v2.Query.Del("Signature") // a resigned request replaces its signature
+	v2.Query.Set("AWSAccessKeyId", v2.AccessKeyID)
+	v2.Query.Set("SignatureVersion", signatureVersion)
+	v2.Query.Set("SignatureMethod", signatureMethod)
+	v2.Query.Set("Timestamp", v2.Time.UTC().Format(timeFormat))
+	if v2.SessionToken != "" {
+		v2.Query.Set("SecurityToken", v2.SessionToken)
+	} else {
+		v2.Query.Del("SecurityToken")
+	}
+
+	v2.buildStringToSign()
+	v2.buildSignature()
+	v2.Query.Set("Signature", v2.signature)
+
+	if v2.Debug.Matches(aws.LogDebugWithSigning) && v2.Logger != nil {
+		v2.Logger.Log(fmt.Sprintf("DEBUG: Request Signature:\n"+
+			"---[ STRING TO SIGN ]--------------------------------\n%s\n"+
+			"-----------------------------------------------------",
+			v2.stringToSign))
+	}
+}
+
+func (v2 *signer) buildStringToSign() {
+	path := v2.Request.URL.Path
+	if path == "" {
+		path = "/"
+	}
+
+	v2.stringToSign = strings.Join([]string{
+		v2.Request.Method,
+		strings.ToLower(v2.Request.URL.Host),
+		path,
+		canonicalQuery(v2.Query),
+	}, "\n")
+}
+
+func (v2 *signer) buildSignature() {
+	hash := hmac.New(sha256.New, []byte(v2.SecretAccessKey))
+	hash.Write([]byte(v2.stringToSign))
+	v2.signature = base64.StdEncoding.EncodeToString(hash.Sum(nil))
+}
+
+// canonicalQuery returns the parameters sorted by name and encoded as RFC
+// 3986 requires, escaping spaces as %20 rather than +.
+func canonicalQuery(query url.Values) string {
+	return strings.Replace(query.Encode(), "+", "%20", -1)
+}
@@ -0,0 +1,136 @@
+package v2
+
+import (
+	"io/ioutil"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/internal/signer/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildRequest(method, endpoint, sessionToken string, params url.Values) *aws.Request {
+	svc := aws.NewService(&aws.Config{
+		Credentials: aws.Creds("AKID", "SECRET", sessionToken),
+		Region:      "us-east-1",
+		Endpoint:    endpoint,
+	})
+	svc.Handlers.Sign.PushBackNamed(SignRequestHandler)
+	svc.Handlers.Build.PushBack(func(r *aws.Request) {
+		if method == "POST" {
+			r.SetBufferBody([]byte(params.Encode()))
+		} else {
+			r.HTTPRequest.URL.RawQuery = params.Encode()
+		}
+	})
+
+	req := aws.NewRequest(svc, &aws.Operation{Name: "Operation", HTTPMethod: method, HTTPPath: "/"}, nil, nil)
+	req.Time = time.Unix(0, 0)
+	return req
+}
+
+func formBody(t *testing.T, req *aws.Request) url.Values {
+	req.Body.Seek(0, 0)
+	b, err := ioutil.ReadAll(req.Body)
+	assert.NoError(t, err)
+	v, err := url.ParseQuery(string(b))
+	assert.NoError(t, err)
+	return v
+}
+
+func TestSignPOSTFormBody(t *testing.T) {
+	req := buildRequest("POST", "https://sqs.us-east-1.amazonaws.com", "", url.Values{
+		"Action":          {"ListQueues"},
+		"Version":         {"2012-11-05"},
+		"QueueNamePrefix": {"my queue*~"},
+	})
+	req.Sign()
+	assert.NoError(t, req.Error)
+
+	body := formBody(t, req)
+	assert.Equal(t, "AKID", body.Get("AWSAccessKeyId"))
+	assert.Equal(t, "2", body.Get("SignatureVersion"))
+	assert.Equal(t, "HmacSHA256", body.Get("SignatureMethod"))
+	assert.Equal(t, "1970-01-01T00:00:00Z", body.Get("Timestamp"))
+	assert.Equal(t, "", body.Get("SecurityToken"))
+	assert.Equal(t, "Yrq3/EfNDruTjcTdMcW+p2xb9XzI+3Pz7o+5m1KBadc=", body.Get("Signature"))
+	assert.Equal(t, "", req.HTTPRequest.URL.RawQuery)
+}
+
+func TestSignPOSTContentLength(t *testing.T) {
+	req := buildRequest("POST", "https://sqs.us-east-1.amazonaws.com", "", url.Values{"Action": {"ListQueues"}})
+	req.Sign()
+	assert.NoError(t, req.Error)
+
+	b, err := ioutil.ReadAll(req.HTTPRequest.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(b)), req.HTTPRequest.ContentLength)
+	assert.Equal(t, strconv.Itoa(len(b)), req.HTTPRequest.Header.Get("Content-Length"))
+}
+
+func TestSignPOSTBodyOffset(t *testing.T) {
+	req := buildRequest("POST", "https://sqs.us-east-1.amazonaws.com", "", nil)
+	assert.NoError(t, req.Build())
+	body := strings.NewReader("ignored&Action=ListQueues")
+	body.Seek(int64(len("ignored&")), 0)
+	req.SetReaderBody(body)
+	req.Sign()
+	assert.NoError(t, req.Error)
+
+	form := formBody(t, req)
+	assert.Equal(t, "ListQueues", form.Get("Action"))
+	_, ok := form["ignored"]
+	assert.False(t, ok)
+}
+
+func TestSignGETQueryString(t *testing.T) {
+	req := buildRequest("GET", "https://SDB.amazonaws.com", "SESSION", url.Values{
+		"Action":          {"ListQueues"},
+		"Version":         {"2012-11-05"},
+		"QueueNamePrefix": {"my queue*~"},
+	})
+	req.Sign()
+	assert.NoError(t, req.Error)
+
+	query := req.HTTPRequest.URL.Query()
+	assert.Equal(t, "SESSION", query.Get("SecurityToken"))
+	assert.Equal(t, "ofhgYpUiB8A8PBFbuzWt4CrFAITTW1tAKoJ9DH+s1Ds=", query.Get("Signature"))
+}
+
+func TestCanonicalQuery(t *testing.T) {
+	s := &signer{Query: url.Values{"b": {"2 3"}, "a": {"1+~*"}}}
+	assert.Equal(t, "a=1%2B~%2A&b=2%203", canonicalQuery(s.Query))
+}
+
+func TestResign(t *testing.T) {
+	req := buildRequest("POST", "https://sqs.us-east-1.amazonaws.com", "", url.Values{"Action": {"ListQueues"}})
+	req.Sign()
+	first := formBody(t, req).Get("Signature")
+
+	req.Time = time.Unix(60, 0)
+	assert.NoError(t, req.ResetBody())
+	req.Sign()
+	body := formBody(t, req)
+	assert.Equal(t, 1, len(body["Signature"]))
+	assert.Equal(t, "1970-01-01T00:01:00Z", body.Get("Timestamp"))
+	assert.NotEqual(t, first, body.Get("Signature"))
+}
+
+func TestSwapSigner(t *testing.T) {
+	svc := aws.NewService(&aws.Config{Credentials: aws.Creds("AKID", "SECRET", ""), Region: "us-east-1"})
+	svc.ServiceName = "sdb"
+	svc.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
+
+	svc.Handlers.Sign.Remove(v4.SignRequestHandler)
+	svc.Handlers.Sign.PushBackNamed(SignRequestHandler)
+
+	req := aws.NewRequest(svc, &aws.Operation{Name: "ListDomains", HTTPMethod: "GET", HTTPPath: "/"}, nil, nil)
+	req.Sign()
+	assert.NoError(t, req.Error)
+	assert.Equal(t, "", req.HTTPRequest.Header.Get("Authorization"))
+	assert.NotEqual(t, "", req.HTTPRequest.URL.Query().Get("Signature"))
+}
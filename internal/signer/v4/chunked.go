@@ -0,0 +1,98 @@
+package v4
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// emptyStringSHA256 is the hex encoded SHA256 digest of an empty string.
+var emptyStringSHA256 = hex.EncodeToString(makeSha256([]byte{}))
+
+// chunkedContentLength returns the encoded length of a body of the given
+// length when sent as signed chunks of chunkSize bytes, including the final
+// zero length chunk.
+func chunkedContentLength(length, chunkSize int64) int64 {
+	total := int64(0)
+	for length > 0 {
+		n := chunkSize
+		if length < n {
+			n = length
+		}
+		total += chunkEncodedLength(n)
+		length -= n
+	}
+	return total + chunkEncodedLength(0)
+}
+
+// chunkEncodedLength returns the length of a single chunk carrying n bytes:
+// hex(n);chunk-signature=<64 hex chars>\r\n<data>\r\n
+func chunkEncodedLength(n int64) int64 {
+	header := len(strconv.FormatInt(n, 16)) + len(";chunk-signature=") + 64 + 2
+	return int64(header) + n + 2
+}
+
+// A chunkedReader encodes a body as a series of signed chunks. The signature
+// of each chunk covers its data and the previous chunk's signature, starting
+// with the signature of the request itself.
+type chunkedReader struct {
+	body      io.Reader
+	chunkSize int
+	key       []byte
+	time      string
+	scope     string
+	prevSig   string
+
+	buf  bytes.Buffer
+	done bool
+}
+
+func (c *chunkedReader) Read(p []byte) (int, error) {
+	for c.buf.Len() == 0 && !c.done {
+		if err := c.nextChunk(); err != nil {
+			return 0, err
+		}
+	}
+	if c.buf.Len() == 0 {
+		return 0, io.EOF
+	}
+	return c.buf.Read(p)
+}
+
+// nextChunk reads the next chunk of the body into the buffer. Once the body
+// is exhausted the final zero length chunk is written.
+func (c *chunkedReader) nextChunk() error {
+	data := make([]byte, c.chunkSize)
+	n, err := io.ReadFull(c.body, data)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return err
+	}
+
+	if n > 0 {
+		c.writeChunk(data[:n])
+	}
+	if n < c.chunkSize {
+		c.writeChunk([]byte{})
+		c.done = true
+	}
+	return nil
+}
+
+func (c *chunkedReader) writeChunk(data []byte) {
+	stringToSign := strings.Join([]string{
+		chunkAlgorithm,
+		c.time,
+		c.scope,
+		c.prevSig,
+		emptyStringSHA256,
+		hex.EncodeToString(makeSha256(data)),
+	}, "\n")
+	c.prevSig = hex.EncodeToString(makeHmac(c.key, []byte(stringToSign)))
+
+	c.buf.WriteString(strconv.FormatInt(int64(len(data)), 16))
+	c.buf.WriteString(";chunk-signature=" + c.prevSig + "\r\n")
+	c.buf.Write(data)
+	c.buf.WriteString("\r\n")
+}
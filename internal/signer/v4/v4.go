@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"sort"
@@ -20,8 +21,19 @@ const (
 	authHeaderPrefix = "AWS4-HMAC-SHA256"
 	timeFormat       = "20060102T150405Z"
 	shortTimeFormat  = "20060102"
+
+	// streamingPayload is the payload hash of a chunk signed request body.
+	streamingPayload = "STREAMING-AWS4-HMAC-SHA256-PAYLOAD"
+	// chunkAlgorithm prefixes the string to sign of each body chunk.
+	chunkAlgorithm = "AWS4-HMAC-SHA256-PAYLOAD"
+	// unsignedPayload is the payload hash of a request whose body is not
+	// signed.
+	unsignedPayload = "UNSIGNED-PAYLOAD"
 )
 
+// DefaultChunkSize is the size of the body chunks used by SignChunked.
+const DefaultChunkSize = 64 * 1024
+
 var ignoredHeaders = map[string]bool{
 	"Authorization":  true,
 	"Content-Type":   true,
@@ -40,8 +52,10 @@ type signer struct {
 	SessionToken    string
 	Query           url.Values
 	Body            io.ReadSeeker
-	Debug           uint
-	Logger          io.Writer
+	Debug           aws.LogLevelType
+	Logger          aws.Logger
+	ChunkSize       int
+	UnsignedPayload bool
 
 	isPresign          bool
 	formattedTime      string
@@ -52,34 +66,84 @@ type signer struct {
 	canonicalString  string
 	credentialString string
 	stringToSign     string
+	signingKey       []byte
 	signature        string
 	authorization    string
 }
 
-// Sign requests with signature version 4.
+// SignRequestHandler is a named request handler which signs requests with
+// signature version 4.
+var SignRequestHandler = aws.NamedHandler{Name: "v4.SignRequestHandler", Fn: Sign}
+
+// Sign requests with signature version 4. Requests of operations whose
+// AuthType is aws.AuthTypeNone are left unsigned.
 func Sign(req *aws.Request) {
-	creds, err := req.Service.Config.Credentials.Credentials()
+	if req.Operation.AuthType == aws.AuthTypeNone {
+		return
+	}
+
+	s, err := newSigner(req)
 	if err != nil {
 		req.Error = err
 		return
 	}
+	s.sign()
+	return
+}
+
+// SignChunked signs requests with signature version 4, signing the request
+// body in chunks of DefaultChunkSize bytes as it is sent rather than hashing
+// the whole payload up front. This is supported by S3 for streaming uploads.
+func SignChunked(req *aws.Request) {
+	if req.Operation.AuthType == aws.AuthTypeNone {
+		return
+	}
+
+	s, err := newSigner(req)
+	if err != nil {
+		req.Error = err
+		return
+	}
+	if !s.UnsignedPayload { // an unsigned payload needs no chunk signatures
+		s.ChunkSize = DefaultChunkSize
+	}
+	s.sign()
+	if s.ChunkSize > 0 && !s.isPresign && s.Body != nil {
+		req.HTTPRequest.Body = ioutil.NopCloser(s.chunkedBody())
+		// the body rewound for a retry is encoded again by signing it again
+		req.SetResignRetries(true)
+	}
+	return
+}
+
+func newSigner(req *aws.Request) (*signer, error) {
+	creds, err := req.Service.Config.Credentials.Credentials()
+	if err != nil {
+		return nil, err
+	}
 
-	s := signer{
+	region := req.Service.SigningRegion
+	if region == "" {
+		region = req.Service.Config.Region
+	}
+
+	return &signer{
 		Request:         req.HTTPRequest,
 		Time:            req.Time,
 		ExpireTime:      req.ExpireTime,
 		Query:           req.HTTPRequest.URL.Query(),
 		Body:            req.Body,
 		ServiceName:     req.Service.ServiceName,
-		Region:          req.Service.Config.Region,
+		Region:          region,
 		AccessKeyID:     creds.AccessKeyID,
 		SecretAccessKey: creds.SecretAccessKey,
 		SessionToken:    creds.SessionToken,
 		Debug:           req.Service.Config.LogLevel,
 		Logger:          req.Service.Config.Logger,
-	}
-	s.sign()
-	return
+		UnsignedPayload: req.Operation.AuthType == aws.AuthTypeV4UnsignedBody ||
+			req.Service.Config.S3UnsignedPayload &&
+				req.Service.ServiceName == "s3" && req.HTTPRequest.URL.Scheme == "https",
+	}, nil
 }
 
 func (v4 *signer) sign() {
@@ -98,17 +162,19 @@ func (v4 *signer) sign() {
 		v4.Request.Header.Set("X-Amz-Security-Token", v4.SessionToken)
 	}
 
+	if v4.ChunkSize > 0 && !v4.isPresign && v4.Body != nil {
+		v4.buildChunkedHeaders()
+	}
+
 	v4.build()
 
-	if v4.Debug > 0 {
-		out := v4.Logger
-		fmt.Fprintf(out, "---[ CANONICAL STRING  ]-----------------------------\n")
-		fmt.Fprintln(out, v4.canonicalString)
-		fmt.Fprintf(out, "---[ STRING TO SIGN ]--------------------------------\n")
-		fmt.Fprintln(out, v4.stringToSign)
-		fmt.Fprintf(out, "---[ SIGNED URL ]--------------------------------\n")
-		fmt.Fprintln(out, v4.Request.URL)
-		fmt.Fprintf(out, "-----------------------------------------------------\n")
+	if v4.Debug.Matches(aws.LogDebugWithSigning) && v4.Logger != nil {
+		v4.Logger.Log(fmt.Sprintf("DEBUG: Request Signature:\n"+
+			"---[ CANONICAL STRING  ]-----------------------------\n%s\n"+
+			"---[ STRING TO SIGN ]--------------------------------\n%s\n"+
+			"---[ SIGNED URL ]------------------------------------\n%s\n"+
+			"-----------------------------------------------------",
+			v4.canonicalString, v4.stringToSign, v4.Request.URL))
 	}
 }
 
@@ -244,16 +310,52 @@ func (v4 *signer) buildSignature() {
 	date := makeHmac([]byte("AWS4"+secret), []byte(v4.formattedShortTime))
 	region := makeHmac(date, []byte(v4.Region))
 	service := makeHmac(region, []byte(v4.ServiceName))
-	credentials := makeHmac(service, []byte("aws4_request"))
-	signature := makeHmac(credentials, []byte(v4.stringToSign))
+	v4.signingKey = makeHmac(service, []byte("aws4_request"))
+	signature := makeHmac(v4.signingKey, []byte(v4.stringToSign))
 	v4.signature = hex.EncodeToString(signature)
 }
 
+// buildChunkedHeaders sets the headers describing a chunk signed body. The
+// decoded content length is signed, while the encoded Content-Length is not.
+// A request signed again for a retry keeps the decoded length of its first
+// signing, as its ContentLength is then already the encoded one.
+func (v4 *signer) buildChunkedHeaders() {
+	length := v4.Request.ContentLength
+	if decoded, err := strconv.ParseInt(v4.Request.Header.Get("X-Amz-Decoded-Content-Length"), 10, 64); err == nil {
+		length = decoded
+	}
+	v4.Request.Header.Set("X-Amz-Content-Sha256", streamingPayload)
+	v4.Request.Header.Set("X-Amz-Decoded-Content-Length", strconv.FormatInt(length, 10))
+	if enc := v4.Request.Header.Get("Content-Encoding"); enc == "" {
+		v4.Request.Header.Set("Content-Encoding", "aws-chunked")
+	} else if !strings.Contains(enc, "aws-chunked") {
+		v4.Request.Header.Set("Content-Encoding", "aws-chunked,"+enc)
+	}
+
+	encoded := chunkedContentLength(length, int64(v4.ChunkSize))
+	v4.Request.ContentLength = encoded
+	v4.Request.Header.Set("Content-Length", strconv.FormatInt(encoded, 10))
+}
+
+// chunkedBody returns a reader which encodes the request body from its
+// current offset, where its length was measured, as a series of signed
+// chunks, each chained to the signature of the previous one.
+func (v4 *signer) chunkedBody() io.Reader {
+	return &chunkedReader{
+		body:      v4.Body,
+		chunkSize: v4.ChunkSize,
+		key:       v4.signingKey,
+		time:      v4.formattedTime,
+		scope:     v4.credentialString,
+		prevSig:   v4.signature,
+	}
+}
+
 func (v4 *signer) bodyDigest() string {
 	hash := v4.Request.Header.Get("X-Amz-Content-Sha256")
 	if hash == "" {
-		if v4.isPresign && v4.ServiceName == "s3" {
-			hash = "UNSIGNED-PAYLOAD"
+		if (v4.isPresign && v4.ServiceName == "s3") || v4.UnsignedPayload {
+			hash = unsignedPayload
 		} else if v4.Body == nil {
 			hash = hex.EncodeToString(makeSha256([]byte{}))
 		} else {
@@ -1,11 +1,15 @@
 package v4
 
 import (
+	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/awslabs/aws-sdk-go/aws"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -87,3 +91,349 @@ func BenchmarkSignRequest(b *testing.B) {
 		signer.sign()
 	}
 }
+
+func buildRequest(serviceName, region string) *aws.Request {
+	svc := aws.NewService(&aws.Config{
+		Credentials: aws.Creds("AKID", "SECRET", "SESSION"),
+		Region:      region,
+	})
+	svc.ServiceName = serviceName
+	svc.Endpoint = "https://" + serviceName + "." + region + ".amazonaws.com"
+	svc.Handlers.Sign.PushBack(Sign)
+
+	op := &aws.Operation{Name: "GetObject", HTTPMethod: "GET", HTTPPath: "/bucket/key"}
+	req := aws.NewRequest(svc, op, nil, nil)
+	req.Time = time.Unix(0, 0)
+	return req
+}
+
+func TestPresignURL(t *testing.T) {
+	req := buildRequest("s3", "us-east-1")
+	urlstr, err := req.Presign(300 * time.Second)
+	assert.NoError(t, err)
+
+	u, err := url.Parse(urlstr)
+	assert.NoError(t, err)
+	q := u.Query()
+	assert.Equal(t, "/bucket/key", u.Path)
+	assert.Equal(t, "AWS4-HMAC-SHA256", q.Get("X-Amz-Algorithm"))
+	assert.Equal(t, "300", q.Get("X-Amz-Expires"))
+	assert.Equal(t, "19700101T000000Z", q.Get("X-Amz-Date"))
+	assert.Equal(t, "AKID/19700101/us-east-1/s3/aws4_request", q.Get("X-Amz-Credential"))
+	assert.Equal(t, "SESSION", q.Get("X-Amz-Security-Token"))
+	assert.Regexp(t, `^[0-9a-f]{64}$`, q.Get("X-Amz-Signature"))
+	assert.Equal(t, "", req.HTTPRequest.Header.Get("Authorization"))
+}
+
+func TestPresignURLInvalidExpiry(t *testing.T) {
+	for _, expire := range []time.Duration{0, -time.Second, 500 * time.Millisecond, 7*24*time.Hour + time.Second} {
+		req := buildRequest("s3", "us-east-1")
+		urlstr, err := req.Presign(expire)
+		apiErr := aws.Error(err)
+		assert.NotNil(t, apiErr)
+		assert.Equal(t, "InvalidPresignExpire", apiErr.Code)
+		assert.Equal(t, "", urlstr)
+	}
+
+	for _, expire := range []time.Duration{time.Second, 7 * 24 * time.Hour} {
+		req := buildRequest("s3", "us-east-1")
+		_, err := req.Presign(expire)
+		assert.NoError(t, err)
+	}
+}
+
+func TestSignSessionToken(t *testing.T) {
+	req := buildRequest("s3", "us-east-1")
+	req.Sign()
+	assert.NoError(t, req.Error)
+	assert.Equal(t, "SESSION", req.HTTPRequest.Header.Get("X-Amz-Security-Token"))
+	assert.Contains(t, req.HTTPRequest.Header.Get("Authorization"), "x-amz-security-token")
+
+	req = buildRequest("s3", "us-east-1")
+	req.Service.Config.Credentials = aws.Creds("AKID", "SECRET", "")
+	req.Sign()
+	assert.NoError(t, req.Error)
+	assert.Equal(t, "", req.HTTPRequest.Header.Get("X-Amz-Security-Token"))
+	assert.NotContains(t, req.HTTPRequest.Header.Get("Authorization"), "x-amz-security-token")
+}
+
+func TestSignHeadersAddedInBuild(t *testing.T) {
+	req := buildRequest("dynamodb", "us-east-1")
+	req.Handlers.Build.PushBack(func(r *aws.Request) {
+		r.HTTPRequest.Header.Set("X-Trace-Id", "trace")
+	})
+	req.Sign()
+	assert.NoError(t, req.Error)
+
+	auth := req.HTTPRequest.Header.Get("Authorization")
+	signed := auth[strings.Index(auth, "SignedHeaders=")+len("SignedHeaders="):]
+	signed = signed[:strings.Index(signed, ",")]
+	assert.Contains(t, strings.Split(signed, ";"), "x-trace-id")
+}
+
+func TestSignSetHeaderAndQueryParam(t *testing.T) {
+	req := buildRequest("dynamodb", "us-east-1")
+	req.SetHeader("X-Trace-Id", "trace")
+	req.AddQueryParam("tag", "a b")
+	req.Sign()
+	assert.NoError(t, req.Error)
+
+	auth := req.HTTPRequest.Header.Get("Authorization")
+	assert.Contains(t, auth, "SignedHeaders=host;x-amz-date;x-amz-security-token;x-trace-id,")
+	assert.Equal(t, "tag=a+b", req.HTTPRequest.URL.RawQuery)
+	assert.Contains(t, req.HTTPRequest.URL.String(), "/bucket/key?tag=a+b")
+
+	unsigned := buildRequest("dynamodb", "us-east-1")
+	unsigned.Sign()
+	assert.NotEqual(t, unsigned.HTTPRequest.Header.Get("Authorization"), auth)
+}
+
+func TestSignDisableSSL(t *testing.T) {
+	sign := func(disableSSL bool) *aws.Request {
+		svc := aws.NewService(&aws.Config{
+			Credentials: aws.Creds("AKID", "SECRET", ""),
+			Region:      "us-east-1",
+			Endpoint:    "localhost:8000",
+			DisableSSL:  disableSSL,
+		})
+		svc.ServiceName = "dynamodb"
+		svc.Handlers.Sign.PushBack(Sign)
+
+		req := aws.NewRequest(svc, &aws.Operation{Name: "ListTables", HTTPMethod: "POST", HTTPPath: "/"}, nil, nil)
+		req.Time = time.Unix(0, 0)
+		req.Sign()
+		assert.NoError(t, req.Error)
+		return req
+	}
+
+	plain, secure := sign(true), sign(false)
+	assert.Equal(t, "http", plain.HTTPRequest.URL.Scheme)
+	assert.Equal(t, "https", secure.HTTPRequest.URL.Scheme)
+	assert.Equal(t, "localhost:8000", plain.HTTPRequest.URL.Host)
+
+	// the scheme is not part of the signature, the host is
+	auth := plain.HTTPRequest.Header.Get("Authorization")
+	assert.Contains(t, auth, "SignedHeaders=host;")
+	assert.Equal(t, secure.HTTPRequest.Header.Get("Authorization"), auth)
+}
+
+func TestSignUnsignedPayload(t *testing.T) {
+	signer := buildSigner("s3", "us-east-1", time.Unix(0, 0), 0, "large body")
+	signer.UnsignedPayload = true
+	signer.sign()
+
+	assert.Equal(t, "UNSIGNED-PAYLOAD", signer.Request.Header.Get("X-Amz-Content-Sha256"))
+	lines := strings.Split(signer.canonicalString, "\n")
+	assert.Equal(t, "UNSIGNED-PAYLOAD", lines[len(lines)-1])
+}
+
+func TestSignUnsignedPayloadConfig(t *testing.T) {
+	sign := func(serviceName, scheme string) *aws.Request {
+		svc := aws.NewService(&aws.Config{
+			Credentials:       aws.Creds("AKID", "SECRET", ""),
+			Region:            "us-east-1",
+			S3UnsignedPayload: true,
+		})
+		svc.ServiceName = serviceName
+		svc.Endpoint = scheme + "://" + serviceName + ".us-east-1.amazonaws.com"
+		svc.Handlers.Sign.PushBack(Sign)
+
+		req := aws.NewRequest(svc, &aws.Operation{Name: "PutObject", HTTPMethod: "PUT", HTTPPath: "/bucket/key"}, nil, nil)
+		req.SetBufferBody([]byte("object data"))
+		req.Sign()
+		assert.NoError(t, req.Error)
+		return req
+	}
+
+	req := sign("s3", "https")
+	assert.Equal(t, "UNSIGNED-PAYLOAD", req.HTTPRequest.Header.Get("X-Amz-Content-Sha256"))
+
+	// sha256 of "object data"
+	hash := "05c98fa0c442debfec682dc25eb255911264c2f3b0c671c218730da7890ed940"
+	for _, req := range []*aws.Request{sign("s3", "http"), sign("dynamodb", "https")} {
+		assert.Equal(t, hash, req.HTTPRequest.Header.Get("X-Amz-Content-Sha256"))
+	}
+}
+
+func TestSignEndpointResolverSigningRegion(t *testing.T) {
+	svc := aws.NewService(&aws.Config{
+		Credentials: aws.Creds("AKID", "SECRET", ""),
+		Region:      "fips-us-gov-west-1",
+		EndpointResolver: func(service, region string) (string, string, error) {
+			return "https://dynamodb-fips.us-gov-west-1.amazonaws.com", "us-gov-west-1", nil
+		},
+	})
+	svc.ServiceName = "dynamodb"
+	svc.Handlers.Sign.PushBack(Sign)
+
+	req := aws.NewRequest(svc, &aws.Operation{Name: "ListTables", HTTPMethod: "POST", HTTPPath: "/"}, nil, nil)
+	req.Time = time.Unix(0, 0)
+	req.Sign()
+	assert.NoError(t, req.Error)
+
+	assert.Equal(t, "dynamodb-fips.us-gov-west-1.amazonaws.com", req.HTTPRequest.URL.Host)
+	assert.Contains(t, req.HTTPRequest.Header.Get("Authorization"),
+		"Credential=AKID/19700101/us-gov-west-1/dynamodb/aws4_request")
+}
+
+func TestSignChunked(t *testing.T) {
+	body := strings.Repeat("a", DefaultChunkSize+1024)
+	svc := aws.NewService(&aws.Config{
+		Credentials: aws.Creds("AKID", "SECRET", ""),
+		Region:      "us-east-1",
+	})
+	svc.ServiceName = "s3"
+	svc.Endpoint = "https://s3.amazonaws.com"
+	svc.Handlers.Sign.PushBack(SignChunked)
+
+	op := &aws.Operation{Name: "PutObject", HTTPMethod: "PUT", HTTPPath: "/bucket/key"}
+	req := aws.NewRequest(svc, op, nil, nil)
+	req.SetBufferBody([]byte(body))
+	req.Sign()
+	assert.NoError(t, req.Error)
+
+	h := req.HTTPRequest.Header
+	assert.Equal(t, "STREAMING-AWS4-HMAC-SHA256-PAYLOAD", h.Get("X-Amz-Content-Sha256"))
+	assert.Equal(t, "66560", h.Get("X-Amz-Decoded-Content-Length"))
+	assert.Equal(t, "aws-chunked", h.Get("Content-Encoding"))
+	assert.Contains(t, h.Get("Authorization"), "x-amz-decoded-content-length")
+	assert.Equal(t, int64(66824), req.HTTPRequest.ContentLength)
+
+	encoded, err := ioutil.ReadAll(req.HTTPRequest.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, 66824, len(encoded))
+	assert.True(t, strings.HasPrefix(string(encoded), "10000;chunk-signature="))
+	assert.Regexp(t, `\r\n400;chunk-signature=[0-9a-f]{64}\r\n`, string(encoded))
+	assert.Regexp(t, `\r\n0;chunk-signature=[0-9a-f]{64}\r\n\r\n$`, string(encoded))
+}
+
+// TestSignChunkedRetry sends a chunk signed request which fails its first
+// attempt, verifying the retry encodes the body again from its start.
+func TestSignChunkedRetry(t *testing.T) {
+	body := strings.Repeat("a", 100)
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		assert.Equal(t, "100", r.Header.Get("X-Amz-Decoded-Content-Length"))
+		assert.Equal(t, "aws-chunked", r.Header.Get("Content-Encoding"))
+		if len(bodies) == 1 {
+			w.WriteHeader(500)
+		}
+	}))
+	defer server.Close()
+
+	svc := aws.NewService(&aws.Config{
+		Credentials: aws.Creds("AKID", "SECRET", ""),
+		Region:      "us-east-1",
+		Endpoint:    server.URL,
+		MaxRetries:  1,
+	})
+	svc.ServiceName = "s3"
+	svc.Handlers.Sign.PushBack(SignChunked)
+
+	op := &aws.Operation{Name: "PutObject", HTTPMethod: "PUT", HTTPPath: "/bucket/key"}
+	req := aws.NewRequest(svc, op, nil, nil)
+	req.SetBufferBody([]byte(body))
+	assert.NoError(t, req.Send())
+	assert.Equal(t, uint(1), req.RetryCount)
+	assert.Equal(t, chunkedContentLength(100, DefaultChunkSize), req.HTTPRequest.ContentLength)
+
+	assert.Len(t, bodies, 2)
+	for _, b := range bodies {
+		assert.Equal(t, chunkedContentLength(100, DefaultChunkSize), int64(len(b)))
+		assert.Regexp(t, `^64;chunk-signature=[0-9a-f]{64}\r\na{100}\r\n0;chunk-signature=[0-9a-f]{64}\r\n\r\n$`, b)
+	}
+}
+
+// TestChunkedReaderSignatures verifies the chunk signatures against the
+// example of the S3 streaming upload documentation.
+func TestChunkedReaderSignatures(t *testing.T) {
+	s := &signer{
+		ServiceName:     "s3",
+		Region:          "us-east-1",
+		SecretAccessKey: "wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY",
+		ChunkSize:       DefaultChunkSize,
+		Body:            strings.NewReader(strings.Repeat("a", 66560)),
+	}
+	s.formattedTime = "20130524T000000Z"
+	s.formattedShortTime = "20130524"
+	s.credentialString = "20130524/us-east-1/s3/aws4_request"
+	s.buildSignature()
+	s.signature = "4f232c4386841ef735655705268965c44a0e4690baa4adea153f7db9fa80a0a9"
+
+	encoded, err := ioutil.ReadAll(s.chunkedBody())
+	assert.NoError(t, err)
+	assert.Equal(t, chunkedContentLength(66560, DefaultChunkSize), int64(len(encoded)))
+
+	chunks := []string{
+		"10000;chunk-signature=ad80c730a21e5b8d04586a2213dd63b9a0e99e0e2307b0ade35a65485a288648\r\n",
+		"400;chunk-signature=0055627c9e194cb4542bae2aa5492e3c1575bbb81b612b7d234b86a503ef5497\r\n",
+		"0;chunk-signature=b6c6ea8a5354eaf15b3cb7646744f4275b71ea724fed81ceb9323e279d449df9\r\n\r\n",
+	}
+	for _, c := range chunks {
+		assert.Contains(t, string(encoded), c)
+	}
+}
+
+// TestSignClockTestVector signs the get-vanilla request of the published
+// Signature Version 4 test suite at its fixed time.
+func TestSignClockTestVector(t *testing.T) {
+	svc := aws.NewService(&aws.Config{
+		Credentials: aws.Creds("AKIDEXAMPLE", "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY", ""),
+		Region:      "us-east-1",
+		Endpoint:    "https://example.amazonaws.com",
+		Clock: func() time.Time {
+			return time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC)
+		},
+	})
+	svc.ServiceName = "service"
+	svc.Handlers.Sign.PushBack(Sign)
+
+	req := aws.NewRequest(svc, &aws.Operation{Name: "GetVanilla", HTTPMethod: "GET", HTTPPath: "/"}, nil, nil)
+	assert.NoError(t, req.Sign())
+
+	assert.Equal(t, "20150830T123600Z", req.HTTPRequest.Header.Get("X-Amz-Date"))
+	assert.Equal(t, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, "+
+		"SignedHeaders=host;x-amz-date, "+
+		"Signature=5fa00fa31553b73ebf1942676e86291e8372ff2a2260956d9b8aae1d763fbf31",
+		req.HTTPRequest.Header.Get("Authorization"))
+}
+
+func TestSignAuthTypeNone(t *testing.T) {
+	svc := aws.NewService(&aws.Config{
+		Credentials: aws.Creds("AKID", "SECRET", "SESSION"),
+		Region:      "us-east-1",
+	})
+	svc.ServiceName = "cognito-identity"
+	svc.Handlers.Sign.PushBack(Sign)
+
+	op := &aws.Operation{Name: "GetId", HTTPMethod: "POST", HTTPPath: "/", AuthType: aws.AuthTypeNone}
+	req := aws.NewRequest(svc, op, nil, nil)
+	req.Sign()
+	assert.NoError(t, req.Error)
+
+	h := req.HTTPRequest.Header
+	assert.Equal(t, "", h.Get("Authorization"))
+	assert.Equal(t, "", h.Get("X-Amz-Date"))
+	assert.Equal(t, "", h.Get("X-Amz-Security-Token"))
+}
+
+func TestSignAuthTypeV4UnsignedBody(t *testing.T) {
+	svc := aws.NewService(&aws.Config{
+		Credentials: aws.Creds("AKID", "SECRET", ""),
+		Region:      "us-east-1",
+	})
+	svc.ServiceName = "lex"
+	svc.Handlers.Sign.PushBack(Sign)
+
+	op := &aws.Operation{Name: "PostContent", HTTPMethod: "POST", HTTPPath: "/content", AuthType: aws.AuthTypeV4UnsignedBody}
+	req := aws.NewRequest(svc, op, nil, nil)
+	req.SetBufferBody([]byte("audio data"))
+	req.Sign()
+	assert.NoError(t, req.Error)
+
+	h := req.HTTPRequest.Header
+	assert.Equal(t, "UNSIGNED-PAYLOAD", h.Get("X-Amz-Content-Sha256"))
+	assert.Contains(t, h.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKID/")
+}
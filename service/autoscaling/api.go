@@ -4,9 +4,11 @@
 package autoscaling
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // AttachInstancesRequest generates a request for the AttachInstances operation.
@@ -428,6 +430,11 @@ func (c *AutoScaling) DescribeAutoScalingGroupsRequest(input *DescribeAutoScalin
 			Name:       "DescribeAutoScalingGroups",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextToken"},
+				OutputTokens: []string{"NextToken"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -450,6 +457,16 @@ func (c *AutoScaling) DescribeAutoScalingGroups(input *DescribeAutoScalingGroups
 	return
 }
 
+// DescribeAutoScalingGroupsPages iterates over the pages of a DescribeAutoScalingGroups operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *AutoScaling) DescribeAutoScalingGroupsPages(input *DescribeAutoScalingGroupsInput, fn func(p *DescribeAutoScalingGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeAutoScalingGroupsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeAutoScalingGroupsOutput), lastPage)
+	})
+}
+
 var opDescribeAutoScalingGroups *aws.Operation
 
 // DescribeAutoScalingInstancesRequest generates a request for the DescribeAutoScalingInstances operation.
@@ -459,6 +476,11 @@ func (c *AutoScaling) DescribeAutoScalingInstancesRequest(input *DescribeAutoSca
 			Name:       "DescribeAutoScalingInstances",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextToken"},
+				OutputTokens: []string{"NextToken"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -482,6 +504,16 @@ func (c *AutoScaling) DescribeAutoScalingInstances(input *DescribeAutoScalingIns
 	return
 }
 
+// DescribeAutoScalingInstancesPages iterates over the pages of a DescribeAutoScalingInstances operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *AutoScaling) DescribeAutoScalingInstancesPages(input *DescribeAutoScalingInstancesInput, fn func(p *DescribeAutoScalingInstancesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeAutoScalingInstancesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeAutoScalingInstancesOutput), lastPage)
+	})
+}
+
 var opDescribeAutoScalingInstances *aws.Operation
 
 // DescribeAutoScalingNotificationTypesRequest generates a request for the DescribeAutoScalingNotificationTypes operation.
@@ -517,6 +549,11 @@ func (c *AutoScaling) DescribeLaunchConfigurationsRequest(input *DescribeLaunchC
 			Name:       "DescribeLaunchConfigurations",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextToken"},
+				OutputTokens: []string{"NextToken"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -539,6 +576,16 @@ func (c *AutoScaling) DescribeLaunchConfigurations(input *DescribeLaunchConfigur
 	return
 }
 
+// DescribeLaunchConfigurationsPages iterates over the pages of a DescribeLaunchConfigurations operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *AutoScaling) DescribeLaunchConfigurationsPages(input *DescribeLaunchConfigurationsInput, fn func(p *DescribeLaunchConfigurationsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeLaunchConfigurationsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeLaunchConfigurationsOutput), lastPage)
+	})
+}
+
 var opDescribeLaunchConfigurations *aws.Operation
 
 // DescribeLifecycleHookTypesRequest generates a request for the DescribeLifecycleHookTypes operation.
@@ -630,6 +677,11 @@ func (c *AutoScaling) DescribeNotificationConfigurationsRequest(input *DescribeN
 			Name:       "DescribeNotificationConfigurations",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextToken"},
+				OutputTokens: []string{"NextToken"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -648,6 +700,16 @@ func (c *AutoScaling) DescribeNotificationConfigurations(input *DescribeNotifica
 	return
 }
 
+// DescribeNotificationConfigurationsPages iterates over the pages of a DescribeNotificationConfigurations operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *AutoScaling) DescribeNotificationConfigurationsPages(input *DescribeNotificationConfigurationsInput, fn func(p *DescribeNotificationConfigurationsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeNotificationConfigurationsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeNotificationConfigurationsOutput), lastPage)
+	})
+}
+
 var opDescribeNotificationConfigurations *aws.Operation
 
 // DescribePoliciesRequest generates a request for the DescribePolicies operation.
@@ -657,6 +719,11 @@ func (c *AutoScaling) DescribePoliciesRequest(input *DescribePoliciesInput) (req
 			Name:       "DescribePolicies",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextToken"},
+				OutputTokens: []string{"NextToken"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -678,6 +745,16 @@ func (c *AutoScaling) DescribePolicies(input *DescribePoliciesInput) (output *De
 	return
 }
 
+// DescribePoliciesPages iterates over the pages of a DescribePolicies operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *AutoScaling) DescribePoliciesPages(input *DescribePoliciesInput, fn func(p *DescribePoliciesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribePoliciesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribePoliciesOutput), lastPage)
+	})
+}
+
 var opDescribePolicies *aws.Operation
 
 // DescribeScalingActivitiesRequest generates a request for the DescribeScalingActivities operation.
@@ -687,6 +764,11 @@ func (c *AutoScaling) DescribeScalingActivitiesRequest(input *DescribeScalingAct
 			Name:       "DescribeScalingActivities",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextToken"},
+				OutputTokens: []string{"NextToken"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -711,6 +793,16 @@ func (c *AutoScaling) DescribeScalingActivities(input *DescribeScalingActivities
 	return
 }
 
+// DescribeScalingActivitiesPages iterates over the pages of a DescribeScalingActivities operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *AutoScaling) DescribeScalingActivitiesPages(input *DescribeScalingActivitiesInput, fn func(p *DescribeScalingActivitiesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeScalingActivitiesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeScalingActivitiesOutput), lastPage)
+	})
+}
+
 var opDescribeScalingActivities *aws.Operation
 
 // DescribeScalingProcessTypesRequest generates a request for the DescribeScalingProcessTypes operation.
@@ -747,6 +839,11 @@ func (c *AutoScaling) DescribeScheduledActionsRequest(input *DescribeScheduledAc
 			Name:       "DescribeScheduledActions",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextToken"},
+				OutputTokens: []string{"NextToken"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -765,6 +862,16 @@ func (c *AutoScaling) DescribeScheduledActions(input *DescribeScheduledActionsIn
 	return
 }
 
+// DescribeScheduledActionsPages iterates over the pages of a DescribeScheduledActions operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *AutoScaling) DescribeScheduledActionsPages(input *DescribeScheduledActionsInput, fn func(p *DescribeScheduledActionsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeScheduledActionsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeScheduledActionsOutput), lastPage)
+	})
+}
+
 var opDescribeScheduledActions *aws.Operation
 
 // DescribeTagsRequest generates a request for the DescribeTags operation.
@@ -774,6 +881,11 @@ func (c *AutoScaling) DescribeTagsRequest(input *DescribeTagsInput) (req *aws.Re
 			Name:       "DescribeTags",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextToken"},
+				OutputTokens: []string{"NextToken"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -800,6 +912,16 @@ func (c *AutoScaling) DescribeTags(input *DescribeTagsInput) (output *DescribeTa
 	return
 }
 
+// DescribeTagsPages iterates over the pages of a DescribeTags operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *AutoScaling) DescribeTagsPages(input *DescribeTagsInput, fn func(p *DescribeTagsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeTagsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeTagsOutput), lastPage)
+	})
+}
+
 var opDescribeTags *aws.Operation
 
 // DescribeTerminationPolicyTypesRequest generates a request for the DescribeTerminationPolicyTypes operation.
@@ -1387,19 +1509,19 @@ var opUpdateAutoScalingGroup *aws.Operation
 // instance, or a process to perform any other long-running operations.
 type Activity struct {
 	// The ID of the activity.
-	ActivityID *string `locationName:"ActivityId" type:"string" required:"true"`
+	ActivityID *string `locationName:"ActivityId" type:"string" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The reason the activity was begun.
-	Cause *string `type:"string" required:"true"`
+	Cause *string `type:"string" min:"1" max:"1023" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// A friendly, more verbose description of the scaling activity.
-	Description *string `type:"string"`
+	Description *string `type:"string" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The details about the scaling activity.
-	Details *string `type:"string"`
+	Details *string `type:"string" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The end time of this activity.
 	EndTime *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -1411,10 +1533,10 @@ type Activity struct {
 	StartTime *time.Time `type:"timestamp" timestampFormat:"iso8601" required:"true"`
 
 	// The current status of the activity.
-	StatusCode *string `type:"string" required:"true"`
+	StatusCode *string `type:"string" enum:"WaitingForSpotInstanceRequestId,WaitingForSpotInstanceId,WaitingForInstanceId,PreInService,InProgress,WaitingForELBConnectionDraining,MidLifecycleAction,Successful,Failed,Cancelled" required:"true"`
 
 	// A friendly, more verbose description of the activity status.
-	StatusMessage *string `type:"string"`
+	StatusMessage *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataActivity `json:"-", xml:"-"`
 }
@@ -1423,6 +1545,11 @@ type metadataActivity struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Activity.
+func (s Activity) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a policy adjustment type.
 type AdjustmentType struct {
 	// The policy adjustment type. The valid values are ChangeInCapacity, ExactCapacity,
@@ -1430,7 +1557,7 @@ type AdjustmentType struct {
 	//
 	// For more information, see Dynamic Scaling (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/as-scale-based-on-demand.html)
 	// in the Auto Scaling Developer Guide.
-	AdjustmentType *string `type:"string"`
+	AdjustmentType *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataAdjustmentType `json:"-", xml:"-"`
 }
@@ -1439,13 +1566,18 @@ type metadataAdjustmentType struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AdjustmentType.
+func (s AdjustmentType) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an alarm.
 type Alarm struct {
 	// The Amazon Resource Name (ARN) of the alarm.
-	AlarmARN *string `type:"string"`
+	AlarmARN *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The name of the alarm.
-	AlarmName *string `type:"string"`
+	AlarmName *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataAlarm `json:"-", xml:"-"`
 }
@@ -1454,9 +1586,14 @@ type metadataAlarm struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Alarm.
+func (s Alarm) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AttachInstancesInput struct {
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// One or more EC2 instance IDs. You must specify at least one ID.
 	InstanceIDs []*string `locationName:"InstanceIds" type:"list"`
@@ -1468,6 +1605,11 @@ type metadataAttachInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AttachInstancesInput.
+func (s AttachInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AttachInstancesOutput struct {
 	metadataAttachInstancesOutput `json:"-", xml:"-"`
 }
@@ -1476,16 +1618,21 @@ type metadataAttachInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AttachInstancesOutput.
+func (s AttachInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an Auto Scaling group.
 type AutoScalingGroup struct {
 	// The Amazon Resource Name (ARN) of the group.
-	AutoScalingGroupARN *string `type:"string"`
+	AutoScalingGroupARN *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// One or more Availability Zones for the group.
-	AvailabilityZones []*string `type:"list" required:"true"`
+	AvailabilityZones []*string `type:"list" min:"1" required:"true"`
 
 	// The date and time the group was created.
 	CreatedTime *time.Time `type:"timestamp" timestampFormat:"iso8601" required:"true"`
@@ -1506,13 +1653,13 @@ type AutoScalingGroup struct {
 
 	// The service of interest for the health status check, which can be either
 	// EC2 for Amazon EC2 or ELB for Elastic Load Balancing.
-	HealthCheckType *string `type:"string" required:"true"`
+	HealthCheckType *string `type:"string" min:"1" max:"32" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The EC2 instances associated with the group.
 	Instances []*Instance `type:"list"`
 
 	// The name of the associated launch configuration.
-	LaunchConfigurationName *string `type:"string" required:"true"`
+	LaunchConfigurationName *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// One or more load balancers associated with the group.
 	LoadBalancerNames []*string `type:"list"`
@@ -1525,11 +1672,11 @@ type AutoScalingGroup struct {
 
 	// The name of the placement group into which you'll launch your instances,
 	// if any. For more information, see Placement Groups (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/placement-groups.html).
-	PlacementGroup *string `type:"string"`
+	PlacementGroup *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The current state of the Auto Scaling group when a DeleteAutoScalingGroup
 	// action is in progress.
-	Status *string `type:"string"`
+	Status *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The suspended processes associated with the group.
 	SuspendedProcesses []*SuspendedProcess `type:"list"`
@@ -1544,7 +1691,7 @@ type AutoScalingGroup struct {
 	//
 	// If you specify VPCZoneIdentifier and AvailabilityZones, ensure that the
 	// Availability Zones of the subnets match the values for AvailabilityZones.
-	VPCZoneIdentifier *string `type:"string"`
+	VPCZoneIdentifier *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataAutoScalingGroup `json:"-", xml:"-"`
 }
@@ -1553,29 +1700,34 @@ type metadataAutoScalingGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AutoScalingGroup.
+func (s AutoScalingGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an EC2 instance associated with an Auto Scaling group.
 type AutoScalingInstanceDetails struct {
 	// The name of the Auto Scaling group associated with the instance.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The Availability Zone for the instance.
-	AvailabilityZone *string `type:"string" required:"true"`
+	AvailabilityZone *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The health status of this instance. "Healthy" means that the instance is
 	// healthy and should remain in service. "Unhealthy" means that the instance
 	// is unhealthy and Auto Scaling should terminate and replace it.
-	HealthStatus *string `type:"string" required:"true"`
+	HealthStatus *string `type:"string" min:"1" max:"32" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"1" max:"16" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The launch configuration associated with the instance.
-	LaunchConfigurationName *string `type:"string" required:"true"`
+	LaunchConfigurationName *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The lifecycle state for the instance. For more information, see Auto Scaling
 	// Instance States (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/AutoScalingGroupLifecycle.html#AutoScalingStates)
 	// in the Auto Scaling Developer Guide.
-	LifecycleState *string `type:"string" required:"true"`
+	LifecycleState *string `type:"string" min:"1" max:"32" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	metadataAutoScalingInstanceDetails `json:"-", xml:"-"`
 }
@@ -1584,10 +1736,15 @@ type metadataAutoScalingInstanceDetails struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AutoScalingInstanceDetails.
+func (s AutoScalingInstanceDetails) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a block device mapping.
 type BlockDeviceMapping struct {
 	// The device name exposed to the EC2 instance (for example, /dev/sdh or xvdh).
-	DeviceName *string `type:"string" required:"true"`
+	DeviceName *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The information about the Amazon EBS volume.
 	EBS *EBS `locationName:"Ebs" type:"structure"`
@@ -1600,7 +1757,7 @@ type BlockDeviceMapping struct {
 	NoDevice *bool `type:"boolean"`
 
 	// The name of the virtual device, ephemeral0 to ephemeral3.
-	VirtualName *string `type:"string"`
+	VirtualName *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataBlockDeviceMapping `json:"-", xml:"-"`
 }
@@ -1609,9 +1766,14 @@ type metadataBlockDeviceMapping struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the BlockDeviceMapping.
+func (s BlockDeviceMapping) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CompleteLifecycleActionInput struct {
 	// The name of the group for the lifecycle hook.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The action for the group to take. This parameter can be either CONTINUE or
 	// ABANDON.
@@ -1620,10 +1782,10 @@ type CompleteLifecycleActionInput struct {
 	// A universally unique identifier (UUID) that identifies a specific lifecycle
 	// action associated with an instance. Auto Scaling sends this token to the
 	// notification target you specified when you created the lifecycle hook.
-	LifecycleActionToken *string `type:"string" required:"true"`
+	LifecycleActionToken *string `type:"string" min:"36" max:"36" required:"true"`
 
 	// The name of the lifecycle hook.
-	LifecycleHookName *string `type:"string" required:"true"`
+	LifecycleHookName *string `type:"string" min:"1" max:"255" pattern:"[A-Za-z0-9\\-_\\/]+" required:"true"`
 
 	metadataCompleteLifecycleActionInput `json:"-", xml:"-"`
 }
@@ -1632,6 +1794,11 @@ type metadataCompleteLifecycleActionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CompleteLifecycleActionInput.
+func (s CompleteLifecycleActionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CompleteLifecycleActionOutput struct {
 	metadataCompleteLifecycleActionOutput `json:"-", xml:"-"`
 }
@@ -1640,14 +1807,19 @@ type metadataCompleteLifecycleActionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CompleteLifecycleActionOutput.
+func (s CompleteLifecycleActionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateAutoScalingGroupInput struct {
 	// The name of the group. This name must be unique within the scope of your
 	// AWS account.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// One or more Availability Zones for the group. This parameter is optional
 	// if you specify subnets using the VPCZoneIdentifier parameter.
-	AvailabilityZones []*string `type:"list"`
+	AvailabilityZones []*string `type:"list" min:"1"`
 
 	// The amount of time, in seconds, after a scaling activity completes before
 	// another scaling activity can start.
@@ -1680,7 +1852,7 @@ type CreateAutoScalingGroupInput struct {
 	//
 	// By default, health checks use Amazon EC2 instance status checks to determine
 	// the health of an instance. For more information, see Health Checks (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/healthcheck.html).
-	HealthCheckType *string `type:"string"`
+	HealthCheckType *string `type:"string" min:"1" max:"32" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The ID of the EC2 instance used to create a launch configuration for the
 	// group. Alternatively, use the LaunchConfigurationName parameter to specify
@@ -1694,11 +1866,11 @@ type CreateAutoScalingGroupInput struct {
 	// For more information, see Create an Auto Scaling Group Using an EC2 Instance
 	// ID (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/create-asg-from-instance.html)
 	// in the Auto Scaling Developer Guide.
-	InstanceID *string `locationName:"InstanceId" type:"string"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"1" max:"16" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The name of the launch configuration. Alternatively, use the InstanceId parameter
 	// to specify an EC2 instance instead of a launch configuration.
-	LaunchConfigurationName *string `type:"string"`
+	LaunchConfigurationName *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// One or more load balancers.
 	//
@@ -1714,7 +1886,7 @@ type CreateAutoScalingGroupInput struct {
 
 	// The name of the placement group into which you'll launch your instances,
 	// if any. For more information, see Placement Groups (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/placement-groups.html).
-	PlacementGroup *string `type:"string"`
+	PlacementGroup *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The tag to be created or updated. Each tag should be defined by its resource
 	// type, resource ID, key, value, and a propagate flag. Valid values: key=value,
@@ -1741,7 +1913,7 @@ type CreateAutoScalingGroupInput struct {
 	//
 	// For more information, see Auto Scaling and Amazon VPC (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/autoscalingsubnets.html)
 	// in the Auto Scaling Developer Guide.
-	VPCZoneIdentifier *string `type:"string"`
+	VPCZoneIdentifier *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataCreateAutoScalingGroupInput `json:"-", xml:"-"`
 }
@@ -1750,6 +1922,11 @@ type metadataCreateAutoScalingGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateAutoScalingGroupInput.
+func (s CreateAutoScalingGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateAutoScalingGroupOutput struct {
 	metadataCreateAutoScalingGroupOutput `json:"-", xml:"-"`
 }
@@ -1758,6 +1935,11 @@ type metadataCreateAutoScalingGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateAutoScalingGroupOutput.
+func (s CreateAutoScalingGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateLaunchConfigurationInput struct {
 	// Used for groups that launch instances into a virtual private cloud (VPC).
 	// Specifies whether to assign a public IP address to each instance. For more
@@ -1782,7 +1964,7 @@ type CreateLaunchConfigurationInput struct {
 	// This parameter can only be used if you are launching EC2-Classic instances.
 	// For more information, see ClassicLink (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/vpc-classiclink.html)
 	// in the Amazon Elastic Compute Cloud User Guide.
-	ClassicLinkVPCID *string `locationName:"ClassicLinkVPCId" type:"string"`
+	ClassicLinkVPCID *string `locationName:"ClassicLinkVPCId" type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The IDs of one or more security groups for the VPC specified in ClassicLinkVPCId.
 	// This parameter is required if ClassicLinkVPCId is specified, and cannot be
@@ -1808,12 +1990,12 @@ type CreateLaunchConfigurationInput struct {
 	// securely access other AWS resources. For more information, see Launch Auto
 	// Scaling Instances with an IAM Role (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/us-iam-role.html)
 	// in the Auto Scaling Developer Guide.
-	IAMInstanceProfile *string `locationName:"IamInstanceProfile" type:"string"`
+	IAMInstanceProfile *string `locationName:"IamInstanceProfile" type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The ID of the Amazon Machine Image (AMI) to use to launch your EC2 instances.
 	// For more information, see Finding an AMI (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/finding-an-ami.html)
 	// in the Amazon Elastic Compute Cloud User Guide.
-	ImageID *string `locationName:"ImageId" type:"string"`
+	ImageID *string `locationName:"ImageId" type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The ID of the EC2 instance to use to create the launch configuration.
 	//
@@ -1826,7 +2008,7 @@ type CreateLaunchConfigurationInput struct {
 	// For more information, see Create a Launch Configuration Using an EC2 Instance
 	// (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/create-lc-with-instanceID.html)
 	// in the Auto Scaling Developer Guide.
-	InstanceID *string `locationName:"InstanceId" type:"string"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"1" max:"16" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// Enables detailed monitoring if it is disabled. Detailed monitoring is enabled
 	// by default.
@@ -1841,19 +2023,19 @@ type CreateLaunchConfigurationInput struct {
 	// The instance type of the Amazon EC2 instance. For information about available
 	// Amazon EC2 instance types, see  Available Instance Types (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/instance-types.html#AvailableInstanceTypes)
 	// in the Amazon Elastic Cloud Compute User Guide.
-	InstanceType *string `type:"string"`
+	InstanceType *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The ID of the kernel associated with the Amazon EC2 AMI.
-	KernelID *string `locationName:"KernelId" type:"string"`
+	KernelID *string `locationName:"KernelId" type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The name of the key pair. For more information, see Amazon EC2 Key Pairs
 	// (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-key-pairs.html) in
 	// the Amazon Elastic Compute Cloud User Guide.
-	KeyName *string `type:"string"`
+	KeyName *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The name of the launch configuration. This name must be unique within the
 	// scope of your AWS account.
-	LaunchConfigurationName *string `type:"string" required:"true"`
+	LaunchConfigurationName *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The tenancy of the instance. An instance with a tenancy of dedicated runs
 	// on single-tenant hardware and can only be launched in a VPC.
@@ -1869,10 +2051,10 @@ type CreateLaunchConfigurationInput struct {
 	// in the Auto Scaling Developer Guide.
 	//
 	// Valid values: default | dedicated
-	PlacementTenancy *string `type:"string"`
+	PlacementTenancy *string `type:"string" min:"1" max:"64" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The ID of the RAM disk associated with the Amazon EC2 AMI.
-	RAMDiskID *string `locationName:"RamdiskId" type:"string"`
+	RAMDiskID *string `locationName:"RamdiskId" type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// One or more security groups with which to associate the instances.
 	//
@@ -1891,7 +2073,7 @@ type CreateLaunchConfigurationInput struct {
 	// the current Spot market price. For more information, see Launch Spot Instances
 	// in Your Auto Scaling Group (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/US-SpotInstances.html)
 	// in the Auto Scaling Developer Guide.
-	SpotPrice *string `type:"string"`
+	SpotPrice *string `type:"string" min:"1" max:"255"`
 
 	// The user data to make available to the launched EC2 instances. For more information,
 	// see Instance Metadata and User Data (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ec2-instance-metadata.html)
@@ -1899,7 +2081,7 @@ type CreateLaunchConfigurationInput struct {
 	//
 	// At this time, launch configurations don't support compressed (zipped) user
 	// data files.
-	UserData *string `type:"string"`
+	UserData *string `type:"string" max:"21847" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataCreateLaunchConfigurationInput `json:"-", xml:"-"`
 }
@@ -1908,6 +2090,11 @@ type metadataCreateLaunchConfigurationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateLaunchConfigurationInput.
+func (s CreateLaunchConfigurationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateLaunchConfigurationOutput struct {
 	metadataCreateLaunchConfigurationOutput `json:"-", xml:"-"`
 }
@@ -1916,6 +2103,11 @@ type metadataCreateLaunchConfigurationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateLaunchConfigurationOutput.
+func (s CreateLaunchConfigurationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateOrUpdateTagsInput struct {
 	// The tag to be created or updated. Each tag should be defined by its resource
 	// type, resource ID, key, value, and a propagate flag. The resource type and
@@ -1941,6 +2133,11 @@ type metadataCreateOrUpdateTagsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateOrUpdateTagsInput.
+func (s CreateOrUpdateTagsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateOrUpdateTagsOutput struct {
 	metadataCreateOrUpdateTagsOutput `json:"-", xml:"-"`
 }
@@ -1949,9 +2146,14 @@ type metadataCreateOrUpdateTagsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateOrUpdateTagsOutput.
+func (s CreateOrUpdateTagsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteAutoScalingGroupInput struct {
 	// The name of the group to delete.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// Specifies that the group will be deleted along with all instances associated
 	// with the group, without waiting for all instances to be terminated. This
@@ -1965,6 +2167,11 @@ type metadataDeleteAutoScalingGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteAutoScalingGroupInput.
+func (s DeleteAutoScalingGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteAutoScalingGroupOutput struct {
 	metadataDeleteAutoScalingGroupOutput `json:"-", xml:"-"`
 }
@@ -1973,9 +2180,14 @@ type metadataDeleteAutoScalingGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteAutoScalingGroupOutput.
+func (s DeleteAutoScalingGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteLaunchConfigurationInput struct {
 	// The name of the launch configuration.
-	LaunchConfigurationName *string `type:"string" required:"true"`
+	LaunchConfigurationName *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	metadataDeleteLaunchConfigurationInput `json:"-", xml:"-"`
 }
@@ -1984,6 +2196,11 @@ type metadataDeleteLaunchConfigurationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteLaunchConfigurationInput.
+func (s DeleteLaunchConfigurationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteLaunchConfigurationOutput struct {
 	metadataDeleteLaunchConfigurationOutput `json:"-", xml:"-"`
 }
@@ -1992,12 +2209,17 @@ type metadataDeleteLaunchConfigurationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteLaunchConfigurationOutput.
+func (s DeleteLaunchConfigurationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteLifecycleHookInput struct {
 	// The name of the Auto Scaling group for the lifecycle hook.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The name of the lifecycle hook.
-	LifecycleHookName *string `type:"string" required:"true"`
+	LifecycleHookName *string `type:"string" min:"1" max:"255" pattern:"[A-Za-z0-9\\-_\\/]+" required:"true"`
 
 	metadataDeleteLifecycleHookInput `json:"-", xml:"-"`
 }
@@ -2006,6 +2228,11 @@ type metadataDeleteLifecycleHookInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteLifecycleHookInput.
+func (s DeleteLifecycleHookInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteLifecycleHookOutput struct {
 	metadataDeleteLifecycleHookOutput `json:"-", xml:"-"`
 }
@@ -2014,13 +2241,18 @@ type metadataDeleteLifecycleHookOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteLifecycleHookOutput.
+func (s DeleteLifecycleHookOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteNotificationConfigurationInput struct {
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the Amazon Simple Notification Service
 	// (SNS) topic.
-	TopicARN *string `type:"string" required:"true"`
+	TopicARN *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	metadataDeleteNotificationConfigurationInput `json:"-", xml:"-"`
 }
@@ -2029,6 +2261,11 @@ type metadataDeleteNotificationConfigurationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteNotificationConfigurationInput.
+func (s DeleteNotificationConfigurationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteNotificationConfigurationOutput struct {
 	metadataDeleteNotificationConfigurationOutput `json:"-", xml:"-"`
 }
@@ -2037,12 +2274,17 @@ type metadataDeleteNotificationConfigurationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteNotificationConfigurationOutput.
+func (s DeleteNotificationConfigurationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeletePolicyInput struct {
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The name or Amazon Resource Name (ARN) of the policy.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	metadataDeletePolicyInput `json:"-", xml:"-"`
 }
@@ -2051,6 +2293,11 @@ type metadataDeletePolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeletePolicyInput.
+func (s DeletePolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeletePolicyOutput struct {
 	metadataDeletePolicyOutput `json:"-", xml:"-"`
 }
@@ -2059,12 +2306,17 @@ type metadataDeletePolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeletePolicyOutput.
+func (s DeletePolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteScheduledActionInput struct {
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The name of the action to delete.
-	ScheduledActionName *string `type:"string" required:"true"`
+	ScheduledActionName *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	metadataDeleteScheduledActionInput `json:"-", xml:"-"`
 }
@@ -2073,6 +2325,11 @@ type metadataDeleteScheduledActionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteScheduledActionInput.
+func (s DeleteScheduledActionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteScheduledActionOutput struct {
 	metadataDeleteScheduledActionOutput `json:"-", xml:"-"`
 }
@@ -2081,6 +2338,11 @@ type metadataDeleteScheduledActionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteScheduledActionOutput.
+func (s DeleteScheduledActionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteTagsInput struct {
 	// Each tag should be defined by its resource type, resource ID, key, value,
 	// and a propagate flag. Valid values are: Resource type = auto-scaling-group,
@@ -2095,6 +2357,11 @@ type metadataDeleteTagsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteTagsInput.
+func (s DeleteTagsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteTagsOutput struct {
 	metadataDeleteTagsOutput `json:"-", xml:"-"`
 }
@@ -2103,6 +2370,11 @@ type metadataDeleteTagsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteTagsOutput.
+func (s DeleteTagsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeAccountLimitsInput struct {
 	metadataDescribeAccountLimitsInput `json:"-", xml:"-"`
 }
@@ -2111,6 +2383,11 @@ type metadataDescribeAccountLimitsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeAccountLimitsInput.
+func (s DescribeAccountLimitsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeAccountLimitsOutput struct {
 	// The maximum number of groups allowed for your AWS account. The default limit
 	// is 20 per region.
@@ -2127,6 +2404,11 @@ type metadataDescribeAccountLimitsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeAccountLimitsOutput.
+func (s DescribeAccountLimitsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeAdjustmentTypesInput struct {
 	metadataDescribeAdjustmentTypesInput `json:"-", xml:"-"`
 }
@@ -2135,6 +2417,11 @@ type metadataDescribeAdjustmentTypesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeAdjustmentTypesInput.
+func (s DescribeAdjustmentTypesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeAdjustmentTypesOutput struct {
 	// The policy adjustment types.
 	AdjustmentTypes []*AdjustmentType `type:"list"`
@@ -2146,6 +2433,11 @@ type metadataDescribeAdjustmentTypesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeAdjustmentTypesOutput.
+func (s DescribeAdjustmentTypesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeAutoScalingGroupsInput struct {
 	// The group names.
 	AutoScalingGroupNames []*string `type:"list"`
@@ -2155,7 +2447,7 @@ type DescribeAutoScalingGroupsInput struct {
 
 	// The token for the next set of items to return. (You received this token from
 	// a previous call.)
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataDescribeAutoScalingGroupsInput `json:"-", xml:"-"`
 }
@@ -2164,13 +2456,18 @@ type metadataDescribeAutoScalingGroupsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeAutoScalingGroupsInput.
+func (s DescribeAutoScalingGroupsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeAutoScalingGroupsOutput struct {
 	// The groups.
 	AutoScalingGroups []*AutoScalingGroup `type:"list" required:"true"`
 
 	// The token to use when requesting the next set of items. If there are no additional
 	// items to return, the string is empty.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataDescribeAutoScalingGroupsOutput `json:"-", xml:"-"`
 }
@@ -2179,6 +2476,11 @@ type metadataDescribeAutoScalingGroupsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeAutoScalingGroupsOutput.
+func (s DescribeAutoScalingGroupsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeAutoScalingInstancesInput struct {
 	// One or more Auto Scaling instances to describe, up to 50 instances. If you
 	// omit this parameter, all Auto Scaling instances are described. If you specify
@@ -2190,7 +2492,7 @@ type DescribeAutoScalingInstancesInput struct {
 
 	// The token for the next set of items to return. (You received this token from
 	// a previous call.)
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataDescribeAutoScalingInstancesInput `json:"-", xml:"-"`
 }
@@ -2199,13 +2501,18 @@ type metadataDescribeAutoScalingInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeAutoScalingInstancesInput.
+func (s DescribeAutoScalingInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeAutoScalingInstancesOutput struct {
 	// The instances.
 	AutoScalingInstances []*AutoScalingInstanceDetails `type:"list"`
 
 	// The token to use when requesting the next set of items. If there are no additional
 	// items to return, the string is empty.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataDescribeAutoScalingInstancesOutput `json:"-", xml:"-"`
 }
@@ -2214,6 +2521,11 @@ type metadataDescribeAutoScalingInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeAutoScalingInstancesOutput.
+func (s DescribeAutoScalingInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeAutoScalingNotificationTypesInput struct {
 	metadataDescribeAutoScalingNotificationTypesInput `json:"-", xml:"-"`
 }
@@ -2222,6 +2534,11 @@ type metadataDescribeAutoScalingNotificationTypesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeAutoScalingNotificationTypesInput.
+func (s DescribeAutoScalingNotificationTypesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeAutoScalingNotificationTypesOutput struct {
 	// One or more of the following notification types:
 	//
@@ -2243,6 +2560,11 @@ type metadataDescribeAutoScalingNotificationTypesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeAutoScalingNotificationTypesOutput.
+func (s DescribeAutoScalingNotificationTypesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeLaunchConfigurationsInput struct {
 	// The launch configuration names.
 	LaunchConfigurationNames []*string `type:"list"`
@@ -2252,7 +2574,7 @@ type DescribeLaunchConfigurationsInput struct {
 
 	// The token for the next set of items to return. (You received this token from
 	// a previous call.)
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataDescribeLaunchConfigurationsInput `json:"-", xml:"-"`
 }
@@ -2261,13 +2583,18 @@ type metadataDescribeLaunchConfigurationsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeLaunchConfigurationsInput.
+func (s DescribeLaunchConfigurationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeLaunchConfigurationsOutput struct {
 	// The launch configurations.
 	LaunchConfigurations []*LaunchConfiguration `type:"list" required:"true"`
 
 	// The token to use when requesting the next set of items. If there are no additional
 	// items to return, the string is empty.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataDescribeLaunchConfigurationsOutput `json:"-", xml:"-"`
 }
@@ -2276,6 +2603,11 @@ type metadataDescribeLaunchConfigurationsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeLaunchConfigurationsOutput.
+func (s DescribeLaunchConfigurationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeLifecycleHookTypesInput struct {
 	metadataDescribeLifecycleHookTypesInput `json:"-", xml:"-"`
 }
@@ -2284,6 +2616,11 @@ type metadataDescribeLifecycleHookTypesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeLifecycleHookTypesInput.
+func (s DescribeLifecycleHookTypesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeLifecycleHookTypesOutput struct {
 	// One or more of the following notification types:
 	//
@@ -2299,9 +2636,14 @@ type metadataDescribeLifecycleHookTypesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeLifecycleHookTypesOutput.
+func (s DescribeLifecycleHookTypesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeLifecycleHooksInput struct {
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The names of one or more lifecycle hooks.
 	LifecycleHookNames []*string `type:"list"`
@@ -2313,6 +2655,11 @@ type metadataDescribeLifecycleHooksInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeLifecycleHooksInput.
+func (s DescribeLifecycleHooksInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeLifecycleHooksOutput struct {
 	// The lifecycle hooks for the specified group.
 	LifecycleHooks []*LifecycleHook `type:"list"`
@@ -2324,6 +2671,11 @@ type metadataDescribeLifecycleHooksOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeLifecycleHooksOutput.
+func (s DescribeLifecycleHooksOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeMetricCollectionTypesInput struct {
 	metadataDescribeMetricCollectionTypesInput `json:"-", xml:"-"`
 }
@@ -2332,6 +2684,11 @@ type metadataDescribeMetricCollectionTypesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeMetricCollectionTypesInput.
+func (s DescribeMetricCollectionTypesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeMetricCollectionTypesOutput struct {
 	// The granularities for the listed metrics.
 	Granularities []*MetricGranularityType `type:"list"`
@@ -2365,6 +2722,11 @@ type metadataDescribeMetricCollectionTypesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeMetricCollectionTypesOutput.
+func (s DescribeMetricCollectionTypesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeNotificationConfigurationsInput struct {
 	// The name of the group.
 	AutoScalingGroupNames []*string `type:"list"`
@@ -2374,7 +2736,7 @@ type DescribeNotificationConfigurationsInput struct {
 
 	// The token for the next set of items to return. (You received this token from
 	// a previous call.)
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataDescribeNotificationConfigurationsInput `json:"-", xml:"-"`
 }
@@ -2383,10 +2745,15 @@ type metadataDescribeNotificationConfigurationsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeNotificationConfigurationsInput.
+func (s DescribeNotificationConfigurationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeNotificationConfigurationsOutput struct {
 	// The token to use when requesting the next set of items. If there are no additional
 	// items to return, the string is empty.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The notification configurations.
 	NotificationConfigurations []*NotificationConfiguration `type:"list" required:"true"`
@@ -2398,16 +2765,21 @@ type metadataDescribeNotificationConfigurationsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeNotificationConfigurationsOutput.
+func (s DescribeNotificationConfigurationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribePoliciesInput struct {
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The maximum number of items to be returned with each call.
 	MaxRecords *int64 `type:"integer"`
 
 	// The token for the next set of items to return. (You received this token from
 	// a previous call.)
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// One or more policy names or policy ARNs to be described. If you omit this
 	// list, all policy names are described. If an group name is provided, the results
@@ -2422,10 +2794,15 @@ type metadataDescribePoliciesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribePoliciesInput.
+func (s DescribePoliciesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribePoliciesOutput struct {
 	// The token to use when requesting the next set of items. If there are no additional
 	// items to return, the string is empty.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The scaling policies.
 	ScalingPolicies []*ScalingPolicy `type:"list"`
@@ -2437,6 +2814,11 @@ type metadataDescribePoliciesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribePoliciesOutput.
+func (s DescribePoliciesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeScalingActivitiesInput struct {
 	// A list containing the activity IDs of the desired scaling activities. If
 	// this list is omitted, all activities are described. If an AutoScalingGroupName
@@ -2446,14 +2828,14 @@ type DescribeScalingActivitiesInput struct {
 	ActivityIDs []*string `locationName:"ActivityIds" type:"list"`
 
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The maximum number of items to return with this call.
 	MaxRecords *int64 `type:"integer"`
 
 	// The token for the next set of items to return. (You received this token from
 	// a previous call.)
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataDescribeScalingActivitiesInput `json:"-", xml:"-"`
 }
@@ -2462,13 +2844,18 @@ type metadataDescribeScalingActivitiesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeScalingActivitiesInput.
+func (s DescribeScalingActivitiesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeScalingActivitiesOutput struct {
 	// The scaling activities.
 	Activities []*Activity `type:"list" required:"true"`
 
 	// The token to use when requesting the next set of items. If there are no additional
 	// items to return, the string is empty.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataDescribeScalingActivitiesOutput `json:"-", xml:"-"`
 }
@@ -2477,6 +2864,11 @@ type metadataDescribeScalingActivitiesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeScalingActivitiesOutput.
+func (s DescribeScalingActivitiesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeScalingProcessTypesInput struct {
 	metadataDescribeScalingProcessTypesInput `json:"-", xml:"-"`
 }
@@ -2485,6 +2877,11 @@ type metadataDescribeScalingProcessTypesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeScalingProcessTypesInput.
+func (s DescribeScalingProcessTypesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeScalingProcessTypesOutput struct {
 	// The names of the process types.
 	Processes []*ProcessType `type:"list"`
@@ -2496,9 +2893,14 @@ type metadataDescribeScalingProcessTypesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeScalingProcessTypesOutput.
+func (s DescribeScalingProcessTypesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeScheduledActionsInput struct {
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The latest scheduled start time to return. If scheduled action names are
 	// provided, this parameter is ignored.
@@ -2509,7 +2911,7 @@ type DescribeScheduledActionsInput struct {
 
 	// The token for the next set of items to return. (You received this token from
 	// a previous call.)
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// Describes one or more scheduled actions. If you omit this list, the call
 	// describes all scheduled actions. If you specify an unknown scheduled action
@@ -2531,10 +2933,15 @@ type metadataDescribeScheduledActionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeScheduledActionsInput.
+func (s DescribeScheduledActionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeScheduledActionsOutput struct {
 	// The token to use when requesting the next set of items. If there are no additional
 	// items to return, the string is empty.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The scheduled actions.
 	ScheduledUpdateGroupActions []*ScheduledUpdateGroupAction `type:"list"`
@@ -2546,6 +2953,11 @@ type metadataDescribeScheduledActionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeScheduledActionsOutput.
+func (s DescribeScheduledActionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeTagsInput struct {
 	// The value of the filter type used to identify the tags to be returned. For
 	// example, you can filter so that tags are returned according to Auto Scaling
@@ -2558,7 +2970,7 @@ type DescribeTagsInput struct {
 
 	// The token for the next set of items to return. (You received this token from
 	// a previous call.)
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataDescribeTagsInput `json:"-", xml:"-"`
 }
@@ -2567,10 +2979,15 @@ type metadataDescribeTagsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeTagsInput.
+func (s DescribeTagsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeTagsOutput struct {
 	// The token to use when requesting the next set of items. If there are no additional
 	// items to return, the string is empty.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The tags.
 	Tags []*TagDescription `type:"list"`
@@ -2582,6 +2999,11 @@ type metadataDescribeTagsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeTagsOutput.
+func (s DescribeTagsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeTerminationPolicyTypesInput struct {
 	metadataDescribeTerminationPolicyTypesInput `json:"-", xml:"-"`
 }
@@ -2590,6 +3012,11 @@ type metadataDescribeTerminationPolicyTypesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeTerminationPolicyTypesInput.
+func (s DescribeTerminationPolicyTypesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeTerminationPolicyTypesOutput struct {
 	// The Termination policies supported by Auto Scaling. They are: OldestInstance,
 	// OldestLaunchConfiguration, NewestInstance, ClosestToNextInstanceHour, and
@@ -2603,9 +3030,14 @@ type metadataDescribeTerminationPolicyTypesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeTerminationPolicyTypesOutput.
+func (s DescribeTerminationPolicyTypesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DetachInstancesInput struct {
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// One or more instance IDs.
 	InstanceIDs []*string `locationName:"InstanceIds" type:"list"`
@@ -2621,6 +3053,11 @@ type metadataDetachInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DetachInstancesInput.
+func (s DetachInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DetachInstancesOutput struct {
 	// The activities related to detaching the instances from the Auto Scaling group.
 	Activities []*Activity `type:"list"`
@@ -2632,9 +3069,14 @@ type metadataDetachInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DetachInstancesOutput.
+func (s DetachInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DisableMetricsCollectionInput struct {
 	// The name or Amazon Resource Name (ARN) of the group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// One or more of the following metrics:
 	//
@@ -2664,6 +3106,11 @@ type metadataDisableMetricsCollectionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DisableMetricsCollectionInput.
+func (s DisableMetricsCollectionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DisableMetricsCollectionOutput struct {
 	metadataDisableMetricsCollectionOutput `json:"-", xml:"-"`
 }
@@ -2672,6 +3119,11 @@ type metadataDisableMetricsCollectionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DisableMetricsCollectionOutput.
+func (s DisableMetricsCollectionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an Amazon EBS volume.
 type EBS struct {
 	// Indicates whether to delete the volume on instance termination.
@@ -2685,10 +3137,10 @@ type EBS struct {
 	// Valid values: Range is 100 to 4000.
 	//
 	// Default: None
-	IOPS *int64 `locationName:"Iops" type:"integer"`
+	IOPS *int64 `locationName:"Iops" type:"integer" min:"100" max:"30000"`
 
 	// The ID of the snapshot.
-	SnapshotID *string `locationName:"SnapshotId" type:"string"`
+	SnapshotID *string `locationName:"SnapshotId" type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The volume size, in gigabytes.
 	//
@@ -2700,14 +3152,14 @@ type EBS struct {
 	// volume size, the default is the size of the snapshot.
 	//
 	// Required: Required when the volume type is io1.
-	VolumeSize *int64 `type:"integer"`
+	VolumeSize *int64 `type:"integer" min:"1" max:"16384"`
 
 	// The volume type.
 	//
 	// Valid values: standard | io1 | gp2
 	//
 	// Default: standard
-	VolumeType *string `type:"string"`
+	VolumeType *string `type:"string" min:"1" max:"255"`
 
 	metadataEBS `json:"-", xml:"-"`
 }
@@ -2716,13 +3168,18 @@ type metadataEBS struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EBS.
+func (s EBS) String() string {
+	return awsutil.StringValue(s)
+}
+
 type EnableMetricsCollectionInput struct {
 	// The name or ARN of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The granularity to associate with the metrics to collect. Currently, the
 	// only valid value is "1Minute".
-	Granularity *string `type:"string" required:"true"`
+	Granularity *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// One or more of the following metrics:
 	//
@@ -2755,6 +3212,11 @@ type metadataEnableMetricsCollectionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EnableMetricsCollectionInput.
+func (s EnableMetricsCollectionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type EnableMetricsCollectionOutput struct {
 	metadataEnableMetricsCollectionOutput `json:"-", xml:"-"`
 }
@@ -2763,13 +3225,18 @@ type metadataEnableMetricsCollectionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EnableMetricsCollectionOutput.
+func (s EnableMetricsCollectionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an enabled metric.
 type EnabledMetric struct {
 	// The granularity of the metric.
-	Granularity *string `type:"string"`
+	Granularity *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The name of the metric.
-	Metric *string `type:"string"`
+	Metric *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataEnabledMetric `json:"-", xml:"-"`
 }
@@ -2778,9 +3245,14 @@ type metadataEnabledMetric struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EnabledMetric.
+func (s EnabledMetric) String() string {
+	return awsutil.StringValue(s)
+}
+
 type EnterStandbyInput struct {
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// One or more instances to move into Standby mode. You must specify at least
 	// one instance ID.
@@ -2799,6 +3271,11 @@ type metadataEnterStandbyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EnterStandbyInput.
+func (s EnterStandbyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type EnterStandbyOutput struct {
 	// The activities related to moving instances into Standby mode.
 	Activities []*Activity `type:"list"`
@@ -2810,9 +3287,14 @@ type metadataEnterStandbyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EnterStandbyOutput.
+func (s EnterStandbyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ExecutePolicyInput struct {
 	// The name or Amazon Resource Name (ARN) of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// Set to True if you want Auto Scaling to wait for the cooldown period associated
 	// with the Auto Scaling group to complete before executing the policy.
@@ -2826,7 +3308,7 @@ type ExecutePolicyInput struct {
 	HonorCooldown *bool `type:"boolean"`
 
 	// The name or ARN of the policy.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	metadataExecutePolicyInput `json:"-", xml:"-"`
 }
@@ -2835,6 +3317,11 @@ type metadataExecutePolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ExecutePolicyInput.
+func (s ExecutePolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ExecutePolicyOutput struct {
 	metadataExecutePolicyOutput `json:"-", xml:"-"`
 }
@@ -2843,9 +3330,14 @@ type metadataExecutePolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ExecutePolicyOutput.
+func (s ExecutePolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ExitStandbyInput struct {
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// One or more instance IDs. You must specify at least one instance ID.
 	InstanceIDs []*string `locationName:"InstanceIds" type:"list"`
@@ -2857,6 +3349,11 @@ type metadataExitStandbyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ExitStandbyInput.
+func (s ExitStandbyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ExitStandbyOutput struct {
 	// The activities related to moving instances out of Standby mode.
 	Activities []*Activity `type:"list"`
@@ -2868,11 +3365,16 @@ type metadataExitStandbyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ExitStandbyOutput.
+func (s ExitStandbyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a filter.
 type Filter struct {
 	// The name of the filter. The valid values are: "auto-scaling-group", "key",
 	// "value", and "propagate-at-launch".
-	Name *string `type:"string"`
+	Name *string `type:"string" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The value of the filter.
 	Values []*string `type:"list"`
@@ -2884,24 +3386,29 @@ type metadataFilter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Filter.
+func (s Filter) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an EC2 instance.
 type Instance struct {
 	// The Availability Zone associated with this instance.
-	AvailabilityZone *string `type:"string" required:"true"`
+	AvailabilityZone *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The health status of the instance.
-	HealthStatus *string `type:"string" required:"true"`
+	HealthStatus *string `type:"string" min:"1" max:"32" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"1" max:"16" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The launch configuration associated with the instance.
-	LaunchConfigurationName *string `type:"string" required:"true"`
+	LaunchConfigurationName *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// A description of the current lifecycle state.
 	//
 	//  The Quarantined lifecycle state is not used.
-	LifecycleState *string `type:"string" required:"true"`
+	LifecycleState *string `type:"string" enum:"Pending,Pending:Wait,Pending:Proceed,Quarantined,InService,Terminating,Terminating:Wait,Terminating:Proceed,Terminated,Detaching,Detached,EnteringStandby,Standby" required:"true"`
 
 	metadataInstance `json:"-", xml:"-"`
 }
@@ -2910,6 +3417,11 @@ type metadataInstance struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Instance.
+func (s Instance) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes whether instance monitoring is enabled.
 type InstanceMonitoring struct {
 	// If True, instance monitoring is enabled.
@@ -2922,6 +3434,11 @@ type metadataInstanceMonitoring struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InstanceMonitoring.
+func (s InstanceMonitoring) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a launch configuration.
 type LaunchConfiguration struct {
 	// Specifies whether the EC2 instances are associated with a public IP address
@@ -2936,7 +3453,7 @@ type LaunchConfiguration struct {
 	// This parameter can only be used if you are launching EC2-Classic instances.
 	// For more information, see ClassicLink (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/vpc-classiclink.html)
 	// in the Amazon Elastic Compute Cloud User Guide.
-	ClassicLinkVPCID *string `locationName:"ClassicLinkVPCId" type:"string"`
+	ClassicLinkVPCID *string `locationName:"ClassicLinkVPCId" type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The IDs of one or more security groups for the VPC specified in ClassicLinkVPCId.
 	// This parameter is required if ClassicLinkVPCId is specified, and cannot be
@@ -2952,45 +3469,45 @@ type LaunchConfiguration struct {
 
 	// The name or Amazon Resource Name (ARN) of the instance profile associated
 	// with the IAM role for the instance.
-	IAMInstanceProfile *string `locationName:"IamInstanceProfile" type:"string"`
+	IAMInstanceProfile *string `locationName:"IamInstanceProfile" type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The ID of the Amazon Machine Image (AMI).
-	ImageID *string `locationName:"ImageId" type:"string" required:"true"`
+	ImageID *string `locationName:"ImageId" type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// Controls whether instances in this group are launched with detailed monitoring.
 	InstanceMonitoring *InstanceMonitoring `type:"structure"`
 
 	// The instance type for the EC2 instances.
-	InstanceType *string `type:"string" required:"true"`
+	InstanceType *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The ID of the kernel associated with the AMI.
-	KernelID *string `locationName:"KernelId" type:"string"`
+	KernelID *string `locationName:"KernelId" type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The name of the key pair.
-	KeyName *string `type:"string"`
+	KeyName *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The Amazon Resource Name (ARN) of the launch configuration.
-	LaunchConfigurationARN *string `type:"string"`
+	LaunchConfigurationARN *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The name of the launch configuration.
-	LaunchConfigurationName *string `type:"string" required:"true"`
+	LaunchConfigurationName *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The tenancy of the instance, either default or dedicated. An instance with
 	// dedicated tenancy runs in an isolated, single-tenant hardware and can only
 	// be launched in a VPC.
-	PlacementTenancy *string `type:"string"`
+	PlacementTenancy *string `type:"string" min:"1" max:"64" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The ID of the RAM disk associated with the AMI.
-	RAMDiskID *string `locationName:"RamdiskId" type:"string"`
+	RAMDiskID *string `locationName:"RamdiskId" type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The security groups to associate with the EC2 instances.
 	SecurityGroups []*string `type:"list"`
 
 	// The price to bid when launching Spot Instances.
-	SpotPrice *string `type:"string"`
+	SpotPrice *string `type:"string" min:"1" max:"255"`
 
 	// The user data available to the EC2 instances.
-	UserData *string `type:"string"`
+	UserData *string `type:"string" max:"21847" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataLaunchConfiguration `json:"-", xml:"-"`
 }
@@ -2999,6 +3516,11 @@ type metadataLaunchConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the LaunchConfiguration.
+func (s LaunchConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a lifecycle hook, which tells Auto Scaling that you want to perform
 // an action when an instance launches or terminates. When you have a lifecycle
 // hook in place, the Auto Scaling group will either:
@@ -3010,7 +3532,7 @@ type metadataLaunchConfiguration struct {
 // in the Auto Scaling Developer Guide.
 type LifecycleHook struct {
 	// The name of the Auto Scaling group for the lifecycle hook.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// Defines the action the Auto Scaling group should take when the lifecycle
 	// hook timeout elapses or if an unexpected failure occurs. The valid values
@@ -3028,7 +3550,7 @@ type LifecycleHook struct {
 	HeartbeatTimeout *int64 `type:"integer"`
 
 	// The name of the lifecycle hook.
-	LifecycleHookName *string `type:"string"`
+	LifecycleHookName *string `type:"string" min:"1" max:"255" pattern:"[A-Za-z0-9\\-_\\/]+"`
 
 	// The state of the EC2 instance to which you want to attach the lifecycle hook.
 	// For a list of lifecycle hook types, see DescribeLifecycleHooks.
@@ -3036,7 +3558,7 @@ type LifecycleHook struct {
 
 	// Additional information that you want to include any time Auto Scaling sends
 	// a message to the notification target.
-	NotificationMetadata *string `type:"string"`
+	NotificationMetadata *string `type:"string" min:"1" max:"1023" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The ARN of the notification target that Auto Scaling uses to notify you when
 	// an instance is in the transition state for the lifecycle hook. This ARN target
@@ -3045,11 +3567,11 @@ type LifecycleHook struct {
 	//
 	//  Lifecycle action token User account ID Name of the Auto Scaling group Lifecycle
 	// hook name EC2 instance ID Lifecycle transition Notification metadata
-	NotificationTargetARN *string `type:"string"`
+	NotificationTargetARN *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The ARN of the IAM role that allows the Auto Scaling group to publish to
 	// the specified notification target.
-	RoleARN *string `type:"string"`
+	RoleARN *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataLifecycleHook `json:"-", xml:"-"`
 }
@@ -3058,10 +3580,71 @@ type metadataLifecycleHook struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the LifecycleHook.
+func (s LifecycleHook) String() string {
+	return awsutil.StringValue(s)
+}
+
+// LifecycleState is an enum of the values of LifecycleState members.
+type LifecycleState string
+
+// The values of LifecycleState.
+const (
+	LifecycleStatePending            LifecycleState = "Pending"
+	LifecycleStatePendingWait        LifecycleState = "Pending:Wait"
+	LifecycleStatePendingProceed     LifecycleState = "Pending:Proceed"
+	LifecycleStateQuarantined        LifecycleState = "Quarantined"
+	LifecycleStateInService          LifecycleState = "InService"
+	LifecycleStateTerminating        LifecycleState = "Terminating"
+	LifecycleStateTerminatingWait    LifecycleState = "Terminating:Wait"
+	LifecycleStateTerminatingProceed LifecycleState = "Terminating:Proceed"
+	LifecycleStateTerminated         LifecycleState = "Terminated"
+	LifecycleStateDetaching          LifecycleState = "Detaching"
+	LifecycleStateDetached           LifecycleState = "Detached"
+	LifecycleStateEnteringStandby    LifecycleState = "EnteringStandby"
+	LifecycleStateStandby            LifecycleState = "Standby"
+)
+
+// LifecycleStateValues returns the values of LifecycleState known to this
+// version of the SDK. The service may accept values added since.
+func LifecycleStateValues() []LifecycleState {
+	return []LifecycleState{
+		LifecycleState("Pending"),
+		LifecycleState("Pending:Wait"),
+		LifecycleState("Pending:Proceed"),
+		LifecycleState("Quarantined"),
+		LifecycleState("InService"),
+		LifecycleState("Terminating"),
+		LifecycleState("Terminating:Wait"),
+		LifecycleState("Terminating:Proceed"),
+		LifecycleState("Terminated"),
+		LifecycleState("Detaching"),
+		LifecycleState("Detached"),
+		LifecycleState("EnteringStandby"),
+		LifecycleState("Standby"),
+	}
+}
+
+// String returns the LifecycleState as the string of a member.
+func (v LifecycleState) String() string {
+	return string(v)
+}
+
+// ParseLifecycleState returns the LifecycleState of str, failing if it is not
+// one of the LifecycleStateValues.
+func ParseLifecycleState(str string) (LifecycleState, error) {
+	for _, v := range LifecycleStateValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid LifecycleState value %q", str)
+}
+
 // Describes a metric.
 type MetricCollectionType struct {
 	// The metric.
-	Metric *string `type:"string"`
+	Metric *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataMetricCollectionType `json:"-", xml:"-"`
 }
@@ -3070,10 +3653,15 @@ type metadataMetricCollectionType struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the MetricCollectionType.
+func (s MetricCollectionType) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a granularity of a metric.
 type MetricGranularityType struct {
 	// The granularity.
-	Granularity *string `type:"string"`
+	Granularity *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataMetricGranularityType `json:"-", xml:"-"`
 }
@@ -3082,17 +3670,22 @@ type metadataMetricGranularityType struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the MetricGranularityType.
+func (s MetricGranularityType) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a notification.
 type NotificationConfiguration struct {
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The types of events for an action to start.
-	NotificationType *string `type:"string"`
+	NotificationType *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The Amazon Resource Name (ARN) of the Amazon Simple Notification Service
 	// (SNS) topic.
-	TopicARN *string `type:"string"`
+	TopicARN *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataNotificationConfiguration `json:"-", xml:"-"`
 }
@@ -3101,6 +3694,11 @@ type metadataNotificationConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the NotificationConfiguration.
+func (s NotificationConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a process type.
 //
 // There are two primary Auto Scaling process types--Launch and Terminate.
@@ -3175,7 +3773,7 @@ type metadataNotificationConfiguration struct {
 // or Terminate, your scheduled actions might not function as expected.
 type ProcessType struct {
 	// The name of the process.
-	ProcessName *string `type:"string" required:"true"`
+	ProcessName *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	metadataProcessType `json:"-", xml:"-"`
 }
@@ -3184,10 +3782,15 @@ type metadataProcessType struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ProcessType.
+func (s ProcessType) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutLifecycleHookInput struct {
 	// The name of the Auto Scaling group to which you want to assign the lifecycle
 	// hook.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// Defines the action the Auto Scaling group should take when the lifecycle
 	// hook timeout elapses or if an unexpected failure occurs. The value for this
@@ -3203,7 +3806,7 @@ type PutLifecycleHookInput struct {
 	HeartbeatTimeout *int64 `type:"integer"`
 
 	// The name of the lifecycle hook.
-	LifecycleHookName *string `type:"string" required:"true"`
+	LifecycleHookName *string `type:"string" min:"1" max:"255" pattern:"[A-Za-z0-9\\-_\\/]+" required:"true"`
 
 	// The Amazon EC2 instance state to which you want to attach the lifecycle hook.
 	// See DescribeLifecycleHookTypes for a list of available lifecycle hook types.
@@ -3214,7 +3817,7 @@ type PutLifecycleHookInput struct {
 
 	// Contains additional information that you want to include any time Auto Scaling
 	// sends a message to the notification target.
-	NotificationMetadata *string `type:"string"`
+	NotificationMetadata *string `type:"string" min:"1" max:"1023" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The ARN of the notification target that Auto Scaling will use to notify you
 	// when an instance is in the transition state for the lifecycle hook. This
@@ -3235,14 +3838,14 @@ type PutLifecycleHookInput struct {
 	//
 	// When you call this operation, a test message is sent to the notification
 	// target. This test message contains an additional key/value pair: Event:autoscaling:TEST_NOTIFICATION.
-	NotificationTargetARN *string `type:"string"`
+	NotificationTargetARN *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The ARN of the IAM role that allows the Auto Scaling group to publish to
 	// the specified notification target.
 	//
 	//  This parameter is required for new lifecycle hooks, but optional when updating
 	// existing hooks.
-	RoleARN *string `type:"string"`
+	RoleARN *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataPutLifecycleHookInput `json:"-", xml:"-"`
 }
@@ -3251,6 +3854,11 @@ type metadataPutLifecycleHookInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutLifecycleHookInput.
+func (s PutLifecycleHookInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutLifecycleHookOutput struct {
 	metadataPutLifecycleHookOutput `json:"-", xml:"-"`
 }
@@ -3259,9 +3867,14 @@ type metadataPutLifecycleHookOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutLifecycleHookOutput.
+func (s PutLifecycleHookOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutNotificationConfigurationInput struct {
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The type of event that will cause the notification to be sent. For details
 	// about notification types supported by Auto Scaling, see DescribeAutoScalingNotificationTypes.
@@ -3269,7 +3882,7 @@ type PutNotificationConfigurationInput struct {
 
 	// The Amazon Resource Name (ARN) of the Amazon Simple Notification Service
 	// (SNS) topic.
-	TopicARN *string `type:"string" required:"true"`
+	TopicARN *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	metadataPutNotificationConfigurationInput `json:"-", xml:"-"`
 }
@@ -3278,6 +3891,11 @@ type metadataPutNotificationConfigurationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutNotificationConfigurationInput.
+func (s PutNotificationConfigurationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutNotificationConfigurationOutput struct {
 	metadataPutNotificationConfigurationOutput `json:"-", xml:"-"`
 }
@@ -3286,6 +3904,11 @@ type metadataPutNotificationConfigurationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutNotificationConfigurationOutput.
+func (s PutNotificationConfigurationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutScalingPolicyInput struct {
 	// Specifies whether the ScalingAdjustment is an absolute number or a percentage
 	// of the current capacity. Valid values are ChangeInCapacity, ExactCapacity,
@@ -3293,10 +3916,10 @@ type PutScalingPolicyInput struct {
 	//
 	// For more information, see Dynamic Scaling (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/as-scale-based-on-demand.html)
 	// in the Auto Scaling Developer Guide.
-	AdjustmentType *string `type:"string" required:"true"`
+	AdjustmentType *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The name or ARN of the group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The amount of time, in seconds, after a scaling activity completes and before
 	// the next scaling activity can start.
@@ -3314,7 +3937,7 @@ type PutScalingPolicyInput struct {
 	MinAdjustmentStep *int64 `type:"integer"`
 
 	// The name of the policy.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The number of instances by which to scale. AdjustmentType determines the
 	// interpretation of this number (e.g., as an absolute number or as a percentage
@@ -3329,9 +3952,14 @@ type metadataPutScalingPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutScalingPolicyInput.
+func (s PutScalingPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutScalingPolicyOutput struct {
 	// The Amazon Resource Name (ARN) of the policy.
-	PolicyARN *string `type:"string"`
+	PolicyARN *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataPutScalingPolicyOutput `json:"-", xml:"-"`
 }
@@ -3340,9 +3968,14 @@ type metadataPutScalingPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutScalingPolicyOutput.
+func (s PutScalingPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutScheduledUpdateGroupActionInput struct {
 	// The name or Amazon Resource Name (ARN) of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The number of Amazon EC2 instances that should be running in the group.
 	DesiredCapacity *int64 `type:"integer"`
@@ -3362,10 +3995,10 @@ type PutScheduledUpdateGroupActionInput struct {
 	//
 	// When StartTime and EndTime are specified with Recurrence, they form the
 	// boundaries of when the recurring action will start and stop.
-	Recurrence *string `type:"string"`
+	Recurrence *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The name of this scaling action.
-	ScheduledActionName *string `type:"string" required:"true"`
+	ScheduledActionName *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The time for this action to start, as in --start-time 2010-06-01T00:00:00Z.
 	//
@@ -3391,6 +4024,11 @@ type metadataPutScheduledUpdateGroupActionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutScheduledUpdateGroupActionInput.
+func (s PutScheduledUpdateGroupActionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutScheduledUpdateGroupActionOutput struct {
 	metadataPutScheduledUpdateGroupActionOutput `json:"-", xml:"-"`
 }
@@ -3399,17 +4037,22 @@ type metadataPutScheduledUpdateGroupActionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutScheduledUpdateGroupActionOutput.
+func (s PutScheduledUpdateGroupActionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RecordLifecycleActionHeartbeatInput struct {
 	// The name of the Auto Scaling group for the hook.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// A token that uniquely identifies a specific lifecycle action associated with
 	// an instance. Auto Scaling sends this token to the notification target you
 	// specified when you created the lifecycle hook.
-	LifecycleActionToken *string `type:"string" required:"true"`
+	LifecycleActionToken *string `type:"string" min:"36" max:"36" required:"true"`
 
 	// The name of the lifecycle hook.
-	LifecycleHookName *string `type:"string" required:"true"`
+	LifecycleHookName *string `type:"string" min:"1" max:"255" pattern:"[A-Za-z0-9\\-_\\/]+" required:"true"`
 
 	metadataRecordLifecycleActionHeartbeatInput `json:"-", xml:"-"`
 }
@@ -3418,6 +4061,11 @@ type metadataRecordLifecycleActionHeartbeatInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RecordLifecycleActionHeartbeatInput.
+func (s RecordLifecycleActionHeartbeatInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RecordLifecycleActionHeartbeatOutput struct {
 	metadataRecordLifecycleActionHeartbeatOutput `json:"-", xml:"-"`
 }
@@ -3426,6 +4074,11 @@ type metadataRecordLifecycleActionHeartbeatOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RecordLifecycleActionHeartbeatOutput.
+func (s RecordLifecycleActionHeartbeatOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ResumeProcessesOutput struct {
 	metadataResumeProcessesOutput `json:"-", xml:"-"`
 }
@@ -3434,18 +4087,73 @@ type metadataResumeProcessesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ResumeProcessesOutput.
+func (s ResumeProcessesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ScalingActivityStatusCode is an enum of the values of ScalingActivityStatusCode members.
+type ScalingActivityStatusCode string
+
+// The values of ScalingActivityStatusCode.
+const (
+	ScalingActivityStatusCodeWaitingForSpotInstanceRequestId ScalingActivityStatusCode = "WaitingForSpotInstanceRequestId"
+	ScalingActivityStatusCodeWaitingForSpotInstanceId        ScalingActivityStatusCode = "WaitingForSpotInstanceId"
+	ScalingActivityStatusCodeWaitingForInstanceId            ScalingActivityStatusCode = "WaitingForInstanceId"
+	ScalingActivityStatusCodePreInService                    ScalingActivityStatusCode = "PreInService"
+	ScalingActivityStatusCodeInProgress                      ScalingActivityStatusCode = "InProgress"
+	ScalingActivityStatusCodeWaitingForELBConnectionDraining ScalingActivityStatusCode = "WaitingForELBConnectionDraining"
+	ScalingActivityStatusCodeMidLifecycleAction              ScalingActivityStatusCode = "MidLifecycleAction"
+	ScalingActivityStatusCodeSuccessful                      ScalingActivityStatusCode = "Successful"
+	ScalingActivityStatusCodeFailed                          ScalingActivityStatusCode = "Failed"
+	ScalingActivityStatusCodeCancelled                       ScalingActivityStatusCode = "Cancelled"
+)
+
+// ScalingActivityStatusCodeValues returns the values of ScalingActivityStatusCode known to this
+// version of the SDK. The service may accept values added since.
+func ScalingActivityStatusCodeValues() []ScalingActivityStatusCode {
+	return []ScalingActivityStatusCode{
+		ScalingActivityStatusCode("WaitingForSpotInstanceRequestId"),
+		ScalingActivityStatusCode("WaitingForSpotInstanceId"),
+		ScalingActivityStatusCode("WaitingForInstanceId"),
+		ScalingActivityStatusCode("PreInService"),
+		ScalingActivityStatusCode("InProgress"),
+		ScalingActivityStatusCode("WaitingForELBConnectionDraining"),
+		ScalingActivityStatusCode("MidLifecycleAction"),
+		ScalingActivityStatusCode("Successful"),
+		ScalingActivityStatusCode("Failed"),
+		ScalingActivityStatusCode("Cancelled"),
+	}
+}
+
+// String returns the ScalingActivityStatusCode as the string of a member.
+func (v ScalingActivityStatusCode) String() string {
+	return string(v)
+}
+
+// ParseScalingActivityStatusCode returns the ScalingActivityStatusCode of str, failing if it is not
+// one of the ScalingActivityStatusCodeValues.
+func ParseScalingActivityStatusCode(str string) (ScalingActivityStatusCode, error) {
+	for _, v := range ScalingActivityStatusCodeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ScalingActivityStatusCode value %q", str)
+}
+
 // Describes a scaling policy.
 type ScalingPolicy struct {
 	// Specifies whether the ScalingAdjustment is an absolute number or a percentage
 	// of the current capacity. Valid values are ChangeInCapacity, ExactCapacity,
 	// and PercentChangeInCapacity.
-	AdjustmentType *string `type:"string"`
+	AdjustmentType *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The CloudWatch Alarms related to the policy.
 	Alarms []*Alarm `type:"list"`
 
 	// The name of the Auto Scaling group associated with this scaling policy.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The amount of time, in seconds, after a scaling activity completes before
 	// any further trigger-related scaling activities can start.
@@ -3456,10 +4164,10 @@ type ScalingPolicy struct {
 	MinAdjustmentStep *int64 `type:"integer"`
 
 	// The Amazon Resource Name (ARN) of the policy.
-	PolicyARN *string `type:"string"`
+	PolicyARN *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The name of the scaling policy.
-	PolicyName *string `type:"string"`
+	PolicyName *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The number associated with the specified adjustment type. A positive value
 	// adds to the current capacity and a negative value removes from the current
@@ -3473,9 +4181,14 @@ type metadataScalingPolicy struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ScalingPolicy.
+func (s ScalingPolicy) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ScalingProcessQuery struct {
 	// The name or Amazon Resource Name (ARN) of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// One or more of the following processes:
 	//
@@ -3490,10 +4203,15 @@ type metadataScalingProcessQuery struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ScalingProcessQuery.
+func (s ScalingProcessQuery) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a scheduled update to an Auto Scaling group.
 type ScheduledUpdateGroupAction struct {
 	// The name of the group.
-	AutoScalingGroupName *string `type:"string"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The number of instances you prefer to maintain in the group.
 	DesiredCapacity *int64 `type:"integer"`
@@ -3509,13 +4227,13 @@ type ScheduledUpdateGroupAction struct {
 	MinSize *int64 `type:"integer"`
 
 	// The regular schedule that an action occurs.
-	Recurrence *string `type:"string"`
+	Recurrence *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The Amazon Resource Name (ARN) of the scheduled action.
-	ScheduledActionARN *string `type:"string"`
+	ScheduledActionARN *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The name of the scheduled action.
-	ScheduledActionName *string `type:"string"`
+	ScheduledActionName *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The time that the action is scheduled to begin. This value can be up to one
 	// month in the future.
@@ -3536,9 +4254,14 @@ type metadataScheduledUpdateGroupAction struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ScheduledUpdateGroupAction.
+func (s ScheduledUpdateGroupAction) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SetDesiredCapacityInput struct {
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The number of EC2 instances that should be running in the Auto Scaling group.
 	DesiredCapacity *int64 `type:"integer" required:"true"`
@@ -3556,6 +4279,11 @@ type metadataSetDesiredCapacityInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetDesiredCapacityInput.
+func (s SetDesiredCapacityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SetDesiredCapacityOutput struct {
 	metadataSetDesiredCapacityOutput `json:"-", xml:"-"`
 }
@@ -3564,14 +4292,19 @@ type metadataSetDesiredCapacityOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetDesiredCapacityOutput.
+func (s SetDesiredCapacityOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SetInstanceHealthInput struct {
 	// The health status of the instance. Set to Healthy if you want the instance
 	// to remain in service. Set to Unhealthy if you want the instance to be out
 	// of service. Auto Scaling will terminate and replace the unhealthy instance.
-	HealthStatus *string `type:"string" required:"true"`
+	HealthStatus *string `type:"string" min:"1" max:"32" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The ID of the EC2 instance.
-	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"1" max:"16" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// If the Auto Scaling group of the specified instance has a HealthCheckGracePeriod
 	// specified for the group, by default, this call will respect the grace period.
@@ -3589,6 +4322,11 @@ type metadataSetInstanceHealthInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetInstanceHealthInput.
+func (s SetInstanceHealthInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SetInstanceHealthOutput struct {
 	metadataSetInstanceHealthOutput `json:"-", xml:"-"`
 }
@@ -3597,6 +4335,11 @@ type metadataSetInstanceHealthOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetInstanceHealthOutput.
+func (s SetInstanceHealthOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SuspendProcessesOutput struct {
 	metadataSuspendProcessesOutput `json:"-", xml:"-"`
 }
@@ -3605,14 +4348,19 @@ type metadataSuspendProcessesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SuspendProcessesOutput.
+func (s SuspendProcessesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an Auto Scaling process that has been suspended. For more information,
 // see ProcessType.
 type SuspendedProcess struct {
 	// The name of the suspended process.
-	ProcessName *string `type:"string"`
+	ProcessName *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The reason that the process was suspended.
-	SuspensionReason *string `type:"string"`
+	SuspensionReason *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataSuspendedProcess `json:"-", xml:"-"`
 }
@@ -3621,10 +4369,15 @@ type metadataSuspendedProcess struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SuspendedProcess.
+func (s SuspendedProcess) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a tag applied to an Auto Scaling group.
 type Tag struct {
 	// The tag key.
-	Key *string `type:"string" required:"true"`
+	Key *string `type:"string" min:"1" max:"128" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// Specifies whether the tag is applied to instances launched after the tag
 	// is created. The same behavior applies to updates: If you change a tag, it
@@ -3632,14 +4385,14 @@ type Tag struct {
 	PropagateAtLaunch *bool `type:"boolean"`
 
 	// The name of the group.
-	ResourceID *string `locationName:"ResourceId" type:"string"`
+	ResourceID *string `locationName:"ResourceId" type:"string" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The kind of resource to which the tag is applied. Currently, Auto Scaling
 	// supports the auto-scaling-group resource type.
-	ResourceType *string `type:"string"`
+	ResourceType *string `type:"string" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The tag value.
-	Value *string `type:"string"`
+	Value *string `type:"string" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataTag `json:"-", xml:"-"`
 }
@@ -3648,10 +4401,15 @@ type metadataTag struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Tag.
+func (s Tag) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a tag applied to an Auto Scaling group.
 type TagDescription struct {
 	// The tag key.
-	Key *string `type:"string"`
+	Key *string `type:"string" min:"1" max:"128" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// Specifies whether the tag is applied to instances launched after the tag
 	// is created. The same behavior applies to updates: If you change a tag, it
@@ -3659,14 +4417,14 @@ type TagDescription struct {
 	PropagateAtLaunch *bool `type:"boolean"`
 
 	// The name of the group.
-	ResourceID *string `locationName:"ResourceId" type:"string"`
+	ResourceID *string `locationName:"ResourceId" type:"string" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The kind of resource to which the tag is applied. Currently, Auto Scaling
 	// supports the auto-scaling-group resource type.
-	ResourceType *string `type:"string"`
+	ResourceType *string `type:"string" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The tag value.
-	Value *string `type:"string"`
+	Value *string `type:"string" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataTagDescription `json:"-", xml:"-"`
 }
@@ -3675,9 +4433,14 @@ type metadataTagDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TagDescription.
+func (s TagDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
 type TerminateInstanceInAutoScalingGroupInput struct {
 	// The ID of the EC2 instance.
-	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"1" max:"16" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// If true, terminating this instance also decrements the size of the Auto Scaling
 	// group.
@@ -3690,6 +4453,11 @@ type metadataTerminateInstanceInAutoScalingGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TerminateInstanceInAutoScalingGroupInput.
+func (s TerminateInstanceInAutoScalingGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type TerminateInstanceInAutoScalingGroupOutput struct {
 	// A scaling activity.
 	Activity *Activity `type:"structure"`
@@ -3701,12 +4469,17 @@ type metadataTerminateInstanceInAutoScalingGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TerminateInstanceInAutoScalingGroupOutput.
+func (s TerminateInstanceInAutoScalingGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateAutoScalingGroupInput struct {
 	// The name of the Auto Scaling group.
-	AutoScalingGroupName *string `type:"string" required:"true"`
+	AutoScalingGroupName *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// One or more Availability Zones for the group.
-	AvailabilityZones []*string `type:"list"`
+	AvailabilityZones []*string `type:"list" min:"1"`
 
 	// The amount of time, in seconds, after a scaling activity completes before
 	// another scaling activity can start. For more information, see Understanding
@@ -3727,10 +4500,10 @@ type UpdateAutoScalingGroupInput struct {
 	// The type of health check for the instances in the Auto Scaling group. The
 	// health check type can either be EC2 for Amazon EC2 or ELB for Elastic Load
 	// Balancing.
-	HealthCheckType *string `type:"string"`
+	HealthCheckType *string `type:"string" min:"1" max:"32" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The name of the launch configuration.
-	LaunchConfigurationName *string `type:"string"`
+	LaunchConfigurationName *string `type:"string" min:"1" max:"1600" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The maximum size of the Auto Scaling group.
 	MaxSize *int64 `type:"integer"`
@@ -3740,7 +4513,7 @@ type UpdateAutoScalingGroupInput struct {
 
 	// The name of the placement group into which you'll launch your instances,
 	// if any. For more information, see Placement Groups (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/placement-groups.html).
-	PlacementGroup *string `type:"string"`
+	PlacementGroup *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// A standalone termination policy or a list of termination policies used to
 	// select the instance to terminate. The policies are executed in the order
@@ -3759,7 +4532,7 @@ type UpdateAutoScalingGroupInput struct {
 	//
 	//  For more information, see Auto Scaling and Amazon VPC (http://docs.aws.amazon.com/AutoScaling/latest/DeveloperGuide/autoscalingsubnets.html)
 	// in the Auto Scaling Developer Guide.
-	VPCZoneIdentifier *string `type:"string"`
+	VPCZoneIdentifier *string `type:"string" min:"1" max:"255" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataUpdateAutoScalingGroupInput `json:"-", xml:"-"`
 }
@@ -3768,10 +4541,20 @@ type metadataUpdateAutoScalingGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateAutoScalingGroupInput.
+func (s UpdateAutoScalingGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateAutoScalingGroupOutput struct {
 	metadataUpdateAutoScalingGroupOutput `json:"-", xml:"-"`
 }
 
 type metadataUpdateAutoScalingGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the UpdateAutoScalingGroupOutput.
+func (s UpdateAutoScalingGroupOutput) String() string {
+	return awsutil.StringValue(s)
 }
\ No newline at end of file
@@ -4,9 +4,11 @@
 package cloudformation
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // CancelUpdateStackRequest generates a request for the CancelUpdateStack operation.
@@ -101,6 +103,10 @@ func (c *CloudFormation) DescribeStackEventsRequest(input *DescribeStackEventsIn
 			Name:       "DescribeStackEvents",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextToken"},
+				OutputTokens: []string{"NextToken"},
+			},
 		}
 	}
 
@@ -123,6 +129,16 @@ func (c *CloudFormation) DescribeStackEvents(input *DescribeStackEventsInput) (o
 	return
 }
 
+// DescribeStackEventsPages iterates over the pages of a DescribeStackEvents operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *CloudFormation) DescribeStackEventsPages(input *DescribeStackEventsInput, fn func(p *DescribeStackEventsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeStackEventsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeStackEventsOutput), lastPage)
+	})
+}
+
 var opDescribeStackEvents *aws.Operation
 
 // DescribeStackResourceRequest generates a request for the DescribeStackResource operation.
@@ -203,6 +219,10 @@ func (c *CloudFormation) DescribeStacksRequest(input *DescribeStacksInput) (req
 			Name:       "DescribeStacks",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextToken"},
+				OutputTokens: []string{"NextToken"},
+			},
 		}
 	}
 
@@ -221,6 +241,16 @@ func (c *CloudFormation) DescribeStacks(input *DescribeStacksInput) (output *Des
 	return
 }
 
+// DescribeStacksPages iterates over the pages of a DescribeStacks operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *CloudFormation) DescribeStacksPages(input *DescribeStacksInput, fn func(p *DescribeStacksOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeStacksRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeStacksOutput), lastPage)
+	})
+}
+
 var opDescribeStacks *aws.Operation
 
 // EstimateTemplateCostRequest generates a request for the EstimateTemplateCost operation.
@@ -352,6 +382,10 @@ func (c *CloudFormation) ListStackResourcesRequest(input *ListStackResourcesInpu
 			Name:       "ListStackResources",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextToken"},
+				OutputTokens: []string{"NextToken"},
+			},
 		}
 	}
 
@@ -372,6 +406,16 @@ func (c *CloudFormation) ListStackResources(input *ListStackResourcesInput) (out
 	return
 }
 
+// ListStackResourcesPages iterates over the pages of a ListStackResources operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *CloudFormation) ListStackResourcesPages(input *ListStackResourcesInput, fn func(p *ListStackResourcesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListStackResourcesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListStackResourcesOutput), lastPage)
+	})
+}
+
 var opListStackResources *aws.Operation
 
 // ListStacksRequest generates a request for the ListStacks operation.
@@ -381,6 +425,10 @@ func (c *CloudFormation) ListStacksRequest(input *ListStacksInput) (req *aws.Req
 			Name:       "ListStacks",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextToken"},
+				OutputTokens: []string{"NextToken"},
+			},
 		}
 	}
 
@@ -402,6 +450,16 @@ func (c *CloudFormation) ListStacks(input *ListStacksInput) (output *ListStacksO
 	return
 }
 
+// ListStacksPages iterates over the pages of a ListStacks operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *CloudFormation) ListStacksPages(input *ListStacksInput, fn func(p *ListStacksOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListStacksRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListStacksOutput), lastPage)
+	})
+}
+
 var opListStacks *aws.Operation
 
 // SetStackPolicyRequest generates a request for the SetStackPolicy operation.
@@ -536,6 +594,11 @@ type metadataCancelUpdateStackInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CancelUpdateStackInput.
+func (s CancelUpdateStackInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CancelUpdateStackOutput struct {
 	metadataCancelUpdateStackOutput `json:"-", xml:"-"`
 }
@@ -544,6 +607,43 @@ type metadataCancelUpdateStackOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CancelUpdateStackOutput.
+func (s CancelUpdateStackOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// Capability is an enum of the values of Capability members.
+type Capability string
+
+// The values of Capability.
+const (
+	CapabilityCAPABILITYIAM Capability = "CAPABILITY_IAM"
+)
+
+// CapabilityValues returns the values of Capability known to this
+// version of the SDK. The service may accept values added since.
+func CapabilityValues() []Capability {
+	return []Capability{
+		Capability("CAPABILITY_IAM"),
+	}
+}
+
+// String returns the Capability as the string of a member.
+func (v Capability) String() string {
+	return string(v)
+}
+
+// ParseCapability returns the Capability of str, failing if it is not
+// one of the CapabilityValues.
+func ParseCapability(str string) (Capability, error) {
+	for _, v := range CapabilityValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid Capability value %q", str)
+}
+
 // The input for CreateStack action.
 type CreateStackInput struct {
 	// A list of capabilities that you must specify before AWS CloudFormation can
@@ -574,14 +674,14 @@ type CreateStackInput struct {
 	// The Simple Notification Service (SNS) topic ARNs to publish stack related
 	// events. You can find your SNS topic ARNs using the SNS console (http://console.aws.amazon.com/sns)
 	// or your Command Line Interface (CLI).
-	NotificationARNs []*string `type:"list"`
+	NotificationARNs []*string `type:"list" max:"5"`
 
 	// Determines what action will be taken if stack creation fails. This must be
 	// one of: DO_NOTHING, ROLLBACK, or DELETE. You can specify either OnFailure
 	// or DisableRollback, but not both.
 	//
 	// Default: ROLLBACK
-	OnFailure *string `type:"string"`
+	OnFailure *string `type:"string" enum:"DO_NOTHING,ROLLBACK,DELETE"`
 
 	// A list of Parameter structures that specify input parameters for the stack.
 	Parameters []*Parameter `type:"list"`
@@ -597,13 +697,13 @@ type CreateStackInput struct {
 	// Prevent Updates to Stack Resources (http://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/protect-stack-resources.html)
 	// in the AWS CloudFormation User Guide. You can specify either the StackPolicyBody
 	// or the StackPolicyURL parameter, but not both.
-	StackPolicyBody *string `type:"string"`
+	StackPolicyBody *string `type:"string" min:"1" max:"16384"`
 
 	// Location of a file containing the stack policy. The URL must point to a policy
 	// (max size: 16KB) located in an S3 bucket in the same region as the stack.
 	// You can specify either the StackPolicyBody or the StackPolicyURL parameter,
 	// but not both.
-	StackPolicyURL *string `type:"string"`
+	StackPolicyURL *string `type:"string" min:"1" max:"1350"`
 
 	// A set of user-defined Tags to associate with this stack, represented by key/value
 	// pairs. Tags defined for the stack are propagated to EC2 resources that are
@@ -617,7 +717,7 @@ type CreateStackInput struct {
 	//
 	// Conditional: You must specify either the TemplateBody or the TemplateURL
 	// parameter, but not both.
-	TemplateBody *string `type:"string"`
+	TemplateBody *string `type:"string" min:"1"`
 
 	// Location of file containing the template body. The URL must point to a template
 	// (max size: 307,200 bytes) located in an S3 bucket in the same region as the
@@ -626,12 +726,12 @@ type CreateStackInput struct {
 	//
 	// Conditional: You must specify either the TemplateBody or the TemplateURL
 	// parameter, but not both.
-	TemplateURL *string `type:"string"`
+	TemplateURL *string `type:"string" min:"1" max:"1024"`
 
 	// The amount of time that can pass before the stack status becomes CREATE_FAILED;
 	// if DisableRollback is not set or is set to false, the stack will be rolled
 	// back.
-	TimeoutInMinutes *int64 `type:"integer"`
+	TimeoutInMinutes *int64 `type:"integer" min:"1"`
 
 	metadataCreateStackInput `json:"-", xml:"-"`
 }
@@ -640,6 +740,11 @@ type metadataCreateStackInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateStackInput.
+func (s CreateStackInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for a CreateStack action.
 type CreateStackOutput struct {
 	// Unique identifier of the stack.
@@ -652,6 +757,11 @@ type metadataCreateStackOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateStackOutput.
+func (s CreateStackOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for DeleteStack action.
 type DeleteStackInput struct {
 	// The name or the unique identifier associated with the stack.
@@ -664,6 +774,11 @@ type metadataDeleteStackInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteStackInput.
+func (s DeleteStackInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteStackOutput struct {
 	metadataDeleteStackOutput `json:"-", xml:"-"`
 }
@@ -672,13 +787,18 @@ type metadataDeleteStackOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteStackOutput.
+func (s DeleteStackOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for DescribeStackEvents action.
 type DescribeStackEventsInput struct {
 	// String that identifies the start of the next list of events, if there is
 	// one.
 	//
 	// Default: There is no default value.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"1024"`
 
 	// The name or the unique identifier associated with the stack, which are not
 	// always interchangeable:
@@ -695,11 +815,16 @@ type metadataDescribeStackEventsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeStackEventsInput.
+func (s DescribeStackEventsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for a DescribeStackEvents action.
 type DescribeStackEventsOutput struct {
 	// String that identifies the start of the next list of events, if there is
 	// one.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"1024"`
 
 	// A list of StackEvents structures.
 	StackEvents []*StackEvent `type:"list"`
@@ -711,6 +836,11 @@ type metadataDescribeStackEventsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeStackEventsOutput.
+func (s DescribeStackEventsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for DescribeStackResource action.
 type DescribeStackResourceInput struct {
 	// The logical name of the resource as specified in the template.
@@ -733,6 +863,11 @@ type metadataDescribeStackResourceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeStackResourceInput.
+func (s DescribeStackResourceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for a DescribeStackResource action.
 type DescribeStackResourceOutput struct {
 	// A StackResourceDetail structure containing the description of the specified
@@ -746,6 +881,11 @@ type metadataDescribeStackResourceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeStackResourceOutput.
+func (s DescribeStackResourceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for DescribeStackResources action.
 type DescribeStackResourcesInput struct {
 	// The logical name of the resource as specified in the template.
@@ -785,6 +925,11 @@ type metadataDescribeStackResourcesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeStackResourcesInput.
+func (s DescribeStackResourcesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for a DescribeStackResources action.
 type DescribeStackResourcesOutput struct {
 	// A list of StackResource structures.
@@ -797,11 +942,16 @@ type metadataDescribeStackResourcesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeStackResourcesOutput.
+func (s DescribeStackResourcesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for DescribeStacks action.
 type DescribeStacksInput struct {
 	// String that identifies the start of the next list of stacks, if there is
 	// one.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"1024"`
 
 	// The name or the unique identifier associated with the stack, which are not
 	// always interchangeable:
@@ -818,11 +968,16 @@ type metadataDescribeStacksInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeStacksInput.
+func (s DescribeStacksInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for a DescribeStacks action.
 type DescribeStacksOutput struct {
 	// String that identifies the start of the next list of stacks, if there is
 	// one.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"1024"`
 
 	// A list of stack structures.
 	Stacks []*Stack `type:"list"`
@@ -834,6 +989,11 @@ type metadataDescribeStacksOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeStacksOutput.
+func (s DescribeStacksOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type EstimateTemplateCostInput struct {
 	// A list of Parameter structures that specify input parameters.
 	Parameters []*Parameter `type:"list"`
@@ -845,7 +1005,7 @@ type EstimateTemplateCostInput struct {
 	//
 	// Conditional: You must pass TemplateBody or TemplateURL. If both are passed,
 	// only TemplateBody is used.
-	TemplateBody *string `type:"string"`
+	TemplateBody *string `type:"string" min:"1"`
 
 	// Location of file containing the template body. The URL must point to a template
 	// located in an S3 bucket in the same region as the stack. For more information,
@@ -854,7 +1014,7 @@ type EstimateTemplateCostInput struct {
 	//
 	// Conditional: You must pass TemplateURL or TemplateBody. If both are passed,
 	// only TemplateBody is used.
-	TemplateURL *string `type:"string"`
+	TemplateURL *string `type:"string" min:"1" max:"1024"`
 
 	metadataEstimateTemplateCostInput `json:"-", xml:"-"`
 }
@@ -863,6 +1023,11 @@ type metadataEstimateTemplateCostInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EstimateTemplateCostInput.
+func (s EstimateTemplateCostInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for a EstimateTemplateCost action.
 type EstimateTemplateCostOutput struct {
 	// An AWS Simple Monthly Calculator URL with a query string that describes the
@@ -876,6 +1041,11 @@ type metadataEstimateTemplateCostOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EstimateTemplateCostOutput.
+func (s EstimateTemplateCostOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the GetStackPolicy action.
 type GetStackPolicyInput struct {
 	// The name or stack ID that is associated with the stack whose policy you want
@@ -889,12 +1059,17 @@ type metadataGetStackPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetStackPolicyInput.
+func (s GetStackPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the GetStackPolicy action.
 type GetStackPolicyOutput struct {
 	// Structure containing the stack policy body. (For more information, go to
 	//  Prevent Updates to Stack Resources (http://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/protect-stack-resources.html)
 	// in the AWS CloudFormation User Guide.)
-	StackPolicyBody *string `type:"string"`
+	StackPolicyBody *string `type:"string" min:"1" max:"16384"`
 
 	metadataGetStackPolicyOutput `json:"-", xml:"-"`
 }
@@ -903,6 +1078,11 @@ type metadataGetStackPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetStackPolicyOutput.
+func (s GetStackPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for a GetTemplate action.
 type GetTemplateInput struct {
 	// The name or the unique identifier associated with the stack, which are not
@@ -920,12 +1100,17 @@ type metadataGetTemplateInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetTemplateInput.
+func (s GetTemplateInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for GetTemplate action.
 type GetTemplateOutput struct {
 	// Structure containing the template body. (For more information, go to Template
 	// Anatomy (http://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/template-anatomy.html)
 	// in the AWS CloudFormation User Guide.)
-	TemplateBody *string `type:"string"`
+	TemplateBody *string `type:"string" min:"1"`
 
 	metadataGetTemplateOutput `json:"-", xml:"-"`
 }
@@ -934,6 +1119,11 @@ type metadataGetTemplateOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetTemplateOutput.
+func (s GetTemplateOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the GetTemplateSummary action.
 type GetTemplateSummaryInput struct {
 	// The name or the unique identifier associated with the stack, which are not
@@ -943,7 +1133,7 @@ type GetTemplateSummaryInput struct {
 	//
 	// Conditional: You must specify only one of the following parameters: StackName,
 	// TemplateBody, or TemplateURL.
-	StackName *string `type:"string"`
+	StackName *string `type:"string" min:"1" pattern:"([a-zA-Z][-a-zA-Z0-9]*)|(arn:\\b(aws|aws-us-gov|aws-cn)\\b:[-a-zA-Z0-9:/._+]*)"`
 
 	// Structure containing the template body with a minimum length of 1 byte and
 	// a maximum length of 51,200 bytes. For more information about templates, see
@@ -952,7 +1142,7 @@ type GetTemplateSummaryInput struct {
 	//
 	// Conditional: You must specify only one of the following parameters: StackName,
 	// TemplateBody, or TemplateURL.
-	TemplateBody *string `type:"string"`
+	TemplateBody *string `type:"string" min:"1"`
 
 	// Location of file containing the template body. The URL must point to a template
 	// (max size: 307,200 bytes) located in an Amazon S3 bucket. For more information
@@ -961,7 +1151,7 @@ type GetTemplateSummaryInput struct {
 	//
 	// Conditional: You must specify only one of the following parameters: StackName,
 	// TemplateBody, or TemplateURL.
-	TemplateURL *string `type:"string"`
+	TemplateURL *string `type:"string" min:"1" max:"1024"`
 
 	metadataGetTemplateSummaryInput `json:"-", xml:"-"`
 }
@@ -970,6 +1160,11 @@ type metadataGetTemplateSummaryInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetTemplateSummaryInput.
+func (s GetTemplateSummaryInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the GetTemplateSummary action.
 type GetTemplateSummaryOutput struct {
 	// The capabilities found within the template. Currently, AWS CloudFormation
@@ -1000,13 +1195,18 @@ type metadataGetTemplateSummaryOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetTemplateSummaryOutput.
+func (s GetTemplateSummaryOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the ListStackResource action.
 type ListStackResourcesInput struct {
 	// String that identifies the start of the next list of stack resource summaries,
 	// if there is one.
 	//
 	// Default: There is no default value.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"1024"`
 
 	// The name or the unique identifier associated with the stack, which are not
 	// always interchangeable:
@@ -1023,11 +1223,16 @@ type metadataListStackResourcesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListStackResourcesInput.
+func (s ListStackResourcesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for a ListStackResources action.
 type ListStackResourcesOutput struct {
 	// String that identifies the start of the next list of stack resources, if
 	// there is one.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"1024"`
 
 	// A list of StackResourceSummary structures.
 	StackResourceSummaries []*StackResourceSummary `type:"list"`
@@ -1039,13 +1244,18 @@ type metadataListStackResourcesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListStackResourcesOutput.
+func (s ListStackResourcesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for ListStacks action.
 type ListStacksInput struct {
 	// String that identifies the start of the next list of stacks, if there is
 	// one.
 	//
 	// Default: There is no default value.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"1024"`
 
 	// Stack status to use as a filter. Specify one or more stack status codes to
 	// list only stacks with the specified status codes. For a complete list of
@@ -1059,11 +1269,16 @@ type metadataListStacksInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListStacksInput.
+func (s ListStacksInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for ListStacks action.
 type ListStacksOutput struct {
 	// String that identifies the start of the next list of stacks, if there is
 	// one.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" max:"1024"`
 
 	// A list of StackSummary structures containing information about the specified
 	// stacks.
@@ -1076,6 +1291,47 @@ type metadataListStacksOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListStacksOutput.
+func (s ListStacksOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// OnFailure is an enum of the values of OnFailure members.
+type OnFailure string
+
+// The values of OnFailure.
+const (
+	OnFailureDONOTHING OnFailure = "DO_NOTHING"
+	OnFailureROLLBACK  OnFailure = "ROLLBACK"
+	OnFailureDELETE    OnFailure = "DELETE"
+)
+
+// OnFailureValues returns the values of OnFailure known to this
+// version of the SDK. The service may accept values added since.
+func OnFailureValues() []OnFailure {
+	return []OnFailure{
+		OnFailure("DO_NOTHING"),
+		OnFailure("ROLLBACK"),
+		OnFailure("DELETE"),
+	}
+}
+
+// String returns the OnFailure as the string of a member.
+func (v OnFailure) String() string {
+	return string(v)
+}
+
+// ParseOnFailure returns the OnFailure of str, failing if it is not
+// one of the OnFailureValues.
+func ParseOnFailure(str string) (OnFailure, error) {
+	for _, v := range OnFailureValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid OnFailure value %q", str)
+}
+
 // The Output data type.
 type Output struct {
 	// User defined description associated with the output.
@@ -1094,6 +1350,11 @@ type metadataOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Output.
+func (s Output) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The Parameter data type.
 type Parameter struct {
 	// The key associated with the parameter.
@@ -1113,6 +1374,11 @@ type metadataParameter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Parameter.
+func (s Parameter) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The ParameterDeclaration data type.
 type ParameterDeclaration struct {
 	// The default value of the parameter.
@@ -1138,6 +1404,95 @@ type metadataParameterDeclaration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ParameterDeclaration.
+func (s ParameterDeclaration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ResourceSignalStatus is an enum of the values of ResourceSignalStatus members.
+type ResourceSignalStatus string
+
+// The values of ResourceSignalStatus.
+const (
+	ResourceSignalStatusSUCCESS ResourceSignalStatus = "SUCCESS"
+	ResourceSignalStatusFAILURE ResourceSignalStatus = "FAILURE"
+)
+
+// ResourceSignalStatusValues returns the values of ResourceSignalStatus known to this
+// version of the SDK. The service may accept values added since.
+func ResourceSignalStatusValues() []ResourceSignalStatus {
+	return []ResourceSignalStatus{
+		ResourceSignalStatus("SUCCESS"),
+		ResourceSignalStatus("FAILURE"),
+	}
+}
+
+// String returns the ResourceSignalStatus as the string of a member.
+func (v ResourceSignalStatus) String() string {
+	return string(v)
+}
+
+// ParseResourceSignalStatus returns the ResourceSignalStatus of str, failing if it is not
+// one of the ResourceSignalStatusValues.
+func ParseResourceSignalStatus(str string) (ResourceSignalStatus, error) {
+	for _, v := range ResourceSignalStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ResourceSignalStatus value %q", str)
+}
+
+// ResourceStatus is an enum of the values of ResourceStatus members.
+type ResourceStatus string
+
+// The values of ResourceStatus.
+const (
+	ResourceStatusCREATEINPROGRESS ResourceStatus = "CREATE_IN_PROGRESS"
+	ResourceStatusCREATEFAILED     ResourceStatus = "CREATE_FAILED"
+	ResourceStatusCREATECOMPLETE   ResourceStatus = "CREATE_COMPLETE"
+	ResourceStatusDELETEINPROGRESS ResourceStatus = "DELETE_IN_PROGRESS"
+	ResourceStatusDELETEFAILED     ResourceStatus = "DELETE_FAILED"
+	ResourceStatusDELETECOMPLETE   ResourceStatus = "DELETE_COMPLETE"
+	ResourceStatusDELETESKIPPED    ResourceStatus = "DELETE_SKIPPED"
+	ResourceStatusUPDATEINPROGRESS ResourceStatus = "UPDATE_IN_PROGRESS"
+	ResourceStatusUPDATEFAILED     ResourceStatus = "UPDATE_FAILED"
+	ResourceStatusUPDATECOMPLETE   ResourceStatus = "UPDATE_COMPLETE"
+)
+
+// ResourceStatusValues returns the values of ResourceStatus known to this
+// version of the SDK. The service may accept values added since.
+func ResourceStatusValues() []ResourceStatus {
+	return []ResourceStatus{
+		ResourceStatus("CREATE_IN_PROGRESS"),
+		ResourceStatus("CREATE_FAILED"),
+		ResourceStatus("CREATE_COMPLETE"),
+		ResourceStatus("DELETE_IN_PROGRESS"),
+		ResourceStatus("DELETE_FAILED"),
+		ResourceStatus("DELETE_COMPLETE"),
+		ResourceStatus("DELETE_SKIPPED"),
+		ResourceStatus("UPDATE_IN_PROGRESS"),
+		ResourceStatus("UPDATE_FAILED"),
+		ResourceStatus("UPDATE_COMPLETE"),
+	}
+}
+
+// String returns the ResourceStatus as the string of a member.
+func (v ResourceStatus) String() string {
+	return string(v)
+}
+
+// ParseResourceStatus returns the ResourceStatus of str, failing if it is not
+// one of the ResourceStatusValues.
+func ParseResourceStatus(str string) (ResourceStatus, error) {
+	for _, v := range ResourceStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ResourceStatus value %q", str)
+}
+
 // The input for the SetStackPolicy action.
 type SetStackPolicyInput struct {
 	// The name or stack ID that you want to associate a policy with.
@@ -1147,13 +1502,13 @@ type SetStackPolicyInput struct {
 	// Prevent Updates to Stack Resources (http://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/protect-stack-resources.html)
 	// in the AWS CloudFormation User Guide. You can specify either the StackPolicyBody
 	// or the StackPolicyURL parameter, but not both.
-	StackPolicyBody *string `type:"string"`
+	StackPolicyBody *string `type:"string" min:"1" max:"16384"`
 
 	// Location of a file containing the stack policy. The URL must point to a policy
 	// (max size: 16KB) located in an S3 bucket in the same region as the stack.
 	// You can specify either the StackPolicyBody or the StackPolicyURL parameter,
 	// but not both.
-	StackPolicyURL *string `type:"string"`
+	StackPolicyURL *string `type:"string" min:"1" max:"1350"`
 
 	metadataSetStackPolicyInput `json:"-", xml:"-"`
 }
@@ -1162,6 +1517,11 @@ type metadataSetStackPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetStackPolicyInput.
+func (s SetStackPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SetStackPolicyOutput struct {
 	metadataSetStackPolicyOutput `json:"-", xml:"-"`
 }
@@ -1170,6 +1530,11 @@ type metadataSetStackPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetStackPolicyOutput.
+func (s SetStackPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the SignalResource action.
 type SignalResourceInput struct {
 	// The logical ID of the resource that you want to signal. The logical ID is
@@ -1177,17 +1542,17 @@ type SignalResourceInput struct {
 	LogicalResourceID *string `locationName:"LogicalResourceId" type:"string" required:"true"`
 
 	// The stack name or ID that includes the resource that you want to signal.
-	StackName *string `type:"string" required:"true"`
+	StackName *string `type:"string" min:"1" pattern:"([a-zA-Z][-a-zA-Z0-9]*)|(arn:\\b(aws|aws-us-gov|aws-cn)\\b:[-a-zA-Z0-9:/._+]*)" required:"true"`
 
 	// The status of the signal, which is either success or failure. A failure signal
 	// causes AWS CloudFormation to immediately fail the stack creation or update.
-	Status *string `type:"string" required:"true"`
+	Status *string `type:"string" enum:"SUCCESS,FAILURE" required:"true"`
 
 	// A unique ID of the signal. When you signal Amazon EC2 instances or Auto Scaling
 	// groups, specify the instance ID that you are signaling as the unique ID.
 	// If you send multiple signals to a single resource (such as signaling a wait
 	// condition), each signal requires a different unique ID.
-	UniqueID *string `locationName:"UniqueId" type:"string" required:"true"`
+	UniqueID *string `locationName:"UniqueId" type:"string" min:"1" max:"64" required:"true"`
 
 	metadataSignalResourceInput `json:"-", xml:"-"`
 }
@@ -1196,6 +1561,11 @@ type metadataSignalResourceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SignalResourceInput.
+func (s SignalResourceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SignalResourceOutput struct {
 	metadataSignalResourceOutput `json:"-", xml:"-"`
 }
@@ -1204,6 +1574,11 @@ type metadataSignalResourceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SignalResourceOutput.
+func (s SignalResourceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The Stack data type.
 type Stack struct {
 	// The capabilities allowed in the stack.
@@ -1225,7 +1600,7 @@ type Stack struct {
 	LastUpdatedTime *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// SNS topic ARNs to which stack related events are published.
-	NotificationARNs []*string `type:"list"`
+	NotificationARNs []*string `type:"list" max:"5"`
 
 	// A list of output structures.
 	Outputs []*Output `type:"list"`
@@ -1240,7 +1615,7 @@ type Stack struct {
 	StackName *string `type:"string" required:"true"`
 
 	// Current status of the stack.
-	StackStatus *string `type:"string" required:"true"`
+	StackStatus *string `type:"string" enum:"CREATE_IN_PROGRESS,CREATE_FAILED,CREATE_COMPLETE,ROLLBACK_IN_PROGRESS,ROLLBACK_FAILED,ROLLBACK_COMPLETE,DELETE_IN_PROGRESS,DELETE_FAILED,DELETE_COMPLETE,UPDATE_IN_PROGRESS,UPDATE_COMPLETE_CLEANUP_IN_PROGRESS,UPDATE_COMPLETE,UPDATE_ROLLBACK_IN_PROGRESS,UPDATE_ROLLBACK_FAILED,UPDATE_ROLLBACK_COMPLETE_CLEANUP_IN_PROGRESS,UPDATE_ROLLBACK_COMPLETE" required:"true"`
 
 	// Success/failure message associated with the stack status.
 	StackStatusReason *string `type:"string"`
@@ -1249,7 +1624,7 @@ type Stack struct {
 	Tags []*Tag `type:"list"`
 
 	// The amount of time within which stack creation should complete.
-	TimeoutInMinutes *int64 `type:"integer"`
+	TimeoutInMinutes *int64 `type:"integer" min:"1"`
 
 	metadataStack `json:"-", xml:"-"`
 }
@@ -1258,6 +1633,11 @@ type metadataStack struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Stack.
+func (s Stack) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The StackEvent data type.
 type StackEvent struct {
 	// The unique ID of this event.
@@ -1274,7 +1654,7 @@ type StackEvent struct {
 	ResourceProperties *string `type:"string"`
 
 	// Current status of the resource.
-	ResourceStatus *string `type:"string"`
+	ResourceStatus *string `type:"string" enum:"CREATE_IN_PROGRESS,CREATE_FAILED,CREATE_COMPLETE,DELETE_IN_PROGRESS,DELETE_FAILED,DELETE_COMPLETE,DELETE_SKIPPED,UPDATE_IN_PROGRESS,UPDATE_FAILED,UPDATE_COMPLETE"`
 
 	// Success/failure message associated with the resource.
 	ResourceStatusReason *string `type:"string"`
@@ -1300,6 +1680,11 @@ type metadataStackEvent struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StackEvent.
+func (s StackEvent) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The StackResource data type.
 type StackResource struct {
 	// User defined description associated with the resource.
@@ -1313,7 +1698,7 @@ type StackResource struct {
 	PhysicalResourceID *string `locationName:"PhysicalResourceId" type:"string"`
 
 	// Current status of the resource.
-	ResourceStatus *string `type:"string" required:"true"`
+	ResourceStatus *string `type:"string" enum:"CREATE_IN_PROGRESS,CREATE_FAILED,CREATE_COMPLETE,DELETE_IN_PROGRESS,DELETE_FAILED,DELETE_COMPLETE,DELETE_SKIPPED,UPDATE_IN_PROGRESS,UPDATE_FAILED,UPDATE_COMPLETE" required:"true"`
 
 	// Success/failure message associated with the resource.
 	ResourceStatusReason *string `type:"string"`
@@ -1339,6 +1724,11 @@ type metadataStackResource struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StackResource.
+func (s StackResource) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains detailed information about the specified stack resource.
 type StackResourceDetail struct {
 	// User defined description associated with the resource.
@@ -1360,7 +1750,7 @@ type StackResourceDetail struct {
 	PhysicalResourceID *string `locationName:"PhysicalResourceId" type:"string"`
 
 	// Current status of the resource.
-	ResourceStatus *string `type:"string" required:"true"`
+	ResourceStatus *string `type:"string" enum:"CREATE_IN_PROGRESS,CREATE_FAILED,CREATE_COMPLETE,DELETE_IN_PROGRESS,DELETE_FAILED,DELETE_COMPLETE,DELETE_SKIPPED,UPDATE_IN_PROGRESS,UPDATE_FAILED,UPDATE_COMPLETE" required:"true"`
 
 	// Success/failure message associated with the resource.
 	ResourceStatusReason *string `type:"string"`
@@ -1383,6 +1773,11 @@ type metadataStackResourceDetail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StackResourceDetail.
+func (s StackResourceDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains high-level information about the specified stack resource.
 type StackResourceSummary struct {
 	// Time the status was updated.
@@ -1396,7 +1791,7 @@ type StackResourceSummary struct {
 	PhysicalResourceID *string `locationName:"PhysicalResourceId" type:"string"`
 
 	// Current status of the resource.
-	ResourceStatus *string `type:"string" required:"true"`
+	ResourceStatus *string `type:"string" enum:"CREATE_IN_PROGRESS,CREATE_FAILED,CREATE_COMPLETE,DELETE_IN_PROGRESS,DELETE_FAILED,DELETE_COMPLETE,DELETE_SKIPPED,UPDATE_IN_PROGRESS,UPDATE_FAILED,UPDATE_COMPLETE" required:"true"`
 
 	// Success/failure message associated with the resource.
 	ResourceStatusReason *string `type:"string"`
@@ -1413,6 +1808,73 @@ type metadataStackResourceSummary struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StackResourceSummary.
+func (s StackResourceSummary) String() string {
+	return awsutil.StringValue(s)
+}
+
+// StackStatus is an enum of the values of StackStatus members.
+type StackStatus string
+
+// The values of StackStatus.
+const (
+	StackStatusCREATEINPROGRESS                        StackStatus = "CREATE_IN_PROGRESS"
+	StackStatusCREATEFAILED                            StackStatus = "CREATE_FAILED"
+	StackStatusCREATECOMPLETE                          StackStatus = "CREATE_COMPLETE"
+	StackStatusROLLBACKINPROGRESS                      StackStatus = "ROLLBACK_IN_PROGRESS"
+	StackStatusROLLBACKFAILED                          StackStatus = "ROLLBACK_FAILED"
+	StackStatusROLLBACKCOMPLETE                        StackStatus = "ROLLBACK_COMPLETE"
+	StackStatusDELETEINPROGRESS                        StackStatus = "DELETE_IN_PROGRESS"
+	StackStatusDELETEFAILED                            StackStatus = "DELETE_FAILED"
+	StackStatusDELETECOMPLETE                          StackStatus = "DELETE_COMPLETE"
+	StackStatusUPDATEINPROGRESS                        StackStatus = "UPDATE_IN_PROGRESS"
+	StackStatusUPDATECOMPLETECLEANUPINPROGRESS         StackStatus = "UPDATE_COMPLETE_CLEANUP_IN_PROGRESS"
+	StackStatusUPDATECOMPLETE                          StackStatus = "UPDATE_COMPLETE"
+	StackStatusUPDATEROLLBACKINPROGRESS                StackStatus = "UPDATE_ROLLBACK_IN_PROGRESS"
+	StackStatusUPDATEROLLBACKFAILED                    StackStatus = "UPDATE_ROLLBACK_FAILED"
+	StackStatusUPDATEROLLBACKCOMPLETECLEANUPINPROGRESS StackStatus = "UPDATE_ROLLBACK_COMPLETE_CLEANUP_IN_PROGRESS"
+	StackStatusUPDATEROLLBACKCOMPLETE                  StackStatus = "UPDATE_ROLLBACK_COMPLETE"
+)
+
+// StackStatusValues returns the values of StackStatus known to this
+// version of the SDK. The service may accept values added since.
+func StackStatusValues() []StackStatus {
+	return []StackStatus{
+		StackStatus("CREATE_IN_PROGRESS"),
+		StackStatus("CREATE_FAILED"),
+		StackStatus("CREATE_COMPLETE"),
+		StackStatus("ROLLBACK_IN_PROGRESS"),
+		StackStatus("ROLLBACK_FAILED"),
+		StackStatus("ROLLBACK_COMPLETE"),
+		StackStatus("DELETE_IN_PROGRESS"),
+		StackStatus("DELETE_FAILED"),
+		StackStatus("DELETE_COMPLETE"),
+		StackStatus("UPDATE_IN_PROGRESS"),
+		StackStatus("UPDATE_COMPLETE_CLEANUP_IN_PROGRESS"),
+		StackStatus("UPDATE_COMPLETE"),
+		StackStatus("UPDATE_ROLLBACK_IN_PROGRESS"),
+		StackStatus("UPDATE_ROLLBACK_FAILED"),
+		StackStatus("UPDATE_ROLLBACK_COMPLETE_CLEANUP_IN_PROGRESS"),
+		StackStatus("UPDATE_ROLLBACK_COMPLETE"),
+	}
+}
+
+// String returns the StackStatus as the string of a member.
+func (v StackStatus) String() string {
+	return string(v)
+}
+
+// ParseStackStatus returns the StackStatus of str, failing if it is not
+// one of the StackStatusValues.
+func ParseStackStatus(str string) (StackStatus, error) {
+	for _, v := range StackStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid StackStatus value %q", str)
+}
+
 // The StackSummary Data Type
 type StackSummary struct {
 	// The time the stack was created.
@@ -1432,7 +1894,7 @@ type StackSummary struct {
 	StackName *string `type:"string" required:"true"`
 
 	// The current status of the stack.
-	StackStatus *string `type:"string" required:"true"`
+	StackStatus *string `type:"string" enum:"CREATE_IN_PROGRESS,CREATE_FAILED,CREATE_COMPLETE,ROLLBACK_IN_PROGRESS,ROLLBACK_FAILED,ROLLBACK_COMPLETE,DELETE_IN_PROGRESS,DELETE_FAILED,DELETE_COMPLETE,UPDATE_IN_PROGRESS,UPDATE_COMPLETE_CLEANUP_IN_PROGRESS,UPDATE_COMPLETE,UPDATE_ROLLBACK_IN_PROGRESS,UPDATE_ROLLBACK_FAILED,UPDATE_ROLLBACK_COMPLETE_CLEANUP_IN_PROGRESS,UPDATE_ROLLBACK_COMPLETE" required:"true"`
 
 	// Success/Failure message associated with the stack status.
 	StackStatusReason *string `type:"string"`
@@ -1447,6 +1909,11 @@ type metadataStackSummary struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StackSummary.
+func (s StackSummary) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The Tag type is used by CreateStack in the Tags parameter. It allows you
 // to specify a key/value pair that can be used to store information related
 // to cost allocation for an AWS CloudFormation stack.
@@ -1467,6 +1934,11 @@ type metadataTag struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Tag.
+func (s Tag) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The TemplateParameter data type.
 type TemplateParameter struct {
 	// The default value associated with the parameter.
@@ -1489,6 +1961,11 @@ type metadataTemplateParameter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TemplateParameter.
+func (s TemplateParameter) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for UpdateStack action.
 type UpdateStackInput struct {
 	// A list of capabilities that you must specify before AWS CloudFormation can
@@ -1510,7 +1987,7 @@ type UpdateStackInput struct {
 	Capabilities []*string `type:"list"`
 
 	// Update the ARNs for the Amazon SNS topics that are associated with the stack.
-	NotificationARNs []*string `type:"list"`
+	NotificationARNs []*string `type:"list" max:"5"`
 
 	// A list of Parameter structures that specify input parameters for the stack.
 	Parameters []*Parameter `type:"list"`
@@ -1527,7 +2004,7 @@ type UpdateStackInput struct {
 	// You might update the stack policy, for example, in order to protect a new
 	// resource that you created during a stack update. If you do not specify a
 	// stack policy, the current policy that is associated with the stack is unchanged.
-	StackPolicyBody *string `type:"string"`
+	StackPolicyBody *string `type:"string" min:"1" max:"16384"`
 
 	// Structure containing the temporary overriding stack policy body. You can
 	// specify either the StackPolicyDuringUpdateBody or the StackPolicyDuringUpdateURL
@@ -1536,7 +2013,7 @@ type UpdateStackInput struct {
 	// If you want to update protected resources, specify a temporary overriding
 	// stack policy during this update. If you do not specify a stack policy, the
 	// current policy that is associated with the stack will be used.
-	StackPolicyDuringUpdateBody *string `type:"string"`
+	StackPolicyDuringUpdateBody *string `type:"string" min:"1" max:"16384"`
 
 	// Location of a file containing the temporary overriding stack policy. The
 	// URL must point to a policy (max size: 16KB) located in an S3 bucket in the
@@ -1546,7 +2023,7 @@ type UpdateStackInput struct {
 	// If you want to update protected resources, specify a temporary overriding
 	// stack policy during this update. If you do not specify a stack policy, the
 	// current policy that is associated with the stack will be used.
-	StackPolicyDuringUpdateURL *string `type:"string"`
+	StackPolicyDuringUpdateURL *string `type:"string" min:"1" max:"1350"`
 
 	// Location of a file containing the updated stack policy. The URL must point
 	// to a policy (max size: 16KB) located in an S3 bucket in the same region as
@@ -1556,7 +2033,7 @@ type UpdateStackInput struct {
 	// You might update the stack policy, for example, in order to protect a new
 	// resource that you created during a stack update. If you do not specify a
 	// stack policy, the current policy that is associated with the stack is unchanged.
-	StackPolicyURL *string `type:"string"`
+	StackPolicyURL *string `type:"string" min:"1" max:"1350"`
 
 	// Structure containing the template body with a minimum length of 1 byte and
 	// a maximum length of 51,200 bytes. (For more information, go to Template Anatomy
@@ -1565,7 +2042,7 @@ type UpdateStackInput struct {
 	//
 	// Conditional: You must specify either the TemplateBody or the TemplateURL
 	// parameter, but not both.
-	TemplateBody *string `type:"string"`
+	TemplateBody *string `type:"string" min:"1"`
 
 	// Location of file containing the template body. The URL must point to a template
 	// located in an S3 bucket in the same region as the stack. For more information,
@@ -1574,7 +2051,7 @@ type UpdateStackInput struct {
 	//
 	// Conditional: You must specify either the TemplateBody or the TemplateURL
 	// parameter, but not both.
-	TemplateURL *string `type:"string"`
+	TemplateURL *string `type:"string" min:"1" max:"1024"`
 
 	// Reuse the existing template that is associated with the stack that you are
 	// updating.
@@ -1587,6 +2064,11 @@ type metadataUpdateStackInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateStackInput.
+func (s UpdateStackInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for a UpdateStack action.
 type UpdateStackOutput struct {
 	// Unique identifier of the stack.
@@ -1599,6 +2081,11 @@ type metadataUpdateStackOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateStackOutput.
+func (s UpdateStackOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for ValidateTemplate action.
 type ValidateTemplateInput struct {
 	// Structure containing the template body with a minimum length of 1 byte and
@@ -1608,7 +2095,7 @@ type ValidateTemplateInput struct {
 	//
 	// Conditional: You must pass TemplateURL or TemplateBody. If both are passed,
 	// only TemplateBody is used.
-	TemplateBody *string `type:"string"`
+	TemplateBody *string `type:"string" min:"1"`
 
 	// Location of file containing the template body. The URL must point to a template
 	// (max size: 307,200 bytes) located in an S3 bucket in the same region as the
@@ -1617,7 +2104,7 @@ type ValidateTemplateInput struct {
 	//
 	// Conditional: You must pass TemplateURL or TemplateBody. If both are passed,
 	// only TemplateBody is used.
-	TemplateURL *string `type:"string"`
+	TemplateURL *string `type:"string" min:"1" max:"1024"`
 
 	metadataValidateTemplateInput `json:"-", xml:"-"`
 }
@@ -1626,6 +2113,11 @@ type metadataValidateTemplateInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ValidateTemplateInput.
+func (s ValidateTemplateInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for ValidateTemplate action.
 type ValidateTemplateOutput struct {
 	// The capabilities found within the template. Currently, AWS CloudFormation
@@ -1649,4 +2141,9 @@ type ValidateTemplateOutput struct {
 
 type metadataValidateTemplateOutput struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the ValidateTemplateOutput.
+func (s ValidateTemplateOutput) String() string {
+	return awsutil.StringValue(s)
 }
\ No newline at end of file
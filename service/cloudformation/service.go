@@ -25,7 +25,7 @@ func New(config *aws.Config) *CloudFormation {
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(query.Build)
 	service.Handlers.Unmarshal.PushBack(query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(query.UnmarshalMeta)
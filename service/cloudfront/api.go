@@ -4,9 +4,11 @@
 package cloudfront
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // CreateCloudFrontOriginAccessIdentityRequest generates a request for the CreateCloudFrontOriginAccessIdentity operation.
@@ -380,6 +382,12 @@ func (c *CloudFront) ListCloudFrontOriginAccessIdentitiesRequest(input *ListClou
 			Name:       "ListCloudFrontOriginAccessIdentities2014_11_06",
 			HTTPMethod: "GET",
 			HTTPPath:   "/2014-11-06/origin-access-identity/cloudfront",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"CloudFrontOriginAccessIdentityList.NextMarker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "CloudFrontOriginAccessIdentityList.IsTruncated",
+			},
 		}
 	}
 
@@ -397,6 +405,16 @@ func (c *CloudFront) ListCloudFrontOriginAccessIdentities(input *ListCloudFrontO
 	return
 }
 
+// ListCloudFrontOriginAccessIdentitiesPages iterates over the pages of a ListCloudFrontOriginAccessIdentities operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *CloudFront) ListCloudFrontOriginAccessIdentitiesPages(input *ListCloudFrontOriginAccessIdentitiesInput, fn func(p *ListCloudFrontOriginAccessIdentitiesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListCloudFrontOriginAccessIdentitiesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListCloudFrontOriginAccessIdentitiesOutput), lastPage)
+	})
+}
+
 var opListCloudFrontOriginAccessIdentities *aws.Operation
 
 // ListDistributionsRequest generates a request for the ListDistributions operation.
@@ -406,6 +424,12 @@ func (c *CloudFront) ListDistributionsRequest(input *ListDistributionsInput) (re
 			Name:       "ListDistributions2014_11_06",
 			HTTPMethod: "GET",
 			HTTPPath:   "/2014-11-06/distribution",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"DistributionList.NextMarker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "DistributionList.IsTruncated",
+			},
 		}
 	}
 
@@ -423,6 +447,16 @@ func (c *CloudFront) ListDistributions(input *ListDistributionsInput) (output *L
 	return
 }
 
+// ListDistributionsPages iterates over the pages of a ListDistributions operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *CloudFront) ListDistributionsPages(input *ListDistributionsInput, fn func(p *ListDistributionsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListDistributionsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListDistributionsOutput), lastPage)
+	})
+}
+
 var opListDistributions *aws.Operation
 
 // ListInvalidationsRequest generates a request for the ListInvalidations operation.
@@ -432,6 +466,12 @@ func (c *CloudFront) ListInvalidationsRequest(input *ListInvalidationsInput) (re
 			Name:       "ListInvalidations2014_11_06",
 			HTTPMethod: "GET",
 			HTTPPath:   "/2014-11-06/distribution/{DistributionId}/invalidation",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"InvalidationList.NextMarker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "InvalidationList.IsTruncated",
+			},
 		}
 	}
 
@@ -449,6 +489,16 @@ func (c *CloudFront) ListInvalidations(input *ListInvalidationsInput) (output *L
 	return
 }
 
+// ListInvalidationsPages iterates over the pages of a ListInvalidations operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *CloudFront) ListInvalidationsPages(input *ListInvalidationsInput, fn func(p *ListInvalidationsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListInvalidationsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListInvalidationsOutput), lastPage)
+	})
+}
+
 var opListInvalidations *aws.Operation
 
 // ListStreamingDistributionsRequest generates a request for the ListStreamingDistributions operation.
@@ -458,6 +508,12 @@ func (c *CloudFront) ListStreamingDistributionsRequest(input *ListStreamingDistr
 			Name:       "ListStreamingDistributions2014_11_06",
 			HTTPMethod: "GET",
 			HTTPPath:   "/2014-11-06/streaming-distribution",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"StreamingDistributionList.NextMarker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "StreamingDistributionList.IsTruncated",
+			},
 		}
 	}
 
@@ -475,6 +531,16 @@ func (c *CloudFront) ListStreamingDistributions(input *ListStreamingDistribution
 	return
 }
 
+// ListStreamingDistributionsPages iterates over the pages of a ListStreamingDistributions operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *CloudFront) ListStreamingDistributionsPages(input *ListStreamingDistributionsInput, fn func(p *ListStreamingDistributionsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListStreamingDistributionsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListStreamingDistributionsOutput), lastPage)
+	})
+}
+
 var opListStreamingDistributions *aws.Operation
 
 // UpdateCloudFrontOriginAccessIdentityRequest generates a request for the UpdateCloudFrontOriginAccessIdentity operation.
@@ -580,6 +646,11 @@ type metadataActiveTrustedSigners struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ActiveTrustedSigners.
+func (s ActiveTrustedSigners) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains information about CNAMEs (alternate domain names),
 // if any, for this distribution.
 type Aliases struct {
@@ -597,6 +668,11 @@ type metadataAliases struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Aliases.
+func (s Aliases) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that controls which HTTP methods CloudFront processes and
 // forwards to your Amazon S3 bucket or your custom origin. There are three
 // choices: - CloudFront forwards only GET and HEAD requests. - CloudFront forwards
@@ -630,6 +706,11 @@ type metadataAllowedMethods struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AllowedMethods.
+func (s AllowedMethods) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that describes how CloudFront processes requests. You can
 // create up to 10 cache behaviors.You must create at least as many cache behaviors
 // (including the default cache behavior) as you have origins if you want CloudFront
@@ -704,7 +785,7 @@ type CacheBehavior struct {
 	// request with an HTTP status code of 301 (Moved Permanently) and the HTTPS
 	// URL, specify redirect-to-https. The viewer then resubmits the request using
 	// the HTTPS URL.
-	ViewerProtocolPolicy *string `type:"string" required:"true"`
+	ViewerProtocolPolicy *string `type:"string" enum:"allow-all,https-only,redirect-to-https" required:"true"`
 
 	metadataCacheBehavior `json:"-", xml:"-"`
 }
@@ -713,6 +794,11 @@ type metadataCacheBehavior struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CacheBehavior.
+func (s CacheBehavior) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains zero or more CacheBehavior elements.
 type CacheBehaviors struct {
 	// Optional: A complex type that contains cache behaviors for this distribution.
@@ -729,6 +815,11 @@ type metadataCacheBehaviors struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CacheBehaviors.
+func (s CacheBehaviors) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that controls whether CloudFront caches the response to requests
 // using the specified HTTP methods. There are two choices: - CloudFront caches
 // responses to GET and HEAD requests. - CloudFront caches responses to GET,
@@ -752,6 +843,11 @@ type metadataCachedMethods struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CachedMethods.
+func (s CachedMethods) String() string {
+	return awsutil.StringValue(s)
+}
+
 // CloudFront origin access identity.
 type CloudFrontOriginAccessIdentity struct {
 	// The current configuration information for the identity.
@@ -772,6 +868,11 @@ type metadataCloudFrontOriginAccessIdentity struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CloudFrontOriginAccessIdentity.
+func (s CloudFrontOriginAccessIdentity) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Origin access identity configuration.
 type CloudFrontOriginAccessIdentityConfig struct {
 	// A unique number that ensures the request can't be replayed. If the CallerReference
@@ -797,6 +898,11 @@ type metadataCloudFrontOriginAccessIdentityConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CloudFrontOriginAccessIdentityConfig.
+func (s CloudFrontOriginAccessIdentityConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The CloudFrontOriginAccessIdentityList type.
 type CloudFrontOriginAccessIdentityList struct {
 	// A flag that indicates whether more origin access identities remain to be
@@ -831,6 +937,11 @@ type metadataCloudFrontOriginAccessIdentityList struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CloudFrontOriginAccessIdentityList.
+func (s CloudFrontOriginAccessIdentityList) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Summary of the information about a CloudFront origin access identity.
 type CloudFrontOriginAccessIdentitySummary struct {
 	// The comment for this origin access identity, as originally specified when
@@ -852,6 +963,11 @@ type metadataCloudFrontOriginAccessIdentitySummary struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CloudFrontOriginAccessIdentitySummary.
+func (s CloudFrontOriginAccessIdentitySummary) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that specifies the whitelisted cookies, if any, that you want
 // CloudFront to forward to your origin that is associated with this cache behavior.
 type CookieNames struct {
@@ -869,6 +985,11 @@ type metadataCookieNames struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CookieNames.
+func (s CookieNames) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that specifies the cookie preferences associated with this
 // cache behavior.
 type CookiePreference struct {
@@ -876,7 +997,7 @@ type CookiePreference struct {
 	// to the origin that is associated with this cache behavior. You can specify
 	// all, none or whitelist. If you choose All, CloudFront forwards all cookies
 	// regardless of how many your application uses.
-	Forward *string `type:"string" required:"true"`
+	Forward *string `type:"string" enum:"none,whitelist,all" required:"true"`
 
 	// A complex type that specifies the whitelisted cookies, if any, that you want
 	// CloudFront to forward to your origin that is associated with this cache behavior.
@@ -889,6 +1010,11 @@ type metadataCookiePreference struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CookiePreference.
+func (s CookiePreference) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The request to create a new origin access identity.
 type CreateCloudFrontOriginAccessIdentityInput struct {
 	// The origin access identity's configuration information.
@@ -901,6 +1027,11 @@ type metadataCreateCloudFrontOriginAccessIdentityInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"CloudFrontOriginAccessIdentityConfig"`
 }
 
+// String returns the string representation of the CreateCloudFrontOriginAccessIdentityInput.
+func (s CreateCloudFrontOriginAccessIdentityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The returned result of the corresponding request.
 type CreateCloudFrontOriginAccessIdentityOutput struct {
 	// The origin access identity's information.
@@ -920,6 +1051,11 @@ type metadataCreateCloudFrontOriginAccessIdentityOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"CloudFrontOriginAccessIdentity"`
 }
 
+// String returns the string representation of the CreateCloudFrontOriginAccessIdentityOutput.
+func (s CreateCloudFrontOriginAccessIdentityOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The request to create a new distribution.
 type CreateDistributionInput struct {
 	// The distribution's configuration information.
@@ -932,6 +1068,11 @@ type metadataCreateDistributionInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"DistributionConfig"`
 }
 
+// String returns the string representation of the CreateDistributionInput.
+func (s CreateDistributionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The returned result of the corresponding request.
 type CreateDistributionOutput struct {
 	// The distribution's information.
@@ -951,6 +1092,11 @@ type metadataCreateDistributionOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"Distribution"`
 }
 
+// String returns the string representation of the CreateDistributionOutput.
+func (s CreateDistributionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The request to create an invalidation.
 type CreateInvalidationInput struct {
 	// The distribution's id.
@@ -966,6 +1112,11 @@ type metadataCreateInvalidationInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"InvalidationBatch"`
 }
 
+// String returns the string representation of the CreateInvalidationInput.
+func (s CreateInvalidationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The returned result of the corresponding request.
 type CreateInvalidationOutput struct {
 	// The invalidation's information.
@@ -982,6 +1133,11 @@ type metadataCreateInvalidationOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"Invalidation"`
 }
 
+// String returns the string representation of the CreateInvalidationOutput.
+func (s CreateInvalidationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The request to create a new streaming distribution.
 type CreateStreamingDistributionInput struct {
 	// The streaming distribution's configuration information.
@@ -994,6 +1150,11 @@ type metadataCreateStreamingDistributionInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"StreamingDistributionConfig"`
 }
 
+// String returns the string representation of the CreateStreamingDistributionInput.
+func (s CreateStreamingDistributionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The returned result of the corresponding request.
 type CreateStreamingDistributionOutput struct {
 	// The current version of the streaming distribution created.
@@ -1013,6 +1174,11 @@ type metadataCreateStreamingDistributionOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"StreamingDistribution"`
 }
 
+// String returns the string representation of the CreateStreamingDistributionOutput.
+func (s CreateStreamingDistributionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that describes how you'd prefer CloudFront to respond to requests
 // that result in either a 4xx or 5xx response. You can control whether a custom
 // error page should be displayed, what the desired response code should be
@@ -1054,6 +1220,11 @@ type metadataCustomErrorResponse struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CustomErrorResponse.
+func (s CustomErrorResponse) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains zero or more CustomErrorResponse elements.
 type CustomErrorResponses struct {
 	// Optional: A complex type that contains custom error responses for this distribution.
@@ -1070,6 +1241,11 @@ type metadataCustomErrorResponses struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CustomErrorResponses.
+func (s CustomErrorResponses) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A customer origin.
 type CustomOriginConfig struct {
 	// The HTTP port the custom origin listens on.
@@ -1079,7 +1255,7 @@ type CustomOriginConfig struct {
 	HTTPSPort *int64 `type:"integer" required:"true"`
 
 	// The origin protocol policy to apply to your origin.
-	OriginProtocolPolicy *string `type:"string" required:"true"`
+	OriginProtocolPolicy *string `type:"string" enum:"http-only,match-viewer" required:"true"`
 
 	metadataCustomOriginConfig `json:"-", xml:"-"`
 }
@@ -1088,6 +1264,11 @@ type metadataCustomOriginConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CustomOriginConfig.
+func (s CustomOriginConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that describes the default cache behavior if you do not specify
 // a CacheBehavior element or if files don't match any of the values of PathPattern
 // in CacheBehavior elements.You must create exactly one default cache behavior.
@@ -1142,7 +1323,7 @@ type DefaultCacheBehavior struct {
 	// request with an HTTP status code of 301 (Moved Permanently) and the HTTPS
 	// URL, specify redirect-to-https. The viewer then resubmits the request using
 	// the HTTPS URL.
-	ViewerProtocolPolicy *string `type:"string" required:"true"`
+	ViewerProtocolPolicy *string `type:"string" enum:"allow-all,https-only,redirect-to-https" required:"true"`
 
 	metadataDefaultCacheBehavior `json:"-", xml:"-"`
 }
@@ -1151,6 +1332,11 @@ type metadataDefaultCacheBehavior struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DefaultCacheBehavior.
+func (s DefaultCacheBehavior) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The request to delete a origin access identity.
 type DeleteCloudFrontOriginAccessIdentityInput struct {
 	// The origin access identity's id.
@@ -1167,6 +1353,11 @@ type metadataDeleteCloudFrontOriginAccessIdentityInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteCloudFrontOriginAccessIdentityInput.
+func (s DeleteCloudFrontOriginAccessIdentityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteCloudFrontOriginAccessIdentityOutput struct {
 	metadataDeleteCloudFrontOriginAccessIdentityOutput `json:"-", xml:"-"`
 }
@@ -1175,6 +1366,11 @@ type metadataDeleteCloudFrontOriginAccessIdentityOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteCloudFrontOriginAccessIdentityOutput.
+func (s DeleteCloudFrontOriginAccessIdentityOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The request to delete a distribution.
 type DeleteDistributionInput struct {
 	// The distribution id.
@@ -1191,6 +1387,11 @@ type metadataDeleteDistributionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteDistributionInput.
+func (s DeleteDistributionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteDistributionOutput struct {
 	metadataDeleteDistributionOutput `json:"-", xml:"-"`
 }
@@ -1199,6 +1400,11 @@ type metadataDeleteDistributionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteDistributionOutput.
+func (s DeleteDistributionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The request to delete a streaming distribution.
 type DeleteStreamingDistributionInput struct {
 	// The distribution id.
@@ -1215,6 +1421,11 @@ type metadataDeleteStreamingDistributionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteStreamingDistributionInput.
+func (s DeleteStreamingDistributionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteStreamingDistributionOutput struct {
 	metadataDeleteStreamingDistributionOutput `json:"-", xml:"-"`
 }
@@ -1223,6 +1434,11 @@ type metadataDeleteStreamingDistributionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteStreamingDistributionOutput.
+func (s DeleteStreamingDistributionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A distribution.
 type Distribution struct {
 	// CloudFront automatically adds this element to the response only if you've
@@ -1262,6 +1478,11 @@ type metadataDistribution struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Distribution.
+func (s Distribution) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A distribution Configuration.
 type DistributionConfig struct {
 	// A complex type that contains information about CNAMEs (alternate domain names),
@@ -1314,7 +1535,7 @@ type DistributionConfig struct {
 	Origins *Origins `type:"structure" required:"true"`
 
 	// A complex type that contains information about price class for this distribution.
-	PriceClass *string `type:"string"`
+	PriceClass *string `type:"string" enum:"PriceClass_100,PriceClass_200,PriceClass_All"`
 
 	// A complex type that identifies ways in which you want to restrict distribution
 	// of your content.
@@ -1331,6 +1552,11 @@ type metadataDistributionConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DistributionConfig.
+func (s DistributionConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A distribution list.
 type DistributionList struct {
 	// A flag that indicates whether more distributions remain to be listed. If
@@ -1364,6 +1590,11 @@ type metadataDistributionList struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DistributionList.
+func (s DistributionList) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A summary of the information for an Amazon CloudFront distribution.
 type DistributionSummary struct {
 	// A complex type that contains information about CNAMEs (alternate domain names),
@@ -1399,7 +1630,7 @@ type DistributionSummary struct {
 	// A complex type that contains information about origins for this distribution.
 	Origins *Origins `type:"structure" required:"true"`
 
-	PriceClass *string `type:"string" required:"true"`
+	PriceClass *string `type:"string" enum:"PriceClass_100,PriceClass_200,PriceClass_All" required:"true"`
 
 	// A complex type that identifies ways in which you want to restrict distribution
 	// of your content.
@@ -1421,6 +1652,11 @@ type metadataDistributionSummary struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DistributionSummary.
+func (s DistributionSummary) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that specifies how CloudFront handles query strings, cookies
 // and headers.
 type ForwardedValues struct {
@@ -1443,6 +1679,11 @@ type metadataForwardedValues struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ForwardedValues.
+func (s ForwardedValues) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that controls the countries in which your content is distributed.
 // For more information about geo restriction, go to Customizing Error Responses
 // in the Amazon CloudFront Developer Guide. CloudFront determines the location
@@ -1472,7 +1713,7 @@ type GeoRestriction struct {
 	// specify the countries in which you do not want CloudFront to distribute your
 	// content. - whitelist: The Location elements specify the countries in which
 	// you want CloudFront to distribute your content.
-	RestrictionType *string `type:"string" required:"true"`
+	RestrictionType *string `type:"string" enum:"blacklist,whitelist,none" required:"true"`
 
 	metadataGeoRestriction `json:"-", xml:"-"`
 }
@@ -1481,6 +1722,47 @@ type metadataGeoRestriction struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GeoRestriction.
+func (s GeoRestriction) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GeoRestrictionType is an enum of the values of GeoRestrictionType members.
+type GeoRestrictionType string
+
+// The values of GeoRestrictionType.
+const (
+	GeoRestrictionTypeBlacklist GeoRestrictionType = "blacklist"
+	GeoRestrictionTypeWhitelist GeoRestrictionType = "whitelist"
+	GeoRestrictionTypeNone      GeoRestrictionType = "none"
+)
+
+// GeoRestrictionTypeValues returns the values of GeoRestrictionType known to this
+// version of the SDK. The service may accept values added since.
+func GeoRestrictionTypeValues() []GeoRestrictionType {
+	return []GeoRestrictionType{
+		GeoRestrictionType("blacklist"),
+		GeoRestrictionType("whitelist"),
+		GeoRestrictionType("none"),
+	}
+}
+
+// String returns the GeoRestrictionType as the string of a member.
+func (v GeoRestrictionType) String() string {
+	return string(v)
+}
+
+// ParseGeoRestrictionType returns the GeoRestrictionType of str, failing if it is not
+// one of the GeoRestrictionTypeValues.
+func ParseGeoRestrictionType(str string) (GeoRestrictionType, error) {
+	for _, v := range GeoRestrictionTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid GeoRestrictionType value %q", str)
+}
+
 // The request to get an origin access identity's configuration.
 type GetCloudFrontOriginAccessIdentityConfigInput struct {
 	// The identity's id.
@@ -1493,6 +1775,11 @@ type metadataGetCloudFrontOriginAccessIdentityConfigInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetCloudFrontOriginAccessIdentityConfigInput.
+func (s GetCloudFrontOriginAccessIdentityConfigInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The returned result of the corresponding request.
 type GetCloudFrontOriginAccessIdentityConfigOutput struct {
 	// The origin access identity's configuration information.
@@ -1508,6 +1795,11 @@ type metadataGetCloudFrontOriginAccessIdentityConfigOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"CloudFrontOriginAccessIdentityConfig"`
 }
 
+// String returns the string representation of the GetCloudFrontOriginAccessIdentityConfigOutput.
+func (s GetCloudFrontOriginAccessIdentityConfigOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The request to get an origin access identity's information.
 type GetCloudFrontOriginAccessIdentityInput struct {
 	// The identity's id.
@@ -1520,6 +1812,11 @@ type metadataGetCloudFrontOriginAccessIdentityInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetCloudFrontOriginAccessIdentityInput.
+func (s GetCloudFrontOriginAccessIdentityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The returned result of the corresponding request.
 type GetCloudFrontOriginAccessIdentityOutput struct {
 	// The origin access identity's information.
@@ -1536,6 +1833,11 @@ type metadataGetCloudFrontOriginAccessIdentityOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"CloudFrontOriginAccessIdentity"`
 }
 
+// String returns the string representation of the GetCloudFrontOriginAccessIdentityOutput.
+func (s GetCloudFrontOriginAccessIdentityOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The request to get a distribution configuration.
 type GetDistributionConfigInput struct {
 	// The distribution's id.
@@ -1548,6 +1850,11 @@ type metadataGetDistributionConfigInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetDistributionConfigInput.
+func (s GetDistributionConfigInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The returned result of the corresponding request.
 type GetDistributionConfigOutput struct {
 	// The distribution's configuration information.
@@ -1563,6 +1870,11 @@ type metadataGetDistributionConfigOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"DistributionConfig"`
 }
 
+// String returns the string representation of the GetDistributionConfigOutput.
+func (s GetDistributionConfigOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The request to get a distribution's information.
 type GetDistributionInput struct {
 	// The distribution's id.
@@ -1575,6 +1887,11 @@ type metadataGetDistributionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetDistributionInput.
+func (s GetDistributionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The returned result of the corresponding request.
 type GetDistributionOutput struct {
 	// The distribution's information.
@@ -1590,6 +1907,11 @@ type metadataGetDistributionOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"Distribution"`
 }
 
+// String returns the string representation of the GetDistributionOutput.
+func (s GetDistributionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The request to get an invalidation's information.
 type GetInvalidationInput struct {
 	// The distribution's id.
@@ -1605,6 +1927,11 @@ type metadataGetInvalidationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetInvalidationInput.
+func (s GetInvalidationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The returned result of the corresponding request.
 type GetInvalidationOutput struct {
 	// The invalidation's information.
@@ -1617,6 +1944,11 @@ type metadataGetInvalidationOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"Invalidation"`
 }
 
+// String returns the string representation of the GetInvalidationOutput.
+func (s GetInvalidationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // To request to get a streaming distribution configuration.
 type GetStreamingDistributionConfigInput struct {
 	// The streaming distribution's id.
@@ -1629,6 +1961,11 @@ type metadataGetStreamingDistributionConfigInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetStreamingDistributionConfigInput.
+func (s GetStreamingDistributionConfigInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The returned result of the corresponding request.
 type GetStreamingDistributionConfigOutput struct {
 	// The current version of the configuration. For example: E2QWRUHAPOMQZL.
@@ -1644,6 +1981,11 @@ type metadataGetStreamingDistributionConfigOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"StreamingDistributionConfig"`
 }
 
+// String returns the string representation of the GetStreamingDistributionConfigOutput.
+func (s GetStreamingDistributionConfigOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The request to get a streaming distribution's information.
 type GetStreamingDistributionInput struct {
 	// The streaming distribution's id.
@@ -1656,6 +1998,11 @@ type metadataGetStreamingDistributionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetStreamingDistributionInput.
+func (s GetStreamingDistributionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The returned result of the corresponding request.
 type GetStreamingDistributionOutput struct {
 	// The current version of the streaming distribution's information. For example:
@@ -1672,6 +2019,11 @@ type metadataGetStreamingDistributionOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"StreamingDistribution"`
 }
 
+// String returns the string representation of the GetStreamingDistributionOutput.
+func (s GetStreamingDistributionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that specifies the headers that you want CloudFront to forward
 // to the origin for this cache behavior. For the headers that you specify,
 // CloudFront also caches separate versions of a given object based on the header
@@ -1702,6 +2054,11 @@ type metadataHeaders struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Headers.
+func (s Headers) String() string {
+	return awsutil.StringValue(s)
+}
+
 // An invalidation.
 type Invalidation struct {
 	// The date and time the invalidation request was first made.
@@ -1724,6 +2081,11 @@ type metadataInvalidation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Invalidation.
+func (s Invalidation) String() string {
+	return awsutil.StringValue(s)
+}
+
 // An invalidation batch.
 type InvalidationBatch struct {
 	// A unique name that ensures the request can't be replayed. If the CallerReference
@@ -1752,6 +2114,11 @@ type metadataInvalidationBatch struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InvalidationBatch.
+func (s InvalidationBatch) String() string {
+	return awsutil.StringValue(s)
+}
+
 // An invalidation list.
 type InvalidationList struct {
 	// A flag that indicates whether more invalidation batch requests remain to
@@ -1785,6 +2152,11 @@ type metadataInvalidationList struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InvalidationList.
+func (s InvalidationList) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Summary of an invalidation request.
 type InvalidationSummary struct {
 	CreateTime *time.Time `type:"timestamp" timestampFormat:"iso8601" required:"true"`
@@ -1802,6 +2174,47 @@ type metadataInvalidationSummary struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InvalidationSummary.
+func (s InvalidationSummary) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ItemSelection is an enum of the values of ItemSelection members.
+type ItemSelection string
+
+// The values of ItemSelection.
+const (
+	ItemSelectionNone      ItemSelection = "none"
+	ItemSelectionWhitelist ItemSelection = "whitelist"
+	ItemSelectionAll       ItemSelection = "all"
+)
+
+// ItemSelectionValues returns the values of ItemSelection known to this
+// version of the SDK. The service may accept values added since.
+func ItemSelectionValues() []ItemSelection {
+	return []ItemSelection{
+		ItemSelection("none"),
+		ItemSelection("whitelist"),
+		ItemSelection("all"),
+	}
+}
+
+// String returns the ItemSelection as the string of a member.
+func (v ItemSelection) String() string {
+	return string(v)
+}
+
+// ParseItemSelection returns the ItemSelection of str, failing if it is not
+// one of the ItemSelectionValues.
+func ParseItemSelection(str string) (ItemSelection, error) {
+	for _, v := range ItemSelectionValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ItemSelection value %q", str)
+}
+
 // A complex type that lists the active CloudFront key pairs, if any, that are
 // associated with AwsAccountNumber.
 type KeyPairIDs struct {
@@ -1819,6 +2232,11 @@ type metadataKeyPairIDs struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the KeyPairIDs.
+func (s KeyPairIDs) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The request to list origin access identities.
 type ListCloudFrontOriginAccessIdentitiesInput struct {
 	// Use this when paginating results to indicate where to begin in your list
@@ -1838,6 +2256,11 @@ type metadataListCloudFrontOriginAccessIdentitiesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListCloudFrontOriginAccessIdentitiesInput.
+func (s ListCloudFrontOriginAccessIdentitiesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The returned result of the corresponding request.
 type ListCloudFrontOriginAccessIdentitiesOutput struct {
 	// The CloudFrontOriginAccessIdentityList type.
@@ -1850,6 +2273,11 @@ type metadataListCloudFrontOriginAccessIdentitiesOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"CloudFrontOriginAccessIdentityList"`
 }
 
+// String returns the string representation of the ListCloudFrontOriginAccessIdentitiesOutput.
+func (s ListCloudFrontOriginAccessIdentitiesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The request to list your distributions.
 type ListDistributionsInput struct {
 	// Use this when paginating results to indicate where to begin in your list
@@ -1869,6 +2297,11 @@ type metadataListDistributionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListDistributionsInput.
+func (s ListDistributionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The returned result of the corresponding request.
 type ListDistributionsOutput struct {
 	// The DistributionList type.
@@ -1881,6 +2314,11 @@ type metadataListDistributionsOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"DistributionList"`
 }
 
+// String returns the string representation of the ListDistributionsOutput.
+func (s ListDistributionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The request to list invalidations.
 type ListInvalidationsInput struct {
 	// The distribution's id.
@@ -1905,6 +2343,11 @@ type metadataListInvalidationsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListInvalidationsInput.
+func (s ListInvalidationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The returned result of the corresponding request.
 type ListInvalidationsOutput struct {
 	// Information about invalidation batches.
@@ -1917,6 +2360,11 @@ type metadataListInvalidationsOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"InvalidationList"`
 }
 
+// String returns the string representation of the ListInvalidationsOutput.
+func (s ListInvalidationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The request to list your streaming distributions.
 type ListStreamingDistributionsInput struct {
 	// Use this when paginating results to indicate where to begin in your list
@@ -1936,6 +2384,11 @@ type metadataListStreamingDistributionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListStreamingDistributionsInput.
+func (s ListStreamingDistributionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The returned result of the corresponding request.
 type ListStreamingDistributionsOutput struct {
 	// The StreamingDistributionList type.
@@ -1948,6 +2401,11 @@ type metadataListStreamingDistributionsOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"StreamingDistributionList"`
 }
 
+// String returns the string representation of the ListStreamingDistributionsOutput.
+func (s ListStreamingDistributionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that controls whether access logs are written for the distribution.
 type LoggingConfig struct {
 	// The Amazon S3 bucket to store the access logs in, for example, myawslogbucket.s3.amazonaws.com.
@@ -1982,6 +2440,89 @@ type metadataLoggingConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the LoggingConfig.
+func (s LoggingConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
+// Method is an enum of the values of Method members.
+type Method string
+
+// The values of Method.
+const (
+	MethodGET     Method = "GET"
+	MethodHEAD    Method = "HEAD"
+	MethodPOST    Method = "POST"
+	MethodPUT     Method = "PUT"
+	MethodPATCH   Method = "PATCH"
+	MethodOPTIONS Method = "OPTIONS"
+	MethodDELETE  Method = "DELETE"
+)
+
+// MethodValues returns the values of Method known to this
+// version of the SDK. The service may accept values added since.
+func MethodValues() []Method {
+	return []Method{
+		Method("GET"),
+		Method("HEAD"),
+		Method("POST"),
+		Method("PUT"),
+		Method("PATCH"),
+		Method("OPTIONS"),
+		Method("DELETE"),
+	}
+}
+
+// String returns the Method as the string of a member.
+func (v Method) String() string {
+	return string(v)
+}
+
+// ParseMethod returns the Method of str, failing if it is not
+// one of the MethodValues.
+func ParseMethod(str string) (Method, error) {
+	for _, v := range MethodValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid Method value %q", str)
+}
+
+// MinimumProtocolVersion is an enum of the values of MinimumProtocolVersion members.
+type MinimumProtocolVersion string
+
+// The values of MinimumProtocolVersion.
+const (
+	MinimumProtocolVersionSSLv3 MinimumProtocolVersion = "SSLv3"
+	MinimumProtocolVersionTLSv1 MinimumProtocolVersion = "TLSv1"
+)
+
+// MinimumProtocolVersionValues returns the values of MinimumProtocolVersion known to this
+// version of the SDK. The service may accept values added since.
+func MinimumProtocolVersionValues() []MinimumProtocolVersion {
+	return []MinimumProtocolVersion{
+		MinimumProtocolVersion("SSLv3"),
+		MinimumProtocolVersion("TLSv1"),
+	}
+}
+
+// String returns the MinimumProtocolVersion as the string of a member.
+func (v MinimumProtocolVersion) String() string {
+	return string(v)
+}
+
+// ParseMinimumProtocolVersion returns the MinimumProtocolVersion of str, failing if it is not
+// one of the MinimumProtocolVersionValues.
+func ParseMinimumProtocolVersion(str string) (MinimumProtocolVersion, error) {
+	for _, v := range MinimumProtocolVersionValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid MinimumProtocolVersion value %q", str)
+}
+
 // A complex type that describes the Amazon S3 bucket or the HTTP server (for
 // example, a web server) from which CloudFront gets your files.You must create
 // at least one origin.
@@ -2019,10 +2560,49 @@ type metadataOrigin struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Origin.
+func (s Origin) String() string {
+	return awsutil.StringValue(s)
+}
+
+// OriginProtocolPolicy is an enum of the values of OriginProtocolPolicy members.
+type OriginProtocolPolicy string
+
+// The values of OriginProtocolPolicy.
+const (
+	OriginProtocolPolicyHttpOnly    OriginProtocolPolicy = "http-only"
+	OriginProtocolPolicyMatchViewer OriginProtocolPolicy = "match-viewer"
+)
+
+// OriginProtocolPolicyValues returns the values of OriginProtocolPolicy known to this
+// version of the SDK. The service may accept values added since.
+func OriginProtocolPolicyValues() []OriginProtocolPolicy {
+	return []OriginProtocolPolicy{
+		OriginProtocolPolicy("http-only"),
+		OriginProtocolPolicy("match-viewer"),
+	}
+}
+
+// String returns the OriginProtocolPolicy as the string of a member.
+func (v OriginProtocolPolicy) String() string {
+	return string(v)
+}
+
+// ParseOriginProtocolPolicy returns the OriginProtocolPolicy of str, failing if it is not
+// one of the OriginProtocolPolicyValues.
+func ParseOriginProtocolPolicy(str string) (OriginProtocolPolicy, error) {
+	for _, v := range OriginProtocolPolicyValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid OriginProtocolPolicy value %q", str)
+}
+
 // A complex type that contains information about origins for this distribution.
 type Origins struct {
 	// A complex type that contains origins for this distribution.
-	Items []*Origin `locationNameList:"Origin" type:"list"`
+	Items []*Origin `locationNameList:"Origin" type:"list" min:"1"`
 
 	// The number of origins for this distribution.
 	Quantity *int64 `type:"integer" required:"true"`
@@ -2034,6 +2614,11 @@ type metadataOrigins struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Origins.
+func (s Origins) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains information about the objects that you want
 // to invalidate.
 type Paths struct {
@@ -2050,6 +2635,47 @@ type metadataPaths struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Paths.
+func (s Paths) String() string {
+	return awsutil.StringValue(s)
+}
+
+// PriceClass is an enum of the values of PriceClass members.
+type PriceClass string
+
+// The values of PriceClass.
+const (
+	PriceClassPriceClass100 PriceClass = "PriceClass_100"
+	PriceClassPriceClass200 PriceClass = "PriceClass_200"
+	PriceClassPriceClassAll PriceClass = "PriceClass_All"
+)
+
+// PriceClassValues returns the values of PriceClass known to this
+// version of the SDK. The service may accept values added since.
+func PriceClassValues() []PriceClass {
+	return []PriceClass{
+		PriceClass("PriceClass_100"),
+		PriceClass("PriceClass_200"),
+		PriceClass("PriceClass_All"),
+	}
+}
+
+// String returns the PriceClass as the string of a member.
+func (v PriceClass) String() string {
+	return string(v)
+}
+
+// ParsePriceClass returns the PriceClass of str, failing if it is not
+// one of the PriceClassValues.
+func ParsePriceClass(str string) (PriceClass, error) {
+	for _, v := range PriceClassValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid PriceClass value %q", str)
+}
+
 // A complex type that identifies ways in which you want to restrict distribution
 // of your content.
 type Restrictions struct {
@@ -2068,6 +2694,11 @@ type metadataRestrictions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Restrictions.
+func (s Restrictions) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains information about the Amazon S3 bucket from
 // which you want CloudFront to get your media files for distribution.
 type S3Origin struct {
@@ -2084,6 +2715,11 @@ type metadataS3Origin struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the S3Origin.
+func (s S3Origin) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains information about the Amazon S3 origin. If the
 // origin is a custom origin, use the CustomOriginConfig element instead.
 type S3OriginConfig struct {
@@ -2107,6 +2743,45 @@ type metadataS3OriginConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the S3OriginConfig.
+func (s S3OriginConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
+// SSLSupportMethod is an enum of the values of SSLSupportMethod members.
+type SSLSupportMethod string
+
+// The values of SSLSupportMethod.
+const (
+	SSLSupportMethodSniOnly SSLSupportMethod = "sni-only"
+	SSLSupportMethodVip     SSLSupportMethod = "vip"
+)
+
+// SSLSupportMethodValues returns the values of SSLSupportMethod known to this
+// version of the SDK. The service may accept values added since.
+func SSLSupportMethodValues() []SSLSupportMethod {
+	return []SSLSupportMethod{
+		SSLSupportMethod("sni-only"),
+		SSLSupportMethod("vip"),
+	}
+}
+
+// String returns the SSLSupportMethod as the string of a member.
+func (v SSLSupportMethod) String() string {
+	return string(v)
+}
+
+// ParseSSLSupportMethod returns the SSLSupportMethod of str, failing if it is not
+// one of the SSLSupportMethodValues.
+func ParseSSLSupportMethod(str string) (SSLSupportMethod, error) {
+	for _, v := range SSLSupportMethodValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid SSLSupportMethod value %q", str)
+}
+
 // A complex type that lists the AWS accounts that were included in the TrustedSigners
 // complex type, as well as their active CloudFront key pair IDs, if any.
 type Signer struct {
@@ -2127,6 +2802,11 @@ type metadataSigner struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Signer.
+func (s Signer) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A streaming distribution.
 type StreamingDistribution struct {
 	// CloudFront automatically adds this element to the response only if you've
@@ -2164,6 +2844,11 @@ type metadataStreamingDistribution struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StreamingDistribution.
+func (s StreamingDistribution) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The configuration for the streaming distribution.
 type StreamingDistributionConfig struct {
 	// A complex type that contains information about CNAMEs (alternate domain names),
@@ -2195,7 +2880,7 @@ type StreamingDistributionConfig struct {
 
 	// A complex type that contains information about price class for this streaming
 	// distribution.
-	PriceClass *string `type:"string"`
+	PriceClass *string `type:"string" enum:"PriceClass_100,PriceClass_200,PriceClass_All"`
 
 	// A complex type that contains information about the Amazon S3 bucket from
 	// which you want CloudFront to get your media files for distribution.
@@ -2221,6 +2906,11 @@ type metadataStreamingDistributionConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StreamingDistributionConfig.
+func (s StreamingDistributionConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A streaming distribution list.
 type StreamingDistributionList struct {
 	// A flag that indicates whether more streaming distributions remain to be listed.
@@ -2255,6 +2945,11 @@ type metadataStreamingDistributionList struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StreamingDistributionList.
+func (s StreamingDistributionList) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A summary of the information for an Amazon CloudFront streaming distribution.
 type StreamingDistributionSummary struct {
 	// A complex type that contains information about CNAMEs (alternate domain names),
@@ -2276,7 +2971,7 @@ type StreamingDistributionSummary struct {
 	// The date and time the distribution was last modified.
 	LastModifiedTime *time.Time `type:"timestamp" timestampFormat:"iso8601" required:"true"`
 
-	PriceClass *string `type:"string" required:"true"`
+	PriceClass *string `type:"string" enum:"PriceClass_100,PriceClass_200,PriceClass_All" required:"true"`
 
 	// A complex type that contains information about the Amazon S3 bucket from
 	// which you want CloudFront to get your media files for distribution.
@@ -2307,6 +3002,11 @@ type metadataStreamingDistributionSummary struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StreamingDistributionSummary.
+func (s StreamingDistributionSummary) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that controls whether access logs are written for this streaming
 // distribution.
 type StreamingLoggingConfig struct {
@@ -2334,6 +3034,11 @@ type metadataStreamingLoggingConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StreamingLoggingConfig.
+func (s StreamingLoggingConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that specifies the AWS accounts, if any, that you want to
 // allow to create signed URLs for private content. If you want to require signed
 // URLs in requests for objects in the target origin that match the PathPattern
@@ -2364,6 +3069,11 @@ type metadataTrustedSigners struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TrustedSigners.
+func (s TrustedSigners) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The request to update an origin access identity.
 type UpdateCloudFrontOriginAccessIdentityInput struct {
 	// The identity's configuration information.
@@ -2383,6 +3093,11 @@ type metadataUpdateCloudFrontOriginAccessIdentityInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"CloudFrontOriginAccessIdentityConfig"`
 }
 
+// String returns the string representation of the UpdateCloudFrontOriginAccessIdentityInput.
+func (s UpdateCloudFrontOriginAccessIdentityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The returned result of the corresponding request.
 type UpdateCloudFrontOriginAccessIdentityOutput struct {
 	// The origin access identity's information.
@@ -2398,6 +3113,11 @@ type metadataUpdateCloudFrontOriginAccessIdentityOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"CloudFrontOriginAccessIdentity"`
 }
 
+// String returns the string representation of the UpdateCloudFrontOriginAccessIdentityOutput.
+func (s UpdateCloudFrontOriginAccessIdentityOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The request to update a distribution.
 type UpdateDistributionInput struct {
 	// The distribution's configuration information.
@@ -2417,6 +3137,11 @@ type metadataUpdateDistributionInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"DistributionConfig"`
 }
 
+// String returns the string representation of the UpdateDistributionInput.
+func (s UpdateDistributionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The returned result of the corresponding request.
 type UpdateDistributionOutput struct {
 	// The distribution's information.
@@ -2432,6 +3157,11 @@ type metadataUpdateDistributionOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"Distribution"`
 }
 
+// String returns the string representation of the UpdateDistributionOutput.
+func (s UpdateDistributionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The request to update a streaming distribution.
 type UpdateStreamingDistributionInput struct {
 	// The streaming distribution's id.
@@ -2451,6 +3181,11 @@ type metadataUpdateStreamingDistributionInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"StreamingDistributionConfig"`
 }
 
+// String returns the string representation of the UpdateStreamingDistributionInput.
+func (s UpdateStreamingDistributionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The returned result of the corresponding request.
 type UpdateStreamingDistributionOutput struct {
 	// The current version of the configuration. For example: E2QWRUHAPOMQZL.
@@ -2466,6 +3201,11 @@ type metadataUpdateStreamingDistributionOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"StreamingDistribution"`
 }
 
+// String returns the string representation of the UpdateStreamingDistributionOutput.
+func (s UpdateStreamingDistributionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains information about viewer certificates for this
 // distribution.
 type ViewerCertificate struct {
@@ -2492,7 +3232,7 @@ type ViewerCertificate struct {
 	// If you're using a custom certificate (if you specify a value for IAMCertificateId)
 	// and if you're using SNI (if you specify sni-only for SSLSupportMethod), you
 	// must specify TLSv1 for MinimumProtocolVersion.
-	MinimumProtocolVersion *string `type:"string"`
+	MinimumProtocolVersion *string `type:"string" enum:"SSLv3,TLSv1"`
 
 	// If you specify a value for IAMCertificateId, you must also specify how you
 	// want CloudFront to serve HTTPS requests. Valid values are vip and sni-only.
@@ -2503,11 +3243,52 @@ type ViewerCertificate struct {
 	// viewers that support Server Name Indication (SNI). All modern browsers support
 	// SNI, but some browsers still in use don't support SNI. Do not specify a value
 	// for SSLSupportMethod if you specified true for CloudFrontDefaultCertificate.
-	SSLSupportMethod *string `type:"string"`
+	SSLSupportMethod *string `type:"string" enum:"sni-only,vip"`
 
 	metadataViewerCertificate `json:"-", xml:"-"`
 }
 
 type metadataViewerCertificate struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the ViewerCertificate.
+func (s ViewerCertificate) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ViewerProtocolPolicy is an enum of the values of ViewerProtocolPolicy members.
+type ViewerProtocolPolicy string
+
+// The values of ViewerProtocolPolicy.
+const (
+	ViewerProtocolPolicyAllowAll        ViewerProtocolPolicy = "allow-all"
+	ViewerProtocolPolicyHttpsOnly       ViewerProtocolPolicy = "https-only"
+	ViewerProtocolPolicyRedirectToHttps ViewerProtocolPolicy = "redirect-to-https"
+)
+
+// ViewerProtocolPolicyValues returns the values of ViewerProtocolPolicy known to this
+// version of the SDK. The service may accept values added since.
+func ViewerProtocolPolicyValues() []ViewerProtocolPolicy {
+	return []ViewerProtocolPolicy{
+		ViewerProtocolPolicy("allow-all"),
+		ViewerProtocolPolicy("https-only"),
+		ViewerProtocolPolicy("redirect-to-https"),
+	}
+}
+
+// String returns the ViewerProtocolPolicy as the string of a member.
+func (v ViewerProtocolPolicy) String() string {
+	return string(v)
+}
+
+// ParseViewerProtocolPolicy returns the ViewerProtocolPolicy of str, failing if it is not
+// one of the ViewerProtocolPolicyValues.
+func ParseViewerProtocolPolicy(str string) (ViewerProtocolPolicy, error) {
+	for _, v := range ViewerProtocolPolicyValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ViewerProtocolPolicy value %q", str)
 }
\ No newline at end of file
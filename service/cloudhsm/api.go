@@ -4,7 +4,10 @@
 package cloudhsm
 
 import (
+	"fmt"
+
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // CreateHAPGRequest generates a request for the CreateHAPG operation.
@@ -473,10 +476,80 @@ func (c *CloudHSM) ModifyLunaClient(input *ModifyLunaClientInput) (output *Modif
 
 var opModifyLunaClient *aws.Operation
 
+// ClientVersion is an enum of the values of ClientVersion members.
+type ClientVersion string
+
+// The values of ClientVersion.
+const (
+	ClientVersion51 ClientVersion = "5.1"
+	ClientVersion53 ClientVersion = "5.3"
+)
+
+// ClientVersionValues returns the values of ClientVersion known to this
+// version of the SDK. The service may accept values added since.
+func ClientVersionValues() []ClientVersion {
+	return []ClientVersion{
+		ClientVersion("5.1"),
+		ClientVersion("5.3"),
+	}
+}
+
+// String returns the ClientVersion as the string of a member.
+func (v ClientVersion) String() string {
+	return string(v)
+}
+
+// ParseClientVersion returns the ClientVersion of str, failing if it is not
+// one of the ClientVersionValues.
+func ParseClientVersion(str string) (ClientVersion, error) {
+	for _, v := range ClientVersionValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ClientVersion value %q", str)
+}
+
+// CloudHsmObjectState is an enum of the values of CloudHsmObjectState members.
+type CloudHsmObjectState string
+
+// The values of CloudHsmObjectState.
+const (
+	CloudHsmObjectStateREADY    CloudHsmObjectState = "READY"
+	CloudHsmObjectStateUPDATING CloudHsmObjectState = "UPDATING"
+	CloudHsmObjectStateDEGRADED CloudHsmObjectState = "DEGRADED"
+)
+
+// CloudHsmObjectStateValues returns the values of CloudHsmObjectState known to this
+// version of the SDK. The service may accept values added since.
+func CloudHsmObjectStateValues() []CloudHsmObjectState {
+	return []CloudHsmObjectState{
+		CloudHsmObjectState("READY"),
+		CloudHsmObjectState("UPDATING"),
+		CloudHsmObjectState("DEGRADED"),
+	}
+}
+
+// String returns the CloudHsmObjectState as the string of a member.
+func (v CloudHsmObjectState) String() string {
+	return string(v)
+}
+
+// ParseCloudHsmObjectState returns the CloudHsmObjectState of str, failing if it is not
+// one of the CloudHsmObjectStateValues.
+func ParseCloudHsmObjectState(str string) (CloudHsmObjectState, error) {
+	for _, v := range CloudHsmObjectStateValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid CloudHsmObjectState value %q", str)
+}
+
 // Contains the inputs for the CreateHapgRequest action.
 type CreateHAPGInput struct {
 	// The label of the new high-availability partition group.
-	Label *string `type:"string" required:"true"`
+	Label *string `type:"string" pattern:"[a-zA-Z0-9_.-]{1,64}" required:"true"`
 
 	metadataCreateHAPGInput `json:"-", xml:"-"`
 }
@@ -485,10 +558,15 @@ type metadataCreateHAPGInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateHAPGInput.
+func (s CreateHAPGInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output of the CreateHAPartitionGroup action.
 type CreateHAPGOutput struct {
 	// The ARN of the high-availability partition group.
-	HAPGARN *string `locationName:"HapgArn" type:"string"`
+	HAPGARN *string `locationName:"HapgArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hapg-[0-9a-f]{8}"`
 
 	metadataCreateHAPGOutput `json:"-", xml:"-"`
 }
@@ -497,33 +575,38 @@ type metadataCreateHAPGOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateHAPGOutput.
+func (s CreateHAPGOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the inputs for the CreateHsm action.
 type CreateHSMInput struct {
 	// A user-defined token to ensure idempotence. Subsequent calls to this action
 	// with the same token will be ignored.
-	ClientToken *string `locationName:"ClientToken" type:"string"`
+	ClientToken *string `locationName:"ClientToken" type:"string" pattern:"[a-zA-Z0-9]{1,64}"`
 
 	// The IP address to assign to the HSM's ENI.
-	ENIIP *string `locationName:"EniIp" type:"string"`
+	ENIIP *string `locationName:"EniIp" type:"string" pattern:"\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}"`
 
 	// The external ID from IamRoleArn, if present.
-	ExternalID *string `locationName:"ExternalId" type:"string"`
+	ExternalID *string `locationName:"ExternalId" type:"string" pattern:"[\\w :+=./-]*"`
 
 	// The ARN of an IAM role to enable the AWS CloudHSM service to allocate an
 	// ENI on your behalf.
-	IAMRoleARN *string `locationName:"IamRoleArn" type:"string" required:"true"`
+	IAMRoleARN *string `locationName:"IamRoleArn" type:"string" pattern:"arn:aws(-iso)?:iam::[0-9]{12}:role/[a-zA-Z0-9_\\+=,\\.\\-@]{1,64}" required:"true"`
 
 	// The SSH public key to install on the HSM.
-	SSHKey *string `locationName:"SshKey" type:"string" required:"true"`
+	SSHKey *string `locationName:"SshKey" type:"string" pattern:"[a-zA-Z0-9+/= ._:\\\\@-]*" required:"true"`
 
 	// The identifier of the subnet in your VPC in which to place the HSM.
-	SubnetID *string `locationName:"SubnetId" type:"string" required:"true"`
+	SubnetID *string `locationName:"SubnetId" type:"string" pattern:"subnet-[0-9a-f]{8}" required:"true"`
 
 	// The subscription type.
-	SubscriptionType *string `locationName:"SubscriptionType" type:"string" required:"true"`
+	SubscriptionType *string `locationName:"SubscriptionType" type:"string" enum:"PRODUCTION" required:"true"`
 
 	// The IP address for the syslog monitoring server.
-	SyslogIP *string `locationName:"SyslogIp" type:"string"`
+	SyslogIP *string `locationName:"SyslogIp" type:"string" pattern:"\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}"`
 
 	metadataCreateHSMInput `json:"-", xml:"-"`
 }
@@ -532,10 +615,15 @@ type metadataCreateHSMInput struct {
 	SDKShapeTraits bool `locationName:"CreateHsmRequest" type:"structure"`
 }
 
+// String returns the string representation of the CreateHSMInput.
+func (s CreateHSMInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output of the CreateHsm action.
 type CreateHSMOutput struct {
 	// The ARN of the HSM.
-	HSMARN *string `locationName:"HsmArn" type:"string"`
+	HSMARN *string `locationName:"HsmArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hsm-[0-9a-f]{8}"`
 
 	metadataCreateHSMOutput `json:"-", xml:"-"`
 }
@@ -544,14 +632,19 @@ type metadataCreateHSMOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateHSMOutput.
+func (s CreateHSMOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the inputs for the CreateLunaClient action.
 type CreateLunaClientInput struct {
 	// The contents of a Base64-Encoded X.509 v3 certificate to be installed on
 	// the HSMs used by this client.
-	Certificate *string `type:"string" required:"true"`
+	Certificate *string `type:"string" min:"600" max:"2400" pattern:"[\\w :+=./\\n-]*" required:"true"`
 
 	// The label for the client.
-	Label *string `type:"string"`
+	Label *string `type:"string" pattern:"[a-zA-Z0-9_.-]{2,64}"`
 
 	metadataCreateLunaClientInput `json:"-", xml:"-"`
 }
@@ -560,10 +653,15 @@ type metadataCreateLunaClientInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateLunaClientInput.
+func (s CreateLunaClientInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output of the CreateLunaClient action.
 type CreateLunaClientOutput struct {
 	// The ARN of the client.
-	ClientARN *string `locationName:"ClientArn" type:"string"`
+	ClientARN *string `locationName:"ClientArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:client-[0-9a-f]{8}"`
 
 	metadataCreateLunaClientOutput `json:"-", xml:"-"`
 }
@@ -572,10 +670,15 @@ type metadataCreateLunaClientOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateLunaClientOutput.
+func (s CreateLunaClientOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the inputs for the DeleteHapg action.
 type DeleteHAPGInput struct {
 	// The ARN of the high-availability partition group to delete.
-	HAPGARN *string `locationName:"HapgArn" type:"string" required:"true"`
+	HAPGARN *string `locationName:"HapgArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hapg-[0-9a-f]{8}" required:"true"`
 
 	metadataDeleteHAPGInput `json:"-", xml:"-"`
 }
@@ -584,10 +687,15 @@ type metadataDeleteHAPGInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteHAPGInput.
+func (s DeleteHAPGInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output of the DeleteHapg action.
 type DeleteHAPGOutput struct {
 	// The status of the action.
-	Status *string `type:"string" required:"true"`
+	Status *string `type:"string" pattern:"[\\w :+=./\\\\-]*" required:"true"`
 
 	metadataDeleteHAPGOutput `json:"-", xml:"-"`
 }
@@ -596,10 +704,15 @@ type metadataDeleteHAPGOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteHAPGOutput.
+func (s DeleteHAPGOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the inputs for the DeleteHsm action.
 type DeleteHSMInput struct {
 	// The ARN of the HSM to delete.
-	HSMARN *string `locationName:"HsmArn" type:"string" required:"true"`
+	HSMARN *string `locationName:"HsmArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hsm-[0-9a-f]{8}" required:"true"`
 
 	metadataDeleteHSMInput `json:"-", xml:"-"`
 }
@@ -608,10 +721,15 @@ type metadataDeleteHSMInput struct {
 	SDKShapeTraits bool `locationName:"DeleteHsmRequest" type:"structure"`
 }
 
+// String returns the string representation of the DeleteHSMInput.
+func (s DeleteHSMInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output of the DeleteHsm action.
 type DeleteHSMOutput struct {
 	// The status of the action.
-	Status *string `type:"string" required:"true"`
+	Status *string `type:"string" pattern:"[\\w :+=./\\\\-]*" required:"true"`
 
 	metadataDeleteHSMOutput `json:"-", xml:"-"`
 }
@@ -620,9 +738,14 @@ type metadataDeleteHSMOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteHSMOutput.
+func (s DeleteHSMOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteLunaClientInput struct {
 	// The ARN of the client to delete.
-	ClientARN *string `locationName:"ClientArn" type:"string" required:"true"`
+	ClientARN *string `locationName:"ClientArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:client-[0-9a-f]{8}" required:"true"`
 
 	metadataDeleteLunaClientInput `json:"-", xml:"-"`
 }
@@ -631,9 +754,14 @@ type metadataDeleteLunaClientInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteLunaClientInput.
+func (s DeleteLunaClientInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteLunaClientOutput struct {
 	// The status of the action.
-	Status *string `type:"string" required:"true"`
+	Status *string `type:"string" pattern:"[\\w :+=./\\\\-]*" required:"true"`
 
 	metadataDeleteLunaClientOutput `json:"-", xml:"-"`
 }
@@ -642,10 +770,15 @@ type metadataDeleteLunaClientOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteLunaClientOutput.
+func (s DeleteLunaClientOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the inputs for the DescribeHapg action.
 type DescribeHAPGInput struct {
 	// The ARN of the high-availability partition group to describe.
-	HAPGARN *string `locationName:"HapgArn" type:"string" required:"true"`
+	HAPGARN *string `locationName:"HapgArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hapg-[0-9a-f]{8}" required:"true"`
 
 	metadataDescribeHAPGInput `json:"-", xml:"-"`
 }
@@ -654,13 +787,18 @@ type metadataDescribeHAPGInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeHAPGInput.
+func (s DescribeHAPGInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output of the DescribeHapg action.
 type DescribeHAPGOutput struct {
 	// The ARN of the high-availability partition group.
-	HAPGARN *string `locationName:"HapgArn" type:"string"`
+	HAPGARN *string `locationName:"HapgArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hapg-[0-9a-f]{8}"`
 
 	// The serial number of the high-availability partition group.
-	HAPGSerial *string `locationName:"HapgSerial" type:"string"`
+	HAPGSerial *string `locationName:"HapgSerial" type:"string" pattern:"[\\w :+=./\\\\-]*"`
 
 	// Contains a list of ARNs that identify the HSMs.
 	HSMsLastActionFailed []*string `locationName:"HsmsLastActionFailed" type:"list"`
@@ -672,17 +810,17 @@ type DescribeHAPGOutput struct {
 	HSMsPendingRegistration []*string `locationName:"HsmsPendingRegistration" type:"list"`
 
 	// The label for the high-availability partition group.
-	Label *string `type:"string"`
+	Label *string `type:"string" pattern:"[a-zA-Z0-9_.-]{1,64}"`
 
 	// The date and time the high-availability partition group was last modified.
-	LastModifiedTimestamp *string `type:"string"`
+	LastModifiedTimestamp *string `type:"string" pattern:"\\d*"`
 
 	// The list of partition serial numbers that belong to the high-availability
 	// partition group.
 	PartitionSerialList []*string `type:"list"`
 
 	// The state of the high-availability partition group.
-	State *string `type:"string"`
+	State *string `type:"string" enum:"READY,UPDATING,DEGRADED"`
 
 	metadataDescribeHAPGOutput `json:"-", xml:"-"`
 }
@@ -691,15 +829,20 @@ type metadataDescribeHAPGOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeHAPGOutput.
+func (s DescribeHAPGOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the inputs for the DescribeHsm action.
 type DescribeHSMInput struct {
 	// The ARN of the HSM. Either the HsmArn or the SerialNumber parameter must
 	// be specified.
-	HSMARN *string `locationName:"HsmArn" type:"string"`
+	HSMARN *string `locationName:"HsmArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hsm-[0-9a-f]{8}"`
 
 	// The serial number of the HSM. Either the HsmArn or the HsmSerialNumber parameter
 	// must be specified.
-	HSMSerialNumber *string `locationName:"HsmSerialNumber" type:"string"`
+	HSMSerialNumber *string `locationName:"HsmSerialNumber" type:"string" pattern:"\\d{1,16}"`
 
 	metadataDescribeHSMInput `json:"-", xml:"-"`
 }
@@ -708,70 +851,75 @@ type metadataDescribeHSMInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeHSMInput.
+func (s DescribeHSMInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output of the DescribeHsm action.
 type DescribeHSMOutput struct {
 	// The Availability Zone that the HSM is in.
-	AvailabilityZone *string `type:"string"`
+	AvailabilityZone *string `type:"string" pattern:"[a-zA-Z0-9\\-]*"`
 
 	// The identifier of the elastic network interface (ENI) attached to the HSM.
-	ENIID *string `locationName:"EniId" type:"string"`
+	ENIID *string `locationName:"EniId" type:"string" pattern:"eni-[0-9a-f]{8}"`
 
 	// The IP address assigned to the HSM's ENI.
-	ENIIP *string `locationName:"EniIp" type:"string"`
+	ENIIP *string `locationName:"EniIp" type:"string" pattern:"\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}"`
 
 	// The ARN of the HSM.
-	HSMARN *string `locationName:"HsmArn" type:"string"`
+	HSMARN *string `locationName:"HsmArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hsm-[0-9a-f]{8}"`
 
 	// The HSM model type.
-	HSMType *string `locationName:"HsmType" type:"string"`
+	HSMType *string `locationName:"HsmType" type:"string" pattern:"[\\w :+=./\\\\-]*"`
 
 	// The ARN of the IAM role assigned to the HSM.
-	IAMRoleARN *string `locationName:"IamRoleArn" type:"string"`
+	IAMRoleARN *string `locationName:"IamRoleArn" type:"string" pattern:"arn:aws(-iso)?:iam::[0-9]{12}:role/[a-zA-Z0-9_\\+=,\\.\\-@]{1,64}"`
 
 	// The list of partitions on the HSM.
 	Partitions []*string `type:"list"`
 
 	// The date and time the SSH key was last updated.
-	SSHKeyLastUpdated *string `locationName:"SshKeyLastUpdated" type:"string"`
+	SSHKeyLastUpdated *string `locationName:"SshKeyLastUpdated" type:"string" pattern:"\\d*"`
 
 	// The public SSH key.
-	SSHPublicKey *string `locationName:"SshPublicKey" type:"string"`
+	SSHPublicKey *string `locationName:"SshPublicKey" type:"string" pattern:"[a-zA-Z0-9+/= ._:\\\\@-]*"`
 
 	// The serial number of the HSM.
-	SerialNumber *string `type:"string"`
+	SerialNumber *string `type:"string" pattern:"\\d{1,16}"`
 
 	// The date and time the server certificate was last updated.
-	ServerCertLastUpdated *string `type:"string"`
+	ServerCertLastUpdated *string `type:"string" pattern:"\\d*"`
 
 	// The URI of the certificate server.
-	ServerCertURI *string `locationName:"ServerCertUri" type:"string"`
+	ServerCertURI *string `locationName:"ServerCertUri" type:"string" pattern:"[\\w :+=./\\\\-]*"`
 
 	// The HSM software version.
-	SoftwareVersion *string `type:"string"`
+	SoftwareVersion *string `type:"string" pattern:"[\\w :+=./\\\\-]*"`
 
 	// The status of the HSM.
-	Status *string `type:"string"`
+	Status *string `type:"string" enum:"PENDING,RUNNING,UPDATING,SUSPENDED,TERMINATING,TERMINATED,DEGRADED"`
 
 	// Contains additional information about the status of the HSM.
-	StatusDetails *string `type:"string"`
+	StatusDetails *string `type:"string" pattern:"[\\w :+=./\\\\-]*"`
 
 	// The identifier of the subnet the HSM is in.
-	SubnetID *string `locationName:"SubnetId" type:"string"`
+	SubnetID *string `locationName:"SubnetId" type:"string" pattern:"subnet-[0-9a-f]{8}"`
 
 	// The subscription end date.
-	SubscriptionEndDate *string `type:"string"`
+	SubscriptionEndDate *string `type:"string" pattern:"\\d*"`
 
 	// The subscription start date.
-	SubscriptionStartDate *string `type:"string"`
+	SubscriptionStartDate *string `type:"string" pattern:"\\d*"`
 
 	// The subscription type.
-	SubscriptionType *string `type:"string"`
+	SubscriptionType *string `type:"string" enum:"PRODUCTION"`
 
 	// The identifier of the VPC that the HSM is in.
-	VPCID *string `locationName:"VpcId" type:"string"`
+	VPCID *string `locationName:"VpcId" type:"string" pattern:"vpc-[0-9a-f]{8}"`
 
 	// The name of the HSM vendor.
-	VendorName *string `type:"string"`
+	VendorName *string `type:"string" pattern:"[\\w :+=./\\\\-]*"`
 
 	metadataDescribeHSMOutput `json:"-", xml:"-"`
 }
@@ -780,12 +928,17 @@ type metadataDescribeHSMOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeHSMOutput.
+func (s DescribeHSMOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeLunaClientInput struct {
 	// The certificate fingerprint.
-	CertificateFingerprint *string `type:"string"`
+	CertificateFingerprint *string `type:"string" pattern:"([0-9a-fA-F][0-9a-fA-F]:){15}[0-9a-fA-F][0-9a-fA-F]"`
 
 	// The ARN of the client.
-	ClientARN *string `locationName:"ClientArn" type:"string"`
+	ClientARN *string `locationName:"ClientArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:client-[0-9a-f]{8}"`
 
 	metadataDescribeLunaClientInput `json:"-", xml:"-"`
 }
@@ -794,21 +947,26 @@ type metadataDescribeLunaClientInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeLunaClientInput.
+func (s DescribeLunaClientInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeLunaClientOutput struct {
 	// The certificate installed on the HSMs used by this client.
-	Certificate *string `type:"string"`
+	Certificate *string `type:"string" min:"600" max:"2400" pattern:"[\\w :+=./\\n-]*"`
 
 	// The certificate fingerprint.
-	CertificateFingerprint *string `type:"string"`
+	CertificateFingerprint *string `type:"string" pattern:"([0-9a-fA-F][0-9a-fA-F]:){15}[0-9a-fA-F][0-9a-fA-F]"`
 
 	// The ARN of the client.
-	ClientARN *string `locationName:"ClientArn" type:"string"`
+	ClientARN *string `locationName:"ClientArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:client-[0-9a-f]{8}"`
 
 	// The label of the client.
-	Label *string `type:"string"`
+	Label *string `type:"string" pattern:"[a-zA-Z0-9_.-]{1,64}"`
 
 	// The date and time the client was last modified.
-	LastModifiedTimestamp *string `type:"string"`
+	LastModifiedTimestamp *string `type:"string" pattern:"\\d*"`
 
 	metadataDescribeLunaClientOutput `json:"-", xml:"-"`
 }
@@ -817,12 +975,17 @@ type metadataDescribeLunaClientOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeLunaClientOutput.
+func (s DescribeLunaClientOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetConfigInput struct {
 	// The ARN of the client.
-	ClientARN *string `locationName:"ClientArn" type:"string" required:"true"`
+	ClientARN *string `locationName:"ClientArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:client-[0-9a-f]{8}" required:"true"`
 
 	// The client version.
-	ClientVersion *string `type:"string" required:"true"`
+	ClientVersion *string `type:"string" enum:"5.1,5.3" required:"true"`
 
 	// A list of ARNs that identify the high-availability partition groups that
 	// are associated with the client.
@@ -835,15 +998,20 @@ type metadataGetConfigInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetConfigInput.
+func (s GetConfigInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetConfigOutput struct {
 	// The certificate file containing the server.pem files of the HSMs.
-	ConfigCred *string `type:"string"`
+	ConfigCred *string `type:"string" pattern:"[\\w :+=./\\\\-]*"`
 
 	// The chrystoki.conf configuration file.
-	ConfigFile *string `type:"string"`
+	ConfigFile *string `type:"string" pattern:"[\\w :+=./\\\\-]*"`
 
 	// The type of credentials.
-	ConfigType *string `type:"string"`
+	ConfigType *string `type:"string" pattern:"[\\w :+=./\\\\-]*"`
 
 	metadataGetConfigOutput `json:"-", xml:"-"`
 }
@@ -852,6 +1020,55 @@ type metadataGetConfigOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetConfigOutput.
+func (s GetConfigOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// HsmStatus is an enum of the values of HsmStatus members.
+type HsmStatus string
+
+// The values of HsmStatus.
+const (
+	HsmStatusPENDING     HsmStatus = "PENDING"
+	HsmStatusRUNNING     HsmStatus = "RUNNING"
+	HsmStatusUPDATING    HsmStatus = "UPDATING"
+	HsmStatusSUSPENDED   HsmStatus = "SUSPENDED"
+	HsmStatusTERMINATING HsmStatus = "TERMINATING"
+	HsmStatusTERMINATED  HsmStatus = "TERMINATED"
+	HsmStatusDEGRADED    HsmStatus = "DEGRADED"
+)
+
+// HsmStatusValues returns the values of HsmStatus known to this
+// version of the SDK. The service may accept values added since.
+func HsmStatusValues() []HsmStatus {
+	return []HsmStatus{
+		HsmStatus("PENDING"),
+		HsmStatus("RUNNING"),
+		HsmStatus("UPDATING"),
+		HsmStatus("SUSPENDED"),
+		HsmStatus("TERMINATING"),
+		HsmStatus("TERMINATED"),
+		HsmStatus("DEGRADED"),
+	}
+}
+
+// String returns the HsmStatus as the string of a member.
+func (v HsmStatus) String() string {
+	return string(v)
+}
+
+// ParseHsmStatus returns the HsmStatus of str, failing if it is not
+// one of the HsmStatusValues.
+func ParseHsmStatus(str string) (HsmStatus, error) {
+	for _, v := range HsmStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HsmStatus value %q", str)
+}
+
 // Contains the inputs for the ListAvailableZones action.
 type ListAvailableZonesInput struct {
 	metadataListAvailableZonesInput `json:"-", xml:"-"`
@@ -861,6 +1078,11 @@ type metadataListAvailableZonesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListAvailableZonesInput.
+func (s ListAvailableZonesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListAvailableZonesOutput struct {
 	// The list of Availability Zones that have available AWS CloudHSM capacity.
 	AZList []*string `type:"list"`
@@ -872,10 +1094,15 @@ type metadataListAvailableZonesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListAvailableZonesOutput.
+func (s ListAvailableZonesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListHSMsInput struct {
 	// The NextToken value from a previous call to ListHsms. Pass null if this is
 	// the first call.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" pattern:"[a-zA-Z0-9+/]*"`
 
 	metadataListHSMsInput `json:"-", xml:"-"`
 }
@@ -884,6 +1111,11 @@ type metadataListHSMsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListHSMsInput.
+func (s ListHSMsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output of the ListHsms action.
 type ListHSMsOutput struct {
 	// The list of ARNs that identify the HSMs.
@@ -891,7 +1123,7 @@ type ListHSMsOutput struct {
 
 	// If not null, more results are available. Pass this value to ListHsms to retrieve
 	// the next set of items.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" pattern:"[a-zA-Z0-9+/]*"`
 
 	metadataListHSMsOutput `json:"-", xml:"-"`
 }
@@ -900,10 +1132,15 @@ type metadataListHSMsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListHSMsOutput.
+func (s ListHSMsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListHapgsInput struct {
 	// The NextToken value from a previous call to ListHapgs. Pass null if this
 	// is the first call.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" pattern:"[a-zA-Z0-9+/]*"`
 
 	metadataListHapgsInput `json:"-", xml:"-"`
 }
@@ -912,13 +1149,18 @@ type metadataListHapgsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListHapgsInput.
+func (s ListHapgsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListHapgsOutput struct {
 	// The list of high-availability partition groups.
 	HAPGList []*string `locationName:"HapgList" type:"list" required:"true"`
 
 	// If not null, more results are available. Pass this value to ListHapgs to
 	// retrieve the next set of items.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" pattern:"[a-zA-Z0-9+/]*"`
 
 	metadataListHapgsOutput `json:"-", xml:"-"`
 }
@@ -927,10 +1169,15 @@ type metadataListHapgsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListHapgsOutput.
+func (s ListHapgsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListLunaClientsInput struct {
 	// The NextToken value from a previous call to ListLunaClients. Pass null if
 	// this is the first call.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" pattern:"[a-zA-Z0-9+/]*"`
 
 	metadataListLunaClientsInput `json:"-", xml:"-"`
 }
@@ -939,13 +1186,18 @@ type metadataListLunaClientsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListLunaClientsInput.
+func (s ListLunaClientsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListLunaClientsOutput struct {
 	// The list of clients.
 	ClientList []*string `type:"list" required:"true"`
 
 	// If not null, more results are available. Pass this to ListLunaClients to
 	// retrieve the next set of items.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" pattern:"[a-zA-Z0-9+/]*"`
 
 	metadataListLunaClientsOutput `json:"-", xml:"-"`
 }
@@ -954,12 +1206,17 @@ type metadataListLunaClientsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListLunaClientsOutput.
+func (s ListLunaClientsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifyHAPGInput struct {
 	// The ARN of the high-availability partition group to modify.
-	HAPGARN *string `locationName:"HapgArn" type:"string" required:"true"`
+	HAPGARN *string `locationName:"HapgArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hapg-[0-9a-f]{8}" required:"true"`
 
 	// The new label for the high-availability partition group.
-	Label *string `type:"string"`
+	Label *string `type:"string" pattern:"[a-zA-Z0-9_.-]{1,64}"`
 
 	// The list of partition serial numbers to make members of the high-availability
 	// partition group.
@@ -972,9 +1229,14 @@ type metadataModifyHAPGInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyHAPGInput.
+func (s ModifyHAPGInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifyHAPGOutput struct {
 	// The ARN of the high-availability partition group.
-	HAPGARN *string `locationName:"HapgArn" type:"string"`
+	HAPGARN *string `locationName:"HapgArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hapg-[0-9a-f]{8}"`
 
 	metadataModifyHAPGOutput `json:"-", xml:"-"`
 }
@@ -983,25 +1245,30 @@ type metadataModifyHAPGOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyHAPGOutput.
+func (s ModifyHAPGOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the inputs for the ModifyHsm action.
 type ModifyHSMInput struct {
 	// The new IP address for the elastic network interface attached to the HSM.
-	ENIIP *string `locationName:"EniIp" type:"string"`
+	ENIIP *string `locationName:"EniIp" type:"string" pattern:"\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}"`
 
 	// The new external ID.
-	ExternalID *string `locationName:"ExternalId" type:"string"`
+	ExternalID *string `locationName:"ExternalId" type:"string" pattern:"[\\w :+=./-]*"`
 
 	// The ARN of the HSM to modify.
-	HSMARN *string `locationName:"HsmArn" type:"string" required:"true"`
+	HSMARN *string `locationName:"HsmArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hsm-[0-9a-f]{8}" required:"true"`
 
 	// The new IAM role ARN.
-	IAMRoleARN *string `locationName:"IamRoleArn" type:"string"`
+	IAMRoleARN *string `locationName:"IamRoleArn" type:"string" pattern:"arn:aws(-iso)?:iam::[0-9]{12}:role/[a-zA-Z0-9_\\+=,\\.\\-@]{1,64}"`
 
 	// The new identifier of the subnet that the HSM is in.
-	SubnetID *string `locationName:"SubnetId" type:"string"`
+	SubnetID *string `locationName:"SubnetId" type:"string" pattern:"subnet-[0-9a-f]{8}"`
 
 	// The new IP address for the syslog monitoring server.
-	SyslogIP *string `locationName:"SyslogIp" type:"string"`
+	SyslogIP *string `locationName:"SyslogIp" type:"string" pattern:"\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}\\.\\d{1,3}"`
 
 	metadataModifyHSMInput `json:"-", xml:"-"`
 }
@@ -1010,10 +1277,15 @@ type metadataModifyHSMInput struct {
 	SDKShapeTraits bool `locationName:"ModifyHsmRequest" type:"structure"`
 }
 
+// String returns the string representation of the ModifyHSMInput.
+func (s ModifyHSMInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output of the ModifyHsm action.
 type ModifyHSMOutput struct {
 	// The ARN of the HSM.
-	HSMARN *string `locationName:"HsmArn" type:"string"`
+	HSMARN *string `locationName:"HsmArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:hsm-[0-9a-f]{8}"`
 
 	metadataModifyHSMOutput `json:"-", xml:"-"`
 }
@@ -1022,12 +1294,17 @@ type metadataModifyHSMOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyHSMOutput.
+func (s ModifyHSMOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifyLunaClientInput struct {
 	// The new certificate for the client.
-	Certificate *string `type:"string" required:"true"`
+	Certificate *string `type:"string" min:"600" max:"2400" pattern:"[\\w :+=./\\n-]*" required:"true"`
 
 	// The ARN of the client.
-	ClientARN *string `locationName:"ClientArn" type:"string" required:"true"`
+	ClientARN *string `locationName:"ClientArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:client-[0-9a-f]{8}" required:"true"`
 
 	metadataModifyLunaClientInput `json:"-", xml:"-"`
 }
@@ -1036,13 +1313,55 @@ type metadataModifyLunaClientInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyLunaClientInput.
+func (s ModifyLunaClientInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifyLunaClientOutput struct {
 	// The ARN of the client.
-	ClientARN *string `locationName:"ClientArn" type:"string"`
+	ClientARN *string `locationName:"ClientArn" type:"string" pattern:"arn:aws(-iso)?:cloudhsm:[a-zA-Z0-9\\-]*:[0-9]{12}:client-[0-9a-f]{8}"`
 
 	metadataModifyLunaClientOutput `json:"-", xml:"-"`
 }
 
 type metadataModifyLunaClientOutput struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the ModifyLunaClientOutput.
+func (s ModifyLunaClientOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// SubscriptionType is an enum of the values of SubscriptionType members.
+type SubscriptionType string
+
+// The values of SubscriptionType.
+const (
+	SubscriptionTypePRODUCTION SubscriptionType = "PRODUCTION"
+)
+
+// SubscriptionTypeValues returns the values of SubscriptionType known to this
+// version of the SDK. The service may accept values added since.
+func SubscriptionTypeValues() []SubscriptionType {
+	return []SubscriptionType{
+		SubscriptionType("PRODUCTION"),
+	}
+}
+
+// String returns the SubscriptionType as the string of a member.
+func (v SubscriptionType) String() string {
+	return string(v)
+}
+
+// ParseSubscriptionType returns the SubscriptionType of str, failing if it is not
+// one of the SubscriptionTypeValues.
+func ParseSubscriptionType(str string) (SubscriptionType, error) {
+	for _, v := range SubscriptionTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid SubscriptionType value %q", str)
 }
\ No newline at end of file
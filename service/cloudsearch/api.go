@@ -4,9 +4,11 @@
 package cloudsearch
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // BuildSuggestersRequest generates a request for the BuildSuggesters operation.
@@ -741,6 +743,49 @@ type metadataAccessPoliciesStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AccessPoliciesStatus.
+func (s AccessPoliciesStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
+// AlgorithmicStemming is an enum of the values of AlgorithmicStemming members.
+type AlgorithmicStemming string
+
+// The values of AlgorithmicStemming.
+const (
+	AlgorithmicStemmingNone    AlgorithmicStemming = "none"
+	AlgorithmicStemmingMinimal AlgorithmicStemming = "minimal"
+	AlgorithmicStemmingLight   AlgorithmicStemming = "light"
+	AlgorithmicStemmingFull    AlgorithmicStemming = "full"
+)
+
+// AlgorithmicStemmingValues returns the values of AlgorithmicStemming known to this
+// version of the SDK. The service may accept values added since.
+func AlgorithmicStemmingValues() []AlgorithmicStemming {
+	return []AlgorithmicStemming{
+		AlgorithmicStemming("none"),
+		AlgorithmicStemming("minimal"),
+		AlgorithmicStemming("light"),
+		AlgorithmicStemming("full"),
+	}
+}
+
+// String returns the AlgorithmicStemming as the string of a member.
+func (v AlgorithmicStemming) String() string {
+	return string(v)
+}
+
+// ParseAlgorithmicStemming returns the AlgorithmicStemming of str, failing if it is not
+// one of the AlgorithmicStemmingValues.
+func ParseAlgorithmicStemming(str string) (AlgorithmicStemming, error) {
+	for _, v := range AlgorithmicStemmingValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid AlgorithmicStemming value %q", str)
+}
+
 // Synonyms, stopwords, and stemming options for an analysis scheme. Includes
 // tokenization dictionary for Japanese.
 type AnalysisOptions struct {
@@ -748,7 +793,7 @@ type AnalysisOptions struct {
 	// The available levels vary depending on the language. For more information,
 	// see Language Specific Text Processing Settings (http://docs.aws.amazon.com/cloudsearch/latest/developerguide/text-processing.html#text-processing-settings"
 	// target="_blank) in the Amazon CloudSearch Developer Guide
-	AlgorithmicStemming *string `type:"string"`
+	AlgorithmicStemming *string `type:"string" enum:"none,minimal,light,full"`
 
 	// A JSON array that contains a collection of terms, tokens, readings and part
 	// of speech for Japanese Tokenizaiton. The Japanese tokenization dictionary
@@ -787,6 +832,11 @@ type metadataAnalysisOptions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AnalysisOptions.
+func (s AnalysisOptions) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Configuration information for an analysis scheme. Each analysis scheme has
 // a unique name and specifies the language of the text to be processed. The
 // following options can be configured for an analysis scheme: Synonyms, Stopwords,
@@ -798,11 +848,11 @@ type AnalysisScheme struct {
 
 	// An IETF RFC 4646 (http://tools.ietf.org/html/rfc4646" target="_blank) language
 	// code or mul for multiple languages.
-	AnalysisSchemeLanguage *string `type:"string" required:"true"`
+	AnalysisSchemeLanguage *string `type:"string" enum:"ar,bg,ca,cs,da,de,el,en,es,eu,fa,fi,fr,ga,gl,he,hi,hu,hy,id,it,ja,ko,lv,mul,nl,no,pt,ro,ru,sv,th,tr,zh-Hans,zh-Hant" required:"true"`
 
 	// Names must begin with a letter and can contain the following characters:
 	// a-z (lowercase), 0-9, and _ (underscore).
-	AnalysisSchemeName *string `type:"string" required:"true"`
+	AnalysisSchemeName *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*" required:"true"`
 
 	metadataAnalysisScheme `json:"-", xml:"-"`
 }
@@ -811,6 +861,111 @@ type metadataAnalysisScheme struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AnalysisScheme.
+func (s AnalysisScheme) String() string {
+	return awsutil.StringValue(s)
+}
+
+// AnalysisSchemeLanguage is an enum of the values of AnalysisSchemeLanguage members.
+type AnalysisSchemeLanguage string
+
+// The values of AnalysisSchemeLanguage.
+const (
+	AnalysisSchemeLanguageAr     AnalysisSchemeLanguage = "ar"
+	AnalysisSchemeLanguageBg     AnalysisSchemeLanguage = "bg"
+	AnalysisSchemeLanguageCa     AnalysisSchemeLanguage = "ca"
+	AnalysisSchemeLanguageCs     AnalysisSchemeLanguage = "cs"
+	AnalysisSchemeLanguageDa     AnalysisSchemeLanguage = "da"
+	AnalysisSchemeLanguageDe     AnalysisSchemeLanguage = "de"
+	AnalysisSchemeLanguageEl     AnalysisSchemeLanguage = "el"
+	AnalysisSchemeLanguageEn     AnalysisSchemeLanguage = "en"
+	AnalysisSchemeLanguageEs     AnalysisSchemeLanguage = "es"
+	AnalysisSchemeLanguageEu     AnalysisSchemeLanguage = "eu"
+	AnalysisSchemeLanguageFa     AnalysisSchemeLanguage = "fa"
+	AnalysisSchemeLanguageFi     AnalysisSchemeLanguage = "fi"
+	AnalysisSchemeLanguageFr     AnalysisSchemeLanguage = "fr"
+	AnalysisSchemeLanguageGa     AnalysisSchemeLanguage = "ga"
+	AnalysisSchemeLanguageGl     AnalysisSchemeLanguage = "gl"
+	AnalysisSchemeLanguageHe     AnalysisSchemeLanguage = "he"
+	AnalysisSchemeLanguageHi     AnalysisSchemeLanguage = "hi"
+	AnalysisSchemeLanguageHu     AnalysisSchemeLanguage = "hu"
+	AnalysisSchemeLanguageHy     AnalysisSchemeLanguage = "hy"
+	AnalysisSchemeLanguageId     AnalysisSchemeLanguage = "id"
+	AnalysisSchemeLanguageIt     AnalysisSchemeLanguage = "it"
+	AnalysisSchemeLanguageJa     AnalysisSchemeLanguage = "ja"
+	AnalysisSchemeLanguageKo     AnalysisSchemeLanguage = "ko"
+	AnalysisSchemeLanguageLv     AnalysisSchemeLanguage = "lv"
+	AnalysisSchemeLanguageMul    AnalysisSchemeLanguage = "mul"
+	AnalysisSchemeLanguageNl     AnalysisSchemeLanguage = "nl"
+	AnalysisSchemeLanguageNo     AnalysisSchemeLanguage = "no"
+	AnalysisSchemeLanguagePt     AnalysisSchemeLanguage = "pt"
+	AnalysisSchemeLanguageRo     AnalysisSchemeLanguage = "ro"
+	AnalysisSchemeLanguageRu     AnalysisSchemeLanguage = "ru"
+	AnalysisSchemeLanguageSv     AnalysisSchemeLanguage = "sv"
+	AnalysisSchemeLanguageTh     AnalysisSchemeLanguage = "th"
+	AnalysisSchemeLanguageTr     AnalysisSchemeLanguage = "tr"
+	AnalysisSchemeLanguageZhHans AnalysisSchemeLanguage = "zh-Hans"
+	AnalysisSchemeLanguageZhHant AnalysisSchemeLanguage = "zh-Hant"
+)
+
+// AnalysisSchemeLanguageValues returns the values of AnalysisSchemeLanguage known to this
+// version of the SDK. The service may accept values added since.
+func AnalysisSchemeLanguageValues() []AnalysisSchemeLanguage {
+	return []AnalysisSchemeLanguage{
+		AnalysisSchemeLanguage("ar"),
+		AnalysisSchemeLanguage("bg"),
+		AnalysisSchemeLanguage("ca"),
+		AnalysisSchemeLanguage("cs"),
+		AnalysisSchemeLanguage("da"),
+		AnalysisSchemeLanguage("de"),
+		AnalysisSchemeLanguage("el"),
+		AnalysisSchemeLanguage("en"),
+		AnalysisSchemeLanguage("es"),
+		AnalysisSchemeLanguage("eu"),
+		AnalysisSchemeLanguage("fa"),
+		AnalysisSchemeLanguage("fi"),
+		AnalysisSchemeLanguage("fr"),
+		AnalysisSchemeLanguage("ga"),
+		AnalysisSchemeLanguage("gl"),
+		AnalysisSchemeLanguage("he"),
+		AnalysisSchemeLanguage("hi"),
+		AnalysisSchemeLanguage("hu"),
+		AnalysisSchemeLanguage("hy"),
+		AnalysisSchemeLanguage("id"),
+		AnalysisSchemeLanguage("it"),
+		AnalysisSchemeLanguage("ja"),
+		AnalysisSchemeLanguage("ko"),
+		AnalysisSchemeLanguage("lv"),
+		AnalysisSchemeLanguage("mul"),
+		AnalysisSchemeLanguage("nl"),
+		AnalysisSchemeLanguage("no"),
+		AnalysisSchemeLanguage("pt"),
+		AnalysisSchemeLanguage("ro"),
+		AnalysisSchemeLanguage("ru"),
+		AnalysisSchemeLanguage("sv"),
+		AnalysisSchemeLanguage("th"),
+		AnalysisSchemeLanguage("tr"),
+		AnalysisSchemeLanguage("zh-Hans"),
+		AnalysisSchemeLanguage("zh-Hant"),
+	}
+}
+
+// String returns the AnalysisSchemeLanguage as the string of a member.
+func (v AnalysisSchemeLanguage) String() string {
+	return string(v)
+}
+
+// ParseAnalysisSchemeLanguage returns the AnalysisSchemeLanguage of str, failing if it is not
+// one of the AnalysisSchemeLanguageValues.
+func ParseAnalysisSchemeLanguage(str string) (AnalysisSchemeLanguage, error) {
+	for _, v := range AnalysisSchemeLanguageValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid AnalysisSchemeLanguage value %q", str)
+}
+
 // The status and configuration of an AnalysisScheme.
 type AnalysisSchemeStatus struct {
 	// Configuration information for an analysis scheme. Each analysis scheme has
@@ -829,6 +984,11 @@ type metadataAnalysisSchemeStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AnalysisSchemeStatus.
+func (s AnalysisSchemeStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The status and configuration of the domain's availability options.
 type AvailabilityOptionsStatus struct {
 	// The availability options configured for the domain.
@@ -844,6 +1004,11 @@ type metadataAvailabilityOptionsStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AvailabilityOptionsStatus.
+func (s AvailabilityOptionsStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the BuildSuggester operation. Specifies the
 // name of the domain you want to update.
 type BuildSuggestersInput struct {
@@ -851,7 +1016,7 @@ type BuildSuggestersInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	metadataBuildSuggestersInput `json:"-", xml:"-"`
 }
@@ -860,6 +1025,11 @@ type metadataBuildSuggestersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the BuildSuggestersInput.
+func (s BuildSuggestersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The result of a BuildSuggester request. Contains a list of the fields used
 // for suggestions.
 type BuildSuggestersOutput struct {
@@ -873,13 +1043,18 @@ type metadataBuildSuggestersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the BuildSuggestersOutput.
+func (s BuildSuggestersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the CreateDomain operation. Specifies a name
 // for the new search domain.
 type CreateDomainInput struct {
 	// A name for the domain you are creating. Allowed characters are a-z (lower-case
 	// letters), 0-9, and hyphen (-). Domain names must start with a letter or number
 	// and be at least 3 and no more than 28 characters long.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	metadataCreateDomainInput `json:"-", xml:"-"`
 }
@@ -888,6 +1063,11 @@ type metadataCreateDomainInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateDomainInput.
+func (s CreateDomainInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The result of a CreateDomainRequest. Contains the status of a newly created
 // domain.
 type CreateDomainOutput struct {
@@ -901,11 +1081,16 @@ type metadataCreateDomainOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateDomainOutput.
+func (s CreateDomainOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Options for a field that contains an array of dates. Present if IndexFieldType
 // specifies the field is of type date-array. All options are enabled by default.
 type DateArrayOptions struct {
 	// A value to use for the field if the field isn't specified for a document.
-	DefaultValue *string `type:"string"`
+	DefaultValue *string `type:"string" max:"1024"`
 
 	// Whether facet information can be returned for the field.
 	FacetEnabled *bool `type:"boolean"`
@@ -917,7 +1102,7 @@ type DateArrayOptions struct {
 	SearchEnabled *bool `type:"boolean"`
 
 	// A list of source fields to map to the field.
-	SourceFields *string `type:"string"`
+	SourceFields *string `type:"string" pattern:"\\s*[a-z*][a-z0-9_]*\\*?\\s*(,\\s*[a-z*][a-z0-9_]*\\*?\\s*)*"`
 
 	metadataDateArrayOptions `json:"-", xml:"-"`
 }
@@ -926,13 +1111,18 @@ type metadataDateArrayOptions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DateArrayOptions.
+func (s DateArrayOptions) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Options for a date field. Dates and times are specified in UTC (Coordinated
 // Universal Time) according to IETF RFC3339: yyyy-mm-ddT00:00:00Z. Present
 // if IndexFieldType specifies the field is of type date. All options are enabled
 // by default.
 type DateOptions struct {
 	// A value to use for the field if the field isn't specified for a document.
-	DefaultValue *string `type:"string"`
+	DefaultValue *string `type:"string" max:"1024"`
 
 	// Whether facet information can be returned for the field.
 	FacetEnabled *bool `type:"boolean"`
@@ -960,7 +1150,7 @@ type DateOptions struct {
 	//
 	// The name score is reserved and cannot be used as a field name. To reference
 	// a document's ID, you can use the name _id.
-	SourceField *string `type:"string"`
+	SourceField *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*"`
 
 	metadataDateOptions `json:"-", xml:"-"`
 }
@@ -969,6 +1159,11 @@ type metadataDateOptions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DateOptions.
+func (s DateOptions) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the DefineAnalysisScheme operation. Specifies
 // the name of the domain you want to update and the analysis scheme configuration.
 type DefineAnalysisSchemeInput struct {
@@ -982,7 +1177,7 @@ type DefineAnalysisSchemeInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	metadataDefineAnalysisSchemeInput `json:"-", xml:"-"`
 }
@@ -991,6 +1186,11 @@ type metadataDefineAnalysisSchemeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DefineAnalysisSchemeInput.
+func (s DefineAnalysisSchemeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The result of a DefineAnalysisScheme request. Contains the status of the
 // newly-configured analysis scheme.
 type DefineAnalysisSchemeOutput struct {
@@ -1004,6 +1204,11 @@ type metadataDefineAnalysisSchemeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DefineAnalysisSchemeOutput.
+func (s DefineAnalysisSchemeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the DefineExpression operation. Specifies
 // the name of the domain you want to update and the expression you want to
 // configure.
@@ -1012,7 +1217,7 @@ type DefineExpressionInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	// A named expression that can be evaluated at search time. Can be used to sort
 	// the search results, define other expressions, or return computed information
@@ -1026,6 +1231,11 @@ type metadataDefineExpressionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DefineExpressionInput.
+func (s DefineExpressionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The result of a DefineExpression request. Contains the status of the newly-configured
 // expression.
 type DefineExpressionOutput struct {
@@ -1039,6 +1249,11 @@ type metadataDefineExpressionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DefineExpressionOutput.
+func (s DefineExpressionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the DefineIndexField operation. Specifies
 // the name of the domain you want to update and the index field configuration.
 type DefineIndexFieldInput struct {
@@ -1046,7 +1261,7 @@ type DefineIndexFieldInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	// The index field and field options you want to configure.
 	IndexField *IndexField `type:"structure" required:"true"`
@@ -1058,6 +1273,11 @@ type metadataDefineIndexFieldInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DefineIndexFieldInput.
+func (s DefineIndexFieldInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The result of a DefineIndexField request. Contains the status of the newly-configured
 // index field.
 type DefineIndexFieldOutput struct {
@@ -1071,6 +1291,11 @@ type metadataDefineIndexFieldOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DefineIndexFieldOutput.
+func (s DefineIndexFieldOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the DefineSuggester operation. Specifies
 // the name of the domain you want to update and the suggester configuration.
 type DefineSuggesterInput struct {
@@ -1078,7 +1303,7 @@ type DefineSuggesterInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	// Configuration information for a search suggester. Each suggester has a unique
 	// name and specifies the text field you want to use for suggestions. The following
@@ -1092,6 +1317,11 @@ type metadataDefineSuggesterInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DefineSuggesterInput.
+func (s DefineSuggesterInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The result of a DefineSuggester request. Contains the status of the newly-configured
 // suggester.
 type DefineSuggesterOutput struct {
@@ -1105,18 +1335,23 @@ type metadataDefineSuggesterOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DefineSuggesterOutput.
+func (s DefineSuggesterOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the DeleteAnalysisScheme operation. Specifies
 // the name of the domain you want to update and the analysis scheme you want
 // to delete.
 type DeleteAnalysisSchemeInput struct {
 	// The name of the analysis scheme you want to delete.
-	AnalysisSchemeName *string `type:"string" required:"true"`
+	AnalysisSchemeName *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*" required:"true"`
 
 	// A string that represents the name of a domain. Domain names are unique across
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	metadataDeleteAnalysisSchemeInput `json:"-", xml:"-"`
 }
@@ -1125,6 +1360,11 @@ type metadataDeleteAnalysisSchemeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteAnalysisSchemeInput.
+func (s DeleteAnalysisSchemeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The result of a DeleteAnalysisScheme request. Contains the status of the
 // deleted analysis scheme.
 type DeleteAnalysisSchemeOutput struct {
@@ -1138,11 +1378,16 @@ type metadataDeleteAnalysisSchemeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteAnalysisSchemeOutput.
+func (s DeleteAnalysisSchemeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the DeleteDomain operation. Specifies the
 // name of the domain you want to delete.
 type DeleteDomainInput struct {
 	// The name of the domain you want to permanently delete.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	metadataDeleteDomainInput `json:"-", xml:"-"`
 }
@@ -1151,6 +1396,11 @@ type metadataDeleteDomainInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteDomainInput.
+func (s DeleteDomainInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The result of a DeleteDomain request. Contains the status of a newly deleted
 // domain, or no status if the domain has already been completely deleted.
 type DeleteDomainOutput struct {
@@ -1164,6 +1414,11 @@ type metadataDeleteDomainOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteDomainOutput.
+func (s DeleteDomainOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the DeleteExpression operation. Specifies
 // the name of the domain you want to update and the name of the expression
 // you want to delete.
@@ -1172,10 +1427,10 @@ type DeleteExpressionInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	// The name of the Expression to delete.
-	ExpressionName *string `type:"string" required:"true"`
+	ExpressionName *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*" required:"true"`
 
 	metadataDeleteExpressionInput `json:"-", xml:"-"`
 }
@@ -1184,6 +1439,11 @@ type metadataDeleteExpressionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteExpressionInput.
+func (s DeleteExpressionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The result of a DeleteExpression request. Specifies the expression being
 // deleted.
 type DeleteExpressionOutput struct {
@@ -1197,6 +1457,11 @@ type metadataDeleteExpressionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteExpressionOutput.
+func (s DeleteExpressionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the DeleteIndexField operation. Specifies
 // the name of the domain you want to update and the name of the index field
 // you want to delete.
@@ -1205,11 +1470,11 @@ type DeleteIndexFieldInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	// The name of the index field your want to remove from the domain's indexing
 	// options.
-	IndexFieldName *string `type:"string" required:"true"`
+	IndexFieldName *string `type:"string" min:"1" max:"64" pattern:"([a-z][a-z0-9_]*\\*?|\\*[a-z0-9_]*)" required:"true"`
 
 	metadataDeleteIndexFieldInput `json:"-", xml:"-"`
 }
@@ -1218,6 +1483,11 @@ type metadataDeleteIndexFieldInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteIndexFieldInput.
+func (s DeleteIndexFieldInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The result of a DeleteIndexField request.
 type DeleteIndexFieldOutput struct {
 	// The status of the index field being deleted.
@@ -1230,6 +1500,11 @@ type metadataDeleteIndexFieldOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteIndexFieldOutput.
+func (s DeleteIndexFieldOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the DeleteSuggester operation. Specifies
 // the name of the domain you want to update and name of the suggester you want
 // to delete.
@@ -1238,10 +1513,10 @@ type DeleteSuggesterInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	// Specifies the name of the suggester you want to delete.
-	SuggesterName *string `type:"string" required:"true"`
+	SuggesterName *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*" required:"true"`
 
 	metadataDeleteSuggesterInput `json:"-", xml:"-"`
 }
@@ -1250,6 +1525,11 @@ type metadataDeleteSuggesterInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteSuggesterInput.
+func (s DeleteSuggesterInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The result of a DeleteSuggester request. Contains the status of the deleted
 // suggester.
 type DeleteSuggesterOutput struct {
@@ -1263,6 +1543,11 @@ type metadataDeleteSuggesterOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteSuggesterOutput.
+func (s DeleteSuggesterOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the DescribeAnalysisSchemes operation. Specifies
 // the name of the domain you want to describe. To limit the response to particular
 // analysis schemes, specify the names of the analysis schemes you want to describe.
@@ -1277,7 +1562,7 @@ type DescribeAnalysisSchemesInput struct {
 	Deployed *bool `type:"boolean"`
 
 	// The name of the domain you want to describe.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	metadataDescribeAnalysisSchemesInput `json:"-", xml:"-"`
 }
@@ -1286,6 +1571,11 @@ type metadataDescribeAnalysisSchemesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeAnalysisSchemesInput.
+func (s DescribeAnalysisSchemesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The result of a DescribeAnalysisSchemes request. Contains the analysis schemes
 // configured for the domain specified in the request.
 type DescribeAnalysisSchemesOutput struct {
@@ -1299,6 +1589,11 @@ type metadataDescribeAnalysisSchemesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeAnalysisSchemesOutput.
+func (s DescribeAnalysisSchemesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the DescribeAvailabilityOptions operation.
 // Specifies the name of the domain you want to describe. To show the active
 // configuration and exclude any pending changes, set the Deployed option to
@@ -1309,7 +1604,7 @@ type DescribeAvailabilityOptionsInput struct {
 	Deployed *bool `type:"boolean"`
 
 	// The name of the domain you want to describe.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	metadataDescribeAvailabilityOptionsInput `json:"-", xml:"-"`
 }
@@ -1318,6 +1613,11 @@ type metadataDescribeAvailabilityOptionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeAvailabilityOptionsInput.
+func (s DescribeAvailabilityOptionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The result of a DescribeAvailabilityOptions request. Indicates whether or
 // not the Multi-AZ option is enabled for the domain specified in the request.
 type DescribeAvailabilityOptionsOutput struct {
@@ -1332,6 +1632,11 @@ type metadataDescribeAvailabilityOptionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeAvailabilityOptionsOutput.
+func (s DescribeAvailabilityOptionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the DescribeDomains operation. By default
 // shows the status of all domains. To restrict the response to particular domains,
 // specify the names of the domains you want to describe.
@@ -1346,6 +1651,11 @@ type metadataDescribeDomainsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeDomainsInput.
+func (s DescribeDomainsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The result of a DescribeDomains request. Contains the status of the domains
 // specified in the request or all domains owned by the account.
 type DescribeDomainsOutput struct {
@@ -1359,6 +1669,11 @@ type metadataDescribeDomainsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeDomainsOutput.
+func (s DescribeDomainsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the DescribeDomains operation. Specifies
 // the name of the domain you want to describe. To restrict the response to
 // particular expressions, specify the names of the expressions you want to
@@ -1370,7 +1685,7 @@ type DescribeExpressionsInput struct {
 	Deployed *bool `type:"boolean"`
 
 	// The name of the domain you want to describe.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	// Limits the DescribeExpressions response to the specified expressions. If
 	// not specified, all expressions are shown.
@@ -1383,6 +1698,11 @@ type metadataDescribeExpressionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeExpressionsInput.
+func (s DescribeExpressionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The result of a DescribeExpressions request. Contains the expressions configured
 // for the domain specified in the request.
 type DescribeExpressionsOutput struct {
@@ -1396,6 +1716,11 @@ type metadataDescribeExpressionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeExpressionsOutput.
+func (s DescribeExpressionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the DescribeIndexFields operation. Specifies
 // the name of the domain you want to describe. To restrict the response to
 // particular index fields, specify the names of the index fields you want to
@@ -1407,7 +1732,7 @@ type DescribeIndexFieldsInput struct {
 	Deployed *bool `type:"boolean"`
 
 	// The name of the domain you want to describe.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	// A list of the index fields you want to describe. If not specified, information
 	// is returned for all configured index fields.
@@ -1420,6 +1745,11 @@ type metadataDescribeIndexFieldsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeIndexFieldsInput.
+func (s DescribeIndexFieldsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The result of a DescribeIndexFields request. Contains the index fields configured
 // for the domain specified in the request.
 type DescribeIndexFieldsOutput struct {
@@ -1433,6 +1763,11 @@ type metadataDescribeIndexFieldsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeIndexFieldsOutput.
+func (s DescribeIndexFieldsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the DescribeScalingParameters operation.
 // Specifies the name of the domain you want to describe.
 type DescribeScalingParametersInput struct {
@@ -1440,7 +1775,7 @@ type DescribeScalingParametersInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	metadataDescribeScalingParametersInput `json:"-", xml:"-"`
 }
@@ -1449,6 +1784,11 @@ type metadataDescribeScalingParametersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeScalingParametersInput.
+func (s DescribeScalingParametersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The result of a DescribeScalingParameters request. Contains the scaling parameters
 // configured for the domain specified in the request.
 type DescribeScalingParametersOutput struct {
@@ -1462,6 +1802,11 @@ type metadataDescribeScalingParametersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeScalingParametersOutput.
+func (s DescribeScalingParametersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the DescribeServiceAccessPolicies operation.
 // Specifies the name of the domain you want to describe. To show the active
 // configuration and exclude any pending changes, set the Deployed option to
@@ -1472,7 +1817,7 @@ type DescribeServiceAccessPoliciesInput struct {
 	Deployed *bool `type:"boolean"`
 
 	// The name of the domain you want to describe.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	metadataDescribeServiceAccessPoliciesInput `json:"-", xml:"-"`
 }
@@ -1481,6 +1826,11 @@ type metadataDescribeServiceAccessPoliciesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeServiceAccessPoliciesInput.
+func (s DescribeServiceAccessPoliciesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The result of a DescribeServiceAccessPolicies request.
 type DescribeServiceAccessPoliciesOutput struct {
 	// The access rules configured for the domain specified in the request.
@@ -1493,6 +1843,11 @@ type metadataDescribeServiceAccessPoliciesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeServiceAccessPoliciesOutput.
+func (s DescribeServiceAccessPoliciesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the DescribeSuggester operation. Specifies
 // the name of the domain you want to describe. To restrict the response to
 // particular suggesters, specify the names of the suggesters you want to describe.
@@ -1504,7 +1859,7 @@ type DescribeSuggestersInput struct {
 	Deployed *bool `type:"boolean"`
 
 	// The name of the domain you want to describe.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	// The suggesters you want to describe.
 	SuggesterNames []*string `type:"list"`
@@ -1516,6 +1871,11 @@ type metadataDescribeSuggestersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeSuggestersInput.
+func (s DescribeSuggestersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The result of a DescribeSuggesters request.
 type DescribeSuggestersOutput struct {
 	// The suggesters configured for the domain specified in the request.
@@ -1528,6 +1888,11 @@ type metadataDescribeSuggestersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeSuggestersOutput.
+func (s DescribeSuggestersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Options for a search suggester.
 type DocumentSuggesterOptions struct {
 	// The level of fuzziness allowed when suggesting matches for a string: none,
@@ -1535,7 +1900,7 @@ type DocumentSuggesterOptions struct {
 	// With low, suggestions must differ from the specified string by no more than
 	// one character. With high, suggestions can differ by up to two characters.
 	// The default is none.
-	FuzzyMatching *string `type:"string"`
+	FuzzyMatching *string `type:"string" enum:"none,low,high"`
 
 	// An expression that computes a score for each suggestion to control how they
 	// are sorted. The scores are rounded to the nearest integer, with a floor of
@@ -1547,7 +1912,7 @@ type DocumentSuggesterOptions struct {
 	SortExpression *string `type:"string"`
 
 	// The name of the index field you want to use for suggestions.
-	SourceField *string `type:"string" required:"true"`
+	SourceField *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*" required:"true"`
 
 	metadataDocumentSuggesterOptions `json:"-", xml:"-"`
 }
@@ -1556,6 +1921,11 @@ type metadataDocumentSuggesterOptions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DocumentSuggesterOptions.
+func (s DocumentSuggesterOptions) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The current status of the search domain.
 type DomainStatus struct {
 	// The Amazon Resource Name (ARN) of the search domain. See Identifiers for
@@ -1579,13 +1949,13 @@ type DomainStatus struct {
 	DocService *ServiceEndpoint `type:"structure"`
 
 	// An internally generated unique identifier for a domain.
-	DomainID *string `locationName:"DomainId" type:"string" required:"true"`
+	DomainID *string `locationName:"DomainId" type:"string" min:"1" max:"64" required:"true"`
 
 	// A string that represents the name of a domain. Domain names are unique across
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	Limits *Limits `type:"structure"`
 
@@ -1597,13 +1967,13 @@ type DomainStatus struct {
 	RequiresIndexDocuments *bool `type:"boolean" required:"true"`
 
 	// The number of search instances that are available to process search requests.
-	SearchInstanceCount *int64 `type:"integer"`
+	SearchInstanceCount *int64 `type:"integer" min:"1"`
 
 	// The instance type that is being used to process search requests.
 	SearchInstanceType *string `type:"string"`
 
 	// The number of partitions across which the search index is spread.
-	SearchPartitionCount *int64 `type:"integer"`
+	SearchPartitionCount *int64 `type:"integer" min:"1"`
 
 	// The service endpoint for requesting search results from a search domain.
 	SearchService *ServiceEndpoint `type:"structure"`
@@ -1615,6 +1985,11 @@ type metadataDomainStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DomainStatus.
+func (s DomainStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Options for a field that contains an array of double-precision 64-bit floating
 // point values. Present if IndexFieldType specifies the field is of type double-array.
 // All options are enabled by default.
@@ -1632,7 +2007,7 @@ type DoubleArrayOptions struct {
 	SearchEnabled *bool `type:"boolean"`
 
 	// A list of source fields to map to the field.
-	SourceFields *string `type:"string"`
+	SourceFields *string `type:"string" pattern:"\\s*[a-z*][a-z0-9_]*\\*?\\s*(,\\s*[a-z*][a-z0-9_]*\\*?\\s*)*"`
 
 	metadataDoubleArrayOptions `json:"-", xml:"-"`
 }
@@ -1641,6 +2016,11 @@ type metadataDoubleArrayOptions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DoubleArrayOptions.
+func (s DoubleArrayOptions) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Options for a double-precision 64-bit floating point field. Present if IndexFieldType
 // specifies the field is of type double. All options are enabled by default.
 type DoubleOptions struct {
@@ -1662,7 +2042,7 @@ type DoubleOptions struct {
 	SortEnabled *bool `type:"boolean"`
 
 	// The name of the source field to map to the field.
-	SourceField *string `type:"string"`
+	SourceField *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*"`
 
 	metadataDoubleOptions `json:"-", xml:"-"`
 }
@@ -1671,19 +2051,24 @@ type metadataDoubleOptions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DoubleOptions.
+func (s DoubleOptions) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A named expression that can be evaluated at search time. Can be used to sort
 // the search results, define other expressions, or return computed information
 // in the search results.
 type Expression struct {
 	// Names must begin with a letter and can contain the following characters:
 	// a-z (lowercase), 0-9, and _ (underscore).
-	ExpressionName *string `type:"string" required:"true"`
+	ExpressionName *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*" required:"true"`
 
 	// The expression to evaluate for sorting while processing a search request.
 	// The Expression syntax is based on JavaScript expressions. For more information,
 	// see Configuring Expressions (http://docs.aws.amazon.com/cloudsearch/latest/developerguide/configuring-expressions.html"
 	// target="_blank) in the Amazon CloudSearch Developer Guide.
-	ExpressionValue *string `type:"string" required:"true"`
+	ExpressionValue *string `type:"string" min:"1" max:"10240" required:"true"`
 
 	metadataExpression `json:"-", xml:"-"`
 }
@@ -1692,6 +2077,11 @@ type metadataExpression struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Expression.
+func (s Expression) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The value of an Expression and its current status.
 type ExpressionStatus struct {
 	// The expression that is evaluated for sorting while processing a search request.
@@ -1707,6 +2097,11 @@ type metadataExpressionStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ExpressionStatus.
+func (s ExpressionStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the IndexDocuments operation. Specifies the
 // name of the domain you want to re-index.
 type IndexDocumentsInput struct {
@@ -1714,7 +2109,7 @@ type IndexDocumentsInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	metadataIndexDocumentsInput `json:"-", xml:"-"`
 }
@@ -1723,6 +2118,11 @@ type metadataIndexDocumentsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the IndexDocumentsInput.
+func (s IndexDocumentsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The result of an IndexDocuments request. Contains the status of the indexing
 // operation, including the fields being indexed.
 type IndexDocumentsOutput struct {
@@ -1736,6 +2136,11 @@ type metadataIndexDocumentsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the IndexDocumentsOutput.
+func (s IndexDocumentsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Configuration information for a field in the index, including its name, type,
 // and options. The supported options depend on the IndexFieldType.
 type IndexField struct {
@@ -1772,13 +2177,13 @@ type IndexField struct {
 	//
 	// The name score is reserved and cannot be used as a field name. To reference
 	// a document's ID, you can use the name _id.
-	IndexFieldName *string `type:"string" required:"true"`
+	IndexFieldName *string `type:"string" min:"1" max:"64" pattern:"([a-z][a-z0-9_]*\\*?|\\*[a-z0-9_]*)" required:"true"`
 
 	// The type of field. The valid options for a field depend on the field type.
 	// For more information about the supported field types, see Configuring Index
 	// Fields (http://docs.aws.amazon.com/cloudsearch/latest/developerguide/configuring-index-fields.html"
 	// target="_blank) in the Amazon CloudSearch Developer Guide.
-	IndexFieldType *string `type:"string" required:"true"`
+	IndexFieldType *string `type:"string" enum:"int,double,literal,text,date,latlon,int-array,double-array,literal-array,text-array,date-array" required:"true"`
 
 	// Options for a field that contains an array of 64-bit signed integers. Present
 	// if IndexFieldType specifies the field is of type int-array. All options are
@@ -1820,6 +2225,11 @@ type metadataIndexField struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the IndexField.
+func (s IndexField) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The value of an IndexField and its current status.
 type IndexFieldStatus struct {
 	// Configuration information for a field in the index, including its name, type,
@@ -1836,6 +2246,63 @@ type metadataIndexFieldStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the IndexFieldStatus.
+func (s IndexFieldStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
+// IndexFieldType is an enum of the values of IndexFieldType members.
+type IndexFieldType string
+
+// The values of IndexFieldType.
+const (
+	IndexFieldTypeInt          IndexFieldType = "int"
+	IndexFieldTypeDouble       IndexFieldType = "double"
+	IndexFieldTypeLiteral      IndexFieldType = "literal"
+	IndexFieldTypeText         IndexFieldType = "text"
+	IndexFieldTypeDate         IndexFieldType = "date"
+	IndexFieldTypeLatlon       IndexFieldType = "latlon"
+	IndexFieldTypeIntArray     IndexFieldType = "int-array"
+	IndexFieldTypeDoubleArray  IndexFieldType = "double-array"
+	IndexFieldTypeLiteralArray IndexFieldType = "literal-array"
+	IndexFieldTypeTextArray    IndexFieldType = "text-array"
+	IndexFieldTypeDateArray    IndexFieldType = "date-array"
+)
+
+// IndexFieldTypeValues returns the values of IndexFieldType known to this
+// version of the SDK. The service may accept values added since.
+func IndexFieldTypeValues() []IndexFieldType {
+	return []IndexFieldType{
+		IndexFieldType("int"),
+		IndexFieldType("double"),
+		IndexFieldType("literal"),
+		IndexFieldType("text"),
+		IndexFieldType("date"),
+		IndexFieldType("latlon"),
+		IndexFieldType("int-array"),
+		IndexFieldType("double-array"),
+		IndexFieldType("literal-array"),
+		IndexFieldType("text-array"),
+		IndexFieldType("date-array"),
+	}
+}
+
+// String returns the IndexFieldType as the string of a member.
+func (v IndexFieldType) String() string {
+	return string(v)
+}
+
+// ParseIndexFieldType returns the IndexFieldType of str, failing if it is not
+// one of the IndexFieldTypeValues.
+func ParseIndexFieldType(str string) (IndexFieldType, error) {
+	for _, v := range IndexFieldTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid IndexFieldType value %q", str)
+}
+
 // Options for a field that contains an array of 64-bit signed integers. Present
 // if IndexFieldType specifies the field is of type int-array. All options are
 // enabled by default.
@@ -1853,7 +2320,7 @@ type IntArrayOptions struct {
 	SearchEnabled *bool `type:"boolean"`
 
 	// A list of source fields to map to the field.
-	SourceFields *string `type:"string"`
+	SourceFields *string `type:"string" pattern:"\\s*[a-z*][a-z0-9_]*\\*?\\s*(,\\s*[a-z*][a-z0-9_]*\\*?\\s*)*"`
 
 	metadataIntArrayOptions `json:"-", xml:"-"`
 }
@@ -1862,6 +2329,11 @@ type metadataIntArrayOptions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the IntArrayOptions.
+func (s IntArrayOptions) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Options for a 64-bit signed integer field. Present if IndexFieldType specifies
 // the field is of type int. All options are enabled by default.
 type IntOptions struct {
@@ -1883,7 +2355,7 @@ type IntOptions struct {
 	SortEnabled *bool `type:"boolean"`
 
 	// The name of the source field to map to the field.
-	SourceField *string `type:"string"`
+	SourceField *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*"`
 
 	metadataIntOptions `json:"-", xml:"-"`
 }
@@ -1892,12 +2364,17 @@ type metadataIntOptions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the IntOptions.
+func (s IntOptions) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Options for a latlon field. A latlon field contains a location stored as
 // a latitude and longitude value pair. Present if IndexFieldType specifies
 // the field is of type latlon. All options are enabled by default.
 type LatLonOptions struct {
 	// A value to use for the field if the field isn't specified for a document.
-	DefaultValue *string `type:"string"`
+	DefaultValue *string `type:"string" max:"1024"`
 
 	// Whether facet information can be returned for the field.
 	FacetEnabled *bool `type:"boolean"`
@@ -1925,7 +2402,7 @@ type LatLonOptions struct {
 	//
 	// The name score is reserved and cannot be used as a field name. To reference
 	// a document's ID, you can use the name _id.
-	SourceField *string `type:"string"`
+	SourceField *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*"`
 
 	metadataLatLonOptions `json:"-", xml:"-"`
 }
@@ -1934,10 +2411,15 @@ type metadataLatLonOptions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the LatLonOptions.
+func (s LatLonOptions) String() string {
+	return awsutil.StringValue(s)
+}
+
 type Limits struct {
-	MaximumPartitionCount *int64 `type:"integer" required:"true"`
+	MaximumPartitionCount *int64 `type:"integer" min:"1" required:"true"`
 
-	MaximumReplicationCount *int64 `type:"integer" required:"true"`
+	MaximumReplicationCount *int64 `type:"integer" min:"1" required:"true"`
 
 	metadataLimits `json:"-", xml:"-"`
 }
@@ -1946,6 +2428,11 @@ type metadataLimits struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Limits.
+func (s Limits) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListDomainNamesInput struct {
 	metadataListDomainNamesInput `json:"-", xml:"-"`
 }
@@ -1954,6 +2441,11 @@ type metadataListDomainNamesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListDomainNamesInput.
+func (s ListDomainNamesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The result of a ListDomainNames request. Contains a list of the domains owned
 // by an account.
 type ListDomainNamesOutput struct {
@@ -1967,12 +2459,17 @@ type metadataListDomainNamesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListDomainNamesOutput.
+func (s ListDomainNamesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Options for a field that contains an array of literal strings. Present if
 // IndexFieldType specifies the field is of type literal-array. All options
 // are enabled by default.
 type LiteralArrayOptions struct {
 	// A value to use for the field if the field isn't specified for a document.
-	DefaultValue *string `type:"string"`
+	DefaultValue *string `type:"string" max:"1024"`
 
 	// Whether facet information can be returned for the field.
 	FacetEnabled *bool `type:"boolean"`
@@ -1984,7 +2481,7 @@ type LiteralArrayOptions struct {
 	SearchEnabled *bool `type:"boolean"`
 
 	// A list of source fields to map to the field.
-	SourceFields *string `type:"string"`
+	SourceFields *string `type:"string" pattern:"\\s*[a-z*][a-z0-9_]*\\*?\\s*(,\\s*[a-z*][a-z0-9_]*\\*?\\s*)*"`
 
 	metadataLiteralArrayOptions `json:"-", xml:"-"`
 }
@@ -1993,11 +2490,16 @@ type metadataLiteralArrayOptions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the LiteralArrayOptions.
+func (s LiteralArrayOptions) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Options for literal field. Present if IndexFieldType specifies the field
 // is of type literal. All options are enabled by default.
 type LiteralOptions struct {
 	// A value to use for the field if the field isn't specified for a document.
-	DefaultValue *string `type:"string"`
+	DefaultValue *string `type:"string" max:"1024"`
 
 	// Whether facet information can be returned for the field.
 	FacetEnabled *bool `type:"boolean"`
@@ -2025,7 +2527,7 @@ type LiteralOptions struct {
 	//
 	// The name score is reserved and cannot be used as a field name. To reference
 	// a document's ID, you can use the name _id.
-	SourceField *string `type:"string"`
+	SourceField *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*"`
 
 	metadataLiteralOptions `json:"-", xml:"-"`
 }
@@ -2034,6 +2536,49 @@ type metadataLiteralOptions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the LiteralOptions.
+func (s LiteralOptions) String() string {
+	return awsutil.StringValue(s)
+}
+
+// OptionState is an enum of the values of OptionState members.
+type OptionState string
+
+// The values of OptionState.
+const (
+	OptionStateRequiresIndexDocuments OptionState = "RequiresIndexDocuments"
+	OptionStateProcessing             OptionState = "Processing"
+	OptionStateActive                 OptionState = "Active"
+	OptionStateFailedToValidate       OptionState = "FailedToValidate"
+)
+
+// OptionStateValues returns the values of OptionState known to this
+// version of the SDK. The service may accept values added since.
+func OptionStateValues() []OptionState {
+	return []OptionState{
+		OptionState("RequiresIndexDocuments"),
+		OptionState("Processing"),
+		OptionState("Active"),
+		OptionState("FailedToValidate"),
+	}
+}
+
+// String returns the OptionState as the string of a member.
+func (v OptionState) String() string {
+	return string(v)
+}
+
+// ParseOptionState returns the OptionState of str, failing if it is not
+// one of the OptionStateValues.
+func ParseOptionState(str string) (OptionState, error) {
+	for _, v := range OptionStateValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid OptionState value %q", str)
+}
+
 // The status of domain configuration option.
 type OptionStatus struct {
 	// A timestamp for when this option was created.
@@ -2051,7 +2596,7 @@ type OptionStatus struct {
 	// option value is not compatible with the domain's data and cannot be used
 	// to index the data. You must either modify the option value or update or remove
 	// the incompatible documents.
-	State *string `type:"string" required:"true"`
+	State *string `type:"string" enum:"RequiresIndexDocuments,Processing,Active,FailedToValidate" required:"true"`
 
 	// A timestamp for when this option was last updated.
 	UpdateDate *time.Time `type:"timestamp" timestampFormat:"iso8601" required:"true"`
@@ -2066,11 +2611,62 @@ type metadataOptionStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OptionStatus.
+func (s OptionStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
+// PartitionInstanceType is an enum of the values of PartitionInstanceType members.
+type PartitionInstanceType string
+
+// The values of PartitionInstanceType.
+const (
+	PartitionInstanceTypeSearchM1Small   PartitionInstanceType = "search.m1.small"
+	PartitionInstanceTypeSearchM1Large   PartitionInstanceType = "search.m1.large"
+	PartitionInstanceTypeSearchM2Xlarge  PartitionInstanceType = "search.m2.xlarge"
+	PartitionInstanceTypeSearchM22xlarge PartitionInstanceType = "search.m2.2xlarge"
+	PartitionInstanceTypeSearchM3Medium  PartitionInstanceType = "search.m3.medium"
+	PartitionInstanceTypeSearchM3Large   PartitionInstanceType = "search.m3.large"
+	PartitionInstanceTypeSearchM3Xlarge  PartitionInstanceType = "search.m3.xlarge"
+	PartitionInstanceTypeSearchM32xlarge PartitionInstanceType = "search.m3.2xlarge"
+)
+
+// PartitionInstanceTypeValues returns the values of PartitionInstanceType known to this
+// version of the SDK. The service may accept values added since.
+func PartitionInstanceTypeValues() []PartitionInstanceType {
+	return []PartitionInstanceType{
+		PartitionInstanceType("search.m1.small"),
+		PartitionInstanceType("search.m1.large"),
+		PartitionInstanceType("search.m2.xlarge"),
+		PartitionInstanceType("search.m2.2xlarge"),
+		PartitionInstanceType("search.m3.medium"),
+		PartitionInstanceType("search.m3.large"),
+		PartitionInstanceType("search.m3.xlarge"),
+		PartitionInstanceType("search.m3.2xlarge"),
+	}
+}
+
+// String returns the PartitionInstanceType as the string of a member.
+func (v PartitionInstanceType) String() string {
+	return string(v)
+}
+
+// ParsePartitionInstanceType returns the PartitionInstanceType of str, failing if it is not
+// one of the PartitionInstanceTypeValues.
+func ParsePartitionInstanceType(str string) (PartitionInstanceType, error) {
+	for _, v := range PartitionInstanceTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid PartitionInstanceType value %q", str)
+}
+
 // The desired instance type and desired number of replicas of each index partition.
 type ScalingParameters struct {
 	// The instance type that you want to preconfigure for your domain. For example,
 	// search.m1.small.
-	DesiredInstanceType *string `type:"string"`
+	DesiredInstanceType *string `type:"string" enum:"search.m1.small,search.m1.large,search.m2.xlarge,search.m2.2xlarge,search.m3.medium,search.m3.large,search.m3.xlarge,search.m3.2xlarge"`
 
 	// The number of partitions you want to preconfigure for your domain. Only valid
 	// when you select m2.2xlarge as the desired instance type.
@@ -2086,6 +2682,11 @@ type metadataScalingParameters struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ScalingParameters.
+func (s ScalingParameters) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The status and configuration of a search domain's scaling parameters.
 type ScalingParametersStatus struct {
 	// The desired instance type and desired number of replicas of each index partition.
@@ -2101,6 +2702,11 @@ type metadataScalingParametersStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ScalingParametersStatus.
+func (s ScalingParametersStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The endpoint to which service requests can be submitted.
 type ServiceEndpoint struct {
 	// The endpoint to which service requests can be submitted. For example, search-imdb-movies-oopcnjfn6ugofer3zx5iadxxca.eu-west-1.cloudsearch.amazonaws.com
@@ -2114,6 +2720,11 @@ type metadataServiceEndpoint struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ServiceEndpoint.
+func (s ServiceEndpoint) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Configuration information for a search suggester. Each suggester has a unique
 // name and specifies the text field you want to use for suggestions. The following
 // options can be configured for a suggester: FuzzyMatching, SortExpression.
@@ -2123,7 +2734,7 @@ type Suggester struct {
 
 	// Names must begin with a letter and can contain the following characters:
 	// a-z (lowercase), 0-9, and _ (underscore).
-	SuggesterName *string `type:"string" required:"true"`
+	SuggesterName *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*" required:"true"`
 
 	metadataSuggester `json:"-", xml:"-"`
 }
@@ -2132,6 +2743,47 @@ type metadataSuggester struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Suggester.
+func (s Suggester) String() string {
+	return awsutil.StringValue(s)
+}
+
+// SuggesterFuzzyMatching is an enum of the values of SuggesterFuzzyMatching members.
+type SuggesterFuzzyMatching string
+
+// The values of SuggesterFuzzyMatching.
+const (
+	SuggesterFuzzyMatchingNone SuggesterFuzzyMatching = "none"
+	SuggesterFuzzyMatchingLow  SuggesterFuzzyMatching = "low"
+	SuggesterFuzzyMatchingHigh SuggesterFuzzyMatching = "high"
+)
+
+// SuggesterFuzzyMatchingValues returns the values of SuggesterFuzzyMatching known to this
+// version of the SDK. The service may accept values added since.
+func SuggesterFuzzyMatchingValues() []SuggesterFuzzyMatching {
+	return []SuggesterFuzzyMatching{
+		SuggesterFuzzyMatching("none"),
+		SuggesterFuzzyMatching("low"),
+		SuggesterFuzzyMatching("high"),
+	}
+}
+
+// String returns the SuggesterFuzzyMatching as the string of a member.
+func (v SuggesterFuzzyMatching) String() string {
+	return string(v)
+}
+
+// ParseSuggesterFuzzyMatching returns the SuggesterFuzzyMatching of str, failing if it is not
+// one of the SuggesterFuzzyMatchingValues.
+func ParseSuggesterFuzzyMatching(str string) (SuggesterFuzzyMatching, error) {
+	for _, v := range SuggesterFuzzyMatchingValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid SuggesterFuzzyMatching value %q", str)
+}
+
 // The value of a Suggester and its current status.
 type SuggesterStatus struct {
 	// Configuration information for a search suggester. Each suggester has a unique
@@ -2149,15 +2801,20 @@ type metadataSuggesterStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SuggesterStatus.
+func (s SuggesterStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Options for a field that contains an array of text strings. Present if IndexFieldType
 // specifies the field is of type text-array. A text-array field is always searchable.
 // All options are enabled by default.
 type TextArrayOptions struct {
 	// The name of an analysis scheme for a text-array field.
-	AnalysisScheme *string `type:"string"`
+	AnalysisScheme *string `type:"string" pattern:"[\\S]+"`
 
 	// A value to use for the field if the field isn't specified for a document.
-	DefaultValue *string `type:"string"`
+	DefaultValue *string `type:"string" max:"1024"`
 
 	// Whether highlights can be returned for the field.
 	HighlightEnabled *bool `type:"boolean"`
@@ -2166,7 +2823,7 @@ type TextArrayOptions struct {
 	ReturnEnabled *bool `type:"boolean"`
 
 	// A list of source fields to map to the field.
-	SourceFields *string `type:"string"`
+	SourceFields *string `type:"string" pattern:"\\s*[a-z*][a-z0-9_]*\\*?\\s*(,\\s*[a-z*][a-z0-9_]*\\*?\\s*)*"`
 
 	metadataTextArrayOptions `json:"-", xml:"-"`
 }
@@ -2175,15 +2832,20 @@ type metadataTextArrayOptions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TextArrayOptions.
+func (s TextArrayOptions) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Options for text field. Present if IndexFieldType specifies the field is
 // of type text. A text field is always searchable. All options are enabled
 // by default.
 type TextOptions struct {
 	// The name of an analysis scheme for a text field.
-	AnalysisScheme *string `type:"string"`
+	AnalysisScheme *string `type:"string" pattern:"[\\S]+"`
 
 	// A value to use for the field if the field isn't specified for a document.
-	DefaultValue *string `type:"string"`
+	DefaultValue *string `type:"string" max:"1024"`
 
 	// Whether highlights can be returned for the field.
 	HighlightEnabled *bool `type:"boolean"`
@@ -2208,7 +2870,7 @@ type TextOptions struct {
 	//
 	// The name score is reserved and cannot be used as a field name. To reference
 	// a document's ID, you can use the name _id.
-	SourceField *string `type:"string"`
+	SourceField *string `type:"string" min:"1" max:"64" pattern:"[a-z][a-z0-9_]*"`
 
 	metadataTextOptions `json:"-", xml:"-"`
 }
@@ -2217,6 +2879,11 @@ type metadataTextOptions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TextOptions.
+func (s TextOptions) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the UpdateAvailabilityOptions operation.
 // Specifies the name of the domain you want to update and the Multi-AZ availability
 // option.
@@ -2225,7 +2892,7 @@ type UpdateAvailabilityOptionsInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	// You expand an existing search domain to a second Availability Zone by setting
 	// the Multi-AZ option to true. Similarly, you can turn off the Multi-AZ option
@@ -2240,6 +2907,11 @@ type metadataUpdateAvailabilityOptionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateAvailabilityOptionsInput.
+func (s UpdateAvailabilityOptionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The result of a UpdateAvailabilityOptions request. Contains the status of
 // the domain's availability options.
 type UpdateAvailabilityOptionsOutput struct {
@@ -2254,6 +2926,11 @@ type metadataUpdateAvailabilityOptionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateAvailabilityOptionsOutput.
+func (s UpdateAvailabilityOptionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the UpdateScalingParameters operation. Specifies
 // the name of the domain you want to update and the scaling parameters you
 // want to configure.
@@ -2262,7 +2939,7 @@ type UpdateScalingParametersInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	// The desired instance type and desired number of replicas of each index partition.
 	ScalingParameters *ScalingParameters `type:"structure" required:"true"`
@@ -2274,6 +2951,11 @@ type metadataUpdateScalingParametersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateScalingParametersInput.
+func (s UpdateScalingParametersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The result of a UpdateScalingParameters request. Contains the status of the
 // newly-configured scaling parameters.
 type UpdateScalingParametersOutput struct {
@@ -2287,6 +2969,11 @@ type metadataUpdateScalingParametersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateScalingParametersOutput.
+func (s UpdateScalingParametersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the UpdateServiceAccessPolicies operation.
 // Specifies the name of the domain you want to update and the access rules
 // you want to configure.
@@ -2299,7 +2986,7 @@ type UpdateServiceAccessPoliciesInput struct {
 	// the domains owned by an account within an AWS region. Domain names start
 	// with a letter or number and can contain the following characters: a-z (lowercase),
 	// 0-9, and - (hyphen).
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" min:"3" max:"28" pattern:"[a-z][a-z0-9\\-]+" required:"true"`
 
 	metadataUpdateServiceAccessPoliciesInput `json:"-", xml:"-"`
 }
@@ -2308,6 +2995,11 @@ type metadataUpdateServiceAccessPoliciesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateServiceAccessPoliciesInput.
+func (s UpdateServiceAccessPoliciesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The result of an UpdateServiceAccessPolicies request. Contains the new access
 // policies.
 type UpdateServiceAccessPoliciesOutput struct {
@@ -2319,4 +3011,9 @@ type UpdateServiceAccessPoliciesOutput struct {
 
 type metadataUpdateServiceAccessPoliciesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the UpdateServiceAccessPoliciesOutput.
+func (s UpdateServiceAccessPoliciesOutput) String() string {
+	return awsutil.StringValue(s)
 }
\ No newline at end of file
@@ -4,9 +4,11 @@
 package cloudtrail
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // CreateTrailRequest generates a request for the CreateTrail operation.
@@ -281,6 +283,11 @@ type metadataCreateTrailInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateTrailInput.
+func (s CreateTrailInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Returns the objects or data listed below if successful. Otherwise, returns
 // an error.
 type CreateTrailOutput struct {
@@ -318,6 +325,11 @@ type metadataCreateTrailOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateTrailOutput.
+func (s CreateTrailOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The request that specifies the name of a trail to delete.
 type DeleteTrailInput struct {
 	// The name of a trail to be deleted.
@@ -330,6 +342,11 @@ type metadataDeleteTrailInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteTrailInput.
+func (s DeleteTrailInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Returns the objects or data listed below if successful. Otherwise, returns
 // an error.
 type DeleteTrailOutput struct {
@@ -340,6 +357,11 @@ type metadataDeleteTrailOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteTrailOutput.
+func (s DeleteTrailOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Returns information about the trail.
 type DescribeTrailsInput struct {
 	// The trail returned.
@@ -352,6 +374,11 @@ type metadataDescribeTrailsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeTrailsInput.
+func (s DescribeTrailsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Returns the objects or data listed below if successful. Otherwise, returns
 // an error.
 type DescribeTrailsOutput struct {
@@ -365,6 +392,11 @@ type metadataDescribeTrailsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeTrailsOutput.
+func (s DescribeTrailsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains information about an event that was returned by a lookup request.
 // The result includes a representation of a CloudTrail event.
 type Event struct {
@@ -394,6 +426,11 @@ type metadataEvent struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Event.
+func (s Event) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The name of a trail about which you want the current status.
 type GetTrailStatusInput struct {
 	// The name of the trail for which you are requesting the current status.
@@ -406,6 +443,11 @@ type metadataGetTrailStatusInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetTrailStatusInput.
+func (s GetTrailStatusInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Returns the objects or data listed below if successful. Otherwise, returns
 // an error.
 type GetTrailStatusOutput struct {
@@ -454,10 +496,15 @@ type metadataGetTrailStatusOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetTrailStatusOutput.
+func (s GetTrailStatusOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Specifies an attribute and value that filter the events returned.
 type LookupAttribute struct {
 	// Specifies an attribute on which to filter the events returned.
-	AttributeKey *string `type:"string" required:"true"`
+	AttributeKey *string `type:"string" enum:"EventId,EventName,Username,ResourceType,ResourceName" required:"true"`
 
 	// Specifies a value for the specified AttributeKey.
 	AttributeValue *string `type:"string" required:"true"`
@@ -469,6 +516,51 @@ type metadataLookupAttribute struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the LookupAttribute.
+func (s LookupAttribute) String() string {
+	return awsutil.StringValue(s)
+}
+
+// LookupAttributeKey is an enum of the values of LookupAttributeKey members.
+type LookupAttributeKey string
+
+// The values of LookupAttributeKey.
+const (
+	LookupAttributeKeyEventId      LookupAttributeKey = "EventId"
+	LookupAttributeKeyEventName    LookupAttributeKey = "EventName"
+	LookupAttributeKeyUsername     LookupAttributeKey = "Username"
+	LookupAttributeKeyResourceType LookupAttributeKey = "ResourceType"
+	LookupAttributeKeyResourceName LookupAttributeKey = "ResourceName"
+)
+
+// LookupAttributeKeyValues returns the values of LookupAttributeKey known to this
+// version of the SDK. The service may accept values added since.
+func LookupAttributeKeyValues() []LookupAttributeKey {
+	return []LookupAttributeKey{
+		LookupAttributeKey("EventId"),
+		LookupAttributeKey("EventName"),
+		LookupAttributeKey("Username"),
+		LookupAttributeKey("ResourceType"),
+		LookupAttributeKey("ResourceName"),
+	}
+}
+
+// String returns the LookupAttributeKey as the string of a member.
+func (v LookupAttributeKey) String() string {
+	return string(v)
+}
+
+// ParseLookupAttributeKey returns the LookupAttributeKey of str, failing if it is not
+// one of the LookupAttributeKeyValues.
+func ParseLookupAttributeKey(str string) (LookupAttributeKey, error) {
+	for _, v := range LookupAttributeKeyValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid LookupAttributeKey value %q", str)
+}
+
 // Contains a request for LookupEvents.
 type LookupEventsInput struct {
 	// Specifies that only events that occur before or at the specified time are
@@ -482,7 +574,7 @@ type LookupEventsInput struct {
 
 	// The number of events to return. Possible values are 1 through 50. The default
 	// is 10.
-	MaxResults *int64 `type:"integer"`
+	MaxResults *int64 `type:"integer" min:"1" max:"50"`
 
 	// The token to use to get the next page of results after a previous API call.
 	// This token must be passed in with the same parameters that were specified
@@ -503,6 +595,11 @@ type metadataLookupEventsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the LookupEventsInput.
+func (s LookupEventsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains a response to a LookupEvents action.
 type LookupEventsOutput struct {
 	// A list of events returned based on the lookup attributes specified and the
@@ -524,6 +621,11 @@ type metadataLookupEventsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the LookupEventsOutput.
+func (s LookupEventsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Specifies the type and name of a resource referenced by an event.
 type Resource struct {
 	// The name of the resource referenced by the event returned. These are user-created
@@ -546,6 +648,11 @@ type metadataResource struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Resource.
+func (s Resource) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The request to CloudTrail to start logging AWS API calls for an account.
 type StartLoggingInput struct {
 	// The name of the trail for which CloudTrail logs AWS API calls.
@@ -558,6 +665,11 @@ type metadataStartLoggingInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StartLoggingInput.
+func (s StartLoggingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Returns the objects or data listed below if successful. Otherwise, returns
 // an error.
 type StartLoggingOutput struct {
@@ -568,6 +680,11 @@ type metadataStartLoggingOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StartLoggingOutput.
+func (s StartLoggingOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Passes the request to CloudTrail to stop logging AWS API calls for the specified
 // account.
 type StopLoggingInput struct {
@@ -582,6 +699,11 @@ type metadataStopLoggingInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StopLoggingInput.
+func (s StopLoggingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Returns the objects or data listed below if successful. Otherwise, returns
 // an error.
 type StopLoggingOutput struct {
@@ -592,6 +714,11 @@ type metadataStopLoggingOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StopLoggingOutput.
+func (s StopLoggingOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The settings for a trail.
 type Trail struct {
 	// Specifies an Amazon Resource Name (ARN), a unique identifier that represents
@@ -626,6 +753,11 @@ type metadataTrail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Trail.
+func (s Trail) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Specifies settings to update for the trail.
 type UpdateTrailInput struct {
 	// Specifies a log group name using an Amazon Resource Name (ARN), a unique
@@ -663,6 +795,11 @@ type metadataUpdateTrailInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateTrailInput.
+func (s UpdateTrailInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Returns the objects or data listed below if successful. Otherwise, returns
 // an error.
 type UpdateTrailOutput struct {
@@ -698,4 +835,9 @@ type UpdateTrailOutput struct {
 
 type metadataUpdateTrailOutput struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the UpdateTrailOutput.
+func (s UpdateTrailOutput) String() string {
+	return awsutil.StringValue(s)
 }
\ No newline at end of file
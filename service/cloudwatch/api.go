@@ -4,9 +4,11 @@
 package cloudwatch
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // DeleteAlarmsRequest generates a request for the DeleteAlarms operation.
@@ -42,6 +44,11 @@ func (c *CloudWatch) DescribeAlarmHistoryRequest(input *DescribeAlarmHistoryInpu
 			Name:       "DescribeAlarmHistory",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextToken"},
+				OutputTokens: []string{"NextToken"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -61,6 +68,16 @@ func (c *CloudWatch) DescribeAlarmHistory(input *DescribeAlarmHistoryInput) (out
 	return
 }
 
+// DescribeAlarmHistoryPages iterates over the pages of a DescribeAlarmHistory operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *CloudWatch) DescribeAlarmHistoryPages(input *DescribeAlarmHistoryInput, fn func(p *DescribeAlarmHistoryOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeAlarmHistoryRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeAlarmHistoryOutput), lastPage)
+	})
+}
+
 var opDescribeAlarmHistory *aws.Operation
 
 // DescribeAlarmsRequest generates a request for the DescribeAlarms operation.
@@ -70,6 +87,11 @@ func (c *CloudWatch) DescribeAlarmsRequest(input *DescribeAlarmsInput) (req *aws
 			Name:       "DescribeAlarms",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextToken"},
+				OutputTokens: []string{"NextToken"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -89,6 +111,16 @@ func (c *CloudWatch) DescribeAlarms(input *DescribeAlarmsInput) (output *Describ
 	return
 }
 
+// DescribeAlarmsPages iterates over the pages of a DescribeAlarms operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *CloudWatch) DescribeAlarmsPages(input *DescribeAlarmsInput, fn func(p *DescribeAlarmsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeAlarmsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeAlarmsOutput), lastPage)
+	})
+}
+
 var opDescribeAlarms *aws.Operation
 
 // DescribeAlarmsForMetricRequest generates a request for the DescribeAlarmsForMetric operation.
@@ -229,6 +261,10 @@ func (c *CloudWatch) ListMetricsRequest(input *ListMetricsInput) (req *aws.Reque
 			Name:       "ListMetrics",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextToken"},
+				OutputTokens: []string{"NextToken"},
+			},
 		}
 	}
 
@@ -248,6 +284,16 @@ func (c *CloudWatch) ListMetrics(input *ListMetricsInput) (output *ListMetricsOu
 	return
 }
 
+// ListMetricsPages iterates over the pages of a ListMetrics operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *CloudWatch) ListMetricsPages(input *ListMetricsInput, fn func(p *ListMetricsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListMetricsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListMetricsOutput), lastPage)
+	})
+}
+
 var opListMetrics *aws.Operation
 
 // PutMetricAlarmRequest generates a request for the PutMetricAlarm operation.
@@ -286,9 +332,10 @@ var opPutMetricAlarm *aws.Operation
 func (c *CloudWatch) PutMetricDataRequest(input *PutMetricDataInput) (req *aws.Request, output *PutMetricDataOutput) {
 	if opPutMetricData == nil {
 		opPutMetricData = &aws.Operation{
-			Name:       "PutMetricData",
-			HTTPMethod: "POST",
-			HTTPPath:   "/",
+			Name:               "PutMetricData",
+			HTTPMethod:         "POST",
+			HTTPPath:           "/",
+			RequestCompression: true,
 		}
 	}
 
@@ -355,16 +402,16 @@ var opSetAlarmState *aws.Operation
 // returns this data type as part of the DescribeAlarmHistoryResult data type.
 type AlarmHistoryItem struct {
 	// The descriptive name for the alarm.
-	AlarmName *string `type:"string"`
+	AlarmName *string `type:"string" min:"1" max:"255"`
 
 	// Machine-readable data about the alarm in JSON format.
-	HistoryData *string `type:"string"`
+	HistoryData *string `type:"string" min:"1" max:"4095"`
 
 	// The type of alarm history item.
-	HistoryItemType *string `type:"string"`
+	HistoryItemType *string `type:"string" enum:"ConfigurationUpdate,StateUpdate,Action"`
 
 	// A human-readable summary of the alarm history.
-	HistorySummary *string `type:"string"`
+	HistorySummary *string `type:"string" min:"1" max:"255"`
 
 	// The time stamp for the alarm history item. Amazon CloudWatch uses Coordinated
 	// Universal Time (UTC) when returning time stamps, which do not accommodate
@@ -380,6 +427,49 @@ type metadataAlarmHistoryItem struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AlarmHistoryItem.
+func (s AlarmHistoryItem) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ComparisonOperator is an enum of the values of ComparisonOperator members.
+type ComparisonOperator string
+
+// The values of ComparisonOperator.
+const (
+	ComparisonOperatorGreaterThanOrEqualToThreshold ComparisonOperator = "GreaterThanOrEqualToThreshold"
+	ComparisonOperatorGreaterThanThreshold          ComparisonOperator = "GreaterThanThreshold"
+	ComparisonOperatorLessThanThreshold             ComparisonOperator = "LessThanThreshold"
+	ComparisonOperatorLessThanOrEqualToThreshold    ComparisonOperator = "LessThanOrEqualToThreshold"
+)
+
+// ComparisonOperatorValues returns the values of ComparisonOperator known to this
+// version of the SDK. The service may accept values added since.
+func ComparisonOperatorValues() []ComparisonOperator {
+	return []ComparisonOperator{
+		ComparisonOperator("GreaterThanOrEqualToThreshold"),
+		ComparisonOperator("GreaterThanThreshold"),
+		ComparisonOperator("LessThanThreshold"),
+		ComparisonOperator("LessThanOrEqualToThreshold"),
+	}
+}
+
+// String returns the ComparisonOperator as the string of a member.
+func (v ComparisonOperator) String() string {
+	return string(v)
+}
+
+// ParseComparisonOperator returns the ComparisonOperator of str, failing if it is not
+// one of the ComparisonOperatorValues.
+func ParseComparisonOperator(str string) (ComparisonOperator, error) {
+	for _, v := range ComparisonOperatorValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ComparisonOperator value %q", str)
+}
+
 // The Datapoint data type encapsulates the statistical data that Amazon CloudWatch
 // computes from metric data.
 type Datapoint struct {
@@ -407,7 +497,7 @@ type Datapoint struct {
 	Timestamp *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The standard unit used for the datapoint.
-	Unit *string `type:"string"`
+	Unit *string `type:"string" enum:"Seconds,Microseconds,Milliseconds,Bytes,Kilobytes,Megabytes,Gigabytes,Terabytes,Bits,Kilobits,Megabits,Gigabits,Terabits,Percent,Count,Bytes/Second,Kilobytes/Second,Megabytes/Second,Gigabytes/Second,Terabytes/Second,Bits/Second,Kilobits/Second,Megabits/Second,Gigabits/Second,Terabits/Second,Count/Second,None"`
 
 	metadataDatapoint `json:"-", xml:"-"`
 }
@@ -416,9 +506,14 @@ type metadataDatapoint struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Datapoint.
+func (s Datapoint) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteAlarmsInput struct {
 	// A list of alarms to be deleted.
-	AlarmNames []*string `type:"list" required:"true"`
+	AlarmNames []*string `type:"list" max:"100" required:"true"`
 
 	metadataDeleteAlarmsInput `json:"-", xml:"-"`
 }
@@ -427,6 +522,11 @@ type metadataDeleteAlarmsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteAlarmsInput.
+func (s DeleteAlarmsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteAlarmsOutput struct {
 	metadataDeleteAlarmsOutput `json:"-", xml:"-"`
 }
@@ -435,18 +535,23 @@ type metadataDeleteAlarmsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteAlarmsOutput.
+func (s DeleteAlarmsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeAlarmHistoryInput struct {
 	// The name of the alarm.
-	AlarmName *string `type:"string"`
+	AlarmName *string `type:"string" min:"1" max:"255"`
 
 	// The ending date to retrieve alarm history.
 	EndDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The type of alarm histories to retrieve.
-	HistoryItemType *string `type:"string"`
+	HistoryItemType *string `type:"string" enum:"ConfigurationUpdate,StateUpdate,Action"`
 
 	// The maximum number of alarm history records to retrieve.
-	MaxRecords *int64 `type:"integer"`
+	MaxRecords *int64 `type:"integer" min:"1" max:"100"`
 
 	// The token returned by a previous call to indicate that there is more data
 	// available.
@@ -462,6 +567,11 @@ type metadataDescribeAlarmHistoryInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeAlarmHistoryInput.
+func (s DescribeAlarmHistoryInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the DescribeAlarmHistory action.
 type DescribeAlarmHistoryOutput struct {
 	// A list of alarm histories in JSON format.
@@ -477,24 +587,29 @@ type metadataDescribeAlarmHistoryOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeAlarmHistoryOutput.
+func (s DescribeAlarmHistoryOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeAlarmsForMetricInput struct {
 	// The list of dimensions associated with the metric.
-	Dimensions []*Dimension `type:"list"`
+	Dimensions []*Dimension `type:"list" max:"10"`
 
 	// The name of the metric.
-	MetricName *string `type:"string" required:"true"`
+	MetricName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The namespace of the metric.
-	Namespace *string `type:"string" required:"true"`
+	Namespace *string `type:"string" min:"1" max:"255" pattern:"[^:].*" required:"true"`
 
 	// The period in seconds over which the statistic is applied.
-	Period *int64 `type:"integer"`
+	Period *int64 `type:"integer" min:"60"`
 
 	// The statistic for the metric.
-	Statistic *string `type:"string"`
+	Statistic *string `type:"string" enum:"SampleCount,Average,Sum,Minimum,Maximum"`
 
 	// The unit for the metric.
-	Unit *string `type:"string"`
+	Unit *string `type:"string" enum:"Seconds,Microseconds,Milliseconds,Bytes,Kilobytes,Megabytes,Gigabytes,Terabytes,Bits,Kilobits,Megabits,Gigabits,Terabits,Percent,Count,Bytes/Second,Kilobytes/Second,Megabytes/Second,Gigabytes/Second,Terabytes/Second,Bits/Second,Kilobits/Second,Megabits/Second,Gigabits/Second,Terabits/Second,Count/Second,None"`
 
 	metadataDescribeAlarmsForMetricInput `json:"-", xml:"-"`
 }
@@ -503,6 +618,11 @@ type metadataDescribeAlarmsForMetricInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeAlarmsForMetricInput.
+func (s DescribeAlarmsForMetricInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the DescribeAlarmsForMetric action.
 type DescribeAlarmsForMetricOutput struct {
 	// A list of information for each alarm with the specified metric.
@@ -515,26 +635,31 @@ type metadataDescribeAlarmsForMetricOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeAlarmsForMetricOutput.
+func (s DescribeAlarmsForMetricOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeAlarmsInput struct {
 	// The action name prefix.
-	ActionPrefix *string `type:"string"`
+	ActionPrefix *string `type:"string" min:"1" max:"1024"`
 
 	// The alarm name prefix. AlarmNames cannot be specified if this parameter is
 	// specified.
-	AlarmNamePrefix *string `type:"string"`
+	AlarmNamePrefix *string `type:"string" min:"1" max:"255"`
 
 	// A list of alarm names to retrieve information for.
-	AlarmNames []*string `type:"list"`
+	AlarmNames []*string `type:"list" max:"100"`
 
 	// The maximum number of alarm descriptions to retrieve.
-	MaxRecords *int64 `type:"integer"`
+	MaxRecords *int64 `type:"integer" min:"1" max:"100"`
 
 	// The token returned by a previous call to indicate that there is more data
 	// available.
 	NextToken *string `type:"string"`
 
 	// The state value to be used in matching alarms.
-	StateValue *string `type:"string"`
+	StateValue *string `type:"string" enum:"OK,ALARM,INSUFFICIENT_DATA"`
 
 	metadataDescribeAlarmsInput `json:"-", xml:"-"`
 }
@@ -543,6 +668,11 @@ type metadataDescribeAlarmsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeAlarmsInput.
+func (s DescribeAlarmsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the DescribeAlarms action.
 type DescribeAlarmsOutput struct {
 	// A list of information for the specified alarms.
@@ -558,16 +688,21 @@ type metadataDescribeAlarmsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeAlarmsOutput.
+func (s DescribeAlarmsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The Dimension data type further expands on the identity of a metric using
 // a Name, Value pair.
 //
 // For examples that use one or more dimensions, see PutMetricData.
 type Dimension struct {
 	// The name of the dimension.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The value representing the dimension measurement
-	Value *string `type:"string" required:"true"`
+	Value *string `type:"string" min:"1" max:"255" required:"true"`
 
 	metadataDimension `json:"-", xml:"-"`
 }
@@ -576,13 +711,18 @@ type metadataDimension struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Dimension.
+func (s Dimension) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The DimensionFilter data type is used to filter ListMetrics results.
 type DimensionFilter struct {
 	// The dimension name to be matched.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The value of the dimension to be matched.
-	Value *string `type:"string"`
+	Value *string `type:"string" min:"1" max:"255"`
 
 	metadataDimensionFilter `json:"-", xml:"-"`
 }
@@ -591,9 +731,14 @@ type metadataDimensionFilter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DimensionFilter.
+func (s DimensionFilter) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DisableAlarmActionsInput struct {
 	// The names of the alarms to disable actions for.
-	AlarmNames []*string `type:"list" required:"true"`
+	AlarmNames []*string `type:"list" max:"100" required:"true"`
 
 	metadataDisableAlarmActionsInput `json:"-", xml:"-"`
 }
@@ -602,6 +747,11 @@ type metadataDisableAlarmActionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DisableAlarmActionsInput.
+func (s DisableAlarmActionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DisableAlarmActionsOutput struct {
 	metadataDisableAlarmActionsOutput `json:"-", xml:"-"`
 }
@@ -610,9 +760,14 @@ type metadataDisableAlarmActionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DisableAlarmActionsOutput.
+func (s DisableAlarmActionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type EnableAlarmActionsInput struct {
 	// The names of the alarms to enable actions for.
-	AlarmNames []*string `type:"list" required:"true"`
+	AlarmNames []*string `type:"list" max:"100" required:"true"`
 
 	metadataEnableAlarmActionsInput `json:"-", xml:"-"`
 }
@@ -621,6 +776,11 @@ type metadataEnableAlarmActionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EnableAlarmActionsInput.
+func (s EnableAlarmActionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type EnableAlarmActionsOutput struct {
 	metadataEnableAlarmActionsOutput `json:"-", xml:"-"`
 }
@@ -629,9 +789,14 @@ type metadataEnableAlarmActionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EnableAlarmActionsOutput.
+func (s EnableAlarmActionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetMetricStatisticsInput struct {
 	// A list of dimensions describing qualities of the metric.
-	Dimensions []*Dimension `type:"list"`
+	Dimensions []*Dimension `type:"list" max:"10"`
 
 	// The time stamp to use for determining the last datapoint to return. The value
 	// specified is exclusive; results will include datapoints up to the time stamp
@@ -639,14 +804,14 @@ type GetMetricStatisticsInput struct {
 	EndTime *time.Time `type:"timestamp" timestampFormat:"iso8601" required:"true"`
 
 	// The name of the metric, with or without spaces.
-	MetricName *string `type:"string" required:"true"`
+	MetricName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The namespace of the metric, with or without spaces.
-	Namespace *string `type:"string" required:"true"`
+	Namespace *string `type:"string" min:"1" max:"255" pattern:"[^:].*" required:"true"`
 
 	// The granularity, in seconds, of the returned datapoints. Period must be at
 	// least 60 seconds and must be a multiple of 60. The default value is 60.
-	Period *int64 `type:"integer" required:"true"`
+	Period *int64 `type:"integer" min:"60" required:"true"`
 
 	// The time stamp to use for determining the first datapoint to return. The
 	// value specified is inclusive; results include datapoints with the time stamp
@@ -658,10 +823,10 @@ type GetMetricStatisticsInput struct {
 	// in the Amazon CloudWatch Developer Guide.
 	//
 	//  Valid Values: Average | Sum | SampleCount | Maximum | Minimum
-	Statistics []*string `type:"list" required:"true"`
+	Statistics []*string `type:"list" min:"1" max:"5" required:"true"`
 
 	// The unit for the metric.
-	Unit *string `type:"string"`
+	Unit *string `type:"string" enum:"Seconds,Microseconds,Milliseconds,Bytes,Kilobytes,Megabytes,Gigabytes,Terabytes,Bits,Kilobits,Megabits,Gigabits,Terabits,Percent,Count,Bytes/Second,Kilobytes/Second,Megabytes/Second,Gigabytes/Second,Terabytes/Second,Bits/Second,Kilobits/Second,Megabits/Second,Gigabits/Second,Terabits/Second,Count/Second,None"`
 
 	metadataGetMetricStatisticsInput `json:"-", xml:"-"`
 }
@@ -670,6 +835,11 @@ type metadataGetMetricStatisticsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetMetricStatisticsInput.
+func (s GetMetricStatisticsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the GetMetricStatistics action.
 type GetMetricStatisticsOutput struct {
 	// The datapoints for the specified metric.
@@ -685,15 +855,56 @@ type metadataGetMetricStatisticsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetMetricStatisticsOutput.
+func (s GetMetricStatisticsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// HistoryItemType is an enum of the values of HistoryItemType members.
+type HistoryItemType string
+
+// The values of HistoryItemType.
+const (
+	HistoryItemTypeConfigurationUpdate HistoryItemType = "ConfigurationUpdate"
+	HistoryItemTypeStateUpdate         HistoryItemType = "StateUpdate"
+	HistoryItemTypeAction              HistoryItemType = "Action"
+)
+
+// HistoryItemTypeValues returns the values of HistoryItemType known to this
+// version of the SDK. The service may accept values added since.
+func HistoryItemTypeValues() []HistoryItemType {
+	return []HistoryItemType{
+		HistoryItemType("ConfigurationUpdate"),
+		HistoryItemType("StateUpdate"),
+		HistoryItemType("Action"),
+	}
+}
+
+// String returns the HistoryItemType as the string of a member.
+func (v HistoryItemType) String() string {
+	return string(v)
+}
+
+// ParseHistoryItemType returns the HistoryItemType of str, failing if it is not
+// one of the HistoryItemTypeValues.
+func ParseHistoryItemType(str string) (HistoryItemType, error) {
+	for _, v := range HistoryItemTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HistoryItemType value %q", str)
+}
+
 type ListMetricsInput struct {
 	// A list of dimensions to filter against.
-	Dimensions []*DimensionFilter `type:"list"`
+	Dimensions []*DimensionFilter `type:"list" max:"10"`
 
 	// The name of the metric to filter against.
-	MetricName *string `type:"string"`
+	MetricName *string `type:"string" min:"1" max:"255"`
 
 	// The namespace to filter against.
-	Namespace *string `type:"string"`
+	Namespace *string `type:"string" min:"1" max:"255" pattern:"[^:].*"`
 
 	// The token returned by a previous call to indicate that there is more data
 	// available.
@@ -706,6 +917,11 @@ type metadataListMetricsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListMetricsInput.
+func (s ListMetricsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the ListMetrics action.
 type ListMetricsOutput struct {
 	// A list of metrics used to generate statistics for an AWS account.
@@ -721,6 +937,11 @@ type metadataListMetricsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListMetricsOutput.
+func (s ListMetricsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The Metric data type contains information about a specific metric. If you
 // call ListMetrics, Amazon CloudWatch returns information contained by this
 // data type.
@@ -730,13 +951,13 @@ type metadataListMetricsOutput struct {
 // two dimensions, InstanceID and InstanceType.
 type Metric struct {
 	// A list of dimensions associated with the metric.
-	Dimensions []*Dimension `type:"list"`
+	Dimensions []*Dimension `type:"list" max:"10"`
 
 	// The name of the metric.
-	MetricName *string `type:"string"`
+	MetricName *string `type:"string" min:"1" max:"255"`
 
 	// The namespace of the metric.
-	Namespace *string `type:"string"`
+	Namespace *string `type:"string" min:"1" max:"255" pattern:"[^:].*"`
 
 	metadataMetric `json:"-", xml:"-"`
 }
@@ -745,6 +966,11 @@ type metadataMetric struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Metric.
+func (s Metric) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The MetricAlarm data type represents an alarm. You can use PutMetricAlarm
 // to create or update an alarm.
 type MetricAlarm struct {
@@ -753,13 +979,13 @@ type MetricAlarm struct {
 	ActionsEnabled *bool `type:"boolean"`
 
 	// The Amazon Resource Name (ARN) of the alarm.
-	AlarmARN *string `locationName:"AlarmArn" type:"string"`
+	AlarmARN *string `locationName:"AlarmArn" type:"string" min:"1" max:"1600"`
 
 	// The list of actions to execute when this alarm transitions into an ALARM
 	// state from any other state. Each action is specified as an Amazon Resource
 	// Number (ARN). Currently the only actions supported are publishing to an Amazon
 	// SNS topic and triggering an Auto Scaling policy.
-	AlarmActions []*string `type:"list"`
+	AlarmActions []*string `type:"list" max:"5"`
 
 	// The time stamp of the last update to the alarm configuration. Amazon CloudWatch
 	// uses Coordinated Universal Time (UTC) when returning time stamps, which do
@@ -769,20 +995,20 @@ type MetricAlarm struct {
 	AlarmConfigurationUpdatedTimestamp *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The description for the alarm.
-	AlarmDescription *string `type:"string"`
+	AlarmDescription *string `type:"string" max:"255"`
 
 	// The name of the alarm.
-	AlarmName *string `type:"string"`
+	AlarmName *string `type:"string" min:"1" max:"255"`
 
 	// The arithmetic operation to use when comparing the specified Statistic and
 	// Threshold. The specified Statistic value is used as the first operand.
-	ComparisonOperator *string `type:"string"`
+	ComparisonOperator *string `type:"string" enum:"GreaterThanOrEqualToThreshold,GreaterThanThreshold,LessThanThreshold,LessThanOrEqualToThreshold"`
 
 	// The list of dimensions associated with the alarm's associated metric.
-	Dimensions []*Dimension `type:"list"`
+	Dimensions []*Dimension `type:"list" max:"10"`
 
 	// The number of periods over which data is compared to the specified threshold.
-	EvaluationPeriods *int64 `type:"integer"`
+	EvaluationPeriods *int64 `type:"integer" min:"1"`
 
 	// The list of actions to execute when this alarm transitions into an INSUFFICIENT_DATA
 	// state from any other state. Each action is specified as an Amazon Resource
@@ -790,28 +1016,28 @@ type MetricAlarm struct {
 	// SNS topic or triggering an Auto Scaling policy.
 	//
 	// The current WSDL lists this attribute as UnknownActions.
-	InsufficientDataActions []*string `type:"list"`
+	InsufficientDataActions []*string `type:"list" max:"5"`
 
 	// The name of the alarm's metric.
-	MetricName *string `type:"string"`
+	MetricName *string `type:"string" min:"1" max:"255"`
 
 	// The namespace of alarm's associated metric.
-	Namespace *string `type:"string"`
+	Namespace *string `type:"string" min:"1" max:"255" pattern:"[^:].*"`
 
 	// The list of actions to execute when this alarm transitions into an OK state
 	// from any other state. Each action is specified as an Amazon Resource Number
 	// (ARN). Currently the only actions supported are publishing to an Amazon SNS
 	// topic and triggering an Auto Scaling policy.
-	OKActions []*string `type:"list"`
+	OKActions []*string `type:"list" max:"5"`
 
 	// The period in seconds over which the statistic is applied.
-	Period *int64 `type:"integer"`
+	Period *int64 `type:"integer" min:"60"`
 
 	// A human-readable explanation for the alarm's state.
-	StateReason *string `type:"string"`
+	StateReason *string `type:"string" max:"1023"`
 
 	// An explanation for the alarm's state in machine-readable JSON format
-	StateReasonData *string `type:"string"`
+	StateReasonData *string `type:"string" max:"4000"`
 
 	// The time stamp of the last update to the alarm's state. Amazon CloudWatch
 	// uses Coordinated Universal Time (UTC) when returning time stamps, which do
@@ -821,16 +1047,16 @@ type MetricAlarm struct {
 	StateUpdatedTimestamp *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The state value for the alarm.
-	StateValue *string `type:"string"`
+	StateValue *string `type:"string" enum:"OK,ALARM,INSUFFICIENT_DATA"`
 
 	// The statistic to apply to the alarm's associated metric.
-	Statistic *string `type:"string"`
+	Statistic *string `type:"string" enum:"SampleCount,Average,Sum,Minimum,Maximum"`
 
 	// The value against which the specified statistic is compared.
 	Threshold *float64 `type:"double"`
 
 	// The unit of the alarm's associated metric.
-	Unit *string `type:"string"`
+	Unit *string `type:"string" enum:"Seconds,Microseconds,Milliseconds,Bytes,Kilobytes,Megabytes,Gigabytes,Terabytes,Bits,Kilobits,Megabits,Gigabits,Terabits,Percent,Count,Bytes/Second,Kilobytes/Second,Megabytes/Second,Gigabytes/Second,Terabytes/Second,Bits/Second,Kilobits/Second,Megabits/Second,Gigabits/Second,Terabits/Second,Count/Second,None"`
 
 	metadataMetricAlarm `json:"-", xml:"-"`
 }
@@ -839,16 +1065,21 @@ type metadataMetricAlarm struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the MetricAlarm.
+func (s MetricAlarm) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The MetricDatum data type encapsulates the information sent with PutMetricData
 // to either create a new metric or add new values to be aggregated into an
 // existing metric.
 type MetricDatum struct {
 	// A list of dimensions associated with the metric. Note, when using the Dimensions
 	// value in a query, you need to append .member.N to it (e.g., Dimensions.member.N).
-	Dimensions []*Dimension `type:"list"`
+	Dimensions []*Dimension `type:"list" max:"10"`
 
 	// The name of the metric.
-	MetricName *string `type:"string" required:"true"`
+	MetricName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// A set of statistical values describing the metric.
 	StatisticValues *StatisticSet `type:"structure"`
@@ -862,7 +1093,7 @@ type MetricDatum struct {
 	Timestamp *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The unit of the metric.
-	Unit *string `type:"string"`
+	Unit *string `type:"string" enum:"Seconds,Microseconds,Milliseconds,Bytes,Kilobytes,Megabytes,Gigabytes,Terabytes,Bits,Kilobits,Megabits,Gigabits,Terabits,Percent,Count,Bytes/Second,Kilobytes/Second,Megabytes/Second,Gigabytes/Second,Terabytes/Second,Bits/Second,Kilobits/Second,Megabits/Second,Gigabits/Second,Terabits/Second,Count/Second,None"`
 
 	// The value for the metric.
 	//
@@ -879,6 +1110,11 @@ type metadataMetricDatum struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the MetricDatum.
+func (s MetricDatum) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutMetricAlarmInput struct {
 	// Indicates whether or not actions should be executed during any changes to
 	// the alarm's state.
@@ -888,54 +1124,54 @@ type PutMetricAlarmInput struct {
 	// state from any other state. Each action is specified as an Amazon Resource
 	// Number (ARN). Currently the only action supported is publishing to an Amazon
 	// SNS topic or an Amazon Auto Scaling policy.
-	AlarmActions []*string `type:"list"`
+	AlarmActions []*string `type:"list" max:"5"`
 
 	// The description for the alarm.
-	AlarmDescription *string `type:"string"`
+	AlarmDescription *string `type:"string" max:"255"`
 
 	// The descriptive name for the alarm. This name must be unique within the user's
 	// AWS account
-	AlarmName *string `type:"string" required:"true"`
+	AlarmName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The arithmetic operation to use when comparing the specified Statistic and
 	// Threshold. The specified Statistic value is used as the first operand.
-	ComparisonOperator *string `type:"string" required:"true"`
+	ComparisonOperator *string `type:"string" enum:"GreaterThanOrEqualToThreshold,GreaterThanThreshold,LessThanThreshold,LessThanOrEqualToThreshold" required:"true"`
 
 	// The dimensions for the alarm's associated metric.
-	Dimensions []*Dimension `type:"list"`
+	Dimensions []*Dimension `type:"list" max:"10"`
 
 	// The number of periods over which data is compared to the specified threshold.
-	EvaluationPeriods *int64 `type:"integer" required:"true"`
+	EvaluationPeriods *int64 `type:"integer" min:"1" required:"true"`
 
 	// The list of actions to execute when this alarm transitions into an INSUFFICIENT_DATA
 	// state from any other state. Each action is specified as an Amazon Resource
 	// Number (ARN). Currently the only action supported is publishing to an Amazon
 	// SNS topic or an Amazon Auto Scaling policy.
-	InsufficientDataActions []*string `type:"list"`
+	InsufficientDataActions []*string `type:"list" max:"5"`
 
 	// The name for the alarm's associated metric.
-	MetricName *string `type:"string" required:"true"`
+	MetricName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The namespace for the alarm's associated metric.
-	Namespace *string `type:"string" required:"true"`
+	Namespace *string `type:"string" min:"1" max:"255" pattern:"[^:].*" required:"true"`
 
 	// The list of actions to execute when this alarm transitions into an OK state
 	// from any other state. Each action is specified as an Amazon Resource Number
 	// (ARN). Currently the only action supported is publishing to an Amazon SNS
 	// topic or an Amazon Auto Scaling policy.
-	OKActions []*string `type:"list"`
+	OKActions []*string `type:"list" max:"5"`
 
 	// The period in seconds over which the specified statistic is applied.
-	Period *int64 `type:"integer" required:"true"`
+	Period *int64 `type:"integer" min:"60" required:"true"`
 
 	// The statistic to apply to the alarm's associated metric.
-	Statistic *string `type:"string" required:"true"`
+	Statistic *string `type:"string" enum:"SampleCount,Average,Sum,Minimum,Maximum" required:"true"`
 
 	// The value against which the specified statistic is compared.
 	Threshold *float64 `type:"double" required:"true"`
 
 	// The unit for the alarm's associated metric.
-	Unit *string `type:"string"`
+	Unit *string `type:"string" enum:"Seconds,Microseconds,Milliseconds,Bytes,Kilobytes,Megabytes,Gigabytes,Terabytes,Bits,Kilobits,Megabits,Gigabits,Terabits,Percent,Count,Bytes/Second,Kilobytes/Second,Megabytes/Second,Gigabytes/Second,Terabytes/Second,Bits/Second,Kilobits/Second,Megabits/Second,Gigabits/Second,Terabits/Second,Count/Second,None"`
 
 	metadataPutMetricAlarmInput `json:"-", xml:"-"`
 }
@@ -944,6 +1180,11 @@ type metadataPutMetricAlarmInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutMetricAlarmInput.
+func (s PutMetricAlarmInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutMetricAlarmOutput struct {
 	metadataPutMetricAlarmOutput `json:"-", xml:"-"`
 }
@@ -952,12 +1193,17 @@ type metadataPutMetricAlarmOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutMetricAlarmOutput.
+func (s PutMetricAlarmOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutMetricDataInput struct {
 	// A list of data describing the metric.
 	MetricData []*MetricDatum `type:"list" required:"true"`
 
 	// The namespace for the metric data.
-	Namespace *string `type:"string" required:"true"`
+	Namespace *string `type:"string" min:"1" max:"255" pattern:"[^:].*" required:"true"`
 
 	metadataPutMetricDataInput `json:"-", xml:"-"`
 }
@@ -966,6 +1212,11 @@ type metadataPutMetricDataInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutMetricDataInput.
+func (s PutMetricDataInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutMetricDataOutput struct {
 	metadataPutMetricDataOutput `json:"-", xml:"-"`
 }
@@ -974,21 +1225,26 @@ type metadataPutMetricDataOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutMetricDataOutput.
+func (s PutMetricDataOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SetAlarmStateInput struct {
 	// The descriptive name for the alarm. This name must be unique within the user's
 	// AWS account. The maximum length is 255 characters.
-	AlarmName *string `type:"string" required:"true"`
+	AlarmName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The reason that this alarm is set to this specific state (in human-readable
 	// text format)
-	StateReason *string `type:"string" required:"true"`
+	StateReason *string `type:"string" max:"1023" required:"true"`
 
 	// The reason that this alarm is set to this specific state (in machine-readable
 	// JSON format)
-	StateReasonData *string `type:"string"`
+	StateReasonData *string `type:"string" max:"4000"`
 
 	// The value of the state.
-	StateValue *string `type:"string" required:"true"`
+	StateValue *string `type:"string" enum:"OK,ALARM,INSUFFICIENT_DATA" required:"true"`
 
 	metadataSetAlarmStateInput `json:"-", xml:"-"`
 }
@@ -997,6 +1253,11 @@ type metadataSetAlarmStateInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetAlarmStateInput.
+func (s SetAlarmStateInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SetAlarmStateOutput struct {
 	metadataSetAlarmStateOutput `json:"-", xml:"-"`
 }
@@ -1005,6 +1266,171 @@ type metadataSetAlarmStateOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetAlarmStateOutput.
+func (s SetAlarmStateOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// StandardUnit is an enum of the values of StandardUnit members.
+type StandardUnit string
+
+// The values of StandardUnit.
+const (
+	StandardUnitSeconds         StandardUnit = "Seconds"
+	StandardUnitMicroseconds    StandardUnit = "Microseconds"
+	StandardUnitMilliseconds    StandardUnit = "Milliseconds"
+	StandardUnitBytes           StandardUnit = "Bytes"
+	StandardUnitKilobytes       StandardUnit = "Kilobytes"
+	StandardUnitMegabytes       StandardUnit = "Megabytes"
+	StandardUnitGigabytes       StandardUnit = "Gigabytes"
+	StandardUnitTerabytes       StandardUnit = "Terabytes"
+	StandardUnitBits            StandardUnit = "Bits"
+	StandardUnitKilobits        StandardUnit = "Kilobits"
+	StandardUnitMegabits        StandardUnit = "Megabits"
+	StandardUnitGigabits        StandardUnit = "Gigabits"
+	StandardUnitTerabits        StandardUnit = "Terabits"
+	StandardUnitPercent         StandardUnit = "Percent"
+	StandardUnitCount           StandardUnit = "Count"
+	StandardUnitBytesSecond     StandardUnit = "Bytes/Second"
+	StandardUnitKilobytesSecond StandardUnit = "Kilobytes/Second"
+	StandardUnitMegabytesSecond StandardUnit = "Megabytes/Second"
+	StandardUnitGigabytesSecond StandardUnit = "Gigabytes/Second"
+	StandardUnitTerabytesSecond StandardUnit = "Terabytes/Second"
+	StandardUnitBitsSecond      StandardUnit = "Bits/Second"
+	StandardUnitKilobitsSecond  StandardUnit = "Kilobits/Second"
+	StandardUnitMegabitsSecond  StandardUnit = "Megabits/Second"
+	StandardUnitGigabitsSecond  StandardUnit = "Gigabits/Second"
+	StandardUnitTerabitsSecond  StandardUnit = "Terabits/Second"
+	StandardUnitCountSecond     StandardUnit = "Count/Second"
+	StandardUnitNone            StandardUnit = "None"
+)
+
+// StandardUnitValues returns the values of StandardUnit known to this
+// version of the SDK. The service may accept values added since.
+func StandardUnitValues() []StandardUnit {
+	return []StandardUnit{
+		StandardUnit("Seconds"),
+		StandardUnit("Microseconds"),
+		StandardUnit("Milliseconds"),
+		StandardUnit("Bytes"),
+		StandardUnit("Kilobytes"),
+		StandardUnit("Megabytes"),
+		StandardUnit("Gigabytes"),
+		StandardUnit("Terabytes"),
+		StandardUnit("Bits"),
+		StandardUnit("Kilobits"),
+		StandardUnit("Megabits"),
+		StandardUnit("Gigabits"),
+		StandardUnit("Terabits"),
+		StandardUnit("Percent"),
+		StandardUnit("Count"),
+		StandardUnit("Bytes/Second"),
+		StandardUnit("Kilobytes/Second"),
+		StandardUnit("Megabytes/Second"),
+		StandardUnit("Gigabytes/Second"),
+		StandardUnit("Terabytes/Second"),
+		StandardUnit("Bits/Second"),
+		StandardUnit("Kilobits/Second"),
+		StandardUnit("Megabits/Second"),
+		StandardUnit("Gigabits/Second"),
+		StandardUnit("Terabits/Second"),
+		StandardUnit("Count/Second"),
+		StandardUnit("None"),
+	}
+}
+
+// String returns the StandardUnit as the string of a member.
+func (v StandardUnit) String() string {
+	return string(v)
+}
+
+// ParseStandardUnit returns the StandardUnit of str, failing if it is not
+// one of the StandardUnitValues.
+func ParseStandardUnit(str string) (StandardUnit, error) {
+	for _, v := range StandardUnitValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid StandardUnit value %q", str)
+}
+
+// StateValue is an enum of the values of StateValue members.
+type StateValue string
+
+// The values of StateValue.
+const (
+	StateValueOK               StateValue = "OK"
+	StateValueALARM            StateValue = "ALARM"
+	StateValueINSUFFICIENTDATA StateValue = "INSUFFICIENT_DATA"
+)
+
+// StateValueValues returns the values of StateValue known to this
+// version of the SDK. The service may accept values added since.
+func StateValueValues() []StateValue {
+	return []StateValue{
+		StateValue("OK"),
+		StateValue("ALARM"),
+		StateValue("INSUFFICIENT_DATA"),
+	}
+}
+
+// String returns the StateValue as the string of a member.
+func (v StateValue) String() string {
+	return string(v)
+}
+
+// ParseStateValue returns the StateValue of str, failing if it is not
+// one of the StateValueValues.
+func ParseStateValue(str string) (StateValue, error) {
+	for _, v := range StateValueValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid StateValue value %q", str)
+}
+
+// Statistic is an enum of the values of Statistic members.
+type Statistic string
+
+// The values of Statistic.
+const (
+	StatisticSampleCount Statistic = "SampleCount"
+	StatisticAverage     Statistic = "Average"
+	StatisticSum         Statistic = "Sum"
+	StatisticMinimum     Statistic = "Minimum"
+	StatisticMaximum     Statistic = "Maximum"
+)
+
+// StatisticValues returns the values of Statistic known to this
+// version of the SDK. The service may accept values added since.
+func StatisticValues() []Statistic {
+	return []Statistic{
+		Statistic("SampleCount"),
+		Statistic("Average"),
+		Statistic("Sum"),
+		Statistic("Minimum"),
+		Statistic("Maximum"),
+	}
+}
+
+// String returns the Statistic as the string of a member.
+func (v Statistic) String() string {
+	return string(v)
+}
+
+// ParseStatistic returns the Statistic of str, failing if it is not
+// one of the StatisticValues.
+func ParseStatistic(str string) (Statistic, error) {
+	for _, v := range StatisticValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid Statistic value %q", str)
+}
+
 // The StatisticSet data type describes the StatisticValues component of MetricDatum,
 // and represents a set of statistics that describes a specific metric.
 type StatisticSet struct {
@@ -1025,4 +1451,9 @@ type StatisticSet struct {
 
 type metadataStatisticSet struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the StatisticSet.
+func (s StatisticSet) String() string {
+	return awsutil.StringValue(s)
 }
\ No newline at end of file
@@ -4,7 +4,10 @@
 package cloudwatchlogs
 
 import (
+	"fmt"
+
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // CreateLogGroupRequest generates a request for the CreateLogGroup operation.
@@ -435,7 +438,7 @@ func (c *CloudWatchLogs) TestMetricFilter(input *TestMetricFilterInput) (output
 var opTestMetricFilter *aws.Operation
 
 type CreateLogGroupInput struct {
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
 	metadataCreateLogGroupInput `json:"-", xml:"-"`
 }
@@ -444,6 +447,11 @@ type metadataCreateLogGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateLogGroupInput.
+func (s CreateLogGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateLogGroupOutput struct {
 	metadataCreateLogGroupOutput `json:"-", xml:"-"`
 }
@@ -452,10 +460,15 @@ type metadataCreateLogGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateLogGroupOutput.
+func (s CreateLogGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateLogStreamInput struct {
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
-	LogStreamName *string `locationName:"logStreamName" type:"string" required:"true"`
+	LogStreamName *string `locationName:"logStreamName" type:"string" min:"1" max:"512" pattern:"[^:*]*" required:"true"`
 
 	metadataCreateLogStreamInput `json:"-", xml:"-"`
 }
@@ -464,6 +477,11 @@ type metadataCreateLogStreamInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateLogStreamInput.
+func (s CreateLogStreamInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateLogStreamOutput struct {
 	metadataCreateLogStreamOutput `json:"-", xml:"-"`
 }
@@ -472,8 +490,13 @@ type metadataCreateLogStreamOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateLogStreamOutput.
+func (s CreateLogStreamOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteLogGroupInput struct {
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
 	metadataDeleteLogGroupInput `json:"-", xml:"-"`
 }
@@ -482,6 +505,11 @@ type metadataDeleteLogGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteLogGroupInput.
+func (s DeleteLogGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteLogGroupOutput struct {
 	metadataDeleteLogGroupOutput `json:"-", xml:"-"`
 }
@@ -490,10 +518,15 @@ type metadataDeleteLogGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteLogGroupOutput.
+func (s DeleteLogGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteLogStreamInput struct {
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
-	LogStreamName *string `locationName:"logStreamName" type:"string" required:"true"`
+	LogStreamName *string `locationName:"logStreamName" type:"string" min:"1" max:"512" pattern:"[^:*]*" required:"true"`
 
 	metadataDeleteLogStreamInput `json:"-", xml:"-"`
 }
@@ -502,6 +535,11 @@ type metadataDeleteLogStreamInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteLogStreamInput.
+func (s DeleteLogStreamInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteLogStreamOutput struct {
 	metadataDeleteLogStreamOutput `json:"-", xml:"-"`
 }
@@ -510,11 +548,16 @@ type metadataDeleteLogStreamOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteLogStreamOutput.
+func (s DeleteLogStreamOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteMetricFilterInput struct {
 	// The name of the metric filter.
-	FilterName *string `locationName:"filterName" type:"string" required:"true"`
+	FilterName *string `locationName:"filterName" type:"string" min:"1" max:"512" pattern:"[^:*]*" required:"true"`
 
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
 	metadataDeleteMetricFilterInput `json:"-", xml:"-"`
 }
@@ -523,6 +566,11 @@ type metadataDeleteMetricFilterInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteMetricFilterInput.
+func (s DeleteMetricFilterInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteMetricFilterOutput struct {
 	metadataDeleteMetricFilterOutput `json:"-", xml:"-"`
 }
@@ -531,8 +579,13 @@ type metadataDeleteMetricFilterOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteMetricFilterOutput.
+func (s DeleteMetricFilterOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteRetentionPolicyInput struct {
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
 	metadataDeleteRetentionPolicyInput `json:"-", xml:"-"`
 }
@@ -541,6 +594,11 @@ type metadataDeleteRetentionPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteRetentionPolicyInput.
+func (s DeleteRetentionPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteRetentionPolicyOutput struct {
 	metadataDeleteRetentionPolicyOutput `json:"-", xml:"-"`
 }
@@ -549,17 +607,22 @@ type metadataDeleteRetentionPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteRetentionPolicyOutput.
+func (s DeleteRetentionPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeLogGroupsInput struct {
 	// The maximum number of items returned in the response. If you don't specify
 	// a value, the request would return up to 50 items.
-	Limit *int64 `locationName:"limit" type:"integer"`
+	Limit *int64 `locationName:"limit" type:"integer" min:"1" max:"50"`
 
-	LogGroupNamePrefix *string `locationName:"logGroupNamePrefix" type:"string"`
+	LogGroupNamePrefix *string `locationName:"logGroupNamePrefix" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+"`
 
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous DescribeLogGroups
 	// request.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	metadataDescribeLogGroupsInput `json:"-", xml:"-"`
 }
@@ -568,6 +631,11 @@ type metadataDescribeLogGroupsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeLogGroupsInput.
+func (s DescribeLogGroupsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeLogGroupsOutput struct {
 	// A list of log groups.
 	LogGroups []*LogGroup `locationName:"logGroups" type:"list"`
@@ -575,7 +643,7 @@ type DescribeLogGroupsOutput struct {
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous request. The
 	// token expires after 24 hours.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	metadataDescribeLogGroupsOutput `json:"-", xml:"-"`
 }
@@ -584,6 +652,11 @@ type metadataDescribeLogGroupsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeLogGroupsOutput.
+func (s DescribeLogGroupsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeLogStreamsInput struct {
 	// If set to true, results are returned in descending order. If you don't specify
 	// a value or set it to false, results are returned in ascending order.
@@ -591,24 +664,24 @@ type DescribeLogStreamsInput struct {
 
 	// The maximum number of items returned in the response. If you don't specify
 	// a value, the request would return up to 50 items.
-	Limit *int64 `locationName:"limit" type:"integer"`
+	Limit *int64 `locationName:"limit" type:"integer" min:"1" max:"50"`
 
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
 	// Will only return log streams that match the provided logStreamNamePrefix.
 	// If you don't specify a value, no prefix filter is applied.
-	LogStreamNamePrefix *string `locationName:"logStreamNamePrefix" type:"string"`
+	LogStreamNamePrefix *string `locationName:"logStreamNamePrefix" type:"string" min:"1" max:"512" pattern:"[^:*]*"`
 
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous DescribeLogStreams
 	// request.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	// Specifies what to order the returned log streams by. Valid arguments are
 	// 'LogStreamName' or 'LastEventTime'. If you don't specify a value, results
 	// are ordered by LogStreamName. If 'LastEventTime' is chosen, the request cannot
 	// also contain a logStreamNamePrefix.
-	OrderBy *string `locationName:"orderBy" type:"string"`
+	OrderBy *string `locationName:"orderBy" type:"string" enum:"LogStreamName,LastEventTime"`
 
 	metadataDescribeLogStreamsInput `json:"-", xml:"-"`
 }
@@ -617,6 +690,11 @@ type metadataDescribeLogStreamsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeLogStreamsInput.
+func (s DescribeLogStreamsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeLogStreamsOutput struct {
 	// A list of log streams.
 	LogStreams []*LogStream `locationName:"logStreams" type:"list"`
@@ -624,7 +702,7 @@ type DescribeLogStreamsOutput struct {
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous request. The
 	// token expires after 24 hours.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	metadataDescribeLogStreamsOutput `json:"-", xml:"-"`
 }
@@ -633,20 +711,25 @@ type metadataDescribeLogStreamsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeLogStreamsOutput.
+func (s DescribeLogStreamsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeMetricFiltersInput struct {
 	// The name of the metric filter.
-	FilterNamePrefix *string `locationName:"filterNamePrefix" type:"string"`
+	FilterNamePrefix *string `locationName:"filterNamePrefix" type:"string" min:"1" max:"512" pattern:"[^:*]*"`
 
 	// The maximum number of items returned in the response. If you don't specify
 	// a value, the request would return up to 50 items.
-	Limit *int64 `locationName:"limit" type:"integer"`
+	Limit *int64 `locationName:"limit" type:"integer" min:"1" max:"50"`
 
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous DescribeMetricFilters
 	// request.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	metadataDescribeMetricFiltersInput `json:"-", xml:"-"`
 }
@@ -655,13 +738,18 @@ type metadataDescribeMetricFiltersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeMetricFiltersInput.
+func (s DescribeMetricFiltersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeMetricFiltersOutput struct {
 	MetricFilters []*MetricFilter `locationName:"metricFilters" type:"list"`
 
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous request. The
 	// token expires after 24 hours.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	metadataDescribeMetricFiltersOutput `json:"-", xml:"-"`
 }
@@ -670,6 +758,11 @@ type metadataDescribeMetricFiltersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeMetricFiltersOutput.
+func (s DescribeMetricFiltersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetLogEventsInput struct {
 	// A point in time expressed as the number milliseconds since Jan 1, 1970 00:00:00
 	// UTC.
@@ -678,16 +771,16 @@ type GetLogEventsInput struct {
 	// The maximum number of log events returned in the response. If you don't specify
 	// a value, the request would return as much log events as can fit in a response
 	// size of 1MB, up to 10,000 log events.
-	Limit *int64 `locationName:"limit" type:"integer"`
+	Limit *int64 `locationName:"limit" type:"integer" min:"1" max:"10000"`
 
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
-	LogStreamName *string `locationName:"logStreamName" type:"string" required:"true"`
+	LogStreamName *string `locationName:"logStreamName" type:"string" min:"1" max:"512" pattern:"[^:*]*" required:"true"`
 
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the nextForwardToken or nextBackwardToken
 	// fields in the response of the previous GetLogEvents request.
-	NextToken *string `locationName:"nextToken" type:"string"`
+	NextToken *string `locationName:"nextToken" type:"string" min:"1"`
 
 	// If set to true, the earliest log events would be returned first. The default
 	// is false (the latest log events are returned first).
@@ -704,18 +797,23 @@ type metadataGetLogEventsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetLogEventsInput.
+func (s GetLogEventsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetLogEventsOutput struct {
 	Events []*OutputLogEvent `locationName:"events" type:"list"`
 
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous request. The
 	// token expires after 24 hours.
-	NextBackwardToken *string `locationName:"nextBackwardToken" type:"string"`
+	NextBackwardToken *string `locationName:"nextBackwardToken" type:"string" min:"1"`
 
 	// A string token used for pagination that points to the next page of results.
 	// It must be a value obtained from the response of the previous request. The
 	// token expires after 24 hours.
-	NextForwardToken *string `locationName:"nextForwardToken" type:"string"`
+	NextForwardToken *string `locationName:"nextForwardToken" type:"string" min:"1"`
 
 	metadataGetLogEventsOutput `json:"-", xml:"-"`
 }
@@ -724,12 +822,17 @@ type metadataGetLogEventsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetLogEventsOutput.
+func (s GetLogEventsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A log event is a record of some activity that was recorded by the application
 // or resource being monitored. The log event record that Amazon CloudWatch
 // Logs understands contains two properties: the timestamp of when the event
 // occurred, and the raw event message.
 type InputLogEvent struct {
-	Message *string `locationName:"message" type:"string" required:"true"`
+	Message *string `locationName:"message" type:"string" min:"1" required:"true"`
 
 	// A point in time expressed as the number milliseconds since Jan 1, 1970 00:00:00
 	// UTC.
@@ -742,6 +845,11 @@ type metadataInputLogEvent struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InputLogEvent.
+func (s InputLogEvent) String() string {
+	return awsutil.StringValue(s)
+}
+
 type LogGroup struct {
 	ARN *string `locationName:"arn" type:"string"`
 
@@ -749,7 +857,7 @@ type LogGroup struct {
 	// UTC.
 	CreationTime *int64 `locationName:"creationTime" type:"long"`
 
-	LogGroupName *string `locationName:"logGroupName" type:"string"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+"`
 
 	// The number of metric filters associated with the log group.
 	MetricFilterCount *int64 `locationName:"metricFilterCount" type:"integer"`
@@ -768,6 +876,11 @@ type metadataLogGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the LogGroup.
+func (s LogGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A log stream is sequence of log events that share the same emitter.
 type LogStream struct {
 	ARN *string `locationName:"arn" type:"string"`
@@ -788,14 +901,14 @@ type LogStream struct {
 	// UTC.
 	LastIngestionTime *int64 `locationName:"lastIngestionTime" type:"long"`
 
-	LogStreamName *string `locationName:"logStreamName" type:"string"`
+	LogStreamName *string `locationName:"logStreamName" type:"string" min:"1" max:"512" pattern:"[^:*]*"`
 
 	StoredBytes *int64 `locationName:"storedBytes" type:"long"`
 
 	// A string token used for making PutLogEvents requests. A sequenceToken can
 	// only be used once, and PutLogEvents requests must include the sequenceToken
 	// obtained from the response of the previous request.
-	UploadSequenceToken *string `locationName:"uploadSequenceToken" type:"string"`
+	UploadSequenceToken *string `locationName:"uploadSequenceToken" type:"string" min:"1"`
 
 	metadataLogStream `json:"-", xml:"-"`
 }
@@ -804,6 +917,11 @@ type metadataLogStream struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the LogStream.
+func (s LogStream) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Metric filters can be used to express how Amazon CloudWatch Logs would extract
 // metric observations from ingested log events and transform them to metric
 // data in a CloudWatch metric.
@@ -813,15 +931,15 @@ type MetricFilter struct {
 	CreationTime *int64 `locationName:"creationTime" type:"long"`
 
 	// The name of the metric filter.
-	FilterName *string `locationName:"filterName" type:"string"`
+	FilterName *string `locationName:"filterName" type:"string" min:"1" max:"512" pattern:"[^:*]*"`
 
 	// A symbolic description of how Amazon CloudWatch Logs should interpret the
 	// data in each log entry. For example, a log entry may contain timestamps,
 	// IP addresses, strings, and so on. You use the pattern to specify what to
 	// look for in the log stream.
-	FilterPattern *string `locationName:"filterPattern" type:"string"`
+	FilterPattern *string `locationName:"filterPattern" type:"string" max:"512"`
 
-	MetricTransformations []*MetricTransformation `locationName:"metricTransformations" type:"list"`
+	MetricTransformations []*MetricTransformation `locationName:"metricTransformations" type:"list" min:"1" max:"1"`
 
 	metadataMetricFilter `json:"-", xml:"-"`
 }
@@ -830,8 +948,13 @@ type metadataMetricFilter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the MetricFilter.
+func (s MetricFilter) String() string {
+	return awsutil.StringValue(s)
+}
+
 type MetricFilterMatchRecord struct {
-	EventMessage *string `locationName:"eventMessage" type:"string"`
+	EventMessage *string `locationName:"eventMessage" type:"string" min:"1"`
 
 	EventNumber *int64 `locationName:"eventNumber" type:"long"`
 
@@ -844,19 +967,24 @@ type metadataMetricFilterMatchRecord struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the MetricFilterMatchRecord.
+func (s MetricFilterMatchRecord) String() string {
+	return awsutil.StringValue(s)
+}
+
 type MetricTransformation struct {
 	// The name of the CloudWatch metric to which the monitored log information
 	// should be published. For example, you may publish to a metric called ErrorCount.
-	MetricName *string `locationName:"metricName" type:"string" required:"true"`
+	MetricName *string `locationName:"metricName" type:"string" max:"255" pattern:"[^:*$]*" required:"true"`
 
 	// The destination namespace of the new CloudWatch metric.
-	MetricNamespace *string `locationName:"metricNamespace" type:"string" required:"true"`
+	MetricNamespace *string `locationName:"metricNamespace" type:"string" max:"255" pattern:"[^:*$]*" required:"true"`
 
 	// What to publish to the metric. For example, if you're counting the occurrences
 	// of a particular term like "Error", the value will be "1" for each occurrence.
 	// If you're counting the bytes transferred the published value will be the
 	// value in the log event.
-	MetricValue *string `locationName:"metricValue" type:"string" required:"true"`
+	MetricValue *string `locationName:"metricValue" type:"string" max:"100" required:"true"`
 
 	metadataMetricTransformation `json:"-", xml:"-"`
 }
@@ -865,12 +993,51 @@ type metadataMetricTransformation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the MetricTransformation.
+func (s MetricTransformation) String() string {
+	return awsutil.StringValue(s)
+}
+
+// OrderBy is an enum of the values of OrderBy members.
+type OrderBy string
+
+// The values of OrderBy.
+const (
+	OrderByLogStreamName OrderBy = "LogStreamName"
+	OrderByLastEventTime OrderBy = "LastEventTime"
+)
+
+// OrderByValues returns the values of OrderBy known to this
+// version of the SDK. The service may accept values added since.
+func OrderByValues() []OrderBy {
+	return []OrderBy{
+		OrderBy("LogStreamName"),
+		OrderBy("LastEventTime"),
+	}
+}
+
+// String returns the OrderBy as the string of a member.
+func (v OrderBy) String() string {
+	return string(v)
+}
+
+// ParseOrderBy returns the OrderBy of str, failing if it is not
+// one of the OrderByValues.
+func ParseOrderBy(str string) (OrderBy, error) {
+	for _, v := range OrderByValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid OrderBy value %q", str)
+}
+
 type OutputLogEvent struct {
 	// A point in time expressed as the number milliseconds since Jan 1, 1970 00:00:00
 	// UTC.
 	IngestionTime *int64 `locationName:"ingestionTime" type:"long"`
 
-	Message *string `locationName:"message" type:"string"`
+	Message *string `locationName:"message" type:"string" min:"1"`
 
 	// A point in time expressed as the number milliseconds since Jan 1, 1970 00:00:00
 	// UTC.
@@ -883,17 +1050,22 @@ type metadataOutputLogEvent struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OutputLogEvent.
+func (s OutputLogEvent) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutLogEventsInput struct {
 	// A list of events belonging to a log stream.
-	LogEvents []*InputLogEvent `locationName:"logEvents" type:"list" required:"true"`
+	LogEvents []*InputLogEvent `locationName:"logEvents" type:"list" min:"1" max:"10000" required:"true"`
 
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
-	LogStreamName *string `locationName:"logStreamName" type:"string" required:"true"`
+	LogStreamName *string `locationName:"logStreamName" type:"string" min:"1" max:"512" pattern:"[^:*]*" required:"true"`
 
 	// A string token that must be obtained from the response of the previous PutLogEvents
 	// request.
-	SequenceToken *string `locationName:"sequenceToken" type:"string"`
+	SequenceToken *string `locationName:"sequenceToken" type:"string" min:"1"`
 
 	metadataPutLogEventsInput `json:"-", xml:"-"`
 }
@@ -902,11 +1074,16 @@ type metadataPutLogEventsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutLogEventsInput.
+func (s PutLogEventsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutLogEventsOutput struct {
 	// A string token used for making PutLogEvents requests. A sequenceToken can
 	// only be used once, and PutLogEvents requests must include the sequenceToken
 	// obtained from the response of the previous request.
-	NextSequenceToken *string `locationName:"nextSequenceToken" type:"string"`
+	NextSequenceToken *string `locationName:"nextSequenceToken" type:"string" min:"1"`
 
 	RejectedLogEventsInfo *RejectedLogEventsInfo `locationName:"rejectedLogEventsInfo" type:"structure"`
 
@@ -917,19 +1094,24 @@ type metadataPutLogEventsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutLogEventsOutput.
+func (s PutLogEventsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutMetricFilterInput struct {
 	// The name of the metric filter.
-	FilterName *string `locationName:"filterName" type:"string" required:"true"`
+	FilterName *string `locationName:"filterName" type:"string" min:"1" max:"512" pattern:"[^:*]*" required:"true"`
 
 	// A symbolic description of how Amazon CloudWatch Logs should interpret the
 	// data in each log entry. For example, a log entry may contain timestamps,
 	// IP addresses, strings, and so on. You use the pattern to specify what to
 	// look for in the log stream.
-	FilterPattern *string `locationName:"filterPattern" type:"string" required:"true"`
+	FilterPattern *string `locationName:"filterPattern" type:"string" max:"512" required:"true"`
 
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
-	MetricTransformations []*MetricTransformation `locationName:"metricTransformations" type:"list" required:"true"`
+	MetricTransformations []*MetricTransformation `locationName:"metricTransformations" type:"list" min:"1" max:"1" required:"true"`
 
 	metadataPutMetricFilterInput `json:"-", xml:"-"`
 }
@@ -938,6 +1120,11 @@ type metadataPutMetricFilterInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutMetricFilterInput.
+func (s PutMetricFilterInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutMetricFilterOutput struct {
 	metadataPutMetricFilterOutput `json:"-", xml:"-"`
 }
@@ -946,8 +1133,13 @@ type metadataPutMetricFilterOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutMetricFilterOutput.
+func (s PutMetricFilterOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutRetentionPolicyInput struct {
-	LogGroupName *string `locationName:"logGroupName" type:"string" required:"true"`
+	LogGroupName *string `locationName:"logGroupName" type:"string" min:"1" max:"512" pattern:"[\\.\\-_/#A-Za-z0-9]+" required:"true"`
 
 	// Specifies the number of days you want to retain log events in the specified
 	// log group. Possible values are: 1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180,
@@ -961,6 +1153,11 @@ type metadataPutRetentionPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutRetentionPolicyInput.
+func (s PutRetentionPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutRetentionPolicyOutput struct {
 	metadataPutRetentionPolicyOutput `json:"-", xml:"-"`
 }
@@ -969,6 +1166,11 @@ type metadataPutRetentionPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutRetentionPolicyOutput.
+func (s PutRetentionPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RejectedLogEventsInfo struct {
 	ExpiredLogEventEndIndex *int64 `locationName:"expiredLogEventEndIndex" type:"integer"`
 
@@ -983,14 +1185,19 @@ type metadataRejectedLogEventsInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RejectedLogEventsInfo.
+func (s RejectedLogEventsInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
 type TestMetricFilterInput struct {
 	// A symbolic description of how Amazon CloudWatch Logs should interpret the
 	// data in each log entry. For example, a log entry may contain timestamps,
 	// IP addresses, strings, and so on. You use the pattern to specify what to
 	// look for in the log stream.
-	FilterPattern *string `locationName:"filterPattern" type:"string" required:"true"`
+	FilterPattern *string `locationName:"filterPattern" type:"string" max:"512" required:"true"`
 
-	LogEventMessages []*string `locationName:"logEventMessages" type:"list" required:"true"`
+	LogEventMessages []*string `locationName:"logEventMessages" type:"list" min:"1" max:"50" required:"true"`
 
 	metadataTestMetricFilterInput `json:"-", xml:"-"`
 }
@@ -999,6 +1206,11 @@ type metadataTestMetricFilterInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TestMetricFilterInput.
+func (s TestMetricFilterInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type TestMetricFilterOutput struct {
 	Matches []*MetricFilterMatchRecord `locationName:"matches" type:"list"`
 
@@ -1007,4 +1219,9 @@ type TestMetricFilterOutput struct {
 
 type metadataTestMetricFilterOutput struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the TestMetricFilterOutput.
+func (s TestMetricFilterOutput) String() string {
+	return awsutil.StringValue(s)
 }
\ No newline at end of file
@@ -27,7 +27,7 @@ func New(config *aws.Config) *CloudWatchLogs {
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(jsonrpc.Build)
 	service.Handlers.Unmarshal.PushBack(jsonrpc.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(jsonrpc.UnmarshalMeta)
@@ -4,9 +4,11 @@
 package codedeploy
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // BatchGetApplicationsRequest generates a request for the BatchGetApplications operation.
@@ -670,7 +672,7 @@ type ApplicationInfo struct {
 	ApplicationID *string `locationName:"applicationId" type:"string"`
 
 	// The application name.
-	ApplicationName *string `locationName:"applicationName" type:"string"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100"`
 
 	// The time that the application was created.
 	CreateTime *time.Time `locationName:"createTime" type:"timestamp" timestampFormat:"unix"`
@@ -686,6 +688,47 @@ type metadataApplicationInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ApplicationInfo.
+func (s ApplicationInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ApplicationRevisionSortBy is an enum of the values of ApplicationRevisionSortBy members.
+type ApplicationRevisionSortBy string
+
+// The values of ApplicationRevisionSortBy.
+const (
+	ApplicationRevisionSortByRegisterTime  ApplicationRevisionSortBy = "registerTime"
+	ApplicationRevisionSortByFirstUsedTime ApplicationRevisionSortBy = "firstUsedTime"
+	ApplicationRevisionSortByLastUsedTime  ApplicationRevisionSortBy = "lastUsedTime"
+)
+
+// ApplicationRevisionSortByValues returns the values of ApplicationRevisionSortBy known to this
+// version of the SDK. The service may accept values added since.
+func ApplicationRevisionSortByValues() []ApplicationRevisionSortBy {
+	return []ApplicationRevisionSortBy{
+		ApplicationRevisionSortBy("registerTime"),
+		ApplicationRevisionSortBy("firstUsedTime"),
+		ApplicationRevisionSortBy("lastUsedTime"),
+	}
+}
+
+// String returns the ApplicationRevisionSortBy as the string of a member.
+func (v ApplicationRevisionSortBy) String() string {
+	return string(v)
+}
+
+// ParseApplicationRevisionSortBy returns the ApplicationRevisionSortBy of str, failing if it is not
+// one of the ApplicationRevisionSortByValues.
+func ParseApplicationRevisionSortBy(str string) (ApplicationRevisionSortBy, error) {
+	for _, v := range ApplicationRevisionSortByValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ApplicationRevisionSortBy value %q", str)
+}
+
 // Information about an Auto Scaling group.
 type AutoScalingGroup struct {
 	// An Auto Scaling lifecycle event hook name.
@@ -701,6 +744,11 @@ type metadataAutoScalingGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AutoScalingGroup.
+func (s AutoScalingGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a batch get applications operation.
 type BatchGetApplicationsInput struct {
 	// A list of application names, with multiple application names separated by
@@ -714,6 +762,11 @@ type metadataBatchGetApplicationsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the BatchGetApplicationsInput.
+func (s BatchGetApplicationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a batch get applications operation.
 type BatchGetApplicationsOutput struct {
 	// Information about the applications.
@@ -726,6 +779,11 @@ type metadataBatchGetApplicationsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the BatchGetApplicationsOutput.
+func (s BatchGetApplicationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a batch get deployments operation.
 type BatchGetDeploymentsInput struct {
 	// A list of deployment IDs, with multiple deployment IDs separated by spaces.
@@ -738,6 +796,11 @@ type metadataBatchGetDeploymentsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the BatchGetDeploymentsInput.
+func (s BatchGetDeploymentsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a batch get deployments operation.
 type BatchGetDeploymentsOutput struct {
 	// Information about the deployments.
@@ -750,11 +813,52 @@ type metadataBatchGetDeploymentsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the BatchGetDeploymentsOutput.
+func (s BatchGetDeploymentsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// BundleType is an enum of the values of BundleType members.
+type BundleType string
+
+// The values of BundleType.
+const (
+	BundleTypeTar BundleType = "tar"
+	BundleTypeTgz BundleType = "tgz"
+	BundleTypeZip BundleType = "zip"
+)
+
+// BundleTypeValues returns the values of BundleType known to this
+// version of the SDK. The service may accept values added since.
+func BundleTypeValues() []BundleType {
+	return []BundleType{
+		BundleType("tar"),
+		BundleType("tgz"),
+		BundleType("zip"),
+	}
+}
+
+// String returns the BundleType as the string of a member.
+func (v BundleType) String() string {
+	return string(v)
+}
+
+// ParseBundleType returns the BundleType of str, failing if it is not
+// one of the BundleTypeValues.
+func ParseBundleType(str string) (BundleType, error) {
+	for _, v := range BundleTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid BundleType value %q", str)
+}
+
 // Represents the input of a create application operation.
 type CreateApplicationInput struct {
 	// The name of the application. This name must be unique within the AWS user
 	// account.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataCreateApplicationInput `json:"-", xml:"-"`
 }
@@ -763,6 +867,11 @@ type metadataCreateApplicationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateApplicationInput.
+func (s CreateApplicationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a create application operation.
 type CreateApplicationOutput struct {
 	// A unique application ID.
@@ -775,10 +884,15 @@ type metadataCreateApplicationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateApplicationOutput.
+func (s CreateApplicationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a create deployment configuration operation.
 type CreateDeploymentConfigInput struct {
 	// The name of the deployment configuration to create.
-	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" required:"true"`
+	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" min:"1" max:"100" required:"true"`
 
 	// The minimum number of healthy instances that should be available at any time
 	// during the deployment. There are two parameters expected in the input: type
@@ -805,6 +919,11 @@ type metadataCreateDeploymentConfigInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateDeploymentConfigInput.
+func (s CreateDeploymentConfigInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a create deployment configuration operation.
 type CreateDeploymentConfigOutput struct {
 	// A unique deployment configuration ID.
@@ -817,10 +936,15 @@ type metadataCreateDeploymentConfigOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateDeploymentConfigOutput.
+func (s CreateDeploymentConfigOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a create deployment group operation.
 type CreateDeploymentGroupInput struct {
 	// The name of an existing AWS CodeDeploy application within the AWS user account.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	// A list of associated Auto Scaling groups.
 	AutoScalingGroups []*string `locationName:"autoScalingGroups" type:"list"`
@@ -854,10 +978,10 @@ type CreateDeploymentGroupInput struct {
 	// specified for either the deployment or the deployment group.  To create a
 	// custom deployment configuration, call the create deployment configuration
 	// operation.
-	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string"`
+	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" min:"1" max:"100"`
 
 	// The name of an existing deployment group for the specified application.
-	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string" required:"true"`
+	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string" min:"1" max:"100" required:"true"`
 
 	// The Amazon EC2 tags to filter on.
 	EC2TagFilters []*EC2TagFilter `locationName:"ec2TagFilters" type:"list"`
@@ -873,6 +997,11 @@ type metadataCreateDeploymentGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateDeploymentGroupInput.
+func (s CreateDeploymentGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a create deployment group operation.
 type CreateDeploymentGroupOutput struct {
 	// A unique deployment group ID.
@@ -885,20 +1014,25 @@ type metadataCreateDeploymentGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateDeploymentGroupOutput.
+func (s CreateDeploymentGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a create deployment operation.
 type CreateDeploymentInput struct {
 	// The name of an existing AWS CodeDeploy application within the AWS user account.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	// The name of an existing deployment configuration within the AWS user account.
 	//
 	// If not specified, the value configured in the deployment group will be used
 	// as the default. If the deployment group does not have a deployment configuration
 	// associated with it, then CodeDeployDefault.OneAtATime will be used by default.
-	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string"`
+	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" min:"1" max:"100"`
 
 	// The deployment group's name.
-	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string"`
+	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string" min:"1" max:"100"`
 
 	// A comment about the deployment.
 	Description *string `locationName:"description" type:"string"`
@@ -925,6 +1059,11 @@ type metadataCreateDeploymentInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateDeploymentInput.
+func (s CreateDeploymentInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a create deployment operation.
 type CreateDeploymentOutput struct {
 	// A unique deployment ID.
@@ -937,10 +1076,15 @@ type metadataCreateDeploymentOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateDeploymentOutput.
+func (s CreateDeploymentOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a delete application operation.
 type DeleteApplicationInput struct {
 	// The name of an existing AWS CodeDeploy application within the AWS user account.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataDeleteApplicationInput `json:"-", xml:"-"`
 }
@@ -949,6 +1093,11 @@ type metadataDeleteApplicationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteApplicationInput.
+func (s DeleteApplicationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteApplicationOutput struct {
 	metadataDeleteApplicationOutput `json:"-", xml:"-"`
 }
@@ -957,10 +1106,15 @@ type metadataDeleteApplicationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteApplicationOutput.
+func (s DeleteApplicationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a delete deployment configuration operation.
 type DeleteDeploymentConfigInput struct {
 	// The name of an existing deployment configuration within the AWS user account.
-	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" required:"true"`
+	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataDeleteDeploymentConfigInput `json:"-", xml:"-"`
 }
@@ -969,6 +1123,11 @@ type metadataDeleteDeploymentConfigInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteDeploymentConfigInput.
+func (s DeleteDeploymentConfigInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteDeploymentConfigOutput struct {
 	metadataDeleteDeploymentConfigOutput `json:"-", xml:"-"`
 }
@@ -977,13 +1136,18 @@ type metadataDeleteDeploymentConfigOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteDeploymentConfigOutput.
+func (s DeleteDeploymentConfigOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a delete deployment group operation.
 type DeleteDeploymentGroupInput struct {
 	// The name of an existing AWS CodeDeploy application within the AWS user account.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	// The name of an existing deployment group for the specified application.
-	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string" required:"true"`
+	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataDeleteDeploymentGroupInput `json:"-", xml:"-"`
 }
@@ -992,6 +1156,11 @@ type metadataDeleteDeploymentGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteDeploymentGroupInput.
+func (s DeleteDeploymentGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a delete deployment group operation.
 type DeleteDeploymentGroupOutput struct {
 	// If the output contains no data, and the corresponding deployment group contained
@@ -1009,6 +1178,11 @@ type metadataDeleteDeploymentGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteDeploymentGroupOutput.
+func (s DeleteDeploymentGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Information about a deployment configuration.
 type DeploymentConfigInfo struct {
 	// The time that the deployment configuration was created.
@@ -1018,7 +1192,7 @@ type DeploymentConfigInfo struct {
 	DeploymentConfigID *string `locationName:"deploymentConfigId" type:"string"`
 
 	// The deployment configuration name.
-	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string"`
+	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" min:"1" max:"100"`
 
 	// Information about the number or percentage of minimum healthy instances.
 	MinimumHealthyHosts *MinimumHealthyHosts `locationName:"minimumHealthyHosts" type:"structure"`
@@ -1030,22 +1204,61 @@ type metadataDeploymentConfigInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeploymentConfigInfo.
+func (s DeploymentConfigInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
+// DeploymentCreator is an enum of the values of DeploymentCreator members.
+type DeploymentCreator string
+
+// The values of DeploymentCreator.
+const (
+	DeploymentCreatorUser        DeploymentCreator = "user"
+	DeploymentCreatorAutoscaling DeploymentCreator = "autoscaling"
+)
+
+// DeploymentCreatorValues returns the values of DeploymentCreator known to this
+// version of the SDK. The service may accept values added since.
+func DeploymentCreatorValues() []DeploymentCreator {
+	return []DeploymentCreator{
+		DeploymentCreator("user"),
+		DeploymentCreator("autoscaling"),
+	}
+}
+
+// String returns the DeploymentCreator as the string of a member.
+func (v DeploymentCreator) String() string {
+	return string(v)
+}
+
+// ParseDeploymentCreator returns the DeploymentCreator of str, failing if it is not
+// one of the DeploymentCreatorValues.
+func ParseDeploymentCreator(str string) (DeploymentCreator, error) {
+	for _, v := range DeploymentCreatorValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DeploymentCreator value %q", str)
+}
+
 // Information about a deployment group.
 type DeploymentGroupInfo struct {
 	// The application name.
-	ApplicationName *string `locationName:"applicationName" type:"string"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100"`
 
 	// A list of associated Auto Scaling groups.
 	AutoScalingGroups []*AutoScalingGroup `locationName:"autoScalingGroups" type:"list"`
 
 	// The deployment configuration name.
-	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string"`
+	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" min:"1" max:"100"`
 
 	// The deployment group ID.
 	DeploymentGroupID *string `locationName:"deploymentGroupId" type:"string"`
 
 	// The deployment group name.
-	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string"`
+	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string" min:"1" max:"100"`
 
 	// The Amazon EC2 tags to filter on.
 	EC2TagFilters []*EC2TagFilter `locationName:"ec2TagFilters" type:"list"`
@@ -1064,10 +1277,15 @@ type metadataDeploymentGroupInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeploymentGroupInfo.
+func (s DeploymentGroupInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Information about a deployment.
 type DeploymentInfo struct {
 	// The application name.
-	ApplicationName *string `locationName:"applicationName" type:"string"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100"`
 
 	// A timestamp indicating when the deployment was completed.
 	CompleteTime *time.Time `locationName:"completeTime" type:"timestamp" timestampFormat:"unix"`
@@ -1079,13 +1297,13 @@ type DeploymentInfo struct {
 	//
 	//  user: A user created the deployment. autoscaling: Auto Scaling created
 	// the deployment.
-	Creator *string `locationName:"creator" type:"string"`
+	Creator *string `locationName:"creator" type:"string" enum:"user,autoscaling"`
 
 	// The deployment configuration name.
-	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string"`
+	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" min:"1" max:"100"`
 
 	// The deployment group name.
-	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string"`
+	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string" min:"1" max:"100"`
 
 	// The deployment ID.
 	DeploymentID *string `locationName:"deploymentId" type:"string"`
@@ -1123,7 +1341,7 @@ type DeploymentInfo struct {
 	StartTime *time.Time `locationName:"startTime" type:"timestamp" timestampFormat:"unix"`
 
 	// The current state of the deployment as a whole.
-	Status *string `locationName:"status" type:"string"`
+	Status *string `locationName:"status" type:"string" enum:"Created,Queued,InProgress,Succeeded,Failed,Stopped"`
 
 	metadataDeploymentInfo `json:"-", xml:"-"`
 }
@@ -1132,6 +1350,11 @@ type metadataDeploymentInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeploymentInfo.
+func (s DeploymentInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Information about the deployment status of the instances in the deployment.
 type DeploymentOverview struct {
 	// The number of instances that have failed in the deployment.
@@ -1156,6 +1379,53 @@ type metadataDeploymentOverview struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeploymentOverview.
+func (s DeploymentOverview) String() string {
+	return awsutil.StringValue(s)
+}
+
+// DeploymentStatus is an enum of the values of DeploymentStatus members.
+type DeploymentStatus string
+
+// The values of DeploymentStatus.
+const (
+	DeploymentStatusCreated    DeploymentStatus = "Created"
+	DeploymentStatusQueued     DeploymentStatus = "Queued"
+	DeploymentStatusInProgress DeploymentStatus = "InProgress"
+	DeploymentStatusSucceeded  DeploymentStatus = "Succeeded"
+	DeploymentStatusFailed     DeploymentStatus = "Failed"
+	DeploymentStatusStopped    DeploymentStatus = "Stopped"
+)
+
+// DeploymentStatusValues returns the values of DeploymentStatus known to this
+// version of the SDK. The service may accept values added since.
+func DeploymentStatusValues() []DeploymentStatus {
+	return []DeploymentStatus{
+		DeploymentStatus("Created"),
+		DeploymentStatus("Queued"),
+		DeploymentStatus("InProgress"),
+		DeploymentStatus("Succeeded"),
+		DeploymentStatus("Failed"),
+		DeploymentStatus("Stopped"),
+	}
+}
+
+// String returns the DeploymentStatus as the string of a member.
+func (v DeploymentStatus) String() string {
+	return string(v)
+}
+
+// ParseDeploymentStatus returns the DeploymentStatus of str, failing if it is not
+// one of the DeploymentStatusValues.
+func ParseDeploymentStatus(str string) (DeploymentStatus, error) {
+	for _, v := range DeploymentStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DeploymentStatus value %q", str)
+}
+
 // Diagnostic information about executable scripts that are part of a deployment.
 type Diagnostics struct {
 	// The associated error code:
@@ -1166,7 +1436,7 @@ type Diagnostics struct {
 	// script did not finish running in the specified time period. ScriptFailed:
 	// The specified script failed to run as expected. UnknownError: The specified
 	// script did not run for an unknown reason.
-	ErrorCode *string `locationName:"errorCode" type:"string"`
+	ErrorCode *string `locationName:"errorCode" type:"string" enum:"Success,ScriptMissing,ScriptNotExecutable,ScriptTimedOut,ScriptFailed,UnknownError"`
 
 	// The last portion of the associated diagnostic log.
 	LogTail *string `locationName:"logTail" type:"string"`
@@ -1184,6 +1454,11 @@ type metadataDiagnostics struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Diagnostics.
+func (s Diagnostics) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Information about an Amazon EC2 tag filter.
 type EC2TagFilter struct {
 	// The Amazon EC2 tag filter key.
@@ -1192,7 +1467,7 @@ type EC2TagFilter struct {
 	// The Amazon EC2 tag filter type:
 	//
 	//  KEY_ONLY: Key only. VALUE_ONLY: Value only. KEY_AND_VALUE: Key and value.
-	Type *string `type:"string"`
+	Type *string `type:"string" enum:"KEY_ONLY,VALUE_ONLY,KEY_AND_VALUE"`
 
 	// The Amazon EC2 tag filter value.
 	Value *string `type:"string"`
@@ -1204,6 +1479,99 @@ type metadataEC2TagFilter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EC2TagFilter.
+func (s EC2TagFilter) String() string {
+	return awsutil.StringValue(s)
+}
+
+// EC2TagFilterType is an enum of the values of EC2TagFilterType members.
+type EC2TagFilterType string
+
+// The values of EC2TagFilterType.
+const (
+	EC2TagFilterTypeKEYONLY     EC2TagFilterType = "KEY_ONLY"
+	EC2TagFilterTypeVALUEONLY   EC2TagFilterType = "VALUE_ONLY"
+	EC2TagFilterTypeKEYANDVALUE EC2TagFilterType = "KEY_AND_VALUE"
+)
+
+// EC2TagFilterTypeValues returns the values of EC2TagFilterType known to this
+// version of the SDK. The service may accept values added since.
+func EC2TagFilterTypeValues() []EC2TagFilterType {
+	return []EC2TagFilterType{
+		EC2TagFilterType("KEY_ONLY"),
+		EC2TagFilterType("VALUE_ONLY"),
+		EC2TagFilterType("KEY_AND_VALUE"),
+	}
+}
+
+// String returns the EC2TagFilterType as the string of a member.
+func (v EC2TagFilterType) String() string {
+	return string(v)
+}
+
+// ParseEC2TagFilterType returns the EC2TagFilterType of str, failing if it is not
+// one of the EC2TagFilterTypeValues.
+func ParseEC2TagFilterType(str string) (EC2TagFilterType, error) {
+	for _, v := range EC2TagFilterTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid EC2TagFilterType value %q", str)
+}
+
+// ErrorCode is an enum of the values of ErrorCode members.
+type ErrorCode string
+
+// The values of ErrorCode.
+const (
+	ErrorCodeDEPLOYMENTGROUPMISSING   ErrorCode = "DEPLOYMENT_GROUP_MISSING"
+	ErrorCodeAPPLICATIONMISSING       ErrorCode = "APPLICATION_MISSING"
+	ErrorCodeREVISIONMISSING          ErrorCode = "REVISION_MISSING"
+	ErrorCodeIAMROLEMISSING           ErrorCode = "IAM_ROLE_MISSING"
+	ErrorCodeIAMROLEPERMISSIONS       ErrorCode = "IAM_ROLE_PERMISSIONS"
+	ErrorCodeOVERMAXINSTANCES         ErrorCode = "OVER_MAX_INSTANCES"
+	ErrorCodeNOINSTANCES              ErrorCode = "NO_INSTANCES"
+	ErrorCodeTIMEOUT                  ErrorCode = "TIMEOUT"
+	ErrorCodeHEALTHCONSTRAINTSINVALID ErrorCode = "HEALTH_CONSTRAINTS_INVALID"
+	ErrorCodeHEALTHCONSTRAINTS        ErrorCode = "HEALTH_CONSTRAINTS"
+	ErrorCodeINTERNALERROR            ErrorCode = "INTERNAL_ERROR"
+)
+
+// ErrorCodeValues returns the values of ErrorCode known to this
+// version of the SDK. The service may accept values added since.
+func ErrorCodeValues() []ErrorCode {
+	return []ErrorCode{
+		ErrorCode("DEPLOYMENT_GROUP_MISSING"),
+		ErrorCode("APPLICATION_MISSING"),
+		ErrorCode("REVISION_MISSING"),
+		ErrorCode("IAM_ROLE_MISSING"),
+		ErrorCode("IAM_ROLE_PERMISSIONS"),
+		ErrorCode("OVER_MAX_INSTANCES"),
+		ErrorCode("NO_INSTANCES"),
+		ErrorCode("TIMEOUT"),
+		ErrorCode("HEALTH_CONSTRAINTS_INVALID"),
+		ErrorCode("HEALTH_CONSTRAINTS"),
+		ErrorCode("INTERNAL_ERROR"),
+	}
+}
+
+// String returns the ErrorCode as the string of a member.
+func (v ErrorCode) String() string {
+	return string(v)
+}
+
+// ParseErrorCode returns the ErrorCode of str, failing if it is not
+// one of the ErrorCodeValues.
+func ParseErrorCode(str string) (ErrorCode, error) {
+	for _, v := range ErrorCodeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ErrorCode value %q", str)
+}
+
 // Information about a deployment error.
 type ErrorInformation struct {
 	// The error code:
@@ -1224,7 +1592,7 @@ type ErrorInformation struct {
 	// as specified. HEALTH_CONSTRAINTS: The deployment failed on too many instances
 	// to be able to successfully deploy under the specified instance health constraints.
 	// INTERNAL_ERROR: There was an internal error.
-	Code *string `locationName:"code" type:"string"`
+	Code *string `locationName:"code" type:"string" enum:"DEPLOYMENT_GROUP_MISSING,APPLICATION_MISSING,REVISION_MISSING,IAM_ROLE_MISSING,IAM_ROLE_PERMISSIONS,OVER_MAX_INSTANCES,NO_INSTANCES,TIMEOUT,HEALTH_CONSTRAINTS_INVALID,HEALTH_CONSTRAINTS,INTERNAL_ERROR"`
 
 	// An accompanying error message.
 	Message *string `locationName:"message" type:"string"`
@@ -1236,6 +1604,11 @@ type metadataErrorInformation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ErrorInformation.
+func (s ErrorInformation) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Information about an application revision.
 type GenericRevisionInfo struct {
 	// A list of deployment groups that use this revision.
@@ -1260,10 +1633,15 @@ type metadataGenericRevisionInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GenericRevisionInfo.
+func (s GenericRevisionInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a get application operation.
 type GetApplicationInput struct {
 	// The name of an existing AWS CodeDeploy application within the AWS user account.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataGetApplicationInput `json:"-", xml:"-"`
 }
@@ -1272,6 +1650,11 @@ type metadataGetApplicationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetApplicationInput.
+func (s GetApplicationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a get application operation.
 type GetApplicationOutput struct {
 	// Information about the application.
@@ -1284,10 +1667,15 @@ type metadataGetApplicationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetApplicationOutput.
+func (s GetApplicationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a get application revision operation.
 type GetApplicationRevisionInput struct {
 	// The name of the application that corresponds to the revision.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	// Information about the application revision to get, including the revision's
 	// type and its location.
@@ -1300,10 +1688,15 @@ type metadataGetApplicationRevisionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetApplicationRevisionInput.
+func (s GetApplicationRevisionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a get application revision operation.
 type GetApplicationRevisionOutput struct {
 	// The name of the application that corresponds to the revision.
-	ApplicationName *string `locationName:"applicationName" type:"string"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100"`
 
 	// Additional information about the revision, including the revision's type
 	// and its location.
@@ -1319,10 +1712,15 @@ type metadataGetApplicationRevisionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetApplicationRevisionOutput.
+func (s GetApplicationRevisionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a get deployment configuration operation.
 type GetDeploymentConfigInput struct {
 	// The name of an existing deployment configuration within the AWS user account.
-	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" required:"true"`
+	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataGetDeploymentConfigInput `json:"-", xml:"-"`
 }
@@ -1331,6 +1729,11 @@ type metadataGetDeploymentConfigInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetDeploymentConfigInput.
+func (s GetDeploymentConfigInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a get deployment configuration operation.
 type GetDeploymentConfigOutput struct {
 	// Information about the deployment configuration.
@@ -1343,13 +1746,18 @@ type metadataGetDeploymentConfigOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetDeploymentConfigOutput.
+func (s GetDeploymentConfigOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a get deployment group operation.
 type GetDeploymentGroupInput struct {
 	// The name of an existing AWS CodeDeploy application within the AWS user account.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	// The name of an existing deployment group for the specified application.
-	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string" required:"true"`
+	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string" min:"1" max:"100" required:"true"`
 
 	metadataGetDeploymentGroupInput `json:"-", xml:"-"`
 }
@@ -1358,6 +1766,11 @@ type metadataGetDeploymentGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetDeploymentGroupInput.
+func (s GetDeploymentGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a get deployment group operation.
 type GetDeploymentGroupOutput struct {
 	// Information about the deployment group.
@@ -1370,6 +1783,11 @@ type metadataGetDeploymentGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetDeploymentGroupOutput.
+func (s GetDeploymentGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a get deployment operation.
 type GetDeploymentInput struct {
 	// An existing deployment ID within the AWS user account.
@@ -1382,6 +1800,11 @@ type metadataGetDeploymentInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetDeploymentInput.
+func (s GetDeploymentInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a get deployment instance operation.
 type GetDeploymentInstanceInput struct {
 	// The unique ID of a deployment.
@@ -1397,6 +1820,11 @@ type metadataGetDeploymentInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetDeploymentInstanceInput.
+func (s GetDeploymentInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a get deployment instance operation.
 type GetDeploymentInstanceOutput struct {
 	// Information about the instance.
@@ -1409,6 +1837,11 @@ type metadataGetDeploymentInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetDeploymentInstanceOutput.
+func (s GetDeploymentInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a get deployment operation.
 type GetDeploymentOutput struct {
 	// Information about the deployment.
@@ -1421,6 +1854,11 @@ type metadataGetDeploymentOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetDeploymentOutput.
+func (s GetDeploymentOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Information about the location of application artifacts that are stored in
 // GitHub.
 type GitHubLocation struct {
@@ -1441,6 +1879,53 @@ type metadataGitHubLocation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GitHubLocation.
+func (s GitHubLocation) String() string {
+	return awsutil.StringValue(s)
+}
+
+// InstanceStatus is an enum of the values of InstanceStatus members.
+type InstanceStatus string
+
+// The values of InstanceStatus.
+const (
+	InstanceStatusPending    InstanceStatus = "Pending"
+	InstanceStatusInProgress InstanceStatus = "InProgress"
+	InstanceStatusSucceeded  InstanceStatus = "Succeeded"
+	InstanceStatusFailed     InstanceStatus = "Failed"
+	InstanceStatusSkipped    InstanceStatus = "Skipped"
+	InstanceStatusUnknown    InstanceStatus = "Unknown"
+)
+
+// InstanceStatusValues returns the values of InstanceStatus known to this
+// version of the SDK. The service may accept values added since.
+func InstanceStatusValues() []InstanceStatus {
+	return []InstanceStatus{
+		InstanceStatus("Pending"),
+		InstanceStatus("InProgress"),
+		InstanceStatus("Succeeded"),
+		InstanceStatus("Failed"),
+		InstanceStatus("Skipped"),
+		InstanceStatus("Unknown"),
+	}
+}
+
+// String returns the InstanceStatus as the string of a member.
+func (v InstanceStatus) String() string {
+	return string(v)
+}
+
+// ParseInstanceStatus returns the InstanceStatus of str, failing if it is not
+// one of the InstanceStatusValues.
+func ParseInstanceStatus(str string) (InstanceStatus, error) {
+	for _, v := range InstanceStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid InstanceStatus value %q", str)
+}
+
 // Information about an Amazon EC2 instance in a deployment.
 type InstanceSummary struct {
 	// The deployment ID.
@@ -1462,7 +1947,7 @@ type InstanceSummary struct {
 	// succeeded for this instance. Failed: The deployment has failed for this instance.
 	// Skipped: The deployment has been skipped for this instance. Unknown: The
 	// deployment status is unknown for this instance.
-	Status *string `locationName:"status" type:"string"`
+	Status *string `locationName:"status" type:"string" enum:"Pending,InProgress,Succeeded,Failed,Skipped,Unknown"`
 
 	metadataInstanceSummary `json:"-", xml:"-"`
 }
@@ -1471,6 +1956,53 @@ type metadataInstanceSummary struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InstanceSummary.
+func (s InstanceSummary) String() string {
+	return awsutil.StringValue(s)
+}
+
+// LifecycleErrorCode is an enum of the values of LifecycleErrorCode members.
+type LifecycleErrorCode string
+
+// The values of LifecycleErrorCode.
+const (
+	LifecycleErrorCodeSuccess             LifecycleErrorCode = "Success"
+	LifecycleErrorCodeScriptMissing       LifecycleErrorCode = "ScriptMissing"
+	LifecycleErrorCodeScriptNotExecutable LifecycleErrorCode = "ScriptNotExecutable"
+	LifecycleErrorCodeScriptTimedOut      LifecycleErrorCode = "ScriptTimedOut"
+	LifecycleErrorCodeScriptFailed        LifecycleErrorCode = "ScriptFailed"
+	LifecycleErrorCodeUnknownError        LifecycleErrorCode = "UnknownError"
+)
+
+// LifecycleErrorCodeValues returns the values of LifecycleErrorCode known to this
+// version of the SDK. The service may accept values added since.
+func LifecycleErrorCodeValues() []LifecycleErrorCode {
+	return []LifecycleErrorCode{
+		LifecycleErrorCode("Success"),
+		LifecycleErrorCode("ScriptMissing"),
+		LifecycleErrorCode("ScriptNotExecutable"),
+		LifecycleErrorCode("ScriptTimedOut"),
+		LifecycleErrorCode("ScriptFailed"),
+		LifecycleErrorCode("UnknownError"),
+	}
+}
+
+// String returns the LifecycleErrorCode as the string of a member.
+func (v LifecycleErrorCode) String() string {
+	return string(v)
+}
+
+// ParseLifecycleErrorCode returns the LifecycleErrorCode of str, failing if it is not
+// one of the LifecycleErrorCodeValues.
+func ParseLifecycleErrorCode(str string) (LifecycleErrorCode, error) {
+	for _, v := range LifecycleErrorCodeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid LifecycleErrorCode value %q", str)
+}
+
 // Information about a deployment lifecycle event.
 type LifecycleEvent struct {
 	// Diagnostic information about the deployment lifecycle event.
@@ -1493,7 +2025,7 @@ type LifecycleEvent struct {
 	// has succeeded. Failed: The deployment lifecycle event has failed. Skipped:
 	// The deployment lifecycle event has been skipped. Unknown: The deployment
 	// lifecycle event is unknown.
-	Status *string `locationName:"status" type:"string"`
+	Status *string `locationName:"status" type:"string" enum:"Pending,InProgress,Succeeded,Failed,Skipped,Unknown"`
 
 	metadataLifecycleEvent `json:"-", xml:"-"`
 }
@@ -1502,10 +2034,57 @@ type metadataLifecycleEvent struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the LifecycleEvent.
+func (s LifecycleEvent) String() string {
+	return awsutil.StringValue(s)
+}
+
+// LifecycleEventStatus is an enum of the values of LifecycleEventStatus members.
+type LifecycleEventStatus string
+
+// The values of LifecycleEventStatus.
+const (
+	LifecycleEventStatusPending    LifecycleEventStatus = "Pending"
+	LifecycleEventStatusInProgress LifecycleEventStatus = "InProgress"
+	LifecycleEventStatusSucceeded  LifecycleEventStatus = "Succeeded"
+	LifecycleEventStatusFailed     LifecycleEventStatus = "Failed"
+	LifecycleEventStatusSkipped    LifecycleEventStatus = "Skipped"
+	LifecycleEventStatusUnknown    LifecycleEventStatus = "Unknown"
+)
+
+// LifecycleEventStatusValues returns the values of LifecycleEventStatus known to this
+// version of the SDK. The service may accept values added since.
+func LifecycleEventStatusValues() []LifecycleEventStatus {
+	return []LifecycleEventStatus{
+		LifecycleEventStatus("Pending"),
+		LifecycleEventStatus("InProgress"),
+		LifecycleEventStatus("Succeeded"),
+		LifecycleEventStatus("Failed"),
+		LifecycleEventStatus("Skipped"),
+		LifecycleEventStatus("Unknown"),
+	}
+}
+
+// String returns the LifecycleEventStatus as the string of a member.
+func (v LifecycleEventStatus) String() string {
+	return string(v)
+}
+
+// ParseLifecycleEventStatus returns the LifecycleEventStatus of str, failing if it is not
+// one of the LifecycleEventStatusValues.
+func ParseLifecycleEventStatus(str string) (LifecycleEventStatus, error) {
+	for _, v := range LifecycleEventStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid LifecycleEventStatus value %q", str)
+}
+
 // Represents the input of a list application revisions operation.
 type ListApplicationRevisionsInput struct {
 	// The name of an existing AWS CodeDeploy application within the AWS user account.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	// Whether to list revisions based on whether the revision is the target revision
 	// of an deployment group:
@@ -1514,7 +2093,7 @@ type ListApplicationRevisionsInput struct {
 	// exclude: Do not list revisions that are target revisions of a deployment
 	// group. ignore: List all revisions, regardless of whether they are target
 	// revisions of a deployment group.
-	Deployed *string `locationName:"deployed" type:"string"`
+	Deployed *string `locationName:"deployed" type:"string" enum:"include,exclude,ignore"`
 
 	// An identifier that was returned from the previous list application revisions
 	// call, which can be used to return the next set of applications in the list.
@@ -1536,7 +2115,7 @@ type ListApplicationRevisionsInput struct {
 	// were first used by in a deployment. lastUsedTime: Sort the list results by
 	// when the revisions were last used in a deployment.  If not specified or set
 	// to null, the results will be returned in an arbitrary order.
-	SortBy *string `locationName:"sortBy" type:"string"`
+	SortBy *string `locationName:"sortBy" type:"string" enum:"registerTime,firstUsedTime,lastUsedTime"`
 
 	// The order to sort the list results by:
 	//
@@ -1545,7 +2124,7 @@ type ListApplicationRevisionsInput struct {
 	// sorted in ascending order.
 	//
 	// If set to null, the results will be sorted in an arbitrary order.
-	SortOrder *string `locationName:"sortOrder" type:"string"`
+	SortOrder *string `locationName:"sortOrder" type:"string" enum:"ascending,descending"`
 
 	metadataListApplicationRevisionsInput `json:"-", xml:"-"`
 }
@@ -1554,6 +2133,11 @@ type metadataListApplicationRevisionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListApplicationRevisionsInput.
+func (s ListApplicationRevisionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a list application revisions operation.
 type ListApplicationRevisionsOutput struct {
 	// If the amount of information that is returned is significantly large, an
@@ -1572,6 +2156,11 @@ type metadataListApplicationRevisionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListApplicationRevisionsOutput.
+func (s ListApplicationRevisionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a list applications operation.
 type ListApplicationsInput struct {
 	// An identifier that was returned from the previous list applications call,
@@ -1585,6 +2174,11 @@ type metadataListApplicationsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListApplicationsInput.
+func (s ListApplicationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a list applications operation.
 type ListApplicationsOutput struct {
 	// A list of application names.
@@ -1602,6 +2196,11 @@ type metadataListApplicationsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListApplicationsOutput.
+func (s ListApplicationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a list deployment configurations operation.
 type ListDeploymentConfigsInput struct {
 	// An identifier that was returned from the previous list deployment configurations
@@ -1616,6 +2215,11 @@ type metadataListDeploymentConfigsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListDeploymentConfigsInput.
+func (s ListDeploymentConfigsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a list deployment configurations operation.
 type ListDeploymentConfigsOutput struct {
 	// A list of deployment configurations, including the built-in configurations
@@ -1635,10 +2239,15 @@ type metadataListDeploymentConfigsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListDeploymentConfigsOutput.
+func (s ListDeploymentConfigsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a list deployment groups operation.
 type ListDeploymentGroupsInput struct {
 	// The name of an existing AWS CodeDeploy application within the AWS user account.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	// An identifier that was returned from the previous list deployment groups
 	// call, which can be used to return the next set of deployment groups in the
@@ -1652,10 +2261,15 @@ type metadataListDeploymentGroupsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListDeploymentGroupsInput.
+func (s ListDeploymentGroupsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a list deployment groups operation.
 type ListDeploymentGroupsOutput struct {
 	// The application name.
-	ApplicationName *string `locationName:"applicationName" type:"string"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100"`
 
 	// A list of corresponding deployment group names.
 	DeploymentGroups []*string `locationName:"deploymentGroups" type:"list"`
@@ -1673,6 +2287,11 @@ type metadataListDeploymentGroupsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListDeploymentGroupsOutput.
+func (s ListDeploymentGroupsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a list deployment instances operation.
 type ListDeploymentInstancesInput struct {
 	// The unique ID of a deployment.
@@ -1701,6 +2320,11 @@ type metadataListDeploymentInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListDeploymentInstancesInput.
+func (s ListDeploymentInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a list deployment instances operation.
 type ListDeploymentInstancesOutput struct {
 	// A list of instance IDs.
@@ -1719,17 +2343,22 @@ type metadataListDeploymentInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListDeploymentInstancesOutput.
+func (s ListDeploymentInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a list deployments operation.
 type ListDeploymentsInput struct {
 	// The name of an existing AWS CodeDeploy application within the AWS user account.
-	ApplicationName *string `locationName:"applicationName" type:"string"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100"`
 
 	// A deployment creation start- and end-time range for returning a subset of
 	// the list of deployments.
 	CreateTimeRange *TimeRange `locationName:"createTimeRange" type:"structure"`
 
 	// The name of an existing deployment group for the specified application.
-	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string"`
+	DeploymentGroupName *string `locationName:"deploymentGroupName" type:"string" min:"1" max:"100"`
 
 	// A subset of deployments to list, by status:  Created: Include in the resulting
 	// list created deployments. Queued: Include in the resulting list queued deployments.
@@ -1750,6 +2379,11 @@ type metadataListDeploymentsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListDeploymentsInput.
+func (s ListDeploymentsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a list deployments operation.
 type ListDeploymentsOutput struct {
 	// A list of deployment IDs.
@@ -1767,6 +2401,47 @@ type metadataListDeploymentsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListDeploymentsOutput.
+func (s ListDeploymentsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ListStateFilterAction is an enum of the values of ListStateFilterAction members.
+type ListStateFilterAction string
+
+// The values of ListStateFilterAction.
+const (
+	ListStateFilterActionInclude ListStateFilterAction = "include"
+	ListStateFilterActionExclude ListStateFilterAction = "exclude"
+	ListStateFilterActionIgnore  ListStateFilterAction = "ignore"
+)
+
+// ListStateFilterActionValues returns the values of ListStateFilterAction known to this
+// version of the SDK. The service may accept values added since.
+func ListStateFilterActionValues() []ListStateFilterAction {
+	return []ListStateFilterAction{
+		ListStateFilterAction("include"),
+		ListStateFilterAction("exclude"),
+		ListStateFilterAction("ignore"),
+	}
+}
+
+// String returns the ListStateFilterAction as the string of a member.
+func (v ListStateFilterAction) String() string {
+	return string(v)
+}
+
+// ParseListStateFilterAction returns the ListStateFilterAction of str, failing if it is not
+// one of the ListStateFilterActionValues.
+func ParseListStateFilterAction(str string) (ListStateFilterAction, error) {
+	for _, v := range ListStateFilterActionValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ListStateFilterAction value %q", str)
+}
+
 // Information about minimum healthy instances.
 type MinimumHealthyHosts struct {
 	// The minimum healthy instances type:
@@ -1785,7 +2460,7 @@ type MinimumHealthyHosts struct {
 	// will return a minimum healthy instances type of MOST_CONCURRENCY and a value
 	// of 1. This means a deployment to only one Amazon EC2 instance at a time.
 	// (You cannot set the type to MOST_CONCURRENCY, only to HOST_COUNT or FLEET_PERCENT.)
-	Type *string `locationName:"type" type:"string"`
+	Type *string `locationName:"type" type:"string" enum:"HOST_COUNT,FLEET_PERCENT"`
 
 	// The minimum healthy instances value.
 	Value *int64 `locationName:"value" type:"integer"`
@@ -1797,10 +2472,49 @@ type metadataMinimumHealthyHosts struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the MinimumHealthyHosts.
+func (s MinimumHealthyHosts) String() string {
+	return awsutil.StringValue(s)
+}
+
+// MinimumHealthyHostsType is an enum of the values of MinimumHealthyHostsType members.
+type MinimumHealthyHostsType string
+
+// The values of MinimumHealthyHostsType.
+const (
+	MinimumHealthyHostsTypeHOSTCOUNT    MinimumHealthyHostsType = "HOST_COUNT"
+	MinimumHealthyHostsTypeFLEETPERCENT MinimumHealthyHostsType = "FLEET_PERCENT"
+)
+
+// MinimumHealthyHostsTypeValues returns the values of MinimumHealthyHostsType known to this
+// version of the SDK. The service may accept values added since.
+func MinimumHealthyHostsTypeValues() []MinimumHealthyHostsType {
+	return []MinimumHealthyHostsType{
+		MinimumHealthyHostsType("HOST_COUNT"),
+		MinimumHealthyHostsType("FLEET_PERCENT"),
+	}
+}
+
+// String returns the MinimumHealthyHostsType as the string of a member.
+func (v MinimumHealthyHostsType) String() string {
+	return string(v)
+}
+
+// ParseMinimumHealthyHostsType returns the MinimumHealthyHostsType of str, failing if it is not
+// one of the MinimumHealthyHostsTypeValues.
+func ParseMinimumHealthyHostsType(str string) (MinimumHealthyHostsType, error) {
+	for _, v := range MinimumHealthyHostsTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid MinimumHealthyHostsType value %q", str)
+}
+
 // Represents the input of a register application revision operation.
 type RegisterApplicationRevisionInput struct {
 	// The name of an existing AWS CodeDeploy application within the AWS user account.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	// A comment about the revision.
 	Description *string `locationName:"description" type:"string"`
@@ -1816,6 +2530,11 @@ type metadataRegisterApplicationRevisionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RegisterApplicationRevisionInput.
+func (s RegisterApplicationRevisionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RegisterApplicationRevisionOutput struct {
 	metadataRegisterApplicationRevisionOutput `json:"-", xml:"-"`
 }
@@ -1824,6 +2543,11 @@ type metadataRegisterApplicationRevisionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RegisterApplicationRevisionOutput.
+func (s RegisterApplicationRevisionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Information about an application revision's location.
 type RevisionLocation struct {
 	// Information about the location of application artifacts that are stored in
@@ -1834,7 +2558,7 @@ type RevisionLocation struct {
 	//
 	//  S3: An application revision stored in Amazon S3. GitHub: An application
 	// revision stored in GitHub.
-	RevisionType *string `locationName:"revisionType" type:"string"`
+	RevisionType *string `locationName:"revisionType" type:"string" enum:"S3,GitHub"`
 
 	// Information about the location of application artifacts that are stored in
 	// Amazon S3.
@@ -1847,6 +2571,45 @@ type metadataRevisionLocation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RevisionLocation.
+func (s RevisionLocation) String() string {
+	return awsutil.StringValue(s)
+}
+
+// RevisionLocationType is an enum of the values of RevisionLocationType members.
+type RevisionLocationType string
+
+// The values of RevisionLocationType.
+const (
+	RevisionLocationTypeS3     RevisionLocationType = "S3"
+	RevisionLocationTypeGitHub RevisionLocationType = "GitHub"
+)
+
+// RevisionLocationTypeValues returns the values of RevisionLocationType known to this
+// version of the SDK. The service may accept values added since.
+func RevisionLocationTypeValues() []RevisionLocationType {
+	return []RevisionLocationType{
+		RevisionLocationType("S3"),
+		RevisionLocationType("GitHub"),
+	}
+}
+
+// String returns the RevisionLocationType as the string of a member.
+func (v RevisionLocationType) String() string {
+	return string(v)
+}
+
+// ParseRevisionLocationType returns the RevisionLocationType of str, failing if it is not
+// one of the RevisionLocationTypeValues.
+func ParseRevisionLocationType(str string) (RevisionLocationType, error) {
+	for _, v := range RevisionLocationTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid RevisionLocationType value %q", str)
+}
+
 // Information about the location of application artifacts that are stored in
 // Amazon S3.
 type S3Location struct {
@@ -1857,7 +2620,7 @@ type S3Location struct {
 	//
 	//  tar: A tar archive file. tgz: A compressed tar archive file. zip: A zip
 	// archive file.
-	BundleType *string `locationName:"bundleType" type:"string"`
+	BundleType *string `locationName:"bundleType" type:"string" enum:"tar,tgz,zip"`
 
 	// The ETag of the Amazon S3 object that represents the bundled artifacts for
 	// the application revision.
@@ -1884,6 +2647,45 @@ type metadataS3Location struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the S3Location.
+func (s S3Location) String() string {
+	return awsutil.StringValue(s)
+}
+
+// SortOrder is an enum of the values of SortOrder members.
+type SortOrder string
+
+// The values of SortOrder.
+const (
+	SortOrderAscending  SortOrder = "ascending"
+	SortOrderDescending SortOrder = "descending"
+)
+
+// SortOrderValues returns the values of SortOrder known to this
+// version of the SDK. The service may accept values added since.
+func SortOrderValues() []SortOrder {
+	return []SortOrder{
+		SortOrder("ascending"),
+		SortOrder("descending"),
+	}
+}
+
+// String returns the SortOrder as the string of a member.
+func (v SortOrder) String() string {
+	return string(v)
+}
+
+// ParseSortOrder returns the SortOrder of str, failing if it is not
+// one of the SortOrderValues.
+func ParseSortOrder(str string) (SortOrder, error) {
+	for _, v := range SortOrderValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid SortOrder value %q", str)
+}
+
 // Represents the input of a stop deployment operation.
 type StopDeploymentInput struct {
 	// The unique ID of a deployment.
@@ -1896,12 +2698,17 @@ type metadataStopDeploymentInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StopDeploymentInput.
+func (s StopDeploymentInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a stop deployment operation.
 type StopDeploymentOutput struct {
 	// The status of the stop deployment operation:
 	//
 	//  Pending: The stop operation is pending. Succeeded: The stop operation succeeded.
-	Status *string `locationName:"status" type:"string"`
+	Status *string `locationName:"status" type:"string" enum:"Pending,Succeeded"`
 
 	// An accompanying status message.
 	StatusMessage *string `locationName:"statusMessage" type:"string"`
@@ -1913,6 +2720,45 @@ type metadataStopDeploymentOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StopDeploymentOutput.
+func (s StopDeploymentOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// StopStatus is an enum of the values of StopStatus members.
+type StopStatus string
+
+// The values of StopStatus.
+const (
+	StopStatusPending   StopStatus = "Pending"
+	StopStatusSucceeded StopStatus = "Succeeded"
+)
+
+// StopStatusValues returns the values of StopStatus known to this
+// version of the SDK. The service may accept values added since.
+func StopStatusValues() []StopStatus {
+	return []StopStatus{
+		StopStatus("Pending"),
+		StopStatus("Succeeded"),
+	}
+}
+
+// String returns the StopStatus as the string of a member.
+func (v StopStatus) String() string {
+	return string(v)
+}
+
+// ParseStopStatus returns the StopStatus of str, failing if it is not
+// one of the StopStatusValues.
+func ParseStopStatus(str string) (StopStatus, error) {
+	for _, v := range StopStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid StopStatus value %q", str)
+}
+
 // Information about a time range.
 type TimeRange struct {
 	// The time range's end time.
@@ -1932,13 +2778,18 @@ type metadataTimeRange struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TimeRange.
+func (s TimeRange) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of an update application operation.
 type UpdateApplicationInput struct {
 	// The current name of the application that you want to change.
-	ApplicationName *string `locationName:"applicationName" type:"string"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100"`
 
 	// The new name that you want to change the application to.
-	NewApplicationName *string `locationName:"newApplicationName" type:"string"`
+	NewApplicationName *string `locationName:"newApplicationName" type:"string" min:"1" max:"100"`
 
 	metadataUpdateApplicationInput `json:"-", xml:"-"`
 }
@@ -1947,6 +2798,11 @@ type metadataUpdateApplicationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateApplicationInput.
+func (s UpdateApplicationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateApplicationOutput struct {
 	metadataUpdateApplicationOutput `json:"-", xml:"-"`
 }
@@ -1955,28 +2811,33 @@ type metadataUpdateApplicationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateApplicationOutput.
+func (s UpdateApplicationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of an update deployment group operation.
 type UpdateDeploymentGroupInput struct {
 	// The application name corresponding to the deployment group to update.
-	ApplicationName *string `locationName:"applicationName" type:"string" required:"true"`
+	ApplicationName *string `locationName:"applicationName" type:"string" min:"1" max:"100" required:"true"`
 
 	// The replacement list of Auto Scaling groups to be included in the deployment
 	// group, if you want to change them.
 	AutoScalingGroups []*string `locationName:"autoScalingGroups" type:"list"`
 
 	// The current name of the existing deployment group.
-	CurrentDeploymentGroupName *string `locationName:"currentDeploymentGroupName" type:"string" required:"true"`
+	CurrentDeploymentGroupName *string `locationName:"currentDeploymentGroupName" type:"string" min:"1" max:"100" required:"true"`
 
 	// The replacement deployment configuration name to use, if you want to change
 	// it.
-	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string"`
+	DeploymentConfigName *string `locationName:"deploymentConfigName" type:"string" min:"1" max:"100"`
 
 	// The replacement set of Amazon EC2 tags to filter on, if you want to change
 	// them.
 	EC2TagFilters []*EC2TagFilter `locationName:"ec2TagFilters" type:"list"`
 
 	// The new name of the deployment group, if you want to change it.
-	NewDeploymentGroupName *string `locationName:"newDeploymentGroupName" type:"string"`
+	NewDeploymentGroupName *string `locationName:"newDeploymentGroupName" type:"string" min:"1" max:"100"`
 
 	// A replacement service role's ARN, if you want to change it.
 	ServiceRoleARN *string `locationName:"serviceRoleArn" type:"string"`
@@ -1988,6 +2849,11 @@ type metadataUpdateDeploymentGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateDeploymentGroupInput.
+func (s UpdateDeploymentGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of an update deployment group operation.
 type UpdateDeploymentGroupOutput struct {
 	// If the output contains no data, and the corresponding deployment group contained
@@ -2002,4 +2868,9 @@ type UpdateDeploymentGroupOutput struct {
 
 type metadataUpdateDeploymentGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the UpdateDeploymentGroupOutput.
+func (s UpdateDeploymentGroupOutput) String() string {
+	return awsutil.StringValue(s)
 }
\ No newline at end of file
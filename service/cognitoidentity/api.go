@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // CreateIdentityPoolRequest generates a request for the CreateIdentityPool operation.
@@ -508,16 +509,16 @@ type CreateIdentityPoolInput struct {
 	//
 	// Once you have set a developer provider name, you cannot change it. Please
 	// take care in setting this parameter.
-	DeveloperProviderName *string `type:"string"`
+	DeveloperProviderName *string `type:"string" min:"1" max:"128" pattern:"[\\w._-]+"`
 
 	// A string that you provide.
-	IdentityPoolName *string `type:"string" required:"true"`
+	IdentityPoolName *string `type:"string" min:"1" max:"128" pattern:"[\\w ]+" required:"true"`
 
 	// A list of OpendID Connect provider ARNs.
 	OpenIDConnectProviderARNs []*string `locationName:"OpenIdConnectProviderARNs" type:"list"`
 
 	// Optional key:value pairs mapping provider names to provider app IDs.
-	SupportedLoginProviders *map[string]*string `type:"map"`
+	SupportedLoginProviders *map[string]*string `type:"map" max:"10"`
 
 	metadataCreateIdentityPoolInput `json:"-", xml:"-"`
 }
@@ -526,6 +527,11 @@ type metadataCreateIdentityPoolInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateIdentityPoolInput.
+func (s CreateIdentityPoolInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Credentials for the the provided identity ID.
 type Credentials struct {
 	// The Access Key portion of the credentials.
@@ -547,10 +553,15 @@ type metadataCredentials struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Credentials.
+func (s Credentials) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input to the DeleteIdentityPool action.
 type DeleteIdentityPoolInput struct {
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataDeleteIdentityPoolInput `json:"-", xml:"-"`
 }
@@ -559,6 +570,11 @@ type metadataDeleteIdentityPoolInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteIdentityPoolInput.
+func (s DeleteIdentityPoolInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteIdentityPoolOutput struct {
 	metadataDeleteIdentityPoolOutput `json:"-", xml:"-"`
 }
@@ -567,10 +583,15 @@ type metadataDeleteIdentityPoolOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteIdentityPoolOutput.
+func (s DeleteIdentityPoolOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input to the DescribeIdentity action.
 type DescribeIdentityInput struct {
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataDescribeIdentityInput `json:"-", xml:"-"`
 }
@@ -579,10 +600,15 @@ type metadataDescribeIdentityInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeIdentityInput.
+func (s DescribeIdentityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input to the DescribeIdentityPool action.
 type DescribeIdentityPoolInput struct {
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataDescribeIdentityPoolInput `json:"-", xml:"-"`
 }
@@ -591,13 +617,18 @@ type metadataDescribeIdentityPoolInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeIdentityPoolInput.
+func (s DescribeIdentityPoolInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input to the GetCredentialsForIdentity action.
 type GetCredentialsForIdentityInput struct {
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A set of optional name-value pairs that map provider names to provider tokens.
-	Logins *map[string]*string `type:"map"`
+	Logins *map[string]*string `type:"map" max:"10"`
 
 	metadataGetCredentialsForIdentityInput `json:"-", xml:"-"`
 }
@@ -606,13 +637,18 @@ type metadataGetCredentialsForIdentityInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetCredentialsForIdentityInput.
+func (s GetCredentialsForIdentityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Returned in response to a successful GetCredentialsForIdentity operation.
 type GetCredentialsForIdentityOutput struct {
 	// Credentials for the the provided identity ID.
 	Credentials *Credentials `type:"structure"`
 
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	metadataGetCredentialsForIdentityOutput `json:"-", xml:"-"`
 }
@@ -621,19 +657,24 @@ type metadataGetCredentialsForIdentityOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetCredentialsForIdentityOutput.
+func (s GetCredentialsForIdentityOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input to the GetId action.
 type GetIDInput struct {
 	// A standard AWS account ID (9+ digits).
-	AccountID *string `locationName:"AccountId" type:"string"`
+	AccountID *string `locationName:"AccountId" type:"string" min:"1" max:"15" pattern:"\\d+"`
 
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A set of optional name-value pairs that map provider names to provider tokens.
 	//
 	// The available provider names for Logins are as follows:  Facebook: graph.facebook.com
 	//  Google: accounts.google.com  Amazon: www.amazon.com
-	Logins *map[string]*string `type:"map"`
+	Logins *map[string]*string `type:"map" max:"10"`
 
 	metadataGetIDInput `json:"-", xml:"-"`
 }
@@ -642,10 +683,15 @@ type metadataGetIDInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetIDInput.
+func (s GetIDInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Returned in response to a GetId request.
 type GetIDOutput struct {
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	metadataGetIDOutput `json:"-", xml:"-"`
 }
@@ -654,10 +700,15 @@ type metadataGetIDOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetIDOutput.
+func (s GetIDOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input to the GetIdentityPoolRoles action.
 type GetIdentityPoolRolesInput struct {
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	metadataGetIdentityPoolRolesInput `json:"-", xml:"-"`
 }
@@ -666,14 +717,19 @@ type metadataGetIdentityPoolRolesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetIdentityPoolRolesInput.
+func (s GetIdentityPoolRolesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Returned in response to a successful GetIdentityPoolRoles operation.
 type GetIdentityPoolRolesOutput struct {
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// The map of roles associated with this pool. Currently only authenticated
 	// and unauthenticated roles are supported.
-	Roles *map[string]*string `type:"map"`
+	Roles *map[string]*string `type:"map" max:"2"`
 
 	metadataGetIdentityPoolRolesOutput `json:"-", xml:"-"`
 }
@@ -682,13 +738,18 @@ type metadataGetIdentityPoolRolesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetIdentityPoolRolesOutput.
+func (s GetIdentityPoolRolesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input to the GetOpenIdTokenForDeveloperIdentity action.
 type GetOpenIDTokenForDeveloperIdentityInput struct {
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A set of optional name-value pairs that map provider names to provider tokens.
 	// Each name-value pair represents a user from a public provider or developer
@@ -699,7 +760,7 @@ type GetOpenIDTokenForDeveloperIdentityInput struct {
 	// The developer user identifier is an identifier from your backend that uniquely
 	// identifies a user. When you create an identity pool, you can specify the
 	// supported logins.
-	Logins *map[string]*string `type:"map" required:"true"`
+	Logins *map[string]*string `type:"map" max:"10" required:"true"`
 
 	// The expiration time of the token, in seconds. You can specify a custom expiration
 	// time for the token so that you can cache it. If you don't provide an expiration
@@ -709,7 +770,7 @@ type GetOpenIDTokenForDeveloperIdentityInput struct {
 	// take care in setting the expiration time for a token, as there are significant
 	// security implications: an attacker could use a leaked token to access your
 	// AWS resources for the token's duration.
-	TokenDuration *int64 `type:"long"`
+	TokenDuration *int64 `type:"long" min:"1" max:"86400"`
 
 	metadataGetOpenIDTokenForDeveloperIdentityInput `json:"-", xml:"-"`
 }
@@ -718,10 +779,15 @@ type metadataGetOpenIDTokenForDeveloperIdentityInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetOpenIDTokenForDeveloperIdentityInput.
+func (s GetOpenIDTokenForDeveloperIdentityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Returned in response to a successful GetOpenIdTokenForDeveloperIdentity request.
 type GetOpenIDTokenForDeveloperIdentityOutput struct {
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// An OpenID token.
 	Token *string `type:"string"`
@@ -733,13 +799,18 @@ type metadataGetOpenIDTokenForDeveloperIdentityOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetOpenIDTokenForDeveloperIdentityOutput.
+func (s GetOpenIDTokenForDeveloperIdentityOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input to the GetOpenIdToken action.
 type GetOpenIDTokenInput struct {
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A set of optional name-value pairs that map provider names to provider tokens.
-	Logins *map[string]*string `type:"map"`
+	Logins *map[string]*string `type:"map" max:"10"`
 
 	metadataGetOpenIDTokenInput `json:"-", xml:"-"`
 }
@@ -748,11 +819,16 @@ type metadataGetOpenIDTokenInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetOpenIDTokenInput.
+func (s GetOpenIDTokenInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Returned in response to a successful GetOpenIdToken request.
 type GetOpenIDTokenOutput struct {
 	// A unique identifier in the format REGION:GUID. Note that the IdentityId returned
 	// may not match the one passed on input.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// An OpenID token, valid for 15 minutes.
 	Token *string `type:"string"`
@@ -764,13 +840,18 @@ type metadataGetOpenIDTokenOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetOpenIDTokenOutput.
+func (s GetOpenIDTokenOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A description of the identity.
 type IdentityDescription struct {
 	// Date on which the identity was created.
 	CreationDate *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// Date on which the identity was last modified.
 	LastModifiedDate *time.Time `type:"timestamp" timestampFormat:"unix"`
@@ -785,25 +866,30 @@ type metadataIdentityDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the IdentityDescription.
+func (s IdentityDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
 // An object representing a Cognito identity pool.
 type IdentityPool struct {
 	// TRUE if the identity pool supports unauthenticated logins.
 	AllowUnauthenticatedIdentities *bool `type:"boolean" required:"true"`
 
 	// The "domain" by which Cognito will refer to your users.
-	DeveloperProviderName *string `type:"string"`
+	DeveloperProviderName *string `type:"string" min:"1" max:"128" pattern:"[\\w._-]+"`
 
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A string that you provide.
-	IdentityPoolName *string `type:"string" required:"true"`
+	IdentityPoolName *string `type:"string" min:"1" max:"128" pattern:"[\\w ]+" required:"true"`
 
 	// A list of OpendID Connect provider ARNs.
 	OpenIDConnectProviderARNs []*string `locationName:"OpenIdConnectProviderARNs" type:"list"`
 
 	// Optional key:value pairs mapping provider names to provider app IDs.
-	SupportedLoginProviders *map[string]*string `type:"map"`
+	SupportedLoginProviders *map[string]*string `type:"map" max:"10"`
 
 	metadataIdentityPool `json:"-", xml:"-"`
 }
@@ -812,13 +898,18 @@ type metadataIdentityPool struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the IdentityPool.
+func (s IdentityPool) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A description of the identity pool.
 type IdentityPoolShortDescription struct {
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// A string that you provide.
-	IdentityPoolName *string `type:"string"`
+	IdentityPoolName *string `type:"string" min:"1" max:"128" pattern:"[\\w ]+"`
 
 	metadataIdentityPoolShortDescription `json:"-", xml:"-"`
 }
@@ -827,16 +918,21 @@ type metadataIdentityPoolShortDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the IdentityPoolShortDescription.
+func (s IdentityPoolShortDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input to the ListIdentities action.
 type ListIdentitiesInput struct {
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// The maximum number of identities to return.
-	MaxResults *int64 `type:"integer" required:"true"`
+	MaxResults *int64 `type:"integer" min:"1" max:"60" required:"true"`
 
 	// A pagination token.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" pattern:"[\\S]+"`
 
 	metadataListIdentitiesInput `json:"-", xml:"-"`
 }
@@ -845,16 +941,21 @@ type metadataListIdentitiesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListIdentitiesInput.
+func (s ListIdentitiesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The response to a ListIdentities request.
 type ListIdentitiesOutput struct {
 	// An object containing a set of identities and associated mappings.
 	Identities []*IdentityDescription `type:"list"`
 
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// A pagination token.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" pattern:"[\\S]+"`
 
 	metadataListIdentitiesOutput `json:"-", xml:"-"`
 }
@@ -863,13 +964,18 @@ type metadataListIdentitiesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListIdentitiesOutput.
+func (s ListIdentitiesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input to the ListIdentityPools action.
 type ListIdentityPoolsInput struct {
 	// The maximum number of identities to return.
-	MaxResults *int64 `type:"integer" required:"true"`
+	MaxResults *int64 `type:"integer" min:"1" max:"60" required:"true"`
 
 	// A pagination token.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" pattern:"[\\S]+"`
 
 	metadataListIdentityPoolsInput `json:"-", xml:"-"`
 }
@@ -878,13 +984,18 @@ type metadataListIdentityPoolsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListIdentityPoolsInput.
+func (s ListIdentityPoolsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The result of a successful ListIdentityPools action.
 type ListIdentityPoolsOutput struct {
 	// The identity pools returned by the ListIdentityPools action.
 	IdentityPools []*IdentityPoolShortDescription `type:"list"`
 
 	// A pagination token.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" pattern:"[\\S]+"`
 
 	metadataListIdentityPoolsOutput `json:"-", xml:"-"`
 }
@@ -893,21 +1004,26 @@ type metadataListIdentityPoolsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListIdentityPoolsOutput.
+func (s ListIdentityPoolsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input to the LookupDeveloperIdentityInput action.
 type LookupDeveloperIdentityInput struct {
 	// A unique ID used by your backend authentication process to identify a user.
 	// Typically, a developer identity provider would issue many developer user
 	// identifiers, in keeping with the number of users.
-	DeveloperUserIdentifier *string `type:"string"`
+	DeveloperUserIdentifier *string `type:"string" min:"1" max:"1024" pattern:"[\\w.@_-]+"`
 
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// The maximum number of identities to return.
-	MaxResults *int64 `type:"integer"`
+	MaxResults *int64 `type:"integer" min:"1" max:"60"`
 
 	// A pagination token. The first call you make will have NextToken set to null.
 	// After that the service will return NextToken values as needed. For example,
@@ -915,7 +1031,7 @@ type LookupDeveloperIdentityInput struct {
 	// matches in the database. The service will return a pagination token as a
 	// part of the response. This token can be used to call the API again and get
 	// results starting from the 11th match.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" pattern:"[\\S]+"`
 
 	metadataLookupDeveloperIdentityInput `json:"-", xml:"-"`
 }
@@ -924,6 +1040,11 @@ type metadataLookupDeveloperIdentityInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the LookupDeveloperIdentityInput.
+func (s LookupDeveloperIdentityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Returned in response to a successful LookupDeveloperIdentity action.
 type LookupDeveloperIdentityOutput struct {
 	// This is the list of developer user identifiers associated with an identity
@@ -932,7 +1053,7 @@ type LookupDeveloperIdentityOutput struct {
 	DeveloperUserIdentifierList []*string `type:"list"`
 
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// A pagination token. The first call you make will have NextToken set to null.
 	// After that the service will return NextToken values as needed. For example,
@@ -940,7 +1061,7 @@ type LookupDeveloperIdentityOutput struct {
 	// matches in the database. The service will return a pagination token as a
 	// part of the response. This token can be used to call the API again and get
 	// results starting from the 11th match.
-	NextToken *string `type:"string"`
+	NextToken *string `type:"string" min:"1" pattern:"[\\S]+"`
 
 	metadataLookupDeveloperIdentityOutput `json:"-", xml:"-"`
 }
@@ -949,23 +1070,28 @@ type metadataLookupDeveloperIdentityOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the LookupDeveloperIdentityOutput.
+func (s LookupDeveloperIdentityOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input to the MergeDeveloperIdentities action.
 type MergeDeveloperIdentitiesInput struct {
 	// User identifier for the destination user. The value should be a DeveloperUserIdentifier.
-	DestinationUserIdentifier *string `type:"string" required:"true"`
+	DestinationUserIdentifier *string `type:"string" min:"1" max:"1024" pattern:"[\\w.@_-]+" required:"true"`
 
 	// The "domain" by which Cognito will refer to your users. This is a (pseudo)
 	// domain name that you provide while creating an identity pool. This name acts
 	// as a placeholder that allows your backend and the Cognito service to communicate
 	// about the developer provider. For the DeveloperProviderName, you can use
 	// letters as well as period (.), underscore (_), and dash (-).
-	DeveloperProviderName *string `type:"string" required:"true"`
+	DeveloperProviderName *string `type:"string" min:"1" max:"128" pattern:"[\\w._-]+" required:"true"`
 
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// User identifier for the source user. The value should be a DeveloperUserIdentifier.
-	SourceUserIdentifier *string `type:"string" required:"true"`
+	SourceUserIdentifier *string `type:"string" min:"1" max:"1024" pattern:"[\\w.@_-]+" required:"true"`
 
 	metadataMergeDeveloperIdentitiesInput `json:"-", xml:"-"`
 }
@@ -974,10 +1100,15 @@ type metadataMergeDeveloperIdentitiesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the MergeDeveloperIdentitiesInput.
+func (s MergeDeveloperIdentitiesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Returned in response to a successful MergeDeveloperIdentities action.
 type MergeDeveloperIdentitiesOutput struct {
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	metadataMergeDeveloperIdentitiesOutput `json:"-", xml:"-"`
 }
@@ -986,14 +1117,19 @@ type metadataMergeDeveloperIdentitiesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the MergeDeveloperIdentitiesOutput.
+func (s MergeDeveloperIdentitiesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input to the SetIdentityPoolRoles action.
 type SetIdentityPoolRolesInput struct {
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// The map of roles associated with this pool. Currently only authenticated
 	// and unauthenticated roles are supported.
-	Roles *map[string]*string `type:"map" required:"true"`
+	Roles *map[string]*string `type:"map" max:"2" required:"true"`
 
 	metadataSetIdentityPoolRolesInput `json:"-", xml:"-"`
 }
@@ -1002,6 +1138,11 @@ type metadataSetIdentityPoolRolesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetIdentityPoolRolesInput.
+func (s SetIdentityPoolRolesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SetIdentityPoolRolesOutput struct {
 	metadataSetIdentityPoolRolesOutput `json:"-", xml:"-"`
 }
@@ -1010,19 +1151,24 @@ type metadataSetIdentityPoolRolesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetIdentityPoolRolesOutput.
+func (s SetIdentityPoolRolesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input to the UnlinkDeveloperIdentity action.
 type UnlinkDeveloperIdentityInput struct {
 	// The "domain" by which Cognito will refer to your users.
-	DeveloperProviderName *string `type:"string" required:"true"`
+	DeveloperProviderName *string `type:"string" min:"1" max:"128" pattern:"[\\w._-]+" required:"true"`
 
 	// A unique ID used by your backend authentication process to identify a user.
-	DeveloperUserIdentifier *string `type:"string" required:"true"`
+	DeveloperUserIdentifier *string `type:"string" min:"1" max:"1024" pattern:"[\\w.@_-]+" required:"true"`
 
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// An identity pool ID in the format REGION:GUID.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataUnlinkDeveloperIdentityInput `json:"-", xml:"-"`
 }
@@ -1031,6 +1177,11 @@ type metadataUnlinkDeveloperIdentityInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UnlinkDeveloperIdentityInput.
+func (s UnlinkDeveloperIdentityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UnlinkDeveloperIdentityOutput struct {
 	metadataUnlinkDeveloperIdentityOutput `json:"-", xml:"-"`
 }
@@ -1039,13 +1190,18 @@ type metadataUnlinkDeveloperIdentityOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UnlinkDeveloperIdentityOutput.
+func (s UnlinkDeveloperIdentityOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input to the UnlinkIdentity action.
 type UnlinkIdentityInput struct {
 	// A unique identifier in the format REGION:GUID.
-	IdentityID *string `locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A set of optional name-value pairs that map provider names to provider tokens.
-	Logins *map[string]*string `type:"map" required:"true"`
+	Logins *map[string]*string `type:"map" max:"10" required:"true"`
 
 	// Provider names to unlink from this identity.
 	LoginsToRemove []*string `type:"list" required:"true"`
@@ -1057,10 +1213,20 @@ type metadataUnlinkIdentityInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UnlinkIdentityInput.
+func (s UnlinkIdentityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UnlinkIdentityOutput struct {
 	metadataUnlinkIdentityOutput `json:"-", xml:"-"`
 }
 
 type metadataUnlinkIdentityOutput struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the UnlinkIdentityOutput.
+func (s UnlinkIdentityOutput) String() string {
+	return awsutil.StringValue(s)
 }
\ No newline at end of file
@@ -4,9 +4,11 @@
 package cognitosync
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // BulkPublishRequest generates a request for the BulkPublish operation.
@@ -449,7 +451,7 @@ var opUpdateRecords *aws.Operation
 type BulkPublishInput struct {
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataBulkPublishInput `json:"-", xml:"-"`
 }
@@ -458,11 +460,16 @@ type metadataBulkPublishInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the BulkPublishInput.
+func (s BulkPublishInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the BulkPublish operation.
 type BulkPublishOutput struct {
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	metadataBulkPublishOutput `json:"-", xml:"-"`
 }
@@ -471,23 +478,66 @@ type metadataBulkPublishOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the BulkPublishOutput.
+func (s BulkPublishOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// BulkPublishStatus is an enum of the values of BulkPublishStatus members.
+type BulkPublishStatus string
+
+// The values of BulkPublishStatus.
+const (
+	BulkPublishStatusNOTSTARTED BulkPublishStatus = "NOT_STARTED"
+	BulkPublishStatusINPROGRESS BulkPublishStatus = "IN_PROGRESS"
+	BulkPublishStatusFAILED     BulkPublishStatus = "FAILED"
+	BulkPublishStatusSUCCEEDED  BulkPublishStatus = "SUCCEEDED"
+)
+
+// BulkPublishStatusValues returns the values of BulkPublishStatus known to this
+// version of the SDK. The service may accept values added since.
+func BulkPublishStatusValues() []BulkPublishStatus {
+	return []BulkPublishStatus{
+		BulkPublishStatus("NOT_STARTED"),
+		BulkPublishStatus("IN_PROGRESS"),
+		BulkPublishStatus("FAILED"),
+		BulkPublishStatus("SUCCEEDED"),
+	}
+}
+
+// String returns the BulkPublishStatus as the string of a member.
+func (v BulkPublishStatus) String() string {
+	return string(v)
+}
+
+// ParseBulkPublishStatus returns the BulkPublishStatus of str, failing if it is not
+// one of the BulkPublishStatusValues.
+func ParseBulkPublishStatus(str string) (BulkPublishStatus, error) {
+	for _, v := range BulkPublishStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid BulkPublishStatus value %q", str)
+}
+
 // Configuration options for configure Cognito streams.
 type CognitoStreams struct {
 	// The ARN of the role Amazon Cognito can assume in order to publish to the
 	// stream. This role must grant access to Amazon Cognito (cognito-sync) to invoke
 	// PutRecord on your Cognito stream.
-	RoleARN *string `locationName:"RoleArn" type:"string"`
+	RoleARN *string `locationName:"RoleArn" type:"string" min:"20" max:"2048" pattern:"arn:aws:iam::\\d+:role/.*"`
 
 	// The name of the Cognito stream to receive updates. This stream must be in
 	// the developers account and in the same region as the identity pool.
-	StreamName *string `type:"string"`
+	StreamName *string `type:"string" min:"1" max:"128"`
 
 	// Status of the Cognito streams. Valid values are: ENABLED - Streaming of updates
 	// to identity pool is enabled.
 	//
 	// DISABLEDStreaming of updates to identity pool is disabled. Bulk publish
 	// will also fail if StreamingStatus is DISABLED.
-	StreamingStatus *string `type:"string"`
+	StreamingStatus *string `type:"string" enum:"ENABLED,DISABLED"`
 
 	metadataCognitoStreams `json:"-", xml:"-"`
 }
@@ -496,6 +546,11 @@ type metadataCognitoStreams struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CognitoStreams.
+func (s CognitoStreams) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A collection of data for an identity pool. An identity pool can have multiple
 // datasets. A dataset is per identity and can be general or associated with
 // a particular entity in an application (like a saved game). Datasets are automatically
@@ -510,11 +565,11 @@ type Dataset struct {
 
 	// A string of up to 128 characters. Allowed characters are a-z, A-Z, 0-9, '_'
 	// (underscore), '-' (dash), and '.' (dot).
-	DatasetName *string `type:"string"`
+	DatasetName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.:-]+"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// The device that made the last change to this dataset.
 	LastModifiedBy *string `type:"string"`
@@ -532,19 +587,24 @@ type metadataDataset struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Dataset.
+func (s Dataset) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A request to delete the specific dataset.
 type DeleteDatasetInput struct {
 	// A string of up to 128 characters. Allowed characters are a-z, A-Z, 0-9, '_'
 	// (underscore), '-' (dash), and '.' (dot).
-	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" required:"true"`
+	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.:-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataDeleteDatasetInput `json:"-", xml:"-"`
 }
@@ -553,6 +613,11 @@ type metadataDeleteDatasetInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteDatasetInput.
+func (s DeleteDatasetInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Response to a successful DeleteDataset request.
 type DeleteDatasetOutput struct {
 	// A collection of data for an identity pool. An identity pool can have multiple
@@ -569,20 +634,25 @@ type metadataDeleteDatasetOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteDatasetOutput.
+func (s DeleteDatasetOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A request for meta data about a dataset (creation date, number of records,
 // size) by owner and dataset name.
 type DescribeDatasetInput struct {
 	// A string of up to 128 characters. Allowed characters are a-z, A-Z, 0-9, '_'
 	// (underscore), '-' (dash), and '.' (dot).
-	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" required:"true"`
+	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.:-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataDescribeDatasetInput `json:"-", xml:"-"`
 }
@@ -591,6 +661,11 @@ type metadataDescribeDatasetInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeDatasetInput.
+func (s DescribeDatasetInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Response to a successful DescribeDataset request.
 type DescribeDatasetOutput struct {
 	// Meta data for a collection of data for an identity. An identity can have
@@ -607,11 +682,16 @@ type metadataDescribeDatasetOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeDatasetOutput.
+func (s DescribeDatasetOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A request for usage information about the identity pool.
 type DescribeIdentityPoolUsageInput struct {
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataDescribeIdentityPoolUsageInput `json:"-", xml:"-"`
 }
@@ -620,6 +700,11 @@ type metadataDescribeIdentityPoolUsageInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeIdentityPoolUsageInput.
+func (s DescribeIdentityPoolUsageInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Response to a successful DescribeIdentityPoolUsage request.
 type DescribeIdentityPoolUsageOutput struct {
 	// Information about the usage of the identity pool.
@@ -632,15 +717,20 @@ type metadataDescribeIdentityPoolUsageOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeIdentityPoolUsageOutput.
+func (s DescribeIdentityPoolUsageOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A request for information about the usage of an identity pool.
 type DescribeIdentityUsageInput struct {
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataDescribeIdentityUsageInput `json:"-", xml:"-"`
 }
@@ -649,6 +739,11 @@ type metadataDescribeIdentityUsageInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeIdentityUsageInput.
+func (s DescribeIdentityUsageInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The response to a successful DescribeIdentityUsage request.
 type DescribeIdentityUsageOutput struct {
 	// Usage information for the identity.
@@ -661,11 +756,16 @@ type metadataDescribeIdentityUsageOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeIdentityUsageOutput.
+func (s DescribeIdentityUsageOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the GetBulkPublishDetails operation.
 type GetBulkPublishDetailsInput struct {
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataGetBulkPublishDetailsInput `json:"-", xml:"-"`
 }
@@ -674,6 +774,11 @@ type metadataGetBulkPublishDetailsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetBulkPublishDetailsInput.
+func (s GetBulkPublishDetailsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the GetBulkPublishDetails operation.
 type GetBulkPublishDetailsOutput struct {
 	// If BulkPublishStatus is SUCCEEDED, the time the last bulk publish operation
@@ -693,7 +798,7 @@ type GetBulkPublishDetailsOutput struct {
 	//
 	// FAILED - Some portion of the data has failed to publish, check FailureMessage
 	// for the cause.
-	BulkPublishStatus *string `type:"string"`
+	BulkPublishStatus *string `type:"string" enum:"NOT_STARTED,IN_PROGRESS,FAILED,SUCCEEDED"`
 
 	// If BulkPublishStatus is FAILED this field will contain the error message
 	// that caused the bulk publish to fail.
@@ -701,7 +806,7 @@ type GetBulkPublishDetailsOutput struct {
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	metadataGetBulkPublishDetailsOutput `json:"-", xml:"-"`
 }
@@ -710,12 +815,17 @@ type metadataGetBulkPublishDetailsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetBulkPublishDetailsOutput.
+func (s GetBulkPublishDetailsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the GetIdentityPoolConfiguration operation.
 type GetIdentityPoolConfigurationInput struct {
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. This is the ID of the pool for which to return
 	// a configuration.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataGetIdentityPoolConfigurationInput `json:"-", xml:"-"`
 }
@@ -724,6 +834,11 @@ type metadataGetIdentityPoolConfigurationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetIdentityPoolConfigurationInput.
+func (s GetIdentityPoolConfigurationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the GetIdentityPoolConfiguration operation.
 type GetIdentityPoolConfigurationOutput struct {
 	// Options to apply to this identity pool for Amazon Cognito streams.
@@ -731,7 +846,7 @@ type GetIdentityPoolConfigurationOutput struct {
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// Options to apply to this identity pool for push synchronization.
 	PushSync *PushSync `type:"structure"`
@@ -743,6 +858,11 @@ type metadataGetIdentityPoolConfigurationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetIdentityPoolConfigurationOutput.
+func (s GetIdentityPoolConfigurationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Usage information for the identity pool.
 type IdentityPoolUsage struct {
 	// Data storage information for the identity pool.
@@ -750,7 +870,7 @@ type IdentityPoolUsage struct {
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// Date on which the identity pool was last modified.
 	LastModifiedDate *time.Time `type:"timestamp" timestampFormat:"unix"`
@@ -765,6 +885,11 @@ type metadataIdentityPoolUsage struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the IdentityPoolUsage.
+func (s IdentityPoolUsage) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Usage information for the identity.
 type IdentityUsage struct {
 	// Total data storage for this identity.
@@ -775,11 +900,11 @@ type IdentityUsage struct {
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityID *string `locationName:"IdentityId" type:"string"`
+	IdentityID *string `locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// Date on which the identity was last modified.
 	LastModifiedDate *time.Time `type:"timestamp" timestampFormat:"unix"`
@@ -791,15 +916,20 @@ type metadataIdentityUsage struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the IdentityUsage.
+func (s IdentityUsage) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Request for a list of datasets for an identity.
 type ListDatasetsInput struct {
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// The maximum number of results to be returned.
 	MaxResults *int64 `location:"querystring" locationName:"maxResults" type:"integer"`
@@ -814,6 +944,11 @@ type metadataListDatasetsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListDatasetsInput.
+func (s ListDatasetsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Returned for a successful ListDatasets request.
 type ListDatasetsOutput struct {
 	// Number of datasets returned.
@@ -832,6 +967,11 @@ type metadataListDatasetsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListDatasetsOutput.
+func (s ListDatasetsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A request for usage information on an identity pool.
 type ListIdentityPoolUsageInput struct {
 	// The maximum number of results to be returned.
@@ -847,6 +987,11 @@ type metadataListIdentityPoolUsageInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListIdentityPoolUsageInput.
+func (s ListIdentityPoolUsageInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Returned for a successful ListIdentityPoolUsage request.
 type ListIdentityPoolUsageOutput struct {
 	// Total number of identities for the identity pool.
@@ -868,19 +1013,24 @@ type metadataListIdentityPoolUsageOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListIdentityPoolUsageOutput.
+func (s ListIdentityPoolUsageOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A request for a list of records.
 type ListRecordsInput struct {
 	// A string of up to 128 characters. Allowed characters are a-z, A-Z, 0-9, '_'
 	// (underscore), '-' (dash), and '.' (dot).
-	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" required:"true"`
+	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.:-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// The last server sync count for this record.
 	LastSyncCount *int64 `location:"querystring" locationName:"lastSyncCount" type:"long"`
@@ -901,6 +1051,11 @@ type metadataListRecordsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListRecordsInput.
+func (s ListRecordsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Returned for a successful ListRecordsRequest.
 type ListRecordsOutput struct {
 	// Total number of records.
@@ -937,13 +1092,90 @@ type metadataListRecordsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListRecordsOutput.
+func (s ListRecordsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// Operation is an enum of the values of Operation members.
+type Operation string
+
+// The values of Operation.
+const (
+	OperationReplace Operation = "replace"
+	OperationRemove  Operation = "remove"
+)
+
+// OperationValues returns the values of Operation known to this
+// version of the SDK. The service may accept values added since.
+func OperationValues() []Operation {
+	return []Operation{
+		Operation("replace"),
+		Operation("remove"),
+	}
+}
+
+// String returns the Operation as the string of a member.
+func (v Operation) String() string {
+	return string(v)
+}
+
+// ParseOperation returns the Operation of str, failing if it is not
+// one of the OperationValues.
+func ParseOperation(str string) (Operation, error) {
+	for _, v := range OperationValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid Operation value %q", str)
+}
+
+// Platform is an enum of the values of Platform members.
+type Platform string
+
+// The values of Platform.
+const (
+	PlatformAPNS        Platform = "APNS"
+	PlatformAPNSSANDBOX Platform = "APNS_SANDBOX"
+	PlatformGCM         Platform = "GCM"
+	PlatformADM         Platform = "ADM"
+)
+
+// PlatformValues returns the values of Platform known to this
+// version of the SDK. The service may accept values added since.
+func PlatformValues() []Platform {
+	return []Platform{
+		Platform("APNS"),
+		Platform("APNS_SANDBOX"),
+		Platform("GCM"),
+		Platform("ADM"),
+	}
+}
+
+// String returns the Platform as the string of a member.
+func (v Platform) String() string {
+	return string(v)
+}
+
+// ParsePlatform returns the Platform of str, failing if it is not
+// one of the PlatformValues.
+func ParsePlatform(str string) (Platform, error) {
+	for _, v := range PlatformValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid Platform value %q", str)
+}
+
 // Configuration options to be applied to the identity pool.
 type PushSync struct {
 	// List of SNS platform application ARNs that could be used by clients.
 	ApplicationARNs []*string `locationName:"ApplicationArns" type:"list"`
 
 	// A role configured to allow Cognito to call SNS on behalf of the developer.
-	RoleARN *string `locationName:"RoleArn" type:"string"`
+	RoleARN *string `locationName:"RoleArn" type:"string" min:"20" max:"2048" pattern:"arn:aws:iam::\\d+:role/.*"`
 
 	metadataPushSync `json:"-", xml:"-"`
 }
@@ -952,13 +1184,18 @@ type metadataPushSync struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PushSync.
+func (s PushSync) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The basic data structure of a dataset.
 type Record struct {
 	// The last modified date of the client device.
 	DeviceLastModifiedDate *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// The key for the record.
-	Key *string `type:"string"`
+	Key *string `type:"string" min:"1" max:"1024"`
 
 	// The user/device that made the last change to this record.
 	LastModifiedBy *string `type:"string"`
@@ -970,7 +1207,7 @@ type Record struct {
 	SyncCount *int64 `type:"long"`
 
 	// The value for the record.
-	Value *string `type:"string"`
+	Value *string `type:"string" max:"1048575"`
 
 	metadataRecord `json:"-", xml:"-"`
 }
@@ -979,22 +1216,27 @@ type metadataRecord struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Record.
+func (s Record) String() string {
+	return awsutil.StringValue(s)
+}
+
 // An update operation for a record.
 type RecordPatch struct {
 	// The last modified date of the client device.
 	DeviceLastModifiedDate *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// The key associated with the record patch.
-	Key *string `type:"string" required:"true"`
+	Key *string `type:"string" min:"1" max:"1024" required:"true"`
 
 	// An operation, either replace or remove.
-	Op *string `type:"string" required:"true"`
+	Op *string `type:"string" enum:"replace,remove" required:"true"`
 
 	// Last known server sync count for this record. Set to 0 if unknown.
 	SyncCount *int64 `type:"long" required:"true"`
 
 	// The value associated with the record patch.
-	Value *string `type:"string"`
+	Value *string `type:"string" max:"1048575"`
 
 	metadataRecordPatch `json:"-", xml:"-"`
 }
@@ -1003,18 +1245,23 @@ type metadataRecordPatch struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RecordPatch.
+func (s RecordPatch) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A request to RegisterDevice.
 type RegisterDeviceInput struct {
 	// The unique ID for this identity.
-	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. Here, the ID of the pool that the identity belongs
 	// to.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// The SNS platform type (e.g. GCM, SDM, APNS, APNS_SANDBOX).
-	Platform *string `type:"string" required:"true"`
+	Platform *string `type:"string" enum:"APNS,APNS_SANDBOX,GCM,ADM" required:"true"`
 
 	// The push token.
 	Token *string `type:"string" required:"true"`
@@ -1026,10 +1273,15 @@ type metadataRegisterDeviceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RegisterDeviceInput.
+func (s RegisterDeviceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Response to a RegisterDevice request.
 type RegisterDeviceOutput struct {
 	// The unique ID generated for this device by Cognito.
-	DeviceID *string `locationName:"DeviceId" type:"string"`
+	DeviceID *string `locationName:"DeviceId" type:"string" min:"1" max:"256"`
 
 	metadataRegisterDeviceOutput `json:"-", xml:"-"`
 }
@@ -1038,6 +1290,11 @@ type metadataRegisterDeviceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RegisterDeviceOutput.
+func (s RegisterDeviceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the SetIdentityPoolConfiguration operation.
 type SetIdentityPoolConfigurationInput struct {
 	// Options to apply to this identity pool for Amazon Cognito streams.
@@ -1045,7 +1302,7 @@ type SetIdentityPoolConfigurationInput struct {
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. This is the ID of the pool to modify.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// Options to apply to this identity pool for push synchronization.
 	PushSync *PushSync `type:"structure"`
@@ -1057,6 +1314,11 @@ type metadataSetIdentityPoolConfigurationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetIdentityPoolConfigurationInput.
+func (s SetIdentityPoolConfigurationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the SetIdentityPoolConfiguration operation
 type SetIdentityPoolConfigurationOutput struct {
 	// Options to apply to this identity pool for Amazon Cognito streams.
@@ -1064,7 +1326,7 @@ type SetIdentityPoolConfigurationOutput struct {
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito.
-	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string"`
+	IdentityPoolID *string `locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+"`
 
 	// Options to apply to this identity pool for push synchronization.
 	PushSync *PushSync `type:"structure"`
@@ -1076,20 +1338,59 @@ type metadataSetIdentityPoolConfigurationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetIdentityPoolConfigurationOutput.
+func (s SetIdentityPoolConfigurationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// StreamingStatus is an enum of the values of StreamingStatus members.
+type StreamingStatus string
+
+// The values of StreamingStatus.
+const (
+	StreamingStatusENABLED  StreamingStatus = "ENABLED"
+	StreamingStatusDISABLED StreamingStatus = "DISABLED"
+)
+
+// StreamingStatusValues returns the values of StreamingStatus known to this
+// version of the SDK. The service may accept values added since.
+func StreamingStatusValues() []StreamingStatus {
+	return []StreamingStatus{
+		StreamingStatus("ENABLED"),
+		StreamingStatus("DISABLED"),
+	}
+}
+
+// String returns the StreamingStatus as the string of a member.
+func (v StreamingStatus) String() string {
+	return string(v)
+}
+
+// ParseStreamingStatus returns the StreamingStatus of str, failing if it is not
+// one of the StreamingStatusValues.
+func ParseStreamingStatus(str string) (StreamingStatus, error) {
+	for _, v := range StreamingStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid StreamingStatus value %q", str)
+}
+
 // A request to SubscribeToDatasetRequest.
 type SubscribeToDatasetInput struct {
 	// The name of the dataset to subcribe to.
-	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" required:"true"`
+	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.:-]+" required:"true"`
 
 	// The unique ID generated for this device by Cognito.
-	DeviceID *string `location:"uri" locationName:"DeviceId" type:"string" required:"true"`
+	DeviceID *string `location:"uri" locationName:"DeviceId" type:"string" min:"1" max:"256" required:"true"`
 
 	// Unique ID for this identity.
-	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. The ID of the pool to which the identity belongs.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataSubscribeToDatasetInput `json:"-", xml:"-"`
 }
@@ -1098,6 +1399,11 @@ type metadataSubscribeToDatasetInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SubscribeToDatasetInput.
+func (s SubscribeToDatasetInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Response to a SubscribeToDataset request.
 type SubscribeToDatasetOutput struct {
 	metadataSubscribeToDatasetOutput `json:"-", xml:"-"`
@@ -1107,20 +1413,25 @@ type metadataSubscribeToDatasetOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SubscribeToDatasetOutput.
+func (s SubscribeToDatasetOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A request to UnsubscribeFromDataset.
 type UnsubscribeFromDatasetInput struct {
 	// The name of the dataset from which to unsubcribe.
-	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" required:"true"`
+	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.:-]+" required:"true"`
 
 	// The unique ID generated for this device by Cognito.
-	DeviceID *string `location:"uri" locationName:"DeviceId" type:"string" required:"true"`
+	DeviceID *string `location:"uri" locationName:"DeviceId" type:"string" min:"1" max:"256" required:"true"`
 
 	// Unique ID for this identity.
-	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. The ID of the pool to which this identity belongs.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	metadataUnsubscribeFromDatasetInput `json:"-", xml:"-"`
 }
@@ -1129,6 +1440,11 @@ type metadataUnsubscribeFromDatasetInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UnsubscribeFromDatasetInput.
+func (s UnsubscribeFromDatasetInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Response to an UnsubscribeFromDataset request.
 type UnsubscribeFromDatasetOutput struct {
 	metadataUnsubscribeFromDatasetOutput `json:"-", xml:"-"`
@@ -1138,6 +1454,11 @@ type metadataUnsubscribeFromDatasetOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UnsubscribeFromDatasetOutput.
+func (s UnsubscribeFromDatasetOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A request to post updates to records or add and delete records for a dataset
 // and user.
 type UpdateRecordsInput struct {
@@ -1147,18 +1468,18 @@ type UpdateRecordsInput struct {
 
 	// A string of up to 128 characters. Allowed characters are a-z, A-Z, 0-9, '_'
 	// (underscore), '-' (dash), and '.' (dot).
-	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" required:"true"`
+	DatasetName *string `location:"uri" locationName:"DatasetName" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.:-]+" required:"true"`
 
 	// The unique ID generated for this device by Cognito.
-	DeviceID *string `locationName:"DeviceId" type:"string"`
+	DeviceID *string `locationName:"DeviceId" type:"string" min:"1" max:"256"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" required:"true"`
+	IdentityID *string `location:"uri" locationName:"IdentityId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A name-spaced GUID (for example, us-east-1:23EC4050-6AEA-7089-A2DD-08002EXAMPLE)
 	// created by Amazon Cognito. GUID generation is unique within a region.
-	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" required:"true"`
+	IdentityPoolID *string `location:"uri" locationName:"IdentityPoolId" type:"string" min:"1" max:"50" pattern:"[\\w-]+:[0-9a-f-]+" required:"true"`
 
 	// A list of patch operations.
 	RecordPatches []*RecordPatch `type:"list"`
@@ -1174,6 +1495,11 @@ type metadataUpdateRecordsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateRecordsInput.
+func (s UpdateRecordsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Returned for a successful UpdateRecordsRequest.
 type UpdateRecordsOutput struct {
 	// A list of records that have been updated.
@@ -1184,4 +1510,9 @@ type UpdateRecordsOutput struct {
 
 type metadataUpdateRecordsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the UpdateRecordsOutput.
+func (s UpdateRecordsOutput) String() string {
+	return awsutil.StringValue(s)
 }
\ No newline at end of file
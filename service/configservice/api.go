@@ -4,9 +4,11 @@
 package configservice
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // DeleteDeliveryChannelRequest generates a request for the DeleteDeliveryChannel operation.
@@ -334,6 +336,40 @@ func (c *ConfigService) StopConfigurationRecorder(input *StopConfigurationRecord
 
 var opStopConfigurationRecorder *aws.Operation
 
+// ChronologicalOrder is an enum of the values of ChronologicalOrder members.
+type ChronologicalOrder string
+
+// The values of ChronologicalOrder.
+const (
+	ChronologicalOrderReverse ChronologicalOrder = "Reverse"
+	ChronologicalOrderForward ChronologicalOrder = "Forward"
+)
+
+// ChronologicalOrderValues returns the values of ChronologicalOrder known to this
+// version of the SDK. The service may accept values added since.
+func ChronologicalOrderValues() []ChronologicalOrder {
+	return []ChronologicalOrder{
+		ChronologicalOrder("Reverse"),
+		ChronologicalOrder("Forward"),
+	}
+}
+
+// String returns the ChronologicalOrder as the string of a member.
+func (v ChronologicalOrder) String() string {
+	return string(v)
+}
+
+// ParseChronologicalOrder returns the ChronologicalOrder of str, failing if it is not
+// one of the ChronologicalOrderValues.
+func ParseChronologicalOrder(str string) (ChronologicalOrder, error) {
+	for _, v := range ChronologicalOrderValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ChronologicalOrder value %q", str)
+}
+
 // A list that contains the status of the delivery of either the snapshot or
 // the configuration history to the specified Amazon S3 bucket.
 type ConfigExportDeliveryInfo struct {
@@ -347,7 +383,7 @@ type ConfigExportDeliveryInfo struct {
 	LastErrorMessage *string `locationName:"lastErrorMessage" type:"string"`
 
 	// Status of the last attempted delivery.
-	LastStatus *string `locationName:"lastStatus" type:"string"`
+	LastStatus *string `locationName:"lastStatus" type:"string" enum:"Success,Failure"`
 
 	// The time of the last successful delivery.
 	LastSuccessfulTime *time.Time `locationName:"lastSuccessfulTime" type:"timestamp" timestampFormat:"unix"`
@@ -359,6 +395,11 @@ type metadataConfigExportDeliveryInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ConfigExportDeliveryInfo.
+func (s ConfigExportDeliveryInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A list that contains the status of the delivery of the configuration stream
 // notification to the Amazon SNS topic.
 type ConfigStreamDeliveryInfo struct {
@@ -369,7 +410,7 @@ type ConfigStreamDeliveryInfo struct {
 	LastErrorMessage *string `locationName:"lastErrorMessage" type:"string"`
 
 	// Status of the last attempted delivery.
-	LastStatus *string `locationName:"lastStatus" type:"string"`
+	LastStatus *string `locationName:"lastStatus" type:"string" enum:"Success,Failure"`
 
 	// The time from the last status change.
 	LastStatusChangeTime *time.Time `locationName:"lastStatusChangeTime" type:"timestamp" timestampFormat:"unix"`
@@ -381,6 +422,11 @@ type metadataConfigStreamDeliveryInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ConfigStreamDeliveryInfo.
+func (s ConfigStreamDeliveryInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A list that contains detailed configurations of a specified resource.
 type ConfigurationItem struct {
 	// The Amazon Resource Name (ARN) of the resource.
@@ -405,7 +451,7 @@ type ConfigurationItem struct {
 	ConfigurationItemMD5Hash *string `locationName:"configurationItemMD5Hash" type:"string"`
 
 	// The configuration item status.
-	ConfigurationItemStatus *string `locationName:"configurationItemStatus" type:"string"`
+	ConfigurationItemStatus *string `locationName:"configurationItemStatus" type:"string" enum:"Ok,Failed,Discovered,Deleted"`
 
 	// An identifier that indicates the ordering of the configuration items of a
 	// resource.
@@ -431,7 +477,7 @@ type ConfigurationItem struct {
 	ResourceID *string `locationName:"resourceId" type:"string"`
 
 	// The type of AWS resource.
-	ResourceType *string `locationName:"resourceType" type:"string"`
+	ResourceType *string `locationName:"resourceType" type:"string" enum:"AWS::EC2::CustomerGateway,AWS::EC2::EIP,AWS::EC2::Instance,AWS::EC2::InternetGateway,AWS::EC2::NetworkAcl,AWS::EC2::NetworkInterface,AWS::EC2::RouteTable,AWS::EC2::SecurityGroup,AWS::EC2::Subnet,AWS::CloudTrail::Trail,AWS::EC2::Volume,AWS::EC2::VPC,AWS::EC2::VPNConnection,AWS::EC2::VPNGateway"`
 
 	// A mapping of key value tags associated with the resource.
 	Tags *map[string]*string `locationName:"tags" type:"map"`
@@ -446,13 +492,56 @@ type metadataConfigurationItem struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ConfigurationItem.
+func (s ConfigurationItem) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ConfigurationItemStatus is an enum of the values of ConfigurationItemStatus members.
+type ConfigurationItemStatus string
+
+// The values of ConfigurationItemStatus.
+const (
+	ConfigurationItemStatusOk         ConfigurationItemStatus = "Ok"
+	ConfigurationItemStatusFailed     ConfigurationItemStatus = "Failed"
+	ConfigurationItemStatusDiscovered ConfigurationItemStatus = "Discovered"
+	ConfigurationItemStatusDeleted    ConfigurationItemStatus = "Deleted"
+)
+
+// ConfigurationItemStatusValues returns the values of ConfigurationItemStatus known to this
+// version of the SDK. The service may accept values added since.
+func ConfigurationItemStatusValues() []ConfigurationItemStatus {
+	return []ConfigurationItemStatus{
+		ConfigurationItemStatus("Ok"),
+		ConfigurationItemStatus("Failed"),
+		ConfigurationItemStatus("Discovered"),
+		ConfigurationItemStatus("Deleted"),
+	}
+}
+
+// String returns the ConfigurationItemStatus as the string of a member.
+func (v ConfigurationItemStatus) String() string {
+	return string(v)
+}
+
+// ParseConfigurationItemStatus returns the ConfigurationItemStatus of str, failing if it is not
+// one of the ConfigurationItemStatusValues.
+func ParseConfigurationItemStatus(str string) (ConfigurationItemStatus, error) {
+	for _, v := range ConfigurationItemStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ConfigurationItemStatus value %q", str)
+}
+
 // An object that represents the recording of configuration changes of an AWS
 // resource.
 type ConfigurationRecorder struct {
 	// The name of the recorder. By default, AWS Config automatically assigns the
 	// name "default" when creating the configuration recorder. You cannot change
 	// the assigned name.
-	Name *string `locationName:"name" type:"string"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256"`
 
 	// Amazon Resource Name (ARN) of the IAM role used to describe the AWS resources
 	// associated with the account.
@@ -465,6 +554,11 @@ type metadataConfigurationRecorder struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ConfigurationRecorder.
+func (s ConfigurationRecorder) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The current status of the configuration recorder.
 type ConfigurationRecorderStatus struct {
 	// The error code indicating that the recording failed.
@@ -477,7 +571,7 @@ type ConfigurationRecorderStatus struct {
 	LastStartTime *time.Time `locationName:"lastStartTime" type:"timestamp" timestampFormat:"unix"`
 
 	// The last (previous) status of the recorder.
-	LastStatus *string `locationName:"lastStatus" type:"string"`
+	LastStatus *string `locationName:"lastStatus" type:"string" enum:"Pending,Success,Failure"`
 
 	// The time when the status was last changed.
 	LastStatusChangeTime *time.Time `locationName:"lastStatusChangeTime" type:"timestamp" timestampFormat:"unix"`
@@ -498,11 +592,16 @@ type metadataConfigurationRecorderStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ConfigurationRecorderStatus.
+func (s ConfigurationRecorderStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the DeleteDeliveryChannel action. The action accepts the following
 // data in JSON format.
 type DeleteDeliveryChannelInput struct {
 	// The name of the delivery channel to delete.
-	DeliveryChannelName *string `type:"string" required:"true"`
+	DeliveryChannelName *string `type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDeleteDeliveryChannelInput `json:"-", xml:"-"`
 }
@@ -511,6 +610,11 @@ type metadataDeleteDeliveryChannelInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteDeliveryChannelInput.
+func (s DeleteDeliveryChannelInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteDeliveryChannelOutput struct {
 	metadataDeleteDeliveryChannelOutput `json:"-", xml:"-"`
 }
@@ -519,10 +623,15 @@ type metadataDeleteDeliveryChannelOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteDeliveryChannelOutput.
+func (s DeleteDeliveryChannelOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the DeliverConfigSnapshot action.
 type DeliverConfigSnapshotInput struct {
 	// The name of the delivery channel through which the snapshot is delivered.
-	DeliveryChannelName *string `locationName:"deliveryChannelName" type:"string" required:"true"`
+	DeliveryChannelName *string `locationName:"deliveryChannelName" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDeliverConfigSnapshotInput `json:"-", xml:"-"`
 }
@@ -531,6 +640,11 @@ type metadataDeliverConfigSnapshotInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeliverConfigSnapshotInput.
+func (s DeliverConfigSnapshotInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the DeliverConfigSnapshot action in JSON format.
 type DeliverConfigSnapshotOutput struct {
 	// The ID of the snapshot that is being created.
@@ -543,13 +657,18 @@ type metadataDeliverConfigSnapshotOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeliverConfigSnapshotOutput.
+func (s DeliverConfigSnapshotOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A logical container used for storing the configuration changes of an AWS
 // resource.
 type DeliveryChannel struct {
 	// The name of the delivery channel. By default, AWS Config automatically assigns
 	// the name "default" when creating the delivery channel. You cannot change
 	// the assigned name.
-	Name *string `locationName:"name" type:"string"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256"`
 
 	// The name of the Amazon S3 bucket used to store configuration history for
 	// the delivery channel.
@@ -569,6 +688,11 @@ type metadataDeliveryChannel struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeliveryChannel.
+func (s DeliveryChannel) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The status of a specified delivery channel.
 //
 // Valid values: Success | Failure
@@ -595,6 +719,45 @@ type metadataDeliveryChannelStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeliveryChannelStatus.
+func (s DeliveryChannelStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
+// DeliveryStatus is an enum of the values of DeliveryStatus members.
+type DeliveryStatus string
+
+// The values of DeliveryStatus.
+const (
+	DeliveryStatusSuccess DeliveryStatus = "Success"
+	DeliveryStatusFailure DeliveryStatus = "Failure"
+)
+
+// DeliveryStatusValues returns the values of DeliveryStatus known to this
+// version of the SDK. The service may accept values added since.
+func DeliveryStatusValues() []DeliveryStatus {
+	return []DeliveryStatus{
+		DeliveryStatus("Success"),
+		DeliveryStatus("Failure"),
+	}
+}
+
+// String returns the DeliveryStatus as the string of a member.
+func (v DeliveryStatus) String() string {
+	return string(v)
+}
+
+// ParseDeliveryStatus returns the DeliveryStatus of str, failing if it is not
+// one of the DeliveryStatusValues.
+func ParseDeliveryStatus(str string) (DeliveryStatus, error) {
+	for _, v := range DeliveryStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DeliveryStatus value %q", str)
+}
+
 // The input for the DescribeConfigurationRecorderStatus action.
 type DescribeConfigurationRecorderStatusInput struct {
 	// The name(s) of the configuration recorder. If the name is not specified,
@@ -609,6 +772,11 @@ type metadataDescribeConfigurationRecorderStatusInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeConfigurationRecorderStatusInput.
+func (s DescribeConfigurationRecorderStatusInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the DescribeConfigurationRecorderStatus action in JSON format.
 type DescribeConfigurationRecorderStatusOutput struct {
 	// A list that contains status of the specified recorders.
@@ -621,6 +789,11 @@ type metadataDescribeConfigurationRecorderStatusOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeConfigurationRecorderStatusOutput.
+func (s DescribeConfigurationRecorderStatusOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the DescribeConfigurationRecorders action.
 type DescribeConfigurationRecordersInput struct {
 	// A list of configuration recorder names.
@@ -633,6 +806,11 @@ type metadataDescribeConfigurationRecordersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeConfigurationRecordersInput.
+func (s DescribeConfigurationRecordersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the DescribeConfigurationRecorders action.
 type DescribeConfigurationRecordersOutput struct {
 	// A list that contains the descriptions of the specified configuration recorders.
@@ -645,6 +823,11 @@ type metadataDescribeConfigurationRecordersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeConfigurationRecordersOutput.
+func (s DescribeConfigurationRecordersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the DeliveryChannelStatus action.
 type DescribeDeliveryChannelStatusInput struct {
 	// A list of delivery channel names.
@@ -657,6 +840,11 @@ type metadataDescribeDeliveryChannelStatusInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeDeliveryChannelStatusInput.
+func (s DescribeDeliveryChannelStatusInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the DescribeDeliveryChannelStatus action.
 type DescribeDeliveryChannelStatusOutput struct {
 	// A list that contains the status of a specified delivery channel.
@@ -669,6 +857,11 @@ type metadataDescribeDeliveryChannelStatusOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeDeliveryChannelStatusOutput.
+func (s DescribeDeliveryChannelStatusOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the DescribeDeliveryChannels action.
 type DescribeDeliveryChannelsInput struct {
 	// A list of delivery channel names.
@@ -681,6 +874,11 @@ type metadataDescribeDeliveryChannelsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeDeliveryChannelsInput.
+func (s DescribeDeliveryChannelsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the DescribeDeliveryChannels action.
 type DescribeDeliveryChannelsOutput struct {
 	// A list that contains the descriptions of the specified delivery channel.
@@ -693,11 +891,16 @@ type metadataDescribeDeliveryChannelsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeDeliveryChannelsOutput.
+func (s DescribeDeliveryChannelsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the GetResourceConfigHistory action.
 type GetResourceConfigHistoryInput struct {
 	// The chronological order for configuration items listed. By default the results
 	// are listed in reverse chronological order.
-	ChronologicalOrder *string `locationName:"chronologicalOrder" type:"string"`
+	ChronologicalOrder *string `locationName:"chronologicalOrder" type:"string" enum:"Reverse,Forward"`
 
 	// The time stamp that indicates an earlier time. If not specified, the action
 	// returns paginated results that contain configuration items that start from
@@ -710,7 +913,7 @@ type GetResourceConfigHistoryInput struct {
 
 	// The maximum number of configuration items returned in each page. The default
 	// is 10. You cannot specify a limit greater than 100.
-	Limit *int64 `locationName:"limit" type:"integer"`
+	Limit *int64 `locationName:"limit" type:"integer" max:"100"`
 
 	// An optional parameter used for pagination of the results.
 	NextToken *string `locationName:"nextToken" type:"string"`
@@ -719,7 +922,7 @@ type GetResourceConfigHistoryInput struct {
 	ResourceID *string `locationName:"resourceId" type:"string" required:"true"`
 
 	// The resource type.
-	ResourceType *string `locationName:"resourceType" type:"string" required:"true"`
+	ResourceType *string `locationName:"resourceType" type:"string" enum:"AWS::EC2::CustomerGateway,AWS::EC2::EIP,AWS::EC2::Instance,AWS::EC2::InternetGateway,AWS::EC2::NetworkAcl,AWS::EC2::NetworkInterface,AWS::EC2::RouteTable,AWS::EC2::SecurityGroup,AWS::EC2::Subnet,AWS::CloudTrail::Trail,AWS::EC2::Volume,AWS::EC2::VPC,AWS::EC2::VPNConnection,AWS::EC2::VPNGateway" required:"true"`
 
 	metadataGetResourceConfigHistoryInput `json:"-", xml:"-"`
 }
@@ -728,6 +931,11 @@ type metadataGetResourceConfigHistoryInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetResourceConfigHistoryInput.
+func (s GetResourceConfigHistoryInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the GetResourceConfigHistory action.
 type GetResourceConfigHistoryOutput struct {
 	// A list that contains the configuration history of one or more resources.
@@ -743,6 +951,11 @@ type metadataGetResourceConfigHistoryOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetResourceConfigHistoryOutput.
+func (s GetResourceConfigHistoryOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the PutConfigurationRecorder action.
 type PutConfigurationRecorderInput struct {
 	// The configuration recorder object that records each configuration change
@@ -756,6 +969,11 @@ type metadataPutConfigurationRecorderInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutConfigurationRecorderInput.
+func (s PutConfigurationRecorderInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutConfigurationRecorderOutput struct {
 	metadataPutConfigurationRecorderOutput `json:"-", xml:"-"`
 }
@@ -764,6 +982,11 @@ type metadataPutConfigurationRecorderOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutConfigurationRecorderOutput.
+func (s PutConfigurationRecorderOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the PutDeliveryChannel action.
 type PutDeliveryChannelInput struct {
 	// The configuration delivery channel object that delivers the configuration
@@ -777,6 +1000,11 @@ type metadataPutDeliveryChannelInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutDeliveryChannelInput.
+func (s PutDeliveryChannelInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutDeliveryChannelOutput struct {
 	metadataPutDeliveryChannelOutput `json:"-", xml:"-"`
 }
@@ -785,6 +1013,47 @@ type metadataPutDeliveryChannelOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutDeliveryChannelOutput.
+func (s PutDeliveryChannelOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// RecorderStatus is an enum of the values of RecorderStatus members.
+type RecorderStatus string
+
+// The values of RecorderStatus.
+const (
+	RecorderStatusPending RecorderStatus = "Pending"
+	RecorderStatusSuccess RecorderStatus = "Success"
+	RecorderStatusFailure RecorderStatus = "Failure"
+)
+
+// RecorderStatusValues returns the values of RecorderStatus known to this
+// version of the SDK. The service may accept values added since.
+func RecorderStatusValues() []RecorderStatus {
+	return []RecorderStatus{
+		RecorderStatus("Pending"),
+		RecorderStatus("Success"),
+		RecorderStatus("Failure"),
+	}
+}
+
+// String returns the RecorderStatus as the string of a member.
+func (v RecorderStatus) String() string {
+	return string(v)
+}
+
+// ParseRecorderStatus returns the RecorderStatus of str, failing if it is not
+// one of the RecorderStatusValues.
+func ParseRecorderStatus(str string) (RecorderStatus, error) {
+	for _, v := range RecorderStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid RecorderStatus value %q", str)
+}
+
 // The relationship of the related resource to the main resource.
 type Relationship struct {
 	// The name of the related resource.
@@ -794,7 +1063,7 @@ type Relationship struct {
 	ResourceID *string `locationName:"resourceId" type:"string"`
 
 	// The resource type of the related resource.
-	ResourceType *string `locationName:"resourceType" type:"string"`
+	ResourceType *string `locationName:"resourceType" type:"string" enum:"AWS::EC2::CustomerGateway,AWS::EC2::EIP,AWS::EC2::Instance,AWS::EC2::InternetGateway,AWS::EC2::NetworkAcl,AWS::EC2::NetworkInterface,AWS::EC2::RouteTable,AWS::EC2::SecurityGroup,AWS::EC2::Subnet,AWS::CloudTrail::Trail,AWS::EC2::Volume,AWS::EC2::VPC,AWS::EC2::VPNConnection,AWS::EC2::VPNGateway"`
 
 	metadataRelationship `json:"-", xml:"-"`
 }
@@ -803,11 +1072,74 @@ type metadataRelationship struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Relationship.
+func (s Relationship) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ResourceType is an enum of the values of ResourceType members.
+type ResourceType string
+
+// The values of ResourceType.
+const (
+	ResourceTypeAWSEC2CustomerGateway  ResourceType = "AWS::EC2::CustomerGateway"
+	ResourceTypeAWSEC2EIP              ResourceType = "AWS::EC2::EIP"
+	ResourceTypeAWSEC2Instance         ResourceType = "AWS::EC2::Instance"
+	ResourceTypeAWSEC2InternetGateway  ResourceType = "AWS::EC2::InternetGateway"
+	ResourceTypeAWSEC2NetworkAcl       ResourceType = "AWS::EC2::NetworkAcl"
+	ResourceTypeAWSEC2NetworkInterface ResourceType = "AWS::EC2::NetworkInterface"
+	ResourceTypeAWSEC2RouteTable       ResourceType = "AWS::EC2::RouteTable"
+	ResourceTypeAWSEC2SecurityGroup    ResourceType = "AWS::EC2::SecurityGroup"
+	ResourceTypeAWSEC2Subnet           ResourceType = "AWS::EC2::Subnet"
+	ResourceTypeAWSCloudTrailTrail     ResourceType = "AWS::CloudTrail::Trail"
+	ResourceTypeAWSEC2Volume           ResourceType = "AWS::EC2::Volume"
+	ResourceTypeAWSEC2VPC              ResourceType = "AWS::EC2::VPC"
+	ResourceTypeAWSEC2VPNConnection    ResourceType = "AWS::EC2::VPNConnection"
+	ResourceTypeAWSEC2VPNGateway       ResourceType = "AWS::EC2::VPNGateway"
+)
+
+// ResourceTypeValues returns the values of ResourceType known to this
+// version of the SDK. The service may accept values added since.
+func ResourceTypeValues() []ResourceType {
+	return []ResourceType{
+		ResourceType("AWS::EC2::CustomerGateway"),
+		ResourceType("AWS::EC2::EIP"),
+		ResourceType("AWS::EC2::Instance"),
+		ResourceType("AWS::EC2::InternetGateway"),
+		ResourceType("AWS::EC2::NetworkAcl"),
+		ResourceType("AWS::EC2::NetworkInterface"),
+		ResourceType("AWS::EC2::RouteTable"),
+		ResourceType("AWS::EC2::SecurityGroup"),
+		ResourceType("AWS::EC2::Subnet"),
+		ResourceType("AWS::CloudTrail::Trail"),
+		ResourceType("AWS::EC2::Volume"),
+		ResourceType("AWS::EC2::VPC"),
+		ResourceType("AWS::EC2::VPNConnection"),
+		ResourceType("AWS::EC2::VPNGateway"),
+	}
+}
+
+// String returns the ResourceType as the string of a member.
+func (v ResourceType) String() string {
+	return string(v)
+}
+
+// ParseResourceType returns the ResourceType of str, failing if it is not
+// one of the ResourceTypeValues.
+func ParseResourceType(str string) (ResourceType, error) {
+	for _, v := range ResourceTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ResourceType value %q", str)
+}
+
 // The input for the StartConfigurationRecorder action.
 type StartConfigurationRecorderInput struct {
 	// The name of the recorder object that records each configuration change made
 	// to the resources.
-	ConfigurationRecorderName *string `type:"string" required:"true"`
+	ConfigurationRecorderName *string `type:"string" min:"1" max:"256" required:"true"`
 
 	metadataStartConfigurationRecorderInput `json:"-", xml:"-"`
 }
@@ -816,6 +1148,11 @@ type metadataStartConfigurationRecorderInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StartConfigurationRecorderInput.
+func (s StartConfigurationRecorderInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type StartConfigurationRecorderOutput struct {
 	metadataStartConfigurationRecorderOutput `json:"-", xml:"-"`
 }
@@ -824,11 +1161,16 @@ type metadataStartConfigurationRecorderOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StartConfigurationRecorderOutput.
+func (s StartConfigurationRecorderOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the StopConfigurationRecorder action.
 type StopConfigurationRecorderInput struct {
 	// The name of the recorder object that records each configuration change made
 	// to the resources.
-	ConfigurationRecorderName *string `type:"string" required:"true"`
+	ConfigurationRecorderName *string `type:"string" min:"1" max:"256" required:"true"`
 
 	metadataStopConfigurationRecorderInput `json:"-", xml:"-"`
 }
@@ -837,10 +1179,20 @@ type metadataStopConfigurationRecorderInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StopConfigurationRecorderInput.
+func (s StopConfigurationRecorderInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type StopConfigurationRecorderOutput struct {
 	metadataStopConfigurationRecorderOutput `json:"-", xml:"-"`
 }
 
 type metadataStopConfigurationRecorderOutput struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the StopConfigurationRecorderOutput.
+func (s StopConfigurationRecorderOutput) String() string {
+	return awsutil.StringValue(s)
 }
\ No newline at end of file
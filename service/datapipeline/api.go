@@ -4,7 +4,10 @@
 package datapipeline
 
 import (
+	"fmt"
+
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // ActivatePipelineRequest generates a request for the ActivatePipeline operation.
@@ -132,6 +135,11 @@ func (c *DataPipeline) DescribeObjectsRequest(input *DescribeObjectsInput) (req
 			Name:       "DescribeObjects",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				TruncationToken: "HasMoreResults",
+			},
 		}
 	}
 
@@ -151,6 +159,16 @@ func (c *DataPipeline) DescribeObjects(input *DescribeObjectsInput) (output *Des
 	return
 }
 
+// DescribeObjectsPages iterates over the pages of a DescribeObjects operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *DataPipeline) DescribeObjectsPages(input *DescribeObjectsInput, fn func(p *DescribeObjectsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeObjectsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeObjectsOutput), lastPage)
+	})
+}
+
 var opDescribeObjects *aws.Operation
 
 // DescribePipelinesRequest generates a request for the DescribePipelines operation.
@@ -248,6 +266,11 @@ func (c *DataPipeline) ListPipelinesRequest(input *ListPipelinesInput) (req *aws
 			Name:       "ListPipelines",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				TruncationToken: "HasMoreResults",
+			},
 		}
 	}
 
@@ -266,6 +289,16 @@ func (c *DataPipeline) ListPipelines(input *ListPipelinesInput) (output *ListPip
 	return
 }
 
+// ListPipelinesPages iterates over the pages of a ListPipelines operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *DataPipeline) ListPipelinesPages(input *ListPipelinesInput, fn func(p *ListPipelinesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListPipelinesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListPipelinesOutput), lastPage)
+	})
+}
+
 var opListPipelines *aws.Operation
 
 // PollForTaskRequest generates a request for the PollForTask operation.
@@ -351,6 +384,12 @@ func (c *DataPipeline) QueryObjectsRequest(input *QueryObjectsInput) (req *aws.R
 			Name:       "QueryObjects",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "Limit",
+				TruncationToken: "HasMoreResults",
+			},
 		}
 	}
 
@@ -375,6 +414,16 @@ func (c *DataPipeline) QueryObjects(input *QueryObjectsInput) (output *QueryObje
 	return
 }
 
+// QueryObjectsPages iterates over the pages of a QueryObjects operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *DataPipeline) QueryObjectsPages(input *QueryObjectsInput, fn func(p *QueryObjectsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.QueryObjectsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*QueryObjectsOutput), lastPage)
+	})
+}
+
 var opQueryObjects *aws.Operation
 
 // RemoveTagsRequest generates a request for the RemoveTags operation.
@@ -562,7 +611,7 @@ type ActivatePipelineInput struct {
 	ParameterValues []*ParameterValue `locationName:"parameterValues" type:"list"`
 
 	// The identifier of the pipeline to activate.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	metadataActivatePipelineInput `json:"-", xml:"-"`
 }
@@ -571,6 +620,11 @@ type metadataActivatePipelineInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ActivatePipelineInput.
+func (s ActivatePipelineInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output from the ActivatePipeline action.
 type ActivatePipelineOutput struct {
 	metadataActivatePipelineOutput `json:"-", xml:"-"`
@@ -580,13 +634,18 @@ type metadataActivatePipelineOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ActivatePipelineOutput.
+func (s ActivatePipelineOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input to the AddTags action.
 type AddTagsInput struct {
 	// The identifier of the pipeline to which you want to add the tags.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The tags as key/value pairs to add to the pipeline.
-	Tags []*Tag `locationName:"tags" type:"list" required:"true"`
+	Tags []*Tag `locationName:"tags" type:"list" max:"10" required:"true"`
 
 	metadataAddTagsInput `json:"-", xml:"-"`
 }
@@ -595,6 +654,11 @@ type metadataAddTagsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddTagsInput.
+func (s AddTagsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The response from the AddTags action.
 type AddTagsOutput struct {
 	metadataAddTagsOutput `json:"-", xml:"-"`
@@ -604,21 +668,26 @@ type metadataAddTagsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddTagsOutput.
+func (s AddTagsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the CreatePipeline action.
 type CreatePipelineInput struct {
 	// The description of the new pipeline.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The name of the new pipeline. You can use the same name for multiple pipelines
 	// associated with your AWS account, because AWS Data Pipeline assigns each
 	// new pipeline a unique pipeline identifier.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// A list of tags to associate with a pipeline at creation time. Tags let you
 	// control access to pipelines. For more information, see Controlling User Access
 	// to Pipelines (http://docs.aws.amazon.com/datapipeline/latest/DeveloperGuide/dp-control-access.html)
 	// in the AWS Data Pipeline Developer Guide.
-	Tags []*Tag `locationName:"tags" type:"list"`
+	Tags []*Tag `locationName:"tags" type:"list" max:"10"`
 
 	// A unique identifier that you specify. This identifier is not the same as
 	// the pipeline identifier assigned by AWS Data Pipeline. You are responsible
@@ -631,7 +700,7 @@ type CreatePipelineInput struct {
 	// will not be created. Instead, you'll receive the pipeline identifier from
 	// the previous attempt. The uniqueness of the name and unique identifier combination
 	// is scoped to the AWS account or IAM user credentials.
-	UniqueID *string `locationName:"uniqueId" type:"string" required:"true"`
+	UniqueID *string `locationName:"uniqueId" type:"string" min:"1" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	metadataCreatePipelineInput `json:"-", xml:"-"`
 }
@@ -640,11 +709,16 @@ type metadataCreatePipelineInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreatePipelineInput.
+func (s CreatePipelineInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output from the CreatePipeline action.
 type CreatePipelineOutput struct {
 	// The ID that AWS Data Pipeline assigns the newly created pipeline. The ID
 	// is a string of the form: df-06372391ZG65EXAMPLE.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	metadataCreatePipelineOutput `json:"-", xml:"-"`
 }
@@ -653,10 +727,15 @@ type metadataCreatePipelineOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreatePipelineOutput.
+func (s CreatePipelineOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the DeletePipeline action.
 type DeletePipelineInput struct {
 	// The identifier of the pipeline to be deleted.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	metadataDeletePipelineInput `json:"-", xml:"-"`
 }
@@ -665,6 +744,11 @@ type metadataDeletePipelineInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeletePipelineInput.
+func (s DeletePipelineInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeletePipelineOutput struct {
 	metadataDeletePipelineOutput `json:"-", xml:"-"`
 }
@@ -673,6 +757,11 @@ type metadataDeletePipelineOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeletePipelineOutput.
+func (s DeletePipelineOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The DescribeObjects action returns the object definitions for a specified
 // set of object identifiers. You can filter the results to named fields and
 // used markers to page through the results.
@@ -685,14 +774,14 @@ type DescribeObjectsInput struct {
 	// DescribeObjects, this value should be empty. As long as the action returns
 	// HasMoreResults as True, you can call DescribeObjects again and pass the marker
 	// value from the response to retrieve the next set of results.
-	Marker *string `locationName:"marker" type:"string"`
+	Marker *string `locationName:"marker" type:"string" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// Identifiers of the pipeline objects that contain the definitions to be described.
 	// You can pass as many as 25 identifiers in a single call to DescribeObjects.
 	ObjectIDs []*string `locationName:"objectIds" type:"list" required:"true"`
 
 	// Identifier of the pipeline that contains the object definitions.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	metadataDescribeObjectsInput `json:"-", xml:"-"`
 }
@@ -701,6 +790,11 @@ type metadataDescribeObjectsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeObjectsInput.
+func (s DescribeObjectsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // If True, there are more results that can be returned in another call to DescribeObjects.
 type DescribeObjectsOutput struct {
 	// If True, there are more pages of results to return.
@@ -708,7 +802,7 @@ type DescribeObjectsOutput struct {
 
 	// The starting point for the next page of results. To view the next page of
 	// results, call DescribeObjects again with this marker value.
-	Marker *string `locationName:"marker" type:"string"`
+	Marker *string `locationName:"marker" type:"string" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// An array of object definitions that are returned by the call to DescribeObjects.
 	PipelineObjects []*PipelineObject `locationName:"pipelineObjects" type:"list" required:"true"`
@@ -720,6 +814,11 @@ type metadataDescribeObjectsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeObjectsOutput.
+func (s DescribeObjectsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input to the DescribePipelines action.
 type DescribePipelinesInput struct {
 	// Identifiers of the pipelines to describe. You can pass as many as 25 identifiers
@@ -734,6 +833,11 @@ type metadataDescribePipelinesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribePipelinesInput.
+func (s DescribePipelinesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output from the DescribePipelines action.
 type DescribePipelinesOutput struct {
 	// An array of descriptions returned for the specified pipelines.
@@ -746,16 +850,21 @@ type metadataDescribePipelinesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribePipelinesOutput.
+func (s DescribePipelinesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the EvaluateExpression action.
 type EvaluateExpressionInput struct {
 	// The expression to evaluate.
-	Expression *string `locationName:"expression" type:"string" required:"true"`
+	Expression *string `locationName:"expression" type:"string" max:"20971520" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The identifier of the object.
-	ObjectID *string `locationName:"objectId" type:"string" required:"true"`
+	ObjectID *string `locationName:"objectId" type:"string" min:"1" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The identifier of the pipeline.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	metadataEvaluateExpressionInput `json:"-", xml:"-"`
 }
@@ -764,10 +873,15 @@ type metadataEvaluateExpressionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EvaluateExpressionInput.
+func (s EvaluateExpressionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output from the EvaluateExpression action.
 type EvaluateExpressionOutput struct {
 	// The evaluated expression.
-	EvaluatedExpression *string `locationName:"evaluatedExpression" type:"string" required:"true"`
+	EvaluatedExpression *string `locationName:"evaluatedExpression" type:"string" max:"20971520" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	metadataEvaluateExpressionOutput `json:"-", xml:"-"`
 }
@@ -776,18 +890,23 @@ type metadataEvaluateExpressionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EvaluateExpressionOutput.
+func (s EvaluateExpressionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A key-value pair that describes a property of a pipeline object. The value
 // is specified as either a string value (StringValue) or a reference to another
 // object (RefValue) but not as both.
 type Field struct {
 	// The field identifier.
-	Key *string `locationName:"key" type:"string" required:"true"`
+	Key *string `locationName:"key" type:"string" min:"1" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The field value, expressed as the identifier of another object.
-	RefValue *string `locationName:"refValue" type:"string"`
+	RefValue *string `locationName:"refValue" type:"string" min:"1" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The field value, expressed as a String.
-	StringValue *string `locationName:"stringValue" type:"string"`
+	StringValue *string `locationName:"stringValue" type:"string" max:"10240" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataField `json:"-", xml:"-"`
 }
@@ -796,16 +915,21 @@ type metadataField struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Field.
+func (s Field) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the GetPipelineDefinition action.
 type GetPipelineDefinitionInput struct {
 	// The identifier of the pipeline.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The version of the pipeline definition to retrieve. This parameter accepts
 	// the values latest (default) and active. Where latest indicates the last definition
 	// saved to the pipeline and active indicates the last definition of the pipeline
 	// that was activated.
-	Version *string `locationName:"version" type:"string"`
+	Version *string `locationName:"version" type:"string" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataGetPipelineDefinitionInput `json:"-", xml:"-"`
 }
@@ -814,6 +938,11 @@ type metadataGetPipelineDefinitionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetPipelineDefinitionInput.
+func (s GetPipelineDefinitionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output from the GetPipelineDefinition action.
 type GetPipelineDefinitionOutput struct {
 	// Returns a list of parameter objects used in the pipeline definition.
@@ -832,6 +961,11 @@ type metadataGetPipelineDefinitionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetPipelineDefinitionOutput.
+func (s GetPipelineDefinitionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Identity information for the Amazon EC2 instance that is hosting the task
 // runner. You can get this value by calling a metadata URI from the EC2 instance.
 // For more information, go to Instance Metadata (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/AESDG-chapter-instancedata.html)
@@ -842,11 +976,11 @@ type InstanceIdentity struct {
 	// A description of an Amazon EC2 instance that is generated when the instance
 	// is launched and exposed to the instance via the instance metadata service
 	// in the form of a JSON representation of an object.
-	Document *string `locationName:"document" type:"string"`
+	Document *string `locationName:"document" type:"string" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// A signature which can be used to verify the accuracy and authenticity of
 	// the information provided in the instance identity document.
-	Signature *string `locationName:"signature" type:"string"`
+	Signature *string `locationName:"signature" type:"string" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataInstanceIdentity `json:"-", xml:"-"`
 }
@@ -855,13 +989,18 @@ type metadataInstanceIdentity struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InstanceIdentity.
+func (s InstanceIdentity) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input to the ListPipelines action.
 type ListPipelinesInput struct {
 	// The starting point for the results to be returned. The first time you call
 	// ListPipelines, this value should be empty. As long as the action returns
 	// HasMoreResults as True, you can call ListPipelines again and pass the marker
 	// value from the response to retrieve the next set of results.
-	Marker *string `locationName:"marker" type:"string"`
+	Marker *string `locationName:"marker" type:"string" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataListPipelinesInput `json:"-", xml:"-"`
 }
@@ -870,6 +1009,11 @@ type metadataListPipelinesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListPipelinesInput.
+func (s ListPipelinesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output from the ListPipelines action.
 type ListPipelinesOutput struct {
 	// If True, there are more results that can be obtained by a subsequent call
@@ -879,7 +1023,7 @@ type ListPipelinesOutput struct {
 	// If not null, indicates the starting point for the set of pipeline identifiers
 	// that the next call to ListPipelines will retrieve. If null, there are no
 	// more pipeline identifiers.
-	Marker *string `locationName:"marker" type:"string"`
+	Marker *string `locationName:"marker" type:"string" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// A list of all the pipeline identifiers that your account has permission to
 	// access. If you require additional information about the pipelines, you can
@@ -893,6 +1037,11 @@ type metadataListPipelinesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListPipelinesOutput.
+func (s ListPipelinesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains a logical operation for comparing the value of a field with a specified
 // value.
 type Operator struct {
@@ -914,7 +1063,7 @@ type Operator struct {
 	// only alpha-numeric values, as symbols may be reserved by AWS Data Pipeline.
 	// User-defined fields that you add to a pipeline should prefix their name with
 	// the string "my".
-	Type *string `locationName:"type" type:"string"`
+	Type *string `locationName:"type" type:"string" enum:"EQ,REF_EQ,LE,GE,BETWEEN"`
 
 	// The value that the actual field value will be compared with.
 	Values []*string `locationName:"values" type:"list"`
@@ -926,13 +1075,58 @@ type metadataOperator struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Operator.
+func (s Operator) String() string {
+	return awsutil.StringValue(s)
+}
+
+// OperatorType is an enum of the values of OperatorType members.
+type OperatorType string
+
+// The values of OperatorType.
+const (
+	OperatorTypeEQ      OperatorType = "EQ"
+	OperatorTypeREFEQ   OperatorType = "REF_EQ"
+	OperatorTypeLE      OperatorType = "LE"
+	OperatorTypeGE      OperatorType = "GE"
+	OperatorTypeBETWEEN OperatorType = "BETWEEN"
+)
+
+// OperatorTypeValues returns the values of OperatorType known to this
+// version of the SDK. The service may accept values added since.
+func OperatorTypeValues() []OperatorType {
+	return []OperatorType{
+		OperatorType("EQ"),
+		OperatorType("REF_EQ"),
+		OperatorType("LE"),
+		OperatorType("GE"),
+		OperatorType("BETWEEN"),
+	}
+}
+
+// String returns the OperatorType as the string of a member.
+func (v OperatorType) String() string {
+	return string(v)
+}
+
+// ParseOperatorType returns the OperatorType of str, failing if it is not
+// one of the OperatorTypeValues.
+func ParseOperatorType(str string) (OperatorType, error) {
+	for _, v := range OperatorTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid OperatorType value %q", str)
+}
+
 // The attributes allowed or specified with a parameter object.
 type ParameterAttribute struct {
 	// The field identifier.
-	Key *string `locationName:"key" type:"string" required:"true"`
+	Key *string `locationName:"key" type:"string" min:"1" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The field value, expressed as a String.
-	StringValue *string `locationName:"stringValue" type:"string" required:"true"`
+	StringValue *string `locationName:"stringValue" type:"string" max:"10240" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	metadataParameterAttribute `json:"-", xml:"-"`
 }
@@ -941,13 +1135,18 @@ type metadataParameterAttribute struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ParameterAttribute.
+func (s ParameterAttribute) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains information about a parameter object.
 type ParameterObject struct {
 	// The attributes of the parameter object.
 	Attributes []*ParameterAttribute `locationName:"attributes" type:"list" required:"true"`
 
 	// Identifier of the parameter object.
-	ID *string `locationName:"id" type:"string" required:"true"`
+	ID *string `locationName:"id" type:"string" min:"1" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	metadataParameterObject `json:"-", xml:"-"`
 }
@@ -956,13 +1155,18 @@ type metadataParameterObject struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ParameterObject.
+func (s ParameterObject) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A value or list of parameter values.
 type ParameterValue struct {
 	// Identifier of the parameter value.
-	ID *string `locationName:"id" type:"string" required:"true"`
+	ID *string `locationName:"id" type:"string" min:"1" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The field value, expressed as a String.
-	StringValue *string `locationName:"stringValue" type:"string" required:"true"`
+	StringValue *string `locationName:"stringValue" type:"string" max:"10240" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	metadataParameterValue `json:"-", xml:"-"`
 }
@@ -971,27 +1175,32 @@ type metadataParameterValue struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ParameterValue.
+func (s ParameterValue) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains pipeline metadata.
 type PipelineDescription struct {
 	// Description of the pipeline.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// A list of read-only fields that contain metadata about the pipeline: @userId,
 	// @accountId, and @pipelineState.
 	Fields []*Field `locationName:"fields" type:"list" required:"true"`
 
 	// Name of the pipeline.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The pipeline identifier that was assigned by AWS Data Pipeline. This is a
 	// string of the form df-297EG78HU43EEXAMPLE.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// A list of tags to associated with a pipeline. Tags let you control access
 	// to pipelines. For more information, see Controlling User Access to Pipelines
 	// (http://docs.aws.amazon.com/datapipeline/latest/DeveloperGuide/dp-control-access.html)
 	// in the AWS Data Pipeline Developer Guide.
-	Tags []*Tag `locationName:"tags" type:"list"`
+	Tags []*Tag `locationName:"tags" type:"list" max:"10"`
 
 	metadataPipelineDescription `json:"-", xml:"-"`
 }
@@ -1000,14 +1209,19 @@ type metadataPipelineDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PipelineDescription.
+func (s PipelineDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the name and identifier of a pipeline.
 type PipelineIDName struct {
 	// Identifier of the pipeline that was assigned by AWS Data Pipeline. This is
 	// a string of the form df-297EG78HU43EEXAMPLE.
-	ID *string `locationName:"id" type:"string"`
+	ID *string `locationName:"id" type:"string" min:"1" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// Name of the pipeline.
-	Name *string `locationName:"name" type:"string"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataPipelineIDName `json:"-", xml:"-"`
 }
@@ -1016,6 +1230,11 @@ type metadataPipelineIDName struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PipelineIDName.
+func (s PipelineIDName) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains information about a pipeline object. This can be a logical, physical,
 // or physical attempt pipeline object. The complete set of components of a
 // pipeline defines the pipeline.
@@ -1024,10 +1243,10 @@ type PipelineObject struct {
 	Fields []*Field `locationName:"fields" type:"list" required:"true"`
 
 	// Identifier of the object.
-	ID *string `locationName:"id" type:"string" required:"true"`
+	ID *string `locationName:"id" type:"string" min:"1" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// Name of the object.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	metadataPipelineObject `json:"-", xml:"-"`
 }
@@ -1036,10 +1255,15 @@ type metadataPipelineObject struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PipelineObject.
+func (s PipelineObject) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The data type passed in as input to the PollForTask action.
 type PollForTaskInput struct {
 	// The public DNS name of the calling task runner.
-	Hostname *string `locationName:"hostname" type:"string"`
+	Hostname *string `locationName:"hostname" type:"string" min:"1" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// Identity information for the Amazon EC2 instance that is hosting the task
 	// runner. You can get this value by calling the URI, http://169.254.169.254/latest/meta-data/instance-id,
@@ -1054,7 +1278,7 @@ type PollForTaskInput struct {
 	// created. You can only specify a single value for workerGroup in the call
 	// to PollForTask. There are no wildcard values permitted in workerGroup, the
 	// string must be an exact, case-sensitive, match.
-	WorkerGroup *string `locationName:"workerGroup" type:"string" required:"true"`
+	WorkerGroup *string `locationName:"workerGroup" type:"string" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	metadataPollForTaskInput `json:"-", xml:"-"`
 }
@@ -1063,6 +1287,11 @@ type metadataPollForTaskInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PollForTaskInput.
+func (s PollForTaskInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output from the PollForTask action.
 type PollForTaskOutput struct {
 	// An instance of TaskObject. The returned object contains all the information
@@ -1079,6 +1308,11 @@ type metadataPollForTaskOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PollForTaskOutput.
+func (s PollForTaskOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input of the PutPipelineDefinition action.
 type PutPipelineDefinitionInput struct {
 	// A list of parameter objects used with the pipeline.
@@ -1088,7 +1322,7 @@ type PutPipelineDefinitionInput struct {
 	ParameterValues []*ParameterValue `locationName:"parameterValues" type:"list"`
 
 	// The identifier of the pipeline to be configured.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The objects that define the pipeline. These will overwrite the existing pipeline
 	// definition.
@@ -1101,6 +1335,11 @@ type metadataPutPipelineDefinitionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutPipelineDefinitionInput.
+func (s PutPipelineDefinitionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output of the PutPipelineDefinition action.
 type PutPipelineDefinitionOutput struct {
 	// If True, there were validation errors. If errored is True, the pipeline definition
@@ -1123,6 +1362,11 @@ type metadataPutPipelineDefinitionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutPipelineDefinitionOutput.
+func (s PutPipelineDefinitionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Defines the query to run against an object.
 type Query struct {
 	// List of selectors that define the query. An object must satisfy all of the
@@ -1136,6 +1380,11 @@ type metadataQuery struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Query.
+func (s Query) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the QueryObjects action.
 type QueryObjectsInput struct {
 	// Specifies the maximum number of object names that QueryObjects will return
@@ -1146,10 +1395,10 @@ type QueryObjectsInput struct {
 	// QueryObjects, this value should be empty. As long as the action returns HasMoreResults
 	// as True, you can call QueryObjects again and pass the marker value from the
 	// response to retrieve the next set of results.
-	Marker *string `locationName:"marker" type:"string"`
+	Marker *string `locationName:"marker" type:"string" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// Identifier of the pipeline to be queried for object names.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// Query that defines the objects to be returned. The Query object can contain
 	// a maximum of ten selectors. The conditions in the query are limited to top-level
@@ -1159,7 +1408,7 @@ type QueryObjectsInput struct {
 
 	// Specifies whether the query applies to components or instances. Allowable
 	// values: COMPONENT, INSTANCE, ATTEMPT.
-	Sphere *string `locationName:"sphere" type:"string" required:"true"`
+	Sphere *string `locationName:"sphere" type:"string" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	metadataQueryObjectsInput `json:"-", xml:"-"`
 }
@@ -1168,6 +1417,11 @@ type metadataQueryObjectsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the QueryObjectsInput.
+func (s QueryObjectsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output from the QueryObjects action.
 type QueryObjectsOutput struct {
 	// If True, there are more results that can be obtained by a subsequent call
@@ -1180,7 +1434,7 @@ type QueryObjectsOutput struct {
 	// The starting point for the results to be returned. As long as the action
 	// returns HasMoreResults as True, you can call QueryObjects again and pass
 	// the marker value from the response to retrieve the next set of results.
-	Marker *string `locationName:"marker" type:"string"`
+	Marker *string `locationName:"marker" type:"string" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataQueryObjectsOutput `json:"-", xml:"-"`
 }
@@ -1189,10 +1443,15 @@ type metadataQueryObjectsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the QueryObjectsOutput.
+func (s QueryObjectsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input to the RemoveTags action.
 type RemoveTagsInput struct {
 	// The pipeline from which you want to remove tags.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The keys of the tags you wish to remove.
 	TagKeys []*string `locationName:"tagKeys" type:"list" required:"true"`
@@ -1204,6 +1463,11 @@ type metadataRemoveTagsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RemoveTagsInput.
+func (s RemoveTagsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The result of the RemoveTags action.
 type RemoveTagsOutput struct {
 	metadataRemoveTagsOutput `json:"-", xml:"-"`
@@ -1213,6 +1477,11 @@ type metadataRemoveTagsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RemoveTagsOutput.
+func (s RemoveTagsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the ReportTaskProgress action.
 type ReportTaskProgressInput struct {
 	// Key-value pairs that define the properties of the ReportTaskProgressInput
@@ -1222,7 +1491,7 @@ type ReportTaskProgressInput struct {
 	// Identifier of the task assigned to the task runner. This value is provided
 	// in the TaskObject that the service returns with the response for the PollForTask
 	// action.
-	TaskID *string `locationName:"taskId" type:"string" required:"true"`
+	TaskID *string `locationName:"taskId" type:"string" min:"1" max:"2048" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	metadataReportTaskProgressInput `json:"-", xml:"-"`
 }
@@ -1231,6 +1500,11 @@ type metadataReportTaskProgressInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReportTaskProgressInput.
+func (s ReportTaskProgressInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output from the ReportTaskProgress action.
 type ReportTaskProgressOutput struct {
 	// If True, the calling task runner should cancel processing of the task. The
@@ -1244,24 +1518,29 @@ type metadataReportTaskProgressOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReportTaskProgressOutput.
+func (s ReportTaskProgressOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the ReportTaskRunnerHeartbeat action.
 type ReportTaskRunnerHeartbeatInput struct {
 	// The public DNS name of the calling task runner.
-	Hostname *string `locationName:"hostname" type:"string"`
+	Hostname *string `locationName:"hostname" type:"string" min:"1" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The identifier of the task runner. This value should be unique across your
 	// AWS account. In the case of AWS Data Pipeline Task Runner launched on a resource
 	// managed by AWS Data Pipeline, the web service provides a unique identifier
 	// when it launches the application. If you have written a custom task runner,
 	// you should assign a unique identifier for the task runner.
-	TaskRunnerID *string `locationName:"taskrunnerId" type:"string" required:"true"`
+	TaskRunnerID *string `locationName:"taskrunnerId" type:"string" min:"1" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// Indicates the type of task the task runner is configured to accept and process.
 	// The worker group is set as a field on objects in the pipeline when they are
 	// created. You can only specify a single value for workerGroup in the call
 	// to ReportTaskRunnerHeartbeat. There are no wildcard values permitted in workerGroup,
 	// the string must be an exact, case-sensitive, match.
-	WorkerGroup *string `locationName:"workerGroup" type:"string"`
+	WorkerGroup *string `locationName:"workerGroup" type:"string" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataReportTaskRunnerHeartbeatInput `json:"-", xml:"-"`
 }
@@ -1270,6 +1549,11 @@ type metadataReportTaskRunnerHeartbeatInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReportTaskRunnerHeartbeatInput.
+func (s ReportTaskRunnerHeartbeatInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output from the ReportTaskRunnerHeartbeat action.
 type ReportTaskRunnerHeartbeatOutput struct {
 	// Indicates whether the calling task runner should terminate. If True, the
@@ -1283,6 +1567,11 @@ type metadataReportTaskRunnerHeartbeatOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReportTaskRunnerHeartbeatOutput.
+func (s ReportTaskRunnerHeartbeatOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A comparision that is used to determine whether a query should return this
 // object.
 type Selector struct {
@@ -1290,7 +1579,7 @@ type Selector struct {
 	// is the "key" portion of the field definition in the pipeline definition syntax
 	// that is used by the AWS Data Pipeline API. If the field is not set on the
 	// object, the condition fails.
-	FieldName *string `locationName:"fieldName" type:"string"`
+	FieldName *string `locationName:"fieldName" type:"string" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// Contains a logical operation for comparing the value of a field with a specified
 	// value.
@@ -1303,6 +1592,11 @@ type metadataSelector struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Selector.
+func (s Selector) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input to the SetStatus action.
 type SetStatusInput struct {
 	// Identifies an array of objects. The corresponding objects can be either physical
@@ -1310,12 +1604,12 @@ type SetStatusInput struct {
 	ObjectIDs []*string `locationName:"objectIds" type:"list" required:"true"`
 
 	// Identifies the pipeline that contains the objects.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// Specifies the status to be set on all the objects in objectIds. For components,
 	// this can be either PAUSE or RESUME. For instances, this can be either TRY_CANCEL,
 	// RERUN, or MARK_FINISHED.
-	Status *string `locationName:"status" type:"string" required:"true"`
+	Status *string `locationName:"status" type:"string" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	metadataSetStatusInput `json:"-", xml:"-"`
 }
@@ -1324,6 +1618,11 @@ type metadataSetStatusInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetStatusInput.
+func (s SetStatusInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SetStatusOutput struct {
 	metadataSetStatusOutput `json:"-", xml:"-"`
 }
@@ -1332,13 +1631,18 @@ type metadataSetStatusOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetStatusOutput.
+func (s SetStatusOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input of the SetTaskStatus action.
 type SetTaskStatusInput struct {
 	// If an error occurred during the task, this value specifies an id value that
 	// represents the error. This value is set on the physical attempt object. It
 	// is used to display error information to the user. It should not start with
 	// string "Service_" which is reserved by the system.
-	ErrorID *string `locationName:"errorId" type:"string"`
+	ErrorID *string `locationName:"errorId" type:"string" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// If an error occurred during the task, this value specifies a text description
 	// of the error. This value is set on the physical attempt object. It is used
@@ -1350,15 +1654,15 @@ type SetTaskStatusInput struct {
 	// associated with the error. This value is set on the physical attempt object.
 	// It is used to display error information to the user. The web service does
 	// not parse this value.
-	ErrorStackTrace *string `locationName:"errorStackTrace" type:"string"`
+	ErrorStackTrace *string `locationName:"errorStackTrace" type:"string" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// Identifies the task assigned to the task runner. This value is set in the
 	// TaskObject that is returned by the PollForTask action.
-	TaskID *string `locationName:"taskId" type:"string" required:"true"`
+	TaskID *string `locationName:"taskId" type:"string" min:"1" max:"2048" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// If FINISHED, the task successfully completed. If FAILED the task ended unsuccessfully.
 	// The FALSE value is used by preconditions.
-	TaskStatus *string `locationName:"taskStatus" type:"string" required:"true"`
+	TaskStatus *string `locationName:"taskStatus" type:"string" enum:"FINISHED,FAILED,FALSE" required:"true"`
 
 	metadataSetTaskStatusInput `json:"-", xml:"-"`
 }
@@ -1367,6 +1671,11 @@ type metadataSetTaskStatusInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetTaskStatusInput.
+func (s SetTaskStatusInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output from the SetTaskStatus action.
 type SetTaskStatusOutput struct {
 	metadataSetTaskStatusOutput `json:"-", xml:"-"`
@@ -1376,6 +1685,11 @@ type metadataSetTaskStatusOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetTaskStatusOutput.
+func (s SetTaskStatusOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Tags are key/value pairs defined by a user and associated with a pipeline
 // to control access. AWS Data Pipeline allows you to associate ten tags per
 // pipeline. For more information, see Controlling User Access to Pipelines
@@ -1385,12 +1699,12 @@ type Tag struct {
 	// The key name of a tag defined by a user. For more information, see Controlling
 	// User Access to Pipelines (http://docs.aws.amazon.com/datapipeline/latest/DeveloperGuide/dp-control-access.html)
 	// in the AWS Data Pipeline Developer Guide.
-	Key *string `locationName:"key" type:"string" required:"true"`
+	Key *string `locationName:"key" type:"string" min:"1" max:"128" required:"true"`
 
 	// The optional value portion of a tag defined by a user. For more information,
 	// see Controlling User Access to Pipelines (http://docs.aws.amazon.com/datapipeline/latest/DeveloperGuide/dp-control-access.html)
 	// in the AWS Data Pipeline Developer Guide.
-	Value *string `locationName:"value" type:"string" required:"true"`
+	Value *string `locationName:"value" type:"string" max:"256" required:"true"`
 
 	metadataTag `json:"-", xml:"-"`
 }
@@ -1399,22 +1713,27 @@ type metadataTag struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Tag.
+func (s Tag) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains information about a pipeline task that is assigned to a task runner.
 type TaskObject struct {
 	// Identifier of the pipeline task attempt object. AWS Data Pipeline uses this
 	// value to track how many times a task is attempted.
-	AttemptID *string `locationName:"attemptId" type:"string"`
+	AttemptID *string `locationName:"attemptId" type:"string" min:"1" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// Connection information for the location where the task runner will publish
 	// the output of the task.
 	Objects *map[string]*PipelineObject `locationName:"objects" type:"map"`
 
 	// Identifier of the pipeline that provided the task.
-	PipelineID *string `locationName:"pipelineId" type:"string"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// An internal identifier for the task. This ID is passed to the SetTaskStatus
 	// and ReportTaskProgress actions.
-	TaskID *string `locationName:"taskId" type:"string"`
+	TaskID *string `locationName:"taskId" type:"string" min:"1" max:"2048" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataTaskObject `json:"-", xml:"-"`
 }
@@ -1423,6 +1742,47 @@ type metadataTaskObject struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TaskObject.
+func (s TaskObject) String() string {
+	return awsutil.StringValue(s)
+}
+
+// TaskStatus is an enum of the values of TaskStatus members.
+type TaskStatus string
+
+// The values of TaskStatus.
+const (
+	TaskStatusFINISHED TaskStatus = "FINISHED"
+	TaskStatusFAILED   TaskStatus = "FAILED"
+	TaskStatusFALSE    TaskStatus = "FALSE"
+)
+
+// TaskStatusValues returns the values of TaskStatus known to this
+// version of the SDK. The service may accept values added since.
+func TaskStatusValues() []TaskStatus {
+	return []TaskStatus{
+		TaskStatus("FINISHED"),
+		TaskStatus("FAILED"),
+		TaskStatus("FALSE"),
+	}
+}
+
+// String returns the TaskStatus as the string of a member.
+func (v TaskStatus) String() string {
+	return string(v)
+}
+
+// ParseTaskStatus returns the TaskStatus of str, failing if it is not
+// one of the TaskStatusValues.
+func ParseTaskStatus(str string) (TaskStatus, error) {
+	for _, v := range TaskStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid TaskStatus value %q", str)
+}
+
 // The input of the ValidatePipelineDefinition action.
 type ValidatePipelineDefinitionInput struct {
 	// A list of parameter objects used with the pipeline.
@@ -1432,7 +1792,7 @@ type ValidatePipelineDefinitionInput struct {
 	ParameterValues []*ParameterValue `locationName:"parameterValues" type:"list"`
 
 	// Identifies the pipeline whose definition is to be validated.
-	PipelineID *string `locationName:"pipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"pipelineId" type:"string" min:"1" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// A list of objects that define the pipeline changes to validate against the
 	// pipeline.
@@ -1445,6 +1805,11 @@ type metadataValidatePipelineDefinitionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ValidatePipelineDefinitionInput.
+func (s ValidatePipelineDefinitionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output from the ValidatePipelineDefinition action.
 type ValidatePipelineDefinitionOutput struct {
 	// If True, there were validation errors.
@@ -1463,6 +1828,11 @@ type metadataValidatePipelineDefinitionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ValidatePipelineDefinitionOutput.
+func (s ValidatePipelineDefinitionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Defines a validation error returned by PutPipelineDefinition or ValidatePipelineDefinition.
 // Validation errors prevent pipeline activation. The set of validation errors
 // that can be returned are defined by AWS Data Pipeline.
@@ -1471,7 +1841,7 @@ type ValidationError struct {
 	Errors []*string `locationName:"errors" type:"list"`
 
 	// The identifier of the object that contains the validation error.
-	ID *string `locationName:"id" type:"string"`
+	ID *string `locationName:"id" type:"string" min:"1" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataValidationError `json:"-", xml:"-"`
 }
@@ -1480,12 +1850,17 @@ type metadataValidationError struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ValidationError.
+func (s ValidationError) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Defines a validation warning returned by PutPipelineDefinition or ValidatePipelineDefinition.
 // Validation warnings do not prevent pipeline activation. The set of validation
 // warnings that can be returned are defined by AWS Data Pipeline.
 type ValidationWarning struct {
 	// The identifier of the object that contains the validation warning.
-	ID *string `locationName:"id" type:"string"`
+	ID *string `locationName:"id" type:"string" min:"1" max:"1024" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// A description of the validation warning.
 	Warnings []*string `locationName:"warnings" type:"list"`
@@ -1495,4 +1870,9 @@ type ValidationWarning struct {
 
 type metadataValidationWarning struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the ValidationWarning.
+func (s ValidationWarning) String() string {
+	return awsutil.StringValue(s)
 }
\ No newline at end of file
@@ -4,7 +4,10 @@
 package directconnect
 
 import (
+	"fmt"
+
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // AllocateConnectionOnInterconnectRequest generates a request for the AllocateConnectionOnInterconnect operation.
@@ -632,6 +635,11 @@ type metadataAllocateConnectionOnInterconnectInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AllocateConnectionOnInterconnectInput.
+func (s AllocateConnectionOnInterconnectInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the AllocatePrivateVirtualInterface operation.
 type AllocatePrivateVirtualInterfaceInput struct {
 	// The connection ID on which the private virtual interface is provisioned.
@@ -656,6 +664,11 @@ type metadataAllocatePrivateVirtualInterfaceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AllocatePrivateVirtualInterfaceInput.
+func (s AllocatePrivateVirtualInterfaceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the AllocatePublicVirtualInterface operation.
 type AllocatePublicVirtualInterfaceInput struct {
 	// The connection ID on which the public virtual interface is provisioned.
@@ -680,6 +693,11 @@ type metadataAllocatePublicVirtualInterfaceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AllocatePublicVirtualInterfaceInput.
+func (s AllocatePublicVirtualInterfaceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the ConfirmConnection operation.
 type ConfirmConnectionInput struct {
 	// ID of the connection.
@@ -696,6 +714,11 @@ type metadataConfirmConnectionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ConfirmConnectionInput.
+func (s ConfirmConnectionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The response received when ConfirmConnection is called.
 type ConfirmConnectionOutput struct {
 	// State of the connection.   Ordering: The initial state of a hosted connection
@@ -708,7 +731,7 @@ type ConfirmConnectionOutput struct {
 	// for use.  Down: The network link is down.  Deleted: The connection has been
 	// deleted.  Rejected: A hosted connection in the 'Ordering' state will enter
 	// the 'Rejected' state if it is deleted by the end customer.
-	ConnectionState *string `locationName:"connectionState" type:"string"`
+	ConnectionState *string `locationName:"connectionState" type:"string" enum:"ordering,requested,pending,available,down,deleting,deleted,rejected"`
 
 	metadataConfirmConnectionOutput `json:"-", xml:"-"`
 }
@@ -717,6 +740,11 @@ type metadataConfirmConnectionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ConfirmConnectionOutput.
+func (s ConfirmConnectionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the ConfirmPrivateVirtualInterface operation.
 type ConfirmPrivateVirtualInterfaceInput struct {
 	// ID of the virtual private gateway that will be attached to the virtual interface.
@@ -742,6 +770,11 @@ type metadataConfirmPrivateVirtualInterfaceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ConfirmPrivateVirtualInterfaceInput.
+func (s ConfirmPrivateVirtualInterfaceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The response received when ConfirmPrivateVirtualInterface is called.
 type ConfirmPrivateVirtualInterfaceOutput struct {
 	// State of the virtual interface.   Confirming: The creation of the virtual
@@ -760,7 +793,7 @@ type ConfirmPrivateVirtualInterfaceOutput struct {
 	// of the virtual interface. If a virtual interface in the 'Confirming' state
 	// is deleted by the virtual interface owner, the virtual interface will enter
 	// the 'Rejected' state.
-	VirtualInterfaceState *string `locationName:"virtualInterfaceState" type:"string"`
+	VirtualInterfaceState *string `locationName:"virtualInterfaceState" type:"string" enum:"confirming,verifying,pending,available,deleting,deleted,rejected"`
 
 	metadataConfirmPrivateVirtualInterfaceOutput `json:"-", xml:"-"`
 }
@@ -769,6 +802,11 @@ type metadataConfirmPrivateVirtualInterfaceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ConfirmPrivateVirtualInterfaceOutput.
+func (s ConfirmPrivateVirtualInterfaceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the ConfirmPublicVirtualInterface operation.
 type ConfirmPublicVirtualInterfaceInput struct {
 	// ID of the virtual interface.
@@ -785,6 +823,11 @@ type metadataConfirmPublicVirtualInterfaceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ConfirmPublicVirtualInterfaceInput.
+func (s ConfirmPublicVirtualInterfaceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The response received when ConfirmPublicVirtualInterface is called.
 type ConfirmPublicVirtualInterfaceOutput struct {
 	// State of the virtual interface.   Confirming: The creation of the virtual
@@ -803,7 +846,7 @@ type ConfirmPublicVirtualInterfaceOutput struct {
 	// of the virtual interface. If a virtual interface in the 'Confirming' state
 	// is deleted by the virtual interface owner, the virtual interface will enter
 	// the 'Rejected' state.
-	VirtualInterfaceState *string `locationName:"virtualInterfaceState" type:"string"`
+	VirtualInterfaceState *string `locationName:"virtualInterfaceState" type:"string" enum:"confirming,verifying,pending,available,deleting,deleted,rejected"`
 
 	metadataConfirmPublicVirtualInterfaceOutput `json:"-", xml:"-"`
 }
@@ -812,6 +855,11 @@ type metadataConfirmPublicVirtualInterfaceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ConfirmPublicVirtualInterfaceOutput.
+func (s ConfirmPublicVirtualInterfaceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A connection represents the physical network connection between the AWS Direct
 // Connect location and the customer.
 type Connection struct {
@@ -846,7 +894,7 @@ type Connection struct {
 	// for use.  Down: The network link is down.  Deleted: The connection has been
 	// deleted.  Rejected: A hosted connection in the 'Ordering' state will enter
 	// the 'Rejected' state if it is deleted by the end customer.
-	ConnectionState *string `locationName:"connectionState" type:"string"`
+	ConnectionState *string `locationName:"connectionState" type:"string" enum:"ordering,requested,pending,available,down,deleting,deleted,rejected"`
 
 	// Where the connection is located.
 	//
@@ -878,6 +926,57 @@ type metadataConnection struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Connection.
+func (s Connection) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ConnectionState is an enum of the values of ConnectionState members.
+type ConnectionState string
+
+// The values of ConnectionState.
+const (
+	ConnectionStateOrdering  ConnectionState = "ordering"
+	ConnectionStateRequested ConnectionState = "requested"
+	ConnectionStatePending   ConnectionState = "pending"
+	ConnectionStateAvailable ConnectionState = "available"
+	ConnectionStateDown      ConnectionState = "down"
+	ConnectionStateDeleting  ConnectionState = "deleting"
+	ConnectionStateDeleted   ConnectionState = "deleted"
+	ConnectionStateRejected  ConnectionState = "rejected"
+)
+
+// ConnectionStateValues returns the values of ConnectionState known to this
+// version of the SDK. The service may accept values added since.
+func ConnectionStateValues() []ConnectionState {
+	return []ConnectionState{
+		ConnectionState("ordering"),
+		ConnectionState("requested"),
+		ConnectionState("pending"),
+		ConnectionState("available"),
+		ConnectionState("down"),
+		ConnectionState("deleting"),
+		ConnectionState("deleted"),
+		ConnectionState("rejected"),
+	}
+}
+
+// String returns the ConnectionState as the string of a member.
+func (v ConnectionState) String() string {
+	return string(v)
+}
+
+// ParseConnectionState returns the ConnectionState of str, failing if it is not
+// one of the ConnectionStateValues.
+func ParseConnectionState(str string) (ConnectionState, error) {
+	for _, v := range ConnectionStateValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ConnectionState value %q", str)
+}
+
 // A structure containing a list of connections.
 type Connections struct {
 	// A list of connections.
@@ -890,6 +989,11 @@ type metadataConnections struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Connections.
+func (s Connections) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the CreateConnection operation.
 type CreateConnectionInput struct {
 	// Bandwidth of the connection.
@@ -920,6 +1024,11 @@ type metadataCreateConnectionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateConnectionInput.
+func (s CreateConnectionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the CreateInterconnect operation.
 type CreateInterconnectInput struct {
 	// The port bandwidth
@@ -952,6 +1061,11 @@ type metadataCreateInterconnectInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateInterconnectInput.
+func (s CreateInterconnectInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the CreatePrivateVirtualInterface operation.
 type CreatePrivateVirtualInterfaceInput struct {
 	// ID of the connection.
@@ -973,6 +1087,11 @@ type metadataCreatePrivateVirtualInterfaceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreatePrivateVirtualInterfaceInput.
+func (s CreatePrivateVirtualInterfaceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the CreatePublicVirtualInterface operation.
 type CreatePublicVirtualInterfaceInput struct {
 	// ID of the connection.
@@ -994,6 +1113,11 @@ type metadataCreatePublicVirtualInterfaceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreatePublicVirtualInterfaceInput.
+func (s CreatePublicVirtualInterfaceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the DeleteConnection operation.
 type DeleteConnectionInput struct {
 	// ID of the connection.
@@ -1010,6 +1134,11 @@ type metadataDeleteConnectionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteConnectionInput.
+func (s DeleteConnectionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the DeleteInterconnect operation.
 type DeleteInterconnectInput struct {
 	// The ID of the interconnect.
@@ -1024,6 +1153,11 @@ type metadataDeleteInterconnectInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteInterconnectInput.
+func (s DeleteInterconnectInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The response received when DeleteInterconnect is called.
 type DeleteInterconnectOutput struct {
 	// State of the interconnect.   Requested: The initial state of an interconnect.
@@ -1032,7 +1166,7 @@ type DeleteInterconnectOutput struct {
 	// and is being initialized.  Available: The network link is up, and the interconnect
 	// is ready for use.  Down: The network link is down.  Deleted: The interconnect
 	// has been deleted.
-	InterconnectState *string `locationName:"interconnectState" type:"string"`
+	InterconnectState *string `locationName:"interconnectState" type:"string" enum:"requested,pending,available,down,deleting,deleted"`
 
 	metadataDeleteInterconnectOutput `json:"-", xml:"-"`
 }
@@ -1041,6 +1175,11 @@ type metadataDeleteInterconnectOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteInterconnectOutput.
+func (s DeleteInterconnectOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the DeleteVirtualInterface operation.
 type DeleteVirtualInterfaceInput struct {
 	// ID of the virtual interface.
@@ -1057,6 +1196,11 @@ type metadataDeleteVirtualInterfaceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteVirtualInterfaceInput.
+func (s DeleteVirtualInterfaceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The response received when DeleteVirtualInterface is called.
 type DeleteVirtualInterfaceOutput struct {
 	// State of the virtual interface.   Confirming: The creation of the virtual
@@ -1075,7 +1219,7 @@ type DeleteVirtualInterfaceOutput struct {
 	// of the virtual interface. If a virtual interface in the 'Confirming' state
 	// is deleted by the virtual interface owner, the virtual interface will enter
 	// the 'Rejected' state.
-	VirtualInterfaceState *string `locationName:"virtualInterfaceState" type:"string"`
+	VirtualInterfaceState *string `locationName:"virtualInterfaceState" type:"string" enum:"confirming,verifying,pending,available,deleting,deleted,rejected"`
 
 	metadataDeleteVirtualInterfaceOutput `json:"-", xml:"-"`
 }
@@ -1084,6 +1228,11 @@ type metadataDeleteVirtualInterfaceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteVirtualInterfaceOutput.
+func (s DeleteVirtualInterfaceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the DescribeConnections operation.
 type DescribeConnectionsInput struct {
 	// ID of the connection.
@@ -1100,6 +1249,11 @@ type metadataDescribeConnectionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeConnectionsInput.
+func (s DescribeConnectionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the DescribeConnectionsOnInterconnect operation.
 type DescribeConnectionsOnInterconnectInput struct {
 	// ID of the interconnect on which a list of connection is provisioned.
@@ -1116,6 +1270,11 @@ type metadataDescribeConnectionsOnInterconnectInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeConnectionsOnInterconnectInput.
+func (s DescribeConnectionsOnInterconnectInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the DescribeInterconnects operation.
 type DescribeInterconnectsInput struct {
 	// The ID of the interconnect.
@@ -1130,6 +1289,11 @@ type metadataDescribeInterconnectsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeInterconnectsInput.
+func (s DescribeInterconnectsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A structure containing a list of interconnects.
 type DescribeInterconnectsOutput struct {
 	// A list of interconnects.
@@ -1142,6 +1306,11 @@ type metadataDescribeInterconnectsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeInterconnectsOutput.
+func (s DescribeInterconnectsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeLocationsInput struct {
 	metadataDescribeLocationsInput `json:"-", xml:"-"`
 }
@@ -1150,6 +1319,11 @@ type metadataDescribeLocationsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeLocationsInput.
+func (s DescribeLocationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeLocationsOutput struct {
 	Locations []*Location `locationName:"locations" type:"list"`
 
@@ -1160,6 +1334,11 @@ type metadataDescribeLocationsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeLocationsOutput.
+func (s DescribeLocationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeVirtualGatewaysInput struct {
 	metadataDescribeVirtualGatewaysInput `json:"-", xml:"-"`
 }
@@ -1168,6 +1347,11 @@ type metadataDescribeVirtualGatewaysInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeVirtualGatewaysInput.
+func (s DescribeVirtualGatewaysInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A structure containing a list of virtual private gateways.
 type DescribeVirtualGatewaysOutput struct {
 	// A list of virtual private gateways.
@@ -1180,6 +1364,11 @@ type metadataDescribeVirtualGatewaysOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeVirtualGatewaysOutput.
+func (s DescribeVirtualGatewaysOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the parameters to the DescribeVirtualInterfaces operation.
 type DescribeVirtualInterfacesInput struct {
 	// ID of the connection.
@@ -1203,6 +1392,11 @@ type metadataDescribeVirtualInterfacesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeVirtualInterfacesInput.
+func (s DescribeVirtualInterfacesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A structure containing a list of virtual interfaces.
 type DescribeVirtualInterfacesOutput struct {
 	// A list of virtual interfaces.
@@ -1215,6 +1409,11 @@ type metadataDescribeVirtualInterfacesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeVirtualInterfacesOutput.
+func (s DescribeVirtualInterfacesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // An interconnect is a connection that can host other connections.
 //
 // Like a standard AWS Direct Connect connection, an interconnect represents
@@ -1251,7 +1450,7 @@ type Interconnect struct {
 	// and is being initialized.  Available: The network link is up, and the interconnect
 	// is ready for use.  Down: The network link is down.  Deleted: The interconnect
 	// has been deleted.
-	InterconnectState *string `locationName:"interconnectState" type:"string"`
+	InterconnectState *string `locationName:"interconnectState" type:"string" enum:"requested,pending,available,down,deleting,deleted"`
 
 	// Where the connection is located.
 	//
@@ -1274,6 +1473,53 @@ type metadataInterconnect struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Interconnect.
+func (s Interconnect) String() string {
+	return awsutil.StringValue(s)
+}
+
+// InterconnectState is an enum of the values of InterconnectState members.
+type InterconnectState string
+
+// The values of InterconnectState.
+const (
+	InterconnectStateRequested InterconnectState = "requested"
+	InterconnectStatePending   InterconnectState = "pending"
+	InterconnectStateAvailable InterconnectState = "available"
+	InterconnectStateDown      InterconnectState = "down"
+	InterconnectStateDeleting  InterconnectState = "deleting"
+	InterconnectStateDeleted   InterconnectState = "deleted"
+)
+
+// InterconnectStateValues returns the values of InterconnectState known to this
+// version of the SDK. The service may accept values added since.
+func InterconnectStateValues() []InterconnectState {
+	return []InterconnectState{
+		InterconnectState("requested"),
+		InterconnectState("pending"),
+		InterconnectState("available"),
+		InterconnectState("down"),
+		InterconnectState("deleting"),
+		InterconnectState("deleted"),
+	}
+}
+
+// String returns the InterconnectState as the string of a member.
+func (v InterconnectState) String() string {
+	return string(v)
+}
+
+// ParseInterconnectState returns the InterconnectState of str, failing if it is not
+// one of the InterconnectStateValues.
+func ParseInterconnectState(str string) (InterconnectState, error) {
+	for _, v := range InterconnectStateValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid InterconnectState value %q", str)
+}
+
 // An AWS Direct Connect location where connections and interconnects can be
 // requested.
 type Location struct {
@@ -1291,6 +1537,11 @@ type metadataLocation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Location.
+func (s Location) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A structure containing information about a new private virtual interface.
 type NewPrivateVirtualInterface struct {
 	// Autonomous system (AS) number for Border Gateway Protocol (BGP) configuration.
@@ -1336,6 +1587,11 @@ type metadataNewPrivateVirtualInterface struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the NewPrivateVirtualInterface.
+func (s NewPrivateVirtualInterface) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A structure containing information about a private virtual interface that
 // will be provisioned on a connection.
 type NewPrivateVirtualInterfaceAllocation struct {
@@ -1376,6 +1632,11 @@ type metadataNewPrivateVirtualInterfaceAllocation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the NewPrivateVirtualInterfaceAllocation.
+func (s NewPrivateVirtualInterfaceAllocation) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A structure containing information about a new public virtual interface.
 type NewPublicVirtualInterface struct {
 	// Autonomous system (AS) number for Border Gateway Protocol (BGP) configuration.
@@ -1419,6 +1680,11 @@ type metadataNewPublicVirtualInterface struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the NewPublicVirtualInterface.
+func (s NewPublicVirtualInterface) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A structure containing information about a public virtual interface that
 // will be provisioned on a connection.
 type NewPublicVirtualInterfaceAllocation struct {
@@ -1463,6 +1729,11 @@ type metadataNewPublicVirtualInterfaceAllocation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the NewPublicVirtualInterfaceAllocation.
+func (s NewPublicVirtualInterfaceAllocation) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A route filter prefix that the customer can advertise through Border Gateway
 // Protocol (BGP) over a public virtual interface.
 type RouteFilterPrefix struct {
@@ -1479,6 +1750,11 @@ type metadataRouteFilterPrefix struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RouteFilterPrefix.
+func (s RouteFilterPrefix) String() string {
+	return awsutil.StringValue(s)
+}
+
 // You can create one or more AWS Direct Connect private virtual interfaces
 // linking to your virtual private gateway.
 //
@@ -1505,6 +1781,11 @@ type metadataVirtualGateway struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the VirtualGateway.
+func (s VirtualGateway) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A virtual interface (VLAN) transmits the traffic between the AWS Direct Connect
 // location and the customer.
 type VirtualInterface struct {
@@ -1590,7 +1871,7 @@ type VirtualInterface struct {
 	// of the virtual interface. If a virtual interface in the 'Confirming' state
 	// is deleted by the virtual interface owner, the virtual interface will enter
 	// the 'Rejected' state.
-	VirtualInterfaceState *string `locationName:"virtualInterfaceState" type:"string"`
+	VirtualInterfaceState *string `locationName:"virtualInterfaceState" type:"string" enum:"confirming,verifying,pending,available,deleting,deleted,rejected"`
 
 	// The type of virtual interface.
 	//
@@ -1603,4 +1884,53 @@ type VirtualInterface struct {
 
 type metadataVirtualInterface struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the VirtualInterface.
+func (s VirtualInterface) String() string {
+	return awsutil.StringValue(s)
+}
+
+// VirtualInterfaceState is an enum of the values of VirtualInterfaceState members.
+type VirtualInterfaceState string
+
+// The values of VirtualInterfaceState.
+const (
+	VirtualInterfaceStateConfirming VirtualInterfaceState = "confirming"
+	VirtualInterfaceStateVerifying  VirtualInterfaceState = "verifying"
+	VirtualInterfaceStatePending    VirtualInterfaceState = "pending"
+	VirtualInterfaceStateAvailable  VirtualInterfaceState = "available"
+	VirtualInterfaceStateDeleting   VirtualInterfaceState = "deleting"
+	VirtualInterfaceStateDeleted    VirtualInterfaceState = "deleted"
+	VirtualInterfaceStateRejected   VirtualInterfaceState = "rejected"
+)
+
+// VirtualInterfaceStateValues returns the values of VirtualInterfaceState known to this
+// version of the SDK. The service may accept values added since.
+func VirtualInterfaceStateValues() []VirtualInterfaceState {
+	return []VirtualInterfaceState{
+		VirtualInterfaceState("confirming"),
+		VirtualInterfaceState("verifying"),
+		VirtualInterfaceState("pending"),
+		VirtualInterfaceState("available"),
+		VirtualInterfaceState("deleting"),
+		VirtualInterfaceState("deleted"),
+		VirtualInterfaceState("rejected"),
+	}
+}
+
+// String returns the VirtualInterfaceState as the string of a member.
+func (v VirtualInterfaceState) String() string {
+	return string(v)
+}
+
+// ParseVirtualInterfaceState returns the VirtualInterfaceState of str, failing if it is not
+// one of the VirtualInterfaceStateValues.
+func ParseVirtualInterfaceState(str string) (VirtualInterfaceState, error) {
+	for _, v := range VirtualInterfaceStateValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualInterfaceState value %q", str)
 }
\ No newline at end of file
@@ -4,9 +4,11 @@
 package dynamodb
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // BatchGetItemRequest generates a request for the BatchGetItem operation.
@@ -365,6 +367,11 @@ func (c *DynamoDB) ListTablesRequest(input *ListTablesInput) (req *aws.Request,
 			Name:       "ListTables",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"ExclusiveStartTableName"},
+				OutputTokens: []string{"LastEvaluatedTableName"},
+				LimitToken:   "Limit",
+			},
 		}
 	}
 
@@ -384,6 +391,16 @@ func (c *DynamoDB) ListTables(input *ListTablesInput) (output *ListTablesOutput,
 	return
 }
 
+// ListTablesPages iterates over the pages of a ListTables operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *DynamoDB) ListTablesPages(input *ListTablesInput, fn func(p *ListTablesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListTablesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListTablesOutput), lastPage)
+	})
+}
+
 var opListTables *aws.Operation
 
 // PutItemRequest generates a request for the PutItem operation.
@@ -443,6 +460,11 @@ func (c *DynamoDB) QueryRequest(input *QueryInput) (req *aws.Request, output *Qu
 			Name:       "Query",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"ExclusiveStartKey"},
+				OutputTokens: []string{"LastEvaluatedKey"},
+				LimitToken:   "Limit",
+			},
 		}
 	}
 
@@ -481,6 +503,16 @@ func (c *DynamoDB) Query(input *QueryInput) (output *QueryOutput, err error) {
 	return
 }
 
+// QueryPages iterates over the pages of a Query operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *DynamoDB) QueryPages(input *QueryInput, fn func(p *QueryOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.QueryRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*QueryOutput), lastPage)
+	})
+}
+
 var opQuery *aws.Operation
 
 // ScanRequest generates a request for the Scan operation.
@@ -490,6 +522,11 @@ func (c *DynamoDB) ScanRequest(input *ScanInput) (req *aws.Request, output *Scan
 			Name:       "Scan",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"ExclusiveStartKey"},
+				OutputTokens: []string{"LastEvaluatedKey"},
+				LimitToken:   "Limit",
+			},
 		}
 	}
 
@@ -523,6 +560,16 @@ func (c *DynamoDB) Scan(input *ScanInput) (output *ScanOutput, err error) {
 	return
 }
 
+// ScanPages iterates over the pages of a Scan operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *DynamoDB) ScanPages(input *ScanInput, fn func(p *ScanOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ScanRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ScanOutput), lastPage)
+	})
+}
+
 var opScan *aws.Operation
 
 // UpdateItemRequest generates a request for the UpdateItem operation.
@@ -603,13 +650,49 @@ func (c *DynamoDB) UpdateTable(input *UpdateTableInput) (output *UpdateTableOutp
 
 var opUpdateTable *aws.Operation
 
+// AttributeAction is an enum of the values of AttributeAction members.
+type AttributeAction string
+
+// The values of AttributeAction.
+const (
+	AttributeActionADD    AttributeAction = "ADD"
+	AttributeActionPUT    AttributeAction = "PUT"
+	AttributeActionDELETE AttributeAction = "DELETE"
+)
+
+// AttributeActionValues returns the values of AttributeAction known to this
+// version of the SDK. The service may accept values added since.
+func AttributeActionValues() []AttributeAction {
+	return []AttributeAction{
+		AttributeAction("ADD"),
+		AttributeAction("PUT"),
+		AttributeAction("DELETE"),
+	}
+}
+
+// String returns the AttributeAction as the string of a member.
+func (v AttributeAction) String() string {
+	return string(v)
+}
+
+// ParseAttributeAction returns the AttributeAction of str, failing if it is not
+// one of the AttributeActionValues.
+func ParseAttributeAction(str string) (AttributeAction, error) {
+	for _, v := range AttributeActionValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid AttributeAction value %q", str)
+}
+
 // Represents an attribute for describing the key schema for the table and indexes.
 type AttributeDefinition struct {
 	// A name for the attribute.
-	AttributeName *string `type:"string" required:"true"`
+	AttributeName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The data type for the attribute.
-	AttributeType *string `type:"string" required:"true"`
+	AttributeType *string `type:"string" enum:"S,N,B" required:"true"`
 
 	metadataAttributeDefinition `json:"-", xml:"-"`
 }
@@ -618,6 +701,11 @@ type metadataAttributeDefinition struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AttributeDefinition.
+func (s AttributeDefinition) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the data for an attribute. You can set one, and only one, of the
 // elements.
 //
@@ -663,6 +751,11 @@ type metadataAttributeValue struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AttributeValue.
+func (s AttributeValue) String() string {
+	return awsutil.StringValue(s)
+}
+
 // For the UpdateItem operation, represents the attributes to be modified, the
 // action to perform on each, and the new value for each.
 //
@@ -736,7 +829,7 @@ type AttributeValueUpdate struct {
 	//   ADD - DynamoDB creates an item with the supplied primary key and number
 	// (or set of numbers) for the attribute value. The only data types allowed
 	// are number and number set; no other data types can be specified.
-	Action *string `type:"string"`
+	Action *string `type:"string" enum:"ADD,PUT,DELETE"`
 
 	// Represents the data for an attribute. You can set one, and only one, of the
 	// elements.
@@ -754,6 +847,11 @@ type metadataAttributeValueUpdate struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AttributeValueUpdate.
+func (s AttributeValueUpdate) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a BatchGetItem operation.
 type BatchGetItemInput struct {
 	// A map of one or more table names and, for each table, the corresponding primary
@@ -777,13 +875,13 @@ type BatchGetItemInput struct {
 	//
 	//   ConsistentRead - If true, a strongly consistent read is used; if false
 	// (the default), an eventually consistent read is used.
-	RequestItems *map[string]*KeysAndAttributes `type:"map" required:"true"`
+	RequestItems *map[string]*KeysAndAttributes `type:"map" min:"1" max:"100" required:"true"`
 
 	// A value that if set to TOTAL, the response includes ConsumedCapacity data
 	// for tables and indexes. If set to INDEXES, the response includes ConsumedCapacity
 	// for indexes. If set to NONE (the default), ConsumedCapacity is not included
 	// in the response.
-	ReturnConsumedCapacity *string `type:"string"`
+	ReturnConsumedCapacity *string `type:"string" enum:"INDEXES,TOTAL,NONE"`
 
 	metadataBatchGetItemInput `json:"-", xml:"-"`
 }
@@ -792,6 +890,11 @@ type metadataBatchGetItemInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the BatchGetItemInput.
+func (s BatchGetItemInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a BatchGetItem operation.
 type BatchGetItemOutput struct {
 	// The read capacity units consumed by the operation.
@@ -828,7 +931,7 @@ type BatchGetItemOutput struct {
 	//
 	//   If there are no unprocessed keys remaining, the response contains an empty
 	// UnprocessedKeys map.
-	UnprocessedKeys *map[string]*KeysAndAttributes `type:"map"`
+	UnprocessedKeys *map[string]*KeysAndAttributes `type:"map" min:"1" max:"100"`
 
 	metadataBatchGetItemOutput `json:"-", xml:"-"`
 }
@@ -837,6 +940,11 @@ type metadataBatchGetItemOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the BatchGetItemOutput.
+func (s BatchGetItemOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a BatchWriteItem operation.
 type BatchWriteItemInput struct {
 	// A map of one or more table names and, for each table, a list of operations
@@ -865,18 +973,18 @@ type BatchWriteItemInput struct {
 	// If you specify any attributes that are part of an index key, then the data
 	// types for those attributes must match those of the schema in the table's
 	// attribute definition.
-	RequestItems *map[string][]*WriteRequest `type:"map" required:"true"`
+	RequestItems *map[string][]*WriteRequest `type:"map" min:"1" max:"25" required:"true"`
 
 	// A value that if set to TOTAL, the response includes ConsumedCapacity data
 	// for tables and indexes. If set to INDEXES, the response includes ConsumedCapacity
 	// for indexes. If set to NONE (the default), ConsumedCapacity is not included
 	// in the response.
-	ReturnConsumedCapacity *string `type:"string"`
+	ReturnConsumedCapacity *string `type:"string" enum:"INDEXES,TOTAL,NONE"`
 
 	// A value that if set to SIZE, the response includes statistics about item
 	// collections, if any, that were modified during the operation are returned
 	// in the response. If set to NONE (the default), no statistics are returned.
-	ReturnItemCollectionMetrics *string `type:"string"`
+	ReturnItemCollectionMetrics *string `type:"string" enum:"SIZE,NONE"`
 
 	metadataBatchWriteItemInput `json:"-", xml:"-"`
 }
@@ -885,6 +993,11 @@ type metadataBatchWriteItemInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the BatchWriteItemInput.
+func (s BatchWriteItemInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a BatchWriteItem operation.
 type BatchWriteItemOutput struct {
 	// The capacity units consumed by the operation.
@@ -946,7 +1059,7 @@ type BatchWriteItemOutput struct {
 	//
 	//     If there are no unprocessed items remaining, the response contains an
 	// empty UnprocessedItems map.
-	UnprocessedItems *map[string][]*WriteRequest `type:"map"`
+	UnprocessedItems *map[string][]*WriteRequest `type:"map" min:"1" max:"25"`
 
 	metadataBatchWriteItemOutput `json:"-", xml:"-"`
 }
@@ -955,6 +1068,11 @@ type metadataBatchWriteItemOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the BatchWriteItemOutput.
+func (s BatchWriteItemOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the amount of provisioned throughput capacity consumed on a table
 // or an index.
 type Capacity struct {
@@ -968,6 +1086,67 @@ type metadataCapacity struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Capacity.
+func (s Capacity) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ComparisonOperator is an enum of the values of ComparisonOperator members.
+type ComparisonOperator string
+
+// The values of ComparisonOperator.
+const (
+	ComparisonOperatorEQ          ComparisonOperator = "EQ"
+	ComparisonOperatorNE          ComparisonOperator = "NE"
+	ComparisonOperatorIN          ComparisonOperator = "IN"
+	ComparisonOperatorLE          ComparisonOperator = "LE"
+	ComparisonOperatorLT          ComparisonOperator = "LT"
+	ComparisonOperatorGE          ComparisonOperator = "GE"
+	ComparisonOperatorGT          ComparisonOperator = "GT"
+	ComparisonOperatorBETWEEN     ComparisonOperator = "BETWEEN"
+	ComparisonOperatorNOTNULL     ComparisonOperator = "NOT_NULL"
+	ComparisonOperatorNULL        ComparisonOperator = "NULL"
+	ComparisonOperatorCONTAINS    ComparisonOperator = "CONTAINS"
+	ComparisonOperatorNOTCONTAINS ComparisonOperator = "NOT_CONTAINS"
+	ComparisonOperatorBEGINSWITH  ComparisonOperator = "BEGINS_WITH"
+)
+
+// ComparisonOperatorValues returns the values of ComparisonOperator known to this
+// version of the SDK. The service may accept values added since.
+func ComparisonOperatorValues() []ComparisonOperator {
+	return []ComparisonOperator{
+		ComparisonOperator("EQ"),
+		ComparisonOperator("NE"),
+		ComparisonOperator("IN"),
+		ComparisonOperator("LE"),
+		ComparisonOperator("LT"),
+		ComparisonOperator("GE"),
+		ComparisonOperator("GT"),
+		ComparisonOperator("BETWEEN"),
+		ComparisonOperator("NOT_NULL"),
+		ComparisonOperator("NULL"),
+		ComparisonOperator("CONTAINS"),
+		ComparisonOperator("NOT_CONTAINS"),
+		ComparisonOperator("BEGINS_WITH"),
+	}
+}
+
+// String returns the ComparisonOperator as the string of a member.
+func (v ComparisonOperator) String() string {
+	return string(v)
+}
+
+// ParseComparisonOperator returns the ComparisonOperator of str, failing if it is not
+// one of the ComparisonOperatorValues.
+func ParseComparisonOperator(str string) (ComparisonOperator, error) {
+	for _, v := range ComparisonOperatorValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ComparisonOperator value %q", str)
+}
+
 // Represents the selection criteria for a Query or Scan operation:
 //
 //   For a Query operation, Condition is used for specifying the KeyConditions
@@ -1127,7 +1306,7 @@ type Condition struct {
 	//   For usage examples of AttributeValueList and ComparisonOperator, see Legacy
 	// Conditional Parameters (http://docs.aws.amazon.com/amazondynamodb/latest/developerguide/LegacyConditionalParameters.html)
 	// in the Amazon DynamoDB Developer Guide.
-	ComparisonOperator *string `type:"string" required:"true"`
+	ComparisonOperator *string `type:"string" enum:"EQ,NE,IN,LE,LT,GE,GT,BETWEEN,NOT_NULL,NULL,CONTAINS,NOT_CONTAINS,BEGINS_WITH" required:"true"`
 
 	metadataCondition `json:"-", xml:"-"`
 }
@@ -1136,6 +1315,45 @@ type metadataCondition struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Condition.
+func (s Condition) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ConditionalOperator is an enum of the values of ConditionalOperator members.
+type ConditionalOperator string
+
+// The values of ConditionalOperator.
+const (
+	ConditionalOperatorAND ConditionalOperator = "AND"
+	ConditionalOperatorOR  ConditionalOperator = "OR"
+)
+
+// ConditionalOperatorValues returns the values of ConditionalOperator known to this
+// version of the SDK. The service may accept values added since.
+func ConditionalOperatorValues() []ConditionalOperator {
+	return []ConditionalOperator{
+		ConditionalOperator("AND"),
+		ConditionalOperator("OR"),
+	}
+}
+
+// String returns the ConditionalOperator as the string of a member.
+func (v ConditionalOperator) String() string {
+	return string(v)
+}
+
+// ParseConditionalOperator returns the ConditionalOperator of str, failing if it is not
+// one of the ConditionalOperatorValues.
+func ParseConditionalOperator(str string) (ConditionalOperator, error) {
+	for _, v := range ConditionalOperatorValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ConditionalOperator value %q", str)
+}
+
 // The capacity units consumed by an operation. The data returned includes the
 // total provisioned throughput consumed, along with statistics for the table
 // and any indexes involved in the operation. ConsumedCapacity is only returned
@@ -1156,7 +1374,7 @@ type ConsumedCapacity struct {
 	Table *Capacity `type:"structure"`
 
 	// The name of the table that was affected by the operation.
-	TableName *string `type:"string"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+"`
 
 	metadataConsumedCapacity `json:"-", xml:"-"`
 }
@@ -1165,13 +1383,18 @@ type metadataConsumedCapacity struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ConsumedCapacity.
+func (s ConsumedCapacity) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents a new global secondary index to be added to an existing table.
 type CreateGlobalSecondaryIndexAction struct {
 	// The name of the global secondary index to be created.
-	IndexName *string `type:"string" required:"true"`
+	IndexName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// The key schema for the global secondary index.
-	KeySchema []*KeySchemaElement `type:"list" required:"true"`
+	KeySchema []*KeySchemaElement `type:"list" min:"1" max:"2" required:"true"`
 
 	// Represents attributes that are copied (projected) from the table into an
 	// index. These are in addition to the primary key attributes and index key
@@ -1193,6 +1416,11 @@ type metadataCreateGlobalSecondaryIndexAction struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateGlobalSecondaryIndexAction.
+func (s CreateGlobalSecondaryIndexAction) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a CreateTable operation.
 type CreateTableInput struct {
 	// An array of attributes that describe the key schema for the table and indexes.
@@ -1250,7 +1478,7 @@ type CreateTableInput struct {
 	//
 	// For more information, see Specifying the Primary Key (http://docs.aws.amazon.com/amazondynamodb/latest/developerguide/WorkingWithTables.html#WorkingWithTables.primary.key)
 	// in the Amazon DynamoDB Developer Guide.
-	KeySchema []*KeySchemaElement `type:"list" required:"true"`
+	KeySchema []*KeySchemaElement `type:"list" min:"1" max:"2" required:"true"`
 
 	// One or more local secondary indexes (the maximum is five) to be created on
 	// the table. Each index is scoped to a given hash key value. There is a 10
@@ -1295,7 +1523,7 @@ type CreateTableInput struct {
 	ProvisionedThroughput *ProvisionedThroughput `type:"structure" required:"true"`
 
 	// The name of the table to create.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataCreateTableInput `json:"-", xml:"-"`
 }
@@ -1304,6 +1532,11 @@ type metadataCreateTableInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateTableInput.
+func (s CreateTableInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a CreateTable operation.
 type CreateTableOutput struct {
 	// Represents the properties of a table.
@@ -1316,10 +1549,15 @@ type metadataCreateTableOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateTableOutput.
+func (s CreateTableOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents a global secondary index to be deleted from an existing table.
 type DeleteGlobalSecondaryIndexAction struct {
 	// The name of the global secondary index to be deleted.
-	IndexName *string `type:"string" required:"true"`
+	IndexName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataDeleteGlobalSecondaryIndexAction `json:"-", xml:"-"`
 }
@@ -1328,6 +1566,11 @@ type metadataDeleteGlobalSecondaryIndexAction struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteGlobalSecondaryIndexAction.
+func (s DeleteGlobalSecondaryIndexAction) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a DeleteItem operation.
 type DeleteItemInput struct {
 	// A condition that must be satisfied in order for a conditional DeleteItem
@@ -1366,7 +1609,7 @@ type DeleteItemInput struct {
 	// The operation will succeed only if the entire map evaluates to true.
 	//
 	// This parameter does not support attributes of type List or Map.
-	ConditionalOperator *string `type:"string"`
+	ConditionalOperator *string `type:"string" enum:"AND,OR"`
 
 	// There is a newer parameter available. Use ConditionExpression instead. Note
 	// that if you use Expected and  ConditionExpression  at the same time, DynamoDB
@@ -1633,12 +1876,12 @@ type DeleteItemInput struct {
 	// for tables and indexes. If set to INDEXES, the response includes ConsumedCapacity
 	// for indexes. If set to NONE (the default), ConsumedCapacity is not included
 	// in the response.
-	ReturnConsumedCapacity *string `type:"string"`
+	ReturnConsumedCapacity *string `type:"string" enum:"INDEXES,TOTAL,NONE"`
 
 	// A value that if set to SIZE, the response includes statistics about item
 	// collections, if any, that were modified during the operation are returned
 	// in the response. If set to NONE (the default), no statistics are returned.
-	ReturnItemCollectionMetrics *string `type:"string"`
+	ReturnItemCollectionMetrics *string `type:"string" enum:"SIZE,NONE"`
 
 	// Use ReturnValues if you want to get the item attributes as they appeared
 	// before they were deleted. For DeleteItem, the valid values are:
@@ -1647,10 +1890,10 @@ type DeleteItemInput struct {
 	// nothing is returned. (This setting is the default for ReturnValues.)
 	//
 	//   ALL_OLD - The content of the old item is returned.
-	ReturnValues *string `type:"string"`
+	ReturnValues *string `type:"string" enum:"NONE,ALL_OLD,UPDATED_OLD,ALL_NEW,UPDATED_NEW"`
 
 	// The name of the table from which to delete the item.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataDeleteItemInput `json:"-", xml:"-"`
 }
@@ -1659,6 +1902,11 @@ type metadataDeleteItemInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteItemInput.
+func (s DeleteItemInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a DeleteItem operation.
 type DeleteItemOutput struct {
 	// A map of attribute names to AttributeValue objects, representing the item
@@ -1702,6 +1950,11 @@ type metadataDeleteItemOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteItemOutput.
+func (s DeleteItemOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents a request to perform a DeleteItem operation on an item.
 type DeleteRequest struct {
 	// A map of attribute name to attribute values, representing the primary key
@@ -1716,10 +1969,15 @@ type metadataDeleteRequest struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteRequest.
+func (s DeleteRequest) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a DeleteTable operation.
 type DeleteTableInput struct {
 	// The name of the table to delete.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataDeleteTableInput `json:"-", xml:"-"`
 }
@@ -1728,6 +1986,11 @@ type metadataDeleteTableInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteTableInput.
+func (s DeleteTableInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a DeleteTable operation.
 type DeleteTableOutput struct {
 	// Represents the properties of a table.
@@ -1740,10 +2003,15 @@ type metadataDeleteTableOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteTableOutput.
+func (s DeleteTableOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a DescribeTable operation.
 type DescribeTableInput struct {
 	// The name of the table to describe.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataDescribeTableInput `json:"-", xml:"-"`
 }
@@ -1752,6 +2020,11 @@ type metadataDescribeTableInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeTableInput.
+func (s DescribeTableInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a DescribeTable operation.
 type DescribeTableOutput struct {
 	// Represents the properties of a table.
@@ -1764,6 +2037,11 @@ type metadataDescribeTableOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeTableOutput.
+func (s DescribeTableOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents a condition to be compared with an attribute value. This condition
 // can be used with DeleteItem, PutItem or UpdateItem operations; if the comparison
 // evaluates to true, the operation succeeds; if not, the operation fails. You
@@ -1929,7 +2207,7 @@ type ExpectedAttributeValue struct {
 	// element of a different type than the one provided in the request, the value
 	// does not match. For example, {"S":"6"} does not compare to {"N":"6"}. Also,
 	// {"N":"6"} does not compare to {"NS":["6", "2", "1"]}
-	ComparisonOperator *string `type:"string"`
+	ComparisonOperator *string `type:"string" enum:"EQ,NE,IN,LE,LT,GE,GT,BETWEEN,NOT_NULL,NULL,CONTAINS,NOT_CONTAINS,BEGINS_WITH"`
 
 	// Causes DynamoDB to evaluate the value before attempting a conditional operation:
 	//
@@ -1971,6 +2249,11 @@ type metadataExpectedAttributeValue struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ExpectedAttributeValue.
+func (s ExpectedAttributeValue) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a GetItem operation.
 type GetItemInput struct {
 	// There is a newer parameter available. Use ProjectionExpression instead. Note
@@ -1987,7 +2270,7 @@ type GetItemInput struct {
 	// Note that AttributesToGet has no effect on provisioned throughput consumption.
 	// DynamoDB determines capacity units consumed based on item size, not on the
 	// amount of data that is returned to an application.
-	AttributesToGet []*string `type:"list"`
+	AttributesToGet []*string `type:"list" min:"1"`
 
 	// A value that if set to true, then the operation uses strongly consistent
 	// reads; otherwise, eventually consistent reads are used.
@@ -2054,10 +2337,10 @@ type GetItemInput struct {
 	// for tables and indexes. If set to INDEXES, the response includes ConsumedCapacity
 	// for indexes. If set to NONE (the default), ConsumedCapacity is not included
 	// in the response.
-	ReturnConsumedCapacity *string `type:"string"`
+	ReturnConsumedCapacity *string `type:"string" enum:"INDEXES,TOTAL,NONE"`
 
 	// The name of the table containing the requested item.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataGetItemInput `json:"-", xml:"-"`
 }
@@ -2066,6 +2349,11 @@ type metadataGetItemInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetItemInput.
+func (s GetItemInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a GetItem operation.
 type GetItemOutput struct {
 	// The capacity units consumed by an operation. The data returned includes the
@@ -2086,15 +2374,20 @@ type metadataGetItemOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetItemOutput.
+func (s GetItemOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the properties of a global secondary index.
 type GlobalSecondaryIndex struct {
 	// The name of the global secondary index. The name must be unique among all
 	// other indexes on this table.
-	IndexName *string `type:"string" required:"true"`
+	IndexName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// The complete key schema for a global secondary index, which consists of one
 	// or more pairs of attribute names and key types (HASH or RANGE).
-	KeySchema []*KeySchemaElement `type:"list" required:"true"`
+	KeySchema []*KeySchemaElement `type:"list" min:"1" max:"2" required:"true"`
 
 	// Represents attributes that are copied (projected) from the table into an
 	// index. These are in addition to the primary key attributes and index key
@@ -2116,6 +2409,11 @@ type metadataGlobalSecondaryIndex struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GlobalSecondaryIndex.
+func (s GlobalSecondaryIndex) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the properties of a global secondary index.
 type GlobalSecondaryIndexDescription struct {
 	// Indicates whether the index is currently backfilling. Backfilling is the
@@ -2130,7 +2428,7 @@ type GlobalSecondaryIndexDescription struct {
 	Backfilling *bool `type:"boolean"`
 
 	// The name of the global secondary index.
-	IndexName *string `type:"string"`
+	IndexName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The total size of the specified index, in bytes. DynamoDB updates this value
 	// approximately every six hours. Recent changes might not be reflected in this
@@ -2146,7 +2444,7 @@ type GlobalSecondaryIndexDescription struct {
 	//   DELETING - The index is being deleted.
 	//
 	//   ACTIVE - The index is ready for use.
-	IndexStatus *string `type:"string"`
+	IndexStatus *string `type:"string" enum:"CREATING,UPDATING,DELETING,ACTIVE"`
 
 	// The number of items in the specified index. DynamoDB updates this value approximately
 	// every six hours. Recent changes might not be reflected in this value.
@@ -2154,7 +2452,7 @@ type GlobalSecondaryIndexDescription struct {
 
 	// The complete key schema for the global secondary index, consisting of one
 	// or more pairs of attribute names and key types (HASH or RANGE).
-	KeySchema []*KeySchemaElement `type:"list"`
+	KeySchema []*KeySchemaElement `type:"list" min:"1" max:"2"`
 
 	// Represents attributes that are copied (projected) from the table into an
 	// index. These are in addition to the primary key attributes and index key
@@ -2172,6 +2470,11 @@ type metadataGlobalSecondaryIndexDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GlobalSecondaryIndexDescription.
+func (s GlobalSecondaryIndexDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents one of the following:
 //
 //  A new global secondary index to be added to an existing table.
@@ -2208,6 +2511,49 @@ type metadataGlobalSecondaryIndexUpdate struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GlobalSecondaryIndexUpdate.
+func (s GlobalSecondaryIndexUpdate) String() string {
+	return awsutil.StringValue(s)
+}
+
+// IndexStatus is an enum of the values of IndexStatus members.
+type IndexStatus string
+
+// The values of IndexStatus.
+const (
+	IndexStatusCREATING IndexStatus = "CREATING"
+	IndexStatusUPDATING IndexStatus = "UPDATING"
+	IndexStatusDELETING IndexStatus = "DELETING"
+	IndexStatusACTIVE   IndexStatus = "ACTIVE"
+)
+
+// IndexStatusValues returns the values of IndexStatus known to this
+// version of the SDK. The service may accept values added since.
+func IndexStatusValues() []IndexStatus {
+	return []IndexStatus{
+		IndexStatus("CREATING"),
+		IndexStatus("UPDATING"),
+		IndexStatus("DELETING"),
+		IndexStatus("ACTIVE"),
+	}
+}
+
+// String returns the IndexStatus as the string of a member.
+func (v IndexStatus) String() string {
+	return string(v)
+}
+
+// ParseIndexStatus returns the IndexStatus of str, failing if it is not
+// one of the IndexStatusValues.
+func ParseIndexStatus(str string) (IndexStatus, error) {
+	for _, v := range IndexStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid IndexStatus value %q", str)
+}
+
 // Information about item collections, if any, that were affected by the operation.
 // ItemCollectionMetrics is only returned if the request asked for it. If the
 // table does not have any local secondary indexes, this information is not
@@ -2235,6 +2581,11 @@ type metadataItemCollectionMetrics struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ItemCollectionMetrics.
+func (s ItemCollectionMetrics) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents a single element of a key schema. A key schema specifies the attributes
 // that make up the primary key of a table, or the key attributes of an index.
 //
@@ -2244,10 +2595,10 @@ type metadataItemCollectionMetrics struct {
 // the hash attribute, and another KeySchemaElement for the range attribute.
 type KeySchemaElement struct {
 	// The name of a key attribute.
-	AttributeName *string `type:"string" required:"true"`
+	AttributeName *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The attribute data, consisting of the data type and the attribute value itself.
-	KeyType *string `type:"string" required:"true"`
+	KeyType *string `type:"string" enum:"HASH,RANGE" required:"true"`
 
 	metadataKeySchemaElement `json:"-", xml:"-"`
 }
@@ -2256,6 +2607,45 @@ type metadataKeySchemaElement struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the KeySchemaElement.
+func (s KeySchemaElement) String() string {
+	return awsutil.StringValue(s)
+}
+
+// KeyType is an enum of the values of KeyType members.
+type KeyType string
+
+// The values of KeyType.
+const (
+	KeyTypeHASH  KeyType = "HASH"
+	KeyTypeRANGE KeyType = "RANGE"
+)
+
+// KeyTypeValues returns the values of KeyType known to this
+// version of the SDK. The service may accept values added since.
+func KeyTypeValues() []KeyType {
+	return []KeyType{
+		KeyType("HASH"),
+		KeyType("RANGE"),
+	}
+}
+
+// String returns the KeyType as the string of a member.
+func (v KeyType) String() string {
+	return string(v)
+}
+
+// ParseKeyType returns the KeyType of str, failing if it is not
+// one of the KeyTypeValues.
+func ParseKeyType(str string) (KeyType, error) {
+	for _, v := range KeyTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid KeyType value %q", str)
+}
+
 // Represents a set of primary keys and, for each key, the attributes to retrieve
 // from the table.
 //
@@ -2267,7 +2657,7 @@ type KeysAndAttributes struct {
 	// One or more attributes to retrieve from the table or index. If no attribute
 	// names are specified then all attributes will be returned. If any of the specified
 	// attributes are not found, they will not appear in the result.
-	AttributesToGet []*string `type:"list"`
+	AttributesToGet []*string `type:"list" min:"1"`
 
 	// The consistency of a read operation. If set to true, then a strongly consistent
 	// read is used; otherwise, an eventually consistent read is used.
@@ -2311,7 +2701,7 @@ type KeysAndAttributes struct {
 
 	// The primary key attribute values that define the items and the attributes
 	// associated with the items.
-	Keys []*map[string]*AttributeValue `type:"list" required:"true"`
+	Keys []*map[string]*AttributeValue `type:"list" min:"1" max:"100" required:"true"`
 
 	// A string that identifies one or more attributes to retrieve from the table.
 	// These attributes can include scalars, sets, or elements of a JSON document.
@@ -2332,16 +2722,21 @@ type metadataKeysAndAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the KeysAndAttributes.
+func (s KeysAndAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a ListTables operation.
 type ListTablesInput struct {
 	// The first table name that this operation will evaluate. Use the value that
 	// was returned for LastEvaluatedTableName in a previous operation, so that
 	// you can obtain the next page of results.
-	ExclusiveStartTableName *string `type:"string"`
+	ExclusiveStartTableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// A maximum number of table names to return. If this parameter is not specified,
 	// the limit is 100.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"100"`
 
 	metadataListTablesInput `json:"-", xml:"-"`
 }
@@ -2350,6 +2745,11 @@ type metadataListTablesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListTablesInput.
+func (s ListTablesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a ListTables operation.
 type ListTablesOutput struct {
 	// The name of the last table in the current page of results. Use this value
@@ -2358,7 +2758,7 @@ type ListTablesOutput struct {
 	//
 	// If you do not receive a LastEvaluatedTableName value in the response, this
 	// means that there are no more table names to be retrieved.
-	LastEvaluatedTableName *string `type:"string"`
+	LastEvaluatedTableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The names of the tables associated with the current account at the current
 	// endpoint. The maximum size of this array is 100.
@@ -2375,15 +2775,20 @@ type metadataListTablesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListTablesOutput.
+func (s ListTablesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the properties of a local secondary index.
 type LocalSecondaryIndex struct {
 	// The name of the local secondary index. The name must be unique among all
 	// other indexes on this table.
-	IndexName *string `type:"string" required:"true"`
+	IndexName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// The complete key schema for the local secondary index, consisting of one
 	// or more pairs of attribute names and key types (HASH or RANGE).
-	KeySchema []*KeySchemaElement `type:"list" required:"true"`
+	KeySchema []*KeySchemaElement `type:"list" min:"1" max:"2" required:"true"`
 
 	// Represents attributes that are copied (projected) from the table into an
 	// index. These are in addition to the primary key attributes and index key
@@ -2397,10 +2802,15 @@ type metadataLocalSecondaryIndex struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the LocalSecondaryIndex.
+func (s LocalSecondaryIndex) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the properties of a local secondary index.
 type LocalSecondaryIndexDescription struct {
 	// Represents the name of the local secondary index.
-	IndexName *string `type:"string"`
+	IndexName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The total size of the specified index, in bytes. DynamoDB updates this value
 	// approximately every six hours. Recent changes might not be reflected in this
@@ -2413,7 +2823,7 @@ type LocalSecondaryIndexDescription struct {
 
 	// The complete index key schema, which consists of one or more pairs of attribute
 	// names and key types (HASH or RANGE).
-	KeySchema []*KeySchemaElement `type:"list"`
+	KeySchema []*KeySchemaElement `type:"list" min:"1" max:"2"`
 
 	// Represents attributes that are copied (projected) from the table into an
 	// index. These are in addition to the primary key attributes and index key
@@ -2427,6 +2837,11 @@ type metadataLocalSecondaryIndexDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the LocalSecondaryIndexDescription.
+func (s LocalSecondaryIndexDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents attributes that are copied (projected) from the table into an
 // index. These are in addition to the primary key attributes and index key
 // attributes, which are automatically projected.
@@ -2437,7 +2852,7 @@ type Projection struct {
 	// across all of the local secondary indexes, must not exceed 20. If you project
 	// the same attribute into two different indexes, this counts as two distinct
 	// attributes when determining the total.
-	NonKeyAttributes []*string `type:"list"`
+	NonKeyAttributes []*string `type:"list" min:"1" max:"20"`
 
 	// The set of attributes that are projected into the index:
 	//
@@ -2447,7 +2862,7 @@ type Projection struct {
 	// The list of projected attributes are in NonKeyAttributes.
 	//
 	//   ALL - All of the table attributes are projected into the index.
-	ProjectionType *string `type:"string"`
+	ProjectionType *string `type:"string" enum:"ALL,KEYS_ONLY,INCLUDE"`
 
 	metadataProjection `json:"-", xml:"-"`
 }
@@ -2456,6 +2871,47 @@ type metadataProjection struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Projection.
+func (s Projection) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ProjectionType is an enum of the values of ProjectionType members.
+type ProjectionType string
+
+// The values of ProjectionType.
+const (
+	ProjectionTypeALL      ProjectionType = "ALL"
+	ProjectionTypeKEYSONLY ProjectionType = "KEYS_ONLY"
+	ProjectionTypeINCLUDE  ProjectionType = "INCLUDE"
+)
+
+// ProjectionTypeValues returns the values of ProjectionType known to this
+// version of the SDK. The service may accept values added since.
+func ProjectionTypeValues() []ProjectionType {
+	return []ProjectionType{
+		ProjectionType("ALL"),
+		ProjectionType("KEYS_ONLY"),
+		ProjectionType("INCLUDE"),
+	}
+}
+
+// String returns the ProjectionType as the string of a member.
+func (v ProjectionType) String() string {
+	return string(v)
+}
+
+// ParseProjectionType returns the ProjectionType of str, failing if it is not
+// one of the ProjectionTypeValues.
+func ParseProjectionType(str string) (ProjectionType, error) {
+	for _, v := range ProjectionTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ProjectionType value %q", str)
+}
+
 // Represents the provisioned throughput settings for a specified table or index.
 // The settings can be modified using the UpdateTable operation.
 //
@@ -2467,13 +2923,13 @@ type ProvisionedThroughput struct {
 	// DynamoDB returns a ThrottlingException. For more information, see Specifying
 	// Read and Write Requirements (http://docs.aws.amazon.com/amazondynamodb/latest/developerguide/WorkingWithTables.html#ProvisionedThroughput)
 	// in the Amazon DynamoDB Developer Guide.
-	ReadCapacityUnits *int64 `type:"long" required:"true"`
+	ReadCapacityUnits *int64 `type:"long" min:"1" required:"true"`
 
 	// The maximum number of writes consumed per second before DynamoDB returns
 	// a ThrottlingException. For more information, see Specifying Read and Write
 	// Requirements (http://docs.aws.amazon.com/amazondynamodb/latest/developerguide/WorkingWithTables.html#ProvisionedThroughput)
 	// in the Amazon DynamoDB Developer Guide.
-	WriteCapacityUnits *int64 `type:"long" required:"true"`
+	WriteCapacityUnits *int64 `type:"long" min:"1" required:"true"`
 
 	metadataProvisionedThroughput `json:"-", xml:"-"`
 }
@@ -2482,6 +2938,11 @@ type metadataProvisionedThroughput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ProvisionedThroughput.
+func (s ProvisionedThroughput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the provisioned throughput settings for the table, consisting
 // of read and write capacity units, along with data about increases and decreases.
 type ProvisionedThroughputDescription struct {
@@ -2495,17 +2956,17 @@ type ProvisionedThroughputDescription struct {
 	// UTC calendar day. For current maximums on provisioned throughput decreases,
 	// see Limits (http://docs.aws.amazon.com/amazondynamodb/latest/developerguide/Limits.html)
 	// in the Amazon DynamoDB Developer Guide.
-	NumberOfDecreasesToday *int64 `type:"long"`
+	NumberOfDecreasesToday *int64 `type:"long" min:"1"`
 
 	// The maximum number of strongly consistent reads consumed per second before
 	// DynamoDB returns a ThrottlingException. Eventually consistent reads require
 	// less effort than strongly consistent reads, so a setting of 50 ReadCapacityUnits
 	// per second provides 100 eventually consistent ReadCapacityUnits per second.
-	ReadCapacityUnits *int64 `type:"long"`
+	ReadCapacityUnits *int64 `type:"long" min:"1"`
 
 	// The maximum number of writes consumed per second before DynamoDB returns
 	// a ThrottlingException.
-	WriteCapacityUnits *int64 `type:"long"`
+	WriteCapacityUnits *int64 `type:"long" min:"1"`
 
 	metadataProvisionedThroughputDescription `json:"-", xml:"-"`
 }
@@ -2514,6 +2975,11 @@ type metadataProvisionedThroughputDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ProvisionedThroughputDescription.
+func (s ProvisionedThroughputDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a PutItem operation.
 type PutItemInput struct {
 	// A condition that must be satisfied in order for a conditional PutItem operation
@@ -2552,7 +3018,7 @@ type PutItemInput struct {
 	// The operation will succeed only if the entire map evaluates to true.
 	//
 	// This parameter does not support attributes of type List or Map.
-	ConditionalOperator *string `type:"string"`
+	ConditionalOperator *string `type:"string" enum:"AND,OR"`
 
 	// There is a newer parameter available. Use ConditionExpression instead. Note
 	// that if you use Expected and  ConditionExpression  at the same time, DynamoDB
@@ -2829,12 +3295,12 @@ type PutItemInput struct {
 	// for tables and indexes. If set to INDEXES, the response includes ConsumedCapacity
 	// for indexes. If set to NONE (the default), ConsumedCapacity is not included
 	// in the response.
-	ReturnConsumedCapacity *string `type:"string"`
+	ReturnConsumedCapacity *string `type:"string" enum:"INDEXES,TOTAL,NONE"`
 
 	// A value that if set to SIZE, the response includes statistics about item
 	// collections, if any, that were modified during the operation are returned
 	// in the response. If set to NONE (the default), no statistics are returned.
-	ReturnItemCollectionMetrics *string `type:"string"`
+	ReturnItemCollectionMetrics *string `type:"string" enum:"SIZE,NONE"`
 
 	// Use ReturnValues if you want to get the item attributes as they appeared
 	// before they were updated with the PutItem request. For PutItem, the valid
@@ -2845,10 +3311,10 @@ type PutItemInput struct {
 	//
 	//   ALL_OLD - If PutItem overwrote an attribute name-value pair, then the
 	// content of the old item is returned.
-	ReturnValues *string `type:"string"`
+	ReturnValues *string `type:"string" enum:"NONE,ALL_OLD,UPDATED_OLD,ALL_NEW,UPDATED_NEW"`
 
 	// The name of the table to contain the item.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataPutItemInput `json:"-", xml:"-"`
 }
@@ -2857,6 +3323,11 @@ type metadataPutItemInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutItemInput.
+func (s PutItemInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a PutItem operation.
 type PutItemOutput struct {
 	// The attribute values as they appeared before the PutItem operation, but only
@@ -2900,6 +3371,11 @@ type metadataPutItemOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutItemOutput.
+func (s PutItemOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents a request to perform a PutItem operation on an item.
 type PutRequest struct {
 	// A map of attribute name to attribute values, representing the primary key
@@ -2916,6 +3392,11 @@ type metadataPutRequest struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutRequest.
+func (s PutRequest) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a Query operation.
 type QueryInput struct {
 	// There is a newer parameter available. Use ProjectionExpression instead. Note
@@ -2947,7 +3428,7 @@ type QueryInput struct {
 	// If you query a global secondary index, you can only request attributes that
 	// are projected into the index. Global secondary index queries cannot fetch
 	// attributes from the parent table.
-	AttributesToGet []*string `type:"list"`
+	AttributesToGet []*string `type:"list" min:"1"`
 
 	// A logical operator to apply to the conditions in a QueryFilter map:
 	//
@@ -2962,7 +3443,7 @@ type QueryInput struct {
 	// The operation will succeed only if the entire map evaluates to true.
 	//
 	// This parameter does not support attributes of type List or Map.
-	ConditionalOperator *string `type:"string"`
+	ConditionalOperator *string `type:"string" enum:"AND,OR"`
 
 	// A value that if set to true, then the operation uses strongly consistent
 	// reads; otherwise, eventually consistent reads are used.
@@ -3050,7 +3531,7 @@ type QueryInput struct {
 
 	// The name of an index to query. This index can be any local secondary index
 	// or global secondary index on the table.
-	IndexName *string `type:"string"`
+	IndexName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The selection criteria for the query. For a query on a table, you can have
 	// conditions only on the table primary key attributes. You must provide the
@@ -3163,7 +3644,7 @@ type QueryInput struct {
 	// and returns the matching values up to the limit, and a key in LastEvaluatedKey
 	// to apply in a subsequent operation to continue the operation. For more information,
 	// see Query and Scan in the Amazon DynamoDB Developer Guide.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1"`
 
 	// A string that identifies one or more attributes to retrieve from the table.
 	// These attributes can include scalars, sets, or elements of a JSON document.
@@ -3235,7 +3716,7 @@ type QueryInput struct {
 	// for tables and indexes. If set to INDEXES, the response includes ConsumedCapacity
 	// for indexes. If set to NONE (the default), ConsumedCapacity is not included
 	// in the response.
-	ReturnConsumedCapacity *string `type:"string"`
+	ReturnConsumedCapacity *string `type:"string" enum:"INDEXES,TOTAL,NONE"`
 
 	// A value that specifies ascending (true) or descending (false) traversal of
 	// the index. DynamoDB returns results reflecting the requested order determined
@@ -3287,10 +3768,10 @@ type QueryInput struct {
 	// in a single request, unless the value for Select is SPECIFIC_ATTRIBUTES.
 	// (This usage is equivalent to specifying AttributesToGet without any value
 	// for Select.)
-	Select *string `type:"string"`
+	Select *string `type:"string" enum:"ALL_ATTRIBUTES,ALL_PROJECTED_ATTRIBUTES,SPECIFIC_ATTRIBUTES,COUNT"`
 
 	// The name of the table containing the requested items.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataQueryInput `json:"-", xml:"-"`
 }
@@ -3299,6 +3780,11 @@ type metadataQueryInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the QueryInput.
+func (s QueryInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a Query operation.
 type QueryOutput struct {
 	// The capacity units consumed by an operation. The data returned includes the
@@ -3351,6 +3837,157 @@ type metadataQueryOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the QueryOutput.
+func (s QueryOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ReturnConsumedCapacity is an enum of the values of ReturnConsumedCapacity members.
+type ReturnConsumedCapacity string
+
+// The values of ReturnConsumedCapacity.
+const (
+	ReturnConsumedCapacityINDEXES ReturnConsumedCapacity = "INDEXES"
+	ReturnConsumedCapacityTOTAL   ReturnConsumedCapacity = "TOTAL"
+	ReturnConsumedCapacityNONE    ReturnConsumedCapacity = "NONE"
+)
+
+// ReturnConsumedCapacityValues returns the values of ReturnConsumedCapacity known to this
+// version of the SDK. The service may accept values added since.
+func ReturnConsumedCapacityValues() []ReturnConsumedCapacity {
+	return []ReturnConsumedCapacity{
+		ReturnConsumedCapacity("INDEXES"),
+		ReturnConsumedCapacity("TOTAL"),
+		ReturnConsumedCapacity("NONE"),
+	}
+}
+
+// String returns the ReturnConsumedCapacity as the string of a member.
+func (v ReturnConsumedCapacity) String() string {
+	return string(v)
+}
+
+// ParseReturnConsumedCapacity returns the ReturnConsumedCapacity of str, failing if it is not
+// one of the ReturnConsumedCapacityValues.
+func ParseReturnConsumedCapacity(str string) (ReturnConsumedCapacity, error) {
+	for _, v := range ReturnConsumedCapacityValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ReturnConsumedCapacity value %q", str)
+}
+
+// ReturnItemCollectionMetrics is an enum of the values of ReturnItemCollectionMetrics members.
+type ReturnItemCollectionMetrics string
+
+// The values of ReturnItemCollectionMetrics.
+const (
+	ReturnItemCollectionMetricsSIZE ReturnItemCollectionMetrics = "SIZE"
+	ReturnItemCollectionMetricsNONE ReturnItemCollectionMetrics = "NONE"
+)
+
+// ReturnItemCollectionMetricsValues returns the values of ReturnItemCollectionMetrics known to this
+// version of the SDK. The service may accept values added since.
+func ReturnItemCollectionMetricsValues() []ReturnItemCollectionMetrics {
+	return []ReturnItemCollectionMetrics{
+		ReturnItemCollectionMetrics("SIZE"),
+		ReturnItemCollectionMetrics("NONE"),
+	}
+}
+
+// String returns the ReturnItemCollectionMetrics as the string of a member.
+func (v ReturnItemCollectionMetrics) String() string {
+	return string(v)
+}
+
+// ParseReturnItemCollectionMetrics returns the ReturnItemCollectionMetrics of str, failing if it is not
+// one of the ReturnItemCollectionMetricsValues.
+func ParseReturnItemCollectionMetrics(str string) (ReturnItemCollectionMetrics, error) {
+	for _, v := range ReturnItemCollectionMetricsValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ReturnItemCollectionMetrics value %q", str)
+}
+
+// ReturnValue is an enum of the values of ReturnValue members.
+type ReturnValue string
+
+// The values of ReturnValue.
+const (
+	ReturnValueNONE       ReturnValue = "NONE"
+	ReturnValueALLOLD     ReturnValue = "ALL_OLD"
+	ReturnValueUPDATEDOLD ReturnValue = "UPDATED_OLD"
+	ReturnValueALLNEW     ReturnValue = "ALL_NEW"
+	ReturnValueUPDATEDNEW ReturnValue = "UPDATED_NEW"
+)
+
+// ReturnValueValues returns the values of ReturnValue known to this
+// version of the SDK. The service may accept values added since.
+func ReturnValueValues() []ReturnValue {
+	return []ReturnValue{
+		ReturnValue("NONE"),
+		ReturnValue("ALL_OLD"),
+		ReturnValue("UPDATED_OLD"),
+		ReturnValue("ALL_NEW"),
+		ReturnValue("UPDATED_NEW"),
+	}
+}
+
+// String returns the ReturnValue as the string of a member.
+func (v ReturnValue) String() string {
+	return string(v)
+}
+
+// ParseReturnValue returns the ReturnValue of str, failing if it is not
+// one of the ReturnValueValues.
+func ParseReturnValue(str string) (ReturnValue, error) {
+	for _, v := range ReturnValueValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ReturnValue value %q", str)
+}
+
+// ScalarAttributeType is an enum of the values of ScalarAttributeType members.
+type ScalarAttributeType string
+
+// The values of ScalarAttributeType.
+const (
+	ScalarAttributeTypeS ScalarAttributeType = "S"
+	ScalarAttributeTypeN ScalarAttributeType = "N"
+	ScalarAttributeTypeB ScalarAttributeType = "B"
+)
+
+// ScalarAttributeTypeValues returns the values of ScalarAttributeType known to this
+// version of the SDK. The service may accept values added since.
+func ScalarAttributeTypeValues() []ScalarAttributeType {
+	return []ScalarAttributeType{
+		ScalarAttributeType("S"),
+		ScalarAttributeType("N"),
+		ScalarAttributeType("B"),
+	}
+}
+
+// String returns the ScalarAttributeType as the string of a member.
+func (v ScalarAttributeType) String() string {
+	return string(v)
+}
+
+// ParseScalarAttributeType returns the ScalarAttributeType of str, failing if it is not
+// one of the ScalarAttributeTypeValues.
+func ParseScalarAttributeType(str string) (ScalarAttributeType, error) {
+	for _, v := range ScalarAttributeTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ScalarAttributeType value %q", str)
+}
+
 // Represents the input of a Scan operation.
 type ScanInput struct {
 	// There is a newer parameter available. Use ProjectionExpression instead. Note
@@ -3367,7 +4004,7 @@ type ScanInput struct {
 	// Note that AttributesToGet has no effect on provisioned throughput consumption.
 	// DynamoDB determines capacity units consumed based on item size, not on the
 	// amount of data that is returned to an application.
-	AttributesToGet []*string `type:"list"`
+	AttributesToGet []*string `type:"list" min:"1"`
 
 	// There is a newer parameter available. Use ConditionExpression instead. Note
 	// that if you use ConditionalOperator and  ConditionExpression  at the same
@@ -3386,7 +4023,7 @@ type ScanInput struct {
 	// The operation will succeed only if the entire map evaluates to true.
 	//
 	// This parameter does not support attributes of type List or Map.
-	ConditionalOperator *string `type:"string"`
+	ConditionalOperator *string `type:"string" enum:"AND,OR"`
 
 	// The primary key of the first item that this operation will evaluate. Use
 	// the value that was returned for LastEvaluatedKey in the previous operation.
@@ -3471,7 +4108,7 @@ type ScanInput struct {
 	// The name of a secondary index to scan. This index can be any local secondary
 	// index or global secondary index. Note that if you use the IndexName parameter,
 	// you must also provide TableName.
-	IndexName *string `type:"string"`
+	IndexName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The maximum number of items to evaluate (not necessarily the number of matching
 	// items). If DynamoDB processes the number of items up to the limit while processing
@@ -3482,7 +4119,7 @@ type ScanInput struct {
 	// and returns the matching values up to the limit, and a key in LastEvaluatedKey
 	// to apply in a subsequent operation to continue the operation. For more information,
 	// see Query and Scan in the Amazon DynamoDB Developer Guide.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1"`
 
 	// A string that identifies one or more attributes to retrieve from the specified
 	// table or index. These attributes can include scalars, sets, or elements of
@@ -3500,7 +4137,7 @@ type ScanInput struct {
 	// for tables and indexes. If set to INDEXES, the response includes ConsumedCapacity
 	// for indexes. If set to NONE (the default), ConsumedCapacity is not included
 	// in the response.
-	ReturnConsumedCapacity *string `type:"string"`
+	ReturnConsumedCapacity *string `type:"string" enum:"INDEXES,TOTAL,NONE"`
 
 	// There is a newer parameter available. Use FilterExpression instead. Note
 	// that if you use ScanFilter and FilterExpression at the same time, DynamoDB
@@ -3564,7 +4201,7 @@ type ScanInput struct {
 	// the value provided for TotalSegments.
 	//
 	// If you provide Segment, you must also provide TotalSegments.
-	Segment *int64 `type:"integer"`
+	Segment *int64 `type:"integer" max:"999999"`
 
 	// The attributes to be returned in the result. You can retrieve all item attributes,
 	// specific item attributes, or the count of matching items.
@@ -3583,11 +4220,11 @@ type ScanInput struct {
 	// in a single request, unless the value for Select is SPECIFIC_ATTRIBUTES.
 	// (This usage is equivalent to specifying AttributesToGet without any value
 	// for Select.)
-	Select *string `type:"string"`
+	Select *string `type:"string" enum:"ALL_ATTRIBUTES,ALL_PROJECTED_ATTRIBUTES,SPECIFIC_ATTRIBUTES,COUNT"`
 
 	// The name of the table containing the requested items; or, if you provide
 	// IndexName, the name of the table to which that index belongs.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// For a parallel Scan request, TotalSegments represents the total number of
 	// segments into which the Scan operation will be divided. The value of TotalSegments
@@ -3600,7 +4237,7 @@ type ScanInput struct {
 	// Scan operation will be sequential rather than parallel.
 	//
 	// If you specify TotalSegments, you must also specify Segment.
-	TotalSegments *int64 `type:"integer"`
+	TotalSegments *int64 `type:"integer" min:"1" max:"1000000"`
 
 	metadataScanInput `json:"-", xml:"-"`
 }
@@ -3609,6 +4246,11 @@ type metadataScanInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ScanInput.
+func (s ScanInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a Scan operation.
 type ScanOutput struct {
 	// The capacity units consumed by an operation. The data returned includes the
@@ -3660,6 +4302,49 @@ type metadataScanOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ScanOutput.
+func (s ScanOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// Select is an enum of the values of Select members.
+type Select string
+
+// The values of Select.
+const (
+	SelectALLATTRIBUTES          Select = "ALL_ATTRIBUTES"
+	SelectALLPROJECTEDATTRIBUTES Select = "ALL_PROJECTED_ATTRIBUTES"
+	SelectSPECIFICATTRIBUTES     Select = "SPECIFIC_ATTRIBUTES"
+	SelectCOUNT                  Select = "COUNT"
+)
+
+// SelectValues returns the values of Select known to this
+// version of the SDK. The service may accept values added since.
+func SelectValues() []Select {
+	return []Select{
+		Select("ALL_ATTRIBUTES"),
+		Select("ALL_PROJECTED_ATTRIBUTES"),
+		Select("SPECIFIC_ATTRIBUTES"),
+		Select("COUNT"),
+	}
+}
+
+// String returns the Select as the string of a member.
+func (v Select) String() string {
+	return string(v)
+}
+
+// ParseSelect returns the Select of str, failing if it is not
+// one of the SelectValues.
+func ParseSelect(str string) (Select, error) {
+	for _, v := range SelectValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid Select value %q", str)
+}
+
 // Represents the properties of a table.
 type TableDescription struct {
 	// An array of AttributeDefinition objects. Each of these objects describes
@@ -3749,7 +4434,7 @@ type TableDescription struct {
 	//
 	//   For more information about primary keys, see Primary Key (http://docs.aws.amazon.com/amazondynamodb/latest/developerguide/DataModel.html#DataModelPrimaryKey)
 	// in the Amazon DynamoDB Developer Guide.
-	KeySchema []*KeySchemaElement `type:"list"`
+	KeySchema []*KeySchemaElement `type:"list" min:"1" max:"2"`
 
 	// Represents one or more local secondary indexes on the table. Each index is
 	// scoped to a given hash key value. Tables with one or more local secondary
@@ -3800,7 +4485,7 @@ type TableDescription struct {
 	ProvisionedThroughput *ProvisionedThroughputDescription `type:"structure"`
 
 	// The name of the table.
-	TableName *string `type:"string"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The total size of the specified table, in bytes. DynamoDB updates this value
 	// approximately every six hours. Recent changes might not be reflected in this
@@ -3816,7 +4501,7 @@ type TableDescription struct {
 	//   DELETING - The table is being deleted.
 	//
 	//   ACTIVE - The table is ready for use.
-	TableStatus *string `type:"string"`
+	TableStatus *string `type:"string" enum:"CREATING,UPDATING,DELETING,ACTIVE"`
 
 	metadataTableDescription `json:"-", xml:"-"`
 }
@@ -3825,11 +4510,54 @@ type metadataTableDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TableDescription.
+func (s TableDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// TableStatus is an enum of the values of TableStatus members.
+type TableStatus string
+
+// The values of TableStatus.
+const (
+	TableStatusCREATING TableStatus = "CREATING"
+	TableStatusUPDATING TableStatus = "UPDATING"
+	TableStatusDELETING TableStatus = "DELETING"
+	TableStatusACTIVE   TableStatus = "ACTIVE"
+)
+
+// TableStatusValues returns the values of TableStatus known to this
+// version of the SDK. The service may accept values added since.
+func TableStatusValues() []TableStatus {
+	return []TableStatus{
+		TableStatus("CREATING"),
+		TableStatus("UPDATING"),
+		TableStatus("DELETING"),
+		TableStatus("ACTIVE"),
+	}
+}
+
+// String returns the TableStatus as the string of a member.
+func (v TableStatus) String() string {
+	return string(v)
+}
+
+// ParseTableStatus returns the TableStatus of str, failing if it is not
+// one of the TableStatusValues.
+func ParseTableStatus(str string) (TableStatus, error) {
+	for _, v := range TableStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid TableStatus value %q", str)
+}
+
 // Represents the new provisioned throughput settings to be applied to a global
 // secondary index.
 type UpdateGlobalSecondaryIndexAction struct {
 	// The name of the global secondary index to be updated.
-	IndexName *string `type:"string" required:"true"`
+	IndexName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// Represents the provisioned throughput settings for a specified table or index.
 	// The settings can be modified using the UpdateTable operation.
@@ -3846,6 +4574,11 @@ type metadataUpdateGlobalSecondaryIndexAction struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateGlobalSecondaryIndexAction.
+func (s UpdateGlobalSecondaryIndexAction) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of an UpdateItem operation.
 type UpdateItemInput struct {
 	// There is a newer parameter available. Use UpdateExpression instead. Note
@@ -3972,7 +4705,7 @@ type UpdateItemInput struct {
 	// The operation will succeed only if the entire map evaluates to true.
 	//
 	// This parameter does not support attributes of type List or Map.
-	ConditionalOperator *string `type:"string"`
+	ConditionalOperator *string `type:"string" enum:"AND,OR"`
 
 	// There is a newer parameter available. Use  ConditionExpression  instead.
 	// Note that if you use Expected and  ConditionExpression  at the same time,
@@ -4239,12 +4972,12 @@ type UpdateItemInput struct {
 	// for tables and indexes. If set to INDEXES, the response includes ConsumedCapacity
 	// for indexes. If set to NONE (the default), ConsumedCapacity is not included
 	// in the response.
-	ReturnConsumedCapacity *string `type:"string"`
+	ReturnConsumedCapacity *string `type:"string" enum:"INDEXES,TOTAL,NONE"`
 
 	// A value that if set to SIZE, the response includes statistics about item
 	// collections, if any, that were modified during the operation are returned
 	// in the response. If set to NONE (the default), no statistics are returned.
-	ReturnItemCollectionMetrics *string `type:"string"`
+	ReturnItemCollectionMetrics *string `type:"string" enum:"SIZE,NONE"`
 
 	// Use ReturnValues if you want to get the item attributes as they appeared
 	// either before or after they were updated. For UpdateItem, the valid values
@@ -4261,10 +4994,10 @@ type UpdateItemInput struct {
 	//   ALL_NEW - All of the attributes of the new version of the item are returned.
 	//
 	//   UPDATED_NEW - The new versions of only the updated attributes are returned.
-	ReturnValues *string `type:"string"`
+	ReturnValues *string `type:"string" enum:"NONE,ALL_OLD,UPDATED_OLD,ALL_NEW,UPDATED_NEW"`
 
 	// The name of the table containing the item to update.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// An expression that defines one or more attributes to be updated, the action
 	// to be performed on them, and new value(s) for them.
@@ -4346,6 +5079,11 @@ type metadataUpdateItemInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateItemInput.
+func (s UpdateItemInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of an UpdateItem operation.
 type UpdateItemOutput struct {
 	// A map of attribute values as they appeared before the UpdateItem operation.
@@ -4374,6 +5112,11 @@ type metadataUpdateItemOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateItemOutput.
+func (s UpdateItemOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of an UpdateTable operation.
 type UpdateTableInput struct {
 	// An array of attributes that describe the key schema for the table and indexes.
@@ -4401,7 +5144,7 @@ type UpdateTableInput struct {
 	ProvisionedThroughput *ProvisionedThroughput `type:"structure"`
 
 	// The name of the table to be updated.
-	TableName *string `type:"string" required:"true"`
+	TableName *string `type:"string" min:"3" max:"255" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataUpdateTableInput `json:"-", xml:"-"`
 }
@@ -4410,6 +5153,11 @@ type metadataUpdateTableInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateTableInput.
+func (s UpdateTableInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of an UpdateTable operation.
 type UpdateTableOutput struct {
 	// Represents the properties of a table.
@@ -4422,6 +5170,11 @@ type metadataUpdateTableOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateTableOutput.
+func (s UpdateTableOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents an operation to perform - either DeleteItem or PutItem. You can
 // only request one of these operations, not both, in a single WriteRequest.
 // If you do need to perform both of these operations, you will need to provide
@@ -4438,4 +5191,9 @@ type WriteRequest struct {
 
 type metadataWriteRequest struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the WriteRequest.
+func (s WriteRequest) String() string {
+	return awsutil.StringValue(s)
 }
\ No newline at end of file
@@ -0,0 +1,89 @@
+package dynamodbattribute
+
+import (
+	"reflect"
+	"strings"
+)
+
+// A field is an exported struct field stored as an attribute of an item.
+type field struct {
+	name      string
+	index     []int
+	omitEmpty bool
+}
+
+// fieldsOf returns the fields of the struct type t, named after their
+// dynamodbav tag or Go name. Fields tagged "-" are skipped, and the fields of
+// untagged embedded structs are stored as if they were fields of t.
+func fieldsOf(t reflect.Type) []field {
+	fields := []field{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" && (!f.Anonymous || f.Type.Kind() == reflect.Ptr) {
+			continue // unexported, and cannot be allocated if embedded
+		}
+
+		tag := f.Tag.Get("dynamodbav")
+		if tag == "-" {
+			continue
+		}
+		name, opts := tag, ""
+		if i := strings.Index(tag, ","); i >= 0 {
+			name, opts = tag[:i], tag[i+1:]
+		}
+
+		ft := f.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if f.Anonymous && name == "" && ft.Kind() == reflect.Struct {
+			for _, e := range fieldsOf(ft) {
+				e.index = append([]int{i}, e.index...)
+				fields = append(fields, e)
+			}
+			continue
+		}
+		if f.PkgPath != "" { // unexported embedded non-struct
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+		fields = append(fields, field{
+			name:      name,
+			index:     []int{i},
+			omitEmpty: hasOption(opts, "omitempty"),
+		})
+	}
+	return fields
+}
+
+// hasOption returns whether the comma separated options of a tag include opt.
+func hasOption(opts, opt string) bool {
+	for _, o := range strings.Split(opts, ",") {
+		if o == opt {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldByIndex returns the field of v at index, allocating the embedded
+// struct pointers on the way when alloc is set. It returns the zero Value if
+// an embedded pointer is nil and alloc is not set.
+func fieldByIndex(v reflect.Value, index []int, alloc bool) reflect.Value {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				if !alloc {
+					return reflect.Value{}
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	return v
+}
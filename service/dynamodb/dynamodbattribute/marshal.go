@@ -0,0 +1,149 @@
+// Package dynamodbattribute converts Go values to and from the typed
+// AttributeValues of DynamoDB items.
+//
+// Structs are stored as maps, with a key for each exported field. A field's
+// key is its name, unless it is tagged with another one. A field tagged
+// omitempty is not stored when it holds the zero value of its type, and a
+// field tagged "-" is never stored:
+//
+//	type Record struct {
+//		ID      string   `dynamodbav:"id"`
+//		Tags    []string `dynamodbav:",omitempty"`
+//		Scratch string   `dynamodbav:"-"`
+//	}
+//
+//	item, err := dynamodbattribute.Marshal(&Record{ID: "abc"})
+//	svc.PutItem(&dynamodb.PutItemInput{TableName: aws.String("records"), Item: &item})
+package dynamodbattribute
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/service/dynamodb"
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// Marshal converts the struct or map in, or a pointer to one, to the
+// attributes of a DynamoDB item. Strings are stored as S, numbers as N,
+// bools as BOOL, byte slices as B, other slices and arrays as L, and maps
+// with string keys and structs as M attributes. Times are stored as RFC 3339
+// strings. Nil values, and empty strings and byte slices, which DynamoDB
+// does not store, are stored as NULL.
+func Marshal(in interface{}) (map[string]*dynamodb.AttributeValue, error) {
+	av, err := marshalValue(reflect.ValueOf(in))
+	if err != nil {
+		return nil, err
+	}
+	if av.M == nil {
+		return nil, fmt.Errorf("cannot marshal %T into a DynamoDB item, must be a struct or map", in)
+	}
+	return *av.M, nil
+}
+
+func marshalValue(v reflect.Value) (*dynamodb.AttributeValue, error) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return &dynamodb.AttributeValue{NULL: aws.Boolean(true)}, nil
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return &dynamodb.AttributeValue{NULL: aws.Boolean(true)}, nil
+	}
+
+	if v.Type() == timeType {
+		return &dynamodb.AttributeValue{S: aws.String(v.Interface().(time.Time).Format(time.RFC3339Nano))}, nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		if v.Len() == 0 {
+			return &dynamodb.AttributeValue{NULL: aws.Boolean(true)}, nil
+		}
+		return &dynamodb.AttributeValue{S: aws.String(v.String())}, nil
+	case reflect.Bool:
+		return &dynamodb.AttributeValue{BOOL: aws.Boolean(v.Bool())}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &dynamodb.AttributeValue{N: aws.String(strconv.FormatInt(v.Int(), 10))}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &dynamodb.AttributeValue{N: aws.String(strconv.FormatUint(v.Uint(), 10))}, nil
+	case reflect.Float32, reflect.Float64:
+		return &dynamodb.AttributeValue{N: aws.String(strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()))}, nil
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			if v.Len() == 0 {
+				return &dynamodb.AttributeValue{NULL: aws.Boolean(true)}, nil
+			}
+			b := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(b), v)
+			return &dynamodb.AttributeValue{B: b}, nil
+		}
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return &dynamodb.AttributeValue{NULL: aws.Boolean(true)}, nil
+		}
+		l := make([]*dynamodb.AttributeValue, v.Len())
+		for i := range l {
+			av, err := marshalValue(v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			l[i] = av
+		}
+		return &dynamodb.AttributeValue{L: l}, nil
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("cannot marshal map with %s keys, must be strings", v.Type().Key())
+		}
+		if v.IsNil() {
+			return &dynamodb.AttributeValue{NULL: aws.Boolean(true)}, nil
+		}
+		m := map[string]*dynamodb.AttributeValue{}
+		for _, k := range v.MapKeys() {
+			av, err := marshalValue(v.MapIndex(k))
+			if err != nil {
+				return nil, err
+			}
+			m[k.String()] = av
+		}
+		return &dynamodb.AttributeValue{M: &m}, nil
+	case reflect.Struct:
+		m := map[string]*dynamodb.AttributeValue{}
+		for _, f := range fieldsOf(v.Type()) {
+			fv := fieldByIndex(v, f.index, false)
+			if !fv.IsValid() || (f.omitEmpty && isEmpty(fv)) {
+				continue
+			}
+			av, err := marshalValue(fv)
+			if err != nil {
+				return nil, err
+			}
+			m[f.name] = av
+		}
+		return &dynamodb.AttributeValue{M: &m}, nil
+	default:
+		return nil, fmt.Errorf("cannot marshal value of type %s", v.Type())
+	}
+}
+
+// isEmpty returns whether v is the zero value of its type, or an empty
+// slice or map, which omitempty fields are not stored for.
+func isEmpty(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map, reflect.String, reflect.Array:
+		return v.Len() == 0
+	case reflect.Ptr, reflect.Interface:
+		return v.IsNil()
+	case reflect.Struct:
+		if v.Type() == timeType {
+			return v.Interface().(time.Time).IsZero()
+		}
+		return false
+	default:
+		return reflect.DeepEqual(v.Interface(), reflect.Zero(v.Type()).Interface())
+	}
+}
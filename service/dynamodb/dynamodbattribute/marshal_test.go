@@ -0,0 +1,150 @@
+package dynamodbattribute_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/service/dynamodb"
+	"github.com/awslabs/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/stretchr/testify/assert"
+)
+
+type address struct {
+	Street string
+	Zip    int `dynamodbav:"zip"`
+}
+
+type order struct {
+	ID       string    `dynamodbav:"id"`
+	Quantity uint      `dynamodbav:",omitempty"`
+	Price    float64   `dynamodbav:"price"`
+	Paid     bool      `dynamodbav:"paid"`
+	Placed   time.Time `dynamodbav:"placed"`
+	Note     string    `dynamodbav:"note,omitempty"`
+	Scratch  string    `dynamodbav:"-"`
+}
+
+type customer struct {
+	Name     string
+	Address  *address
+	Orders   []order
+	Tags     []string
+	Data     []byte
+	Settings map[string]interface{}
+
+	private string
+}
+
+func TestMarshalNestedStruct(t *testing.T) {
+	item, err := dynamodbattribute.Marshal(&customer{
+		Name:    "Jane",
+		Address: &address{Street: "Main St", Zip: 10001},
+		Tags:    []string{"vip"},
+		private: "hidden",
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Jane", *item["Name"].S)
+	addr := *item["Address"].M
+	assert.Equal(t, "Main St", *addr["Street"].S)
+	assert.Equal(t, "10001", *addr["zip"].N)
+	assert.Equal(t, true, *item["Orders"].NULL)
+	assert.Equal(t, "vip", *item["Tags"].L[0].S)
+	assert.Equal(t, true, *item["Data"].NULL)
+	assert.Nil(t, item["private"])
+}
+
+func TestMarshalTags(t *testing.T) {
+	item, err := dynamodbattribute.Marshal(order{ID: "o-1", Price: 9.5, Scratch: "x"})
+	assert.NoError(t, err)
+
+	assert.Equal(t, "o-1", *item["id"].S)
+	assert.Equal(t, "9.5", *item["price"].N)
+	assert.Equal(t, false, *item["paid"].BOOL)
+	assert.Equal(t, "0001-01-01T00:00:00Z", *item["placed"].S)
+	for _, k := range []string{"Quantity", "note", "Scratch", "ID"} {
+		_, ok := item[k]
+		assert.False(t, ok, k)
+	}
+}
+
+func TestMarshalNotAnItem(t *testing.T) {
+	_, err := dynamodbattribute.Marshal("string")
+	assert.Error(t, err)
+}
+
+func TestRoundTripNestedStruct(t *testing.T) {
+	placed := time.Date(2015, 1, 25, 8, 0, 0, 500, time.UTC)
+	in := customer{
+		Name:    "Jane",
+		Address: &address{Street: "Main St", Zip: 10001},
+		Tags:    []string{"vip", "early"},
+		Data:    []byte("data"),
+		Settings: map[string]interface{}{
+			"theme":  "dark",
+			"volume": 0.75,
+			"beta":   true,
+		},
+		Orders: []order{{ID: "o-1", Quantity: 2, Price: 9.5, Paid: true, Placed: placed, Note: "gift"}},
+	}
+
+	item, err := dynamodbattribute.Marshal(in)
+	assert.NoError(t, err)
+
+	out := customer{}
+	assert.NoError(t, dynamodbattribute.Unmarshal(item, &out))
+	assert.Equal(t, in, out)
+}
+
+func TestRoundTripSliceOfStructs(t *testing.T) {
+	in := struct {
+		Orders []*order `dynamodbav:"orders"`
+	}{
+		Orders: []*order{{ID: "o-1", Price: 1}, {ID: "o-2", Quantity: 3}, nil},
+	}
+
+	item, err := dynamodbattribute.Marshal(in)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(item["orders"].L))
+
+	out := in
+	out.Orders = nil
+	assert.NoError(t, dynamodbattribute.Unmarshal(item, &out))
+	assert.Equal(t, in, out)
+}
+
+func TestUnmarshalSets(t *testing.T) {
+	out := struct {
+		Names  []string
+		Scores []int64
+		Blobs  [][]byte
+		Any    interface{}
+	}{}
+	err := dynamodbattribute.Unmarshal(map[string]*dynamodb.AttributeValue{
+		"Names":  {SS: []*string{aws.String("a"), aws.String("b")}},
+		"Scores": {NS: []*string{aws.String("1"), aws.String("-2")}},
+		"Blobs":  {BS: [][]byte{[]byte("x")}},
+		"Any":    {NS: []*string{aws.String("1.5")}},
+	}, &out)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"a", "b"}, out.Names)
+	assert.Equal(t, []int64{1, -2}, out.Scores)
+	assert.Equal(t, [][]byte{[]byte("x")}, out.Blobs)
+	assert.Equal(t, []interface{}{1.5}, out.Any)
+}
+
+func TestUnmarshalTypeMismatch(t *testing.T) {
+	out := order{}
+	err := dynamodbattribute.Unmarshal(map[string]*dynamodb.AttributeValue{
+		"price": {S: aws.String("free")},
+	}, &out)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "float64")
+}
+
+func TestUnmarshalNotAPointer(t *testing.T) {
+	err := dynamodbattribute.Unmarshal(map[string]*dynamodb.AttributeValue{}, order{})
+	assert.Error(t, err)
+}
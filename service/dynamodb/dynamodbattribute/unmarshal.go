@@ -0,0 +1,214 @@
+package dynamodbattribute
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/awslabs/aws-sdk-go/service/dynamodb"
+)
+
+// Unmarshal converts the attributes of a DynamoDB item into out, a pointer
+// to a struct, map or interface{}, as Marshal converts them from it.
+// Attributes without a matching struct field are ignored, and fields without
+// an attribute are left as they are. Into an interface{}, items unmarshal to
+// map[string]interface{}, lists and sets to []interface{}, and numbers to
+// float64.
+func Unmarshal(item map[string]*dynamodb.AttributeValue, out interface{}) error {
+	v := reflect.ValueOf(out)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return fmt.Errorf("cannot unmarshal into %T, must be a non-nil pointer", out)
+	}
+	return unmarshalValue(&dynamodb.AttributeValue{M: &item}, v.Elem())
+}
+
+func unmarshalValue(av *dynamodb.AttributeValue, v reflect.Value) error {
+	if av == nil || (av.NULL != nil && *av.NULL) {
+		v.Set(reflect.Zero(v.Type()))
+		return nil
+	}
+
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return unmarshalValue(av, v.Elem())
+	}
+	if v.Kind() == reflect.Interface && v.NumMethod() == 0 {
+		i, err := interfaceOf(av)
+		if err != nil {
+			return err
+		}
+		if i != nil {
+			v.Set(reflect.ValueOf(i))
+		}
+		return nil
+	}
+
+	switch {
+	case av.S != nil:
+		return unmarshalString(*av.S, v)
+	case av.N != nil:
+		return unmarshalNumber(*av.N, v)
+	case av.BOOL != nil:
+		if v.Kind() != reflect.Bool {
+			return typeError("BOOL", v)
+		}
+		v.SetBool(*av.BOOL)
+	case av.B != nil:
+		if !isByteSlice(v) {
+			return typeError("B", v)
+		}
+		v.SetBytes(append([]byte(nil), av.B...))
+	case av.L != nil:
+		return unmarshalList(len(av.L), v, "L", func(i int, e reflect.Value) error {
+			return unmarshalValue(av.L[i], e)
+		})
+	case av.SS != nil:
+		return unmarshalList(len(av.SS), v, "SS", func(i int, e reflect.Value) error {
+			return unmarshalValue(&dynamodb.AttributeValue{S: av.SS[i]}, e)
+		})
+	case av.NS != nil:
+		return unmarshalList(len(av.NS), v, "NS", func(i int, e reflect.Value) error {
+			return unmarshalValue(&dynamodb.AttributeValue{N: av.NS[i]}, e)
+		})
+	case av.BS != nil:
+		return unmarshalList(len(av.BS), v, "BS", func(i int, e reflect.Value) error {
+			return unmarshalValue(&dynamodb.AttributeValue{B: av.BS[i]}, e)
+		})
+	case av.M != nil:
+		return unmarshalMap(*av.M, v)
+	}
+	return nil
+}
+
+func unmarshalString(s string, v reflect.Value) error {
+	if v.Type() == timeType {
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(t))
+		return nil
+	}
+	if v.Kind() != reflect.String {
+		return typeError("S", v)
+	}
+	v.SetString(s)
+	return nil
+}
+
+func unmarshalNumber(n string, v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(n, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(n, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(n, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	default:
+		return typeError("N", v)
+	}
+	return nil
+}
+
+// unmarshalList unmarshals the n elements of a list or set attribute into
+// the slice or array v with elem.
+func unmarshalList(n int, v reflect.Value, kind string, elem func(int, reflect.Value) error) error {
+	switch v.Kind() {
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), n, n))
+	case reflect.Array:
+		if n > v.Len() {
+			return fmt.Errorf("cannot unmarshal %s of %d elements into %s", kind, n, v.Type())
+		}
+	default:
+		return typeError(kind, v)
+	}
+
+	for i := 0; i < n; i++ {
+		if err := elem(i, v.Index(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func unmarshalMap(m map[string]*dynamodb.AttributeValue, v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return typeError("M", v)
+		}
+		if v.IsNil() {
+			v.Set(reflect.MakeMap(v.Type()))
+		}
+		for k, av := range m {
+			e := reflect.New(v.Type().Elem()).Elem()
+			if err := unmarshalValue(av, e); err != nil {
+				return err
+			}
+			v.SetMapIndex(reflect.ValueOf(k).Convert(v.Type().Key()), e)
+		}
+	case reflect.Struct:
+		for _, f := range fieldsOf(v.Type()) {
+			av, ok := m[f.name]
+			if !ok {
+				continue
+			}
+			if err := unmarshalValue(av, fieldByIndex(v, f.index, true)); err != nil {
+				return err
+			}
+		}
+	default:
+		return typeError("M", v)
+	}
+	return nil
+}
+
+// interfaceOf returns the value of an attribute as the type it unmarshals to
+// in an interface{}.
+func interfaceOf(av *dynamodb.AttributeValue) (interface{}, error) {
+	var v interface{}
+	var err error
+	switch {
+	case av.S != nil:
+		v = *av.S
+	case av.N != nil:
+		v, err = strconv.ParseFloat(*av.N, 64)
+	case av.BOOL != nil:
+		v = *av.BOOL
+	case av.B != nil:
+		v = append([]byte(nil), av.B...)
+	case av.M != nil:
+		m := map[string]interface{}{}
+		err = unmarshalMap(*av.M, reflect.ValueOf(&m).Elem())
+		v = m
+	case av.L != nil, av.SS != nil, av.NS != nil, av.BS != nil:
+		l := []interface{}{}
+		err = unmarshalValue(av, reflect.ValueOf(&l).Elem())
+		v = l
+	}
+	return v, err
+}
+
+func isByteSlice(v reflect.Value) bool {
+	return v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8
+}
+
+func typeError(kind string, v reflect.Value) error {
+	return fmt.Errorf("cannot unmarshal %s attribute into value of type %s", kind, v.Type())
+}
@@ -0,0 +1,38 @@
+package dynamodb
+
+import (
+	"time"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+)
+
+// retryer is the default retry policy of DynamoDB clients. Tables throttle
+// requests exceeding their provisioned throughput, which is often only
+// restored after several seconds, so throttled requests are retried more
+// times than by the aws.DefaultRetryer, starting from a shorter delay which
+// grows up to a longer cap. A Retryer set on the client's Config takes
+// precedence.
+type retryer struct {
+	aws.DefaultRetryer
+}
+
+// the backoff curve of DynamoDB retries
+const (
+	retryerMaxRetries       = 10
+	retryerMinRetryDelay    = 50 * time.Millisecond
+	retryerMinThrottleDelay = 50 * time.Millisecond
+	retryerMaxRetryDelay    = 60 * time.Second
+)
+
+func newRetryer() retryer {
+	return retryer{aws.DefaultRetryer{
+		MinRetryDelay:    retryerMinRetryDelay,
+		MinThrottleDelay: retryerMinThrottleDelay,
+		MaxRetryDelay:    retryerMaxRetryDelay,
+	}}
+}
+
+// MaxRetries returns the number of retries of DynamoDB requests.
+func (d retryer) MaxRetries() uint {
+	return retryerMaxRetries
+}
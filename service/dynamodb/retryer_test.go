@@ -0,0 +1,77 @@
+package dynamodb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+)
+
+// maxDelay returns the longest of many delays the retryer picks for the
+// retry of a throttled request.
+func maxDelay(r aws.Retryer, retryCount uint) time.Duration {
+	req := &aws.Request{
+		Error:      aws.APIError{Code: "ProvisionedThroughputExceededException", StatusCode: 400},
+		RetryCount: retryCount,
+	}
+
+	var max time.Duration
+	for i := 0; i < 200; i++ {
+		if d := r.RetryRules(req); d > max {
+			max = d
+		}
+	}
+	return max
+}
+
+func TestRetryer(t *testing.T) {
+	svc := dynamodb.New(&aws.Config{Region: "us-east-1", MaxRetries: aws.DEFAULT_RETRIES})
+	def := aws.DefaultRetryer{}
+
+	assert.Equal(t, uint(10), svc.MaxRetries())
+	assert.Equal(t, uint(3), def.MaxRetries())
+
+	req := &aws.Request{Error: aws.APIError{Code: "ProvisionedThroughputExceededException", StatusCode: 400}}
+	assert.True(t, svc.ShouldRetry(req))
+
+	// the first retries are sooner than the default's
+	assert.True(t, maxDelay(svc.Retryer, 0) <= 50*time.Millisecond)
+	assert.True(t, maxDelay(def, 0) > 50*time.Millisecond)
+
+	// and the last ones may be later than the default's cap
+	assert.True(t, maxDelay(def, 9) <= aws.DefaultRetryerMaxRetryDelay)
+	assert.True(t, maxDelay(svc.Retryer, 9) > aws.DefaultRetryerMaxRetryDelay)
+	assert.True(t, maxDelay(svc.Retryer, 20) <= 60*time.Second)
+}
+
+func TestRetryerConfigOverride(t *testing.T) {
+	svc := dynamodb.New(&aws.Config{Region: "us-east-1", MaxRetries: aws.DEFAULT_RETRIES, Retryer: aws.DefaultRetryer{}})
+	assert.Equal(t, uint(3), svc.MaxRetries())
+
+	svc = dynamodb.New(&aws.Config{Region: "us-east-1", MaxRetries: 2})
+	assert.Equal(t, uint(2), svc.MaxRetries())
+}
+
+func TestRetryerThrottledResponse(t *testing.T) {
+	svc := dynamodb.New(&aws.Config{
+		Region:      "us-east-1",
+		Credentials: aws.Creds("AKID", "SECRET", ""),
+		MaxRetries:  aws.DEFAULT_RETRIES,
+	})
+	throttled := aws.StubResponse{
+		StatusCode: 400,
+		Body:       `{"__type":"com.amazonaws.dynamodb.v20120810#ProvisionedThroughputExceededException","message":"Rate exceeded"}`,
+	}
+	svc.Handlers.Send.SwapNamed(aws.StubSendHandler(throttled, throttled, aws.StubResponse{StatusCode: 200, Body: `{}`}))
+
+	codes := []string{}
+	svc.Handlers.RetryScheduled.PushBack(func(r *aws.Request) {
+		codes = append(codes, aws.Error(r.Error).Code)
+	})
+
+	_, err := svc.ListTables(&dynamodb.ListTablesInput{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ProvisionedThroughputExceededException", "ProvisionedThroughputExceededException"}, codes)
+}
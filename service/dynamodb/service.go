@@ -23,11 +23,14 @@ func New(config *aws.Config) *DynamoDB {
 		APIVersion:   "2012-08-10",
 		JSONVersion:  "1.0",
 		TargetPrefix: "DynamoDB_20120810",
+
+		// throttled requests are retried with a longer backoff
+		Retryer: newRetryer(),
 	}
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(jsonrpc.Build)
 	service.Handlers.Unmarshal.PushBack(jsonrpc.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(jsonrpc.UnmarshalMeta)
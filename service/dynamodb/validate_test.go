@@ -0,0 +1,48 @@
+package dynamodb_test
+
+import (
+	"testing"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/service/dynamodb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTableNamePattern(t *testing.T) {
+	svc := dynamodb.New(&aws.Config{Region: "us-east-1"})
+
+	req, _ := svc.DescribeTableRequest(&dynamodb.DescribeTableInput{TableName: aws.String("my-table.1")})
+	assert.NoError(t, req.Build())
+
+	req, _ = svc.DescribeTableRequest(&dynamodb.DescribeTableInput{TableName: aws.String("my table")})
+	err := aws.Error(req.Build())
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "InvalidParameter", err.Code)
+		assert.Contains(t, err.Message, "TableName must match pattern [a-zA-Z0-9_.-]+")
+	}
+}
+
+func TestValidateEnumValues(t *testing.T) {
+	input := func(v string) *dynamodb.GetItemInput {
+		return &dynamodb.GetItemInput{
+			TableName:              aws.String("table"),
+			Key:                    &map[string]*dynamodb.AttributeValue{"id": {S: aws.String("1")}},
+			ReturnConsumedCapacity: aws.String(v),
+		}
+	}
+
+	svc := dynamodb.New(&aws.Config{Region: "us-east-1", ValidateEnumValues: true})
+	req, _ := svc.GetItemRequest(input(dynamodb.ReturnConsumedCapacityTOTAL.String()))
+	assert.NoError(t, req.Build())
+
+	req, _ = svc.GetItemRequest(input("ALL"))
+	err := aws.Error(req.Build())
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Message, "ReturnConsumedCapacity must be one of INDEXES, TOTAL, NONE")
+	}
+
+	// values unknown to the SDK are sent unless enum validation is enabled
+	svc = dynamodb.New(&aws.Config{Region: "us-east-1"})
+	req, _ = svc.GetItemRequest(input("ALL"))
+	assert.NoError(t, req.Build())
+}
@@ -4,9 +4,11 @@
 package ec2
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // AcceptVPCPeeringConnectionRequest generates a request for the AcceptVPCPeeringConnection operation.
@@ -2702,6 +2704,11 @@ func (c *EC2) DescribeInstanceStatusRequest(input *DescribeInstanceStatusInput)
 			Name:       "DescribeInstanceStatus",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextToken"},
+				OutputTokens: []string{"NextToken"},
+				LimitToken:   "MaxResults",
+			},
 		}
 	}
 
@@ -2777,6 +2784,16 @@ func (c *EC2) DescribeInstanceStatus(input *DescribeInstanceStatusInput) (output
 	return
 }
 
+// DescribeInstanceStatusPages iterates over the pages of a DescribeInstanceStatus operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *EC2) DescribeInstanceStatusPages(input *DescribeInstanceStatusInput, fn func(p *DescribeInstanceStatusOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeInstanceStatusRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeInstanceStatusOutput), lastPage)
+	})
+}
+
 var opDescribeInstanceStatus *aws.Operation
 
 // DescribeInstancesRequest generates a request for the DescribeInstances operation.
@@ -2786,6 +2803,11 @@ func (c *EC2) DescribeInstancesRequest(input *DescribeInstancesInput) (req *aws.
 			Name:       "DescribeInstances",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextToken"},
+				OutputTokens: []string{"NextToken"},
+				LimitToken:   "MaxResults",
+			},
 		}
 	}
 
@@ -2812,6 +2834,16 @@ func (c *EC2) DescribeInstances(input *DescribeInstancesInput) (output *Describe
 	return
 }
 
+// DescribeInstancesPages iterates over the pages of a DescribeInstances operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *EC2) DescribeInstancesPages(input *DescribeInstancesInput, fn func(p *DescribeInstancesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeInstancesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeInstancesOutput), lastPage)
+	})
+}
+
 var opDescribeInstances *aws.Operation
 
 // DescribeInternetGatewaysRequest generates a request for the DescribeInternetGateways operation.
@@ -3091,6 +3123,10 @@ func (c *EC2) DescribeReservedInstancesModificationsRequest(input *DescribeReser
 			Name:       "DescribeReservedInstancesModifications",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextToken"},
+				OutputTokens: []string{"NextToken"},
+			},
 		}
 	}
 
@@ -3114,6 +3150,16 @@ func (c *EC2) DescribeReservedInstancesModifications(input *DescribeReservedInst
 	return
 }
 
+// DescribeReservedInstancesModificationsPages iterates over the pages of a DescribeReservedInstancesModifications operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *EC2) DescribeReservedInstancesModificationsPages(input *DescribeReservedInstancesModificationsInput, fn func(p *DescribeReservedInstancesModificationsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeReservedInstancesModificationsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeReservedInstancesModificationsOutput), lastPage)
+	})
+}
+
 var opDescribeReservedInstancesModifications *aws.Operation
 
 // DescribeReservedInstancesOfferingsRequest generates a request for the DescribeReservedInstancesOfferings operation.
@@ -3123,6 +3169,11 @@ func (c *EC2) DescribeReservedInstancesOfferingsRequest(input *DescribeReservedI
 			Name:       "DescribeReservedInstancesOfferings",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextToken"},
+				OutputTokens: []string{"NextToken"},
+				LimitToken:   "MaxResults",
+			},
 		}
 	}
 
@@ -3147,6 +3198,16 @@ func (c *EC2) DescribeReservedInstancesOfferings(input *DescribeReservedInstance
 	return
 }
 
+// DescribeReservedInstancesOfferingsPages iterates over the pages of a DescribeReservedInstancesOfferings operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *EC2) DescribeReservedInstancesOfferingsPages(input *DescribeReservedInstancesOfferingsInput, fn func(p *DescribeReservedInstancesOfferingsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeReservedInstancesOfferingsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeReservedInstancesOfferingsOutput), lastPage)
+	})
+}
+
 var opDescribeReservedInstancesOfferings *aws.Operation
 
 // DescribeRouteTablesRequest generates a request for the DescribeRouteTables operation.
@@ -3248,6 +3309,11 @@ func (c *EC2) DescribeSnapshotsRequest(input *DescribeSnapshotsInput) (req *aws.
 			Name:       "DescribeSnapshots",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextToken"},
+				OutputTokens: []string{"NextToken"},
+				LimitToken:   "MaxResults",
+			},
 		}
 	}
 
@@ -3304,6 +3370,16 @@ func (c *EC2) DescribeSnapshots(input *DescribeSnapshotsInput) (output *Describe
 	return
 }
 
+// DescribeSnapshotsPages iterates over the pages of a DescribeSnapshots operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *EC2) DescribeSnapshotsPages(input *DescribeSnapshotsInput, fn func(p *DescribeSnapshotsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeSnapshotsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeSnapshotsOutput), lastPage)
+	})
+}
+
 var opDescribeSnapshots *aws.Operation
 
 // DescribeSpotDatafeedSubscriptionRequest generates a request for the DescribeSpotDatafeedSubscription operation.
@@ -3378,6 +3454,11 @@ func (c *EC2) DescribeSpotPriceHistoryRequest(input *DescribeSpotPriceHistoryInp
 			Name:       "DescribeSpotPriceHistory",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextToken"},
+				OutputTokens: []string{"NextToken"},
+				LimitToken:   "MaxResults",
+			},
 		}
 	}
 
@@ -3403,6 +3484,16 @@ func (c *EC2) DescribeSpotPriceHistory(input *DescribeSpotPriceHistoryInput) (ou
 	return
 }
 
+// DescribeSpotPriceHistoryPages iterates over the pages of a DescribeSpotPriceHistory operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *EC2) DescribeSpotPriceHistoryPages(input *DescribeSpotPriceHistoryInput, fn func(p *DescribeSpotPriceHistoryOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeSpotPriceHistoryRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeSpotPriceHistoryOutput), lastPage)
+	})
+}
+
 var opDescribeSpotPriceHistory *aws.Operation
 
 // DescribeSubnetsRequest generates a request for the DescribeSubnets operation.
@@ -3441,6 +3532,11 @@ func (c *EC2) DescribeTagsRequest(input *DescribeTagsInput) (req *aws.Request, o
 			Name:       "DescribeTags",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextToken"},
+				OutputTokens: []string{"NextToken"},
+				LimitToken:   "MaxResults",
+			},
 		}
 	}
 
@@ -3461,6 +3557,16 @@ func (c *EC2) DescribeTags(input *DescribeTagsInput) (output *DescribeTagsOutput
 	return
 }
 
+// DescribeTagsPages iterates over the pages of a DescribeTags operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *EC2) DescribeTagsPages(input *DescribeTagsInput, fn func(p *DescribeTagsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeTagsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeTagsOutput), lastPage)
+	})
+}
+
 var opDescribeTags *aws.Operation
 
 // DescribeVPCAttributeRequest generates a request for the DescribeVPCAttribute operation.
@@ -3665,6 +3771,11 @@ func (c *EC2) DescribeVolumeStatusRequest(input *DescribeVolumeStatusInput) (req
 			Name:       "DescribeVolumeStatus",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextToken"},
+				OutputTokens: []string{"NextToken"},
+				LimitToken:   "MaxResults",
+			},
 		}
 	}
 
@@ -3716,6 +3827,16 @@ func (c *EC2) DescribeVolumeStatus(input *DescribeVolumeStatusInput) (output *De
 	return
 }
 
+// DescribeVolumeStatusPages iterates over the pages of a DescribeVolumeStatus operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *EC2) DescribeVolumeStatusPages(input *DescribeVolumeStatusInput, fn func(p *DescribeVolumeStatusOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeVolumeStatusRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeVolumeStatusOutput), lastPage)
+	})
+}
+
 var opDescribeVolumeStatus *aws.Operation
 
 // DescribeVolumesRequest generates a request for the DescribeVolumes operation.
@@ -5397,6 +5518,11 @@ type metadataAcceptVPCPeeringConnectionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AcceptVPCPeeringConnectionInput.
+func (s AcceptVPCPeeringConnectionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AcceptVPCPeeringConnectionOutput struct {
 	// Information about the VPC peering connection.
 	VPCPeeringConnection *VPCPeeringConnection `locationName:"vpcPeeringConnection" type:"structure"`
@@ -5408,6 +5534,11 @@ type metadataAcceptVPCPeeringConnectionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AcceptVPCPeeringConnectionOutput.
+func (s AcceptVPCPeeringConnectionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an account attribute.
 type AccountAttribute struct {
 	// The name of the account attribute.
@@ -5423,6 +5554,45 @@ type metadataAccountAttribute struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AccountAttribute.
+func (s AccountAttribute) String() string {
+	return awsutil.StringValue(s)
+}
+
+// AccountAttributeName is an enum of the values of AccountAttributeName members.
+type AccountAttributeName string
+
+// The values of AccountAttributeName.
+const (
+	AccountAttributeNameSupportedPlatforms AccountAttributeName = "supported-platforms"
+	AccountAttributeNameDefaultVpc         AccountAttributeName = "default-vpc"
+)
+
+// AccountAttributeNameValues returns the values of AccountAttributeName known to this
+// version of the SDK. The service may accept values added since.
+func AccountAttributeNameValues() []AccountAttributeName {
+	return []AccountAttributeName{
+		AccountAttributeName("supported-platforms"),
+		AccountAttributeName("default-vpc"),
+	}
+}
+
+// String returns the AccountAttributeName as the string of a member.
+func (v AccountAttributeName) String() string {
+	return string(v)
+}
+
+// ParseAccountAttributeName returns the AccountAttributeName of str, failing if it is not
+// one of the AccountAttributeNameValues.
+func ParseAccountAttributeName(str string) (AccountAttributeName, error) {
+	for _, v := range AccountAttributeNameValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid AccountAttributeName value %q", str)
+}
+
 // Describes a value of an account attribute.
 type AccountAttributeValue struct {
 	// The value of the attribute.
@@ -5435,6 +5605,11 @@ type metadataAccountAttributeValue struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AccountAttributeValue.
+func (s AccountAttributeValue) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an Elastic IP address.
 type Address struct {
 	// The ID representing the allocation of the address for use with EC2-VPC.
@@ -5446,7 +5621,7 @@ type Address struct {
 
 	// Indicates whether this Elastic IP address is for use with instances in EC2-Classic
 	// (standard) or instances in a VPC (vpc).
-	Domain *string `locationName:"domain" type:"string"`
+	Domain *string `locationName:"domain" type:"string" enum:"vpc,standard"`
 
 	// The ID of the instance the address is associated with (if any).
 	InstanceID *string `locationName:"instanceId" type:"string"`
@@ -5470,11 +5645,16 @@ type metadataAddress struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Address.
+func (s Address) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AllocateAddressInput struct {
 	// Set to vpc to allocate the address for use with instances in a VPC.
 	//
 	// Default: The address is for use with instances in EC2-Classic.
-	Domain *string `type:"string"`
+	Domain *string `type:"string" enum:"vpc,standard"`
 
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -5485,6 +5665,11 @@ type metadataAllocateAddressInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AllocateAddressInput.
+func (s AllocateAddressInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AllocateAddressOutput struct {
 	// [EC2-VPC] The ID that AWS assigns to represent the allocation of the Elastic
 	// IP address for use with instances in a VPC.
@@ -5492,7 +5677,7 @@ type AllocateAddressOutput struct {
 
 	// Indicates whether this Elastic IP address is for use with instances in EC2-Classic
 	// (standard) or instances in a VPC (vpc).
-	Domain *string `locationName:"domain" type:"string"`
+	Domain *string `locationName:"domain" type:"string" enum:"vpc,standard"`
 
 	// The Elastic IP address.
 	PublicIP *string `locationName:"publicIp" type:"string"`
@@ -5504,6 +5689,45 @@ type metadataAllocateAddressOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AllocateAddressOutput.
+func (s AllocateAddressOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ArchitectureValues is an enum of the values of ArchitectureValues members.
+type ArchitectureValues string
+
+// The values of ArchitectureValues.
+const (
+	ArchitectureValuesI386  ArchitectureValues = "i386"
+	ArchitectureValuesX8664 ArchitectureValues = "x86_64"
+)
+
+// ArchitectureValuesValues returns the values of ArchitectureValues known to this
+// version of the SDK. The service may accept values added since.
+func ArchitectureValuesValues() []ArchitectureValues {
+	return []ArchitectureValues{
+		ArchitectureValues("i386"),
+		ArchitectureValues("x86_64"),
+	}
+}
+
+// String returns the ArchitectureValues as the string of a member.
+func (v ArchitectureValues) String() string {
+	return string(v)
+}
+
+// ParseArchitectureValues returns the ArchitectureValues of str, failing if it is not
+// one of the ArchitectureValuesValues.
+func ParseArchitectureValues(str string) (ArchitectureValues, error) {
+	for _, v := range ArchitectureValuesValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ArchitectureValues value %q", str)
+}
+
 type AssignPrivateIPAddressesInput struct {
 	// Indicates whether to allow an IP address that is already assigned to another
 	// network interface or instance to be reassigned to the specified network interface.
@@ -5531,6 +5755,11 @@ type metadataAssignPrivateIPAddressesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AssignPrivateIPAddressesInput.
+func (s AssignPrivateIPAddressesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AssignPrivateIPAddressesOutput struct {
 	metadataAssignPrivateIPAddressesOutput `json:"-", xml:"-"`
 }
@@ -5539,6 +5768,11 @@ type metadataAssignPrivateIPAddressesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AssignPrivateIPAddressesOutput.
+func (s AssignPrivateIPAddressesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AssociateAddressInput struct {
 	// [EC2-VPC] The allocation ID. This is required for EC2-VPC.
 	AllocationID *string `locationName:"AllocationId" type:"string"`
@@ -5577,6 +5811,11 @@ type metadataAssociateAddressInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AssociateAddressInput.
+func (s AssociateAddressInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AssociateAddressOutput struct {
 	// [EC2-VPC] The ID that represents the association of the Elastic IP address
 	// with an instance.
@@ -5589,6 +5828,11 @@ type metadataAssociateAddressOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AssociateAddressOutput.
+func (s AssociateAddressOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AssociateDHCPOptionsInput struct {
 	// The ID of the DHCP options set, or default to associate no DHCP options with
 	// the VPC.
@@ -5606,6 +5850,11 @@ type metadataAssociateDHCPOptionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AssociateDHCPOptionsInput.
+func (s AssociateDHCPOptionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AssociateDHCPOptionsOutput struct {
 	metadataAssociateDHCPOptionsOutput `json:"-", xml:"-"`
 }
@@ -5614,6 +5863,11 @@ type metadataAssociateDHCPOptionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AssociateDHCPOptionsOutput.
+func (s AssociateDHCPOptionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AssociateRouteTableInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -5630,6 +5884,11 @@ type metadataAssociateRouteTableInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AssociateRouteTableInput.
+func (s AssociateRouteTableInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AssociateRouteTableOutput struct {
 	// The route table association ID (needed to disassociate the route table).
 	AssociationID *string `locationName:"associationId" type:"string"`
@@ -5641,6 +5900,11 @@ type metadataAssociateRouteTableOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AssociateRouteTableOutput.
+func (s AssociateRouteTableOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AttachClassicLinkVPCInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -5661,6 +5925,11 @@ type metadataAttachClassicLinkVPCInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AttachClassicLinkVPCInput.
+func (s AttachClassicLinkVPCInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AttachClassicLinkVPCOutput struct {
 	// Returns true if the request succeeds; otherwise, it returns an error.
 	Return *bool `locationName:"return" type:"boolean"`
@@ -5672,6 +5941,11 @@ type metadataAttachClassicLinkVPCOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AttachClassicLinkVPCOutput.
+func (s AttachClassicLinkVPCOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AttachInternetGatewayInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -5688,6 +5962,11 @@ type metadataAttachInternetGatewayInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AttachInternetGatewayInput.
+func (s AttachInternetGatewayInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AttachInternetGatewayOutput struct {
 	metadataAttachInternetGatewayOutput `json:"-", xml:"-"`
 }
@@ -5696,6 +5975,11 @@ type metadataAttachInternetGatewayOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AttachInternetGatewayOutput.
+func (s AttachInternetGatewayOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AttachNetworkInterfaceInput struct {
 	// The index of the device for the network interface attachment.
 	DeviceIndex *int64 `locationName:"deviceIndex" type:"integer" required:"true"`
@@ -5715,6 +5999,11 @@ type metadataAttachNetworkInterfaceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AttachNetworkInterfaceInput.
+func (s AttachNetworkInterfaceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AttachNetworkInterfaceOutput struct {
 	// The ID of the network interface attachment.
 	AttachmentID *string `locationName:"attachmentId" type:"string"`
@@ -5726,6 +6015,11 @@ type metadataAttachNetworkInterfaceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AttachNetworkInterfaceOutput.
+func (s AttachNetworkInterfaceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AttachVPNGatewayInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -5742,6 +6036,11 @@ type metadataAttachVPNGatewayInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AttachVPNGatewayInput.
+func (s AttachVPNGatewayInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AttachVPNGatewayOutput struct {
 	// Information about the attachment.
 	VPCAttachment *VPCAttachment `locationName:"attachment" type:"structure"`
@@ -5753,6 +6052,11 @@ type metadataAttachVPNGatewayOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AttachVPNGatewayOutput.
+func (s AttachVPNGatewayOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AttachVolumeInput struct {
 	// The device name to expose to the instance (for example, /dev/sdh or xvdh).
 	Device *string `type:"string" required:"true"`
@@ -5773,6 +6077,49 @@ type metadataAttachVolumeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AttachVolumeInput.
+func (s AttachVolumeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// AttachmentStatus is an enum of the values of AttachmentStatus members.
+type AttachmentStatus string
+
+// The values of AttachmentStatus.
+const (
+	AttachmentStatusAttaching AttachmentStatus = "attaching"
+	AttachmentStatusAttached  AttachmentStatus = "attached"
+	AttachmentStatusDetaching AttachmentStatus = "detaching"
+	AttachmentStatusDetached  AttachmentStatus = "detached"
+)
+
+// AttachmentStatusValues returns the values of AttachmentStatus known to this
+// version of the SDK. The service may accept values added since.
+func AttachmentStatusValues() []AttachmentStatus {
+	return []AttachmentStatus{
+		AttachmentStatus("attaching"),
+		AttachmentStatus("attached"),
+		AttachmentStatus("detaching"),
+		AttachmentStatus("detached"),
+	}
+}
+
+// String returns the AttachmentStatus as the string of a member.
+func (v AttachmentStatus) String() string {
+	return string(v)
+}
+
+// ParseAttachmentStatus returns the AttachmentStatus of str, failing if it is not
+// one of the AttachmentStatusValues.
+func ParseAttachmentStatus(str string) (AttachmentStatus, error) {
+	for _, v := range AttachmentStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid AttachmentStatus value %q", str)
+}
+
 // The value to use when a resource attribute accepts a Boolean value.
 type AttributeBooleanValue struct {
 	// Valid values are true or false.
@@ -5785,6 +6132,11 @@ type metadataAttributeBooleanValue struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AttributeBooleanValue.
+func (s AttributeBooleanValue) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The value to use for a resource attribute.
 type AttributeValue struct {
 	// Valid values are case-sensitive and vary by action.
@@ -5797,6 +6149,11 @@ type metadataAttributeValue struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AttributeValue.
+func (s AttributeValue) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AuthorizeSecurityGroupEgressInput struct {
 	// The CIDR IP address range. You can't specify this parameter when specifying
 	// a source security group.
@@ -5838,6 +6195,11 @@ type metadataAuthorizeSecurityGroupEgressInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AuthorizeSecurityGroupEgressInput.
+func (s AuthorizeSecurityGroupEgressInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AuthorizeSecurityGroupEgressOutput struct {
 	metadataAuthorizeSecurityGroupEgressOutput `json:"-", xml:"-"`
 }
@@ -5846,6 +6208,11 @@ type metadataAuthorizeSecurityGroupEgressOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AuthorizeSecurityGroupEgressOutput.
+func (s AuthorizeSecurityGroupEgressOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AuthorizeSecurityGroupIngressInput struct {
 	// The CIDR IP address range. You can't specify this parameter when specifying
 	// a source security group.
@@ -5890,6 +6257,11 @@ type metadataAuthorizeSecurityGroupIngressInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AuthorizeSecurityGroupIngressInput.
+func (s AuthorizeSecurityGroupIngressInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AuthorizeSecurityGroupIngressOutput struct {
 	metadataAuthorizeSecurityGroupIngressOutput `json:"-", xml:"-"`
 }
@@ -5898,6 +6270,11 @@ type metadataAuthorizeSecurityGroupIngressOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AuthorizeSecurityGroupIngressOutput.
+func (s AuthorizeSecurityGroupIngressOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an Availability Zone.
 type AvailabilityZone struct {
 	// Any messages about the Availability Zone.
@@ -5907,7 +6284,7 @@ type AvailabilityZone struct {
 	RegionName *string `locationName:"regionName" type:"string"`
 
 	// The state of the Availability Zone (available | impaired | unavailable).
-	State *string `locationName:"zoneState" type:"string"`
+	State *string `locationName:"zoneState" type:"string" enum:"available"`
 
 	// The name of the Availability Zone.
 	ZoneName *string `locationName:"zoneName" type:"string"`
@@ -5919,6 +6296,11 @@ type metadataAvailabilityZone struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AvailabilityZone.
+func (s AvailabilityZone) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a message about an Availability Zone.
 type AvailabilityZoneMessage struct {
 	// The message about the Availability Zone.
@@ -5931,6 +6313,43 @@ type metadataAvailabilityZoneMessage struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AvailabilityZoneMessage.
+func (s AvailabilityZoneMessage) String() string {
+	return awsutil.StringValue(s)
+}
+
+// AvailabilityZoneState is an enum of the values of AvailabilityZoneState members.
+type AvailabilityZoneState string
+
+// The values of AvailabilityZoneState.
+const (
+	AvailabilityZoneStateAvailable AvailabilityZoneState = "available"
+)
+
+// AvailabilityZoneStateValues returns the values of AvailabilityZoneState known to this
+// version of the SDK. The service may accept values added since.
+func AvailabilityZoneStateValues() []AvailabilityZoneState {
+	return []AvailabilityZoneState{
+		AvailabilityZoneState("available"),
+	}
+}
+
+// String returns the AvailabilityZoneState as the string of a member.
+func (v AvailabilityZoneState) String() string {
+	return string(v)
+}
+
+// ParseAvailabilityZoneState returns the AvailabilityZoneState of str, failing if it is not
+// one of the AvailabilityZoneStateValues.
+func ParseAvailabilityZoneState(str string) (AvailabilityZoneState, error) {
+	for _, v := range AvailabilityZoneStateValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid AvailabilityZoneState value %q", str)
+}
+
 type BlobAttributeValue struct {
 	Value []byte `locationName:"value" type:"blob"`
 
@@ -5941,6 +6360,11 @@ type metadataBlobAttributeValue struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the BlobAttributeValue.
+func (s BlobAttributeValue) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a block device mapping.
 type BlockDeviceMapping struct {
 	// The device name exposed to the instance (for example, /dev/sdh).
@@ -5973,6 +6397,11 @@ type metadataBlockDeviceMapping struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the BlockDeviceMapping.
+func (s BlockDeviceMapping) String() string {
+	return awsutil.StringValue(s)
+}
+
 type BundleInstanceInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -5997,6 +6426,11 @@ type metadataBundleInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the BundleInstanceInput.
+func (s BundleInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type BundleInstanceOutput struct {
 	// Information about the bundle task.
 	BundleTask *BundleTask `locationName:"bundleInstanceTask" type:"structure"`
@@ -6008,6 +6442,11 @@ type metadataBundleInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the BundleInstanceOutput.
+func (s BundleInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a bundle task.
 type BundleTask struct {
 	// The ID of the bundle task.
@@ -6026,7 +6465,7 @@ type BundleTask struct {
 	StartTime *time.Time `locationName:"startTime" type:"timestamp" timestampFormat:"iso8601"`
 
 	// The state of the task.
-	State *string `locationName:"state" type:"string"`
+	State *string `locationName:"state" type:"string" enum:"pending,waiting-for-shutdown,bundling,storing,cancelling,complete,failed"`
 
 	// The Amazon S3 storage locations.
 	Storage *Storage `locationName:"storage" type:"structure"`
@@ -6041,6 +6480,11 @@ type metadataBundleTask struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the BundleTask.
+func (s BundleTask) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an error for BundleInstance.
 type BundleTaskError struct {
 	// The error code.
@@ -6056,6 +6500,55 @@ type metadataBundleTaskError struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the BundleTaskError.
+func (s BundleTaskError) String() string {
+	return awsutil.StringValue(s)
+}
+
+// BundleTaskState is an enum of the values of BundleTaskState members.
+type BundleTaskState string
+
+// The values of BundleTaskState.
+const (
+	BundleTaskStatePending            BundleTaskState = "pending"
+	BundleTaskStateWaitingForShutdown BundleTaskState = "waiting-for-shutdown"
+	BundleTaskStateBundling           BundleTaskState = "bundling"
+	BundleTaskStateStoring            BundleTaskState = "storing"
+	BundleTaskStateCancelling         BundleTaskState = "cancelling"
+	BundleTaskStateComplete           BundleTaskState = "complete"
+	BundleTaskStateFailed             BundleTaskState = "failed"
+)
+
+// BundleTaskStateValues returns the values of BundleTaskState known to this
+// version of the SDK. The service may accept values added since.
+func BundleTaskStateValues() []BundleTaskState {
+	return []BundleTaskState{
+		BundleTaskState("pending"),
+		BundleTaskState("waiting-for-shutdown"),
+		BundleTaskState("bundling"),
+		BundleTaskState("storing"),
+		BundleTaskState("cancelling"),
+		BundleTaskState("complete"),
+		BundleTaskState("failed"),
+	}
+}
+
+// String returns the BundleTaskState as the string of a member.
+func (v BundleTaskState) String() string {
+	return string(v)
+}
+
+// ParseBundleTaskState returns the BundleTaskState of str, failing if it is not
+// one of the BundleTaskStateValues.
+func ParseBundleTaskState(str string) (BundleTaskState, error) {
+	for _, v := range BundleTaskStateValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid BundleTaskState value %q", str)
+}
+
 type CancelBundleTaskInput struct {
 	// The ID of the bundle task.
 	BundleID *string `locationName:"BundleId" type:"string" required:"true"`
@@ -6069,6 +6562,11 @@ type metadataCancelBundleTaskInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CancelBundleTaskInput.
+func (s CancelBundleTaskInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CancelBundleTaskOutput struct {
 	// The bundle task.
 	BundleTask *BundleTask `locationName:"bundleInstanceTask" type:"structure"`
@@ -6080,6 +6578,11 @@ type metadataCancelBundleTaskOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CancelBundleTaskOutput.
+func (s CancelBundleTaskOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CancelConversionTaskInput struct {
 	// The ID of the conversion task.
 	ConversionTaskID *string `locationName:"conversionTaskId" type:"string" required:"true"`
@@ -6095,6 +6598,11 @@ type metadataCancelConversionTaskInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CancelConversionTaskInput.
+func (s CancelConversionTaskInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CancelConversionTaskOutput struct {
 	metadataCancelConversionTaskOutput `json:"-", xml:"-"`
 }
@@ -6103,6 +6611,11 @@ type metadataCancelConversionTaskOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CancelConversionTaskOutput.
+func (s CancelConversionTaskOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CancelExportTaskInput struct {
 	// The ID of the export task. This is the ID returned by CreateInstanceExportTask.
 	ExportTaskID *string `locationName:"exportTaskId" type:"string" required:"true"`
@@ -6114,6 +6627,11 @@ type metadataCancelExportTaskInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CancelExportTaskInput.
+func (s CancelExportTaskInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CancelExportTaskOutput struct {
 	metadataCancelExportTaskOutput `json:"-", xml:"-"`
 }
@@ -6122,6 +6640,11 @@ type metadataCancelExportTaskOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CancelExportTaskOutput.
+func (s CancelExportTaskOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CancelReservedInstancesListingInput struct {
 	// The ID of the Reserved Instance listing.
 	ReservedInstancesListingID *string `locationName:"reservedInstancesListingId" type:"string" required:"true"`
@@ -6133,6 +6656,11 @@ type metadataCancelReservedInstancesListingInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CancelReservedInstancesListingInput.
+func (s CancelReservedInstancesListingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CancelReservedInstancesListingOutput struct {
 	// The Reserved Instance listing.
 	ReservedInstancesListings []*ReservedInstancesListing `locationName:"reservedInstancesListingsSet" locationNameList:"item" type:"list"`
@@ -6144,6 +6672,51 @@ type metadataCancelReservedInstancesListingOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CancelReservedInstancesListingOutput.
+func (s CancelReservedInstancesListingOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// CancelSpotInstanceRequestState is an enum of the values of CancelSpotInstanceRequestState members.
+type CancelSpotInstanceRequestState string
+
+// The values of CancelSpotInstanceRequestState.
+const (
+	CancelSpotInstanceRequestStateActive    CancelSpotInstanceRequestState = "active"
+	CancelSpotInstanceRequestStateOpen      CancelSpotInstanceRequestState = "open"
+	CancelSpotInstanceRequestStateClosed    CancelSpotInstanceRequestState = "closed"
+	CancelSpotInstanceRequestStateCancelled CancelSpotInstanceRequestState = "cancelled"
+	CancelSpotInstanceRequestStateCompleted CancelSpotInstanceRequestState = "completed"
+)
+
+// CancelSpotInstanceRequestStateValues returns the values of CancelSpotInstanceRequestState known to this
+// version of the SDK. The service may accept values added since.
+func CancelSpotInstanceRequestStateValues() []CancelSpotInstanceRequestState {
+	return []CancelSpotInstanceRequestState{
+		CancelSpotInstanceRequestState("active"),
+		CancelSpotInstanceRequestState("open"),
+		CancelSpotInstanceRequestState("closed"),
+		CancelSpotInstanceRequestState("cancelled"),
+		CancelSpotInstanceRequestState("completed"),
+	}
+}
+
+// String returns the CancelSpotInstanceRequestState as the string of a member.
+func (v CancelSpotInstanceRequestState) String() string {
+	return string(v)
+}
+
+// ParseCancelSpotInstanceRequestState returns the CancelSpotInstanceRequestState of str, failing if it is not
+// one of the CancelSpotInstanceRequestStateValues.
+func ParseCancelSpotInstanceRequestState(str string) (CancelSpotInstanceRequestState, error) {
+	for _, v := range CancelSpotInstanceRequestStateValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid CancelSpotInstanceRequestState value %q", str)
+}
+
 type CancelSpotInstanceRequestsInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -6157,6 +6730,11 @@ type metadataCancelSpotInstanceRequestsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CancelSpotInstanceRequestsInput.
+func (s CancelSpotInstanceRequestsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CancelSpotInstanceRequestsOutput struct {
 	// One or more Spot Instance requests.
 	CancelledSpotInstanceRequests []*CancelledSpotInstanceRequest `locationName:"spotInstanceRequestSet" locationNameList:"item" type:"list"`
@@ -6168,13 +6746,18 @@ type metadataCancelSpotInstanceRequestsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CancelSpotInstanceRequestsOutput.
+func (s CancelSpotInstanceRequestsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a request to cancel a Spot Instance.
 type CancelledSpotInstanceRequest struct {
 	// The ID of the Spot Instance request.
 	SpotInstanceRequestID *string `locationName:"spotInstanceRequestId" type:"string"`
 
 	// The state of the Spot Instance request.
-	State *string `locationName:"state" type:"string"`
+	State *string `locationName:"state" type:"string" enum:"active,open,closed,cancelled,completed"`
 
 	metadataCancelledSpotInstanceRequest `json:"-", xml:"-"`
 }
@@ -6183,6 +6766,11 @@ type metadataCancelledSpotInstanceRequest struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CancelledSpotInstanceRequest.
+func (s CancelledSpotInstanceRequest) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a linked EC2-Classic instance.
 type ClassicLinkInstance struct {
 	// A list of security groups.
@@ -6204,6 +6792,11 @@ type metadataClassicLinkInstance struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ClassicLinkInstance.
+func (s ClassicLinkInstance) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ConfirmProductInstanceInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -6220,6 +6813,11 @@ type metadataConfirmProductInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ConfirmProductInstanceInput.
+func (s ConfirmProductInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ConfirmProductInstanceOutput struct {
 	// The AWS account ID of the instance owner. This is only present if the product
 	// code is attached to the instance.
@@ -6232,6 +6830,43 @@ type metadataConfirmProductInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ConfirmProductInstanceOutput.
+func (s ConfirmProductInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ContainerFormat is an enum of the values of ContainerFormat members.
+type ContainerFormat string
+
+// The values of ContainerFormat.
+const (
+	ContainerFormatOva ContainerFormat = "ova"
+)
+
+// ContainerFormatValues returns the values of ContainerFormat known to this
+// version of the SDK. The service may accept values added since.
+func ContainerFormatValues() []ContainerFormat {
+	return []ContainerFormat{
+		ContainerFormat("ova"),
+	}
+}
+
+// String returns the ContainerFormat as the string of a member.
+func (v ContainerFormat) String() string {
+	return string(v)
+}
+
+// ParseContainerFormat returns the ContainerFormat of str, failing if it is not
+// one of the ContainerFormatValues.
+func ParseContainerFormat(str string) (ContainerFormat, error) {
+	for _, v := range ContainerFormatValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ContainerFormat value %q", str)
+}
+
 // Describes a conversion task.
 type ConversionTask struct {
 	// The ID of the conversion task.
@@ -6250,7 +6885,7 @@ type ConversionTask struct {
 	ImportVolume *ImportVolumeTaskDetails `locationName:"importVolume" type:"structure"`
 
 	// The state of the conversion task.
-	State *string `locationName:"state" type:"string" required:"true"`
+	State *string `locationName:"state" type:"string" enum:"active,cancelling,cancelled,completed" required:"true"`
 
 	// The status message related to the conversion task.
 	StatusMessage *string `locationName:"statusMessage" type:"string"`
@@ -6264,6 +6899,49 @@ type metadataConversionTask struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ConversionTask.
+func (s ConversionTask) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ConversionTaskState is an enum of the values of ConversionTaskState members.
+type ConversionTaskState string
+
+// The values of ConversionTaskState.
+const (
+	ConversionTaskStateActive     ConversionTaskState = "active"
+	ConversionTaskStateCancelling ConversionTaskState = "cancelling"
+	ConversionTaskStateCancelled  ConversionTaskState = "cancelled"
+	ConversionTaskStateCompleted  ConversionTaskState = "completed"
+)
+
+// ConversionTaskStateValues returns the values of ConversionTaskState known to this
+// version of the SDK. The service may accept values added since.
+func ConversionTaskStateValues() []ConversionTaskState {
+	return []ConversionTaskState{
+		ConversionTaskState("active"),
+		ConversionTaskState("cancelling"),
+		ConversionTaskState("cancelled"),
+		ConversionTaskState("completed"),
+	}
+}
+
+// String returns the ConversionTaskState as the string of a member.
+func (v ConversionTaskState) String() string {
+	return string(v)
+}
+
+// ParseConversionTaskState returns the ConversionTaskState of str, failing if it is not
+// one of the ConversionTaskStateValues.
+func ParseConversionTaskState(str string) (ConversionTaskState, error) {
+	for _, v := range ConversionTaskStateValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ConversionTaskState value %q", str)
+}
+
 type CopyImageInput struct {
 	// Unique, case-sensitive identifier you provide to ensure idempotency of the
 	// request. For more information, see How to Ensure Idempotency (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/Run_Instance_Idempotency.html)
@@ -6291,6 +6969,11 @@ type metadataCopyImageInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CopyImageInput.
+func (s CopyImageInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CopyImageOutput struct {
 	// The ID of the new AMI.
 	ImageID *string `locationName:"imageId" type:"string"`
@@ -6302,6 +6985,11 @@ type metadataCopyImageOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CopyImageOutput.
+func (s CopyImageOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CopySnapshotInput struct {
 	// A description for the new Amazon EBS snapshot.
 	Description *string `type:"string"`
@@ -6339,6 +7027,11 @@ type metadataCopySnapshotInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CopySnapshotInput.
+func (s CopySnapshotInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CopySnapshotOutput struct {
 	// The ID of the new snapshot.
 	SnapshotID *string `locationName:"snapshotId" type:"string"`
@@ -6350,6 +7043,11 @@ type metadataCopySnapshotOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CopySnapshotOutput.
+func (s CopySnapshotOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateCustomerGatewayInput struct {
 	// For devices that support BGP, the customer gateway's BGP ASN.
 	//
@@ -6363,7 +7061,7 @@ type CreateCustomerGatewayInput struct {
 	PublicIP *string `locationName:"IpAddress" type:"string" required:"true"`
 
 	// The type of VPN connection that this customer gateway supports (ipsec.1).
-	Type *string `type:"string" required:"true"`
+	Type *string `type:"string" enum:"ipsec.1" required:"true"`
 
 	metadataCreateCustomerGatewayInput `json:"-", xml:"-"`
 }
@@ -6372,6 +7070,11 @@ type metadataCreateCustomerGatewayInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateCustomerGatewayInput.
+func (s CreateCustomerGatewayInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateCustomerGatewayOutput struct {
 	// Information about the customer gateway.
 	CustomerGateway *CustomerGateway `locationName:"customerGateway" type:"structure"`
@@ -6383,6 +7086,11 @@ type metadataCreateCustomerGatewayOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateCustomerGatewayOutput.
+func (s CreateCustomerGatewayOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateDHCPOptionsInput struct {
 	// A DHCP configuration option.
 	DHCPConfigurations []*NewDHCPConfiguration `locationName:"dhcpConfiguration" locationNameList:"item" type:"list" required:"true"`
@@ -6396,6 +7104,11 @@ type metadataCreateDHCPOptionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateDHCPOptionsInput.
+func (s CreateDHCPOptionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateDHCPOptionsOutput struct {
 	// A set of DHCP options.
 	DHCPOptions *DHCPOptions `locationName:"dhcpOptions" type:"structure"`
@@ -6407,6 +7120,11 @@ type metadataCreateDHCPOptionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateDHCPOptionsOutput.
+func (s CreateDHCPOptionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateImageInput struct {
 	// Information about one or more block device mappings.
 	BlockDeviceMappings []*BlockDeviceMapping `locationName:"blockDeviceMapping" locationNameList:"BlockDeviceMapping" type:"list"`
@@ -6440,6 +7158,11 @@ type metadataCreateImageInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateImageInput.
+func (s CreateImageInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateImageOutput struct {
 	// The ID of the new AMI.
 	ImageID *string `locationName:"imageId" type:"string"`
@@ -6451,6 +7174,11 @@ type metadataCreateImageOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateImageOutput.
+func (s CreateImageOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateInstanceExportTaskInput struct {
 	// A description for the conversion task or the resource being exported. The
 	// maximum length is 255 bytes.
@@ -6462,7 +7190,7 @@ type CreateInstanceExportTaskInput struct {
 	InstanceID *string `locationName:"instanceId" type:"string" required:"true"`
 
 	// The target virtualization environment.
-	TargetEnvironment *string `locationName:"targetEnvironment" type:"string"`
+	TargetEnvironment *string `locationName:"targetEnvironment" type:"string" enum:"citrix,vmware,microsoft"`
 
 	metadataCreateInstanceExportTaskInput `json:"-", xml:"-"`
 }
@@ -6471,6 +7199,11 @@ type metadataCreateInstanceExportTaskInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateInstanceExportTaskInput.
+func (s CreateInstanceExportTaskInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateInstanceExportTaskOutput struct {
 	// Describes an export task.
 	ExportTask *ExportTask `locationName:"exportTask" type:"structure"`
@@ -6482,6 +7215,11 @@ type metadataCreateInstanceExportTaskOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateInstanceExportTaskOutput.
+func (s CreateInstanceExportTaskOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateInternetGatewayInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -6492,6 +7230,11 @@ type metadataCreateInternetGatewayInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateInternetGatewayInput.
+func (s CreateInternetGatewayInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateInternetGatewayOutput struct {
 	// Information about the Internet gateway.
 	InternetGateway *InternetGateway `locationName:"internetGateway" type:"structure"`
@@ -6503,6 +7246,11 @@ type metadataCreateInternetGatewayOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateInternetGatewayOutput.
+func (s CreateInternetGatewayOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateKeyPairInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -6518,6 +7266,11 @@ type metadataCreateKeyPairInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateKeyPairInput.
+func (s CreateKeyPairInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a key pair.
 type CreateKeyPairOutput struct {
 	// The SHA-1 digest of the DER encoded private key.
@@ -6536,6 +7289,11 @@ type metadataCreateKeyPairOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateKeyPairOutput.
+func (s CreateKeyPairOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateNetworkACLEntryInput struct {
 	// The network range to allow or deny, in CIDR notation (for example 172.16.0.0/24).
 	CIDRBlock *string `locationName:"cidrBlock" type:"string" required:"true"`
@@ -6560,7 +7318,7 @@ type CreateNetworkACLEntryInput struct {
 	Protocol *string `locationName:"protocol" type:"string" required:"true"`
 
 	// Indicates whether to allow or deny the traffic that matches the rule.
-	RuleAction *string `locationName:"ruleAction" type:"string" required:"true"`
+	RuleAction *string `locationName:"ruleAction" type:"string" enum:"allow,deny" required:"true"`
 
 	// The rule number for the entry (for example, 100). ACL entries are processed
 	// in ascending order by rule number.
@@ -6575,6 +7333,11 @@ type metadataCreateNetworkACLEntryInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateNetworkACLEntryInput.
+func (s CreateNetworkACLEntryInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateNetworkACLEntryOutput struct {
 	metadataCreateNetworkACLEntryOutput `json:"-", xml:"-"`
 }
@@ -6583,7 +7346,12 @@ type metadataCreateNetworkACLEntryOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type CreateNetworkACLInput struct {
+// String returns the string representation of the CreateNetworkACLEntryOutput.
+func (s CreateNetworkACLEntryOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+type CreateNetworkACLInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
 	// The ID of the VPC.
@@ -6596,6 +7364,11 @@ type metadataCreateNetworkACLInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateNetworkACLInput.
+func (s CreateNetworkACLInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateNetworkACLOutput struct {
 	// Information about the network ACL.
 	NetworkACL *NetworkACL `locationName:"networkAcl" type:"structure"`
@@ -6607,6 +7380,11 @@ type metadataCreateNetworkACLOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateNetworkACLOutput.
+func (s CreateNetworkACLOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateNetworkInterfaceInput struct {
 	// A description for the network interface.
 	Description *string `locationName:"description" type:"string"`
@@ -6646,6 +7424,11 @@ type metadataCreateNetworkInterfaceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateNetworkInterfaceInput.
+func (s CreateNetworkInterfaceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateNetworkInterfaceOutput struct {
 	// Information about the network interface.
 	NetworkInterface *NetworkInterface `locationName:"networkInterface" type:"structure"`
@@ -6657,6 +7440,11 @@ type metadataCreateNetworkInterfaceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateNetworkInterfaceOutput.
+func (s CreateNetworkInterfaceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreatePlacementGroupInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -6666,7 +7454,7 @@ type CreatePlacementGroupInput struct {
 	GroupName *string `locationName:"groupName" type:"string" required:"true"`
 
 	// The placement strategy.
-	Strategy *string `locationName:"strategy" type:"string" required:"true"`
+	Strategy *string `locationName:"strategy" type:"string" enum:"cluster" required:"true"`
 
 	metadataCreatePlacementGroupInput `json:"-", xml:"-"`
 }
@@ -6675,6 +7463,11 @@ type metadataCreatePlacementGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreatePlacementGroupInput.
+func (s CreatePlacementGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreatePlacementGroupOutput struct {
 	metadataCreatePlacementGroupOutput `json:"-", xml:"-"`
 }
@@ -6683,6 +7476,11 @@ type metadataCreatePlacementGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreatePlacementGroupOutput.
+func (s CreatePlacementGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateReservedInstancesListingInput struct {
 	// Unique, case-sensitive identifier you provide to ensure idempotency of your
 	// listings. This helps avoid duplicate listings. For more information, see
@@ -6710,6 +7508,11 @@ type metadataCreateReservedInstancesListingInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateReservedInstancesListingInput.
+func (s CreateReservedInstancesListingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateReservedInstancesListingOutput struct {
 	// Information about the Reserved Instances listing.
 	ReservedInstancesListings []*ReservedInstancesListing `locationName:"reservedInstancesListingsSet" locationNameList:"item" type:"list"`
@@ -6721,6 +7524,11 @@ type metadataCreateReservedInstancesListingOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateReservedInstancesListingOutput.
+func (s CreateReservedInstancesListingOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateRouteInput struct {
 	// The CIDR address block used for the destination match. Routing decisions
 	// are based on the most specific match.
@@ -6752,6 +7560,11 @@ type metadataCreateRouteInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateRouteInput.
+func (s CreateRouteInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateRouteOutput struct {
 	metadataCreateRouteOutput `json:"-", xml:"-"`
 }
@@ -6760,6 +7573,11 @@ type metadataCreateRouteOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateRouteOutput.
+func (s CreateRouteOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateRouteTableInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -6773,6 +7591,11 @@ type metadataCreateRouteTableInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateRouteTableInput.
+func (s CreateRouteTableInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateRouteTableOutput struct {
 	// Information about the route table.
 	RouteTable *RouteTable `locationName:"routeTable" type:"structure"`
@@ -6784,6 +7607,11 @@ type metadataCreateRouteTableOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateRouteTableOutput.
+func (s CreateRouteTableOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateSecurityGroupInput struct {
 	// A description for the security group. This is informational only.
 	//
@@ -6815,6 +7643,11 @@ type metadataCreateSecurityGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateSecurityGroupInput.
+func (s CreateSecurityGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateSecurityGroupOutput struct {
 	// The ID of the security group.
 	GroupID *string `locationName:"groupId" type:"string"`
@@ -6826,6 +7659,11 @@ type metadataCreateSecurityGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateSecurityGroupOutput.
+func (s CreateSecurityGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateSnapshotInput struct {
 	// A description for the snapshot.
 	Description *string `type:"string"`
@@ -6842,6 +7680,11 @@ type metadataCreateSnapshotInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateSnapshotInput.
+func (s CreateSnapshotInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateSpotDatafeedSubscriptionInput struct {
 	// The Amazon S3 bucket in which to store the Spot Instance data feed.
 	Bucket *string `locationName:"bucket" type:"string" required:"true"`
@@ -6858,6 +7701,11 @@ type metadataCreateSpotDatafeedSubscriptionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateSpotDatafeedSubscriptionInput.
+func (s CreateSpotDatafeedSubscriptionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateSpotDatafeedSubscriptionOutput struct {
 	// The Spot Instance data feed subscription.
 	SpotDatafeedSubscription *SpotDatafeedSubscription `locationName:"spotDatafeedSubscription" type:"structure"`
@@ -6869,6 +7717,11 @@ type metadataCreateSpotDatafeedSubscriptionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateSpotDatafeedSubscriptionOutput.
+func (s CreateSpotDatafeedSubscriptionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateSubnetInput struct {
 	// The Availability Zone for the subnet.
 	//
@@ -6890,6 +7743,11 @@ type metadataCreateSubnetInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateSubnetInput.
+func (s CreateSubnetInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateSubnetOutput struct {
 	// Information about the subnet.
 	Subnet *Subnet `locationName:"subnet" type:"structure"`
@@ -6901,6 +7759,11 @@ type metadataCreateSubnetOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateSubnetOutput.
+func (s CreateSubnetOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateTagsInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -6919,6 +7782,11 @@ type metadataCreateTagsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateTagsInput.
+func (s CreateTagsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateTagsOutput struct {
 	metadataCreateTagsOutput `json:"-", xml:"-"`
 }
@@ -6927,6 +7795,11 @@ type metadataCreateTagsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateTagsOutput.
+func (s CreateTagsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateVPCInput struct {
 	// The network range for the VPC, in CIDR notation. For example, 10.0.0.0/16.
 	CIDRBlock *string `locationName:"CidrBlock" type:"string" required:"true"`
@@ -6940,7 +7813,7 @@ type CreateVPCInput struct {
 	// Dedicated tenancy instances run on single-tenant hardware.
 	//
 	// Default: default
-	InstanceTenancy *string `locationName:"instanceTenancy" type:"string"`
+	InstanceTenancy *string `locationName:"instanceTenancy" type:"string" enum:"default,dedicated"`
 
 	metadataCreateVPCInput `json:"-", xml:"-"`
 }
@@ -6949,6 +7822,11 @@ type metadataCreateVPCInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateVPCInput.
+func (s CreateVPCInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateVPCOutput struct {
 	// Information about the VPC.
 	VPC *VPC `locationName:"vpc" type:"structure"`
@@ -6960,6 +7838,11 @@ type metadataCreateVPCOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateVPCOutput.
+func (s CreateVPCOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateVPCPeeringConnectionInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -6981,6 +7864,11 @@ type metadataCreateVPCPeeringConnectionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateVPCPeeringConnectionInput.
+func (s CreateVPCPeeringConnectionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateVPCPeeringConnectionOutput struct {
 	// Information about the VPC peering connection.
 	VPCPeeringConnection *VPCPeeringConnection `locationName:"vpcPeeringConnection" type:"structure"`
@@ -6992,6 +7880,11 @@ type metadataCreateVPCPeeringConnectionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateVPCPeeringConnectionOutput.
+func (s CreateVPCPeeringConnectionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateVPNConnectionInput struct {
 	// The ID of the customer gateway.
 	CustomerGatewayID *string `locationName:"CustomerGatewayId" type:"string" required:"true"`
@@ -7018,6 +7911,11 @@ type metadataCreateVPNConnectionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateVPNConnectionInput.
+func (s CreateVPNConnectionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateVPNConnectionOutput struct {
 	// Information about the VPN connection.
 	VPNConnection *VPNConnection `locationName:"vpnConnection" type:"structure"`
@@ -7029,6 +7927,11 @@ type metadataCreateVPNConnectionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateVPNConnectionOutput.
+func (s CreateVPNConnectionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateVPNConnectionRouteInput struct {
 	// The CIDR block associated with the local subnet of the customer network.
 	DestinationCIDRBlock *string `locationName:"DestinationCidrBlock" type:"string" required:"true"`
@@ -7043,6 +7946,11 @@ type metadataCreateVPNConnectionRouteInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateVPNConnectionRouteInput.
+func (s CreateVPNConnectionRouteInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateVPNConnectionRouteOutput struct {
 	metadataCreateVPNConnectionRouteOutput `json:"-", xml:"-"`
 }
@@ -7051,6 +7959,11 @@ type metadataCreateVPNConnectionRouteOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateVPNConnectionRouteOutput.
+func (s CreateVPNConnectionRouteOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateVPNGatewayInput struct {
 	// The Availability Zone for the virtual private gateway.
 	AvailabilityZone *string `type:"string"`
@@ -7058,7 +7971,7 @@ type CreateVPNGatewayInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
 	// The type of VPN connection this virtual private gateway supports.
-	Type *string `type:"string" required:"true"`
+	Type *string `type:"string" enum:"ipsec.1" required:"true"`
 
 	metadataCreateVPNGatewayInput `json:"-", xml:"-"`
 }
@@ -7067,6 +7980,11 @@ type metadataCreateVPNGatewayInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateVPNGatewayInput.
+func (s CreateVPNGatewayInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateVPNGatewayOutput struct {
 	// Information about the virtual private gateway.
 	VPNGateway *VPNGateway `locationName:"vpnGateway" type:"structure"`
@@ -7078,6 +7996,11 @@ type metadataCreateVPNGatewayOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateVPNGatewayOutput.
+func (s CreateVPNGatewayOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateVolumeInput struct {
 	// The Availability Zone in which to create the volume. Use DescribeAvailabilityZones
 	// to list the Availability Zones that are currently available to you.
@@ -7124,7 +8047,7 @@ type CreateVolumeInput struct {
 	// Provisioned IOPS (SSD) volumes, or standard for Magnetic volumes.
 	//
 	// Default: standard
-	VolumeType *string `type:"string"`
+	VolumeType *string `type:"string" enum:"standard,io1,gp2"`
 
 	metadataCreateVolumeInput `json:"-", xml:"-"`
 }
@@ -7133,10 +8056,15 @@ type metadataCreateVolumeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateVolumeInput.
+func (s CreateVolumeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateVolumePermission struct {
 	// The specific group that is to be added or removed from a volume's list of
 	// create volume permissions.
-	Group *string `locationName:"group" type:"string"`
+	Group *string `locationName:"group" type:"string" enum:"all"`
 
 	// The specific AWS account ID that is to be added or removed from a volume's
 	// list of create volume permissions.
@@ -7149,6 +8077,11 @@ type metadataCreateVolumePermission struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateVolumePermission.
+func (s CreateVolumePermission) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateVolumePermissionModifications struct {
 	// Adds a specific AWS account ID or group to a volume's list of create volume
 	// permissions.
@@ -7165,6 +8098,43 @@ type metadataCreateVolumePermissionModifications struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateVolumePermissionModifications.
+func (s CreateVolumePermissionModifications) String() string {
+	return awsutil.StringValue(s)
+}
+
+// CurrencyCodeValues is an enum of the values of CurrencyCodeValues members.
+type CurrencyCodeValues string
+
+// The values of CurrencyCodeValues.
+const (
+	CurrencyCodeValuesUSD CurrencyCodeValues = "USD"
+)
+
+// CurrencyCodeValuesValues returns the values of CurrencyCodeValues known to this
+// version of the SDK. The service may accept values added since.
+func CurrencyCodeValuesValues() []CurrencyCodeValues {
+	return []CurrencyCodeValues{
+		CurrencyCodeValues("USD"),
+	}
+}
+
+// String returns the CurrencyCodeValues as the string of a member.
+func (v CurrencyCodeValues) String() string {
+	return string(v)
+}
+
+// ParseCurrencyCodeValues returns the CurrencyCodeValues of str, failing if it is not
+// one of the CurrencyCodeValuesValues.
+func ParseCurrencyCodeValues(str string) (CurrencyCodeValues, error) {
+	for _, v := range CurrencyCodeValuesValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid CurrencyCodeValues value %q", str)
+}
+
 // Describes a customer gateway.
 type CustomerGateway struct {
 	// The customer gateway's Border Gateway Protocol (BGP) Autonomous System Number
@@ -7194,6 +8164,11 @@ type metadataCustomerGateway struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CustomerGateway.
+func (s CustomerGateway) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a DHCP configuration option.
 type DHCPConfiguration struct {
 	// The name of a DHCP option.
@@ -7209,6 +8184,11 @@ type metadataDHCPConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DHCPConfiguration.
+func (s DHCPConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a set of DHCP options.
 type DHCPOptions struct {
 	// One or more DHCP options in the set.
@@ -7227,6 +8207,45 @@ type metadataDHCPOptions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DHCPOptions.
+func (s DHCPOptions) String() string {
+	return awsutil.StringValue(s)
+}
+
+// DatafeedSubscriptionState is an enum of the values of DatafeedSubscriptionState members.
+type DatafeedSubscriptionState string
+
+// The values of DatafeedSubscriptionState.
+const (
+	DatafeedSubscriptionStateActive   DatafeedSubscriptionState = "Active"
+	DatafeedSubscriptionStateInactive DatafeedSubscriptionState = "Inactive"
+)
+
+// DatafeedSubscriptionStateValues returns the values of DatafeedSubscriptionState known to this
+// version of the SDK. The service may accept values added since.
+func DatafeedSubscriptionStateValues() []DatafeedSubscriptionState {
+	return []DatafeedSubscriptionState{
+		DatafeedSubscriptionState("Active"),
+		DatafeedSubscriptionState("Inactive"),
+	}
+}
+
+// String returns the DatafeedSubscriptionState as the string of a member.
+func (v DatafeedSubscriptionState) String() string {
+	return string(v)
+}
+
+// ParseDatafeedSubscriptionState returns the DatafeedSubscriptionState of str, failing if it is not
+// one of the DatafeedSubscriptionStateValues.
+func ParseDatafeedSubscriptionState(str string) (DatafeedSubscriptionState, error) {
+	for _, v := range DatafeedSubscriptionStateValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DatafeedSubscriptionState value %q", str)
+}
+
 type DeleteCustomerGatewayInput struct {
 	// The ID of the customer gateway.
 	CustomerGatewayID *string `locationName:"CustomerGatewayId" type:"string" required:"true"`
@@ -7240,6 +8259,11 @@ type metadataDeleteCustomerGatewayInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteCustomerGatewayInput.
+func (s DeleteCustomerGatewayInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteCustomerGatewayOutput struct {
 	metadataDeleteCustomerGatewayOutput `json:"-", xml:"-"`
 }
@@ -7248,6 +8272,11 @@ type metadataDeleteCustomerGatewayOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteCustomerGatewayOutput.
+func (s DeleteCustomerGatewayOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteDHCPOptionsInput struct {
 	// The ID of the DHCP options set.
 	DHCPOptionsID *string `locationName:"DhcpOptionsId" type:"string" required:"true"`
@@ -7261,6 +8290,11 @@ type metadataDeleteDHCPOptionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteDHCPOptionsInput.
+func (s DeleteDHCPOptionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteDHCPOptionsOutput struct {
 	metadataDeleteDHCPOptionsOutput `json:"-", xml:"-"`
 }
@@ -7269,6 +8303,11 @@ type metadataDeleteDHCPOptionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteDHCPOptionsOutput.
+func (s DeleteDHCPOptionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteInternetGatewayInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -7282,6 +8321,11 @@ type metadataDeleteInternetGatewayInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteInternetGatewayInput.
+func (s DeleteInternetGatewayInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteInternetGatewayOutput struct {
 	metadataDeleteInternetGatewayOutput `json:"-", xml:"-"`
 }
@@ -7290,6 +8334,11 @@ type metadataDeleteInternetGatewayOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteInternetGatewayOutput.
+func (s DeleteInternetGatewayOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteKeyPairInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -7303,6 +8352,11 @@ type metadataDeleteKeyPairInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteKeyPairInput.
+func (s DeleteKeyPairInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteKeyPairOutput struct {
 	metadataDeleteKeyPairOutput `json:"-", xml:"-"`
 }
@@ -7311,6 +8365,11 @@ type metadataDeleteKeyPairOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteKeyPairOutput.
+func (s DeleteKeyPairOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteNetworkACLEntryInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -7330,6 +8389,11 @@ type metadataDeleteNetworkACLEntryInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteNetworkACLEntryInput.
+func (s DeleteNetworkACLEntryInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteNetworkACLEntryOutput struct {
 	metadataDeleteNetworkACLEntryOutput `json:"-", xml:"-"`
 }
@@ -7338,6 +8402,11 @@ type metadataDeleteNetworkACLEntryOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteNetworkACLEntryOutput.
+func (s DeleteNetworkACLEntryOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteNetworkACLInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -7351,6 +8420,11 @@ type metadataDeleteNetworkACLInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteNetworkACLInput.
+func (s DeleteNetworkACLInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteNetworkACLOutput struct {
 	metadataDeleteNetworkACLOutput `json:"-", xml:"-"`
 }
@@ -7359,6 +8433,11 @@ type metadataDeleteNetworkACLOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteNetworkACLOutput.
+func (s DeleteNetworkACLOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteNetworkInterfaceInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -7372,6 +8451,11 @@ type metadataDeleteNetworkInterfaceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteNetworkInterfaceInput.
+func (s DeleteNetworkInterfaceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteNetworkInterfaceOutput struct {
 	metadataDeleteNetworkInterfaceOutput `json:"-", xml:"-"`
 }
@@ -7380,6 +8464,11 @@ type metadataDeleteNetworkInterfaceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteNetworkInterfaceOutput.
+func (s DeleteNetworkInterfaceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeletePlacementGroupInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -7393,6 +8482,11 @@ type metadataDeletePlacementGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeletePlacementGroupInput.
+func (s DeletePlacementGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeletePlacementGroupOutput struct {
 	metadataDeletePlacementGroupOutput `json:"-", xml:"-"`
 }
@@ -7401,6 +8495,11 @@ type metadataDeletePlacementGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeletePlacementGroupOutput.
+func (s DeletePlacementGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteRouteInput struct {
 	// The CIDR range for the route. The value you specify must match the CIDR for
 	// the route exactly.
@@ -7418,6 +8517,11 @@ type metadataDeleteRouteInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteRouteInput.
+func (s DeleteRouteInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteRouteOutput struct {
 	metadataDeleteRouteOutput `json:"-", xml:"-"`
 }
@@ -7426,6 +8530,11 @@ type metadataDeleteRouteOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteRouteOutput.
+func (s DeleteRouteOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteRouteTableInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -7439,6 +8548,11 @@ type metadataDeleteRouteTableInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteRouteTableInput.
+func (s DeleteRouteTableInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteRouteTableOutput struct {
 	metadataDeleteRouteTableOutput `json:"-", xml:"-"`
 }
@@ -7447,6 +8561,11 @@ type metadataDeleteRouteTableOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteRouteTableOutput.
+func (s DeleteRouteTableOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteSecurityGroupInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -7464,6 +8583,11 @@ type metadataDeleteSecurityGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteSecurityGroupInput.
+func (s DeleteSecurityGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteSecurityGroupOutput struct {
 	metadataDeleteSecurityGroupOutput `json:"-", xml:"-"`
 }
@@ -7472,6 +8596,11 @@ type metadataDeleteSecurityGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteSecurityGroupOutput.
+func (s DeleteSecurityGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteSnapshotInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -7485,6 +8614,11 @@ type metadataDeleteSnapshotInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteSnapshotInput.
+func (s DeleteSnapshotInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteSnapshotOutput struct {
 	metadataDeleteSnapshotOutput `json:"-", xml:"-"`
 }
@@ -7493,6 +8627,11 @@ type metadataDeleteSnapshotOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteSnapshotOutput.
+func (s DeleteSnapshotOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteSpotDatafeedSubscriptionInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -7503,6 +8642,11 @@ type metadataDeleteSpotDatafeedSubscriptionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteSpotDatafeedSubscriptionInput.
+func (s DeleteSpotDatafeedSubscriptionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteSpotDatafeedSubscriptionOutput struct {
 	metadataDeleteSpotDatafeedSubscriptionOutput `json:"-", xml:"-"`
 }
@@ -7511,6 +8655,11 @@ type metadataDeleteSpotDatafeedSubscriptionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteSpotDatafeedSubscriptionOutput.
+func (s DeleteSpotDatafeedSubscriptionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteSubnetInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -7524,6 +8673,11 @@ type metadataDeleteSubnetInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteSubnetInput.
+func (s DeleteSubnetInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteSubnetOutput struct {
 	metadataDeleteSubnetOutput `json:"-", xml:"-"`
 }
@@ -7532,6 +8686,11 @@ type metadataDeleteSubnetOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteSubnetOutput.
+func (s DeleteSubnetOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteTagsInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -7551,6 +8710,11 @@ type metadataDeleteTagsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteTagsInput.
+func (s DeleteTagsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteTagsOutput struct {
 	metadataDeleteTagsOutput `json:"-", xml:"-"`
 }
@@ -7559,6 +8723,11 @@ type metadataDeleteTagsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteTagsOutput.
+func (s DeleteTagsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteVPCInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -7572,6 +8741,11 @@ type metadataDeleteVPCInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteVPCInput.
+func (s DeleteVPCInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteVPCOutput struct {
 	metadataDeleteVPCOutput `json:"-", xml:"-"`
 }
@@ -7580,6 +8754,11 @@ type metadataDeleteVPCOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteVPCOutput.
+func (s DeleteVPCOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteVPCPeeringConnectionInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -7593,6 +8772,11 @@ type metadataDeleteVPCPeeringConnectionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteVPCPeeringConnectionInput.
+func (s DeleteVPCPeeringConnectionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteVPCPeeringConnectionOutput struct {
 	// Returns true if the request succeeds; otherwise, it returns an error.
 	Return *bool `locationName:"return" type:"boolean"`
@@ -7604,6 +8788,11 @@ type metadataDeleteVPCPeeringConnectionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteVPCPeeringConnectionOutput.
+func (s DeleteVPCPeeringConnectionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteVPNConnectionInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -7617,6 +8806,11 @@ type metadataDeleteVPNConnectionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteVPNConnectionInput.
+func (s DeleteVPNConnectionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteVPNConnectionOutput struct {
 	metadataDeleteVPNConnectionOutput `json:"-", xml:"-"`
 }
@@ -7625,6 +8819,11 @@ type metadataDeleteVPNConnectionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteVPNConnectionOutput.
+func (s DeleteVPNConnectionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteVPNConnectionRouteInput struct {
 	// The CIDR block associated with the local subnet of the customer network.
 	DestinationCIDRBlock *string `locationName:"DestinationCidrBlock" type:"string" required:"true"`
@@ -7639,6 +8838,11 @@ type metadataDeleteVPNConnectionRouteInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteVPNConnectionRouteInput.
+func (s DeleteVPNConnectionRouteInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteVPNConnectionRouteOutput struct {
 	metadataDeleteVPNConnectionRouteOutput `json:"-", xml:"-"`
 }
@@ -7647,6 +8851,11 @@ type metadataDeleteVPNConnectionRouteOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteVPNConnectionRouteOutput.
+func (s DeleteVPNConnectionRouteOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteVPNGatewayInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -7660,6 +8869,11 @@ type metadataDeleteVPNGatewayInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteVPNGatewayInput.
+func (s DeleteVPNGatewayInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteVPNGatewayOutput struct {
 	metadataDeleteVPNGatewayOutput `json:"-", xml:"-"`
 }
@@ -7668,6 +8882,11 @@ type metadataDeleteVPNGatewayOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteVPNGatewayOutput.
+func (s DeleteVPNGatewayOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteVolumeInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -7681,6 +8900,11 @@ type metadataDeleteVolumeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteVolumeInput.
+func (s DeleteVolumeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteVolumeOutput struct {
 	metadataDeleteVolumeOutput `json:"-", xml:"-"`
 }
@@ -7689,6 +8913,11 @@ type metadataDeleteVolumeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteVolumeOutput.
+func (s DeleteVolumeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeregisterImageInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -7702,6 +8931,11 @@ type metadataDeregisterImageInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeregisterImageInput.
+func (s DeregisterImageInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeregisterImageOutput struct {
 	metadataDeregisterImageOutput `json:"-", xml:"-"`
 }
@@ -7710,6 +8944,11 @@ type metadataDeregisterImageOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeregisterImageOutput.
+func (s DeregisterImageOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeAccountAttributesInput struct {
 	// One or more account attribute names.
 	AttributeNames []*string `locationName:"attributeName" locationNameList:"attributeName" type:"list"`
@@ -7723,6 +8962,11 @@ type metadataDescribeAccountAttributesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeAccountAttributesInput.
+func (s DescribeAccountAttributesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeAccountAttributesOutput struct {
 	// Information about one or more account attributes.
 	AccountAttributes []*AccountAttribute `locationName:"accountAttributeSet" locationNameList:"item" type:"list"`
@@ -7734,6 +8978,11 @@ type metadataDescribeAccountAttributesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeAccountAttributesOutput.
+func (s DescribeAccountAttributesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeAddressesInput struct {
 	// [EC2-VPC] One or more allocation IDs.
 	//
@@ -7777,6 +9026,11 @@ type metadataDescribeAddressesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeAddressesInput.
+func (s DescribeAddressesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeAddressesOutput struct {
 	// Information about one or more Elastic IP addresses.
 	Addresses []*Address `locationName:"addressesSet" locationNameList:"item" type:"list"`
@@ -7788,6 +9042,11 @@ type metadataDescribeAddressesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeAddressesOutput.
+func (s DescribeAddressesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeAvailabilityZonesInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -7813,6 +9072,11 @@ type metadataDescribeAvailabilityZonesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeAvailabilityZonesInput.
+func (s DescribeAvailabilityZonesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeAvailabilityZonesOutput struct {
 	// Information about one or more Availability Zones.
 	AvailabilityZones []*AvailabilityZone `locationName:"availabilityZoneInfo" locationNameList:"item" type:"list"`
@@ -7824,6 +9088,11 @@ type metadataDescribeAvailabilityZonesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeAvailabilityZonesOutput.
+func (s DescribeAvailabilityZonesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeBundleTasksInput struct {
 	// One or more bundle task IDs.
 	//
@@ -7864,6 +9133,11 @@ type metadataDescribeBundleTasksInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeBundleTasksInput.
+func (s DescribeBundleTasksInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeBundleTasksOutput struct {
 	// Information about one or more bundle tasks.
 	BundleTasks []*BundleTask `locationName:"bundleInstanceTasksSet" locationNameList:"item" type:"list"`
@@ -7875,6 +9149,11 @@ type metadataDescribeBundleTasksOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeBundleTasksOutput.
+func (s DescribeBundleTasksOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeClassicLinkInstancesInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -7921,6 +9200,11 @@ type metadataDescribeClassicLinkInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeClassicLinkInstancesInput.
+func (s DescribeClassicLinkInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeClassicLinkInstancesOutput struct {
 	// Information about one or more linked EC2-Classic instances.
 	Instances []*ClassicLinkInstance `locationName:"instancesSet" locationNameList:"item" type:"list"`
@@ -7936,6 +9220,11 @@ type metadataDescribeClassicLinkInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeClassicLinkInstancesOutput.
+func (s DescribeClassicLinkInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeConversionTasksInput struct {
 	// One or more conversion task IDs.
 	ConversionTaskIDs []*string `locationName:"conversionTaskId" locationNameList:"item" type:"list"`
@@ -7951,6 +9240,11 @@ type metadataDescribeConversionTasksInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeConversionTasksInput.
+func (s DescribeConversionTasksInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeConversionTasksOutput struct {
 	ConversionTasks []*ConversionTask `locationName:"conversionTasks" locationNameList:"item" type:"list"`
 
@@ -7961,6 +9255,11 @@ type metadataDescribeConversionTasksOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeConversionTasksOutput.
+func (s DescribeConversionTasksOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeCustomerGatewaysInput struct {
 	// One or more customer gateway IDs.
 	//
@@ -8005,6 +9304,11 @@ type metadataDescribeCustomerGatewaysInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeCustomerGatewaysInput.
+func (s DescribeCustomerGatewaysInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeCustomerGatewaysOutput struct {
 	// Information about one or more customer gateways.
 	CustomerGateways []*CustomerGateway `locationName:"customerGatewaySet" locationNameList:"item" type:"list"`
@@ -8016,6 +9320,11 @@ type metadataDescribeCustomerGatewaysOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeCustomerGatewaysOutput.
+func (s DescribeCustomerGatewaysOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeDHCPOptionsInput struct {
 	// The IDs of one or more DHCP options sets.
 	//
@@ -8052,6 +9361,11 @@ type metadataDescribeDHCPOptionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeDHCPOptionsInput.
+func (s DescribeDHCPOptionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeDHCPOptionsOutput struct {
 	// Information about one or more DHCP options sets.
 	DHCPOptions []*DHCPOptions `locationName:"dhcpOptionsSet" locationNameList:"item" type:"list"`
@@ -8063,6 +9377,11 @@ type metadataDescribeDHCPOptionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeDHCPOptionsOutput.
+func (s DescribeDHCPOptionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeExportTasksInput struct {
 	// One or more export task IDs.
 	ExportTaskIDs []*string `locationName:"exportTaskId" locationNameList:"ExportTaskId" type:"list"`
@@ -8074,6 +9393,11 @@ type metadataDescribeExportTasksInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeExportTasksInput.
+func (s DescribeExportTasksInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeExportTasksOutput struct {
 	ExportTasks []*ExportTask `locationName:"exportTaskSet" locationNameList:"item" type:"list"`
 
@@ -8084,13 +9408,18 @@ type metadataDescribeExportTasksOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeExportTasksOutput.
+func (s DescribeExportTasksOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeImageAttributeInput struct {
 	// The AMI attribute.
 	//
 	// Note: Depending on your account privileges, the blockDeviceMapping attribute
 	// may return a Client.AuthFailure error. If this happens, use DescribeImages
 	// to get information about the block device mapping for the AMI.
-	Attribute *string `type:"string" required:"true"`
+	Attribute *string `type:"string" enum:"description,kernel,ramdisk,launchPermission,productCodes,blockDeviceMapping" required:"true"`
 
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -8104,6 +9433,11 @@ type metadataDescribeImageAttributeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeImageAttributeInput.
+func (s DescribeImageAttributeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an image attribute.
 type DescribeImageAttributeOutput struct {
 	// One or more block device mapping entries.
@@ -8137,6 +9471,11 @@ type metadataDescribeImageAttributeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeImageAttributeOutput.
+func (s DescribeImageAttributeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeImagesInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -8234,6 +9573,11 @@ type metadataDescribeImagesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeImagesInput.
+func (s DescribeImagesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeImagesOutput struct {
 	// Information about one or more images.
 	Images []*Image `locationName:"imagesSet" locationNameList:"item" type:"list"`
@@ -8245,9 +9589,14 @@ type metadataDescribeImagesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeImagesOutput.
+func (s DescribeImagesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeInstanceAttributeInput struct {
 	// The instance attribute.
-	Attribute *string `locationName:"attribute" type:"string" required:"true"`
+	Attribute *string `locationName:"attribute" type:"string" enum:"instanceType,kernel,ramdisk,userData,disableApiTermination,instanceInitiatedShutdownBehavior,rootDeviceName,blockDeviceMapping,productCodes,sourceDestCheck,groupSet,ebsOptimized,sriovNetSupport" required:"true"`
 
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -8261,6 +9610,11 @@ type metadataDescribeInstanceAttributeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeInstanceAttributeInput.
+func (s DescribeInstanceAttributeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an instance attribute.
 type DescribeInstanceAttributeOutput struct {
 	// The block device mapping of the instance.
@@ -8316,6 +9670,11 @@ type metadataDescribeInstanceAttributeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeInstanceAttributeOutput.
+func (s DescribeInstanceAttributeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeInstanceStatusInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -8389,6 +9748,11 @@ type metadataDescribeInstanceStatusInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeInstanceStatusInput.
+func (s DescribeInstanceStatusInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeInstanceStatusOutput struct {
 	// One or more instance status descriptions.
 	InstanceStatuses []*InstanceStatus `locationName:"instanceStatusSet" locationNameList:"item" type:"list"`
@@ -8403,6 +9767,11 @@ type metadataDescribeInstanceStatusOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeInstanceStatusOutput.
+func (s DescribeInstanceStatusOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeInstancesInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -8654,6 +10023,11 @@ type metadataDescribeInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeInstancesInput.
+func (s DescribeInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeInstancesOutput struct {
 	// The token to use when requesting the next set of items. If there are no additional
 	// items to return, the string is empty.
@@ -8669,6 +10043,11 @@ type metadataDescribeInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeInstancesOutput.
+func (s DescribeInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeInternetGatewaysInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -8706,6 +10085,11 @@ type metadataDescribeInternetGatewaysInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeInternetGatewaysInput.
+func (s DescribeInternetGatewaysInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeInternetGatewaysOutput struct {
 	// Information about one or more Internet gateways.
 	InternetGateways []*InternetGateway `locationName:"internetGatewaySet" locationNameList:"item" type:"list"`
@@ -8717,6 +10101,11 @@ type metadataDescribeInternetGatewaysOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeInternetGatewaysOutput.
+func (s DescribeInternetGatewaysOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeKeyPairsInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -8739,6 +10128,11 @@ type metadataDescribeKeyPairsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeKeyPairsInput.
+func (s DescribeKeyPairsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeKeyPairsOutput struct {
 	// Information about one or more key pairs.
 	KeyPairs []*KeyPairInfo `locationName:"keySet" locationNameList:"item" type:"list"`
@@ -8750,6 +10144,11 @@ type metadataDescribeKeyPairsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeKeyPairsOutput.
+func (s DescribeKeyPairsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeNetworkACLsInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -8814,6 +10213,11 @@ type metadataDescribeNetworkACLsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeNetworkACLsInput.
+func (s DescribeNetworkACLsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeNetworkACLsOutput struct {
 	// Information about one or more network ACLs.
 	NetworkACLs []*NetworkACL `locationName:"networkAclSet" locationNameList:"item" type:"list"`
@@ -8825,9 +10229,14 @@ type metadataDescribeNetworkACLsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeNetworkACLsOutput.
+func (s DescribeNetworkACLsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeNetworkInterfaceAttributeInput struct {
 	// The attribute of the network interface.
-	Attribute *string `locationName:"attribute" type:"string"`
+	Attribute *string `locationName:"attribute" type:"string" enum:"description,groupSet,sourceDestCheck,attachment"`
 
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -8841,6 +10250,11 @@ type metadataDescribeNetworkInterfaceAttributeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeNetworkInterfaceAttributeInput.
+func (s DescribeNetworkInterfaceAttributeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeNetworkInterfaceAttributeOutput struct {
 	// The attachment (if any) of the network interface.
 	Attachment *NetworkInterfaceAttachment `locationName:"attachment" type:"structure"`
@@ -8864,6 +10278,11 @@ type metadataDescribeNetworkInterfaceAttributeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeNetworkInterfaceAttributeOutput.
+func (s DescribeNetworkInterfaceAttributeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeNetworkInterfacesInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -8980,6 +10399,11 @@ type metadataDescribeNetworkInterfacesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeNetworkInterfacesInput.
+func (s DescribeNetworkInterfacesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeNetworkInterfacesOutput struct {
 	// Information about one or more network interfaces.
 	NetworkInterfaces []*NetworkInterface `locationName:"networkInterfaceSet" locationNameList:"item" type:"list"`
@@ -8991,6 +10415,11 @@ type metadataDescribeNetworkInterfacesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeNetworkInterfacesOutput.
+func (s DescribeNetworkInterfacesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribePlacementGroupsInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -9016,6 +10445,11 @@ type metadataDescribePlacementGroupsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribePlacementGroupsInput.
+func (s DescribePlacementGroupsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribePlacementGroupsOutput struct {
 	// One or more placement groups.
 	PlacementGroups []*PlacementGroup `locationName:"placementGroupSet" locationNameList:"item" type:"list"`
@@ -9027,6 +10461,11 @@ type metadataDescribePlacementGroupsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribePlacementGroupsOutput.
+func (s DescribePlacementGroupsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeRegionsInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -9047,6 +10486,11 @@ type metadataDescribeRegionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeRegionsInput.
+func (s DescribeRegionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeRegionsOutput struct {
 	// Information about one or more regions.
 	Regions []*Region `locationName:"regionInfo" locationNameList:"item" type:"list"`
@@ -9058,6 +10502,11 @@ type metadataDescribeRegionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeRegionsOutput.
+func (s DescribeRegionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeReservedInstancesInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -9107,7 +10556,7 @@ type DescribeReservedInstancesInput struct {
 	// The Reserved Instance offering type. If you are using tools that predate
 	// the 2011-11-01 API version, you only have access to the Medium Utilization
 	// Reserved Instance offering type.
-	OfferingType *string `locationName:"offeringType" type:"string"`
+	OfferingType *string `locationName:"offeringType" type:"string" enum:"Heavy Utilization,Medium Utilization,Light Utilization,No Upfront,Partial Upfront,All Upfront"`
 
 	// One or more Reserved Instance IDs.
 	//
@@ -9122,6 +10571,11 @@ type metadataDescribeReservedInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeReservedInstancesInput.
+func (s DescribeReservedInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeReservedInstancesListingsInput struct {
 	// One or more filters.
 	//
@@ -9148,6 +10602,11 @@ type metadataDescribeReservedInstancesListingsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeReservedInstancesListingsInput.
+func (s DescribeReservedInstancesListingsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeReservedInstancesListingsOutput struct {
 	// Information about the Reserved Instance listing.
 	ReservedInstancesListings []*ReservedInstancesListing `locationName:"reservedInstancesListingsSet" locationNameList:"item" type:"list"`
@@ -9159,6 +10618,11 @@ type metadataDescribeReservedInstancesListingsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeReservedInstancesListingsOutput.
+func (s DescribeReservedInstancesListingsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeReservedInstancesModificationsInput struct {
 	// One or more filters.
 	//
@@ -9209,6 +10673,11 @@ type metadataDescribeReservedInstancesModificationsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeReservedInstancesModificationsInput.
+func (s DescribeReservedInstancesModificationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeReservedInstancesModificationsOutput struct {
 	// The token for the next page of data.
 	NextToken *string `locationName:"nextToken" type:"string"`
@@ -9223,6 +10692,11 @@ type metadataDescribeReservedInstancesModificationsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeReservedInstancesModificationsOutput.
+func (s DescribeReservedInstancesModificationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeReservedInstancesOfferingsInput struct {
 	// The Availability Zone in which the Reserved Instance can be used.
 	AvailabilityZone *string `type:"string"`
@@ -9264,12 +10738,12 @@ type DescribeReservedInstancesOfferingsInput struct {
 	// VPC.
 	//
 	// Default: default
-	InstanceTenancy *string `locationName:"instanceTenancy" type:"string"`
+	InstanceTenancy *string `locationName:"instanceTenancy" type:"string" enum:"default,dedicated"`
 
 	// The instance type on which the Reserved Instance can be used. For more information,
 	// see Instance Types (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/instance-types.html)
 	// in the Amazon Elastic Compute Cloud User Guide.
-	InstanceType *string `type:"string"`
+	InstanceType *string `type:"string" enum:"t1.micro,m1.small,m1.medium,m1.large,m1.xlarge,m3.medium,m3.large,m3.xlarge,m3.2xlarge,t2.micro,t2.small,t2.medium,m2.xlarge,m2.2xlarge,m2.4xlarge,cr1.8xlarge,i2.xlarge,i2.2xlarge,i2.4xlarge,i2.8xlarge,hi1.4xlarge,hs1.8xlarge,c1.medium,c1.xlarge,c3.large,c3.xlarge,c3.2xlarge,c3.4xlarge,c3.8xlarge,c4.large,c4.xlarge,c4.2xlarge,c4.4xlarge,c4.8xlarge,cc1.4xlarge,cc2.8xlarge,g2.2xlarge,cg1.4xlarge,r3.large,r3.xlarge,r3.2xlarge,r3.4xlarge,r3.8xlarge"`
 
 	// The maximum duration (in seconds) to filter when searching for offerings.
 	//
@@ -9297,11 +10771,11 @@ type DescribeReservedInstancesOfferingsInput struct {
 	// The Reserved Instance offering type. If you are using tools that predate
 	// the 2011-11-01 API version, you only have access to the Medium Utilization
 	// Reserved Instance offering type.
-	OfferingType *string `locationName:"offeringType" type:"string"`
+	OfferingType *string `locationName:"offeringType" type:"string" enum:"Heavy Utilization,Medium Utilization,Light Utilization,No Upfront,Partial Upfront,All Upfront"`
 
 	// The Reserved Instance description. Instances that include (Amazon VPC) in
 	// the description are for use with Amazon VPC.
-	ProductDescription *string `type:"string"`
+	ProductDescription *string `type:"string" enum:"Linux/UNIX,Linux/UNIX (Amazon VPC),Windows,Windows (Amazon VPC)"`
 
 	// One or more Reserved Instances offering IDs.
 	ReservedInstancesOfferingIDs []*string `locationName:"ReservedInstancesOfferingId" type:"list"`
@@ -9313,6 +10787,11 @@ type metadataDescribeReservedInstancesOfferingsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeReservedInstancesOfferingsInput.
+func (s DescribeReservedInstancesOfferingsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeReservedInstancesOfferingsOutput struct {
 	// The next paginated set of results to return.
 	NextToken *string `locationName:"nextToken" type:"string"`
@@ -9327,6 +10806,11 @@ type metadataDescribeReservedInstancesOfferingsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeReservedInstancesOfferingsOutput.
+func (s DescribeReservedInstancesOfferingsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeReservedInstancesOutput struct {
 	// A list of Reserved Instances.
 	ReservedInstances []*ReservedInstances `locationName:"reservedInstancesSet" locationNameList:"item" type:"list"`
@@ -9338,6 +10822,11 @@ type metadataDescribeReservedInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeReservedInstancesOutput.
+func (s DescribeReservedInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeRouteTablesInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -9405,6 +10894,11 @@ type metadataDescribeRouteTablesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeRouteTablesInput.
+func (s DescribeRouteTablesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeRouteTablesOutput struct {
 	// Information about one or more route tables.
 	RouteTables []*RouteTable `locationName:"routeTableSet" locationNameList:"item" type:"list"`
@@ -9416,6 +10910,11 @@ type metadataDescribeRouteTablesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeRouteTablesOutput.
+func (s DescribeRouteTablesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeSecurityGroupsInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -9477,6 +10976,11 @@ type metadataDescribeSecurityGroupsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeSecurityGroupsInput.
+func (s DescribeSecurityGroupsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeSecurityGroupsOutput struct {
 	// Information about one or more security groups.
 	SecurityGroups []*SecurityGroup `locationName:"securityGroupInfo" locationNameList:"item" type:"list"`
@@ -9488,9 +10992,14 @@ type metadataDescribeSecurityGroupsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeSecurityGroupsOutput.
+func (s DescribeSecurityGroupsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeSnapshotAttributeInput struct {
 	// The snapshot attribute you would like to view.
-	Attribute *string `type:"string" required:"true"`
+	Attribute *string `type:"string" enum:"productCodes,createVolumePermission" required:"true"`
 
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -9504,6 +11013,11 @@ type metadataDescribeSnapshotAttributeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeSnapshotAttributeInput.
+func (s DescribeSnapshotAttributeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeSnapshotAttributeOutput struct {
 	// A list of permissions for creating volumes from the snapshot.
 	CreateVolumePermissions []*CreateVolumePermission `locationName:"createVolumePermission" locationNameList:"item" type:"list"`
@@ -9521,6 +11035,11 @@ type metadataDescribeSnapshotAttributeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeSnapshotAttributeOutput.
+func (s DescribeSnapshotAttributeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeSnapshotsInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -9596,6 +11115,11 @@ type metadataDescribeSnapshotsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeSnapshotsInput.
+func (s DescribeSnapshotsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeSnapshotsOutput struct {
 	// The NextToken value to include in a future DescribeSnapshots request. When
 	// the results of a DescribeSnapshots request exceed MaxResults, this value
@@ -9612,6 +11136,11 @@ type metadataDescribeSnapshotsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeSnapshotsOutput.
+func (s DescribeSnapshotsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeSpotDatafeedSubscriptionInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -9622,6 +11151,11 @@ type metadataDescribeSpotDatafeedSubscriptionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeSpotDatafeedSubscriptionInput.
+func (s DescribeSpotDatafeedSubscriptionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeSpotDatafeedSubscriptionOutput struct {
 	// The Spot Instance data feed subscription.
 	SpotDatafeedSubscription *SpotDatafeedSubscription `locationName:"spotDatafeedSubscription" type:"structure"`
@@ -9633,6 +11167,11 @@ type metadataDescribeSpotDatafeedSubscriptionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeSpotDatafeedSubscriptionOutput.
+func (s DescribeSpotDatafeedSubscriptionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeSpotInstanceRequestsInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -9756,6 +11295,11 @@ type metadataDescribeSpotInstanceRequestsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeSpotInstanceRequestsInput.
+func (s DescribeSpotInstanceRequestsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeSpotInstanceRequestsOutput struct {
 	// One or more Spot Instance requests.
 	SpotInstanceRequests []*SpotInstanceRequest `locationName:"spotInstanceRequestSet" locationNameList:"item" type:"list"`
@@ -9767,6 +11311,11 @@ type metadataDescribeSpotInstanceRequestsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeSpotInstanceRequestsOutput.
+func (s DescribeSpotInstanceRequestsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeSpotPriceHistoryInput struct {
 	// Filters the results by the specified Availability Zone.
 	AvailabilityZone *string `locationName:"availabilityZone" type:"string"`
@@ -9821,6 +11370,11 @@ type metadataDescribeSpotPriceHistoryInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeSpotPriceHistoryInput.
+func (s DescribeSpotPriceHistoryInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeSpotPriceHistoryOutput struct {
 	// The token to use when requesting the next set of items. If there are no additional
 	// items to return, the string is empty.
@@ -9836,6 +11390,11 @@ type metadataDescribeSpotPriceHistoryOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeSpotPriceHistoryOutput.
+func (s DescribeSpotPriceHistoryOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeSubnetsInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -9885,6 +11444,11 @@ type metadataDescribeSubnetsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeSubnetsInput.
+func (s DescribeSubnetsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeSubnetsOutput struct {
 	// Information about one or more subnets.
 	Subnets []*Subnet `locationName:"subnetSet" locationNameList:"item" type:"list"`
@@ -9896,6 +11460,11 @@ type metadataDescribeSubnetsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeSubnetsOutput.
+func (s DescribeSubnetsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeTagsInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -9929,6 +11498,11 @@ type metadataDescribeTagsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeTagsInput.
+func (s DescribeTagsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeTagsOutput struct {
 	// The token to use when requesting the next set of items. If there are no additional
 	// items to return, the string is empty.
@@ -9944,9 +11518,14 @@ type metadataDescribeTagsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeTagsOutput.
+func (s DescribeTagsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeVPCAttributeInput struct {
 	// The VPC attribute.
-	Attribute *string `type:"string"`
+	Attribute *string `type:"string" enum:"enableDnsSupport,enableDnsHostnames"`
 
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -9960,6 +11539,11 @@ type metadataDescribeVPCAttributeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeVPCAttributeInput.
+func (s DescribeVPCAttributeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeVPCAttributeOutput struct {
 	// Indicates whether the instances launched in the VPC get DNS hostnames. If
 	// this attribute is true, instances in the VPC get DNS hostnames; otherwise,
@@ -9981,6 +11565,11 @@ type metadataDescribeVPCAttributeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeVPCAttributeOutput.
+func (s DescribeVPCAttributeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeVPCClassicLinkInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -10012,6 +11601,11 @@ type metadataDescribeVPCClassicLinkInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeVPCClassicLinkInput.
+func (s DescribeVPCClassicLinkInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeVPCClassicLinkOutput struct {
 	// The ClassicLink status of one or more VPCs.
 	VPCs []*VPCClassicLink `locationName:"vpcSet" locationNameList:"item" type:"list"`
@@ -10023,6 +11617,11 @@ type metadataDescribeVPCClassicLinkOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeVPCClassicLinkOutput.
+func (s DescribeVPCClassicLinkOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeVPCPeeringConnectionsInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -10077,6 +11676,11 @@ type metadataDescribeVPCPeeringConnectionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeVPCPeeringConnectionsInput.
+func (s DescribeVPCPeeringConnectionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeVPCPeeringConnectionsOutput struct {
 	// Information about the VPC peering connections.
 	VPCPeeringConnections []*VPCPeeringConnection `locationName:"vpcPeeringConnectionSet" locationNameList:"item" type:"list"`
@@ -10088,6 +11692,11 @@ type metadataDescribeVPCPeeringConnectionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeVPCPeeringConnectionsOutput.
+func (s DescribeVPCPeeringConnectionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeVPCsInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -10130,6 +11739,11 @@ type metadataDescribeVPCsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeVPCsInput.
+func (s DescribeVPCsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeVPCsOutput struct {
 	// Information about one or more VPCs.
 	VPCs []*VPC `locationName:"vpcSet" locationNameList:"item" type:"list"`
@@ -10141,6 +11755,11 @@ type metadataDescribeVPCsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeVPCsOutput.
+func (s DescribeVPCsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeVPNConnectionsInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -10198,6 +11817,11 @@ type metadataDescribeVPNConnectionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeVPNConnectionsInput.
+func (s DescribeVPNConnectionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeVPNConnectionsOutput struct {
 	// Information about one or more VPN connections.
 	VPNConnections []*VPNConnection `locationName:"vpnConnectionSet" locationNameList:"item" type:"list"`
@@ -10209,6 +11833,11 @@ type metadataDescribeVPNConnectionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeVPNConnectionsOutput.
+func (s DescribeVPNConnectionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeVPNGatewaysInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -10254,6 +11883,11 @@ type metadataDescribeVPNGatewaysInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeVPNGatewaysInput.
+func (s DescribeVPNGatewaysInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeVPNGatewaysOutput struct {
 	// Information about one or more virtual private gateways.
 	VPNGateways []*VPNGateway `locationName:"vpnGatewaySet" locationNameList:"item" type:"list"`
@@ -10265,9 +11899,14 @@ type metadataDescribeVPNGatewaysOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeVPNGatewaysOutput.
+func (s DescribeVPNGatewaysOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeVolumeAttributeInput struct {
 	// The instance attribute.
-	Attribute *string `type:"string"`
+	Attribute *string `type:"string" enum:"autoEnableIO,productCodes"`
 
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -10281,6 +11920,11 @@ type metadataDescribeVolumeAttributeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeVolumeAttributeInput.
+func (s DescribeVolumeAttributeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeVolumeAttributeOutput struct {
 	// The state of autoEnableIO attribute.
 	AutoEnableIO *AttributeBooleanValue `locationName:"autoEnableIO" type:"structure"`
@@ -10298,6 +11942,11 @@ type metadataDescribeVolumeAttributeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeVolumeAttributeOutput.
+func (s DescribeVolumeAttributeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeVolumeStatusInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -10354,6 +12003,11 @@ type metadataDescribeVolumeStatusInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeVolumeStatusInput.
+func (s DescribeVolumeStatusInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeVolumeStatusOutput struct {
 	// The next paginated set of results to return.
 	NextToken *string `locationName:"nextToken" type:"string"`
@@ -10368,6 +12022,11 @@ type metadataDescribeVolumeStatusOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeVolumeStatusOutput.
+func (s DescribeVolumeStatusOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeVolumesInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -10446,6 +12105,11 @@ type metadataDescribeVolumesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeVolumesInput.
+func (s DescribeVolumesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeVolumesOutput struct {
 	// The NextToken value to include in a future DescribeVolumes request. When
 	// the results of a DescribeVolumes request exceed MaxResults, this value can
@@ -10462,6 +12126,11 @@ type metadataDescribeVolumesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeVolumesOutput.
+func (s DescribeVolumesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DetachClassicLinkVPCInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -10478,6 +12147,11 @@ type metadataDetachClassicLinkVPCInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DetachClassicLinkVPCInput.
+func (s DetachClassicLinkVPCInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DetachClassicLinkVPCOutput struct {
 	// Returns true if the request succeeds; otherwise, it returns an error.
 	Return *bool `locationName:"return" type:"boolean"`
@@ -10489,6 +12163,11 @@ type metadataDetachClassicLinkVPCOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DetachClassicLinkVPCOutput.
+func (s DetachClassicLinkVPCOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DetachInternetGatewayInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -10505,6 +12184,11 @@ type metadataDetachInternetGatewayInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DetachInternetGatewayInput.
+func (s DetachInternetGatewayInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DetachInternetGatewayOutput struct {
 	metadataDetachInternetGatewayOutput `json:"-", xml:"-"`
 }
@@ -10513,6 +12197,11 @@ type metadataDetachInternetGatewayOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DetachInternetGatewayOutput.
+func (s DetachInternetGatewayOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DetachNetworkInterfaceInput struct {
 	// The ID of the attachment.
 	AttachmentID *string `locationName:"attachmentId" type:"string" required:"true"`
@@ -10529,6 +12218,11 @@ type metadataDetachNetworkInterfaceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DetachNetworkInterfaceInput.
+func (s DetachNetworkInterfaceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DetachNetworkInterfaceOutput struct {
 	metadataDetachNetworkInterfaceOutput `json:"-", xml:"-"`
 }
@@ -10537,6 +12231,11 @@ type metadataDetachNetworkInterfaceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DetachNetworkInterfaceOutput.
+func (s DetachNetworkInterfaceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DetachVPNGatewayInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -10553,6 +12252,11 @@ type metadataDetachVPNGatewayInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DetachVPNGatewayInput.
+func (s DetachVPNGatewayInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DetachVPNGatewayOutput struct {
 	metadataDetachVPNGatewayOutput `json:"-", xml:"-"`
 }
@@ -10561,6 +12265,11 @@ type metadataDetachVPNGatewayOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DetachVPNGatewayOutput.
+func (s DetachVPNGatewayOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DetachVolumeInput struct {
 	// The device name.
 	Device *string `type:"string"`
@@ -10589,6 +12298,45 @@ type metadataDetachVolumeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DetachVolumeInput.
+func (s DetachVolumeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// DeviceType is an enum of the values of DeviceType members.
+type DeviceType string
+
+// The values of DeviceType.
+const (
+	DeviceTypeEbs           DeviceType = "ebs"
+	DeviceTypeInstanceStore DeviceType = "instance-store"
+)
+
+// DeviceTypeValues returns the values of DeviceType known to this
+// version of the SDK. The service may accept values added since.
+func DeviceTypeValues() []DeviceType {
+	return []DeviceType{
+		DeviceType("ebs"),
+		DeviceType("instance-store"),
+	}
+}
+
+// String returns the DeviceType as the string of a member.
+func (v DeviceType) String() string {
+	return string(v)
+}
+
+// ParseDeviceType returns the DeviceType of str, failing if it is not
+// one of the DeviceTypeValues.
+func ParseDeviceType(str string) (DeviceType, error) {
+	for _, v := range DeviceTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DeviceType value %q", str)
+}
+
 type DisableVGWRoutePropagationInput struct {
 	// The ID of the virtual private gateway.
 	GatewayID *string `locationName:"GatewayId" type:"string" required:"true"`
@@ -10603,6 +12351,11 @@ type metadataDisableVGWRoutePropagationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DisableVGWRoutePropagationInput.
+func (s DisableVGWRoutePropagationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DisableVGWRoutePropagationOutput struct {
 	metadataDisableVGWRoutePropagationOutput `json:"-", xml:"-"`
 }
@@ -10611,6 +12364,11 @@ type metadataDisableVGWRoutePropagationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DisableVGWRoutePropagationOutput.
+func (s DisableVGWRoutePropagationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DisableVPCClassicLinkInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -10624,6 +12382,11 @@ type metadataDisableVPCClassicLinkInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DisableVPCClassicLinkInput.
+func (s DisableVPCClassicLinkInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DisableVPCClassicLinkOutput struct {
 	// Returns true if the request succeeds; otherwise, it returns an error.
 	Return *bool `locationName:"return" type:"boolean"`
@@ -10635,6 +12398,11 @@ type metadataDisableVPCClassicLinkOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DisableVPCClassicLinkOutput.
+func (s DisableVPCClassicLinkOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DisassociateAddressInput struct {
 	// [EC2-VPC] The association ID. Required for EC2-VPC.
 	AssociationID *string `locationName:"AssociationId" type:"string"`
@@ -10651,6 +12419,11 @@ type metadataDisassociateAddressInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DisassociateAddressInput.
+func (s DisassociateAddressInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DisassociateAddressOutput struct {
 	metadataDisassociateAddressOutput `json:"-", xml:"-"`
 }
@@ -10659,6 +12432,11 @@ type metadataDisassociateAddressOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DisassociateAddressOutput.
+func (s DisassociateAddressOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DisassociateRouteTableInput struct {
 	// The association ID representing the current association between the route
 	// table and subnet.
@@ -10673,6 +12451,11 @@ type metadataDisassociateRouteTableInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DisassociateRouteTableInput.
+func (s DisassociateRouteTableInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DisassociateRouteTableOutput struct {
 	metadataDisassociateRouteTableOutput `json:"-", xml:"-"`
 }
@@ -10681,6 +12464,11 @@ type metadataDisassociateRouteTableOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DisassociateRouteTableOutput.
+func (s DisassociateRouteTableOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a disk image.
 type DiskImage struct {
 	Description *string `type:"string"`
@@ -10697,12 +12485,17 @@ type metadataDiskImage struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DiskImage.
+func (s DiskImage) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DiskImageDescription struct {
 	// The checksum computed for the disk image.
 	Checksum *string `locationName:"checksum" type:"string"`
 
 	// The disk image format.
-	Format *string `locationName:"format" type:"string" required:"true"`
+	Format *string `locationName:"format" type:"string" enum:"VMDK,RAW,VHD" required:"true"`
 
 	// A presigned URL for the import manifest stored in Amazon S3. For information
 	// about creating a presigned URL for an Amazon S3 object, read the "Query String
@@ -10721,11 +12514,16 @@ type metadataDiskImageDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DiskImageDescription.
+func (s DiskImageDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DiskImageDetail struct {
 	Bytes *int64 `locationName:"bytes" type:"long" required:"true"`
 
 	// The disk image format.
-	Format *string `locationName:"format" type:"string" required:"true"`
+	Format *string `locationName:"format" type:"string" enum:"VMDK,RAW,VHD" required:"true"`
 
 	// A presigned URL for the import manifest stored in Amazon S3 and presented
 	// here as an Amazon S3 presigned URL. For information about creating a presigned
@@ -10741,6 +12539,47 @@ type metadataDiskImageDetail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DiskImageDetail.
+func (s DiskImageDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
+// DiskImageFormat is an enum of the values of DiskImageFormat members.
+type DiskImageFormat string
+
+// The values of DiskImageFormat.
+const (
+	DiskImageFormatVMDK DiskImageFormat = "VMDK"
+	DiskImageFormatRAW  DiskImageFormat = "RAW"
+	DiskImageFormatVHD  DiskImageFormat = "VHD"
+)
+
+// DiskImageFormatValues returns the values of DiskImageFormat known to this
+// version of the SDK. The service may accept values added since.
+func DiskImageFormatValues() []DiskImageFormat {
+	return []DiskImageFormat{
+		DiskImageFormat("VMDK"),
+		DiskImageFormat("RAW"),
+		DiskImageFormat("VHD"),
+	}
+}
+
+// String returns the DiskImageFormat as the string of a member.
+func (v DiskImageFormat) String() string {
+	return string(v)
+}
+
+// ParseDiskImageFormat returns the DiskImageFormat of str, failing if it is not
+// one of the DiskImageFormatValues.
+func ParseDiskImageFormat(str string) (DiskImageFormat, error) {
+	for _, v := range DiskImageFormatValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DiskImageFormat value %q", str)
+}
+
 type DiskImageVolumeDescription struct {
 	// The volume identifier.
 	ID *string `locationName:"id" type:"string" required:"true"`
@@ -10755,6 +12594,45 @@ type metadataDiskImageVolumeDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DiskImageVolumeDescription.
+func (s DiskImageVolumeDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// DomainType is an enum of the values of DomainType members.
+type DomainType string
+
+// The values of DomainType.
+const (
+	DomainTypeVpc      DomainType = "vpc"
+	DomainTypeStandard DomainType = "standard"
+)
+
+// DomainTypeValues returns the values of DomainType known to this
+// version of the SDK. The service may accept values added since.
+func DomainTypeValues() []DomainType {
+	return []DomainType{
+		DomainType("vpc"),
+		DomainType("standard"),
+	}
+}
+
+// String returns the DomainType as the string of a member.
+func (v DomainType) String() string {
+	return string(v)
+}
+
+// ParseDomainType returns the DomainType of str, failing if it is not
+// one of the DomainTypeValues.
+func ParseDomainType(str string) (DomainType, error) {
+	for _, v := range DomainTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DomainType value %q", str)
+}
+
 // Describes an Amazon EBS block device.
 type EBSBlockDevice struct {
 	// Indicates whether the Amazon EBS volume is deleted on instance termination.
@@ -10797,7 +12675,7 @@ type EBSBlockDevice struct {
 	// IOPS (SSD) volumes, and standard for Magnetic volumes.
 	//
 	// Default: standard
-	VolumeType *string `locationName:"volumeType" type:"string"`
+	VolumeType *string `locationName:"volumeType" type:"string" enum:"standard,io1,gp2"`
 
 	metadataEBSBlockDevice `json:"-", xml:"-"`
 }
@@ -10806,6 +12684,11 @@ type metadataEBSBlockDevice struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EBSBlockDevice.
+func (s EBSBlockDevice) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a parameter used to set up an Amazon EBS volume in a block device
 // mapping.
 type EBSInstanceBlockDevice struct {
@@ -10816,7 +12699,7 @@ type EBSInstanceBlockDevice struct {
 	DeleteOnTermination *bool `locationName:"deleteOnTermination" type:"boolean"`
 
 	// The attachment state.
-	Status *string `locationName:"status" type:"string"`
+	Status *string `locationName:"status" type:"string" enum:"attaching,attached,detaching,detached"`
 
 	// The ID of the Amazon EBS volume.
 	VolumeID *string `locationName:"volumeId" type:"string"`
@@ -10828,6 +12711,11 @@ type metadataEBSInstanceBlockDevice struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EBSInstanceBlockDevice.
+func (s EBSInstanceBlockDevice) String() string {
+	return awsutil.StringValue(s)
+}
+
 type EBSInstanceBlockDeviceSpecification struct {
 	// Indicates whether the volume is deleted on instance termination.
 	DeleteOnTermination *bool `locationName:"deleteOnTermination" type:"boolean"`
@@ -10842,6 +12730,11 @@ type metadataEBSInstanceBlockDeviceSpecification struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EBSInstanceBlockDeviceSpecification.
+func (s EBSInstanceBlockDeviceSpecification) String() string {
+	return awsutil.StringValue(s)
+}
+
 type EnableVGWRoutePropagationInput struct {
 	// The ID of the virtual private gateway.
 	GatewayID *string `locationName:"GatewayId" type:"string" required:"true"`
@@ -10856,6 +12749,11 @@ type metadataEnableVGWRoutePropagationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EnableVGWRoutePropagationInput.
+func (s EnableVGWRoutePropagationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type EnableVGWRoutePropagationOutput struct {
 	metadataEnableVGWRoutePropagationOutput `json:"-", xml:"-"`
 }
@@ -10864,6 +12762,11 @@ type metadataEnableVGWRoutePropagationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EnableVGWRoutePropagationOutput.
+func (s EnableVGWRoutePropagationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type EnableVPCClassicLinkInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -10877,6 +12780,11 @@ type metadataEnableVPCClassicLinkInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EnableVPCClassicLinkInput.
+func (s EnableVPCClassicLinkInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type EnableVPCClassicLinkOutput struct {
 	// Returns true if the request succeeds; otherwise, it returns an error.
 	Return *bool `locationName:"return" type:"boolean"`
@@ -10888,6 +12796,11 @@ type metadataEnableVPCClassicLinkOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EnableVPCClassicLinkOutput.
+func (s EnableVPCClassicLinkOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type EnableVolumeIOInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -10901,6 +12814,11 @@ type metadataEnableVolumeIOInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EnableVolumeIOInput.
+func (s EnableVolumeIOInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type EnableVolumeIOOutput struct {
 	metadataEnableVolumeIOOutput `json:"-", xml:"-"`
 }
@@ -10909,6 +12827,87 @@ type metadataEnableVolumeIOOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EnableVolumeIOOutput.
+func (s EnableVolumeIOOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// EventCode is an enum of the values of EventCode members.
+type EventCode string
+
+// The values of EventCode.
+const (
+	EventCodeInstanceReboot     EventCode = "instance-reboot"
+	EventCodeSystemReboot       EventCode = "system-reboot"
+	EventCodeSystemMaintenance  EventCode = "system-maintenance"
+	EventCodeInstanceRetirement EventCode = "instance-retirement"
+	EventCodeInstanceStop       EventCode = "instance-stop"
+)
+
+// EventCodeValues returns the values of EventCode known to this
+// version of the SDK. The service may accept values added since.
+func EventCodeValues() []EventCode {
+	return []EventCode{
+		EventCode("instance-reboot"),
+		EventCode("system-reboot"),
+		EventCode("system-maintenance"),
+		EventCode("instance-retirement"),
+		EventCode("instance-stop"),
+	}
+}
+
+// String returns the EventCode as the string of a member.
+func (v EventCode) String() string {
+	return string(v)
+}
+
+// ParseEventCode returns the EventCode of str, failing if it is not
+// one of the EventCodeValues.
+func ParseEventCode(str string) (EventCode, error) {
+	for _, v := range EventCodeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid EventCode value %q", str)
+}
+
+// ExportEnvironment is an enum of the values of ExportEnvironment members.
+type ExportEnvironment string
+
+// The values of ExportEnvironment.
+const (
+	ExportEnvironmentCitrix    ExportEnvironment = "citrix"
+	ExportEnvironmentVmware    ExportEnvironment = "vmware"
+	ExportEnvironmentMicrosoft ExportEnvironment = "microsoft"
+)
+
+// ExportEnvironmentValues returns the values of ExportEnvironment known to this
+// version of the SDK. The service may accept values added since.
+func ExportEnvironmentValues() []ExportEnvironment {
+	return []ExportEnvironment{
+		ExportEnvironment("citrix"),
+		ExportEnvironment("vmware"),
+		ExportEnvironment("microsoft"),
+	}
+}
+
+// String returns the ExportEnvironment as the string of a member.
+func (v ExportEnvironment) String() string {
+	return string(v)
+}
+
+// ParseExportEnvironment returns the ExportEnvironment of str, failing if it is not
+// one of the ExportEnvironmentValues.
+func ParseExportEnvironment(str string) (ExportEnvironment, error) {
+	for _, v := range ExportEnvironmentValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ExportEnvironment value %q", str)
+}
+
 // Describes an export task.
 type ExportTask struct {
 	// A description of the resource being exported.
@@ -10923,7 +12922,7 @@ type ExportTask struct {
 	InstanceExportDetails *InstanceExportDetails `locationName:"instanceExport" type:"structure"`
 
 	// The state of the conversion task.
-	State *string `locationName:"state" type:"string"`
+	State *string `locationName:"state" type:"string" enum:"active,cancelling,cancelled,completed"`
 
 	// The status message related to the export task.
 	StatusMessage *string `locationName:"statusMessage" type:"string"`
@@ -10935,13 +12934,56 @@ type metadataExportTask struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ExportTask.
+func (s ExportTask) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ExportTaskState is an enum of the values of ExportTaskState members.
+type ExportTaskState string
+
+// The values of ExportTaskState.
+const (
+	ExportTaskStateActive     ExportTaskState = "active"
+	ExportTaskStateCancelling ExportTaskState = "cancelling"
+	ExportTaskStateCancelled  ExportTaskState = "cancelled"
+	ExportTaskStateCompleted  ExportTaskState = "completed"
+)
+
+// ExportTaskStateValues returns the values of ExportTaskState known to this
+// version of the SDK. The service may accept values added since.
+func ExportTaskStateValues() []ExportTaskState {
+	return []ExportTaskState{
+		ExportTaskState("active"),
+		ExportTaskState("cancelling"),
+		ExportTaskState("cancelled"),
+		ExportTaskState("completed"),
+	}
+}
+
+// String returns the ExportTaskState as the string of a member.
+func (v ExportTaskState) String() string {
+	return string(v)
+}
+
+// ParseExportTaskState returns the ExportTaskState of str, failing if it is not
+// one of the ExportTaskStateValues.
+func ParseExportTaskState(str string) (ExportTaskState, error) {
+	for _, v := range ExportTaskStateValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ExportTaskState value %q", str)
+}
+
 type ExportToS3Task struct {
 	// The container format used to combine disk images with metadata (such as OVF).
 	// If absent, only the disk image is exported.
-	ContainerFormat *string `locationName:"containerFormat" type:"string"`
+	ContainerFormat *string `locationName:"containerFormat" type:"string" enum:"ova"`
 
 	// The format for the exported image.
-	DiskImageFormat *string `locationName:"diskImageFormat" type:"string"`
+	DiskImageFormat *string `locationName:"diskImageFormat" type:"string" enum:"VMDK,RAW,VHD"`
 
 	// The Amazon S3 bucket for the destination image. The destination bucket must
 	// exist and grant WRITE and READ_ACL permissions to the AWS account vm-import-export@amazon.com.
@@ -10956,10 +12998,15 @@ type metadataExportToS3Task struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ExportToS3Task.
+func (s ExportToS3Task) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ExportToS3TaskSpecification struct {
-	ContainerFormat *string `locationName:"containerFormat" type:"string"`
+	ContainerFormat *string `locationName:"containerFormat" type:"string" enum:"ova"`
 
-	DiskImageFormat *string `locationName:"diskImageFormat" type:"string"`
+	DiskImageFormat *string `locationName:"diskImageFormat" type:"string" enum:"VMDK,RAW,VHD"`
 
 	S3Bucket *string `locationName:"s3Bucket" type:"string"`
 
@@ -10974,6 +13021,11 @@ type metadataExportToS3TaskSpecification struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ExportToS3TaskSpecification.
+func (s ExportToS3TaskSpecification) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A filter name and value pair that is used to return a more specific list
 // of results. Filters can be used to match a set of resources by various criteria,
 // such as tags, attributes, or IDs.
@@ -10991,19 +13043,61 @@ type metadataFilter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type GetConsoleOutputInput struct {
-	DryRun *bool `locationName:"dryRun" type:"boolean"`
+// String returns the string representation of the Filter.
+func (s Filter) String() string {
+	return awsutil.StringValue(s)
+}
 
-	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
+// GatewayType is an enum of the values of GatewayType members.
+type GatewayType string
 
-	metadataGetConsoleOutputInput `json:"-", xml:"-"`
-}
+// The values of GatewayType.
+const (
+	GatewayTypeIpsec1 GatewayType = "ipsec.1"
+)
+
+// GatewayTypeValues returns the values of GatewayType known to this
+// version of the SDK. The service may accept values added since.
+func GatewayTypeValues() []GatewayType {
+	return []GatewayType{
+		GatewayType("ipsec.1"),
+	}
+}
+
+// String returns the GatewayType as the string of a member.
+func (v GatewayType) String() string {
+	return string(v)
+}
+
+// ParseGatewayType returns the GatewayType of str, failing if it is not
+// one of the GatewayTypeValues.
+func ParseGatewayType(str string) (GatewayType, error) {
+	for _, v := range GatewayTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid GatewayType value %q", str)
+}
+
+type GetConsoleOutputInput struct {
+	DryRun *bool `locationName:"dryRun" type:"boolean"`
+
+	// The ID of the instance.
+	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
+
+	metadataGetConsoleOutputInput `json:"-", xml:"-"`
+}
 
 type metadataGetConsoleOutputInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetConsoleOutputInput.
+func (s GetConsoleOutputInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetConsoleOutputOutput struct {
 	// The ID of the instance.
 	InstanceID *string `locationName:"instanceId" type:"string"`
@@ -11021,6 +13115,11 @@ type metadataGetConsoleOutputOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetConsoleOutputOutput.
+func (s GetConsoleOutputOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetPasswordDataInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -11034,6 +13133,11 @@ type metadataGetPasswordDataInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetPasswordDataInput.
+func (s GetPasswordDataInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetPasswordDataOutput struct {
 	// The ID of the Windows instance.
 	InstanceID *string `locationName:"instanceId" type:"string"`
@@ -11051,6 +13155,11 @@ type metadataGetPasswordDataOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetPasswordDataOutput.
+func (s GetPasswordDataOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a security group.
 type GroupIdentifier struct {
 	// The ID of the security group.
@@ -11066,6 +13175,45 @@ type metadataGroupIdentifier struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GroupIdentifier.
+func (s GroupIdentifier) String() string {
+	return awsutil.StringValue(s)
+}
+
+// HypervisorType is an enum of the values of HypervisorType members.
+type HypervisorType string
+
+// The values of HypervisorType.
+const (
+	HypervisorTypeOvm HypervisorType = "ovm"
+	HypervisorTypeXen HypervisorType = "xen"
+)
+
+// HypervisorTypeValues returns the values of HypervisorType known to this
+// version of the SDK. The service may accept values added since.
+func HypervisorTypeValues() []HypervisorType {
+	return []HypervisorType{
+		HypervisorType("ovm"),
+		HypervisorType("xen"),
+	}
+}
+
+// String returns the HypervisorType as the string of a member.
+func (v HypervisorType) String() string {
+	return string(v)
+}
+
+// ParseHypervisorType returns the HypervisorType of str, failing if it is not
+// one of the HypervisorTypeValues.
+func ParseHypervisorType(str string) (HypervisorType, error) {
+	for _, v := range HypervisorTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HypervisorType value %q", str)
+}
+
 // Describes an IAM instance profile.
 type IAMInstanceProfile struct {
 	// The Amazon Resource Name (ARN) of the instance profile.
@@ -11081,6 +13229,11 @@ type metadataIAMInstanceProfile struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the IAMInstanceProfile.
+func (s IAMInstanceProfile) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an IAM instance profile.
 type IAMInstanceProfileSpecification struct {
 	// The Amazon Resource Name (ARN) of the instance profile.
@@ -11096,6 +13249,11 @@ type metadataIAMInstanceProfileSpecification struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the IAMInstanceProfileSpecification.
+func (s IAMInstanceProfileSpecification) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the ICMP type and code.
 type ICMPTypeCode struct {
 	// The ICMP type. A value of -1 means all types.
@@ -11111,6 +13269,11 @@ type metadataICMPTypeCode struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ICMPTypeCode.
+func (s ICMPTypeCode) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a security group rule.
 type IPPermission struct {
 	// The start of port range for the TCP and UDP protocols, or an ICMP type number.
@@ -11144,6 +13307,11 @@ type metadataIPPermission struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the IPPermission.
+func (s IPPermission) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an IP range.
 type IPRange struct {
 	// The CIDR range. You can either specify a CIDR range or a source security
@@ -11157,10 +13325,15 @@ type metadataIPRange struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the IPRange.
+func (s IPRange) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an image.
 type Image struct {
 	// The architecture of the image.
-	Architecture *string `locationName:"architecture" type:"string"`
+	Architecture *string `locationName:"architecture" type:"string" enum:"i386,x86_64"`
 
 	// Any block device mapping entries.
 	BlockDeviceMappings []*BlockDeviceMapping `locationName:"blockDeviceMapping" locationNameList:"item" type:"list"`
@@ -11172,7 +13345,7 @@ type Image struct {
 	Description *string `locationName:"description" type:"string"`
 
 	// The hypervisor type of the image.
-	Hypervisor *string `locationName:"hypervisor" type:"string"`
+	Hypervisor *string `locationName:"hypervisor" type:"string" enum:"ovm,xen"`
 
 	// The ID of the AMI.
 	ImageID *string `locationName:"imageId" type:"string"`
@@ -11185,7 +13358,7 @@ type Image struct {
 	ImageOwnerAlias *string `locationName:"imageOwnerAlias" type:"string"`
 
 	// The type of image.
-	ImageType *string `locationName:"imageType" type:"string"`
+	ImageType *string `locationName:"imageType" type:"string" enum:"machine,kernel,ramdisk"`
 
 	// The kernel associated with the image, if any. Only applicable for machine
 	// images.
@@ -11198,7 +13371,7 @@ type Image struct {
 	OwnerID *string `locationName:"imageOwnerId" type:"string"`
 
 	// The value is Windows for Windows AMIs; otherwise blank.
-	Platform *string `locationName:"platform" type:"string"`
+	Platform *string `locationName:"platform" type:"string" enum:"Windows"`
 
 	// Any product codes associated with the AMI.
 	ProductCodes []*ProductCode `locationName:"productCodes" locationNameList:"item" type:"list"`
@@ -11217,14 +13390,14 @@ type Image struct {
 
 	// The type of root device used by the AMI. The AMI can use an Amazon EBS volume
 	// or an instance store volume.
-	RootDeviceType *string `locationName:"rootDeviceType" type:"string"`
+	RootDeviceType *string `locationName:"rootDeviceType" type:"string" enum:"ebs,instance-store"`
 
 	// Specifies whether enhanced networking is enabled.
 	SRIOVNetSupport *string `locationName:"sriovNetSupport" type:"string"`
 
 	// The current state of the AMI. If the state is available, the image is successfully
 	// registered and can be used to launch an instance.
-	State *string `locationName:"imageState" type:"string"`
+	State *string `locationName:"imageState" type:"string" enum:"available,deregistered"`
 
 	// The reason for the state change.
 	StateReason *StateReason `locationName:"stateReason" type:"structure"`
@@ -11233,7 +13406,7 @@ type Image struct {
 	Tags []*Tag `locationName:"tagSet" locationNameList:"item" type:"list"`
 
 	// The type of virtualization of the AMI.
-	VirtualizationType *string `locationName:"virtualizationType" type:"string"`
+	VirtualizationType *string `locationName:"virtualizationType" type:"string" enum:"hvm,paravirtual"`
 
 	metadataImage `json:"-", xml:"-"`
 }
@@ -11242,6 +13415,123 @@ type metadataImage struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Image.
+func (s Image) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ImageAttributeName is an enum of the values of ImageAttributeName members.
+type ImageAttributeName string
+
+// The values of ImageAttributeName.
+const (
+	ImageAttributeNameDescription        ImageAttributeName = "description"
+	ImageAttributeNameKernel             ImageAttributeName = "kernel"
+	ImageAttributeNameRamdisk            ImageAttributeName = "ramdisk"
+	ImageAttributeNameLaunchPermission   ImageAttributeName = "launchPermission"
+	ImageAttributeNameProductCodes       ImageAttributeName = "productCodes"
+	ImageAttributeNameBlockDeviceMapping ImageAttributeName = "blockDeviceMapping"
+)
+
+// ImageAttributeNameValues returns the values of ImageAttributeName known to this
+// version of the SDK. The service may accept values added since.
+func ImageAttributeNameValues() []ImageAttributeName {
+	return []ImageAttributeName{
+		ImageAttributeName("description"),
+		ImageAttributeName("kernel"),
+		ImageAttributeName("ramdisk"),
+		ImageAttributeName("launchPermission"),
+		ImageAttributeName("productCodes"),
+		ImageAttributeName("blockDeviceMapping"),
+	}
+}
+
+// String returns the ImageAttributeName as the string of a member.
+func (v ImageAttributeName) String() string {
+	return string(v)
+}
+
+// ParseImageAttributeName returns the ImageAttributeName of str, failing if it is not
+// one of the ImageAttributeNameValues.
+func ParseImageAttributeName(str string) (ImageAttributeName, error) {
+	for _, v := range ImageAttributeNameValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ImageAttributeName value %q", str)
+}
+
+// ImageState is an enum of the values of ImageState members.
+type ImageState string
+
+// The values of ImageState.
+const (
+	ImageStateAvailable    ImageState = "available"
+	ImageStateDeregistered ImageState = "deregistered"
+)
+
+// ImageStateValues returns the values of ImageState known to this
+// version of the SDK. The service may accept values added since.
+func ImageStateValues() []ImageState {
+	return []ImageState{
+		ImageState("available"),
+		ImageState("deregistered"),
+	}
+}
+
+// String returns the ImageState as the string of a member.
+func (v ImageState) String() string {
+	return string(v)
+}
+
+// ParseImageState returns the ImageState of str, failing if it is not
+// one of the ImageStateValues.
+func ParseImageState(str string) (ImageState, error) {
+	for _, v := range ImageStateValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ImageState value %q", str)
+}
+
+// ImageTypeValues is an enum of the values of ImageTypeValues members.
+type ImageTypeValues string
+
+// The values of ImageTypeValues.
+const (
+	ImageTypeValuesMachine ImageTypeValues = "machine"
+	ImageTypeValuesKernel  ImageTypeValues = "kernel"
+	ImageTypeValuesRamdisk ImageTypeValues = "ramdisk"
+)
+
+// ImageTypeValuesValues returns the values of ImageTypeValues known to this
+// version of the SDK. The service may accept values added since.
+func ImageTypeValuesValues() []ImageTypeValues {
+	return []ImageTypeValues{
+		ImageTypeValues("machine"),
+		ImageTypeValues("kernel"),
+		ImageTypeValues("ramdisk"),
+	}
+}
+
+// String returns the ImageTypeValues as the string of a member.
+func (v ImageTypeValues) String() string {
+	return string(v)
+}
+
+// ParseImageTypeValues returns the ImageTypeValues of str, failing if it is not
+// one of the ImageTypeValuesValues.
+func ParseImageTypeValues(str string) (ImageTypeValues, error) {
+	for _, v := range ImageTypeValuesValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ImageTypeValues value %q", str)
+}
+
 type ImportInstanceInput struct {
 	// A description for the instance being imported.
 	Description *string `locationName:"description" type:"string"`
@@ -11253,7 +13543,7 @@ type ImportInstanceInput struct {
 	LaunchSpecification *ImportInstanceLaunchSpecification `locationName:"launchSpecification" type:"structure"`
 
 	// The instance operating system.
-	Platform *string `locationName:"platform" type:"string" required:"true"`
+	Platform *string `locationName:"platform" type:"string" enum:"Windows" required:"true"`
 
 	metadataImportInstanceInput `json:"-", xml:"-"`
 }
@@ -11262,11 +13552,16 @@ type metadataImportInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ImportInstanceInput.
+func (s ImportInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ImportInstanceLaunchSpecification struct {
 	AdditionalInfo *string `locationName:"additionalInfo" type:"string"`
 
 	// The architecture of the instance.
-	Architecture *string `locationName:"architecture" type:"string"`
+	Architecture *string `locationName:"architecture" type:"string" enum:"i386,x86_64"`
 
 	// One or more security group IDs.
 	GroupIDs []*string `locationName:"GroupId" locationNameList:"SecurityGroupId" type:"list"`
@@ -11276,7 +13571,7 @@ type ImportInstanceLaunchSpecification struct {
 
 	// Indicates whether an instance stops or terminates when you initiate shutdown
 	// from the instance (using the operating system command for system shutdown).
-	InstanceInitiatedShutdownBehavior *string `locationName:"instanceInitiatedShutdownBehavior" type:"string"`
+	InstanceInitiatedShutdownBehavior *string `locationName:"instanceInitiatedShutdownBehavior" type:"string" enum:"stop,terminate"`
 
 	// The instance type. This is not supported for VMs imported into a VPC, which
 	// are assigned the default security group. After a VM is imported into a VPC,
@@ -11285,7 +13580,7 @@ type ImportInstanceLaunchSpecification struct {
 	// in the Amazon Elastic Compute Cloud User Guide. For more information about
 	// the Linux instance types you can import, see Before You Get Started (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/VMImportPrerequisites.html)
 	// in the Amazon Elastic Compute Cloud User Guide.
-	InstanceType *string `locationName:"instanceType" type:"string"`
+	InstanceType *string `locationName:"instanceType" type:"string" enum:"t1.micro,m1.small,m1.medium,m1.large,m1.xlarge,m3.medium,m3.large,m3.xlarge,m3.2xlarge,t2.micro,t2.small,t2.medium,m2.xlarge,m2.2xlarge,m2.4xlarge,cr1.8xlarge,i2.xlarge,i2.2xlarge,i2.4xlarge,i2.8xlarge,hi1.4xlarge,hs1.8xlarge,c1.medium,c1.xlarge,c3.large,c3.xlarge,c3.2xlarge,c3.4xlarge,c3.8xlarge,c4.large,c4.xlarge,c4.2xlarge,c4.4xlarge,c4.8xlarge,cc1.4xlarge,cc2.8xlarge,g2.2xlarge,cg1.4xlarge,r3.large,r3.xlarge,r3.2xlarge,r3.4xlarge,r3.8xlarge"`
 
 	Monitoring *bool `locationName:"monitoring" type:"boolean"`
 
@@ -11309,6 +13604,11 @@ type metadataImportInstanceLaunchSpecification struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ImportInstanceLaunchSpecification.
+func (s ImportInstanceLaunchSpecification) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ImportInstanceOutput struct {
 	// Describes a conversion task.
 	ConversionTask *ConversionTask `locationName:"conversionTask" type:"structure"`
@@ -11320,13 +13620,18 @@ type metadataImportInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ImportInstanceOutput.
+func (s ImportInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ImportInstanceTaskDetails struct {
 	Description *string `locationName:"description" type:"string"`
 
 	InstanceID *string `locationName:"instanceId" type:"string"`
 
 	// The instance operating system.
-	Platform *string `locationName:"platform" type:"string"`
+	Platform *string `locationName:"platform" type:"string" enum:"Windows"`
 
 	Volumes []*ImportInstanceVolumeDetailItem `locationName:"volumes" locationNameList:"item" type:"list" required:"true"`
 
@@ -11337,6 +13642,11 @@ type metadataImportInstanceTaskDetails struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ImportInstanceTaskDetails.
+func (s ImportInstanceTaskDetails) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an import volume task.
 type ImportInstanceVolumeDetailItem struct {
 	// The Availability Zone where the resulting instance will reside.
@@ -11366,6 +13676,11 @@ type metadataImportInstanceVolumeDetailItem struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ImportInstanceVolumeDetailItem.
+func (s ImportInstanceVolumeDetailItem) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ImportKeyPairInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -11383,6 +13698,11 @@ type metadataImportKeyPairInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ImportKeyPairInput.
+func (s ImportKeyPairInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ImportKeyPairOutput struct {
 	// The MD5 public key fingerprint as specified in section 4 of RFC 4716.
 	KeyFingerprint *string `locationName:"keyFingerprint" type:"string"`
@@ -11397,6 +13717,11 @@ type metadataImportKeyPairOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ImportKeyPairOutput.
+func (s ImportKeyPairOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ImportVolumeInput struct {
 	// The Availability Zone for the resulting Amazon EBS volume.
 	AvailabilityZone *string `locationName:"availabilityZone" type:"string" required:"true"`
@@ -11418,6 +13743,11 @@ type metadataImportVolumeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ImportVolumeInput.
+func (s ImportVolumeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ImportVolumeOutput struct {
 	// Describes a conversion task.
 	ConversionTask *ConversionTask `locationName:"conversionTask" type:"structure"`
@@ -11429,6 +13759,11 @@ type metadataImportVolumeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ImportVolumeOutput.
+func (s ImportVolumeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an import volume task.
 type ImportVolumeTaskDetails struct {
 	// The Availability Zone where the resulting volume will reside.
@@ -11453,6 +13788,11 @@ type metadataImportVolumeTaskDetails struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ImportVolumeTaskDetails.
+func (s ImportVolumeTaskDetails) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an instance.
 type Instance struct {
 	// The AMI launch index, which can be used to find this instance in the launch
@@ -11460,7 +13800,7 @@ type Instance struct {
 	AMILaunchIndex *int64 `locationName:"amiLaunchIndex" type:"integer"`
 
 	// The architecture of the image.
-	Architecture *string `locationName:"architecture" type:"string"`
+	Architecture *string `locationName:"architecture" type:"string" enum:"i386,x86_64"`
 
 	// Any block device mapping entries for the instance.
 	BlockDeviceMappings []*InstanceBlockDeviceMapping `locationName:"blockDeviceMapping" locationNameList:"item" type:"list"`
@@ -11476,7 +13816,7 @@ type Instance struct {
 	EBSOptimized *bool `locationName:"ebsOptimized" type:"boolean"`
 
 	// The hypervisor type of the instance.
-	Hypervisor *string `locationName:"hypervisor" type:"string"`
+	Hypervisor *string `locationName:"hypervisor" type:"string" enum:"ovm,xen"`
 
 	// The IAM instance profile associated with the instance.
 	IAMInstanceProfile *IAMInstanceProfile `locationName:"iamInstanceProfile" type:"structure"`
@@ -11488,10 +13828,10 @@ type Instance struct {
 	InstanceID *string `locationName:"instanceId" type:"string"`
 
 	// Indicates whether this is a Spot Instance.
-	InstanceLifecycle *string `locationName:"instanceLifecycle" type:"string"`
+	InstanceLifecycle *string `locationName:"instanceLifecycle" type:"string" enum:"spot"`
 
 	// The instance type.
-	InstanceType *string `locationName:"instanceType" type:"string"`
+	InstanceType *string `locationName:"instanceType" type:"string" enum:"t1.micro,m1.small,m1.medium,m1.large,m1.xlarge,m3.medium,m3.large,m3.xlarge,m3.2xlarge,t2.micro,t2.small,t2.medium,m2.xlarge,m2.2xlarge,m2.4xlarge,cr1.8xlarge,i2.xlarge,i2.2xlarge,i2.4xlarge,i2.8xlarge,hi1.4xlarge,hs1.8xlarge,c1.medium,c1.xlarge,c3.large,c3.xlarge,c3.2xlarge,c3.4xlarge,c3.8xlarge,c4.large,c4.xlarge,c4.2xlarge,c4.4xlarge,c4.8xlarge,cc1.4xlarge,cc2.8xlarge,g2.2xlarge,cg1.4xlarge,r3.large,r3.xlarge,r3.2xlarge,r3.4xlarge,r3.8xlarge"`
 
 	// The kernel associated with this instance.
 	KernelID *string `locationName:"kernelId" type:"string"`
@@ -11513,7 +13853,7 @@ type Instance struct {
 	Placement *Placement `locationName:"placement" type:"structure"`
 
 	// The value is Windows for Windows instances; otherwise blank.
-	Platform *string `locationName:"platform" type:"string"`
+	Platform *string `locationName:"platform" type:"string" enum:"Windows"`
 
 	// The private DNS name assigned to the instance. This DNS name can only be
 	// used inside the Amazon EC2 network. This name is not available until the
@@ -11541,7 +13881,7 @@ type Instance struct {
 
 	// The root device type used by the AMI. The AMI can use an Amazon EBS volume
 	// or an instance store volume.
-	RootDeviceType *string `locationName:"rootDeviceType" type:"string"`
+	RootDeviceType *string `locationName:"rootDeviceType" type:"string" enum:"ebs,instance-store"`
 
 	// Specifies whether enhanced networking is enabled.
 	SRIOVNetSupport *string `locationName:"sriovNetSupport" type:"string"`
@@ -11579,7 +13919,7 @@ type Instance struct {
 	VPCID *string `locationName:"vpcId" type:"string"`
 
 	// The virtualization type of the instance.
-	VirtualizationType *string `locationName:"virtualizationType" type:"string"`
+	VirtualizationType *string `locationName:"virtualizationType" type:"string" enum:"hvm,paravirtual"`
 
 	metadataInstance `json:"-", xml:"-"`
 }
@@ -11588,6 +13928,67 @@ type metadataInstance struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Instance.
+func (s Instance) String() string {
+	return awsutil.StringValue(s)
+}
+
+// InstanceAttributeName is an enum of the values of InstanceAttributeName members.
+type InstanceAttributeName string
+
+// The values of InstanceAttributeName.
+const (
+	InstanceAttributeNameInstanceType                      InstanceAttributeName = "instanceType"
+	InstanceAttributeNameKernel                            InstanceAttributeName = "kernel"
+	InstanceAttributeNameRamdisk                           InstanceAttributeName = "ramdisk"
+	InstanceAttributeNameUserData                          InstanceAttributeName = "userData"
+	InstanceAttributeNameDisableApiTermination             InstanceAttributeName = "disableApiTermination"
+	InstanceAttributeNameInstanceInitiatedShutdownBehavior InstanceAttributeName = "instanceInitiatedShutdownBehavior"
+	InstanceAttributeNameRootDeviceName                    InstanceAttributeName = "rootDeviceName"
+	InstanceAttributeNameBlockDeviceMapping                InstanceAttributeName = "blockDeviceMapping"
+	InstanceAttributeNameProductCodes                      InstanceAttributeName = "productCodes"
+	InstanceAttributeNameSourceDestCheck                   InstanceAttributeName = "sourceDestCheck"
+	InstanceAttributeNameGroupSet                          InstanceAttributeName = "groupSet"
+	InstanceAttributeNameEbsOptimized                      InstanceAttributeName = "ebsOptimized"
+	InstanceAttributeNameSriovNetSupport                   InstanceAttributeName = "sriovNetSupport"
+)
+
+// InstanceAttributeNameValues returns the values of InstanceAttributeName known to this
+// version of the SDK. The service may accept values added since.
+func InstanceAttributeNameValues() []InstanceAttributeName {
+	return []InstanceAttributeName{
+		InstanceAttributeName("instanceType"),
+		InstanceAttributeName("kernel"),
+		InstanceAttributeName("ramdisk"),
+		InstanceAttributeName("userData"),
+		InstanceAttributeName("disableApiTermination"),
+		InstanceAttributeName("instanceInitiatedShutdownBehavior"),
+		InstanceAttributeName("rootDeviceName"),
+		InstanceAttributeName("blockDeviceMapping"),
+		InstanceAttributeName("productCodes"),
+		InstanceAttributeName("sourceDestCheck"),
+		InstanceAttributeName("groupSet"),
+		InstanceAttributeName("ebsOptimized"),
+		InstanceAttributeName("sriovNetSupport"),
+	}
+}
+
+// String returns the InstanceAttributeName as the string of a member.
+func (v InstanceAttributeName) String() string {
+	return string(v)
+}
+
+// ParseInstanceAttributeName returns the InstanceAttributeName of str, failing if it is not
+// one of the InstanceAttributeNameValues.
+func ParseInstanceAttributeName(str string) (InstanceAttributeName, error) {
+	for _, v := range InstanceAttributeNameValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid InstanceAttributeName value %q", str)
+}
+
 // Describes a block device mapping.
 type InstanceBlockDeviceMapping struct {
 	// The device name exposed to the instance (for example, /dev/sdh).
@@ -11604,6 +14005,11 @@ type metadataInstanceBlockDeviceMapping struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InstanceBlockDeviceMapping.
+func (s InstanceBlockDeviceMapping) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a block device mapping entry.
 type InstanceBlockDeviceMappingSpecification struct {
 	// The device name exposed to the instance (for example, /dev/sdh).
@@ -11626,13 +14032,18 @@ type metadataInstanceBlockDeviceMappingSpecification struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InstanceBlockDeviceMappingSpecification.
+func (s InstanceBlockDeviceMappingSpecification) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a Reserved Instance listing state.
 type InstanceCount struct {
 	// he number of listed Reserved Instances in the state specified by the state.
 	InstanceCount *int64 `locationName:"instanceCount" type:"integer"`
 
 	// The states of the listed Reserved Instances.
-	State *string `locationName:"state" type:"string"`
+	State *string `locationName:"state" type:"string" enum:"available,sold,cancelled,pending"`
 
 	metadataInstanceCount `json:"-", xml:"-"`
 }
@@ -11641,13 +14052,18 @@ type metadataInstanceCount struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InstanceCount.
+func (s InstanceCount) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an instance export task.
 type InstanceExportDetails struct {
 	// The ID of the resource being exported.
 	InstanceID *string `locationName:"instanceId" type:"string"`
 
 	// The target virtualization environment.
-	TargetEnvironment *string `locationName:"targetEnvironment" type:"string"`
+	TargetEnvironment *string `locationName:"targetEnvironment" type:"string" enum:"citrix,vmware,microsoft"`
 
 	metadataInstanceExportDetails `json:"-", xml:"-"`
 }
@@ -11656,6 +14072,43 @@ type metadataInstanceExportDetails struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InstanceExportDetails.
+func (s InstanceExportDetails) String() string {
+	return awsutil.StringValue(s)
+}
+
+// InstanceLifecycleType is an enum of the values of InstanceLifecycleType members.
+type InstanceLifecycleType string
+
+// The values of InstanceLifecycleType.
+const (
+	InstanceLifecycleTypeSpot InstanceLifecycleType = "spot"
+)
+
+// InstanceLifecycleTypeValues returns the values of InstanceLifecycleType known to this
+// version of the SDK. The service may accept values added since.
+func InstanceLifecycleTypeValues() []InstanceLifecycleType {
+	return []InstanceLifecycleType{
+		InstanceLifecycleType("spot"),
+	}
+}
+
+// String returns the InstanceLifecycleType as the string of a member.
+func (v InstanceLifecycleType) String() string {
+	return string(v)
+}
+
+// ParseInstanceLifecycleType returns the InstanceLifecycleType of str, failing if it is not
+// one of the InstanceLifecycleTypeValues.
+func ParseInstanceLifecycleType(str string) (InstanceLifecycleType, error) {
+	for _, v := range InstanceLifecycleTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid InstanceLifecycleType value %q", str)
+}
+
 // Describes the monitoring information of the instance.
 type InstanceMonitoring struct {
 	// The ID of the instance.
@@ -11671,6 +14124,11 @@ type metadataInstanceMonitoring struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InstanceMonitoring.
+func (s InstanceMonitoring) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a network interface.
 type InstanceNetworkInterface struct {
 	// The association information for an Elastic IP associated with the network
@@ -11708,7 +14166,7 @@ type InstanceNetworkInterface struct {
 	SourceDestCheck *bool `locationName:"sourceDestCheck" type:"boolean"`
 
 	// The status of the network interface.
-	Status *string `locationName:"status" type:"string"`
+	Status *string `locationName:"status" type:"string" enum:"available,attaching,in-use,detaching"`
 
 	// The ID of the subnet.
 	SubnetID *string `locationName:"subnetId" type:"string"`
@@ -11723,6 +14181,11 @@ type metadataInstanceNetworkInterface struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InstanceNetworkInterface.
+func (s InstanceNetworkInterface) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes association information for an Elastic IP address.
 type InstanceNetworkInterfaceAssociation struct {
 	// The ID of the owner of the Elastic IP address.
@@ -11741,6 +14204,11 @@ type metadataInstanceNetworkInterfaceAssociation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InstanceNetworkInterfaceAssociation.
+func (s InstanceNetworkInterfaceAssociation) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a network interface attachment.
 type InstanceNetworkInterfaceAttachment struct {
 	// The time stamp when the attachment initiated.
@@ -11756,7 +14224,7 @@ type InstanceNetworkInterfaceAttachment struct {
 	DeviceIndex *int64 `locationName:"deviceIndex" type:"integer"`
 
 	// The attachment state.
-	Status *string `locationName:"status" type:"string"`
+	Status *string `locationName:"status" type:"string" enum:"attaching,attached,detaching,detached"`
 
 	metadataInstanceNetworkInterfaceAttachment `json:"-", xml:"-"`
 }
@@ -11765,6 +14233,11 @@ type metadataInstanceNetworkInterfaceAttachment struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InstanceNetworkInterfaceAttachment.
+func (s InstanceNetworkInterfaceAttachment) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a network interface.
 type InstanceNetworkInterfaceSpecification struct {
 	// Indicates whether to assign a public IP address to an instance you launch
@@ -11819,6 +14292,11 @@ type metadataInstanceNetworkInterfaceSpecification struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InstanceNetworkInterfaceSpecification.
+func (s InstanceNetworkInterfaceSpecification) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a private IP address.
 type InstancePrivateIPAddress struct {
 	// The association information for an Elastic IP address for the network interface.
@@ -11841,6 +14319,11 @@ type metadataInstancePrivateIPAddress struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InstancePrivateIPAddress.
+func (s InstancePrivateIPAddress) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the current state of the instance.
 type InstanceState struct {
 	// The low byte represents the state. The high byte is an opaque internal value
@@ -11860,7 +14343,7 @@ type InstanceState struct {
 	Code *int64 `locationName:"code" type:"integer"`
 
 	// The current state of the instance.
-	Name *string `locationName:"name" type:"string"`
+	Name *string `locationName:"name" type:"string" enum:"pending,running,shutting-down,terminated,stopping,stopped"`
 
 	metadataInstanceState `json:"-", xml:"-"`
 }
@@ -11869,6 +14352,11 @@ type metadataInstanceState struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InstanceState.
+func (s InstanceState) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an instance state change.
 type InstanceStateChange struct {
 	// The current state of the instance.
@@ -11887,6 +14375,53 @@ type metadataInstanceStateChange struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InstanceStateChange.
+func (s InstanceStateChange) String() string {
+	return awsutil.StringValue(s)
+}
+
+// InstanceStateName is an enum of the values of InstanceStateName members.
+type InstanceStateName string
+
+// The values of InstanceStateName.
+const (
+	InstanceStateNamePending      InstanceStateName = "pending"
+	InstanceStateNameRunning      InstanceStateName = "running"
+	InstanceStateNameShuttingDown InstanceStateName = "shutting-down"
+	InstanceStateNameTerminated   InstanceStateName = "terminated"
+	InstanceStateNameStopping     InstanceStateName = "stopping"
+	InstanceStateNameStopped      InstanceStateName = "stopped"
+)
+
+// InstanceStateNameValues returns the values of InstanceStateName known to this
+// version of the SDK. The service may accept values added since.
+func InstanceStateNameValues() []InstanceStateName {
+	return []InstanceStateName{
+		InstanceStateName("pending"),
+		InstanceStateName("running"),
+		InstanceStateName("shutting-down"),
+		InstanceStateName("terminated"),
+		InstanceStateName("stopping"),
+		InstanceStateName("stopped"),
+	}
+}
+
+// String returns the InstanceStateName as the string of a member.
+func (v InstanceStateName) String() string {
+	return string(v)
+}
+
+// ParseInstanceStateName returns the InstanceStateName of str, failing if it is not
+// one of the InstanceStateNameValues.
+func ParseInstanceStateName(str string) (InstanceStateName, error) {
+	for _, v := range InstanceStateNameValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid InstanceStateName value %q", str)
+}
+
 // Describes the status of an instance.
 type InstanceStatus struct {
 	// The Availability Zone of the instance.
@@ -11918,6 +14453,11 @@ type metadataInstanceStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InstanceStatus.
+func (s InstanceStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the instance status.
 type InstanceStatusDetails struct {
 	// The time when a status check failed. For an instance that was launched and
@@ -11925,10 +14465,10 @@ type InstanceStatusDetails struct {
 	ImpairedSince *time.Time `locationName:"impairedSince" type:"timestamp" timestampFormat:"iso8601"`
 
 	// The type of instance status.
-	Name *string `locationName:"name" type:"string"`
+	Name *string `locationName:"name" type:"string" enum:"reachability"`
 
 	// The status.
-	Status *string `locationName:"status" type:"string"`
+	Status *string `locationName:"status" type:"string" enum:"passed,failed,insufficient-data"`
 
 	metadataInstanceStatusDetails `json:"-", xml:"-"`
 }
@@ -11937,10 +14477,15 @@ type metadataInstanceStatusDetails struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InstanceStatusDetails.
+func (s InstanceStatusDetails) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an instance event.
 type InstanceStatusEvent struct {
 	// The associated code of the event.
-	Code *string `locationName:"code" type:"string"`
+	Code *string `locationName:"code" type:"string" enum:"instance-reboot,system-reboot,system-maintenance,instance-retirement,instance-stop"`
 
 	// A description of the event.
 	Description *string `locationName:"description" type:"string"`
@@ -11958,13 +14503,18 @@ type metadataInstanceStatusEvent struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InstanceStatusEvent.
+func (s InstanceStatusEvent) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the status of an instance.
 type InstanceStatusSummary struct {
 	// The system instance health or application instance health.
 	Details []*InstanceStatusDetails `locationName:"details" locationNameList:"item" type:"list"`
 
 	// The status.
-	Status *string `locationName:"status" type:"string"`
+	Status *string `locationName:"status" type:"string" enum:"ok,impaired,insufficient-data,not-applicable"`
 
 	metadataInstanceStatusSummary `json:"-", xml:"-"`
 }
@@ -11973,6 +14523,127 @@ type metadataInstanceStatusSummary struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InstanceStatusSummary.
+func (s InstanceStatusSummary) String() string {
+	return awsutil.StringValue(s)
+}
+
+// InstanceType is an enum of the values of InstanceType members.
+type InstanceType string
+
+// The values of InstanceType.
+const (
+	InstanceTypeT1Micro    InstanceType = "t1.micro"
+	InstanceTypeM1Small    InstanceType = "m1.small"
+	InstanceTypeM1Medium   InstanceType = "m1.medium"
+	InstanceTypeM1Large    InstanceType = "m1.large"
+	InstanceTypeM1Xlarge   InstanceType = "m1.xlarge"
+	InstanceTypeM3Medium   InstanceType = "m3.medium"
+	InstanceTypeM3Large    InstanceType = "m3.large"
+	InstanceTypeM3Xlarge   InstanceType = "m3.xlarge"
+	InstanceTypeM32xlarge  InstanceType = "m3.2xlarge"
+	InstanceTypeT2Micro    InstanceType = "t2.micro"
+	InstanceTypeT2Small    InstanceType = "t2.small"
+	InstanceTypeT2Medium   InstanceType = "t2.medium"
+	InstanceTypeM2Xlarge   InstanceType = "m2.xlarge"
+	InstanceTypeM22xlarge  InstanceType = "m2.2xlarge"
+	InstanceTypeM24xlarge  InstanceType = "m2.4xlarge"
+	InstanceTypeCr18xlarge InstanceType = "cr1.8xlarge"
+	InstanceTypeI2Xlarge   InstanceType = "i2.xlarge"
+	InstanceTypeI22xlarge  InstanceType = "i2.2xlarge"
+	InstanceTypeI24xlarge  InstanceType = "i2.4xlarge"
+	InstanceTypeI28xlarge  InstanceType = "i2.8xlarge"
+	InstanceTypeHi14xlarge InstanceType = "hi1.4xlarge"
+	InstanceTypeHs18xlarge InstanceType = "hs1.8xlarge"
+	InstanceTypeC1Medium   InstanceType = "c1.medium"
+	InstanceTypeC1Xlarge   InstanceType = "c1.xlarge"
+	InstanceTypeC3Large    InstanceType = "c3.large"
+	InstanceTypeC3Xlarge   InstanceType = "c3.xlarge"
+	InstanceTypeC32xlarge  InstanceType = "c3.2xlarge"
+	InstanceTypeC34xlarge  InstanceType = "c3.4xlarge"
+	InstanceTypeC38xlarge  InstanceType = "c3.8xlarge"
+	InstanceTypeC4Large    InstanceType = "c4.large"
+	InstanceTypeC4Xlarge   InstanceType = "c4.xlarge"
+	InstanceTypeC42xlarge  InstanceType = "c4.2xlarge"
+	InstanceTypeC44xlarge  InstanceType = "c4.4xlarge"
+	InstanceTypeC48xlarge  InstanceType = "c4.8xlarge"
+	InstanceTypeCc14xlarge InstanceType = "cc1.4xlarge"
+	InstanceTypeCc28xlarge InstanceType = "cc2.8xlarge"
+	InstanceTypeG22xlarge  InstanceType = "g2.2xlarge"
+	InstanceTypeCg14xlarge InstanceType = "cg1.4xlarge"
+	InstanceTypeR3Large    InstanceType = "r3.large"
+	InstanceTypeR3Xlarge   InstanceType = "r3.xlarge"
+	InstanceTypeR32xlarge  InstanceType = "r3.2xlarge"
+	InstanceTypeR34xlarge  InstanceType = "r3.4xlarge"
+	InstanceTypeR38xlarge  InstanceType = "r3.8xlarge"
+)
+
+// InstanceTypeValues returns the values of InstanceType known to this
+// version of the SDK. The service may accept values added since.
+func InstanceTypeValues() []InstanceType {
+	return []InstanceType{
+		InstanceType("t1.micro"),
+		InstanceType("m1.small"),
+		InstanceType("m1.medium"),
+		InstanceType("m1.large"),
+		InstanceType("m1.xlarge"),
+		InstanceType("m3.medium"),
+		InstanceType("m3.large"),
+		InstanceType("m3.xlarge"),
+		InstanceType("m3.2xlarge"),
+		InstanceType("t2.micro"),
+		InstanceType("t2.small"),
+		InstanceType("t2.medium"),
+		InstanceType("m2.xlarge"),
+		InstanceType("m2.2xlarge"),
+		InstanceType("m2.4xlarge"),
+		InstanceType("cr1.8xlarge"),
+		InstanceType("i2.xlarge"),
+		InstanceType("i2.2xlarge"),
+		InstanceType("i2.4xlarge"),
+		InstanceType("i2.8xlarge"),
+		InstanceType("hi1.4xlarge"),
+		InstanceType("hs1.8xlarge"),
+		InstanceType("c1.medium"),
+		InstanceType("c1.xlarge"),
+		InstanceType("c3.large"),
+		InstanceType("c3.xlarge"),
+		InstanceType("c3.2xlarge"),
+		InstanceType("c3.4xlarge"),
+		InstanceType("c3.8xlarge"),
+		InstanceType("c4.large"),
+		InstanceType("c4.xlarge"),
+		InstanceType("c4.2xlarge"),
+		InstanceType("c4.4xlarge"),
+		InstanceType("c4.8xlarge"),
+		InstanceType("cc1.4xlarge"),
+		InstanceType("cc2.8xlarge"),
+		InstanceType("g2.2xlarge"),
+		InstanceType("cg1.4xlarge"),
+		InstanceType("r3.large"),
+		InstanceType("r3.xlarge"),
+		InstanceType("r3.2xlarge"),
+		InstanceType("r3.4xlarge"),
+		InstanceType("r3.8xlarge"),
+	}
+}
+
+// String returns the InstanceType as the string of a member.
+func (v InstanceType) String() string {
+	return string(v)
+}
+
+// ParseInstanceType returns the InstanceType of str, failing if it is not
+// one of the InstanceTypeValues.
+func ParseInstanceType(str string) (InstanceType, error) {
+	for _, v := range InstanceTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid InstanceType value %q", str)
+}
+
 // Describes an Internet gateway.
 type InternetGateway struct {
 	// Any VPCs attached to the Internet gateway.
@@ -11991,10 +14662,15 @@ type metadataInternetGateway struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InternetGateway.
+func (s InternetGateway) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the attachment of a VPC to an Internet gateway.
 type InternetGatewayAttachment struct {
 	// The current state of the attachment.
-	State *string `locationName:"state" type:"string"`
+	State *string `locationName:"state" type:"string" enum:"attaching,attached,detaching,detached"`
 
 	// The ID of the VPC.
 	VPCID *string `locationName:"vpcId" type:"string"`
@@ -12006,6 +14682,11 @@ type metadataInternetGatewayAttachment struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InternetGatewayAttachment.
+func (s InternetGatewayAttachment) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a key pair.
 type KeyPairInfo struct {
 	// If you used CreateKeyPair to create the key pair, this is the SHA-1 digest
@@ -12024,10 +14705,15 @@ type metadataKeyPairInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the KeyPairInfo.
+func (s KeyPairInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a launch permission.
 type LaunchPermission struct {
 	// The name of the group.
-	Group *string `locationName:"group" type:"string"`
+	Group *string `locationName:"group" type:"string" enum:"all"`
 
 	// The AWS account ID.
 	UserID *string `locationName:"userId" type:"string"`
@@ -12039,6 +14725,11 @@ type metadataLaunchPermission struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the LaunchPermission.
+func (s LaunchPermission) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a launch permission modification.
 type LaunchPermissionModifications struct {
 	// The AWS account ID to add to the list of launch permissions for the AMI.
@@ -12055,6 +14746,11 @@ type metadataLaunchPermissionModifications struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the LaunchPermissionModifications.
+func (s LaunchPermissionModifications) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the launch specification for an instance.
 type LaunchSpecification struct {
 	// Deprecated.
@@ -12079,7 +14775,7 @@ type LaunchSpecification struct {
 	ImageID *string `locationName:"imageId" type:"string"`
 
 	// The instance type.
-	InstanceType *string `locationName:"instanceType" type:"string"`
+	InstanceType *string `locationName:"instanceType" type:"string" enum:"t1.micro,m1.small,m1.medium,m1.large,m1.xlarge,m3.medium,m3.large,m3.xlarge,m3.2xlarge,t2.micro,t2.small,t2.medium,m2.xlarge,m2.2xlarge,m2.4xlarge,cr1.8xlarge,i2.xlarge,i2.2xlarge,i2.4xlarge,i2.8xlarge,hi1.4xlarge,hs1.8xlarge,c1.medium,c1.xlarge,c3.large,c3.xlarge,c3.2xlarge,c3.4xlarge,c3.8xlarge,c4.large,c4.xlarge,c4.2xlarge,c4.4xlarge,c4.8xlarge,cc1.4xlarge,cc2.8xlarge,g2.2xlarge,cg1.4xlarge,r3.large,r3.xlarge,r3.2xlarge,r3.4xlarge,r3.8xlarge"`
 
 	// The ID of the kernel.
 	KernelID *string `locationName:"kernelId" type:"string"`
@@ -12118,6 +14814,87 @@ type metadataLaunchSpecification struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the LaunchSpecification.
+func (s LaunchSpecification) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ListingState is an enum of the values of ListingState members.
+type ListingState string
+
+// The values of ListingState.
+const (
+	ListingStateAvailable ListingState = "available"
+	ListingStateSold      ListingState = "sold"
+	ListingStateCancelled ListingState = "cancelled"
+	ListingStatePending   ListingState = "pending"
+)
+
+// ListingStateValues returns the values of ListingState known to this
+// version of the SDK. The service may accept values added since.
+func ListingStateValues() []ListingState {
+	return []ListingState{
+		ListingState("available"),
+		ListingState("sold"),
+		ListingState("cancelled"),
+		ListingState("pending"),
+	}
+}
+
+// String returns the ListingState as the string of a member.
+func (v ListingState) String() string {
+	return string(v)
+}
+
+// ParseListingState returns the ListingState of str, failing if it is not
+// one of the ListingStateValues.
+func ParseListingState(str string) (ListingState, error) {
+	for _, v := range ListingStateValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ListingState value %q", str)
+}
+
+// ListingStatus is an enum of the values of ListingStatus members.
+type ListingStatus string
+
+// The values of ListingStatus.
+const (
+	ListingStatusActive    ListingStatus = "active"
+	ListingStatusPending   ListingStatus = "pending"
+	ListingStatusCancelled ListingStatus = "cancelled"
+	ListingStatusClosed    ListingStatus = "closed"
+)
+
+// ListingStatusValues returns the values of ListingStatus known to this
+// version of the SDK. The service may accept values added since.
+func ListingStatusValues() []ListingStatus {
+	return []ListingStatus{
+		ListingStatus("active"),
+		ListingStatus("pending"),
+		ListingStatus("cancelled"),
+		ListingStatus("closed"),
+	}
+}
+
+// String returns the ListingStatus as the string of a member.
+func (v ListingStatus) String() string {
+	return string(v)
+}
+
+// ParseListingStatus returns the ListingStatus of str, failing if it is not
+// one of the ListingStatusValues.
+func ParseListingStatus(str string) (ListingStatus, error) {
+	for _, v := range ListingStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ListingStatus value %q", str)
+}
+
 type ModifyImageAttributeInput struct {
 	// The name of the attribute to modify.
 	Attribute *string `type:"string"`
@@ -12158,6 +14935,11 @@ type metadataModifyImageAttributeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyImageAttributeInput.
+func (s ModifyImageAttributeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifyImageAttributeOutput struct {
 	metadataModifyImageAttributeOutput `json:"-", xml:"-"`
 }
@@ -12166,9 +14948,14 @@ type metadataModifyImageAttributeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyImageAttributeOutput.
+func (s ModifyImageAttributeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifyInstanceAttributeInput struct {
 	// The name of the attribute.
-	Attribute *string `locationName:"attribute" type:"string"`
+	Attribute *string `locationName:"attribute" type:"string" enum:"instanceType,kernel,ramdisk,userData,disableApiTermination,instanceInitiatedShutdownBehavior,rootDeviceName,blockDeviceMapping,productCodes,sourceDestCheck,groupSet,ebsOptimized,sriovNetSupport"`
 
 	// Modifies the DeleteOnTermination attribute for volumes that are currently
 	// attached. The volume must be owned by the caller. If no value is specified
@@ -12251,6 +15038,11 @@ type metadataModifyInstanceAttributeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyInstanceAttributeInput.
+func (s ModifyInstanceAttributeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifyInstanceAttributeOutput struct {
 	metadataModifyInstanceAttributeOutput `json:"-", xml:"-"`
 }
@@ -12259,6 +15051,11 @@ type metadataModifyInstanceAttributeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyInstanceAttributeOutput.
+func (s ModifyInstanceAttributeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifyNetworkInterfaceAttributeInput struct {
 	// Information about the interface attachment. If modifying the 'delete on termination'
 	// attribute, you must specify the ID of the interface attachment.
@@ -12292,6 +15089,11 @@ type metadataModifyNetworkInterfaceAttributeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyNetworkInterfaceAttributeInput.
+func (s ModifyNetworkInterfaceAttributeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifyNetworkInterfaceAttributeOutput struct {
 	metadataModifyNetworkInterfaceAttributeOutput `json:"-", xml:"-"`
 }
@@ -12300,6 +15102,11 @@ type metadataModifyNetworkInterfaceAttributeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyNetworkInterfaceAttributeOutput.
+func (s ModifyNetworkInterfaceAttributeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifyReservedInstancesInput struct {
 	// A unique, case-sensitive token you provide to ensure idempotency of your
 	// modification request.
@@ -12318,6 +15125,11 @@ type metadataModifyReservedInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyReservedInstancesInput.
+func (s ModifyReservedInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifyReservedInstancesOutput struct {
 	// The ID for the modification.
 	ReservedInstancesModificationID *string `locationName:"reservedInstancesModificationId" type:"string"`
@@ -12329,9 +15141,14 @@ type metadataModifyReservedInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyReservedInstancesOutput.
+func (s ModifyReservedInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifySnapshotAttributeInput struct {
 	// The snapshot attribute to modify.
-	Attribute *string `type:"string"`
+	Attribute *string `type:"string" enum:"productCodes,createVolumePermission"`
 
 	// A JSON representation of the snapshot attribute modification.
 	CreateVolumePermission *CreateVolumePermissionModifications `type:"structure"`
@@ -12357,6 +15174,11 @@ type metadataModifySnapshotAttributeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifySnapshotAttributeInput.
+func (s ModifySnapshotAttributeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifySnapshotAttributeOutput struct {
 	metadataModifySnapshotAttributeOutput `json:"-", xml:"-"`
 }
@@ -12365,6 +15187,11 @@ type metadataModifySnapshotAttributeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifySnapshotAttributeOutput.
+func (s ModifySnapshotAttributeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifySubnetAttributeInput struct {
 	// The value to use when a resource attribute accepts a Boolean value.
 	MapPublicIPOnLaunch *AttributeBooleanValue `locationName:"MapPublicIpOnLaunch" type:"structure"`
@@ -12379,6 +15206,11 @@ type metadataModifySubnetAttributeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifySubnetAttributeInput.
+func (s ModifySubnetAttributeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifySubnetAttributeOutput struct {
 	metadataModifySubnetAttributeOutput `json:"-", xml:"-"`
 }
@@ -12387,6 +15219,11 @@ type metadataModifySubnetAttributeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifySubnetAttributeOutput.
+func (s ModifySubnetAttributeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifyVPCAttributeInput struct {
 	// Indicates whether the instances launched in the VPC get DNS hostnames. If
 	// enabled, instances in the VPC get DNS hostnames; otherwise, they do not.
@@ -12411,6 +15248,11 @@ type metadataModifyVPCAttributeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyVPCAttributeInput.
+func (s ModifyVPCAttributeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifyVPCAttributeOutput struct {
 	metadataModifyVPCAttributeOutput `json:"-", xml:"-"`
 }
@@ -12419,6 +15261,11 @@ type metadataModifyVPCAttributeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyVPCAttributeOutput.
+func (s ModifyVPCAttributeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifyVolumeAttributeInput struct {
 	// Indicates whether the volume should be auto-enabled for I/O operations.
 	AutoEnableIO *AttributeBooleanValue `type:"structure"`
@@ -12435,6 +15282,11 @@ type metadataModifyVolumeAttributeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyVolumeAttributeInput.
+func (s ModifyVolumeAttributeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifyVolumeAttributeOutput struct {
 	metadataModifyVolumeAttributeOutput `json:"-", xml:"-"`
 }
@@ -12443,6 +15295,11 @@ type metadataModifyVolumeAttributeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyVolumeAttributeOutput.
+func (s ModifyVolumeAttributeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type MonitorInstancesInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -12456,6 +15313,11 @@ type metadataMonitorInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the MonitorInstancesInput.
+func (s MonitorInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type MonitorInstancesOutput struct {
 	// Monitoring information for one or more instances.
 	InstanceMonitorings []*InstanceMonitoring `locationName:"instancesSet" locationNameList:"item" type:"list"`
@@ -12467,10 +15329,15 @@ type metadataMonitorInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the MonitorInstancesOutput.
+func (s MonitorInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the monitoring for the instance.
 type Monitoring struct {
 	// Indicates whether monitoring is enabled for the instance.
-	State *string `locationName:"state" type:"string"`
+	State *string `locationName:"state" type:"string" enum:"disabled,enabled,pending"`
 
 	metadataMonitoring `json:"-", xml:"-"`
 }
@@ -12479,6 +15346,47 @@ type metadataMonitoring struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Monitoring.
+func (s Monitoring) String() string {
+	return awsutil.StringValue(s)
+}
+
+// MonitoringState is an enum of the values of MonitoringState members.
+type MonitoringState string
+
+// The values of MonitoringState.
+const (
+	MonitoringStateDisabled MonitoringState = "disabled"
+	MonitoringStateEnabled  MonitoringState = "enabled"
+	MonitoringStatePending  MonitoringState = "pending"
+)
+
+// MonitoringStateValues returns the values of MonitoringState known to this
+// version of the SDK. The service may accept values added since.
+func MonitoringStateValues() []MonitoringState {
+	return []MonitoringState{
+		MonitoringState("disabled"),
+		MonitoringState("enabled"),
+		MonitoringState("pending"),
+	}
+}
+
+// String returns the MonitoringState as the string of a member.
+func (v MonitoringState) String() string {
+	return string(v)
+}
+
+// ParseMonitoringState returns the MonitoringState of str, failing if it is not
+// one of the MonitoringStateValues.
+func ParseMonitoringState(str string) (MonitoringState, error) {
+	for _, v := range MonitoringStateValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid MonitoringState value %q", str)
+}
+
 // Describes a network ACL.
 type NetworkACL struct {
 	// Any associations between the network ACL and one or more subnets
@@ -12506,6 +15414,11 @@ type metadataNetworkACL struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the NetworkACL.
+func (s NetworkACL) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an association between a network ACL and a subnet.
 type NetworkACLAssociation struct {
 	// The ID of the association between a network ACL and a subnet.
@@ -12524,6 +15437,11 @@ type metadataNetworkACLAssociation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the NetworkACLAssociation.
+func (s NetworkACLAssociation) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an entry in a network ACL.
 type NetworkACLEntry struct {
 	// The network range to allow or deny, in CIDR notation.
@@ -12543,7 +15461,7 @@ type NetworkACLEntry struct {
 	Protocol *string `locationName:"protocol" type:"string"`
 
 	// Indicates whether to allow or deny the traffic that matches the rule.
-	RuleAction *string `locationName:"ruleAction" type:"string"`
+	RuleAction *string `locationName:"ruleAction" type:"string" enum:"allow,deny"`
 
 	// The rule number for the entry. ACL entries are processed in ascending order
 	// by rule number.
@@ -12556,6 +15474,11 @@ type metadataNetworkACLEntry struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the NetworkACLEntry.
+func (s NetworkACLEntry) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a network interface.
 type NetworkInterface struct {
 	// The association information for an Elastic IP associated with the network
@@ -12603,7 +15526,7 @@ type NetworkInterface struct {
 	SourceDestCheck *bool `locationName:"sourceDestCheck" type:"boolean"`
 
 	// The status of the network interface.
-	Status *string `locationName:"status" type:"string"`
+	Status *string `locationName:"status" type:"string" enum:"available,attaching,in-use,detaching"`
 
 	// The ID of the subnet.
 	SubnetID *string `locationName:"subnetId" type:"string"`
@@ -12621,6 +15544,11 @@ type metadataNetworkInterface struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the NetworkInterface.
+func (s NetworkInterface) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes association information for an Elastic IP address.
 type NetworkInterfaceAssociation struct {
 	// The allocation ID.
@@ -12645,6 +15573,11 @@ type metadataNetworkInterfaceAssociation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the NetworkInterfaceAssociation.
+func (s NetworkInterfaceAssociation) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a network interface attachment.
 type NetworkInterfaceAttachment struct {
 	// The timestamp indicating when the attachment initiated.
@@ -12666,7 +15599,7 @@ type NetworkInterfaceAttachment struct {
 	InstanceOwnerID *string `locationName:"instanceOwnerId" type:"string"`
 
 	// The attachment state.
-	Status *string `locationName:"status" type:"string"`
+	Status *string `locationName:"status" type:"string" enum:"attaching,attached,detaching,detached"`
 
 	metadataNetworkInterfaceAttachment `json:"-", xml:"-"`
 }
@@ -12675,6 +15608,11 @@ type metadataNetworkInterfaceAttachment struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the NetworkInterfaceAttachment.
+func (s NetworkInterfaceAttachment) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an attachment change.
 type NetworkInterfaceAttachmentChanges struct {
 	// The ID of the network interface attachment.
@@ -12690,6 +15628,49 @@ type metadataNetworkInterfaceAttachmentChanges struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the NetworkInterfaceAttachmentChanges.
+func (s NetworkInterfaceAttachmentChanges) String() string {
+	return awsutil.StringValue(s)
+}
+
+// NetworkInterfaceAttribute is an enum of the values of NetworkInterfaceAttribute members.
+type NetworkInterfaceAttribute string
+
+// The values of NetworkInterfaceAttribute.
+const (
+	NetworkInterfaceAttributeDescription     NetworkInterfaceAttribute = "description"
+	NetworkInterfaceAttributeGroupSet        NetworkInterfaceAttribute = "groupSet"
+	NetworkInterfaceAttributeSourceDestCheck NetworkInterfaceAttribute = "sourceDestCheck"
+	NetworkInterfaceAttributeAttachment      NetworkInterfaceAttribute = "attachment"
+)
+
+// NetworkInterfaceAttributeValues returns the values of NetworkInterfaceAttribute known to this
+// version of the SDK. The service may accept values added since.
+func NetworkInterfaceAttributeValues() []NetworkInterfaceAttribute {
+	return []NetworkInterfaceAttribute{
+		NetworkInterfaceAttribute("description"),
+		NetworkInterfaceAttribute("groupSet"),
+		NetworkInterfaceAttribute("sourceDestCheck"),
+		NetworkInterfaceAttribute("attachment"),
+	}
+}
+
+// String returns the NetworkInterfaceAttribute as the string of a member.
+func (v NetworkInterfaceAttribute) String() string {
+	return string(v)
+}
+
+// ParseNetworkInterfaceAttribute returns the NetworkInterfaceAttribute of str, failing if it is not
+// one of the NetworkInterfaceAttributeValues.
+func ParseNetworkInterfaceAttribute(str string) (NetworkInterfaceAttribute, error) {
+	for _, v := range NetworkInterfaceAttributeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid NetworkInterfaceAttribute value %q", str)
+}
+
 // Describes the private IP address of a network interface.
 type NetworkInterfacePrivateIPAddress struct {
 	// The association information for an Elastic IP address associated with the
@@ -12713,6 +15694,49 @@ type metadataNetworkInterfacePrivateIPAddress struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the NetworkInterfacePrivateIPAddress.
+func (s NetworkInterfacePrivateIPAddress) String() string {
+	return awsutil.StringValue(s)
+}
+
+// NetworkInterfaceStatus is an enum of the values of NetworkInterfaceStatus members.
+type NetworkInterfaceStatus string
+
+// The values of NetworkInterfaceStatus.
+const (
+	NetworkInterfaceStatusAvailable NetworkInterfaceStatus = "available"
+	NetworkInterfaceStatusAttaching NetworkInterfaceStatus = "attaching"
+	NetworkInterfaceStatusInUse     NetworkInterfaceStatus = "in-use"
+	NetworkInterfaceStatusDetaching NetworkInterfaceStatus = "detaching"
+)
+
+// NetworkInterfaceStatusValues returns the values of NetworkInterfaceStatus known to this
+// version of the SDK. The service may accept values added since.
+func NetworkInterfaceStatusValues() []NetworkInterfaceStatus {
+	return []NetworkInterfaceStatus{
+		NetworkInterfaceStatus("available"),
+		NetworkInterfaceStatus("attaching"),
+		NetworkInterfaceStatus("in-use"),
+		NetworkInterfaceStatus("detaching"),
+	}
+}
+
+// String returns the NetworkInterfaceStatus as the string of a member.
+func (v NetworkInterfaceStatus) String() string {
+	return string(v)
+}
+
+// ParseNetworkInterfaceStatus returns the NetworkInterfaceStatus of str, failing if it is not
+// one of the NetworkInterfaceStatusValues.
+func ParseNetworkInterfaceStatus(str string) (NetworkInterfaceStatus, error) {
+	for _, v := range NetworkInterfaceStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid NetworkInterfaceStatus value %q", str)
+}
+
 type NewDHCPConfiguration struct {
 	Key *string `locationName:"key" type:"string"`
 
@@ -12725,6 +15749,85 @@ type metadataNewDHCPConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the NewDHCPConfiguration.
+func (s NewDHCPConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
+// OfferingTypeValues is an enum of the values of OfferingTypeValues members.
+type OfferingTypeValues string
+
+// The values of OfferingTypeValues.
+const (
+	OfferingTypeValuesHeavyUtilization  OfferingTypeValues = "Heavy Utilization"
+	OfferingTypeValuesMediumUtilization OfferingTypeValues = "Medium Utilization"
+	OfferingTypeValuesLightUtilization  OfferingTypeValues = "Light Utilization"
+	OfferingTypeValuesNoUpfront         OfferingTypeValues = "No Upfront"
+	OfferingTypeValuesPartialUpfront    OfferingTypeValues = "Partial Upfront"
+	OfferingTypeValuesAllUpfront        OfferingTypeValues = "All Upfront"
+)
+
+// OfferingTypeValuesValues returns the values of OfferingTypeValues known to this
+// version of the SDK. The service may accept values added since.
+func OfferingTypeValuesValues() []OfferingTypeValues {
+	return []OfferingTypeValues{
+		OfferingTypeValues("Heavy Utilization"),
+		OfferingTypeValues("Medium Utilization"),
+		OfferingTypeValues("Light Utilization"),
+		OfferingTypeValues("No Upfront"),
+		OfferingTypeValues("Partial Upfront"),
+		OfferingTypeValues("All Upfront"),
+	}
+}
+
+// String returns the OfferingTypeValues as the string of a member.
+func (v OfferingTypeValues) String() string {
+	return string(v)
+}
+
+// ParseOfferingTypeValues returns the OfferingTypeValues of str, failing if it is not
+// one of the OfferingTypeValuesValues.
+func ParseOfferingTypeValues(str string) (OfferingTypeValues, error) {
+	for _, v := range OfferingTypeValuesValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid OfferingTypeValues value %q", str)
+}
+
+// PermissionGroup is an enum of the values of PermissionGroup members.
+type PermissionGroup string
+
+// The values of PermissionGroup.
+const (
+	PermissionGroupAll PermissionGroup = "all"
+)
+
+// PermissionGroupValues returns the values of PermissionGroup known to this
+// version of the SDK. The service may accept values added since.
+func PermissionGroupValues() []PermissionGroup {
+	return []PermissionGroup{
+		PermissionGroup("all"),
+	}
+}
+
+// String returns the PermissionGroup as the string of a member.
+func (v PermissionGroup) String() string {
+	return string(v)
+}
+
+// ParsePermissionGroup returns the PermissionGroup of str, failing if it is not
+// one of the PermissionGroupValues.
+func ParsePermissionGroup(str string) (PermissionGroup, error) {
+	for _, v := range PermissionGroupValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid PermissionGroup value %q", str)
+}
+
 // Describes the placement for the instance.
 type Placement struct {
 	// The Availability Zone of the instance.
@@ -12735,7 +15838,7 @@ type Placement struct {
 
 	// The tenancy of the instance (if the instance is running in a VPC). An instance
 	// with a tenancy of dedicated runs on single-tenant hardware.
-	Tenancy *string `locationName:"tenancy" type:"string"`
+	Tenancy *string `locationName:"tenancy" type:"string" enum:"default,dedicated"`
 
 	metadataPlacement `json:"-", xml:"-"`
 }
@@ -12744,16 +15847,21 @@ type metadataPlacement struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Placement.
+func (s Placement) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a placement group.
 type PlacementGroup struct {
 	// The name of the placement group.
 	GroupName *string `locationName:"groupName" type:"string"`
 
 	// The state of the placement group.
-	State *string `locationName:"state" type:"string"`
+	State *string `locationName:"state" type:"string" enum:"pending,available,deleting,deleted"`
 
 	// The placement strategy.
-	Strategy *string `locationName:"strategy" type:"string"`
+	Strategy *string `locationName:"strategy" type:"string" enum:"cluster"`
 
 	metadataPlacementGroup `json:"-", xml:"-"`
 }
@@ -12762,6 +15870,113 @@ type metadataPlacementGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PlacementGroup.
+func (s PlacementGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
+// PlacementGroupState is an enum of the values of PlacementGroupState members.
+type PlacementGroupState string
+
+// The values of PlacementGroupState.
+const (
+	PlacementGroupStatePending   PlacementGroupState = "pending"
+	PlacementGroupStateAvailable PlacementGroupState = "available"
+	PlacementGroupStateDeleting  PlacementGroupState = "deleting"
+	PlacementGroupStateDeleted   PlacementGroupState = "deleted"
+)
+
+// PlacementGroupStateValues returns the values of PlacementGroupState known to this
+// version of the SDK. The service may accept values added since.
+func PlacementGroupStateValues() []PlacementGroupState {
+	return []PlacementGroupState{
+		PlacementGroupState("pending"),
+		PlacementGroupState("available"),
+		PlacementGroupState("deleting"),
+		PlacementGroupState("deleted"),
+	}
+}
+
+// String returns the PlacementGroupState as the string of a member.
+func (v PlacementGroupState) String() string {
+	return string(v)
+}
+
+// ParsePlacementGroupState returns the PlacementGroupState of str, failing if it is not
+// one of the PlacementGroupStateValues.
+func ParsePlacementGroupState(str string) (PlacementGroupState, error) {
+	for _, v := range PlacementGroupStateValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid PlacementGroupState value %q", str)
+}
+
+// PlacementStrategy is an enum of the values of PlacementStrategy members.
+type PlacementStrategy string
+
+// The values of PlacementStrategy.
+const (
+	PlacementStrategyCluster PlacementStrategy = "cluster"
+)
+
+// PlacementStrategyValues returns the values of PlacementStrategy known to this
+// version of the SDK. The service may accept values added since.
+func PlacementStrategyValues() []PlacementStrategy {
+	return []PlacementStrategy{
+		PlacementStrategy("cluster"),
+	}
+}
+
+// String returns the PlacementStrategy as the string of a member.
+func (v PlacementStrategy) String() string {
+	return string(v)
+}
+
+// ParsePlacementStrategy returns the PlacementStrategy of str, failing if it is not
+// one of the PlacementStrategyValues.
+func ParsePlacementStrategy(str string) (PlacementStrategy, error) {
+	for _, v := range PlacementStrategyValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid PlacementStrategy value %q", str)
+}
+
+// PlatformValues is an enum of the values of PlatformValues members.
+type PlatformValues string
+
+// The values of PlatformValues.
+const (
+	PlatformValuesWindows PlatformValues = "Windows"
+)
+
+// PlatformValuesValues returns the values of PlatformValues known to this
+// version of the SDK. The service may accept values added since.
+func PlatformValuesValues() []PlatformValues {
+	return []PlatformValues{
+		PlatformValues("Windows"),
+	}
+}
+
+// String returns the PlatformValues as the string of a member.
+func (v PlatformValues) String() string {
+	return string(v)
+}
+
+// ParsePlatformValues returns the PlatformValues of str, failing if it is not
+// one of the PlatformValuesValues.
+func ParsePlatformValues(str string) (PlatformValues, error) {
+	for _, v := range PlatformValuesValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid PlatformValues value %q", str)
+}
+
 // Describes a range of ports.
 type PortRange struct {
 	// The first port in the range.
@@ -12777,6 +15992,11 @@ type metadataPortRange struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PortRange.
+func (s PortRange) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the price for a Reserved Instance.
 type PriceSchedule struct {
 	// The current price schedule, as determined by the term remaining for the Reserved
@@ -12793,7 +16013,7 @@ type PriceSchedule struct {
 
 	// The currency for transacting the Reserved Instance resale. At this time,
 	// the only supported currency is USD.
-	CurrencyCode *string `locationName:"currencyCode" type:"string"`
+	CurrencyCode *string `locationName:"currencyCode" type:"string" enum:"USD"`
 
 	// The fixed price for the term.
 	Price *float64 `locationName:"price" type:"double"`
@@ -12809,11 +16029,16 @@ type metadataPriceSchedule struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PriceSchedule.
+func (s PriceSchedule) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the price for a Reserved Instance.
 type PriceScheduleSpecification struct {
 	// The currency for transacting the Reserved Instance resale. At this time,
 	// the only supported currency is USD.
-	CurrencyCode *string `locationName:"currencyCode" type:"string"`
+	CurrencyCode *string `locationName:"currencyCode" type:"string" enum:"USD"`
 
 	// The fixed price for the term.
 	Price *float64 `locationName:"price" type:"double"`
@@ -12829,6 +16054,11 @@ type metadataPriceScheduleSpecification struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PriceScheduleSpecification.
+func (s PriceScheduleSpecification) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a Reserved Instance offering.
 type PricingDetail struct {
 	// The number of instances available for the price.
@@ -12844,6 +16074,11 @@ type metadataPricingDetail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PricingDetail.
+func (s PricingDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a secondary private IP address for a network interface.
 type PrivateIPAddressSpecification struct {
 	// Indicates whether the private IP address is the primary private IP address.
@@ -12860,13 +16095,18 @@ type metadataPrivateIPAddressSpecification struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PrivateIPAddressSpecification.
+func (s PrivateIPAddressSpecification) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a product code.
 type ProductCode struct {
 	// The product code.
 	ProductCodeID *string `locationName:"productCode" type:"string"`
 
 	// The type of product code.
-	ProductCodeType *string `locationName:"type" type:"string"`
+	ProductCodeType *string `locationName:"type" type:"string" enum:"devpay,marketplace"`
 
 	metadataProductCode `json:"-", xml:"-"`
 }
@@ -12875,6 +16115,45 @@ type metadataProductCode struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ProductCode.
+func (s ProductCode) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ProductCodeValues is an enum of the values of ProductCodeValues members.
+type ProductCodeValues string
+
+// The values of ProductCodeValues.
+const (
+	ProductCodeValuesDevpay      ProductCodeValues = "devpay"
+	ProductCodeValuesMarketplace ProductCodeValues = "marketplace"
+)
+
+// ProductCodeValuesValues returns the values of ProductCodeValues known to this
+// version of the SDK. The service may accept values added since.
+func ProductCodeValuesValues() []ProductCodeValues {
+	return []ProductCodeValues{
+		ProductCodeValues("devpay"),
+		ProductCodeValues("marketplace"),
+	}
+}
+
+// String returns the ProductCodeValues as the string of a member.
+func (v ProductCodeValues) String() string {
+	return string(v)
+}
+
+// ParseProductCodeValues returns the ProductCodeValues of str, failing if it is not
+// one of the ProductCodeValuesValues.
+func ParseProductCodeValues(str string) (ProductCodeValues, error) {
+	for _, v := range ProductCodeValuesValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ProductCodeValues value %q", str)
+}
+
 // Describes a virtual private gateway propagating route.
 type PropagatingVGW struct {
 	// The ID of the virtual private gateway (VGW).
@@ -12887,6 +16166,11 @@ type metadataPropagatingVGW struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PropagatingVGW.
+func (s PropagatingVGW) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PurchaseReservedInstancesOfferingInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -12908,6 +16192,11 @@ type metadataPurchaseReservedInstancesOfferingInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PurchaseReservedInstancesOfferingInput.
+func (s PurchaseReservedInstancesOfferingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PurchaseReservedInstancesOfferingOutput struct {
 	// The IDs of the purchased Reserved Instances.
 	ReservedInstancesID *string `locationName:"reservedInstancesId" type:"string"`
@@ -12919,6 +16208,49 @@ type metadataPurchaseReservedInstancesOfferingOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PurchaseReservedInstancesOfferingOutput.
+func (s PurchaseReservedInstancesOfferingOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// RIProductDescription is an enum of the values of RIProductDescription members.
+type RIProductDescription string
+
+// The values of RIProductDescription.
+const (
+	RIProductDescriptionLinuxUNIX          RIProductDescription = "Linux/UNIX"
+	RIProductDescriptionLinuxUNIXAmazonVPC RIProductDescription = "Linux/UNIX (Amazon VPC)"
+	RIProductDescriptionWindows            RIProductDescription = "Windows"
+	RIProductDescriptionWindowsAmazonVPC   RIProductDescription = "Windows (Amazon VPC)"
+)
+
+// RIProductDescriptionValues returns the values of RIProductDescription known to this
+// version of the SDK. The service may accept values added since.
+func RIProductDescriptionValues() []RIProductDescription {
+	return []RIProductDescription{
+		RIProductDescription("Linux/UNIX"),
+		RIProductDescription("Linux/UNIX (Amazon VPC)"),
+		RIProductDescription("Windows"),
+		RIProductDescription("Windows (Amazon VPC)"),
+	}
+}
+
+// String returns the RIProductDescription as the string of a member.
+func (v RIProductDescription) String() string {
+	return string(v)
+}
+
+// ParseRIProductDescription returns the RIProductDescription of str, failing if it is not
+// one of the RIProductDescriptionValues.
+func ParseRIProductDescription(str string) (RIProductDescription, error) {
+	for _, v := range RIProductDescriptionValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid RIProductDescription value %q", str)
+}
+
 type RebootInstancesInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -12932,6 +16264,11 @@ type metadataRebootInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RebootInstancesInput.
+func (s RebootInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RebootInstancesOutput struct {
 	metadataRebootInstancesOutput `json:"-", xml:"-"`
 }
@@ -12940,13 +16277,18 @@ type metadataRebootInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RebootInstancesOutput.
+func (s RebootInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a recurring charge.
 type RecurringCharge struct {
 	// The amount of the recurring charge.
 	Amount *float64 `locationName:"amount" type:"double"`
 
 	// The frequency of the recurring charge.
-	Frequency *string `locationName:"frequency" type:"string"`
+	Frequency *string `locationName:"frequency" type:"string" enum:"Hourly"`
 
 	metadataRecurringCharge `json:"-", xml:"-"`
 }
@@ -12955,6 +16297,43 @@ type metadataRecurringCharge struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RecurringCharge.
+func (s RecurringCharge) String() string {
+	return awsutil.StringValue(s)
+}
+
+// RecurringChargeFrequency is an enum of the values of RecurringChargeFrequency members.
+type RecurringChargeFrequency string
+
+// The values of RecurringChargeFrequency.
+const (
+	RecurringChargeFrequencyHourly RecurringChargeFrequency = "Hourly"
+)
+
+// RecurringChargeFrequencyValues returns the values of RecurringChargeFrequency known to this
+// version of the SDK. The service may accept values added since.
+func RecurringChargeFrequencyValues() []RecurringChargeFrequency {
+	return []RecurringChargeFrequency{
+		RecurringChargeFrequency("Hourly"),
+	}
+}
+
+// String returns the RecurringChargeFrequency as the string of a member.
+func (v RecurringChargeFrequency) String() string {
+	return string(v)
+}
+
+// ParseRecurringChargeFrequency returns the RecurringChargeFrequency of str, failing if it is not
+// one of the RecurringChargeFrequencyValues.
+func ParseRecurringChargeFrequency(str string) (RecurringChargeFrequency, error) {
+	for _, v := range RecurringChargeFrequencyValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid RecurringChargeFrequency value %q", str)
+}
+
 // Describes a region.
 type Region struct {
 	// The region service endpoint.
@@ -12970,12 +16349,17 @@ type metadataRegion struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Region.
+func (s Region) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RegisterImageInput struct {
 	// The architecture of the AMI.
 	//
 	// Default: For Amazon EBS-backed AMIs, i386. For instance store-backed AMIs,
 	// the architecture specified in the manifest file.
-	Architecture *string `locationName:"architecture" type:"string"`
+	Architecture *string `locationName:"architecture" type:"string" enum:"i386,x86_64"`
 
 	// One or more block device mapping entries.
 	BlockDeviceMappings []*BlockDeviceMapping `locationName:"BlockDeviceMapping" locationNameList:"BlockDeviceMapping" type:"list"`
@@ -13025,6 +16409,11 @@ type metadataRegisterImageInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RegisterImageInput.
+func (s RegisterImageInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RegisterImageOutput struct {
 	// The ID of the newly registered AMI.
 	ImageID *string `locationName:"imageId" type:"string"`
@@ -13036,6 +16425,11 @@ type metadataRegisterImageOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RegisterImageOutput.
+func (s RegisterImageOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RejectVPCPeeringConnectionInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -13049,6 +16443,11 @@ type metadataRejectVPCPeeringConnectionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RejectVPCPeeringConnectionInput.
+func (s RejectVPCPeeringConnectionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RejectVPCPeeringConnectionOutput struct {
 	// Returns true if the request succeeds; otherwise, it returns an error.
 	Return *bool `locationName:"return" type:"boolean"`
@@ -13060,6 +16459,11 @@ type metadataRejectVPCPeeringConnectionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RejectVPCPeeringConnectionOutput.
+func (s RejectVPCPeeringConnectionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ReleaseAddressInput struct {
 	// [EC2-VPC] The allocation ID. Required for EC2-VPC.
 	AllocationID *string `locationName:"AllocationId" type:"string"`
@@ -13076,6 +16480,11 @@ type metadataReleaseAddressInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReleaseAddressInput.
+func (s ReleaseAddressInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ReleaseAddressOutput struct {
 	metadataReleaseAddressOutput `json:"-", xml:"-"`
 }
@@ -13084,6 +16493,11 @@ type metadataReleaseAddressOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReleaseAddressOutput.
+func (s ReleaseAddressOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ReplaceNetworkACLAssociationInput struct {
 	// The ID of the current association between the original network ACL and the
 	// subnet.
@@ -13101,6 +16515,11 @@ type metadataReplaceNetworkACLAssociationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReplaceNetworkACLAssociationInput.
+func (s ReplaceNetworkACLAssociationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ReplaceNetworkACLAssociationOutput struct {
 	// The ID of the new association.
 	NewAssociationID *string `locationName:"newAssociationId" type:"string"`
@@ -13112,6 +16531,11 @@ type metadataReplaceNetworkACLAssociationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReplaceNetworkACLAssociationOutput.
+func (s ReplaceNetworkACLAssociationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ReplaceNetworkACLEntryInput struct {
 	// The network range to allow or deny, in CIDR notation.
 	CIDRBlock *string `locationName:"cidrBlock" type:"string" required:"true"`
@@ -13138,7 +16562,7 @@ type ReplaceNetworkACLEntryInput struct {
 	Protocol *string `locationName:"protocol" type:"string" required:"true"`
 
 	// Indicates whether to allow or deny the traffic that matches the rule.
-	RuleAction *string `locationName:"ruleAction" type:"string" required:"true"`
+	RuleAction *string `locationName:"ruleAction" type:"string" enum:"allow,deny" required:"true"`
 
 	// The rule number of the entry to replace.
 	RuleNumber *int64 `locationName:"ruleNumber" type:"integer" required:"true"`
@@ -13150,6 +16574,11 @@ type metadataReplaceNetworkACLEntryInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReplaceNetworkACLEntryInput.
+func (s ReplaceNetworkACLEntryInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ReplaceNetworkACLEntryOutput struct {
 	metadataReplaceNetworkACLEntryOutput `json:"-", xml:"-"`
 }
@@ -13158,6 +16587,11 @@ type metadataReplaceNetworkACLEntryOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReplaceNetworkACLEntryOutput.
+func (s ReplaceNetworkACLEntryOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ReplaceRouteInput struct {
 	// The CIDR address block used for the destination match. The value you provide
 	// must match the CIDR of an existing route in the table.
@@ -13187,6 +16621,11 @@ type metadataReplaceRouteInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReplaceRouteInput.
+func (s ReplaceRouteInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ReplaceRouteOutput struct {
 	metadataReplaceRouteOutput `json:"-", xml:"-"`
 }
@@ -13195,6 +16634,11 @@ type metadataReplaceRouteOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReplaceRouteOutput.
+func (s ReplaceRouteOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ReplaceRouteTableAssociationInput struct {
 	// The association ID.
 	AssociationID *string `locationName:"associationId" type:"string" required:"true"`
@@ -13211,6 +16655,11 @@ type metadataReplaceRouteTableAssociationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReplaceRouteTableAssociationInput.
+func (s ReplaceRouteTableAssociationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ReplaceRouteTableAssociationOutput struct {
 	// The ID of the new association.
 	NewAssociationID *string `locationName:"newAssociationId" type:"string"`
@@ -13222,6 +16671,59 @@ type metadataReplaceRouteTableAssociationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReplaceRouteTableAssociationOutput.
+func (s ReplaceRouteTableAssociationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ReportInstanceReasonCodes is an enum of the values of ReportInstanceReasonCodes members.
+type ReportInstanceReasonCodes string
+
+// The values of ReportInstanceReasonCodes.
+const (
+	ReportInstanceReasonCodesInstanceStuckInState     ReportInstanceReasonCodes = "instance-stuck-in-state"
+	ReportInstanceReasonCodesUnresponsive             ReportInstanceReasonCodes = "unresponsive"
+	ReportInstanceReasonCodesNotAcceptingCredentials  ReportInstanceReasonCodes = "not-accepting-credentials"
+	ReportInstanceReasonCodesPasswordNotAvailable     ReportInstanceReasonCodes = "password-not-available"
+	ReportInstanceReasonCodesPerformanceNetwork       ReportInstanceReasonCodes = "performance-network"
+	ReportInstanceReasonCodesPerformanceInstanceStore ReportInstanceReasonCodes = "performance-instance-store"
+	ReportInstanceReasonCodesPerformanceEbsVolume     ReportInstanceReasonCodes = "performance-ebs-volume"
+	ReportInstanceReasonCodesPerformanceOther         ReportInstanceReasonCodes = "performance-other"
+	ReportInstanceReasonCodesOther                    ReportInstanceReasonCodes = "other"
+)
+
+// ReportInstanceReasonCodesValues returns the values of ReportInstanceReasonCodes known to this
+// version of the SDK. The service may accept values added since.
+func ReportInstanceReasonCodesValues() []ReportInstanceReasonCodes {
+	return []ReportInstanceReasonCodes{
+		ReportInstanceReasonCodes("instance-stuck-in-state"),
+		ReportInstanceReasonCodes("unresponsive"),
+		ReportInstanceReasonCodes("not-accepting-credentials"),
+		ReportInstanceReasonCodes("password-not-available"),
+		ReportInstanceReasonCodes("performance-network"),
+		ReportInstanceReasonCodes("performance-instance-store"),
+		ReportInstanceReasonCodes("performance-ebs-volume"),
+		ReportInstanceReasonCodes("performance-other"),
+		ReportInstanceReasonCodes("other"),
+	}
+}
+
+// String returns the ReportInstanceReasonCodes as the string of a member.
+func (v ReportInstanceReasonCodes) String() string {
+	return string(v)
+}
+
+// ParseReportInstanceReasonCodes returns the ReportInstanceReasonCodes of str, failing if it is not
+// one of the ReportInstanceReasonCodesValues.
+func ParseReportInstanceReasonCodes(str string) (ReportInstanceReasonCodes, error) {
+	for _, v := range ReportInstanceReasonCodesValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ReportInstanceReasonCodes value %q", str)
+}
+
 type ReportInstanceStatusInput struct {
 	// Descriptive text about the health state of your instance.
 	Description *string `locationName:"description" type:"string"`
@@ -13262,7 +16764,7 @@ type ReportInstanceStatusInput struct {
 	StartTime *time.Time `locationName:"startTime" type:"timestamp" timestampFormat:"iso8601"`
 
 	// The status of all instances listed.
-	Status *string `locationName:"status" type:"string" required:"true"`
+	Status *string `locationName:"status" type:"string" enum:"ok,impaired" required:"true"`
 
 	metadataReportInstanceStatusInput `json:"-", xml:"-"`
 }
@@ -13271,6 +16773,11 @@ type metadataReportInstanceStatusInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReportInstanceStatusInput.
+func (s ReportInstanceStatusInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ReportInstanceStatusOutput struct {
 	metadataReportInstanceStatusOutput `json:"-", xml:"-"`
 }
@@ -13279,15 +16786,54 @@ type metadataReportInstanceStatusOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type RequestSpotInstancesInput struct {
-	// The user-specified name for a logical grouping of bids.
-	//
-	// When you specify an Availability Zone group in a Spot Instance request,
-	// all Spot Instances in the request are launched in the same Availability Zone.
-	// Instance proximity is maintained with this parameter, but the choice of Availability
-	// Zone is not. The group applies only to bids for Spot Instances of the same
-	// instance type. Any additional Spot Instance requests that are specified with
-	// the same Availability Zone group name are launched in that same Availability
+// String returns the string representation of the ReportInstanceStatusOutput.
+func (s ReportInstanceStatusOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ReportStatusType is an enum of the values of ReportStatusType members.
+type ReportStatusType string
+
+// The values of ReportStatusType.
+const (
+	ReportStatusTypeOk       ReportStatusType = "ok"
+	ReportStatusTypeImpaired ReportStatusType = "impaired"
+)
+
+// ReportStatusTypeValues returns the values of ReportStatusType known to this
+// version of the SDK. The service may accept values added since.
+func ReportStatusTypeValues() []ReportStatusType {
+	return []ReportStatusType{
+		ReportStatusType("ok"),
+		ReportStatusType("impaired"),
+	}
+}
+
+// String returns the ReportStatusType as the string of a member.
+func (v ReportStatusType) String() string {
+	return string(v)
+}
+
+// ParseReportStatusType returns the ReportStatusType of str, failing if it is not
+// one of the ReportStatusTypeValues.
+func ParseReportStatusType(str string) (ReportStatusType, error) {
+	for _, v := range ReportStatusTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ReportStatusType value %q", str)
+}
+
+type RequestSpotInstancesInput struct {
+	// The user-specified name for a logical grouping of bids.
+	//
+	// When you specify an Availability Zone group in a Spot Instance request,
+	// all Spot Instances in the request are launched in the same Availability Zone.
+	// Instance proximity is maintained with this parameter, but the choice of Availability
+	// Zone is not. The group applies only to bids for Spot Instances of the same
+	// instance type. Any additional Spot Instance requests that are specified with
+	// the same Availability Zone group name are launched in that same Availability
 	// Zone, as long as at least one instance from the group is still active.
 	//
 	// If there is no active instance running in the Availability Zone group that
@@ -13324,7 +16870,7 @@ type RequestSpotInstancesInput struct {
 	// The Spot Instance request type.
 	//
 	// Default: one-time
-	Type *string `locationName:"type" type:"string"`
+	Type *string `locationName:"type" type:"string" enum:"one-time,persistent"`
 
 	// The start date of the request. If this is a one-time request, the request
 	// becomes active at this date and time and remains active until all instances
@@ -13350,6 +16896,11 @@ type metadataRequestSpotInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RequestSpotInstancesInput.
+func (s RequestSpotInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RequestSpotInstancesOutput struct {
 	// One or more Spot Instance requests.
 	SpotInstanceRequests []*SpotInstanceRequest `locationName:"spotInstanceRequestSet" locationNameList:"item" type:"list"`
@@ -13361,6 +16912,11 @@ type metadataRequestSpotInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RequestSpotInstancesOutput.
+func (s RequestSpotInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the launch specification for an instance.
 type RequestSpotLaunchSpecification struct {
 	// Deprecated.
@@ -13385,7 +16941,7 @@ type RequestSpotLaunchSpecification struct {
 	ImageID *string `locationName:"imageId" type:"string"`
 
 	// The instance type.
-	InstanceType *string `locationName:"instanceType" type:"string"`
+	InstanceType *string `locationName:"instanceType" type:"string" enum:"t1.micro,m1.small,m1.medium,m1.large,m1.xlarge,m3.medium,m3.large,m3.xlarge,m3.2xlarge,t2.micro,t2.small,t2.medium,m2.xlarge,m2.2xlarge,m2.4xlarge,cr1.8xlarge,i2.xlarge,i2.2xlarge,i2.4xlarge,i2.8xlarge,hi1.4xlarge,hs1.8xlarge,c1.medium,c1.xlarge,c3.large,c3.xlarge,c3.2xlarge,c3.4xlarge,c3.8xlarge,c4.large,c4.xlarge,c4.2xlarge,c4.4xlarge,c4.8xlarge,cc1.4xlarge,cc2.8xlarge,g2.2xlarge,cg1.4xlarge,r3.large,r3.xlarge,r3.2xlarge,r3.4xlarge,r3.8xlarge"`
 
 	// The ID of the kernel.
 	KernelID *string `locationName:"kernelId" type:"string"`
@@ -13422,6 +16978,11 @@ type metadataRequestSpotLaunchSpecification struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RequestSpotLaunchSpecification.
+func (s RequestSpotLaunchSpecification) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a reservation.
 type Reservation struct {
 	// One or more security groups.
@@ -13447,6 +17008,11 @@ type metadataReservation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Reservation.
+func (s Reservation) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the limit price of a Reserved Instance offering.
 type ReservedInstanceLimitPrice struct {
 	// Used for Reserved Instance Marketplace offerings. Specifies the limit price
@@ -13455,7 +17021,7 @@ type ReservedInstanceLimitPrice struct {
 
 	// The currency in which the limitPrice amount is specified. At this time, the
 	// only supported currency is USD.
-	CurrencyCode *string `locationName:"currencyCode" type:"string"`
+	CurrencyCode *string `locationName:"currencyCode" type:"string" enum:"USD"`
 
 	metadataReservedInstanceLimitPrice `json:"-", xml:"-"`
 }
@@ -13464,6 +17030,49 @@ type metadataReservedInstanceLimitPrice struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReservedInstanceLimitPrice.
+func (s ReservedInstanceLimitPrice) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ReservedInstanceState is an enum of the values of ReservedInstanceState members.
+type ReservedInstanceState string
+
+// The values of ReservedInstanceState.
+const (
+	ReservedInstanceStatePaymentPending ReservedInstanceState = "payment-pending"
+	ReservedInstanceStateActive         ReservedInstanceState = "active"
+	ReservedInstanceStatePaymentFailed  ReservedInstanceState = "payment-failed"
+	ReservedInstanceStateRetired        ReservedInstanceState = "retired"
+)
+
+// ReservedInstanceStateValues returns the values of ReservedInstanceState known to this
+// version of the SDK. The service may accept values added since.
+func ReservedInstanceStateValues() []ReservedInstanceState {
+	return []ReservedInstanceState{
+		ReservedInstanceState("payment-pending"),
+		ReservedInstanceState("active"),
+		ReservedInstanceState("payment-failed"),
+		ReservedInstanceState("retired"),
+	}
+}
+
+// String returns the ReservedInstanceState as the string of a member.
+func (v ReservedInstanceState) String() string {
+	return string(v)
+}
+
+// ParseReservedInstanceState returns the ReservedInstanceState of str, failing if it is not
+// one of the ReservedInstanceStateValues.
+func ParseReservedInstanceState(str string) (ReservedInstanceState, error) {
+	for _, v := range ReservedInstanceStateValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ReservedInstanceState value %q", str)
+}
+
 // Describes a Reserved Instance.
 type ReservedInstances struct {
 	// The Availability Zone in which the Reserved Instance can be used.
@@ -13471,7 +17080,7 @@ type ReservedInstances struct {
 
 	// The currency of the Reserved Instance. It's specified using ISO 4217 standard
 	// currency codes. At this time, the only supported currency is USD.
-	CurrencyCode *string `locationName:"currencyCode" type:"string"`
+	CurrencyCode *string `locationName:"currencyCode" type:"string" enum:"USD"`
 
 	// The duration of the Reserved Instance, in seconds.
 	Duration *int64 `locationName:"duration" type:"long"`
@@ -13486,16 +17095,16 @@ type ReservedInstances struct {
 	InstanceCount *int64 `locationName:"instanceCount" type:"integer"`
 
 	// The tenancy of the reserved instance.
-	InstanceTenancy *string `locationName:"instanceTenancy" type:"string"`
+	InstanceTenancy *string `locationName:"instanceTenancy" type:"string" enum:"default,dedicated"`
 
 	// The instance type on which the Reserved Instance can be used.
-	InstanceType *string `locationName:"instanceType" type:"string"`
+	InstanceType *string `locationName:"instanceType" type:"string" enum:"t1.micro,m1.small,m1.medium,m1.large,m1.xlarge,m3.medium,m3.large,m3.xlarge,m3.2xlarge,t2.micro,t2.small,t2.medium,m2.xlarge,m2.2xlarge,m2.4xlarge,cr1.8xlarge,i2.xlarge,i2.2xlarge,i2.4xlarge,i2.8xlarge,hi1.4xlarge,hs1.8xlarge,c1.medium,c1.xlarge,c3.large,c3.xlarge,c3.2xlarge,c3.4xlarge,c3.8xlarge,c4.large,c4.xlarge,c4.2xlarge,c4.4xlarge,c4.8xlarge,cc1.4xlarge,cc2.8xlarge,g2.2xlarge,cg1.4xlarge,r3.large,r3.xlarge,r3.2xlarge,r3.4xlarge,r3.8xlarge"`
 
 	// The Reserved Instance offering type.
-	OfferingType *string `locationName:"offeringType" type:"string"`
+	OfferingType *string `locationName:"offeringType" type:"string" enum:"Heavy Utilization,Medium Utilization,Light Utilization,No Upfront,Partial Upfront,All Upfront"`
 
 	// The Reserved Instance description.
-	ProductDescription *string `locationName:"productDescription" type:"string"`
+	ProductDescription *string `locationName:"productDescription" type:"string" enum:"Linux/UNIX,Linux/UNIX (Amazon VPC),Windows,Windows (Amazon VPC)"`
 
 	// The recurring charge tag assigned to the resource.
 	RecurringCharges []*RecurringCharge `locationName:"recurringCharges" locationNameList:"item" type:"list"`
@@ -13507,7 +17116,7 @@ type ReservedInstances struct {
 	Start *time.Time `locationName:"start" type:"timestamp" timestampFormat:"iso8601"`
 
 	// The state of the Reserved Instance purchase.
-	State *string `locationName:"state" type:"string"`
+	State *string `locationName:"state" type:"string" enum:"payment-pending,active,payment-failed,retired"`
 
 	// Any tags assigned to the resource.
 	Tags []*Tag `locationName:"tagSet" locationNameList:"item" type:"list"`
@@ -13522,6 +17131,11 @@ type metadataReservedInstances struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReservedInstances.
+func (s ReservedInstances) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the configuration settings for the modified Reserved Instances.
 type ReservedInstancesConfiguration struct {
 	// The Availability Zone for the modified Reserved Instances.
@@ -13531,7 +17145,7 @@ type ReservedInstancesConfiguration struct {
 	InstanceCount *int64 `locationName:"instanceCount" type:"integer"`
 
 	// The instance type for the modified Reserved Instances.
-	InstanceType *string `locationName:"instanceType" type:"string"`
+	InstanceType *string `locationName:"instanceType" type:"string" enum:"t1.micro,m1.small,m1.medium,m1.large,m1.xlarge,m3.medium,m3.large,m3.xlarge,m3.2xlarge,t2.micro,t2.small,t2.medium,m2.xlarge,m2.2xlarge,m2.4xlarge,cr1.8xlarge,i2.xlarge,i2.2xlarge,i2.4xlarge,i2.8xlarge,hi1.4xlarge,hs1.8xlarge,c1.medium,c1.xlarge,c3.large,c3.xlarge,c3.2xlarge,c3.4xlarge,c3.8xlarge,c4.large,c4.xlarge,c4.2xlarge,c4.4xlarge,c4.8xlarge,cc1.4xlarge,cc2.8xlarge,g2.2xlarge,cg1.4xlarge,r3.large,r3.xlarge,r3.2xlarge,r3.4xlarge,r3.8xlarge"`
 
 	// The network platform of the modified Reserved Instances, which is either
 	// EC2-Classic or EC2-VPC.
@@ -13544,6 +17158,11 @@ type metadataReservedInstancesConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReservedInstancesConfiguration.
+func (s ReservedInstancesConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the ID of a Reserved Instance.
 type ReservedInstancesID struct {
 	// The ID of the Reserved Instance.
@@ -13556,6 +17175,11 @@ type metadataReservedInstancesID struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReservedInstancesID.
+func (s ReservedInstancesID) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a Reserved Instance listing.
 type ReservedInstancesListing struct {
 	// The idempotency token you provided when you created the listing.
@@ -13577,7 +17201,7 @@ type ReservedInstancesListing struct {
 	ReservedInstancesListingID *string `locationName:"reservedInstancesListingId" type:"string"`
 
 	// The status of the Reserved Instance listing.
-	Status *string `locationName:"status" type:"string"`
+	Status *string `locationName:"status" type:"string" enum:"active,pending,cancelled,closed"`
 
 	// The reason for the current status of the Reserved Instance listing. The response
 	// can be blank.
@@ -13596,6 +17220,11 @@ type metadataReservedInstancesListing struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReservedInstancesListing.
+func (s ReservedInstancesListing) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a Reserved Instance modification.
 type ReservedInstancesModification struct {
 	// A unique, case-sensitive key supplied by the client to ensure that the modification
@@ -13634,6 +17263,11 @@ type metadataReservedInstancesModification struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReservedInstancesModification.
+func (s ReservedInstancesModification) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ReservedInstancesModificationResult struct {
 	// The ID for the Reserved Instances that were created as part of the modification
 	// request. This field is only available when the modification is fulfilled.
@@ -13650,6 +17284,11 @@ type metadataReservedInstancesModificationResult struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReservedInstancesModificationResult.
+func (s ReservedInstancesModificationResult) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a Reserved Instance offering.
 type ReservedInstancesOffering struct {
 	// The Availability Zone in which the Reserved Instance can be used.
@@ -13658,7 +17297,7 @@ type ReservedInstancesOffering struct {
 	// The currency of the Reserved Instance offering you are purchasing. It's specified
 	// using ISO 4217 standard currency codes. At this time, the only supported
 	// currency is USD.
-	CurrencyCode *string `locationName:"currencyCode" type:"string"`
+	CurrencyCode *string `locationName:"currencyCode" type:"string" enum:"USD"`
 
 	// The duration of the Reserved Instance, in seconds.
 	Duration *int64 `locationName:"duration" type:"long"`
@@ -13667,10 +17306,10 @@ type ReservedInstancesOffering struct {
 	FixedPrice *float64 `locationName:"fixedPrice" type:"float"`
 
 	// The tenancy of the reserved instance.
-	InstanceTenancy *string `locationName:"instanceTenancy" type:"string"`
+	InstanceTenancy *string `locationName:"instanceTenancy" type:"string" enum:"default,dedicated"`
 
 	// The instance type on which the Reserved Instance can be used.
-	InstanceType *string `locationName:"instanceType" type:"string"`
+	InstanceType *string `locationName:"instanceType" type:"string" enum:"t1.micro,m1.small,m1.medium,m1.large,m1.xlarge,m3.medium,m3.large,m3.xlarge,m3.2xlarge,t2.micro,t2.small,t2.medium,m2.xlarge,m2.2xlarge,m2.4xlarge,cr1.8xlarge,i2.xlarge,i2.2xlarge,i2.4xlarge,i2.8xlarge,hi1.4xlarge,hs1.8xlarge,c1.medium,c1.xlarge,c3.large,c3.xlarge,c3.2xlarge,c3.4xlarge,c3.8xlarge,c4.large,c4.xlarge,c4.2xlarge,c4.4xlarge,c4.8xlarge,cc1.4xlarge,cc2.8xlarge,g2.2xlarge,cg1.4xlarge,r3.large,r3.xlarge,r3.2xlarge,r3.4xlarge,r3.8xlarge"`
 
 	// Indicates whether the offering is available through the Reserved Instance
 	// Marketplace (resale) or AWS. If it's a Reserved Instance Marketplace offering,
@@ -13678,13 +17317,13 @@ type ReservedInstancesOffering struct {
 	Marketplace *bool `locationName:"marketplace" type:"boolean"`
 
 	// The Reserved Instance offering type.
-	OfferingType *string `locationName:"offeringType" type:"string"`
+	OfferingType *string `locationName:"offeringType" type:"string" enum:"Heavy Utilization,Medium Utilization,Light Utilization,No Upfront,Partial Upfront,All Upfront"`
 
 	// The pricing details of the Reserved Instance offering.
 	PricingDetails []*PricingDetail `locationName:"pricingDetailsSet" locationNameList:"item" type:"list"`
 
 	// The Reserved Instance description.
-	ProductDescription *string `locationName:"productDescription" type:"string"`
+	ProductDescription *string `locationName:"productDescription" type:"string" enum:"Linux/UNIX,Linux/UNIX (Amazon VPC),Windows,Windows (Amazon VPC)"`
 
 	// The recurring charge tag assigned to the resource.
 	RecurringCharges []*RecurringCharge `locationName:"recurringCharges" locationNameList:"item" type:"list"`
@@ -13702,10 +17341,15 @@ type metadataReservedInstancesOffering struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReservedInstancesOffering.
+func (s ReservedInstancesOffering) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ResetImageAttributeInput struct {
 	// The attribute to reset (currently you can only reset the launch permission
 	// attribute).
-	Attribute *string `type:"string" required:"true"`
+	Attribute *string `type:"string" enum:"launchPermission" required:"true"`
 
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -13719,6 +17363,43 @@ type metadataResetImageAttributeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ResetImageAttributeInput.
+func (s ResetImageAttributeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ResetImageAttributeName is an enum of the values of ResetImageAttributeName members.
+type ResetImageAttributeName string
+
+// The values of ResetImageAttributeName.
+const (
+	ResetImageAttributeNameLaunchPermission ResetImageAttributeName = "launchPermission"
+)
+
+// ResetImageAttributeNameValues returns the values of ResetImageAttributeName known to this
+// version of the SDK. The service may accept values added since.
+func ResetImageAttributeNameValues() []ResetImageAttributeName {
+	return []ResetImageAttributeName{
+		ResetImageAttributeName("launchPermission"),
+	}
+}
+
+// String returns the ResetImageAttributeName as the string of a member.
+func (v ResetImageAttributeName) String() string {
+	return string(v)
+}
+
+// ParseResetImageAttributeName returns the ResetImageAttributeName of str, failing if it is not
+// one of the ResetImageAttributeNameValues.
+func ParseResetImageAttributeName(str string) (ResetImageAttributeName, error) {
+	for _, v := range ResetImageAttributeNameValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ResetImageAttributeName value %q", str)
+}
+
 type ResetImageAttributeOutput struct {
 	metadataResetImageAttributeOutput `json:"-", xml:"-"`
 }
@@ -13727,9 +17408,14 @@ type metadataResetImageAttributeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ResetImageAttributeOutput.
+func (s ResetImageAttributeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ResetInstanceAttributeInput struct {
 	// The attribute to reset.
-	Attribute *string `locationName:"attribute" type:"string" required:"true"`
+	Attribute *string `locationName:"attribute" type:"string" enum:"instanceType,kernel,ramdisk,userData,disableApiTermination,instanceInitiatedShutdownBehavior,rootDeviceName,blockDeviceMapping,productCodes,sourceDestCheck,groupSet,ebsOptimized,sriovNetSupport" required:"true"`
 
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -13743,6 +17429,11 @@ type metadataResetInstanceAttributeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ResetInstanceAttributeInput.
+func (s ResetInstanceAttributeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ResetInstanceAttributeOutput struct {
 	metadataResetInstanceAttributeOutput `json:"-", xml:"-"`
 }
@@ -13751,6 +17442,11 @@ type metadataResetInstanceAttributeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ResetInstanceAttributeOutput.
+func (s ResetInstanceAttributeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ResetNetworkInterfaceAttributeInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -13767,6 +17463,11 @@ type metadataResetNetworkInterfaceAttributeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ResetNetworkInterfaceAttributeInput.
+func (s ResetNetworkInterfaceAttributeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ResetNetworkInterfaceAttributeOutput struct {
 	metadataResetNetworkInterfaceAttributeOutput `json:"-", xml:"-"`
 }
@@ -13775,10 +17476,15 @@ type metadataResetNetworkInterfaceAttributeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ResetNetworkInterfaceAttributeOutput.
+func (s ResetNetworkInterfaceAttributeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ResetSnapshotAttributeInput struct {
 	// The attribute to reset (currently only the attribute for permission to create
 	// volumes can be reset).
-	Attribute *string `type:"string" required:"true"`
+	Attribute *string `type:"string" enum:"productCodes,createVolumePermission" required:"true"`
 
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -13792,6 +17498,11 @@ type metadataResetSnapshotAttributeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ResetSnapshotAttributeInput.
+func (s ResetSnapshotAttributeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ResetSnapshotAttributeOutput struct {
 	metadataResetSnapshotAttributeOutput `json:"-", xml:"-"`
 }
@@ -13800,6 +17511,75 @@ type metadataResetSnapshotAttributeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ResetSnapshotAttributeOutput.
+func (s ResetSnapshotAttributeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ResourceType is an enum of the values of ResourceType members.
+type ResourceType string
+
+// The values of ResourceType.
+const (
+	ResourceTypeCustomerGateway      ResourceType = "customer-gateway"
+	ResourceTypeDhcpOptions          ResourceType = "dhcp-options"
+	ResourceTypeImage                ResourceType = "image"
+	ResourceTypeInstance             ResourceType = "instance"
+	ResourceTypeInternetGateway      ResourceType = "internet-gateway"
+	ResourceTypeNetworkAcl           ResourceType = "network-acl"
+	ResourceTypeNetworkInterface     ResourceType = "network-interface"
+	ResourceTypeReservedInstances    ResourceType = "reserved-instances"
+	ResourceTypeRouteTable           ResourceType = "route-table"
+	ResourceTypeSnapshot             ResourceType = "snapshot"
+	ResourceTypeSpotInstancesRequest ResourceType = "spot-instances-request"
+	ResourceTypeSubnet               ResourceType = "subnet"
+	ResourceTypeSecurityGroup        ResourceType = "security-group"
+	ResourceTypeVolume               ResourceType = "volume"
+	ResourceTypeVpc                  ResourceType = "vpc"
+	ResourceTypeVpnConnection        ResourceType = "vpn-connection"
+	ResourceTypeVpnGateway           ResourceType = "vpn-gateway"
+)
+
+// ResourceTypeValues returns the values of ResourceType known to this
+// version of the SDK. The service may accept values added since.
+func ResourceTypeValues() []ResourceType {
+	return []ResourceType{
+		ResourceType("customer-gateway"),
+		ResourceType("dhcp-options"),
+		ResourceType("image"),
+		ResourceType("instance"),
+		ResourceType("internet-gateway"),
+		ResourceType("network-acl"),
+		ResourceType("network-interface"),
+		ResourceType("reserved-instances"),
+		ResourceType("route-table"),
+		ResourceType("snapshot"),
+		ResourceType("spot-instances-request"),
+		ResourceType("subnet"),
+		ResourceType("security-group"),
+		ResourceType("volume"),
+		ResourceType("vpc"),
+		ResourceType("vpn-connection"),
+		ResourceType("vpn-gateway"),
+	}
+}
+
+// String returns the ResourceType as the string of a member.
+func (v ResourceType) String() string {
+	return string(v)
+}
+
+// ParseResourceType returns the ResourceType of str, failing if it is not
+// one of the ResourceTypeValues.
+func ParseResourceType(str string) (ResourceType, error) {
+	for _, v := range ResourceTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ResourceType value %q", str)
+}
+
 type RevokeSecurityGroupEgressInput struct {
 	// The CIDR IP address range. You can't specify this parameter when specifying
 	// a source security group.
@@ -13841,6 +17621,11 @@ type metadataRevokeSecurityGroupEgressInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RevokeSecurityGroupEgressInput.
+func (s RevokeSecurityGroupEgressInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RevokeSecurityGroupEgressOutput struct {
 	metadataRevokeSecurityGroupEgressOutput `json:"-", xml:"-"`
 }
@@ -13849,6 +17634,11 @@ type metadataRevokeSecurityGroupEgressOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RevokeSecurityGroupEgressOutput.
+func (s RevokeSecurityGroupEgressOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RevokeSecurityGroupIngressInput struct {
 	// The CIDR IP address range. You can't specify this parameter when specifying
 	// a source security group.
@@ -13893,6 +17683,11 @@ type metadataRevokeSecurityGroupIngressInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RevokeSecurityGroupIngressInput.
+func (s RevokeSecurityGroupIngressInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RevokeSecurityGroupIngressOutput struct {
 	metadataRevokeSecurityGroupIngressOutput `json:"-", xml:"-"`
 }
@@ -13901,6 +17696,11 @@ type metadataRevokeSecurityGroupIngressOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RevokeSecurityGroupIngressOutput.
+func (s RevokeSecurityGroupIngressOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a route in a route table.
 type Route struct {
 	// The CIDR block used for the destination match.
@@ -13924,12 +17724,12 @@ type Route struct {
 	// route table was created.  CreateRoute indicates that the route was manually
 	// added to the route table.  EnableVgwRoutePropagation indicates that the route
 	// was propagated by route propagation.
-	Origin *string `locationName:"origin" type:"string"`
+	Origin *string `locationName:"origin" type:"string" enum:"CreateRouteTable,CreateRoute,EnableVgwRoutePropagation"`
 
 	// The state of the route. The blackhole state indicates that the route's target
 	// isn't available (for example, the specified gateway isn't attached to the
 	// VPC, or the specified NAT instance has been terminated).
-	State *string `locationName:"state" type:"string"`
+	State *string `locationName:"state" type:"string" enum:"active,blackhole"`
 
 	// The ID of the VPC peering connection.
 	VPCPeeringConnectionID *string `locationName:"vpcPeeringConnectionId" type:"string"`
@@ -13941,6 +17741,81 @@ type metadataRoute struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Route.
+func (s Route) String() string {
+	return awsutil.StringValue(s)
+}
+
+// RouteOrigin is an enum of the values of RouteOrigin members.
+type RouteOrigin string
+
+// The values of RouteOrigin.
+const (
+	RouteOriginCreateRouteTable          RouteOrigin = "CreateRouteTable"
+	RouteOriginCreateRoute               RouteOrigin = "CreateRoute"
+	RouteOriginEnableVgwRoutePropagation RouteOrigin = "EnableVgwRoutePropagation"
+)
+
+// RouteOriginValues returns the values of RouteOrigin known to this
+// version of the SDK. The service may accept values added since.
+func RouteOriginValues() []RouteOrigin {
+	return []RouteOrigin{
+		RouteOrigin("CreateRouteTable"),
+		RouteOrigin("CreateRoute"),
+		RouteOrigin("EnableVgwRoutePropagation"),
+	}
+}
+
+// String returns the RouteOrigin as the string of a member.
+func (v RouteOrigin) String() string {
+	return string(v)
+}
+
+// ParseRouteOrigin returns the RouteOrigin of str, failing if it is not
+// one of the RouteOriginValues.
+func ParseRouteOrigin(str string) (RouteOrigin, error) {
+	for _, v := range RouteOriginValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid RouteOrigin value %q", str)
+}
+
+// RouteState is an enum of the values of RouteState members.
+type RouteState string
+
+// The values of RouteState.
+const (
+	RouteStateActive    RouteState = "active"
+	RouteStateBlackhole RouteState = "blackhole"
+)
+
+// RouteStateValues returns the values of RouteState known to this
+// version of the SDK. The service may accept values added since.
+func RouteStateValues() []RouteState {
+	return []RouteState{
+		RouteState("active"),
+		RouteState("blackhole"),
+	}
+}
+
+// String returns the RouteState as the string of a member.
+func (v RouteState) String() string {
+	return string(v)
+}
+
+// ParseRouteState returns the RouteState of str, failing if it is not
+// one of the RouteStateValues.
+func ParseRouteState(str string) (RouteState, error) {
+	for _, v := range RouteStateValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid RouteState value %q", str)
+}
+
 // Describes a route table.
 type RouteTable struct {
 	// The associations between the route table and one or more subnets.
@@ -13968,6 +17843,11 @@ type metadataRouteTable struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RouteTable.
+func (s RouteTable) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an association between a route table and a subnet.
 type RouteTableAssociation struct {
 	// Indicates whether this is the main route table.
@@ -13989,6 +17869,45 @@ type metadataRouteTableAssociation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RouteTableAssociation.
+func (s RouteTableAssociation) String() string {
+	return awsutil.StringValue(s)
+}
+
+// RuleAction is an enum of the values of RuleAction members.
+type RuleAction string
+
+// The values of RuleAction.
+const (
+	RuleActionAllow RuleAction = "allow"
+	RuleActionDeny  RuleAction = "deny"
+)
+
+// RuleActionValues returns the values of RuleAction known to this
+// version of the SDK. The service may accept values added since.
+func RuleActionValues() []RuleAction {
+	return []RuleAction{
+		RuleAction("allow"),
+		RuleAction("deny"),
+	}
+}
+
+// String returns the RuleAction as the string of a member.
+func (v RuleAction) String() string {
+	return string(v)
+}
+
+// ParseRuleAction returns the RuleAction of str, failing if it is not
+// one of the RuleActionValues.
+func ParseRuleAction(str string) (RuleAction, error) {
+	for _, v := range RuleActionValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid RuleAction value %q", str)
+}
+
 type RunInstancesInput struct {
 	// Reserved.
 	AdditionalInfo *string `locationName:"additionalInfo" type:"string"`
@@ -14035,13 +17954,13 @@ type RunInstancesInput struct {
 	// from the instance (using the operating system command for system shutdown).
 	//
 	// Default: stop
-	InstanceInitiatedShutdownBehavior *string `locationName:"instanceInitiatedShutdownBehavior" type:"string"`
+	InstanceInitiatedShutdownBehavior *string `locationName:"instanceInitiatedShutdownBehavior" type:"string" enum:"stop,terminate"`
 
 	// The instance type. For more information, see Instance Types (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/instance-types.html)
 	// in the Amazon Elastic Compute Cloud User Guide.
 	//
 	// Default: m1.small
-	InstanceType *string `type:"string"`
+	InstanceType *string `type:"string" enum:"t1.micro,m1.small,m1.medium,m1.large,m1.xlarge,m3.medium,m3.large,m3.xlarge,m3.2xlarge,t2.micro,t2.small,t2.medium,m2.xlarge,m2.2xlarge,m2.4xlarge,cr1.8xlarge,i2.xlarge,i2.2xlarge,i2.4xlarge,i2.8xlarge,hi1.4xlarge,hs1.8xlarge,c1.medium,c1.xlarge,c3.large,c3.xlarge,c3.2xlarge,c3.4xlarge,c3.8xlarge,c4.large,c4.xlarge,c4.2xlarge,c4.4xlarge,c4.8xlarge,cc1.4xlarge,cc2.8xlarge,g2.2xlarge,cg1.4xlarge,r3.large,r3.xlarge,r3.2xlarge,r3.4xlarge,r3.8xlarge"`
 
 	// The ID of the kernel.
 	//
@@ -14127,6 +18046,11 @@ type metadataRunInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RunInstancesInput.
+func (s RunInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the monitoring for the instance.
 type RunInstancesMonitoringEnabled struct {
 	// Indicates whether monitoring is enabled for the instance.
@@ -14139,6 +18063,11 @@ type metadataRunInstancesMonitoringEnabled struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RunInstancesMonitoringEnabled.
+func (s RunInstancesMonitoringEnabled) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the storage parameters for S3 and S3 buckets for an instance store-backed
 // AMI.
 type S3Storage struct {
@@ -14169,6 +18098,11 @@ type metadataS3Storage struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the S3Storage.
+func (s S3Storage) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a security group
 type SecurityGroup struct {
 	// A description of the security group.
@@ -14202,6 +18136,45 @@ type metadataSecurityGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SecurityGroup.
+func (s SecurityGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ShutdownBehavior is an enum of the values of ShutdownBehavior members.
+type ShutdownBehavior string
+
+// The values of ShutdownBehavior.
+const (
+	ShutdownBehaviorStop      ShutdownBehavior = "stop"
+	ShutdownBehaviorTerminate ShutdownBehavior = "terminate"
+)
+
+// ShutdownBehaviorValues returns the values of ShutdownBehavior known to this
+// version of the SDK. The service may accept values added since.
+func ShutdownBehaviorValues() []ShutdownBehavior {
+	return []ShutdownBehavior{
+		ShutdownBehavior("stop"),
+		ShutdownBehavior("terminate"),
+	}
+}
+
+// String returns the ShutdownBehavior as the string of a member.
+func (v ShutdownBehavior) String() string {
+	return string(v)
+}
+
+// ParseShutdownBehavior returns the ShutdownBehavior of str, failing if it is not
+// one of the ShutdownBehaviorValues.
+func ParseShutdownBehavior(str string) (ShutdownBehavior, error) {
+	for _, v := range ShutdownBehaviorValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ShutdownBehavior value %q", str)
+}
+
 // Describes a snapshot.
 type Snapshot struct {
 	// The description for the snapshot.
@@ -14231,7 +18204,7 @@ type Snapshot struct {
 	StartTime *time.Time `locationName:"startTime" type:"timestamp" timestampFormat:"iso8601"`
 
 	// The snapshot state.
-	State *string `locationName:"status" type:"string"`
+	State *string `locationName:"status" type:"string" enum:"pending,completed,error"`
 
 	// Any tags assigned to the snapshot.
 	Tags []*Tag `locationName:"tagSet" locationNameList:"item" type:"list"`
@@ -14249,6 +18222,81 @@ type metadataSnapshot struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Snapshot.
+func (s Snapshot) String() string {
+	return awsutil.StringValue(s)
+}
+
+// SnapshotAttributeName is an enum of the values of SnapshotAttributeName members.
+type SnapshotAttributeName string
+
+// The values of SnapshotAttributeName.
+const (
+	SnapshotAttributeNameProductCodes           SnapshotAttributeName = "productCodes"
+	SnapshotAttributeNameCreateVolumePermission SnapshotAttributeName = "createVolumePermission"
+)
+
+// SnapshotAttributeNameValues returns the values of SnapshotAttributeName known to this
+// version of the SDK. The service may accept values added since.
+func SnapshotAttributeNameValues() []SnapshotAttributeName {
+	return []SnapshotAttributeName{
+		SnapshotAttributeName("productCodes"),
+		SnapshotAttributeName("createVolumePermission"),
+	}
+}
+
+// String returns the SnapshotAttributeName as the string of a member.
+func (v SnapshotAttributeName) String() string {
+	return string(v)
+}
+
+// ParseSnapshotAttributeName returns the SnapshotAttributeName of str, failing if it is not
+// one of the SnapshotAttributeNameValues.
+func ParseSnapshotAttributeName(str string) (SnapshotAttributeName, error) {
+	for _, v := range SnapshotAttributeNameValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid SnapshotAttributeName value %q", str)
+}
+
+// SnapshotState is an enum of the values of SnapshotState members.
+type SnapshotState string
+
+// The values of SnapshotState.
+const (
+	SnapshotStatePending   SnapshotState = "pending"
+	SnapshotStateCompleted SnapshotState = "completed"
+	SnapshotStateError     SnapshotState = "error"
+)
+
+// SnapshotStateValues returns the values of SnapshotState known to this
+// version of the SDK. The service may accept values added since.
+func SnapshotStateValues() []SnapshotState {
+	return []SnapshotState{
+		SnapshotState("pending"),
+		SnapshotState("completed"),
+		SnapshotState("error"),
+	}
+}
+
+// String returns the SnapshotState as the string of a member.
+func (v SnapshotState) String() string {
+	return string(v)
+}
+
+// ParseSnapshotState returns the SnapshotState of str, failing if it is not
+// one of the SnapshotStateValues.
+func ParseSnapshotState(str string) (SnapshotState, error) {
+	for _, v := range SnapshotStateValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid SnapshotState value %q", str)
+}
+
 // Describes the data feed for a Spot Instance.
 type SpotDatafeedSubscription struct {
 	// The Amazon S3 bucket where the Spot Instance data feed is located.
@@ -14264,7 +18312,7 @@ type SpotDatafeedSubscription struct {
 	Prefix *string `locationName:"prefix" type:"string"`
 
 	// The state of the Spot Instance data feed subscription.
-	State *string `locationName:"state" type:"string"`
+	State *string `locationName:"state" type:"string" enum:"Active,Inactive"`
 
 	metadataSpotDatafeedSubscription `json:"-", xml:"-"`
 }
@@ -14273,6 +18321,11 @@ type metadataSpotDatafeedSubscription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SpotDatafeedSubscription.
+func (s SpotDatafeedSubscription) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describe a Spot Instance request.
 type SpotInstanceRequest struct {
 	// The Availability Zone group. If you specify the same Availability Zone group
@@ -14301,7 +18354,7 @@ type SpotInstanceRequest struct {
 	LaunchedAvailabilityZone *string `locationName:"launchedAvailabilityZone" type:"string"`
 
 	// The product description associated with the Spot Instance.
-	ProductDescription *string `locationName:"productDescription" type:"string"`
+	ProductDescription *string `locationName:"productDescription" type:"string" enum:"Linux/UNIX,Linux/UNIX (Amazon VPC),Windows,Windows (Amazon VPC)"`
 
 	// The ID of the Spot Instance request.
 	SpotInstanceRequestID *string `locationName:"spotInstanceRequestId" type:"string"`
@@ -14314,7 +18367,7 @@ type SpotInstanceRequest struct {
 	// you track your Spot Instance requests. For more information, see Spot Bid
 	// Status (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/spot-bid-status.html)
 	// in the Amazon Elastic Compute Cloud User Guide.
-	State *string `locationName:"state" type:"string"`
+	State *string `locationName:"state" type:"string" enum:"open,active,closed,cancelled,failed"`
 
 	// The status code and status message describing the Spot Instance request.
 	Status *SpotInstanceStatus `locationName:"status" type:"structure"`
@@ -14323,7 +18376,7 @@ type SpotInstanceRequest struct {
 	Tags []*Tag `locationName:"tagSet" locationNameList:"item" type:"list"`
 
 	// The Spot Instance request type.
-	Type *string `locationName:"type" type:"string"`
+	Type *string `locationName:"type" type:"string" enum:"one-time,persistent"`
 
 	// The start date of the request. If this is a one-time request, the request
 	// becomes active at this date and time and remains active until all instances
@@ -14345,6 +18398,51 @@ type metadataSpotInstanceRequest struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SpotInstanceRequest.
+func (s SpotInstanceRequest) String() string {
+	return awsutil.StringValue(s)
+}
+
+// SpotInstanceState is an enum of the values of SpotInstanceState members.
+type SpotInstanceState string
+
+// The values of SpotInstanceState.
+const (
+	SpotInstanceStateOpen      SpotInstanceState = "open"
+	SpotInstanceStateActive    SpotInstanceState = "active"
+	SpotInstanceStateClosed    SpotInstanceState = "closed"
+	SpotInstanceStateCancelled SpotInstanceState = "cancelled"
+	SpotInstanceStateFailed    SpotInstanceState = "failed"
+)
+
+// SpotInstanceStateValues returns the values of SpotInstanceState known to this
+// version of the SDK. The service may accept values added since.
+func SpotInstanceStateValues() []SpotInstanceState {
+	return []SpotInstanceState{
+		SpotInstanceState("open"),
+		SpotInstanceState("active"),
+		SpotInstanceState("closed"),
+		SpotInstanceState("cancelled"),
+		SpotInstanceState("failed"),
+	}
+}
+
+// String returns the SpotInstanceState as the string of a member.
+func (v SpotInstanceState) String() string {
+	return string(v)
+}
+
+// ParseSpotInstanceState returns the SpotInstanceState of str, failing if it is not
+// one of the SpotInstanceStateValues.
+func ParseSpotInstanceState(str string) (SpotInstanceState, error) {
+	for _, v := range SpotInstanceStateValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid SpotInstanceState value %q", str)
+}
+
 // Describes a Spot Instance state change.
 type SpotInstanceStateFault struct {
 	// The reason code for the Spot Instance state change.
@@ -14360,6 +18458,11 @@ type metadataSpotInstanceStateFault struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SpotInstanceStateFault.
+func (s SpotInstanceStateFault) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the status of a Spot Instance request.
 type SpotInstanceStatus struct {
 	// The status code.
@@ -14378,6 +18481,45 @@ type metadataSpotInstanceStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SpotInstanceStatus.
+func (s SpotInstanceStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
+// SpotInstanceType is an enum of the values of SpotInstanceType members.
+type SpotInstanceType string
+
+// The values of SpotInstanceType.
+const (
+	SpotInstanceTypeOneTime    SpotInstanceType = "one-time"
+	SpotInstanceTypePersistent SpotInstanceType = "persistent"
+)
+
+// SpotInstanceTypeValues returns the values of SpotInstanceType known to this
+// version of the SDK. The service may accept values added since.
+func SpotInstanceTypeValues() []SpotInstanceType {
+	return []SpotInstanceType{
+		SpotInstanceType("one-time"),
+		SpotInstanceType("persistent"),
+	}
+}
+
+// String returns the SpotInstanceType as the string of a member.
+func (v SpotInstanceType) String() string {
+	return string(v)
+}
+
+// ParseSpotInstanceType returns the SpotInstanceType of str, failing if it is not
+// one of the SpotInstanceTypeValues.
+func ParseSpotInstanceType(str string) (SpotInstanceType, error) {
+	for _, v := range SpotInstanceTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid SpotInstanceType value %q", str)
+}
+
 // Describes Spot Instance placement.
 type SpotPlacement struct {
 	// The Availability Zone.
@@ -14393,6 +18535,11 @@ type metadataSpotPlacement struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SpotPlacement.
+func (s SpotPlacement) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the maximum hourly price (bid) for any Spot Instance launched to
 // fulfill the request.
 type SpotPrice struct {
@@ -14400,10 +18547,10 @@ type SpotPrice struct {
 	AvailabilityZone *string `locationName:"availabilityZone" type:"string"`
 
 	// The instance type.
-	InstanceType *string `locationName:"instanceType" type:"string"`
+	InstanceType *string `locationName:"instanceType" type:"string" enum:"t1.micro,m1.small,m1.medium,m1.large,m1.xlarge,m3.medium,m3.large,m3.xlarge,m3.2xlarge,t2.micro,t2.small,t2.medium,m2.xlarge,m2.2xlarge,m2.4xlarge,cr1.8xlarge,i2.xlarge,i2.2xlarge,i2.4xlarge,i2.8xlarge,hi1.4xlarge,hs1.8xlarge,c1.medium,c1.xlarge,c3.large,c3.xlarge,c3.2xlarge,c3.4xlarge,c3.8xlarge,c4.large,c4.xlarge,c4.2xlarge,c4.4xlarge,c4.8xlarge,cc1.4xlarge,cc2.8xlarge,g2.2xlarge,cg1.4xlarge,r3.large,r3.xlarge,r3.2xlarge,r3.4xlarge,r3.8xlarge"`
 
 	// A general description of the AMI.
-	ProductDescription *string `locationName:"productDescription" type:"string"`
+	ProductDescription *string `locationName:"productDescription" type:"string" enum:"Linux/UNIX,Linux/UNIX (Amazon VPC),Windows,Windows (Amazon VPC)"`
 
 	// The maximum price (bid) that you are willing to pay for a Spot Instance.
 	SpotPrice *string `locationName:"spotPrice" type:"string"`
@@ -14418,6 +18565,11 @@ type metadataSpotPrice struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SpotPrice.
+func (s SpotPrice) String() string {
+	return awsutil.StringValue(s)
+}
+
 type StartInstancesInput struct {
 	// Reserved.
 	AdditionalInfo *string `locationName:"additionalInfo" type:"string"`
@@ -14434,6 +18586,11 @@ type metadataStartInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StartInstancesInput.
+func (s StartInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type StartInstancesOutput struct {
 	// Information about one or more started instances.
 	StartingInstances []*InstanceStateChange `locationName:"instancesSet" locationNameList:"item" type:"list"`
@@ -14445,6 +18602,11 @@ type metadataStartInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StartInstancesOutput.
+func (s StartInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a state change.
 type StateReason struct {
 	// The reason code for the state change.
@@ -14482,25 +18644,103 @@ type metadataStateReason struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type StopInstancesInput struct {
-	DryRun *bool `locationName:"dryRun" type:"boolean"`
+// String returns the string representation of the StateReason.
+func (s StateReason) String() string {
+	return awsutil.StringValue(s)
+}
 
-	// Forces the instances to stop. The instances do not have an opportunity to
-	// flush file system caches or file system metadata. If you use this option,
-	// you must perform file system check and repair procedures. This option is
-	// not recommended for Windows instances.
-	//
-	// Default: false
-	Force *bool `locationName:"force" type:"boolean"`
+// StatusName is an enum of the values of StatusName members.
+type StatusName string
 
-	// One or more instance IDs.
-	InstanceIDs []*string `locationName:"InstanceId" locationNameList:"InstanceId" type:"list" required:"true"`
+// The values of StatusName.
+const (
+	StatusNameReachability StatusName = "reachability"
+)
 
-	metadataStopInstancesInput `json:"-", xml:"-"`
+// StatusNameValues returns the values of StatusName known to this
+// version of the SDK. The service may accept values added since.
+func StatusNameValues() []StatusName {
+	return []StatusName{
+		StatusName("reachability"),
+	}
 }
 
-type metadataStopInstancesInput struct {
-	SDKShapeTraits bool `type:"structure"`
+// String returns the StatusName as the string of a member.
+func (v StatusName) String() string {
+	return string(v)
+}
+
+// ParseStatusName returns the StatusName of str, failing if it is not
+// one of the StatusNameValues.
+func ParseStatusName(str string) (StatusName, error) {
+	for _, v := range StatusNameValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid StatusName value %q", str)
+}
+
+// StatusType is an enum of the values of StatusType members.
+type StatusType string
+
+// The values of StatusType.
+const (
+	StatusTypePassed           StatusType = "passed"
+	StatusTypeFailed           StatusType = "failed"
+	StatusTypeInsufficientData StatusType = "insufficient-data"
+)
+
+// StatusTypeValues returns the values of StatusType known to this
+// version of the SDK. The service may accept values added since.
+func StatusTypeValues() []StatusType {
+	return []StatusType{
+		StatusType("passed"),
+		StatusType("failed"),
+		StatusType("insufficient-data"),
+	}
+}
+
+// String returns the StatusType as the string of a member.
+func (v StatusType) String() string {
+	return string(v)
+}
+
+// ParseStatusType returns the StatusType of str, failing if it is not
+// one of the StatusTypeValues.
+func ParseStatusType(str string) (StatusType, error) {
+	for _, v := range StatusTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid StatusType value %q", str)
+}
+
+type StopInstancesInput struct {
+	DryRun *bool `locationName:"dryRun" type:"boolean"`
+
+	// Forces the instances to stop. The instances do not have an opportunity to
+	// flush file system caches or file system metadata. If you use this option,
+	// you must perform file system check and repair procedures. This option is
+	// not recommended for Windows instances.
+	//
+	// Default: false
+	Force *bool `locationName:"force" type:"boolean"`
+
+	// One or more instance IDs.
+	InstanceIDs []*string `locationName:"InstanceId" locationNameList:"InstanceId" type:"list" required:"true"`
+
+	metadataStopInstancesInput `json:"-", xml:"-"`
+}
+
+type metadataStopInstancesInput struct {
+	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the StopInstancesInput.
+func (s StopInstancesInput) String() string {
+	return awsutil.StringValue(s)
 }
 
 type StopInstancesOutput struct {
@@ -14514,6 +18754,11 @@ type metadataStopInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StopInstancesOutput.
+func (s StopInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the storage location for an instance store-backed AMI.
 type Storage struct {
 	// An Amazon S3 storage location.
@@ -14526,6 +18771,11 @@ type metadataStorage struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Storage.
+func (s Storage) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a subnet.
 type Subnet struct {
 	// The Availability Zone of the subnet.
@@ -14545,7 +18795,7 @@ type Subnet struct {
 	MapPublicIPOnLaunch *bool `locationName:"mapPublicIpOnLaunch" type:"boolean"`
 
 	// The current state of the subnet.
-	State *string `locationName:"state" type:"string"`
+	State *string `locationName:"state" type:"string" enum:"pending,available"`
 
 	// The ID of the subnet.
 	SubnetID *string `locationName:"subnetId" type:"string"`
@@ -14563,6 +18813,83 @@ type metadataSubnet struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Subnet.
+func (s Subnet) String() string {
+	return awsutil.StringValue(s)
+}
+
+// SubnetState is an enum of the values of SubnetState members.
+type SubnetState string
+
+// The values of SubnetState.
+const (
+	SubnetStatePending   SubnetState = "pending"
+	SubnetStateAvailable SubnetState = "available"
+)
+
+// SubnetStateValues returns the values of SubnetState known to this
+// version of the SDK. The service may accept values added since.
+func SubnetStateValues() []SubnetState {
+	return []SubnetState{
+		SubnetState("pending"),
+		SubnetState("available"),
+	}
+}
+
+// String returns the SubnetState as the string of a member.
+func (v SubnetState) String() string {
+	return string(v)
+}
+
+// ParseSubnetState returns the SubnetState of str, failing if it is not
+// one of the SubnetStateValues.
+func ParseSubnetState(str string) (SubnetState, error) {
+	for _, v := range SubnetStateValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid SubnetState value %q", str)
+}
+
+// SummaryStatus is an enum of the values of SummaryStatus members.
+type SummaryStatus string
+
+// The values of SummaryStatus.
+const (
+	SummaryStatusOk               SummaryStatus = "ok"
+	SummaryStatusImpaired         SummaryStatus = "impaired"
+	SummaryStatusInsufficientData SummaryStatus = "insufficient-data"
+	SummaryStatusNotApplicable    SummaryStatus = "not-applicable"
+)
+
+// SummaryStatusValues returns the values of SummaryStatus known to this
+// version of the SDK. The service may accept values added since.
+func SummaryStatusValues() []SummaryStatus {
+	return []SummaryStatus{
+		SummaryStatus("ok"),
+		SummaryStatus("impaired"),
+		SummaryStatus("insufficient-data"),
+		SummaryStatus("not-applicable"),
+	}
+}
+
+// String returns the SummaryStatus as the string of a member.
+func (v SummaryStatus) String() string {
+	return string(v)
+}
+
+// ParseSummaryStatus returns the SummaryStatus of str, failing if it is not
+// one of the SummaryStatusValues.
+func ParseSummaryStatus(str string) (SummaryStatus, error) {
+	for _, v := range SummaryStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid SummaryStatus value %q", str)
+}
+
 // Describes a tag.
 type Tag struct {
 	// The key of the tag.
@@ -14584,6 +18911,11 @@ type metadataTag struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Tag.
+func (s Tag) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a tag.
 type TagDescription struct {
 	// The tag key.
@@ -14593,7 +18925,7 @@ type TagDescription struct {
 	ResourceID *string `locationName:"resourceId" type:"string"`
 
 	// The resource type.
-	ResourceType *string `locationName:"resourceType" type:"string"`
+	ResourceType *string `locationName:"resourceType" type:"string" enum:"customer-gateway,dhcp-options,image,instance,internet-gateway,network-acl,network-interface,reserved-instances,route-table,snapshot,spot-instances-request,subnet,security-group,volume,vpc,vpn-connection,vpn-gateway"`
 
 	// The tag value.
 	Value *string `locationName:"value" type:"string"`
@@ -14605,6 +18937,79 @@ type metadataTagDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TagDescription.
+func (s TagDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// TelemetryStatus is an enum of the values of TelemetryStatus members.
+type TelemetryStatus string
+
+// The values of TelemetryStatus.
+const (
+	TelemetryStatusUP   TelemetryStatus = "UP"
+	TelemetryStatusDOWN TelemetryStatus = "DOWN"
+)
+
+// TelemetryStatusValues returns the values of TelemetryStatus known to this
+// version of the SDK. The service may accept values added since.
+func TelemetryStatusValues() []TelemetryStatus {
+	return []TelemetryStatus{
+		TelemetryStatus("UP"),
+		TelemetryStatus("DOWN"),
+	}
+}
+
+// String returns the TelemetryStatus as the string of a member.
+func (v TelemetryStatus) String() string {
+	return string(v)
+}
+
+// ParseTelemetryStatus returns the TelemetryStatus of str, failing if it is not
+// one of the TelemetryStatusValues.
+func ParseTelemetryStatus(str string) (TelemetryStatus, error) {
+	for _, v := range TelemetryStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid TelemetryStatus value %q", str)
+}
+
+// Tenancy is an enum of the values of Tenancy members.
+type Tenancy string
+
+// The values of Tenancy.
+const (
+	TenancyDefault   Tenancy = "default"
+	TenancyDedicated Tenancy = "dedicated"
+)
+
+// TenancyValues returns the values of Tenancy known to this
+// version of the SDK. The service may accept values added since.
+func TenancyValues() []Tenancy {
+	return []Tenancy{
+		Tenancy("default"),
+		Tenancy("dedicated"),
+	}
+}
+
+// String returns the Tenancy as the string of a member.
+func (v Tenancy) String() string {
+	return string(v)
+}
+
+// ParseTenancy returns the Tenancy of str, failing if it is not
+// one of the TenancyValues.
+func ParseTenancy(str string) (Tenancy, error) {
+	for _, v := range TenancyValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid Tenancy value %q", str)
+}
+
 type TerminateInstancesInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -14618,6 +19023,11 @@ type metadataTerminateInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TerminateInstancesInput.
+func (s TerminateInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type TerminateInstancesOutput struct {
 	// Information about one or more terminated instances.
 	TerminatingInstances []*InstanceStateChange `locationName:"instancesSet" locationNameList:"item" type:"list"`
@@ -14629,6 +19039,11 @@ type metadataTerminateInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TerminateInstancesOutput.
+func (s TerminateInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UnassignPrivateIPAddressesInput struct {
 	// The ID of the network interface.
 	NetworkInterfaceID *string `locationName:"networkInterfaceId" type:"string" required:"true"`
@@ -14644,6 +19059,11 @@ type metadataUnassignPrivateIPAddressesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UnassignPrivateIPAddressesInput.
+func (s UnassignPrivateIPAddressesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UnassignPrivateIPAddressesOutput struct {
 	metadataUnassignPrivateIPAddressesOutput `json:"-", xml:"-"`
 }
@@ -14652,6 +19072,11 @@ type metadataUnassignPrivateIPAddressesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UnassignPrivateIPAddressesOutput.
+func (s UnassignPrivateIPAddressesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UnmonitorInstancesInput struct {
 	DryRun *bool `locationName:"dryRun" type:"boolean"`
 
@@ -14665,6 +19090,11 @@ type metadataUnmonitorInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UnmonitorInstancesInput.
+func (s UnmonitorInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UnmonitorInstancesOutput struct {
 	// Monitoring information for one or more instances.
 	InstanceMonitorings []*InstanceMonitoring `locationName:"instancesSet" locationNameList:"item" type:"list"`
@@ -14676,6 +19106,11 @@ type metadataUnmonitorInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UnmonitorInstancesOutput.
+func (s UnmonitorInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UserData struct {
 	Data *string `locationName:"data" type:"string"`
 
@@ -14686,6 +19121,11 @@ type metadataUserData struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UserData.
+func (s UserData) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a security group and AWS account ID pair.
 type UserIDGroupPair struct {
 	// The ID of the security group.
@@ -14706,6 +19146,11 @@ type metadataUserIDGroupPair struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UserIDGroupPair.
+func (s UserIDGroupPair) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes telemetry for a VPN tunnel.
 type VGWTelemetry struct {
 	// The number of accepted routes.
@@ -14719,7 +19164,7 @@ type VGWTelemetry struct {
 	OutsideIPAddress *string `locationName:"outsideIpAddress" type:"string"`
 
 	// The status of the VPN tunnel.
-	Status *string `locationName:"status" type:"string"`
+	Status *string `locationName:"status" type:"string" enum:"UP,DOWN"`
 
 	// If an error occurs, a description of the error.
 	StatusMessage *string `locationName:"statusMessage" type:"string"`
@@ -14731,6 +19176,11 @@ type metadataVGWTelemetry struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the VGWTelemetry.
+func (s VGWTelemetry) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a VPC.
 type VPC struct {
 	// The CIDR block for the VPC.
@@ -14741,13 +19191,13 @@ type VPC struct {
 	DHCPOptionsID *string `locationName:"dhcpOptionsId" type:"string"`
 
 	// The allowed tenancy of instances launched into the VPC.
-	InstanceTenancy *string `locationName:"instanceTenancy" type:"string"`
+	InstanceTenancy *string `locationName:"instanceTenancy" type:"string" enum:"default,dedicated"`
 
 	// Indicates whether the VPC is the default VPC.
 	IsDefault *bool `locationName:"isDefault" type:"boolean"`
 
 	// The current state of the VPC.
-	State *string `locationName:"state" type:"string"`
+	State *string `locationName:"state" type:"string" enum:"pending,available"`
 
 	// Any tags assigned to the VPC.
 	Tags []*Tag `locationName:"tagSet" locationNameList:"item" type:"list"`
@@ -14762,10 +19212,15 @@ type metadataVPC struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the VPC.
+func (s VPC) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an attachment between a virtual private gateway and a VPC.
 type VPCAttachment struct {
 	// The current state of the attachment.
-	State *string `locationName:"state" type:"string"`
+	State *string `locationName:"state" type:"string" enum:"attaching,attached,detaching,detached"`
 
 	// The ID of the VPC.
 	VPCID *string `locationName:"vpcId" type:"string"`
@@ -14777,6 +19232,11 @@ type metadataVPCAttachment struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the VPCAttachment.
+func (s VPCAttachment) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes whether a VPC is enabled for ClassicLink.
 type VPCClassicLink struct {
 	// Indicates whether the VPC is enabled for ClassicLink.
@@ -14795,6 +19255,11 @@ type metadataVPCClassicLink struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the VPCClassicLink.
+func (s VPCClassicLink) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a VPC peering connection.
 type VPCPeeringConnection struct {
 	// The information of the peer VPC.
@@ -14822,6 +19287,11 @@ type metadataVPCPeeringConnection struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the VPCPeeringConnection.
+func (s VPCPeeringConnection) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the status of a VPC peering connection.
 type VPCPeeringConnectionStateReason struct {
 	// The status of the VPC peering connection.
@@ -14837,6 +19307,11 @@ type metadataVPCPeeringConnectionStateReason struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the VPCPeeringConnectionStateReason.
+func (s VPCPeeringConnectionStateReason) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a VPC in a VPC peering connection.
 type VPCPeeringConnectionVPCInfo struct {
 	// The CIDR block for the VPC.
@@ -14855,6 +19330,11 @@ type metadataVPCPeeringConnectionVPCInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the VPCPeeringConnectionVPCInfo.
+func (s VPCPeeringConnectionVPCInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a VPN connection.
 type VPNConnection struct {
 	// The configuration information for the VPN connection's customer gateway (in
@@ -14873,13 +19353,13 @@ type VPNConnection struct {
 	Routes []*VPNStaticRoute `locationName:"routes" locationNameList:"item" type:"list"`
 
 	// The current state of the VPN connection.
-	State *string `locationName:"state" type:"string"`
+	State *string `locationName:"state" type:"string" enum:"pending,available,deleting,deleted"`
 
 	// Any tags assigned to the VPN connection.
 	Tags []*Tag `locationName:"tagSet" locationNameList:"item" type:"list"`
 
 	// The type of VPN connection.
-	Type *string `locationName:"type" type:"string"`
+	Type *string `locationName:"type" type:"string" enum:"ipsec.1"`
 
 	// Information about the VPN tunnel.
 	VGWTelemetry []*VGWTelemetry `locationName:"vgwTelemetry" locationNameList:"item" type:"list"`
@@ -14897,6 +19377,11 @@ type metadataVPNConnection struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the VPNConnection.
+func (s VPNConnection) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes VPN connection options.
 type VPNConnectionOptions struct {
 	// Indicates whether the VPN connection uses static routes only. Static routes
@@ -14910,6 +19395,11 @@ type metadataVPNConnectionOptions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the VPNConnectionOptions.
+func (s VPNConnectionOptions) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes VPN connection options.
 type VPNConnectionOptionsSpecification struct {
 	// Indicates whether the VPN connection uses static routes only. Static routes
@@ -14923,19 +19413,24 @@ type metadataVPNConnectionOptionsSpecification struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the VPNConnectionOptionsSpecification.
+func (s VPNConnectionOptionsSpecification) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a virtual private gateway.
 type VPNGateway struct {
 	// The Availability Zone where the virtual private gateway was created.
 	AvailabilityZone *string `locationName:"availabilityZone" type:"string"`
 
 	// The current state of the virtual private gateway.
-	State *string `locationName:"state" type:"string"`
+	State *string `locationName:"state" type:"string" enum:"pending,available,deleting,deleted"`
 
 	// Any tags assigned to the virtual private gateway.
 	Tags []*Tag `locationName:"tagSet" locationNameList:"item" type:"list"`
 
 	// The type of VPN connection the virtual private gateway supports.
-	Type *string `locationName:"type" type:"string"`
+	Type *string `locationName:"type" type:"string" enum:"ipsec.1"`
 
 	// Any VPCs attached to the virtual private gateway.
 	VPCAttachments []*VPCAttachment `locationName:"attachments" locationNameList:"item" type:"list"`
@@ -14950,16 +19445,21 @@ type metadataVPNGateway struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the VPNGateway.
+func (s VPNGateway) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a static route for a VPN connection.
 type VPNStaticRoute struct {
 	// The CIDR block associated with the local subnet of the customer data center.
 	DestinationCIDRBlock *string `locationName:"destinationCidrBlock" type:"string"`
 
 	// Indicates how the routes were provided.
-	Source *string `locationName:"source" type:"string"`
+	Source *string `locationName:"source" type:"string" enum:"Static"`
 
 	// The current state of the static route.
-	State *string `locationName:"state" type:"string"`
+	State *string `locationName:"state" type:"string" enum:"pending,available,deleting,deleted"`
 
 	metadataVPNStaticRoute `json:"-", xml:"-"`
 }
@@ -14968,6 +19468,45 @@ type metadataVPNStaticRoute struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the VPNStaticRoute.
+func (s VPNStaticRoute) String() string {
+	return awsutil.StringValue(s)
+}
+
+// VirtualizationType is an enum of the values of VirtualizationType members.
+type VirtualizationType string
+
+// The values of VirtualizationType.
+const (
+	VirtualizationTypeHvm         VirtualizationType = "hvm"
+	VirtualizationTypeParavirtual VirtualizationType = "paravirtual"
+)
+
+// VirtualizationTypeValues returns the values of VirtualizationType known to this
+// version of the SDK. The service may accept values added since.
+func VirtualizationTypeValues() []VirtualizationType {
+	return []VirtualizationType{
+		VirtualizationType("hvm"),
+		VirtualizationType("paravirtual"),
+	}
+}
+
+// String returns the VirtualizationType as the string of a member.
+func (v VirtualizationType) String() string {
+	return string(v)
+}
+
+// ParseVirtualizationType returns the VirtualizationType of str, failing if it is not
+// one of the VirtualizationTypeValues.
+func ParseVirtualizationType(str string) (VirtualizationType, error) {
+	for _, v := range VirtualizationTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualizationType value %q", str)
+}
+
 // Describes a volume.
 type Volume struct {
 	Attachments []*VolumeAttachment `locationName:"attachmentSet" locationNameList:"item" type:"list"`
@@ -15007,7 +19546,7 @@ type Volume struct {
 	SnapshotID *string `locationName:"snapshotId" type:"string"`
 
 	// The volume state.
-	State *string `locationName:"status" type:"string"`
+	State *string `locationName:"status" type:"string" enum:"creating,available,in-use,deleting,deleted,error"`
 
 	// Any tags assigned to the volume.
 	Tags []*Tag `locationName:"tagSet" locationNameList:"item" type:"list"`
@@ -15017,7 +19556,7 @@ type Volume struct {
 
 	// The volume type. This can be gp2 for General Purpose (SSD) volumes, io1 for
 	// Provisioned IOPS (SSD) volumes, or standard for Magnetic volumes.
-	VolumeType *string `locationName:"volumeType" type:"string"`
+	VolumeType *string `locationName:"volumeType" type:"string" enum:"standard,io1,gp2"`
 
 	metadataVolume `json:"-", xml:"-"`
 }
@@ -15026,6 +19565,11 @@ type metadataVolume struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Volume.
+func (s Volume) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes volume attachment details.
 type VolumeAttachment struct {
 	// The time stamp when the attachment initiated.
@@ -15041,7 +19585,7 @@ type VolumeAttachment struct {
 	InstanceID *string `locationName:"instanceId" type:"string"`
 
 	// The attachment state of the volume.
-	State *string `locationName:"status" type:"string"`
+	State *string `locationName:"status" type:"string" enum:"attaching,attached,detaching,detached"`
 
 	// The ID of the volume.
 	VolumeID *string `locationName:"volumeId" type:"string"`
@@ -15053,6 +19597,83 @@ type metadataVolumeAttachment struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the VolumeAttachment.
+func (s VolumeAttachment) String() string {
+	return awsutil.StringValue(s)
+}
+
+// VolumeAttachmentState is an enum of the values of VolumeAttachmentState members.
+type VolumeAttachmentState string
+
+// The values of VolumeAttachmentState.
+const (
+	VolumeAttachmentStateAttaching VolumeAttachmentState = "attaching"
+	VolumeAttachmentStateAttached  VolumeAttachmentState = "attached"
+	VolumeAttachmentStateDetaching VolumeAttachmentState = "detaching"
+	VolumeAttachmentStateDetached  VolumeAttachmentState = "detached"
+)
+
+// VolumeAttachmentStateValues returns the values of VolumeAttachmentState known to this
+// version of the SDK. The service may accept values added since.
+func VolumeAttachmentStateValues() []VolumeAttachmentState {
+	return []VolumeAttachmentState{
+		VolumeAttachmentState("attaching"),
+		VolumeAttachmentState("attached"),
+		VolumeAttachmentState("detaching"),
+		VolumeAttachmentState("detached"),
+	}
+}
+
+// String returns the VolumeAttachmentState as the string of a member.
+func (v VolumeAttachmentState) String() string {
+	return string(v)
+}
+
+// ParseVolumeAttachmentState returns the VolumeAttachmentState of str, failing if it is not
+// one of the VolumeAttachmentStateValues.
+func ParseVolumeAttachmentState(str string) (VolumeAttachmentState, error) {
+	for _, v := range VolumeAttachmentStateValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VolumeAttachmentState value %q", str)
+}
+
+// VolumeAttributeName is an enum of the values of VolumeAttributeName members.
+type VolumeAttributeName string
+
+// The values of VolumeAttributeName.
+const (
+	VolumeAttributeNameAutoEnableIO VolumeAttributeName = "autoEnableIO"
+	VolumeAttributeNameProductCodes VolumeAttributeName = "productCodes"
+)
+
+// VolumeAttributeNameValues returns the values of VolumeAttributeName known to this
+// version of the SDK. The service may accept values added since.
+func VolumeAttributeNameValues() []VolumeAttributeName {
+	return []VolumeAttributeName{
+		VolumeAttributeName("autoEnableIO"),
+		VolumeAttributeName("productCodes"),
+	}
+}
+
+// String returns the VolumeAttributeName as the string of a member.
+func (v VolumeAttributeName) String() string {
+	return string(v)
+}
+
+// ParseVolumeAttributeName returns the VolumeAttributeName of str, failing if it is not
+// one of the VolumeAttributeNameValues.
+func ParseVolumeAttributeName(str string) (VolumeAttributeName, error) {
+	for _, v := range VolumeAttributeNameValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VolumeAttributeName value %q", str)
+}
+
 // Describes an Amazon EBS volume.
 type VolumeDetail struct {
 	// The size of the volume, in GiB.
@@ -15065,6 +19686,53 @@ type metadataVolumeDetail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the VolumeDetail.
+func (s VolumeDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
+// VolumeState is an enum of the values of VolumeState members.
+type VolumeState string
+
+// The values of VolumeState.
+const (
+	VolumeStateCreating  VolumeState = "creating"
+	VolumeStateAvailable VolumeState = "available"
+	VolumeStateInUse     VolumeState = "in-use"
+	VolumeStateDeleting  VolumeState = "deleting"
+	VolumeStateDeleted   VolumeState = "deleted"
+	VolumeStateError     VolumeState = "error"
+)
+
+// VolumeStateValues returns the values of VolumeState known to this
+// version of the SDK. The service may accept values added since.
+func VolumeStateValues() []VolumeState {
+	return []VolumeState{
+		VolumeState("creating"),
+		VolumeState("available"),
+		VolumeState("in-use"),
+		VolumeState("deleting"),
+		VolumeState("deleted"),
+		VolumeState("error"),
+	}
+}
+
+// String returns the VolumeState as the string of a member.
+func (v VolumeState) String() string {
+	return string(v)
+}
+
+// ParseVolumeState returns the VolumeState of str, failing if it is not
+// one of the VolumeStateValues.
+func ParseVolumeState(str string) (VolumeState, error) {
+	for _, v := range VolumeStateValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VolumeState value %q", str)
+}
+
 // Describes a volume status operation code.
 type VolumeStatusAction struct {
 	// The code identifying the operation, for example, enable-volume-io.
@@ -15086,10 +19754,15 @@ type metadataVolumeStatusAction struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the VolumeStatusAction.
+func (s VolumeStatusAction) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a volume status.
 type VolumeStatusDetails struct {
 	// The name of the volume status.
-	Name *string `locationName:"name" type:"string"`
+	Name *string `locationName:"name" type:"string" enum:"io-enabled,io-performance"`
 
 	// The intended status of the volume status.
 	Status *string `locationName:"status" type:"string"`
@@ -15101,6 +19774,11 @@ type metadataVolumeStatusDetails struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the VolumeStatusDetails.
+func (s VolumeStatusDetails) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a volume status event.
 type VolumeStatusEvent struct {
 	// A description of the event.
@@ -15125,13 +19803,18 @@ type metadataVolumeStatusEvent struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the VolumeStatusEvent.
+func (s VolumeStatusEvent) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the status of a volume.
 type VolumeStatusInfo struct {
 	// The details of the volume status.
 	Details []*VolumeStatusDetails `locationName:"details" locationNameList:"item" type:"list"`
 
 	// The status of the volume.
-	Status *string `locationName:"status" type:"string"`
+	Status *string `locationName:"status" type:"string" enum:"ok,impaired,insufficient-data"`
 
 	metadataVolumeStatusInfo `json:"-", xml:"-"`
 }
@@ -15140,6 +19823,47 @@ type metadataVolumeStatusInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the VolumeStatusInfo.
+func (s VolumeStatusInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
+// VolumeStatusInfoStatus is an enum of the values of VolumeStatusInfoStatus members.
+type VolumeStatusInfoStatus string
+
+// The values of VolumeStatusInfoStatus.
+const (
+	VolumeStatusInfoStatusOk               VolumeStatusInfoStatus = "ok"
+	VolumeStatusInfoStatusImpaired         VolumeStatusInfoStatus = "impaired"
+	VolumeStatusInfoStatusInsufficientData VolumeStatusInfoStatus = "insufficient-data"
+)
+
+// VolumeStatusInfoStatusValues returns the values of VolumeStatusInfoStatus known to this
+// version of the SDK. The service may accept values added since.
+func VolumeStatusInfoStatusValues() []VolumeStatusInfoStatus {
+	return []VolumeStatusInfoStatus{
+		VolumeStatusInfoStatus("ok"),
+		VolumeStatusInfoStatus("impaired"),
+		VolumeStatusInfoStatus("insufficient-data"),
+	}
+}
+
+// String returns the VolumeStatusInfoStatus as the string of a member.
+func (v VolumeStatusInfoStatus) String() string {
+	return string(v)
+}
+
+// ParseVolumeStatusInfoStatus returns the VolumeStatusInfoStatus of str, failing if it is not
+// one of the VolumeStatusInfoStatusValues.
+func ParseVolumeStatusInfoStatus(str string) (VolumeStatusInfoStatus, error) {
+	for _, v := range VolumeStatusInfoStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VolumeStatusInfoStatus value %q", str)
+}
+
 // Describes the volume status.
 type VolumeStatusItem struct {
 	// The details of the operation.
@@ -15162,4 +19886,217 @@ type VolumeStatusItem struct {
 
 type metadataVolumeStatusItem struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the VolumeStatusItem.
+func (s VolumeStatusItem) String() string {
+	return awsutil.StringValue(s)
+}
+
+// VolumeStatusName is an enum of the values of VolumeStatusName members.
+type VolumeStatusName string
+
+// The values of VolumeStatusName.
+const (
+	VolumeStatusNameIoEnabled     VolumeStatusName = "io-enabled"
+	VolumeStatusNameIoPerformance VolumeStatusName = "io-performance"
+)
+
+// VolumeStatusNameValues returns the values of VolumeStatusName known to this
+// version of the SDK. The service may accept values added since.
+func VolumeStatusNameValues() []VolumeStatusName {
+	return []VolumeStatusName{
+		VolumeStatusName("io-enabled"),
+		VolumeStatusName("io-performance"),
+	}
+}
+
+// String returns the VolumeStatusName as the string of a member.
+func (v VolumeStatusName) String() string {
+	return string(v)
+}
+
+// ParseVolumeStatusName returns the VolumeStatusName of str, failing if it is not
+// one of the VolumeStatusNameValues.
+func ParseVolumeStatusName(str string) (VolumeStatusName, error) {
+	for _, v := range VolumeStatusNameValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VolumeStatusName value %q", str)
+}
+
+// VolumeType is an enum of the values of VolumeType members.
+type VolumeType string
+
+// The values of VolumeType.
+const (
+	VolumeTypeStandard VolumeType = "standard"
+	VolumeTypeIo1      VolumeType = "io1"
+	VolumeTypeGp2      VolumeType = "gp2"
+)
+
+// VolumeTypeValues returns the values of VolumeType known to this
+// version of the SDK. The service may accept values added since.
+func VolumeTypeValues() []VolumeType {
+	return []VolumeType{
+		VolumeType("standard"),
+		VolumeType("io1"),
+		VolumeType("gp2"),
+	}
+}
+
+// String returns the VolumeType as the string of a member.
+func (v VolumeType) String() string {
+	return string(v)
+}
+
+// ParseVolumeType returns the VolumeType of str, failing if it is not
+// one of the VolumeTypeValues.
+func ParseVolumeType(str string) (VolumeType, error) {
+	for _, v := range VolumeTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VolumeType value %q", str)
+}
+
+// VpcAttributeName is an enum of the values of VpcAttributeName members.
+type VpcAttributeName string
+
+// The values of VpcAttributeName.
+const (
+	VpcAttributeNameEnableDnsSupport   VpcAttributeName = "enableDnsSupport"
+	VpcAttributeNameEnableDnsHostnames VpcAttributeName = "enableDnsHostnames"
+)
+
+// VpcAttributeNameValues returns the values of VpcAttributeName known to this
+// version of the SDK. The service may accept values added since.
+func VpcAttributeNameValues() []VpcAttributeName {
+	return []VpcAttributeName{
+		VpcAttributeName("enableDnsSupport"),
+		VpcAttributeName("enableDnsHostnames"),
+	}
+}
+
+// String returns the VpcAttributeName as the string of a member.
+func (v VpcAttributeName) String() string {
+	return string(v)
+}
+
+// ParseVpcAttributeName returns the VpcAttributeName of str, failing if it is not
+// one of the VpcAttributeNameValues.
+func ParseVpcAttributeName(str string) (VpcAttributeName, error) {
+	for _, v := range VpcAttributeNameValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VpcAttributeName value %q", str)
+}
+
+// VpcState is an enum of the values of VpcState members.
+type VpcState string
+
+// The values of VpcState.
+const (
+	VpcStatePending   VpcState = "pending"
+	VpcStateAvailable VpcState = "available"
+)
+
+// VpcStateValues returns the values of VpcState known to this
+// version of the SDK. The service may accept values added since.
+func VpcStateValues() []VpcState {
+	return []VpcState{
+		VpcState("pending"),
+		VpcState("available"),
+	}
+}
+
+// String returns the VpcState as the string of a member.
+func (v VpcState) String() string {
+	return string(v)
+}
+
+// ParseVpcState returns the VpcState of str, failing if it is not
+// one of the VpcStateValues.
+func ParseVpcState(str string) (VpcState, error) {
+	for _, v := range VpcStateValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VpcState value %q", str)
+}
+
+// VpnState is an enum of the values of VpnState members.
+type VpnState string
+
+// The values of VpnState.
+const (
+	VpnStatePending   VpnState = "pending"
+	VpnStateAvailable VpnState = "available"
+	VpnStateDeleting  VpnState = "deleting"
+	VpnStateDeleted   VpnState = "deleted"
+)
+
+// VpnStateValues returns the values of VpnState known to this
+// version of the SDK. The service may accept values added since.
+func VpnStateValues() []VpnState {
+	return []VpnState{
+		VpnState("pending"),
+		VpnState("available"),
+		VpnState("deleting"),
+		VpnState("deleted"),
+	}
+}
+
+// String returns the VpnState as the string of a member.
+func (v VpnState) String() string {
+	return string(v)
+}
+
+// ParseVpnState returns the VpnState of str, failing if it is not
+// one of the VpnStateValues.
+func ParseVpnState(str string) (VpnState, error) {
+	for _, v := range VpnStateValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VpnState value %q", str)
+}
+
+// VpnStaticRouteSource is an enum of the values of VpnStaticRouteSource members.
+type VpnStaticRouteSource string
+
+// The values of VpnStaticRouteSource.
+const (
+	VpnStaticRouteSourceStatic VpnStaticRouteSource = "Static"
+)
+
+// VpnStaticRouteSourceValues returns the values of VpnStaticRouteSource known to this
+// version of the SDK. The service may accept values added since.
+func VpnStaticRouteSourceValues() []VpnStaticRouteSource {
+	return []VpnStaticRouteSource{
+		VpnStaticRouteSource("Static"),
+	}
+}
+
+// String returns the VpnStaticRouteSource as the string of a member.
+func (v VpnStaticRouteSource) String() string {
+	return string(v)
+}
+
+// ParseVpnStaticRouteSource returns the VpnStaticRouteSource of str, failing if it is not
+// one of the VpnStaticRouteSourceValues.
+func ParseVpnStaticRouteSource(str string) (VpnStaticRouteSource, error) {
+	for _, v := range VpnStaticRouteSourceValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VpnStaticRouteSource value %q", str)
 }
\ No newline at end of file
@@ -25,7 +25,7 @@ func New(config *aws.Config) *EC2 {
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(ec2query.Build)
 	service.Handlers.Unmarshal.PushBack(ec2query.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(ec2query.UnmarshalMeta)
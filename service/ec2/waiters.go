@@ -0,0 +1,449 @@
+// THIS FILE IS AUTOMATICALLY GENERATED. DO NOT EDIT.
+
+package ec2
+
+import (
+	"time"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+)
+
+// WaitUntilBundleTaskComplete polls the DescribeBundleTasks operation every
+// 15 seconds until the BundleTaskComplete state is reached. An error is returned
+// if a failure state is reached or the state is not reached within
+// 40 attempts.
+func (c *EC2) WaitUntilBundleTaskComplete(input *DescribeBundleTasksInput) error {
+	w := &aws.Waiter{
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaiterAcceptor{
+			{State: "success", Matcher: "pathAll", Argument: "BundleTasks[].State", Expected: "complete"},
+			{State: "failure", Matcher: "pathAny", Argument: "BundleTasks[].State", Expected: "failed"},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeBundleTasksRequest(input)
+			return req
+		},
+	}
+	return w.WaitUntil()
+}
+
+// WaitUntilConversionTaskCancelled polls the DescribeConversionTasks operation every
+// 15 seconds until the ConversionTaskCancelled state is reached. An error is returned
+// if a failure state is reached or the state is not reached within
+// 40 attempts.
+func (c *EC2) WaitUntilConversionTaskCancelled(input *DescribeConversionTasksInput) error {
+	w := &aws.Waiter{
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaiterAcceptor{
+			{State: "success", Matcher: "pathAll", Argument: "ConversionTasks[].State", Expected: "cancelled"},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeConversionTasksRequest(input)
+			return req
+		},
+	}
+	return w.WaitUntil()
+}
+
+// WaitUntilConversionTaskCompleted polls the DescribeConversionTasks operation every
+// 15 seconds until the ConversionTaskCompleted state is reached. An error is returned
+// if a failure state is reached or the state is not reached within
+// 40 attempts.
+func (c *EC2) WaitUntilConversionTaskCompleted(input *DescribeConversionTasksInput) error {
+	w := &aws.Waiter{
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaiterAcceptor{
+			{State: "success", Matcher: "pathAll", Argument: "ConversionTasks[].State", Expected: "completed"},
+			{State: "failure", Matcher: "pathAny", Argument: "ConversionTasks[].State", Expected: "cancelled"},
+			{State: "failure", Matcher: "pathAny", Argument: "ConversionTasks[].State", Expected: "cancelling"},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeConversionTasksRequest(input)
+			return req
+		},
+	}
+	return w.WaitUntil()
+}
+
+// WaitUntilConversionTaskDeleted polls the DescribeConversionTasks operation every
+// 15 seconds until the ConversionTaskDeleted state is reached. An error is returned
+// if a failure state is reached or the state is not reached within
+// 40 attempts.
+func (c *EC2) WaitUntilConversionTaskDeleted(input *DescribeConversionTasksInput) error {
+	w := &aws.Waiter{
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaiterAcceptor{
+			{State: "success", Matcher: "pathAll", Argument: "ConversionTasks[].State", Expected: "deleted"},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeConversionTasksRequest(input)
+			return req
+		},
+	}
+	return w.WaitUntil()
+}
+
+// WaitUntilCustomerGatewayAvailable polls the DescribeCustomerGateways operation every
+// 15 seconds until the CustomerGatewayAvailable state is reached. An error is returned
+// if a failure state is reached or the state is not reached within
+// 40 attempts.
+func (c *EC2) WaitUntilCustomerGatewayAvailable(input *DescribeCustomerGatewaysInput) error {
+	w := &aws.Waiter{
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaiterAcceptor{
+			{State: "success", Matcher: "pathAll", Argument: "CustomerGateways[].State", Expected: "available"},
+			{State: "failure", Matcher: "pathAny", Argument: "CustomerGateways[].State", Expected: "deleted"},
+			{State: "failure", Matcher: "pathAny", Argument: "CustomerGateways[].State", Expected: "deleting"},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeCustomerGatewaysRequest(input)
+			return req
+		},
+	}
+	return w.WaitUntil()
+}
+
+// WaitUntilExportTaskCancelled polls the DescribeExportTasks operation every
+// 15 seconds until the ExportTaskCancelled state is reached. An error is returned
+// if a failure state is reached or the state is not reached within
+// 40 attempts.
+func (c *EC2) WaitUntilExportTaskCancelled(input *DescribeExportTasksInput) error {
+	w := &aws.Waiter{
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaiterAcceptor{
+			{State: "success", Matcher: "pathAll", Argument: "ExportTasks[].State", Expected: "cancelled"},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeExportTasksRequest(input)
+			return req
+		},
+	}
+	return w.WaitUntil()
+}
+
+// WaitUntilExportTaskCompleted polls the DescribeExportTasks operation every
+// 15 seconds until the ExportTaskCompleted state is reached. An error is returned
+// if a failure state is reached or the state is not reached within
+// 40 attempts.
+func (c *EC2) WaitUntilExportTaskCompleted(input *DescribeExportTasksInput) error {
+	w := &aws.Waiter{
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaiterAcceptor{
+			{State: "success", Matcher: "pathAll", Argument: "ExportTasks[].State", Expected: "completed"},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeExportTasksRequest(input)
+			return req
+		},
+	}
+	return w.WaitUntil()
+}
+
+// WaitUntilImageAvailable polls the DescribeImages operation every
+// 15 seconds until the ImageAvailable state is reached. An error is returned
+// if a failure state is reached or the state is not reached within
+// 40 attempts.
+func (c *EC2) WaitUntilImageAvailable(input *DescribeImagesInput) error {
+	w := &aws.Waiter{
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaiterAcceptor{
+			{State: "success", Matcher: "pathAll", Argument: "Images[].State", Expected: "available"},
+			{State: "failure", Matcher: "pathAny", Argument: "Images[].State", Expected: "failed"},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeImagesRequest(input)
+			return req
+		},
+	}
+	return w.WaitUntil()
+}
+
+// WaitUntilInstanceRunning polls the DescribeInstances operation every
+// 15 seconds until the InstanceRunning state is reached. An error is returned
+// if a failure state is reached or the state is not reached within
+// 40 attempts.
+func (c *EC2) WaitUntilInstanceRunning(input *DescribeInstancesInput) error {
+	w := &aws.Waiter{
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaiterAcceptor{
+			{State: "success", Matcher: "pathAll", Argument: "Reservations[].Instances[].State.Name", Expected: "running"},
+			{State: "failure", Matcher: "pathAny", Argument: "Reservations[].Instances[].State.Name", Expected: "shutting-down"},
+			{State: "failure", Matcher: "pathAny", Argument: "Reservations[].Instances[].State.Name", Expected: "terminated"},
+			{State: "failure", Matcher: "pathAny", Argument: "Reservations[].Instances[].State.Name", Expected: "stopping"},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeInstancesRequest(input)
+			return req
+		},
+	}
+	return w.WaitUntil()
+}
+
+// WaitUntilInstanceStatusOk polls the DescribeInstanceStatus operation every
+// 15 seconds until the InstanceStatusOk state is reached. An error is returned
+// if a failure state is reached or the state is not reached within
+// 40 attempts.
+func (c *EC2) WaitUntilInstanceStatusOk(input *DescribeInstanceStatusInput) error {
+	w := &aws.Waiter{
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaiterAcceptor{
+			{State: "success", Matcher: "pathAll", Argument: "InstanceStatuses[].InstanceStatus.Status", Expected: "ok"},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeInstanceStatusRequest(input)
+			return req
+		},
+	}
+	return w.WaitUntil()
+}
+
+// WaitUntilInstanceStopped polls the DescribeInstances operation every
+// 15 seconds until the InstanceStopped state is reached. An error is returned
+// if a failure state is reached or the state is not reached within
+// 40 attempts.
+func (c *EC2) WaitUntilInstanceStopped(input *DescribeInstancesInput) error {
+	w := &aws.Waiter{
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaiterAcceptor{
+			{State: "success", Matcher: "pathAll", Argument: "Reservations[].Instances[].State.Name", Expected: "stopped"},
+			{State: "failure", Matcher: "pathAny", Argument: "Reservations[].Instances[].State.Name", Expected: "pending"},
+			{State: "failure", Matcher: "pathAny", Argument: "Reservations[].Instances[].State.Name", Expected: "terminated"},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeInstancesRequest(input)
+			return req
+		},
+	}
+	return w.WaitUntil()
+}
+
+// WaitUntilInstanceTerminated polls the DescribeInstances operation every
+// 15 seconds until the InstanceTerminated state is reached. An error is returned
+// if a failure state is reached or the state is not reached within
+// 40 attempts.
+func (c *EC2) WaitUntilInstanceTerminated(input *DescribeInstancesInput) error {
+	w := &aws.Waiter{
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaiterAcceptor{
+			{State: "success", Matcher: "pathAll", Argument: "Reservations[].Instances[].State.Name", Expected: "terminated"},
+			{State: "failure", Matcher: "pathAny", Argument: "Reservations[].Instances[].State.Name", Expected: "pending"},
+			{State: "failure", Matcher: "pathAny", Argument: "Reservations[].Instances[].State.Name", Expected: "stopping"},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeInstancesRequest(input)
+			return req
+		},
+	}
+	return w.WaitUntil()
+}
+
+// WaitUntilSnapshotCompleted polls the DescribeSnapshots operation every
+// 15 seconds until the SnapshotCompleted state is reached. An error is returned
+// if a failure state is reached or the state is not reached within
+// 40 attempts.
+func (c *EC2) WaitUntilSnapshotCompleted(input *DescribeSnapshotsInput) error {
+	w := &aws.Waiter{
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaiterAcceptor{
+			{State: "success", Matcher: "pathAll", Argument: "Snapshots[].State", Expected: "completed"},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeSnapshotsRequest(input)
+			return req
+		},
+	}
+	return w.WaitUntil()
+}
+
+// WaitUntilSpotInstanceRequestFulfilled polls the DescribeSpotInstanceRequests operation every
+// 15 seconds until the SpotInstanceRequestFulfilled state is reached. An error is returned
+// if a failure state is reached or the state is not reached within
+// 40 attempts.
+func (c *EC2) WaitUntilSpotInstanceRequestFulfilled(input *DescribeSpotInstanceRequestsInput) error {
+	w := &aws.Waiter{
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaiterAcceptor{
+			{State: "success", Matcher: "pathAll", Argument: "SpotInstanceRequests[].Status.Code", Expected: "fulfilled"},
+			{State: "failure", Matcher: "pathAny", Argument: "SpotInstanceRequests[].Status.Code", Expected: "schedule-expired"},
+			{State: "failure", Matcher: "pathAny", Argument: "SpotInstanceRequests[].Status.Code", Expected: "canceled-before-fulfillment"},
+			{State: "failure", Matcher: "pathAny", Argument: "SpotInstanceRequests[].Status.Code", Expected: "bad-parameters"},
+			{State: "failure", Matcher: "pathAny", Argument: "SpotInstanceRequests[].Status.Code", Expected: "system-error"},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeSpotInstanceRequestsRequest(input)
+			return req
+		},
+	}
+	return w.WaitUntil()
+}
+
+// WaitUntilSubnetAvailable polls the DescribeSubnets operation every
+// 15 seconds until the SubnetAvailable state is reached. An error is returned
+// if a failure state is reached or the state is not reached within
+// 40 attempts.
+func (c *EC2) WaitUntilSubnetAvailable(input *DescribeSubnetsInput) error {
+	w := &aws.Waiter{
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaiterAcceptor{
+			{State: "success", Matcher: "pathAll", Argument: "Subnets[].State", Expected: "available"},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeSubnetsRequest(input)
+			return req
+		},
+	}
+	return w.WaitUntil()
+}
+
+// WaitUntilSystemStatusOk polls the DescribeInstanceStatus operation every
+// 15 seconds until the SystemStatusOk state is reached. An error is returned
+// if a failure state is reached or the state is not reached within
+// 40 attempts.
+func (c *EC2) WaitUntilSystemStatusOk(input *DescribeInstanceStatusInput) error {
+	w := &aws.Waiter{
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaiterAcceptor{
+			{State: "success", Matcher: "pathAll", Argument: "InstanceStatuses[].SystemStatus.Status", Expected: "ok"},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeInstanceStatusRequest(input)
+			return req
+		},
+	}
+	return w.WaitUntil()
+}
+
+// WaitUntilVolumeAvailable polls the DescribeVolumes operation every
+// 15 seconds until the VolumeAvailable state is reached. An error is returned
+// if a failure state is reached or the state is not reached within
+// 40 attempts.
+func (c *EC2) WaitUntilVolumeAvailable(input *DescribeVolumesInput) error {
+	w := &aws.Waiter{
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaiterAcceptor{
+			{State: "success", Matcher: "pathAll", Argument: "Volumes[].State", Expected: "available"},
+			{State: "failure", Matcher: "pathAny", Argument: "Volumes[].State", Expected: "deleted"},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeVolumesRequest(input)
+			return req
+		},
+	}
+	return w.WaitUntil()
+}
+
+// WaitUntilVolumeDeleted polls the DescribeVolumes operation every
+// 15 seconds until the VolumeDeleted state is reached. An error is returned
+// if a failure state is reached or the state is not reached within
+// 40 attempts.
+func (c *EC2) WaitUntilVolumeDeleted(input *DescribeVolumesInput) error {
+	w := &aws.Waiter{
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaiterAcceptor{
+			{State: "success", Matcher: "pathAll", Argument: "Volumes[].State", Expected: "deleted"},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeVolumesRequest(input)
+			return req
+		},
+	}
+	return w.WaitUntil()
+}
+
+// WaitUntilVolumeInUse polls the DescribeVolumes operation every
+// 15 seconds until the VolumeInUse state is reached. An error is returned
+// if a failure state is reached or the state is not reached within
+// 40 attempts.
+func (c *EC2) WaitUntilVolumeInUse(input *DescribeVolumesInput) error {
+	w := &aws.Waiter{
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaiterAcceptor{
+			{State: "success", Matcher: "pathAll", Argument: "Volumes[].State", Expected: "in-use"},
+			{State: "failure", Matcher: "pathAny", Argument: "Volumes[].State", Expected: "deleted"},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeVolumesRequest(input)
+			return req
+		},
+	}
+	return w.WaitUntil()
+}
+
+// WaitUntilVpcAvailable polls the DescribeVPCs operation every
+// 15 seconds until the VpcAvailable state is reached. An error is returned
+// if a failure state is reached or the state is not reached within
+// 40 attempts.
+func (c *EC2) WaitUntilVpcAvailable(input *DescribeVPCsInput) error {
+	w := &aws.Waiter{
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaiterAcceptor{
+			{State: "success", Matcher: "pathAll", Argument: "VPCs[].State", Expected: "available"},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeVPCsRequest(input)
+			return req
+		},
+	}
+	return w.WaitUntil()
+}
+
+// WaitUntilVpnConnectionAvailable polls the DescribeVPNConnections operation every
+// 15 seconds until the VpnConnectionAvailable state is reached. An error is returned
+// if a failure state is reached or the state is not reached within
+// 40 attempts.
+func (c *EC2) WaitUntilVpnConnectionAvailable(input *DescribeVPNConnectionsInput) error {
+	w := &aws.Waiter{
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaiterAcceptor{
+			{State: "success", Matcher: "pathAll", Argument: "VPNConnections[].State", Expected: "available"},
+			{State: "failure", Matcher: "pathAny", Argument: "VPNConnections[].State", Expected: "deleting"},
+			{State: "failure", Matcher: "pathAny", Argument: "VPNConnections[].State", Expected: "deleted"},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeVPNConnectionsRequest(input)
+			return req
+		},
+	}
+	return w.WaitUntil()
+}
+
+// WaitUntilVpnConnectionDeleted polls the DescribeVPNConnections operation every
+// 15 seconds until the VpnConnectionDeleted state is reached. An error is returned
+// if a failure state is reached or the state is not reached within
+// 40 attempts.
+func (c *EC2) WaitUntilVpnConnectionDeleted(input *DescribeVPNConnectionsInput) error {
+	w := &aws.Waiter{
+		Delay:       15 * time.Second,
+		MaxAttempts: 40,
+		Acceptors: []aws.WaiterAcceptor{
+			{State: "success", Matcher: "pathAll", Argument: "VPNConnections[].State", Expected: "deleted"},
+			{State: "failure", Matcher: "pathAny", Argument: "VPNConnections[].State", Expected: "pending"},
+		},
+		NewRequest: func() *aws.Request {
+			req, _ := c.DescribeVPNConnectionsRequest(input)
+			return req
+		},
+	}
+	return w.WaitUntil()
+}
\ No newline at end of file
@@ -5,6 +5,7 @@ package ecs
 
 import (
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // CreateClusterRequest generates a request for the CreateCluster operation.
@@ -620,6 +621,11 @@ type metadataCluster struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Cluster.
+func (s Cluster) String() string {
+	return awsutil.StringValue(s)
+}
+
 type Container struct {
 	// The Amazon Resource Name (ARN) of the container.
 	ContainerARN *string `locationName:"containerArn" type:"string"`
@@ -649,6 +655,11 @@ type metadataContainer struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Container.
+func (s Container) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container definitions are used in task definitions to describe the different
 // containers that are launched as part of a task.
 type ContainerDefinition struct {
@@ -714,6 +725,11 @@ type metadataContainerDefinition struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ContainerDefinition.
+func (s ContainerDefinition) String() string {
+	return awsutil.StringValue(s)
+}
+
 // An Amazon EC2 instance that is running the Amazon ECS agent and has been
 // registered with a cluster.
 type ContainerInstance struct {
@@ -751,6 +767,11 @@ type metadataContainerInstance struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ContainerInstance.
+func (s ContainerInstance) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ContainerOverride struct {
 	// The command to send to the container that receives the override.
 	Command []*string `locationName:"command" type:"list"`
@@ -765,6 +786,11 @@ type metadataContainerOverride struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ContainerOverride.
+func (s ContainerOverride) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateClusterInput struct {
 	// The name of your cluster. If you do not specify a name for your cluster,
 	// you will create a cluster named default.
@@ -777,6 +803,11 @@ type metadataCreateClusterInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateClusterInput.
+func (s CreateClusterInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateClusterOutput struct {
 	// The full description of your new cluster.
 	Cluster *Cluster `locationName:"cluster" type:"structure"`
@@ -788,6 +819,11 @@ type metadataCreateClusterOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateClusterOutput.
+func (s CreateClusterOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteClusterInput struct {
 	// The short name or full Amazon Resource Name (ARN) of the cluster that you
 	// want to delete.
@@ -800,6 +836,11 @@ type metadataDeleteClusterInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteClusterInput.
+func (s DeleteClusterInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteClusterOutput struct {
 	// The full description of the deleted cluster.
 	Cluster *Cluster `locationName:"cluster" type:"structure"`
@@ -811,6 +852,11 @@ type metadataDeleteClusterOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteClusterOutput.
+func (s DeleteClusterOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeregisterContainerInstanceInput struct {
 	// The short name or full Amazon Resource Name (ARN) of the cluster that hosts
 	// the container instance you want to deregister. If you do not specify a cluster,
@@ -837,6 +883,11 @@ type metadataDeregisterContainerInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeregisterContainerInstanceInput.
+func (s DeregisterContainerInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeregisterContainerInstanceOutput struct {
 	// An Amazon EC2 instance that is running the Amazon ECS agent and has been
 	// registered with a cluster.
@@ -849,6 +900,11 @@ type metadataDeregisterContainerInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeregisterContainerInstanceOutput.
+func (s DeregisterContainerInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeregisterTaskDefinitionInput struct {
 	// The family and revision (family:revision) or full Amazon Resource Name (ARN)
 	// of the task definition that you want to deregister.
@@ -861,6 +917,11 @@ type metadataDeregisterTaskDefinitionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeregisterTaskDefinitionInput.
+func (s DeregisterTaskDefinitionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeregisterTaskDefinitionOutput struct {
 	// The full description of the deregistered task.
 	TaskDefinition *TaskDefinition `locationName:"taskDefinition" type:"structure"`
@@ -872,6 +933,11 @@ type metadataDeregisterTaskDefinitionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeregisterTaskDefinitionOutput.
+func (s DeregisterTaskDefinitionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeClustersInput struct {
 	// A space-separated list of cluster names or full cluster Amazon Resource Name
 	// (ARN) entries. If you do not specify a cluster, the default cluster is assumed.
@@ -884,6 +950,11 @@ type metadataDescribeClustersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeClustersInput.
+func (s DescribeClustersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeClustersOutput struct {
 	// The list of clusters.
 	Clusters []*Cluster `locationName:"clusters" type:"list"`
@@ -897,6 +968,11 @@ type metadataDescribeClustersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeClustersOutput.
+func (s DescribeClustersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeContainerInstancesInput struct {
 	// The short name or full Amazon Resource Name (ARN) of the cluster that hosts
 	// the container instances you want to describe. If you do not specify a cluster,
@@ -914,6 +990,11 @@ type metadataDescribeContainerInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeContainerInstancesInput.
+func (s DescribeContainerInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeContainerInstancesOutput struct {
 	// The list of container instances.
 	ContainerInstances []*ContainerInstance `locationName:"containerInstances" type:"list"`
@@ -927,6 +1008,11 @@ type metadataDescribeContainerInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeContainerInstancesOutput.
+func (s DescribeContainerInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeTaskDefinitionInput struct {
 	// The family for the latest revision, family and revision (family:revision)
 	// for a specific revision in the family, or full Amazon Resource Name (ARN)
@@ -940,6 +1026,11 @@ type metadataDescribeTaskDefinitionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeTaskDefinitionInput.
+func (s DescribeTaskDefinitionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeTaskDefinitionOutput struct {
 	// The full task definition description.
 	TaskDefinition *TaskDefinition `locationName:"taskDefinition" type:"structure"`
@@ -951,6 +1042,11 @@ type metadataDescribeTaskDefinitionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeTaskDefinitionOutput.
+func (s DescribeTaskDefinitionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeTasksInput struct {
 	// The short name or full Amazon Resource Name (ARN) of the cluster that hosts
 	// the task you want to describe. If you do not specify a cluster, the default
@@ -967,6 +1063,11 @@ type metadataDescribeTasksInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeTasksInput.
+func (s DescribeTasksInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeTasksOutput struct {
 	Failures []*Failure `locationName:"failures" type:"list"`
 
@@ -980,6 +1081,11 @@ type metadataDescribeTasksOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeTasksOutput.
+func (s DescribeTasksOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DiscoverPollEndpointInput struct {
 	// The cluster that the container instance belongs to.
 	Cluster *string `locationName:"cluster" type:"string"`
@@ -998,6 +1104,11 @@ type metadataDiscoverPollEndpointInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DiscoverPollEndpointInput.
+func (s DiscoverPollEndpointInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DiscoverPollEndpointOutput struct {
 	// The endpoint for the Amazon ECS agent to poll.
 	Endpoint *string `locationName:"endpoint" type:"string"`
@@ -1009,6 +1120,11 @@ type metadataDiscoverPollEndpointOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DiscoverPollEndpointOutput.
+func (s DiscoverPollEndpointOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type Failure struct {
 	// The Amazon Resource Name (ARN) of the failed resource.
 	ARN *string `locationName:"arn" type:"string"`
@@ -1023,6 +1139,11 @@ type metadataFailure struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Failure.
+func (s Failure) String() string {
+	return awsutil.StringValue(s)
+}
+
 type HostVolumeProperties struct {
 	// The path on the host container instance that is presented to the container.
 	// If this parameter is empty, then the Docker daemon has assigned a host path
@@ -1036,6 +1157,11 @@ type metadataHostVolumeProperties struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the HostVolumeProperties.
+func (s HostVolumeProperties) String() string {
+	return awsutil.StringValue(s)
+}
+
 type KeyValuePair struct {
 	// The name of the key value pair.
 	Name *string `locationName:"name" type:"string"`
@@ -1050,6 +1176,11 @@ type metadataKeyValuePair struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the KeyValuePair.
+func (s KeyValuePair) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListClustersInput struct {
 	// The maximum number of cluster results returned by ListClusters in paginated
 	// output. When this parameter is used, ListClusters only returns maxResults
@@ -1073,6 +1204,11 @@ type metadataListClustersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListClustersInput.
+func (s ListClustersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListClustersOutput struct {
 	// The list of full Amazon Resource Name (ARN) entries for each cluster associated
 	// with your account.
@@ -1091,6 +1227,11 @@ type metadataListClustersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListClustersOutput.
+func (s ListClustersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListContainerInstancesInput struct {
 	// The short name or full Amazon Resource Name (ARN) of the cluster that hosts
 	// the container instances you want to list. If you do not specify a cluster,
@@ -1121,6 +1262,11 @@ type metadataListContainerInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListContainerInstancesInput.
+func (s ListContainerInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListContainerInstancesOutput struct {
 	// The list of container instance full Amazon Resource Name (ARN) entries for
 	// each container instance associated with the specified cluster.
@@ -1139,6 +1285,11 @@ type metadataListContainerInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListContainerInstancesOutput.
+func (s ListContainerInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListTaskDefinitionFamiliesInput struct {
 	// The familyPrefix is a string that is used to filter the results of ListTaskDefinitionFamilies.
 	// If you specify a familyPrefix, only task definition family names that begin
@@ -1169,6 +1320,11 @@ type metadataListTaskDefinitionFamiliesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListTaskDefinitionFamiliesInput.
+func (s ListTaskDefinitionFamiliesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListTaskDefinitionFamiliesOutput struct {
 	// The list of task definition family names that match the ListTaskDefinitionFamilies
 	// request.
@@ -1187,6 +1343,11 @@ type metadataListTaskDefinitionFamiliesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListTaskDefinitionFamiliesOutput.
+func (s ListTaskDefinitionFamiliesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListTaskDefinitionsInput struct {
 	// The full family name that you want to filter the ListTaskDefinitions results
 	// with. Specifying a familyPrefix will limit the listed task definitions to
@@ -1216,6 +1377,11 @@ type metadataListTaskDefinitionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListTaskDefinitionsInput.
+func (s ListTaskDefinitionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListTaskDefinitionsOutput struct {
 	// The nextToken value to include in a future ListTaskDefinitions request. When
 	// the results of a ListTaskDefinitions request exceed maxResults, this value
@@ -1234,6 +1400,11 @@ type metadataListTaskDefinitionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListTaskDefinitionsOutput.
+func (s ListTaskDefinitionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListTasksInput struct {
 	// The short name or full Amazon Resource Name (ARN) of the cluster that hosts
 	// the tasks you want to list. If you do not specify a cluster, the default
@@ -1272,6 +1443,11 @@ type metadataListTasksInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListTasksInput.
+func (s ListTasksInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListTasksOutput struct {
 	// The nextToken value to include in a future ListTasks request. When the results
 	// of a ListTasks request exceed maxResults, this value can be used to retrieve
@@ -1289,6 +1465,11 @@ type metadataListTasksOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListTasksOutput.
+func (s ListTasksOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type MountPoint struct {
 	// The path on the container to mount the host volume at.
 	ContainerPath *string `locationName:"containerPath" type:"string"`
@@ -1308,6 +1489,11 @@ type metadataMountPoint struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the MountPoint.
+func (s MountPoint) String() string {
+	return awsutil.StringValue(s)
+}
+
 type NetworkBinding struct {
 	// The IP address that the container is bound to on the container instance.
 	BindIP *string `locationName:"bindIP" type:"string"`
@@ -1325,6 +1511,11 @@ type metadataNetworkBinding struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the NetworkBinding.
+func (s NetworkBinding) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Port mappings allow containers to access ports on the host container instance
 // to send or receive traffic. Port mappings are specified as part of the container
 // definition.
@@ -1358,6 +1549,11 @@ type metadataPortMapping struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PortMapping.
+func (s PortMapping) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RegisterContainerInstanceInput struct {
 	// The short name or full Amazon Resource Name (ARN) of the cluster that you
 	// want to register your container instance with. If you do not specify a cluster,
@@ -1377,6 +1573,11 @@ type metadataRegisterContainerInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RegisterContainerInstanceInput.
+func (s RegisterContainerInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RegisterContainerInstanceOutput struct {
 	// An Amazon EC2 instance that is running the Amazon ECS agent and has been
 	// registered with a cluster.
@@ -1389,6 +1590,11 @@ type metadataRegisterContainerInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RegisterContainerInstanceOutput.
+func (s RegisterContainerInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RegisterTaskDefinitionInput struct {
 	// A list of container definitions in JSON format that describe the different
 	// containers that make up your task.
@@ -1410,6 +1616,11 @@ type metadataRegisterTaskDefinitionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RegisterTaskDefinitionInput.
+func (s RegisterTaskDefinitionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RegisterTaskDefinitionOutput struct {
 	TaskDefinition *TaskDefinition `locationName:"taskDefinition" type:"structure"`
 
@@ -1420,6 +1631,11 @@ type metadataRegisterTaskDefinitionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RegisterTaskDefinitionOutput.
+func (s RegisterTaskDefinitionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the resources available for a container instance.
 type Resource struct {
 	// When the doubleValue type is set, the value of the resource must be a double
@@ -1450,6 +1666,11 @@ type metadataResource struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Resource.
+func (s Resource) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RunTaskInput struct {
 	// The short name or full Amazon Resource Name (ARN) of the cluster that you
 	// want to run your task on. If you do not specify a cluster, the default cluster
@@ -1473,6 +1694,11 @@ type metadataRunTaskInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RunTaskInput.
+func (s RunTaskInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RunTaskOutput struct {
 	// Any failed tasks from your RunTask action are listed here.
 	Failures []*Failure `locationName:"failures" type:"list"`
@@ -1488,6 +1714,11 @@ type metadataRunTaskOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RunTaskOutput.
+func (s RunTaskOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type StartTaskInput struct {
 	// The short name or full Amazon Resource Name (ARN) of the cluster that you
 	// want to start your task on. If you do not specify a cluster, the default
@@ -1511,6 +1742,11 @@ type metadataStartTaskInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StartTaskInput.
+func (s StartTaskInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type StartTaskOutput struct {
 	// Any failed tasks from your StartTask action are listed here.
 	Failures []*Failure `locationName:"failures" type:"list"`
@@ -1526,6 +1762,11 @@ type metadataStartTaskOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StartTaskOutput.
+func (s StartTaskOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type StopTaskInput struct {
 	// The short name or full Amazon Resource Name (ARN) of the cluster that hosts
 	// the task you want to stop. If you do not specify a cluster, the default cluster
@@ -1543,6 +1784,11 @@ type metadataStopTaskInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StopTaskInput.
+func (s StopTaskInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type StopTaskOutput struct {
 	Task *Task `locationName:"task" type:"structure"`
 
@@ -1553,6 +1799,11 @@ type metadataStopTaskOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StopTaskOutput.
+func (s StopTaskOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SubmitContainerStateChangeInput struct {
 	// The short name or full Amazon Resource Name (ARN) of the cluster that hosts
 	// the container.
@@ -1584,6 +1835,11 @@ type metadataSubmitContainerStateChangeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SubmitContainerStateChangeInput.
+func (s SubmitContainerStateChangeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SubmitContainerStateChangeOutput struct {
 	// Acknowledgement of the state change.
 	Acknowledgment *string `locationName:"acknowledgment" type:"string"`
@@ -1595,6 +1851,11 @@ type metadataSubmitContainerStateChangeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SubmitContainerStateChangeOutput.
+func (s SubmitContainerStateChangeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SubmitTaskStateChangeInput struct {
 	// The short name or full Amazon Resource Name (ARN) of the cluster that hosts
 	// the task.
@@ -1617,6 +1878,11 @@ type metadataSubmitTaskStateChangeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SubmitTaskStateChangeInput.
+func (s SubmitTaskStateChangeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SubmitTaskStateChangeOutput struct {
 	// Acknowledgement of the state change.
 	Acknowledgment *string `locationName:"acknowledgment" type:"string"`
@@ -1628,6 +1894,11 @@ type metadataSubmitTaskStateChangeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SubmitTaskStateChangeOutput.
+func (s SubmitTaskStateChangeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type Task struct {
 	// The Amazon Resource Name (ARN) of the of the cluster that hosts the task.
 	ClusterARN *string `locationName:"clusterArn" type:"string"`
@@ -1661,6 +1932,11 @@ type metadataTask struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Task.
+func (s Task) String() string {
+	return awsutil.StringValue(s)
+}
+
 type TaskDefinition struct {
 	// A list of container definitions in JSON format that describe the different
 	// containers that make up your task. For more information on container definition
@@ -1694,6 +1970,11 @@ type metadataTaskDefinition struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TaskDefinition.
+func (s TaskDefinition) String() string {
+	return awsutil.StringValue(s)
+}
+
 type TaskOverride struct {
 	// One or more container overrides to send when running a task.
 	ContainerOverrides []*ContainerOverride `locationName:"containerOverrides" type:"list"`
@@ -1705,6 +1986,11 @@ type metadataTaskOverride struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TaskOverride.
+func (s TaskOverride) String() string {
+	return awsutil.StringValue(s)
+}
+
 type Volume struct {
 	// The path on the host container instance that is presented to the containers
 	// which access the volume. If this parameter is empty, then the Docker daemon
@@ -1722,6 +2008,11 @@ type metadataVolume struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Volume.
+func (s Volume) String() string {
+	return awsutil.StringValue(s)
+}
+
 type VolumeFrom struct {
 	// If this value is true, the container has read-only access to the volume.
 	// If this value is false, then the container can write to the volume. The default
@@ -1736,4 +2027,9 @@ type VolumeFrom struct {
 
 type metadataVolumeFrom struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the VolumeFrom.
+func (s VolumeFrom) String() string {
+	return awsutil.StringValue(s)
 }
\ No newline at end of file
@@ -4,9 +4,11 @@
 package elasticache
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // AddTagsToResourceRequest generates a request for the AddTagsToResource operation.
@@ -469,6 +471,11 @@ func (c *ElastiCache) DescribeCacheClustersRequest(input *DescribeCacheClustersI
 			Name:       "DescribeCacheClusters",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -507,6 +514,16 @@ func (c *ElastiCache) DescribeCacheClusters(input *DescribeCacheClustersInput) (
 	return
 }
 
+// DescribeCacheClustersPages iterates over the pages of a DescribeCacheClusters operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *ElastiCache) DescribeCacheClustersPages(input *DescribeCacheClustersInput, fn func(p *DescribeCacheClustersOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeCacheClustersRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeCacheClustersOutput), lastPage)
+	})
+}
+
 var opDescribeCacheClusters *aws.Operation
 
 // DescribeCacheEngineVersionsRequest generates a request for the DescribeCacheEngineVersions operation.
@@ -516,6 +533,11 @@ func (c *ElastiCache) DescribeCacheEngineVersionsRequest(input *DescribeCacheEng
 			Name:       "DescribeCacheEngineVersions",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -534,6 +556,16 @@ func (c *ElastiCache) DescribeCacheEngineVersions(input *DescribeCacheEngineVers
 	return
 }
 
+// DescribeCacheEngineVersionsPages iterates over the pages of a DescribeCacheEngineVersions operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *ElastiCache) DescribeCacheEngineVersionsPages(input *DescribeCacheEngineVersionsInput, fn func(p *DescribeCacheEngineVersionsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeCacheEngineVersionsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeCacheEngineVersionsOutput), lastPage)
+	})
+}
+
 var opDescribeCacheEngineVersions *aws.Operation
 
 // DescribeCacheParameterGroupsRequest generates a request for the DescribeCacheParameterGroups operation.
@@ -543,6 +575,11 @@ func (c *ElastiCache) DescribeCacheParameterGroupsRequest(input *DescribeCachePa
 			Name:       "DescribeCacheParameterGroups",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -562,6 +599,16 @@ func (c *ElastiCache) DescribeCacheParameterGroups(input *DescribeCacheParameter
 	return
 }
 
+// DescribeCacheParameterGroupsPages iterates over the pages of a DescribeCacheParameterGroups operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *ElastiCache) DescribeCacheParameterGroupsPages(input *DescribeCacheParameterGroupsInput, fn func(p *DescribeCacheParameterGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeCacheParameterGroupsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeCacheParameterGroupsOutput), lastPage)
+	})
+}
+
 var opDescribeCacheParameterGroups *aws.Operation
 
 // DescribeCacheParametersRequest generates a request for the DescribeCacheParameters operation.
@@ -571,6 +618,11 @@ func (c *ElastiCache) DescribeCacheParametersRequest(input *DescribeCacheParamet
 			Name:       "DescribeCacheParameters",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -589,6 +641,16 @@ func (c *ElastiCache) DescribeCacheParameters(input *DescribeCacheParametersInpu
 	return
 }
 
+// DescribeCacheParametersPages iterates over the pages of a DescribeCacheParameters operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *ElastiCache) DescribeCacheParametersPages(input *DescribeCacheParametersInput, fn func(p *DescribeCacheParametersOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeCacheParametersRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeCacheParametersOutput), lastPage)
+	})
+}
+
 var opDescribeCacheParameters *aws.Operation
 
 // DescribeCacheSecurityGroupsRequest generates a request for the DescribeCacheSecurityGroups operation.
@@ -598,6 +660,11 @@ func (c *ElastiCache) DescribeCacheSecurityGroupsRequest(input *DescribeCacheSec
 			Name:       "DescribeCacheSecurityGroups",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -617,6 +684,16 @@ func (c *ElastiCache) DescribeCacheSecurityGroups(input *DescribeCacheSecurityGr
 	return
 }
 
+// DescribeCacheSecurityGroupsPages iterates over the pages of a DescribeCacheSecurityGroups operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *ElastiCache) DescribeCacheSecurityGroupsPages(input *DescribeCacheSecurityGroupsInput, fn func(p *DescribeCacheSecurityGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeCacheSecurityGroupsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeCacheSecurityGroupsOutput), lastPage)
+	})
+}
+
 var opDescribeCacheSecurityGroups *aws.Operation
 
 // DescribeCacheSubnetGroupsRequest generates a request for the DescribeCacheSubnetGroups operation.
@@ -626,6 +703,11 @@ func (c *ElastiCache) DescribeCacheSubnetGroupsRequest(input *DescribeCacheSubne
 			Name:       "DescribeCacheSubnetGroups",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -645,6 +727,16 @@ func (c *ElastiCache) DescribeCacheSubnetGroups(input *DescribeCacheSubnetGroups
 	return
 }
 
+// DescribeCacheSubnetGroupsPages iterates over the pages of a DescribeCacheSubnetGroups operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *ElastiCache) DescribeCacheSubnetGroupsPages(input *DescribeCacheSubnetGroupsInput, fn func(p *DescribeCacheSubnetGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeCacheSubnetGroupsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeCacheSubnetGroupsOutput), lastPage)
+	})
+}
+
 var opDescribeCacheSubnetGroups *aws.Operation
 
 // DescribeEngineDefaultParametersRequest generates a request for the DescribeEngineDefaultParameters operation.
@@ -654,6 +746,11 @@ func (c *ElastiCache) DescribeEngineDefaultParametersRequest(input *DescribeEngi
 			Name:       "DescribeEngineDefaultParameters",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"EngineDefaults.Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -672,6 +769,16 @@ func (c *ElastiCache) DescribeEngineDefaultParameters(input *DescribeEngineDefau
 	return
 }
 
+// DescribeEngineDefaultParametersPages iterates over the pages of a DescribeEngineDefaultParameters operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *ElastiCache) DescribeEngineDefaultParametersPages(input *DescribeEngineDefaultParametersInput, fn func(p *DescribeEngineDefaultParametersOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeEngineDefaultParametersRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeEngineDefaultParametersOutput), lastPage)
+	})
+}
+
 var opDescribeEngineDefaultParameters *aws.Operation
 
 // DescribeEventsRequest generates a request for the DescribeEvents operation.
@@ -681,6 +788,11 @@ func (c *ElastiCache) DescribeEventsRequest(input *DescribeEventsInput) (req *aw
 			Name:       "DescribeEvents",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -704,6 +816,16 @@ func (c *ElastiCache) DescribeEvents(input *DescribeEventsInput) (output *Descri
 	return
 }
 
+// DescribeEventsPages iterates over the pages of a DescribeEvents operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *ElastiCache) DescribeEventsPages(input *DescribeEventsInput, fn func(p *DescribeEventsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeEventsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeEventsOutput), lastPage)
+	})
+}
+
 var opDescribeEvents *aws.Operation
 
 // DescribeReplicationGroupsRequest generates a request for the DescribeReplicationGroups operation.
@@ -713,6 +835,11 @@ func (c *ElastiCache) DescribeReplicationGroupsRequest(input *DescribeReplicatio
 			Name:       "DescribeReplicationGroups",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -732,6 +859,16 @@ func (c *ElastiCache) DescribeReplicationGroups(input *DescribeReplicationGroups
 	return
 }
 
+// DescribeReplicationGroupsPages iterates over the pages of a DescribeReplicationGroups operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *ElastiCache) DescribeReplicationGroupsPages(input *DescribeReplicationGroupsInput, fn func(p *DescribeReplicationGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeReplicationGroupsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeReplicationGroupsOutput), lastPage)
+	})
+}
+
 var opDescribeReplicationGroups *aws.Operation
 
 // DescribeReservedCacheNodesRequest generates a request for the DescribeReservedCacheNodes operation.
@@ -741,6 +878,11 @@ func (c *ElastiCache) DescribeReservedCacheNodesRequest(input *DescribeReservedC
 			Name:       "DescribeReservedCacheNodes",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -759,6 +901,16 @@ func (c *ElastiCache) DescribeReservedCacheNodes(input *DescribeReservedCacheNod
 	return
 }
 
+// DescribeReservedCacheNodesPages iterates over the pages of a DescribeReservedCacheNodes operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *ElastiCache) DescribeReservedCacheNodesPages(input *DescribeReservedCacheNodesInput, fn func(p *DescribeReservedCacheNodesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeReservedCacheNodesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeReservedCacheNodesOutput), lastPage)
+	})
+}
+
 var opDescribeReservedCacheNodes *aws.Operation
 
 // DescribeReservedCacheNodesOfferingsRequest generates a request for the DescribeReservedCacheNodesOfferings operation.
@@ -768,6 +920,11 @@ func (c *ElastiCache) DescribeReservedCacheNodesOfferingsRequest(input *Describe
 			Name:       "DescribeReservedCacheNodesOfferings",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -786,6 +943,16 @@ func (c *ElastiCache) DescribeReservedCacheNodesOfferings(input *DescribeReserve
 	return
 }
 
+// DescribeReservedCacheNodesOfferingsPages iterates over the pages of a DescribeReservedCacheNodesOfferings operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *ElastiCache) DescribeReservedCacheNodesOfferingsPages(input *DescribeReservedCacheNodesOfferingsInput, fn func(p *DescribeReservedCacheNodesOfferingsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeReservedCacheNodesOfferingsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeReservedCacheNodesOfferingsOutput), lastPage)
+	})
+}
+
 var opDescribeReservedCacheNodesOfferings *aws.Operation
 
 // DescribeSnapshotsRequest generates a request for the DescribeSnapshots operation.
@@ -795,6 +962,11 @@ func (c *ElastiCache) DescribeSnapshotsRequest(input *DescribeSnapshotsInput) (r
 			Name:       "DescribeSnapshots",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -815,6 +987,16 @@ func (c *ElastiCache) DescribeSnapshots(input *DescribeSnapshotsInput) (output *
 	return
 }
 
+// DescribeSnapshotsPages iterates over the pages of a DescribeSnapshots operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *ElastiCache) DescribeSnapshotsPages(input *DescribeSnapshotsInput, fn func(p *DescribeSnapshotsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeSnapshotsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeSnapshotsOutput), lastPage)
+	})
+}
+
 var opDescribeSnapshots *aws.Operation
 
 // ListTagsForResourceRequest generates a request for the ListTagsForResource operation.
@@ -1105,6 +1287,40 @@ func (c *ElastiCache) RevokeCacheSecurityGroupIngress(input *RevokeCacheSecurity
 
 var opRevokeCacheSecurityGroupIngress *aws.Operation
 
+// AZMode is an enum of the values of AZMode members.
+type AZMode string
+
+// The values of AZMode.
+const (
+	AZModeSingleAz AZMode = "single-az"
+	AZModeCrossAz  AZMode = "cross-az"
+)
+
+// AZModeValues returns the values of AZMode known to this
+// version of the SDK. The service may accept values added since.
+func AZModeValues() []AZMode {
+	return []AZMode{
+		AZMode("single-az"),
+		AZMode("cross-az"),
+	}
+}
+
+// String returns the AZMode as the string of a member.
+func (v AZMode) String() string {
+	return string(v)
+}
+
+// ParseAZMode returns the AZMode of str, failing if it is not
+// one of the AZModeValues.
+func ParseAZMode(str string) (AZMode, error) {
+	for _, v := range AZModeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid AZMode value %q", str)
+}
+
 // Represents the input of an AddTagsToResource action.
 type AddTagsToResourceInput struct {
 	// The name of the resource to which the tags are to be added, for example arn:aws:elasticache:us-west-2:0123456789:cluster:myCluster.
@@ -1121,6 +1337,11 @@ type metadataAddTagsToResourceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddTagsToResourceInput.
+func (s AddTagsToResourceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of an AuthorizeCacheSecurityGroupIngress action.
 type AuthorizeCacheSecurityGroupIngressInput struct {
 	// The cache security group which will allow network ingress.
@@ -1142,6 +1363,11 @@ type metadataAuthorizeCacheSecurityGroupIngressInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AuthorizeCacheSecurityGroupIngressInput.
+func (s AuthorizeCacheSecurityGroupIngressInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AuthorizeCacheSecurityGroupIngressOutput struct {
 	// Represents the output of one of the following actions:
 	//
@@ -1155,6 +1381,49 @@ type metadataAuthorizeCacheSecurityGroupIngressOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AuthorizeCacheSecurityGroupIngressOutput.
+func (s AuthorizeCacheSecurityGroupIngressOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// AutomaticFailoverStatus is an enum of the values of AutomaticFailoverStatus members.
+type AutomaticFailoverStatus string
+
+// The values of AutomaticFailoverStatus.
+const (
+	AutomaticFailoverStatusEnabled   AutomaticFailoverStatus = "enabled"
+	AutomaticFailoverStatusDisabled  AutomaticFailoverStatus = "disabled"
+	AutomaticFailoverStatusEnabling  AutomaticFailoverStatus = "enabling"
+	AutomaticFailoverStatusDisabling AutomaticFailoverStatus = "disabling"
+)
+
+// AutomaticFailoverStatusValues returns the values of AutomaticFailoverStatus known to this
+// version of the SDK. The service may accept values added since.
+func AutomaticFailoverStatusValues() []AutomaticFailoverStatus {
+	return []AutomaticFailoverStatus{
+		AutomaticFailoverStatus("enabled"),
+		AutomaticFailoverStatus("disabled"),
+		AutomaticFailoverStatus("enabling"),
+		AutomaticFailoverStatus("disabling"),
+	}
+}
+
+// String returns the AutomaticFailoverStatus as the string of a member.
+func (v AutomaticFailoverStatus) String() string {
+	return string(v)
+}
+
+// ParseAutomaticFailoverStatus returns the AutomaticFailoverStatus of str, failing if it is not
+// one of the AutomaticFailoverStatusValues.
+func ParseAutomaticFailoverStatus(str string) (AutomaticFailoverStatus, error) {
+	for _, v := range AutomaticFailoverStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid AutomaticFailoverStatus value %q", str)
+}
+
 // Describes an Availability Zone in which the cache cluster is launched.
 type AvailabilityZone struct {
 	// The name of the Availability Zone.
@@ -1167,6 +1436,11 @@ type metadataAvailabilityZone struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AvailabilityZone.
+func (s AvailabilityZone) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains all of the attributes of a specific cache cluster.
 type CacheCluster struct {
 	// This parameter is currently disabled.
@@ -1288,6 +1562,11 @@ type metadataCacheCluster struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CacheCluster.
+func (s CacheCluster) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides all of the details about a particular cache engine version.
 type CacheEngineVersion struct {
 	// The description of the cache engine.
@@ -1312,6 +1591,11 @@ type metadataCacheEngineVersion struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CacheEngineVersion.
+func (s CacheEngineVersion) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents an individual cache node within a cache cluster. Each cache node
 // runs its own instance of the cluster's protocol-compliant caching software
 // - either Memcached or Redis.
@@ -1366,6 +1650,11 @@ type metadataCacheNode struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CacheNode.
+func (s CacheNode) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A parameter that has a different value for each cache node type it is applied
 // to. For example, in a Redis cache cluster, a cache.m1.large cache node type
 // would have a larger maxmemory value than a cache.m1.small type.
@@ -1403,6 +1692,11 @@ type metadataCacheNodeTypeSpecificParameter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CacheNodeTypeSpecificParameter.
+func (s CacheNodeTypeSpecificParameter) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A value that applies only to a certain cache node type.
 type CacheNodeTypeSpecificValue struct {
 	// The cache node type for which this value applies.
@@ -1418,6 +1712,11 @@ type metadataCacheNodeTypeSpecificValue struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CacheNodeTypeSpecificValue.
+func (s CacheNodeTypeSpecificValue) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a CreateCacheParameterGroup action.
 type CacheParameterGroup struct {
 	// The name of the cache parameter group family that this cache parameter group
@@ -1437,6 +1736,11 @@ type metadataCacheParameterGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CacheParameterGroup.
+func (s CacheParameterGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of one of the following actions:
 //
 //   ModifyCacheParameterGroup   ResetCacheParameterGroup
@@ -1451,6 +1755,11 @@ type metadataCacheParameterGroupNameMessage struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CacheParameterGroupNameMessage.
+func (s CacheParameterGroupNameMessage) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The status of the cache parameter group.
 type CacheParameterGroupStatus struct {
 	// A list of the cache node IDs which need to be rebooted for parameter changes
@@ -1470,6 +1779,11 @@ type metadataCacheParameterGroupStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CacheParameterGroupStatus.
+func (s CacheParameterGroupStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of one of the following actions:
 //
 //   AuthorizeCacheSecurityGroupIngress   CreateCacheSecurityGroup   RevokeCacheSecurityGroupIngress
@@ -1494,6 +1808,11 @@ type metadataCacheSecurityGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CacheSecurityGroup.
+func (s CacheSecurityGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents a cache cluster's status within a particular cache security group.
 type CacheSecurityGroupMembership struct {
 	// The name of the cache security group.
@@ -1511,6 +1830,11 @@ type metadataCacheSecurityGroupMembership struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CacheSecurityGroupMembership.
+func (s CacheSecurityGroupMembership) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of one of the following actions:
 //
 //   CreateCacheSubnetGroup   ModifyCacheSubnetGroup
@@ -1535,6 +1859,11 @@ type metadataCacheSubnetGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CacheSubnetGroup.
+func (s CacheSubnetGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a CopySnapshotMessage action.
 type CopySnapshotInput struct {
 	// The name of an existing snapshot from which to copy.
@@ -1550,6 +1879,11 @@ type metadataCopySnapshotInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CopySnapshotInput.
+func (s CopySnapshotInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CopySnapshotOutput struct {
 	// Represents a copy of an entire cache cluster as of the time when the snapshot
 	// was taken.
@@ -1562,6 +1896,11 @@ type metadataCopySnapshotOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CopySnapshotOutput.
+func (s CopySnapshotOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a CreateCacheCluster action.
 type CreateCacheClusterInput struct {
 	// Specifies whether the nodes in this Memcached node group are created in a
@@ -1572,7 +1911,7 @@ type CreateCacheClusterInput struct {
 	//
 	// If the AZMode and PreferredAvailabilityZones are not specified, ElastiCache
 	// assumes single-az mode.
-	AZMode *string `type:"string"`
+	AZMode *string `type:"string" enum:"single-az,cross-az"`
 
 	// This parameter is currently disabled.
 	AutoMinorVersionUpgrade *bool `type:"boolean"`
@@ -1761,6 +2100,11 @@ type metadataCreateCacheClusterInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateCacheClusterInput.
+func (s CreateCacheClusterInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateCacheClusterOutput struct {
 	// Contains all of the attributes of a specific cache cluster.
 	CacheCluster *CacheCluster `type:"structure"`
@@ -1772,6 +2116,11 @@ type metadataCreateCacheClusterOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateCacheClusterOutput.
+func (s CreateCacheClusterOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a CreateCacheParameterGroup action.
 type CreateCacheParameterGroupInput struct {
 	// The name of the cache parameter group family the cache parameter group can
@@ -1793,6 +2142,11 @@ type metadataCreateCacheParameterGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateCacheParameterGroupInput.
+func (s CreateCacheParameterGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateCacheParameterGroupOutput struct {
 	// Represents the output of a CreateCacheParameterGroup action.
 	CacheParameterGroup *CacheParameterGroup `type:"structure"`
@@ -1804,6 +2158,11 @@ type metadataCreateCacheParameterGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateCacheParameterGroupOutput.
+func (s CreateCacheParameterGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a CreateCacheSecurityGroup action.
 type CreateCacheSecurityGroupInput struct {
 	// A name for the cache security group. This value is stored as a lowercase
@@ -1825,6 +2184,11 @@ type metadataCreateCacheSecurityGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateCacheSecurityGroupInput.
+func (s CreateCacheSecurityGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateCacheSecurityGroupOutput struct {
 	// Represents the output of one of the following actions:
 	//
@@ -1838,6 +2202,11 @@ type metadataCreateCacheSecurityGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateCacheSecurityGroupOutput.
+func (s CreateCacheSecurityGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a CreateCacheSubnetGroup action.
 type CreateCacheSubnetGroupInput struct {
 	// A description for the cache subnet group.
@@ -1860,6 +2229,11 @@ type metadataCreateCacheSubnetGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateCacheSubnetGroupInput.
+func (s CreateCacheSubnetGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateCacheSubnetGroupOutput struct {
 	// Represents the output of one of the following actions:
 	//
@@ -1873,6 +2247,11 @@ type metadataCreateCacheSubnetGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateCacheSubnetGroupOutput.
+func (s CreateCacheSubnetGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a CreateReplicationGroup action.
 type CreateReplicationGroupInput struct {
 	// This parameter is currently disabled.
@@ -2051,6 +2430,11 @@ type metadataCreateReplicationGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateReplicationGroupInput.
+func (s CreateReplicationGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateReplicationGroupOutput struct {
 	// Contains all of the attributes of a specific replication group.
 	ReplicationGroup *ReplicationGroup `type:"structure"`
@@ -2062,6 +2446,11 @@ type metadataCreateReplicationGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateReplicationGroupOutput.
+func (s CreateReplicationGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a CreateSnapshot action.
 type CreateSnapshotInput struct {
 	// The identifier of an existing cache cluster. The snapshot will be created
@@ -2078,6 +2467,11 @@ type metadataCreateSnapshotInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateSnapshotInput.
+func (s CreateSnapshotInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateSnapshotOutput struct {
 	// Represents a copy of an entire cache cluster as of the time when the snapshot
 	// was taken.
@@ -2090,6 +2484,11 @@ type metadataCreateSnapshotOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateSnapshotOutput.
+func (s CreateSnapshotOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a DeleteCacheCluster action.
 type DeleteCacheClusterInput struct {
 	// The cache cluster identifier for the cluster to be deleted. This parameter
@@ -2108,6 +2507,11 @@ type metadataDeleteCacheClusterInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteCacheClusterInput.
+func (s DeleteCacheClusterInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteCacheClusterOutput struct {
 	// Contains all of the attributes of a specific cache cluster.
 	CacheCluster *CacheCluster `type:"structure"`
@@ -2119,6 +2523,11 @@ type metadataDeleteCacheClusterOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteCacheClusterOutput.
+func (s DeleteCacheClusterOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a DeleteCacheParameterGroup action.
 type DeleteCacheParameterGroupInput struct {
 	// The name of the cache parameter group to delete.
@@ -2134,6 +2543,11 @@ type metadataDeleteCacheParameterGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteCacheParameterGroupInput.
+func (s DeleteCacheParameterGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteCacheParameterGroupOutput struct {
 	metadataDeleteCacheParameterGroupOutput `json:"-", xml:"-"`
 }
@@ -2142,6 +2556,11 @@ type metadataDeleteCacheParameterGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteCacheParameterGroupOutput.
+func (s DeleteCacheParameterGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a DeleteCacheSecurityGroup action.
 type DeleteCacheSecurityGroupInput struct {
 	// The name of the cache security group to delete.
@@ -2156,6 +2575,11 @@ type metadataDeleteCacheSecurityGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteCacheSecurityGroupInput.
+func (s DeleteCacheSecurityGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteCacheSecurityGroupOutput struct {
 	metadataDeleteCacheSecurityGroupOutput `json:"-", xml:"-"`
 }
@@ -2164,6 +2588,11 @@ type metadataDeleteCacheSecurityGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteCacheSecurityGroupOutput.
+func (s DeleteCacheSecurityGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a DeleteCacheSubnetGroup action.
 type DeleteCacheSubnetGroupInput struct {
 	// The name of the cache subnet group to delete.
@@ -2178,6 +2607,11 @@ type metadataDeleteCacheSubnetGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteCacheSubnetGroupInput.
+func (s DeleteCacheSubnetGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteCacheSubnetGroupOutput struct {
 	metadataDeleteCacheSubnetGroupOutput `json:"-", xml:"-"`
 }
@@ -2186,6 +2620,11 @@ type metadataDeleteCacheSubnetGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteCacheSubnetGroupOutput.
+func (s DeleteCacheSubnetGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a DeleteReplicationGroup action.
 type DeleteReplicationGroupInput struct {
 	// The name of a final node group snapshot. ElastiCache creates the snapshot
@@ -2209,6 +2648,11 @@ type metadataDeleteReplicationGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteReplicationGroupInput.
+func (s DeleteReplicationGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteReplicationGroupOutput struct {
 	// Contains all of the attributes of a specific replication group.
 	ReplicationGroup *ReplicationGroup `type:"structure"`
@@ -2220,6 +2664,11 @@ type metadataDeleteReplicationGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteReplicationGroupOutput.
+func (s DeleteReplicationGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a DeleteSnapshot action.
 type DeleteSnapshotInput struct {
 	// The name of the snapshot to be deleted.
@@ -2232,6 +2681,11 @@ type metadataDeleteSnapshotInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteSnapshotInput.
+func (s DeleteSnapshotInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteSnapshotOutput struct {
 	// Represents a copy of an entire cache cluster as of the time when the snapshot
 	// was taken.
@@ -2244,6 +2698,11 @@ type metadataDeleteSnapshotOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteSnapshotOutput.
+func (s DeleteSnapshotOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a DescribeCacheClusters action.
 type DescribeCacheClustersInput struct {
 	// The user-supplied cluster identifier. If this parameter is specified, only
@@ -2276,6 +2735,11 @@ type metadataDescribeCacheClustersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeCacheClustersInput.
+func (s DescribeCacheClustersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a DescribeCacheClusters action.
 type DescribeCacheClustersOutput struct {
 	// A list of cache clusters. Each item in the list contains detailed information
@@ -2292,6 +2756,11 @@ type metadataDescribeCacheClustersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeCacheClustersOutput.
+func (s DescribeCacheClustersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a DescribeCacheEngineVersions action.
 type DescribeCacheEngineVersionsInput struct {
 	// The name of a specific cache parameter group family to return details for.
@@ -2335,6 +2804,11 @@ type metadataDescribeCacheEngineVersionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeCacheEngineVersionsInput.
+func (s DescribeCacheEngineVersionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a DescribeCacheEngineVersions action.
 type DescribeCacheEngineVersionsOutput struct {
 	// A list of cache engine version details. Each element in the list contains
@@ -2351,6 +2825,11 @@ type metadataDescribeCacheEngineVersionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeCacheEngineVersionsOutput.
+func (s DescribeCacheEngineVersionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a DescribeCacheParameterGroups action.
 type DescribeCacheParameterGroupsInput struct {
 	// The name of a specific cache parameter group to return details for.
@@ -2377,6 +2856,11 @@ type metadataDescribeCacheParameterGroupsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeCacheParameterGroupsInput.
+func (s DescribeCacheParameterGroupsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a DescribeCacheParameterGroups action.
 type DescribeCacheParameterGroupsOutput struct {
 	// A list of cache parameter groups. Each element in the list contains detailed
@@ -2393,6 +2877,11 @@ type metadataDescribeCacheParameterGroupsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeCacheParameterGroupsOutput.
+func (s DescribeCacheParameterGroupsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a DescribeCacheParameters action.
 type DescribeCacheParametersInput struct {
 	// The name of a specific cache parameter group to return details for.
@@ -2424,6 +2913,11 @@ type metadataDescribeCacheParametersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeCacheParametersInput.
+func (s DescribeCacheParametersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a DescribeCacheParameters action.
 type DescribeCacheParametersOutput struct {
 	// A list of parameters specific to a particular cache node type. Each element
@@ -2443,6 +2937,11 @@ type metadataDescribeCacheParametersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeCacheParametersOutput.
+func (s DescribeCacheParametersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a DescribeCacheSecurityGroups action.
 type DescribeCacheSecurityGroupsInput struct {
 	// The name of the cache security group to return details for.
@@ -2469,6 +2968,11 @@ type metadataDescribeCacheSecurityGroupsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeCacheSecurityGroupsInput.
+func (s DescribeCacheSecurityGroupsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a DescribeCacheSecurityGroups action.
 type DescribeCacheSecurityGroupsOutput struct {
 	// A list of cache security groups. Each element in the list contains detailed
@@ -2485,6 +2989,11 @@ type metadataDescribeCacheSecurityGroupsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeCacheSecurityGroupsOutput.
+func (s DescribeCacheSecurityGroupsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a DescribeCacheSubnetGroups action.
 type DescribeCacheSubnetGroupsInput struct {
 	// The name of the cache subnet group to return details for.
@@ -2511,6 +3020,11 @@ type metadataDescribeCacheSubnetGroupsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeCacheSubnetGroupsInput.
+func (s DescribeCacheSubnetGroupsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a DescribeCacheSubnetGroups action.
 type DescribeCacheSubnetGroupsOutput struct {
 	// A list of cache subnet groups. Each element in the list contains detailed
@@ -2527,6 +3041,11 @@ type metadataDescribeCacheSubnetGroupsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeCacheSubnetGroupsOutput.
+func (s DescribeCacheSubnetGroupsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a DescribeEngineDefaultParameters action.
 type DescribeEngineDefaultParametersInput struct {
 	// The name of the cache parameter group family. Valid values are: memcached1.4
@@ -2554,6 +3073,11 @@ type metadataDescribeEngineDefaultParametersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeEngineDefaultParametersInput.
+func (s DescribeEngineDefaultParametersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeEngineDefaultParametersOutput struct {
 	// Represents the output of a DescribeEngineDefaultParameters action.
 	EngineDefaults *EngineDefaults `type:"structure"`
@@ -2565,6 +3089,11 @@ type metadataDescribeEngineDefaultParametersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeEngineDefaultParametersOutput.
+func (s DescribeEngineDefaultParametersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a DescribeEvents action.
 type DescribeEventsInput struct {
 	// The number of minutes' worth of events to retrieve.
@@ -2597,7 +3126,7 @@ type DescribeEventsInput struct {
 	//
 	// Valid values are: cache-cluster | cache-parameter-group | cache-security-group
 	// | cache-subnet-group
-	SourceType *string `type:"string"`
+	SourceType *string `type:"string" enum:"cache-cluster,cache-parameter-group,cache-security-group,cache-subnet-group"`
 
 	// The beginning of the time interval to retrieve events for, specified in ISO
 	// 8601 format.
@@ -2610,6 +3139,11 @@ type metadataDescribeEventsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeEventsInput.
+func (s DescribeEventsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a DescribeEvents action.
 type DescribeEventsOutput struct {
 	// A list of events. Each element in the list contains detailed information
@@ -2626,6 +3160,11 @@ type metadataDescribeEventsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeEventsOutput.
+func (s DescribeEventsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a DescribeReplicationGroups action.
 type DescribeReplicationGroupsInput struct {
 	// An optional marker returned from a prior request. Use this marker for pagination
@@ -2656,6 +3195,11 @@ type metadataDescribeReplicationGroupsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeReplicationGroupsInput.
+func (s DescribeReplicationGroupsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a DescribeReplicationGroups action.
 type DescribeReplicationGroupsOutput struct {
 	// Provides an identifier to allow retrieval of paginated results.
@@ -2672,6 +3216,11 @@ type metadataDescribeReplicationGroupsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeReplicationGroupsOutput.
+func (s DescribeReplicationGroupsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a DescribeReservedCacheNodes action.
 type DescribeReservedCacheNodesInput struct {
 	// The cache node type filter value. Use this parameter to show only those reservations
@@ -2741,6 +3290,11 @@ type metadataDescribeReservedCacheNodesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeReservedCacheNodesInput.
+func (s DescribeReservedCacheNodesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a DescribeReservedCacheNodesOfferings action.
 type DescribeReservedCacheNodesOfferingsInput struct {
 	// The cache node type filter value. Use this parameter to show only the available
@@ -2808,6 +3362,11 @@ type metadataDescribeReservedCacheNodesOfferingsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeReservedCacheNodesOfferingsInput.
+func (s DescribeReservedCacheNodesOfferingsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a DescribeReservedCacheNodesOfferings action.
 type DescribeReservedCacheNodesOfferingsOutput struct {
 	// Provides an identifier to allow retrieval of paginated results.
@@ -2824,6 +3383,11 @@ type metadataDescribeReservedCacheNodesOfferingsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeReservedCacheNodesOfferingsOutput.
+func (s DescribeReservedCacheNodesOfferingsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a DescribeReservedCacheNodes action.
 type DescribeReservedCacheNodesOutput struct {
 	// Provides an identifier to allow retrieval of paginated results.
@@ -2840,6 +3404,11 @@ type metadataDescribeReservedCacheNodesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeReservedCacheNodesOutput.
+func (s DescribeReservedCacheNodesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a DescribeSnapshotsMessage action.
 type DescribeSnapshotsInput struct {
 	// A user-supplied cluster identifier. If this parameter is specified, only
@@ -2877,6 +3446,11 @@ type metadataDescribeSnapshotsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeSnapshotsInput.
+func (s DescribeSnapshotsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a DescribeSnapshots action.
 type DescribeSnapshotsOutput struct {
 	// An optional marker returned from a prior request. Use this marker for pagination
@@ -2895,6 +3469,11 @@ type metadataDescribeSnapshotsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeSnapshotsOutput.
+func (s DescribeSnapshotsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides ownership and status information for an Amazon EC2 security group.
 type EC2SecurityGroup struct {
 	// The name of the Amazon EC2 security group.
@@ -2913,6 +3492,11 @@ type metadataEC2SecurityGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EC2SecurityGroup.
+func (s EC2SecurityGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the information required for client programs to connect to a cache
 // node.
 type Endpoint struct {
@@ -2929,6 +3513,11 @@ type metadataEndpoint struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Endpoint.
+func (s Endpoint) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a DescribeEngineDefaultParameters action.
 type EngineDefaults struct {
 	// A list of parameters specific to a particular cache node type. Each element
@@ -2952,6 +3541,11 @@ type metadataEngineDefaults struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EngineDefaults.
+func (s EngineDefaults) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents a single occurrence of something interesting within the system.
 // Some examples of events are creating a cache cluster, adding or removing
 // a cache node, or rebooting a node.
@@ -2969,7 +3563,7 @@ type Event struct {
 
 	// Specifies the origin of this event - a cache cluster, a parameter group,
 	// a security group, etc.
-	SourceType *string `type:"string"`
+	SourceType *string `type:"string" enum:"cache-cluster,cache-parameter-group,cache-security-group,cache-subnet-group"`
 
 	metadataEvent `json:"-", xml:"-"`
 }
@@ -2978,6 +3572,11 @@ type metadataEvent struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Event.
+func (s Event) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input parameters for the ListTagsForResource action.
 type ListTagsForResourceInput struct {
 	// The name of the resource for which you want the list of tags, for example
@@ -2991,6 +3590,11 @@ type metadataListTagsForResourceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListTagsForResourceInput.
+func (s ListTagsForResourceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a ModifyCacheCluster action.
 type ModifyCacheClusterInput struct {
 	// Specifies whether the new nodes in this Memcached cache cluster are all created
@@ -3008,7 +3612,7 @@ type ModifyCacheClusterInput struct {
 	// For instructions on how to move existing Memcached nodes to different Availability
 	// Zones, see the Availability Zone Considerations section of Cache Node Considerations
 	// for Memcached (http://docs.aws.amazon.com/AmazonElastiCache/latest/UserGuide/CacheNode.Memcached.html).
-	AZMode *string `type:"string"`
+	AZMode *string `type:"string" enum:"single-az,cross-az"`
 
 	// If true, this parameter causes the modifications in this request and any
 	// pending modifications to be applied, asynchronously and as soon as possible,
@@ -3178,6 +3782,11 @@ type metadataModifyCacheClusterInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyCacheClusterInput.
+func (s ModifyCacheClusterInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifyCacheClusterOutput struct {
 	// Contains all of the attributes of a specific cache cluster.
 	CacheCluster *CacheCluster `type:"structure"`
@@ -3189,6 +3798,11 @@ type metadataModifyCacheClusterOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyCacheClusterOutput.
+func (s ModifyCacheClusterOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a ModifyCacheParameterGroup action.
 type ModifyCacheParameterGroupInput struct {
 	// The name of the cache parameter group to modify.
@@ -3206,6 +3820,11 @@ type metadataModifyCacheParameterGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyCacheParameterGroupInput.
+func (s ModifyCacheParameterGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a ModifyCacheSubnetGroup action.
 type ModifyCacheSubnetGroupInput struct {
 	// A description for the cache subnet group.
@@ -3229,6 +3848,11 @@ type metadataModifyCacheSubnetGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyCacheSubnetGroupInput.
+func (s ModifyCacheSubnetGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifyCacheSubnetGroupOutput struct {
 	// Represents the output of one of the following actions:
 	//
@@ -3242,6 +3866,11 @@ type metadataModifyCacheSubnetGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyCacheSubnetGroupOutput.
+func (s ModifyCacheSubnetGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a ModifyReplicationGroups action.
 type ModifyReplicationGroupInput struct {
 	// If true, this parameter causes the modifications in this request and any
@@ -3358,6 +3987,11 @@ type metadataModifyReplicationGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyReplicationGroupInput.
+func (s ModifyReplicationGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifyReplicationGroupOutput struct {
 	// Contains all of the attributes of a specific replication group.
 	ReplicationGroup *ReplicationGroup `type:"structure"`
@@ -3369,6 +4003,11 @@ type metadataModifyReplicationGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyReplicationGroupOutput.
+func (s ModifyReplicationGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents a collection of cache nodes in a replication group.
 type NodeGroup struct {
 	// The identifier for the node group. A replication group contains only one
@@ -3392,6 +4031,11 @@ type metadataNodeGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the NodeGroup.
+func (s NodeGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents a single node within a node group.
 type NodeGroupMember struct {
 	// The ID of the cache cluster to which the node belongs.
@@ -3418,6 +4062,11 @@ type metadataNodeGroupMember struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the NodeGroupMember.
+func (s NodeGroupMember) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents an individual cache node in a snapshot of a cache cluster.
 type NodeSnapshot struct {
 	// The date and time when the cache node was created in the source cache cluster.
@@ -3440,6 +4089,11 @@ type metadataNodeSnapshot struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the NodeSnapshot.
+func (s NodeSnapshot) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a notification topic and its status. Notification topics are used
 // for publishing ElastiCache events to subscribers using Amazon Simple Notification
 // Service (SNS).
@@ -3457,6 +4111,11 @@ type metadataNotificationConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the NotificationConfiguration.
+func (s NotificationConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an individual setting that controls some aspect of ElastiCache
 // behavior.
 type Parameter struct {
@@ -3493,6 +4152,11 @@ type metadataParameter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Parameter.
+func (s Parameter) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a name-value pair that is used to update the value of a parameter.
 type ParameterNameValue struct {
 	// The name of the parameter.
@@ -3508,6 +4172,45 @@ type metadataParameterNameValue struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ParameterNameValue.
+func (s ParameterNameValue) String() string {
+	return awsutil.StringValue(s)
+}
+
+// PendingAutomaticFailoverStatus is an enum of the values of PendingAutomaticFailoverStatus members.
+type PendingAutomaticFailoverStatus string
+
+// The values of PendingAutomaticFailoverStatus.
+const (
+	PendingAutomaticFailoverStatusEnabled  PendingAutomaticFailoverStatus = "enabled"
+	PendingAutomaticFailoverStatusDisabled PendingAutomaticFailoverStatus = "disabled"
+)
+
+// PendingAutomaticFailoverStatusValues returns the values of PendingAutomaticFailoverStatus known to this
+// version of the SDK. The service may accept values added since.
+func PendingAutomaticFailoverStatusValues() []PendingAutomaticFailoverStatus {
+	return []PendingAutomaticFailoverStatus{
+		PendingAutomaticFailoverStatus("enabled"),
+		PendingAutomaticFailoverStatus("disabled"),
+	}
+}
+
+// String returns the PendingAutomaticFailoverStatus as the string of a member.
+func (v PendingAutomaticFailoverStatus) String() string {
+	return string(v)
+}
+
+// ParsePendingAutomaticFailoverStatus returns the PendingAutomaticFailoverStatus of str, failing if it is not
+// one of the PendingAutomaticFailoverStatusValues.
+func ParsePendingAutomaticFailoverStatus(str string) (PendingAutomaticFailoverStatus, error) {
+	for _, v := range PendingAutomaticFailoverStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid PendingAutomaticFailoverStatus value %q", str)
+}
+
 // A group of settings that will be applied to the cache cluster in the future,
 // or that are currently being applied.
 type PendingModifiedValues struct {
@@ -3531,6 +4234,11 @@ type metadataPendingModifiedValues struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PendingModifiedValues.
+func (s PendingModifiedValues) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a PurchaseReservedCacheNodesOffering action.
 type PurchaseReservedCacheNodesOfferingInput struct {
 	// The number of cache node instances to reserve.
@@ -3555,6 +4263,11 @@ type metadataPurchaseReservedCacheNodesOfferingInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PurchaseReservedCacheNodesOfferingInput.
+func (s PurchaseReservedCacheNodesOfferingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PurchaseReservedCacheNodesOfferingOutput struct {
 	// Represents the output of a PurchaseReservedCacheNodesOffering action.
 	ReservedCacheNode *ReservedCacheNode `type:"structure"`
@@ -3566,6 +4279,11 @@ type metadataPurchaseReservedCacheNodesOfferingOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PurchaseReservedCacheNodesOfferingOutput.
+func (s PurchaseReservedCacheNodesOfferingOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a RebootCacheCluster action.
 type RebootCacheClusterInput struct {
 	// The cache cluster identifier. This parameter is stored as a lowercase string.
@@ -3583,6 +4301,11 @@ type metadataRebootCacheClusterInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RebootCacheClusterInput.
+func (s RebootCacheClusterInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RebootCacheClusterOutput struct {
 	// Contains all of the attributes of a specific cache cluster.
 	CacheCluster *CacheCluster `type:"structure"`
@@ -3594,6 +4317,11 @@ type metadataRebootCacheClusterOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RebootCacheClusterOutput.
+func (s RebootCacheClusterOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the specific price and frequency of a recurring charges for a reserved
 // cache node, or for a reserved cache node offering.
 type RecurringCharge struct {
@@ -3610,6 +4338,11 @@ type metadataRecurringCharge struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RecurringCharge.
+func (s RecurringCharge) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a RemoveTagsFromResource action.
 type RemoveTagsFromResourceInput struct {
 	// The name of the ElastiCache resource from which you want the listed tags
@@ -3628,6 +4361,11 @@ type metadataRemoveTagsFromResourceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RemoveTagsFromResourceInput.
+func (s RemoveTagsFromResourceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains all of the attributes of a specific replication group.
 type ReplicationGroup struct {
 	// Indicates the status of Multi-AZ for this replication group.
@@ -3635,7 +4373,7 @@ type ReplicationGroup struct {
 	// ElastiCache Multi-AZ replication groups are not supported on:
 	//
 	//  Redis versions earlier than 2.8.6. T1 and T2 cache node types.
-	AutomaticFailover *string `type:"string"`
+	AutomaticFailover *string `type:"string" enum:"enabled,disabled,enabling,disabling"`
 
 	// The description of the replication group.
 	Description *string `type:"string"`
@@ -3668,6 +4406,11 @@ type metadataReplicationGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReplicationGroup.
+func (s ReplicationGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The settings to be applied to the replication group, either immediately or
 // during the next maintenance window.
 type ReplicationGroupPendingModifiedValues struct {
@@ -3676,7 +4419,7 @@ type ReplicationGroupPendingModifiedValues struct {
 	// ElastiCache Multi-AZ replication groups are not supported on:
 	//
 	//  Redis versions earlier than 2.8.6. T1 and T2 cache node types.
-	AutomaticFailoverStatus *string `type:"string"`
+	AutomaticFailoverStatus *string `type:"string" enum:"enabled,disabled"`
 
 	// The primary cluster ID which will be applied immediately (if --apply-immediately
 	// was specified), or during the next maintenance window.
@@ -3689,6 +4432,11 @@ type metadataReplicationGroupPendingModifiedValues struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReplicationGroupPendingModifiedValues.
+func (s ReplicationGroupPendingModifiedValues) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output of a PurchaseReservedCacheNodesOffering action.
 type ReservedCacheNode struct {
 	// The number of cache nodes that have been reserved.
@@ -3752,6 +4500,11 @@ type metadataReservedCacheNode struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReservedCacheNode.
+func (s ReservedCacheNode) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes all of the attributes of a reserved cache node offering.
 type ReservedCacheNodesOffering struct {
 	// The cache node type for the reserved cache node.
@@ -3803,6 +4556,11 @@ type metadataReservedCacheNodesOffering struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReservedCacheNodesOffering.
+func (s ReservedCacheNodesOffering) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a ResetCacheParameterGroup action.
 type ResetCacheParameterGroupInput struct {
 	// The name of the cache parameter group to reset.
@@ -3825,6 +4583,11 @@ type metadataResetCacheParameterGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ResetCacheParameterGroupInput.
+func (s ResetCacheParameterGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input of a RevokeCacheSecurityGroupIngress action.
 type RevokeCacheSecurityGroupIngressInput struct {
 	// The name of the cache security group to revoke ingress from.
@@ -3845,6 +4608,11 @@ type metadataRevokeCacheSecurityGroupIngressInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RevokeCacheSecurityGroupIngressInput.
+func (s RevokeCacheSecurityGroupIngressInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RevokeCacheSecurityGroupIngressOutput struct {
 	// Represents the output of one of the following actions:
 	//
@@ -3858,6 +4626,11 @@ type metadataRevokeCacheSecurityGroupIngressOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RevokeCacheSecurityGroupIngressOutput.
+func (s RevokeCacheSecurityGroupIngressOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents a single cache security group and its status.
 type SecurityGroupMembership struct {
 	// The identifier of the cache security group.
@@ -3875,6 +4648,11 @@ type metadataSecurityGroupMembership struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SecurityGroupMembership.
+func (s SecurityGroupMembership) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents a copy of an entire cache cluster as of the time when the snapshot
 // was taken.
 type Snapshot struct {
@@ -3989,6 +4767,49 @@ type metadataSnapshot struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Snapshot.
+func (s Snapshot) String() string {
+	return awsutil.StringValue(s)
+}
+
+// SourceType is an enum of the values of SourceType members.
+type SourceType string
+
+// The values of SourceType.
+const (
+	SourceTypeCacheCluster        SourceType = "cache-cluster"
+	SourceTypeCacheParameterGroup SourceType = "cache-parameter-group"
+	SourceTypeCacheSecurityGroup  SourceType = "cache-security-group"
+	SourceTypeCacheSubnetGroup    SourceType = "cache-subnet-group"
+)
+
+// SourceTypeValues returns the values of SourceType known to this
+// version of the SDK. The service may accept values added since.
+func SourceTypeValues() []SourceType {
+	return []SourceType{
+		SourceType("cache-cluster"),
+		SourceType("cache-parameter-group"),
+		SourceType("cache-security-group"),
+		SourceType("cache-subnet-group"),
+	}
+}
+
+// String returns the SourceType as the string of a member.
+func (v SourceType) String() string {
+	return string(v)
+}
+
+// ParseSourceType returns the SourceType of str, failing if it is not
+// one of the SourceTypeValues.
+func ParseSourceType(str string) (SourceType, error) {
+	for _, v := range SourceTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid SourceType value %q", str)
+}
+
 // Represents the subnet associated with a cache cluster. This parameter refers
 // to subnets defined in Amazon Virtual Private Cloud (Amazon VPC) and used
 // with ElastiCache.
@@ -4006,6 +4827,11 @@ type metadataSubnet struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Subnet.
+func (s Subnet) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A cost allocation Tag that can be added to an ElastiCache cluster or replication
 // group. Tags are composed of a Key/Value pair. A tag with a null Value is
 // permitted.
@@ -4023,6 +4849,11 @@ type metadataTag struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Tag.
+func (s Tag) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output from the AddTagsToResource, ListTagsOnResource, and
 // RemoveTagsFromResource actions.
 type TagListMessage struct {
@@ -4034,4 +4865,9 @@ type TagListMessage struct {
 
 type metadataTagListMessage struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the TagListMessage.
+func (s TagListMessage) String() string {
+	return awsutil.StringValue(s)
 }
\ No newline at end of file
@@ -4,9 +4,11 @@
 package elasticbeanstalk
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // CheckDNSAvailabilityRequest generates a request for the CheckDNSAvailability operation.
@@ -467,6 +469,11 @@ func (c *ElasticBeanstalk) DescribeEventsRequest(input *DescribeEventsInput) (re
 			Name:       "DescribeEvents",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextToken"},
+				OutputTokens: []string{"NextToken"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -484,6 +491,16 @@ func (c *ElasticBeanstalk) DescribeEvents(input *DescribeEventsInput) (output *D
 	return
 }
 
+// DescribeEventsPages iterates over the pages of a DescribeEvents operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *ElasticBeanstalk) DescribeEventsPages(input *DescribeEventsInput, fn func(p *DescribeEventsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeEventsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeEventsOutput), lastPage)
+	})
+}
+
 var opDescribeEvents *aws.Operation
 
 // ListAvailableSolutionStacksRequest generates a request for the ListAvailableSolutionStacks operation.
@@ -835,7 +852,7 @@ var opValidateConfigurationSettings *aws.Operation
 // Describes the properties of an application.
 type ApplicationDescription struct {
 	// The name of the application.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// The names of the configuration templates associated with this application.
 	ConfigurationTemplates []*string `type:"list"`
@@ -847,7 +864,7 @@ type ApplicationDescription struct {
 	DateUpdated *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// User-defined description of the application.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// The names of the versions for this application.
 	Versions []*string `type:"list"`
@@ -859,6 +876,11 @@ type metadataApplicationDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ApplicationDescription.
+func (s ApplicationDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Result message containing a single description of an application.
 type ApplicationDescriptionMessage struct {
 	// The ApplicationDescription of the application.
@@ -871,10 +893,15 @@ type metadataApplicationDescriptionMessage struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ApplicationDescriptionMessage.
+func (s ApplicationDescriptionMessage) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the properties of an application version.
 type ApplicationVersionDescription struct {
 	// The name of the application associated with this release.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// The creation date of the application version.
 	DateCreated *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -883,13 +910,13 @@ type ApplicationVersionDescription struct {
 	DateUpdated *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The description of this application version.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// The location where the source bundle is located for this version.
 	SourceBundle *S3Location `type:"structure"`
 
 	// A label uniquely identifying the version for the associated application.
-	VersionLabel *string `type:"string"`
+	VersionLabel *string `type:"string" min:"1" max:"100"`
 
 	metadataApplicationVersionDescription `json:"-", xml:"-"`
 }
@@ -898,6 +925,11 @@ type metadataApplicationVersionDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ApplicationVersionDescription.
+func (s ApplicationVersionDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Result message wrapping a single description of an application version.
 type ApplicationVersionDescriptionMessage struct {
 	// The ApplicationVersionDescription of the application version.
@@ -910,6 +942,11 @@ type metadataApplicationVersionDescriptionMessage struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ApplicationVersionDescriptionMessage.
+func (s ApplicationVersionDescriptionMessage) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an Auto Scaling launch configuration.
 type AutoScalingGroup struct {
 	// The name of the AutoScalingGroup .
@@ -922,10 +959,15 @@ type metadataAutoScalingGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AutoScalingGroup.
+func (s AutoScalingGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Results message indicating whether a CNAME is available.
 type CheckDNSAvailabilityInput struct {
 	// The prefix used when this CNAME is reserved.
-	CNAMEPrefix *string `type:"string" required:"true"`
+	CNAMEPrefix *string `type:"string" min:"4" max:"63" required:"true"`
 
 	metadataCheckDNSAvailabilityInput `json:"-", xml:"-"`
 }
@@ -934,6 +976,11 @@ type metadataCheckDNSAvailabilityInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CheckDNSAvailabilityInput.
+func (s CheckDNSAvailabilityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Indicates if the specified CNAME is available.
 type CheckDNSAvailabilityOutput struct {
 	// Indicates if the specified CNAME is available:
@@ -947,7 +994,7 @@ type CheckDNSAvailabilityOutput struct {
 
 	// The fully qualified CNAME to reserve when CreateEnvironment is called with
 	// the provided prefix.
-	FullyQualifiedCNAME *string `type:"string"`
+	FullyQualifiedCNAME *string `type:"string" min:"1" max:"255"`
 
 	metadataCheckDNSAvailabilityOutput `json:"-", xml:"-"`
 }
@@ -956,6 +1003,47 @@ type metadataCheckDNSAvailabilityOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CheckDNSAvailabilityOutput.
+func (s CheckDNSAvailabilityOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ConfigurationDeploymentStatus is an enum of the values of ConfigurationDeploymentStatus members.
+type ConfigurationDeploymentStatus string
+
+// The values of ConfigurationDeploymentStatus.
+const (
+	ConfigurationDeploymentStatusDeployed ConfigurationDeploymentStatus = "deployed"
+	ConfigurationDeploymentStatusPending  ConfigurationDeploymentStatus = "pending"
+	ConfigurationDeploymentStatusFailed   ConfigurationDeploymentStatus = "failed"
+)
+
+// ConfigurationDeploymentStatusValues returns the values of ConfigurationDeploymentStatus known to this
+// version of the SDK. The service may accept values added since.
+func ConfigurationDeploymentStatusValues() []ConfigurationDeploymentStatus {
+	return []ConfigurationDeploymentStatus{
+		ConfigurationDeploymentStatus("deployed"),
+		ConfigurationDeploymentStatus("pending"),
+		ConfigurationDeploymentStatus("failed"),
+	}
+}
+
+// String returns the ConfigurationDeploymentStatus as the string of a member.
+func (v ConfigurationDeploymentStatus) String() string {
+	return string(v)
+}
+
+// ParseConfigurationDeploymentStatus returns the ConfigurationDeploymentStatus of str, failing if it is not
+// one of the ConfigurationDeploymentStatusValues.
+func ParseConfigurationDeploymentStatus(str string) (ConfigurationDeploymentStatus, error) {
+	for _, v := range ConfigurationDeploymentStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ConfigurationDeploymentStatus value %q", str)
+}
+
 // Describes the possible values for a configuration option.
 type ConfigurationOptionDescription struct {
 	// An indication of which action is required if the value for this configuration
@@ -1044,7 +1132,7 @@ type ConfigurationOptionDescription struct {
 	// constraints.   List : Values for this option are multiple selections from
 	// the possible values.   Boolean : Values for this option are either true or
 	// false .
-	ValueType *string `type:"string"`
+	ValueType *string `type:"string" enum:"Scalar,List"`
 
 	metadataConfigurationOptionDescription `json:"-", xml:"-"`
 }
@@ -1053,6 +1141,11 @@ type metadataConfigurationOptionDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ConfigurationOptionDescription.
+func (s ConfigurationOptionDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A specification identifying an individual configuration option along with
 // its current value. For a list of possible option values, go to Option Values
 // (http://docs.aws.amazon.com/elasticbeanstalk/latest/dg/command-options.html)
@@ -1074,10 +1167,49 @@ type metadataConfigurationOptionSetting struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ConfigurationOptionSetting.
+func (s ConfigurationOptionSetting) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ConfigurationOptionValueType is an enum of the values of ConfigurationOptionValueType members.
+type ConfigurationOptionValueType string
+
+// The values of ConfigurationOptionValueType.
+const (
+	ConfigurationOptionValueTypeScalar ConfigurationOptionValueType = "Scalar"
+	ConfigurationOptionValueTypeList   ConfigurationOptionValueType = "List"
+)
+
+// ConfigurationOptionValueTypeValues returns the values of ConfigurationOptionValueType known to this
+// version of the SDK. The service may accept values added since.
+func ConfigurationOptionValueTypeValues() []ConfigurationOptionValueType {
+	return []ConfigurationOptionValueType{
+		ConfigurationOptionValueType("Scalar"),
+		ConfigurationOptionValueType("List"),
+	}
+}
+
+// String returns the ConfigurationOptionValueType as the string of a member.
+func (v ConfigurationOptionValueType) String() string {
+	return string(v)
+}
+
+// ParseConfigurationOptionValueType returns the ConfigurationOptionValueType of str, failing if it is not
+// one of the ConfigurationOptionValueTypeValues.
+func ParseConfigurationOptionValueType(str string) (ConfigurationOptionValueType, error) {
+	for _, v := range ConfigurationOptionValueTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ConfigurationOptionValueType value %q", str)
+}
+
 // Describes the settings for a configuration set.
 type ConfigurationSettingsDescription struct {
 	// The name of the application associated with this configuration set.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// The date (in UTC time) when this configuration set was created.
 	DateCreated *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -1103,24 +1235,24 @@ type ConfigurationSettingsDescription struct {
 	// environment but is in the process of deploying.   deployed: This is the configuration
 	// that is currently deployed to the associated running environment.   failed:
 	// This is a draft configuration that failed to successfully deploy.
-	DeploymentStatus *string `type:"string"`
+	DeploymentStatus *string `type:"string" enum:"deployed,pending,failed"`
 
 	// Describes this configuration set.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// If not null, the name of the environment for this configuration set.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// A list of the configuration options and their values in this configuration
 	// set.
 	OptionSettings []*ConfigurationOptionSetting `type:"list"`
 
 	// The name of the solution stack this configuration set uses.
-	SolutionStackName *string `type:"string"`
+	SolutionStackName *string `type:"string" max:"100"`
 
 	// If not null, the name of the configuration template for this configuration
 	// set.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	metadataConfigurationSettingsDescription `json:"-", xml:"-"`
 }
@@ -1129,16 +1261,21 @@ type metadataConfigurationSettingsDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ConfigurationSettingsDescription.
+func (s ConfigurationSettingsDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This documentation target is not reported in the API reference.
 type CreateApplicationInput struct {
 	// The name of the application.
 	//
 	//  Constraint: This name must be unique within your account. If the specified
 	// name already exists, the action returns an InvalidParameterValue error.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// Describes the application.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	metadataCreateApplicationInput `json:"-", xml:"-"`
 }
@@ -1147,10 +1284,15 @@ type metadataCreateApplicationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateApplicationInput.
+func (s CreateApplicationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateApplicationVersionInput struct {
 	// The name of the application. If no application is found with this name, and
 	// AutoCreateApplication is false, returns an InvalidParameterValue error.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// Determines how the system behaves if the specified application for this version
 	// does not already exist:
@@ -1170,7 +1312,7 @@ type CreateApplicationVersionInput struct {
 	AutoCreateApplication *bool `type:"boolean"`
 
 	// Describes this version.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// The Amazon S3 bucket and key that identify the location of the source bundle
 	// for this version.
@@ -1190,7 +1332,7 @@ type CreateApplicationVersionInput struct {
 	// Constraint: Must be unique per application. If an application version already
 	// exists with this label for the specified application, AWS Elastic Beanstalk
 	// returns an InvalidParameterValue error.
-	VersionLabel *string `type:"string" required:"true"`
+	VersionLabel *string `type:"string" min:"1" max:"100" required:"true"`
 
 	metadataCreateApplicationVersionInput `json:"-", xml:"-"`
 }
@@ -1199,15 +1341,20 @@ type metadataCreateApplicationVersionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateApplicationVersionInput.
+func (s CreateApplicationVersionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This documentation target is not reported in the API reference.
 type CreateConfigurationTemplateInput struct {
 	// The name of the application to associate with this configuration template.
 	// If no application is found with this name, AWS Elastic Beanstalk returns
 	// an InvalidParameterValue error.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// Describes this configuration.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// The ID of the environment used with this configuration template.
 	EnvironmentID *string `locationName:"EnvironmentId" type:"string"`
@@ -1231,7 +1378,7 @@ type CreateConfigurationTemplateInput struct {
 	//  If a solution stack name is not specified and the source configuration
 	// parameter is specified, AWS Elastic Beanstalk uses the same solution stack
 	// as the source configuration template.
-	SolutionStackName *string `type:"string"`
+	SolutionStackName *string `type:"string" max:"100"`
 
 	// If specified, AWS Elastic Beanstalk uses the configuration values from the
 	// specified configuration template to create a new configuration.
@@ -1254,7 +1401,7 @@ type CreateConfigurationTemplateInput struct {
 	//
 	// Default: If a configuration template already exists with this name, AWS
 	// Elastic Beanstalk returns an InvalidParameterValue error.
-	TemplateName *string `type:"string" required:"true"`
+	TemplateName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	metadataCreateConfigurationTemplateInput `json:"-", xml:"-"`
 }
@@ -1263,20 +1410,25 @@ type metadataCreateConfigurationTemplateInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateConfigurationTemplateInput.
+func (s CreateConfigurationTemplateInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateEnvironmentInput struct {
 	// The name of the application that contains the version to be deployed.
 	//
 	//  If no application is found with this name, CreateEnvironment returns an
 	// InvalidParameterValue error.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// If specified, the environment attempts to use this value as the prefix for
 	// the CNAME. If not specified, the CNAME is generated automatically by appending
 	// a random alphanumeric string to the environment name.
-	CNAMEPrefix *string `type:"string"`
+	CNAMEPrefix *string `type:"string" min:"4" max:"63"`
 
 	// Describes this environment.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// A unique name for the deployment environment. Used in the application URL.
 	//
@@ -1287,7 +1439,7 @@ type CreateEnvironmentInput struct {
 	//
 	// Default: If the CNAME parameter is not specified, the environment name becomes
 	// part of the CNAME, and therefore part of the visible URL for your application.
-	EnvironmentName *string `type:"string" required:"true"`
+	EnvironmentName *string `type:"string" min:"4" max:"23" required:"true"`
 
 	// If specified, AWS Elastic Beanstalk sets the specified configuration options
 	// to the requested value in the configuration set for the new environment.
@@ -1307,7 +1459,7 @@ type CreateEnvironmentInput struct {
 	// If you specify both, AWS Elastic Beanstalk returns an InvalidParameterCombination
 	// error. If you do not specify either, AWS Elastic Beanstalk returns a MissingRequiredParameter
 	// error.
-	SolutionStackName *string `type:"string"`
+	SolutionStackName *string `type:"string" max:"100"`
 
 	// This specifies the tags applied to resources in the environment.
 	Tags []*Tag `type:"list"`
@@ -1320,7 +1472,7 @@ type CreateEnvironmentInput struct {
 	// but not both. If you specify both, AWS Elastic Beanstalk returns an InvalidParameterCombination
 	// error. If you do not specify either, AWS Elastic Beanstalk returns a MissingRequiredParameter
 	// error.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	// This specifies the tier to use for creating this environment.
 	Tier *EnvironmentTier `type:"structure"`
@@ -1332,7 +1484,7 @@ type CreateEnvironmentInput struct {
 	//
 	//  Default: If not specified, AWS Elastic Beanstalk attempts to launch the
 	// sample application in the container.
-	VersionLabel *string `type:"string"`
+	VersionLabel *string `type:"string" min:"1" max:"100"`
 
 	metadataCreateEnvironmentInput `json:"-", xml:"-"`
 }
@@ -1341,6 +1493,11 @@ type metadataCreateEnvironmentInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateEnvironmentInput.
+func (s CreateEnvironmentInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateStorageLocationInput struct {
 	metadataCreateStorageLocationInput `json:"-", xml:"-"`
 }
@@ -1349,10 +1506,15 @@ type metadataCreateStorageLocationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateStorageLocationInput.
+func (s CreateStorageLocationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Results of a CreateStorageLocationResult call.
 type CreateStorageLocationOutput struct {
 	// The name of the Amazon S3 bucket created.
-	S3Bucket *string `type:"string"`
+	S3Bucket *string `type:"string" max:"255"`
 
 	metadataCreateStorageLocationOutput `json:"-", xml:"-"`
 }
@@ -1361,10 +1523,15 @@ type metadataCreateStorageLocationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateStorageLocationOutput.
+func (s CreateStorageLocationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This documentation target is not reported in the API reference.
 type DeleteApplicationInput struct {
 	// The name of the application to delete.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// When set to true, running environments will be terminated before deleting
 	// the application.
@@ -1377,6 +1544,11 @@ type metadataDeleteApplicationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteApplicationInput.
+func (s DeleteApplicationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteApplicationOutput struct {
 	metadataDeleteApplicationOutput `json:"-", xml:"-"`
 }
@@ -1385,10 +1557,15 @@ type metadataDeleteApplicationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteApplicationOutput.
+func (s DeleteApplicationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This documentation target is not reported in the API reference.
 type DeleteApplicationVersionInput struct {
 	// The name of the application to delete releases from.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// Indicates whether to delete the associated source bundle from Amazon S3:
 	//
@@ -1398,7 +1575,7 @@ type DeleteApplicationVersionInput struct {
 	DeleteSourceBundle *bool `type:"boolean"`
 
 	// The label of the version to delete.
-	VersionLabel *string `type:"string" required:"true"`
+	VersionLabel *string `type:"string" min:"1" max:"100" required:"true"`
 
 	metadataDeleteApplicationVersionInput `json:"-", xml:"-"`
 }
@@ -1407,6 +1584,11 @@ type metadataDeleteApplicationVersionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteApplicationVersionInput.
+func (s DeleteApplicationVersionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteApplicationVersionOutput struct {
 	metadataDeleteApplicationVersionOutput `json:"-", xml:"-"`
 }
@@ -1415,13 +1597,18 @@ type metadataDeleteApplicationVersionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteApplicationVersionOutput.
+func (s DeleteApplicationVersionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This documentation target is not reported in the API reference.
 type DeleteConfigurationTemplateInput struct {
 	// The name of the application to delete the configuration template from.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// The name of the configuration template to delete.
-	TemplateName *string `type:"string" required:"true"`
+	TemplateName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	metadataDeleteConfigurationTemplateInput `json:"-", xml:"-"`
 }
@@ -1430,6 +1617,11 @@ type metadataDeleteConfigurationTemplateInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteConfigurationTemplateInput.
+func (s DeleteConfigurationTemplateInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteConfigurationTemplateOutput struct {
 	metadataDeleteConfigurationTemplateOutput `json:"-", xml:"-"`
 }
@@ -1438,13 +1630,18 @@ type metadataDeleteConfigurationTemplateOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteConfigurationTemplateOutput.
+func (s DeleteConfigurationTemplateOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This documentation target is not reported in the API reference.
 type DeleteEnvironmentConfigurationInput struct {
 	// The name of the application the environment is associated with.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// The name of the environment to delete the draft configuration from.
-	EnvironmentName *string `type:"string" required:"true"`
+	EnvironmentName *string `type:"string" min:"4" max:"23" required:"true"`
 
 	metadataDeleteEnvironmentConfigurationInput `json:"-", xml:"-"`
 }
@@ -1453,6 +1650,11 @@ type metadataDeleteEnvironmentConfigurationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteEnvironmentConfigurationInput.
+func (s DeleteEnvironmentConfigurationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteEnvironmentConfigurationOutput struct {
 	metadataDeleteEnvironmentConfigurationOutput `json:"-", xml:"-"`
 }
@@ -1461,11 +1663,16 @@ type metadataDeleteEnvironmentConfigurationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteEnvironmentConfigurationOutput.
+func (s DeleteEnvironmentConfigurationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Result message containing a list of configuration descriptions.
 type DescribeApplicationVersionsInput struct {
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// only include ones that are associated with the specified application.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// If specified, restricts the returned descriptions to only include ones that
 	// have the specified version labels.
@@ -1478,6 +1685,11 @@ type metadataDescribeApplicationVersionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeApplicationVersionsInput.
+func (s DescribeApplicationVersionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Result message wrapping a list of application version descriptions.
 type DescribeApplicationVersionsOutput struct {
 	// A list of ApplicationVersionDescription .
@@ -1490,6 +1702,11 @@ type metadataDescribeApplicationVersionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeApplicationVersionsOutput.
+func (s DescribeApplicationVersionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This documentation target is not reported in the API reference.
 type DescribeApplicationsInput struct {
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
@@ -1503,6 +1720,11 @@ type metadataDescribeApplicationsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeApplicationsInput.
+func (s DescribeApplicationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Result message containing a list of application descriptions.
 type DescribeApplicationsOutput struct {
 	// This parameter contains a list of ApplicationDescription.
@@ -1515,25 +1737,30 @@ type metadataDescribeApplicationsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeApplicationsOutput.
+func (s DescribeApplicationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Result message containig a list of application version descriptions.
 type DescribeConfigurationOptionsInput struct {
 	// The name of the application associated with the configuration template or
 	// environment. Only needed if you want to describe the configuration options
 	// associated with either the configuration template or environment.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// The name of the environment whose configuration options you want to describe.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// If specified, restricts the descriptions to only the specified options.
 	Options []*OptionSpecification `type:"list"`
 
 	// The name of the solution stack whose configuration options you want to describe.
-	SolutionStackName *string `type:"string"`
+	SolutionStackName *string `type:"string" max:"100"`
 
 	// The name of the configuration template whose configuration options you want
 	// to describe.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	metadataDescribeConfigurationOptionsInput `json:"-", xml:"-"`
 }
@@ -1542,13 +1769,18 @@ type metadataDescribeConfigurationOptionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeConfigurationOptionsInput.
+func (s DescribeConfigurationOptionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the settings for a specified configuration set.
 type DescribeConfigurationOptionsOutput struct {
 	// A list of ConfigurationOptionDescription.
 	Options []*ConfigurationOptionDescription `type:"list"`
 
 	// The name of the solution stack these configuration options belong to.
-	SolutionStackName *string `type:"string"`
+	SolutionStackName *string `type:"string" max:"100"`
 
 	metadataDescribeConfigurationOptionsOutput `json:"-", xml:"-"`
 }
@@ -1557,11 +1789,16 @@ type metadataDescribeConfigurationOptionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeConfigurationOptionsOutput.
+func (s DescribeConfigurationOptionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Result message containing all of the configuration settings for a specified
 // solution stack or configuration template.
 type DescribeConfigurationSettingsInput struct {
 	// The application for the environment or configuration template.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// The name of the environment to describe.
 	//
@@ -1569,7 +1806,7 @@ type DescribeConfigurationSettingsInput struct {
 	// If you specify both, AWS Elastic Beanstalk returns an InvalidParameterCombination
 	// error. If you do not specify either, AWS Elastic Beanstalk returns MissingRequiredParameter
 	// error.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// The name of the configuration template to describe.
 	//
@@ -1577,7 +1814,7 @@ type DescribeConfigurationSettingsInput struct {
 	// but not both. If you specify both, AWS Elastic Beanstalk returns an InvalidParameterCombination
 	// error. If you do not specify either, AWS Elastic Beanstalk returns a MissingRequiredParameter
 	// error.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	metadataDescribeConfigurationSettingsInput `json:"-", xml:"-"`
 }
@@ -1586,6 +1823,11 @@ type metadataDescribeConfigurationSettingsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeConfigurationSettingsInput.
+func (s DescribeConfigurationSettingsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The results from a request to change the configuration settings of an environment.
 type DescribeConfigurationSettingsOutput struct {
 	// A list of ConfigurationSettingsDescription.
@@ -1598,6 +1840,11 @@ type metadataDescribeConfigurationSettingsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeConfigurationSettingsOutput.
+func (s DescribeConfigurationSettingsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This documentation target is not reported in the API reference.
 type DescribeEnvironmentResourcesInput struct {
 	// The ID of the environment to retrieve AWS resource usage data.
@@ -1612,7 +1859,7 @@ type DescribeEnvironmentResourcesInput struct {
 	//  Condition: You must specify either this or an EnvironmentId, or both. If
 	// you do not specify either, AWS Elastic Beanstalk returns MissingRequiredParameter
 	// error.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	metadataDescribeEnvironmentResourcesInput `json:"-", xml:"-"`
 }
@@ -1621,6 +1868,11 @@ type metadataDescribeEnvironmentResourcesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeEnvironmentResourcesInput.
+func (s DescribeEnvironmentResourcesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Result message containing a list of environment resource descriptions.
 type DescribeEnvironmentResourcesOutput struct {
 	// A list of EnvironmentResourceDescription.
@@ -1633,11 +1885,16 @@ type metadataDescribeEnvironmentResourcesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeEnvironmentResourcesOutput.
+func (s DescribeEnvironmentResourcesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This documentation target is not reported in the API reference.
 type DescribeEnvironmentsInput struct {
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// include only those that are associated with this application.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// include only those that have the specified IDs.
@@ -1661,7 +1918,7 @@ type DescribeEnvironmentsInput struct {
 
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// include only those that are associated with this application version.
-	VersionLabel *string `type:"string"`
+	VersionLabel *string `type:"string" min:"1" max:"100"`
 
 	metadataDescribeEnvironmentsInput `json:"-", xml:"-"`
 }
@@ -1670,6 +1927,11 @@ type metadataDescribeEnvironmentsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeEnvironmentsInput.
+func (s DescribeEnvironmentsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Result message containing a list of environment descriptions.
 type DescribeEnvironmentsOutput struct {
 	// Returns an EnvironmentDescription list.
@@ -1682,11 +1944,16 @@ type metadataDescribeEnvironmentsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeEnvironmentsOutput.
+func (s DescribeEnvironmentsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This documentation target is not reported in the API reference.
 type DescribeEventsInput struct {
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// include only those associated with this application.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// those that occur up to, but not including, the EndTime.
@@ -1698,11 +1965,11 @@ type DescribeEventsInput struct {
 
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// those associated with this environment.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// Specifies the maximum number of events that can be returned, beginning with
 	// the most recent event.
-	MaxRecords *int64 `type:"integer"`
+	MaxRecords *int64 `type:"integer" min:"1" max:"1000"`
 
 	// Pagination token. If specified, the events return the next batch of results.
 	NextToken *string `type:"string"`
@@ -1713,7 +1980,7 @@ type DescribeEventsInput struct {
 
 	// If specified, limits the events returned from this call to include only those
 	// with the specified severity or higher.
-	Severity *string `type:"string"`
+	Severity *string `type:"string" enum:"TRACE,DEBUG,INFO,WARN,ERROR,FATAL"`
 
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// those that occur on or after this time.
@@ -1721,11 +1988,11 @@ type DescribeEventsInput struct {
 
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// those that are associated with this environment configuration.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	// If specified, AWS Elastic Beanstalk restricts the returned descriptions to
 	// those associated with this application version.
-	VersionLabel *string `type:"string"`
+	VersionLabel *string `type:"string" min:"1" max:"100"`
 
 	metadataDescribeEventsInput `json:"-", xml:"-"`
 }
@@ -1734,6 +2001,11 @@ type metadataDescribeEventsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeEventsInput.
+func (s DescribeEventsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Result message wrapping a list of event descriptions.
 type DescribeEventsOutput struct {
 	// A list of EventDescription.
@@ -1750,13 +2022,18 @@ type metadataDescribeEventsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeEventsOutput.
+func (s DescribeEventsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the properties of an environment.
 type EnvironmentDescription struct {
 	// The name of the application associated with this environment.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// The URL to the CNAME for this environment.
-	CNAME *string `type:"string"`
+	CNAME *string `type:"string" min:"1" max:"255"`
 
 	// The creation date for this environment.
 	DateCreated *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -1765,7 +2042,7 @@ type EnvironmentDescription struct {
 	DateUpdated *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// Describes this environment.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// For load-balanced, autoscaling environments, the URL to the LoadBalancer.
 	// For single-instance environments, the IP address of the instance.
@@ -1775,7 +2052,7 @@ type EnvironmentDescription struct {
 	EnvironmentID *string `locationName:"EnvironmentId" type:"string"`
 
 	// The name of this environment.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// Describes the health status of the environment. AWS Elastic Beanstalk indicates
 	// the failure levels for a running environment:
@@ -1794,13 +2071,13 @@ type EnvironmentDescription struct {
 	//   Grey: Default health for a new environment. The environment is not fully
 	// launched and health checks have not started or health checks are suspended
 	// during an UpdateEnvironment or RestartEnvironement request.    Default: Grey
-	Health *string `type:"string"`
+	Health *string `type:"string" enum:"Green,Yellow,Red,Grey"`
 
 	// The description of the AWS resources used by this environment.
 	Resources *EnvironmentResourcesDescription `type:"structure"`
 
 	// The name of the SolutionStack deployed with this environment.
-	SolutionStackName *string `type:"string"`
+	SolutionStackName *string `type:"string" max:"100"`
 
 	// The current operational status of the environment:
 	//
@@ -1809,16 +2086,16 @@ type EnvironmentDescription struct {
 	// version.   Ready: Environment is available to have an action performed on
 	// it, such as update or terminate.   Terminating: Environment is in the shut-down
 	// process.   Terminated: Environment is not running.
-	Status *string `type:"string"`
+	Status *string `type:"string" enum:"Launching,Updating,Ready,Terminating,Terminated"`
 
 	// The name of the configuration template used to originally launch this environment.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	// Describes the current tier of this environment.
 	Tier *EnvironmentTier `type:"structure"`
 
 	// The application version deployed in this environment.
-	VersionLabel *string `type:"string"`
+	VersionLabel *string `type:"string" min:"1" max:"100"`
 
 	metadataEnvironmentDescription `json:"-", xml:"-"`
 }
@@ -1827,13 +2104,56 @@ type metadataEnvironmentDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EnvironmentDescription.
+func (s EnvironmentDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// EnvironmentHealth is an enum of the values of EnvironmentHealth members.
+type EnvironmentHealth string
+
+// The values of EnvironmentHealth.
+const (
+	EnvironmentHealthGreen  EnvironmentHealth = "Green"
+	EnvironmentHealthYellow EnvironmentHealth = "Yellow"
+	EnvironmentHealthRed    EnvironmentHealth = "Red"
+	EnvironmentHealthGrey   EnvironmentHealth = "Grey"
+)
+
+// EnvironmentHealthValues returns the values of EnvironmentHealth known to this
+// version of the SDK. The service may accept values added since.
+func EnvironmentHealthValues() []EnvironmentHealth {
+	return []EnvironmentHealth{
+		EnvironmentHealth("Green"),
+		EnvironmentHealth("Yellow"),
+		EnvironmentHealth("Red"),
+		EnvironmentHealth("Grey"),
+	}
+}
+
+// String returns the EnvironmentHealth as the string of a member.
+func (v EnvironmentHealth) String() string {
+	return string(v)
+}
+
+// ParseEnvironmentHealth returns the EnvironmentHealth of str, failing if it is not
+// one of the EnvironmentHealthValues.
+func ParseEnvironmentHealth(str string) (EnvironmentHealth, error) {
+	for _, v := range EnvironmentHealthValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid EnvironmentHealth value %q", str)
+}
+
 // The information retrieved from the Amazon EC2 instances.
 type EnvironmentInfoDescription struct {
 	// The Amazon EC2 Instance ID for this information.
 	EC2InstanceID *string `locationName:"Ec2InstanceId" type:"string"`
 
 	// The type of information retrieved.
-	InfoType *string `type:"string"`
+	InfoType *string `type:"string" enum:"tail"`
 
 	// The retrieved information.
 	Message *string `type:"string"`
@@ -1848,13 +2168,50 @@ type metadataEnvironmentInfoDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EnvironmentInfoDescription.
+func (s EnvironmentInfoDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// EnvironmentInfoType is an enum of the values of EnvironmentInfoType members.
+type EnvironmentInfoType string
+
+// The values of EnvironmentInfoType.
+const (
+	EnvironmentInfoTypeTail EnvironmentInfoType = "tail"
+)
+
+// EnvironmentInfoTypeValues returns the values of EnvironmentInfoType known to this
+// version of the SDK. The service may accept values added since.
+func EnvironmentInfoTypeValues() []EnvironmentInfoType {
+	return []EnvironmentInfoType{
+		EnvironmentInfoType("tail"),
+	}
+}
+
+// String returns the EnvironmentInfoType as the string of a member.
+func (v EnvironmentInfoType) String() string {
+	return string(v)
+}
+
+// ParseEnvironmentInfoType returns the EnvironmentInfoType of str, failing if it is not
+// one of the EnvironmentInfoTypeValues.
+func ParseEnvironmentInfoType(str string) (EnvironmentInfoType, error) {
+	for _, v := range EnvironmentInfoTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid EnvironmentInfoType value %q", str)
+}
+
 // Describes the AWS resources in use by this environment. This data is live.
 type EnvironmentResourceDescription struct {
 	// The AutoScalingGroups used by this environment.
 	AutoScalingGroups []*AutoScalingGroup `type:"list"`
 
 	// The name of the environment.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// The Amazon EC2 instances used by this environment.
 	Instances []*Instance `type:"list"`
@@ -1878,6 +2235,11 @@ type metadataEnvironmentResourceDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EnvironmentResourceDescription.
+func (s EnvironmentResourceDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the AWS resources in use by this environment. This data is not
 // live data.
 type EnvironmentResourcesDescription struct {
@@ -1891,6 +2253,51 @@ type metadataEnvironmentResourcesDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EnvironmentResourcesDescription.
+func (s EnvironmentResourcesDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// EnvironmentStatus is an enum of the values of EnvironmentStatus members.
+type EnvironmentStatus string
+
+// The values of EnvironmentStatus.
+const (
+	EnvironmentStatusLaunching   EnvironmentStatus = "Launching"
+	EnvironmentStatusUpdating    EnvironmentStatus = "Updating"
+	EnvironmentStatusReady       EnvironmentStatus = "Ready"
+	EnvironmentStatusTerminating EnvironmentStatus = "Terminating"
+	EnvironmentStatusTerminated  EnvironmentStatus = "Terminated"
+)
+
+// EnvironmentStatusValues returns the values of EnvironmentStatus known to this
+// version of the SDK. The service may accept values added since.
+func EnvironmentStatusValues() []EnvironmentStatus {
+	return []EnvironmentStatus{
+		EnvironmentStatus("Launching"),
+		EnvironmentStatus("Updating"),
+		EnvironmentStatus("Ready"),
+		EnvironmentStatus("Terminating"),
+		EnvironmentStatus("Terminated"),
+	}
+}
+
+// String returns the EnvironmentStatus as the string of a member.
+func (v EnvironmentStatus) String() string {
+	return string(v)
+}
+
+// ParseEnvironmentStatus returns the EnvironmentStatus of str, failing if it is not
+// one of the EnvironmentStatusValues.
+func ParseEnvironmentStatus(str string) (EnvironmentStatus, error) {
+	for _, v := range EnvironmentStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid EnvironmentStatus value %q", str)
+}
+
 // Describes the properties of an environment tier
 type EnvironmentTier struct {
 	// The name of this environment tier.
@@ -1909,13 +2316,18 @@ type metadataEnvironmentTier struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EnvironmentTier.
+func (s EnvironmentTier) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an event.
 type EventDescription struct {
 	// The application associated with the event.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// The name of the environment associated with this event.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// The date when the event occurred.
 	EventDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -1927,13 +2339,13 @@ type EventDescription struct {
 	RequestID *string `locationName:"RequestId" type:"string"`
 
 	// The severity level of this event.
-	Severity *string `type:"string"`
+	Severity *string `type:"string" enum:"TRACE,DEBUG,INFO,WARN,ERROR,FATAL"`
 
 	// The name of the configuration associated with this event.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	// The release label for the application version associated with this event.
-	VersionLabel *string `type:"string"`
+	VersionLabel *string `type:"string" min:"1" max:"100"`
 
 	metadataEventDescription `json:"-", xml:"-"`
 }
@@ -1942,6 +2354,53 @@ type metadataEventDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EventDescription.
+func (s EventDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// EventSeverity is an enum of the values of EventSeverity members.
+type EventSeverity string
+
+// The values of EventSeverity.
+const (
+	EventSeverityTRACE EventSeverity = "TRACE"
+	EventSeverityDEBUG EventSeverity = "DEBUG"
+	EventSeverityINFO  EventSeverity = "INFO"
+	EventSeverityWARN  EventSeverity = "WARN"
+	EventSeverityERROR EventSeverity = "ERROR"
+	EventSeverityFATAL EventSeverity = "FATAL"
+)
+
+// EventSeverityValues returns the values of EventSeverity known to this
+// version of the SDK. The service may accept values added since.
+func EventSeverityValues() []EventSeverity {
+	return []EventSeverity{
+		EventSeverity("TRACE"),
+		EventSeverity("DEBUG"),
+		EventSeverity("INFO"),
+		EventSeverity("WARN"),
+		EventSeverity("ERROR"),
+		EventSeverity("FATAL"),
+	}
+}
+
+// String returns the EventSeverity as the string of a member.
+func (v EventSeverity) String() string {
+	return string(v)
+}
+
+// ParseEventSeverity returns the EventSeverity of str, failing if it is not
+// one of the EventSeverityValues.
+func ParseEventSeverity(str string) (EventSeverity, error) {
+	for _, v := range EventSeverityValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid EventSeverity value %q", str)
+}
+
 // The description of an Amazon EC2 instance.
 type Instance struct {
 	// The ID of the Amazon EC2 instance.
@@ -1954,6 +2413,11 @@ type metadataInstance struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Instance.
+func (s Instance) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an Auto Scaling launch configuration.
 type LaunchConfiguration struct {
 	// The name of the launch configuration.
@@ -1966,6 +2430,11 @@ type metadataLaunchConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the LaunchConfiguration.
+func (s LaunchConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListAvailableSolutionStacksInput struct {
 	metadataListAvailableSolutionStacksInput `json:"-", xml:"-"`
 }
@@ -1974,6 +2443,11 @@ type metadataListAvailableSolutionStacksInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListAvailableSolutionStacksInput.
+func (s ListAvailableSolutionStacksInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A list of available AWS Elastic Beanstalk solution stacks.
 type ListAvailableSolutionStacksOutput struct {
 	// A list of available solution stacks and their SolutionStackDescription.
@@ -1989,6 +2463,11 @@ type metadataListAvailableSolutionStacksOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListAvailableSolutionStacksOutput.
+func (s ListAvailableSolutionStacksOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the properties of a Listener for the LoadBalancer.
 type Listener struct {
 	// The port that is used by the Listener.
@@ -2004,6 +2483,11 @@ type metadataListener struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Listener.
+func (s Listener) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a LoadBalancer.
 type LoadBalancer struct {
 	// The name of the LoadBalancer.
@@ -2016,6 +2500,11 @@ type metadataLoadBalancer struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the LoadBalancer.
+func (s LoadBalancer) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the details of a LoadBalancer.
 type LoadBalancerDescription struct {
 	// The domain name of the LoadBalancer.
@@ -2034,6 +2523,11 @@ type metadataLoadBalancerDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the LoadBalancerDescription.
+func (s LoadBalancerDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A regular expression representing a restriction on a string configuration
 // option value.
 type OptionRestrictionRegex struct {
@@ -2051,6 +2545,11 @@ type metadataOptionRestrictionRegex struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OptionRestrictionRegex.
+func (s OptionRestrictionRegex) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A specification identifying an individual configuration option.
 type OptionSpecification struct {
 	// A unique namespace identifying the option's associated AWS resource.
@@ -2066,6 +2565,11 @@ type metadataOptionSpecification struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OptionSpecification.
+func (s OptionSpecification) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a queue.
 type Queue struct {
 	// The name of the queue.
@@ -2081,6 +2585,11 @@ type metadataQueue struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Queue.
+func (s Queue) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RebuildEnvironmentInput struct {
 	// The ID of the environment to rebuild.
 	//
@@ -2094,7 +2603,7 @@ type RebuildEnvironmentInput struct {
 	//  Condition: You must specify either this or an EnvironmentId, or both. If
 	// you do not specify either, AWS Elastic Beanstalk returns MissingRequiredParameter
 	// error.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	metadataRebuildEnvironmentInput `json:"-", xml:"-"`
 }
@@ -2103,6 +2612,11 @@ type metadataRebuildEnvironmentInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RebuildEnvironmentInput.
+func (s RebuildEnvironmentInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RebuildEnvironmentOutput struct {
 	metadataRebuildEnvironmentOutput `json:"-", xml:"-"`
 }
@@ -2111,6 +2625,11 @@ type metadataRebuildEnvironmentOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RebuildEnvironmentOutput.
+func (s RebuildEnvironmentOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This documentation target is not reported in the API reference.
 type RequestEnvironmentInfoInput struct {
 	// The ID of the environment of the requested data.
@@ -2131,10 +2650,10 @@ type RequestEnvironmentInfoInput struct {
 	//  Condition: You must specify either this or an EnvironmentId, or both. If
 	// you do not specify either, AWS Elastic Beanstalk returns MissingRequiredParameter
 	// error.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// The type of information to request.
-	InfoType *string `type:"string" required:"true"`
+	InfoType *string `type:"string" enum:"tail" required:"true"`
 
 	metadataRequestEnvironmentInfoInput `json:"-", xml:"-"`
 }
@@ -2143,6 +2662,11 @@ type metadataRequestEnvironmentInfoInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RequestEnvironmentInfoInput.
+func (s RequestEnvironmentInfoInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RequestEnvironmentInfoOutput struct {
 	metadataRequestEnvironmentInfoOutput `json:"-", xml:"-"`
 }
@@ -2151,6 +2675,11 @@ type metadataRequestEnvironmentInfoOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RequestEnvironmentInfoOutput.
+func (s RequestEnvironmentInfoOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RestartAppServerInput struct {
 	// The ID of the environment to restart the server for.
 	//
@@ -2164,7 +2693,7 @@ type RestartAppServerInput struct {
 	//  Condition: You must specify either this or an EnvironmentId, or both. If
 	// you do not specify either, AWS Elastic Beanstalk returns MissingRequiredParameter
 	// error.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	metadataRestartAppServerInput `json:"-", xml:"-"`
 }
@@ -2173,6 +2702,11 @@ type metadataRestartAppServerInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RestartAppServerInput.
+func (s RestartAppServerInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RestartAppServerOutput struct {
 	metadataRestartAppServerOutput `json:"-", xml:"-"`
 }
@@ -2181,6 +2715,11 @@ type metadataRestartAppServerOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RestartAppServerOutput.
+func (s RestartAppServerOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This documentation target is not reported in the API reference.
 type RetrieveEnvironmentInfoInput struct {
 	// The ID of the data's environment.
@@ -2199,10 +2738,10 @@ type RetrieveEnvironmentInfoInput struct {
 	//  Condition: You must specify either this or an EnvironmentId, or both. If
 	// you do not specify either, AWS Elastic Beanstalk returns MissingRequiredParameter
 	// error.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// The type of information to retrieve.
-	InfoType *string `type:"string" required:"true"`
+	InfoType *string `type:"string" enum:"tail" required:"true"`
 
 	metadataRetrieveEnvironmentInfoInput `json:"-", xml:"-"`
 }
@@ -2211,6 +2750,11 @@ type metadataRetrieveEnvironmentInfoInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RetrieveEnvironmentInfoInput.
+func (s RetrieveEnvironmentInfoInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Result message containing a description of the requested environment info.
 type RetrieveEnvironmentInfoOutput struct {
 	// The EnvironmentInfoDescription of the environment.
@@ -2223,13 +2767,18 @@ type metadataRetrieveEnvironmentInfoOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RetrieveEnvironmentInfoOutput.
+func (s RetrieveEnvironmentInfoOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A specification of a location in Amazon S3.
 type S3Location struct {
 	// The Amazon S3 bucket where the data is located.
-	S3Bucket *string `type:"string"`
+	S3Bucket *string `type:"string" max:"255"`
 
 	// The Amazon S3 key where the data is located.
-	S3Key *string `type:"string"`
+	S3Key *string `type:"string" max:"1024"`
 
 	metadataS3Location `json:"-", xml:"-"`
 }
@@ -2238,13 +2787,18 @@ type metadataS3Location struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the S3Location.
+func (s S3Location) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the solution stack.
 type SolutionStackDescription struct {
 	// The permitted file types allowed for a solution stack.
 	PermittedFileTypes []*string `type:"list"`
 
 	// The name of the solution stack.
-	SolutionStackName *string `type:"string"`
+	SolutionStackName *string `type:"string" max:"100"`
 
 	metadataSolutionStackDescription `json:"-", xml:"-"`
 }
@@ -2253,13 +2807,18 @@ type metadataSolutionStackDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SolutionStackDescription.
+func (s SolutionStackDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A specification for an environment configuration
 type SourceConfiguration struct {
 	// The name of the application associated with the configuration.
-	ApplicationName *string `type:"string"`
+	ApplicationName *string `type:"string" min:"1" max:"100"`
 
 	// The name of the configuration template.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	metadataSourceConfiguration `json:"-", xml:"-"`
 }
@@ -2268,6 +2827,11 @@ type metadataSourceConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SourceConfiguration.
+func (s SourceConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SwapEnvironmentCNAMEsInput struct {
 	// The ID of the destination environment.
 	//
@@ -2281,7 +2845,7 @@ type SwapEnvironmentCNAMEsInput struct {
 	//  Condition: You must specify at least the DestinationEnvironmentID or the
 	// DestinationEnvironmentName. You may also specify both. You must specify the
 	// SourceEnvironmentName with the DestinationEnvironmentName.
-	DestinationEnvironmentName *string `type:"string"`
+	DestinationEnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// The ID of the source environment.
 	//
@@ -2295,7 +2859,7 @@ type SwapEnvironmentCNAMEsInput struct {
 	//  Condition: You must specify at least the SourceEnvironmentID or the SourceEnvironmentName.
 	// You may also specify both. If you specify the SourceEnvironmentName, you
 	// must specify the DestinationEnvironmentName.
-	SourceEnvironmentName *string `type:"string"`
+	SourceEnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	metadataSwapEnvironmentCNAMEsInput `json:"-", xml:"-"`
 }
@@ -2304,6 +2868,11 @@ type metadataSwapEnvironmentCNAMEsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SwapEnvironmentCNAMEsInput.
+func (s SwapEnvironmentCNAMEsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SwapEnvironmentCNAMEsOutput struct {
 	metadataSwapEnvironmentCNAMEsOutput `json:"-", xml:"-"`
 }
@@ -2312,13 +2881,18 @@ type metadataSwapEnvironmentCNAMEsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SwapEnvironmentCNAMEsOutput.
+func (s SwapEnvironmentCNAMEsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a tag applied to a resource in an environment.
 type Tag struct {
 	// The key of the tag.
-	Key *string `type:"string"`
+	Key *string `type:"string" min:"1" max:"128"`
 
 	// The value of the tag.
-	Value *string `type:"string"`
+	Value *string `type:"string" min:"1" max:"256"`
 
 	metadataTag `json:"-", xml:"-"`
 }
@@ -2327,6 +2901,11 @@ type metadataTag struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Tag.
+func (s Tag) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This documentation target is not reported in the API reference.
 type TerminateEnvironmentInput struct {
 	// The ID of the environment to terminate.
@@ -2341,7 +2920,7 @@ type TerminateEnvironmentInput struct {
 	//  Condition: You must specify either this or an EnvironmentId, or both. If
 	// you do not specify either, AWS Elastic Beanstalk returns MissingRequiredParameter
 	// error.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// Indicates whether the associated AWS resources should shut down when the
 	// environment is terminated:
@@ -2370,6 +2949,11 @@ type metadataTerminateEnvironmentInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TerminateEnvironmentInput.
+func (s TerminateEnvironmentInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a trigger.
 type Trigger struct {
 	// The name of the trigger.
@@ -2382,16 +2966,21 @@ type metadataTrigger struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Trigger.
+func (s Trigger) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This documentation target is not reported in the API reference.
 type UpdateApplicationInput struct {
 	// The name of the application to update. If no such application is found, UpdateApplication
 	// returns an InvalidParameterValue error.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// A new description for the application.
 	//
 	// Default: If not specified, AWS Elastic Beanstalk does not update the description.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	metadataUpdateApplicationInput `json:"-", xml:"-"`
 }
@@ -2400,21 +2989,26 @@ type metadataUpdateApplicationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateApplicationInput.
+func (s UpdateApplicationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateApplicationVersionInput struct {
 	// The name of the application associated with this version.
 	//
 	//  If no application is found with this name, UpdateApplication returns an
 	// InvalidParameterValue error.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// A new description for this release.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// The name of the version to update.
 	//
 	//  If no application version is found with this label, UpdateApplication returns
 	// an InvalidParameterValue error.
-	VersionLabel *string `type:"string" required:"true"`
+	VersionLabel *string `type:"string" min:"1" max:"100" required:"true"`
 
 	metadataUpdateApplicationVersionInput `json:"-", xml:"-"`
 }
@@ -2423,6 +3017,11 @@ type metadataUpdateApplicationVersionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateApplicationVersionInput.
+func (s UpdateApplicationVersionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The result message containing the options for the specified solution stack.
 type UpdateConfigurationTemplateInput struct {
 	// The name of the application associated with the configuration template to
@@ -2430,10 +3029,10 @@ type UpdateConfigurationTemplateInput struct {
 	//
 	//  If no application is found with this name, UpdateConfigurationTemplate
 	// returns an InvalidParameterValue error.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// A new description for the configuration.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// A list of configuration option settings to update with the new specified
 	// option value.
@@ -2448,7 +3047,7 @@ type UpdateConfigurationTemplateInput struct {
 	//
 	//  If no configuration template is found with this name, UpdateConfigurationTemplate
 	// returns an InvalidParameterValue error.
-	TemplateName *string `type:"string" required:"true"`
+	TemplateName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	metadataUpdateConfigurationTemplateInput `json:"-", xml:"-"`
 }
@@ -2457,11 +3056,16 @@ type metadataUpdateConfigurationTemplateInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateConfigurationTemplateInput.
+func (s UpdateConfigurationTemplateInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This documentation target is not reported in the API reference.
 type UpdateEnvironmentInput struct {
 	// If this parameter is specified, AWS Elastic Beanstalk updates the description
 	// of this environment.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"200"`
 
 	// The ID of the environment to update.
 	//
@@ -2479,7 +3083,7 @@ type UpdateEnvironmentInput struct {
 	//  Condition: You must specify either this or an EnvironmentId, or both. If
 	// you do not specify either, AWS Elastic Beanstalk returns MissingRequiredParameter
 	// error.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// If specified, AWS Elastic Beanstalk updates the configuration set associated
 	// with the running environment and sets the specified configuration options
@@ -2493,7 +3097,7 @@ type UpdateEnvironmentInput struct {
 	// If this parameter is specified, AWS Elastic Beanstalk deploys this configuration
 	// template to the environment. If no such configuration template is found,
 	// AWS Elastic Beanstalk returns an InvalidParameterValue error.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	// This specifies the tier to use to update the environment.
 	//
@@ -2505,7 +3109,7 @@ type UpdateEnvironmentInput struct {
 	// If this parameter is specified, AWS Elastic Beanstalk deploys the named application
 	// version to the environment. If no such application version is found, returns
 	// an InvalidParameterValue error.
-	VersionLabel *string `type:"string"`
+	VersionLabel *string `type:"string" min:"1" max:"100"`
 
 	metadataUpdateEnvironmentInput `json:"-", xml:"-"`
 }
@@ -2514,16 +3118,21 @@ type metadataUpdateEnvironmentInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateEnvironmentInput.
+func (s UpdateEnvironmentInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A list of validation messages for a specified configuration template.
 type ValidateConfigurationSettingsInput struct {
 	// The name of the application that the configuration template or environment
 	// belongs to.
-	ApplicationName *string `type:"string" required:"true"`
+	ApplicationName *string `type:"string" min:"1" max:"100" required:"true"`
 
 	// The name of the environment to validate the settings against.
 	//
 	//  Condition: You cannot specify both this and a configuration template name.
-	EnvironmentName *string `type:"string"`
+	EnvironmentName *string `type:"string" min:"4" max:"23"`
 
 	// A list of the options and desired values to evaluate.
 	OptionSettings []*ConfigurationOptionSetting `type:"list" required:"true"`
@@ -2531,7 +3140,7 @@ type ValidateConfigurationSettingsInput struct {
 	// The name of the configuration template to validate the settings against.
 	//
 	//  Condition: You cannot specify both this and an environment name.
-	TemplateName *string `type:"string"`
+	TemplateName *string `type:"string" min:"1" max:"100"`
 
 	metadataValidateConfigurationSettingsInput `json:"-", xml:"-"`
 }
@@ -2540,6 +3149,11 @@ type metadataValidateConfigurationSettingsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ValidateConfigurationSettingsInput.
+func (s ValidateConfigurationSettingsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides a list of validation messages.
 type ValidateConfigurationSettingsOutput struct {
 	// A list of ValidationMessage.
@@ -2552,6 +3166,11 @@ type metadataValidateConfigurationSettingsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ValidateConfigurationSettingsOutput.
+func (s ValidateConfigurationSettingsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // An error or warning for a desired configuration option value.
 type ValidationMessage struct {
 	// A message describing the error or warning.
@@ -2571,11 +3190,50 @@ type ValidationMessage struct {
 	//     error: This message indicates that this is not a valid setting for an
 	// option.   warning: This message is providing information you should take
 	// into account.
-	Severity *string `type:"string"`
+	Severity *string `type:"string" enum:"error,warning"`
 
 	metadataValidationMessage `json:"-", xml:"-"`
 }
 
 type metadataValidationMessage struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the ValidationMessage.
+func (s ValidationMessage) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ValidationSeverity is an enum of the values of ValidationSeverity members.
+type ValidationSeverity string
+
+// The values of ValidationSeverity.
+const (
+	ValidationSeverityError   ValidationSeverity = "error"
+	ValidationSeverityWarning ValidationSeverity = "warning"
+)
+
+// ValidationSeverityValues returns the values of ValidationSeverity known to this
+// version of the SDK. The service may accept values added since.
+func ValidationSeverityValues() []ValidationSeverity {
+	return []ValidationSeverity{
+		ValidationSeverity("error"),
+		ValidationSeverity("warning"),
+	}
+}
+
+// String returns the ValidationSeverity as the string of a member.
+func (v ValidationSeverity) String() string {
+	return string(v)
+}
+
+// ParseValidationSeverity returns the ValidationSeverity of str, failing if it is not
+// one of the ValidationSeverityValues.
+func ParseValidationSeverity(str string) (ValidationSeverity, error) {
+	for _, v := range ValidationSeverityValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ValidationSeverity value %q", str)
 }
\ No newline at end of file
@@ -5,6 +5,7 @@ package elastictranscoder
 
 import (
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // CancelJobRequest generates a request for the CancelJob operation.
@@ -200,6 +201,10 @@ func (c *ElasticTranscoder) ListJobsByPipelineRequest(input *ListJobsByPipelineI
 			Name:       "ListJobsByPipeline",
 			HTTPMethod: "GET",
 			HTTPPath:   "/2012-09-25/jobsByPipeline/{PipelineId}",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"PageToken"},
+				OutputTokens: []string{"NextPageToken"},
+			},
 		}
 	}
 
@@ -221,6 +226,16 @@ func (c *ElasticTranscoder) ListJobsByPipeline(input *ListJobsByPipelineInput) (
 	return
 }
 
+// ListJobsByPipelinePages iterates over the pages of a ListJobsByPipeline operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *ElasticTranscoder) ListJobsByPipelinePages(input *ListJobsByPipelineInput, fn func(p *ListJobsByPipelineOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListJobsByPipelineRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListJobsByPipelineOutput), lastPage)
+	})
+}
+
 var opListJobsByPipeline *aws.Operation
 
 // ListJobsByStatusRequest generates a request for the ListJobsByStatus operation.
@@ -230,6 +245,10 @@ func (c *ElasticTranscoder) ListJobsByStatusRequest(input *ListJobsByStatusInput
 			Name:       "ListJobsByStatus",
 			HTTPMethod: "GET",
 			HTTPPath:   "/2012-09-25/jobsByStatus/{Status}",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"PageToken"},
+				OutputTokens: []string{"NextPageToken"},
+			},
 		}
 	}
 
@@ -249,6 +268,16 @@ func (c *ElasticTranscoder) ListJobsByStatus(input *ListJobsByStatusInput) (outp
 	return
 }
 
+// ListJobsByStatusPages iterates over the pages of a ListJobsByStatus operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *ElasticTranscoder) ListJobsByStatusPages(input *ListJobsByStatusInput, fn func(p *ListJobsByStatusOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListJobsByStatusRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListJobsByStatusOutput), lastPage)
+	})
+}
+
 var opListJobsByStatus *aws.Operation
 
 // ListPipelinesRequest generates a request for the ListPipelines operation.
@@ -258,6 +287,10 @@ func (c *ElasticTranscoder) ListPipelinesRequest(input *ListPipelinesInput) (req
 			Name:       "ListPipelines",
 			HTTPMethod: "GET",
 			HTTPPath:   "/2012-09-25/pipelines",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"PageToken"},
+				OutputTokens: []string{"NextPageToken"},
+			},
 		}
 	}
 
@@ -276,6 +309,16 @@ func (c *ElasticTranscoder) ListPipelines(input *ListPipelinesInput) (output *Li
 	return
 }
 
+// ListPipelinesPages iterates over the pages of a ListPipelines operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *ElasticTranscoder) ListPipelinesPages(input *ListPipelinesInput, fn func(p *ListPipelinesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListPipelinesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListPipelinesOutput), lastPage)
+	})
+}
+
 var opListPipelines *aws.Operation
 
 // ListPresetsRequest generates a request for the ListPresets operation.
@@ -285,6 +328,10 @@ func (c *ElasticTranscoder) ListPresetsRequest(input *ListPresetsInput) (req *aw
 			Name:       "ListPresets",
 			HTTPMethod: "GET",
 			HTTPPath:   "/2012-09-25/presets",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"PageToken"},
+				OutputTokens: []string{"NextPageToken"},
+			},
 		}
 	}
 
@@ -303,6 +350,16 @@ func (c *ElasticTranscoder) ListPresets(input *ListPresetsInput) (output *ListPr
 	return
 }
 
+// ListPresetsPages iterates over the pages of a ListPresets operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *ElasticTranscoder) ListPresetsPages(input *ListPresetsInput, fn func(p *ListPresetsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListPresetsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListPresetsOutput), lastPage)
+	})
+}
+
 var opListPresets *aws.Operation
 
 // ReadJobRequest generates a request for the ReadJob operation.
@@ -518,7 +575,7 @@ var opUpdatePipelineStatus *aws.Operation
 // "Append", or "Fallback", and use an empty Artwork array.
 type Artwork struct {
 	// The format of album art, if any. Valid formats are .jpg and .png.
-	AlbumArtFormat *string `type:"string"`
+	AlbumArtFormat *string `type:"string" pattern:"(^jpg$)|(^png$)"`
 
 	// The encryption settings, if any, that you want Elastic Transcoder to apply
 	// to your artwork.
@@ -532,23 +589,23 @@ type Artwork struct {
 	// If the file name includes a prefix, for example, cooking/pie.jpg, include
 	// the prefix in the key. If the file isn't in the specified bucket, Elastic
 	// Transcoder returns an error.
-	InputKey *string `type:"string"`
+	InputKey *string `type:"string" min:"1" max:"255" pattern:"(^.{1,}.jpg$)|(^.{1,}.jpeg$)|(^.{1,}.png$)"`
 
 	// The maximum height of the output album art in pixels. If you specify auto,
 	// Elastic Transcoder uses 600 as the default value. If you specify a numeric
 	// value, enter an even integer between 32 and 3072, inclusive.
-	MaxHeight *string `type:"string"`
+	MaxHeight *string `type:"string" pattern:"(^auto$)|(^\\d{2,4}$)"`
 
 	// The maximum width of the output album art in pixels. If you specify auto,
 	// Elastic Transcoder uses 600 as the default value. If you specify a numeric
 	// value, enter an even integer between 32 and 4096, inclusive.
-	MaxWidth *string `type:"string"`
+	MaxWidth *string `type:"string" pattern:"(^auto$)|(^\\d{2,4}$)"`
 
 	// When you set PaddingPolicy to Pad, Elastic Transcoder may add white bars
 	// to the top and bottom and/or left and right sides of the output album art
 	// to make the total size of the output art match the values that you specified
 	// for MaxWidth and MaxHeight.
-	PaddingPolicy *string `type:"string"`
+	PaddingPolicy *string `type:"string" pattern:"(^Pad$)|(^NoPad$)"`
 
 	// Specify one of the following values to control scaling of the output album
 	// art:
@@ -572,7 +629,7 @@ type Artwork struct {
 	// that its dimensions match the values that you specified for at least one
 	// of MaxWidth and MaxHeight without dropping below either value. If you specify
 	// this option, Elastic Transcoder does not scale the art up.
-	SizingPolicy *string `type:"string"`
+	SizingPolicy *string `type:"string" pattern:"(^Fit$)|(^Fill$)|(^Stretch$)|(^Keep$)|(^ShrinkToFit$)|(^ShrinkToFill$)"`
 
 	metadataArtwork `json:"-", xml:"-"`
 }
@@ -581,6 +638,11 @@ type metadataArtwork struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Artwork.
+func (s Artwork) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Options associated with your audio codec.
 type AudioCodecOptions struct {
 	// You can only choose an audio profile when you specify AAC for the value of
@@ -600,7 +662,7 @@ type AudioCodecOptions struct {
 	// If you created any presets before AAC profiles were added, Elastic Transcoder
 	// automatically updated your presets to use AAC-LC. You can change the value
 	// as required.
-	Profile *string `type:"string"`
+	Profile *string `type:"string" pattern:"(^auto$)|(^AAC-LC$)|(^HE-AAC$)|(^HE-AACv2$)"`
 
 	metadataAudioCodecOptions `json:"-", xml:"-"`
 }
@@ -609,11 +671,16 @@ type metadataAudioCodecOptions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AudioCodecOptions.
+func (s AudioCodecOptions) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Parameters required for transcoding audio.
 type AudioParameters struct {
 	// The bit rate of the audio stream in the output file, in kilobits/second.
 	// Enter an integer between 64 and 320, inclusive.
-	BitRate *string `type:"string"`
+	BitRate *string `type:"string" pattern:"^\\d{1,3}$"`
 
 	// The number of audio channels in the output file. Valid values include:
 	//
@@ -621,11 +688,11 @@ type AudioParameters struct {
 	//
 	// If you specify auto, Elastic Transcoder automatically detects the number
 	// of channels in the input file.
-	Channels *string `type:"string"`
+	Channels *string `type:"string" pattern:"(^auto$)|(^0$)|(^1$)|(^2$)"`
 
 	// The audio codec for the output file. Valid values include aac, mp2, mp3,
 	// and vorbis.
-	Codec *string `type:"string"`
+	Codec *string `type:"string" pattern:"(^AAC$)|(^vorbis$)|(^mp3$)|(^mp2$)"`
 
 	// If you specified AAC for Audio:Codec, this is the AAC compression profile
 	// to use. Valid values include:
@@ -643,7 +710,7 @@ type AudioParameters struct {
 	//
 	// If you specify auto, Elastic Transcoder automatically detects the sample
 	// rate.
-	SampleRate *string `type:"string"`
+	SampleRate *string `type:"string" pattern:"(^auto$)|(^22050$)|(^32000$)|(^44100$)|(^48000$)|(^96000$)"`
 
 	metadataAudioParameters `json:"-", xml:"-"`
 }
@@ -652,13 +719,18 @@ type metadataAudioParameters struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AudioParameters.
+func (s AudioParameters) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The CancelJobRequest structure.
 type CancelJobInput struct {
 	// The identifier of the job that you want to cancel.
 	//
 	// To get a list of the jobs (including their jobId) that have a status of
 	// Submitted, use the ListJobsByStatus API action.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$" required:"true"`
 
 	metadataCancelJobInput `json:"-", xml:"-"`
 }
@@ -667,6 +739,11 @@ type metadataCancelJobInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CancelJobInput.
+func (s CancelJobInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The response body contains a JSON object. If the job is successfully canceled,
 // the value of Success is true.
 type CancelJobOutput struct {
@@ -677,6 +754,11 @@ type metadataCancelJobOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CancelJobOutput.
+func (s CancelJobOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The file format of the output captions. If you leave this value blank, Elastic
 // Transcoder returns an error.
 type CaptionFormat struct {
@@ -708,7 +790,7 @@ type CaptionFormat struct {
 	// Non-FMP4 outputs: All sidecar types
 	//
 	//  fmp4 captions have an extension of .ismt
-	Format *string `type:"string"`
+	Format *string `type:"string" pattern:"(^mov-text$)|(^srt$)|(^scc$)|(^webvtt$)|(^dfxp$)"`
 
 	// The prefix for caption filenames, in the form description-{language}, where:
 	//
@@ -722,7 +804,7 @@ type CaptionFormat struct {
 	// For example, suppose you're transcoding into srt format. When you enter
 	// "Sydney-{language}-sunrise", and the language of the captions is English
 	// (en), the name of the first caption file will be Sydney-en-sunrise00000.srt.
-	Pattern *string `type:"string"`
+	Pattern *string `type:"string" pattern:"(^$)|(^.*\\{language\\}.*$)"`
 
 	metadataCaptionFormat `json:"-", xml:"-"`
 }
@@ -731,6 +813,11 @@ type metadataCaptionFormat struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CaptionFormat.
+func (s CaptionFormat) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A source file for the input sidecar captions used during the transcoding
 // process.
 type CaptionSource struct {
@@ -740,12 +827,12 @@ type CaptionSource struct {
 
 	// The name of the sidecar caption file that you want Elastic Transcoder to
 	// include in the output file.
-	Key *string `type:"string"`
+	Key *string `type:"string" min:"1" max:"255"`
 
 	// The label of the caption shown in the player when choosing a language. We
 	// recommend that you put the caption language name here, in the language of
 	// the captions.
-	Label *string `type:"string"`
+	Label *string `type:"string" min:"1" max:"40"`
 
 	// A string that specifies the language of the caption. Specify this as one
 	// of:
@@ -756,14 +843,14 @@ type CaptionSource struct {
 	//
 	//  For more information on ISO language codes and language names, see the
 	// List of ISO 639-1 codes.
-	Language *string `type:"string"`
+	Language *string `type:"string" min:"1" max:"255"`
 
 	// For clip generation or captions that do not start at the same time as the
 	// associated video file, the TimeOffset tells Elastic Transcoder how much of
 	// the video to encode before including captions.
 	//
 	// Specify the TimeOffset in the form [+-]SS.sss or [+-]HH:mm:SS.ss.
-	TimeOffset *string `type:"string"`
+	TimeOffset *string `type:"string" pattern:"(^[+-]?\\d{1,5}(\\.\\d{0,3})?$)|(^[+-]?([0-1]?[0-9]:|2[0-3]:)?([0-5]?[0-9]:)?[0-5]?[0-9](\\.\\d{0,3})?$)"`
 
 	metadataCaptionSource `json:"-", xml:"-"`
 }
@@ -772,15 +859,20 @@ type metadataCaptionSource struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CaptionSource.
+func (s CaptionSource) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The captions to be created, if any.
 type Captions struct {
 	// The array of file formats for the output captions. If you leave this value
 	// blank, Elastic Transcoder returns an error.
-	CaptionFormats []*CaptionFormat `type:"list"`
+	CaptionFormats []*CaptionFormat `type:"list" max:"4"`
 
 	// Source files for the input sidecar captions used during the transcoding process.
 	// To omit all sidecar captions, leave CaptionSources blank.
-	CaptionSources []*CaptionSource `type:"list"`
+	CaptionSources []*CaptionSource `type:"list" max:"20"`
 
 	// A policy that determines how Elastic Transcoder handles the existence of
 	// multiple captions.
@@ -800,7 +892,7 @@ type Captions struct {
 	// specify in CaptionSources.
 	//
 	//  MergePolicy cannot be null.
-	MergePolicy *string `type:"string"`
+	MergePolicy *string `type:"string" pattern:"(^MergeOverride$)|(^MergeRetain$)|(^Override$)"`
 
 	metadataCaptions `json:"-", xml:"-"`
 }
@@ -809,6 +901,11 @@ type metadataCaptions struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Captions.
+func (s Captions) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Settings for one clip in a composition. All jobs in a playlist must have
 // the same clip settings.
 type Clip struct {
@@ -822,6 +919,11 @@ type metadataClip struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Clip.
+func (s Clip) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The CreateJobRequest structure.
 type CreateJobInput struct {
 	// A section of the request body that provides information about the file that
@@ -834,25 +936,25 @@ type CreateJobInput struct {
 	// The value, if any, that you want Elastic Transcoder to prepend to the names
 	// of all files that this job creates, including output files, thumbnails, and
 	// playlists.
-	OutputKeyPrefix *string `type:"string"`
+	OutputKeyPrefix *string `type:"string" min:"1" max:"255"`
 
 	// A section of the request body that provides information about the transcoded
 	// (target) files. We recommend that you use the Outputs syntax instead of the
 	// Output syntax.
-	Outputs []*CreateJobOutput `type:"list"`
+	Outputs []*CreateJobOutput `type:"list" max:"30"`
 
 	// The Id of the pipeline that you want Elastic Transcoder to use for transcoding.
 	// The pipeline determines several settings, including the Amazon S3 bucket
 	// from which Elastic Transcoder gets the files to transcode and the bucket
 	// into which Elastic Transcoder puts the transcoded files.
-	PipelineID *string `locationName:"PipelineId" type:"string" required:"true"`
+	PipelineID *string `locationName:"PipelineId" type:"string" pattern:"^\\d{13}-\\w{6}$" required:"true"`
 
 	// If you specify a preset in PresetId for which the value of Container is fmp4
 	// (Fragmented MP4) or ts (MPEG-TS), Playlists contains information about the
 	// master playlists that you want Elastic Transcoder to create.
 	//
 	// The maximum number of master playlists in a job is 30.
-	Playlists []*CreateJobPlaylist `type:"list"`
+	Playlists []*CreateJobPlaylist `type:"list" max:"30"`
 
 	// User-defined metadata that you want to associate with an Elastic Transcoder
 	// job. You specify metadata in key/value pairs, and you can add up to 10 key/value
@@ -867,6 +969,11 @@ type metadataCreateJobInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateJobInput.
+func (s CreateJobInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The CreateJobResponse structure.
 type CreateJobOutput struct {
 	// A section of the response body that provides information about the job that
@@ -880,11 +987,16 @@ type metadataCreateJobOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateJobOutput.
+func (s CreateJobOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Information about the master playlist.
 type CreateJobPlaylist struct {
 	// The format of the output playlist. Valid formats include HLSv3, HLSv4, and
 	// Smooth.
-	Format *string `type:"string"`
+	Format *string `type:"string" pattern:"(^HLSv3$)|(^HLSv4$)|(^Smooth$)"`
 
 	// The HLS content protection settings, if any, that you want Elastic Transcoder
 	// to apply to the output files associated with this playlist.
@@ -900,7 +1012,7 @@ type CreateJobPlaylist struct {
 	// to the file name (.m3u8 for HLSv3 and HLSv4 playlists, and .ism and .ismc
 	// for Smooth playlists). If you include a file extension in Name, the file
 	// name will have two extensions.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"1" max:"255"`
 
 	// For each output in this job that you want to include in a master playlist,
 	// the value of the Outputs:Key object.
@@ -939,7 +1051,7 @@ type CreateJobPlaylist struct {
 	// in the playlist. For Smooth playlists, the Audio:Profile, Video:Profile,
 	// and Video:FrameRate to Video:KeyframesMaxDist ratio must be the same for
 	// all outputs.
-	OutputKeys []*string `type:"list"`
+	OutputKeys []*string `type:"list" max:"30"`
 
 	metadataCreateJobPlaylist `json:"-", xml:"-"`
 }
@@ -948,6 +1060,11 @@ type metadataCreateJobPlaylist struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateJobPlaylist.
+func (s CreateJobPlaylist) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The CreatePipelineRequest structure.
 type CreatePipelineInput struct {
 	// The AWS Key Management Service (AWS KMS) key that you want to use with this
@@ -958,7 +1075,7 @@ type CreatePipelineInput struct {
 	// key, is created for you automatically. You need to provide an AWS-KMS key
 	// only if you want to use a non-default AWS-KMS key, or if you are using an
 	// Encryption:Mode of AES-PKCS7, AES-CTR, or AES-GCM.
-	AWSKMSKeyARN *string `locationName:"AwsKmsKeyArn" type:"string"`
+	AWSKMSKeyARN *string `locationName:"AwsKmsKeyArn" type:"string" max:"255"`
 
 	// The optional ContentConfig object specifies information about the Amazon
 	// S3 bucket in which you want Elastic Transcoder to save transcoded files and
@@ -1009,13 +1126,13 @@ type CreatePipelineInput struct {
 
 	// The Amazon S3 bucket in which you saved the media files that you want to
 	// transcode.
-	InputBucket *string `type:"string" required:"true"`
+	InputBucket *string `type:"string" pattern:"^(\\w|\\.|-){1,255}$" required:"true"`
 
 	// The name of the pipeline. We recommend that the name be unique within the
 	// AWS account, but uniqueness is not enforced.
 	//
 	// Constraints: Maximum 40 characters.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"1" max:"40" required:"true"`
 
 	// The Amazon Simple Notification Service (Amazon SNS) topic that you want to
 	// notify to report job status.
@@ -1054,11 +1171,11 @@ type CreatePipelineInput struct {
 	// in another bucket, specify which users can access the transcoded files or
 	// the permissions the users have, or change the Amazon S3 storage class, omit
 	// OutputBucket and specify values for ContentConfig and ThumbnailConfig instead.
-	OutputBucket *string `type:"string"`
+	OutputBucket *string `type:"string" pattern:"^(\\w|\\.|-){1,255}$"`
 
 	// The IAM Amazon Resource Name (ARN) for the role that you want Elastic Transcoder
 	// to use to create the pipeline.
-	Role *string `type:"string" required:"true"`
+	Role *string `type:"string" pattern:"^arn:aws:iam::\\w{12}:role/.+$" required:"true"`
 
 	// The ThumbnailConfig object specifies several values, including the Amazon
 	// S3 bucket in which you want Elastic Transcoder to save thumbnail files, which
@@ -1108,6 +1225,11 @@ type metadataCreatePipelineInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreatePipelineInput.
+func (s CreatePipelineInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // When you create a pipeline, Elastic Transcoder returns the values that you
 // specified in the request.
 type CreatePipelineOutput struct {
@@ -1130,6 +1252,11 @@ type metadataCreatePipelineOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreatePipelineOutput.
+func (s CreatePipelineOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The CreatePresetRequest structure.
 type CreatePresetInput struct {
 	// A section of the request body that specifies the audio parameters.
@@ -1137,14 +1264,14 @@ type CreatePresetInput struct {
 
 	// The container type for the output file. Valid values include flv, fmp4, gif,
 	// mp3, mp4, mpg, ogg, ts, and webm.
-	Container *string `type:"string" required:"true"`
+	Container *string `type:"string" pattern:"(^mp4$)|(^ts$)|(^webm$)|(^mp3$)|(^ogg$)|(^fmp4$)|(^mpg$)|(^flv$)|(^gif$)" required:"true"`
 
 	// A description of the preset.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"255"`
 
 	// The name of the preset. We recommend that the name be unique within the AWS
 	// account, but uniqueness is not enforced.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"1" max:"40" required:"true"`
 
 	// A section of the request body that specifies the thumbnail parameters, if
 	// any.
@@ -1160,6 +1287,11 @@ type metadataCreatePresetInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreatePresetInput.
+func (s CreatePresetInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The CreatePresetResponse structure.
 type CreatePresetOutput struct {
 	// A section of the response body that provides information about the preset
@@ -1179,10 +1311,15 @@ type metadataCreatePresetOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreatePresetOutput.
+func (s CreatePresetOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The DeletePipelineRequest structure.
 type DeletePipelineInput struct {
 	// The identifier of the pipeline that you want to delete.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$" required:"true"`
 
 	metadataDeletePipelineInput `json:"-", xml:"-"`
 }
@@ -1191,6 +1328,11 @@ type metadataDeletePipelineInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeletePipelineInput.
+func (s DeletePipelineInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The DeletePipelineResponse structure.
 type DeletePipelineOutput struct {
 	metadataDeletePipelineOutput `json:"-", xml:"-"`
@@ -1200,10 +1342,15 @@ type metadataDeletePipelineOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeletePipelineOutput.
+func (s DeletePipelineOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The DeletePresetRequest structure.
 type DeletePresetInput struct {
 	// The identifier of the preset for which you want to get detailed information.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$" required:"true"`
 
 	metadataDeletePresetInput `json:"-", xml:"-"`
 }
@@ -1212,6 +1359,11 @@ type metadataDeletePresetInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeletePresetInput.
+func (s DeletePresetInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The DeletePresetResponse structure.
 type DeletePresetOutput struct {
 	metadataDeletePresetOutput `json:"-", xml:"-"`
@@ -1221,6 +1373,11 @@ type metadataDeletePresetOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeletePresetOutput.
+func (s DeletePresetOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The encryption settings, if any, that are used for decrypting your input
 // files or encrypting your output files. If your input file is encrypted, you
 // must specify the mode that Elastic Transcoder will use to decrypt your file,
@@ -1232,7 +1389,7 @@ type Encryption struct {
 	// want Elastic Transcoder to use to encrypt your output files. The initialization
 	// vector must be base64-encoded, and it must be exactly 16 bytes long before
 	// being base64-encoded.
-	InitializationVector *string `type:"string"`
+	InitializationVector *string `type:"string" max:"255"`
 
 	// The data encryption key that you want Elastic Transcoder to use to encrypt
 	// your output file, or that was used to encrypt your input file. The key must
@@ -1242,14 +1399,14 @@ type Encryption struct {
 	// 128, 192, or 256.
 	//
 	// The key must also be encrypted by using the Amazon Key Management Service.
-	Key *string `type:"string"`
+	Key *string `type:"string" pattern:"^$|(^(?:[A-Za-z0-9\\+/]{4})*(?:[A-Za-z0-9\\+/]{2}==|[A-Za-z0-9\\+/]{3}=)?$)"`
 
 	// The MD5 digest of the key that you used to encrypt your input file, or that
 	// you want Elastic Transcoder to use to encrypt your output file. Elastic Transcoder
 	// uses the key digest as a checksum to make sure your key was not corrupted
 	// in transit. The key MD5 must be base64-encoded, and it must be exactly 16
 	// bytes long before being base64-encoded.
-	KeyMD5 *string `locationName:"KeyMd5" type:"string"`
+	KeyMD5 *string `locationName:"KeyMd5" type:"string" pattern:"^$|(^(?:[A-Za-z0-9\\+/]{4})*(?:[A-Za-z0-9\\+/]{2}==|[A-Za-z0-9\\+/]{3}=)?$)"`
 
 	// The specific server-side encryption mode that you want Elastic Transcoder
 	// to use when decrypting your input files or encrypting your output files.
@@ -1284,7 +1441,7 @@ type Encryption struct {
 	// are never stored by AWS; therefore, it is important that you safely manage
 	// your encryption keys. If you lose them, you won't be able to unencrypt your
 	// data.
-	Mode *string `type:"string"`
+	Mode *string `type:"string" pattern:"(^s3$)|(^s3-aws-kms$)|(^aes-cbc-pkcs7$)|(^aes-ctr$)|(^aes-gcm$)"`
 
 	metadataEncryption `json:"-", xml:"-"`
 }
@@ -1293,6 +1450,11 @@ type metadataEncryption struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Encryption.
+func (s Encryption) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The HLS content protection settings, if any, that you want Elastic Transcoder
 // to apply to your output files.
 type HLSContentProtection struct {
@@ -1303,7 +1465,7 @@ type HLSContentProtection struct {
 	// every encryption operation, that you want Elastic Transcoder to use to encrypt
 	// your output files. The initialization vector must be base64-encoded, and
 	// it must be exactly 16 bytes before being base64-encoded.
-	InitializationVector *string `type:"string"`
+	InitializationVector *string `type:"string" max:"255"`
 
 	// If you want Elastic Transcoder to generate a key for you, leave this field
 	// blank.
@@ -1313,7 +1475,7 @@ type HLSContentProtection struct {
 	// bit lengths before being base64-encoded:
 	//
 	// 128, 192, or 256.
-	Key *string `type:"string"`
+	Key *string `type:"string" pattern:"^$|(^(?:[A-Za-z0-9\\+/]{4})*(?:[A-Za-z0-9\\+/]{2}==|[A-Za-z0-9\\+/]{3}=)?$)"`
 
 	// If Elastic Transcoder is generating your key for you, you must leave this
 	// field blank.
@@ -1322,24 +1484,24 @@ type HLSContentProtection struct {
 	// your output file, and that you want Elastic Transcoder to use as a checksum
 	// to make sure your key was not corrupted in transit. The key MD5 must be base64-encoded,
 	// and it must be exactly 16 bytes before being base64- encoded.
-	KeyMD5 *string `locationName:"KeyMd5" type:"string"`
+	KeyMD5 *string `locationName:"KeyMd5" type:"string" pattern:"^$|(^(?:[A-Za-z0-9\\+/]{4})*(?:[A-Za-z0-9\\+/]{2}==|[A-Za-z0-9\\+/]{3}=)?$)"`
 
 	// Specify whether you want Elastic Transcoder to write your HLS license key
 	// to an Amazon S3 bucket. If you choose WithVariantPlaylists, LicenseAcquisitionUrl
 	// must be left blank and Elastic Transcoder writes your data key into the same
 	// bucket as the associated playlist.
-	KeyStoragePolicy *string `type:"string"`
+	KeyStoragePolicy *string `type:"string" pattern:"(^NoStore$)|(^WithVariantPlaylists$)"`
 
 	// The location of the license key required to decrypt your HLS playlist. The
 	// URL must be an absolute path, and is referenced in the URI attribute of the
 	// EXT-X-KEY metadata tag in the playlist file.
-	LicenseAcquisitionURL *string `locationName:"LicenseAcquisitionUrl" type:"string"`
+	LicenseAcquisitionURL *string `locationName:"LicenseAcquisitionUrl" type:"string" max:"512"`
 
 	// The content protection method for your output. The only valid value is: aes-128.
 	//
 	// This value will be written into the method attribute of the EXT-X-KEY metadata
 	// tag in the output playlist.
-	Method *string `type:"string"`
+	Method *string `type:"string" pattern:"(^aes-128$)"`
 
 	metadataHLSContentProtection `json:"-", xml:"-"`
 }
@@ -1348,6 +1510,11 @@ type metadataHLSContentProtection struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the HLSContentProtection.
+func (s HLSContentProtection) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A section of the response body that provides information about the job that
 // is created.
 type Job struct {
@@ -1356,7 +1523,7 @@ type Job struct {
 
 	// The identifier that Elastic Transcoder assigned to the job. You use this
 	// value to get settings for the job or to delete the job.
-	ID *string `locationName:"Id" type:"string"`
+	ID *string `locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	// A section of the request or response body that provides information about
 	// the file that is being transcoded.
@@ -1375,7 +1542,7 @@ type Job struct {
 	// of all files that this job creates, including output files, thumbnails, and
 	// playlists. We recommend that you add a / or some other delimiter to the end
 	// of the OutputKeyPrefix.
-	OutputKeyPrefix *string `type:"string"`
+	OutputKeyPrefix *string `type:"string" min:"1" max:"255"`
 
 	// Information about the output files. We recommend that you use the Outputs
 	// syntax for all jobs, even when you want Elastic Transcoder to transcode a
@@ -1390,7 +1557,7 @@ type Job struct {
 	// The pipeline determines several settings, including the Amazon S3 bucket
 	// from which Elastic Transcoder gets the files to transcode and the bucket
 	// into which Elastic Transcoder puts the transcoded files.
-	PipelineID *string `locationName:"PipelineId" type:"string"`
+	PipelineID *string `locationName:"PipelineId" type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	// Outputs in Fragmented MP4 or MPEG-TS format only.If you specify a preset
 	// in PresetId for which the value of Container is fmp4 (Fragmented MP4) or
@@ -1401,7 +1568,7 @@ type Job struct {
 	Playlists []*Playlist `type:"list"`
 
 	// The status of the job: Submitted, Progressing, Complete, Canceled, or Error.
-	Status *string `type:"string"`
+	Status *string `type:"string" pattern:"(^Submitted$)|(^Progressing$)|(^Complete$)|(^Canceled$)|(^Error$)"`
 
 	// User-defined metadata that you want to associate with an Elastic Transcoder
 	// job. You specify metadata in key/value pairs, and you can add up to 10 key/value
@@ -1426,6 +1593,11 @@ type metadataJob struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Job.
+func (s Job) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The .jpg or .png file associated with an audio file.
 type JobAlbumArt struct {
 	// The file to be used as album art. There can be multiple artworks associated
@@ -1442,7 +1614,7 @@ type JobAlbumArt struct {
 	// Transcoder will use that artwork for the output. If the original input does
 	// not contain artwork, Elastic Transcoder will use the specified album art
 	// file.
-	MergePolicy *string `type:"string"`
+	MergePolicy *string `type:"string" pattern:"(^Replace$)|(^Prepend$)|(^Append$)|(^Fallback$)"`
 
 	metadataJobAlbumArt `json:"-", xml:"-"`
 }
@@ -1451,6 +1623,11 @@ type metadataJobAlbumArt struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the JobAlbumArt.
+func (s JobAlbumArt) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Information about the file that you're transcoding.
 type JobInput struct {
 	// The aspect ratio of the input file. If you want Elastic Transcoder to automatically
@@ -1461,7 +1638,7 @@ type JobInput struct {
 	//
 	//  If you specify a value other than auto, Elastic Transcoder disables automatic
 	// detection of the aspect ratio.
-	AspectRatio *string `type:"string"`
+	AspectRatio *string `type:"string" pattern:"(^auto$)|(^1:1$)|(^4:3$)|(^3:2$)|(^16:9$)"`
 
 	// The container type for the input file. If you want Elastic Transcoder to
 	// automatically detect the container type of the input file, specify auto.
@@ -1470,7 +1647,7 @@ type JobInput struct {
 	//
 	//  3gp, aac, asf, avi, divx, flv, m4a, mkv, mov, mp3, mp4, mpeg, mpeg-ps,
 	// mpeg-ts, mxf, ogg, vob, wav, webm
-	Container *string `type:"string"`
+	Container *string `type:"string" pattern:"(^auto$)|(^3gp$)|(^asf$)|(^avi$)|(^divx$)|(^flv$)|(^mkv$)|(^mov$)|(^mp4$)|(^mpeg$)|(^mpeg-ps$)|(^mpeg-ts$)|(^mxf$)|(^ogg$)|(^ts$)|(^vob$)|(^wav$)|(^webm$)|(^mp3$)|(^m4a$)|(^aac$)"`
 
 	// The encryption settings, if any, that are used for decrypting your input
 	// files. If your input file is encrypted, you must specify the mode that Elastic
@@ -1485,7 +1662,7 @@ type JobInput struct {
 	//
 	// If you specify a value other than auto, Elastic Transcoder disables automatic
 	// detection of the frame rate.
-	FrameRate *string `type:"string"`
+	FrameRate *string `type:"string" pattern:"(^auto$)|(^10$)|(^15$)|(^23.97$)|(^24$)|(^25$)|(^29.97$)|(^30$)|(^50$)|(^60$)"`
 
 	// Whether the input file is interlaced. If you want Elastic Transcoder to automatically
 	// detect whether the input file is interlaced, specify auto. If you want to
@@ -1496,7 +1673,7 @@ type JobInput struct {
 	//
 	// If you specify a value other than auto, Elastic Transcoder disables automatic
 	// detection of interlacing.
-	Interlaced *string `type:"string"`
+	Interlaced *string `type:"string" pattern:"(^auto$)|(^true$)|(^false$)"`
 
 	// The name of the file to transcode. Elsewhere in the body of the JSON block
 	// is the the ID of the pipeline to use for processing the job. The InputBucket
@@ -1506,11 +1683,11 @@ type JobInput struct {
 	// If the file name includes a prefix, such as cooking/lasagna.mpg, include
 	// the prefix in the key. If the file isn't in the specified bucket, Elastic
 	// Transcoder returns an error.
-	Key *string `type:"string"`
+	Key *string `type:"string" min:"1" max:"255"`
 
 	// This value must be auto, which causes Elastic Transcoder to automatically
 	// detect the resolution of the input file.
-	Resolution *string `type:"string"`
+	Resolution *string `type:"string" pattern:"(^auto$)|(^\\d{1,5}x\\d{1,5}$)"`
 
 	metadataJobInput `json:"-", xml:"-"`
 }
@@ -1519,6 +1696,11 @@ type metadataJobInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the JobInput.
+func (s JobInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Outputs recommended instead.If you specified one output for a job, information
 // about that output. If you specified multiple outputs for a job, the Output
 // object lists information about the first output. This duplicates the information
@@ -1604,7 +1786,7 @@ type JobOutput struct {
 	// The name to assign to the transcoded file. Elastic Transcoder saves the file
 	// in the Amazon S3 bucket specified by the OutputBucket object in the pipeline
 	// that is specified by the pipeline ID.
-	Key *string `type:"string"`
+	Key *string `type:"string" min:"1" max:"255"`
 
 	// The value of the Id object for the preset that you want to use for this job.
 	// The preset determines the audio, video, and thumbnail settings that Elastic
@@ -1612,7 +1794,7 @@ type JobOutput struct {
 	// the preset ID that Elastic Transcoder returned in the response when you created
 	// the preset. You can also use the Elastic Transcoder system presets, which
 	// you can get with ListPresets.
-	PresetID *string `locationName:"PresetId" type:"string"`
+	PresetID *string `locationName:"PresetId" type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	// The number of degrees clockwise by which you want Elastic Transcoder to rotate
 	// the output relative to the input. Enter one of the following values:
@@ -1621,7 +1803,7 @@ type JobOutput struct {
 	//
 	//  The value auto generally works only if the file that you're transcoding
 	// contains rotation metadata.
-	Rotate *string `type:"string"`
+	Rotate *string `type:"string" pattern:"(^auto$)|(^0$)|(^90$)|(^180$)|(^270$)"`
 
 	// (Outputs in Fragmented MP4 or MPEG-TS format only.If you specify a preset
 	// in PresetId for which the value of Container is fmp4 (Fragmented MP4) or
@@ -1639,7 +1821,7 @@ type JobOutput struct {
 	// Elastic Transcoder creates an output-specific playlist for each output HLS
 	// output that you specify in OutputKeys. To add an output to the master playlist
 	// for this job, include it in the OutputKeys of the associated playlist.
-	SegmentDuration *string `type:"string"`
+	SegmentDuration *string `type:"string" pattern:"^\\d{1,5}(\\.\\d{0,5})?$"`
 
 	// The status of one output in a job. If you specified only one output for the
 	// job, Outputs:Status is always the same as Job:Status. If you specified more
@@ -1655,10 +1837,10 @@ type JobOutput struct {
 	// is Error, the terminal status for Job:Status is also Error.  The value of
 	// Status is one of the following: Submitted, Progressing, Complete, Canceled,
 	// or Error.
-	Status *string `type:"string"`
+	Status *string `type:"string" pattern:"(^Submitted$)|(^Progressing$)|(^Complete$)|(^Canceled$)|(^Error$)"`
 
 	// Information that further explains Status.
-	StatusDetail *string `type:"string"`
+	StatusDetail *string `type:"string" max:"255"`
 
 	// The encryption settings, if any, that you want Elastic Transcoder to apply
 	// to your thumbnail.
@@ -1693,7 +1875,7 @@ type JobOutput struct {
 	// in the format (.jpg or .png) that appears in the preset that you specified
 	// in the PresetID value of CreateJobOutput. Elastic Transcoder also appends
 	// the applicable file name extension.
-	ThumbnailPattern *string `type:"string"`
+	ThumbnailPattern *string `type:"string" pattern:"(^$)|(^.*\\{count\\}.*$)"`
 
 	// Information about the watermarks that you want Elastic Transcoder to add
 	// to the video during transcoding. You can specify up to four watermarks for
@@ -1719,6 +1901,11 @@ type metadataJobOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the JobOutput.
+func (s JobOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Watermarks can be in .png or .jpg format. If you want to display a watermark
 // that is not rectangular, use the .png format, which supports transparency.
 type JobWatermark struct {
@@ -1734,13 +1921,13 @@ type JobWatermark struct {
 	//  If the file name includes a prefix, for example, logos/128x64.png, include
 	// the prefix in the key. If the file isn't in the specified bucket, Elastic
 	// Transcoder returns an error.
-	InputKey *string `type:"string"`
+	InputKey *string `type:"string" min:"1" max:"255" pattern:"(^.{1,}.jpg$)|(^.{1,}.jpeg$)|(^.{1,}.png$)"`
 
 	// The ID of the watermark settings that Elastic Transcoder uses to add watermarks
 	// to the video during transcoding. The settings are in the preset specified
 	// by Preset for the current output. In that preset, the value of Watermarks
 	// Id tells Elastic Transcoder which settings to use.
-	PresetWatermarkID *string `locationName:"PresetWatermarkId" type:"string"`
+	PresetWatermarkID *string `locationName:"PresetWatermarkId" type:"string" min:"1" max:"40"`
 
 	metadataJobWatermark `json:"-", xml:"-"`
 }
@@ -1749,18 +1936,23 @@ type metadataJobWatermark struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the JobWatermark.
+func (s JobWatermark) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The ListJobsByPipelineRequest structure.
 type ListJobsByPipelineInput struct {
 	// To list jobs in chronological order by the date and time that they were submitted,
 	// enter true. To list jobs in reverse chronological order, enter false.
-	Ascending *string `location:"querystring" locationName:"Ascending" type:"string"`
+	Ascending *string `location:"querystring" locationName:"Ascending" type:"string" pattern:"(^true$)|(^false$)"`
 
 	// When Elastic Transcoder returns more than one page of results, use pageToken
 	// in subsequent GET requests to get each successive page of results.
-	PageToken *string `location:"querystring" locationName:"PageToken" type:"string"`
+	PageToken *string `location:"querystring" locationName:"PageToken" type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	// The ID of the pipeline for which you want to get job information.
-	PipelineID *string `location:"uri" locationName:"PipelineId" type:"string" required:"true"`
+	PipelineID *string `location:"uri" locationName:"PipelineId" type:"string" pattern:"^\\d{13}-\\w{6}$" required:"true"`
 
 	metadataListJobsByPipelineInput `json:"-", xml:"-"`
 }
@@ -1769,6 +1961,11 @@ type metadataListJobsByPipelineInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListJobsByPipelineInput.
+func (s ListJobsByPipelineInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The ListJobsByPipelineResponse structure.
 type ListJobsByPipelineOutput struct {
 	// An array of Job objects that are in the specified pipeline.
@@ -1777,7 +1974,7 @@ type ListJobsByPipelineOutput struct {
 	// A value that you use to access the second and subsequent pages of results,
 	// if any. When the jobs in the specified pipeline fit on one page or when you've
 	// reached the last page of results, the value of NextPageToken is null.
-	NextPageToken *string `type:"string"`
+	NextPageToken *string `type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	metadataListJobsByPipelineOutput `json:"-", xml:"-"`
 }
@@ -1786,20 +1983,25 @@ type metadataListJobsByPipelineOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListJobsByPipelineOutput.
+func (s ListJobsByPipelineOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The ListJobsByStatusRequest structure.
 type ListJobsByStatusInput struct {
 	// To list jobs in chronological order by the date and time that they were submitted,
 	// enter true. To list jobs in reverse chronological order, enter false.
-	Ascending *string `location:"querystring" locationName:"Ascending" type:"string"`
+	Ascending *string `location:"querystring" locationName:"Ascending" type:"string" pattern:"(^true$)|(^false$)"`
 
 	// When Elastic Transcoder returns more than one page of results, use pageToken
 	// in subsequent GET requests to get each successive page of results.
-	PageToken *string `location:"querystring" locationName:"PageToken" type:"string"`
+	PageToken *string `location:"querystring" locationName:"PageToken" type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	// To get information about all of the jobs associated with the current AWS
 	// account that have a given status, specify the following status: Submitted,
 	// Progressing, Complete, Canceled, or Error.
-	Status *string `location:"uri" locationName:"Status" type:"string" required:"true"`
+	Status *string `location:"uri" locationName:"Status" type:"string" pattern:"(^Submitted$)|(^Progressing$)|(^Complete$)|(^Canceled$)|(^Error$)" required:"true"`
 
 	metadataListJobsByStatusInput `json:"-", xml:"-"`
 }
@@ -1808,6 +2010,11 @@ type metadataListJobsByStatusInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListJobsByStatusInput.
+func (s ListJobsByStatusInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The ListJobsByStatusResponse structure.
 type ListJobsByStatusOutput struct {
 	// An array of Job objects that have the specified status.
@@ -1816,7 +2023,7 @@ type ListJobsByStatusOutput struct {
 	// A value that you use to access the second and subsequent pages of results,
 	// if any. When the jobs in the specified pipeline fit on one page or when you've
 	// reached the last page of results, the value of NextPageToken is null.
-	NextPageToken *string `type:"string"`
+	NextPageToken *string `type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	metadataListJobsByStatusOutput `json:"-", xml:"-"`
 }
@@ -1825,16 +2032,21 @@ type metadataListJobsByStatusOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListJobsByStatusOutput.
+func (s ListJobsByStatusOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The ListPipelineRequest structure.
 type ListPipelinesInput struct {
 	// To list pipelines in chronological order by the date and time that they were
 	// created, enter true. To list pipelines in reverse chronological order, enter
 	// false.
-	Ascending *string `location:"querystring" locationName:"Ascending" type:"string"`
+	Ascending *string `location:"querystring" locationName:"Ascending" type:"string" pattern:"(^true$)|(^false$)"`
 
 	// When Elastic Transcoder returns more than one page of results, use pageToken
 	// in subsequent GET requests to get each successive page of results.
-	PageToken *string `location:"querystring" locationName:"PageToken" type:"string"`
+	PageToken *string `location:"querystring" locationName:"PageToken" type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	metadataListPipelinesInput `json:"-", xml:"-"`
 }
@@ -1843,12 +2055,17 @@ type metadataListPipelinesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListPipelinesInput.
+func (s ListPipelinesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A list of the pipelines associated with the current AWS account.
 type ListPipelinesOutput struct {
 	// A value that you use to access the second and subsequent pages of results,
 	// if any. When the pipelines fit on one page or when you've reached the last
 	// page of results, the value of NextPageToken is null.
-	NextPageToken *string `type:"string"`
+	NextPageToken *string `type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	// An array of Pipeline objects.
 	Pipelines []*Pipeline `type:"list"`
@@ -1860,16 +2077,21 @@ type metadataListPipelinesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListPipelinesOutput.
+func (s ListPipelinesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The ListPresetsRequest structure.
 type ListPresetsInput struct {
 	// To list presets in chronological order by the date and time that they were
 	// created, enter true. To list presets in reverse chronological order, enter
 	// false.
-	Ascending *string `location:"querystring" locationName:"Ascending" type:"string"`
+	Ascending *string `location:"querystring" locationName:"Ascending" type:"string" pattern:"(^true$)|(^false$)"`
 
 	// When Elastic Transcoder returns more than one page of results, use pageToken
 	// in subsequent GET requests to get each successive page of results.
-	PageToken *string `location:"querystring" locationName:"PageToken" type:"string"`
+	PageToken *string `location:"querystring" locationName:"PageToken" type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	metadataListPresetsInput `json:"-", xml:"-"`
 }
@@ -1878,12 +2100,17 @@ type metadataListPresetsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListPresetsInput.
+func (s ListPresetsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The ListPresetsResponse structure.
 type ListPresetsOutput struct {
 	// A value that you use to access the second and subsequent pages of results,
 	// if any. When the presets fit on one page or when you've reached the last
 	// page of results, the value of NextPageToken is null.
-	NextPageToken *string `type:"string"`
+	NextPageToken *string `type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	// An array of Preset objects.
 	Presets []*Preset `type:"list"`
@@ -1895,6 +2122,11 @@ type metadataListPresetsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListPresetsOutput.
+func (s ListPresetsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The Amazon Simple Notification Service (Amazon SNS) topic or topics to notify
 // in order to report job status.
 //
@@ -1903,19 +2135,19 @@ type metadataListPresetsOutput struct {
 type Notifications struct {
 	// The Amazon SNS topic that you want to notify when Elastic Transcoder has
 	// finished processing the job.
-	Completed *string `type:"string"`
+	Completed *string `type:"string" pattern:"(^$)|(^arn:aws:sns:.*:\\w{12}:.+$)"`
 
 	// The Amazon SNS topic that you want to notify when Elastic Transcoder encounters
 	// an error condition.
-	Error *string `type:"string"`
+	Error *string `type:"string" pattern:"(^$)|(^arn:aws:sns:.*:\\w{12}:.+$)"`
 
 	// The Amazon Simple Notification Service (Amazon SNS) topic that you want to
 	// notify when Elastic Transcoder has started to process the job.
-	Progressing *string `type:"string"`
+	Progressing *string `type:"string" pattern:"(^$)|(^arn:aws:sns:.*:\\w{12}:.+$)"`
 
 	// The Amazon SNS topic that you want to notify when Elastic Transcoder encounters
 	// a warning condition.
-	Warning *string `type:"string"`
+	Warning *string `type:"string" pattern:"(^$)|(^arn:aws:sns:.*:\\w{12}:.+$)"`
 
 	metadataNotifications `json:"-", xml:"-"`
 }
@@ -1924,6 +2156,11 @@ type metadataNotifications struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Notifications.
+func (s Notifications) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The Permission structure.
 type Permission struct {
 	// The permission that you want to give to the AWS user that is listed in Grantee.
@@ -1934,14 +2171,14 @@ type Permission struct {
 	// the thumbnails that Elastic Transcoder adds to the Amazon S3 bucket.  FULL_CONTROL:
 	// The grantee has READ, READ_ACP, and WRITE_ACP permissions for the thumbnails
 	// that Elastic Transcoder adds to the Amazon S3 bucket.
-	Access []*string `type:"list"`
+	Access []*string `type:"list" max:"30"`
 
 	// The AWS user or group that you want to have access to transcoded files and
 	// playlists. To identify the user or group, you can specify the canonical user
 	// ID for an AWS account, an origin access identity for a CloudFront distribution,
 	// the registered email address of an AWS account, or a predefined Amazon S3
 	// group.
-	Grantee *string `type:"string"`
+	Grantee *string `type:"string" min:"1" max:"255"`
 
 	// The type of value that appears in the Grantee object:   Canonical: Either
 	// the canonical user ID for an AWS account or an origin access identity for
@@ -1949,7 +2186,7 @@ type Permission struct {
 	// an AWS account number.   Email: The registered email address of an AWS account.
 	//  Group: One of the following predefined Amazon S3 groups: AllUsers, AuthenticatedUsers,
 	// or LogDelivery.
-	GranteeType *string `type:"string"`
+	GranteeType *string `type:"string" pattern:"(^Canonical$)|(^Email$)|(^Group$)"`
 
 	metadataPermission `json:"-", xml:"-"`
 }
@@ -1958,6 +2195,11 @@ type metadataPermission struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Permission.
+func (s Permission) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The pipeline (queue) that is used to manage jobs.
 type Pipeline struct {
 	// The Amazon Resource Name (ARN) for the pipeline.
@@ -1971,7 +2213,7 @@ type Pipeline struct {
 	// key, is created for you automatically. You need to provide an AWS-KMS key
 	// only if you want to use a non-default AWS-KMS key, or if you are using an
 	// Encryption:Mode of AES-PKCS7, AES-CTR, or AES-GCM.
-	AWSKMSKeyARN *string `locationName:"AwsKmsKeyArn" type:"string"`
+	AWSKMSKeyARN *string `locationName:"AwsKmsKeyArn" type:"string" max:"255"`
 
 	// Information about the Amazon S3 bucket in which you want Elastic Transcoder
 	// to save transcoded files and playlists. Either you specify both ContentConfig
@@ -2003,17 +2245,17 @@ type Pipeline struct {
 	// The identifier for the pipeline. You use this value to identify the pipeline
 	// in which you want to perform a variety of operations, such as creating a
 	// job or a preset.
-	ID *string `locationName:"Id" type:"string"`
+	ID *string `locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	// The Amazon S3 bucket from which Elastic Transcoder gets media files for transcoding
 	// and the graphics files, if any, that you want to use for watermarks.
-	InputBucket *string `type:"string"`
+	InputBucket *string `type:"string" pattern:"^(\\w|\\.|-){1,255}$"`
 
 	// The name of the pipeline. We recommend that the name be unique within the
 	// AWS account, but uniqueness is not enforced.
 	//
 	// Constraints: Maximum 40 characters
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"1" max:"40"`
 
 	// The Amazon Simple Notification Service (Amazon SNS) topic that you want to
 	// notify to report job status.
@@ -2032,17 +2274,17 @@ type Pipeline struct {
 	// The Amazon S3 bucket in which you want Elastic Transcoder to save transcoded
 	// files, thumbnails, and playlists. Either you specify this value, or you specify
 	// both ContentConfig and ThumbnailConfig.
-	OutputBucket *string `type:"string"`
+	OutputBucket *string `type:"string" pattern:"^(\\w|\\.|-){1,255}$"`
 
 	// The IAM Amazon Resource Name (ARN) for the role that Elastic Transcoder uses
 	// to transcode jobs for this pipeline.
-	Role *string `type:"string"`
+	Role *string `type:"string" pattern:"^arn:aws:iam::\\w{12}:role/.+$"`
 
 	// The current status of the pipeline:
 	//
 	//   Active: The pipeline is processing jobs.  Paused: The pipeline is not
 	// currently processing jobs.
-	Status *string `type:"string"`
+	Status *string `type:"string" pattern:"(^Active$)|(^Paused$)"`
 
 	// Information about the Amazon S3 bucket in which you want Elastic Transcoder
 	// to save thumbnail files. Either you specify both ContentConfig and ThumbnailConfig,
@@ -2078,6 +2320,11 @@ type metadataPipeline struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Pipeline.
+func (s Pipeline) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The PipelineOutputConfig structure.
 type PipelineOutputConfig struct {
 	// The Amazon S3 bucket in which you want Elastic Transcoder to save the transcoded
@@ -2092,7 +2339,7 @@ type PipelineOutputConfig struct {
 	// transcoded files or the permissions the users have, or change the Amazon
 	// S3 storage class, omit OutputBucket and specify values for ContentConfig
 	// and ThumbnailConfig instead.
-	Bucket *string `type:"string"`
+	Bucket *string `type:"string" pattern:"^(\\w|\\.|-){1,255}$"`
 
 	// Optional. The Permissions object specifies which users and/or predefined
 	// Amazon S3 groups you want to have access to transcoded files and playlists,
@@ -2107,12 +2354,12 @@ type PipelineOutputConfig struct {
 	//  If you omit Permissions, Elastic Transcoder grants full control over the
 	// transcoded files and playlists to the owner of the role specified by Role,
 	// and grants no other permissions to any other user or group.
-	Permissions []*Permission `type:"list"`
+	Permissions []*Permission `type:"list" max:"30"`
 
 	// The Amazon S3 storage class, Standard or ReducedRedundancy, that you want
 	// Elastic Transcoder to assign to the video files and playlists that it stores
 	// in your Amazon S3 bucket.
-	StorageClass *string `type:"string"`
+	StorageClass *string `type:"string" pattern:"(^ReducedRedundancy$)|(^Standard$)"`
 
 	metadataPipelineOutputConfig `json:"-", xml:"-"`
 }
@@ -2121,6 +2368,11 @@ type metadataPipelineOutputConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PipelineOutputConfig.
+func (s PipelineOutputConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Use Only for Fragmented MP4 or MPEG-TS Outputs. If you specify a preset for
 // which the value of Container is fmp4 (Fragmented MP4) or ts (MPEG-TS), Playlists
 // contains information about the master playlists that you want Elastic Transcoder
@@ -2129,7 +2381,7 @@ type metadataPipelineOutputConfig struct {
 type Playlist struct {
 	// The format of the output playlist. Valid formats include HLSv3, HLSv4, and
 	// Smooth.
-	Format *string `type:"string"`
+	Format *string `type:"string" pattern:"(^HLSv3$)|(^HLSv4$)|(^Smooth$)"`
 
 	// The HLS content protection settings, if any, that you want Elastic Transcoder
 	// to apply to the output files associated with this playlist.
@@ -2145,7 +2397,7 @@ type Playlist struct {
 	// to the file name (.m3u8 for HLSv3 and HLSv4 playlists, and .ism and .ismc
 	// for Smooth playlists). If you include a file extension in Name, the file
 	// name will have two extensions.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"1" max:"255"`
 
 	// For each output in this job that you want to include in a master playlist,
 	// the value of the Outputs:Key object.
@@ -2184,13 +2436,13 @@ type Playlist struct {
 	// in the playlist. For Smooth playlists, the Audio:Profile, Video:Profile,
 	// and Video:FrameRate to Video:KeyframesMaxDist ratio must be the same for
 	// all outputs.
-	OutputKeys []*string `type:"list"`
+	OutputKeys []*string `type:"list" max:"30"`
 
 	// The status of the job with which the playlist is associated.
-	Status *string `type:"string"`
+	Status *string `type:"string" pattern:"(^Submitted$)|(^Progressing$)|(^Complete$)|(^Canceled$)|(^Error$)"`
 
 	// Information that further explains the status.
-	StatusDetail *string `type:"string"`
+	StatusDetail *string `type:"string" max:"255"`
 
 	metadataPlaylist `json:"-", xml:"-"`
 }
@@ -2199,6 +2451,11 @@ type metadataPlaylist struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Playlist.
+func (s Playlist) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Presets are templates that contain most of the settings for transcoding media
 // files from one format to another. Elastic Transcoder includes some default
 // presets for common formats, for example, several iPod and iPhone versions.
@@ -2215,17 +2472,17 @@ type Preset struct {
 
 	// The container type for the output file. Valid values include flv, fmp4, gif,
 	// mp3, mp4, mpg, ogg, ts, and webm.
-	Container *string `type:"string"`
+	Container *string `type:"string" pattern:"(^mp4$)|(^ts$)|(^webm$)|(^mp3$)|(^ogg$)|(^fmp4$)|(^mpg$)|(^flv$)|(^gif$)"`
 
 	// A description of the preset.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"255"`
 
 	// Identifier for the new preset. You use this value to get settings for the
 	// preset or to delete it.
-	ID *string `locationName:"Id" type:"string"`
+	ID *string `locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$"`
 
 	// The name of the preset.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"1" max:"40"`
 
 	// A section of the response body that provides information about the thumbnail
 	// preset values, if any.
@@ -2233,7 +2490,7 @@ type Preset struct {
 
 	// Whether the preset is a default preset provided by Elastic Transcoder (System)
 	// or a preset that you have defined (Custom).
-	Type *string `type:"string"`
+	Type *string `type:"string" pattern:"(^System$)|(^Custom$)"`
 
 	// A section of the response body that provides information about the video
 	// preset values.
@@ -2246,6 +2503,11 @@ type metadataPreset struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Preset.
+func (s Preset) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Settings for the size, location, and opacity of graphics that you want Elastic
 // Transcoder to overlay over videos that are transcoded using this preset.
 // You can specify settings for up to four watermarks. Watermarks appear in
@@ -2266,7 +2528,7 @@ type PresetWatermark struct {
 	// the left border of the video.  Right: The right edge of the watermark is
 	// aligned with the right border of the video.  Center: The watermark is centered
 	// between the left and right borders.
-	HorizontalAlign *string `type:"string"`
+	HorizontalAlign *string `type:"string" pattern:"(^Left$)|(^Right$)|(^Center$)"`
 
 	// The amount by which you want the horizontal position of the watermark to
 	// be offset from the position specified by HorizontalAlign:  number of pixels
@@ -2285,11 +2547,11 @@ type PresetWatermark struct {
 	//
 	// Use the value of Target to specify whether you want to include the black
 	// bars that are added by Elastic Transcoder, if any, in the offset calculation.
-	HorizontalOffset *string `type:"string"`
+	HorizontalOffset *string `type:"string" pattern:"(^\\d{1,3}(\\.\\d{0,5})?%$)|(^\\d{1,4}?px$)"`
 
 	// A unique identifier for the settings for one watermark. The value of Id can
 	// be up to 40 characters long.
-	ID *string `locationName:"Id" type:"string"`
+	ID *string `locationName:"Id" type:"string" min:"1" max:"40"`
 
 	// The maximum height of the watermark in one of the following formats:  number
 	// of pixels (px): The minimum value is 16 pixels, and the maximum value is
@@ -2298,7 +2560,7 @@ type PresetWatermark struct {
 	// Transcoder to include the black bars that are added by Elastic Transcoder,
 	// if any, in the calculation.  If you specify the value in pixels, it must
 	// be less than or equal to the value of MaxHeight.
-	MaxHeight *string `type:"string"`
+	MaxHeight *string `type:"string" pattern:"(^\\d{1,3}(\\.\\d{0,5})?%$)|(^\\d{1,4}?px$)"`
 
 	// The maximum width of the watermark in one of the following formats:  number
 	// of pixels (px): The minimum value is 16 pixels, and the maximum value is
@@ -2307,7 +2569,7 @@ type PresetWatermark struct {
 	// Transcoder to include the black bars that are added by Elastic Transcoder,
 	// if any, in the calculation. If you specify the value in pixels, it must be
 	// less than or equal to the value of MaxWidth.
-	MaxWidth *string `type:"string"`
+	MaxWidth *string `type:"string" pattern:"(^\\d{1,3}(\\.\\d{0,5})?%$)|(^\\d{1,4}?px$)"`
 
 	// A percentage that indicates how much you want a watermark to obscure the
 	// video in the location where it appears. Valid values are 0 (the watermark
@@ -2317,7 +2579,7 @@ type PresetWatermark struct {
 	// Elastic Transcoder supports transparent .png graphics. If you use a transparent
 	// .png, the transparent portion of the video appears as if you had specified
 	// a value of 0 for Opacity. The .jpg file format doesn't support transparency.
-	Opacity *string `type:"string"`
+	Opacity *string `type:"string" pattern:"^\\d{1,3}(\\.\\d{0,20})?$"`
 
 	// A value that controls scaling of the watermark:   Fit: Elastic Transcoder
 	// scales the watermark so it matches the value that you specified in either
@@ -2329,7 +2591,7 @@ type PresetWatermark struct {
 	// so that its dimensions match the values that you specified for at least one
 	// of MaxWidth and MaxHeight without exceeding either value. If you specify
 	// this option, Elastic Transcoder does not scale the watermark up.
-	SizingPolicy *string `type:"string"`
+	SizingPolicy *string `type:"string" pattern:"(^Fit$)|(^Stretch$)|(^ShrinkToFit$)"`
 
 	// A value that determines how Elastic Transcoder interprets values that you
 	// specified for HorizontalOffset, VerticalOffset, MaxWidth, and MaxHeight:
@@ -2342,14 +2604,14 @@ type PresetWatermark struct {
 	// added by Elastic Transcoder, if any. In addition, MaxWidth and MaxHeight,
 	// if specified as a percentage, are calculated based on the borders of the
 	// video including black bars added by Elastic Transcoder, if any.
-	Target *string `type:"string"`
+	Target *string `type:"string" pattern:"(^Content$)|(^Frame$)"`
 
 	// The vertical position of the watermark unless you specify a non-zero value
 	// for VerticalOffset:   Top: The top edge of the watermark is aligned with
 	// the top border of the video.  Bottom: The bottom edge of the watermark is
 	// aligned with the bottom border of the video.  Center: The watermark is centered
 	// between the top and bottom borders.
-	VerticalAlign *string `type:"string"`
+	VerticalAlign *string `type:"string" pattern:"(^Top$)|(^Bottom$)|(^Center$)"`
 
 	// VerticalOffset The amount by which you want the vertical position of the
 	// watermark to be offset from the position specified by VerticalAlign: number
@@ -2370,7 +2632,7 @@ type PresetWatermark struct {
 	// Use the value of Target to specify whether you want Elastic Transcoder to
 	// include the black bars that are added by Elastic Transcoder, if any, in the
 	// offset calculation.
-	VerticalOffset *string `type:"string"`
+	VerticalOffset *string `type:"string" pattern:"(^\\d{1,3}(\\.\\d{0,5})?%$)|(^\\d{1,4}?px$)"`
 
 	metadataPresetWatermark `json:"-", xml:"-"`
 }
@@ -2379,10 +2641,15 @@ type metadataPresetWatermark struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PresetWatermark.
+func (s PresetWatermark) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The ReadJobRequest structure.
 type ReadJobInput struct {
 	// The identifier of the job for which you want to get detailed information.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$" required:"true"`
 
 	metadataReadJobInput `json:"-", xml:"-"`
 }
@@ -2391,6 +2658,11 @@ type metadataReadJobInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReadJobInput.
+func (s ReadJobInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The ReadJobResponse structure.
 type ReadJobOutput struct {
 	// A section of the response body that provides information about the job.
@@ -2403,10 +2675,15 @@ type metadataReadJobOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReadJobOutput.
+func (s ReadJobOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The ReadPipelineRequest structure.
 type ReadPipelineInput struct {
 	// The identifier of the pipeline to read.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$" required:"true"`
 
 	metadataReadPipelineInput `json:"-", xml:"-"`
 }
@@ -2415,6 +2692,11 @@ type metadataReadPipelineInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReadPipelineInput.
+func (s ReadPipelineInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The ReadPipelineResponse structure.
 type ReadPipelineOutput struct {
 	// A section of the response body that provides information about the pipeline.
@@ -2435,10 +2717,15 @@ type metadataReadPipelineOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReadPipelineOutput.
+func (s ReadPipelineOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The ReadPresetRequest structure.
 type ReadPresetInput struct {
 	// The identifier of the preset for which you want to get detailed information.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$" required:"true"`
 
 	metadataReadPresetInput `json:"-", xml:"-"`
 }
@@ -2447,6 +2734,11 @@ type metadataReadPresetInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReadPresetInput.
+func (s ReadPresetInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The ReadPresetResponse structure.
 type ReadPresetOutput struct {
 	// A section of the response body that provides information about the preset.
@@ -2459,23 +2751,28 @@ type metadataReadPresetOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReadPresetOutput.
+func (s ReadPresetOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The TestRoleRequest structure.
 type TestRoleInput struct {
 	// The Amazon S3 bucket that contains media files to be transcoded. The action
 	// attempts to read from this bucket.
-	InputBucket *string `type:"string" required:"true"`
+	InputBucket *string `type:"string" pattern:"^(\\w|\\.|-){1,255}$" required:"true"`
 
 	// The Amazon S3 bucket that Elastic Transcoder will write transcoded media
 	// files to. The action attempts to read from this bucket.
-	OutputBucket *string `type:"string" required:"true"`
+	OutputBucket *string `type:"string" pattern:"^(\\w|\\.|-){1,255}$" required:"true"`
 
 	// The IAM Amazon Resource Name (ARN) for the role that you want Elastic Transcoder
 	// to test.
-	Role *string `type:"string" required:"true"`
+	Role *string `type:"string" pattern:"^arn:aws:iam::\\w{12}:role/.+$" required:"true"`
 
 	// The ARNs of one or more Amazon Simple Notification Service (Amazon SNS) topics
 	// that you want the action to send a test notification to.
-	Topics []*string `type:"list" required:"true"`
+	Topics []*string `type:"list" max:"30" required:"true"`
 
 	metadataTestRoleInput `json:"-", xml:"-"`
 }
@@ -2484,6 +2781,11 @@ type metadataTestRoleInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TestRoleInput.
+func (s TestRoleInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The TestRoleResponse structure.
 type TestRoleOutput struct {
 	// If the Success element contains false, this value is an array of one or more
@@ -2492,7 +2794,7 @@ type TestRoleOutput struct {
 
 	// If the operation is successful, this value is true; otherwise, the value
 	// is false.
-	Success *string `type:"string"`
+	Success *string `type:"string" pattern:"(^true$)|(^false$)"`
 
 	metadataTestRoleOutput `json:"-", xml:"-"`
 }
@@ -2501,6 +2803,11 @@ type metadataTestRoleOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TestRoleOutput.
+func (s TestRoleOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Thumbnails for videos.
 type Thumbnails struct {
 	// To better control resolution and aspect ratio of thumbnails, we recommend
@@ -2514,33 +2821,33 @@ type Thumbnails struct {
 	//
 	// If you specify auto, Elastic Transcoder tries to preserve the aspect ratio
 	// of the video in the output file.
-	AspectRatio *string `type:"string"`
+	AspectRatio *string `type:"string" pattern:"(^auto$)|(^1:1$)|(^4:3$)|(^3:2$)|(^16:9$)"`
 
 	// The format of thumbnails, if any. Valid values are jpg and png.
 	//
 	// You specify whether you want Elastic Transcoder to create thumbnails when
 	// you create a job.
-	Format *string `type:"string"`
+	Format *string `type:"string" pattern:"(^jpg$)|(^png$)"`
 
 	// The approximate number of seconds between thumbnails. Specify an integer
 	// value.
-	Interval *string `type:"string"`
+	Interval *string `type:"string" pattern:"^\\d{1,5}$"`
 
 	// The maximum height of thumbnails in pixels. If you specify auto, Elastic
 	// Transcoder uses 1080 (Full HD) as the default value. If you specify a numeric
 	// value, enter an even integer between 32 and 3072.
-	MaxHeight *string `type:"string"`
+	MaxHeight *string `type:"string" pattern:"(^auto$)|(^\\d{2,4}$)"`
 
 	// The maximum width of thumbnails in pixels. If you specify auto, Elastic Transcoder
 	// uses 1920 (Full HD) as the default value. If you specify a numeric value,
 	// enter an even integer between 32 and 4096.
-	MaxWidth *string `type:"string"`
+	MaxWidth *string `type:"string" pattern:"(^auto$)|(^\\d{2,4}$)"`
 
 	// When you set PaddingPolicy to Pad, Elastic Transcoder may add black bars
 	// to the top and bottom and/or left and right sides of thumbnails to make the
 	// total size of the thumbnails match the values that you specified for thumbnail
 	// MaxWidth and MaxHeight settings.
-	PaddingPolicy *string `type:"string"`
+	PaddingPolicy *string `type:"string" pattern:"(^Pad$)|(^NoPad$)"`
 
 	// To better control resolution and aspect ratio of thumbnails, we recommend
 	// that you use the values MaxWidth, MaxHeight, SizingPolicy, and PaddingPolicy
@@ -2550,7 +2857,7 @@ type Thumbnails struct {
 	//  The width and height of thumbnail files in pixels. Specify a value in the
 	// format width x height where both values are even integers. The values cannot
 	// exceed the width and height that you specified in the Video:Resolution object.
-	Resolution *string `type:"string"`
+	Resolution *string `type:"string" pattern:"^\\d{1,5}x\\d{1,5}$"`
 
 	// Specify one of the following values to control scaling of thumbnails:
 	//
@@ -2574,7 +2881,7 @@ type Thumbnails struct {
 	// the values that you specified for at least one of MaxWidth and MaxHeight
 	// without dropping below either value. If you specify this option, Elastic
 	// Transcoder does not scale thumbnails up.
-	SizingPolicy *string `type:"string"`
+	SizingPolicy *string `type:"string" pattern:"(^Fit$)|(^Fill$)|(^Stretch$)|(^Keep$)|(^ShrinkToFit$)|(^ShrinkToFill$)"`
 
 	metadataThumbnails `json:"-", xml:"-"`
 }
@@ -2583,6 +2890,11 @@ type metadataThumbnails struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Thumbnails.
+func (s Thumbnails) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Settings that determine when a clip begins and how long it lasts.
 type TimeSpan struct {
 	// The duration of the clip. The format can be either HH:mm:ss.SSS (maximum
@@ -2592,13 +2904,13 @@ type TimeSpan struct {
 	//
 	// If you specify a value longer than the duration of the input file, Elastic
 	// Transcoder transcodes the file and returns a warning message.
-	Duration *string `type:"string"`
+	Duration *string `type:"string" pattern:"(^\\d{1,5}(\\.\\d{0,3})?$)|(^([0-1]?[0-9]:|2[0-3]:)?([0-5]?[0-9]:)?[0-5]?[0-9](\\.\\d{0,3})?$)"`
 
 	// The place in the input file where you want a clip to start. The format can
 	// be either HH:mm:ss.SSS (maximum value: 23:59:59.999; SSS is thousandths of
 	// a second) or sssss.SSS (maximum value: 86399.999). If you don't specify a
 	// value, Elastic Transcoder starts at the beginning of the input file.
-	StartTime *string `type:"string"`
+	StartTime *string `type:"string" pattern:"(^\\d{1,5}(\\.\\d{0,3})?$)|(^([0-1]?[0-9]:|2[0-3]:)?([0-5]?[0-9]:)?[0-5]?[0-9](\\.\\d{0,3})?$)"`
 
 	metadataTimeSpan `json:"-", xml:"-"`
 }
@@ -2607,6 +2919,11 @@ type metadataTimeSpan struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TimeSpan.
+func (s TimeSpan) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The UpdatePipelineRequest structure.
 type UpdatePipelineInput struct {
 	// The AWS Key Management Service (AWS KMS) key that you want to use with this
@@ -2617,7 +2934,7 @@ type UpdatePipelineInput struct {
 	// key, is created for you automatically. You need to provide an AWS-KMS key
 	// only if you want to use a non-default AWS-KMS key, or if you are using an
 	// Encryption:Mode of AES-PKCS7, AES-CTR, or AES-GCM.
-	AWSKMSKeyARN *string `locationName:"AwsKmsKeyArn" type:"string"`
+	AWSKMSKeyARN *string `locationName:"AwsKmsKeyArn" type:"string" max:"255"`
 
 	// The optional ContentConfig object specifies information about the Amazon
 	// S3 bucket in which you want Elastic Transcoder to save transcoded files and
@@ -2667,17 +2984,17 @@ type UpdatePipelineInput struct {
 	ContentConfig *PipelineOutputConfig `type:"structure"`
 
 	// The ID of the pipeline that you want to update.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$" required:"true"`
 
 	// The Amazon S3 bucket in which you saved the media files that you want to
 	// transcode and the graphics that you want to use as watermarks.
-	InputBucket *string `type:"string"`
+	InputBucket *string `type:"string" pattern:"^(\\w|\\.|-){1,255}$"`
 
 	// The name of the pipeline. We recommend that the name be unique within the
 	// AWS account, but uniqueness is not enforced.
 	//
 	// Constraints: Maximum 40 characters
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"1" max:"40"`
 
 	// The Amazon Simple Notification Service (Amazon SNS) topic or topics to notify
 	// in order to report job status.
@@ -2688,7 +3005,7 @@ type UpdatePipelineInput struct {
 
 	// The IAM Amazon Resource Name (ARN) for the role that you want Elastic Transcoder
 	// to use to transcode jobs for this pipeline.
-	Role *string `type:"string"`
+	Role *string `type:"string" pattern:"^arn:aws:iam::\\w{12}:role/.+$"`
 
 	// The ThumbnailConfig object specifies several values, including the Amazon
 	// S3 bucket in which you want Elastic Transcoder to save thumbnail files, which
@@ -2738,11 +3055,16 @@ type metadataUpdatePipelineInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdatePipelineInput.
+func (s UpdatePipelineInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The UpdatePipelineNotificationsRequest structure.
 type UpdatePipelineNotificationsInput struct {
 	// The identifier of the pipeline for which you want to change notification
 	// settings.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$" required:"true"`
 
 	// The topic ARN for the Amazon Simple Notification Service (Amazon SNS) topic
 	// that you want to notify to report job status.
@@ -2769,6 +3091,11 @@ type metadataUpdatePipelineNotificationsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdatePipelineNotificationsInput.
+func (s UpdatePipelineNotificationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The UpdatePipelineNotificationsResponse structure.
 type UpdatePipelineNotificationsOutput struct {
 	// A section of the response body that provides information about the pipeline.
@@ -2781,6 +3108,11 @@ type metadataUpdatePipelineNotificationsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdatePipelineNotificationsOutput.
+func (s UpdatePipelineNotificationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // When you update a pipeline, Elastic Transcoder returns the values that you
 // specified in the request.
 type UpdatePipelineOutput struct {
@@ -2802,16 +3134,21 @@ type metadataUpdatePipelineOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdatePipelineOutput.
+func (s UpdatePipelineOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The UpdatePipelineStatusRequest structure.
 type UpdatePipelineStatusInput struct {
 	// The identifier of the pipeline to update.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" pattern:"^\\d{13}-\\w{6}$" required:"true"`
 
 	// The desired status of the pipeline:
 	//
 	//   Active: The pipeline is processing jobs.  Paused: The pipeline is not
 	// currently processing jobs.
-	Status *string `type:"string" required:"true"`
+	Status *string `type:"string" pattern:"(^Active$)|(^Paused$)" required:"true"`
 
 	metadataUpdatePipelineStatusInput `json:"-", xml:"-"`
 }
@@ -2820,6 +3157,11 @@ type metadataUpdatePipelineStatusInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdatePipelineStatusInput.
+func (s UpdatePipelineStatusInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // When you update status for a pipeline, Elastic Transcoder returns the values
 // that you specified in the request.
 type UpdatePipelineStatusOutput struct {
@@ -2833,6 +3175,11 @@ type metadataUpdatePipelineStatusOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdatePipelineStatusOutput.
+func (s UpdatePipelineStatusOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The VideoParameters structure.
 type VideoParameters struct {
 	// To better control resolution and aspect ratio of output videos, we recommend
@@ -2852,7 +3199,7 @@ type VideoParameters struct {
 	// ratio of the input file, Elastic Transcoder adds pillarboxing (black bars
 	// on the sides) or letterboxing (black bars on the top and bottom) to maintain
 	// the aspect ratio of the active region of the video.
-	AspectRatio *string `type:"string"`
+	AspectRatio *string `type:"string" pattern:"(^auto$)|(^1:1$)|(^4:3$)|(^3:2$)|(^16:9$)"`
 
 	// The bit rate of the video stream in the output file, in kilobits/second.
 	// Valid values depend on the values of Level and Profile. If you specify auto,
@@ -2867,12 +3214,12 @@ type VideoParameters struct {
 	//  1 - 64 : 80 1b - 128 : 160 1.1 - 192 : 240 1.2 - 384 : 480 1.3 - 768 :
 	// 960 2 - 2000 : 2500 3 - 10000 : 12500 3.1 - 14000 : 17500 3.2 - 20000 : 25000
 	// 4 - 20000 : 25000 4.1 - 50000 : 62500
-	BitRate *string `type:"string"`
+	BitRate *string `type:"string" pattern:"(^\\d{2,5}$)|(^auto$)"`
 
 	// The video codec for the output file. Valid values include gif, H.264, mpeg2,
 	// and vp8. You can only specify vp8 when the container type is webm, gif when
 	// the container type is gif, and mpeg2 when the container type is mpg.
-	Codec *string `type:"string"`
+	Codec *string `type:"string" pattern:"(^H\\.264$)|(^vp8$)|(^mpeg2$)|(^gif$)"`
 
 	// Profile (H.264/VP8 Only)
 	//
@@ -2976,10 +3323,10 @@ type VideoParameters struct {
 	//
 	// The number of times you want the output gif to loop. Valid values include
 	// Infinite and integers between 0 and 100, inclusive.
-	CodecOptions *map[string]*string `type:"map"`
+	CodecOptions *map[string]*string `type:"map" max:"30"`
 
 	// The value that Elastic Transcoder adds to the metadata in the output file.
-	DisplayAspectRatio *string `type:"string"`
+	DisplayAspectRatio *string `type:"string" pattern:"(^auto$)|(^1:1$)|(^4:3$)|(^3:2$)|(^16:9$)"`
 
 	// Applicable only when the value of Video:Codec is one of H.264, MPEG2, or
 	// VP8.
@@ -2990,7 +3337,7 @@ type VideoParameters struct {
 	// between key frames (the number of frames in a group of pictures, or GOP).
 	//  false: The distance between key frames can vary.  FixedGOP must be set to
 	// true for fmp4 containers.
-	FixedGOP *string `type:"string"`
+	FixedGOP *string `type:"string" pattern:"(^true$)|(^false$)"`
 
 	// The frames per second for the video stream in the output file. Valid values
 	// include:
@@ -3016,7 +3363,7 @@ type VideoParameters struct {
 	//  1 - 380160 1b - 380160 1.1 - 76800 1.2 - 1536000 1.3 - 3041280 2 - 3041280
 	// 2.1 - 5068800 2.2 - 5184000 3 - 10368000 3.1 - 27648000 3.2 - 55296000 4
 	// - 62914560 4.1 - 62914560
-	FrameRate *string `type:"string"`
+	FrameRate *string `type:"string" pattern:"(^auto$)|(^10$)|(^15$)|(^23.97$)|(^24$)|(^25$)|(^29.97$)|(^30$)|(^50$)|(^60$)"`
 
 	// Applicable only when the value of Video:Codec is one of H.264, MPEG2, or
 	// VP8.
@@ -3039,30 +3386,30 @@ type VideoParameters struct {
 	//
 	// Alternately, this can be achieved by setting FrameRate to auto and having
 	// the same values for MaxFrameRate and KeyframesMaxDist.
-	KeyframesMaxDist *string `type:"string"`
+	KeyframesMaxDist *string `type:"string" pattern:"^\\d{1,6}$"`
 
 	// If you specify auto for FrameRate, Elastic Transcoder uses the frame rate
 	// of the input video for the frame rate of the output video. Specify the maximum
 	// frame rate that you want Elastic Transcoder to use when the frame rate of
 	// the input video is greater than the desired maximum frame rate of the output
 	// video. Valid values include: 10, 15, 23.97, 24, 25, 29.97, 30, 60.
-	MaxFrameRate *string `type:"string"`
+	MaxFrameRate *string `type:"string" pattern:"(^10$)|(^15$)|(^23.97$)|(^24$)|(^25$)|(^29.97$)|(^30$)|(^50$)|(^60$)"`
 
 	// The maximum height of the output video in pixels. If you specify auto, Elastic
 	// Transcoder uses 1080 (Full HD) as the default value. If you specify a numeric
 	// value, enter an even integer between 96 and 3072.
-	MaxHeight *string `type:"string"`
+	MaxHeight *string `type:"string" pattern:"(^auto$)|(^\\d{2,4}$)"`
 
 	// The maximum width of the output video in pixels. If you specify auto, Elastic
 	// Transcoder uses 1920 (Full HD) as the default value. If you specify a numeric
 	// value, enter an even integer between 128 and 4096.
-	MaxWidth *string `type:"string"`
+	MaxWidth *string `type:"string" pattern:"(^auto$)|(^\\d{2,4}$)"`
 
 	// When you set PaddingPolicy to Pad, Elastic Transcoder may add black bars
 	// to the top and bottom and/or left and right sides of the output video to
 	// make the total size of the output video match the values that you specified
 	// for MaxWidth and MaxHeight.
-	PaddingPolicy *string `type:"string"`
+	PaddingPolicy *string `type:"string" pattern:"(^Pad$)|(^NoPad$)"`
 
 	// To better control resolution and aspect ratio of output videos, we recommend
 	// that you use the values MaxWidth, MaxHeight, SizingPolicy, PaddingPolicy,
@@ -3088,7 +3435,7 @@ type VideoParameters struct {
 	// x height value):  1 - 25344 1b - 25344 1.1 - 101376 1.2 - 101376 1.3 - 101376
 	// 2 - 101376 2.1 - 202752 2.2 - 404720 3 - 404720 3.1 - 921600 3.2 - 1310720
 	// 4 - 2097152 4.1 - 2097152
-	Resolution *string `type:"string"`
+	Resolution *string `type:"string" pattern:"(^auto$)|(^\\d{1,5}x\\d{1,5}$)"`
 
 	// Specify one of the following values to control scaling of the output video:
 	//
@@ -3112,7 +3459,7 @@ type VideoParameters struct {
 	// for at least one of MaxWidth and MaxHeight without dropping below either
 	// value. If you specify this option, Elastic Transcoder does not scale the
 	// video up.
-	SizingPolicy *string `type:"string"`
+	SizingPolicy *string `type:"string" pattern:"(^Fit$)|(^Fill$)|(^Stretch$)|(^Keep$)|(^ShrinkToFit$)|(^ShrinkToFill$)"`
 
 	// Settings for the size, location, and opacity of graphics that you want Elastic
 	// Transcoder to overlay over videos that are transcoded using this preset.
@@ -3137,6 +3484,11 @@ type metadataVideoParameters struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the VideoParameters.
+func (s VideoParameters) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Elastic Transcoder returns a warning if the resources used by your pipeline
 // are not in the same region as the pipeline.
 //
@@ -3158,4 +3510,9 @@ type Warning struct {
 
 type metadataWarning struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the Warning.
+func (s Warning) String() string {
+	return awsutil.StringValue(s)
 }
\ No newline at end of file
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // AddTagsRequest generates a request for the AddTags operation.
@@ -584,6 +585,11 @@ func (c *ELB) DescribeLoadBalancersRequest(input *DescribeLoadBalancersInput) (r
 			Name:       "DescribeLoadBalancers",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"NextMarker"},
+				LimitToken:   "PageSize",
+			},
 		}
 	}
 
@@ -606,6 +612,16 @@ func (c *ELB) DescribeLoadBalancers(input *DescribeLoadBalancersInput) (output *
 	return
 }
 
+// DescribeLoadBalancersPages iterates over the pages of a DescribeLoadBalancers operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *ELB) DescribeLoadBalancersPages(input *DescribeLoadBalancersInput, fn func(p *DescribeLoadBalancersOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeLoadBalancersRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeLoadBalancersOutput), lastPage)
+	})
+}
+
 var opDescribeLoadBalancers *aws.Operation
 
 // DescribeTagsRequest generates a request for the DescribeTags operation.
@@ -979,6 +995,11 @@ type metadataAccessLog struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AccessLog.
+func (s AccessLog) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the AddTags action
 type AddTagsInput struct {
 	// The name of the load balancer to tag. You can specify a maximum of one load
@@ -986,7 +1007,7 @@ type AddTagsInput struct {
 	LoadBalancerNames []*string `type:"list" required:"true"`
 
 	// A list of tags for each load balancer.
-	Tags []*Tag `type:"list" required:"true"`
+	Tags []*Tag `type:"list" min:"1" required:"true"`
 
 	metadataAddTagsInput `json:"-", xml:"-"`
 }
@@ -995,6 +1016,11 @@ type metadataAddTagsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddTagsInput.
+func (s AddTagsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the AddTags action.
 type AddTagsOutput struct {
 	metadataAddTagsOutput `json:"-", xml:"-"`
@@ -1004,6 +1030,11 @@ type metadataAddTagsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddTagsOutput.
+func (s AddTagsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AdditionalAttribute struct {
 	Key *string `type:"string"`
 
@@ -1016,6 +1047,11 @@ type metadataAdditionalAttribute struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AdditionalAttribute.
+func (s AdditionalAttribute) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The AppCookieStickinessPolicy data type.
 type AppCookieStickinessPolicy struct {
 	// The name of the application cookie used for stickiness.
@@ -1032,6 +1068,11 @@ type metadataAppCookieStickinessPolicy struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AppCookieStickinessPolicy.
+func (s AppCookieStickinessPolicy) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the ApplySecurityGroupsToLoadBalancer action.
 type ApplySecurityGroupsToLoadBalancerInput struct {
 	// The name associated with the load balancer. The name must be unique within
@@ -1050,6 +1091,11 @@ type metadataApplySecurityGroupsToLoadBalancerInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ApplySecurityGroupsToLoadBalancerInput.
+func (s ApplySecurityGroupsToLoadBalancerInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The out for the ApplySecurityGroupsToLoadBalancer action.
 type ApplySecurityGroupsToLoadBalancerOutput struct {
 	// A list of security group IDs associated with your load balancer.
@@ -1062,6 +1108,11 @@ type metadataApplySecurityGroupsToLoadBalancerOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ApplySecurityGroupsToLoadBalancerOutput.
+func (s ApplySecurityGroupsToLoadBalancerOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the AttachLoadBalancerToSubnets action.
 type AttachLoadBalancerToSubnetsInput struct {
 	// The name associated with the load balancer. The name must be unique within
@@ -1079,6 +1130,11 @@ type metadataAttachLoadBalancerToSubnetsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AttachLoadBalancerToSubnetsInput.
+func (s AttachLoadBalancerToSubnetsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the AttachLoadBalancerToSubnets action.
 type AttachLoadBalancerToSubnetsOutput struct {
 	// A list of subnet IDs attached to the load balancer.
@@ -1091,11 +1147,16 @@ type metadataAttachLoadBalancerToSubnetsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AttachLoadBalancerToSubnetsOutput.
+func (s AttachLoadBalancerToSubnetsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This data type is used as a response element in the DescribeLoadBalancers
 // action to describe the configuration of the back-end server.
 type BackendServerDescription struct {
 	// Provides the port on which the back-end server is listening.
-	InstancePort *int64 `type:"integer"`
+	InstancePort *int64 `type:"integer" min:"1" max:"65535"`
 
 	// Provides a list of policy names enabled for the back-end server.
 	PolicyNames []*string `type:"list"`
@@ -1107,6 +1168,11 @@ type metadataBackendServerDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the BackendServerDescription.
+func (s BackendServerDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input for the ConfigureHealthCheck action.
 type ConfigureHealthCheckInput struct {
 	// A structure containing the configuration information for the new healthcheck.
@@ -1123,6 +1189,11 @@ type metadataConfigureHealthCheckInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ConfigureHealthCheckInput.
+func (s ConfigureHealthCheckInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the ConfigureHealthCheck action.
 type ConfigureHealthCheckOutput struct {
 	// The updated healthcheck for the instances.
@@ -1135,6 +1206,11 @@ type metadataConfigureHealthCheckOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ConfigureHealthCheckOutput.
+func (s ConfigureHealthCheckOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The ConnectionDraining data type.
 type ConnectionDraining struct {
 	// Specifies whether connection draining is enabled for the load balancer.
@@ -1151,11 +1227,16 @@ type metadataConnectionDraining struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ConnectionDraining.
+func (s ConnectionDraining) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The ConnectionSettings data type.
 type ConnectionSettings struct {
 	// Specifies the time (in seconds) the connection is allowed to be idle (no
 	// data has been sent over the connection) before it is closed by the load balancer.
-	IdleTimeout *int64 `type:"integer" required:"true"`
+	IdleTimeout *int64 `type:"integer" min:"1" max:"3600" required:"true"`
 
 	metadataConnectionSettings `json:"-", xml:"-"`
 }
@@ -1164,6 +1245,11 @@ type metadataConnectionSettings struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ConnectionSettings.
+func (s ConnectionSettings) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the CreateAppCookieStickinessPolicy action.
 type CreateAppCookieStickinessPolicyInput struct {
 	// Name of the application cookie used for stickiness.
@@ -1183,6 +1269,11 @@ type metadataCreateAppCookieStickinessPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateAppCookieStickinessPolicyInput.
+func (s CreateAppCookieStickinessPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the CreateAppCookieStickinessPolicy action.
 type CreateAppCookieStickinessPolicyOutput struct {
 	metadataCreateAppCookieStickinessPolicyOutput `json:"-", xml:"-"`
@@ -1192,6 +1283,11 @@ type metadataCreateAppCookieStickinessPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateAppCookieStickinessPolicyOutput.
+func (s CreateAppCookieStickinessPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the CreateLBCookieStickinessPolicy action.
 type CreateLBCookieStickinessPolicyInput struct {
 	// The time period in seconds after which the cookie should be considered stale.
@@ -1213,6 +1309,11 @@ type metadataCreateLBCookieStickinessPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateLBCookieStickinessPolicyInput.
+func (s CreateLBCookieStickinessPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the CreateLBCookieStickinessPolicy action.
 type CreateLBCookieStickinessPolicyOutput struct {
 	metadataCreateLBCookieStickinessPolicyOutput `json:"-", xml:"-"`
@@ -1222,6 +1323,11 @@ type metadataCreateLBCookieStickinessPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateLBCookieStickinessPolicyOutput.
+func (s CreateLBCookieStickinessPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the CreateLoadBalancer action.
 type CreateLoadBalancerInput struct {
 	// A list of Availability Zones.
@@ -1267,7 +1373,7 @@ type CreateLoadBalancerInput struct {
 	//
 	// For more information about setting tags for your load balancer, see Tagging
 	// (http://docs.aws.amazon.com/ElasticLoadBalancing/latest/DeveloperGuide/TerminologyandKeyConcepts.html#tagging-elb).
-	Tags []*Tag `type:"list"`
+	Tags []*Tag `type:"list" min:"1"`
 
 	metadataCreateLoadBalancerInput `json:"-", xml:"-"`
 }
@@ -1276,6 +1382,11 @@ type metadataCreateLoadBalancerInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateLoadBalancerInput.
+func (s CreateLoadBalancerInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the CreateLoadBalancerListeners action.
 type CreateLoadBalancerListenersInput struct {
 	// A list of LoadBalancerPort, InstancePort, Protocol, InstanceProtocol, and
@@ -1292,6 +1403,11 @@ type metadataCreateLoadBalancerListenersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateLoadBalancerListenersInput.
+func (s CreateLoadBalancerListenersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the CreateLoadBalancerListeners action.
 type CreateLoadBalancerListenersOutput struct {
 	metadataCreateLoadBalancerListenersOutput `json:"-", xml:"-"`
@@ -1301,6 +1417,11 @@ type metadataCreateLoadBalancerListenersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateLoadBalancerListenersOutput.
+func (s CreateLoadBalancerListenersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the CreateLoadBalancer action.
 type CreateLoadBalancerOutput struct {
 	// The DNS name for the load balancer.
@@ -1313,6 +1434,11 @@ type metadataCreateLoadBalancerOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateLoadBalancerOutput.
+func (s CreateLoadBalancerOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateLoadBalancerPolicyInput struct {
 	// The name associated with the LoadBalancer for which the policy is being created.
 	LoadBalancerName *string `type:"string" required:"true"`
@@ -1335,6 +1461,11 @@ type metadataCreateLoadBalancerPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateLoadBalancerPolicyInput.
+func (s CreateLoadBalancerPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the CreateLoadBalancerPolicy action.
 type CreateLoadBalancerPolicyOutput struct {
 	metadataCreateLoadBalancerPolicyOutput `json:"-", xml:"-"`
@@ -1344,6 +1475,11 @@ type metadataCreateLoadBalancerPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateLoadBalancerPolicyOutput.
+func (s CreateLoadBalancerPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The CrossZoneLoadBalancing data type.
 type CrossZoneLoadBalancing struct {
 	// Specifies whether cross-zone load balancing is enabled for the load balancer.
@@ -1356,6 +1492,11 @@ type metadataCrossZoneLoadBalancing struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CrossZoneLoadBalancing.
+func (s CrossZoneLoadBalancing) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the DeleteLoadBalancer action.
 type DeleteLoadBalancerInput struct {
 	// The name associated with the load balancer.
@@ -1368,6 +1509,11 @@ type metadataDeleteLoadBalancerInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteLoadBalancerInput.
+func (s DeleteLoadBalancerInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the DeleteLoadBalancerListeners action.
 type DeleteLoadBalancerListenersInput struct {
 	// The mnemonic name associated with the load balancer.
@@ -1383,6 +1529,11 @@ type metadataDeleteLoadBalancerListenersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteLoadBalancerListenersInput.
+func (s DeleteLoadBalancerListenersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the DeleteLoadBalancerListeners action.
 type DeleteLoadBalancerListenersOutput struct {
 	metadataDeleteLoadBalancerListenersOutput `json:"-", xml:"-"`
@@ -1392,6 +1543,11 @@ type metadataDeleteLoadBalancerListenersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteLoadBalancerListenersOutput.
+func (s DeleteLoadBalancerListenersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the DeleteLoadBalancer action.
 type DeleteLoadBalancerOutput struct {
 	metadataDeleteLoadBalancerOutput `json:"-", xml:"-"`
@@ -1401,6 +1557,11 @@ type metadataDeleteLoadBalancerOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteLoadBalancerOutput.
+func (s DeleteLoadBalancerOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the DeleteLoadBalancerPolicy action.
 type DeleteLoadBalancerPolicyInput struct {
 	// The mnemonic name associated with the load balancer.
@@ -1416,6 +1577,11 @@ type metadataDeleteLoadBalancerPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteLoadBalancerPolicyInput.
+func (s DeleteLoadBalancerPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the DeleteLoadBalancerPolicy action.
 type DeleteLoadBalancerPolicyOutput struct {
 	metadataDeleteLoadBalancerPolicyOutput `json:"-", xml:"-"`
@@ -1425,6 +1591,11 @@ type metadataDeleteLoadBalancerPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteLoadBalancerPolicyOutput.
+func (s DeleteLoadBalancerPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the DeregisterInstancesFromLoadBalancer action.
 type DeregisterInstancesFromLoadBalancerInput struct {
 	// A list of EC2 instance IDs consisting of all instances to be deregistered.
@@ -1440,6 +1611,11 @@ type metadataDeregisterInstancesFromLoadBalancerInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeregisterInstancesFromLoadBalancerInput.
+func (s DeregisterInstancesFromLoadBalancerInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the DeregisterInstancesFromLoadBalancer action.
 type DeregisterInstancesFromLoadBalancerOutput struct {
 	// An updated list of remaining instances registered with the load balancer.
@@ -1452,6 +1628,11 @@ type metadataDeregisterInstancesFromLoadBalancerOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeregisterInstancesFromLoadBalancerOutput.
+func (s DeregisterInstancesFromLoadBalancerOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the DescribeEndPointState action.
 type DescribeInstanceHealthInput struct {
 	// A list of instance IDs whose states are being queried.
@@ -1467,6 +1648,11 @@ type metadataDescribeInstanceHealthInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeInstanceHealthInput.
+func (s DescribeInstanceHealthInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the DescribeInstanceHealth action.
 type DescribeInstanceHealthOutput struct {
 	// A list containing health information for the specified instances.
@@ -1479,6 +1665,11 @@ type metadataDescribeInstanceHealthOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeInstanceHealthOutput.
+func (s DescribeInstanceHealthOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the DescribeLoadBalancerAttributes action.
 type DescribeLoadBalancerAttributesInput struct {
 	// The name of the load balancer.
@@ -1491,6 +1682,11 @@ type metadataDescribeLoadBalancerAttributesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeLoadBalancerAttributesInput.
+func (s DescribeLoadBalancerAttributesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The following element is returned in a structure named DescribeLoadBalancerAttributesResult.
 type DescribeLoadBalancerAttributesOutput struct {
 	// The load balancer attributes structure.
@@ -1503,6 +1699,11 @@ type metadataDescribeLoadBalancerAttributesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeLoadBalancerAttributesOutput.
+func (s DescribeLoadBalancerAttributesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeLoadBalancerPoliciesInput struct {
 	// The mnemonic name associated with the load balancer. If no name is specified,
 	// the operation returns the attributes of either all the sample policies pre-defined
@@ -1520,6 +1721,11 @@ type metadataDescribeLoadBalancerPoliciesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeLoadBalancerPoliciesInput.
+func (s DescribeLoadBalancerPoliciesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the DescribeLoadBalancerPolicies action.
 type DescribeLoadBalancerPoliciesOutput struct {
 	// A list of policy description structures.
@@ -1532,6 +1738,11 @@ type metadataDescribeLoadBalancerPoliciesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeLoadBalancerPoliciesOutput.
+func (s DescribeLoadBalancerPoliciesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeLoadBalancerPolicyTypesInput struct {
 	// Specifies the name of the policy types. If no names are specified, returns
 	// the description of all the policy types defined by Elastic Load Balancing
@@ -1545,6 +1756,11 @@ type metadataDescribeLoadBalancerPolicyTypesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeLoadBalancerPolicyTypesInput.
+func (s DescribeLoadBalancerPolicyTypesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the DescribeLoadBalancerPolicyTypes action.
 type DescribeLoadBalancerPolicyTypesOutput struct {
 	// List of policy type description structures of the specified policy type.
@@ -1559,6 +1775,11 @@ type metadataDescribeLoadBalancerPolicyTypesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeLoadBalancerPolicyTypesOutput.
+func (s DescribeLoadBalancerPolicyTypesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the DescribeLoadBalancers action.
 type DescribeLoadBalancersInput struct {
 	// A list of load balancer names associated with the account.
@@ -1570,7 +1791,7 @@ type DescribeLoadBalancersInput struct {
 
 	// The number of results returned in each page. The default is 400. You cannot
 	// specify a page size greater than 400 or less than 1.
-	PageSize *int64 `type:"integer"`
+	PageSize *int64 `type:"integer" min:"1" max:"400"`
 
 	metadataDescribeLoadBalancersInput `json:"-", xml:"-"`
 }
@@ -1579,6 +1800,11 @@ type metadataDescribeLoadBalancersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeLoadBalancersInput.
+func (s DescribeLoadBalancersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the DescribeLoadBalancers action.
 type DescribeLoadBalancersOutput struct {
 	// A list of load balancer description structures.
@@ -1595,10 +1821,15 @@ type metadataDescribeLoadBalancersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeLoadBalancersOutput.
+func (s DescribeLoadBalancersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the DescribeTags action.
 type DescribeTagsInput struct {
 	// The names of the load balancers.
-	LoadBalancerNames []*string `type:"list" required:"true"`
+	LoadBalancerNames []*string `type:"list" min:"1" max:"20" required:"true"`
 
 	metadataDescribeTagsInput `json:"-", xml:"-"`
 }
@@ -1607,6 +1838,11 @@ type metadataDescribeTagsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeTagsInput.
+func (s DescribeTagsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the DescribeTags action.
 type DescribeTagsOutput struct {
 	// A list of tag description structures.
@@ -1619,6 +1855,11 @@ type metadataDescribeTagsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeTagsOutput.
+func (s DescribeTagsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the DetachLoadBalancerFromSubnets action.
 type DetachLoadBalancerFromSubnetsInput struct {
 	// The name associated with the load balancer to be detached.
@@ -1635,6 +1876,11 @@ type metadataDetachLoadBalancerFromSubnetsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DetachLoadBalancerFromSubnetsInput.
+func (s DetachLoadBalancerFromSubnetsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the DetachLoadBalancerFromSubnets action.
 type DetachLoadBalancerFromSubnetsOutput struct {
 	// A list of subnet IDs the load balancer is now attached to.
@@ -1647,6 +1893,11 @@ type metadataDetachLoadBalancerFromSubnetsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DetachLoadBalancerFromSubnetsOutput.
+func (s DetachLoadBalancerFromSubnetsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the DisableAvailabilityZonesForLoadBalancer action.
 type DisableAvailabilityZonesForLoadBalancerInput struct {
 	// A list of Availability Zones to be removed from the load balancer.
@@ -1665,6 +1916,11 @@ type metadataDisableAvailabilityZonesForLoadBalancerInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DisableAvailabilityZonesForLoadBalancerInput.
+func (s DisableAvailabilityZonesForLoadBalancerInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the DisableAvailabilityZonesForLoadBalancer action.
 type DisableAvailabilityZonesForLoadBalancerOutput struct {
 	// A list of updated Availability Zones for the load balancer.
@@ -1677,6 +1933,11 @@ type metadataDisableAvailabilityZonesForLoadBalancerOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DisableAvailabilityZonesForLoadBalancerOutput.
+func (s DisableAvailabilityZonesForLoadBalancerOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the EnableAvailabilityZonesForLoadBalancer action.
 type EnableAvailabilityZonesForLoadBalancerInput struct {
 	// A list of new Availability Zones for the load balancer. Each Availability
@@ -1693,6 +1954,11 @@ type metadataEnableAvailabilityZonesForLoadBalancerInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EnableAvailabilityZonesForLoadBalancerInput.
+func (s EnableAvailabilityZonesForLoadBalancerInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the EnableAvailabilityZonesForLoadBalancer action.
 type EnableAvailabilityZonesForLoadBalancerOutput struct {
 	// An updated list of Availability Zones for the load balancer.
@@ -1705,15 +1971,20 @@ type metadataEnableAvailabilityZonesForLoadBalancerOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EnableAvailabilityZonesForLoadBalancerOutput.
+func (s EnableAvailabilityZonesForLoadBalancerOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The HealthCheck data type.
 type HealthCheck struct {
 	// Specifies the number of consecutive health probe successes required before
 	// moving the instance to the Healthy state.
-	HealthyThreshold *int64 `type:"integer" required:"true"`
+	HealthyThreshold *int64 `type:"integer" min:"2" max:"10" required:"true"`
 
 	// Specifies the approximate interval, in seconds, between health checks of
 	// an individual instance.
-	Interval *int64 `type:"integer" required:"true"`
+	Interval *int64 `type:"integer" min:"1" max:"300" required:"true"`
 
 	// Specifies the instance being checked. The protocol is either TCP, HTTP, HTTPS,
 	// or SSL. The range of valid ports is one (1) through 65535.
@@ -1739,11 +2010,11 @@ type HealthCheck struct {
 	// a failed health probe.
 	//
 	//  This value must be less than the Interval value.
-	Timeout *int64 `type:"integer" required:"true"`
+	Timeout *int64 `type:"integer" min:"1" max:"300" required:"true"`
 
 	// Specifies the number of consecutive health probe failures required before
 	// moving the instance to the Unhealthy state.
-	UnhealthyThreshold *int64 `type:"integer" required:"true"`
+	UnhealthyThreshold *int64 `type:"integer" min:"2" max:"10" required:"true"`
 
 	metadataHealthCheck `json:"-", xml:"-"`
 }
@@ -1752,6 +2023,11 @@ type metadataHealthCheck struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the HealthCheck.
+func (s HealthCheck) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The Instance data type.
 type Instance struct {
 	// Provides an EC2 instance ID.
@@ -1764,6 +2040,11 @@ type metadataInstance struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Instance.
+func (s Instance) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The InstanceState data type.
 type InstanceState struct {
 	// Provides a description of the instance state.
@@ -1791,6 +2072,11 @@ type metadataInstanceState struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InstanceState.
+func (s InstanceState) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The LBCookieStickinessPolicy data type.
 type LBCookieStickinessPolicy struct {
 	// The time period in seconds after which the cookie should be considered stale.
@@ -1809,11 +2095,16 @@ type metadataLBCookieStickinessPolicy struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the LBCookieStickinessPolicy.
+func (s LBCookieStickinessPolicy) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The Listener data type.
 type Listener struct {
 	// Specifies the TCP port on which the instance server is listening. This property
 	// cannot be modified for the life of the load balancer.
-	InstancePort *int64 `type:"integer" required:"true"`
+	InstancePort *int64 `type:"integer" min:"1" max:"65535" required:"true"`
 
 	// Specifies the protocol to use for routing traffic to back-end instances -
 	// HTTP, HTTPS, TCP, or SSL. This property cannot be modified for the life of
@@ -1850,6 +2141,11 @@ type metadataListener struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Listener.
+func (s Listener) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The ListenerDescription data type.
 type ListenerDescription struct {
 	// The Listener data type.
@@ -1866,6 +2162,11 @@ type metadataListenerDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListenerDescription.
+func (s ListenerDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The LoadBalancerAttributes data type.
 type LoadBalancerAttributes struct {
 	// The name of the load balancer attribute. If enabled, the load balancer captures
@@ -1909,6 +2210,11 @@ type metadataLoadBalancerAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the LoadBalancerAttributes.
+func (s LoadBalancerAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the result of a successful invocation of DescribeLoadBalancers.
 type LoadBalancerDescription struct {
 	// Specifies a list of Availability Zones.
@@ -1988,6 +2294,11 @@ type metadataLoadBalancerDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the LoadBalancerDescription.
+func (s LoadBalancerDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the ModifyLoadBalancerAttributes action.
 type ModifyLoadBalancerAttributesInput struct {
 	// Attributes of the load balancer.
@@ -2003,6 +2314,11 @@ type metadataModifyLoadBalancerAttributesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyLoadBalancerAttributesInput.
+func (s ModifyLoadBalancerAttributesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the ModifyLoadBalancerAttributes action.
 type ModifyLoadBalancerAttributesOutput struct {
 	// The LoadBalancerAttributes data type.
@@ -2018,6 +2334,11 @@ type metadataModifyLoadBalancerAttributesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyLoadBalancerAttributesOutput.
+func (s ModifyLoadBalancerAttributesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The policies data type.
 type Policies struct {
 	// A list of the AppCookieStickinessPolicy objects created with CreateAppCookieStickinessPolicy.
@@ -2036,6 +2357,11 @@ type metadataPolicies struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Policies.
+func (s Policies) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The PolicyAttribute data type. This data type contains a key/value pair that
 // defines properties of a specific policy.
 type PolicyAttribute struct {
@@ -2052,6 +2378,11 @@ type metadataPolicyAttribute struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PolicyAttribute.
+func (s PolicyAttribute) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The PolicyAttributeDescription data type. This data type is used to describe
 // the attributes and values associated with a policy.
 type PolicyAttributeDescription struct {
@@ -2068,6 +2399,11 @@ type metadataPolicyAttributeDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PolicyAttributeDescription.
+func (s PolicyAttributeDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The PolicyAttributeTypeDescription data type. This data type is used to describe
 // values that are acceptable for the policy attribute.
 type PolicyAttributeTypeDescription struct {
@@ -2096,6 +2432,11 @@ type metadataPolicyAttributeTypeDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PolicyAttributeTypeDescription.
+func (s PolicyAttributeTypeDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The PolicyDescription data type.
 type PolicyDescription struct {
 	// A list of policy attribute description structures.
@@ -2114,6 +2455,11 @@ type metadataPolicyDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PolicyDescription.
+func (s PolicyDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The PolicyTypeDescription data type.
 type PolicyTypeDescription struct {
 	// A human-readable description of the policy type.
@@ -2133,6 +2479,11 @@ type metadataPolicyTypeDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PolicyTypeDescription.
+func (s PolicyTypeDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the RegisterInstancesWithLoadBalancer action.
 type RegisterInstancesWithLoadBalancerInput struct {
 	// A list of instance IDs that should be registered with the load balancer.
@@ -2149,6 +2500,11 @@ type metadataRegisterInstancesWithLoadBalancerInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RegisterInstancesWithLoadBalancerInput.
+func (s RegisterInstancesWithLoadBalancerInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the RegisterInstancesWithLoadBalancer action.
 type RegisterInstancesWithLoadBalancerOutput struct {
 	// An updated list of instances for the load balancer.
@@ -2161,6 +2517,11 @@ type metadataRegisterInstancesWithLoadBalancerOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RegisterInstancesWithLoadBalancerOutput.
+func (s RegisterInstancesWithLoadBalancerOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the RemoveTags action.
 type RemoveTagsInput struct {
 	// The name of the load balancer. You can specify a maximum of one load balancer
@@ -2168,7 +2529,7 @@ type RemoveTagsInput struct {
 	LoadBalancerNames []*string `type:"list" required:"true"`
 
 	// A list of tag keys to remove.
-	Tags []*TagKeyOnly `type:"list" required:"true"`
+	Tags []*TagKeyOnly `type:"list" min:"1" required:"true"`
 
 	metadataRemoveTagsInput `json:"-", xml:"-"`
 }
@@ -2177,6 +2538,11 @@ type metadataRemoveTagsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RemoveTagsInput.
+func (s RemoveTagsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the RemoveTags action.
 type RemoveTagsOutput struct {
 	metadataRemoveTagsOutput `json:"-", xml:"-"`
@@ -2186,6 +2552,11 @@ type metadataRemoveTagsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RemoveTagsOutput.
+func (s RemoveTagsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the SetLoadBalancerListenerSSLCertificate action.
 type SetLoadBalancerListenerSSLCertificateInput struct {
 	// The name of the load balancer.
@@ -2206,6 +2577,11 @@ type metadataSetLoadBalancerListenerSSLCertificateInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetLoadBalancerListenerSSLCertificateInput.
+func (s SetLoadBalancerListenerSSLCertificateInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the SetLoadBalancerListenerSSLCertificate action.
 type SetLoadBalancerListenerSSLCertificateOutput struct {
 	metadataSetLoadBalancerListenerSSLCertificateOutput `json:"-", xml:"-"`
@@ -2215,6 +2591,11 @@ type metadataSetLoadBalancerListenerSSLCertificateOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetLoadBalancerListenerSSLCertificateOutput.
+func (s SetLoadBalancerListenerSSLCertificateOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the SetLoadBalancerPoliciesForBackendServer action.
 type SetLoadBalancerPoliciesForBackendServerInput struct {
 	// The port number associated with the back-end server.
@@ -2235,6 +2616,11 @@ type metadataSetLoadBalancerPoliciesForBackendServerInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetLoadBalancerPoliciesForBackendServerInput.
+func (s SetLoadBalancerPoliciesForBackendServerInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the SetLoadBalancerPoliciesForBackendServer action.
 type SetLoadBalancerPoliciesForBackendServerOutput struct {
 	metadataSetLoadBalancerPoliciesForBackendServerOutput `json:"-", xml:"-"`
@@ -2244,6 +2630,11 @@ type metadataSetLoadBalancerPoliciesForBackendServerOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetLoadBalancerPoliciesForBackendServerOutput.
+func (s SetLoadBalancerPoliciesForBackendServerOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the SetLoadBalancerPoliciesOfListener action.
 type SetLoadBalancerPoliciesOfListenerInput struct {
 	// The name of the load balancer.
@@ -2263,6 +2654,11 @@ type metadataSetLoadBalancerPoliciesOfListenerInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetLoadBalancerPoliciesOfListenerInput.
+func (s SetLoadBalancerPoliciesOfListenerInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the SetLoadBalancerPoliciesOfListener action.
 type SetLoadBalancerPoliciesOfListenerOutput struct {
 	metadataSetLoadBalancerPoliciesOfListenerOutput `json:"-", xml:"-"`
@@ -2272,6 +2668,11 @@ type metadataSetLoadBalancerPoliciesOfListenerOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetLoadBalancerPoliciesOfListenerOutput.
+func (s SetLoadBalancerPoliciesOfListenerOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This data type is used as a response element in the DescribeLoadBalancers
 // action. For information about Elastic Load Balancing security groups, go
 // to Using Security Groups With Elastic Load Balancing (http://docs.aws.amazon.com/ElasticLoadBalancing/latest/DeveloperGuide/elb-security-features.html#using-elb-security-groups)
@@ -2292,16 +2693,21 @@ type metadataSourceSecurityGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SourceSecurityGroup.
+func (s SourceSecurityGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Metadata assigned to a load balancer consisting of key-value pair.
 //
 // For more information, see Tagging (http://docs.aws.amazon.com/ElasticLoadBalancing/latest/DeveloperGuide/TerminologyandKeyConcepts.html#tagging-elb)
 // in the Elastic Load Balancing Developer Guide.
 type Tag struct {
 	// The key of the tag.
-	Key *string `type:"string" required:"true"`
+	Key *string `type:"string" min:"1" max:"128" pattern:"^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$" required:"true"`
 
 	// The value of the tag.
-	Value *string `type:"string"`
+	Value *string `type:"string" max:"256" pattern:"^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$"`
 
 	metadataTag `json:"-", xml:"-"`
 }
@@ -2310,13 +2716,18 @@ type metadataTag struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Tag.
+func (s Tag) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The descriptions of all the tags associated with load balancer.
 type TagDescription struct {
 	// The name of the load balancer.
 	LoadBalancerName *string `type:"string"`
 
 	// List of tags associated with the load balancer.
-	Tags []*Tag `type:"list"`
+	Tags []*Tag `type:"list" min:"1"`
 
 	metadataTagDescription `json:"-", xml:"-"`
 }
@@ -2325,14 +2736,24 @@ type metadataTagDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TagDescription.
+func (s TagDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The key of a tag to be removed.
 type TagKeyOnly struct {
 	// The name of the key.
-	Key *string `type:"string"`
+	Key *string `type:"string" min:"1" max:"128" pattern:"^([\\p{L}\\p{Z}\\p{N}_.:/=+\\-@]*)$"`
 
 	metadataTagKeyOnly `json:"-", xml:"-"`
 }
 
 type metadataTagKeyOnly struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the TagKeyOnly.
+func (s TagKeyOnly) String() string {
+	return awsutil.StringValue(s)
 }
\ No newline at end of file
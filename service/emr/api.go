@@ -4,9 +4,11 @@
 package emr
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // AddInstanceGroupsRequest generates a request for the AddInstanceGroups operation.
@@ -216,6 +218,10 @@ func (c *EMR) ListBootstrapActionsRequest(input *ListBootstrapActionsInput) (req
 			Name:       "ListBootstrapActions",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+			},
 		}
 	}
 
@@ -233,6 +239,16 @@ func (c *EMR) ListBootstrapActions(input *ListBootstrapActionsInput) (output *Li
 	return
 }
 
+// ListBootstrapActionsPages iterates over the pages of a ListBootstrapActions operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *EMR) ListBootstrapActionsPages(input *ListBootstrapActionsInput, fn func(p *ListBootstrapActionsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListBootstrapActionsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListBootstrapActionsOutput), lastPage)
+	})
+}
+
 var opListBootstrapActions *aws.Operation
 
 // ListClustersRequest generates a request for the ListClusters operation.
@@ -242,6 +258,10 @@ func (c *EMR) ListClustersRequest(input *ListClustersInput) (req *aws.Request, o
 			Name:       "ListClusters",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+			},
 		}
 	}
 
@@ -263,6 +283,16 @@ func (c *EMR) ListClusters(input *ListClustersInput) (output *ListClustersOutput
 	return
 }
 
+// ListClustersPages iterates over the pages of a ListClusters operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *EMR) ListClustersPages(input *ListClustersInput, fn func(p *ListClustersOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListClustersRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListClustersOutput), lastPage)
+	})
+}
+
 var opListClusters *aws.Operation
 
 // ListInstanceGroupsRequest generates a request for the ListInstanceGroups operation.
@@ -272,6 +302,10 @@ func (c *EMR) ListInstanceGroupsRequest(input *ListInstanceGroupsInput) (req *aw
 			Name:       "ListInstanceGroups",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+			},
 		}
 	}
 
@@ -289,6 +323,16 @@ func (c *EMR) ListInstanceGroups(input *ListInstanceGroupsInput) (output *ListIn
 	return
 }
 
+// ListInstanceGroupsPages iterates over the pages of a ListInstanceGroups operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *EMR) ListInstanceGroupsPages(input *ListInstanceGroupsInput, fn func(p *ListInstanceGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListInstanceGroupsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListInstanceGroupsOutput), lastPage)
+	})
+}
+
 var opListInstanceGroups *aws.Operation
 
 // ListInstancesRequest generates a request for the ListInstances operation.
@@ -298,6 +342,10 @@ func (c *EMR) ListInstancesRequest(input *ListInstancesInput) (req *aws.Request,
 			Name:       "ListInstances",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+			},
 		}
 	}
 
@@ -319,6 +367,16 @@ func (c *EMR) ListInstances(input *ListInstancesInput) (output *ListInstancesOut
 	return
 }
 
+// ListInstancesPages iterates over the pages of a ListInstances operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *EMR) ListInstancesPages(input *ListInstancesInput, fn func(p *ListInstancesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListInstancesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListInstancesOutput), lastPage)
+	})
+}
+
 var opListInstances *aws.Operation
 
 // ListStepsRequest generates a request for the ListSteps operation.
@@ -328,6 +386,10 @@ func (c *EMR) ListStepsRequest(input *ListStepsInput) (req *aws.Request, output
 			Name:       "ListSteps",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+			},
 		}
 	}
 
@@ -345,6 +407,16 @@ func (c *EMR) ListSteps(input *ListStepsInput) (output *ListStepsOutput, err err
 	return
 }
 
+// ListStepsPages iterates over the pages of a ListSteps operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *EMR) ListStepsPages(input *ListStepsInput, fn func(p *ListStepsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListStepsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListStepsOutput), lastPage)
+	})
+}
+
 var opListSteps *aws.Operation
 
 // ModifyInstanceGroupsRequest generates a request for the ModifyInstanceGroups operation.
@@ -562,13 +634,51 @@ func (c *EMR) TerminateJobFlows(input *TerminateJobFlowsInput) (output *Terminat
 
 var opTerminateJobFlows *aws.Operation
 
+// ActionOnFailure is an enum of the values of ActionOnFailure members.
+type ActionOnFailure string
+
+// The values of ActionOnFailure.
+const (
+	ActionOnFailureTERMINATEJOBFLOW ActionOnFailure = "TERMINATE_JOB_FLOW"
+	ActionOnFailureTERMINATECLUSTER ActionOnFailure = "TERMINATE_CLUSTER"
+	ActionOnFailureCANCELANDWAIT    ActionOnFailure = "CANCEL_AND_WAIT"
+	ActionOnFailureCONTINUE         ActionOnFailure = "CONTINUE"
+)
+
+// ActionOnFailureValues returns the values of ActionOnFailure known to this
+// version of the SDK. The service may accept values added since.
+func ActionOnFailureValues() []ActionOnFailure {
+	return []ActionOnFailure{
+		ActionOnFailure("TERMINATE_JOB_FLOW"),
+		ActionOnFailure("TERMINATE_CLUSTER"),
+		ActionOnFailure("CANCEL_AND_WAIT"),
+		ActionOnFailure("CONTINUE"),
+	}
+}
+
+// String returns the ActionOnFailure as the string of a member.
+func (v ActionOnFailure) String() string {
+	return string(v)
+}
+
+// ParseActionOnFailure returns the ActionOnFailure of str, failing if it is not
+// one of the ActionOnFailureValues.
+func ParseActionOnFailure(str string) (ActionOnFailure, error) {
+	for _, v := range ActionOnFailureValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ActionOnFailure value %q", str)
+}
+
 // Input to an AddInstanceGroups call.
 type AddInstanceGroupsInput struct {
 	// Instance Groups to add.
 	InstanceGroups []*InstanceGroupConfig `type:"list" required:"true"`
 
 	// Job flow in which to add the instance groups.
-	JobFlowID *string `locationName:"JobFlowId" type:"string" required:"true"`
+	JobFlowID *string `locationName:"JobFlowId" type:"string" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	metadataAddInstanceGroupsInput `json:"-", xml:"-"`
 }
@@ -577,13 +687,18 @@ type metadataAddInstanceGroupsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddInstanceGroupsInput.
+func (s AddInstanceGroupsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Output from an AddInstanceGroups call.
 type AddInstanceGroupsOutput struct {
 	// Instance group IDs of the newly created instance groups.
 	InstanceGroupIDs []*string `locationName:"InstanceGroupIds" type:"list"`
 
 	// The job flow ID in which the instance groups are added.
-	JobFlowID *string `locationName:"JobFlowId" type:"string"`
+	JobFlowID *string `locationName:"JobFlowId" type:"string" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataAddInstanceGroupsOutput `json:"-", xml:"-"`
 }
@@ -592,11 +707,16 @@ type metadataAddInstanceGroupsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddInstanceGroupsOutput.
+func (s AddInstanceGroupsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input argument to the AddJobFlowSteps operation.
 type AddJobFlowStepsInput struct {
 	// A string that uniquely identifies the job flow. This identifier is returned
 	// by RunJobFlow and can also be obtained from ListClusters.
-	JobFlowID *string `locationName:"JobFlowId" type:"string" required:"true"`
+	JobFlowID *string `locationName:"JobFlowId" type:"string" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// A list of StepConfig to be executed by the job flow.
 	Steps []*StepConfig `type:"list" required:"true"`
@@ -608,6 +728,11 @@ type metadataAddJobFlowStepsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddJobFlowStepsInput.
+func (s AddJobFlowStepsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the AddJobFlowSteps operation.
 type AddJobFlowStepsOutput struct {
 	// The identifiers of the list of steps added to the job flow.
@@ -620,6 +745,11 @@ type metadataAddJobFlowStepsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddJobFlowStepsOutput.
+func (s AddJobFlowStepsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This input identifies a cluster and a list of tags to attach.
 type AddTagsInput struct {
 	// The Amazon EMR resource identifier to which tags will be added. This value
@@ -639,6 +769,11 @@ type metadataAddTagsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddTagsInput.
+func (s AddTagsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This output indicates the result of adding tags to a resource.
 type AddTagsOutput struct {
 	metadataAddTagsOutput `json:"-", xml:"-"`
@@ -648,6 +783,11 @@ type metadataAddTagsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddTagsOutput.
+func (s AddTagsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // An application is any Amazon or third-party software that you can add to
 // the cluster. This structure contains a list of strings that indicates the
 // software to use with the cluster and accepts a user argument list. Amazon
@@ -681,10 +821,15 @@ type metadataApplication struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Application.
+func (s Application) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Configuration of a bootstrap action.
 type BootstrapActionConfig struct {
 	// The name of the bootstrap action.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The script run by the bootstrap action.
 	ScriptBootstrapAction *ScriptBootstrapActionConfig `type:"structure" required:"true"`
@@ -696,6 +841,11 @@ type metadataBootstrapActionConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the BootstrapActionConfig.
+func (s BootstrapActionConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Reports the configuration of a bootstrap action in a job flow.
 type BootstrapActionDetail struct {
 	// A description of the bootstrap action.
@@ -708,6 +858,11 @@ type metadataBootstrapActionDetail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the BootstrapActionDetail.
+func (s BootstrapActionDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The detailed description of the cluster.
 type Cluster struct {
 	// The applications installed on this cluster.
@@ -777,10 +932,59 @@ type metadataCluster struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Cluster.
+func (s Cluster) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ClusterState is an enum of the values of ClusterState members.
+type ClusterState string
+
+// The values of ClusterState.
+const (
+	ClusterStateSTARTING             ClusterState = "STARTING"
+	ClusterStateBOOTSTRAPPING        ClusterState = "BOOTSTRAPPING"
+	ClusterStateRUNNING              ClusterState = "RUNNING"
+	ClusterStateWAITING              ClusterState = "WAITING"
+	ClusterStateTERMINATING          ClusterState = "TERMINATING"
+	ClusterStateTERMINATED           ClusterState = "TERMINATED"
+	ClusterStateTERMINATEDWITHERRORS ClusterState = "TERMINATED_WITH_ERRORS"
+)
+
+// ClusterStateValues returns the values of ClusterState known to this
+// version of the SDK. The service may accept values added since.
+func ClusterStateValues() []ClusterState {
+	return []ClusterState{
+		ClusterState("STARTING"),
+		ClusterState("BOOTSTRAPPING"),
+		ClusterState("RUNNING"),
+		ClusterState("WAITING"),
+		ClusterState("TERMINATING"),
+		ClusterState("TERMINATED"),
+		ClusterState("TERMINATED_WITH_ERRORS"),
+	}
+}
+
+// String returns the ClusterState as the string of a member.
+func (v ClusterState) String() string {
+	return string(v)
+}
+
+// ParseClusterState returns the ClusterState of str, failing if it is not
+// one of the ClusterStateValues.
+func ParseClusterState(str string) (ClusterState, error) {
+	for _, v := range ClusterStateValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ClusterState value %q", str)
+}
+
 // The reason that the cluster changed to its current state.
 type ClusterStateChangeReason struct {
 	// The programmatic code for the state change reason.
-	Code *string `type:"string"`
+	Code *string `type:"string" enum:"INTERNAL_ERROR,VALIDATION_ERROR,INSTANCE_FAILURE,BOOTSTRAP_FAILURE,USER_REQUEST,STEP_FAILURE,ALL_STEPS_COMPLETED"`
 
 	// The descriptive message for the state change reason.
 	Message *string `type:"string"`
@@ -792,10 +996,59 @@ type metadataClusterStateChangeReason struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ClusterStateChangeReason.
+func (s ClusterStateChangeReason) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ClusterStateChangeReasonCode is an enum of the values of ClusterStateChangeReasonCode members.
+type ClusterStateChangeReasonCode string
+
+// The values of ClusterStateChangeReasonCode.
+const (
+	ClusterStateChangeReasonCodeINTERNALERROR     ClusterStateChangeReasonCode = "INTERNAL_ERROR"
+	ClusterStateChangeReasonCodeVALIDATIONERROR   ClusterStateChangeReasonCode = "VALIDATION_ERROR"
+	ClusterStateChangeReasonCodeINSTANCEFAILURE   ClusterStateChangeReasonCode = "INSTANCE_FAILURE"
+	ClusterStateChangeReasonCodeBOOTSTRAPFAILURE  ClusterStateChangeReasonCode = "BOOTSTRAP_FAILURE"
+	ClusterStateChangeReasonCodeUSERREQUEST       ClusterStateChangeReasonCode = "USER_REQUEST"
+	ClusterStateChangeReasonCodeSTEPFAILURE       ClusterStateChangeReasonCode = "STEP_FAILURE"
+	ClusterStateChangeReasonCodeALLSTEPSCOMPLETED ClusterStateChangeReasonCode = "ALL_STEPS_COMPLETED"
+)
+
+// ClusterStateChangeReasonCodeValues returns the values of ClusterStateChangeReasonCode known to this
+// version of the SDK. The service may accept values added since.
+func ClusterStateChangeReasonCodeValues() []ClusterStateChangeReasonCode {
+	return []ClusterStateChangeReasonCode{
+		ClusterStateChangeReasonCode("INTERNAL_ERROR"),
+		ClusterStateChangeReasonCode("VALIDATION_ERROR"),
+		ClusterStateChangeReasonCode("INSTANCE_FAILURE"),
+		ClusterStateChangeReasonCode("BOOTSTRAP_FAILURE"),
+		ClusterStateChangeReasonCode("USER_REQUEST"),
+		ClusterStateChangeReasonCode("STEP_FAILURE"),
+		ClusterStateChangeReasonCode("ALL_STEPS_COMPLETED"),
+	}
+}
+
+// String returns the ClusterStateChangeReasonCode as the string of a member.
+func (v ClusterStateChangeReasonCode) String() string {
+	return string(v)
+}
+
+// ParseClusterStateChangeReasonCode returns the ClusterStateChangeReasonCode of str, failing if it is not
+// one of the ClusterStateChangeReasonCodeValues.
+func ParseClusterStateChangeReasonCode(str string) (ClusterStateChangeReasonCode, error) {
+	for _, v := range ClusterStateChangeReasonCodeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ClusterStateChangeReasonCode value %q", str)
+}
+
 // The detailed status of the cluster.
 type ClusterStatus struct {
 	// The current state of the cluster.
-	State *string `type:"string"`
+	State *string `type:"string" enum:"STARTING,BOOTSTRAPPING,RUNNING,WAITING,TERMINATING,TERMINATED,TERMINATED_WITH_ERRORS"`
 
 	// The reason for the cluster status change.
 	StateChangeReason *ClusterStateChangeReason `type:"structure"`
@@ -811,6 +1064,11 @@ type metadataClusterStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ClusterStatus.
+func (s ClusterStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The summary description of the cluster.
 type ClusterSummary struct {
 	// The unique identifier for the cluster.
@@ -837,6 +1095,11 @@ type metadataClusterSummary struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ClusterSummary.
+func (s ClusterSummary) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the timeline of the cluster's lifecycle.
 type ClusterTimeline struct {
 	// The creation date and time of the cluster.
@@ -855,6 +1118,11 @@ type metadataClusterTimeline struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ClusterTimeline.
+func (s ClusterTimeline) String() string {
+	return awsutil.StringValue(s)
+}
+
 // An entity describing an executable that runs on a cluster.
 type Command struct {
 	// Arguments for Amazon EMR to pass to the command for execution.
@@ -873,6 +1141,11 @@ type metadataCommand struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Command.
+func (s Command) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This input determines which cluster to describe.
 type DescribeClusterInput struct {
 	// The identifier of the cluster to describe.
@@ -885,6 +1158,11 @@ type metadataDescribeClusterInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeClusterInput.
+func (s DescribeClusterInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This output contains the description of the cluster.
 type DescribeClusterOutput struct {
 	// This output contains the details for the requested cluster.
@@ -897,6 +1175,11 @@ type metadataDescribeClusterOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeClusterOutput.
+func (s DescribeClusterOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for the DescribeJobFlows operation.
 type DescribeJobFlowsInput struct {
 	// Return only job flows created after this date and time.
@@ -918,6 +1201,11 @@ type metadataDescribeJobFlowsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeJobFlowsInput.
+func (s DescribeJobFlowsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The output for the DescribeJobFlows operation.
 type DescribeJobFlowsOutput struct {
 	// A list of job flows matching the parameters supplied.
@@ -930,6 +1218,11 @@ type metadataDescribeJobFlowsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeJobFlowsOutput.
+func (s DescribeJobFlowsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This input determines which step to describe.
 type DescribeStepInput struct {
 	// The identifier of the cluster with steps to describe.
@@ -945,6 +1238,11 @@ type metadataDescribeStepInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeStepInput.
+func (s DescribeStepInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This output contains the description of the cluster step.
 type DescribeStepOutput struct {
 	// The step details for the requested step identifier.
@@ -957,6 +1255,11 @@ type metadataDescribeStepOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeStepOutput.
+func (s DescribeStepOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides information about the EC2 instances in a cluster grouped by category.
 // For example, key name, subnet ID, IAM instance profile, and so on.
 type EC2InstanceAttributes struct {
@@ -1002,6 +1305,11 @@ type metadataEC2InstanceAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EC2InstanceAttributes.
+func (s EC2InstanceAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A job flow step consisting of a JAR file whose main function will be executed.
 // The main function submits a job for Hadoop to execute and waits for the job
 // to finish or fail.
@@ -1011,11 +1319,11 @@ type HadoopJARStepConfig struct {
 	Args []*string `type:"list"`
 
 	// A path to a JAR file run during the step.
-	JAR *string `locationName:"Jar" type:"string" required:"true"`
+	JAR *string `locationName:"Jar" type:"string" max:"10280" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The name of the main class in the specified Java file. If not specified,
 	// the JAR file should specify a Main-Class in its manifest file.
-	MainClass *string `type:"string"`
+	MainClass *string `type:"string" max:"10280" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// A list of Java properties that are set when the step runs. You can use these
 	// properties to pass key value pairs to your main function.
@@ -1028,6 +1336,11 @@ type metadataHadoopJARStepConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the HadoopJARStepConfig.
+func (s HadoopJARStepConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A cluster step consisting of a JAR file whose main function will be executed.
 // The main function submits a job for Hadoop to execute and waits for the job
 // to finish or fail.
@@ -1054,6 +1367,11 @@ type metadataHadoopStepConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the HadoopStepConfig.
+func (s HadoopStepConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents an EC2 instance provisioned as part of cluster.
 type Instance struct {
 	// The unique identifier of the instance in Amazon EC2.
@@ -1084,6 +1402,11 @@ type metadataInstance struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Instance.
+func (s Instance) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This entity represents an instance group, which is a group of instances that
 // have common purpose. For example, CORE instance group is used for HDFS.
 type InstanceGroup struct {
@@ -1095,14 +1418,14 @@ type InstanceGroup struct {
 	ID *string `locationName:"Id" type:"string"`
 
 	// The type of the instance group. Valid values are MASTER, CORE or TASK.
-	InstanceGroupType *string `type:"string"`
+	InstanceGroupType *string `type:"string" enum:"MASTER,CORE,TASK"`
 
 	// The EC2 instance type for all instances in the instance group.
-	InstanceType *string `type:"string"`
+	InstanceType *string `type:"string" min:"1" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The marketplace to provision instances for this group. Valid values are ON_DEMAND
 	// or SPOT.
-	Market *string `type:"string"`
+	Market *string `type:"string" enum:"ON_DEMAND,SPOT"`
 
 	// The name of the instance group.
 	Name *string `type:"string"`
@@ -1123,26 +1446,31 @@ type metadataInstanceGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InstanceGroup.
+func (s InstanceGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Configuration defining a new instance group.
 type InstanceGroupConfig struct {
 	// Bid price for each Amazon EC2 instance in the instance group when launching
 	// nodes as Spot Instances, expressed in USD.
-	BidPrice *string `type:"string"`
+	BidPrice *string `type:"string" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// Target number of instances for the instance group.
 	InstanceCount *int64 `type:"integer" required:"true"`
 
 	// The role of the instance group in the cluster.
-	InstanceRole *string `type:"string" required:"true"`
+	InstanceRole *string `type:"string" enum:"MASTER,CORE,TASK" required:"true"`
 
 	// The Amazon EC2 instance type for all instances in the instance group.
-	InstanceType *string `type:"string" required:"true"`
+	InstanceType *string `type:"string" min:"1" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// Market type of the Amazon EC2 instances used to create a cluster node.
-	Market *string `type:"string"`
+	Market *string `type:"string" enum:"ON_DEMAND,SPOT"`
 
 	// Friendly name given to the instance group.
-	Name *string `type:"string"`
+	Name *string `type:"string" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataInstanceGroupConfig `json:"-", xml:"-"`
 }
@@ -1151,11 +1479,16 @@ type metadataInstanceGroupConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InstanceGroupConfig.
+func (s InstanceGroupConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Detailed information about an instance group.
 type InstanceGroupDetail struct {
 	// Bid price for EC2 Instances when launching nodes as Spot Instances, expressed
 	// in USD.
-	BidPrice *string `type:"string"`
+	BidPrice *string `type:"string" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The date/time the instance group was created.
 	CreationDateTime *time.Time `type:"timestamp" timestampFormat:"unix" required:"true"`
@@ -1164,28 +1497,28 @@ type InstanceGroupDetail struct {
 	EndDateTime *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// Unique identifier for the instance group.
-	InstanceGroupID *string `locationName:"InstanceGroupId" type:"string"`
+	InstanceGroupID *string `locationName:"InstanceGroupId" type:"string" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// Target number of instances to run in the instance group.
 	InstanceRequestCount *int64 `type:"integer" required:"true"`
 
 	// Instance group role in the cluster
-	InstanceRole *string `type:"string" required:"true"`
+	InstanceRole *string `type:"string" enum:"MASTER,CORE,TASK" required:"true"`
 
 	// Actual count of running instances.
 	InstanceRunningCount *int64 `type:"integer" required:"true"`
 
 	// Amazon EC2 Instance type.
-	InstanceType *string `type:"string" required:"true"`
+	InstanceType *string `type:"string" min:"1" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// Details regarding the state of the instance group.
-	LastStateChangeReason *string `type:"string"`
+	LastStateChangeReason *string `type:"string" max:"10280" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// Market type of the Amazon EC2 instances used to create a cluster node.
-	Market *string `type:"string" required:"true"`
+	Market *string `type:"string" enum:"ON_DEMAND,SPOT" required:"true"`
 
 	// Friendly name for the instance group.
-	Name *string `type:"string"`
+	Name *string `type:"string" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The date/time the instance group was available to the cluster.
 	ReadyDateTime *time.Time `type:"timestamp" timestampFormat:"unix"`
@@ -1195,7 +1528,7 @@ type InstanceGroupDetail struct {
 
 	// State of instance group. The following values are deprecated: STARTING, TERMINATED,
 	// and FAILED.
-	State *string `type:"string" required:"true"`
+	State *string `type:"string" enum:"PROVISIONING,BOOTSTRAPPING,RUNNING,RESIZING,SUSPENDED,TERMINATING,TERMINATED,ARRESTED,SHUTTING_DOWN,ENDED" required:"true"`
 
 	metadataInstanceGroupDetail `json:"-", xml:"-"`
 }
@@ -1204,6 +1537,11 @@ type metadataInstanceGroupDetail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InstanceGroupDetail.
+func (s InstanceGroupDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Modify an instance group size.
 type InstanceGroupModifyConfig struct {
 	// The EC2 InstanceIds to terminate. For advanced users only. Once you terminate
@@ -1215,7 +1553,7 @@ type InstanceGroupModifyConfig struct {
 	InstanceCount *int64 `type:"integer"`
 
 	// Unique ID of the instance group to expand or shrink.
-	InstanceGroupID *string `locationName:"InstanceGroupId" type:"string" required:"true"`
+	InstanceGroupID *string `locationName:"InstanceGroupId" type:"string" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	metadataInstanceGroupModifyConfig `json:"-", xml:"-"`
 }
@@ -1224,10 +1562,65 @@ type metadataInstanceGroupModifyConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InstanceGroupModifyConfig.
+func (s InstanceGroupModifyConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
+// InstanceGroupState is an enum of the values of InstanceGroupState members.
+type InstanceGroupState string
+
+// The values of InstanceGroupState.
+const (
+	InstanceGroupStatePROVISIONING  InstanceGroupState = "PROVISIONING"
+	InstanceGroupStateBOOTSTRAPPING InstanceGroupState = "BOOTSTRAPPING"
+	InstanceGroupStateRUNNING       InstanceGroupState = "RUNNING"
+	InstanceGroupStateRESIZING      InstanceGroupState = "RESIZING"
+	InstanceGroupStateSUSPENDED     InstanceGroupState = "SUSPENDED"
+	InstanceGroupStateTERMINATING   InstanceGroupState = "TERMINATING"
+	InstanceGroupStateTERMINATED    InstanceGroupState = "TERMINATED"
+	InstanceGroupStateARRESTED      InstanceGroupState = "ARRESTED"
+	InstanceGroupStateSHUTTINGDOWN  InstanceGroupState = "SHUTTING_DOWN"
+	InstanceGroupStateENDED         InstanceGroupState = "ENDED"
+)
+
+// InstanceGroupStateValues returns the values of InstanceGroupState known to this
+// version of the SDK. The service may accept values added since.
+func InstanceGroupStateValues() []InstanceGroupState {
+	return []InstanceGroupState{
+		InstanceGroupState("PROVISIONING"),
+		InstanceGroupState("BOOTSTRAPPING"),
+		InstanceGroupState("RUNNING"),
+		InstanceGroupState("RESIZING"),
+		InstanceGroupState("SUSPENDED"),
+		InstanceGroupState("TERMINATING"),
+		InstanceGroupState("TERMINATED"),
+		InstanceGroupState("ARRESTED"),
+		InstanceGroupState("SHUTTING_DOWN"),
+		InstanceGroupState("ENDED"),
+	}
+}
+
+// String returns the InstanceGroupState as the string of a member.
+func (v InstanceGroupState) String() string {
+	return string(v)
+}
+
+// ParseInstanceGroupState returns the InstanceGroupState of str, failing if it is not
+// one of the InstanceGroupStateValues.
+func ParseInstanceGroupState(str string) (InstanceGroupState, error) {
+	for _, v := range InstanceGroupStateValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid InstanceGroupState value %q", str)
+}
+
 // The status change reason details for the instance group.
 type InstanceGroupStateChangeReason struct {
 	// The programmable code for the state change reason.
-	Code *string `type:"string"`
+	Code *string `type:"string" enum:"INTERNAL_ERROR,VALIDATION_ERROR,INSTANCE_FAILURE,CLUSTER_TERMINATED"`
 
 	// The status change reason description.
 	Message *string `type:"string"`
@@ -1239,10 +1632,53 @@ type metadataInstanceGroupStateChangeReason struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InstanceGroupStateChangeReason.
+func (s InstanceGroupStateChangeReason) String() string {
+	return awsutil.StringValue(s)
+}
+
+// InstanceGroupStateChangeReasonCode is an enum of the values of InstanceGroupStateChangeReasonCode members.
+type InstanceGroupStateChangeReasonCode string
+
+// The values of InstanceGroupStateChangeReasonCode.
+const (
+	InstanceGroupStateChangeReasonCodeINTERNALERROR     InstanceGroupStateChangeReasonCode = "INTERNAL_ERROR"
+	InstanceGroupStateChangeReasonCodeVALIDATIONERROR   InstanceGroupStateChangeReasonCode = "VALIDATION_ERROR"
+	InstanceGroupStateChangeReasonCodeINSTANCEFAILURE   InstanceGroupStateChangeReasonCode = "INSTANCE_FAILURE"
+	InstanceGroupStateChangeReasonCodeCLUSTERTERMINATED InstanceGroupStateChangeReasonCode = "CLUSTER_TERMINATED"
+)
+
+// InstanceGroupStateChangeReasonCodeValues returns the values of InstanceGroupStateChangeReasonCode known to this
+// version of the SDK. The service may accept values added since.
+func InstanceGroupStateChangeReasonCodeValues() []InstanceGroupStateChangeReasonCode {
+	return []InstanceGroupStateChangeReasonCode{
+		InstanceGroupStateChangeReasonCode("INTERNAL_ERROR"),
+		InstanceGroupStateChangeReasonCode("VALIDATION_ERROR"),
+		InstanceGroupStateChangeReasonCode("INSTANCE_FAILURE"),
+		InstanceGroupStateChangeReasonCode("CLUSTER_TERMINATED"),
+	}
+}
+
+// String returns the InstanceGroupStateChangeReasonCode as the string of a member.
+func (v InstanceGroupStateChangeReasonCode) String() string {
+	return string(v)
+}
+
+// ParseInstanceGroupStateChangeReasonCode returns the InstanceGroupStateChangeReasonCode of str, failing if it is not
+// one of the InstanceGroupStateChangeReasonCodeValues.
+func ParseInstanceGroupStateChangeReasonCode(str string) (InstanceGroupStateChangeReasonCode, error) {
+	for _, v := range InstanceGroupStateChangeReasonCodeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid InstanceGroupStateChangeReasonCode value %q", str)
+}
+
 // The details of the instance group status.
 type InstanceGroupStatus struct {
 	// The current state of the instance group.
-	State *string `type:"string"`
+	State *string `type:"string" enum:"PROVISIONING,BOOTSTRAPPING,RUNNING,RESIZING,SUSPENDED,TERMINATING,TERMINATED,ARRESTED,SHUTTING_DOWN,ENDED"`
 
 	// The status change reason details for the instance group.
 	StateChangeReason *InstanceGroupStateChangeReason `type:"structure"`
@@ -1257,6 +1693,11 @@ type metadataInstanceGroupStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InstanceGroupStatus.
+func (s InstanceGroupStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The timeline of the instance group lifecycle.
 type InstanceGroupTimeline struct {
 	// The creation date and time of the instance group.
@@ -1275,10 +1716,127 @@ type metadataInstanceGroupTimeline struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InstanceGroupTimeline.
+func (s InstanceGroupTimeline) String() string {
+	return awsutil.StringValue(s)
+}
+
+// InstanceGroupType is an enum of the values of InstanceGroupType members.
+type InstanceGroupType string
+
+// The values of InstanceGroupType.
+const (
+	InstanceGroupTypeMASTER InstanceGroupType = "MASTER"
+	InstanceGroupTypeCORE   InstanceGroupType = "CORE"
+	InstanceGroupTypeTASK   InstanceGroupType = "TASK"
+)
+
+// InstanceGroupTypeValues returns the values of InstanceGroupType known to this
+// version of the SDK. The service may accept values added since.
+func InstanceGroupTypeValues() []InstanceGroupType {
+	return []InstanceGroupType{
+		InstanceGroupType("MASTER"),
+		InstanceGroupType("CORE"),
+		InstanceGroupType("TASK"),
+	}
+}
+
+// String returns the InstanceGroupType as the string of a member.
+func (v InstanceGroupType) String() string {
+	return string(v)
+}
+
+// ParseInstanceGroupType returns the InstanceGroupType of str, failing if it is not
+// one of the InstanceGroupTypeValues.
+func ParseInstanceGroupType(str string) (InstanceGroupType, error) {
+	for _, v := range InstanceGroupTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid InstanceGroupType value %q", str)
+}
+
+// InstanceRoleType is an enum of the values of InstanceRoleType members.
+type InstanceRoleType string
+
+// The values of InstanceRoleType.
+const (
+	InstanceRoleTypeMASTER InstanceRoleType = "MASTER"
+	InstanceRoleTypeCORE   InstanceRoleType = "CORE"
+	InstanceRoleTypeTASK   InstanceRoleType = "TASK"
+)
+
+// InstanceRoleTypeValues returns the values of InstanceRoleType known to this
+// version of the SDK. The service may accept values added since.
+func InstanceRoleTypeValues() []InstanceRoleType {
+	return []InstanceRoleType{
+		InstanceRoleType("MASTER"),
+		InstanceRoleType("CORE"),
+		InstanceRoleType("TASK"),
+	}
+}
+
+// String returns the InstanceRoleType as the string of a member.
+func (v InstanceRoleType) String() string {
+	return string(v)
+}
+
+// ParseInstanceRoleType returns the InstanceRoleType of str, failing if it is not
+// one of the InstanceRoleTypeValues.
+func ParseInstanceRoleType(str string) (InstanceRoleType, error) {
+	for _, v := range InstanceRoleTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid InstanceRoleType value %q", str)
+}
+
+// InstanceState is an enum of the values of InstanceState members.
+type InstanceState string
+
+// The values of InstanceState.
+const (
+	InstanceStateAWAITINGFULFILLMENT InstanceState = "AWAITING_FULFILLMENT"
+	InstanceStatePROVISIONING        InstanceState = "PROVISIONING"
+	InstanceStateBOOTSTRAPPING       InstanceState = "BOOTSTRAPPING"
+	InstanceStateRUNNING             InstanceState = "RUNNING"
+	InstanceStateTERMINATED          InstanceState = "TERMINATED"
+)
+
+// InstanceStateValues returns the values of InstanceState known to this
+// version of the SDK. The service may accept values added since.
+func InstanceStateValues() []InstanceState {
+	return []InstanceState{
+		InstanceState("AWAITING_FULFILLMENT"),
+		InstanceState("PROVISIONING"),
+		InstanceState("BOOTSTRAPPING"),
+		InstanceState("RUNNING"),
+		InstanceState("TERMINATED"),
+	}
+}
+
+// String returns the InstanceState as the string of a member.
+func (v InstanceState) String() string {
+	return string(v)
+}
+
+// ParseInstanceState returns the InstanceState of str, failing if it is not
+// one of the InstanceStateValues.
+func ParseInstanceState(str string) (InstanceState, error) {
+	for _, v := range InstanceStateValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid InstanceState value %q", str)
+}
+
 // The details of the status change reason for the instance.
 type InstanceStateChangeReason struct {
 	// The programmable code for the state change reason.
-	Code *string `type:"string"`
+	Code *string `type:"string" enum:"INTERNAL_ERROR,VALIDATION_ERROR,INSTANCE_FAILURE,BOOTSTRAP_FAILURE,CLUSTER_TERMINATED"`
 
 	// The status change reason description.
 	Message *string `type:"string"`
@@ -1290,10 +1848,55 @@ type metadataInstanceStateChangeReason struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InstanceStateChangeReason.
+func (s InstanceStateChangeReason) String() string {
+	return awsutil.StringValue(s)
+}
+
+// InstanceStateChangeReasonCode is an enum of the values of InstanceStateChangeReasonCode members.
+type InstanceStateChangeReasonCode string
+
+// The values of InstanceStateChangeReasonCode.
+const (
+	InstanceStateChangeReasonCodeINTERNALERROR     InstanceStateChangeReasonCode = "INTERNAL_ERROR"
+	InstanceStateChangeReasonCodeVALIDATIONERROR   InstanceStateChangeReasonCode = "VALIDATION_ERROR"
+	InstanceStateChangeReasonCodeINSTANCEFAILURE   InstanceStateChangeReasonCode = "INSTANCE_FAILURE"
+	InstanceStateChangeReasonCodeBOOTSTRAPFAILURE  InstanceStateChangeReasonCode = "BOOTSTRAP_FAILURE"
+	InstanceStateChangeReasonCodeCLUSTERTERMINATED InstanceStateChangeReasonCode = "CLUSTER_TERMINATED"
+)
+
+// InstanceStateChangeReasonCodeValues returns the values of InstanceStateChangeReasonCode known to this
+// version of the SDK. The service may accept values added since.
+func InstanceStateChangeReasonCodeValues() []InstanceStateChangeReasonCode {
+	return []InstanceStateChangeReasonCode{
+		InstanceStateChangeReasonCode("INTERNAL_ERROR"),
+		InstanceStateChangeReasonCode("VALIDATION_ERROR"),
+		InstanceStateChangeReasonCode("INSTANCE_FAILURE"),
+		InstanceStateChangeReasonCode("BOOTSTRAP_FAILURE"),
+		InstanceStateChangeReasonCode("CLUSTER_TERMINATED"),
+	}
+}
+
+// String returns the InstanceStateChangeReasonCode as the string of a member.
+func (v InstanceStateChangeReasonCode) String() string {
+	return string(v)
+}
+
+// ParseInstanceStateChangeReasonCode returns the InstanceStateChangeReasonCode of str, failing if it is not
+// one of the InstanceStateChangeReasonCodeValues.
+func ParseInstanceStateChangeReasonCode(str string) (InstanceStateChangeReasonCode, error) {
+	for _, v := range InstanceStateChangeReasonCodeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid InstanceStateChangeReasonCode value %q", str)
+}
+
 // The instance status details.
 type InstanceStatus struct {
 	// The current state of the instance.
-	State *string `type:"string"`
+	State *string `type:"string" enum:"AWAITING_FULFILLMENT,PROVISIONING,BOOTSTRAPPING,RUNNING,TERMINATED"`
 
 	// The details of the status change reason for the instance.
 	StateChangeReason *InstanceStateChangeReason `type:"structure"`
@@ -1308,6 +1911,11 @@ type metadataInstanceStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InstanceStatus.
+func (s InstanceStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The timeline of the instance lifecycle.
 type InstanceTimeline struct {
 	// The creation date and time of the instance.
@@ -1326,13 +1934,18 @@ type metadataInstanceTimeline struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InstanceTimeline.
+func (s InstanceTimeline) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A description of a job flow.
 type JobFlowDetail struct {
 	// The version of the AMI used to initialize Amazon EC2 instances in the job
 	// flow. For a list of AMI versions currently supported by Amazon ElasticMapReduce,
 	// go to AMI Versions Supported in Elastic MapReduce (http://docs.aws.amazon.com/ElasticMapReduce/latest/DeveloperGuide/EnvironmentConfig_AMIVersion.html#ami-versions-supported)
 	// in the Amazon Elastic MapReduce Developer's Guide.
-	AMIVersion *string `locationName:"AmiVersion" type:"string"`
+	AMIVersion *string `locationName:"AmiVersion" type:"string" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// A list of the bootstrap actions run by the job flow.
 	BootstrapActions []*BootstrapActionDetail `type:"list"`
@@ -1344,21 +1957,21 @@ type JobFlowDetail struct {
 	Instances *JobFlowInstancesDetail `type:"structure" required:"true"`
 
 	// The job flow identifier.
-	JobFlowID *string `locationName:"JobFlowId" type:"string" required:"true"`
+	JobFlowID *string `locationName:"JobFlowId" type:"string" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The IAM role that was specified when the job flow was launched. The EC2 instances
 	// of the job flow assume this role.
-	JobFlowRole *string `type:"string"`
+	JobFlowRole *string `type:"string" max:"10280" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The location in Amazon S3 where log files for the job are stored.
-	LogURI *string `locationName:"LogUri" type:"string"`
+	LogURI *string `locationName:"LogUri" type:"string" max:"10280" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The name of the job flow.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The IAM role that will be assumed by the Amazon EMR service to access AWS
 	// resources on your behalf.
-	ServiceRole *string `type:"string"`
+	ServiceRole *string `type:"string" max:"10280" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// A list of steps run by the job flow.
 	Steps []*StepDetail `type:"list"`
@@ -1383,6 +1996,57 @@ type metadataJobFlowDetail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the JobFlowDetail.
+func (s JobFlowDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
+// JobFlowExecutionState is an enum of the values of JobFlowExecutionState members.
+type JobFlowExecutionState string
+
+// The values of JobFlowExecutionState.
+const (
+	JobFlowExecutionStateSTARTING      JobFlowExecutionState = "STARTING"
+	JobFlowExecutionStateBOOTSTRAPPING JobFlowExecutionState = "BOOTSTRAPPING"
+	JobFlowExecutionStateRUNNING       JobFlowExecutionState = "RUNNING"
+	JobFlowExecutionStateWAITING       JobFlowExecutionState = "WAITING"
+	JobFlowExecutionStateSHUTTINGDOWN  JobFlowExecutionState = "SHUTTING_DOWN"
+	JobFlowExecutionStateTERMINATED    JobFlowExecutionState = "TERMINATED"
+	JobFlowExecutionStateCOMPLETED     JobFlowExecutionState = "COMPLETED"
+	JobFlowExecutionStateFAILED        JobFlowExecutionState = "FAILED"
+)
+
+// JobFlowExecutionStateValues returns the values of JobFlowExecutionState known to this
+// version of the SDK. The service may accept values added since.
+func JobFlowExecutionStateValues() []JobFlowExecutionState {
+	return []JobFlowExecutionState{
+		JobFlowExecutionState("STARTING"),
+		JobFlowExecutionState("BOOTSTRAPPING"),
+		JobFlowExecutionState("RUNNING"),
+		JobFlowExecutionState("WAITING"),
+		JobFlowExecutionState("SHUTTING_DOWN"),
+		JobFlowExecutionState("TERMINATED"),
+		JobFlowExecutionState("COMPLETED"),
+		JobFlowExecutionState("FAILED"),
+	}
+}
+
+// String returns the JobFlowExecutionState as the string of a member.
+func (v JobFlowExecutionState) String() string {
+	return string(v)
+}
+
+// ParseJobFlowExecutionState returns the JobFlowExecutionState of str, failing if it is not
+// one of the JobFlowExecutionStateValues.
+func ParseJobFlowExecutionState(str string) (JobFlowExecutionState, error) {
+	for _, v := range JobFlowExecutionStateValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid JobFlowExecutionState value %q", str)
+}
+
 // Describes the status of the job flow.
 type JobFlowExecutionStatusDetail struct {
 	// The creation date and time of the job flow.
@@ -1392,7 +2056,7 @@ type JobFlowExecutionStatusDetail struct {
 	EndDateTime *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// Description of the job flow last changed state.
-	LastStateChangeReason *string `type:"string"`
+	LastStateChangeReason *string `type:"string" max:"10280" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The date and time when the job flow was ready to start running bootstrap
 	// actions.
@@ -1402,7 +2066,7 @@ type JobFlowExecutionStatusDetail struct {
 	StartDateTime *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// The state of the job flow.
-	State *string `type:"string" required:"true"`
+	State *string `type:"string" enum:"STARTING,BOOTSTRAPPING,RUNNING,WAITING,SHUTTING_DOWN,TERMINATED,COMPLETED,FAILED" required:"true"`
 
 	metadataJobFlowExecutionStatusDetail `json:"-", xml:"-"`
 }
@@ -1411,6 +2075,11 @@ type metadataJobFlowExecutionStatusDetail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the JobFlowExecutionStatusDetail.
+func (s JobFlowExecutionStatusDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A description of the Amazon EC2 instance running the job flow. A valid JobFlowInstancesConfig
 // must contain at least InstanceGroups, which is the recommended configuration.
 // However, a valid alternative is to have MasterInstanceType, SlaveInstanceType,
@@ -1424,7 +2093,7 @@ type JobFlowInstancesConfig struct {
 
 	// The name of the Amazon EC2 key pair that can be used to ssh to the master
 	// node as the user called "hadoop."
-	EC2KeyName *string `locationName:"Ec2KeyName" type:"string"`
+	EC2KeyName *string `locationName:"Ec2KeyName" type:"string" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// To launch the job flow in Amazon Virtual Private Cloud (Amazon VPC), set
 	// this parameter to the identifier of the Amazon VPC subnet where you want
@@ -1434,21 +2103,21 @@ type JobFlowInstancesConfig struct {
 	//  Amazon VPC currently does not support cluster compute quadruple extra large
 	// (cc1.4xlarge) instances. Thus you cannot specify the cc1.4xlarge instance
 	// type for nodes of a job flow launched in a Amazon VPC.
-	EC2SubnetID *string `locationName:"Ec2SubnetId" type:"string"`
+	EC2SubnetID *string `locationName:"Ec2SubnetId" type:"string" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The identifier of the Amazon EC2 security group (managed by Amazon ElasticMapReduce)
 	// for the master node.
-	EMRManagedMasterSecurityGroup *string `locationName:"EmrManagedMasterSecurityGroup" type:"string"`
+	EMRManagedMasterSecurityGroup *string `locationName:"EmrManagedMasterSecurityGroup" type:"string" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The identifier of the Amazon EC2 security group (managed by Amazon ElasticMapReduce)
 	// for the slave nodes.
-	EMRManagedSlaveSecurityGroup *string `locationName:"EmrManagedSlaveSecurityGroup" type:"string"`
+	EMRManagedSlaveSecurityGroup *string `locationName:"EmrManagedSlaveSecurityGroup" type:"string" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The Hadoop version for the job flow. Valid inputs are "0.18", "0.20", "0.20.205",
 	// "1.0.3", "2.2.0", or "2.4.0". If you do not set this value, the default of
 	// 0.18 is used, unless the AmiVersion parameter is set in the RunJobFlow call,
 	// in which case the default version of Hadoop for that AMI version is used.
-	HadoopVersion *string `type:"string"`
+	HadoopVersion *string `type:"string" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The number of Amazon EC2 instances used to execute the job flow.
 	InstanceCount *int64 `type:"integer"`
@@ -1460,13 +2129,13 @@ type JobFlowInstancesConfig struct {
 	KeepJobFlowAliveWhenNoSteps *bool `type:"boolean"`
 
 	// The EC2 instance type of the master node.
-	MasterInstanceType *string `type:"string"`
+	MasterInstanceType *string `type:"string" min:"1" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The Availability Zone the job flow will run in.
 	Placement *PlacementType `type:"structure"`
 
 	// The EC2 instance type of the slave nodes.
-	SlaveInstanceType *string `type:"string"`
+	SlaveInstanceType *string `type:"string" min:"1" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// Specifies whether to lock the job flow to prevent the Amazon EC2 instances
 	// from being terminated by API call, user intervention, or in the event of
@@ -1480,18 +2149,23 @@ type metadataJobFlowInstancesConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the JobFlowInstancesConfig.
+func (s JobFlowInstancesConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Specify the type of Amazon EC2 instances to run the job flow on.
 type JobFlowInstancesDetail struct {
 	// The name of an Amazon EC2 key pair that can be used to ssh to the master
 	// node of job flow.
-	EC2KeyName *string `locationName:"Ec2KeyName" type:"string"`
+	EC2KeyName *string `locationName:"Ec2KeyName" type:"string" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// For job flows launched within Amazon Virtual Private Cloud, this value specifies
 	// the identifier of the subnet where the job flow was launched.
-	EC2SubnetID *string `locationName:"Ec2SubnetId" type:"string"`
+	EC2SubnetID *string `locationName:"Ec2SubnetId" type:"string" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The Hadoop version for the job flow.
-	HadoopVersion *string `type:"string"`
+	HadoopVersion *string `type:"string" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The number of Amazon EC2 instances in the cluster. If the value is 1, the
 	// same instance serves as both the master and slave node. If the value is greater
@@ -1505,13 +2179,13 @@ type JobFlowInstancesDetail struct {
 	KeepJobFlowAliveWhenNoSteps *bool `type:"boolean"`
 
 	// The Amazon EC2 instance identifier of the master node.
-	MasterInstanceID *string `locationName:"MasterInstanceId" type:"string"`
+	MasterInstanceID *string `locationName:"MasterInstanceId" type:"string" max:"10280" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The Amazon EC2 master node instance type.
-	MasterInstanceType *string `type:"string" required:"true"`
+	MasterInstanceType *string `type:"string" min:"1" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// The DNS name of the master node.
-	MasterPublicDNSName *string `locationName:"MasterPublicDnsName" type:"string"`
+	MasterPublicDNSName *string `locationName:"MasterPublicDnsName" type:"string" max:"10280" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// An approximation of the cost of the job flow, represented in m1.small/hours.
 	// This value is incremented once for every hour an m1.small runs. Larger instances
@@ -1525,7 +2199,7 @@ type JobFlowInstancesDetail struct {
 	Placement *PlacementType `type:"structure"`
 
 	// The Amazon EC2 slave node instance type.
-	SlaveInstanceType *string `type:"string" required:"true"`
+	SlaveInstanceType *string `type:"string" min:"1" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// Specifies whether the Amazon EC2 instances in the cluster are protected from
 	// termination by API calls, user intervention, or in the event of a job flow
@@ -1539,13 +2213,18 @@ type metadataJobFlowInstancesDetail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the JobFlowInstancesDetail.
+func (s JobFlowInstancesDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A key value pair.
 type KeyValue struct {
 	// The unique identifier of a key value pair.
-	Key *string `type:"string"`
+	Key *string `type:"string" max:"10280" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The value part of the identified key.
-	Value *string `type:"string"`
+	Value *string `type:"string" max:"10280" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataKeyValue `json:"-", xml:"-"`
 }
@@ -1554,6 +2233,11 @@ type metadataKeyValue struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the KeyValue.
+func (s KeyValue) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This input determines which bootstrap actions to retrieve.
 type ListBootstrapActionsInput struct {
 	// The cluster identifier for the bootstrap actions to list .
@@ -1569,6 +2253,11 @@ type metadataListBootstrapActionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListBootstrapActionsInput.
+func (s ListBootstrapActionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This output contains the boostrap actions detail .
 type ListBootstrapActionsOutput struct {
 	// The bootstrap actions associated with the cluster .
@@ -1584,6 +2273,11 @@ type metadataListBootstrapActionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListBootstrapActionsOutput.
+func (s ListBootstrapActionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This input determines how the ListClusters action filters the list of clusters
 // that it returns.
 type ListClustersInput struct {
@@ -1606,6 +2300,11 @@ type metadataListClustersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListClustersInput.
+func (s ListClustersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This contains a ClusterSummaryList with the cluster details; for example,
 // the cluster IDs, names, and status.
 type ListClustersOutput struct {
@@ -1622,6 +2321,11 @@ type metadataListClustersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListClustersOutput.
+func (s ListClustersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This input determines which instance groups to retrieve.
 type ListInstanceGroupsInput struct {
 	// The identifier of the cluster for which to list the instance groups.
@@ -1637,6 +2341,11 @@ type metadataListInstanceGroupsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListInstanceGroupsInput.
+func (s ListInstanceGroupsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This input determines which instance groups to retrieve.
 type ListInstanceGroupsOutput struct {
 	// The list of instance groups for the cluster and given filters.
@@ -1652,6 +2361,11 @@ type metadataListInstanceGroupsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListInstanceGroupsOutput.
+func (s ListInstanceGroupsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This input determines which instances to list.
 type ListInstancesInput struct {
 	// The identifier of the cluster for which to list the instances.
@@ -1673,6 +2387,11 @@ type metadataListInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListInstancesInput.
+func (s ListInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This output contains the list of instances.
 type ListInstancesOutput struct {
 	// The list of instances for the cluster and given filters.
@@ -1688,6 +2407,11 @@ type metadataListInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListInstancesOutput.
+func (s ListInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This input determines which steps to list.
 type ListStepsInput struct {
 	// The identifier of the cluster for which to list the steps.
@@ -1709,6 +2433,11 @@ type metadataListStepsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListStepsInput.
+func (s ListStepsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This output contains the list of steps.
 type ListStepsOutput struct {
 	// The pagination token that indicates the next set of results to retrieve.
@@ -1724,6 +2453,45 @@ type metadataListStepsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListStepsOutput.
+func (s ListStepsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// MarketType is an enum of the values of MarketType members.
+type MarketType string
+
+// The values of MarketType.
+const (
+	MarketTypeONDEMAND MarketType = "ON_DEMAND"
+	MarketTypeSPOT     MarketType = "SPOT"
+)
+
+// MarketTypeValues returns the values of MarketType known to this
+// version of the SDK. The service may accept values added since.
+func MarketTypeValues() []MarketType {
+	return []MarketType{
+		MarketType("ON_DEMAND"),
+		MarketType("SPOT"),
+	}
+}
+
+// String returns the MarketType as the string of a member.
+func (v MarketType) String() string {
+	return string(v)
+}
+
+// ParseMarketType returns the MarketType of str, failing if it is not
+// one of the MarketTypeValues.
+func ParseMarketType(str string) (MarketType, error) {
+	for _, v := range MarketTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid MarketType value %q", str)
+}
+
 // Change the size of some instance groups.
 type ModifyInstanceGroupsInput struct {
 	// Instance groups to change.
@@ -1736,6 +2504,11 @@ type metadataModifyInstanceGroupsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyInstanceGroupsInput.
+func (s ModifyInstanceGroupsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifyInstanceGroupsOutput struct {
 	metadataModifyInstanceGroupsOutput `json:"-", xml:"-"`
 }
@@ -1744,10 +2517,15 @@ type metadataModifyInstanceGroupsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyInstanceGroupsOutput.
+func (s ModifyInstanceGroupsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The Amazon EC2 location for the job flow.
 type PlacementType struct {
 	// The Amazon EC2 Availability Zone for the job flow.
-	AvailabilityZone *string `type:"string" required:"true"`
+	AvailabilityZone *string `type:"string" max:"10280" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	metadataPlacementType `json:"-", xml:"-"`
 }
@@ -1756,6 +2534,11 @@ type metadataPlacementType struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PlacementType.
+func (s PlacementType) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This input identifies a cluster and a list of tags to remove.
 type RemoveTagsInput struct {
 	// The Amazon EMR resource identifier from which tags will be removed. This
@@ -1772,6 +2555,11 @@ type metadataRemoveTagsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RemoveTagsInput.
+func (s RemoveTagsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This output indicates the result of removing tags from a resource.
 type RemoveTagsOutput struct {
 	metadataRemoveTagsOutput `json:"-", xml:"-"`
@@ -1781,6 +2569,11 @@ type metadataRemoveTagsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RemoveTagsOutput.
+func (s RemoveTagsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input to the RunJobFlow operation.
 type RunJobFlowInput struct {
 	// The version of the Amazon Machine Image (AMI) to use when launching Amazon
@@ -1795,10 +2588,10 @@ type RunJobFlowInput struct {
 	// For details about the AMI versions currently supported by Amazon Elastic
 	// MapReduce, go to AMI Versions Supported in Elastic MapReduce (http://docs.aws.amazon.com/ElasticMapReduce/latest/DeveloperGuide/EnvironmentConfig_AMIVersion.html#ami-versions-supported)
 	// in the Amazon Elastic MapReduce Developer's Guide.
-	AMIVersion *string `locationName:"AmiVersion" type:"string"`
+	AMIVersion *string `locationName:"AmiVersion" type:"string" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// A JSON string for selecting additional features.
-	AdditionalInfo *string `type:"string"`
+	AdditionalInfo *string `type:"string" max:"10280" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// A list of bootstrap actions that will be run before Hadoop is started on
 	// the cluster nodes.
@@ -1811,14 +2604,14 @@ type RunJobFlowInput struct {
 	// An IAM role for the job flow. The EC2 instances of the job flow assume this
 	// role. The default role is EMRJobflowDefault. In order to use the default
 	// role, you must have already created it using the CLI.
-	JobFlowRole *string `type:"string"`
+	JobFlowRole *string `type:"string" max:"10280" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The location in Amazon S3 to write the log files of the job flow. If a value
 	// is not provided, logs are not created.
-	LogURI *string `locationName:"LogUri" type:"string"`
+	LogURI *string `locationName:"LogUri" type:"string" max:"10280" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The name of the job flow.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	// A list of strings that indicates third-party software to use with the job
 	// flow that accepts a user argument list. EMR accepts and forwards the argument
@@ -1835,7 +2628,7 @@ type RunJobFlowInput struct {
 
 	// The IAM role that will be assumed by the Amazon EMR service to access AWS
 	// resources on your behalf.
-	ServiceRole *string `type:"string"`
+	ServiceRole *string `type:"string" max:"10280" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// A list of steps to be executed by the job flow.
 	Steps []*StepConfig `type:"list"`
@@ -1866,10 +2659,15 @@ type metadataRunJobFlowInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RunJobFlowInput.
+func (s RunJobFlowInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The result of the RunJobFlow operation.
 type RunJobFlowOutput struct {
 	// An unique identifier for the job flow.
-	JobFlowID *string `locationName:"JobFlowId" type:"string"`
+	JobFlowID *string `locationName:"JobFlowId" type:"string" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataRunJobFlowOutput `json:"-", xml:"-"`
 }
@@ -1878,6 +2676,11 @@ type metadataRunJobFlowOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RunJobFlowOutput.
+func (s RunJobFlowOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Configuration of the script to run during a bootstrap action.
 type ScriptBootstrapActionConfig struct {
 	// A list of command line arguments to pass to the bootstrap action script.
@@ -1885,7 +2688,7 @@ type ScriptBootstrapActionConfig struct {
 
 	// Location of the script to run during a bootstrap action. Can be either a
 	// location in Amazon S3 or on a local file system.
-	Path *string `type:"string" required:"true"`
+	Path *string `type:"string" max:"10280" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	metadataScriptBootstrapActionConfig `json:"-", xml:"-"`
 }
@@ -1894,6 +2697,11 @@ type metadataScriptBootstrapActionConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ScriptBootstrapActionConfig.
+func (s ScriptBootstrapActionConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input argument to the TerminationProtection operation.
 type SetTerminationProtectionInput struct {
 	// A list of strings that uniquely identify the job flows to protect. This identifier
@@ -1913,6 +2721,11 @@ type metadataSetTerminationProtectionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetTerminationProtectionInput.
+func (s SetTerminationProtectionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SetTerminationProtectionOutput struct {
 	metadataSetTerminationProtectionOutput `json:"-", xml:"-"`
 }
@@ -1921,6 +2734,11 @@ type metadataSetTerminationProtectionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetTerminationProtectionOutput.
+func (s SetTerminationProtectionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input to the SetVisibleToAllUsers action.
 type SetVisibleToAllUsersInput struct {
 	// Identifiers of the job flows to receive the new visibility setting.
@@ -1940,6 +2758,11 @@ type metadataSetVisibleToAllUsersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetVisibleToAllUsersInput.
+func (s SetVisibleToAllUsersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SetVisibleToAllUsersOutput struct {
 	metadataSetVisibleToAllUsersOutput `json:"-", xml:"-"`
 }
@@ -1948,11 +2771,16 @@ type metadataSetVisibleToAllUsersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetVisibleToAllUsersOutput.
+func (s SetVisibleToAllUsersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This represents a step in a cluster.
 type Step struct {
 	// This specifies what action to take when the cluster step fails. Possible
 	// values are TERMINATE_CLUSTER, CANCEL_AND_WAIT, and CONTINUE.
-	ActionOnFailure *string `type:"string"`
+	ActionOnFailure *string `type:"string" enum:"TERMINATE_JOB_FLOW,TERMINATE_CLUSTER,CANCEL_AND_WAIT,CONTINUE"`
 
 	// The Hadoop job configuration of the cluster step.
 	Config *HadoopStepConfig `type:"structure"`
@@ -1973,16 +2801,21 @@ type metadataStep struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Step.
+func (s Step) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Specification of a job flow step.
 type StepConfig struct {
 	// The action to take if the job flow step fails.
-	ActionOnFailure *string `type:"string"`
+	ActionOnFailure *string `type:"string" enum:"TERMINATE_JOB_FLOW,TERMINATE_CLUSTER,CANCEL_AND_WAIT,CONTINUE"`
 
 	// The JAR file used for the job flow step.
 	HadoopJARStep *HadoopJARStepConfig `locationName:"HadoopJarStep" type:"structure" required:"true"`
 
 	// The name of the job flow step.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*" required:"true"`
 
 	metadataStepConfig `json:"-", xml:"-"`
 }
@@ -1991,6 +2824,11 @@ type metadataStepConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StepConfig.
+func (s StepConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Combines the execution state and configuration of a step.
 type StepDetail struct {
 	// The description of the step status.
@@ -2006,6 +2844,55 @@ type metadataStepDetail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StepDetail.
+func (s StepDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
+// StepExecutionState is an enum of the values of StepExecutionState members.
+type StepExecutionState string
+
+// The values of StepExecutionState.
+const (
+	StepExecutionStatePENDING     StepExecutionState = "PENDING"
+	StepExecutionStateRUNNING     StepExecutionState = "RUNNING"
+	StepExecutionStateCONTINUE    StepExecutionState = "CONTINUE"
+	StepExecutionStateCOMPLETED   StepExecutionState = "COMPLETED"
+	StepExecutionStateCANCELLED   StepExecutionState = "CANCELLED"
+	StepExecutionStateFAILED      StepExecutionState = "FAILED"
+	StepExecutionStateINTERRUPTED StepExecutionState = "INTERRUPTED"
+)
+
+// StepExecutionStateValues returns the values of StepExecutionState known to this
+// version of the SDK. The service may accept values added since.
+func StepExecutionStateValues() []StepExecutionState {
+	return []StepExecutionState{
+		StepExecutionState("PENDING"),
+		StepExecutionState("RUNNING"),
+		StepExecutionState("CONTINUE"),
+		StepExecutionState("COMPLETED"),
+		StepExecutionState("CANCELLED"),
+		StepExecutionState("FAILED"),
+		StepExecutionState("INTERRUPTED"),
+	}
+}
+
+// String returns the StepExecutionState as the string of a member.
+func (v StepExecutionState) String() string {
+	return string(v)
+}
+
+// ParseStepExecutionState returns the StepExecutionState of str, failing if it is not
+// one of the StepExecutionStateValues.
+func ParseStepExecutionState(str string) (StepExecutionState, error) {
+	for _, v := range StepExecutionStateValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid StepExecutionState value %q", str)
+}
+
 // The execution state of a step.
 type StepExecutionStatusDetail struct {
 	// The creation date and time of the step.
@@ -2015,13 +2902,13 @@ type StepExecutionStatusDetail struct {
 	EndDateTime *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// A description of the step's current state.
-	LastStateChangeReason *string `type:"string"`
+	LastStateChangeReason *string `type:"string" max:"10280" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	// The start date and time of the step.
 	StartDateTime *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// The state of the job flow step.
-	State *string `type:"string" required:"true"`
+	State *string `type:"string" enum:"PENDING,RUNNING,CONTINUE,COMPLETED,CANCELLED,FAILED,INTERRUPTED" required:"true"`
 
 	metadataStepExecutionStatusDetail `json:"-", xml:"-"`
 }
@@ -2030,10 +2917,57 @@ type metadataStepExecutionStatusDetail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StepExecutionStatusDetail.
+func (s StepExecutionStatusDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
+// StepState is an enum of the values of StepState members.
+type StepState string
+
+// The values of StepState.
+const (
+	StepStatePENDING     StepState = "PENDING"
+	StepStateRUNNING     StepState = "RUNNING"
+	StepStateCOMPLETED   StepState = "COMPLETED"
+	StepStateCANCELLED   StepState = "CANCELLED"
+	StepStateFAILED      StepState = "FAILED"
+	StepStateINTERRUPTED StepState = "INTERRUPTED"
+)
+
+// StepStateValues returns the values of StepState known to this
+// version of the SDK. The service may accept values added since.
+func StepStateValues() []StepState {
+	return []StepState{
+		StepState("PENDING"),
+		StepState("RUNNING"),
+		StepState("COMPLETED"),
+		StepState("CANCELLED"),
+		StepState("FAILED"),
+		StepState("INTERRUPTED"),
+	}
+}
+
+// String returns the StepState as the string of a member.
+func (v StepState) String() string {
+	return string(v)
+}
+
+// ParseStepState returns the StepState of str, failing if it is not
+// one of the StepStateValues.
+func ParseStepState(str string) (StepState, error) {
+	for _, v := range StepStateValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid StepState value %q", str)
+}
+
 // The details of the step state change reason.
 type StepStateChangeReason struct {
 	// The programmable code for the state change reason.
-	Code *string `type:"string"`
+	Code *string `type:"string" enum:"NONE"`
 
 	// The descriptive message for the state change reason.
 	Message *string `type:"string"`
@@ -2045,10 +2979,47 @@ type metadataStepStateChangeReason struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StepStateChangeReason.
+func (s StepStateChangeReason) String() string {
+	return awsutil.StringValue(s)
+}
+
+// StepStateChangeReasonCode is an enum of the values of StepStateChangeReasonCode members.
+type StepStateChangeReasonCode string
+
+// The values of StepStateChangeReasonCode.
+const (
+	StepStateChangeReasonCodeNONE StepStateChangeReasonCode = "NONE"
+)
+
+// StepStateChangeReasonCodeValues returns the values of StepStateChangeReasonCode known to this
+// version of the SDK. The service may accept values added since.
+func StepStateChangeReasonCodeValues() []StepStateChangeReasonCode {
+	return []StepStateChangeReasonCode{
+		StepStateChangeReasonCode("NONE"),
+	}
+}
+
+// String returns the StepStateChangeReasonCode as the string of a member.
+func (v StepStateChangeReasonCode) String() string {
+	return string(v)
+}
+
+// ParseStepStateChangeReasonCode returns the StepStateChangeReasonCode of str, failing if it is not
+// one of the StepStateChangeReasonCodeValues.
+func ParseStepStateChangeReasonCode(str string) (StepStateChangeReasonCode, error) {
+	for _, v := range StepStateChangeReasonCodeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid StepStateChangeReasonCode value %q", str)
+}
+
 // The execution status details of the cluster step.
 type StepStatus struct {
 	// The execution state of the cluster step.
-	State *string `type:"string"`
+	State *string `type:"string" enum:"PENDING,RUNNING,COMPLETED,CANCELLED,FAILED,INTERRUPTED"`
 
 	// The reason for the step execution status change.
 	StateChangeReason *StepStateChangeReason `type:"structure"`
@@ -2063,11 +3034,16 @@ type metadataStepStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StepStatus.
+func (s StepStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The summary of the cluster step.
 type StepSummary struct {
 	// This specifies what action to take when the cluster step fails. Possible
 	// values are TERMINATE_CLUSTER, CANCEL_AND_WAIT, and CONTINUE.
-	ActionOnFailure *string `type:"string"`
+	ActionOnFailure *string `type:"string" enum:"TERMINATE_JOB_FLOW,TERMINATE_CLUSTER,CANCEL_AND_WAIT,CONTINUE"`
 
 	// The Hadoop job configuration of the cluster step.
 	Config *HadoopStepConfig `type:"structure"`
@@ -2088,6 +3064,11 @@ type metadataStepSummary struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StepSummary.
+func (s StepSummary) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The timeline of the cluster step lifecycle.
 type StepTimeline struct {
 	// The date and time when the cluster step was created.
@@ -2106,6 +3087,11 @@ type metadataStepTimeline struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StepTimeline.
+func (s StepTimeline) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The list of supported product configurations which allow user-supplied arguments.
 // EMR accepts these arguments and forwards them to the corresponding installation
 // script as bootstrap action arguments.
@@ -2114,7 +3100,7 @@ type SupportedProductConfig struct {
 	Args []*string `type:"list"`
 
 	// The name of the product configuration.
-	Name *string `type:"string"`
+	Name *string `type:"string" max:"256" pattern:"[\\u0020-\\uD7FF\\uE000-\\uFFFD\\uD800\\uDC00-\\uDBFF\\uDFFF\\r\\n\\t]*"`
 
 	metadataSupportedProductConfig `json:"-", xml:"-"`
 }
@@ -2123,6 +3109,11 @@ type metadataSupportedProductConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SupportedProductConfig.
+func (s SupportedProductConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A key/value pair containing user-defined metadata that you can associate
 // with an Amazon EMR resource. Tags make it easier to associate clusters in
 // various ways, such as grouping clu\ sters to track your Amazon EMR resource
@@ -2144,6 +3135,11 @@ type metadataTag struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Tag.
+func (s Tag) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input to the TerminateJobFlows operation.
 type TerminateJobFlowsInput struct {
 	// A list of job flows to be shutdown.
@@ -2156,10 +3152,20 @@ type metadataTerminateJobFlowsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TerminateJobFlowsInput.
+func (s TerminateJobFlowsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type TerminateJobFlowsOutput struct {
 	metadataTerminateJobFlowsOutput `json:"-", xml:"-"`
 }
 
 type metadataTerminateJobFlowsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the TerminateJobFlowsOutput.
+func (s TerminateJobFlowsOutput) String() string {
+	return awsutil.StringValue(s)
 }
\ No newline at end of file
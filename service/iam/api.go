@@ -4,9 +4,11 @@
 package iam
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // AddClientIDToOpenIDConnectProviderRequest generates a request for the AddClientIDToOpenIDConnectProvider operation.
@@ -1521,6 +1523,12 @@ func (c *IAM) GetGroupRequest(input *GetGroupInput) (req *aws.Request, output *G
 			Name:       "GetGroup",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -1539,6 +1547,16 @@ func (c *IAM) GetGroup(input *GetGroupInput) (output *GetGroupOutput, err error)
 	return
 }
 
+// GetGroupPages iterates over the pages of a GetGroup operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *IAM) GetGroupPages(input *GetGroupInput, fn func(p *GetGroupOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.GetGroupRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*GetGroupOutput), lastPage)
+	})
+}
+
 var opGetGroup *aws.Operation
 
 // GetGroupPolicyRequest generates a request for the GetGroupPolicy operation.
@@ -1935,6 +1953,12 @@ func (c *IAM) ListAccessKeysRequest(input *ListAccessKeysInput) (req *aws.Reques
 			Name:       "ListAccessKeys",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -1964,6 +1988,16 @@ func (c *IAM) ListAccessKeys(input *ListAccessKeysInput) (output *ListAccessKeys
 	return
 }
 
+// ListAccessKeysPages iterates over the pages of a ListAccessKeys operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *IAM) ListAccessKeysPages(input *ListAccessKeysInput, fn func(p *ListAccessKeysOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListAccessKeysRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListAccessKeysOutput), lastPage)
+	})
+}
+
 var opListAccessKeys *aws.Operation
 
 // ListAccountAliasesRequest generates a request for the ListAccountAliases operation.
@@ -1973,6 +2007,12 @@ func (c *IAM) ListAccountAliasesRequest(input *ListAccountAliasesInput) (req *aw
 			Name:       "ListAccountAliases",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -1994,6 +2034,16 @@ func (c *IAM) ListAccountAliases(input *ListAccountAliasesInput) (output *ListAc
 	return
 }
 
+// ListAccountAliasesPages iterates over the pages of a ListAccountAliases operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *IAM) ListAccountAliasesPages(input *ListAccountAliasesInput, fn func(p *ListAccountAliasesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListAccountAliasesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListAccountAliasesOutput), lastPage)
+	})
+}
+
 var opListAccountAliases *aws.Operation
 
 // ListAttachedGroupPoliciesRequest generates a request for the ListAttachedGroupPolicies operation.
@@ -2148,6 +2198,12 @@ func (c *IAM) ListGroupPoliciesRequest(input *ListGroupPoliciesInput) (req *aws.
 			Name:       "ListGroupPolicies",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -2176,6 +2232,16 @@ func (c *IAM) ListGroupPolicies(input *ListGroupPoliciesInput) (output *ListGrou
 	return
 }
 
+// ListGroupPoliciesPages iterates over the pages of a ListGroupPolicies operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *IAM) ListGroupPoliciesPages(input *ListGroupPoliciesInput, fn func(p *ListGroupPoliciesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListGroupPoliciesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListGroupPoliciesOutput), lastPage)
+	})
+}
+
 var opListGroupPolicies *aws.Operation
 
 // ListGroupsRequest generates a request for the ListGroups operation.
@@ -2185,6 +2251,12 @@ func (c *IAM) ListGroupsRequest(input *ListGroupsInput) (req *aws.Request, outpu
 			Name:       "ListGroups",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -2204,6 +2276,16 @@ func (c *IAM) ListGroups(input *ListGroupsInput) (output *ListGroupsOutput, err
 	return
 }
 
+// ListGroupsPages iterates over the pages of a ListGroups operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *IAM) ListGroupsPages(input *ListGroupsInput, fn func(p *ListGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListGroupsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListGroupsOutput), lastPage)
+	})
+}
+
 var opListGroups *aws.Operation
 
 // ListGroupsForUserRequest generates a request for the ListGroupsForUser operation.
@@ -2213,6 +2295,12 @@ func (c *IAM) ListGroupsForUserRequest(input *ListGroupsForUserInput) (req *aws.
 			Name:       "ListGroupsForUser",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -2232,6 +2320,16 @@ func (c *IAM) ListGroupsForUser(input *ListGroupsForUserInput) (output *ListGrou
 	return
 }
 
+// ListGroupsForUserPages iterates over the pages of a ListGroupsForUser operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *IAM) ListGroupsForUserPages(input *ListGroupsForUserInput, fn func(p *ListGroupsForUserOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListGroupsForUserRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListGroupsForUserOutput), lastPage)
+	})
+}
+
 var opListGroupsForUser *aws.Operation
 
 // ListInstanceProfilesRequest generates a request for the ListInstanceProfiles operation.
@@ -2241,6 +2339,12 @@ func (c *IAM) ListInstanceProfilesRequest(input *ListInstanceProfilesInput) (req
 			Name:       "ListInstanceProfiles",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -2262,6 +2366,16 @@ func (c *IAM) ListInstanceProfiles(input *ListInstanceProfilesInput) (output *Li
 	return
 }
 
+// ListInstanceProfilesPages iterates over the pages of a ListInstanceProfiles operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *IAM) ListInstanceProfilesPages(input *ListInstanceProfilesInput, fn func(p *ListInstanceProfilesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListInstanceProfilesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListInstanceProfilesOutput), lastPage)
+	})
+}
+
 var opListInstanceProfiles *aws.Operation
 
 // ListInstanceProfilesForRoleRequest generates a request for the ListInstanceProfilesForRole operation.
@@ -2271,6 +2385,12 @@ func (c *IAM) ListInstanceProfilesForRoleRequest(input *ListInstanceProfilesForR
 			Name:       "ListInstanceProfilesForRole",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -2292,6 +2412,16 @@ func (c *IAM) ListInstanceProfilesForRole(input *ListInstanceProfilesForRoleInpu
 	return
 }
 
+// ListInstanceProfilesForRolePages iterates over the pages of a ListInstanceProfilesForRole operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *IAM) ListInstanceProfilesForRolePages(input *ListInstanceProfilesForRoleInput, fn func(p *ListInstanceProfilesForRoleOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListInstanceProfilesForRoleRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListInstanceProfilesForRoleOutput), lastPage)
+	})
+}
+
 var opListInstanceProfilesForRole *aws.Operation
 
 // ListMFADevicesRequest generates a request for the ListMFADevices operation.
@@ -2301,6 +2431,12 @@ func (c *IAM) ListMFADevicesRequest(input *ListMFADevicesInput) (req *aws.Reques
 			Name:       "ListMFADevices",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -2323,6 +2459,16 @@ func (c *IAM) ListMFADevices(input *ListMFADevicesInput) (output *ListMFADevices
 	return
 }
 
+// ListMFADevicesPages iterates over the pages of a ListMFADevices operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *IAM) ListMFADevicesPages(input *ListMFADevicesInput, fn func(p *ListMFADevicesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListMFADevicesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListMFADevicesOutput), lastPage)
+	})
+}
+
 var opListMFADevices *aws.Operation
 
 // ListOpenIDConnectProvidersRequest generates a request for the ListOpenIDConnectProviders operation.
@@ -2427,6 +2573,12 @@ func (c *IAM) ListRolePoliciesRequest(input *ListRolePoliciesInput) (req *aws.Re
 			Name:       "ListRolePolicies",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -2455,6 +2607,16 @@ func (c *IAM) ListRolePolicies(input *ListRolePoliciesInput) (output *ListRolePo
 	return
 }
 
+// ListRolePoliciesPages iterates over the pages of a ListRolePolicies operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *IAM) ListRolePoliciesPages(input *ListRolePoliciesInput, fn func(p *ListRolePoliciesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListRolePoliciesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListRolePoliciesOutput), lastPage)
+	})
+}
+
 var opListRolePolicies *aws.Operation
 
 // ListRolesRequest generates a request for the ListRoles operation.
@@ -2464,6 +2626,12 @@ func (c *IAM) ListRolesRequest(input *ListRolesInput) (req *aws.Request, output
 			Name:       "ListRoles",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -2488,6 +2656,16 @@ func (c *IAM) ListRoles(input *ListRolesInput) (output *ListRolesOutput, err err
 	return
 }
 
+// ListRolesPages iterates over the pages of a ListRoles operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *IAM) ListRolesPages(input *ListRolesInput, fn func(p *ListRolesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListRolesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListRolesOutput), lastPage)
+	})
+}
+
 var opListRoles *aws.Operation
 
 // ListSAMLProvidersRequest generates a request for the ListSAMLProviders operation.
@@ -2525,6 +2703,12 @@ func (c *IAM) ListServerCertificatesRequest(input *ListServerCertificatesInput)
 			Name:       "ListServerCertificates",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -2545,6 +2729,16 @@ func (c *IAM) ListServerCertificates(input *ListServerCertificatesInput) (output
 	return
 }
 
+// ListServerCertificatesPages iterates over the pages of a ListServerCertificates operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *IAM) ListServerCertificatesPages(input *ListServerCertificatesInput, fn func(p *ListServerCertificatesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListServerCertificatesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListServerCertificatesOutput), lastPage)
+	})
+}
+
 var opListServerCertificates *aws.Operation
 
 // ListSigningCertificatesRequest generates a request for the ListSigningCertificates operation.
@@ -2554,6 +2748,12 @@ func (c *IAM) ListSigningCertificatesRequest(input *ListSigningCertificatesInput
 			Name:       "ListSigningCertificates",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -2580,6 +2780,16 @@ func (c *IAM) ListSigningCertificates(input *ListSigningCertificatesInput) (outp
 	return
 }
 
+// ListSigningCertificatesPages iterates over the pages of a ListSigningCertificates operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *IAM) ListSigningCertificatesPages(input *ListSigningCertificatesInput, fn func(p *ListSigningCertificatesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListSigningCertificatesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListSigningCertificatesOutput), lastPage)
+	})
+}
+
 var opListSigningCertificates *aws.Operation
 
 // ListUserPoliciesRequest generates a request for the ListUserPolicies operation.
@@ -2589,6 +2799,12 @@ func (c *IAM) ListUserPoliciesRequest(input *ListUserPoliciesInput) (req *aws.Re
 			Name:       "ListUserPolicies",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -2616,6 +2832,16 @@ func (c *IAM) ListUserPolicies(input *ListUserPoliciesInput) (output *ListUserPo
 	return
 }
 
+// ListUserPoliciesPages iterates over the pages of a ListUserPolicies operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *IAM) ListUserPoliciesPages(input *ListUserPoliciesInput, fn func(p *ListUserPoliciesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListUserPoliciesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListUserPoliciesOutput), lastPage)
+	})
+}
+
 var opListUserPolicies *aws.Operation
 
 // ListUsersRequest generates a request for the ListUsers operation.
@@ -2625,6 +2851,12 @@ func (c *IAM) ListUsersRequest(input *ListUsersInput) (req *aws.Request, output
 			Name:       "ListUsers",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -2646,6 +2878,16 @@ func (c *IAM) ListUsers(input *ListUsersInput) (output *ListUsersOutput, err err
 	return
 }
 
+// ListUsersPages iterates over the pages of a ListUsers operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *IAM) ListUsersPages(input *ListUsersInput, fn func(p *ListUsersOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListUsersRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListUsersOutput), lastPage)
+	})
+}
+
 var opListUsers *aws.Operation
 
 // ListVirtualMFADevicesRequest generates a request for the ListVirtualMFADevices operation.
@@ -2655,6 +2897,12 @@ func (c *IAM) ListVirtualMFADevicesRequest(input *ListVirtualMFADevicesInput) (r
 			Name:       "ListVirtualMFADevices",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -2677,6 +2925,16 @@ func (c *IAM) ListVirtualMFADevices(input *ListVirtualMFADevicesInput) (output *
 	return
 }
 
+// ListVirtualMFADevicesPages iterates over the pages of a ListVirtualMFADevices operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *IAM) ListVirtualMFADevicesPages(input *ListVirtualMFADevicesInput, fn func(p *ListVirtualMFADevicesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListVirtualMFADevicesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListVirtualMFADevicesOutput), lastPage)
+	})
+}
+
 var opListVirtualMFADevices *aws.Operation
 
 // PutGroupPolicyRequest generates a request for the PutGroupPolicy operation.
@@ -3399,20 +3657,20 @@ var opUploadSigningCertificate *aws.Operation
 // key, you must create a new access key.
 type AccessKey struct {
 	// The ID for this access key.
-	AccessKeyID *string `locationName:"AccessKeyId" type:"string" required:"true"`
+	AccessKeyID *string `locationName:"AccessKeyId" type:"string" min:"16" max:"32" pattern:"[\\w]*" required:"true"`
 
 	// The date when the access key was created.
 	CreateDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The secret key used to sign requests.
-	SecretAccessKey *string `type:"string" required:"true"`
+	SecretAccessKey *string `type:"string" sensitive:"true" required:"true"`
 
 	// The status of the access key. Active means the key is valid for API calls,
 	// while Inactive means it is not.
-	Status *string `type:"string" required:"true"`
+	Status *string `type:"string" enum:"Active,Inactive" required:"true"`
 
 	// The name of the IAM user that the access key is associated with.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataAccessKey `json:"-", xml:"-"`
 }
@@ -3421,22 +3679,27 @@ type metadataAccessKey struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AccessKey.
+func (s AccessKey) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains information about an AWS access key, without its secret key.
 //
 // This data type is used as a response element in the ListAccessKeys action.
 type AccessKeyMetadata struct {
 	// The ID for this access key.
-	AccessKeyID *string `locationName:"AccessKeyId" type:"string"`
+	AccessKeyID *string `locationName:"AccessKeyId" type:"string" min:"16" max:"32" pattern:"[\\w]*"`
 
 	// The date when the access key was created.
 	CreateDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The status of the access key. Active means the key is valid for API calls;
 	// Inactive means it is not.
-	Status *string `type:"string"`
+	Status *string `type:"string" enum:"Active,Inactive"`
 
 	// The name of the IAM user that the key is associated with.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*"`
 
 	metadataAccessKeyMetadata `json:"-", xml:"-"`
 }
@@ -3445,14 +3708,19 @@ type metadataAccessKeyMetadata struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AccessKeyMetadata.
+func (s AccessKeyMetadata) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AddClientIDToOpenIDConnectProviderInput struct {
 	// The client ID (also known as audience) to add to the IAM OpenID Connect provider.
-	ClientID *string `type:"string" required:"true"`
+	ClientID *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the IAM OpenID Connect (OIDC) provider
 	// to add the client ID to. You can get a list of OIDC provider ARNs by using
 	// the ListOpenIDConnectProviders action.
-	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" required:"true"`
+	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataAddClientIDToOpenIDConnectProviderInput `json:"-", xml:"-"`
 }
@@ -3461,6 +3729,11 @@ type metadataAddClientIDToOpenIDConnectProviderInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddClientIDToOpenIDConnectProviderInput.
+func (s AddClientIDToOpenIDConnectProviderInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AddClientIDToOpenIDConnectProviderOutput struct {
 	metadataAddClientIDToOpenIDConnectProviderOutput `json:"-", xml:"-"`
 }
@@ -3469,12 +3742,17 @@ type metadataAddClientIDToOpenIDConnectProviderOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddClientIDToOpenIDConnectProviderOutput.
+func (s AddClientIDToOpenIDConnectProviderOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AddRoleToInstanceProfileInput struct {
 	// The name of the instance profile to update.
-	InstanceProfileName *string `type:"string" required:"true"`
+	InstanceProfileName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name of the role to add.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataAddRoleToInstanceProfileInput `json:"-", xml:"-"`
 }
@@ -3483,6 +3761,11 @@ type metadataAddRoleToInstanceProfileInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddRoleToInstanceProfileInput.
+func (s AddRoleToInstanceProfileInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AddRoleToInstanceProfileOutput struct {
 	metadataAddRoleToInstanceProfileOutput `json:"-", xml:"-"`
 }
@@ -3491,12 +3774,17 @@ type metadataAddRoleToInstanceProfileOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddRoleToInstanceProfileOutput.
+func (s AddRoleToInstanceProfileOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AddUserToGroupInput struct {
 	// The name of the group to update.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name of the user to add.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataAddUserToGroupInput `json:"-", xml:"-"`
 }
@@ -3505,6 +3793,11 @@ type metadataAddUserToGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddUserToGroupInput.
+func (s AddUserToGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AddUserToGroupOutput struct {
 	metadataAddUserToGroupOutput `json:"-", xml:"-"`
 }
@@ -3513,16 +3806,21 @@ type metadataAddUserToGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddUserToGroupOutput.
+func (s AddUserToGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AttachGroupPolicyInput struct {
 	// The name (friendly name, not ARN) of the group to attach the policy to.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataAttachGroupPolicyInput `json:"-", xml:"-"`
 }
@@ -3531,6 +3829,11 @@ type metadataAttachGroupPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AttachGroupPolicyInput.
+func (s AttachGroupPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AttachGroupPolicyOutput struct {
 	metadataAttachGroupPolicyOutput `json:"-", xml:"-"`
 }
@@ -3539,16 +3842,21 @@ type metadataAttachGroupPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AttachGroupPolicyOutput.
+func (s AttachGroupPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AttachRolePolicyInput struct {
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The name (friendly name, not ARN) of the role to attach the policy to.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataAttachRolePolicyInput `json:"-", xml:"-"`
 }
@@ -3557,6 +3865,11 @@ type metadataAttachRolePolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AttachRolePolicyInput.
+func (s AttachRolePolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AttachRolePolicyOutput struct {
 	metadataAttachRolePolicyOutput `json:"-", xml:"-"`
 }
@@ -3565,16 +3878,21 @@ type metadataAttachRolePolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AttachRolePolicyOutput.
+func (s AttachRolePolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AttachUserPolicyInput struct {
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The name (friendly name, not ARN) of the user to attach the policy to.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataAttachUserPolicyInput `json:"-", xml:"-"`
 }
@@ -3583,6 +3901,11 @@ type metadataAttachUserPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AttachUserPolicyInput.
+func (s AttachUserPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AttachUserPolicyOutput struct {
 	metadataAttachUserPolicyOutput `json:"-", xml:"-"`
 }
@@ -3591,6 +3914,11 @@ type metadataAttachUserPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AttachUserPolicyOutput.
+func (s AttachUserPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains information about an attached policy.
 //
 // An attached policy is a managed policy that has been attached to a user,
@@ -3606,10 +3934,10 @@ type AttachedPolicy struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048"`
 
 	// The friendly name of the attached policy.
-	PolicyName *string `type:"string"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataAttachedPolicy `json:"-", xml:"-"`
 }
@@ -3618,13 +3946,18 @@ type metadataAttachedPolicy struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AttachedPolicy.
+func (s AttachedPolicy) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ChangePasswordInput struct {
 	// The new password. The new password must conform to the AWS account's password
 	// policy, if one exists.
-	NewPassword *string `type:"string" required:"true"`
+	NewPassword *string `type:"string" min:"1" max:"128" pattern:"[\\u0009\\u000A\\u000D\\u0020-\\u00FF]+" sensitive:"true" required:"true"`
 
 	// The IAM user's current password.
-	OldPassword *string `type:"string" required:"true"`
+	OldPassword *string `type:"string" min:"1" max:"128" pattern:"[\\u0009\\u000A\\u000D\\u0020-\\u00FF]+" sensitive:"true" required:"true"`
 
 	metadataChangePasswordInput `json:"-", xml:"-"`
 }
@@ -3633,6 +3966,11 @@ type metadataChangePasswordInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ChangePasswordInput.
+func (s ChangePasswordInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ChangePasswordOutput struct {
 	metadataChangePasswordOutput `json:"-", xml:"-"`
 }
@@ -3641,9 +3979,14 @@ type metadataChangePasswordOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ChangePasswordOutput.
+func (s ChangePasswordOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateAccessKeyInput struct {
 	// The user name that the new key will belong to.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataCreateAccessKeyInput `json:"-", xml:"-"`
 }
@@ -3652,6 +3995,11 @@ type metadataCreateAccessKeyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateAccessKeyInput.
+func (s CreateAccessKeyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful CreateAccessKey request.
 type CreateAccessKeyOutput struct {
 	// Information about the access key.
@@ -3664,9 +4012,14 @@ type metadataCreateAccessKeyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateAccessKeyOutput.
+func (s CreateAccessKeyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateAccountAliasInput struct {
 	// The account alias to create.
-	AccountAlias *string `type:"string" required:"true"`
+	AccountAlias *string `type:"string" min:"3" max:"63" pattern:"^[a-z0-9](([a-z0-9]|-(?!-))*[a-z0-9])?$" required:"true"`
 
 	metadataCreateAccountAliasInput `json:"-", xml:"-"`
 }
@@ -3675,6 +4028,11 @@ type metadataCreateAccountAliasInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateAccountAliasInput.
+func (s CreateAccountAliasInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateAccountAliasOutput struct {
 	metadataCreateAccountAliasOutput `json:"-", xml:"-"`
 }
@@ -3683,9 +4041,14 @@ type metadataCreateAccountAliasOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateAccountAliasOutput.
+func (s CreateAccountAliasOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateGroupInput struct {
 	// The name of the group to create. Do not include the path in this value.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The path to the group. For more information about paths, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
@@ -3693,7 +4056,7 @@ type CreateGroupInput struct {
 	//
 	// This parameter is optional. If it is not included, it defaults to a slash
 	// (/).
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\u002F)|(\\u002F[\\u0021-\\u007F]+\\u002F)"`
 
 	metadataCreateGroupInput `json:"-", xml:"-"`
 }
@@ -3702,6 +4065,11 @@ type metadataCreateGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateGroupInput.
+func (s CreateGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful CreateGroup request.
 type CreateGroupOutput struct {
 	// Information about the group.
@@ -3714,9 +4082,14 @@ type metadataCreateGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateGroupOutput.
+func (s CreateGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateInstanceProfileInput struct {
 	// The name of the instance profile to create.
-	InstanceProfileName *string `type:"string" required:"true"`
+	InstanceProfileName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The path to the instance profile. For more information about paths, see IAM
 	// Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
@@ -3724,7 +4097,7 @@ type CreateInstanceProfileInput struct {
 	//
 	// This parameter is optional. If it is not included, it defaults to a slash
 	// (/).
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\u002F)|(\\u002F[\\u0021-\\u007F]+\\u002F)"`
 
 	metadataCreateInstanceProfileInput `json:"-", xml:"-"`
 }
@@ -3733,6 +4106,11 @@ type metadataCreateInstanceProfileInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateInstanceProfileInput.
+func (s CreateInstanceProfileInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful CreateInstanceProfile request.
 type CreateInstanceProfileOutput struct {
 	// Information about the instance profile.
@@ -3745,15 +4123,20 @@ type metadataCreateInstanceProfileOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateInstanceProfileOutput.
+func (s CreateInstanceProfileOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateLoginProfileInput struct {
 	// The new password for the user.
-	Password *string `type:"string" required:"true"`
+	Password *string `type:"string" min:"1" max:"128" pattern:"[\\u0009\\u000A\\u000D\\u0020-\\u00FF]+" sensitive:"true" required:"true"`
 
 	// Specifies whether the user is required to set a new password on next sign-in.
 	PasswordResetRequired *bool `type:"boolean"`
 
 	// The name of the user to create a password for.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataCreateLoginProfileInput `json:"-", xml:"-"`
 }
@@ -3762,6 +4145,11 @@ type metadataCreateLoginProfileInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateLoginProfileInput.
+func (s CreateLoginProfileInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful CreateLoginProfile request.
 type CreateLoginProfileOutput struct {
 	// The user name and password create date.
@@ -3774,6 +4162,11 @@ type metadataCreateLoginProfileOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateLoginProfileOutput.
+func (s CreateLoginProfileOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateOpenIDConnectProviderInput struct {
 	// A list of client IDs (also known as audiences). When a mobile or web app
 	// registers with an OpenID Connect provider, they establish a value that identifies
@@ -3818,7 +4211,7 @@ type CreateOpenIDConnectProviderInput struct {
 	// You cannot register the same provider multiple times in a single AWS account.
 	// If you try to submit a URL that has already been used for an OpenID Connect
 	// provider in the AWS account, you will get an error.
-	URL *string `locationName:"Url" type:"string" required:"true"`
+	URL *string `locationName:"Url" type:"string" min:"1" max:"255" required:"true"`
 
 	metadataCreateOpenIDConnectProviderInput `json:"-", xml:"-"`
 }
@@ -3827,11 +4220,16 @@ type metadataCreateOpenIDConnectProviderInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateOpenIDConnectProviderInput.
+func (s CreateOpenIDConnectProviderInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful CreateOpenIDConnectProvider request.
 type CreateOpenIDConnectProviderOutput struct {
 	// The Amazon Resource Name (ARN) of the IAM OpenID Connect provider that was
 	// created. For more information, see OpenIDConnectProviderListEntry.
-	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string"`
+	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" min:"20" max:"2048"`
 
 	metadataCreateOpenIDConnectProviderOutput `json:"-", xml:"-"`
 }
@@ -3840,6 +4238,11 @@ type metadataCreateOpenIDConnectProviderOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateOpenIDConnectProviderOutput.
+func (s CreateOpenIDConnectProviderOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreatePolicyInput struct {
 	// A friendly description of the policy.
 	//
@@ -3848,7 +4251,7 @@ type CreatePolicyInput struct {
 	//
 	// The policy description is immutable. After a value is assigned, it cannot
 	// be changed.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"1000"`
 
 	// The path for the policy.
 	//
@@ -3857,15 +4260,15 @@ type CreatePolicyInput struct {
 	//
 	// This parameter is optional. If it is not included, it defaults to a slash
 	// (/).
-	Path *string `type:"string"`
+	Path *string `type:"string" pattern:"((/[A-Za-z0-9\\.,\\+@=_-]+)*)/"`
 
 	// The policy document.
 	//
 	// The policy must be URL-encoded according to RFC 3986 (http://www.faqs.org/rfcs/rfc3986.html).
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\u0009\\u000A\\u000D\\u0020-\\u00FF]+" required:"true"`
 
 	// The name of the policy document.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataCreatePolicyInput `json:"-", xml:"-"`
 }
@@ -3874,6 +4277,11 @@ type metadataCreatePolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreatePolicyInput.
+func (s CreatePolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful CreatePolicy request.
 type CreatePolicyOutput struct {
 	// Information about the policy.
@@ -3886,18 +4294,23 @@ type metadataCreatePolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreatePolicyOutput.
+func (s CreatePolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreatePolicyVersionInput struct {
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The policy document.
 	//
 	// The policy must be URL-encoded according to RFC 3986 (http://www.faqs.org/rfcs/rfc3986.html).
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\u0009\\u000A\\u000D\\u0020-\\u00FF]+" required:"true"`
 
 	// Specifies whether to set this version as the policy's default version.
 	//
@@ -3917,6 +4330,11 @@ type metadataCreatePolicyVersionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreatePolicyVersionInput.
+func (s CreatePolicyVersionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful CreatePolicyVersion request.
 type CreatePolicyVersionOutput struct {
 	// Information about the policy version.
@@ -3929,9 +4347,14 @@ type metadataCreatePolicyVersionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreatePolicyVersionOutput.
+func (s CreatePolicyVersionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateRoleInput struct {
 	// The policy that grants an entity permission to assume the role.
-	AssumeRolePolicyDocument *string `type:"string" required:"true"`
+	AssumeRolePolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\u0009\\u000A\\u000D\\u0020-\\u00FF]+" required:"true"`
 
 	// The path to the role. For more information about paths, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
@@ -3939,10 +4362,10 @@ type CreateRoleInput struct {
 	//
 	// This parameter is optional. If it is not included, it defaults to a slash
 	// (/).
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\u002F)|(\\u002F[\\u0021-\\u007F]+\\u002F)"`
 
 	// The name of the role to create.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataCreateRoleInput `json:"-", xml:"-"`
 }
@@ -3951,6 +4374,11 @@ type metadataCreateRoleInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateRoleInput.
+func (s CreateRoleInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful CreateRole request.
 type CreateRoleOutput struct {
 	// Information about the role.
@@ -3963,9 +4391,14 @@ type metadataCreateRoleOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateRoleOutput.
+func (s CreateRoleOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateSAMLProviderInput struct {
 	// The name of the provider to create.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"1" max:"128" pattern:"[\\w._-]*" required:"true"`
 
 	// An XML document generated by an identity provider (IdP) that supports SAML
 	// 2.0. The document includes the issuer's name, expiration information, and
@@ -3976,7 +4409,7 @@ type CreateSAMLProviderInput struct {
 	//  For more information, see Creating Temporary Security Credentials for SAML
 	// Federation (http://docs.aws.amazon.com/STS/latest/UsingSTS/CreatingSAML.html)
 	// in the Using Temporary Security Credentials guide.
-	SAMLMetadataDocument *string `type:"string" required:"true"`
+	SAMLMetadataDocument *string `type:"string" min:"1000" max:"10000000" required:"true"`
 
 	metadataCreateSAMLProviderInput `json:"-", xml:"-"`
 }
@@ -3985,10 +4418,15 @@ type metadataCreateSAMLProviderInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateSAMLProviderInput.
+func (s CreateSAMLProviderInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful CreateSAMLProvider request.
 type CreateSAMLProviderOutput struct {
 	// The Amazon Resource Name (ARN) of the SAML provider.
-	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string"`
+	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string" min:"20" max:"2048"`
 
 	metadataCreateSAMLProviderOutput `json:"-", xml:"-"`
 }
@@ -3997,6 +4435,11 @@ type metadataCreateSAMLProviderOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateSAMLProviderOutput.
+func (s CreateSAMLProviderOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateUserInput struct {
 	// The path for the user name. For more information about paths, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
@@ -4004,10 +4447,10 @@ type CreateUserInput struct {
 	//
 	// This parameter is optional. If it is not included, it defaults to a slash
 	// (/).
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\u002F)|(\\u002F[\\u0021-\\u007F]+\\u002F)"`
 
 	// The name of the user to create.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataCreateUserInput `json:"-", xml:"-"`
 }
@@ -4016,6 +4459,11 @@ type metadataCreateUserInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateUserInput.
+func (s CreateUserInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful CreateUser request.
 type CreateUserOutput struct {
 	// Information about the user.
@@ -4028,6 +4476,11 @@ type metadataCreateUserOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateUserOutput.
+func (s CreateUserOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateVirtualMFADeviceInput struct {
 	// The path for the virtual MFA device. For more information about paths, see
 	// IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
@@ -4035,11 +4488,11 @@ type CreateVirtualMFADeviceInput struct {
 	//
 	// This parameter is optional. If it is not included, it defaults to a slash
 	// (/).
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\u002F)|(\\u002F[\\u0021-\\u007F]+\\u002F)"`
 
 	// The name of the virtual MFA device. Use with path to uniquely identify a
 	// virtual MFA device.
-	VirtualMFADeviceName *string `type:"string" required:"true"`
+	VirtualMFADeviceName *string `type:"string" min:"1" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataCreateVirtualMFADeviceInput `json:"-", xml:"-"`
 }
@@ -4048,6 +4501,11 @@ type metadataCreateVirtualMFADeviceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateVirtualMFADeviceInput.
+func (s CreateVirtualMFADeviceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful CreateVirtualMFADevice request.
 type CreateVirtualMFADeviceOutput struct {
 	// A newly created virtual MFA device.
@@ -4060,13 +4518,18 @@ type metadataCreateVirtualMFADeviceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateVirtualMFADeviceOutput.
+func (s CreateVirtualMFADeviceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeactivateMFADeviceInput struct {
 	// The serial number that uniquely identifies the MFA device. For virtual MFA
 	// devices, the serial number is the device ARN.
-	SerialNumber *string `type:"string" required:"true"`
+	SerialNumber *string `type:"string" min:"9" max:"256" pattern:"[\\w+=/:,.@-]*" required:"true"`
 
 	// The name of the user whose MFA device you want to deactivate.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDeactivateMFADeviceInput `json:"-", xml:"-"`
 }
@@ -4075,6 +4538,11 @@ type metadataDeactivateMFADeviceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeactivateMFADeviceInput.
+func (s DeactivateMFADeviceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeactivateMFADeviceOutput struct {
 	metadataDeactivateMFADeviceOutput `json:"-", xml:"-"`
 }
@@ -4083,13 +4551,18 @@ type metadataDeactivateMFADeviceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeactivateMFADeviceOutput.
+func (s DeactivateMFADeviceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteAccessKeyInput struct {
 	// The access key ID for the access key ID and secret access key you want to
 	// delete.
-	AccessKeyID *string `locationName:"AccessKeyId" type:"string" required:"true"`
+	AccessKeyID *string `locationName:"AccessKeyId" type:"string" min:"16" max:"32" pattern:"[\\w]*" required:"true"`
 
 	// The name of the user whose key you want to delete.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataDeleteAccessKeyInput `json:"-", xml:"-"`
 }
@@ -4098,6 +4571,11 @@ type metadataDeleteAccessKeyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteAccessKeyInput.
+func (s DeleteAccessKeyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteAccessKeyOutput struct {
 	metadataDeleteAccessKeyOutput `json:"-", xml:"-"`
 }
@@ -4106,9 +4584,14 @@ type metadataDeleteAccessKeyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteAccessKeyOutput.
+func (s DeleteAccessKeyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteAccountAliasInput struct {
 	// The name of the account alias to delete.
-	AccountAlias *string `type:"string" required:"true"`
+	AccountAlias *string `type:"string" min:"3" max:"63" pattern:"^[a-z0-9](([a-z0-9]|-(?!-))*[a-z0-9])?$" required:"true"`
 
 	metadataDeleteAccountAliasInput `json:"-", xml:"-"`
 }
@@ -4117,6 +4600,11 @@ type metadataDeleteAccountAliasInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteAccountAliasInput.
+func (s DeleteAccountAliasInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteAccountAliasOutput struct {
 	metadataDeleteAccountAliasOutput `json:"-", xml:"-"`
 }
@@ -4125,6 +4613,11 @@ type metadataDeleteAccountAliasOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteAccountAliasOutput.
+func (s DeleteAccountAliasOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteAccountPasswordPolicyInput struct {
 	metadataDeleteAccountPasswordPolicyInput `json:"-", xml:"-"`
 }
@@ -4133,6 +4626,11 @@ type metadataDeleteAccountPasswordPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteAccountPasswordPolicyInput.
+func (s DeleteAccountPasswordPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteAccountPasswordPolicyOutput struct {
 	metadataDeleteAccountPasswordPolicyOutput `json:"-", xml:"-"`
 }
@@ -4141,9 +4639,14 @@ type metadataDeleteAccountPasswordPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteAccountPasswordPolicyOutput.
+func (s DeleteAccountPasswordPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteGroupInput struct {
 	// The name of the group to delete.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDeleteGroupInput `json:"-", xml:"-"`
 }
@@ -4152,6 +4655,11 @@ type metadataDeleteGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteGroupInput.
+func (s DeleteGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteGroupOutput struct {
 	metadataDeleteGroupOutput `json:"-", xml:"-"`
 }
@@ -4160,13 +4668,18 @@ type metadataDeleteGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteGroupOutput.
+func (s DeleteGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteGroupPolicyInput struct {
 	// The name (friendly name, not ARN) identifying the group that the policy is
 	// embedded in.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name identifying the policy document to delete.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDeleteGroupPolicyInput `json:"-", xml:"-"`
 }
@@ -4175,6 +4688,11 @@ type metadataDeleteGroupPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteGroupPolicyInput.
+func (s DeleteGroupPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteGroupPolicyOutput struct {
 	metadataDeleteGroupPolicyOutput `json:"-", xml:"-"`
 }
@@ -4183,9 +4701,14 @@ type metadataDeleteGroupPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteGroupPolicyOutput.
+func (s DeleteGroupPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteInstanceProfileInput struct {
 	// The name of the instance profile to delete.
-	InstanceProfileName *string `type:"string" required:"true"`
+	InstanceProfileName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDeleteInstanceProfileInput `json:"-", xml:"-"`
 }
@@ -4194,6 +4717,11 @@ type metadataDeleteInstanceProfileInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteInstanceProfileInput.
+func (s DeleteInstanceProfileInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteInstanceProfileOutput struct {
 	metadataDeleteInstanceProfileOutput `json:"-", xml:"-"`
 }
@@ -4202,9 +4730,14 @@ type metadataDeleteInstanceProfileOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteInstanceProfileOutput.
+func (s DeleteInstanceProfileOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteLoginProfileInput struct {
 	// The name of the user whose password you want to delete.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDeleteLoginProfileInput `json:"-", xml:"-"`
 }
@@ -4213,6 +4746,11 @@ type metadataDeleteLoginProfileInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteLoginProfileInput.
+func (s DeleteLoginProfileInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteLoginProfileOutput struct {
 	metadataDeleteLoginProfileOutput `json:"-", xml:"-"`
 }
@@ -4221,11 +4759,16 @@ type metadataDeleteLoginProfileOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteLoginProfileOutput.
+func (s DeleteLoginProfileOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteOpenIDConnectProviderInput struct {
 	// The Amazon Resource Name (ARN) of the IAM OpenID Connect provider to delete.
 	// You can get a list of OpenID Connect provider ARNs by using the ListOpenIDConnectProviders
 	// action.
-	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" required:"true"`
+	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataDeleteOpenIDConnectProviderInput `json:"-", xml:"-"`
 }
@@ -4234,6 +4777,11 @@ type metadataDeleteOpenIDConnectProviderInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteOpenIDConnectProviderInput.
+func (s DeleteOpenIDConnectProviderInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteOpenIDConnectProviderOutput struct {
 	metadataDeleteOpenIDConnectProviderOutput `json:"-", xml:"-"`
 }
@@ -4242,13 +4790,18 @@ type metadataDeleteOpenIDConnectProviderOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteOpenIDConnectProviderOutput.
+func (s DeleteOpenIDConnectProviderOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeletePolicyInput struct {
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataDeletePolicyInput `json:"-", xml:"-"`
 }
@@ -4257,6 +4810,11 @@ type metadataDeletePolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeletePolicyInput.
+func (s DeletePolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeletePolicyOutput struct {
 	metadataDeletePolicyOutput `json:"-", xml:"-"`
 }
@@ -4265,20 +4823,25 @@ type metadataDeletePolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeletePolicyOutput.
+func (s DeletePolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeletePolicyVersionInput struct {
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The policy version to delete.
 	//
 	// For more information about managed policy versions, see Versioning for Managed
 	// Policies (http://docs.aws.amazon.com/IAM/latest/UserGuide/policies-managed-versions.html)
 	// in the Using IAM guide.
-	VersionID *string `locationName:"VersionId" type:"string" required:"true"`
+	VersionID *string `locationName:"VersionId" type:"string" pattern:"v[1-9][0-9]*(\\.[A-Za-z0-9-]*)?" required:"true"`
 
 	metadataDeletePolicyVersionInput `json:"-", xml:"-"`
 }
@@ -4287,6 +4850,11 @@ type metadataDeletePolicyVersionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeletePolicyVersionInput.
+func (s DeletePolicyVersionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeletePolicyVersionOutput struct {
 	metadataDeletePolicyVersionOutput `json:"-", xml:"-"`
 }
@@ -4295,9 +4863,14 @@ type metadataDeletePolicyVersionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeletePolicyVersionOutput.
+func (s DeletePolicyVersionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteRoleInput struct {
 	// The name of the role to delete.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDeleteRoleInput `json:"-", xml:"-"`
 }
@@ -4306,6 +4879,11 @@ type metadataDeleteRoleInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteRoleInput.
+func (s DeleteRoleInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteRoleOutput struct {
 	metadataDeleteRoleOutput `json:"-", xml:"-"`
 }
@@ -4314,13 +4892,18 @@ type metadataDeleteRoleOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteRoleOutput.
+func (s DeleteRoleOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteRolePolicyInput struct {
 	// The name identifying the policy document to delete.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name (friendly name, not ARN) identifying the role that the policy is
 	// embedded in.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDeleteRolePolicyInput `json:"-", xml:"-"`
 }
@@ -4329,6 +4912,11 @@ type metadataDeleteRolePolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteRolePolicyInput.
+func (s DeleteRolePolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteRolePolicyOutput struct {
 	metadataDeleteRolePolicyOutput `json:"-", xml:"-"`
 }
@@ -4337,9 +4925,14 @@ type metadataDeleteRolePolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteRolePolicyOutput.
+func (s DeleteRolePolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteSAMLProviderInput struct {
 	// The Amazon Resource Name (ARN) of the SAML provider to delete.
-	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string" required:"true"`
+	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataDeleteSAMLProviderInput `json:"-", xml:"-"`
 }
@@ -4348,6 +4941,11 @@ type metadataDeleteSAMLProviderInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteSAMLProviderInput.
+func (s DeleteSAMLProviderInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteSAMLProviderOutput struct {
 	metadataDeleteSAMLProviderOutput `json:"-", xml:"-"`
 }
@@ -4356,9 +4954,14 @@ type metadataDeleteSAMLProviderOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteSAMLProviderOutput.
+func (s DeleteSAMLProviderOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteServerCertificateInput struct {
 	// The name of the server certificate you want to delete.
-	ServerCertificateName *string `type:"string" required:"true"`
+	ServerCertificateName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDeleteServerCertificateInput `json:"-", xml:"-"`
 }
@@ -4367,6 +4970,11 @@ type metadataDeleteServerCertificateInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteServerCertificateInput.
+func (s DeleteServerCertificateInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteServerCertificateOutput struct {
 	metadataDeleteServerCertificateOutput `json:"-", xml:"-"`
 }
@@ -4375,12 +4983,17 @@ type metadataDeleteServerCertificateOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteServerCertificateOutput.
+func (s DeleteServerCertificateOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteSigningCertificateInput struct {
 	// The ID of the signing certificate to delete.
-	CertificateID *string `locationName:"CertificateId" type:"string" required:"true"`
+	CertificateID *string `locationName:"CertificateId" type:"string" min:"24" max:"128" pattern:"[\\w]*" required:"true"`
 
 	// The name of the user the signing certificate belongs to.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataDeleteSigningCertificateInput `json:"-", xml:"-"`
 }
@@ -4389,6 +5002,11 @@ type metadataDeleteSigningCertificateInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteSigningCertificateInput.
+func (s DeleteSigningCertificateInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteSigningCertificateOutput struct {
 	metadataDeleteSigningCertificateOutput `json:"-", xml:"-"`
 }
@@ -4397,9 +5015,14 @@ type metadataDeleteSigningCertificateOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteSigningCertificateOutput.
+func (s DeleteSigningCertificateOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteUserInput struct {
 	// The name of the user to delete.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDeleteUserInput `json:"-", xml:"-"`
 }
@@ -4408,6 +5031,11 @@ type metadataDeleteUserInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteUserInput.
+func (s DeleteUserInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteUserOutput struct {
 	metadataDeleteUserOutput `json:"-", xml:"-"`
 }
@@ -4416,13 +5044,18 @@ type metadataDeleteUserOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteUserOutput.
+func (s DeleteUserOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteUserPolicyInput struct {
 	// The name identifying the policy document to delete.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name (friendly name, not ARN) identifying the user that the policy is
 	// embedded in.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDeleteUserPolicyInput `json:"-", xml:"-"`
 }
@@ -4431,6 +5064,11 @@ type metadataDeleteUserPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteUserPolicyInput.
+func (s DeleteUserPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteUserPolicyOutput struct {
 	metadataDeleteUserPolicyOutput `json:"-", xml:"-"`
 }
@@ -4439,10 +5077,15 @@ type metadataDeleteUserPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteUserPolicyOutput.
+func (s DeleteUserPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteVirtualMFADeviceInput struct {
 	// The serial number that uniquely identifies the MFA device. For virtual MFA
 	// devices, the serial number is the same as the ARN.
-	SerialNumber *string `type:"string" required:"true"`
+	SerialNumber *string `type:"string" min:"9" max:"256" pattern:"[\\w+=/:,.@-]*" required:"true"`
 
 	metadataDeleteVirtualMFADeviceInput `json:"-", xml:"-"`
 }
@@ -4451,6 +5094,11 @@ type metadataDeleteVirtualMFADeviceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteVirtualMFADeviceInput.
+func (s DeleteVirtualMFADeviceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteVirtualMFADeviceOutput struct {
 	metadataDeleteVirtualMFADeviceOutput `json:"-", xml:"-"`
 }
@@ -4459,16 +5107,21 @@ type metadataDeleteVirtualMFADeviceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteVirtualMFADeviceOutput.
+func (s DeleteVirtualMFADeviceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DetachGroupPolicyInput struct {
 	// The name (friendly name, not ARN) of the group to detach the policy from.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataDetachGroupPolicyInput `json:"-", xml:"-"`
 }
@@ -4477,6 +5130,11 @@ type metadataDetachGroupPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DetachGroupPolicyInput.
+func (s DetachGroupPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DetachGroupPolicyOutput struct {
 	metadataDetachGroupPolicyOutput `json:"-", xml:"-"`
 }
@@ -4485,16 +5143,21 @@ type metadataDetachGroupPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DetachGroupPolicyOutput.
+func (s DetachGroupPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DetachRolePolicyInput struct {
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The name (friendly name, not ARN) of the role to detach the policy from.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDetachRolePolicyInput `json:"-", xml:"-"`
 }
@@ -4503,6 +5166,11 @@ type metadataDetachRolePolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DetachRolePolicyInput.
+func (s DetachRolePolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DetachRolePolicyOutput struct {
 	metadataDetachRolePolicyOutput `json:"-", xml:"-"`
 }
@@ -4511,16 +5179,21 @@ type metadataDetachRolePolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DetachRolePolicyOutput.
+func (s DetachRolePolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DetachUserPolicyInput struct {
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The name (friendly name, not ARN) of the user to detach the policy from.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataDetachUserPolicyInput `json:"-", xml:"-"`
 }
@@ -4529,6 +5202,11 @@ type metadataDetachUserPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DetachUserPolicyInput.
+func (s DetachUserPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DetachUserPolicyOutput struct {
 	metadataDetachUserPolicyOutput `json:"-", xml:"-"`
 }
@@ -4537,19 +5215,24 @@ type metadataDetachUserPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DetachUserPolicyOutput.
+func (s DetachUserPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type EnableMFADeviceInput struct {
 	// An authentication code emitted by the device.
-	AuthenticationCode1 *string `type:"string" required:"true"`
+	AuthenticationCode1 *string `type:"string" min:"6" max:"6" pattern:"[\\d]*" required:"true"`
 
 	// A subsequent authentication code emitted by the device.
-	AuthenticationCode2 *string `type:"string" required:"true"`
+	AuthenticationCode2 *string `type:"string" min:"6" max:"6" pattern:"[\\d]*" required:"true"`
 
 	// The serial number that uniquely identifies the MFA device. For virtual MFA
 	// devices, the serial number is the device ARN.
-	SerialNumber *string `type:"string" required:"true"`
+	SerialNumber *string `type:"string" min:"9" max:"256" pattern:"[\\w+=/:,.@-]*" required:"true"`
 
 	// The name of the user for whom you want to enable the MFA device.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataEnableMFADeviceInput `json:"-", xml:"-"`
 }
@@ -4558,6 +5241,11 @@ type metadataEnableMFADeviceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EnableMFADeviceInput.
+func (s EnableMFADeviceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type EnableMFADeviceOutput struct {
 	metadataEnableMFADeviceOutput `json:"-", xml:"-"`
 }
@@ -4566,6 +5254,47 @@ type metadataEnableMFADeviceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EnableMFADeviceOutput.
+func (s EnableMFADeviceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// EntityType is an enum of the values of EntityType members.
+type EntityType string
+
+// The values of EntityType.
+const (
+	EntityTypeUser  EntityType = "User"
+	EntityTypeRole  EntityType = "Role"
+	EntityTypeGroup EntityType = "Group"
+)
+
+// EntityTypeValues returns the values of EntityType known to this
+// version of the SDK. The service may accept values added since.
+func EntityTypeValues() []EntityType {
+	return []EntityType{
+		EntityType("User"),
+		EntityType("Role"),
+		EntityType("Group"),
+	}
+}
+
+// String returns the EntityType as the string of a member.
+func (v EntityType) String() string {
+	return string(v)
+}
+
+// ParseEntityType returns the EntityType of str, failing if it is not
+// one of the EntityTypeValues.
+func ParseEntityType(str string) (EntityType, error) {
+	for _, v := range EntityTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid EntityType value %q", str)
+}
+
 type GenerateCredentialReportInput struct {
 	metadataGenerateCredentialReportInput `json:"-", xml:"-"`
 }
@@ -4574,13 +5303,18 @@ type metadataGenerateCredentialReportInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GenerateCredentialReportInput.
+func (s GenerateCredentialReportInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful GenerateCredentialReport request.
 type GenerateCredentialReportOutput struct {
 	// Information about the credential report.
 	Description *string `type:"string"`
 
 	// Information about the state of the credential report.
-	State *string `type:"string"`
+	State *string `type:"string" enum:"STARTED,INPROGRESS,COMPLETE"`
 
 	metadataGenerateCredentialReportOutput `json:"-", xml:"-"`
 }
@@ -4589,6 +5323,11 @@ type metadataGenerateCredentialReportOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GenerateCredentialReportOutput.
+func (s GenerateCredentialReportOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetAccountAuthorizationDetailsInput struct {
 	// A list of entity types (user, group, or role) for filtering the results.
 	Filter []*string `type:"list"`
@@ -4596,13 +5335,13 @@ type GetAccountAuthorizationDetailsInput struct {
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// Use this only when paginating results to indicate the maximum number of items
 	// you want in the response. If there are additional items beyond the maximum
 	// you specify, the IsTruncated response element is true. This parameter is
 	// optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	metadataGetAccountAuthorizationDetailsInput `json:"-", xml:"-"`
 }
@@ -4611,6 +5350,11 @@ type metadataGetAccountAuthorizationDetailsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetAccountAuthorizationDetailsInput.
+func (s GetAccountAuthorizationDetailsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful GetAccountAuthorizationDetails request.
 type GetAccountAuthorizationDetailsOutput struct {
 	// A list containing information about IAM groups.
@@ -4623,7 +5367,7 @@ type GetAccountAuthorizationDetailsOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// A list containing information about IAM roles.
 	RoleDetailList []*RoleDetail `type:"list"`
@@ -4638,6 +5382,11 @@ type metadataGetAccountAuthorizationDetailsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetAccountAuthorizationDetailsOutput.
+func (s GetAccountAuthorizationDetailsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetAccountPasswordPolicyInput struct {
 	metadataGetAccountPasswordPolicyInput `json:"-", xml:"-"`
 }
@@ -4646,6 +5395,11 @@ type metadataGetAccountPasswordPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetAccountPasswordPolicyInput.
+func (s GetAccountPasswordPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful GetAccountPasswordPolicy request.
 type GetAccountPasswordPolicyOutput struct {
 	// Contains information about the account password policy.
@@ -4661,6 +5415,11 @@ type metadataGetAccountPasswordPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetAccountPasswordPolicyOutput.
+func (s GetAccountPasswordPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetAccountSummaryInput struct {
 	metadataGetAccountSummaryInput `json:"-", xml:"-"`
 }
@@ -4669,6 +5428,11 @@ type metadataGetAccountSummaryInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetAccountSummaryInput.
+func (s GetAccountSummaryInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful GetAccountSummary request.
 type GetAccountSummaryOutput struct {
 	// A set of key value pairs containing information about IAM entity usage and
@@ -4823,6 +5587,11 @@ type metadataGetAccountSummaryOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetAccountSummaryOutput.
+func (s GetAccountSummaryOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetCredentialReportInput struct {
 	metadataGetCredentialReportInput `json:"-", xml:"-"`
 }
@@ -4831,6 +5600,11 @@ type metadataGetCredentialReportInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetCredentialReportInput.
+func (s GetCredentialReportInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful GetCredentialReport request.
 type GetCredentialReportOutput struct {
 	// Contains the credential report. The report is Base64-encoded.
@@ -4841,7 +5615,7 @@ type GetCredentialReportOutput struct {
 	GeneratedTime *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The format (MIME type) of the credential report.
-	ReportFormat *string `type:"string"`
+	ReportFormat *string `type:"string" enum:"text/csv"`
 
 	metadataGetCredentialReportOutput `json:"-", xml:"-"`
 }
@@ -4850,20 +5624,25 @@ type metadataGetCredentialReportOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetCredentialReportOutput.
+func (s GetCredentialReportOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetGroupInput struct {
 	// The name of the group.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// Use this only when paginating results to indicate the maximum number of groups
 	// you want in the response. If there are additional groups beyond the maximum
 	// you specify, the IsTruncated response element is true. This parameter is
 	// optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	metadataGetGroupInput `json:"-", xml:"-"`
 }
@@ -4872,6 +5651,11 @@ type metadataGetGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetGroupInput.
+func (s GetGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful GetGroup request.
 type GetGroupOutput struct {
 	// Information about the group.
@@ -4884,7 +5668,7 @@ type GetGroupOutput struct {
 
 	// If IsTruncated is true, then this element is present and contains the value
 	// to use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// A list of users in the group.
 	Users []*User `type:"list" required:"true"`
@@ -4896,12 +5680,17 @@ type metadataGetGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetGroupOutput.
+func (s GetGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetGroupPolicyInput struct {
 	// The name of the group the policy is associated with.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name of the policy document to get.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataGetGroupPolicyInput `json:"-", xml:"-"`
 }
@@ -4910,16 +5699,21 @@ type metadataGetGroupPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetGroupPolicyInput.
+func (s GetGroupPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful GetGroupPolicy request.
 type GetGroupPolicyOutput struct {
 	// The group the policy is associated with.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The policy document.
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\u0009\\u000A\\u000D\\u0020-\\u00FF]+" required:"true"`
 
 	// The name of the policy.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataGetGroupPolicyOutput `json:"-", xml:"-"`
 }
@@ -4928,9 +5722,14 @@ type metadataGetGroupPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetGroupPolicyOutput.
+func (s GetGroupPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetInstanceProfileInput struct {
 	// The name of the instance profile to get information about.
-	InstanceProfileName *string `type:"string" required:"true"`
+	InstanceProfileName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataGetInstanceProfileInput `json:"-", xml:"-"`
 }
@@ -4939,6 +5738,11 @@ type metadataGetInstanceProfileInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetInstanceProfileInput.
+func (s GetInstanceProfileInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful GetInstanceProfile request.
 type GetInstanceProfileOutput struct {
 	// Information about the instance profile.
@@ -4951,9 +5755,14 @@ type metadataGetInstanceProfileOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetInstanceProfileOutput.
+func (s GetInstanceProfileOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetLoginProfileInput struct {
 	// The name of the user whose login profile you want to retrieve.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataGetLoginProfileInput `json:"-", xml:"-"`
 }
@@ -4962,6 +5771,11 @@ type metadataGetLoginProfileInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetLoginProfileInput.
+func (s GetLoginProfileInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful GetLoginProfile request.
 type GetLoginProfileOutput struct {
 	// The user name and password create date for the user.
@@ -4974,11 +5788,16 @@ type metadataGetLoginProfileOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetLoginProfileOutput.
+func (s GetLoginProfileOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetOpenIDConnectProviderInput struct {
 	// The Amazon Resource Name (ARN) of the IAM OpenID Connect (OIDC) provider
 	// to get information for. You can get a list of OIDC provider ARNs by using
 	// the ListOpenIDConnectProviders action.
-	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" required:"true"`
+	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataGetOpenIDConnectProviderInput `json:"-", xml:"-"`
 }
@@ -4987,7 +5806,12 @@ type metadataGetOpenIDConnectProviderInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-// Contains the response to a successful GetOpenIDConnectProvider request.
+// String returns the string representation of the GetOpenIDConnectProviderInput.
+func (s GetOpenIDConnectProviderInput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// Contains the response to a successful GetOpenIDConnectProvider request.
 type GetOpenIDConnectProviderOutput struct {
 	// A list of client IDs (also known as audiences) that are associated with the
 	// specified IAM OpenID Connect provider. For more information, see CreateOpenIDConnectProvider.
@@ -5003,7 +5827,7 @@ type GetOpenIDConnectProviderOutput struct {
 
 	// The URL that the IAM OpenID Connect provider is associated with. For more
 	// information, see CreateOpenIDConnectProvider.
-	URL *string `locationName:"Url" type:"string"`
+	URL *string `locationName:"Url" type:"string" min:"1" max:"255"`
 
 	metadataGetOpenIDConnectProviderOutput `json:"-", xml:"-"`
 }
@@ -5012,13 +5836,18 @@ type metadataGetOpenIDConnectProviderOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetOpenIDConnectProviderOutput.
+func (s GetOpenIDConnectProviderOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetPolicyInput struct {
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataGetPolicyInput `json:"-", xml:"-"`
 }
@@ -5027,6 +5856,11 @@ type metadataGetPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetPolicyInput.
+func (s GetPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful GetPolicy request.
 type GetPolicyOutput struct {
 	// Information about the policy.
@@ -5039,16 +5873,21 @@ type metadataGetPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetPolicyOutput.
+func (s GetPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetPolicyVersionInput struct {
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// Identifies the policy version to retrieve.
-	VersionID *string `locationName:"VersionId" type:"string" required:"true"`
+	VersionID *string `locationName:"VersionId" type:"string" pattern:"v[1-9][0-9]*(\\.[A-Za-z0-9-]*)?" required:"true"`
 
 	metadataGetPolicyVersionInput `json:"-", xml:"-"`
 }
@@ -5057,6 +5896,11 @@ type metadataGetPolicyVersionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetPolicyVersionInput.
+func (s GetPolicyVersionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful GetPolicyVersion request.
 type GetPolicyVersionOutput struct {
 	// Information about the policy version.
@@ -5073,9 +5917,14 @@ type metadataGetPolicyVersionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetPolicyVersionOutput.
+func (s GetPolicyVersionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetRoleInput struct {
 	// The name of the role to get information about.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataGetRoleInput `json:"-", xml:"-"`
 }
@@ -5084,6 +5933,11 @@ type metadataGetRoleInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetRoleInput.
+func (s GetRoleInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful GetRole request.
 type GetRoleOutput struct {
 	// Information about the role.
@@ -5096,12 +5950,17 @@ type metadataGetRoleOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetRoleOutput.
+func (s GetRoleOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetRolePolicyInput struct {
 	// The name of the policy document to get.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name of the role associated with the policy.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataGetRolePolicyInput `json:"-", xml:"-"`
 }
@@ -5110,16 +5969,21 @@ type metadataGetRolePolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetRolePolicyInput.
+func (s GetRolePolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful GetRolePolicy request.
 type GetRolePolicyOutput struct {
 	// The policy document.
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\u0009\\u000A\\u000D\\u0020-\\u00FF]+" required:"true"`
 
 	// The name of the policy.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The role the policy is associated with.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataGetRolePolicyOutput `json:"-", xml:"-"`
 }
@@ -5128,9 +5992,14 @@ type metadataGetRolePolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetRolePolicyOutput.
+func (s GetRolePolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetSAMLProviderInput struct {
 	// The Amazon Resource Name (ARN) of the SAML provider to get information about.
-	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string" required:"true"`
+	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataGetSAMLProviderInput `json:"-", xml:"-"`
 }
@@ -5139,13 +6008,18 @@ type metadataGetSAMLProviderInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetSAMLProviderInput.
+func (s GetSAMLProviderInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful GetSAMLProvider request.
 type GetSAMLProviderOutput struct {
 	// The date and time when the SAML provider was created.
 	CreateDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The XML metadata document that includes information about an identity provider.
-	SAMLMetadataDocument *string `type:"string"`
+	SAMLMetadataDocument *string `type:"string" min:"1000" max:"10000000"`
 
 	// The expiration date and time for the SAML provider.
 	ValidUntil *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -5157,9 +6031,14 @@ type metadataGetSAMLProviderOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetSAMLProviderOutput.
+func (s GetSAMLProviderOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetServerCertificateInput struct {
 	// The name of the server certificate you want to retrieve information about.
-	ServerCertificateName *string `type:"string" required:"true"`
+	ServerCertificateName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataGetServerCertificateInput `json:"-", xml:"-"`
 }
@@ -5168,6 +6047,11 @@ type metadataGetServerCertificateInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetServerCertificateInput.
+func (s GetServerCertificateInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful GetServerCertificate request.
 type GetServerCertificateOutput struct {
 	// Information about the server certificate.
@@ -5180,12 +6064,17 @@ type metadataGetServerCertificateOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetServerCertificateOutput.
+func (s GetServerCertificateOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetUserInput struct {
 	// The name of the user to get information about.
 	//
 	// This parameter is optional. If it is not included, it defaults to the user
 	// making the request.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataGetUserInput `json:"-", xml:"-"`
 }
@@ -5194,6 +6083,11 @@ type metadataGetUserInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetUserInput.
+func (s GetUserInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful GetUser request.
 type GetUserOutput struct {
 	// Information about the user.
@@ -5206,12 +6100,17 @@ type metadataGetUserOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetUserOutput.
+func (s GetUserOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetUserPolicyInput struct {
 	// The name of the policy document to get.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name of the user who the policy is associated with.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataGetUserPolicyInput `json:"-", xml:"-"`
 }
@@ -5220,16 +6119,21 @@ type metadataGetUserPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetUserPolicyInput.
+func (s GetUserPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful GetUserPolicy request.
 type GetUserPolicyOutput struct {
 	// The policy document.
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\u0009\\u000A\\u000D\\u0020-\\u00FF]+" required:"true"`
 
 	// The name of the policy.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The user the policy is associated with.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataGetUserPolicyOutput `json:"-", xml:"-"`
 }
@@ -5238,6 +6142,11 @@ type metadataGetUserPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetUserPolicyOutput.
+func (s GetUserPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains information about an IAM group entity.
 //
 //  This data type is used as a response element in the following actions:
@@ -5247,7 +6156,7 @@ type Group struct {
 	// The Amazon Resource Name (ARN) specifying the group. For more information
 	// about ARNs and how to use them in policies, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	ARN *string `locationName:"Arn" type:"string" required:"true"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The date and time, in ISO 8601 date-time format (http://www.iso.org/iso/iso8601),
 	// when the group was created.
@@ -5256,15 +6165,15 @@ type Group struct {
 	// The stable and unique string identifying the group. For more information
 	// about IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	GroupID *string `locationName:"GroupId" type:"string" required:"true"`
+	GroupID *string `locationName:"GroupId" type:"string" min:"16" max:"32" pattern:"[\\w]*" required:"true"`
 
 	// The friendly name that identifies the group.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The path to the group. For more information about paths, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string" required:"true"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\u002F)|(\\u002F[\\u0021-\\u007F]+\\u002F)" required:"true"`
 
 	metadataGroup `json:"-", xml:"-"`
 }
@@ -5273,6 +6182,11 @@ type metadataGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Group.
+func (s Group) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains information about an IAM group, including all of the group's policies.
 //
 // This data type is used as a response element in the GetAccountAuthorizationDetails
@@ -5283,7 +6197,7 @@ type GroupDetail struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	ARN *string `locationName:"Arn" type:"string"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048"`
 
 	// The date and time, in ISO 8601 date-time format (http://www.iso.org/iso/iso8601),
 	// when the group was created.
@@ -5292,10 +6206,10 @@ type GroupDetail struct {
 	// The stable and unique string identifying the group. For more information
 	// about IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	GroupID *string `locationName:"GroupId" type:"string"`
+	GroupID *string `locationName:"GroupId" type:"string" min:"16" max:"32" pattern:"[\\w]*"`
 
 	// The friendly name that identifies the group.
-	GroupName *string `type:"string"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	// A list of the group's policies.
 	GroupPolicyList []*PolicyDetail `type:"list"`
@@ -5303,7 +6217,7 @@ type GroupDetail struct {
 	// The path to the group. For more information about paths, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\u002F)|(\\u002F[\\u0021-\\u007F]+\\u002F)"`
 
 	metadataGroupDetail `json:"-", xml:"-"`
 }
@@ -5312,6 +6226,11 @@ type metadataGroupDetail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GroupDetail.
+func (s GroupDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains information about an instance profile.
 //
 // This data type is used as a response element in the following actions:
@@ -5328,7 +6247,7 @@ type InstanceProfile struct {
 	// information about ARNs and how to use them in policies, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	ARN *string `locationName:"Arn" type:"string" required:"true"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The date when the instance profile was created.
 	CreateDate *time.Time `type:"timestamp" timestampFormat:"iso8601" required:"true"`
@@ -5336,15 +6255,15 @@ type InstanceProfile struct {
 	// The stable and unique string identifying the instance profile. For more information
 	// about IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	InstanceProfileID *string `locationName:"InstanceProfileId" type:"string" required:"true"`
+	InstanceProfileID *string `locationName:"InstanceProfileId" type:"string" min:"16" max:"32" pattern:"[\\w]*" required:"true"`
 
 	// The name identifying the instance profile.
-	InstanceProfileName *string `type:"string" required:"true"`
+	InstanceProfileName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The path to the instance profile. For more information about paths, see IAM
 	// Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string" required:"true"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\u002F)|(\\u002F[\\u0021-\\u007F]+\\u002F)" required:"true"`
 
 	// The role associated with the instance profile.
 	Roles []*Role `type:"list" required:"true"`
@@ -5356,20 +6275,25 @@ type metadataInstanceProfile struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InstanceProfile.
+func (s InstanceProfile) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListAccessKeysInput struct {
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// Use this parameter only when paginating results to indicate the maximum number
 	// of keys you want in the response. If there are additional keys beyond the
 	// maximum you specify, the IsTruncated response element is true. This parameter
 	// is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The name of the user.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataListAccessKeysInput `json:"-", xml:"-"`
 }
@@ -5378,6 +6302,11 @@ type metadataListAccessKeysInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListAccessKeysInput.
+func (s ListAccessKeysInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful ListAccessKeys request.
 type ListAccessKeysOutput struct {
 	// A list of access key metadata.
@@ -5390,7 +6319,7 @@ type ListAccessKeysOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	metadataListAccessKeysOutput `json:"-", xml:"-"`
 }
@@ -5399,17 +6328,22 @@ type metadataListAccessKeysOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListAccessKeysOutput.
+func (s ListAccessKeysOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListAccountAliasesInput struct {
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// Use this only when paginating results to indicate the maximum number of account
 	// aliases you want in the response. If there are additional account aliases
 	// beyond the maximum you specify, the IsTruncated response element is true.
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	metadataListAccountAliasesInput `json:"-", xml:"-"`
 }
@@ -5418,6 +6352,11 @@ type metadataListAccountAliasesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListAccountAliasesInput.
+func (s ListAccountAliasesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful ListAccountAliases request.
 type ListAccountAliasesOutput struct {
 	// A list of aliases associated with the account.
@@ -5432,7 +6371,7 @@ type ListAccountAliasesOutput struct {
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	metadataListAccountAliasesOutput `json:"-", xml:"-"`
 }
@@ -5441,25 +6380,30 @@ type metadataListAccountAliasesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListAccountAliasesOutput.
+func (s ListAccountAliasesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListAttachedGroupPoliciesInput struct {
 	// The name (friendly name, not ARN) of the group to list attached policies
 	// for.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// Use this only when paginating results to indicate the maximum number of policies
 	// you want in the response. If there are additional policies beyond the maximum
 	// you specify, the IsTruncated response element is true. This parameter is
 	// optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. This parameter is optional. If
 	// it is not included, it defaults to a slash (/), listing all policies.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" pattern:"((/[A-Za-z0-9\\.,\\+@=_-]+)*)/"`
 
 	metadataListAttachedGroupPoliciesInput `json:"-", xml:"-"`
 }
@@ -5468,6 +6412,11 @@ type metadataListAttachedGroupPoliciesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListAttachedGroupPoliciesInput.
+func (s ListAttachedGroupPoliciesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful ListAttachedGroupPolicies request.
 type ListAttachedGroupPoliciesOutput struct {
 	// A list of the attached policies.
@@ -5480,7 +6429,7 @@ type ListAttachedGroupPoliciesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	metadataListAttachedGroupPoliciesOutput `json:"-", xml:"-"`
 }
@@ -5489,24 +6438,29 @@ type metadataListAttachedGroupPoliciesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListAttachedGroupPoliciesOutput.
+func (s ListAttachedGroupPoliciesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListAttachedRolePoliciesInput struct {
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// Use this only when paginating results to indicate the maximum number of policies
 	// you want in the response. If there are additional policies beyond the maximum
 	// you specify, the IsTruncated response element is true. This parameter is
 	// optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. This parameter is optional. If
 	// it is not included, it defaults to a slash (/), listing all policies.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" pattern:"((/[A-Za-z0-9\\.,\\+@=_-]+)*)/"`
 
 	// The name (friendly name, not ARN) of the role to list attached policies for.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataListAttachedRolePoliciesInput `json:"-", xml:"-"`
 }
@@ -5515,6 +6469,11 @@ type metadataListAttachedRolePoliciesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListAttachedRolePoliciesInput.
+func (s ListAttachedRolePoliciesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful ListAttachedRolePolicies request.
 type ListAttachedRolePoliciesOutput struct {
 	// A list of the attached policies.
@@ -5527,7 +6486,7 @@ type ListAttachedRolePoliciesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	metadataListAttachedRolePoliciesOutput `json:"-", xml:"-"`
 }
@@ -5536,24 +6495,29 @@ type metadataListAttachedRolePoliciesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListAttachedRolePoliciesOutput.
+func (s ListAttachedRolePoliciesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListAttachedUserPoliciesInput struct {
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// Use this only when paginating results to indicate the maximum number of policies
 	// you want in the response. If there are additional policies beyond the maximum
 	// you specify, the IsTruncated response element is true. This parameter is
 	// optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. This parameter is optional. If
 	// it is not included, it defaults to a slash (/), listing all policies.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" pattern:"((/[A-Za-z0-9\\.,\\+@=_-]+)*)/"`
 
 	// The name (friendly name, not ARN) of the user to list attached policies for.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataListAttachedUserPoliciesInput `json:"-", xml:"-"`
 }
@@ -5562,6 +6526,11 @@ type metadataListAttachedUserPoliciesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListAttachedUserPoliciesInput.
+func (s ListAttachedUserPoliciesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful ListAttachedUserPolicies request.
 type ListAttachedUserPoliciesOutput struct {
 	// A list of the attached policies.
@@ -5574,7 +6543,7 @@ type ListAttachedUserPoliciesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	metadataListAttachedUserPoliciesOutput `json:"-", xml:"-"`
 }
@@ -5583,35 +6552,40 @@ type metadataListAttachedUserPoliciesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListAttachedUserPoliciesOutput.
+func (s ListAttachedUserPoliciesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListEntitiesForPolicyInput struct {
 	// The entity type to use for filtering the results.
 	//
 	// For example, when EntityFilter is Role, only the roles that are attached
 	// to the specified policy are returned. This parameter is optional. If it is
 	// not included, all attached entities (users, groups, and roles) are returned.
-	EntityFilter *string `type:"string"`
+	EntityFilter *string `type:"string" enum:"User,Role,Group"`
 
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// Use this only when paginating results to indicate the maximum number of entities
 	// you want in the response. If there are additional entities beyond the maximum
 	// you specify, the IsTruncated response element is true. This parameter is
 	// optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. This parameter is optional. If
 	// it is not included, it defaults to a slash (/), listing all entities.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" min:"1" max:"512" pattern:"(\\u002F)|(\\u002F[\\u0021-\\u007F]+\\u002F)"`
 
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataListEntitiesForPolicyInput `json:"-", xml:"-"`
 }
@@ -5620,6 +6594,11 @@ type metadataListEntitiesForPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListEntitiesForPolicyInput.
+func (s ListEntitiesForPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful ListEntitiesForPolicy request.
 type ListEntitiesForPolicyOutput struct {
 	// A flag that indicates whether there are more entities to list. If your results
@@ -5629,7 +6608,7 @@ type ListEntitiesForPolicyOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// A list of groups that the policy is attached to.
 	PolicyGroups []*PolicyGroup `type:"list"`
@@ -5647,20 +6626,25 @@ type metadataListEntitiesForPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListEntitiesForPolicyOutput.
+func (s ListEntitiesForPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListGroupPoliciesInput struct {
 	// The name of the group to list policies for.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// Use this only when paginating results to indicate the maximum number of policy
 	// names you want in the response. If there are additional policy names beyond
 	// the maximum you specify, the IsTruncated response element is true. This parameter
 	// is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	metadataListGroupPoliciesInput `json:"-", xml:"-"`
 }
@@ -5669,6 +6653,11 @@ type metadataListGroupPoliciesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListGroupPoliciesInput.
+func (s ListGroupPoliciesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful ListGroupPolicies request.
 type ListGroupPoliciesOutput struct {
 	// A flag that indicates whether there are more policy names to list. If your
@@ -5678,7 +6667,7 @@ type ListGroupPoliciesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// A list of policy names.
 	PolicyNames []*string `type:"list" required:"true"`
@@ -5690,20 +6679,25 @@ type metadataListGroupPoliciesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListGroupPoliciesOutput.
+func (s ListGroupPoliciesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListGroupsForUserInput struct {
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// Use this only when paginating results to indicate the maximum number of groups
 	// you want in the response. If there are additional groups beyond the maximum
 	// you specify, the IsTruncated response element is true. This parameter is
 	// optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The name of the user to list groups for.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataListGroupsForUserInput `json:"-", xml:"-"`
 }
@@ -5712,6 +6706,11 @@ type metadataListGroupsForUserInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListGroupsForUserInput.
+func (s ListGroupsForUserInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful ListGroupsForUser request.
 type ListGroupsForUserOutput struct {
 	// A list of groups.
@@ -5724,7 +6723,7 @@ type ListGroupsForUserOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	metadataListGroupsForUserOutput `json:"-", xml:"-"`
 }
@@ -5733,24 +6732,29 @@ type metadataListGroupsForUserOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListGroupsForUserOutput.
+func (s ListGroupsForUserOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListGroupsInput struct {
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// Use this only when paginating results to indicate the maximum number of groups
 	// you want in the response. If there are additional groups beyond the maximum
 	// you specify, the IsTruncated response element is true. This parameter is
 	// optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. For example, the prefix /division_abc/subdivision_xyz/
 	// gets all groups whose path starts with /division_abc/subdivision_xyz/.
 	//
 	//  This parameter is optional. If it is not included, it defaults to a slash
 	// (/), listing all groups.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" min:"1" max:"512" pattern:"\\u002F[\\u0021-\\u007F]*"`
 
 	metadataListGroupsInput `json:"-", xml:"-"`
 }
@@ -5759,6 +6763,11 @@ type metadataListGroupsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListGroupsInput.
+func (s ListGroupsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful ListGroups request.
 type ListGroupsOutput struct {
 	// A list of groups.
@@ -5771,7 +6780,7 @@ type ListGroupsOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	metadataListGroupsOutput `json:"-", xml:"-"`
 }
@@ -5780,21 +6789,26 @@ type metadataListGroupsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListGroupsOutput.
+func (s ListGroupsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListInstanceProfilesForRoleInput struct {
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// Use this parameter only when paginating results to indicate the maximum number
 	// of instance profiles you want in the response. If there are additional instance
 	// profiles beyond the maximum you specify, the IsTruncated response element
 	// is true. This parameter is optional. If you do not include it, it defaults
 	// to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The name of the role to list instance profiles for.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataListInstanceProfilesForRoleInput `json:"-", xml:"-"`
 }
@@ -5803,6 +6817,11 @@ type metadataListInstanceProfilesForRoleInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListInstanceProfilesForRoleInput.
+func (s ListInstanceProfilesForRoleInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful ListInstanceProfilesForRole request.
 type ListInstanceProfilesForRoleOutput struct {
 	// A list of instance profiles.
@@ -5816,7 +6835,7 @@ type ListInstanceProfilesForRoleOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	metadataListInstanceProfilesForRoleOutput `json:"-", xml:"-"`
 }
@@ -5825,25 +6844,30 @@ type metadataListInstanceProfilesForRoleOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListInstanceProfilesForRoleOutput.
+func (s ListInstanceProfilesForRoleOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListInstanceProfilesInput struct {
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// Use this parameter only when paginating results to indicate the maximum number
 	// of instance profiles you want in the response. If there are additional instance
 	// profiles beyond the maximum you specify, the IsTruncated response element
 	// is true. This parameter is optional. If you do not include it, it defaults
 	// to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. For example, the prefix /application_abc/component_xyz/
 	// gets all instance profiles whose path starts with /application_abc/component_xyz/.
 	//
 	//  This parameter is optional. If it is not included, it defaults to a slash
 	// (/), listing all instance profiles.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" min:"1" max:"512" pattern:"\\u002F[\\u0021-\\u007F]*"`
 
 	metadataListInstanceProfilesInput `json:"-", xml:"-"`
 }
@@ -5852,6 +6876,11 @@ type metadataListInstanceProfilesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListInstanceProfilesInput.
+func (s ListInstanceProfilesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful ListInstanceProfiles request.
 type ListInstanceProfilesOutput struct {
 	// A list of instance profiles.
@@ -5865,7 +6894,7 @@ type ListInstanceProfilesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	metadataListInstanceProfilesOutput `json:"-", xml:"-"`
 }
@@ -5874,20 +6903,25 @@ type metadataListInstanceProfilesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListInstanceProfilesOutput.
+func (s ListInstanceProfilesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListMFADevicesInput struct {
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// Use this only when paginating results to indicate the maximum number of MFA
 	// devices you want in the response. If there are additional MFA devices beyond
 	// the maximum you specify, the IsTruncated response element is true. This parameter
 	// is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The name of the user whose MFA devices you want to list.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataListMFADevicesInput `json:"-", xml:"-"`
 }
@@ -5896,6 +6930,11 @@ type metadataListMFADevicesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListMFADevicesInput.
+func (s ListMFADevicesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful ListMFADevices request.
 type ListMFADevicesOutput struct {
 	// A flag that indicates whether there are more MFA devices to list. If your
@@ -5908,7 +6947,7 @@ type ListMFADevicesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	metadataListMFADevicesOutput `json:"-", xml:"-"`
 }
@@ -5917,6 +6956,11 @@ type metadataListMFADevicesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListMFADevicesOutput.
+func (s ListMFADevicesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListOpenIDConnectProvidersInput struct {
 	metadataListOpenIDConnectProvidersInput `json:"-", xml:"-"`
 }
@@ -5925,6 +6969,11 @@ type metadataListOpenIDConnectProvidersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListOpenIDConnectProvidersInput.
+func (s ListOpenIDConnectProvidersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful ListOpenIDConnectProviders request.
 type ListOpenIDConnectProvidersOutput struct {
 	// The list of IAM OpenID Connect providers in the AWS account.
@@ -5937,17 +6986,22 @@ type metadataListOpenIDConnectProvidersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListOpenIDConnectProvidersOutput.
+func (s ListOpenIDConnectProvidersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListPoliciesInput struct {
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// Use this parameter only when paginating results to indicate the maximum number
 	// of policies you want in the response. If there are additional policies beyond
 	// the maximum you specify, the IsTruncated response element is true. This parameter
 	// is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// A flag to filter the results to only the attached policies.
 	//
@@ -5958,7 +7012,7 @@ type ListPoliciesInput struct {
 
 	// The path prefix for filtering the results. This parameter is optional. If
 	// it is not included, it defaults to a slash (/), listing all policies.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" pattern:"((/[A-Za-z0-9\\.,\\+@=_-]+)*)/"`
 
 	// The scope to use for filtering the results.
 	//
@@ -5967,7 +7021,7 @@ type ListPoliciesInput struct {
 	//
 	// This parameter is optional. If it is not included, or if it is set to All,
 	// all policies are returned.
-	Scope *string `type:"string"`
+	Scope *string `type:"string" enum:"All,AWS,Local"`
 
 	metadataListPoliciesInput `json:"-", xml:"-"`
 }
@@ -5976,6 +7030,11 @@ type metadataListPoliciesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListPoliciesInput.
+func (s ListPoliciesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful ListPolicies request.
 type ListPoliciesOutput struct {
 	// A flag that indicates whether there are more policies to list. If your results
@@ -5985,7 +7044,7 @@ type ListPoliciesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// A list of policies.
 	Policies []*Policy `type:"list"`
@@ -5997,25 +7056,30 @@ type metadataListPoliciesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListPoliciesOutput.
+func (s ListPoliciesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListPolicyVersionsInput struct {
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// Use this parameter only when paginating results to indicate the maximum number
 	// of policy versions you want in the response. If there are additional policy
 	// versions beyond the maximum you specify, the IsTruncated response element
 	// is true. This parameter is optional. If you do not include it, it defaults
 	// to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataListPolicyVersionsInput `json:"-", xml:"-"`
 }
@@ -6024,6 +7088,11 @@ type metadataListPolicyVersionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListPolicyVersionsInput.
+func (s ListPolicyVersionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful ListPolicyVersions request.
 type ListPolicyVersionsOutput struct {
 	// A flag that indicates whether there are more policy versions to list. If
@@ -6034,7 +7103,7 @@ type ListPolicyVersionsOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// A list of policy versions.
 	//
@@ -6050,20 +7119,25 @@ type metadataListPolicyVersionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListPolicyVersionsOutput.
+func (s ListPolicyVersionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListRolePoliciesInput struct {
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// Use this parameter only when paginating results to indicate the maximum number
 	// of role policies you want in the response. If there are additional role policies
 	// beyond the maximum you specify, the IsTruncated response element is true.
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The name of the role to list policies for.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataListRolePoliciesInput `json:"-", xml:"-"`
 }
@@ -6072,6 +7146,11 @@ type metadataListRolePoliciesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListRolePoliciesInput.
+func (s ListRolePoliciesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful ListRolePolicies request.
 type ListRolePoliciesOutput struct {
 	// A flag that indicates whether there are more policy names to list. If your
@@ -6081,7 +7160,7 @@ type ListRolePoliciesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// A list of policy names.
 	PolicyNames []*string `type:"list" required:"true"`
@@ -6093,24 +7172,29 @@ type metadataListRolePoliciesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListRolePoliciesOutput.
+func (s ListRolePoliciesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListRolesInput struct {
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// Use this parameter only when paginating results to indicate the maximum number
 	// of roles you want in the response. If there are additional roles beyond the
 	// maximum you specify, the IsTruncated response element is true. This parameter
 	// is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. For example, the prefix /application_abc/component_xyz/
 	// gets all roles whose path starts with /application_abc/component_xyz/.
 	//
 	//  This parameter is optional. If it is not included, it defaults to a slash
 	// (/), listing all roles.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" min:"1" max:"512" pattern:"\\u002F[\\u0021-\\u007F]*"`
 
 	metadataListRolesInput `json:"-", xml:"-"`
 }
@@ -6119,6 +7203,11 @@ type metadataListRolesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListRolesInput.
+func (s ListRolesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful ListRoles request.
 type ListRolesOutput struct {
 	// A flag that indicates whether there are more roles to list. If your results
@@ -6128,7 +7217,7 @@ type ListRolesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// A list of roles.
 	Roles []*Role `type:"list" required:"true"`
@@ -6140,6 +7229,11 @@ type metadataListRolesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListRolesOutput.
+func (s ListRolesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListSAMLProvidersInput struct {
 	metadataListSAMLProvidersInput `json:"-", xml:"-"`
 }
@@ -6148,6 +7242,11 @@ type metadataListSAMLProvidersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListSAMLProvidersInput.
+func (s ListSAMLProvidersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful ListSAMLProviders request.
 type ListSAMLProvidersOutput struct {
 	// The list of SAML providers for this account.
@@ -6160,25 +7259,30 @@ type metadataListSAMLProvidersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListSAMLProvidersOutput.
+func (s ListSAMLProvidersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListServerCertificatesInput struct {
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// Use this only when paginating results to indicate the maximum number of server
 	// certificates you want in the response. If there are additional server certificates
 	// beyond the maximum you specify, the IsTruncated response element will be
 	// set to true. This parameter is optional. If you do not include it, it defaults
 	// to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. For example: /company/servercerts
 	// would get all server certificates for which the path starts with /company/servercerts.
 	//
 	//  This parameter is optional. If it is not included, it defaults to a slash
 	// (/), listing all server certificates.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" min:"1" max:"512" pattern:"\\u002F[\\u0021-\\u007F]*"`
 
 	metadataListServerCertificatesInput `json:"-", xml:"-"`
 }
@@ -6187,6 +7291,11 @@ type metadataListServerCertificatesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListServerCertificatesInput.
+func (s ListServerCertificatesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful ListServerCertificates request.
 type ListServerCertificatesOutput struct {
 	// A flag that indicates whether there are more server certificates to list.
@@ -6197,7 +7306,7 @@ type ListServerCertificatesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// A list of server certificates.
 	ServerCertificateMetadataList []*ServerCertificateMetadata `type:"list" required:"true"`
@@ -6209,20 +7318,25 @@ type metadataListServerCertificatesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListServerCertificatesOutput.
+func (s ListServerCertificatesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListSigningCertificatesInput struct {
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// Use this only when paginating results to indicate the maximum number of certificate
 	// IDs you want in the response. If there are additional certificate IDs beyond
 	// the maximum you specify, the IsTruncated response element is true. This parameter
 	// is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The name of the user.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataListSigningCertificatesInput `json:"-", xml:"-"`
 }
@@ -6231,6 +7345,11 @@ type metadataListSigningCertificatesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListSigningCertificatesInput.
+func (s ListSigningCertificatesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful ListSigningCertificates request.
 type ListSigningCertificatesOutput struct {
 	// A list of the user's signing certificate information.
@@ -6243,7 +7362,7 @@ type ListSigningCertificatesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	metadataListSigningCertificatesOutput `json:"-", xml:"-"`
 }
@@ -6252,20 +7371,25 @@ type metadataListSigningCertificatesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListSigningCertificatesOutput.
+func (s ListSigningCertificatesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListUserPoliciesInput struct {
 	// Use this only when paginating results, and only in a subsequent request after
 	// you've received a response where the results are truncated. Set it to the
 	// value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// Use this only when paginating results to indicate the maximum number of policy
 	// names you want in the response. If there are additional policy names beyond
 	// the maximum you specify, the IsTruncated response element is true. This parameter
 	// is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The name of the user to list policies for.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataListUserPoliciesInput `json:"-", xml:"-"`
 }
@@ -6274,6 +7398,11 @@ type metadataListUserPoliciesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListUserPoliciesInput.
+func (s ListUserPoliciesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful ListUserPolicies request.
 type ListUserPoliciesOutput struct {
 	// A flag that indicates whether there are more policy names to list. If your
@@ -6283,7 +7412,7 @@ type ListUserPoliciesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// A list of policy names.
 	PolicyNames []*string `type:"list" required:"true"`
@@ -6295,24 +7424,29 @@ type metadataListUserPoliciesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListUserPoliciesOutput.
+func (s ListUserPoliciesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListUsersInput struct {
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// Use this parameter only when paginating results to indicate the maximum number
 	// of user names you want in the response. If there are additional user names
 	// beyond the maximum you specify, the IsTruncated response element is true.
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	// The path prefix for filtering the results. For example: /division_abc/subdivision_xyz/,
 	// which would get all user names whose path starts with /division_abc/subdivision_xyz/.
 	//
 	//  This parameter is optional. If it is not included, it defaults to a slash
 	// (/), listing all user names.
-	PathPrefix *string `type:"string"`
+	PathPrefix *string `type:"string" min:"1" max:"512" pattern:"\\u002F[\\u0021-\\u007F]*"`
 
 	metadataListUsersInput `json:"-", xml:"-"`
 }
@@ -6321,6 +7455,11 @@ type metadataListUsersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListUsersInput.
+func (s ListUsersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful ListUsers request.
 type ListUsersOutput struct {
 	// A flag that indicates whether there are more user names to list. If your
@@ -6330,7 +7469,7 @@ type ListUsersOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// A list of users.
 	Users []*User `type:"list" required:"true"`
@@ -6342,22 +7481,27 @@ type metadataListUsersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListUsersOutput.
+func (s ListUsersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListVirtualMFADevicesInput struct {
 	// The status (unassigned or assigned) of the devices to list. If you do not
 	// specify an AssignmentStatus, the action defaults to Any which lists both
 	// assigned and unassigned virtual MFA devices.
-	AssignmentStatus *string `type:"string"`
+	AssignmentStatus *string `type:"string" enum:"Assigned,Unassigned,Any"`
 
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the Marker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// Use this parameter only when paginating results to indicate the maximum number
 	// of MFA devices you want in the response. If there are additional MFA devices
 	// beyond the maximum you specify, the IsTruncated response element is true.
 	// This parameter is optional. If you do not include it, it defaults to 100.
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" min:"1" max:"1000"`
 
 	metadataListVirtualMFADevicesInput `json:"-", xml:"-"`
 }
@@ -6366,6 +7510,11 @@ type metadataListVirtualMFADevicesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListVirtualMFADevicesInput.
+func (s ListVirtualMFADevicesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful ListVirtualMFADevices request.
 type ListVirtualMFADevicesOutput struct {
 	// A flag that indicates whether there are more items to list. If your results
@@ -6375,7 +7524,7 @@ type ListVirtualMFADevicesOutput struct {
 
 	// If IsTruncated is true, this element is present and contains the value to
 	// use for the Marker parameter in a subsequent pagination request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// The list of virtual MFA devices in the current account that match the AssignmentStatus
 	// value that was passed in the request.
@@ -6388,6 +7537,11 @@ type metadataListVirtualMFADevicesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListVirtualMFADevicesOutput.
+func (s ListVirtualMFADevicesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the user name and password create date for a user.
 //
 //  This data type is used as a response element in the CreateLoginProfile
@@ -6401,7 +7555,7 @@ type LoginProfile struct {
 
 	// The name of the user, which can be used for signing in to the AWS Management
 	// Console.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataLoginProfile `json:"-", xml:"-"`
 }
@@ -6410,6 +7564,11 @@ type metadataLoginProfile struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the LoginProfile.
+func (s LoginProfile) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains information about an MFA device.
 //
 // This data type is used as a response element in the ListMFADevices action.
@@ -6419,10 +7578,10 @@ type MFADevice struct {
 
 	// The serial number that uniquely identifies the MFA device. For virtual MFA
 	// devices, the serial number is the device ARN.
-	SerialNumber *string `type:"string" required:"true"`
+	SerialNumber *string `type:"string" min:"9" max:"256" pattern:"[\\w+=/:,.@-]*" required:"true"`
 
 	// The user with whom the MFA device is associated.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataMFADevice `json:"-", xml:"-"`
 }
@@ -6431,6 +7590,11 @@ type metadataMFADevice struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the MFADevice.
+func (s MFADevice) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the Amazon Resource Name (ARN) for an IAM OpenID Connect provider.
 type OpenIDConnectProviderListEntry struct {
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
@@ -6438,7 +7602,7 @@ type OpenIDConnectProviderListEntry struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	ARN *string `locationName:"Arn" type:"string"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048"`
 
 	metadataOpenIDConnectProviderListEntry `json:"-", xml:"-"`
 }
@@ -6447,6 +7611,11 @@ type metadataOpenIDConnectProviderListEntry struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OpenIDConnectProviderListEntry.
+func (s OpenIDConnectProviderListEntry) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains information about the account password policy.
 //
 //  This data type is used as a response element in the GetAccountPasswordPolicy
@@ -6464,14 +7633,14 @@ type PasswordPolicy struct {
 	HardExpiry *bool `type:"boolean"`
 
 	// The number of days that an IAM user password is valid.
-	MaxPasswordAge *int64 `type:"integer"`
+	MaxPasswordAge *int64 `type:"integer" min:"1" max:"1095"`
 
 	// Minimum length to require for IAM user passwords.
-	MinimumPasswordLength *int64 `type:"integer"`
+	MinimumPasswordLength *int64 `type:"integer" min:"6" max:"128"`
 
 	// Specifies the number of previous passwords that IAM users are prevented from
 	// reusing.
-	PasswordReusePrevention *int64 `type:"integer"`
+	PasswordReusePrevention *int64 `type:"integer" min:"1" max:"24"`
 
 	// Specifies whether to require lowercase characters for IAM user passwords.
 	RequireLowercaseCharacters *bool `type:"boolean"`
@@ -6492,6 +7661,11 @@ type metadataPasswordPolicy struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PasswordPolicy.
+func (s PasswordPolicy) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains information about a managed policy.
 //
 // This data type is used as a response element in the CreatePolicy, GetPolicy,
@@ -6506,7 +7680,7 @@ type Policy struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	ARN *string `locationName:"Arn" type:"string"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048"`
 
 	// The number of entities (users, groups, and roles) that the policy is attached
 	// to.
@@ -6517,13 +7691,13 @@ type Policy struct {
 	CreateDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The identifier for the version of the policy that is set as the default version.
-	DefaultVersionID *string `locationName:"DefaultVersionId" type:"string"`
+	DefaultVersionID *string `locationName:"DefaultVersionId" type:"string" pattern:"v[1-9][0-9]*(\\.[A-Za-z0-9-]*)?"`
 
 	// A friendly description of the policy.
 	//
 	// This element is included in the response to the GetPolicy operation. It
 	// is not included in the response to the ListPolicies operation.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"1000"`
 
 	// Specifies whether the policy can be attached to an IAM user, group, or role.
 	IsAttachable *bool `type:"boolean"`
@@ -6532,16 +7706,16 @@ type Policy struct {
 	//
 	// For more information about paths, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string"`
+	Path *string `type:"string" pattern:"((/[A-Za-z0-9\\.,\\+@=_-]+)*)/"`
 
 	// The stable and unique string identifying the policy.
 	//
 	// For more information about IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	PolicyID *string `locationName:"PolicyId" type:"string"`
+	PolicyID *string `locationName:"PolicyId" type:"string" min:"16" max:"32" pattern:"[\\w]*"`
 
 	// The friendly name (not ARN) identifying the policy.
-	PolicyName *string `type:"string"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	// The date and time, in ISO 8601 date-time format (http://www.iso.org/iso/iso8601),
 	// when the policy was last updated.
@@ -6559,6 +7733,11 @@ type metadataPolicy struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Policy.
+func (s Policy) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains information about an IAM policy, including the policy document.
 //
 // This data type is used as a response element in the GetAccountAuthorizationDetails
@@ -6567,10 +7746,10 @@ type PolicyDetail struct {
 	// The policy document.
 	//
 	// The returned policy is URL-encoded according to RFC 3986 (http://www.faqs.org/rfcs/rfc3986.html).
-	PolicyDocument *string `type:"string"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\u0009\\u000A\\u000D\\u0020-\\u00FF]+"`
 
 	// The name of the policy.
-	PolicyName *string `type:"string"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataPolicyDetail `json:"-", xml:"-"`
 }
@@ -6579,6 +7758,11 @@ type metadataPolicyDetail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PolicyDetail.
+func (s PolicyDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains information about a group that a managed policy is attached to.
 //
 // This data type is used as a response element in the ListEntitiesForPolicy
@@ -6589,7 +7773,7 @@ type metadataPolicyDetail struct {
 // in the Using IAM guide.
 type PolicyGroup struct {
 	// The name (friendly name, not ARN) identifying the group.
-	GroupName *string `type:"string"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataPolicyGroup `json:"-", xml:"-"`
 }
@@ -6598,6 +7782,11 @@ type metadataPolicyGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PolicyGroup.
+func (s PolicyGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains information about a role that a managed policy is attached to.
 //
 // This data type is used as a response element in the ListEntitiesForPolicy
@@ -6608,7 +7797,7 @@ type metadataPolicyGroup struct {
 // in the Using IAM guide.
 type PolicyRole struct {
 	// The name (friendly name, not ARN) identifying the role.
-	RoleName *string `type:"string"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*"`
 
 	metadataPolicyRole `json:"-", xml:"-"`
 }
@@ -6617,6 +7806,11 @@ type metadataPolicyRole struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PolicyRole.
+func (s PolicyRole) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains information about a user that a managed policy is attached to.
 //
 // This data type is used as a response element in the ListEntitiesForPolicy
@@ -6627,7 +7821,7 @@ type metadataPolicyRole struct {
 // in the Using IAM guide.
 type PolicyUser struct {
 	// The name (friendly name, not ARN) identifying the user.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*"`
 
 	metadataPolicyUser `json:"-", xml:"-"`
 }
@@ -6636,6 +7830,11 @@ type metadataPolicyUser struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PolicyUser.
+func (s PolicyUser) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains information about a version of a managed policy.
 //
 // This data type is used as a response element in the CreatePolicyVersion,
@@ -6653,7 +7852,7 @@ type PolicyVersion struct {
 	//
 	// The policy document is returned in the response to the GetPolicyVersion
 	// operation. It is not included in the response to the ListPolicyVersions operation.
-	Document *string `type:"string"`
+	Document *string `type:"string" min:"1" max:"131072" pattern:"[\\u0009\\u000A\\u000D\\u0020-\\u00FF]+"`
 
 	// Specifies whether the policy version is set as the policy's default version.
 	IsDefaultVersion *bool `type:"boolean"`
@@ -6662,7 +7861,7 @@ type PolicyVersion struct {
 	//
 	// Policy version identifiers always begin with v (always lowercase). When
 	// a policy is created, the first policy version is v1.
-	VersionID *string `locationName:"VersionId" type:"string"`
+	VersionID *string `locationName:"VersionId" type:"string" pattern:"v[1-9][0-9]*(\\.[A-Za-z0-9-]*)?"`
 
 	metadataPolicyVersion `json:"-", xml:"-"`
 }
@@ -6671,15 +7870,20 @@ type metadataPolicyVersion struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PolicyVersion.
+func (s PolicyVersion) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutGroupPolicyInput struct {
 	// The name of the group to associate the policy with.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The policy document.
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\u0009\\u000A\\u000D\\u0020-\\u00FF]+" required:"true"`
 
 	// The name of the policy document.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataPutGroupPolicyInput `json:"-", xml:"-"`
 }
@@ -6688,6 +7892,11 @@ type metadataPutGroupPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutGroupPolicyInput.
+func (s PutGroupPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutGroupPolicyOutput struct {
 	metadataPutGroupPolicyOutput `json:"-", xml:"-"`
 }
@@ -6696,15 +7905,20 @@ type metadataPutGroupPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutGroupPolicyOutput.
+func (s PutGroupPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutRolePolicyInput struct {
 	// The policy document.
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\u0009\\u000A\\u000D\\u0020-\\u00FF]+" required:"true"`
 
 	// The name of the policy document.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name of the role to associate the policy with.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataPutRolePolicyInput `json:"-", xml:"-"`
 }
@@ -6713,6 +7927,11 @@ type metadataPutRolePolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutRolePolicyInput.
+func (s PutRolePolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutRolePolicyOutput struct {
 	metadataPutRolePolicyOutput `json:"-", xml:"-"`
 }
@@ -6721,15 +7940,20 @@ type metadataPutRolePolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutRolePolicyOutput.
+func (s PutRolePolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutUserPolicyInput struct {
 	// The policy document.
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\u0009\\u000A\\u000D\\u0020-\\u00FF]+" required:"true"`
 
 	// The name of the policy document.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name of the user to associate the policy with.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataPutUserPolicyInput `json:"-", xml:"-"`
 }
@@ -6738,6 +7962,11 @@ type metadataPutUserPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutUserPolicyInput.
+func (s PutUserPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutUserPolicyOutput struct {
 	metadataPutUserPolicyOutput `json:"-", xml:"-"`
 }
@@ -6746,15 +7975,20 @@ type metadataPutUserPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutUserPolicyOutput.
+func (s PutUserPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RemoveClientIDFromOpenIDConnectProviderInput struct {
 	// The client ID (also known as audience) to remove from the IAM OpenID Connect
 	// provider. For more information about client IDs, see CreateOpenIDConnectProvider.
-	ClientID *string `type:"string" required:"true"`
+	ClientID *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the IAM OpenID Connect (OIDC) provider
 	// to remove the client ID from. You can get a list of OIDC provider ARNs by
 	// using the ListOpenIDConnectProviders action.
-	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" required:"true"`
+	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataRemoveClientIDFromOpenIDConnectProviderInput `json:"-", xml:"-"`
 }
@@ -6763,6 +7997,11 @@ type metadataRemoveClientIDFromOpenIDConnectProviderInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RemoveClientIDFromOpenIDConnectProviderInput.
+func (s RemoveClientIDFromOpenIDConnectProviderInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RemoveClientIDFromOpenIDConnectProviderOutput struct {
 	metadataRemoveClientIDFromOpenIDConnectProviderOutput `json:"-", xml:"-"`
 }
@@ -6771,12 +8010,17 @@ type metadataRemoveClientIDFromOpenIDConnectProviderOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RemoveClientIDFromOpenIDConnectProviderOutput.
+func (s RemoveClientIDFromOpenIDConnectProviderOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RemoveRoleFromInstanceProfileInput struct {
 	// The name of the instance profile to update.
-	InstanceProfileName *string `type:"string" required:"true"`
+	InstanceProfileName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name of the role to remove.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataRemoveRoleFromInstanceProfileInput `json:"-", xml:"-"`
 }
@@ -6785,6 +8029,11 @@ type metadataRemoveRoleFromInstanceProfileInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RemoveRoleFromInstanceProfileInput.
+func (s RemoveRoleFromInstanceProfileInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RemoveRoleFromInstanceProfileOutput struct {
 	metadataRemoveRoleFromInstanceProfileOutput `json:"-", xml:"-"`
 }
@@ -6793,12 +8042,17 @@ type metadataRemoveRoleFromInstanceProfileOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RemoveRoleFromInstanceProfileOutput.
+func (s RemoveRoleFromInstanceProfileOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RemoveUserFromGroupInput struct {
 	// The name of the group to update.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The name of the user to remove.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataRemoveUserFromGroupInput `json:"-", xml:"-"`
 }
@@ -6807,6 +8061,11 @@ type metadataRemoveUserFromGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RemoveUserFromGroupInput.
+func (s RemoveUserFromGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RemoveUserFromGroupOutput struct {
 	metadataRemoveUserFromGroupOutput `json:"-", xml:"-"`
 }
@@ -6815,18 +8074,91 @@ type metadataRemoveUserFromGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RemoveUserFromGroupOutput.
+func (s RemoveUserFromGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ReportFormatType is an enum of the values of ReportFormatType members.
+type ReportFormatType string
+
+// The values of ReportFormatType.
+const (
+	ReportFormatTypeTextCsv ReportFormatType = "text/csv"
+)
+
+// ReportFormatTypeValues returns the values of ReportFormatType known to this
+// version of the SDK. The service may accept values added since.
+func ReportFormatTypeValues() []ReportFormatType {
+	return []ReportFormatType{
+		ReportFormatType("text/csv"),
+	}
+}
+
+// String returns the ReportFormatType as the string of a member.
+func (v ReportFormatType) String() string {
+	return string(v)
+}
+
+// ParseReportFormatType returns the ReportFormatType of str, failing if it is not
+// one of the ReportFormatTypeValues.
+func ParseReportFormatType(str string) (ReportFormatType, error) {
+	for _, v := range ReportFormatTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ReportFormatType value %q", str)
+}
+
+// ReportStateType is an enum of the values of ReportStateType members.
+type ReportStateType string
+
+// The values of ReportStateType.
+const (
+	ReportStateTypeSTARTED    ReportStateType = "STARTED"
+	ReportStateTypeINPROGRESS ReportStateType = "INPROGRESS"
+	ReportStateTypeCOMPLETE   ReportStateType = "COMPLETE"
+)
+
+// ReportStateTypeValues returns the values of ReportStateType known to this
+// version of the SDK. The service may accept values added since.
+func ReportStateTypeValues() []ReportStateType {
+	return []ReportStateType{
+		ReportStateType("STARTED"),
+		ReportStateType("INPROGRESS"),
+		ReportStateType("COMPLETE"),
+	}
+}
+
+// String returns the ReportStateType as the string of a member.
+func (v ReportStateType) String() string {
+	return string(v)
+}
+
+// ParseReportStateType returns the ReportStateType of str, failing if it is not
+// one of the ReportStateTypeValues.
+func ParseReportStateType(str string) (ReportStateType, error) {
+	for _, v := range ReportStateTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ReportStateType value %q", str)
+}
+
 type ResyncMFADeviceInput struct {
 	// An authentication code emitted by the device.
-	AuthenticationCode1 *string `type:"string" required:"true"`
+	AuthenticationCode1 *string `type:"string" min:"6" max:"6" pattern:"[\\d]*" required:"true"`
 
 	// A subsequent authentication code emitted by the device.
-	AuthenticationCode2 *string `type:"string" required:"true"`
+	AuthenticationCode2 *string `type:"string" min:"6" max:"6" pattern:"[\\d]*" required:"true"`
 
 	// Serial number that uniquely identifies the MFA device.
-	SerialNumber *string `type:"string" required:"true"`
+	SerialNumber *string `type:"string" min:"9" max:"256" pattern:"[\\w+=/:,.@-]*" required:"true"`
 
 	// The name of the user whose MFA device you want to resynchronize.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataResyncMFADeviceInput `json:"-", xml:"-"`
 }
@@ -6835,6 +8167,11 @@ type metadataResyncMFADeviceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ResyncMFADeviceInput.
+func (s ResyncMFADeviceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ResyncMFADeviceOutput struct {
 	metadataResyncMFADeviceOutput `json:"-", xml:"-"`
 }
@@ -6843,6 +8180,11 @@ type metadataResyncMFADeviceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ResyncMFADeviceOutput.
+func (s ResyncMFADeviceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains information about an IAM role.
 //
 //  This data type is used as a response element in the following actions:
@@ -6856,12 +8198,12 @@ type Role struct {
 	// The Amazon Resource Name (ARN) specifying the role. For more information
 	// about ARNs and how to use them in policies, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	ARN *string `locationName:"Arn" type:"string" required:"true"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The policy that grants an entity permission to assume the role.
 	//
 	//  The returned policy is URL-encoded according to RFC 3986 (http://www.faqs.org/rfcs/rfc3986.html).
-	AssumeRolePolicyDocument *string `type:"string"`
+	AssumeRolePolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\u0009\\u000A\\u000D\\u0020-\\u00FF]+"`
 
 	// The date and time, in ISO 8601 date-time format (http://www.iso.org/iso/iso8601),
 	// when the role was created.
@@ -6870,15 +8212,15 @@ type Role struct {
 	// The path to the role. For more information about paths, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string" required:"true"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\u002F)|(\\u002F[\\u0021-\\u007F]+\\u002F)" required:"true"`
 
 	// The stable and unique string identifying the role. For more information about
 	// IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	RoleID *string `locationName:"RoleId" type:"string" required:"true"`
+	RoleID *string `locationName:"RoleId" type:"string" min:"16" max:"32" pattern:"[\\w]*" required:"true"`
 
 	// The friendly name that identifies the role.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataRole `json:"-", xml:"-"`
 }
@@ -6887,6 +8229,11 @@ type metadataRole struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Role.
+func (s Role) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains information about an IAM role, including all of the role's policies.
 //
 // This data type is used as a response element in the GetAccountAuthorizationDetails
@@ -6897,12 +8244,12 @@ type RoleDetail struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	ARN *string `locationName:"Arn" type:"string"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048"`
 
 	// The trust policy that grants permission to assume the role.
 	//
 	//  The returned policy is URL-encoded according to RFC 3986 (http://www.faqs.org/rfcs/rfc3986.html).
-	AssumeRolePolicyDocument *string `type:"string"`
+	AssumeRolePolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\u0009\\u000A\\u000D\\u0020-\\u00FF]+"`
 
 	// The date and time, in ISO 8601 date-time format (http://www.iso.org/iso/iso8601),
 	// when the role was created.
@@ -6914,15 +8261,15 @@ type RoleDetail struct {
 	// The path to the role. For more information about paths, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\u002F)|(\\u002F[\\u0021-\\u007F]+\\u002F)"`
 
 	// The stable and unique string identifying the role. For more information about
 	// IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	RoleID *string `locationName:"RoleId" type:"string"`
+	RoleID *string `locationName:"RoleId" type:"string" min:"16" max:"32" pattern:"[\\w]*"`
 
 	// The friendly name that identifies the role.
-	RoleName *string `type:"string"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*"`
 
 	// A list of the role's access (permissions) policies.
 	RolePolicyList []*PolicyDetail `type:"list"`
@@ -6934,10 +8281,15 @@ type metadataRoleDetail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RoleDetail.
+func (s RoleDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the list of SAML providers for this account.
 type SAMLProviderListEntry struct {
 	// The Amazon Resource Name (ARN) of the SAML provider.
-	ARN *string `locationName:"Arn" type:"string"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048"`
 
 	// The date and time when the SAML provider was created.
 	CreateDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -6952,16 +8304,21 @@ type metadataSAMLProviderListEntry struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SAMLProviderListEntry.
+func (s SAMLProviderListEntry) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains information about a server certificate.
 //
 //  This data type is used as a response element in the GetServerCertificate
 // action.
 type ServerCertificate struct {
 	// The contents of the public key certificate.
-	CertificateBody *string `type:"string" required:"true"`
+	CertificateBody *string `type:"string" min:"1" max:"16384" pattern:"[\\u0009\\u000A\\u000D\\u0020-\\u00FF]+" required:"true"`
 
 	// The contents of the public key certificate chain.
-	CertificateChain *string `type:"string"`
+	CertificateChain *string `type:"string" min:"1" max:"2097152" pattern:"[\\u0009\\u000A\\u000D\\u0020-\\u00FF]*"`
 
 	// The meta information of the server certificate, such as its name, path, ID,
 	// and ARN.
@@ -6974,6 +8331,11 @@ type metadataServerCertificate struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ServerCertificate.
+func (s ServerCertificate) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains information about a server certificate without its certificate body,
 // certificate chain, and private key.
 //
@@ -6984,7 +8346,7 @@ type ServerCertificateMetadata struct {
 	// information about ARNs and how to use them in policies, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	ARN *string `locationName:"Arn" type:"string" required:"true"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The date on which the certificate is set to expire.
 	Expiration *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -6992,15 +8354,15 @@ type ServerCertificateMetadata struct {
 	// The path to the server certificate. For more information about paths, see
 	// IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string" required:"true"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\u002F)|(\\u002F[\\u0021-\\u007F]+\\u002F)" required:"true"`
 
 	// The stable and unique string identifying the server certificate. For more
 	// information about IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	ServerCertificateID *string `locationName:"ServerCertificateId" type:"string" required:"true"`
+	ServerCertificateID *string `locationName:"ServerCertificateId" type:"string" min:"16" max:"32" pattern:"[\\w]*" required:"true"`
 
 	// The name that identifies the server certificate.
-	ServerCertificateName *string `type:"string" required:"true"`
+	ServerCertificateName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The date when the server certificate was uploaded.
 	UploadDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -7012,20 +8374,25 @@ type metadataServerCertificateMetadata struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ServerCertificateMetadata.
+func (s ServerCertificateMetadata) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SetDefaultPolicyVersionInput struct {
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for AWS resources.
 	//
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	PolicyARN *string `locationName:"PolicyArn" type:"string" required:"true"`
+	PolicyARN *string `locationName:"PolicyArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The version of the policy to set as the default (operative) version.
 	//
 	// For more information about managed policy versions, see Versioning for Managed
 	// Policies (http://docs.aws.amazon.com/IAM/latest/UserGuide/policies-managed-versions.html)
 	// in the Using IAM guide.
-	VersionID *string `locationName:"VersionId" type:"string" required:"true"`
+	VersionID *string `locationName:"VersionId" type:"string" pattern:"v[1-9][0-9]*(\\.[A-Za-z0-9-]*)?" required:"true"`
 
 	metadataSetDefaultPolicyVersionInput `json:"-", xml:"-"`
 }
@@ -7034,6 +8401,11 @@ type metadataSetDefaultPolicyVersionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetDefaultPolicyVersionInput.
+func (s SetDefaultPolicyVersionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SetDefaultPolicyVersionOutput struct {
 	metadataSetDefaultPolicyVersionOutput `json:"-", xml:"-"`
 }
@@ -7042,26 +8414,31 @@ type metadataSetDefaultPolicyVersionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetDefaultPolicyVersionOutput.
+func (s SetDefaultPolicyVersionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains information about an X.509 signing certificate.
 //
 // This data type is used as a response element in the UploadSigningCertificate
 // and ListSigningCertificates actions.
 type SigningCertificate struct {
 	// The contents of the signing certificate.
-	CertificateBody *string `type:"string" required:"true"`
+	CertificateBody *string `type:"string" min:"1" max:"16384" pattern:"[\\u0009\\u000A\\u000D\\u0020-\\u00FF]+" required:"true"`
 
 	// The ID for the signing certificate.
-	CertificateID *string `locationName:"CertificateId" type:"string" required:"true"`
+	CertificateID *string `locationName:"CertificateId" type:"string" min:"24" max:"128" pattern:"[\\w]*" required:"true"`
 
 	// The status of the signing certificate. Active means the key is valid for
 	// API calls, while Inactive means it is not.
-	Status *string `type:"string" required:"true"`
+	Status *string `type:"string" enum:"Active,Inactive" required:"true"`
 
 	// The date when the signing certificate was uploaded.
 	UploadDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
 
 	// The name of the user the signing certificate is associated with.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataSigningCertificate `json:"-", xml:"-"`
 }
@@ -7070,17 +8447,22 @@ type metadataSigningCertificate struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SigningCertificate.
+func (s SigningCertificate) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateAccessKeyInput struct {
 	// The access key ID of the secret access key you want to update.
-	AccessKeyID *string `locationName:"AccessKeyId" type:"string" required:"true"`
+	AccessKeyID *string `locationName:"AccessKeyId" type:"string" min:"16" max:"32" pattern:"[\\w]*" required:"true"`
 
 	// The status you want to assign to the secret access key. Active means the
 	// key can be used for API calls to AWS, while Inactive means the key cannot
 	// be used.
-	Status *string `type:"string" required:"true"`
+	Status *string `type:"string" enum:"Active,Inactive" required:"true"`
 
 	// The name of the user whose key you want to update.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataUpdateAccessKeyInput `json:"-", xml:"-"`
 }
@@ -7089,6 +8471,11 @@ type metadataUpdateAccessKeyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateAccessKeyInput.
+func (s UpdateAccessKeyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateAccessKeyOutput struct {
 	metadataUpdateAccessKeyOutput `json:"-", xml:"-"`
 }
@@ -7097,6 +8484,11 @@ type metadataUpdateAccessKeyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateAccessKeyOutput.
+func (s UpdateAccessKeyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateAccountPasswordPolicyInput struct {
 	// Allows all IAM users in your account to use the AWS Management Console to
 	// change their own passwords. For more information, see Letting IAM Users Change
@@ -7115,19 +8507,19 @@ type UpdateAccountPasswordPolicyInput struct {
 	// of 0 means IAM user passwords never expire.
 	//
 	// Default value: 0
-	MaxPasswordAge *int64 `type:"integer"`
+	MaxPasswordAge *int64 `type:"integer" min:"1" max:"1095"`
 
 	// The minimum number of characters allowed in an IAM user password.
 	//
 	// Default value: 6
-	MinimumPasswordLength *int64 `type:"integer"`
+	MinimumPasswordLength *int64 `type:"integer" min:"6" max:"128"`
 
 	// Specifies the number of previous passwords that IAM users are prevented from
 	// reusing. The default value of 0 means IAM users are not prevented from reusing
 	// previous passwords.
 	//
 	// Default value: 0
-	PasswordReusePrevention *int64 `type:"integer"`
+	PasswordReusePrevention *int64 `type:"integer" min:"1" max:"24"`
 
 	// Specifies whether IAM user passwords must contain at least one lowercase
 	// character from the ISO basic Latin alphabet (a to z).
@@ -7162,6 +8554,11 @@ type metadataUpdateAccountPasswordPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateAccountPasswordPolicyInput.
+func (s UpdateAccountPasswordPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateAccountPasswordPolicyOutput struct {
 	metadataUpdateAccountPasswordPolicyOutput `json:"-", xml:"-"`
 }
@@ -7170,12 +8567,17 @@ type metadataUpdateAccountPasswordPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateAccountPasswordPolicyOutput.
+func (s UpdateAccountPasswordPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateAssumeRolePolicyInput struct {
 	// The policy that grants an entity permission to assume the role.
-	PolicyDocument *string `type:"string" required:"true"`
+	PolicyDocument *string `type:"string" min:"1" max:"131072" pattern:"[\\u0009\\u000A\\u000D\\u0020-\\u00FF]+" required:"true"`
 
 	// The name of the role to update.
-	RoleName *string `type:"string" required:"true"`
+	RoleName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataUpdateAssumeRolePolicyInput `json:"-", xml:"-"`
 }
@@ -7184,6 +8586,11 @@ type metadataUpdateAssumeRolePolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateAssumeRolePolicyInput.
+func (s UpdateAssumeRolePolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateAssumeRolePolicyOutput struct {
 	metadataUpdateAssumeRolePolicyOutput `json:"-", xml:"-"`
 }
@@ -7192,16 +8599,21 @@ type metadataUpdateAssumeRolePolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateAssumeRolePolicyOutput.
+func (s UpdateAssumeRolePolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateGroupInput struct {
 	// Name of the group to update. If you're changing the name of the group, this
 	// is the original name.
-	GroupName *string `type:"string" required:"true"`
+	GroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// New name for the group. Only include this if changing the group's name.
-	NewGroupName *string `type:"string"`
+	NewGroupName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	// New path for the group. Only include this if changing the group's path.
-	NewPath *string `type:"string"`
+	NewPath *string `type:"string" min:"1" max:"512" pattern:"(\\u002F)|(\\u002F[\\u0021-\\u007F]+\\u002F)"`
 
 	metadataUpdateGroupInput `json:"-", xml:"-"`
 }
@@ -7210,6 +8622,11 @@ type metadataUpdateGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateGroupInput.
+func (s UpdateGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateGroupOutput struct {
 	metadataUpdateGroupOutput `json:"-", xml:"-"`
 }
@@ -7218,15 +8635,20 @@ type metadataUpdateGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateGroupOutput.
+func (s UpdateGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateLoginProfileInput struct {
 	// The new password for the specified user.
-	Password *string `type:"string"`
+	Password *string `type:"string" min:"1" max:"128" pattern:"[\\u0009\\u000A\\u000D\\u0020-\\u00FF]+" sensitive:"true"`
 
 	// Require the specified user to set a new password on next sign-in.
 	PasswordResetRequired *bool `type:"boolean"`
 
 	// The name of the user whose password you want to update.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataUpdateLoginProfileInput `json:"-", xml:"-"`
 }
@@ -7235,6 +8657,11 @@ type metadataUpdateLoginProfileInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateLoginProfileInput.
+func (s UpdateLoginProfileInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateLoginProfileOutput struct {
 	metadataUpdateLoginProfileOutput `json:"-", xml:"-"`
 }
@@ -7243,11 +8670,16 @@ type metadataUpdateLoginProfileOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateLoginProfileOutput.
+func (s UpdateLoginProfileOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateOpenIDConnectProviderThumbprintInput struct {
 	// The Amazon Resource Name (ARN) of the IAM OpenID Connect (OIDC) provider
 	// to update the thumbprint for. You can get a list of OIDC provider ARNs by
 	// using the ListOpenIDConnectProviders action.
-	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" required:"true"`
+	OpenIDConnectProviderARN *string `locationName:"OpenIDConnectProviderArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// A list of certificate thumbprints that are associated with the specified
 	// IAM OpenID Connect provider. For more information, see CreateOpenIDConnectProvider.
@@ -7260,6 +8692,11 @@ type metadataUpdateOpenIDConnectProviderThumbprintInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateOpenIDConnectProviderThumbprintInput.
+func (s UpdateOpenIDConnectProviderThumbprintInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateOpenIDConnectProviderThumbprintOutput struct {
 	metadataUpdateOpenIDConnectProviderThumbprintOutput `json:"-", xml:"-"`
 }
@@ -7268,16 +8705,21 @@ type metadataUpdateOpenIDConnectProviderThumbprintOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateOpenIDConnectProviderThumbprintOutput.
+func (s UpdateOpenIDConnectProviderThumbprintOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateSAMLProviderInput struct {
 	// An XML document generated by an identity provider (IdP) that supports SAML
 	// 2.0. The document includes the issuer's name, expiration information, and
 	// keys that can be used to validate the SAML authentication response (assertions)
 	// that are received from the IdP. You must generate the metadata document using
 	// the identity management software that is used as your organization's IdP.
-	SAMLMetadataDocument *string `type:"string" required:"true"`
+	SAMLMetadataDocument *string `type:"string" min:"1000" max:"10000000" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the SAML provider to update.
-	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string" required:"true"`
+	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	metadataUpdateSAMLProviderInput `json:"-", xml:"-"`
 }
@@ -7286,10 +8728,15 @@ type metadataUpdateSAMLProviderInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateSAMLProviderInput.
+func (s UpdateSAMLProviderInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful UpdateSAMLProvider request.
 type UpdateSAMLProviderOutput struct {
 	// The Amazon Resource Name (ARN) of the SAML provider that was updated.
-	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string"`
+	SAMLProviderARN *string `locationName:"SAMLProviderArn" type:"string" min:"20" max:"2048"`
 
 	metadataUpdateSAMLProviderOutput `json:"-", xml:"-"`
 }
@@ -7298,17 +8745,22 @@ type metadataUpdateSAMLProviderOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateSAMLProviderOutput.
+func (s UpdateSAMLProviderOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateServerCertificateInput struct {
 	// The new path for the server certificate. Include this only if you are updating
 	// the server certificate's path.
-	NewPath *string `type:"string"`
+	NewPath *string `type:"string" min:"1" max:"512" pattern:"(\\u002F)|(\\u002F[\\u0021-\\u007F]+\\u002F)"`
 
 	// The new name for the server certificate. Include this only if you are updating
 	// the server certificate's name.
-	NewServerCertificateName *string `type:"string"`
+	NewServerCertificateName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	// The name of the server certificate that you want to update.
-	ServerCertificateName *string `type:"string" required:"true"`
+	ServerCertificateName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataUpdateServerCertificateInput `json:"-", xml:"-"`
 }
@@ -7317,6 +8769,11 @@ type metadataUpdateServerCertificateInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateServerCertificateInput.
+func (s UpdateServerCertificateInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateServerCertificateOutput struct {
 	metadataUpdateServerCertificateOutput `json:"-", xml:"-"`
 }
@@ -7325,17 +8782,22 @@ type metadataUpdateServerCertificateOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateServerCertificateOutput.
+func (s UpdateServerCertificateOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateSigningCertificateInput struct {
 	// The ID of the signing certificate you want to update.
-	CertificateID *string `locationName:"CertificateId" type:"string" required:"true"`
+	CertificateID *string `locationName:"CertificateId" type:"string" min:"24" max:"128" pattern:"[\\w]*" required:"true"`
 
 	// The status you want to assign to the certificate. Active means the certificate
 	// can be used for API calls to AWS, while Inactive means the certificate cannot
 	// be used.
-	Status *string `type:"string" required:"true"`
+	Status *string `type:"string" enum:"Active,Inactive" required:"true"`
 
 	// The name of the user the signing certificate belongs to.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataUpdateSigningCertificateInput `json:"-", xml:"-"`
 }
@@ -7344,6 +8806,11 @@ type metadataUpdateSigningCertificateInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateSigningCertificateInput.
+func (s UpdateSigningCertificateInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateSigningCertificateOutput struct {
 	metadataUpdateSigningCertificateOutput `json:"-", xml:"-"`
 }
@@ -7352,18 +8819,23 @@ type metadataUpdateSigningCertificateOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateSigningCertificateOutput.
+func (s UpdateSigningCertificateOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateUserInput struct {
 	// New path for the user. Include this parameter only if you're changing the
 	// user's path.
-	NewPath *string `type:"string"`
+	NewPath *string `type:"string" min:"1" max:"512" pattern:"(\\u002F)|(\\u002F[\\u0021-\\u007F]+\\u002F)"`
 
 	// New name for the user. Include this parameter only if you're changing the
 	// user's name.
-	NewUserName *string `type:"string"`
+	NewUserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*"`
 
 	// Name of the user to update. If you're changing the name of the user, this
 	// is the original user name.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataUpdateUserInput `json:"-", xml:"-"`
 }
@@ -7372,6 +8844,11 @@ type metadataUpdateUserInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateUserInput.
+func (s UpdateUserInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateUserOutput struct {
 	metadataUpdateUserOutput `json:"-", xml:"-"`
 }
@@ -7380,13 +8857,18 @@ type metadataUpdateUserOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateUserOutput.
+func (s UpdateUserOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UploadServerCertificateInput struct {
 	// The contents of the public key certificate in PEM-encoded format.
-	CertificateBody *string `type:"string" required:"true"`
+	CertificateBody *string `type:"string" min:"1" max:"16384" pattern:"[\\u0009\\u000A\\u000D\\u0020-\\u00FF]+" required:"true"`
 
 	// The contents of the certificate chain. This is typically a concatenation
 	// of the PEM-encoded public key certificates of the chain.
-	CertificateChain *string `type:"string"`
+	CertificateChain *string `type:"string" min:"1" max:"2097152" pattern:"[\\u0009\\u000A\\u000D\\u0020-\\u00FF]*"`
 
 	// The path for the server certificate. For more information about paths, see
 	// IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
@@ -7399,13 +8881,13 @@ type UploadServerCertificateInput struct {
 	// CloudFront distributions, you must specify a path using the --path option.
 	// The path must begin with /cloudfront and must include a trailing slash (for
 	// example, /cloudfront/test/).
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\u002F)|(\\u002F[\\u0021-\\u007F]+\\u002F)"`
 
 	// The contents of the private key in PEM-encoded format.
-	PrivateKey *string `type:"string" required:"true"`
+	PrivateKey *string `type:"string" min:"1" max:"16384" pattern:"[\\u0009\\u000A\\u000D\\u0020-\\u00FF]*" sensitive:"true" required:"true"`
 
 	// The name for the server certificate. Do not include the path in this value.
-	ServerCertificateName *string `type:"string" required:"true"`
+	ServerCertificateName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataUploadServerCertificateInput `json:"-", xml:"-"`
 }
@@ -7414,6 +8896,11 @@ type metadataUploadServerCertificateInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UploadServerCertificateInput.
+func (s UploadServerCertificateInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful UploadServerCertificate request.
 type UploadServerCertificateOutput struct {
 	// The meta information of the uploaded server certificate without its certificate
@@ -7427,12 +8914,17 @@ type metadataUploadServerCertificateOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UploadServerCertificateOutput.
+func (s UploadServerCertificateOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UploadSigningCertificateInput struct {
 	// The contents of the signing certificate.
-	CertificateBody *string `type:"string" required:"true"`
+	CertificateBody *string `type:"string" min:"1" max:"16384" pattern:"[\\u0009\\u000A\\u000D\\u0020-\\u00FF]+" required:"true"`
 
 	// The name of the user the signing certificate is for.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"128" pattern:"[\\w+=,.@-]*"`
 
 	metadataUploadSigningCertificateInput `json:"-", xml:"-"`
 }
@@ -7441,6 +8933,11 @@ type metadataUploadSigningCertificateInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UploadSigningCertificateInput.
+func (s UploadSigningCertificateInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a successful UploadSigningCertificate request.
 type UploadSigningCertificateOutput struct {
 	// Information about the certificate.
@@ -7453,6 +8950,11 @@ type metadataUploadSigningCertificateOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UploadSigningCertificateOutput.
+func (s UploadSigningCertificateOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains information about an IAM user entity.
 //
 //  This data type is used as a response element in the following actions:
@@ -7466,7 +8968,7 @@ type User struct {
 	// The Amazon Resource Name (ARN) that identifies the user. For more information
 	// about ARNs and how to use ARNs in policies, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	ARN *string `locationName:"Arn" type:"string" required:"true"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The date and time, in ISO 8601 date-time format (http://www.iso.org/iso/iso8601),
 	// when the user was created.
@@ -7488,15 +8990,15 @@ type User struct {
 	// The path to the user. For more information about paths, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string" required:"true"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\u002F)|(\\u002F[\\u0021-\\u007F]+\\u002F)" required:"true"`
 
 	// The stable and unique string identifying the user. For more information about
 	// IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	UserID *string `locationName:"UserId" type:"string" required:"true"`
+	UserID *string `locationName:"UserId" type:"string" min:"16" max:"32" pattern:"[\\w]*" required:"true"`
 
 	// The friendly name identifying the user.
-	UserName *string `type:"string" required:"true"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	metadataUser `json:"-", xml:"-"`
 }
@@ -7505,6 +9007,11 @@ type metadataUser struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the User.
+func (s User) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains information about an IAM user, including all the user's policies
 // and all the IAM groups the user is in.
 //
@@ -7516,7 +9023,7 @@ type UserDetail struct {
 	// For more information about ARNs, go to Amazon Resource Names (ARNs) and
 	// AWS Service Namespaces (http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html)
 	// in the AWS General Reference.
-	ARN *string `locationName:"Arn" type:"string"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048"`
 
 	// The date and time, in ISO 8601 date-time format (http://www.iso.org/iso/iso8601),
 	// when the user was created.
@@ -7528,15 +9035,15 @@ type UserDetail struct {
 	// The path to the user. For more information about paths, see IAM Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	Path *string `type:"string"`
+	Path *string `type:"string" min:"1" max:"512" pattern:"(\\u002F)|(\\u002F[\\u0021-\\u007F]+\\u002F)"`
 
 	// The stable and unique string identifying the user. For more information about
 	// IDs, see IAM Identifiers (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the Using IAM guide.
-	UserID *string `locationName:"UserId" type:"string"`
+	UserID *string `locationName:"UserId" type:"string" min:"16" max:"32" pattern:"[\\w]*"`
 
 	// The friendly name identifying the user.
-	UserName *string `type:"string"`
+	UserName *string `type:"string" min:"1" max:"64" pattern:"[\\w+=,.@-]*"`
 
 	// A list of the user's policies.
 	UserPolicyList []*PolicyDetail `type:"list"`
@@ -7548,11 +9055,16 @@ type metadataUserDetail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UserDetail.
+func (s UserDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains information about a virtual MFA device.
 type VirtualMFADevice struct {
 	// The Base32 seed defined as specified in RFC3548 (http://www.ietf.org/rfc/rfc3548.txt).
 	// The Base32StringSeed is Base64-encoded.
-	Base32StringSeed []byte `type:"blob"`
+	Base32StringSeed []byte `type:"blob" sensitive:"true"`
 
 	// The date and time on which the virtual MFA device was enabled.
 	EnableDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -7561,10 +9073,10 @@ type VirtualMFADevice struct {
 	// where $virtualMFADeviceName is one of the create call arguments, AccountName
 	// is the user name if set (otherwise, the account ID otherwise), and Base32String
 	// is the seed in Base32 format. The Base32String value is Base64-encoded.
-	QRCodePNG []byte `type:"blob"`
+	QRCodePNG []byte `type:"blob" sensitive:"true"`
 
 	// The serial number associated with VirtualMFADevice.
-	SerialNumber *string `type:"string" required:"true"`
+	SerialNumber *string `type:"string" min:"9" max:"256" pattern:"[\\w+=/:,.@-]*" required:"true"`
 
 	// Contains information about an IAM user entity.
 	//
@@ -7582,4 +9094,195 @@ type VirtualMFADevice struct {
 
 type metadataVirtualMFADevice struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the VirtualMFADevice.
+func (s VirtualMFADevice) String() string {
+	return awsutil.StringValue(s)
+}
+
+// assignmentStatusType is an enum of the values of assignmentStatusType members.
+type assignmentStatusType string
+
+// The values of assignmentStatusType.
+const (
+	assignmentStatusTypeAssigned   assignmentStatusType = "Assigned"
+	assignmentStatusTypeUnassigned assignmentStatusType = "Unassigned"
+	assignmentStatusTypeAny        assignmentStatusType = "Any"
+)
+
+// assignmentStatusTypeValues returns the values of assignmentStatusType known to this
+// version of the SDK. The service may accept values added since.
+func assignmentStatusTypeValues() []assignmentStatusType {
+	return []assignmentStatusType{
+		assignmentStatusType("Assigned"),
+		assignmentStatusType("Unassigned"),
+		assignmentStatusType("Any"),
+	}
+}
+
+// String returns the assignmentStatusType as the string of a member.
+func (v assignmentStatusType) String() string {
+	return string(v)
+}
+
+// ParseassignmentStatusType returns the assignmentStatusType of str, failing if it is not
+// one of the assignmentStatusTypeValues.
+func ParseassignmentStatusType(str string) (assignmentStatusType, error) {
+	for _, v := range assignmentStatusTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid assignmentStatusType value %q", str)
+}
+
+// policyScopeType is an enum of the values of policyScopeType members.
+type policyScopeType string
+
+// The values of policyScopeType.
+const (
+	policyScopeTypeAll   policyScopeType = "All"
+	policyScopeTypeAWS   policyScopeType = "AWS"
+	policyScopeTypeLocal policyScopeType = "Local"
+)
+
+// policyScopeTypeValues returns the values of policyScopeType known to this
+// version of the SDK. The service may accept values added since.
+func policyScopeTypeValues() []policyScopeType {
+	return []policyScopeType{
+		policyScopeType("All"),
+		policyScopeType("AWS"),
+		policyScopeType("Local"),
+	}
+}
+
+// String returns the policyScopeType as the string of a member.
+func (v policyScopeType) String() string {
+	return string(v)
+}
+
+// ParsepolicyScopeType returns the policyScopeType of str, failing if it is not
+// one of the policyScopeTypeValues.
+func ParsepolicyScopeType(str string) (policyScopeType, error) {
+	for _, v := range policyScopeTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid policyScopeType value %q", str)
+}
+
+// statusType is an enum of the values of statusType members.
+type statusType string
+
+// The values of statusType.
+const (
+	statusTypeActive   statusType = "Active"
+	statusTypeInactive statusType = "Inactive"
+)
+
+// statusTypeValues returns the values of statusType known to this
+// version of the SDK. The service may accept values added since.
+func statusTypeValues() []statusType {
+	return []statusType{
+		statusType("Active"),
+		statusType("Inactive"),
+	}
+}
+
+// String returns the statusType as the string of a member.
+func (v statusType) String() string {
+	return string(v)
+}
+
+// ParsestatusType returns the statusType of str, failing if it is not
+// one of the statusTypeValues.
+func ParsestatusType(str string) (statusType, error) {
+	for _, v := range statusTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid statusType value %q", str)
+}
+
+// summaryKeyType is an enum of the values of summaryKeyType members.
+type summaryKeyType string
+
+// The values of summaryKeyType.
+const (
+	summaryKeyTypeUsers                             summaryKeyType = "Users"
+	summaryKeyTypeUsersQuota                        summaryKeyType = "UsersQuota"
+	summaryKeyTypeGroups                            summaryKeyType = "Groups"
+	summaryKeyTypeGroupsQuota                       summaryKeyType = "GroupsQuota"
+	summaryKeyTypeServerCertificates                summaryKeyType = "ServerCertificates"
+	summaryKeyTypeServerCertificatesQuota           summaryKeyType = "ServerCertificatesQuota"
+	summaryKeyTypeUserPolicySizeQuota               summaryKeyType = "UserPolicySizeQuota"
+	summaryKeyTypeGroupPolicySizeQuota              summaryKeyType = "GroupPolicySizeQuota"
+	summaryKeyTypeGroupsPerUserQuota                summaryKeyType = "GroupsPerUserQuota"
+	summaryKeyTypeSigningCertificatesPerUserQuota   summaryKeyType = "SigningCertificatesPerUserQuota"
+	summaryKeyTypeAccessKeysPerUserQuota            summaryKeyType = "AccessKeysPerUserQuota"
+	summaryKeyTypeMFADevices                        summaryKeyType = "MFADevices"
+	summaryKeyTypeMFADevicesInUse                   summaryKeyType = "MFADevicesInUse"
+	summaryKeyTypeAccountMFAEnabled                 summaryKeyType = "AccountMFAEnabled"
+	summaryKeyTypeAccountAccessKeysPresent          summaryKeyType = "AccountAccessKeysPresent"
+	summaryKeyTypeAccountSigningCertificatesPresent summaryKeyType = "AccountSigningCertificatesPresent"
+	summaryKeyTypeAttachedPoliciesPerGroupQuota     summaryKeyType = "AttachedPoliciesPerGroupQuota"
+	summaryKeyTypeAttachedPoliciesPerRoleQuota      summaryKeyType = "AttachedPoliciesPerRoleQuota"
+	summaryKeyTypeAttachedPoliciesPerUserQuota      summaryKeyType = "AttachedPoliciesPerUserQuota"
+	summaryKeyTypePolicies                          summaryKeyType = "Policies"
+	summaryKeyTypePoliciesQuota                     summaryKeyType = "PoliciesQuota"
+	summaryKeyTypePolicySizeQuota                   summaryKeyType = "PolicySizeQuota"
+	summaryKeyTypePolicyVersionsInUse               summaryKeyType = "PolicyVersionsInUse"
+	summaryKeyTypePolicyVersionsInUseQuota          summaryKeyType = "PolicyVersionsInUseQuota"
+	summaryKeyTypeVersionsPerPolicyQuota            summaryKeyType = "VersionsPerPolicyQuota"
+)
+
+// summaryKeyTypeValues returns the values of summaryKeyType known to this
+// version of the SDK. The service may accept values added since.
+func summaryKeyTypeValues() []summaryKeyType {
+	return []summaryKeyType{
+		summaryKeyType("Users"),
+		summaryKeyType("UsersQuota"),
+		summaryKeyType("Groups"),
+		summaryKeyType("GroupsQuota"),
+		summaryKeyType("ServerCertificates"),
+		summaryKeyType("ServerCertificatesQuota"),
+		summaryKeyType("UserPolicySizeQuota"),
+		summaryKeyType("GroupPolicySizeQuota"),
+		summaryKeyType("GroupsPerUserQuota"),
+		summaryKeyType("SigningCertificatesPerUserQuota"),
+		summaryKeyType("AccessKeysPerUserQuota"),
+		summaryKeyType("MFADevices"),
+		summaryKeyType("MFADevicesInUse"),
+		summaryKeyType("AccountMFAEnabled"),
+		summaryKeyType("AccountAccessKeysPresent"),
+		summaryKeyType("AccountSigningCertificatesPresent"),
+		summaryKeyType("AttachedPoliciesPerGroupQuota"),
+		summaryKeyType("AttachedPoliciesPerRoleQuota"),
+		summaryKeyType("AttachedPoliciesPerUserQuota"),
+		summaryKeyType("Policies"),
+		summaryKeyType("PoliciesQuota"),
+		summaryKeyType("PolicySizeQuota"),
+		summaryKeyType("PolicyVersionsInUse"),
+		summaryKeyType("PolicyVersionsInUseQuota"),
+		summaryKeyType("VersionsPerPolicyQuota"),
+	}
+}
+
+// String returns the summaryKeyType as the string of a member.
+func (v summaryKeyType) String() string {
+	return string(v)
+}
+
+// ParsesummaryKeyType returns the summaryKeyType of str, failing if it is not
+// one of the summaryKeyTypeValues.
+func ParsesummaryKeyType(str string) (summaryKeyType, error) {
+	for _, v := range summaryKeyTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid summaryKeyType value %q", str)
 }
\ No newline at end of file
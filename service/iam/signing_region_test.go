@@ -0,0 +1,38 @@
+package iam_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/service/iam"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignsForUSEast1(t *testing.T) {
+	svc := iam.New(&aws.Config{
+		Credentials: aws.Creds("AKID", "SECRET", ""),
+		Region:      "eu-west-1",
+	})
+	req, _ := svc.ListUsersRequest(&iam.ListUsersInput{})
+	req.Time = time.Unix(0, 0)
+	assert.NoError(t, req.Sign())
+
+	assert.Equal(t, "iam.amazonaws.com", req.HTTPRequest.URL.Host)
+	assert.Contains(t, req.HTTPRequest.Header.Get("Authorization"),
+		"Credential=AKID/19700101/us-east-1/iam/aws4_request")
+}
+
+func TestSignsForGovCloudRegion(t *testing.T) {
+	svc := iam.New(&aws.Config{
+		Credentials: aws.Creds("AKID", "SECRET", ""),
+		Region:      "us-gov-west-1",
+	})
+	req, _ := svc.ListUsersRequest(&iam.ListUsersInput{})
+	req.Time = time.Unix(0, 0)
+	assert.NoError(t, req.Sign())
+
+	assert.Equal(t, "iam.us-gov.amazonaws.com", req.HTTPRequest.URL.Host)
+	assert.Contains(t, req.HTTPRequest.Header.Get("Authorization"),
+		"Credential=AKID/19700101/us-gov-west-1/iam/aws4_request")
+}
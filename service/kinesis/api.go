@@ -4,7 +4,10 @@
 package kinesis
 
 import (
+	"fmt"
+
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // AddTagsToStreamRequest generates a request for the AddTagsToStream operation.
@@ -150,6 +153,12 @@ func (c *Kinesis) DescribeStreamRequest(input *DescribeStreamInput) (req *aws.Re
 			Name:       "DescribeStream",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"ExclusiveStartShardID"},
+				OutputTokens:    []string{"StreamDescription.Shards[-1].ShardID"},
+				LimitToken:      "Limit",
+				TruncationToken: "StreamDescription.HasMoreShards",
+			},
 		}
 	}
 
@@ -188,6 +197,16 @@ func (c *Kinesis) DescribeStream(input *DescribeStreamInput) (output *DescribeSt
 	return
 }
 
+// DescribeStreamPages iterates over the pages of a DescribeStream operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *Kinesis) DescribeStreamPages(input *DescribeStreamInput, fn func(p *DescribeStreamOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeStreamRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeStreamOutput), lastPage)
+	})
+}
+
 var opDescribeStream *aws.Operation
 
 // GetRecordsRequest generates a request for the GetRecords operation.
@@ -329,6 +348,12 @@ func (c *Kinesis) ListStreamsRequest(input *ListStreamsInput) (req *aws.Request,
 			Name:       "ListStreams",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"ExclusiveStartStreamName"},
+				OutputTokens:    []string{"StreamNames[-1]"},
+				LimitToken:      "Limit",
+				TruncationToken: "HasMoreStreams",
+			},
 		}
 	}
 
@@ -361,6 +386,16 @@ func (c *Kinesis) ListStreams(input *ListStreamsInput) (output *ListStreamsOutpu
 	return
 }
 
+// ListStreamsPages iterates over the pages of a ListStreams operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *Kinesis) ListStreamsPages(input *ListStreamsInput, fn func(p *ListStreamsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListStreamsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListStreamsOutput), lastPage)
+	})
+}
+
 var opListStreams *aws.Operation
 
 // ListTagsForStreamRequest generates a request for the ListTagsForStream operation.
@@ -692,10 +727,10 @@ var opSplitShard *aws.Operation
 // Represents the input for AddTagsToStream.
 type AddTagsToStreamInput struct {
 	// The name of the stream.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// The set of key-value pairs to use to create the tags.
-	Tags *map[string]*string `type:"map" required:"true"`
+	Tags *map[string]*string `type:"map" min:"1" max:"10" required:"true"`
 
 	metadataAddTagsToStreamInput `json:"-", xml:"-"`
 }
@@ -704,6 +739,11 @@ type metadataAddTagsToStreamInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddTagsToStreamInput.
+func (s AddTagsToStreamInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AddTagsToStreamOutput struct {
 	metadataAddTagsToStreamOutput `json:"-", xml:"-"`
 }
@@ -712,6 +752,11 @@ type metadataAddTagsToStreamOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddTagsToStreamOutput.
+func (s AddTagsToStreamOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input for CreateStream.
 type CreateStreamInput struct {
 	// The number of shards that the stream will use. The throughput of the stream
@@ -721,14 +766,14 @@ type CreateStreamInput struct {
 	// Note: The default limit for an AWS account is 10 shards per stream. If you
 	// need to create a stream with more than 10 shards, contact AWS Support (http://docs.aws.amazon.com/general/latest/gr/aws_service_limits.html)
 	// to increase the limit on your account.
-	ShardCount *int64 `type:"integer" required:"true"`
+	ShardCount *int64 `type:"integer" min:"1" required:"true"`
 
 	// A name to identify the stream. The stream name is scoped to the AWS account
 	// used by the application that creates the stream. It is also scoped by region.
 	// That is, two streams in two different AWS accounts can have the same name,
 	// and two streams in the same AWS account, but in two different regions, can
 	// have the same name.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataCreateStreamInput `json:"-", xml:"-"`
 }
@@ -737,6 +782,11 @@ type metadataCreateStreamInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateStreamInput.
+func (s CreateStreamInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateStreamOutput struct {
 	metadataCreateStreamOutput `json:"-", xml:"-"`
 }
@@ -745,10 +795,15 @@ type metadataCreateStreamOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateStreamOutput.
+func (s CreateStreamOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input for DeleteStream.
 type DeleteStreamInput struct {
 	// The name of the stream to delete.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataDeleteStreamInput `json:"-", xml:"-"`
 }
@@ -757,6 +812,11 @@ type metadataDeleteStreamInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteStreamInput.
+func (s DeleteStreamInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteStreamOutput struct {
 	metadataDeleteStreamOutput `json:"-", xml:"-"`
 }
@@ -765,16 +825,21 @@ type metadataDeleteStreamOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteStreamOutput.
+func (s DeleteStreamOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input for DescribeStream.
 type DescribeStreamInput struct {
 	// The shard ID of the shard to start with.
-	ExclusiveStartShardID *string `locationName:"ExclusiveStartShardId" type:"string"`
+	ExclusiveStartShardID *string `locationName:"ExclusiveStartShardId" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The maximum number of shards to return.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"10000"`
 
 	// The name of the stream to describe.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataDescribeStreamInput `json:"-", xml:"-"`
 }
@@ -783,6 +848,11 @@ type metadataDescribeStreamInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeStreamInput.
+func (s DescribeStreamInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output for DescribeStream.
 type DescribeStreamOutput struct {
 	// The current status of the stream, the stream ARN, an array of shard objects
@@ -796,16 +866,21 @@ type metadataDescribeStreamOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeStreamOutput.
+func (s DescribeStreamOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input for GetRecords.
 type GetRecordsInput struct {
 	// The maximum number of records to return. Specify a value of up to 10,000.
 	// If you specify a value that is greater than 10,000, GetRecords throws InvalidArgumentException.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"10000"`
 
 	// The position in the shard from which you want to start sequentially reading
 	// data records. A shard iterator specifies this position using the sequence
 	// number of a data record in the shard.
-	ShardIterator *string `type:"string" required:"true"`
+	ShardIterator *string `type:"string" min:"1" max:"512" required:"true"`
 
 	metadataGetRecordsInput `json:"-", xml:"-"`
 }
@@ -814,12 +889,17 @@ type metadataGetRecordsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetRecordsInput.
+func (s GetRecordsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output for GetRecords.
 type GetRecordsOutput struct {
 	// The next position in the shard from which to start sequentially reading data
 	// records. If set to null, the shard has been closed and the requested iterator
 	// will not return any more data.
-	NextShardIterator *string `type:"string"`
+	NextShardIterator *string `type:"string" min:"1" max:"512"`
 
 	// The data records retrieved from the shard.
 	Records []*Record `type:"list" required:"true"`
@@ -831,10 +911,15 @@ type metadataGetRecordsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetRecordsOutput.
+func (s GetRecordsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input for GetShardIterator.
 type GetShardIteratorInput struct {
 	// The shard ID of the shard to get the iterator for.
-	ShardID *string `locationName:"ShardId" type:"string" required:"true"`
+	ShardID *string `locationName:"ShardId" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// Determines how the shard iterator is used to start reading data records from
 	// the shard.
@@ -848,14 +933,14 @@ type GetShardIteratorInput struct {
 	// the oldest data record in the shard. LATEST - Start reading just after the
 	// most recent record in the shard, so that you always read the most recent
 	// data in the shard.
-	ShardIteratorType *string `type:"string" required:"true"`
+	ShardIteratorType *string `type:"string" enum:"AT_SEQUENCE_NUMBER,AFTER_SEQUENCE_NUMBER,TRIM_HORIZON,LATEST" required:"true"`
 
 	// The sequence number of the data record in the shard from which to start reading
 	// from.
-	StartingSequenceNumber *string `type:"string"`
+	StartingSequenceNumber *string `type:"string" pattern:"0|([1-9]\\d{0,128})"`
 
 	// The name of the stream.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataGetShardIteratorInput `json:"-", xml:"-"`
 }
@@ -864,12 +949,17 @@ type metadataGetShardIteratorInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetShardIteratorInput.
+func (s GetShardIteratorInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output for GetShardIterator.
 type GetShardIteratorOutput struct {
 	// The position in the shard from which to start reading data records sequentially.
 	// A shard iterator specifies this position using the sequence number of a data
 	// record in a shard.
-	ShardIterator *string `type:"string"`
+	ShardIterator *string `type:"string" min:"1" max:"512"`
 
 	metadataGetShardIteratorOutput `json:"-", xml:"-"`
 }
@@ -878,14 +968,19 @@ type metadataGetShardIteratorOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetShardIteratorOutput.
+func (s GetShardIteratorOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The range of possible hash key values for the shard, which is a set of ordered
 // contiguous positive integers.
 type HashKeyRange struct {
 	// The ending hash key of the hash key range.
-	EndingHashKey *string `type:"string" required:"true"`
+	EndingHashKey *string `type:"string" pattern:"0|([1-9]\\d{0,38})" required:"true"`
 
 	// The starting hash key of the hash key range.
-	StartingHashKey *string `type:"string" required:"true"`
+	StartingHashKey *string `type:"string" pattern:"0|([1-9]\\d{0,38})" required:"true"`
 
 	metadataHashKeyRange `json:"-", xml:"-"`
 }
@@ -894,13 +989,18 @@ type metadataHashKeyRange struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the HashKeyRange.
+func (s HashKeyRange) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input for ListStreams.
 type ListStreamsInput struct {
 	// The name of the stream to start the list with.
-	ExclusiveStartStreamName *string `type:"string"`
+	ExclusiveStartStreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The maximum number of streams to list.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"10000"`
 
 	metadataListStreamsInput `json:"-", xml:"-"`
 }
@@ -909,6 +1009,11 @@ type metadataListStreamsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListStreamsInput.
+func (s ListStreamsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output for ListStreams.
 type ListStreamsOutput struct {
 	// If set to true, there are more streams available to list.
@@ -925,19 +1030,24 @@ type metadataListStreamsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListStreamsOutput.
+func (s ListStreamsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input for ListTagsForStream.
 type ListTagsForStreamInput struct {
 	// The key to use as the starting point for the list of tags. If this parameter
 	// is set, ListTagsForStream gets all tags that occur after ExclusiveStartTagKey.
-	ExclusiveStartTagKey *string `type:"string"`
+	ExclusiveStartTagKey *string `type:"string" min:"1" max:"128"`
 
 	// The number of tags to return. If this number is less than the total number
 	// of tags associated with the stream, HasMoreTags is set to true. To list additional
 	// tags, set ExclusiveStartTagKey to the last key in the response.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"10"`
 
 	// The name of the stream.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataListTagsForStreamInput `json:"-", xml:"-"`
 }
@@ -946,6 +1056,11 @@ type metadataListTagsForStreamInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListTagsForStreamInput.
+func (s ListTagsForStreamInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output for ListTagsForStream.
 type ListTagsForStreamOutput struct {
 	// If set to true, more tags are available. To request additional tags, set
@@ -963,16 +1078,21 @@ type metadataListTagsForStreamOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListTagsForStreamOutput.
+func (s ListTagsForStreamOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input for MergeShards.
 type MergeShardsInput struct {
 	// The shard ID of the adjacent shard for the merge.
-	AdjacentShardToMerge *string `type:"string" required:"true"`
+	AdjacentShardToMerge *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// The shard ID of the shard to combine with the adjacent shard for the merge.
-	ShardToMerge *string `type:"string" required:"true"`
+	ShardToMerge *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// The name of the stream for the merge.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataMergeShardsInput `json:"-", xml:"-"`
 }
@@ -981,6 +1101,11 @@ type metadataMergeShardsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the MergeShardsInput.
+func (s MergeShardsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type MergeShardsOutput struct {
 	metadataMergeShardsOutput `json:"-", xml:"-"`
 }
@@ -989,16 +1114,21 @@ type metadataMergeShardsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the MergeShardsOutput.
+func (s MergeShardsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input for PutRecord.
 type PutRecordInput struct {
 	// The data blob to put into the record, which is base64-encoded when the blob
 	// is serialized. The maximum size of the data blob (the payload before base64-encoding)
 	// is 50 kilobytes (KB)
-	Data []byte `type:"blob" required:"true"`
+	Data []byte `type:"blob" max:"51200" required:"true"`
 
 	// The hash value used to explicitly determine the shard the data record is
 	// assigned to by overriding the partition key hash.
-	ExplicitHashKey *string `type:"string"`
+	ExplicitHashKey *string `type:"string" pattern:"0|([1-9]\\d{0,38})"`
 
 	// Determines which shard in the stream the data record is assigned to. Partition
 	// keys are Unicode strings with a maximum length limit of 256 bytes. Amazon
@@ -1008,17 +1138,17 @@ type PutRecordInput struct {
 	// to map associated data records to shards. As a result of this hashing mechanism,
 	// all data records with the same partition key will map to the same shard within
 	// the stream.
-	PartitionKey *string `type:"string" required:"true"`
+	PartitionKey *string `type:"string" min:"1" max:"256" required:"true"`
 
 	// Guarantees strictly increasing sequence numbers, for puts from the same client
 	// and to the same partition key. Usage: set the SequenceNumberForOrdering of
 	// record n to the sequence number of record n-1 (as returned in the PutRecordResult
 	// when putting record n-1). If this parameter is not set, records will be coarsely
 	// ordered based on arrival time.
-	SequenceNumberForOrdering *string `type:"string"`
+	SequenceNumberForOrdering *string `type:"string" pattern:"0|([1-9]\\d{0,128})"`
 
 	// The name of the stream to put the data record into.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataPutRecordInput `json:"-", xml:"-"`
 }
@@ -1027,16 +1157,21 @@ type metadataPutRecordInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutRecordInput.
+func (s PutRecordInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output for PutRecord.
 type PutRecordOutput struct {
 	// The sequence number identifier that was assigned to the put data record.
 	// The sequence number for the record is unique across all records in the stream.
 	// A sequence number is the identifier associated with every record put into
 	// the stream.
-	SequenceNumber *string `type:"string" required:"true"`
+	SequenceNumber *string `type:"string" pattern:"0|([1-9]\\d{0,128})" required:"true"`
 
 	// The shard ID of the shard where the data record was placed.
-	ShardID *string `locationName:"ShardId" type:"string" required:"true"`
+	ShardID *string `locationName:"ShardId" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataPutRecordOutput `json:"-", xml:"-"`
 }
@@ -1045,13 +1180,18 @@ type metadataPutRecordOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutRecordOutput.
+func (s PutRecordOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A PutRecords request.
 type PutRecordsInput struct {
 	// The records associated with the request.
-	Records []*PutRecordsRequestEntry `type:"list" required:"true"`
+	Records []*PutRecordsRequestEntry `type:"list" min:"1" max:"500" required:"true"`
 
 	// The stream name associated with the request.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataPutRecordsInput `json:"-", xml:"-"`
 }
@@ -1060,17 +1200,22 @@ type metadataPutRecordsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutRecordsInput.
+func (s PutRecordsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // PutRecords results.
 type PutRecordsOutput struct {
 	// The number of unsuccessfully processed records in a PutRecords request.
-	FailedRecordCount *int64 `type:"integer"`
+	FailedRecordCount *int64 `type:"integer" min:"1"`
 
 	// An array of successfully and unsuccessfully processed record results, correlated
 	// with the request by natural ordering. A record that is successfully added
 	// to your Amazon Kinesis stream includes SequenceNumber and ShardId in the
 	// result. A record that fails to be added to your Amazon Kinesis stream includes
 	// ErrorCode and ErrorMessage in the result.
-	Records []*PutRecordsResultEntry `type:"list" required:"true"`
+	Records []*PutRecordsResultEntry `type:"list" min:"1" max:"500" required:"true"`
 
 	metadataPutRecordsOutput `json:"-", xml:"-"`
 }
@@ -1079,16 +1224,21 @@ type metadataPutRecordsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutRecordsOutput.
+func (s PutRecordsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output for PutRecords.
 type PutRecordsRequestEntry struct {
 	// The data blob to put into the record, which is base64-encoded when the blob
 	// is serialized. The maximum size of the data blob (the payload before base64-encoding)
 	// is 50 kilobytes (KB)
-	Data []byte `type:"blob" required:"true"`
+	Data []byte `type:"blob" max:"51200" required:"true"`
 
 	// The hash value used to determine explicitly the shard that the data record
 	// is assigned to by overriding the partition key hash.
-	ExplicitHashKey *string `type:"string"`
+	ExplicitHashKey *string `type:"string" pattern:"0|([1-9]\\d{0,38})"`
 
 	// Determines which shard in the stream the data record is assigned to. Partition
 	// keys are Unicode strings with a maximum length limit of 256 bytes. Amazon
@@ -1098,7 +1248,7 @@ type PutRecordsRequestEntry struct {
 	// to map associated data records to shards. As a result of this hashing mechanism,
 	// all data records with the same partition key map to the same shard within
 	// the stream.
-	PartitionKey *string `type:"string" required:"true"`
+	PartitionKey *string `type:"string" min:"1" max:"256" required:"true"`
 
 	metadataPutRecordsRequestEntry `json:"-", xml:"-"`
 }
@@ -1107,6 +1257,11 @@ type metadataPutRecordsRequestEntry struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutRecordsRequestEntry.
+func (s PutRecordsRequestEntry) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the result of an individual record from a PutRecords request.
 // A record that is successfully added to your Amazon Kinesis stream includes
 // SequenceNumber and ShardId in the result. A record that fails to be added
@@ -1124,10 +1279,10 @@ type PutRecordsResultEntry struct {
 	ErrorMessage *string `type:"string"`
 
 	// The sequence number for an individual record result.
-	SequenceNumber *string `type:"string"`
+	SequenceNumber *string `type:"string" pattern:"0|([1-9]\\d{0,128})"`
 
 	// The shard ID for an individual record result.
-	ShardID *string `locationName:"ShardId" type:"string"`
+	ShardID *string `locationName:"ShardId" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+"`
 
 	metadataPutRecordsResultEntry `json:"-", xml:"-"`
 }
@@ -1136,6 +1291,11 @@ type metadataPutRecordsResultEntry struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutRecordsResultEntry.
+func (s PutRecordsResultEntry) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The unit of data of the Amazon Kinesis stream, which is composed of a sequence
 // number, a partition key, and a data blob.
 type Record struct {
@@ -1143,13 +1303,13 @@ type Record struct {
 	// Kinesis service, which does not inspect, interpret, or change the data in
 	// the blob in any way. The maximum size of the data blob (the payload before
 	// base64-encoding) is 50 kilobytes (KB)
-	Data []byte `type:"blob" required:"true"`
+	Data []byte `type:"blob" max:"51200" required:"true"`
 
 	// Identifies which shard in the stream the data record is assigned to.
-	PartitionKey *string `type:"string" required:"true"`
+	PartitionKey *string `type:"string" min:"1" max:"256" required:"true"`
 
 	// The unique identifier for the record in the Amazon Kinesis stream.
-	SequenceNumber *string `type:"string" required:"true"`
+	SequenceNumber *string `type:"string" pattern:"0|([1-9]\\d{0,128})" required:"true"`
 
 	metadataRecord `json:"-", xml:"-"`
 }
@@ -1158,13 +1318,18 @@ type metadataRecord struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Record.
+func (s Record) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the input for RemoveTagsFromStream.
 type RemoveTagsFromStreamInput struct {
 	// The name of the stream.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// A list of tag keys. Each corresponding tag is removed from the stream.
-	TagKeys []*string `type:"list" required:"true"`
+	TagKeys []*string `type:"list" min:"1" max:"10" required:"true"`
 
 	metadataRemoveTagsFromStreamInput `json:"-", xml:"-"`
 }
@@ -1173,6 +1338,11 @@ type metadataRemoveTagsFromStreamInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RemoveTagsFromStreamInput.
+func (s RemoveTagsFromStreamInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RemoveTagsFromStreamOutput struct {
 	metadataRemoveTagsFromStreamOutput `json:"-", xml:"-"`
 }
@@ -1181,14 +1351,19 @@ type metadataRemoveTagsFromStreamOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RemoveTagsFromStreamOutput.
+func (s RemoveTagsFromStreamOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The range of possible sequence numbers for the shard.
 type SequenceNumberRange struct {
 	// The ending sequence number for the range. Shards that are in the OPEN state
 	// have an ending sequence number of null.
-	EndingSequenceNumber *string `type:"string"`
+	EndingSequenceNumber *string `type:"string" pattern:"0|([1-9]\\d{0,128})"`
 
 	// The starting sequence number for the range.
-	StartingSequenceNumber *string `type:"string" required:"true"`
+	StartingSequenceNumber *string `type:"string" pattern:"0|([1-9]\\d{0,128})" required:"true"`
 
 	metadataSequenceNumberRange `json:"-", xml:"-"`
 }
@@ -1197,23 +1372,28 @@ type metadataSequenceNumberRange struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SequenceNumberRange.
+func (s SequenceNumberRange) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A uniquely identified group of data records in an Amazon Kinesis stream.
 type Shard struct {
 	// The shard Id of the shard adjacent to the shard's parent.
-	AdjacentParentShardID *string `locationName:"AdjacentParentShardId" type:"string"`
+	AdjacentParentShardID *string `locationName:"AdjacentParentShardId" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The range of possible hash key values for the shard, which is a set of ordered
 	// contiguous positive integers.
 	HashKeyRange *HashKeyRange `type:"structure" required:"true"`
 
 	// The shard Id of the shard's parent.
-	ParentShardID *string `locationName:"ParentShardId" type:"string"`
+	ParentShardID *string `locationName:"ParentShardId" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+"`
 
 	// The range of possible sequence numbers for the shard.
 	SequenceNumberRange *SequenceNumberRange `type:"structure" required:"true"`
 
 	// The unique identifier of the shard within the Amazon Kinesis stream.
-	ShardID *string `locationName:"ShardId" type:"string" required:"true"`
+	ShardID *string `locationName:"ShardId" type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataShard `json:"-", xml:"-"`
 }
@@ -1222,6 +1402,49 @@ type metadataShard struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Shard.
+func (s Shard) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ShardIteratorType is an enum of the values of ShardIteratorType members.
+type ShardIteratorType string
+
+// The values of ShardIteratorType.
+const (
+	ShardIteratorTypeATSEQUENCENUMBER    ShardIteratorType = "AT_SEQUENCE_NUMBER"
+	ShardIteratorTypeAFTERSEQUENCENUMBER ShardIteratorType = "AFTER_SEQUENCE_NUMBER"
+	ShardIteratorTypeTRIMHORIZON         ShardIteratorType = "TRIM_HORIZON"
+	ShardIteratorTypeLATEST              ShardIteratorType = "LATEST"
+)
+
+// ShardIteratorTypeValues returns the values of ShardIteratorType known to this
+// version of the SDK. The service may accept values added since.
+func ShardIteratorTypeValues() []ShardIteratorType {
+	return []ShardIteratorType{
+		ShardIteratorType("AT_SEQUENCE_NUMBER"),
+		ShardIteratorType("AFTER_SEQUENCE_NUMBER"),
+		ShardIteratorType("TRIM_HORIZON"),
+		ShardIteratorType("LATEST"),
+	}
+}
+
+// String returns the ShardIteratorType as the string of a member.
+func (v ShardIteratorType) String() string {
+	return string(v)
+}
+
+// ParseShardIteratorType returns the ShardIteratorType of str, failing if it is not
+// one of the ShardIteratorTypeValues.
+func ParseShardIteratorType(str string) (ShardIteratorType, error) {
+	for _, v := range ShardIteratorTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ShardIteratorType value %q", str)
+}
+
 // Represents the input for SplitShard.
 type SplitShardInput struct {
 	// A hash key value for the starting hash key of one of the child shards created
@@ -1231,13 +1454,13 @@ type SplitShardInput struct {
 	// hash key value and all higher hash key values in hash key range are distributed
 	// to one of the child shards. All the lower hash key values in the range are
 	// distributed to the other child shard.
-	NewStartingHashKey *string `type:"string" required:"true"`
+	NewStartingHashKey *string `type:"string" pattern:"0|([1-9]\\d{0,38})" required:"true"`
 
 	// The shard ID of the shard to split.
-	ShardToSplit *string `type:"string" required:"true"`
+	ShardToSplit *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// The name of the stream for the shard split.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	metadataSplitShardInput `json:"-", xml:"-"`
 }
@@ -1246,6 +1469,11 @@ type metadataSplitShardInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SplitShardInput.
+func (s SplitShardInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SplitShardOutput struct {
 	metadataSplitShardOutput `json:"-", xml:"-"`
 }
@@ -1254,6 +1482,11 @@ type metadataSplitShardOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SplitShardOutput.
+func (s SplitShardOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the output for DescribeStream.
 type StreamDescription struct {
 	// If set to true, more shards in the stream are available to describe.
@@ -1266,7 +1499,7 @@ type StreamDescription struct {
 	StreamARN *string `type:"string" required:"true"`
 
 	// The name of the stream being described.
-	StreamName *string `type:"string" required:"true"`
+	StreamName *string `type:"string" min:"1" max:"128" pattern:"[a-zA-Z0-9_.-]+" required:"true"`
 
 	// The current status of the stream being described.
 	//
@@ -1280,7 +1513,7 @@ type StreamDescription struct {
 	// on an ACTIVE stream.  UPDATING - Shards in the stream are being merged or
 	// split. Read and write operations continue to work while the stream is in
 	// the UPDATING state.
-	StreamStatus *string `type:"string" required:"true"`
+	StreamStatus *string `type:"string" enum:"CREATING,DELETING,ACTIVE,UPDATING" required:"true"`
 
 	metadataStreamDescription `json:"-", xml:"-"`
 }
@@ -1289,20 +1522,68 @@ type metadataStreamDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StreamDescription.
+func (s StreamDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
+// StreamStatus is an enum of the values of StreamStatus members.
+type StreamStatus string
+
+// The values of StreamStatus.
+const (
+	StreamStatusCREATING StreamStatus = "CREATING"
+	StreamStatusDELETING StreamStatus = "DELETING"
+	StreamStatusACTIVE   StreamStatus = "ACTIVE"
+	StreamStatusUPDATING StreamStatus = "UPDATING"
+)
+
+// StreamStatusValues returns the values of StreamStatus known to this
+// version of the SDK. The service may accept values added since.
+func StreamStatusValues() []StreamStatus {
+	return []StreamStatus{
+		StreamStatus("CREATING"),
+		StreamStatus("DELETING"),
+		StreamStatus("ACTIVE"),
+		StreamStatus("UPDATING"),
+	}
+}
+
+// String returns the StreamStatus as the string of a member.
+func (v StreamStatus) String() string {
+	return string(v)
+}
+
+// ParseStreamStatus returns the StreamStatus of str, failing if it is not
+// one of the StreamStatusValues.
+func ParseStreamStatus(str string) (StreamStatus, error) {
+	for _, v := range StreamStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid StreamStatus value %q", str)
+}
+
 // Metadata assigned to the stream, consisting of a key-value pair.
 type Tag struct {
 	// A unique identifier for the tag. Maximum length: 128 characters. Valid characters:
 	// Unicode letters, digits, white space, _ . / = + - % @
-	Key *string `type:"string" required:"true"`
+	Key *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// An optional string, typically used to describe or define the tag. Maximum
 	// length: 256 characters. Valid characters: Unicode letters, digits, white
 	// space, _ . / = + - % @
-	Value *string `type:"string"`
+	Value *string `type:"string" max:"256"`
 
 	metadataTag `json:"-", xml:"-"`
 }
 
 type metadataTag struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the Tag.
+func (s Tag) String() string {
+	return awsutil.StringValue(s)
 }
\ No newline at end of file
@@ -4,9 +4,11 @@
 package kms
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // CreateAliasRequest generates a request for the CreateAlias operation.
@@ -745,13 +747,13 @@ var opUpdateKeyDescription *aws.Operation
 // Contains information about an alias.
 type AliasListEntry struct {
 	// String that contains the key ARN.
-	AliasARN *string `locationName:"AliasArn" type:"string"`
+	AliasARN *string `locationName:"AliasArn" type:"string" min:"20" max:"2048"`
 
 	// String that contains the alias.
-	AliasName *string `type:"string"`
+	AliasName *string `type:"string" min:"1" max:"256" pattern:"^[a-zA-Z0-9:/_-]+$"`
 
 	// String that contains the key identifier pointed to by the alias.
-	TargetKeyID *string `locationName:"TargetKeyId" type:"string"`
+	TargetKeyID *string `locationName:"TargetKeyId" type:"string" min:"1" max:"256"`
 
 	metadataAliasListEntry `json:"-", xml:"-"`
 }
@@ -760,15 +762,20 @@ type metadataAliasListEntry struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AliasListEntry.
+func (s AliasListEntry) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateAliasInput struct {
 	// String that contains the display name. Aliases that begin with AWS are reserved.
-	AliasName *string `type:"string" required:"true"`
+	AliasName *string `type:"string" min:"1" max:"256" pattern:"^[a-zA-Z0-9:/_-]+$" required:"true"`
 
 	// An identifier of the key for which you are creating the alias. This value
 	// cannot be another alias but can be a globally unique identifier or a fully
 	// specified ARN to a key.  Key ARN Example - arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012
-	TargetKeyID *string `locationName:"TargetKeyId" type:"string" required:"true"`
+	TargetKeyID *string `locationName:"TargetKeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataCreateAliasInput `json:"-", xml:"-"`
 }
@@ -777,6 +784,11 @@ type metadataCreateAliasInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateAliasInput.
+func (s CreateAliasInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateAliasOutput struct {
 	metadataCreateAliasOutput `json:"-", xml:"-"`
 }
@@ -785,23 +797,28 @@ type metadataCreateAliasOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateAliasOutput.
+func (s CreateAliasOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateGrantInput struct {
 	// Specifies the conditions under which the actions specified by the Operations
 	// parameter are allowed.
 	Constraints *GrantConstraints `type:"structure"`
 
 	// For more information, see Grant Tokens (http://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#grant_token).
-	GrantTokens []*string `type:"list"`
+	GrantTokens []*string `type:"list" max:"10"`
 
 	// Principal given permission by the grant to use the key identified by the
 	// keyId parameter.
-	GranteePrincipal *string `type:"string" required:"true"`
+	GranteePrincipal *string `type:"string" min:"1" max:"256" required:"true"`
 
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// List of operations permitted by the grant. This can be any combination of
 	// one or more of the following values:  Decrypt Encrypt GenerateDataKey GenerateDataKeyWithoutPlaintext
@@ -810,7 +827,7 @@ type CreateGrantInput struct {
 
 	// Principal given permission to retire the grant. For more information, see
 	// RetireGrant.
-	RetiringPrincipal *string `type:"string"`
+	RetiringPrincipal *string `type:"string" min:"1" max:"256"`
 
 	metadataCreateGrantInput `json:"-", xml:"-"`
 }
@@ -819,12 +836,17 @@ type metadataCreateGrantInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateGrantInput.
+func (s CreateGrantInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateGrantOutput struct {
 	// Unique grant identifier. You can use the GrantId value to revoke a grant.
-	GrantID *string `locationName:"GrantId" type:"string"`
+	GrantID *string `locationName:"GrantId" type:"string" min:"1" max:"128"`
 
 	// For more information, see Grant Tokens (http://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#grant_token).
-	GrantToken *string `type:"string"`
+	GrantToken *string `type:"string" min:"1" max:"8192"`
 
 	metadataCreateGrantOutput `json:"-", xml:"-"`
 }
@@ -833,18 +855,23 @@ type metadataCreateGrantOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateGrantOutput.
+func (s CreateGrantOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateKeyInput struct {
 	// Description of the key. We recommend that you choose a description that helps
 	// your customer decide whether the key is appropriate for a task.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"8192"`
 
 	// Specifies the intended use of the key. Currently this defaults to ENCRYPT/DECRYPT,
 	// and only symmetric encryption and decryption are supported.
-	KeyUsage *string `type:"string"`
+	KeyUsage *string `type:"string" enum:"ENCRYPT_DECRYPT"`
 
 	// Policy to be attached to the key. This is required and delegates back to
 	// the account. The key is the root of trust.
-	Policy *string `type:"string"`
+	Policy *string `type:"string" min:"1" max:"131072" pattern:"[\\u0009\\u000A\\u000D\\u0020-\\u00FF]+"`
 
 	metadataCreateKeyInput `json:"-", xml:"-"`
 }
@@ -853,6 +880,11 @@ type metadataCreateKeyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateKeyInput.
+func (s CreateKeyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateKeyOutput struct {
 	// Metadata associated with the key.
 	KeyMetadata *KeyMetadata `type:"structure"`
@@ -864,9 +896,48 @@ type metadataCreateKeyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateKeyOutput.
+func (s CreateKeyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// DataKeySpec is an enum of the values of DataKeySpec members.
+type DataKeySpec string
+
+// The values of DataKeySpec.
+const (
+	DataKeySpecAES256 DataKeySpec = "AES_256"
+	DataKeySpecAES128 DataKeySpec = "AES_128"
+)
+
+// DataKeySpecValues returns the values of DataKeySpec known to this
+// version of the SDK. The service may accept values added since.
+func DataKeySpecValues() []DataKeySpec {
+	return []DataKeySpec{
+		DataKeySpec("AES_256"),
+		DataKeySpec("AES_128"),
+	}
+}
+
+// String returns the DataKeySpec as the string of a member.
+func (v DataKeySpec) String() string {
+	return string(v)
+}
+
+// ParseDataKeySpec returns the DataKeySpec of str, failing if it is not
+// one of the DataKeySpecValues.
+func ParseDataKeySpec(str string) (DataKeySpec, error) {
+	for _, v := range DataKeySpecValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DataKeySpec value %q", str)
+}
+
 type DecryptInput struct {
 	// Ciphertext to be decrypted. The blob includes metadata.
-	CiphertextBlob []byte `type:"blob" required:"true"`
+	CiphertextBlob []byte `type:"blob" min:"1" max:"6144" required:"true"`
 
 	// The encryption context. If this was specified in the Encrypt function, it
 	// must be specified here or the decryption operation will fail. For more information,
@@ -874,7 +945,7 @@ type DecryptInput struct {
 	EncryptionContext *map[string]*string `type:"map"`
 
 	// For more information, see Grant Tokens (http://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#grant_token).
-	GrantTokens []*string `type:"list"`
+	GrantTokens []*string `type:"list" max:"10"`
 
 	metadataDecryptInput `json:"-", xml:"-"`
 }
@@ -883,14 +954,19 @@ type metadataDecryptInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DecryptInput.
+func (s DecryptInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DecryptOutput struct {
 	// ARN of the key used to perform the decryption. This value is returned if
 	// no errors are encountered during the operation.
-	KeyID *string `locationName:"KeyId" type:"string"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256"`
 
 	// Decrypted plaintext data. This value may not be returned if the customer
 	// master key is not available or if you didn't have permission to use it.
-	Plaintext []byte `type:"blob"`
+	Plaintext []byte `type:"blob" min:"1" max:"4096" sensitive:"true"`
 
 	metadataDecryptOutput `json:"-", xml:"-"`
 }
@@ -899,9 +975,14 @@ type metadataDecryptOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DecryptOutput.
+func (s DecryptOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteAliasInput struct {
 	// The alias to be deleted.
-	AliasName *string `type:"string" required:"true"`
+	AliasName *string `type:"string" min:"1" max:"256" pattern:"^[a-zA-Z0-9:/_-]+$" required:"true"`
 
 	metadataDeleteAliasInput `json:"-", xml:"-"`
 }
@@ -910,6 +991,11 @@ type metadataDeleteAliasInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteAliasInput.
+func (s DeleteAliasInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteAliasOutput struct {
 	metadataDeleteAliasOutput `json:"-", xml:"-"`
 }
@@ -918,6 +1004,11 @@ type metadataDeleteAliasOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteAliasOutput.
+func (s DeleteAliasOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeKeyInput struct {
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier, a fully specified ARN to either an alias or a key, or
@@ -925,7 +1016,7 @@ type DescribeKeyInput struct {
 	// Alias ARN Example - arn:aws:kms:us-east-1:123456789012:/alias/MyAliasName
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012 Alias Name
 	// Example - alias/MyAliasName
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDescribeKeyInput `json:"-", xml:"-"`
 }
@@ -934,6 +1025,11 @@ type metadataDescribeKeyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeKeyInput.
+func (s DescribeKeyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeKeyOutput struct {
 	// Metadata associated with the key.
 	KeyMetadata *KeyMetadata `type:"structure"`
@@ -945,12 +1041,17 @@ type metadataDescribeKeyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeKeyOutput.
+func (s DescribeKeyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DisableKeyInput struct {
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDisableKeyInput `json:"-", xml:"-"`
 }
@@ -959,6 +1060,11 @@ type metadataDisableKeyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DisableKeyInput.
+func (s DisableKeyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DisableKeyOutput struct {
 	metadataDisableKeyOutput `json:"-", xml:"-"`
 }
@@ -967,12 +1073,17 @@ type metadataDisableKeyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DisableKeyOutput.
+func (s DisableKeyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DisableKeyRotationInput struct {
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDisableKeyRotationInput `json:"-", xml:"-"`
 }
@@ -981,6 +1092,11 @@ type metadataDisableKeyRotationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DisableKeyRotationInput.
+func (s DisableKeyRotationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DisableKeyRotationOutput struct {
 	metadataDisableKeyRotationOutput `json:"-", xml:"-"`
 }
@@ -989,12 +1105,17 @@ type metadataDisableKeyRotationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DisableKeyRotationOutput.
+func (s DisableKeyRotationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type EnableKeyInput struct {
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataEnableKeyInput `json:"-", xml:"-"`
 }
@@ -1003,6 +1124,11 @@ type metadataEnableKeyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EnableKeyInput.
+func (s EnableKeyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type EnableKeyOutput struct {
 	metadataEnableKeyOutput `json:"-", xml:"-"`
 }
@@ -1011,12 +1137,17 @@ type metadataEnableKeyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EnableKeyOutput.
+func (s EnableKeyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type EnableKeyRotationInput struct {
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataEnableKeyRotationInput `json:"-", xml:"-"`
 }
@@ -1025,6 +1156,11 @@ type metadataEnableKeyRotationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EnableKeyRotationInput.
+func (s EnableKeyRotationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type EnableKeyRotationOutput struct {
 	metadataEnableKeyRotationOutput `json:"-", xml:"-"`
 }
@@ -1033,6 +1169,11 @@ type metadataEnableKeyRotationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EnableKeyRotationOutput.
+func (s EnableKeyRotationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type EncryptInput struct {
 	// Name/value pair that specifies the encryption context to be used for authenticated
 	// encryption. If used here, the same value must be supplied to the Decrypt
@@ -1041,7 +1182,7 @@ type EncryptInput struct {
 	EncryptionContext *map[string]*string `type:"map"`
 
 	// For more information, see Grant Tokens (http://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#grant_token).
-	GrantTokens []*string `type:"list"`
+	GrantTokens []*string `type:"list" max:"10"`
 
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier, a fully specified ARN to either an alias or a key, or
@@ -1049,10 +1190,10 @@ type EncryptInput struct {
 	// Alias ARN Example - arn:aws:kms:us-east-1:123456789012:/alias/MyAliasName
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012 Alias Name
 	// Example - alias/MyAliasName
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// Data to be encrypted.
-	Plaintext []byte `type:"blob" required:"true"`
+	Plaintext []byte `type:"blob" min:"1" max:"4096" sensitive:"true" required:"true"`
 
 	metadataEncryptInput `json:"-", xml:"-"`
 }
@@ -1061,13 +1202,18 @@ type metadataEncryptInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EncryptInput.
+func (s EncryptInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type EncryptOutput struct {
 	// The encrypted plaintext. If you are using the CLI, the value is Base64 encoded.
 	// Otherwise, it is not encoded.
-	CiphertextBlob []byte `type:"blob"`
+	CiphertextBlob []byte `type:"blob" min:"1" max:"6144"`
 
 	// The ID of the key used during encryption.
-	KeyID *string `locationName:"KeyId" type:"string"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256"`
 
 	metadataEncryptOutput `json:"-", xml:"-"`
 }
@@ -1076,6 +1222,11 @@ type metadataEncryptOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EncryptOutput.
+func (s EncryptOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GenerateDataKeyInput struct {
 	// Name/value pair that contains additional data to be authenticated during
 	// the encryption and decryption processes that use the key. This value is logged
@@ -1083,7 +1234,7 @@ type GenerateDataKeyInput struct {
 	EncryptionContext *map[string]*string `type:"map"`
 
 	// For more information, see Grant Tokens (http://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#grant_token).
-	GrantTokens []*string `type:"list"`
+	GrantTokens []*string `type:"list" max:"10"`
 
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier, a fully specified ARN to either an alias or a key, or
@@ -1091,16 +1242,16 @@ type GenerateDataKeyInput struct {
 	// Alias ARN Example - arn:aws:kms:us-east-1:123456789012:/alias/MyAliasName
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012 Alias Name
 	// Example - alias/MyAliasName
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// Value that identifies the encryption algorithm and key size to generate a
 	// data key for. Currently this can be AES_128 or AES_256.
-	KeySpec *string `type:"string"`
+	KeySpec *string `type:"string" enum:"AES_256,AES_128"`
 
 	// Integer that contains the number of bytes to generate. Common values are
 	// 128, 256, 512, and 1024. 1024 is the current limit. We recommend that you
 	// use the KeySpec parameter instead.
-	NumberOfBytes *int64 `type:"integer"`
+	NumberOfBytes *int64 `type:"integer" min:"1" max:"1024"`
 
 	metadataGenerateDataKeyInput `json:"-", xml:"-"`
 }
@@ -1109,6 +1260,11 @@ type metadataGenerateDataKeyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GenerateDataKeyInput.
+func (s GenerateDataKeyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GenerateDataKeyOutput struct {
 	// Ciphertext that contains the encrypted data key. You must store the blob
 	// and enough information to reconstruct the encryption context so that the
@@ -1118,15 +1274,15 @@ type GenerateDataKeyOutput struct {
 	//
 	// If you are using the CLI, the value is Base64 encoded. Otherwise, it is
 	// not encoded.
-	CiphertextBlob []byte `type:"blob"`
+	CiphertextBlob []byte `type:"blob" min:"1" max:"6144"`
 
 	// System generated unique identifier of the key to be used to decrypt the encrypted
 	// copy of the data key.
-	KeyID *string `locationName:"KeyId" type:"string"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256"`
 
 	// Plaintext that contains the data key. Use this for encryption and decryption
 	// and then remove it from memory as soon as possible.
-	Plaintext []byte `type:"blob"`
+	Plaintext []byte `type:"blob" min:"1" max:"4096" sensitive:"true"`
 
 	metadataGenerateDataKeyOutput `json:"-", xml:"-"`
 }
@@ -1135,13 +1291,18 @@ type metadataGenerateDataKeyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GenerateDataKeyOutput.
+func (s GenerateDataKeyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GenerateDataKeyWithoutPlaintextInput struct {
 	// Name:value pair that contains additional data to be authenticated during
 	// the encryption and decryption processes.
 	EncryptionContext *map[string]*string `type:"map"`
 
 	// For more information, see Grant Tokens (http://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#grant_token).
-	GrantTokens []*string `type:"list"`
+	GrantTokens []*string `type:"list" max:"10"`
 
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier, a fully specified ARN to either an alias or a key, or
@@ -1149,16 +1310,16 @@ type GenerateDataKeyWithoutPlaintextInput struct {
 	// Alias ARN Example - arn:aws:kms:us-east-1:123456789012:/alias/MyAliasName
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012 Alias Name
 	// Example - alias/MyAliasName
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// Value that identifies the encryption algorithm and key size. Currently this
 	// can be AES_128 or AES_256.
-	KeySpec *string `type:"string"`
+	KeySpec *string `type:"string" enum:"AES_256,AES_128"`
 
 	// Integer that contains the number of bytes to generate. Common values are
 	// 128, 256, 512, 1024 and so on. We recommend that you use the KeySpec parameter
 	// instead.
-	NumberOfBytes *int64 `type:"integer"`
+	NumberOfBytes *int64 `type:"integer" min:"1" max:"1024"`
 
 	metadataGenerateDataKeyWithoutPlaintextInput `json:"-", xml:"-"`
 }
@@ -1167,17 +1328,22 @@ type metadataGenerateDataKeyWithoutPlaintextInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GenerateDataKeyWithoutPlaintextInput.
+func (s GenerateDataKeyWithoutPlaintextInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GenerateDataKeyWithoutPlaintextOutput struct {
 	// Ciphertext that contains the wrapped data key. You must store the blob and
 	// encryption context so that the key can be used in a future decrypt operation.
 	//
 	// If you are using the CLI, the value is Base64 encoded. Otherwise, it is
 	// not encoded.
-	CiphertextBlob []byte `type:"blob"`
+	CiphertextBlob []byte `type:"blob" min:"1" max:"6144"`
 
 	// System generated unique identifier of the key to be used to decrypt the encrypted
 	// copy of the data key.
-	KeyID *string `locationName:"KeyId" type:"string"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256"`
 
 	metadataGenerateDataKeyWithoutPlaintextOutput `json:"-", xml:"-"`
 }
@@ -1186,10 +1352,15 @@ type metadataGenerateDataKeyWithoutPlaintextOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GenerateDataKeyWithoutPlaintextOutput.
+func (s GenerateDataKeyWithoutPlaintextOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GenerateRandomInput struct {
 	// Integer that contains the number of bytes to generate. Common values are
 	// 128, 256, 512, 1024 and so on. The current limit is 1024 bytes.
-	NumberOfBytes *int64 `type:"integer"`
+	NumberOfBytes *int64 `type:"integer" min:"1" max:"1024"`
 
 	metadataGenerateRandomInput `json:"-", xml:"-"`
 }
@@ -1198,9 +1369,14 @@ type metadataGenerateRandomInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GenerateRandomInput.
+func (s GenerateRandomInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GenerateRandomOutput struct {
 	// Plaintext that contains the unpredictable byte string.
-	Plaintext []byte `type:"blob"`
+	Plaintext []byte `type:"blob" min:"1" max:"4096" sensitive:"true"`
 
 	metadataGenerateRandomOutput `json:"-", xml:"-"`
 }
@@ -1209,16 +1385,21 @@ type metadataGenerateRandomOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GenerateRandomOutput.
+func (s GenerateRandomOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetKeyPolicyInput struct {
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// String that contains the name of the policy. Currently, this must be "default".
 	// Policy names can be discovered by calling ListKeyPolicies.
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w]+" required:"true"`
 
 	metadataGetKeyPolicyInput `json:"-", xml:"-"`
 }
@@ -1227,9 +1408,14 @@ type metadataGetKeyPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetKeyPolicyInput.
+func (s GetKeyPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetKeyPolicyOutput struct {
 	// A policy document in JSON format.
-	Policy *string `type:"string"`
+	Policy *string `type:"string" min:"1" max:"131072" pattern:"[\\u0009\\u000A\\u000D\\u0020-\\u00FF]+"`
 
 	metadataGetKeyPolicyOutput `json:"-", xml:"-"`
 }
@@ -1238,12 +1424,17 @@ type metadataGetKeyPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetKeyPolicyOutput.
+func (s GetKeyPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetKeyRotationStatusInput struct {
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataGetKeyRotationStatusInput `json:"-", xml:"-"`
 }
@@ -1252,6 +1443,11 @@ type metadataGetKeyRotationStatusInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetKeyRotationStatusInput.
+func (s GetKeyRotationStatusInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetKeyRotationStatusOutput struct {
 	// A Boolean value that specifies whether key rotation is enabled.
 	KeyRotationEnabled *bool `type:"boolean"`
@@ -1263,6 +1459,11 @@ type metadataGetKeyRotationStatusOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetKeyRotationStatusOutput.
+func (s GetKeyRotationStatusOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains constraints on the grant.
 type GrantConstraints struct {
 	// The constraint contains additional key/value pairs that serve to further
@@ -1279,6 +1480,11 @@ type metadataGrantConstraints struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GrantConstraints.
+func (s GrantConstraints) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains information about each entry in the grant list.
 type GrantListEntry struct {
 	// Specifies the conditions under which the actions specified by the Operations
@@ -1286,13 +1492,13 @@ type GrantListEntry struct {
 	Constraints *GrantConstraints `type:"structure"`
 
 	// Unique grant identifier.
-	GrantID *string `locationName:"GrantId" type:"string"`
+	GrantID *string `locationName:"GrantId" type:"string" min:"1" max:"128"`
 
 	// The principal that receives the grant permission.
-	GranteePrincipal *string `type:"string"`
+	GranteePrincipal *string `type:"string" min:"1" max:"256"`
 
 	// The account under which the grant was issued.
-	IssuingAccount *string `type:"string"`
+	IssuingAccount *string `type:"string" min:"1" max:"256"`
 
 	// List of operations permitted by the grant. This can be any combination of
 	// one or more of the following values:  Decrypt Encrypt GenerateDataKey GenerateDataKeyWithoutPlaintext
@@ -1300,7 +1506,7 @@ type GrantListEntry struct {
 	Operations []*string `type:"list"`
 
 	// The principal that can retire the account.
-	RetiringPrincipal *string `type:"string"`
+	RetiringPrincipal *string `type:"string" min:"1" max:"256"`
 
 	metadataGrantListEntry `json:"-", xml:"-"`
 }
@@ -1309,13 +1515,64 @@ type metadataGrantListEntry struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GrantListEntry.
+func (s GrantListEntry) String() string {
+	return awsutil.StringValue(s)
+}
+
+// GrantOperation is an enum of the values of GrantOperation members.
+type GrantOperation string
+
+// The values of GrantOperation.
+const (
+	GrantOperationDecrypt                         GrantOperation = "Decrypt"
+	GrantOperationEncrypt                         GrantOperation = "Encrypt"
+	GrantOperationGenerateDataKey                 GrantOperation = "GenerateDataKey"
+	GrantOperationGenerateDataKeyWithoutPlaintext GrantOperation = "GenerateDataKeyWithoutPlaintext"
+	GrantOperationReEncryptFrom                   GrantOperation = "ReEncryptFrom"
+	GrantOperationReEncryptTo                     GrantOperation = "ReEncryptTo"
+	GrantOperationCreateGrant                     GrantOperation = "CreateGrant"
+	GrantOperationRetireGrant                     GrantOperation = "RetireGrant"
+)
+
+// GrantOperationValues returns the values of GrantOperation known to this
+// version of the SDK. The service may accept values added since.
+func GrantOperationValues() []GrantOperation {
+	return []GrantOperation{
+		GrantOperation("Decrypt"),
+		GrantOperation("Encrypt"),
+		GrantOperation("GenerateDataKey"),
+		GrantOperation("GenerateDataKeyWithoutPlaintext"),
+		GrantOperation("ReEncryptFrom"),
+		GrantOperation("ReEncryptTo"),
+		GrantOperation("CreateGrant"),
+		GrantOperation("RetireGrant"),
+	}
+}
+
+// String returns the GrantOperation as the string of a member.
+func (v GrantOperation) String() string {
+	return string(v)
+}
+
+// ParseGrantOperation returns the GrantOperation of str, failing if it is not
+// one of the GrantOperationValues.
+func ParseGrantOperation(str string) (GrantOperation, error) {
+	for _, v := range GrantOperationValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid GrantOperation value %q", str)
+}
+
 // Contains information about each entry in the key list.
 type KeyListEntry struct {
 	// ARN of the key.
-	KeyARN *string `locationName:"KeyArn" type:"string"`
+	KeyARN *string `locationName:"KeyArn" type:"string" min:"20" max:"2048"`
 
 	// Unique identifier of the key.
-	KeyID *string `locationName:"KeyId" type:"string"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256"`
 
 	metadataKeyListEntry `json:"-", xml:"-"`
 }
@@ -1324,10 +1581,15 @@ type metadataKeyListEntry struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the KeyListEntry.
+func (s KeyListEntry) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains metadata associated with a specific key.
 type KeyMetadata struct {
 	// Key ARN (Amazon Resource Name).
-	ARN *string `locationName:"Arn" type:"string"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048"`
 
 	// Account ID number.
 	AWSAccountID *string `locationName:"AWSAccountId" type:"string"`
@@ -1336,16 +1598,16 @@ type KeyMetadata struct {
 	CreationDate *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// The description of the key.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"8192"`
 
 	// Value that specifies whether the key is enabled.
 	Enabled *bool `type:"boolean"`
 
 	// Unique identifier for the key.
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// A value that specifies what operation(s) the key can perform.
-	KeyUsage *string `type:"string"`
+	KeyUsage *string `type:"string" enum:"ENCRYPT_DECRYPT"`
 
 	metadataKeyMetadata `json:"-", xml:"-"`
 }
@@ -1354,16 +1616,53 @@ type metadataKeyMetadata struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the KeyMetadata.
+func (s KeyMetadata) String() string {
+	return awsutil.StringValue(s)
+}
+
+// KeyUsageType is an enum of the values of KeyUsageType members.
+type KeyUsageType string
+
+// The values of KeyUsageType.
+const (
+	KeyUsageTypeENCRYPTDECRYPT KeyUsageType = "ENCRYPT_DECRYPT"
+)
+
+// KeyUsageTypeValues returns the values of KeyUsageType known to this
+// version of the SDK. The service may accept values added since.
+func KeyUsageTypeValues() []KeyUsageType {
+	return []KeyUsageType{
+		KeyUsageType("ENCRYPT_DECRYPT"),
+	}
+}
+
+// String returns the KeyUsageType as the string of a member.
+func (v KeyUsageType) String() string {
+	return string(v)
+}
+
+// ParseKeyUsageType returns the KeyUsageType of str, failing if it is not
+// one of the KeyUsageTypeValues.
+func ParseKeyUsageType(str string) (KeyUsageType, error) {
+	for _, v := range KeyUsageTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid KeyUsageType value %q", str)
+}
+
 type ListAliasesInput struct {
 	// Specify this parameter when paginating results to indicate the maximum number
 	// of aliases you want in each response. If there are additional aliases beyond
 	// the maximum you specify, the Truncated response element will be set to true.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"1000"`
 
 	// Use this parameter when paginating results, and only in a subsequent request
 	// after you've received a response where the results are truncated. Set it
 	// to the value of the NextMarker element in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	metadataListAliasesInput `json:"-", xml:"-"`
 }
@@ -1372,13 +1671,18 @@ type metadataListAliasesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListAliasesInput.
+func (s ListAliasesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListAliasesOutput struct {
 	// A list of key aliases in the user's account.
 	Aliases []*AliasListEntry `type:"list"`
 
 	// If Truncated is true, this value is present and contains the value to use
 	// for the Marker request parameter in a subsequent pagination request.
-	NextMarker *string `type:"string"`
+	NextMarker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// A flag that indicates whether there are more items in the list. If your results
 	// were truncated, you can make a subsequent pagination request using the Marker
@@ -1392,23 +1696,28 @@ type metadataListAliasesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListAliasesOutput.
+func (s ListAliasesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListGrantsInput struct {
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// Specify this parameter only when paginating results to indicate the maximum
 	// number of grants you want listed in the response. If there are additional
 	// grants beyond the maximum you specify, the Truncated response element will
 	// be set to true.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"1000"`
 
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the NextMarker in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	metadataListGrantsInput `json:"-", xml:"-"`
 }
@@ -1417,13 +1726,18 @@ type metadataListGrantsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListGrantsInput.
+func (s ListGrantsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListGrantsOutput struct {
 	// A list of grants.
 	Grants []*GrantListEntry `type:"list"`
 
 	// If Truncated is true, this value is present and contains the value to use
 	// for the Marker request parameter in a subsequent pagination request.
-	NextMarker *string `type:"string"`
+	NextMarker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// A flag that indicates whether there are more items in the list. If your results
 	// were truncated, you can make a subsequent pagination request using the Marker
@@ -1437,6 +1751,11 @@ type metadataListGrantsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListGrantsOutput.
+func (s ListGrantsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListKeyPoliciesInput struct {
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier, a fully specified ARN to either an alias or a key, or
@@ -1444,18 +1763,18 @@ type ListKeyPoliciesInput struct {
 	// Alias ARN Example - arn:aws:kms:us-east-1:123456789012:/alias/MyAliasName
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012 Alias Name
 	// Example - alias/MyAliasName
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// Specify this parameter only when paginating results to indicate the maximum
 	// number of policies you want listed in the response. If there are additional
 	// policies beyond the maximum you specify, the Truncated response element will
 	// be set to true.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"1000"`
 
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the NextMarker in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	metadataListKeyPoliciesInput `json:"-", xml:"-"`
 }
@@ -1464,10 +1783,15 @@ type metadataListKeyPoliciesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListKeyPoliciesInput.
+func (s ListKeyPoliciesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListKeyPoliciesOutput struct {
 	// If Truncated is true, this value is present and contains the value to use
 	// for the Marker request parameter in a subsequent pagination request.
-	NextMarker *string `type:"string"`
+	NextMarker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// A list of policy names. Currently, there is only one policy and it is named
 	// "Default".
@@ -1485,17 +1809,22 @@ type metadataListKeyPoliciesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListKeyPoliciesOutput.
+func (s ListKeyPoliciesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListKeysInput struct {
 	// Specify this parameter only when paginating results to indicate the maximum
 	// number of keys you want listed in the response. If there are additional keys
 	// beyond the maximum you specify, the Truncated response element will be set
 	// to true.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1" max:"1000"`
 
 	// Use this parameter only when paginating results, and only in a subsequent
 	// request after you've received a response where the results are truncated.
 	// Set it to the value of the NextMarker in the response you just received.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	metadataListKeysInput `json:"-", xml:"-"`
 }
@@ -1504,13 +1833,18 @@ type metadataListKeysInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListKeysInput.
+func (s ListKeysInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListKeysOutput struct {
 	// A list of keys.
 	Keys []*KeyListEntry `type:"list"`
 
 	// If Truncated is true, this value is present and contains the value to use
 	// for the Marker request parameter in a subsequent pagination request.
-	NextMarker *string `type:"string"`
+	NextMarker *string `type:"string" min:"1" max:"320" pattern:"[\\u0020-\\u00FF]*"`
 
 	// A flag that indicates whether there are more items in the list. If your results
 	// were truncated, you can make a subsequent pagination request using the Marker
@@ -1524,19 +1858,24 @@ type metadataListKeysOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListKeysOutput.
+func (s ListKeysOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutKeyPolicyInput struct {
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The policy, in JSON format, to be attached to the key.
-	Policy *string `type:"string" required:"true"`
+	Policy *string `type:"string" min:"1" max:"131072" pattern:"[\\u0009\\u000A\\u000D\\u0020-\\u00FF]+" required:"true"`
 
 	// Name of the policy to be attached. Currently, the only supported name is
 	// "default".
-	PolicyName *string `type:"string" required:"true"`
+	PolicyName *string `type:"string" min:"1" max:"128" pattern:"[\\w]+" required:"true"`
 
 	metadataPutKeyPolicyInput `json:"-", xml:"-"`
 }
@@ -1545,6 +1884,11 @@ type metadataPutKeyPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutKeyPolicyInput.
+func (s PutKeyPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutKeyPolicyOutput struct {
 	metadataPutKeyPolicyOutput `json:"-", xml:"-"`
 }
@@ -1553,9 +1897,14 @@ type metadataPutKeyPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutKeyPolicyOutput.
+func (s PutKeyPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ReEncryptInput struct {
 	// Ciphertext of the data to re-encrypt.
-	CiphertextBlob []byte `type:"blob" required:"true"`
+	CiphertextBlob []byte `type:"blob" min:"1" max:"6144" required:"true"`
 
 	// Encryption context to be used when the data is re-encrypted.
 	DestinationEncryptionContext *map[string]*string `type:"map"`
@@ -1567,10 +1916,10 @@ type ReEncryptInput struct {
 	// Alias ARN Example - arn:aws:kms:us-east-1:123456789012:/alias/MyAliasName
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012 Alias Name
 	// Example - alias/MyAliasName
-	DestinationKeyID *string `locationName:"DestinationKeyId" type:"string" required:"true"`
+	DestinationKeyID *string `locationName:"DestinationKeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	// For more information, see Grant Tokens (http://docs.aws.amazon.com/kms/latest/developerguide/concepts.html#grant_token).
-	GrantTokens []*string `type:"list"`
+	GrantTokens []*string `type:"list" max:"10"`
 
 	// Encryption context used to encrypt and decrypt the data specified in the
 	// CiphertextBlob parameter.
@@ -1583,16 +1932,21 @@ type metadataReEncryptInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReEncryptInput.
+func (s ReEncryptInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ReEncryptOutput struct {
 	// The re-encrypted data. If you are using the CLI, the value is Base64 encoded.
 	// Otherwise, it is not encoded.
-	CiphertextBlob []byte `type:"blob"`
+	CiphertextBlob []byte `type:"blob" min:"1" max:"6144"`
 
 	// Unique identifier of the key used to re-encrypt the data.
-	KeyID *string `locationName:"KeyId" type:"string"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256"`
 
 	// Unique identifier of the key used to originally encrypt the data.
-	SourceKeyID *string `locationName:"SourceKeyId" type:"string"`
+	SourceKeyID *string `locationName:"SourceKeyId" type:"string" min:"1" max:"256"`
 
 	metadataReEncryptOutput `json:"-", xml:"-"`
 }
@@ -1601,9 +1955,14 @@ type metadataReEncryptOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReEncryptOutput.
+func (s ReEncryptOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RetireGrantInput struct {
 	// Token that identifies the grant to be retired.
-	GrantToken *string `type:"string" required:"true"`
+	GrantToken *string `type:"string" min:"1" max:"8192" required:"true"`
 
 	metadataRetireGrantInput `json:"-", xml:"-"`
 }
@@ -1612,6 +1971,11 @@ type metadataRetireGrantInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RetireGrantInput.
+func (s RetireGrantInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RetireGrantOutput struct {
 	metadataRetireGrantOutput `json:"-", xml:"-"`
 }
@@ -1620,15 +1984,20 @@ type metadataRetireGrantOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RetireGrantOutput.
+func (s RetireGrantOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RevokeGrantInput struct {
 	// Identifier of the grant to be revoked.
-	GrantID *string `locationName:"GrantId" type:"string" required:"true"`
+	GrantID *string `locationName:"GrantId" type:"string" min:"1" max:"128" required:"true"`
 
 	// A unique identifier for the customer master key associated with the grant.
 	// This value can be a globally unique identifier or the fully specified ARN
 	// to a key.  Key ARN Example - arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataRevokeGrantInput `json:"-", xml:"-"`
 }
@@ -1637,6 +2006,11 @@ type metadataRevokeGrantInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RevokeGrantInput.
+func (s RevokeGrantInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RevokeGrantOutput struct {
 	metadataRevokeGrantOutput `json:"-", xml:"-"`
 }
@@ -1645,15 +2019,20 @@ type metadataRevokeGrantOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RevokeGrantOutput.
+func (s RevokeGrantOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateKeyDescriptionInput struct {
 	// New description for the key.
-	Description *string `type:"string" required:"true"`
+	Description *string `type:"string" max:"8192" required:"true"`
 
 	// A unique identifier for the customer master key. This value can be a globally
 	// unique identifier or the fully specified ARN to a key.  Key ARN Example -
 	// arn:aws:kms:us-east-1:123456789012:key/12345678-1234-1234-1234-123456789012
 	// Globally Unique Key ID Example - 12345678-1234-1234-123456789012
-	KeyID *string `locationName:"KeyId" type:"string" required:"true"`
+	KeyID *string `locationName:"KeyId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataUpdateKeyDescriptionInput `json:"-", xml:"-"`
 }
@@ -1662,10 +2041,20 @@ type metadataUpdateKeyDescriptionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateKeyDescriptionInput.
+func (s UpdateKeyDescriptionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateKeyDescriptionOutput struct {
 	metadataUpdateKeyDescriptionOutput `json:"-", xml:"-"`
 }
 
 type metadataUpdateKeyDescriptionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the UpdateKeyDescriptionOutput.
+func (s UpdateKeyDescriptionOutput) String() string {
+	return awsutil.StringValue(s)
 }
\ No newline at end of file
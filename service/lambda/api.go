@@ -4,7 +4,10 @@
 package lambda
 
 import (
+	"fmt"
+
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // AddEventSourceRequest generates a request for the AddEventSource operation.
@@ -369,7 +372,7 @@ type AddEventSourceInput struct {
 	EventSource *string `type:"string" required:"true"`
 
 	// The Lambda function to invoke when AWS Lambda detects an event on the stream.
-	FunctionName *string `type:"string" required:"true"`
+	FunctionName *string `type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9-_]+" required:"true"`
 
 	// A map (key-value pairs) defining the configuration for AWS Lambda to use
 	// when reading the event source. Currently, AWS Lambda supports only the InitialPositionInStream
@@ -380,7 +383,7 @@ type AddEventSourceInput struct {
 
 	// The ARN of the IAM role (invocation role) that AWS Lambda can assume to read
 	// from the stream and invoke the function.
-	Role *string `type:"string" required:"true"`
+	Role *string `type:"string" pattern:"arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+" required:"true"`
 
 	metadataAddEventSourceInput `json:"-", xml:"-"`
 }
@@ -389,9 +392,14 @@ type metadataAddEventSourceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddEventSourceInput.
+func (s AddEventSourceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteFunctionInput struct {
 	// The Lambda function to delete.
-	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" required:"true"`
+	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9-_]+" required:"true"`
 
 	metadataDeleteFunctionInput `json:"-", xml:"-"`
 }
@@ -400,6 +408,11 @@ type metadataDeleteFunctionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteFunctionInput.
+func (s DeleteFunctionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteFunctionOutput struct {
 	metadataDeleteFunctionOutput `json:"-", xml:"-"`
 }
@@ -408,6 +421,11 @@ type metadataDeleteFunctionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteFunctionOutput.
+func (s DeleteFunctionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes mapping between an Amazon Kinesis stream and a Lambda function.
 type EventSourceConfiguration struct {
 	// The largest number of records that AWS Lambda will POST in the invocation
@@ -419,7 +437,7 @@ type EventSourceConfiguration struct {
 	EventSource *string `type:"string"`
 
 	// The Lambda function to invoke when AWS Lambda detects an event on the stream.
-	FunctionName *string `type:"string"`
+	FunctionName *string `type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9-_]+"`
 
 	// Indicates whether the event source mapping is currently honored. Events are
 	// only processes if IsActive is true.
@@ -434,7 +452,7 @@ type EventSourceConfiguration struct {
 
 	// The ARN of the IAM role (invocation role) that AWS Lambda can assume to read
 	// from the stream and invoke the function.
-	Role *string `type:"string"`
+	Role *string `type:"string" pattern:"arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+"`
 
 	// The description of the health of the event source mapping. Valid values are:
 	// "PENDING", "OK", and "PROBLEM:message". Initially this staus is "PENDING".
@@ -451,6 +469,11 @@ type metadataEventSourceConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EventSourceConfiguration.
+func (s EventSourceConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The object for the Lambda function location.
 type FunctionCodeLocation struct {
 	// The presigned URL you can use to download the function's .zip file that you
@@ -467,6 +490,11 @@ type metadataFunctionCodeLocation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the FunctionCodeLocation.
+func (s FunctionCodeLocation) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that describes function metadata.
 type FunctionConfiguration struct {
 	// The size, in bytes, of the function .zip file you uploaded.
@@ -477,38 +505,38 @@ type FunctionConfiguration struct {
 	ConfigurationID *string `locationName:"ConfigurationId" type:"string"`
 
 	// The user-provided description.
-	Description *string `type:"string"`
+	Description *string `type:"string" max:"256"`
 
 	// The Amazon Resource Name (ARN) assigned to the function.
-	FunctionARN *string `type:"string"`
+	FunctionARN *string `type:"string" pattern:"arn:aws:lambda:[a-z]{2}-[a-z]+-\\d{1}:\\d{12}:function:[a-zA-Z0-9-_]+(\\/[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})?"`
 
 	// The name of the function.
-	FunctionName *string `type:"string"`
+	FunctionName *string `type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9-_]+"`
 
 	// The function Lambda calls to begin executing your function.
-	Handler *string `type:"string"`
+	Handler *string `type:"string" pattern:"[a-zA-Z0-9./\\-_]+"`
 
 	// The timestamp of the last time you updated the function.
 	LastModified *string `type:"string"`
 
 	// The memory size, in MB, you configured for the function. Must be a multiple
 	// of 64 MB.
-	MemorySize *int64 `type:"integer"`
+	MemorySize *int64 `type:"integer" min:"128" max:"1024"`
 
 	// The type of the Lambda function you uploaded.
-	Mode *string `type:"string"`
+	Mode *string `type:"string" enum:"event"`
 
 	// The Amazon Resource Name (ARN) of the IAM role that Lambda assumes when it
 	// executes your function to access any other Amazon Web Services (AWS) resources.
-	Role *string `type:"string"`
+	Role *string `type:"string" pattern:"arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+"`
 
 	// The runtime environment for the Lambda function.
-	Runtime *string `type:"string"`
+	Runtime *string `type:"string" enum:"nodejs"`
 
 	// The function execution time at which Lambda should terminate the function.
 	// Because the execution time has cost implications, we recommend you set this
 	// value based on your expected execution time. The default is 3 seconds.
-	Timeout *int64 `type:"integer"`
+	Timeout *int64 `type:"integer" min:"1" max:"60"`
 
 	metadataFunctionConfiguration `json:"-", xml:"-"`
 }
@@ -517,6 +545,11 @@ type metadataFunctionConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the FunctionConfiguration.
+func (s FunctionConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetEventSourceInput struct {
 	// The AWS Lambda assigned ID of the event source mapping.
 	UUID *string `location:"uri" locationName:"UUID" type:"string" required:"true"`
@@ -528,10 +561,15 @@ type metadataGetEventSourceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetEventSourceInput.
+func (s GetEventSourceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetFunctionConfigurationInput struct {
 	// The name of the Lambda function for which you want to retrieve the configuration
 	// information.
-	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" required:"true"`
+	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9-_]+" required:"true"`
 
 	metadataGetFunctionConfigurationInput `json:"-", xml:"-"`
 }
@@ -540,9 +578,14 @@ type metadataGetFunctionConfigurationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetFunctionConfigurationInput.
+func (s GetFunctionConfigurationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetFunctionInput struct {
 	// The Lambda function name.
-	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" required:"true"`
+	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9-_]+" required:"true"`
 
 	metadataGetFunctionInput `json:"-", xml:"-"`
 }
@@ -551,6 +594,11 @@ type metadataGetFunctionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetFunctionInput.
+func (s GetFunctionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This response contains the object for AWS Lambda function location (see API_FunctionCodeLocation
 type GetFunctionOutput struct {
 	// The object for the Lambda function location.
@@ -566,9 +614,14 @@ type metadataGetFunctionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetFunctionOutput.
+func (s GetFunctionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type InvokeAsyncInput struct {
 	// The Lambda function name.
-	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" required:"true"`
+	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9-_]+" required:"true"`
 
 	// JSON that you want to provide to your Lambda function as input.
 	InvokeArgs []byte `type:"blob" required:"true"`
@@ -580,6 +633,11 @@ type metadataInvokeAsyncInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"InvokeArgs"`
 }
 
+// String returns the string representation of the InvokeAsyncInput.
+func (s InvokeAsyncInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Upon success, it returns empty response. Otherwise, throws an exception.
 type InvokeAsyncOutput struct {
 	// It will be 202 upon success.
@@ -592,12 +650,17 @@ type metadataInvokeAsyncOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InvokeAsyncOutput.
+func (s InvokeAsyncOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListEventSourcesInput struct {
 	// The Amazon Resource Name (ARN) of the Amazon Kinesis stream.
 	EventSourceARN *string `location:"querystring" locationName:"EventSource" type:"string"`
 
 	// The name of the AWS Lambda function.
-	FunctionName *string `location:"querystring" locationName:"FunctionName" type:"string"`
+	FunctionName *string `location:"querystring" locationName:"FunctionName" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9-_]+"`
 
 	// Optional string. An opaque pagination token returned from a previous ListEventSources
 	// operation. If present, specifies to continue the list from where the returning
@@ -606,7 +669,7 @@ type ListEventSourcesInput struct {
 
 	// Optional integer. Specifies the maximum number of event sources to return
 	// in response. This value must be greater than 0.
-	MaxItems *int64 `location:"querystring" locationName:"MaxItems" type:"integer"`
+	MaxItems *int64 `location:"querystring" locationName:"MaxItems" type:"integer" min:"1" max:"10000"`
 
 	metadataListEventSourcesInput `json:"-", xml:"-"`
 }
@@ -615,6 +678,11 @@ type metadataListEventSourcesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListEventSourcesInput.
+func (s ListEventSourcesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains a list of event sources (see API_EventSourceConfiguration)
 type ListEventSourcesOutput struct {
 	// An arrary of EventSourceConfiguration objects.
@@ -630,6 +698,11 @@ type metadataListEventSourcesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListEventSourcesOutput.
+func (s ListEventSourcesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListFunctionsInput struct {
 	// Optional string. An opaque pagination token returned from a previous ListFunctions
 	// operation. If present, indicates where to continue the listing.
@@ -637,7 +710,7 @@ type ListFunctionsInput struct {
 
 	// Optional integer. Specifies the maximum number of AWS Lambda functions to
 	// return in response. This parameter value must be greater than 0.
-	MaxItems *int64 `location:"querystring" locationName:"MaxItems" type:"integer"`
+	MaxItems *int64 `location:"querystring" locationName:"MaxItems" type:"integer" min:"1" max:"10000"`
 
 	metadataListFunctionsInput `json:"-", xml:"-"`
 }
@@ -646,6 +719,11 @@ type metadataListFunctionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListFunctionsInput.
+func (s ListFunctionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains a list of AWS Lambda function configurations (see API_FunctionConfiguration.
 type ListFunctionsOutput struct {
 	// A list of Lambda functions.
@@ -661,6 +739,43 @@ type metadataListFunctionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListFunctionsOutput.
+func (s ListFunctionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// Mode is an enum of the values of Mode members.
+type Mode string
+
+// The values of Mode.
+const (
+	ModeEvent Mode = "event"
+)
+
+// ModeValues returns the values of Mode known to this
+// version of the SDK. The service may accept values added since.
+func ModeValues() []Mode {
+	return []Mode{
+		Mode("event"),
+	}
+}
+
+// String returns the Mode as the string of a member.
+func (v Mode) String() string {
+	return string(v)
+}
+
+// ParseMode returns the Mode of str, failing if it is not
+// one of the ModeValues.
+func ParseMode(str string) (Mode, error) {
+	for _, v := range ModeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid Mode value %q", str)
+}
+
 type RemoveEventSourceInput struct {
 	// The event source mapping ID.
 	UUID *string `location:"uri" locationName:"UUID" type:"string" required:"true"`
@@ -672,6 +787,11 @@ type metadataRemoveEventSourceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RemoveEventSourceInput.
+func (s RemoveEventSourceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RemoveEventSourceOutput struct {
 	metadataRemoveEventSourceOutput `json:"-", xml:"-"`
 }
@@ -680,33 +800,70 @@ type metadataRemoveEventSourceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RemoveEventSourceOutput.
+func (s RemoveEventSourceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// Runtime is an enum of the values of Runtime members.
+type Runtime string
+
+// The values of Runtime.
+const (
+	RuntimeNodejs Runtime = "nodejs"
+)
+
+// RuntimeValues returns the values of Runtime known to this
+// version of the SDK. The service may accept values added since.
+func RuntimeValues() []Runtime {
+	return []Runtime{
+		Runtime("nodejs"),
+	}
+}
+
+// String returns the Runtime as the string of a member.
+func (v Runtime) String() string {
+	return string(v)
+}
+
+// ParseRuntime returns the Runtime of str, failing if it is not
+// one of the RuntimeValues.
+func ParseRuntime(str string) (Runtime, error) {
+	for _, v := range RuntimeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid Runtime value %q", str)
+}
+
 type UpdateFunctionConfigurationInput struct {
 	// A short user-defined function description. Lambda does not use this value.
 	// Assign a meaningful description as you see fit.
-	Description *string `location:"querystring" locationName:"Description" type:"string"`
+	Description *string `location:"querystring" locationName:"Description" type:"string" max:"256"`
 
 	// The name of the Lambda function.
-	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" required:"true"`
+	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9-_]+" required:"true"`
 
 	// The function that Lambda calls to begin executing your function. For Node.js,
 	// it is the module-name.export value in your function.
-	Handler *string `location:"querystring" locationName:"Handler" type:"string"`
+	Handler *string `location:"querystring" locationName:"Handler" type:"string" pattern:"[a-zA-Z0-9./\\-_]+"`
 
 	// The amount of memory, in MB, your Lambda function is given. Lambda uses this
 	// memory size to infer the amount of CPU allocated to your function. Your function
 	// use-case determines your CPU and memory requirements. For example, a database
 	// operation might need less memory compared to an image processing function.
 	// The default value is 128 MB. The value must be a multiple of 64 MB.
-	MemorySize *int64 `location:"querystring" locationName:"MemorySize" type:"integer"`
+	MemorySize *int64 `location:"querystring" locationName:"MemorySize" type:"integer" min:"128" max:"1024"`
 
 	// The Amazon Resource Name (ARN) of the IAM role that Lambda will assume when
 	// it executes your function.
-	Role *string `location:"querystring" locationName:"Role" type:"string"`
+	Role *string `location:"querystring" locationName:"Role" type:"string" pattern:"arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+"`
 
 	// The function execution time at which Lambda should terminate the function.
 	// Because the execution time has cost implications, we recommend you set this
 	// value based on your expected execution time. The default is 3 seconds.
-	Timeout *int64 `location:"querystring" locationName:"Timeout" type:"integer"`
+	Timeout *int64 `location:"querystring" locationName:"Timeout" type:"integer" min:"1" max:"60"`
 
 	metadataUpdateFunctionConfigurationInput `json:"-", xml:"-"`
 }
@@ -715,15 +872,20 @@ type metadataUpdateFunctionConfigurationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateFunctionConfigurationInput.
+func (s UpdateFunctionConfigurationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UploadFunctionInput struct {
 	// A short, user-defined function description. Lambda does not use this value.
 	// Assign a meaningful description as you see fit.
-	Description *string `location:"querystring" locationName:"Description" type:"string"`
+	Description *string `location:"querystring" locationName:"Description" type:"string" max:"256"`
 
 	// The name you want to assign to the function you are uploading. The function
 	// names appear in the console and are returned in the ListFunctions API. Function
 	// names are used to specify functions to other AWS Lambda APIs, such as InvokeAsync.
-	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" required:"true"`
+	FunctionName *string `location:"uri" locationName:"FunctionName" type:"string" min:"1" max:"64" pattern:"[a-zA-Z0-9-_]+" required:"true"`
 
 	// A .zip file containing your packaged source code. For more information about
 	// creating a .zip file, go to AWS LambdaL How it Works (http://docs.aws.amazon.com/lambda/latest/dg/walkthrough-custom-events.html)
@@ -732,35 +894,40 @@ type UploadFunctionInput struct {
 
 	// The function that Lambda calls to begin execution. For Node.js, it is the
 	// module-name.export value in your function.
-	Handler *string `location:"querystring" locationName:"Handler" type:"string" required:"true"`
+	Handler *string `location:"querystring" locationName:"Handler" type:"string" pattern:"[a-zA-Z0-9./\\-_]+" required:"true"`
 
 	// The amount of memory, in MB, your Lambda function is given. Lambda uses this
 	// memory size to infer the amount of CPU allocated to your function. Your function
 	// use-case determines your CPU and memory requirements. For example, database
 	// operation might need less memory compared to image processing function. The
 	// default value is 128 MB. The value must be a multiple of 64 MB.
-	MemorySize *int64 `location:"querystring" locationName:"MemorySize" type:"integer"`
+	MemorySize *int64 `location:"querystring" locationName:"MemorySize" type:"integer" min:"128" max:"1024"`
 
 	// How the Lambda function will be invoked. Lambda supports only the "event"
 	// mode.
-	Mode *string `location:"querystring" locationName:"Mode" type:"string" required:"true"`
+	Mode *string `location:"querystring" locationName:"Mode" type:"string" enum:"event" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the IAM role that Lambda assumes when it
 	// executes your function to access any other Amazon Web Services (AWS) resources.
-	Role *string `location:"querystring" locationName:"Role" type:"string" required:"true"`
+	Role *string `location:"querystring" locationName:"Role" type:"string" pattern:"arn:aws:iam::\\d{12}:role/?[a-zA-Z_0-9+=,.@\\-_/]+" required:"true"`
 
 	// The runtime environment for the Lambda function you are uploading. Currently,
 	// Lambda supports only "nodejs" as the runtime.
-	Runtime *string `location:"querystring" locationName:"Runtime" type:"string" required:"true"`
+	Runtime *string `location:"querystring" locationName:"Runtime" type:"string" enum:"nodejs" required:"true"`
 
 	// The function execution time at which Lambda should terminate the function.
 	// Because the execution time has cost implications, we recommend you set this
 	// value based on your expected execution time. The default is 3 seconds.
-	Timeout *int64 `location:"querystring" locationName:"Timeout" type:"integer"`
+	Timeout *int64 `location:"querystring" locationName:"Timeout" type:"integer" min:"1" max:"60"`
 
 	metadataUploadFunctionInput `json:"-", xml:"-"`
 }
 
 type metadataUploadFunctionInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"FunctionZip"`
+}
+
+// String returns the string representation of the UploadFunctionInput.
+func (s UploadFunctionInput) String() string {
+	return awsutil.StringValue(s)
 }
\ No newline at end of file
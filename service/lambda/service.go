@@ -25,7 +25,7 @@ func New(config *aws.Config) *Lambda {
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restjson.Build)
 	service.Handlers.Unmarshal.PushBack(restjson.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restjson.UnmarshalMeta)
@@ -4,7 +4,10 @@
 package opsworks
 
 import (
+	"fmt"
+
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // AssignInstanceRequest generates a request for the AssignInstance operation.
@@ -2151,7 +2154,7 @@ type App struct {
 	StackID *string `locationName:"StackId" type:"string"`
 
 	// The app type.
-	Type *string `type:"string"`
+	Type *string `type:"string" enum:"java,rails,php,nodejs,static,other"`
 
 	metadataApp `json:"-", xml:"-"`
 }
@@ -2160,6 +2163,123 @@ type metadataApp struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the App.
+func (s App) String() string {
+	return awsutil.StringValue(s)
+}
+
+// AppAttributesKeys is an enum of the values of AppAttributesKeys members.
+type AppAttributesKeys string
+
+// The values of AppAttributesKeys.
+const (
+	AppAttributesKeysDocumentRoot       AppAttributesKeys = "DocumentRoot"
+	AppAttributesKeysRailsEnv           AppAttributesKeys = "RailsEnv"
+	AppAttributesKeysAutoBundleOnDeploy AppAttributesKeys = "AutoBundleOnDeploy"
+)
+
+// AppAttributesKeysValues returns the values of AppAttributesKeys known to this
+// version of the SDK. The service may accept values added since.
+func AppAttributesKeysValues() []AppAttributesKeys {
+	return []AppAttributesKeys{
+		AppAttributesKeys("DocumentRoot"),
+		AppAttributesKeys("RailsEnv"),
+		AppAttributesKeys("AutoBundleOnDeploy"),
+	}
+}
+
+// String returns the AppAttributesKeys as the string of a member.
+func (v AppAttributesKeys) String() string {
+	return string(v)
+}
+
+// ParseAppAttributesKeys returns the AppAttributesKeys of str, failing if it is not
+// one of the AppAttributesKeysValues.
+func ParseAppAttributesKeys(str string) (AppAttributesKeys, error) {
+	for _, v := range AppAttributesKeysValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid AppAttributesKeys value %q", str)
+}
+
+// AppType is an enum of the values of AppType members.
+type AppType string
+
+// The values of AppType.
+const (
+	AppTypeJava   AppType = "java"
+	AppTypeRails  AppType = "rails"
+	AppTypePhp    AppType = "php"
+	AppTypeNodejs AppType = "nodejs"
+	AppTypeStatic AppType = "static"
+	AppTypeOther  AppType = "other"
+)
+
+// AppTypeValues returns the values of AppType known to this
+// version of the SDK. The service may accept values added since.
+func AppTypeValues() []AppType {
+	return []AppType{
+		AppType("java"),
+		AppType("rails"),
+		AppType("php"),
+		AppType("nodejs"),
+		AppType("static"),
+		AppType("other"),
+	}
+}
+
+// String returns the AppType as the string of a member.
+func (v AppType) String() string {
+	return string(v)
+}
+
+// ParseAppType returns the AppType of str, failing if it is not
+// one of the AppTypeValues.
+func ParseAppType(str string) (AppType, error) {
+	for _, v := range AppTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid AppType value %q", str)
+}
+
+// Architecture is an enum of the values of Architecture members.
+type Architecture string
+
+// The values of Architecture.
+const (
+	ArchitectureX8664 Architecture = "x86_64"
+	ArchitectureI386  Architecture = "i386"
+)
+
+// ArchitectureValues returns the values of Architecture known to this
+// version of the SDK. The service may accept values added since.
+func ArchitectureValues() []Architecture {
+	return []Architecture{
+		Architecture("x86_64"),
+		Architecture("i386"),
+	}
+}
+
+// String returns the Architecture as the string of a member.
+func (v Architecture) String() string {
+	return string(v)
+}
+
+// ParseArchitecture returns the Architecture of str, failing if it is not
+// one of the ArchitectureValues.
+func ParseArchitecture(str string) (Architecture, error) {
+	for _, v := range ArchitectureValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid Architecture value %q", str)
+}
+
 type AssignInstanceInput struct {
 	// The instance ID.
 	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
@@ -2175,6 +2295,11 @@ type metadataAssignInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AssignInstanceInput.
+func (s AssignInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AssignInstanceOutput struct {
 	metadataAssignInstanceOutput `json:"-", xml:"-"`
 }
@@ -2183,6 +2308,11 @@ type metadataAssignInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AssignInstanceOutput.
+func (s AssignInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AssignVolumeInput struct {
 	// The instance ID.
 	InstanceID *string `locationName:"InstanceId" type:"string"`
@@ -2197,6 +2327,11 @@ type metadataAssignVolumeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AssignVolumeInput.
+func (s AssignVolumeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AssignVolumeOutput struct {
 	metadataAssignVolumeOutput `json:"-", xml:"-"`
 }
@@ -2205,6 +2340,11 @@ type metadataAssignVolumeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AssignVolumeOutput.
+func (s AssignVolumeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AssociateElasticIPInput struct {
 	// The Elastic IP address.
 	ElasticIP *string `locationName:"ElasticIp" type:"string" required:"true"`
@@ -2219,6 +2359,11 @@ type metadataAssociateElasticIPInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AssociateElasticIPInput.
+func (s AssociateElasticIPInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AssociateElasticIPOutput struct {
 	metadataAssociateElasticIPOutput `json:"-", xml:"-"`
 }
@@ -2227,6 +2372,11 @@ type metadataAssociateElasticIPOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AssociateElasticIPOutput.
+func (s AssociateElasticIPOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AttachElasticLoadBalancerInput struct {
 	// The Elastic Load Balancing instance's name.
 	ElasticLoadBalancerName *string `type:"string" required:"true"`
@@ -2242,6 +2392,11 @@ type metadataAttachElasticLoadBalancerInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AttachElasticLoadBalancerInput.
+func (s AttachElasticLoadBalancerInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AttachElasticLoadBalancerOutput struct {
 	metadataAttachElasticLoadBalancerOutput `json:"-", xml:"-"`
 }
@@ -2250,6 +2405,11 @@ type metadataAttachElasticLoadBalancerOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AttachElasticLoadBalancerOutput.
+func (s AttachElasticLoadBalancerOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a load-based auto scaling upscaling or downscaling threshold configuration,
 // which specifies when AWS OpsWorks starts or stops load-based instances.
 type AutoScalingThresholds struct {
@@ -2264,7 +2424,7 @@ type AutoScalingThresholds struct {
 	// which typically takes several minutes. IgnoreMetricsTime allows you to direct
 	// AWS OpsWorks to not raise any scaling events long enough to get the new instances
 	// online.
-	IgnoreMetricsTime *int64 `type:"integer"`
+	IgnoreMetricsTime *int64 `type:"integer" min:"1" max:"100"`
 
 	// The number of instances to add or remove when the load exceeds a threshold.
 	InstanceCount *int64 `type:"integer"`
@@ -2278,7 +2438,7 @@ type AutoScalingThresholds struct {
 
 	// The amount of time, in minutes, that the load must exceed a threshold before
 	// more instances are added or removed.
-	ThresholdsWaitTime *int64 `type:"integer"`
+	ThresholdsWaitTime *int64 `type:"integer" min:"1" max:"100"`
 
 	metadataAutoScalingThresholds `json:"-", xml:"-"`
 }
@@ -2287,6 +2447,45 @@ type metadataAutoScalingThresholds struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AutoScalingThresholds.
+func (s AutoScalingThresholds) String() string {
+	return awsutil.StringValue(s)
+}
+
+// AutoScalingType is an enum of the values of AutoScalingType members.
+type AutoScalingType string
+
+// The values of AutoScalingType.
+const (
+	AutoScalingTypeLoad  AutoScalingType = "load"
+	AutoScalingTypeTimer AutoScalingType = "timer"
+)
+
+// AutoScalingTypeValues returns the values of AutoScalingType known to this
+// version of the SDK. The service may accept values added since.
+func AutoScalingTypeValues() []AutoScalingType {
+	return []AutoScalingType{
+		AutoScalingType("load"),
+		AutoScalingType("timer"),
+	}
+}
+
+// String returns the AutoScalingType as the string of a member.
+func (v AutoScalingType) String() string {
+	return string(v)
+}
+
+// ParseAutoScalingType returns the AutoScalingType of str, failing if it is not
+// one of the AutoScalingTypeValues.
+func ParseAutoScalingType(str string) (AutoScalingType, error) {
+	for _, v := range AutoScalingTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid AutoScalingType value %q", str)
+}
+
 // Describes the Chef configuration.
 type ChefConfiguration struct {
 	// The Berkshelf version.
@@ -2302,6 +2501,11 @@ type metadataChefConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ChefConfiguration.
+func (s ChefConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CloneStackInput struct {
 	// A list of stack attributes and values as key/value pairs to be added to the
 	// cloned stack.
@@ -2360,7 +2564,7 @@ type CloneStackInput struct {
 	// The default root device type. This value is used by default for all instances
 	// in the cloned stack, but you can override it when you create an instance.
 	// For more information, see Storage for the Root Device (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ComponentsAMIs.html#storage-for-the-root-device).
-	DefaultRootDeviceType *string `type:"string"`
+	DefaultRootDeviceType *string `type:"string" enum:"ebs,instance-store"`
 
 	// A default SSH key for the stack instances. You can override this value when
 	// you create or update an instance.
@@ -2458,6 +2662,11 @@ type metadataCloneStackInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CloneStackInput.
+func (s CloneStackInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a CloneStack request.
 type CloneStackOutput struct {
 	// The cloned stack ID.
@@ -2470,6 +2679,11 @@ type metadataCloneStackOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CloneStackOutput.
+func (s CloneStackOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a command.
 type Command struct {
 	// Date and time when the command was acknowledged.
@@ -2514,6 +2728,11 @@ type metadataCommand struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Command.
+func (s Command) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateAppInput struct {
 	// A Source object that specifies the app repository.
 	AppSource *Source `type:"structure"`
@@ -2559,7 +2778,7 @@ type CreateAppInput struct {
 	// For example, PHP applications are associated with a PHP layer. AWS OpsWorks
 	// deploys an application to those instances that are members of the corresponding
 	// layer.
-	Type *string `type:"string" required:"true"`
+	Type *string `type:"string" enum:"java,rails,php,nodejs,static,other" required:"true"`
 
 	metadataCreateAppInput `json:"-", xml:"-"`
 }
@@ -2568,6 +2787,11 @@ type metadataCreateAppInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateAppInput.
+func (s CreateAppInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a CreateApp request.
 type CreateAppOutput struct {
 	// The app ID.
@@ -2580,6 +2804,11 @@ type metadataCreateAppOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateAppOutput.
+func (s CreateAppOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateDeploymentInput struct {
 	// The app ID. This parameter is required for app deployments, but not for other
 	// deployment commands.
@@ -2615,6 +2844,11 @@ type metadataCreateDeploymentInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateDeploymentInput.
+func (s CreateDeploymentInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a CreateDeployment request.
 type CreateDeploymentOutput struct {
 	// The deployment ID, which can be used with other requests to identify the
@@ -2628,6 +2862,11 @@ type metadataCreateDeploymentOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateDeploymentOutput.
+func (s CreateDeploymentOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateInstanceInput struct {
 	// A custom AMI ID to be used to create the instance. The AMI should be based
 	// on one of the standard AWS OpsWorks AMIs: Amazon Linux, Ubuntu 12.04 LTS,
@@ -2640,10 +2879,10 @@ type CreateInstanceInput struct {
 	// not necessarily support both architectures. For a list of the architectures
 	// that are supported by the different instance types, see Instance Families
 	// and Types (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/instance-types.html).
-	Architecture *string `type:"string"`
+	Architecture *string `type:"string" enum:"x86_64,i386"`
 
 	// For load-based or time-based instances, the type.
-	AutoScalingType *string `type:"string"`
+	AutoScalingType *string `type:"string" enum:"load,timer"`
 
 	// The instance Availability Zone. For more information, see Regions and Endpoints
 	// (http://docs.aws.amazon.com/general/latest/gr/rande.html).
@@ -2689,7 +2928,7 @@ type CreateInstanceInput struct {
 
 	// The instance root device type. For more information, see Storage for the
 	// Root Device (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ComponentsAMIs.html#storage-for-the-root-device).
-	RootDeviceType *string `type:"string"`
+	RootDeviceType *string `type:"string" enum:"ebs,instance-store"`
 
 	// The instance SSH key name.
 	SSHKeyName *string `locationName:"SshKeyName" type:"string"`
@@ -2712,6 +2951,11 @@ type metadataCreateInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateInstanceInput.
+func (s CreateInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a CreateInstance request.
 type CreateInstanceOutput struct {
 	// The instance ID.
@@ -2724,6 +2968,11 @@ type metadataCreateInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateInstanceOutput.
+func (s CreateInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateLayerInput struct {
 	// One or more user-defined key/value pairs to be added to the stack attributes.
 	Attributes *map[string]*string `type:"map"`
@@ -2782,7 +3031,7 @@ type CreateLayerInput struct {
 
 	// The layer type. A stack cannot have more than one built-in layer of the same
 	// type. It can have any number of custom layers.
-	Type *string `type:"string" required:"true"`
+	Type *string `type:"string" enum:"java-app,lb,web,php-app,rails-app,nodejs-app,memcached,db-master,monitoring-master,custom" required:"true"`
 
 	// Whether to use Amazon EBS-optimized instances.
 	UseEBSOptimizedInstances *bool `locationName:"UseEbsOptimizedInstances" type:"boolean"`
@@ -2797,6 +3046,11 @@ type metadataCreateLayerInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateLayerInput.
+func (s CreateLayerInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a CreateLayer request.
 type CreateLayerOutput struct {
 	// The layer ID.
@@ -2809,6 +3063,11 @@ type metadataCreateLayerOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateLayerOutput.
+func (s CreateLayerOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateStackInput struct {
 	// One or more user-defined key/value pairs to be added to the stack attributes.
 	Attributes *map[string]*string `type:"map"`
@@ -2861,7 +3120,7 @@ type CreateStackInput struct {
 	// in the stack, but you can override it when you create an instance. The default
 	// option is instance-store. For more information, see Storage for the Root
 	// Device (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ComponentsAMIs.html#storage-for-the-root-device).
-	DefaultRootDeviceType *string `type:"string"`
+	DefaultRootDeviceType *string `type:"string" enum:"ebs,instance-store"`
 
 	// A default SSH key for the stack instances. You can override this value when
 	// you create or update an instance.
@@ -2950,6 +3209,11 @@ type metadataCreateStackInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateStackInput.
+func (s CreateStackInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a CreateStack request.
 type CreateStackOutput struct {
 	// The stack ID, which is an opaque string that you use to identify the stack
@@ -2963,6 +3227,11 @@ type metadataCreateStackOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateStackOutput.
+func (s CreateStackOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateUserProfileInput struct {
 	// Whether users can specify their own SSH public key through the My Settings
 	// page. For more information, see Setting an IAM User's Public SSH Key (http://docs.aws.amazon.com/opsworks/latest/userguide/security-settingsshkey.html).
@@ -2988,6 +3257,11 @@ type metadataCreateUserProfileInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateUserProfileInput.
+func (s CreateUserProfileInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a CreateUserProfile request.
 type CreateUserProfileOutput struct {
 	// The user's IAM ARN.
@@ -3000,6 +3274,11 @@ type metadataCreateUserProfileOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateUserProfileOutput.
+func (s CreateUserProfileOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an app's data source.
 type DataSource struct {
 	// The data source's ARN.
@@ -3019,6 +3298,11 @@ type metadataDataSource struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DataSource.
+func (s DataSource) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteAppInput struct {
 	// The app ID.
 	AppID *string `locationName:"AppId" type:"string" required:"true"`
@@ -3030,6 +3314,11 @@ type metadataDeleteAppInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteAppInput.
+func (s DeleteAppInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteAppOutput struct {
 	metadataDeleteAppOutput `json:"-", xml:"-"`
 }
@@ -3038,6 +3327,11 @@ type metadataDeleteAppOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteAppOutput.
+func (s DeleteAppOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteInstanceInput struct {
 	// Whether to delete the instance Elastic IP address.
 	DeleteElasticIP *bool `locationName:"DeleteElasticIp" type:"boolean"`
@@ -3055,6 +3349,11 @@ type metadataDeleteInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteInstanceInput.
+func (s DeleteInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteInstanceOutput struct {
 	metadataDeleteInstanceOutput `json:"-", xml:"-"`
 }
@@ -3063,6 +3362,11 @@ type metadataDeleteInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteInstanceOutput.
+func (s DeleteInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteLayerInput struct {
 	// The layer ID.
 	LayerID *string `locationName:"LayerId" type:"string" required:"true"`
@@ -3074,6 +3378,11 @@ type metadataDeleteLayerInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteLayerInput.
+func (s DeleteLayerInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteLayerOutput struct {
 	metadataDeleteLayerOutput `json:"-", xml:"-"`
 }
@@ -3082,6 +3391,11 @@ type metadataDeleteLayerOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteLayerOutput.
+func (s DeleteLayerOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteStackInput struct {
 	// The stack ID.
 	StackID *string `locationName:"StackId" type:"string" required:"true"`
@@ -3093,6 +3407,11 @@ type metadataDeleteStackInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteStackInput.
+func (s DeleteStackInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteStackOutput struct {
 	metadataDeleteStackOutput `json:"-", xml:"-"`
 }
@@ -3101,6 +3420,11 @@ type metadataDeleteStackOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteStackOutput.
+func (s DeleteStackOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteUserProfileInput struct {
 	// The user's IAM ARN.
 	IAMUserARN *string `locationName:"IamUserArn" type:"string" required:"true"`
@@ -3112,6 +3436,11 @@ type metadataDeleteUserProfileInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteUserProfileInput.
+func (s DeleteUserProfileInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteUserProfileOutput struct {
 	metadataDeleteUserProfileOutput `json:"-", xml:"-"`
 }
@@ -3120,6 +3449,11 @@ type metadataDeleteUserProfileOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteUserProfileOutput.
+func (s DeleteUserProfileOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a deployment of a stack or app.
 type Deployment struct {
 	// The app ID.
@@ -3174,6 +3508,11 @@ type metadataDeployment struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Deployment.
+func (s Deployment) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Used to specify a stack or deployment command.
 type DeploymentCommand struct {
 	// The arguments of those commands that take arguments. It should be set to
@@ -3214,7 +3553,7 @@ type DeploymentCommand struct {
 	// back as many as four versions.  start: Start the app's web or application
 	// server.  stop: Stop the app's web or application server.  restart: Restart
 	// the app's web or application server.  undeploy: Undeploy the app.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" enum:"install_dependencies,update_dependencies,update_custom_cookbooks,execute_recipes,deploy,rollback,start,stop,restart,undeploy" required:"true"`
 
 	metadataDeploymentCommand `json:"-", xml:"-"`
 }
@@ -3223,6 +3562,61 @@ type metadataDeploymentCommand struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeploymentCommand.
+func (s DeploymentCommand) String() string {
+	return awsutil.StringValue(s)
+}
+
+// DeploymentCommandName is an enum of the values of DeploymentCommandName members.
+type DeploymentCommandName string
+
+// The values of DeploymentCommandName.
+const (
+	DeploymentCommandNameInstallDependencies   DeploymentCommandName = "install_dependencies"
+	DeploymentCommandNameUpdateDependencies    DeploymentCommandName = "update_dependencies"
+	DeploymentCommandNameUpdateCustomCookbooks DeploymentCommandName = "update_custom_cookbooks"
+	DeploymentCommandNameExecuteRecipes        DeploymentCommandName = "execute_recipes"
+	DeploymentCommandNameDeploy                DeploymentCommandName = "deploy"
+	DeploymentCommandNameRollback              DeploymentCommandName = "rollback"
+	DeploymentCommandNameStart                 DeploymentCommandName = "start"
+	DeploymentCommandNameStop                  DeploymentCommandName = "stop"
+	DeploymentCommandNameRestart               DeploymentCommandName = "restart"
+	DeploymentCommandNameUndeploy              DeploymentCommandName = "undeploy"
+)
+
+// DeploymentCommandNameValues returns the values of DeploymentCommandName known to this
+// version of the SDK. The service may accept values added since.
+func DeploymentCommandNameValues() []DeploymentCommandName {
+	return []DeploymentCommandName{
+		DeploymentCommandName("install_dependencies"),
+		DeploymentCommandName("update_dependencies"),
+		DeploymentCommandName("update_custom_cookbooks"),
+		DeploymentCommandName("execute_recipes"),
+		DeploymentCommandName("deploy"),
+		DeploymentCommandName("rollback"),
+		DeploymentCommandName("start"),
+		DeploymentCommandName("stop"),
+		DeploymentCommandName("restart"),
+		DeploymentCommandName("undeploy"),
+	}
+}
+
+// String returns the DeploymentCommandName as the string of a member.
+func (v DeploymentCommandName) String() string {
+	return string(v)
+}
+
+// ParseDeploymentCommandName returns the DeploymentCommandName of str, failing if it is not
+// one of the DeploymentCommandNameValues.
+func ParseDeploymentCommandName(str string) (DeploymentCommandName, error) {
+	for _, v := range DeploymentCommandNameValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DeploymentCommandName value %q", str)
+}
+
 type DeregisterElasticIPInput struct {
 	// The Elastic IP address.
 	ElasticIP *string `locationName:"ElasticIp" type:"string" required:"true"`
@@ -3234,6 +3628,11 @@ type metadataDeregisterElasticIPInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeregisterElasticIPInput.
+func (s DeregisterElasticIPInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeregisterElasticIPOutput struct {
 	metadataDeregisterElasticIPOutput `json:"-", xml:"-"`
 }
@@ -3242,6 +3641,11 @@ type metadataDeregisterElasticIPOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeregisterElasticIPOutput.
+func (s DeregisterElasticIPOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeregisterInstanceInput struct {
 	// The instance ID.
 	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
@@ -3253,6 +3657,11 @@ type metadataDeregisterInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeregisterInstanceInput.
+func (s DeregisterInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeregisterInstanceOutput struct {
 	metadataDeregisterInstanceOutput `json:"-", xml:"-"`
 }
@@ -3261,6 +3670,11 @@ type metadataDeregisterInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeregisterInstanceOutput.
+func (s DeregisterInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeregisterRDSDBInstanceInput struct {
 	// The Amazon RDS instance's ARN.
 	RDSDBInstanceARN *string `locationName:"RdsDbInstanceArn" type:"string" required:"true"`
@@ -3272,6 +3686,11 @@ type metadataDeregisterRDSDBInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeregisterRDSDBInstanceInput.
+func (s DeregisterRDSDBInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeregisterRDSDBInstanceOutput struct {
 	metadataDeregisterRDSDBInstanceOutput `json:"-", xml:"-"`
 }
@@ -3280,6 +3699,11 @@ type metadataDeregisterRDSDBInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeregisterRDSDBInstanceOutput.
+func (s DeregisterRDSDBInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeregisterVolumeInput struct {
 	// The volume ID.
 	VolumeID *string `locationName:"VolumeId" type:"string" required:"true"`
@@ -3291,6 +3715,11 @@ type metadataDeregisterVolumeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeregisterVolumeInput.
+func (s DeregisterVolumeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeregisterVolumeOutput struct {
 	metadataDeregisterVolumeOutput `json:"-", xml:"-"`
 }
@@ -3299,6 +3728,11 @@ type metadataDeregisterVolumeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeregisterVolumeOutput.
+func (s DeregisterVolumeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeAppsInput struct {
 	// An array of app IDs for the apps to be described. If you use this parameter,
 	// DescribeApps returns a description of the specified apps. Otherwise, it returns
@@ -3316,6 +3750,11 @@ type metadataDescribeAppsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeAppsInput.
+func (s DescribeAppsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a DescribeApps request.
 type DescribeAppsOutput struct {
 	// An array of App objects that describe the specified apps.
@@ -3328,6 +3767,11 @@ type metadataDescribeAppsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeAppsOutput.
+func (s DescribeAppsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeCommandsInput struct {
 	// An array of command IDs. If you include this parameter, DescribeCommands
 	// returns a description of the specified commands. Otherwise, it returns a
@@ -3349,6 +3793,11 @@ type metadataDescribeCommandsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeCommandsInput.
+func (s DescribeCommandsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a DescribeCommands request.
 type DescribeCommandsOutput struct {
 	// An array of Command objects that describe each of the specified commands.
@@ -3361,6 +3810,11 @@ type metadataDescribeCommandsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeCommandsOutput.
+func (s DescribeCommandsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeDeploymentsInput struct {
 	// The app ID. If you include this parameter, DescribeDeployments returns a
 	// description of the commands associated with the specified app.
@@ -3382,6 +3836,11 @@ type metadataDescribeDeploymentsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeDeploymentsInput.
+func (s DescribeDeploymentsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a DescribeDeployments request.
 type DescribeDeploymentsOutput struct {
 	// An array of Deployment objects that describe the deployments.
@@ -3394,6 +3853,11 @@ type metadataDescribeDeploymentsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeDeploymentsOutput.
+func (s DescribeDeploymentsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeElasticIPsInput struct {
 	// An array of Elastic IP addresses to be described. If you include this parameter,
 	// DescribeElasticIps returns a description of the specified Elastic IP addresses.
@@ -3415,6 +3879,11 @@ type metadataDescribeElasticIPsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeElasticIPsInput.
+func (s DescribeElasticIPsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a DescribeElasticIps request.
 type DescribeElasticIPsOutput struct {
 	// An ElasticIps object that describes the specified Elastic IP addresses.
@@ -3427,6 +3896,11 @@ type metadataDescribeElasticIPsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeElasticIPsOutput.
+func (s DescribeElasticIPsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeElasticLoadBalancersInput struct {
 	// A list of layer IDs. The action describes the Elastic Load Balancing instances
 	// for the specified layers.
@@ -3442,6 +3916,11 @@ type metadataDescribeElasticLoadBalancersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeElasticLoadBalancersInput.
+func (s DescribeElasticLoadBalancersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a DescribeElasticLoadBalancers request.
 type DescribeElasticLoadBalancersOutput struct {
 	// A list of ElasticLoadBalancer objects that describe the specified Elastic
@@ -3455,6 +3934,11 @@ type metadataDescribeElasticLoadBalancersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeElasticLoadBalancersOutput.
+func (s DescribeElasticLoadBalancersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeInstancesInput struct {
 	// An array of instance IDs to be described. If you use this parameter, DescribeInstances
 	// returns a description of the specified instances. Otherwise, it returns a
@@ -3476,6 +3960,11 @@ type metadataDescribeInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeInstancesInput.
+func (s DescribeInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a DescribeInstances request.
 type DescribeInstancesOutput struct {
 	// An array of Instance objects that describe the instances.
@@ -3488,6 +3977,11 @@ type metadataDescribeInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeInstancesOutput.
+func (s DescribeInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeLayersInput struct {
 	// An array of layer IDs that specify the layers to be described. If you omit
 	// this parameter, DescribeLayers returns a description of every layer in the
@@ -3504,6 +3998,11 @@ type metadataDescribeLayersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeLayersInput.
+func (s DescribeLayersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a DescribeLayers request.
 type DescribeLayersOutput struct {
 	// An array of Layer objects that describe the layers.
@@ -3516,6 +4015,11 @@ type metadataDescribeLayersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeLayersOutput.
+func (s DescribeLayersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeLoadBasedAutoScalingInput struct {
 	// An array of layer IDs.
 	LayerIDs []*string `locationName:"LayerIds" type:"list" required:"true"`
@@ -3527,6 +4031,11 @@ type metadataDescribeLoadBasedAutoScalingInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeLoadBasedAutoScalingInput.
+func (s DescribeLoadBasedAutoScalingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a DescribeLoadBasedAutoScaling request.
 type DescribeLoadBasedAutoScalingOutput struct {
 	// An array of LoadBasedAutoScalingConfiguration objects that describe each
@@ -3540,6 +4049,11 @@ type metadataDescribeLoadBasedAutoScalingOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeLoadBasedAutoScalingOutput.
+func (s DescribeLoadBasedAutoScalingOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeMyUserProfileInput struct {
 	metadataDescribeMyUserProfileInput `json:"-", xml:"-"`
 }
@@ -3548,6 +4062,11 @@ type metadataDescribeMyUserProfileInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeMyUserProfileInput.
+func (s DescribeMyUserProfileInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a DescribeMyUserProfile request.
 type DescribeMyUserProfileOutput struct {
 	// A UserProfile object that describes the user's SSH information.
@@ -3560,6 +4079,11 @@ type metadataDescribeMyUserProfileOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeMyUserProfileOutput.
+func (s DescribeMyUserProfileOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribePermissionsInput struct {
 	// The user's IAM ARN. For more information about IAM ARNs, see Using Identifiers
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html).
@@ -3575,6 +4099,11 @@ type metadataDescribePermissionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribePermissionsInput.
+func (s DescribePermissionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a DescribePermissions request.
 type DescribePermissionsOutput struct {
 	// An array of Permission objects that describe the stack permissions.
@@ -3594,6 +4123,11 @@ type metadataDescribePermissionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribePermissionsOutput.
+func (s DescribePermissionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeRAIDArraysInput struct {
 	// The instance ID. If you use this parameter, DescribeRaidArrays returns descriptions
 	// of the RAID arrays associated with the specified instance.
@@ -3614,6 +4148,11 @@ type metadataDescribeRAIDArraysInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeRAIDArraysInput.
+func (s DescribeRAIDArraysInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a DescribeRaidArrays request.
 type DescribeRAIDArraysOutput struct {
 	// A RaidArrays object that describes the specified RAID arrays.
@@ -3626,6 +4165,11 @@ type metadataDescribeRAIDArraysOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeRAIDArraysOutput.
+func (s DescribeRAIDArraysOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeRDSDBInstancesInput struct {
 	// An array containing the ARNs of the instances to be described.
 	RDSDBInstanceARNs []*string `locationName:"RdsDbInstanceArns" type:"list"`
@@ -3641,6 +4185,11 @@ type metadataDescribeRDSDBInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeRDSDBInstancesInput.
+func (s DescribeRDSDBInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a DescribeRdsDbInstances request.
 type DescribeRDSDBInstancesOutput struct {
 	// An a array of RdsDbInstance objects that describe the instances.
@@ -3653,6 +4202,11 @@ type metadataDescribeRDSDBInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeRDSDBInstancesOutput.
+func (s DescribeRDSDBInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeServiceErrorsInput struct {
 	// The instance ID. If you use this parameter, DescribeServiceErrors returns
 	// descriptions of the errors associated with the specified instance.
@@ -3674,6 +4228,11 @@ type metadataDescribeServiceErrorsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeServiceErrorsInput.
+func (s DescribeServiceErrorsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a DescribeServiceErrors request.
 type DescribeServiceErrorsOutput struct {
 	// An array of ServiceError objects that describe the specified service errors.
@@ -3686,6 +4245,11 @@ type metadataDescribeServiceErrorsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeServiceErrorsOutput.
+func (s DescribeServiceErrorsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeStackProvisioningParametersInput struct {
 	// The stack ID
 	StackID *string `locationName:"StackId" type:"string" required:"true"`
@@ -3697,6 +4261,11 @@ type metadataDescribeStackProvisioningParametersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeStackProvisioningParametersInput.
+func (s DescribeStackProvisioningParametersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a DescribeStackProvisioningParameters request.
 type DescribeStackProvisioningParametersOutput struct {
 	// The AWS OpsWorks agent installer's URL.
@@ -3712,6 +4281,11 @@ type metadataDescribeStackProvisioningParametersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeStackProvisioningParametersOutput.
+func (s DescribeStackProvisioningParametersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeStackSummaryInput struct {
 	// The stack ID.
 	StackID *string `locationName:"StackId" type:"string" required:"true"`
@@ -3723,6 +4297,11 @@ type metadataDescribeStackSummaryInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeStackSummaryInput.
+func (s DescribeStackSummaryInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a DescribeStackSummary request.
 type DescribeStackSummaryOutput struct {
 	// A StackSummary object that contains the results.
@@ -3735,6 +4314,11 @@ type metadataDescribeStackSummaryOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeStackSummaryOutput.
+func (s DescribeStackSummaryOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeStacksInput struct {
 	// An array of stack IDs that specify the stacks to be described. If you omit
 	// this parameter, DescribeStacks returns a description of every stack.
@@ -3747,6 +4331,11 @@ type metadataDescribeStacksInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeStacksInput.
+func (s DescribeStacksInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a DescribeStacks request.
 type DescribeStacksOutput struct {
 	// An array of Stack objects that describe the stacks.
@@ -3759,6 +4348,11 @@ type metadataDescribeStacksOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeStacksOutput.
+func (s DescribeStacksOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeTimeBasedAutoScalingInput struct {
 	// An array of instance IDs.
 	InstanceIDs []*string `locationName:"InstanceIds" type:"list" required:"true"`
@@ -3770,6 +4364,11 @@ type metadataDescribeTimeBasedAutoScalingInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeTimeBasedAutoScalingInput.
+func (s DescribeTimeBasedAutoScalingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a DescribeTimeBasedAutoScaling request.
 type DescribeTimeBasedAutoScalingOutput struct {
 	// An array of TimeBasedAutoScalingConfiguration objects that describe the configuration
@@ -3783,6 +4382,11 @@ type metadataDescribeTimeBasedAutoScalingOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeTimeBasedAutoScalingOutput.
+func (s DescribeTimeBasedAutoScalingOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeUserProfilesInput struct {
 	// An array of IAM user ARNs that identify the users to be described.
 	IAMUserARNs []*string `locationName:"IamUserArns" type:"list"`
@@ -3794,6 +4398,11 @@ type metadataDescribeUserProfilesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeUserProfilesInput.
+func (s DescribeUserProfilesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a DescribeUserProfiles request.
 type DescribeUserProfilesOutput struct {
 	// A Users object that describes the specified users.
@@ -3806,6 +4415,11 @@ type metadataDescribeUserProfilesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeUserProfilesOutput.
+func (s DescribeUserProfilesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeVolumesInput struct {
 	// The instance ID. If you use this parameter, DescribeVolumes returns descriptions
 	// of the volumes associated with the specified instance.
@@ -3830,6 +4444,11 @@ type metadataDescribeVolumesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeVolumesInput.
+func (s DescribeVolumesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a DescribeVolumes request.
 type DescribeVolumesOutput struct {
 	// An array of volume IDs.
@@ -3842,6 +4461,11 @@ type metadataDescribeVolumesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeVolumesOutput.
+func (s DescribeVolumesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DetachElasticLoadBalancerInput struct {
 	// The Elastic Load Balancing instance's name.
 	ElasticLoadBalancerName *string `type:"string" required:"true"`
@@ -3857,6 +4481,11 @@ type metadataDetachElasticLoadBalancerInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DetachElasticLoadBalancerInput.
+func (s DetachElasticLoadBalancerInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DetachElasticLoadBalancerOutput struct {
 	metadataDetachElasticLoadBalancerOutput `json:"-", xml:"-"`
 }
@@ -3865,6 +4494,11 @@ type metadataDetachElasticLoadBalancerOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DetachElasticLoadBalancerOutput.
+func (s DetachElasticLoadBalancerOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DisassociateElasticIPInput struct {
 	// The Elastic IP address.
 	ElasticIP *string `locationName:"ElasticIp" type:"string" required:"true"`
@@ -3876,6 +4510,11 @@ type metadataDisassociateElasticIPInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DisassociateElasticIPInput.
+func (s DisassociateElasticIPInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DisassociateElasticIPOutput struct {
 	metadataDisassociateElasticIPOutput `json:"-", xml:"-"`
 }
@@ -3884,6 +4523,11 @@ type metadataDisassociateElasticIPOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DisassociateElasticIPOutput.
+func (s DisassociateElasticIPOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an Elastic IP address.
 type ElasticIP struct {
 	// The domain.
@@ -3908,6 +4552,11 @@ type metadataElasticIP struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ElasticIP.
+func (s ElasticIP) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an Elastic Load Balancing instance.
 type ElasticLoadBalancer struct {
 	// A list of Availability Zones.
@@ -3945,6 +4594,11 @@ type metadataElasticLoadBalancer struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ElasticLoadBalancer.
+func (s ElasticLoadBalancer) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents an app's environment variable.
 type EnvironmentVariable struct {
 	// (Required) The environment variable's name, which can consist of up to 64
@@ -3971,6 +4625,11 @@ type metadataEnvironmentVariable struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EnvironmentVariable.
+func (s EnvironmentVariable) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetHostnameSuggestionInput struct {
 	// The layer ID.
 	LayerID *string `locationName:"LayerId" type:"string" required:"true"`
@@ -3982,6 +4641,11 @@ type metadataGetHostnameSuggestionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetHostnameSuggestionInput.
+func (s GetHostnameSuggestionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a GetHostnameSuggestion request.
 type GetHostnameSuggestionOutput struct {
 	// The generated host name.
@@ -3997,6 +4661,11 @@ type metadataGetHostnameSuggestionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetHostnameSuggestionOutput.
+func (s GetHostnameSuggestionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an instance.
 type Instance struct {
 	// A custom AMI ID to be used to create the instance. The AMI should be based
@@ -4005,10 +4674,10 @@ type Instance struct {
 	AMIID *string `locationName:"AmiId" type:"string"`
 
 	// The instance architecture, "i386" or "x86_64".
-	Architecture *string `type:"string"`
+	Architecture *string `type:"string" enum:"x86_64,i386"`
 
 	// For load-based or time-based instances, the type.
-	AutoScalingType *string `type:"string"`
+	AutoScalingType *string `type:"string" enum:"load,timer"`
 
 	// The instance Availability Zone. For more information, see Regions and Endpoints
 	// (http://docs.aws.amazon.com/general/latest/gr/rande.html).
@@ -4085,7 +4754,7 @@ type Instance struct {
 
 	// The instance root device type. For more information, see Storage for the
 	// Root Device (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ComponentsAMIs.html#storage-for-the-root-device).
-	RootDeviceType *string `type:"string"`
+	RootDeviceType *string `type:"string" enum:"ebs,instance-store"`
 
 	// The root device volume ID.
 	RootDeviceVolumeID *string `locationName:"RootDeviceVolumeId" type:"string"`
@@ -4115,7 +4784,7 @@ type Instance struct {
 	SubnetID *string `locationName:"SubnetId" type:"string"`
 
 	// The instance's virtualization type, paravirtual or hvm.
-	VirtualizationType *string `type:"string"`
+	VirtualizationType *string `type:"string" enum:"paravirtual,hvm"`
 
 	metadataInstance `json:"-", xml:"-"`
 }
@@ -4124,6 +4793,11 @@ type metadataInstance struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Instance.
+func (s Instance) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains a description of an Amazon EC2 instance from the Amazon EC2 metadata
 // service. For more information, see Instance Metadata and User Data (http://docs.aws.amazon.com/sdkfornet/latest/apidocs/Index.html).
 type InstanceIdentity struct {
@@ -4140,6 +4814,11 @@ type metadataInstanceIdentity struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InstanceIdentity.
+func (s InstanceIdentity) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes how many instances a stack has for each status.
 type InstancesCount struct {
 	// The number of instances in the Assigning state.
@@ -4206,6 +4885,11 @@ type metadataInstancesCount struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the InstancesCount.
+func (s InstancesCount) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a layer.
 type Layer struct {
 	// The layer attributes.
@@ -4281,7 +4965,7 @@ type Layer struct {
 	StackID *string `locationName:"StackId" type:"string"`
 
 	// The layer type.
-	Type *string `type:"string"`
+	Type *string `type:"string" enum:"java-app,lb,web,php-app,rails-app,nodejs-app,memcached,db-master,monitoring-master,custom"`
 
 	// Whether the layer uses Amazon EBS-optimized instances.
 	UseEBSOptimizedInstances *bool `locationName:"UseEbsOptimizedInstances" type:"boolean"`
@@ -4296,6 +4980,139 @@ type metadataLayer struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Layer.
+func (s Layer) String() string {
+	return awsutil.StringValue(s)
+}
+
+// LayerAttributesKeys is an enum of the values of LayerAttributesKeys members.
+type LayerAttributesKeys string
+
+// The values of LayerAttributesKeys.
+const (
+	LayerAttributesKeysEnableHaproxyStats          LayerAttributesKeys = "EnableHaproxyStats"
+	LayerAttributesKeysHaproxyStatsUrl             LayerAttributesKeys = "HaproxyStatsUrl"
+	LayerAttributesKeysHaproxyStatsUser            LayerAttributesKeys = "HaproxyStatsUser"
+	LayerAttributesKeysHaproxyStatsPassword        LayerAttributesKeys = "HaproxyStatsPassword"
+	LayerAttributesKeysHaproxyHealthCheckUrl       LayerAttributesKeys = "HaproxyHealthCheckUrl"
+	LayerAttributesKeysHaproxyHealthCheckMethod    LayerAttributesKeys = "HaproxyHealthCheckMethod"
+	LayerAttributesKeysMysqlRootPassword           LayerAttributesKeys = "MysqlRootPassword"
+	LayerAttributesKeysMysqlRootPasswordUbiquitous LayerAttributesKeys = "MysqlRootPasswordUbiquitous"
+	LayerAttributesKeysGangliaUrl                  LayerAttributesKeys = "GangliaUrl"
+	LayerAttributesKeysGangliaUser                 LayerAttributesKeys = "GangliaUser"
+	LayerAttributesKeysGangliaPassword             LayerAttributesKeys = "GangliaPassword"
+	LayerAttributesKeysMemcachedMemory             LayerAttributesKeys = "MemcachedMemory"
+	LayerAttributesKeysNodejsVersion               LayerAttributesKeys = "NodejsVersion"
+	LayerAttributesKeysRubyVersion                 LayerAttributesKeys = "RubyVersion"
+	LayerAttributesKeysRubygemsVersion             LayerAttributesKeys = "RubygemsVersion"
+	LayerAttributesKeysManageBundler               LayerAttributesKeys = "ManageBundler"
+	LayerAttributesKeysBundlerVersion              LayerAttributesKeys = "BundlerVersion"
+	LayerAttributesKeysRailsStack                  LayerAttributesKeys = "RailsStack"
+	LayerAttributesKeysPassengerVersion            LayerAttributesKeys = "PassengerVersion"
+	LayerAttributesKeysJvm                         LayerAttributesKeys = "Jvm"
+	LayerAttributesKeysJvmVersion                  LayerAttributesKeys = "JvmVersion"
+	LayerAttributesKeysJvmOptions                  LayerAttributesKeys = "JvmOptions"
+	LayerAttributesKeysJavaAppServer               LayerAttributesKeys = "JavaAppServer"
+	LayerAttributesKeysJavaAppServerVersion        LayerAttributesKeys = "JavaAppServerVersion"
+)
+
+// LayerAttributesKeysValues returns the values of LayerAttributesKeys known to this
+// version of the SDK. The service may accept values added since.
+func LayerAttributesKeysValues() []LayerAttributesKeys {
+	return []LayerAttributesKeys{
+		LayerAttributesKeys("EnableHaproxyStats"),
+		LayerAttributesKeys("HaproxyStatsUrl"),
+		LayerAttributesKeys("HaproxyStatsUser"),
+		LayerAttributesKeys("HaproxyStatsPassword"),
+		LayerAttributesKeys("HaproxyHealthCheckUrl"),
+		LayerAttributesKeys("HaproxyHealthCheckMethod"),
+		LayerAttributesKeys("MysqlRootPassword"),
+		LayerAttributesKeys("MysqlRootPasswordUbiquitous"),
+		LayerAttributesKeys("GangliaUrl"),
+		LayerAttributesKeys("GangliaUser"),
+		LayerAttributesKeys("GangliaPassword"),
+		LayerAttributesKeys("MemcachedMemory"),
+		LayerAttributesKeys("NodejsVersion"),
+		LayerAttributesKeys("RubyVersion"),
+		LayerAttributesKeys("RubygemsVersion"),
+		LayerAttributesKeys("ManageBundler"),
+		LayerAttributesKeys("BundlerVersion"),
+		LayerAttributesKeys("RailsStack"),
+		LayerAttributesKeys("PassengerVersion"),
+		LayerAttributesKeys("Jvm"),
+		LayerAttributesKeys("JvmVersion"),
+		LayerAttributesKeys("JvmOptions"),
+		LayerAttributesKeys("JavaAppServer"),
+		LayerAttributesKeys("JavaAppServerVersion"),
+	}
+}
+
+// String returns the LayerAttributesKeys as the string of a member.
+func (v LayerAttributesKeys) String() string {
+	return string(v)
+}
+
+// ParseLayerAttributesKeys returns the LayerAttributesKeys of str, failing if it is not
+// one of the LayerAttributesKeysValues.
+func ParseLayerAttributesKeys(str string) (LayerAttributesKeys, error) {
+	for _, v := range LayerAttributesKeysValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid LayerAttributesKeys value %q", str)
+}
+
+// LayerType is an enum of the values of LayerType members.
+type LayerType string
+
+// The values of LayerType.
+const (
+	LayerTypeJavaApp          LayerType = "java-app"
+	LayerTypeLb               LayerType = "lb"
+	LayerTypeWeb              LayerType = "web"
+	LayerTypePhpApp           LayerType = "php-app"
+	LayerTypeRailsApp         LayerType = "rails-app"
+	LayerTypeNodejsApp        LayerType = "nodejs-app"
+	LayerTypeMemcached        LayerType = "memcached"
+	LayerTypeDbMaster         LayerType = "db-master"
+	LayerTypeMonitoringMaster LayerType = "monitoring-master"
+	LayerTypeCustom           LayerType = "custom"
+)
+
+// LayerTypeValues returns the values of LayerType known to this
+// version of the SDK. The service may accept values added since.
+func LayerTypeValues() []LayerType {
+	return []LayerType{
+		LayerType("java-app"),
+		LayerType("lb"),
+		LayerType("web"),
+		LayerType("php-app"),
+		LayerType("rails-app"),
+		LayerType("nodejs-app"),
+		LayerType("memcached"),
+		LayerType("db-master"),
+		LayerType("monitoring-master"),
+		LayerType("custom"),
+	}
+}
+
+// String returns the LayerType as the string of a member.
+func (v LayerType) String() string {
+	return string(v)
+}
+
+// ParseLayerType returns the LayerType of str, failing if it is not
+// one of the LayerTypeValues.
+func ParseLayerType(str string) (LayerType, error) {
+	for _, v := range LayerTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid LayerType value %q", str)
+}
+
 // Specifies the lifecycle event configuration
 type LifecycleEventConfiguration struct {
 	// A ShutdownEventConfiguration object that specifies the Shutdown event configuration.
@@ -4308,6 +5125,11 @@ type metadataLifecycleEventConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the LifecycleEventConfiguration.
+func (s LifecycleEventConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a layer's load-based auto scaling configuration.
 type LoadBasedAutoScalingConfiguration struct {
 	// An AutoScalingThresholds object that describes the downscaling configuration,
@@ -4331,6 +5153,11 @@ type metadataLoadBasedAutoScalingConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the LoadBasedAutoScalingConfiguration.
+func (s LoadBasedAutoScalingConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes stack or user permissions.
 type Permission struct {
 	// Whether the user can use SSH.
@@ -4359,6 +5186,11 @@ type metadataPermission struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Permission.
+func (s Permission) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an instance's RAID array.
 type RAIDArray struct {
 	// The array's Availability Zone. For more information, see Regions and Endpoints
@@ -4408,6 +5240,11 @@ type metadataRAIDArray struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RAIDArray.
+func (s RAIDArray) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an Amazon RDS instance.
 type RDSDBInstance struct {
 	// The instance's address.
@@ -4446,6 +5283,11 @@ type metadataRDSDBInstance struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RDSDBInstance.
+func (s RDSDBInstance) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RebootInstanceInput struct {
 	// The instance ID.
 	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
@@ -4457,6 +5299,11 @@ type metadataRebootInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RebootInstanceInput.
+func (s RebootInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RebootInstanceOutput struct {
 	metadataRebootInstanceOutput `json:"-", xml:"-"`
 }
@@ -4465,6 +5312,11 @@ type metadataRebootInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RebootInstanceOutput.
+func (s RebootInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // AWS OpsWorks supports five lifecycle events, setup, configuration, deploy,
 // undeploy, and shutdown. For each layer, AWS OpsWorks runs a set of standard
 // recipes for each event. In addition, you can provide custom recipes for any
@@ -4499,6 +5351,11 @@ type metadataRecipes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Recipes.
+func (s Recipes) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RegisterElasticIPInput struct {
 	// The Elastic IP address.
 	ElasticIP *string `locationName:"ElasticIp" type:"string" required:"true"`
@@ -4513,6 +5370,11 @@ type metadataRegisterElasticIPInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RegisterElasticIPInput.
+func (s RegisterElasticIPInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a RegisterElasticIp request.
 type RegisterElasticIPOutput struct {
 	// The Elastic IP address.
@@ -4525,6 +5387,11 @@ type metadataRegisterElasticIPOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RegisterElasticIPOutput.
+func (s RegisterElasticIPOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RegisterInstanceInput struct {
 	// The instance's hostname.
 	Hostname *string `type:"string"`
@@ -4555,6 +5422,11 @@ type metadataRegisterInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RegisterInstanceInput.
+func (s RegisterInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a RegisterInstanceResult request.
 type RegisterInstanceOutput struct {
 	// The registered instance's AWS OpsWorks ID.
@@ -4567,6 +5439,11 @@ type metadataRegisterInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RegisterInstanceOutput.
+func (s RegisterInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RegisterRDSDBInstanceInput struct {
 	// The database password.
 	DBPassword *string `locationName:"DbPassword" type:"string" required:"true"`
@@ -4587,6 +5464,11 @@ type metadataRegisterRDSDBInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RegisterRDSDBInstanceInput.
+func (s RegisterRDSDBInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RegisterRDSDBInstanceOutput struct {
 	metadataRegisterRDSDBInstanceOutput `json:"-", xml:"-"`
 }
@@ -4595,6 +5477,11 @@ type metadataRegisterRDSDBInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RegisterRDSDBInstanceOutput.
+func (s RegisterRDSDBInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RegisterVolumeInput struct {
 	// The Amazon EBS volume ID.
 	EC2VolumeID *string `locationName:"Ec2VolumeId" type:"string"`
@@ -4609,6 +5496,11 @@ type metadataRegisterVolumeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RegisterVolumeInput.
+func (s RegisterVolumeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the response to a RegisterVolume request.
 type RegisterVolumeOutput struct {
 	// The volume ID.
@@ -4621,6 +5513,11 @@ type metadataRegisterVolumeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RegisterVolumeOutput.
+func (s RegisterVolumeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A registered instance's reported operating system.
 type ReportedOs struct {
 	// The operating system family.
@@ -4639,6 +5536,45 @@ type metadataReportedOs struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReportedOs.
+func (s ReportedOs) String() string {
+	return awsutil.StringValue(s)
+}
+
+// RootDeviceType is an enum of the values of RootDeviceType members.
+type RootDeviceType string
+
+// The values of RootDeviceType.
+const (
+	RootDeviceTypeEbs           RootDeviceType = "ebs"
+	RootDeviceTypeInstanceStore RootDeviceType = "instance-store"
+)
+
+// RootDeviceTypeValues returns the values of RootDeviceType known to this
+// version of the SDK. The service may accept values added since.
+func RootDeviceTypeValues() []RootDeviceType {
+	return []RootDeviceType{
+		RootDeviceType("ebs"),
+		RootDeviceType("instance-store"),
+	}
+}
+
+// String returns the RootDeviceType as the string of a member.
+func (v RootDeviceType) String() string {
+	return string(v)
+}
+
+// ParseRootDeviceType returns the RootDeviceType of str, failing if it is not
+// one of the RootDeviceTypeValues.
+func ParseRootDeviceType(str string) (RootDeviceType, error) {
+	for _, v := range RootDeviceTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid RootDeviceType value %q", str)
+}
+
 // Describes an app's SSL configuration.
 type SSLConfiguration struct {
 	// The contents of the certificate's domain.crt file.
@@ -4658,6 +5594,11 @@ type metadataSSLConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SSLConfiguration.
+func (s SSLConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a user's SSH information.
 type SelfUserProfile struct {
 	// The user's IAM ARN.
@@ -4679,6 +5620,11 @@ type metadataSelfUserProfile struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SelfUserProfile.
+func (s SelfUserProfile) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an AWS OpsWorks service error.
 type ServiceError struct {
 	// When the error occurred.
@@ -4706,6 +5652,11 @@ type metadataServiceError struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ServiceError.
+func (s ServiceError) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SetLoadBasedAutoScalingInput struct {
 	// An AutoScalingThresholds object with the downscaling threshold configuration.
 	// If the load falls below these thresholds for a specified amount of time,
@@ -4730,6 +5681,11 @@ type metadataSetLoadBasedAutoScalingInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetLoadBasedAutoScalingInput.
+func (s SetLoadBasedAutoScalingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SetLoadBasedAutoScalingOutput struct {
 	metadataSetLoadBasedAutoScalingOutput `json:"-", xml:"-"`
 }
@@ -4738,6 +5694,11 @@ type metadataSetLoadBasedAutoScalingOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetLoadBasedAutoScalingOutput.
+func (s SetLoadBasedAutoScalingOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SetPermissionInput struct {
 	// The user is allowed to use SSH to communicate with the instance.
 	AllowSSH *bool `locationName:"AllowSsh" type:"boolean"`
@@ -4765,6 +5726,11 @@ type metadataSetPermissionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetPermissionInput.
+func (s SetPermissionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SetPermissionOutput struct {
 	metadataSetPermissionOutput `json:"-", xml:"-"`
 }
@@ -4773,6 +5739,11 @@ type metadataSetPermissionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetPermissionOutput.
+func (s SetPermissionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SetTimeBasedAutoScalingInput struct {
 	// An AutoScalingSchedule with the instance schedule.
 	AutoScalingSchedule *WeeklyAutoScalingSchedule `type:"structure"`
@@ -4787,6 +5758,11 @@ type metadataSetTimeBasedAutoScalingInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetTimeBasedAutoScalingInput.
+func (s SetTimeBasedAutoScalingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SetTimeBasedAutoScalingOutput struct {
 	metadataSetTimeBasedAutoScalingOutput `json:"-", xml:"-"`
 }
@@ -4795,6 +5771,11 @@ type metadataSetTimeBasedAutoScalingOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetTimeBasedAutoScalingOutput.
+func (s SetTimeBasedAutoScalingOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The Shutdown event configuration.
 type ShutdownEventConfiguration struct {
 	// Whether to enable Elastic Load Balancing connection draining. For more information,
@@ -4812,6 +5793,11 @@ type metadataShutdownEventConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ShutdownEventConfiguration.
+func (s ShutdownEventConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the information required to retrieve an app or cookbook from a repository.
 // For more information, see Creating Apps (http://docs.aws.amazon.com/opsworks/latest/userguide/workingapps-creating.html)
 // or Custom Recipes and Cookbooks (http://docs.aws.amazon.com/opsworks/latest/userguide/workingcookbook.html).
@@ -4833,7 +5819,7 @@ type Source struct {
 	SSHKey *string `locationName:"SshKey" type:"string"`
 
 	// The repository type.
-	Type *string `type:"string"`
+	Type *string `type:"string" enum:"git,svn,archive,s3"`
 
 	// The source URL.
 	URL *string `locationName:"Url" type:"string"`
@@ -4852,6 +5838,49 @@ type metadataSource struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Source.
+func (s Source) String() string {
+	return awsutil.StringValue(s)
+}
+
+// SourceType is an enum of the values of SourceType members.
+type SourceType string
+
+// The values of SourceType.
+const (
+	SourceTypeGit     SourceType = "git"
+	SourceTypeSvn     SourceType = "svn"
+	SourceTypeArchive SourceType = "archive"
+	SourceTypeS3      SourceType = "s3"
+)
+
+// SourceTypeValues returns the values of SourceType known to this
+// version of the SDK. The service may accept values added since.
+func SourceTypeValues() []SourceType {
+	return []SourceType{
+		SourceType("git"),
+		SourceType("svn"),
+		SourceType("archive"),
+		SourceType("s3"),
+	}
+}
+
+// String returns the SourceType as the string of a member.
+func (v SourceType) String() string {
+	return string(v)
+}
+
+// ParseSourceType returns the SourceType of str, failing if it is not
+// one of the SourceTypeValues.
+func ParseSourceType(str string) (SourceType, error) {
+	for _, v := range SourceTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid SourceType value %q", str)
+}
+
 // Describes a stack.
 type Stack struct {
 	// The stack's ARN.
@@ -4900,7 +5929,7 @@ type Stack struct {
 	// The default root device type. This value is used by default for all instances
 	// in the stack, but you can override it when you create an instance. For more
 	// information, see Storage for the Root Device (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ComponentsAMIs.html#storage-for-the-root-device).
-	DefaultRootDeviceType *string `type:"string"`
+	DefaultRootDeviceType *string `type:"string" enum:"ebs,instance-store"`
 
 	// A default SSH key for the stack's instances. You can override this value
 	// when you create or update an instance.
@@ -4942,6 +5971,43 @@ type metadataStack struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Stack.
+func (s Stack) String() string {
+	return awsutil.StringValue(s)
+}
+
+// StackAttributesKeys is an enum of the values of StackAttributesKeys members.
+type StackAttributesKeys string
+
+// The values of StackAttributesKeys.
+const (
+	StackAttributesKeysColor StackAttributesKeys = "Color"
+)
+
+// StackAttributesKeysValues returns the values of StackAttributesKeys known to this
+// version of the SDK. The service may accept values added since.
+func StackAttributesKeysValues() []StackAttributesKeys {
+	return []StackAttributesKeys{
+		StackAttributesKeys("Color"),
+	}
+}
+
+// String returns the StackAttributesKeys as the string of a member.
+func (v StackAttributesKeys) String() string {
+	return string(v)
+}
+
+// ParseStackAttributesKeys returns the StackAttributesKeys of str, failing if it is not
+// one of the StackAttributesKeysValues.
+func ParseStackAttributesKeys(str string) (StackAttributesKeys, error) {
+	for _, v := range StackAttributesKeysValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid StackAttributesKeys value %q", str)
+}
+
 // Describes the configuration manager.
 type StackConfigurationManager struct {
 	// The name. This parameter must be set to "Chef".
@@ -4958,6 +6024,11 @@ type metadataStackConfigurationManager struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StackConfigurationManager.
+func (s StackConfigurationManager) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Summarizes the number of layers, instances, and apps in a stack.
 type StackSummary struct {
 	// The stack's ARN.
@@ -4985,6 +6056,11 @@ type metadataStackSummary struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StackSummary.
+func (s StackSummary) String() string {
+	return awsutil.StringValue(s)
+}
+
 type StartInstanceInput struct {
 	// The instance ID.
 	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
@@ -4996,6 +6072,11 @@ type metadataStartInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StartInstanceInput.
+func (s StartInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type StartInstanceOutput struct {
 	metadataStartInstanceOutput `json:"-", xml:"-"`
 }
@@ -5004,6 +6085,11 @@ type metadataStartInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StartInstanceOutput.
+func (s StartInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type StartStackInput struct {
 	// The stack ID.
 	StackID *string `locationName:"StackId" type:"string" required:"true"`
@@ -5015,6 +6101,11 @@ type metadataStartStackInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StartStackInput.
+func (s StartStackInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type StartStackOutput struct {
 	metadataStartStackOutput `json:"-", xml:"-"`
 }
@@ -5023,6 +6114,11 @@ type metadataStartStackOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StartStackOutput.
+func (s StartStackOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type StopInstanceInput struct {
 	// The instance ID.
 	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
@@ -5034,6 +6130,11 @@ type metadataStopInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StopInstanceInput.
+func (s StopInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type StopInstanceOutput struct {
 	metadataStopInstanceOutput `json:"-", xml:"-"`
 }
@@ -5042,6 +6143,11 @@ type metadataStopInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StopInstanceOutput.
+func (s StopInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type StopStackInput struct {
 	// The stack ID.
 	StackID *string `locationName:"StackId" type:"string" required:"true"`
@@ -5053,6 +6159,11 @@ type metadataStopStackInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StopStackInput.
+func (s StopStackInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type StopStackOutput struct {
 	metadataStopStackOutput `json:"-", xml:"-"`
 }
@@ -5061,6 +6172,11 @@ type metadataStopStackOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StopStackOutput.
+func (s StopStackOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an instance's time-based auto scaling configuration.
 type TimeBasedAutoScalingConfiguration struct {
 	// A WeeklyAutoScalingSchedule object with the instance schedule.
@@ -5076,6 +6192,11 @@ type metadataTimeBasedAutoScalingConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TimeBasedAutoScalingConfiguration.
+func (s TimeBasedAutoScalingConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UnassignInstanceInput struct {
 	// The instance ID.
 	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
@@ -5087,6 +6208,11 @@ type metadataUnassignInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UnassignInstanceInput.
+func (s UnassignInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UnassignInstanceOutput struct {
 	metadataUnassignInstanceOutput `json:"-", xml:"-"`
 }
@@ -5095,6 +6221,11 @@ type metadataUnassignInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UnassignInstanceOutput.
+func (s UnassignInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UnassignVolumeInput struct {
 	// The volume ID.
 	VolumeID *string `locationName:"VolumeId" type:"string" required:"true"`
@@ -5106,6 +6237,11 @@ type metadataUnassignVolumeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UnassignVolumeInput.
+func (s UnassignVolumeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UnassignVolumeOutput struct {
 	metadataUnassignVolumeOutput `json:"-", xml:"-"`
 }
@@ -5114,6 +6250,11 @@ type metadataUnassignVolumeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UnassignVolumeOutput.
+func (s UnassignVolumeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateAppInput struct {
 	// The app ID.
 	AppID *string `locationName:"AppId" type:"string" required:"true"`
@@ -5153,7 +6294,7 @@ type UpdateAppInput struct {
 	SSLConfiguration *SSLConfiguration `locationName:"SslConfiguration" type:"structure"`
 
 	// The app type.
-	Type *string `type:"string"`
+	Type *string `type:"string" enum:"java,rails,php,nodejs,static,other"`
 
 	metadataUpdateAppInput `json:"-", xml:"-"`
 }
@@ -5162,6 +6303,11 @@ type metadataUpdateAppInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateAppInput.
+func (s UpdateAppInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateAppOutput struct {
 	metadataUpdateAppOutput `json:"-", xml:"-"`
 }
@@ -5170,6 +6316,11 @@ type metadataUpdateAppOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateAppOutput.
+func (s UpdateAppOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateElasticIPInput struct {
 	// The address.
 	ElasticIP *string `locationName:"ElasticIp" type:"string" required:"true"`
@@ -5184,6 +6335,11 @@ type metadataUpdateElasticIPInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateElasticIPInput.
+func (s UpdateElasticIPInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateElasticIPOutput struct {
 	metadataUpdateElasticIPOutput `json:"-", xml:"-"`
 }
@@ -5192,6 +6348,11 @@ type metadataUpdateElasticIPOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateElasticIPOutput.
+func (s UpdateElasticIPOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateInstanceInput struct {
 	// A custom AMI ID to be used to create the instance. The AMI should be based
 	// on one of the standard AWS OpsWorks AMIs: Amazon Linux, Ubuntu 12.04 LTS,
@@ -5203,10 +6364,10 @@ type UpdateInstanceInput struct {
 	// The instance architecture. Instance types do not necessarily support both
 	// architectures. For a list of the architectures that are supported by the
 	// different instance types, see Instance Families and Types (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/instance-types.html).
-	Architecture *string `type:"string"`
+	Architecture *string `type:"string" enum:"x86_64,i386"`
 
 	// For load-based or time-based instances, the type.
-	AutoScalingType *string `type:"string"`
+	AutoScalingType *string `type:"string" enum:"load,timer"`
 
 	// Whether this is an Amazon EBS-optimized instance.
 	EBSOptimized *bool `locationName:"EbsOptimized" type:"boolean"`
@@ -5260,6 +6421,11 @@ type metadataUpdateInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateInstanceInput.
+func (s UpdateInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateInstanceOutput struct {
 	metadataUpdateInstanceOutput `json:"-", xml:"-"`
 }
@@ -5268,6 +6434,11 @@ type metadataUpdateInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateInstanceOutput.
+func (s UpdateInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateLayerInput struct {
 	// One or more user-defined key/value pairs to be added to the stack attributes.
 	Attributes *map[string]*string `type:"map"`
@@ -5334,6 +6505,11 @@ type metadataUpdateLayerInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateLayerInput.
+func (s UpdateLayerInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateLayerOutput struct {
 	metadataUpdateLayerOutput `json:"-", xml:"-"`
 }
@@ -5342,6 +6518,11 @@ type metadataUpdateLayerOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateLayerOutput.
+func (s UpdateLayerOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateMyUserProfileInput struct {
 	// The user's SSH public key.
 	SSHPublicKey *string `locationName:"SshPublicKey" type:"string"`
@@ -5353,6 +6534,11 @@ type metadataUpdateMyUserProfileInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateMyUserProfileInput.
+func (s UpdateMyUserProfileInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateMyUserProfileOutput struct {
 	metadataUpdateMyUserProfileOutput `json:"-", xml:"-"`
 }
@@ -5361,6 +6547,11 @@ type metadataUpdateMyUserProfileOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateMyUserProfileOutput.
+func (s UpdateMyUserProfileOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateRDSDBInstanceInput struct {
 	// The database password.
 	DBPassword *string `locationName:"DbPassword" type:"string"`
@@ -5378,6 +6569,11 @@ type metadataUpdateRDSDBInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateRDSDBInstanceInput.
+func (s UpdateRDSDBInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateRDSDBInstanceOutput struct {
 	metadataUpdateRDSDBInstanceOutput `json:"-", xml:"-"`
 }
@@ -5386,6 +6582,11 @@ type metadataUpdateRDSDBInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateRDSDBInstanceOutput.
+func (s UpdateRDSDBInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateStackInput struct {
 	// One or more user-defined key/value pairs to be added to the stack attributes.
 	Attributes *map[string]*string `type:"map"`
@@ -5437,7 +6638,7 @@ type UpdateStackInput struct {
 	// The default root device type. This value is used by default for all instances
 	// in the stack, but you can override it when you create an instance. For more
 	// information, see Storage for the Root Device (http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ComponentsAMIs.html#storage-for-the-root-device).
-	DefaultRootDeviceType *string `type:"string"`
+	DefaultRootDeviceType *string `type:"string" enum:"ebs,instance-store"`
 
 	// A default SSH key for the stack instances. You can override this value when
 	// you create or update an instance.
@@ -5507,6 +6708,11 @@ type metadataUpdateStackInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateStackInput.
+func (s UpdateStackInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateStackOutput struct {
 	metadataUpdateStackOutput `json:"-", xml:"-"`
 }
@@ -5515,6 +6721,11 @@ type metadataUpdateStackOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateStackOutput.
+func (s UpdateStackOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateUserProfileInput struct {
 	// Whether users can specify their own SSH public key through the My Settings
 	// page. For more information, see Managing User Permissions (http://docs.aws.amazon.com/opsworks/latest/userguide/security-settingsshkey.html).
@@ -5540,6 +6751,11 @@ type metadataUpdateUserProfileInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateUserProfileInput.
+func (s UpdateUserProfileInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateUserProfileOutput struct {
 	metadataUpdateUserProfileOutput `json:"-", xml:"-"`
 }
@@ -5548,6 +6764,11 @@ type metadataUpdateUserProfileOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateUserProfileOutput.
+func (s UpdateUserProfileOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateVolumeInput struct {
 	// The new mount point.
 	MountPoint *string `type:"string"`
@@ -5565,6 +6786,11 @@ type metadataUpdateVolumeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateVolumeInput.
+func (s UpdateVolumeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateVolumeOutput struct {
 	metadataUpdateVolumeOutput `json:"-", xml:"-"`
 }
@@ -5573,6 +6799,11 @@ type metadataUpdateVolumeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateVolumeOutput.
+func (s UpdateVolumeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a user's SSH information.
 type UserProfile struct {
 	// Whether users can specify their own SSH public key through the My Settings
@@ -5598,6 +6829,45 @@ type metadataUserProfile struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UserProfile.
+func (s UserProfile) String() string {
+	return awsutil.StringValue(s)
+}
+
+// VirtualizationType is an enum of the values of VirtualizationType members.
+type VirtualizationType string
+
+// The values of VirtualizationType.
+const (
+	VirtualizationTypeParavirtual VirtualizationType = "paravirtual"
+	VirtualizationTypeHvm         VirtualizationType = "hvm"
+)
+
+// VirtualizationTypeValues returns the values of VirtualizationType known to this
+// version of the SDK. The service may accept values added since.
+func VirtualizationTypeValues() []VirtualizationType {
+	return []VirtualizationType{
+		VirtualizationType("paravirtual"),
+		VirtualizationType("hvm"),
+	}
+}
+
+// String returns the VirtualizationType as the string of a member.
+func (v VirtualizationType) String() string {
+	return string(v)
+}
+
+// ParseVirtualizationType returns the VirtualizationType of str, failing if it is not
+// one of the VirtualizationTypeValues.
+func ParseVirtualizationType(str string) (VirtualizationType, error) {
+	for _, v := range VirtualizationTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualizationType value %q", str)
+}
+
 // Describes an instance's Amazon EBS volume.
 type Volume struct {
 	// The volume Availability Zone. For more information, see Regions and Endpoints
@@ -5648,6 +6918,11 @@ type metadataVolume struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Volume.
+func (s Volume) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an Amazon EBS volume configuration.
 type VolumeConfiguration struct {
 	// For PIOPS volumes, the IOPS per disk.
@@ -5678,6 +6953,11 @@ type metadataVolumeConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the VolumeConfiguration.
+func (s VolumeConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a time-based instance's auto scaling schedule. The schedule consists
 // of a set of key-value pairs.
 //
@@ -5719,4 +6999,9 @@ type WeeklyAutoScalingSchedule struct {
 
 type metadataWeeklyAutoScalingSchedule struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the WeeklyAutoScalingSchedule.
+func (s WeeklyAutoScalingSchedule) String() string {
+	return awsutil.StringValue(s)
 }
\ No newline at end of file
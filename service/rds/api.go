@@ -4,9 +4,11 @@
 package rds
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // AddSourceIdentifierToSubscriptionRequest generates a request for the AddSourceIdentifierToSubscription operation.
@@ -634,6 +636,11 @@ func (c *RDS) DescribeDBEngineVersionsRequest(input *DescribeDBEngineVersionsInp
 			Name:       "DescribeDBEngineVersions",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -651,6 +658,16 @@ func (c *RDS) DescribeDBEngineVersions(input *DescribeDBEngineVersionsInput) (ou
 	return
 }
 
+// DescribeDBEngineVersionsPages iterates over the pages of a DescribeDBEngineVersions operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *RDS) DescribeDBEngineVersionsPages(input *DescribeDBEngineVersionsInput, fn func(p *DescribeDBEngineVersionsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeDBEngineVersionsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeDBEngineVersionsOutput), lastPage)
+	})
+}
+
 var opDescribeDBEngineVersions *aws.Operation
 
 // DescribeDBInstancesRequest generates a request for the DescribeDBInstances operation.
@@ -660,6 +677,11 @@ func (c *RDS) DescribeDBInstancesRequest(input *DescribeDBInstancesInput) (req *
 			Name:       "DescribeDBInstances",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -677,6 +699,16 @@ func (c *RDS) DescribeDBInstances(input *DescribeDBInstancesInput) (output *Desc
 	return
 }
 
+// DescribeDBInstancesPages iterates over the pages of a DescribeDBInstances operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *RDS) DescribeDBInstancesPages(input *DescribeDBInstancesInput, fn func(p *DescribeDBInstancesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeDBInstancesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeDBInstancesOutput), lastPage)
+	})
+}
+
 var opDescribeDBInstances *aws.Operation
 
 // DescribeDBLogFilesRequest generates a request for the DescribeDBLogFiles operation.
@@ -686,6 +718,11 @@ func (c *RDS) DescribeDBLogFilesRequest(input *DescribeDBLogFilesInput) (req *aw
 			Name:       "DescribeDBLogFiles",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -703,6 +740,16 @@ func (c *RDS) DescribeDBLogFiles(input *DescribeDBLogFilesInput) (output *Descri
 	return
 }
 
+// DescribeDBLogFilesPages iterates over the pages of a DescribeDBLogFiles operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *RDS) DescribeDBLogFilesPages(input *DescribeDBLogFilesInput, fn func(p *DescribeDBLogFilesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeDBLogFilesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeDBLogFilesOutput), lastPage)
+	})
+}
+
 var opDescribeDBLogFiles *aws.Operation
 
 // DescribeDBParameterGroupsRequest generates a request for the DescribeDBParameterGroups operation.
@@ -712,6 +759,11 @@ func (c *RDS) DescribeDBParameterGroupsRequest(input *DescribeDBParameterGroupsI
 			Name:       "DescribeDBParameterGroups",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -731,6 +783,16 @@ func (c *RDS) DescribeDBParameterGroups(input *DescribeDBParameterGroupsInput) (
 	return
 }
 
+// DescribeDBParameterGroupsPages iterates over the pages of a DescribeDBParameterGroups operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *RDS) DescribeDBParameterGroupsPages(input *DescribeDBParameterGroupsInput, fn func(p *DescribeDBParameterGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeDBParameterGroupsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeDBParameterGroupsOutput), lastPage)
+	})
+}
+
 var opDescribeDBParameterGroups *aws.Operation
 
 // DescribeDBParametersRequest generates a request for the DescribeDBParameters operation.
@@ -740,6 +802,11 @@ func (c *RDS) DescribeDBParametersRequest(input *DescribeDBParametersInput) (req
 			Name:       "DescribeDBParameters",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -757,6 +824,16 @@ func (c *RDS) DescribeDBParameters(input *DescribeDBParametersInput) (output *De
 	return
 }
 
+// DescribeDBParametersPages iterates over the pages of a DescribeDBParameters operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *RDS) DescribeDBParametersPages(input *DescribeDBParametersInput, fn func(p *DescribeDBParametersOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeDBParametersRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeDBParametersOutput), lastPage)
+	})
+}
+
 var opDescribeDBParameters *aws.Operation
 
 // DescribeDBSecurityGroupsRequest generates a request for the DescribeDBSecurityGroups operation.
@@ -766,6 +843,11 @@ func (c *RDS) DescribeDBSecurityGroupsRequest(input *DescribeDBSecurityGroupsInp
 			Name:       "DescribeDBSecurityGroups",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -785,6 +867,16 @@ func (c *RDS) DescribeDBSecurityGroups(input *DescribeDBSecurityGroupsInput) (ou
 	return
 }
 
+// DescribeDBSecurityGroupsPages iterates over the pages of a DescribeDBSecurityGroups operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *RDS) DescribeDBSecurityGroupsPages(input *DescribeDBSecurityGroupsInput, fn func(p *DescribeDBSecurityGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeDBSecurityGroupsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeDBSecurityGroupsOutput), lastPage)
+	})
+}
+
 var opDescribeDBSecurityGroups *aws.Operation
 
 // DescribeDBSnapshotsRequest generates a request for the DescribeDBSnapshots operation.
@@ -794,6 +886,11 @@ func (c *RDS) DescribeDBSnapshotsRequest(input *DescribeDBSnapshotsInput) (req *
 			Name:       "DescribeDBSnapshots",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -811,6 +908,16 @@ func (c *RDS) DescribeDBSnapshots(input *DescribeDBSnapshotsInput) (output *Desc
 	return
 }
 
+// DescribeDBSnapshotsPages iterates over the pages of a DescribeDBSnapshots operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *RDS) DescribeDBSnapshotsPages(input *DescribeDBSnapshotsInput, fn func(p *DescribeDBSnapshotsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeDBSnapshotsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeDBSnapshotsOutput), lastPage)
+	})
+}
+
 var opDescribeDBSnapshots *aws.Operation
 
 // DescribeDBSubnetGroupsRequest generates a request for the DescribeDBSubnetGroups operation.
@@ -820,6 +927,11 @@ func (c *RDS) DescribeDBSubnetGroupsRequest(input *DescribeDBSubnetGroupsInput)
 			Name:       "DescribeDBSubnetGroups",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -840,6 +952,16 @@ func (c *RDS) DescribeDBSubnetGroups(input *DescribeDBSubnetGroupsInput) (output
 	return
 }
 
+// DescribeDBSubnetGroupsPages iterates over the pages of a DescribeDBSubnetGroups operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *RDS) DescribeDBSubnetGroupsPages(input *DescribeDBSubnetGroupsInput, fn func(p *DescribeDBSubnetGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeDBSubnetGroupsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeDBSubnetGroupsOutput), lastPage)
+	})
+}
+
 var opDescribeDBSubnetGroups *aws.Operation
 
 // DescribeEngineDefaultParametersRequest generates a request for the DescribeEngineDefaultParameters operation.
@@ -849,6 +971,11 @@ func (c *RDS) DescribeEngineDefaultParametersRequest(input *DescribeEngineDefaul
 			Name:       "DescribeEngineDefaultParameters",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"EngineDefaults.Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -867,6 +994,16 @@ func (c *RDS) DescribeEngineDefaultParameters(input *DescribeEngineDefaultParame
 	return
 }
 
+// DescribeEngineDefaultParametersPages iterates over the pages of a DescribeEngineDefaultParameters operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *RDS) DescribeEngineDefaultParametersPages(input *DescribeEngineDefaultParametersInput, fn func(p *DescribeEngineDefaultParametersOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeEngineDefaultParametersRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeEngineDefaultParametersOutput), lastPage)
+	})
+}
+
 var opDescribeEngineDefaultParameters *aws.Operation
 
 // DescribeEventCategoriesRequest generates a request for the DescribeEventCategories operation.
@@ -905,6 +1042,11 @@ func (c *RDS) DescribeEventSubscriptionsRequest(input *DescribeEventSubscription
 			Name:       "DescribeEventSubscriptions",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -926,6 +1068,16 @@ func (c *RDS) DescribeEventSubscriptions(input *DescribeEventSubscriptionsInput)
 	return
 }
 
+// DescribeEventSubscriptionsPages iterates over the pages of a DescribeEventSubscriptions operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *RDS) DescribeEventSubscriptionsPages(input *DescribeEventSubscriptionsInput, fn func(p *DescribeEventSubscriptionsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeEventSubscriptionsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeEventSubscriptionsOutput), lastPage)
+	})
+}
+
 var opDescribeEventSubscriptions *aws.Operation
 
 // DescribeEventsRequest generates a request for the DescribeEvents operation.
@@ -935,6 +1087,11 @@ func (c *RDS) DescribeEventsRequest(input *DescribeEventsInput) (req *aws.Reques
 			Name:       "DescribeEvents",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -956,6 +1113,16 @@ func (c *RDS) DescribeEvents(input *DescribeEventsInput) (output *DescribeEvents
 	return
 }
 
+// DescribeEventsPages iterates over the pages of a DescribeEvents operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *RDS) DescribeEventsPages(input *DescribeEventsInput, fn func(p *DescribeEventsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeEventsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeEventsOutput), lastPage)
+	})
+}
+
 var opDescribeEvents *aws.Operation
 
 // DescribeOptionGroupOptionsRequest generates a request for the DescribeOptionGroupOptions operation.
@@ -965,6 +1132,11 @@ func (c *RDS) DescribeOptionGroupOptionsRequest(input *DescribeOptionGroupOption
 			Name:       "DescribeOptionGroupOptions",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -982,6 +1154,16 @@ func (c *RDS) DescribeOptionGroupOptions(input *DescribeOptionGroupOptionsInput)
 	return
 }
 
+// DescribeOptionGroupOptionsPages iterates over the pages of a DescribeOptionGroupOptions operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *RDS) DescribeOptionGroupOptionsPages(input *DescribeOptionGroupOptionsInput, fn func(p *DescribeOptionGroupOptionsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeOptionGroupOptionsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeOptionGroupOptionsOutput), lastPage)
+	})
+}
+
 var opDescribeOptionGroupOptions *aws.Operation
 
 // DescribeOptionGroupsRequest generates a request for the DescribeOptionGroups operation.
@@ -991,6 +1173,11 @@ func (c *RDS) DescribeOptionGroupsRequest(input *DescribeOptionGroupsInput) (req
 			Name:       "DescribeOptionGroups",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -1008,6 +1195,16 @@ func (c *RDS) DescribeOptionGroups(input *DescribeOptionGroupsInput) (output *De
 	return
 }
 
+// DescribeOptionGroupsPages iterates over the pages of a DescribeOptionGroups operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *RDS) DescribeOptionGroupsPages(input *DescribeOptionGroupsInput, fn func(p *DescribeOptionGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeOptionGroupsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeOptionGroupsOutput), lastPage)
+	})
+}
+
 var opDescribeOptionGroups *aws.Operation
 
 // DescribeOrderableDBInstanceOptionsRequest generates a request for the DescribeOrderableDBInstanceOptions operation.
@@ -1017,6 +1214,11 @@ func (c *RDS) DescribeOrderableDBInstanceOptionsRequest(input *DescribeOrderable
 			Name:       "DescribeOrderableDBInstanceOptions",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -1034,6 +1236,16 @@ func (c *RDS) DescribeOrderableDBInstanceOptions(input *DescribeOrderableDBInsta
 	return
 }
 
+// DescribeOrderableDBInstanceOptionsPages iterates over the pages of a DescribeOrderableDBInstanceOptions operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *RDS) DescribeOrderableDBInstanceOptionsPages(input *DescribeOrderableDBInstanceOptionsInput, fn func(p *DescribeOrderableDBInstanceOptionsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeOrderableDBInstanceOptionsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeOrderableDBInstanceOptionsOutput), lastPage)
+	})
+}
+
 var opDescribeOrderableDBInstanceOptions *aws.Operation
 
 // DescribeReservedDBInstancesRequest generates a request for the DescribeReservedDBInstances operation.
@@ -1043,6 +1255,11 @@ func (c *RDS) DescribeReservedDBInstancesRequest(input *DescribeReservedDBInstan
 			Name:       "DescribeReservedDBInstances",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -1061,6 +1278,16 @@ func (c *RDS) DescribeReservedDBInstances(input *DescribeReservedDBInstancesInpu
 	return
 }
 
+// DescribeReservedDBInstancesPages iterates over the pages of a DescribeReservedDBInstances operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *RDS) DescribeReservedDBInstancesPages(input *DescribeReservedDBInstancesInput, fn func(p *DescribeReservedDBInstancesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeReservedDBInstancesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeReservedDBInstancesOutput), lastPage)
+	})
+}
+
 var opDescribeReservedDBInstances *aws.Operation
 
 // DescribeReservedDBInstancesOfferingsRequest generates a request for the DescribeReservedDBInstancesOfferings operation.
@@ -1070,6 +1297,11 @@ func (c *RDS) DescribeReservedDBInstancesOfferingsRequest(input *DescribeReserve
 			Name:       "DescribeReservedDBInstancesOfferings",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -1087,6 +1319,16 @@ func (c *RDS) DescribeReservedDBInstancesOfferings(input *DescribeReservedDBInst
 	return
 }
 
+// DescribeReservedDBInstancesOfferingsPages iterates over the pages of a DescribeReservedDBInstancesOfferings operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *RDS) DescribeReservedDBInstancesOfferingsPages(input *DescribeReservedDBInstancesOfferingsInput, fn func(p *DescribeReservedDBInstancesOfferingsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeReservedDBInstancesOfferingsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeReservedDBInstancesOfferingsOutput), lastPage)
+	})
+}
+
 var opDescribeReservedDBInstancesOfferings *aws.Operation
 
 // DownloadDBLogFilePortionRequest generates a request for the DownloadDBLogFilePortion operation.
@@ -1096,6 +1338,12 @@ func (c *RDS) DownloadDBLogFilePortionRequest(input *DownloadDBLogFilePortionInp
 			Name:       "DownloadDBLogFilePortion",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"Marker"},
+				LimitToken:      "NumberOfLines",
+				TruncationToken: "AdditionalDataPending",
+			},
 		}
 	}
 
@@ -1113,6 +1361,16 @@ func (c *RDS) DownloadDBLogFilePortion(input *DownloadDBLogFilePortionInput) (ou
 	return
 }
 
+// DownloadDBLogFilePortionPages iterates over the pages of a DownloadDBLogFilePortion operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *RDS) DownloadDBLogFilePortionPages(input *DownloadDBLogFilePortionInput, fn func(p *DownloadDBLogFilePortionOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DownloadDBLogFilePortionRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DownloadDBLogFilePortionOutput), lastPage)
+	})
+}
+
 var opDownloadDBLogFilePortion *aws.Operation
 
 // ListTagsForResourceRequest generates a request for the ListTagsForResource operation.
@@ -1591,6 +1849,11 @@ type metadataAddSourceIdentifierToSubscriptionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddSourceIdentifierToSubscriptionInput.
+func (s AddSourceIdentifierToSubscriptionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AddSourceIdentifierToSubscriptionOutput struct {
 	// Contains the results of a successful invocation of the DescribeEventSubscriptions
 	// action.
@@ -1603,6 +1866,11 @@ type metadataAddSourceIdentifierToSubscriptionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddSourceIdentifierToSubscriptionOutput.
+func (s AddSourceIdentifierToSubscriptionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AddTagsToResourceInput struct {
 	// The Amazon RDS resource the tags will be added to. This value is an Amazon
 	// Resource Name (ARN). For information about creating an ARN, see  Constructing
@@ -1619,6 +1887,11 @@ type metadataAddTagsToResourceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddTagsToResourceInput.
+func (s AddTagsToResourceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AddTagsToResourceOutput struct {
 	metadataAddTagsToResourceOutput `json:"-", xml:"-"`
 }
@@ -1627,6 +1900,45 @@ type metadataAddTagsToResourceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddTagsToResourceOutput.
+func (s AddTagsToResourceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ApplyMethod is an enum of the values of ApplyMethod members.
+type ApplyMethod string
+
+// The values of ApplyMethod.
+const (
+	ApplyMethodImmediate     ApplyMethod = "immediate"
+	ApplyMethodPendingReboot ApplyMethod = "pending-reboot"
+)
+
+// ApplyMethodValues returns the values of ApplyMethod known to this
+// version of the SDK. The service may accept values added since.
+func ApplyMethodValues() []ApplyMethod {
+	return []ApplyMethod{
+		ApplyMethod("immediate"),
+		ApplyMethod("pending-reboot"),
+	}
+}
+
+// String returns the ApplyMethod as the string of a member.
+func (v ApplyMethod) String() string {
+	return string(v)
+}
+
+// ParseApplyMethod returns the ApplyMethod of str, failing if it is not
+// one of the ApplyMethodValues.
+func ParseApplyMethod(str string) (ApplyMethod, error) {
+	for _, v := range ApplyMethodValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ApplyMethod value %q", str)
+}
+
 type AuthorizeDBSecurityGroupIngressInput struct {
 	// The IP range to authorize.
 	CIDRIP *string `type:"string"`
@@ -1658,6 +1970,11 @@ type metadataAuthorizeDBSecurityGroupIngressInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AuthorizeDBSecurityGroupIngressInput.
+func (s AuthorizeDBSecurityGroupIngressInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AuthorizeDBSecurityGroupIngressOutput struct {
 	// Contains the result of a successful invocation of the following actions:
 	//
@@ -1673,6 +1990,11 @@ type metadataAuthorizeDBSecurityGroupIngressOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AuthorizeDBSecurityGroupIngressOutput.
+func (s AuthorizeDBSecurityGroupIngressOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains Availability Zone information.
 //
 //  This data type is used as an element in the following data type:  OrderableDBInstanceOption
@@ -1687,6 +2009,11 @@ type metadataAvailabilityZone struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AvailabilityZone.
+func (s AvailabilityZone) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This data type is used as a response element in the action DescribeDBEngineVersions.
 type CharacterSet struct {
 	// The description of the character set.
@@ -1702,6 +2029,11 @@ type metadataCharacterSet struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CharacterSet.
+func (s CharacterSet) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CopyDBParameterGroupInput struct {
 	// The identifier or ARN for the source DB Parameter Group.
 	//
@@ -1737,6 +2069,11 @@ type metadataCopyDBParameterGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CopyDBParameterGroupInput.
+func (s CopyDBParameterGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CopyDBParameterGroupOutput struct {
 	// Contains the result of a successful invocation of the CreateDBParameterGroup
 	// action.
@@ -1752,6 +2089,11 @@ type metadataCopyDBParameterGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CopyDBParameterGroupOutput.
+func (s CopyDBParameterGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CopyDBSnapshotInput struct {
 	// The identifier for the source DB snapshot.
 	//
@@ -1786,6 +2128,11 @@ type metadataCopyDBSnapshotInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CopyDBSnapshotInput.
+func (s CopyDBSnapshotInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CopyDBSnapshotOutput struct {
 	// Contains the result of a successful invocation of the following actions:
 	//
@@ -1800,6 +2147,11 @@ type metadataCopyDBSnapshotOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CopyDBSnapshotOutput.
+func (s CopyDBSnapshotOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CopyOptionGroupInput struct {
 	// The identifier or ARN for the source Option Group.
 	//
@@ -1835,6 +2187,11 @@ type metadataCopyOptionGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CopyOptionGroupInput.
+func (s CopyOptionGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CopyOptionGroupOutput struct {
 	OptionGroup *OptionGroup `type:"structure"`
 
@@ -1845,6 +2202,11 @@ type metadataCopyOptionGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CopyOptionGroupOutput.
+func (s CopyOptionGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateDBInstanceInput struct {
 	// The amount of storage (in gigabytes) to be initially allocated for the database
 	// instance.
@@ -2171,6 +2533,11 @@ type metadataCreateDBInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateDBInstanceInput.
+func (s CreateDBInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateDBInstanceOutput struct {
 	// Contains the result of a successful invocation of the following actions:
 	//
@@ -2185,6 +2552,11 @@ type metadataCreateDBInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateDBInstanceOutput.
+func (s CreateDBInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateDBInstanceReadReplicaInput struct {
 	// Indicates that minor engine upgrades will be applied automatically to the
 	// read replica during the maintenance window.
@@ -2290,6 +2662,11 @@ type metadataCreateDBInstanceReadReplicaInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateDBInstanceReadReplicaInput.
+func (s CreateDBInstanceReadReplicaInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateDBInstanceReadReplicaOutput struct {
 	// Contains the result of a successful invocation of the following actions:
 	//
@@ -2304,6 +2681,11 @@ type metadataCreateDBInstanceReadReplicaOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateDBInstanceReadReplicaOutput.
+func (s CreateDBInstanceReadReplicaOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateDBParameterGroupInput struct {
 	// The DB parameter group family name. A DB parameter group can be associated
 	// with one and only one DB parameter group family, and can be applied only
@@ -2332,6 +2714,11 @@ type metadataCreateDBParameterGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateDBParameterGroupInput.
+func (s CreateDBParameterGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateDBParameterGroupOutput struct {
 	// Contains the result of a successful invocation of the CreateDBParameterGroup
 	// action.
@@ -2347,6 +2734,11 @@ type metadataCreateDBParameterGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateDBParameterGroupOutput.
+func (s CreateDBParameterGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateDBSecurityGroupInput struct {
 	// The description for the DB security group.
 	DBSecurityGroupDescription *string `type:"string" required:"true"`
@@ -2370,6 +2762,11 @@ type metadataCreateDBSecurityGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateDBSecurityGroupInput.
+func (s CreateDBSecurityGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateDBSecurityGroupOutput struct {
 	// Contains the result of a successful invocation of the following actions:
 	//
@@ -2385,6 +2782,11 @@ type metadataCreateDBSecurityGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateDBSecurityGroupOutput.
+func (s CreateDBSecurityGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateDBSnapshotInput struct {
 	// The DB instance identifier. This is the unique key that identifies a DB instance.
 	//
@@ -2413,6 +2815,11 @@ type metadataCreateDBSnapshotInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateDBSnapshotInput.
+func (s CreateDBSnapshotInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateDBSnapshotOutput struct {
 	// Contains the result of a successful invocation of the following actions:
 	//
@@ -2427,6 +2834,11 @@ type metadataCreateDBSnapshotOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateDBSnapshotOutput.
+func (s CreateDBSnapshotOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateDBSubnetGroupInput struct {
 	// The description for the DB subnet group.
 	DBSubnetGroupDescription *string `type:"string" required:"true"`
@@ -2452,6 +2864,11 @@ type metadataCreateDBSubnetGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateDBSubnetGroupInput.
+func (s CreateDBSubnetGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateDBSubnetGroupOutput struct {
 	// Contains the result of a successful invocation of the following actions:
 	//
@@ -2467,6 +2884,11 @@ type metadataCreateDBSubnetGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateDBSubnetGroupOutput.
+func (s CreateDBSubnetGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateEventSubscriptionInput struct {
 	// A Boolean value; set to true to activate the subscription, set to false to
 	// create the subscription but not active it.
@@ -2522,6 +2944,11 @@ type metadataCreateEventSubscriptionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateEventSubscriptionInput.
+func (s CreateEventSubscriptionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateEventSubscriptionOutput struct {
 	// Contains the results of a successful invocation of the DescribeEventSubscriptions
 	// action.
@@ -2534,6 +2961,11 @@ type metadataCreateEventSubscriptionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateEventSubscriptionOutput.
+func (s CreateEventSubscriptionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateOptionGroupInput struct {
 	// Specifies the name of the engine that this option group should be associated
 	// with.
@@ -2565,6 +2997,11 @@ type metadataCreateOptionGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateOptionGroupInput.
+func (s CreateOptionGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateOptionGroupOutput struct {
 	OptionGroup *OptionGroup `type:"structure"`
 
@@ -2575,6 +3012,11 @@ type metadataCreateOptionGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateOptionGroupOutput.
+func (s CreateOptionGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This data type is used as a response element in the action DescribeDBEngineVersions.
 type DBEngineVersion struct {
 	// The description of the database engine.
@@ -2607,6 +3049,11 @@ type metadataDBEngineVersion struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DBEngineVersion.
+func (s DBEngineVersion) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the result of a successful invocation of the following actions:
 //
 //  CreateDBInstance DeleteDBInstance ModifyDBInstance  This data type is used
@@ -2760,6 +3207,11 @@ type metadataDBInstance struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DBInstance.
+func (s DBInstance) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides a list of status information for a DB instance.
 type DBInstanceStatusInfo struct {
 	// Details of the error if there is an error for the instance. If the instance
@@ -2784,6 +3236,11 @@ type metadataDBInstanceStatusInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DBInstanceStatusInfo.
+func (s DBInstanceStatusInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the result of a successful invocation of the CreateDBParameterGroup
 // action.
 //
@@ -2807,6 +3264,11 @@ type metadataDBParameterGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DBParameterGroup.
+func (s DBParameterGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the result of a successful invocation of the ModifyDBParameterGroup
 // or ResetDBParameterGroup action.
 type DBParameterGroupNameMessage struct {
@@ -2820,6 +3282,11 @@ type metadataDBParameterGroupNameMessage struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DBParameterGroupNameMessage.
+func (s DBParameterGroupNameMessage) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The status of the DB parameter group.
 //
 // This data type is used as a response element in the following actions:
@@ -2840,6 +3307,11 @@ type metadataDBParameterGroupStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DBParameterGroupStatus.
+func (s DBParameterGroupStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the result of a successful invocation of the following actions:
 //
 //  DescribeDBSecurityGroups AuthorizeDBSecurityGroupIngress CreateDBSecurityGroup
@@ -2871,6 +3343,11 @@ type metadataDBSecurityGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DBSecurityGroup.
+func (s DBSecurityGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This data type is used as a response element in the following actions:
 //
 //  ModifyDBInstance RebootDBInstance RestoreDBInstanceFromDBSnapshot RestoreDBInstanceToPointInTime
@@ -2888,6 +3365,11 @@ type metadataDBSecurityGroupMembership struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DBSecurityGroupMembership.
+func (s DBSecurityGroupMembership) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the result of a successful invocation of the following actions:
 //
 //  CreateDBSnapshot DeleteDBSnapshot  This data type is used as a response
@@ -2964,6 +3446,11 @@ type metadataDBSnapshot struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DBSnapshot.
+func (s DBSnapshot) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the result of a successful invocation of the following actions:
 //
 //  CreateDBSubnetGroup ModifyDBSubnetGroup DescribeDBSubnetGroups DeleteDBSubnetGroup
@@ -2992,6 +3479,11 @@ type metadataDBSubnetGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DBSubnetGroup.
+func (s DBSubnetGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteDBInstanceInput struct {
 	// The DB instance identifier for the DB instance to be deleted. This parameter
 	// isn't case sensitive.
@@ -3028,6 +3520,11 @@ type metadataDeleteDBInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteDBInstanceInput.
+func (s DeleteDBInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteDBInstanceOutput struct {
 	// Contains the result of a successful invocation of the following actions:
 	//
@@ -3042,6 +3539,11 @@ type metadataDeleteDBInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteDBInstanceOutput.
+func (s DeleteDBInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteDBParameterGroupInput struct {
 	// The name of the DB parameter group.
 	//
@@ -3058,6 +3560,11 @@ type metadataDeleteDBParameterGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteDBParameterGroupInput.
+func (s DeleteDBParameterGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteDBParameterGroupOutput struct {
 	metadataDeleteDBParameterGroupOutput `json:"-", xml:"-"`
 }
@@ -3066,6 +3573,11 @@ type metadataDeleteDBParameterGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteDBParameterGroupOutput.
+func (s DeleteDBParameterGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteDBSecurityGroupInput struct {
 	// The name of the DB security group to delete.
 	//
@@ -3083,6 +3595,11 @@ type metadataDeleteDBSecurityGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteDBSecurityGroupInput.
+func (s DeleteDBSecurityGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteDBSecurityGroupOutput struct {
 	metadataDeleteDBSecurityGroupOutput `json:"-", xml:"-"`
 }
@@ -3091,6 +3608,11 @@ type metadataDeleteDBSecurityGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteDBSecurityGroupOutput.
+func (s DeleteDBSecurityGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteDBSnapshotInput struct {
 	// The DBSnapshot identifier.
 	//
@@ -3105,6 +3627,11 @@ type metadataDeleteDBSnapshotInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteDBSnapshotInput.
+func (s DeleteDBSnapshotInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteDBSnapshotOutput struct {
 	// Contains the result of a successful invocation of the following actions:
 	//
@@ -3119,6 +3646,11 @@ type metadataDeleteDBSnapshotOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteDBSnapshotOutput.
+func (s DeleteDBSnapshotOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteDBSubnetGroupInput struct {
 	// The name of the database subnet group to delete.
 	//
@@ -3135,6 +3667,11 @@ type metadataDeleteDBSubnetGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteDBSubnetGroupInput.
+func (s DeleteDBSubnetGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteDBSubnetGroupOutput struct {
 	metadataDeleteDBSubnetGroupOutput `json:"-", xml:"-"`
 }
@@ -3143,6 +3680,11 @@ type metadataDeleteDBSubnetGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteDBSubnetGroupOutput.
+func (s DeleteDBSubnetGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteEventSubscriptionInput struct {
 	// The name of the RDS event notification subscription you want to delete.
 	SubscriptionName *string `type:"string" required:"true"`
@@ -3154,6 +3696,11 @@ type metadataDeleteEventSubscriptionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteEventSubscriptionInput.
+func (s DeleteEventSubscriptionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteEventSubscriptionOutput struct {
 	// Contains the results of a successful invocation of the DescribeEventSubscriptions
 	// action.
@@ -3166,6 +3713,11 @@ type metadataDeleteEventSubscriptionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteEventSubscriptionOutput.
+func (s DeleteEventSubscriptionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteOptionGroupInput struct {
 	// The name of the option group to be deleted.
 	OptionGroupName *string `type:"string" required:"true"`
@@ -3177,6 +3729,11 @@ type metadataDeleteOptionGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteOptionGroupInput.
+func (s DeleteOptionGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteOptionGroupOutput struct {
 	metadataDeleteOptionGroupOutput `json:"-", xml:"-"`
 }
@@ -3185,6 +3742,11 @@ type metadataDeleteOptionGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteOptionGroupOutput.
+func (s DeleteOptionGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeDBEngineVersionsInput struct {
 	// The name of a specific DB parameter group family to return details for.
 	//
@@ -3235,6 +3797,11 @@ type metadataDescribeDBEngineVersionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeDBEngineVersionsInput.
+func (s DescribeDBEngineVersionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the result of a successful invocation of the DescribeDBEngineVersions
 // action.
 type DescribeDBEngineVersionsOutput struct {
@@ -3253,6 +3820,11 @@ type metadataDescribeDBEngineVersionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeDBEngineVersionsOutput.
+func (s DescribeDBEngineVersionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeDBInstancesInput struct {
 	// The user-supplied instance identifier. If this parameter is specified, information
 	// from only the specific DB instance is returned. This parameter isn't case
@@ -3288,6 +3860,11 @@ type metadataDescribeDBInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeDBInstancesInput.
+func (s DescribeDBInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the result of a successful invocation of the DescribeDBInstances
 // action.
 type DescribeDBInstancesOutput struct {
@@ -3306,6 +3883,11 @@ type metadataDescribeDBInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeDBInstancesOutput.
+func (s DescribeDBInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This data type is used as a response element to DescribeDBLogFiles.
 type DescribeDBLogFilesDetails struct {
 	// A POSIX timestamp when the last log entry was written.
@@ -3324,6 +3906,11 @@ type metadataDescribeDBLogFilesDetails struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeDBLogFilesDetails.
+func (s DescribeDBLogFilesDetails) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeDBLogFilesInput struct {
 	// The customer-assigned name of the DB instance that contains the log files
 	// you want to list.
@@ -3365,6 +3952,11 @@ type metadataDescribeDBLogFilesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeDBLogFilesInput.
+func (s DescribeDBLogFilesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The response from a call to DescribeDBLogFiles.
 type DescribeDBLogFilesOutput struct {
 	// The DB log files returned.
@@ -3380,6 +3972,11 @@ type metadataDescribeDBLogFilesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeDBLogFilesOutput.
+func (s DescribeDBLogFilesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeDBParameterGroupsInput struct {
 	// The name of a specific DB parameter group to return details for.
 	//
@@ -3413,6 +4010,11 @@ type metadataDescribeDBParameterGroupsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeDBParameterGroupsInput.
+func (s DescribeDBParameterGroupsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the result of a successful invocation of the DescribeDBParameterGroups
 // action.
 type DescribeDBParameterGroupsOutput struct {
@@ -3431,6 +4033,11 @@ type metadataDescribeDBParameterGroupsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeDBParameterGroupsOutput.
+func (s DescribeDBParameterGroupsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeDBParametersInput struct {
 	// The name of a specific DB parameter group to return details for.
 	//
@@ -3471,6 +4078,11 @@ type metadataDescribeDBParametersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeDBParametersInput.
+func (s DescribeDBParametersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the result of a successful invocation of the DescribeDBParameters
 // action.
 type DescribeDBParametersOutput struct {
@@ -3489,6 +4101,11 @@ type metadataDescribeDBParametersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeDBParametersOutput.
+func (s DescribeDBParametersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeDBSecurityGroupsInput struct {
 	// The name of the DB security group to return details for.
 	DBSecurityGroupName *string `type:"string"`
@@ -3517,6 +4134,11 @@ type metadataDescribeDBSecurityGroupsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeDBSecurityGroupsInput.
+func (s DescribeDBSecurityGroupsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the result of a successful invocation of the DescribeDBSecurityGroups
 // action.
 type DescribeDBSecurityGroupsOutput struct {
@@ -3535,6 +4157,11 @@ type metadataDescribeDBSecurityGroupsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeDBSecurityGroupsOutput.
+func (s DescribeDBSecurityGroupsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeDBSnapshotsInput struct {
 	// A DB instance identifier to retrieve the list of DB snapshots for. Cannot
 	// be used in conjunction with DBSnapshotIdentifier. This parameter is not case
@@ -3586,6 +4213,11 @@ type metadataDescribeDBSnapshotsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeDBSnapshotsInput.
+func (s DescribeDBSnapshotsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the result of a successful invocation of the DescribeDBSnapshots
 // action.
 type DescribeDBSnapshotsOutput struct {
@@ -3604,6 +4236,11 @@ type metadataDescribeDBSnapshotsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeDBSnapshotsOutput.
+func (s DescribeDBSnapshotsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeDBSubnetGroupsInput struct {
 	// The name of the DB subnet group to return details for.
 	DBSubnetGroupName *string `type:"string"`
@@ -3632,6 +4269,11 @@ type metadataDescribeDBSubnetGroupsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeDBSubnetGroupsInput.
+func (s DescribeDBSubnetGroupsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the result of a successful invocation of the DescribeDBSubnetGroups
 // action.
 type DescribeDBSubnetGroupsOutput struct {
@@ -3650,6 +4292,11 @@ type metadataDescribeDBSubnetGroupsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeDBSubnetGroupsOutput.
+func (s DescribeDBSubnetGroupsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeEngineDefaultParametersInput struct {
 	// The name of the DB parameter group family.
 	DBParameterGroupFamily *string `type:"string" required:"true"`
@@ -3678,6 +4325,11 @@ type metadataDescribeEngineDefaultParametersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeEngineDefaultParametersInput.
+func (s DescribeEngineDefaultParametersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeEngineDefaultParametersOutput struct {
 	// Contains the result of a successful invocation of the DescribeEngineDefaultParameters
 	// action.
@@ -3690,6 +4342,11 @@ type metadataDescribeEngineDefaultParametersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeEngineDefaultParametersOutput.
+func (s DescribeEngineDefaultParametersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeEventCategoriesInput struct {
 	// This parameter is not currently supported.
 	Filters []*Filter `locationNameList:"Filter" type:"list"`
@@ -3706,6 +4363,11 @@ type metadataDescribeEventCategoriesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeEventCategoriesInput.
+func (s DescribeEventCategoriesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Data returned from the DescribeEventCategories action.
 type DescribeEventCategoriesOutput struct {
 	// A list of EventCategoriesMap data types.
@@ -3718,6 +4380,11 @@ type metadataDescribeEventCategoriesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeEventCategoriesOutput.
+func (s DescribeEventCategoriesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeEventSubscriptionsInput struct {
 	// This parameter is not currently supported.
 	Filters []*Filter `locationNameList:"Filter" type:"list"`
@@ -3746,6 +4413,11 @@ type metadataDescribeEventSubscriptionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeEventSubscriptionsInput.
+func (s DescribeEventSubscriptionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Data returned by the DescribeEventSubscriptions action.
 type DescribeEventSubscriptionsOutput struct {
 	// A list of EventSubscriptions data types.
@@ -3763,6 +4435,11 @@ type metadataDescribeEventSubscriptionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeEventSubscriptionsOutput.
+func (s DescribeEventSubscriptionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeEventsInput struct {
 	// The number of minutes to retrieve events for.
 	//
@@ -3812,7 +4489,7 @@ type DescribeEventsInput struct {
 
 	// The event source to retrieve events for. If no value is specified, all events
 	// are returned.
-	SourceType *string `type:"string"`
+	SourceType *string `type:"string" enum:"db-instance,db-parameter-group,db-security-group,db-snapshot"`
 
 	// The beginning of the time interval to retrieve events for, specified in ISO
 	// 8601 format. For more information about ISO 8601, go to the ISO8601 Wikipedia
@@ -3828,6 +4505,11 @@ type metadataDescribeEventsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeEventsInput.
+func (s DescribeEventsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the result of a successful invocation of the DescribeEvents action.
 type DescribeEventsOutput struct {
 	// A list of Event instances.
@@ -3845,6 +4527,11 @@ type metadataDescribeEventsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeEventsOutput.
+func (s DescribeEventsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeOptionGroupOptionsInput struct {
 	// A required parameter. Options available for the given Engine name will be
 	// described.
@@ -3878,6 +4565,11 @@ type metadataDescribeOptionGroupOptionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeOptionGroupOptionsInput.
+func (s DescribeOptionGroupOptionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeOptionGroupOptionsOutput struct {
 	// An optional pagination token provided by a previous request. If this parameter
 	// is specified, the response includes only records beyond the marker, up to
@@ -3894,6 +4586,11 @@ type metadataDescribeOptionGroupOptionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeOptionGroupOptionsOutput.
+func (s DescribeOptionGroupOptionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeOptionGroupsInput struct {
 	// Filters the list of option groups to only include groups associated with
 	// a specific database engine.
@@ -3932,6 +4629,11 @@ type metadataDescribeOptionGroupsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeOptionGroupsInput.
+func (s DescribeOptionGroupsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // List of option groups.
 type DescribeOptionGroupsOutput struct {
 	// An optional pagination token provided by a previous request. If this parameter
@@ -3949,6 +4651,11 @@ type metadataDescribeOptionGroupsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeOptionGroupsOutput.
+func (s DescribeOptionGroupsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeOrderableDBInstanceOptionsInput struct {
 	// The DB instance class filter value. Specify this parameter to show only the
 	// available offerings matching the specified DB instance class.
@@ -3993,6 +4700,11 @@ type metadataDescribeOrderableDBInstanceOptionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeOrderableDBInstanceOptionsInput.
+func (s DescribeOrderableDBInstanceOptionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the result of a successful invocation of the DescribeOrderableDBInstanceOptions
 // action.
 type DescribeOrderableDBInstanceOptionsOutput struct {
@@ -4012,6 +4724,11 @@ type metadataDescribeOrderableDBInstanceOptionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeOrderableDBInstanceOptionsOutput.
+func (s DescribeOrderableDBInstanceOptionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeReservedDBInstancesInput struct {
 	// The DB instance class filter value. Specify this parameter to show only those
 	// reservations matching the specified DB instances class.
@@ -4069,6 +4786,11 @@ type metadataDescribeReservedDBInstancesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeReservedDBInstancesInput.
+func (s DescribeReservedDBInstancesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeReservedDBInstancesOfferingsInput struct {
 	// The DB instance class filter value. Specify this parameter to show only the
 	// available offerings matching the specified DB instance class.
@@ -4124,6 +4846,11 @@ type metadataDescribeReservedDBInstancesOfferingsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeReservedDBInstancesOfferingsInput.
+func (s DescribeReservedDBInstancesOfferingsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the result of a successful invocation of the DescribeReservedDBInstancesOfferings
 // action.
 type DescribeReservedDBInstancesOfferingsOutput struct {
@@ -4142,6 +4869,11 @@ type metadataDescribeReservedDBInstancesOfferingsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeReservedDBInstancesOfferingsOutput.
+func (s DescribeReservedDBInstancesOfferingsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the result of a successful invocation of the DescribeReservedDBInstances
 // action.
 type DescribeReservedDBInstancesOutput struct {
@@ -4160,6 +4892,11 @@ type metadataDescribeReservedDBInstancesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeReservedDBInstancesOutput.
+func (s DescribeReservedDBInstancesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DownloadDBLogFilePortionInput struct {
 	// The customer-assigned name of the DB instance that contains the log files
 	// you want to list.
@@ -4205,6 +4942,11 @@ type metadataDownloadDBLogFilePortionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DownloadDBLogFilePortionInput.
+func (s DownloadDBLogFilePortionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This data type is used as a response element to DownloadDBLogFilePortion.
 type DownloadDBLogFilePortionOutput struct {
 	// Boolean value that if true, indicates there is more data to be downloaded.
@@ -4224,6 +4966,11 @@ type metadataDownloadDBLogFilePortionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DownloadDBLogFilePortionOutput.
+func (s DownloadDBLogFilePortionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This data type is used as a response element in the following actions:
 //
 //  AuthorizeDBSecurityGroupIngress DescribeDBSecurityGroups RevokeDBSecurityGroupIngress
@@ -4249,6 +4996,11 @@ type metadataEC2SecurityGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EC2SecurityGroup.
+func (s EC2SecurityGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This data type is used as a response element in the following actions:
 //
 //  CreateDBInstance DescribeDBInstances DeleteDBInstance
@@ -4266,6 +5018,11 @@ type metadataEndpoint struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Endpoint.
+func (s Endpoint) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the result of a successful invocation of the DescribeEngineDefaultParameters
 // action.
 type EngineDefaults struct {
@@ -4288,6 +5045,11 @@ type metadataEngineDefaults struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EngineDefaults.
+func (s EngineDefaults) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This data type is used as a response element in the DescribeEvents action.
 type Event struct {
 	// Specifies the date and time of the event.
@@ -4303,7 +5065,7 @@ type Event struct {
 	SourceIdentifier *string `type:"string"`
 
 	// Specifies the source type for this event.
-	SourceType *string `type:"string"`
+	SourceType *string `type:"string" enum:"db-instance,db-parameter-group,db-security-group,db-snapshot"`
 
 	metadataEvent `json:"-", xml:"-"`
 }
@@ -4312,6 +5074,11 @@ type metadataEvent struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Event.
+func (s Event) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the results of a successful invocation of the DescribeEventCategories
 // action.
 type EventCategoriesMap struct {
@@ -4328,6 +5095,11 @@ type metadataEventCategoriesMap struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EventCategoriesMap.
+func (s EventCategoriesMap) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the results of a successful invocation of the DescribeEventSubscriptions
 // action.
 type EventSubscription struct {
@@ -4375,6 +5147,11 @@ type metadataEventSubscription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EventSubscription.
+func (s EventSubscription) String() string {
+	return awsutil.StringValue(s)
+}
+
 type Filter struct {
 	// This parameter is not currently supported.
 	Name *string `type:"string" required:"true"`
@@ -4389,6 +5166,11 @@ type metadataFilter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Filter.
+func (s Filter) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This data type is used as a response element in the DescribeDBSecurityGroups
 // action.
 type IPRange struct {
@@ -4406,6 +5188,11 @@ type metadataIPRange struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the IPRange.
+func (s IPRange) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListTagsForResourceInput struct {
 	// This parameter is not currently supported.
 	Filters []*Filter `locationNameList:"Filter" type:"list"`
@@ -4422,6 +5209,11 @@ type metadataListTagsForResourceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListTagsForResourceInput.
+func (s ListTagsForResourceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListTagsForResourceOutput struct {
 	// List of tags returned by the ListTagsForResource operation.
 	TagList []*Tag `locationNameList:"Tag" type:"list"`
@@ -4433,6 +5225,11 @@ type metadataListTagsForResourceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListTagsForResourceOutput.
+func (s ListTagsForResourceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifyDBInstanceInput struct {
 	// The new storage capacity of the RDS instance. Changing this setting does
 	// not result in an outage and the change is applied during the next maintenance
@@ -4744,6 +5541,11 @@ type metadataModifyDBInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyDBInstanceInput.
+func (s ModifyDBInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifyDBInstanceOutput struct {
 	// Contains the result of a successful invocation of the following actions:
 	//
@@ -4758,6 +5560,11 @@ type metadataModifyDBInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyDBInstanceOutput.
+func (s ModifyDBInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifyDBParameterGroupInput struct {
 	// The name of the DB parameter group.
 	//
@@ -4783,6 +5590,11 @@ type metadataModifyDBParameterGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyDBParameterGroupInput.
+func (s ModifyDBParameterGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifyDBSubnetGroupInput struct {
 	// The description for the DB subnet group.
 	DBSubnetGroupDescription *string `type:"string"`
@@ -4805,6 +5617,11 @@ type metadataModifyDBSubnetGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyDBSubnetGroupInput.
+func (s ModifyDBSubnetGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifyDBSubnetGroupOutput struct {
 	// Contains the result of a successful invocation of the following actions:
 	//
@@ -4820,6 +5637,11 @@ type metadataModifyDBSubnetGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyDBSubnetGroupOutput.
+func (s ModifyDBSubnetGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifyEventSubscriptionInput struct {
 	// A Boolean value; set to true to activate the subscription.
 	Enabled *bool `type:"boolean"`
@@ -4854,6 +5676,11 @@ type metadataModifyEventSubscriptionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyEventSubscriptionInput.
+func (s ModifyEventSubscriptionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifyEventSubscriptionOutput struct {
 	// Contains the results of a successful invocation of the DescribeEventSubscriptions
 	// action.
@@ -4866,6 +5693,11 @@ type metadataModifyEventSubscriptionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyEventSubscriptionOutput.
+func (s ModifyEventSubscriptionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifyOptionGroupInput struct {
 	// Indicates whether the changes should be applied immediately, or during the
 	// next maintenance window for each instance associated with the option group.
@@ -4892,6 +5724,11 @@ type metadataModifyOptionGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyOptionGroupInput.
+func (s ModifyOptionGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifyOptionGroupOutput struct {
 	OptionGroup *OptionGroup `type:"structure"`
 
@@ -4902,6 +5739,11 @@ type metadataModifyOptionGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyOptionGroupOutput.
+func (s ModifyOptionGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Option details.
 type Option struct {
 	// If the option requires access to a port, then this DB security group allows
@@ -4937,6 +5779,11 @@ type metadataOption struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Option.
+func (s Option) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A list of all available options
 type OptionConfiguration struct {
 	// A list of DBSecurityGroupMemebrship name strings used for this option.
@@ -4961,6 +5808,11 @@ type metadataOptionConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OptionConfiguration.
+func (s OptionConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
 type OptionGroup struct {
 	// Indicates whether this option group can be applied to both VPC and non-VPC
 	// instances. The value 'true' indicates the option group can be applied to
@@ -4996,6 +5848,11 @@ type metadataOptionGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OptionGroup.
+func (s OptionGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides information on the option groups the DB instance is a member of.
 type OptionGroupMembership struct {
 	// The name of the option group that the instance belongs to.
@@ -5012,6 +5869,11 @@ type metadataOptionGroupMembership struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OptionGroupMembership.
+func (s OptionGroupMembership) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Available option.
 type OptionGroupOption struct {
 	// If the option requires a port, specifies the default port for the option.
@@ -5060,6 +5922,11 @@ type metadataOptionGroupOption struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OptionGroupOption.
+func (s OptionGroupOption) String() string {
+	return awsutil.StringValue(s)
+}
+
 // option group option settings are used to display settings available for each
 // option with their default values and other information. These values are
 // used with the DescribeOptionGroupOptions action.
@@ -5090,6 +5957,11 @@ type metadataOptionGroupOptionSetting struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OptionGroupOptionSetting.
+func (s OptionGroupOptionSetting) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Option settings are the actual settings being applied or configured for that
 // option. It is used when you modify an option group or describe option groups.
 // For example, the NATIVE_NETWORK_ENCRYPTION option has a setting called SQLNET.ENCRYPTION_SERVER
@@ -5130,6 +6002,11 @@ type metadataOptionSetting struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OptionSetting.
+func (s OptionSetting) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains a list of available options for a DB instance
 //
 //  This data type is used as a response element in the DescribeOrderableDBInstanceOptions
@@ -5172,6 +6049,11 @@ type metadataOrderableDBInstanceOption struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OrderableDBInstanceOption.
+func (s OrderableDBInstanceOption) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This data type is used as a request parameter in the ModifyDBParameterGroup
 // and ResetDBParameterGroup actions.
 //
@@ -5182,7 +6064,7 @@ type Parameter struct {
 	AllowedValues *string `type:"string"`
 
 	// Indicates when to apply parameter updates.
-	ApplyMethod *string `type:"string"`
+	ApplyMethod *string `type:"string" enum:"immediate,pending-reboot"`
 
 	// Specifies the engine specific parameters type.
 	ApplyType *string `type:"string"`
@@ -5217,6 +6099,11 @@ type metadataParameter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Parameter.
+func (s Parameter) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This data type is used as a response element in the ModifyDBInstance action.
 type PendingModifiedValues struct {
 	// Contains the new AllocatedStorage size for the DB instance that will be applied
@@ -5261,6 +6148,11 @@ type metadataPendingModifiedValues struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PendingModifiedValues.
+func (s PendingModifiedValues) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PromoteReadReplicaInput struct {
 	// The number of days to retain automated backups. Setting this parameter to
 	// a positive number enables backups. Setting this parameter to 0 disables automated
@@ -5302,6 +6194,11 @@ type metadataPromoteReadReplicaInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PromoteReadReplicaInput.
+func (s PromoteReadReplicaInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PromoteReadReplicaOutput struct {
 	// Contains the result of a successful invocation of the following actions:
 	//
@@ -5316,6 +6213,11 @@ type metadataPromoteReadReplicaOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PromoteReadReplicaOutput.
+func (s PromoteReadReplicaOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PurchaseReservedDBInstancesOfferingInput struct {
 	// The number of instances to reserve.
 	//
@@ -5342,6 +6244,11 @@ type metadataPurchaseReservedDBInstancesOfferingInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PurchaseReservedDBInstancesOfferingInput.
+func (s PurchaseReservedDBInstancesOfferingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PurchaseReservedDBInstancesOfferingOutput struct {
 	// This data type is used as a response element in the DescribeReservedDBInstances
 	// and PurchaseReservedDBInstancesOffering actions.
@@ -5354,6 +6261,11 @@ type metadataPurchaseReservedDBInstancesOfferingOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PurchaseReservedDBInstancesOfferingOutput.
+func (s PurchaseReservedDBInstancesOfferingOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RebootDBInstanceInput struct {
 	// The DB instance identifier. This parameter is stored as a lowercase string.
 	//
@@ -5376,6 +6288,11 @@ type metadataRebootDBInstanceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RebootDBInstanceInput.
+func (s RebootDBInstanceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RebootDBInstanceOutput struct {
 	// Contains the result of a successful invocation of the following actions:
 	//
@@ -5390,6 +6307,11 @@ type metadataRebootDBInstanceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RebootDBInstanceOutput.
+func (s RebootDBInstanceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This data type is used as a response element in the DescribeReservedDBInstances
 // and DescribeReservedDBInstancesOfferings actions.
 type RecurringCharge struct {
@@ -5406,6 +6328,11 @@ type metadataRecurringCharge struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RecurringCharge.
+func (s RecurringCharge) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RemoveSourceIdentifierFromSubscriptionInput struct {
 	// The source identifier to be removed from the subscription, such as the DB
 	// instance identifier for a DB instance or the name of a security group.
@@ -5422,6 +6349,11 @@ type metadataRemoveSourceIdentifierFromSubscriptionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RemoveSourceIdentifierFromSubscriptionInput.
+func (s RemoveSourceIdentifierFromSubscriptionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RemoveSourceIdentifierFromSubscriptionOutput struct {
 	// Contains the results of a successful invocation of the DescribeEventSubscriptions
 	// action.
@@ -5434,6 +6366,11 @@ type metadataRemoveSourceIdentifierFromSubscriptionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RemoveSourceIdentifierFromSubscriptionOutput.
+func (s RemoveSourceIdentifierFromSubscriptionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RemoveTagsFromResourceInput struct {
 	// The Amazon RDS resource the tags will be removed from. This value is an Amazon
 	// Resource Name (ARN). For information about creating an ARN, see  Constructing
@@ -5450,6 +6387,11 @@ type metadataRemoveTagsFromResourceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RemoveTagsFromResourceInput.
+func (s RemoveTagsFromResourceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RemoveTagsFromResourceOutput struct {
 	metadataRemoveTagsFromResourceOutput `json:"-", xml:"-"`
 }
@@ -5458,6 +6400,11 @@ type metadataRemoveTagsFromResourceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RemoveTagsFromResourceOutput.
+func (s RemoveTagsFromResourceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This data type is used as a response element in the DescribeReservedDBInstances
 // and PurchaseReservedDBInstancesOffering actions.
 type ReservedDBInstance struct {
@@ -5510,6 +6457,11 @@ type metadataReservedDBInstance struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReservedDBInstance.
+func (s ReservedDBInstance) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This data type is used as a response element in the DescribeReservedDBInstancesOfferings
 // action.
 type ReservedDBInstancesOffering struct {
@@ -5550,6 +6502,11 @@ type metadataReservedDBInstancesOffering struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReservedDBInstancesOffering.
+func (s ReservedDBInstancesOffering) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ResetDBParameterGroupInput struct {
 	// The name of the DB parameter group.
 	//
@@ -5590,6 +6547,11 @@ type metadataResetDBParameterGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ResetDBParameterGroupInput.
+func (s ResetDBParameterGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RestoreDBInstanceFromDBSnapshotInput struct {
 	// Indicates that minor version upgrades will be applied automatically to the
 	// DB instance during the maintenance window.
@@ -5726,6 +6688,11 @@ type metadataRestoreDBInstanceFromDBSnapshotInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RestoreDBInstanceFromDBSnapshotInput.
+func (s RestoreDBInstanceFromDBSnapshotInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RestoreDBInstanceFromDBSnapshotOutput struct {
 	// Contains the result of a successful invocation of the following actions:
 	//
@@ -5740,6 +6707,11 @@ type metadataRestoreDBInstanceFromDBSnapshotOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RestoreDBInstanceFromDBSnapshotOutput.
+func (s RestoreDBInstanceFromDBSnapshotOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RestoreDBInstanceToPointInTimeInput struct {
 	// Indicates that minor version upgrades will be applied automatically to the
 	// DB instance during the maintenance window.
@@ -5891,6 +6863,11 @@ type metadataRestoreDBInstanceToPointInTimeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RestoreDBInstanceToPointInTimeInput.
+func (s RestoreDBInstanceToPointInTimeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RestoreDBInstanceToPointInTimeOutput struct {
 	// Contains the result of a successful invocation of the following actions:
 	//
@@ -5905,6 +6882,11 @@ type metadataRestoreDBInstanceToPointInTimeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RestoreDBInstanceToPointInTimeOutput.
+func (s RestoreDBInstanceToPointInTimeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RevokeDBSecurityGroupIngressInput struct {
 	// The IP range to revoke access from. Must be a valid CIDR range. If CIDRIP
 	// is specified, EC2SecurityGroupName, EC2SecurityGroupId and EC2SecurityGroupOwnerId
@@ -5938,6 +6920,11 @@ type metadataRevokeDBSecurityGroupIngressInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RevokeDBSecurityGroupIngressInput.
+func (s RevokeDBSecurityGroupIngressInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RevokeDBSecurityGroupIngressOutput struct {
 	// Contains the result of a successful invocation of the following actions:
 	//
@@ -5953,6 +6940,49 @@ type metadataRevokeDBSecurityGroupIngressOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RevokeDBSecurityGroupIngressOutput.
+func (s RevokeDBSecurityGroupIngressOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// SourceType is an enum of the values of SourceType members.
+type SourceType string
+
+// The values of SourceType.
+const (
+	SourceTypeDbInstance       SourceType = "db-instance"
+	SourceTypeDbParameterGroup SourceType = "db-parameter-group"
+	SourceTypeDbSecurityGroup  SourceType = "db-security-group"
+	SourceTypeDbSnapshot       SourceType = "db-snapshot"
+)
+
+// SourceTypeValues returns the values of SourceType known to this
+// version of the SDK. The service may accept values added since.
+func SourceTypeValues() []SourceType {
+	return []SourceType{
+		SourceType("db-instance"),
+		SourceType("db-parameter-group"),
+		SourceType("db-security-group"),
+		SourceType("db-snapshot"),
+	}
+}
+
+// String returns the SourceType as the string of a member.
+func (v SourceType) String() string {
+	return string(v)
+}
+
+// ParseSourceType returns the SourceType of str, failing if it is not
+// one of the SourceTypeValues.
+func ParseSourceType(str string) (SourceType, error) {
+	for _, v := range SourceTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid SourceType value %q", str)
+}
+
 // This data type is used as a response element in the DescribeDBSubnetGroups
 // action.
 type Subnet struct {
@@ -5974,6 +7004,11 @@ type metadataSubnet struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Subnet.
+func (s Subnet) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Metadata assigned to an Amazon RDS resource consisting of a key-value pair.
 type Tag struct {
 	// A key is the required name of the tag. The string value can be from 1 to
@@ -5995,6 +7030,11 @@ type metadataTag struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Tag.
+func (s Tag) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This data type is used as a response element for queries on VPC security
 // group membership.
 type VPCSecurityGroupMembership struct {
@@ -6009,4 +7049,9 @@ type VPCSecurityGroupMembership struct {
 
 type metadataVPCSecurityGroupMembership struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the VPCSecurityGroupMembership.
+func (s VPCSecurityGroupMembership) String() string {
+	return awsutil.StringValue(s)
 }
\ No newline at end of file
@@ -4,9 +4,11 @@
 package redshift
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // AuthorizeClusterSecurityGroupIngressRequest generates a request for the AuthorizeClusterSecurityGroupIngress operation.
@@ -698,6 +700,11 @@ func (c *Redshift) DescribeClusterParameterGroupsRequest(input *DescribeClusterP
 			Name:       "DescribeClusterParameterGroups",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -733,6 +740,16 @@ func (c *Redshift) DescribeClusterParameterGroups(input *DescribeClusterParamete
 	return
 }
 
+// DescribeClusterParameterGroupsPages iterates over the pages of a DescribeClusterParameterGroups operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *Redshift) DescribeClusterParameterGroupsPages(input *DescribeClusterParameterGroupsInput, fn func(p *DescribeClusterParameterGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeClusterParameterGroupsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeClusterParameterGroupsOutput), lastPage)
+	})
+}
+
 var opDescribeClusterParameterGroups *aws.Operation
 
 // DescribeClusterParametersRequest generates a request for the DescribeClusterParameters operation.
@@ -742,6 +759,11 @@ func (c *Redshift) DescribeClusterParametersRequest(input *DescribeClusterParame
 			Name:       "DescribeClusterParameters",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -770,6 +792,16 @@ func (c *Redshift) DescribeClusterParameters(input *DescribeClusterParametersInp
 	return
 }
 
+// DescribeClusterParametersPages iterates over the pages of a DescribeClusterParameters operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *Redshift) DescribeClusterParametersPages(input *DescribeClusterParametersInput, fn func(p *DescribeClusterParametersOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeClusterParametersRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeClusterParametersOutput), lastPage)
+	})
+}
+
 var opDescribeClusterParameters *aws.Operation
 
 // DescribeClusterSecurityGroupsRequest generates a request for the DescribeClusterSecurityGroups operation.
@@ -779,6 +811,11 @@ func (c *Redshift) DescribeClusterSecurityGroupsRequest(input *DescribeClusterSe
 			Name:       "DescribeClusterSecurityGroups",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -812,6 +849,16 @@ func (c *Redshift) DescribeClusterSecurityGroups(input *DescribeClusterSecurityG
 	return
 }
 
+// DescribeClusterSecurityGroupsPages iterates over the pages of a DescribeClusterSecurityGroups operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *Redshift) DescribeClusterSecurityGroupsPages(input *DescribeClusterSecurityGroupsInput, fn func(p *DescribeClusterSecurityGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeClusterSecurityGroupsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeClusterSecurityGroupsOutput), lastPage)
+	})
+}
+
 var opDescribeClusterSecurityGroups *aws.Operation
 
 // DescribeClusterSnapshotsRequest generates a request for the DescribeClusterSnapshots operation.
@@ -821,6 +868,11 @@ func (c *Redshift) DescribeClusterSnapshotsRequest(input *DescribeClusterSnapsho
 			Name:       "DescribeClusterSnapshots",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -853,6 +905,16 @@ func (c *Redshift) DescribeClusterSnapshots(input *DescribeClusterSnapshotsInput
 	return
 }
 
+// DescribeClusterSnapshotsPages iterates over the pages of a DescribeClusterSnapshots operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *Redshift) DescribeClusterSnapshotsPages(input *DescribeClusterSnapshotsInput, fn func(p *DescribeClusterSnapshotsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeClusterSnapshotsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeClusterSnapshotsOutput), lastPage)
+	})
+}
+
 var opDescribeClusterSnapshots *aws.Operation
 
 // DescribeClusterSubnetGroupsRequest generates a request for the DescribeClusterSubnetGroups operation.
@@ -862,6 +924,11 @@ func (c *Redshift) DescribeClusterSubnetGroupsRequest(input *DescribeClusterSubn
 			Name:       "DescribeClusterSubnetGroups",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -891,6 +958,16 @@ func (c *Redshift) DescribeClusterSubnetGroups(input *DescribeClusterSubnetGroup
 	return
 }
 
+// DescribeClusterSubnetGroupsPages iterates over the pages of a DescribeClusterSubnetGroups operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *Redshift) DescribeClusterSubnetGroupsPages(input *DescribeClusterSubnetGroupsInput, fn func(p *DescribeClusterSubnetGroupsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeClusterSubnetGroupsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeClusterSubnetGroupsOutput), lastPage)
+	})
+}
+
 var opDescribeClusterSubnetGroups *aws.Operation
 
 // DescribeClusterVersionsRequest generates a request for the DescribeClusterVersions operation.
@@ -900,6 +977,11 @@ func (c *Redshift) DescribeClusterVersionsRequest(input *DescribeClusterVersions
 			Name:       "DescribeClusterVersions",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -921,6 +1003,16 @@ func (c *Redshift) DescribeClusterVersions(input *DescribeClusterVersionsInput)
 	return
 }
 
+// DescribeClusterVersionsPages iterates over the pages of a DescribeClusterVersions operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *Redshift) DescribeClusterVersionsPages(input *DescribeClusterVersionsInput, fn func(p *DescribeClusterVersionsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeClusterVersionsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeClusterVersionsOutput), lastPage)
+	})
+}
+
 var opDescribeClusterVersions *aws.Operation
 
 // DescribeClustersRequest generates a request for the DescribeClusters operation.
@@ -930,6 +1022,11 @@ func (c *Redshift) DescribeClustersRequest(input *DescribeClustersInput) (req *a
 			Name:       "DescribeClusters",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -960,6 +1057,16 @@ func (c *Redshift) DescribeClusters(input *DescribeClustersInput) (output *Descr
 	return
 }
 
+// DescribeClustersPages iterates over the pages of a DescribeClusters operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *Redshift) DescribeClustersPages(input *DescribeClustersInput, fn func(p *DescribeClustersOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeClustersRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeClustersOutput), lastPage)
+	})
+}
+
 var opDescribeClusters *aws.Operation
 
 // DescribeDefaultClusterParametersRequest generates a request for the DescribeDefaultClusterParameters operation.
@@ -969,6 +1076,11 @@ func (c *Redshift) DescribeDefaultClusterParametersRequest(input *DescribeDefaul
 			Name:       "DescribeDefaultClusterParameters",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"DefaultClusterParameters.Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -990,6 +1102,16 @@ func (c *Redshift) DescribeDefaultClusterParameters(input *DescribeDefaultCluste
 	return
 }
 
+// DescribeDefaultClusterParametersPages iterates over the pages of a DescribeDefaultClusterParameters operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *Redshift) DescribeDefaultClusterParametersPages(input *DescribeDefaultClusterParametersInput, fn func(p *DescribeDefaultClusterParametersOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeDefaultClusterParametersRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeDefaultClusterParametersOutput), lastPage)
+	})
+}
+
 var opDescribeDefaultClusterParameters *aws.Operation
 
 // DescribeEventCategoriesRequest generates a request for the DescribeEventCategories operation.
@@ -1027,6 +1149,11 @@ func (c *Redshift) DescribeEventSubscriptionsRequest(input *DescribeEventSubscri
 			Name:       "DescribeEventSubscriptions",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -1046,6 +1173,16 @@ func (c *Redshift) DescribeEventSubscriptions(input *DescribeEventSubscriptionsI
 	return
 }
 
+// DescribeEventSubscriptionsPages iterates over the pages of a DescribeEventSubscriptions operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *Redshift) DescribeEventSubscriptionsPages(input *DescribeEventSubscriptionsInput, fn func(p *DescribeEventSubscriptionsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeEventSubscriptionsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeEventSubscriptionsOutput), lastPage)
+	})
+}
+
 var opDescribeEventSubscriptions *aws.Operation
 
 // DescribeEventsRequest generates a request for the DescribeEvents operation.
@@ -1055,6 +1192,11 @@ func (c *Redshift) DescribeEventsRequest(input *DescribeEventsInput) (req *aws.R
 			Name:       "DescribeEvents",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -1075,6 +1217,16 @@ func (c *Redshift) DescribeEvents(input *DescribeEventsInput) (output *DescribeE
 	return
 }
 
+// DescribeEventsPages iterates over the pages of a DescribeEvents operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *Redshift) DescribeEventsPages(input *DescribeEventsInput, fn func(p *DescribeEventsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeEventsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeEventsOutput), lastPage)
+	})
+}
+
 var opDescribeEvents *aws.Operation
 
 // DescribeHSMClientCertificatesRequest generates a request for the DescribeHSMClientCertificates operation.
@@ -1084,6 +1236,11 @@ func (c *Redshift) DescribeHSMClientCertificatesRequest(input *DescribeHSMClient
 			Name:       "DescribeHsmClientCertificates",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -1113,6 +1270,16 @@ func (c *Redshift) DescribeHSMClientCertificates(input *DescribeHSMClientCertifi
 	return
 }
 
+// DescribeHSMClientCertificatesPages iterates over the pages of a DescribeHSMClientCertificates operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *Redshift) DescribeHSMClientCertificatesPages(input *DescribeHSMClientCertificatesInput, fn func(p *DescribeHSMClientCertificatesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeHSMClientCertificatesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeHSMClientCertificatesOutput), lastPage)
+	})
+}
+
 var opDescribeHSMClientCertificates *aws.Operation
 
 // DescribeHSMConfigurationsRequest generates a request for the DescribeHSMConfigurations operation.
@@ -1122,6 +1289,11 @@ func (c *Redshift) DescribeHSMConfigurationsRequest(input *DescribeHSMConfigurat
 			Name:       "DescribeHsmConfigurations",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -1151,6 +1323,16 @@ func (c *Redshift) DescribeHSMConfigurations(input *DescribeHSMConfigurationsInp
 	return
 }
 
+// DescribeHSMConfigurationsPages iterates over the pages of a DescribeHSMConfigurations operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *Redshift) DescribeHSMConfigurationsPages(input *DescribeHSMConfigurationsInput, fn func(p *DescribeHSMConfigurationsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeHSMConfigurationsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeHSMConfigurationsOutput), lastPage)
+	})
+}
+
 var opDescribeHSMConfigurations *aws.Operation
 
 // DescribeLoggingStatusRequest generates a request for the DescribeLoggingStatus operation.
@@ -1187,6 +1369,11 @@ func (c *Redshift) DescribeOrderableClusterOptionsRequest(input *DescribeOrderab
 			Name:       "DescribeOrderableClusterOptions",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -1212,6 +1399,16 @@ func (c *Redshift) DescribeOrderableClusterOptions(input *DescribeOrderableClust
 	return
 }
 
+// DescribeOrderableClusterOptionsPages iterates over the pages of a DescribeOrderableClusterOptions operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *Redshift) DescribeOrderableClusterOptionsPages(input *DescribeOrderableClusterOptionsInput, fn func(p *DescribeOrderableClusterOptionsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeOrderableClusterOptionsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeOrderableClusterOptionsOutput), lastPage)
+	})
+}
+
 var opDescribeOrderableClusterOptions *aws.Operation
 
 // DescribeReservedNodeOfferingsRequest generates a request for the DescribeReservedNodeOfferings operation.
@@ -1221,6 +1418,11 @@ func (c *Redshift) DescribeReservedNodeOfferingsRequest(input *DescribeReservedN
 			Name:       "DescribeReservedNodeOfferings",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -1247,6 +1449,16 @@ func (c *Redshift) DescribeReservedNodeOfferings(input *DescribeReservedNodeOffe
 	return
 }
 
+// DescribeReservedNodeOfferingsPages iterates over the pages of a DescribeReservedNodeOfferings operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *Redshift) DescribeReservedNodeOfferingsPages(input *DescribeReservedNodeOfferingsInput, fn func(p *DescribeReservedNodeOfferingsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeReservedNodeOfferingsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeReservedNodeOfferingsOutput), lastPage)
+	})
+}
+
 var opDescribeReservedNodeOfferings *aws.Operation
 
 // DescribeReservedNodesRequest generates a request for the DescribeReservedNodes operation.
@@ -1256,6 +1468,11 @@ func (c *Redshift) DescribeReservedNodesRequest(input *DescribeReservedNodesInpu
 			Name:       "DescribeReservedNodes",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "MaxRecords",
+			},
 		}
 	}
 
@@ -1273,6 +1490,16 @@ func (c *Redshift) DescribeReservedNodes(input *DescribeReservedNodesInput) (out
 	return
 }
 
+// DescribeReservedNodesPages iterates over the pages of a DescribeReservedNodes operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *Redshift) DescribeReservedNodesPages(input *DescribeReservedNodesInput, fn func(p *DescribeReservedNodesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeReservedNodesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeReservedNodesOutput), lastPage)
+	})
+}
+
 var opDescribeReservedNodes *aws.Operation
 
 // DescribeResizeRequest generates a request for the DescribeResize operation.
@@ -1839,6 +2066,11 @@ type metadataAccountWithRestoreAccess struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AccountWithRestoreAccess.
+func (s AccountWithRestoreAccess) String() string {
+	return awsutil.StringValue(s)
+}
+
 // ???
 type AuthorizeClusterSecurityGroupIngressInput struct {
 	// The IP range to be added the Amazon Redshift security group.
@@ -1864,6 +2096,11 @@ type metadataAuthorizeClusterSecurityGroupIngressInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AuthorizeClusterSecurityGroupIngressInput.
+func (s AuthorizeClusterSecurityGroupIngressInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AuthorizeClusterSecurityGroupIngressOutput struct {
 	// Describes a security group.
 	ClusterSecurityGroup *ClusterSecurityGroup `type:"structure"`
@@ -1875,6 +2112,11 @@ type metadataAuthorizeClusterSecurityGroupIngressOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AuthorizeClusterSecurityGroupIngressOutput.
+func (s AuthorizeClusterSecurityGroupIngressOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AuthorizeSnapshotAccessInput struct {
 	// The identifier of the AWS customer account authorized to restore the specified
 	// snapshot.
@@ -1895,6 +2137,11 @@ type metadataAuthorizeSnapshotAccessInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AuthorizeSnapshotAccessInput.
+func (s AuthorizeSnapshotAccessInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AuthorizeSnapshotAccessOutput struct {
 	// Describes a snapshot.
 	Snapshot *Snapshot `type:"structure"`
@@ -1906,6 +2153,11 @@ type metadataAuthorizeSnapshotAccessOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AuthorizeSnapshotAccessOutput.
+func (s AuthorizeSnapshotAccessOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an availability zone.
 type AvailabilityZone struct {
 	// The name of the availability zone.
@@ -1918,6 +2170,11 @@ type metadataAvailabilityZone struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AvailabilityZone.
+func (s AvailabilityZone) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a cluster.
 type Cluster struct {
 	// If true, major version upgrades will be applied automatically to the cluster
@@ -2041,6 +2298,11 @@ type metadataCluster struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Cluster.
+func (s Cluster) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The identifier of a node in a cluster.
 type ClusterNode struct {
 	// Whether the node is a leader node or a compute node.
@@ -2059,6 +2321,11 @@ type metadataClusterNode struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ClusterNode.
+func (s ClusterNode) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a parameter group.
 type ClusterParameterGroup struct {
 	// The description of the parameter group.
@@ -2081,6 +2348,11 @@ type metadataClusterParameterGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ClusterParameterGroup.
+func (s ClusterParameterGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output from the ModifyClusterParameterGroup and ResetClusterParameterGroup
 // actions and indicate the parameter group involved and the status of the operation
 // on the parameter group.
@@ -2100,6 +2372,11 @@ type metadataClusterParameterGroupNameMessage struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ClusterParameterGroupNameMessage.
+func (s ClusterParameterGroupNameMessage) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the status of a parameter group.
 type ClusterParameterGroupStatus struct {
 	// The status of parameter updates.
@@ -2115,6 +2392,11 @@ type metadataClusterParameterGroupStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ClusterParameterGroupStatus.
+func (s ClusterParameterGroupStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a security group.
 type ClusterSecurityGroup struct {
 	// The name of the cluster security group to which the operation was applied.
@@ -2141,6 +2423,11 @@ type metadataClusterSecurityGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ClusterSecurityGroup.
+func (s ClusterSecurityGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a security group.
 type ClusterSecurityGroupMembership struct {
 	// The name of the cluster security group.
@@ -2156,6 +2443,11 @@ type metadataClusterSecurityGroupMembership struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ClusterSecurityGroupMembership.
+func (s ClusterSecurityGroupMembership) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Returns the destination region and retention period that are configured for
 // cross-region snapshot copy.
 type ClusterSnapshotCopyStatus struct {
@@ -2174,6 +2466,11 @@ type metadataClusterSnapshotCopyStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ClusterSnapshotCopyStatus.
+func (s ClusterSnapshotCopyStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a subnet group.
 type ClusterSubnetGroup struct {
 	// The name of the cluster subnet group.
@@ -2202,6 +2499,11 @@ type metadataClusterSubnetGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ClusterSubnetGroup.
+func (s ClusterSubnetGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a cluster version, including the parameter group family and description
 // of the version.
 type ClusterVersion struct {
@@ -2221,6 +2523,11 @@ type metadataClusterVersion struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ClusterVersion.
+func (s ClusterVersion) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CopyClusterSnapshotInput struct {
 	// The identifier of the cluster the source snapshot was created from. This
 	// parameter is required if your IAM user has a policy containing a snapshot
@@ -2255,6 +2562,11 @@ type metadataCopyClusterSnapshotInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CopyClusterSnapshotInput.
+func (s CopyClusterSnapshotInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CopyClusterSnapshotOutput struct {
 	// Describes a snapshot.
 	Snapshot *Snapshot `type:"structure"`
@@ -2266,6 +2578,11 @@ type metadataCopyClusterSnapshotOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CopyClusterSnapshotOutput.
+func (s CopyClusterSnapshotOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateClusterInput struct {
 	// If true, major version upgrades can be applied during the maintenance window
 	// to the Amazon Redshift engine that is running on the cluster.
@@ -2486,6 +2803,11 @@ type metadataCreateClusterInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateClusterInput.
+func (s CreateClusterInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateClusterOutput struct {
 	// Describes a cluster.
 	Cluster *Cluster `type:"structure"`
@@ -2497,6 +2819,11 @@ type metadataCreateClusterOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateClusterOutput.
+func (s CreateClusterOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateClusterParameterGroupInput struct {
 	// A description of the parameter group.
 	Description *string `type:"string" required:"true"`
@@ -2532,6 +2859,11 @@ type metadataCreateClusterParameterGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateClusterParameterGroupInput.
+func (s CreateClusterParameterGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateClusterParameterGroupOutput struct {
 	// Describes a parameter group.
 	ClusterParameterGroup *ClusterParameterGroup `type:"structure"`
@@ -2543,6 +2875,11 @@ type metadataCreateClusterParameterGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateClusterParameterGroupOutput.
+func (s CreateClusterParameterGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // ???
 type CreateClusterSecurityGroupInput struct {
 	// The name for the security group. Amazon Redshift stores the value as a lowercase
@@ -2568,6 +2905,11 @@ type metadataCreateClusterSecurityGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateClusterSecurityGroupInput.
+func (s CreateClusterSecurityGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateClusterSecurityGroupOutput struct {
 	// Describes a security group.
 	ClusterSecurityGroup *ClusterSecurityGroup `type:"structure"`
@@ -2579,6 +2921,11 @@ type metadataCreateClusterSecurityGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateClusterSecurityGroupOutput.
+func (s CreateClusterSecurityGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateClusterSnapshotInput struct {
 	// The cluster identifier for which you want a snapshot.
 	ClusterIdentifier *string `type:"string" required:"true"`
@@ -2603,6 +2950,11 @@ type metadataCreateClusterSnapshotInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateClusterSnapshotInput.
+func (s CreateClusterSnapshotInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateClusterSnapshotOutput struct {
 	// Describes a snapshot.
 	Snapshot *Snapshot `type:"structure"`
@@ -2614,6 +2966,11 @@ type metadataCreateClusterSnapshotOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateClusterSnapshotOutput.
+func (s CreateClusterSnapshotOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateClusterSubnetGroupInput struct {
 	// The name for the subnet group. Amazon Redshift stores the value as a lowercase
 	// string.
@@ -2642,6 +2999,11 @@ type metadataCreateClusterSubnetGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateClusterSubnetGroupInput.
+func (s CreateClusterSubnetGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateClusterSubnetGroupOutput struct {
 	// Describes a subnet group.
 	ClusterSubnetGroup *ClusterSubnetGroup `type:"structure"`
@@ -2653,6 +3015,11 @@ type metadataCreateClusterSubnetGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateClusterSubnetGroupOutput.
+func (s CreateClusterSubnetGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateEventSubscriptionInput struct {
 	// A Boolean value; set to true to activate the subscription, set to false to
 	// create the subscription but not active it.
@@ -2715,6 +3082,11 @@ type metadataCreateEventSubscriptionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateEventSubscriptionInput.
+func (s CreateEventSubscriptionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateEventSubscriptionOutput struct {
 	EventSubscription *EventSubscription `type:"structure"`
 
@@ -2725,6 +3097,11 @@ type metadataCreateEventSubscriptionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateEventSubscriptionOutput.
+func (s CreateEventSubscriptionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateHSMClientCertificateInput struct {
 	// The identifier to be assigned to the new HSM client certificate that the
 	// cluster will use to connect to the HSM to use the database encryption keys.
@@ -2740,6 +3117,11 @@ type metadataCreateHSMClientCertificateInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateHSMClientCertificateInput.
+func (s CreateHSMClientCertificateInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateHSMClientCertificateOutput struct {
 	// Returns information about an HSM client certificate. The certificate is stored
 	// in a secure Hardware Storage Module (HSM), and used by the Amazon Redshift
@@ -2753,6 +3135,11 @@ type metadataCreateHSMClientCertificateOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateHSMClientCertificateOutput.
+func (s CreateHSMClientCertificateOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateHSMConfigurationInput struct {
 	// A text description of the HSM configuration to be created.
 	Description *string `type:"string" required:"true"`
@@ -2784,6 +3171,11 @@ type metadataCreateHSMConfigurationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateHSMConfigurationInput.
+func (s CreateHSMConfigurationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateHSMConfigurationOutput struct {
 	// Returns information about an HSM configuration, which is an object that describes
 	// to Amazon Redshift clusters the information they require to connect to an
@@ -2797,6 +3189,11 @@ type metadataCreateHSMConfigurationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateHSMConfigurationOutput.
+func (s CreateHSMConfigurationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output from the CreateTags action.
 type CreateTagsInput struct {
 	// The Amazon Resource Name (ARN) to which you want to add the tag or tags.
@@ -2818,6 +3215,11 @@ type metadataCreateTagsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateTagsInput.
+func (s CreateTagsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateTagsOutput struct {
 	metadataCreateTagsOutput `json:"-", xml:"-"`
 }
@@ -2826,6 +3228,11 @@ type metadataCreateTagsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateTagsOutput.
+func (s CreateTagsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the default cluster parameters for a parameter group family.
 type DefaultClusterParameters struct {
 	// A value that indicates the starting point for the next set of response records
@@ -2849,6 +3256,11 @@ type metadataDefaultClusterParameters struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DefaultClusterParameters.
+func (s DefaultClusterParameters) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteClusterInput struct {
 	// The identifier of the cluster to be deleted.
 	//
@@ -2884,6 +3296,11 @@ type metadataDeleteClusterInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteClusterInput.
+func (s DeleteClusterInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteClusterOutput struct {
 	// Describes a cluster.
 	Cluster *Cluster `type:"structure"`
@@ -2895,6 +3312,11 @@ type metadataDeleteClusterOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteClusterOutput.
+func (s DeleteClusterOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteClusterParameterGroupInput struct {
 	// The name of the parameter group to be deleted.
 	//
@@ -2911,6 +3333,11 @@ type metadataDeleteClusterParameterGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteClusterParameterGroupInput.
+func (s DeleteClusterParameterGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteClusterParameterGroupOutput struct {
 	metadataDeleteClusterParameterGroupOutput `json:"-", xml:"-"`
 }
@@ -2919,6 +3346,11 @@ type metadataDeleteClusterParameterGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteClusterParameterGroupOutput.
+func (s DeleteClusterParameterGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteClusterSecurityGroupInput struct {
 	// The name of the cluster security group to be deleted.
 	ClusterSecurityGroupName *string `type:"string" required:"true"`
@@ -2930,6 +3362,11 @@ type metadataDeleteClusterSecurityGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteClusterSecurityGroupInput.
+func (s DeleteClusterSecurityGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteClusterSecurityGroupOutput struct {
 	metadataDeleteClusterSecurityGroupOutput `json:"-", xml:"-"`
 }
@@ -2938,6 +3375,11 @@ type metadataDeleteClusterSecurityGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteClusterSecurityGroupOutput.
+func (s DeleteClusterSecurityGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteClusterSnapshotInput struct {
 	// The unique identifier of the cluster the snapshot was created from. This
 	// parameter is required if your IAM user has a policy containing a snapshot
@@ -2959,6 +3401,11 @@ type metadataDeleteClusterSnapshotInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteClusterSnapshotInput.
+func (s DeleteClusterSnapshotInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteClusterSnapshotOutput struct {
 	// Describes a snapshot.
 	Snapshot *Snapshot `type:"structure"`
@@ -2970,6 +3417,11 @@ type metadataDeleteClusterSnapshotOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteClusterSnapshotOutput.
+func (s DeleteClusterSnapshotOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteClusterSubnetGroupInput struct {
 	// The name of the cluster subnet group name to be deleted.
 	ClusterSubnetGroupName *string `type:"string" required:"true"`
@@ -2981,6 +3433,11 @@ type metadataDeleteClusterSubnetGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteClusterSubnetGroupInput.
+func (s DeleteClusterSubnetGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteClusterSubnetGroupOutput struct {
 	metadataDeleteClusterSubnetGroupOutput `json:"-", xml:"-"`
 }
@@ -2989,6 +3446,11 @@ type metadataDeleteClusterSubnetGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteClusterSubnetGroupOutput.
+func (s DeleteClusterSubnetGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteEventSubscriptionInput struct {
 	// The name of the Amazon Redshift event notification subscription to be deleted.
 	SubscriptionName *string `type:"string" required:"true"`
@@ -3000,6 +3462,11 @@ type metadataDeleteEventSubscriptionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteEventSubscriptionInput.
+func (s DeleteEventSubscriptionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteEventSubscriptionOutput struct {
 	metadataDeleteEventSubscriptionOutput `json:"-", xml:"-"`
 }
@@ -3008,6 +3475,11 @@ type metadataDeleteEventSubscriptionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteEventSubscriptionOutput.
+func (s DeleteEventSubscriptionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteHSMClientCertificateInput struct {
 	// The identifier of the HSM client certificate to be deleted.
 	HSMClientCertificateIdentifier *string `locationName:"HsmClientCertificateIdentifier" type:"string" required:"true"`
@@ -3019,6 +3491,11 @@ type metadataDeleteHSMClientCertificateInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteHSMClientCertificateInput.
+func (s DeleteHSMClientCertificateInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteHSMClientCertificateOutput struct {
 	metadataDeleteHSMClientCertificateOutput `json:"-", xml:"-"`
 }
@@ -3027,6 +3504,11 @@ type metadataDeleteHSMClientCertificateOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteHSMClientCertificateOutput.
+func (s DeleteHSMClientCertificateOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteHSMConfigurationInput struct {
 	// The identifier of the Amazon Redshift HSM configuration to be deleted.
 	HSMConfigurationIdentifier *string `locationName:"HsmConfigurationIdentifier" type:"string" required:"true"`
@@ -3038,6 +3520,11 @@ type metadataDeleteHSMConfigurationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteHSMConfigurationInput.
+func (s DeleteHSMConfigurationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteHSMConfigurationOutput struct {
 	metadataDeleteHSMConfigurationOutput `json:"-", xml:"-"`
 }
@@ -3046,6 +3533,11 @@ type metadataDeleteHSMConfigurationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteHSMConfigurationOutput.
+func (s DeleteHSMConfigurationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output from the DeleteTags action.
 type DeleteTagsInput struct {
 	// The Amazon Resource Name (ARN) from which you want to remove the tag or tags.
@@ -3062,6 +3554,11 @@ type metadataDeleteTagsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteTagsInput.
+func (s DeleteTagsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteTagsOutput struct {
 	metadataDeleteTagsOutput `json:"-", xml:"-"`
 }
@@ -3070,6 +3567,11 @@ type metadataDeleteTagsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteTagsOutput.
+func (s DeleteTagsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeClusterParameterGroupsInput struct {
 	// An optional parameter that specifies the starting point to return a set of
 	// response records. When the results of a DescribeClusterParameterGroups request
@@ -3116,6 +3618,11 @@ type metadataDescribeClusterParameterGroupsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeClusterParameterGroupsInput.
+func (s DescribeClusterParameterGroupsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output from the DescribeClusterParameterGroups action.
 type DescribeClusterParameterGroupsOutput struct {
 	// A value that indicates the starting point for the next set of response records
@@ -3136,6 +3643,11 @@ type metadataDescribeClusterParameterGroupsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeClusterParameterGroupsOutput.
+func (s DescribeClusterParameterGroupsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeClusterParametersInput struct {
 	// An optional parameter that specifies the starting point to return a set of
 	// response records. When the results of a DescribeClusterParameters request
@@ -3174,6 +3686,11 @@ type metadataDescribeClusterParametersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeClusterParametersInput.
+func (s DescribeClusterParametersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output from the DescribeClusterParameters action.
 type DescribeClusterParametersOutput struct {
 	// A value that indicates the starting point for the next set of response records
@@ -3194,6 +3711,11 @@ type metadataDescribeClusterParametersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeClusterParametersOutput.
+func (s DescribeClusterParametersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // ???
 type DescribeClusterSecurityGroupsInput struct {
 	// The name of a cluster security group for which you are requesting details.
@@ -3247,6 +3769,11 @@ type metadataDescribeClusterSecurityGroupsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeClusterSecurityGroupsInput.
+func (s DescribeClusterSecurityGroupsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output from the DescribeClusterSecurityGroups action.
 type DescribeClusterSecurityGroupsOutput struct {
 	// A list of ClusterSecurityGroup instances.
@@ -3266,6 +3793,11 @@ type metadataDescribeClusterSecurityGroupsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeClusterSecurityGroupsOutput.
+func (s DescribeClusterSecurityGroupsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeClusterSnapshotsInput struct {
 	// The identifier of the cluster for which information about snapshots is requested.
 	ClusterIdentifier *string `type:"string"`
@@ -3340,6 +3872,11 @@ type metadataDescribeClusterSnapshotsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeClusterSnapshotsInput.
+func (s DescribeClusterSnapshotsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output from the DescribeClusterSnapshots action.
 type DescribeClusterSnapshotsOutput struct {
 	// A value that indicates the starting point for the next set of response records
@@ -3359,6 +3896,11 @@ type metadataDescribeClusterSnapshotsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeClusterSnapshotsOutput.
+func (s DescribeClusterSnapshotsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeClusterSubnetGroupsInput struct {
 	// The name of the cluster subnet group for which information is requested.
 	ClusterSubnetGroupName *string `type:"string"`
@@ -3404,6 +3946,11 @@ type metadataDescribeClusterSubnetGroupsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeClusterSubnetGroupsInput.
+func (s DescribeClusterSubnetGroupsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output from the DescribeClusterSubnetGroups action.
 type DescribeClusterSubnetGroupsOutput struct {
 	// A list of ClusterSubnetGroup instances.
@@ -3423,6 +3970,11 @@ type metadataDescribeClusterSubnetGroupsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeClusterSubnetGroupsOutput.
+func (s DescribeClusterSubnetGroupsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeClusterVersionsInput struct {
 	// The name of a specific cluster parameter group family to return details for.
 	//
@@ -3461,6 +4013,11 @@ type metadataDescribeClusterVersionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeClusterVersionsInput.
+func (s DescribeClusterVersionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output from the DescribeClusterVersions action.
 type DescribeClusterVersionsOutput struct {
 	// A list of Version elements.
@@ -3480,6 +4037,11 @@ type metadataDescribeClusterVersionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeClusterVersionsOutput.
+func (s DescribeClusterVersionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeClustersInput struct {
 	// The unique identifier of a cluster whose properties you are requesting. This
 	// parameter is case sensitive.
@@ -3530,6 +4092,11 @@ type metadataDescribeClustersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeClustersInput.
+func (s DescribeClustersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output from the DescribeClusters action.
 type DescribeClustersOutput struct {
 	// A list of Cluster objects, where each object describes one cluster.
@@ -3549,6 +4116,11 @@ type metadataDescribeClustersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeClustersOutput.
+func (s DescribeClustersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeDefaultClusterParametersInput struct {
 	// An optional parameter that specifies the starting point to return a set of
 	// response records. When the results of a DescribeDefaultClusterParameters
@@ -3578,6 +4150,11 @@ type metadataDescribeDefaultClusterParametersInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeDefaultClusterParametersInput.
+func (s DescribeDefaultClusterParametersInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeDefaultClusterParametersOutput struct {
 	// Describes the default cluster parameters for a parameter group family.
 	DefaultClusterParameters *DefaultClusterParameters `type:"structure"`
@@ -3589,6 +4166,11 @@ type metadataDescribeDefaultClusterParametersOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeDefaultClusterParametersOutput.
+func (s DescribeDefaultClusterParametersOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeEventCategoriesInput struct {
 	// The source type, such as cluster or parameter group, to which the described
 	// event categories apply.
@@ -3603,6 +4185,11 @@ type metadataDescribeEventCategoriesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeEventCategoriesInput.
+func (s DescribeEventCategoriesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeEventCategoriesOutput struct {
 	// A list of event categories descriptions.
 	EventCategoriesMapList []*EventCategoriesMap `locationNameList:"EventCategoriesMap" type:"list"`
@@ -3614,6 +4201,11 @@ type metadataDescribeEventCategoriesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeEventCategoriesOutput.
+func (s DescribeEventCategoriesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeEventSubscriptionsInput struct {
 	// An optional parameter that specifies the starting point to return a set of
 	// response records. When the results of a DescribeEventSubscriptions request
@@ -3643,6 +4235,11 @@ type metadataDescribeEventSubscriptionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeEventSubscriptionsInput.
+func (s DescribeEventSubscriptionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeEventSubscriptionsOutput struct {
 	// A list of event subscriptions.
 	EventSubscriptionsList []*EventSubscription `locationNameList:"EventSubscription" type:"list"`
@@ -3661,6 +4258,11 @@ type metadataDescribeEventSubscriptionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeEventSubscriptionsOutput.
+func (s DescribeEventSubscriptionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeEventsInput struct {
 	// The number of minutes prior to the time of the request for which to retrieve
 	// events. For example, if the request is sent at 18:00 and you specify a duration
@@ -3718,7 +4320,7 @@ type DescribeEventsInput struct {
 	// name. Specify cluster-parameter-group when SourceIdentifier is a cluster
 	// parameter group name. Specify cluster-snapshot when SourceIdentifier is a
 	// cluster snapshot identifier.
-	SourceType *string `type:"string"`
+	SourceType *string `type:"string" enum:"cluster,cluster-parameter-group,cluster-security-group,cluster-snapshot"`
 
 	// The beginning of the time interval to retrieve events for, specified in ISO
 	// 8601 format. For more information about ISO 8601, go to the ISO8601 Wikipedia
@@ -3734,6 +4336,11 @@ type metadataDescribeEventsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeEventsInput.
+func (s DescribeEventsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output from the DescribeEvents action.
 type DescribeEventsOutput struct {
 	// A list of Event instances.
@@ -3753,6 +4360,11 @@ type metadataDescribeEventsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeEventsOutput.
+func (s DescribeEventsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeHSMClientCertificatesInput struct {
 	// The identifier of a specific HSM client certificate for which you want information.
 	// If no identifier is specified, information is returned for all HSM client
@@ -3800,6 +4412,11 @@ type metadataDescribeHSMClientCertificatesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeHSMClientCertificatesInput.
+func (s DescribeHSMClientCertificatesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeHSMClientCertificatesOutput struct {
 	// A list of the identifiers for one or more HSM client certificates used by
 	// Amazon Redshift clusters to store and retrieve database encryption keys in
@@ -3820,6 +4437,11 @@ type metadataDescribeHSMClientCertificatesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeHSMClientCertificatesOutput.
+func (s DescribeHSMClientCertificatesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeHSMConfigurationsInput struct {
 	// The identifier of a specific Amazon Redshift HSM configuration to be described.
 	// If no identifier is specified, information is returned for all HSM configurations
@@ -3867,6 +4489,11 @@ type metadataDescribeHSMConfigurationsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeHSMConfigurationsInput.
+func (s DescribeHSMConfigurationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeHSMConfigurationsOutput struct {
 	// A list of Amazon Redshift HSM configurations.
 	HSMConfigurations []*HSMConfiguration `locationName:"HsmConfigurations" locationNameList:"HsmConfiguration" type:"list"`
@@ -3885,6 +4512,11 @@ type metadataDescribeHSMConfigurationsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeHSMConfigurationsOutput.
+func (s DescribeHSMConfigurationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeLoggingStatusInput struct {
 	// The identifier of the cluster to get the logging status from.
 	//
@@ -3898,6 +4530,11 @@ type metadataDescribeLoggingStatusInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeLoggingStatusInput.
+func (s DescribeLoggingStatusInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeOrderableClusterOptionsInput struct {
 	// The version filter value. Specify this parameter to show only the available
 	// offerings matching the specified version.
@@ -3936,6 +4573,11 @@ type metadataDescribeOrderableClusterOptionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeOrderableClusterOptionsInput.
+func (s DescribeOrderableClusterOptionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output from the DescribeOrderableClusterOptions action.
 type DescribeOrderableClusterOptionsOutput struct {
 	// A value that indicates the starting point for the next set of response records
@@ -3956,6 +4598,11 @@ type metadataDescribeOrderableClusterOptionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeOrderableClusterOptionsOutput.
+func (s DescribeOrderableClusterOptionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // to be provided.
 type DescribeReservedNodeOfferingsInput struct {
 	// An optional parameter that specifies the starting point to return a set of
@@ -3986,6 +4633,11 @@ type metadataDescribeReservedNodeOfferingsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeReservedNodeOfferingsInput.
+func (s DescribeReservedNodeOfferingsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output from the DescribeReservedNodeOfferings action.
 type DescribeReservedNodeOfferingsOutput struct {
 	// A value that indicates the starting point for the next set of response records
@@ -4005,6 +4657,11 @@ type metadataDescribeReservedNodeOfferingsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeReservedNodeOfferingsOutput.
+func (s DescribeReservedNodeOfferingsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeReservedNodesInput struct {
 	// An optional parameter that specifies the starting point to return a set of
 	// response records. When the results of a DescribeReservedNodes request exceed
@@ -4033,6 +4690,11 @@ type metadataDescribeReservedNodesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeReservedNodesInput.
+func (s DescribeReservedNodesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output from the DescribeReservedNodes action.
 type DescribeReservedNodesOutput struct {
 	// A value that indicates the starting point for the next set of response records
@@ -4052,6 +4714,11 @@ type metadataDescribeReservedNodesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeReservedNodesOutput.
+func (s DescribeReservedNodesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeResizeInput struct {
 	// The unique identifier of a cluster whose resize progress you are requesting.
 	// This parameter is case-sensitive.
@@ -4067,6 +4734,11 @@ type metadataDescribeResizeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeResizeInput.
+func (s DescribeResizeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the result of a cluster resize operation.
 type DescribeResizeOutput struct {
 	// The average rate of the resize operation over the last few minutes, measured
@@ -4135,6 +4807,11 @@ type metadataDescribeResizeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeResizeOutput.
+func (s DescribeResizeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output from the DescribeTags action.
 type DescribeTagsInput struct {
 	// A value that indicates the starting point for the next set of response records
@@ -4186,6 +4863,11 @@ type metadataDescribeTagsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeTagsInput.
+func (s DescribeTagsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the output from the DescribeTags action.
 type DescribeTagsOutput struct {
 	// A value that indicates the starting point for the next set of response records
@@ -4205,6 +4887,11 @@ type metadataDescribeTagsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeTagsOutput.
+func (s DescribeTagsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DisableLoggingInput struct {
 	// The identifier of the cluster on which logging is to be stopped.
 	//
@@ -4218,6 +4905,11 @@ type metadataDisableLoggingInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DisableLoggingInput.
+func (s DisableLoggingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DisableSnapshotCopyInput struct {
 	// The unique identifier of the source cluster that you want to disable copying
 	// of snapshots to a destination region.
@@ -4233,6 +4925,11 @@ type metadataDisableSnapshotCopyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DisableSnapshotCopyInput.
+func (s DisableSnapshotCopyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DisableSnapshotCopyOutput struct {
 	// Describes a cluster.
 	Cluster *Cluster `type:"structure"`
@@ -4244,6 +4941,11 @@ type metadataDisableSnapshotCopyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DisableSnapshotCopyOutput.
+func (s DisableSnapshotCopyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an Amazon EC2 security group.
 type EC2SecurityGroup struct {
 	// The name of the EC2 Security Group.
@@ -4266,6 +4968,11 @@ type metadataEC2SecurityGroup struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EC2SecurityGroup.
+func (s EC2SecurityGroup) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the status of the elastic IP (EIP) address.
 type ElasticIPStatus struct {
 	// The elastic IP (EIP) address for the cluster.
@@ -4281,6 +4988,11 @@ type metadataElasticIPStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ElasticIPStatus.
+func (s ElasticIPStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
 type EnableLoggingInput struct {
 	// The name of an existing S3 bucket where the log files are to be stored.
 	//
@@ -4311,6 +5023,11 @@ type metadataEnableLoggingInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EnableLoggingInput.
+func (s EnableLoggingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type EnableSnapshotCopyInput struct {
 	// The unique identifier of the source cluster to copy snapshots from.
 	//
@@ -4340,6 +5057,11 @@ type metadataEnableSnapshotCopyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EnableSnapshotCopyInput.
+func (s EnableSnapshotCopyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type EnableSnapshotCopyOutput struct {
 	// Describes a cluster.
 	Cluster *Cluster `type:"structure"`
@@ -4351,6 +5073,11 @@ type metadataEnableSnapshotCopyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EnableSnapshotCopyOutput.
+func (s EnableSnapshotCopyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a connection endpoint.
 type Endpoint struct {
 	// The DNS address of the Cluster.
@@ -4366,6 +5093,11 @@ type metadataEndpoint struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Endpoint.
+func (s Endpoint) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an event.
 type Event struct {
 	// The date and time of the event.
@@ -4389,7 +5121,7 @@ type Event struct {
 	SourceIdentifier *string `type:"string"`
 
 	// The source type for this event.
-	SourceType *string `type:"string"`
+	SourceType *string `type:"string" enum:"cluster,cluster-parameter-group,cluster-security-group,cluster-snapshot"`
 
 	metadataEvent `json:"-", xml:"-"`
 }
@@ -4398,6 +5130,11 @@ type metadataEvent struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Event.
+func (s Event) String() string {
+	return awsutil.StringValue(s)
+}
+
 type EventCategoriesMap struct {
 	// The events in the event category.
 	Events []*EventInfoMap `locationNameList:"EventInfoMap" type:"list"`
@@ -4413,6 +5150,11 @@ type metadataEventCategoriesMap struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EventCategoriesMap.
+func (s EventCategoriesMap) String() string {
+	return awsutil.StringValue(s)
+}
+
 type EventInfoMap struct {
 	// The category of an Amazon Redshift event.
 	EventCategories []*string `locationNameList:"EventCategory" type:"list"`
@@ -4435,6 +5177,11 @@ type metadataEventInfoMap struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EventInfoMap.
+func (s EventInfoMap) String() string {
+	return awsutil.StringValue(s)
+}
+
 type EventSubscription struct {
 	// The name of the Amazon Redshift event notification subscription.
 	CustSubscriptionID *string `locationName:"CustSubscriptionId" type:"string"`
@@ -4494,6 +5241,11 @@ type metadataEventSubscription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EventSubscription.
+func (s EventSubscription) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Returns information about an HSM client certificate. The certificate is stored
 // in a secure Hardware Storage Module (HSM), and used by the Amazon Redshift
 // cluster to encrypt data files.
@@ -4515,6 +5267,11 @@ type metadataHSMClientCertificate struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the HSMClientCertificate.
+func (s HSMClientCertificate) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Returns information about an HSM configuration, which is an object that describes
 // to Amazon Redshift clusters the information they require to connect to an
 // HSM where they can store database encryption keys.
@@ -4542,6 +5299,11 @@ type metadataHSMConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the HSMConfiguration.
+func (s HSMConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
 type HSMStatus struct {
 	// Specifies the name of the HSM client certificate the Amazon Redshift cluster
 	// uses to retrieve the data encryption keys stored in an HSM.
@@ -4564,6 +5326,11 @@ type metadataHSMStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the HSMStatus.
+func (s HSMStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an IP range used in a security group.
 type IPRange struct {
 	// The IP range in Classless Inter-Domain Routing (CIDR) notation.
@@ -4582,6 +5349,11 @@ type metadataIPRange struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the IPRange.
+func (s IPRange) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the status of logging for a cluster.
 type LoggingStatus struct {
 	// The name of the S3 bucket where the log files are stored.
@@ -4609,6 +5381,11 @@ type metadataLoggingStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the LoggingStatus.
+func (s LoggingStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifyClusterInput struct {
 	// If true, major version upgrades will be applied automatically to the cluster
 	// during the maintenance window.
@@ -4771,6 +5548,11 @@ type metadataModifyClusterInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyClusterInput.
+func (s ModifyClusterInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifyClusterOutput struct {
 	// Describes a cluster.
 	Cluster *Cluster `type:"structure"`
@@ -4782,6 +5564,11 @@ type metadataModifyClusterOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyClusterOutput.
+func (s ModifyClusterOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifyClusterParameterGroupInput struct {
 	// The name of the parameter group to be modified.
 	ParameterGroupName *string `type:"string" required:"true"`
@@ -4803,6 +5590,11 @@ type metadataModifyClusterParameterGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyClusterParameterGroupInput.
+func (s ModifyClusterParameterGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifyClusterSubnetGroupInput struct {
 	// The name of the subnet group to be modified.
 	ClusterSubnetGroupName *string `type:"string" required:"true"`
@@ -4821,6 +5613,11 @@ type metadataModifyClusterSubnetGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyClusterSubnetGroupInput.
+func (s ModifyClusterSubnetGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifyClusterSubnetGroupOutput struct {
 	// Describes a subnet group.
 	ClusterSubnetGroup *ClusterSubnetGroup `type:"structure"`
@@ -4832,6 +5629,11 @@ type metadataModifyClusterSubnetGroupOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyClusterSubnetGroupOutput.
+func (s ModifyClusterSubnetGroupOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifyEventSubscriptionInput struct {
 	// A Boolean value indicating if the subscription is enabled. true indicates
 	// the subscription is enabled
@@ -4884,6 +5686,11 @@ type metadataModifyEventSubscriptionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyEventSubscriptionInput.
+func (s ModifyEventSubscriptionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifyEventSubscriptionOutput struct {
 	EventSubscription *EventSubscription `type:"structure"`
 
@@ -4894,6 +5701,11 @@ type metadataModifyEventSubscriptionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifyEventSubscriptionOutput.
+func (s ModifyEventSubscriptionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifySnapshotCopyRetentionPeriodInput struct {
 	// The unique identifier of the cluster for which you want to change the retention
 	// period for automated snapshots that are copied to a destination region.
@@ -4920,6 +5732,11 @@ type metadataModifySnapshotCopyRetentionPeriodInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifySnapshotCopyRetentionPeriodInput.
+func (s ModifySnapshotCopyRetentionPeriodInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ModifySnapshotCopyRetentionPeriodOutput struct {
 	// Describes a cluster.
 	Cluster *Cluster `type:"structure"`
@@ -4931,6 +5748,11 @@ type metadataModifySnapshotCopyRetentionPeriodOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ModifySnapshotCopyRetentionPeriodOutput.
+func (s ModifySnapshotCopyRetentionPeriodOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an orderable cluster option.
 type OrderableClusterOption struct {
 	// A list of availability zones for the orderable cluster.
@@ -4952,6 +5774,11 @@ type metadataOrderableClusterOption struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OrderableClusterOption.
+func (s OrderableClusterOption) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a parameter in a cluster parameter group.
 type Parameter struct {
 	// The valid range of values for the parameter.
@@ -4986,6 +5813,11 @@ type metadataParameter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Parameter.
+func (s Parameter) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes cluster attributes that are in a pending state. A change to one
 // or more the attributes was requested and is in progress or will be applied.
 type PendingModifiedValues struct {
@@ -5017,6 +5849,11 @@ type metadataPendingModifiedValues struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PendingModifiedValues.
+func (s PendingModifiedValues) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PurchaseReservedNodeOfferingInput struct {
 	// The number of reserved nodes you want to purchase.
 	//
@@ -5033,6 +5870,11 @@ type metadataPurchaseReservedNodeOfferingInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PurchaseReservedNodeOfferingInput.
+func (s PurchaseReservedNodeOfferingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PurchaseReservedNodeOfferingOutput struct {
 	// Describes a reserved node.
 	ReservedNode *ReservedNode `type:"structure"`
@@ -5044,6 +5886,11 @@ type metadataPurchaseReservedNodeOfferingOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PurchaseReservedNodeOfferingOutput.
+func (s PurchaseReservedNodeOfferingOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RebootClusterInput struct {
 	// The cluster identifier.
 	ClusterIdentifier *string `type:"string" required:"true"`
@@ -5055,6 +5902,11 @@ type metadataRebootClusterInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RebootClusterInput.
+func (s RebootClusterInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RebootClusterOutput struct {
 	// Describes a cluster.
 	Cluster *Cluster `type:"structure"`
@@ -5066,6 +5918,11 @@ type metadataRebootClusterOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RebootClusterOutput.
+func (s RebootClusterOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a recurring charge.
 type RecurringCharge struct {
 	// The amount charged per the period of time specified by the recurring charge
@@ -5082,6 +5939,11 @@ type metadataRecurringCharge struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RecurringCharge.
+func (s RecurringCharge) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a reserved node.
 type ReservedNode struct {
 	// The currency code for the reserved cluster.
@@ -5136,6 +5998,11 @@ type metadataReservedNode struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReservedNode.
+func (s ReservedNode) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a reserved node offering.
 type ReservedNodeOffering struct {
 	// The currency code for the compute nodes offering.
@@ -5174,6 +6041,11 @@ type metadataReservedNodeOffering struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReservedNodeOffering.
+func (s ReservedNodeOffering) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ResetClusterParameterGroupInput struct {
 	// The name of the cluster parameter group to be reset.
 	ParameterGroupName *string `type:"string" required:"true"`
@@ -5197,6 +6069,11 @@ type metadataResetClusterParameterGroupInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ResetClusterParameterGroupInput.
+func (s ResetClusterParameterGroupInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RestoreFromClusterSnapshotInput struct {
 	// If true, major version upgrades can be applied during the maintenance window
 	// to the Amazon Redshift engine that is running on the cluster.
@@ -5327,6 +6204,11 @@ type metadataRestoreFromClusterSnapshotInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RestoreFromClusterSnapshotInput.
+func (s RestoreFromClusterSnapshotInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RestoreFromClusterSnapshotOutput struct {
 	// Describes a cluster.
 	Cluster *Cluster `type:"structure"`
@@ -5338,6 +6220,11 @@ type metadataRestoreFromClusterSnapshotOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RestoreFromClusterSnapshotOutput.
+func (s RestoreFromClusterSnapshotOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the status of a cluster restore action. Returns null if the cluster
 // was not created by restoring a snapshot.
 type RestoreStatus struct {
@@ -5370,6 +6257,11 @@ type metadataRestoreStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RestoreStatus.
+func (s RestoreStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
 // ???
 type RevokeClusterSecurityGroupIngressInput struct {
 	// The IP range for which to revoke access. This range must be a valid Classless
@@ -5400,6 +6292,11 @@ type metadataRevokeClusterSecurityGroupIngressInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RevokeClusterSecurityGroupIngressInput.
+func (s RevokeClusterSecurityGroupIngressInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RevokeClusterSecurityGroupIngressOutput struct {
 	// Describes a security group.
 	ClusterSecurityGroup *ClusterSecurityGroup `type:"structure"`
@@ -5411,6 +6308,11 @@ type metadataRevokeClusterSecurityGroupIngressOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RevokeClusterSecurityGroupIngressOutput.
+func (s RevokeClusterSecurityGroupIngressOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RevokeSnapshotAccessInput struct {
 	// The identifier of the AWS customer account that can no longer restore the
 	// specified snapshot.
@@ -5431,6 +6333,11 @@ type metadataRevokeSnapshotAccessInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RevokeSnapshotAccessInput.
+func (s RevokeSnapshotAccessInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RevokeSnapshotAccessOutput struct {
 	// Describes a snapshot.
 	Snapshot *Snapshot `type:"structure"`
@@ -5442,6 +6349,11 @@ type metadataRevokeSnapshotAccessOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RevokeSnapshotAccessOutput.
+func (s RevokeSnapshotAccessOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RotateEncryptionKeyInput struct {
 	// The unique identifier of the cluster that you want to rotate the encryption
 	// keys for.
@@ -5456,6 +6368,11 @@ type metadataRotateEncryptionKeyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RotateEncryptionKeyInput.
+func (s RotateEncryptionKeyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RotateEncryptionKeyOutput struct {
 	// Describes a cluster.
 	Cluster *Cluster `type:"structure"`
@@ -5467,6 +6384,11 @@ type metadataRotateEncryptionKeyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RotateEncryptionKeyOutput.
+func (s RotateEncryptionKeyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a snapshot.
 type Snapshot struct {
 	// A list of the AWS customer accounts authorized to restore the snapshot. Returns
@@ -5573,6 +6495,49 @@ type metadataSnapshot struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Snapshot.
+func (s Snapshot) String() string {
+	return awsutil.StringValue(s)
+}
+
+// SourceType is an enum of the values of SourceType members.
+type SourceType string
+
+// The values of SourceType.
+const (
+	SourceTypeCluster               SourceType = "cluster"
+	SourceTypeClusterParameterGroup SourceType = "cluster-parameter-group"
+	SourceTypeClusterSecurityGroup  SourceType = "cluster-security-group"
+	SourceTypeClusterSnapshot       SourceType = "cluster-snapshot"
+)
+
+// SourceTypeValues returns the values of SourceType known to this
+// version of the SDK. The service may accept values added since.
+func SourceTypeValues() []SourceType {
+	return []SourceType{
+		SourceType("cluster"),
+		SourceType("cluster-parameter-group"),
+		SourceType("cluster-security-group"),
+		SourceType("cluster-snapshot"),
+	}
+}
+
+// String returns the SourceType as the string of a member.
+func (v SourceType) String() string {
+	return string(v)
+}
+
+// ParseSourceType returns the SourceType of str, failing if it is not
+// one of the SourceTypeValues.
+func ParseSourceType(str string) (SourceType, error) {
+	for _, v := range SourceTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid SourceType value %q", str)
+}
+
 // Describes a subnet.
 type Subnet struct {
 	// Describes an availability zone.
@@ -5591,6 +6556,11 @@ type metadataSubnet struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Subnet.
+func (s Subnet) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A tag consisting of a name/value pair for a resource.
 type Tag struct {
 	// The key, or name, for the resource tag.
@@ -5606,6 +6576,11 @@ type metadataTag struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Tag.
+func (s Tag) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A tag and its associated resource.
 type TaggedResource struct {
 	// The Amazon Resource Name (ARN) with which the tag is associated. For example,
@@ -5631,6 +6606,11 @@ type metadataTaggedResource struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TaggedResource.
+func (s TaggedResource) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the members of a VPC security group.
 type VPCSecurityGroupMembership struct {
 	Status *string `type:"string"`
@@ -5642,4 +6622,9 @@ type VPCSecurityGroupMembership struct {
 
 type metadataVPCSecurityGroupMembership struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the VPCSecurityGroupMembership.
+func (s VPCSecurityGroupMembership) String() string {
+	return awsutil.StringValue(s)
 }
\ No newline at end of file
@@ -4,9 +4,11 @@
 package route53
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // AssociateVPCWithHostedZoneRequest generates a request for the AssociateVPCWithHostedZone operation.
@@ -702,6 +704,12 @@ func (c *Route53) ListHealthChecksRequest(input *ListHealthChecksInput) (req *aw
 			Name:       "ListHealthChecks",
 			HTTPMethod: "GET",
 			HTTPPath:   "/2013-04-01/healthcheck",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"NextMarker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -727,6 +735,16 @@ func (c *Route53) ListHealthChecks(input *ListHealthChecksInput) (output *ListHe
 	return
 }
 
+// ListHealthChecksPages iterates over the pages of a ListHealthChecks operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *Route53) ListHealthChecksPages(input *ListHealthChecksInput, fn func(p *ListHealthChecksOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListHealthChecksRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListHealthChecksOutput), lastPage)
+	})
+}
+
 var opListHealthChecks *aws.Operation
 
 // ListHostedZonesRequest generates a request for the ListHostedZones operation.
@@ -736,6 +754,12 @@ func (c *Route53) ListHostedZonesRequest(input *ListHostedZonesInput) (req *aws.
 			Name:       "ListHostedZones",
 			HTTPMethod: "GET",
 			HTTPPath:   "/2013-04-01/hostedzone",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"NextMarker"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -761,6 +785,16 @@ func (c *Route53) ListHostedZones(input *ListHostedZonesInput) (output *ListHost
 	return
 }
 
+// ListHostedZonesPages iterates over the pages of a ListHostedZones operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *Route53) ListHostedZonesPages(input *ListHostedZonesInput, fn func(p *ListHostedZonesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListHostedZonesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListHostedZonesOutput), lastPage)
+	})
+}
+
 var opListHostedZones *aws.Operation
 
 // ListHostedZonesByNameRequest generates a request for the ListHostedZonesByName operation.
@@ -806,6 +840,12 @@ func (c *Route53) ListResourceRecordSetsRequest(input *ListResourceRecordSetsInp
 			Name:       "ListResourceRecordSets",
 			HTTPMethod: "GET",
 			HTTPPath:   "/2013-04-01/hostedzone/{Id}/rrset",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"StartRecordName", "StartRecordType", "StartRecordIdentifier"},
+				OutputTokens:    []string{"NextRecordName", "NextRecordType", "NextRecordIdentifier"},
+				LimitToken:      "MaxItems",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -860,6 +900,16 @@ func (c *Route53) ListResourceRecordSets(input *ListResourceRecordSetsInput) (ou
 	return
 }
 
+// ListResourceRecordSetsPages iterates over the pages of a ListResourceRecordSets operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *Route53) ListResourceRecordSetsPages(input *ListResourceRecordSetsInput, fn func(p *ListResourceRecordSetsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListResourceRecordSetsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListResourceRecordSetsOutput), lastPage)
+	})
+}
+
 var opListResourceRecordSets *aws.Operation
 
 // ListReusableDelegationSetsRequest generates a request for the ListReusableDelegationSets operation.
@@ -1025,7 +1075,7 @@ type AliasTarget struct {
 	// in the Amazon Route 53 Developer Guide
 	//
 	// .
-	DNSName *string `type:"string" required:"true"`
+	DNSName *string `type:"string" max:"1024" required:"true"`
 
 	// Alias resource record sets only: A boolean value that indicates whether this
 	// Resource Record Set should respect the health status of any health checks
@@ -1046,7 +1096,7 @@ type AliasTarget struct {
 	// in the Amazon Route 53 Developer Guide
 	//
 	// .
-	HostedZoneID *string `locationName:"HostedZoneId" type:"string" required:"true"`
+	HostedZoneID *string `locationName:"HostedZoneId" type:"string" max:"32" required:"true"`
 
 	metadataAliasTarget `json:"-", xml:"-"`
 }
@@ -1055,6 +1105,11 @@ type metadataAliasTarget struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AliasTarget.
+func (s AliasTarget) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains information about the request to associate a
 // VPC with an hosted zone.
 type AssociateVPCWithHostedZoneInput struct {
@@ -1065,7 +1120,7 @@ type AssociateVPCWithHostedZoneInput struct {
 	//
 	// Note that you cannot associate a VPC with a hosted zone that doesn't have
 	// an existing VPC association.
-	HostedZoneID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	HostedZoneID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	// The VPC that you want your hosted zone to be associated with.
 	VPC *VPC `type:"structure" required:"true"`
@@ -1077,6 +1132,11 @@ type metadataAssociateVPCWithHostedZoneInput struct {
 	SDKShapeTraits bool `locationName:"AssociateVPCWithHostedZoneRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
 }
 
+// String returns the string representation of the AssociateVPCWithHostedZoneInput.
+func (s AssociateVPCWithHostedZoneInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type containing the response information for the request.
 type AssociateVPCWithHostedZoneOutput struct {
 	// A complex type that contains the ID, the status, and the date and time of
@@ -1090,13 +1150,18 @@ type metadataAssociateVPCWithHostedZoneOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AssociateVPCWithHostedZoneOutput.
+func (s AssociateVPCWithHostedZoneOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains the information for each change in a change
 // batch request.
 type Change struct {
 	// The action to perform.
 	//
 	// Valid values: CREATE | DELETE | UPSERT
-	Action *string `type:"string" required:"true"`
+	Action *string `type:"string" enum:"CREATE,DELETE,UPSERT" required:"true"`
 
 	// Information about the resource record set to create or delete.
 	ResourceRecordSet *ResourceRecordSet `type:"structure" required:"true"`
@@ -1108,15 +1173,56 @@ type metadataChange struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Change.
+func (s Change) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ChangeAction is an enum of the values of ChangeAction members.
+type ChangeAction string
+
+// The values of ChangeAction.
+const (
+	ChangeActionCREATE ChangeAction = "CREATE"
+	ChangeActionDELETE ChangeAction = "DELETE"
+	ChangeActionUPSERT ChangeAction = "UPSERT"
+)
+
+// ChangeActionValues returns the values of ChangeAction known to this
+// version of the SDK. The service may accept values added since.
+func ChangeActionValues() []ChangeAction {
+	return []ChangeAction{
+		ChangeAction("CREATE"),
+		ChangeAction("DELETE"),
+		ChangeAction("UPSERT"),
+	}
+}
+
+// String returns the ChangeAction as the string of a member.
+func (v ChangeAction) String() string {
+	return string(v)
+}
+
+// ParseChangeAction returns the ChangeAction of str, failing if it is not
+// one of the ChangeActionValues.
+func ParseChangeAction(str string) (ChangeAction, error) {
+	for _, v := range ChangeActionValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ChangeAction value %q", str)
+}
+
 // A complex type that contains an optional comment and the changes that you
 // want to make with a change batch request.
 type ChangeBatch struct {
 	// A complex type that contains one Change element for each resource record
 	// set that you want to create or delete.
-	Changes []*Change `locationNameList:"Change" type:"list" required:"true"`
+	Changes []*Change `locationNameList:"Change" type:"list" min:"1" required:"true"`
 
 	// Optional: Any comments you want to include about a change batch request.
-	Comment *string `type:"string"`
+	Comment *string `type:"string" max:"256"`
 
 	metadataChangeBatch `json:"-", xml:"-"`
 }
@@ -1125,6 +1231,11 @@ type metadataChangeBatch struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ChangeBatch.
+func (s ChangeBatch) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that describes change information about changes made to your
 // hosted zone.
 //
@@ -1136,17 +1247,17 @@ type ChangeInfo struct {
 	//
 	// This element contains an ID that you use when performing a GetChange action
 	// to get detailed information about the change.
-	Comment *string `type:"string"`
+	Comment *string `type:"string" max:"256"`
 
 	// The ID of the request. Use this ID to track when the change has completed
 	// across all Amazon Route 53 DNS servers.
-	ID *string `locationName:"Id" type:"string" required:"true"`
+	ID *string `locationName:"Id" type:"string" max:"32" required:"true"`
 
 	// The current state of the request. PENDING indicates that this request has
 	// not yet been applied to all Amazon Route 53 DNS servers.
 	//
 	// Valid Values: PENDING | INSYNC
-	Status *string `type:"string" required:"true"`
+	Status *string `type:"string" enum:"PENDING,INSYNC" required:"true"`
 
 	// The date and time the change was submitted, in the format YYYY-MM-DDThh:mm:ssZ,
 	// as specified in the ISO 8601 standard (for example, 2009-11-19T19:37:58Z).
@@ -1161,6 +1272,11 @@ type metadataChangeInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ChangeInfo.
+func (s ChangeInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains a change batch.
 type ChangeResourceRecordSetsInput struct {
 	// A complex type that contains an optional comment and the Changes element.
@@ -1168,7 +1284,7 @@ type ChangeResourceRecordSetsInput struct {
 
 	// The ID of the hosted zone that contains the resource record sets that you
 	// want to change.
-	HostedZoneID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	HostedZoneID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	metadataChangeResourceRecordSetsInput `json:"-", xml:"-"`
 }
@@ -1177,6 +1293,11 @@ type metadataChangeResourceRecordSetsInput struct {
 	SDKShapeTraits bool `locationName:"ChangeResourceRecordSetsRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
 }
 
+// String returns the string representation of the ChangeResourceRecordSetsInput.
+func (s ChangeResourceRecordSetsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type containing the response for the request.
 type ChangeResourceRecordSetsOutput struct {
 	// A complex type that contains information about changes made to your hosted
@@ -1193,25 +1314,64 @@ type metadataChangeResourceRecordSetsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ChangeResourceRecordSetsOutput.
+func (s ChangeResourceRecordSetsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ChangeStatus is an enum of the values of ChangeStatus members.
+type ChangeStatus string
+
+// The values of ChangeStatus.
+const (
+	ChangeStatusPENDING ChangeStatus = "PENDING"
+	ChangeStatusINSYNC  ChangeStatus = "INSYNC"
+)
+
+// ChangeStatusValues returns the values of ChangeStatus known to this
+// version of the SDK. The service may accept values added since.
+func ChangeStatusValues() []ChangeStatus {
+	return []ChangeStatus{
+		ChangeStatus("PENDING"),
+		ChangeStatus("INSYNC"),
+	}
+}
+
+// String returns the ChangeStatus as the string of a member.
+func (v ChangeStatus) String() string {
+	return string(v)
+}
+
+// ParseChangeStatus returns the ChangeStatus of str, failing if it is not
+// one of the ChangeStatusValues.
+func ParseChangeStatus(str string) (ChangeStatus, error) {
+	for _, v := range ChangeStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ChangeStatus value %q", str)
+}
+
 // A complex type containing information about a request to add, change, or
 // delete the tags that are associated with a resource.
 type ChangeTagsForResourceInput struct {
 	// A complex type that contains a list of Tag elements. Each Tag element identifies
 	// a tag that you want to add or update for the specified resource.
-	AddTags []*Tag `locationNameList:"Tag" type:"list"`
+	AddTags []*Tag `locationNameList:"Tag" type:"list" min:"1" max:"10"`
 
 	// A list of Tag keys that you want to remove from the specified resource.
-	RemoveTagKeys []*string `locationNameList:"Key" type:"list"`
+	RemoveTagKeys []*string `locationNameList:"Key" type:"list" min:"1" max:"10"`
 
 	// The ID of the resource for which you want to add, change, or delete tags.
-	ResourceID *string `location:"uri" locationName:"ResourceId" type:"string" required:"true"`
+	ResourceID *string `location:"uri" locationName:"ResourceId" type:"string" max:"64" required:"true"`
 
 	// The type of the resource.
 	//
 	// - The resource type for health checks is healthcheck.
 	//
 	// - The resource type for hosted zones is hostedzone.
-	ResourceType *string `location:"uri" locationName:"ResourceType" type:"string" required:"true"`
+	ResourceType *string `location:"uri" locationName:"ResourceType" type:"string" enum:"healthcheck,hostedzone" required:"true"`
 
 	metadataChangeTagsForResourceInput `json:"-", xml:"-"`
 }
@@ -1220,6 +1380,11 @@ type metadataChangeTagsForResourceInput struct {
 	SDKShapeTraits bool `locationName:"ChangeTagsForResourceRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
 }
 
+// String returns the string representation of the ChangeTagsForResourceInput.
+func (s ChangeTagsForResourceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Empty response for the request.
 type ChangeTagsForResourceOutput struct {
 	metadataChangeTagsForResourceOutput `json:"-", xml:"-"`
@@ -1229,6 +1394,11 @@ type metadataChangeTagsForResourceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ChangeTagsForResourceOutput.
+func (s ChangeTagsForResourceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // >A complex type that contains information about the request to create a health
 // check.
 type CreateHealthCheckInput struct {
@@ -1240,7 +1410,7 @@ type CreateHealthCheckInput struct {
 	//
 	// Valid characters are any Unicode code points that are legal in an XML 1.0
 	// document. The UTF-8 encoding of the value must be less than 128 bytes.
-	CallerReference *string `type:"string" required:"true"`
+	CallerReference *string `type:"string" min:"1" max:"64" required:"true"`
 
 	// A complex type that contains health check configuration.
 	HealthCheckConfig *HealthCheckConfig `type:"structure" required:"true"`
@@ -1252,13 +1422,18 @@ type metadataCreateHealthCheckInput struct {
 	SDKShapeTraits bool `locationName:"CreateHealthCheckRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
 }
 
+// String returns the string representation of the CreateHealthCheckInput.
+func (s CreateHealthCheckInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type containing the response information for the new health check.
 type CreateHealthCheckOutput struct {
 	// A complex type that contains identifying information about the health check.
 	HealthCheck *HealthCheck `type:"structure" required:"true"`
 
 	// The unique URL representing the new health check.
-	Location *string `location:"header" locationName:"Location" type:"string" required:"true"`
+	Location *string `location:"header" locationName:"Location" type:"string" max:"1024" required:"true"`
 
 	metadataCreateHealthCheckOutput `json:"-", xml:"-"`
 }
@@ -1267,6 +1442,11 @@ type metadataCreateHealthCheckOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateHealthCheckOutput.
+func (s CreateHealthCheckOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains information about the request to create a hosted
 // zone.
 type CreateHostedZoneInput struct {
@@ -1278,11 +1458,11 @@ type CreateHostedZoneInput struct {
 	//
 	// Valid characters are any Unicode code points that are legal in an XML 1.0
 	// document. The UTF-8 encoding of the value must be less than 128 bytes.
-	CallerReference *string `type:"string" required:"true"`
+	CallerReference *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The delegation set id of the reusable delgation set whose NS records you
 	// want to assign to the new hosted zone.
-	DelegationSetID *string `locationName:"DelegationSetId" type:"string"`
+	DelegationSetID *string `locationName:"DelegationSetId" type:"string" max:"32"`
 
 	// A complex type that contains an optional comment about your hosted zone.
 	HostedZoneConfig *HostedZoneConfig `type:"structure"`
@@ -1295,7 +1475,7 @@ type CreateHostedZoneInput struct {
 	// This is the name you have registered with your DNS registrar. You should
 	// ask your registrar to change the authoritative name servers for your domain
 	// to the set of NameServers elements returned in DelegationSet.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" max:"1024" required:"true"`
 
 	// The VPC that you want your hosted zone to be associated with. By providing
 	// this parameter, your newly created hosted cannot be resolved anywhere other
@@ -1309,6 +1489,11 @@ type metadataCreateHostedZoneInput struct {
 	SDKShapeTraits bool `locationName:"CreateHostedZoneRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
 }
 
+// String returns the string representation of the CreateHostedZoneInput.
+func (s CreateHostedZoneInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type containing the response information for the new hosted zone.
 type CreateHostedZoneOutput struct {
 	// A complex type that contains information about the request to create a hosted
@@ -1323,7 +1508,7 @@ type CreateHostedZoneOutput struct {
 	HostedZone *HostedZone `type:"structure" required:"true"`
 
 	// The unique URL representing the new hosted zone.
-	Location *string `location:"header" locationName:"Location" type:"string" required:"true"`
+	Location *string `location:"header" locationName:"Location" type:"string" max:"1024" required:"true"`
 
 	VPC *VPC `type:"structure"`
 
@@ -1334,6 +1519,11 @@ type metadataCreateHostedZoneOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateHostedZoneOutput.
+func (s CreateHostedZoneOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateReusableDelegationSetInput struct {
 	// A unique string that identifies the request and that allows failed CreateReusableDelegationSet
 	// requests to be retried without the risk of executing the operation twice.
@@ -1343,11 +1533,11 @@ type CreateReusableDelegationSetInput struct {
 	//
 	// Valid characters are any Unicode code points that are legal in an XML 1.0
 	// document. The UTF-8 encoding of the value must be less than 128 bytes.
-	CallerReference *string `type:"string" required:"true"`
+	CallerReference *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// The ID of the hosted zone whose delegation set you want to mark as reusable.
 	// It is an optional parameter.
-	HostedZoneID *string `locationName:"HostedZoneId" type:"string"`
+	HostedZoneID *string `locationName:"HostedZoneId" type:"string" max:"32"`
 
 	metadataCreateReusableDelegationSetInput `json:"-", xml:"-"`
 }
@@ -1356,12 +1546,17 @@ type metadataCreateReusableDelegationSetInput struct {
 	SDKShapeTraits bool `locationName:"CreateReusableDelegationSetRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
 }
 
+// String returns the string representation of the CreateReusableDelegationSetInput.
+func (s CreateReusableDelegationSetInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateReusableDelegationSetOutput struct {
 	// A complex type that contains name server information.
 	DelegationSet *DelegationSet `type:"structure" required:"true"`
 
 	// The unique URL representing the new reusbale delegation set.
-	Location *string `location:"header" locationName:"Location" type:"string" required:"true"`
+	Location *string `location:"header" locationName:"Location" type:"string" max:"1024" required:"true"`
 
 	metadataCreateReusableDelegationSetOutput `json:"-", xml:"-"`
 }
@@ -1370,16 +1565,21 @@ type metadataCreateReusableDelegationSetOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateReusableDelegationSetOutput.
+func (s CreateReusableDelegationSetOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains name server information.
 type DelegationSet struct {
-	CallerReference *string `type:"string"`
+	CallerReference *string `type:"string" min:"1" max:"128"`
 
-	ID *string `locationName:"Id" type:"string"`
+	ID *string `locationName:"Id" type:"string" max:"32"`
 
 	// A complex type that contains the authoritative name servers for the hosted
 	// zone. Use the method provided by your domain registrar to add an NS record
 	// to your domain for each NameServer that is assigned to your hosted zone.
-	NameServers []*string `locationNameList:"NameServer" type:"list" required:"true"`
+	NameServers []*string `locationNameList:"NameServer" type:"list" min:"1" required:"true"`
 
 	metadataDelegationSet `json:"-", xml:"-"`
 }
@@ -1388,10 +1588,15 @@ type metadataDelegationSet struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DelegationSet.
+func (s DelegationSet) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type containing the request information for delete health check.
 type DeleteHealthCheckInput struct {
 	// The ID of the health check to delete.
-	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" required:"true"`
+	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" max:"64" required:"true"`
 
 	metadataDeleteHealthCheckInput `json:"-", xml:"-"`
 }
@@ -1400,6 +1605,11 @@ type metadataDeleteHealthCheckInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteHealthCheckInput.
+func (s DeleteHealthCheckInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Empty response for the request.
 type DeleteHealthCheckOutput struct {
 	metadataDeleteHealthCheckOutput `json:"-", xml:"-"`
@@ -1409,11 +1619,16 @@ type metadataDeleteHealthCheckOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteHealthCheckOutput.
+func (s DeleteHealthCheckOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains information about the hosted zone that you want
 // to delete.
 type DeleteHostedZoneInput struct {
 	// The ID of the hosted zone you want to delete.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	metadataDeleteHostedZoneInput `json:"-", xml:"-"`
 }
@@ -1422,6 +1637,11 @@ type metadataDeleteHostedZoneInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteHostedZoneInput.
+func (s DeleteHostedZoneInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type containing the response information for the request.
 type DeleteHostedZoneOutput struct {
 	// A complex type that contains the ID, the status, and the date and time of
@@ -1435,10 +1655,15 @@ type metadataDeleteHostedZoneOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteHostedZoneOutput.
+func (s DeleteHostedZoneOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type containing the information for the delete request.
 type DeleteReusableDelegationSetInput struct {
 	// The ID of the reusable delegation set you want to delete.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	metadataDeleteReusableDelegationSetInput `json:"-", xml:"-"`
 }
@@ -1447,6 +1672,11 @@ type metadataDeleteReusableDelegationSetInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteReusableDelegationSetInput.
+func (s DeleteReusableDelegationSetInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Empty response for the request.
 type DeleteReusableDelegationSetOutput struct {
 	metadataDeleteReusableDelegationSetOutput `json:"-", xml:"-"`
@@ -1456,6 +1686,11 @@ type metadataDeleteReusableDelegationSetOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteReusableDelegationSetOutput.
+func (s DeleteReusableDelegationSetOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains information about the request to disassociate
 // a VPC from an hosted zone.
 type DisassociateVPCFromHostedZoneInput struct {
@@ -1465,7 +1700,7 @@ type DisassociateVPCFromHostedZoneInput struct {
 	// The ID of the hosted zone you want to disassociate your VPC from.
 	//
 	// Note that you cannot disassociate the last VPC from a hosted zone.
-	HostedZoneID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	HostedZoneID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	// The VPC that you want your hosted zone to be disassociated from.
 	VPC *VPC `type:"structure" required:"true"`
@@ -1477,6 +1712,11 @@ type metadataDisassociateVPCFromHostedZoneInput struct {
 	SDKShapeTraits bool `locationName:"DisassociateVPCFromHostedZoneRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
 }
 
+// String returns the string representation of the DisassociateVPCFromHostedZoneInput.
+func (s DisassociateVPCFromHostedZoneInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type containing the response information for the request.
 type DisassociateVPCFromHostedZoneOutput struct {
 	// A complex type that contains the ID, the status, and the date and time of
@@ -1490,6 +1730,11 @@ type metadataDisassociateVPCFromHostedZoneOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DisassociateVPCFromHostedZoneOutput.
+func (s DisassociateVPCFromHostedZoneOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains information about a geo location.
 type GeoLocation struct {
 	// The code for a continent geo location. Note: only continent locations have
@@ -1499,21 +1744,21 @@ type GeoLocation struct {
 	//
 	// Constraint: Specifying ContinentCode with either CountryCode or SubdivisionCode
 	// returns an InvalidInput error.
-	ContinentCode *string `type:"string"`
+	ContinentCode *string `type:"string" min:"2" max:"2"`
 
 	// The code for a country geo location. The default location uses '*' for the
 	// country code and will match all locations that are not matched by a geo location.
 	//
 	// The default geo location uses a * for the country code. All other country
 	// codes follow the ISO 3166 two-character code.
-	CountryCode *string `type:"string"`
+	CountryCode *string `type:"string" min:"1" max:"2"`
 
 	// The code for a country's subdivision (e.g., a province of Canada). A subdivision
 	// code is only valid with the appropriate country code.
 	//
 	// Constraint: Specifying SubdivisionCode without CountryCode returns an InvalidInput
 	// error.
-	SubdivisionCode *string `type:"string"`
+	SubdivisionCode *string `type:"string" min:"1" max:"3"`
 
 	metadataGeoLocation `json:"-", xml:"-"`
 }
@@ -1522,34 +1767,39 @@ type metadataGeoLocation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GeoLocation.
+func (s GeoLocation) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains information about a GeoLocation.
 type GeoLocationDetails struct {
 	// The code for a continent geo location. Note: only continent locations have
 	// a continent code.
-	ContinentCode *string `type:"string"`
+	ContinentCode *string `type:"string" min:"2" max:"2"`
 
 	// The name of the continent. This element is only present if ContinentCode
 	// is also present.
-	ContinentName *string `type:"string"`
+	ContinentName *string `type:"string" min:"1" max:"32"`
 
 	// The code for a country geo location. The default location uses '*' for the
 	// country code and will match all locations that are not matched by a geo location.
 	//
 	// The default geo location uses a * for the country code. All other country
 	// codes follow the ISO 3166 two-character code.
-	CountryCode *string `type:"string"`
+	CountryCode *string `type:"string" min:"1" max:"2"`
 
 	// The name of the country. This element is only present if CountryCode is also
 	// present.
-	CountryName *string `type:"string"`
+	CountryName *string `type:"string" min:"1" max:"64"`
 
 	// The code for a country's subdivision (e.g., a province of Canada). A subdivision
 	// code is only valid with the appropriate country code.
-	SubdivisionCode *string `type:"string"`
+	SubdivisionCode *string `type:"string" min:"1" max:"3"`
 
 	// The name of the subdivision. This element is only present if SubdivisionCode
 	// is also present.
-	SubdivisionName *string `type:"string"`
+	SubdivisionName *string `type:"string" min:"1" max:"64"`
 
 	metadataGeoLocationDetails `json:"-", xml:"-"`
 }
@@ -1558,12 +1808,17 @@ type metadataGeoLocationDetails struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GeoLocationDetails.
+func (s GeoLocationDetails) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for a GetChange request.
 type GetChangeInput struct {
 	// The ID of the change batch request. The value that you specify here is the
 	// value that ChangeResourceRecordSets returned in the Id element when you submitted
 	// the request.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	metadataGetChangeInput `json:"-", xml:"-"`
 }
@@ -1572,6 +1827,11 @@ type metadataGetChangeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetChangeInput.
+func (s GetChangeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains the ChangeInfo element.
 type GetChangeOutput struct {
 	// A complex type that contains information about the specified change batch,
@@ -1586,6 +1846,11 @@ type metadataGetChangeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetChangeOutput.
+func (s GetChangeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Empty request.
 type GetCheckerIPRangesInput struct {
 	metadataGetCheckerIPRangesInput `json:"-", xml:"-"`
@@ -1595,6 +1860,11 @@ type metadataGetCheckerIPRangesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetCheckerIPRangesInput.
+func (s GetCheckerIPRangesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains the CheckerIpRanges element.
 type GetCheckerIPRangesOutput struct {
 	// A complex type that contains sorted list of IP ranges in CIDR format for
@@ -1608,6 +1878,11 @@ type metadataGetCheckerIPRangesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetCheckerIPRangesOutput.
+func (s GetCheckerIPRangesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains information about the request to get a geo location.
 type GetGeoLocationInput struct {
 	// The code for a continent geo location. Note: only continent locations have
@@ -1617,21 +1892,21 @@ type GetGeoLocationInput struct {
 	//
 	// Constraint: Specifying ContinentCode with either CountryCode or SubdivisionCode
 	// returns an InvalidInput error.
-	ContinentCode *string `location:"querystring" locationName:"continentcode" type:"string"`
+	ContinentCode *string `location:"querystring" locationName:"continentcode" type:"string" min:"2" max:"2"`
 
 	// The code for a country geo location. The default location uses '*' for the
 	// country code and will match all locations that are not matched by a geo location.
 	//
 	// The default geo location uses a * for the country code. All other country
 	// codes follow the ISO 3166 two-character code.
-	CountryCode *string `location:"querystring" locationName:"countrycode" type:"string"`
+	CountryCode *string `location:"querystring" locationName:"countrycode" type:"string" min:"1" max:"2"`
 
 	// The code for a country's subdivision (e.g., a province of Canada). A subdivision
 	// code is only valid with the appropriate country code.
 	//
 	// Constraint: Specifying SubdivisionCode without CountryCode returns an InvalidInput
 	// error.
-	SubdivisionCode *string `location:"querystring" locationName:"subdivisioncode" type:"string"`
+	SubdivisionCode *string `location:"querystring" locationName:"subdivisioncode" type:"string" min:"1" max:"3"`
 
 	metadataGetGeoLocationInput `json:"-", xml:"-"`
 }
@@ -1640,6 +1915,11 @@ type metadataGetGeoLocationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetGeoLocationInput.
+func (s GetGeoLocationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type containing information about the specified geo location.
 type GetGeoLocationOutput struct {
 	// A complex type that contains the information about the specified geo location.
@@ -1652,6 +1932,11 @@ type metadataGetGeoLocationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetGeoLocationOutput.
+func (s GetGeoLocationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // To retrieve a count of all your health checks, send a GET request to the
 // 2013-04-01/healthcheckcount resource.
 type GetHealthCheckCountInput struct {
@@ -1662,6 +1947,11 @@ type metadataGetHealthCheckCountInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetHealthCheckCountInput.
+func (s GetHealthCheckCountInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains the count of health checks associated with the
 // current AWS account.
 type GetHealthCheckCountOutput struct {
@@ -1675,11 +1965,16 @@ type metadataGetHealthCheckCountOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetHealthCheckCountOutput.
+func (s GetHealthCheckCountOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains information about the request to get a health
 // check.
 type GetHealthCheckInput struct {
 	// The ID of the health check to retrieve.
-	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" required:"true"`
+	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" max:"64" required:"true"`
 
 	metadataGetHealthCheckInput `json:"-", xml:"-"`
 }
@@ -1688,12 +1983,17 @@ type metadataGetHealthCheckInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetHealthCheckInput.
+func (s GetHealthCheckInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains information about the request to get the most
 // recent failure reason for a health check.
 type GetHealthCheckLastFailureReasonInput struct {
 	// The ID of the health check for which you want to retrieve the reason for
 	// the most recent failure.
-	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" required:"true"`
+	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" max:"64" required:"true"`
 
 	metadataGetHealthCheckLastFailureReasonInput `json:"-", xml:"-"`
 }
@@ -1702,6 +2002,11 @@ type metadataGetHealthCheckLastFailureReasonInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetHealthCheckLastFailureReasonInput.
+func (s GetHealthCheckLastFailureReasonInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains information about the most recent failure for
 // the specified health check.
 type GetHealthCheckLastFailureReasonOutput struct {
@@ -1716,6 +2021,11 @@ type metadataGetHealthCheckLastFailureReasonOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetHealthCheckLastFailureReasonOutput.
+func (s GetHealthCheckLastFailureReasonOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type containing information about the specified health check.
 type GetHealthCheckOutput struct {
 	// A complex type that contains the information about the specified health check.
@@ -1728,12 +2038,17 @@ type metadataGetHealthCheckOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetHealthCheckOutput.
+func (s GetHealthCheckOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains information about the request to get health
 // check status for a health check.
 type GetHealthCheckStatusInput struct {
 	// The ID of the health check for which you want to retrieve the most recent
 	// status.
-	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" required:"true"`
+	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" max:"64" required:"true"`
 
 	metadataGetHealthCheckStatusInput `json:"-", xml:"-"`
 }
@@ -1742,6 +2057,11 @@ type metadataGetHealthCheckStatusInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetHealthCheckStatusInput.
+func (s GetHealthCheckStatusInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains information about the status of the specified
 // health check.
 type GetHealthCheckStatusOutput struct {
@@ -1756,6 +2076,11 @@ type metadataGetHealthCheckStatusOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetHealthCheckStatusOutput.
+func (s GetHealthCheckStatusOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // To retrieve a count of all your hosted zones, send a GET request to the 2013-04-01/hostedzonecount
 // resource.
 type GetHostedZoneCountInput struct {
@@ -1766,6 +2091,11 @@ type metadataGetHostedZoneCountInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetHostedZoneCountInput.
+func (s GetHostedZoneCountInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains the count of hosted zones associated with the
 // current AWS account.
 type GetHostedZoneCountOutput struct {
@@ -1779,11 +2109,16 @@ type metadataGetHostedZoneCountOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetHostedZoneCountOutput.
+func (s GetHostedZoneCountOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for a GetHostedZone request.
 type GetHostedZoneInput struct {
 	// The ID of the hosted zone for which you want to get a list of the name servers
 	// in the delegation set.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	metadataGetHostedZoneInput `json:"-", xml:"-"`
 }
@@ -1792,6 +2127,11 @@ type metadataGetHostedZoneInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetHostedZoneInput.
+func (s GetHostedZoneInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type containing information about the specified hosted zone.
 type GetHostedZoneOutput struct {
 	// A complex type that contains information about the name servers for the specified
@@ -1803,7 +2143,7 @@ type GetHostedZoneOutput struct {
 
 	// A complex type that contains information about VPCs associated with the specified
 	// hosted zone.
-	VPCs []*VPC `locationNameList:"VPC" type:"list"`
+	VPCs []*VPC `locationNameList:"VPC" type:"list" min:"1"`
 
 	metadataGetHostedZoneOutput `json:"-", xml:"-"`
 }
@@ -1812,11 +2152,16 @@ type metadataGetHostedZoneOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetHostedZoneOutput.
+func (s GetHostedZoneOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for a GetReusableDelegationSet request.
 type GetReusableDelegationSetInput struct {
 	// The ID of the reusable delegation set for which you want to get a list of
 	// the name server.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	metadataGetReusableDelegationSetInput `json:"-", xml:"-"`
 }
@@ -1825,6 +2170,11 @@ type metadataGetReusableDelegationSetInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetReusableDelegationSetInput.
+func (s GetReusableDelegationSetInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type containing information about the specified reusable delegation
 // set.
 type GetReusableDelegationSetOutput struct {
@@ -1839,10 +2189,15 @@ type metadataGetReusableDelegationSetOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetReusableDelegationSetOutput.
+func (s GetReusableDelegationSetOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains identifying information about the health check.
 type HealthCheck struct {
 	// A unique string that identifies the request to create the health check.
-	CallerReference *string `type:"string" required:"true"`
+	CallerReference *string `type:"string" min:"1" max:"64" required:"true"`
 
 	// A complex type that contains the health check configuration.
 	HealthCheckConfig *HealthCheckConfig `type:"structure" required:"true"`
@@ -1850,10 +2205,10 @@ type HealthCheck struct {
 	// The version of the health check. You can optionally pass this value in a
 	// call to UpdateHealthCheck to prevent overwriting another change to the health
 	// check.
-	HealthCheckVersion *int64 `type:"long" required:"true"`
+	HealthCheckVersion *int64 `type:"long" min:"1" required:"true"`
 
 	// The ID of the specified health check.
-	ID *string `locationName:"Id" type:"string" required:"true"`
+	ID *string `locationName:"Id" type:"string" max:"64" required:"true"`
 
 	metadataHealthCheck `json:"-", xml:"-"`
 }
@@ -1862,6 +2217,11 @@ type metadataHealthCheck struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the HealthCheck.
+func (s HealthCheck) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains the health check configuration.
 type HealthCheckConfig struct {
 	// The number of consecutive health checks that an endpoint must pass or fail
@@ -1871,38 +2231,38 @@ type HealthCheckConfig struct {
 	// Valid values are integers between 1 and 10. For more information, see "How
 	// Amazon Route 53 Determines Whether an Endpoint Is Healthy" in the Amazon
 	// Route 53 Developer Guide.
-	FailureThreshold *int64 `type:"integer"`
+	FailureThreshold *int64 `type:"integer" min:"1" max:"10"`
 
 	// Fully qualified domain name of the instance to be health checked.
-	FullyQualifiedDomainName *string `type:"string"`
+	FullyQualifiedDomainName *string `type:"string" max:"255"`
 
 	// IP Address of the instance being checked.
-	IPAddress *string `type:"string"`
+	IPAddress *string `type:"string" max:"15" pattern:"^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$"`
 
 	// Port on which connection will be opened to the instance to health check.
 	// For HTTP and HTTP_STR_MATCH this defaults to 80 if the port is not specified.
 	// For HTTPS and HTTPS_STR_MATCH this defaults to 443 if the port is not specified.
-	Port *int64 `type:"integer"`
+	Port *int64 `type:"integer" min:"1" max:"65535"`
 
 	// The number of seconds between the time that Route 53 gets a response from
 	// your endpoint and the time that it sends the next health-check request.
 	//
 	// Each Route 53 health checker makes requests at this interval. Valid values
 	// are 10 and 30. The default value is 30.
-	RequestInterval *int64 `type:"integer"`
+	RequestInterval *int64 `type:"integer" min:"10" max:"30"`
 
 	// Path to ping on the instance to check the health. Required for HTTP, HTTPS,
 	// HTTP_STR_MATCH, and HTTPS_STR_MATCH health checks, HTTP request is issued
 	// to the instance on the given port and path.
-	ResourcePath *string `type:"string"`
+	ResourcePath *string `type:"string" max:"255"`
 
 	// A string to search for in the body of a health check response. Required for
 	// HTTP_STR_MATCH and HTTPS_STR_MATCH health checks.
-	SearchString *string `type:"string"`
+	SearchString *string `type:"string" max:"255"`
 
 	// The type of health check to be performed. Currently supported types are TCP,
 	// HTTP, HTTPS, HTTP_STR_MATCH, and HTTPS_STR_MATCH.
-	Type *string `type:"string" required:"true"`
+	Type *string `type:"string" enum:"HTTP,HTTPS,HTTP_STR_MATCH,HTTPS_STR_MATCH,TCP" required:"true"`
 
 	metadataHealthCheckConfig `json:"-", xml:"-"`
 }
@@ -1911,11 +2271,16 @@ type metadataHealthCheckConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the HealthCheckConfig.
+func (s HealthCheckConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains the IP address of a Route 53 health checker
 // and the reason for the health check status.
 type HealthCheckObservation struct {
 	// The IP address of the Route 53 health checker that performed the health check.
-	IPAddress *string `type:"string"`
+	IPAddress *string `type:"string" max:"15" pattern:"^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$"`
 
 	// A complex type that contains information about the health check status for
 	// the current observation.
@@ -1928,16 +2293,61 @@ type metadataHealthCheckObservation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the HealthCheckObservation.
+func (s HealthCheckObservation) String() string {
+	return awsutil.StringValue(s)
+}
+
+// HealthCheckType is an enum of the values of HealthCheckType members.
+type HealthCheckType string
+
+// The values of HealthCheckType.
+const (
+	HealthCheckTypeHTTP          HealthCheckType = "HTTP"
+	HealthCheckTypeHTTPS         HealthCheckType = "HTTPS"
+	HealthCheckTypeHTTPSTRMATCH  HealthCheckType = "HTTP_STR_MATCH"
+	HealthCheckTypeHTTPSSTRMATCH HealthCheckType = "HTTPS_STR_MATCH"
+	HealthCheckTypeTCP           HealthCheckType = "TCP"
+)
+
+// HealthCheckTypeValues returns the values of HealthCheckType known to this
+// version of the SDK. The service may accept values added since.
+func HealthCheckTypeValues() []HealthCheckType {
+	return []HealthCheckType{
+		HealthCheckType("HTTP"),
+		HealthCheckType("HTTPS"),
+		HealthCheckType("HTTP_STR_MATCH"),
+		HealthCheckType("HTTPS_STR_MATCH"),
+		HealthCheckType("TCP"),
+	}
+}
+
+// String returns the HealthCheckType as the string of a member.
+func (v HealthCheckType) String() string {
+	return string(v)
+}
+
+// ParseHealthCheckType returns the HealthCheckType of str, failing if it is not
+// one of the HealthCheckTypeValues.
+func ParseHealthCheckType(str string) (HealthCheckType, error) {
+	for _, v := range HealthCheckTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HealthCheckType value %q", str)
+}
+
 // A complex type that contain information about the specified hosted zone.
 type HostedZone struct {
 	// A unique string that identifies the request to create the hosted zone.
-	CallerReference *string `type:"string" required:"true"`
+	CallerReference *string `type:"string" min:"1" max:"128" required:"true"`
 
 	// A complex type that contains the Comment element.
 	Config *HostedZoneConfig `type:"structure"`
 
 	// The ID of the specified hosted zone.
-	ID *string `locationName:"Id" type:"string" required:"true"`
+	ID *string `locationName:"Id" type:"string" max:"32" required:"true"`
 
 	// The name of the domain. This must be a fully-specified domain, for example,
 	// www.example.com. The trailing dot is optional; Route 53 assumes that the
@@ -1947,7 +2357,7 @@ type HostedZone struct {
 	// This is the name you have registered with your DNS registrar. You should
 	// ask your registrar to change the authoritative name servers for your domain
 	// to the set of NameServers elements returned in DelegationSet.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" max:"1024" required:"true"`
 
 	// Total number of resource record sets in the hosted zone.
 	ResourceRecordSetCount *int64 `type:"long"`
@@ -1959,6 +2369,11 @@ type metadataHostedZone struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the HostedZone.
+func (s HostedZone) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains an optional comment about your hosted zone.
 // If you don't want to specify a comment, you can omit the HostedZoneConfig
 // and Comment elements from the XML document.
@@ -1966,7 +2381,7 @@ type HostedZoneConfig struct {
 	// An optional comment about your hosted zone. If you don't want to specify
 	// a comment, you can omit the HostedZoneConfig and Comment elements from the
 	// XML document.
-	Comment *string `type:"string"`
+	Comment *string `type:"string" max:"256"`
 
 	// A value that indicates whether this is a private hosted zone. The value is
 	// returned in the response; do not specify it in the request.
@@ -1979,6 +2394,11 @@ type metadataHostedZoneConfig struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the HostedZoneConfig.
+func (s HostedZoneConfig) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for a ListGeoLocations request.
 type ListGeoLocationsInput struct {
 	// The maximum number of geo locations you want in the response body.
@@ -1992,21 +2412,21 @@ type ListGeoLocationsInput struct {
 	//
 	// Constraint: Specifying ContinentCode with either CountryCode or SubdivisionCode
 	// returns an InvalidInput error.
-	StartContinentCode *string `location:"querystring" locationName:"startcontinentcode" type:"string"`
+	StartContinentCode *string `location:"querystring" locationName:"startcontinentcode" type:"string" min:"2" max:"2"`
 
 	// The first country code in the lexicographic ordering of geo locations that
 	// you want the ListGeoLocations request to list.
 	//
 	// The default geo location uses a * for the country code. All other country
 	// codes follow the ISO 3166 two-character code.
-	StartCountryCode *string `location:"querystring" locationName:"startcountrycode" type:"string"`
+	StartCountryCode *string `location:"querystring" locationName:"startcountrycode" type:"string" min:"1" max:"2"`
 
 	// The first subdivision code in the lexicographic ordering of geo locations
 	// that you want the ListGeoLocations request to list.
 	//
 	// Constraint: Specifying SubdivisionCode without CountryCode returns an InvalidInput
 	// error.
-	StartSubdivisionCode *string `location:"querystring" locationName:"startsubdivisioncode" type:"string"`
+	StartSubdivisionCode *string `location:"querystring" locationName:"startsubdivisioncode" type:"string" min:"1" max:"3"`
 
 	metadataListGeoLocationsInput `json:"-", xml:"-"`
 }
@@ -2015,6 +2435,11 @@ type metadataListGeoLocationsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListGeoLocationsInput.
+func (s ListGeoLocationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains information about the geo locations that are
 // returned by the request and information about the response.
 type ListGeoLocationsOutput struct {
@@ -2038,17 +2463,17 @@ type ListGeoLocationsOutput struct {
 	// If the results were truncated, the continent code of the next geo location
 	// in the list. This element is present only if ListGeoLocationsResponse$IsTruncated
 	// is true and the next geo location to list is a continent location.
-	NextContinentCode *string `type:"string"`
+	NextContinentCode *string `type:"string" min:"2" max:"2"`
 
 	// If the results were truncated, the country code of the next geo location
 	// in the list. This element is present only if ListGeoLocationsResponse$IsTruncated
 	// is true and the next geo location to list is not a continent location.
-	NextCountryCode *string `type:"string"`
+	NextCountryCode *string `type:"string" min:"1" max:"2"`
 
 	// If the results were truncated, the subdivision code of the next geo location
 	// in the list. This element is present only if ListGeoLocationsResponse$IsTruncated
 	// is true and the next geo location has a subdivision.
-	NextSubdivisionCode *string `type:"string"`
+	NextSubdivisionCode *string `type:"string" min:"1" max:"3"`
 
 	metadataListGeoLocationsOutput `json:"-", xml:"-"`
 }
@@ -2057,6 +2482,11 @@ type metadataListGeoLocationsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListGeoLocationsOutput.
+func (s ListGeoLocationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // To retrieve a list of your health checks, send a GET request to the 2013-04-01/healthcheck
 // resource. The response to this request includes a HealthChecks element with
 // zero or more HealthCheck child elements. By default, the list of health checks
@@ -2070,7 +2500,7 @@ type ListHealthChecksInput struct {
 	// If the request returned more than one page of results, submit another request
 	// and specify the value of NextMarker from the last response in the marker
 	// parameter to get the next page of results.
-	Marker *string `location:"querystring" locationName:"marker" type:"string"`
+	Marker *string `location:"querystring" locationName:"marker" type:"string" max:"64"`
 
 	// Specify the maximum number of health checks to return per page of results.
 	MaxItems *string `location:"querystring" locationName:"maxitems" type:"string"`
@@ -2082,6 +2512,11 @@ type metadataListHealthChecksInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListHealthChecksInput.
+func (s ListHealthChecksInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains the response for the request.
 type ListHealthChecksOutput struct {
 	// A complex type that contains information about the health checks associated
@@ -2098,7 +2533,7 @@ type ListHealthChecksOutput struct {
 	// If the request returned more than one page of results, submit another request
 	// and specify the value of NextMarker from the last response in the marker
 	// parameter to get the next page of results.
-	Marker *string `type:"string" required:"true"`
+	Marker *string `type:"string" max:"64" required:"true"`
 
 	// The maximum number of health checks to be included in the response body.
 	// If the number of health checks associated with this AWS account exceeds MaxItems,
@@ -2110,7 +2545,7 @@ type ListHealthChecksOutput struct {
 	// Indicates where to continue listing health checks. If ListHealthChecksResponse$IsTruncated
 	// is true, make another request to ListHealthChecks and include the value of
 	// the NextMarker element in the Marker element to get the next page of results.
-	NextMarker *string `type:"string"`
+	NextMarker *string `type:"string" max:"64"`
 
 	metadataListHealthChecksOutput `json:"-", xml:"-"`
 }
@@ -2119,6 +2554,11 @@ type metadataListHealthChecksOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListHealthChecksOutput.
+func (s ListHealthChecksOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // To retrieve a list of your hosted zones in lexicographic order, send a GET
 // request to the 2013-04-01/hostedzonesbyname resource. The response to this
 // request includes a HostedZones element with zero or more HostedZone child
@@ -2138,12 +2578,12 @@ type ListHostedZonesByNameInput struct {
 	// If the request returned more than one page of results, submit another request
 	// and specify the value of NextDNSName and NextHostedZoneId from the last response
 	// in the DNSName and HostedZoneId parameters to get the next page of results.
-	DNSName *string `location:"querystring" locationName:"dnsname" type:"string"`
+	DNSName *string `location:"querystring" locationName:"dnsname" type:"string" max:"1024"`
 
 	// If the request returned more than one page of results, submit another request
 	// and specify the value of NextDNSName and NextHostedZoneId from the last response
 	// in the DNSName and HostedZoneId parameters to get the next page of results.
-	HostedZoneID *string `location:"querystring" locationName:"hostedzoneid" type:"string"`
+	HostedZoneID *string `location:"querystring" locationName:"hostedzoneid" type:"string" max:"32"`
 
 	// Specify the maximum number of hosted zones to return per page of results.
 	MaxItems *string `location:"querystring" locationName:"maxitems" type:"string"`
@@ -2155,13 +2595,18 @@ type metadataListHostedZonesByNameInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListHostedZonesByNameInput.
+func (s ListHostedZonesByNameInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains the response for the request.
 type ListHostedZonesByNameOutput struct {
 	// The DNSName value sent in the request.
-	DNSName *string `type:"string"`
+	DNSName *string `type:"string" max:"1024"`
 
 	// The HostedZoneId value sent in the request.
-	HostedZoneID *string `locationName:"HostedZoneId" type:"string"`
+	HostedZoneID *string `locationName:"HostedZoneId" type:"string" max:"32"`
 
 	// A complex type that contains information about the hosted zones associated
 	// with the current AWS account.
@@ -2187,14 +2632,14 @@ type ListHostedZonesByNameOutput struct {
 	// make another request to ListHostedZonesByName. Specify the value of ListHostedZonesByNameResponse$NextDNSName
 	// in the ListHostedZonesByNameRequest$DNSName element and ListHostedZonesByNameResponse$NextHostedZoneId
 	// in the ListHostedZonesByNameRequest$HostedZoneId element.
-	NextDNSName *string `type:"string"`
+	NextDNSName *string `type:"string" max:"1024"`
 
 	// If ListHostedZonesByNameResponse$IsTruncated is true, there are more hosted
 	// zones associated with the current AWS account. To get the next page of results,
 	// make another request to ListHostedZonesByName. Specify the value of ListHostedZonesByNameResponse$NextDNSName
 	// in the ListHostedZonesByNameRequest$DNSName element and ListHostedZonesByNameResponse$NextHostedZoneId
 	// in the ListHostedZonesByNameRequest$HostedZoneId element.
-	NextHostedZoneID *string `locationName:"NextHostedZoneId" type:"string"`
+	NextHostedZoneID *string `locationName:"NextHostedZoneId" type:"string" max:"32"`
 
 	metadataListHostedZonesByNameOutput `json:"-", xml:"-"`
 }
@@ -2203,6 +2648,11 @@ type metadataListHostedZonesByNameOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListHostedZonesByNameOutput.
+func (s ListHostedZonesByNameOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // To retrieve a list of your hosted zones, send a GET request to the 2013-04-01/hostedzone
 // resource. The response to this request includes a HostedZones element with
 // zero or more HostedZone child elements. By default, the list of hosted zones
@@ -2216,12 +2666,12 @@ type metadataListHostedZonesByNameOutput struct {
 //  Route 53 returns a maximum of 100 items. If you set MaxItems to a value
 // greater than 100, Route 53 returns only the first 100.
 type ListHostedZonesInput struct {
-	DelegationSetID *string `location:"querystring" locationName:"delegationsetid" type:"string"`
+	DelegationSetID *string `location:"querystring" locationName:"delegationsetid" type:"string" max:"32"`
 
 	// If the request returned more than one page of results, submit another request
 	// and specify the value of NextMarker from the last response in the marker
 	// parameter to get the next page of results.
-	Marker *string `location:"querystring" locationName:"marker" type:"string"`
+	Marker *string `location:"querystring" locationName:"marker" type:"string" max:"64"`
 
 	// Specify the maximum number of hosted zones to return per page of results.
 	MaxItems *string `location:"querystring" locationName:"maxitems" type:"string"`
@@ -2233,6 +2683,11 @@ type metadataListHostedZonesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListHostedZonesInput.
+func (s ListHostedZonesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains the response for the request.
 type ListHostedZonesOutput struct {
 	// A complex type that contains information about the hosted zones associated
@@ -2249,7 +2704,7 @@ type ListHostedZonesOutput struct {
 	// If the request returned more than one page of results, submit another request
 	// and specify the value of NextMarker from the last response in the marker
 	// parameter to get the next page of results.
-	Marker *string `type:"string" required:"true"`
+	Marker *string `type:"string" max:"64" required:"true"`
 
 	// The maximum number of hosted zones to be included in the response body. If
 	// the number of hosted zones associated with this AWS account exceeds MaxItems,
@@ -2261,7 +2716,7 @@ type ListHostedZonesOutput struct {
 	// Indicates where to continue listing hosted zones. If ListHostedZonesResponse$IsTruncated
 	// is true, make another request to ListHostedZones and include the value of
 	// the NextMarker element in the Marker element to get the next page of results.
-	NextMarker *string `type:"string"`
+	NextMarker *string `type:"string" max:"64"`
 
 	metadataListHostedZonesOutput `json:"-", xml:"-"`
 }
@@ -2270,11 +2725,16 @@ type metadataListHostedZonesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListHostedZonesOutput.
+func (s ListHostedZonesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The input for a ListResourceRecordSets request.
 type ListResourceRecordSetsInput struct {
 	// The ID of the hosted zone that contains the resource record sets that you
 	// want to get.
-	HostedZoneID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	HostedZoneID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	// The maximum number of records you want in the response body.
 	MaxItems *string `location:"querystring" locationName:"maxitems" type:"string"`
@@ -2283,11 +2743,11 @@ type ListResourceRecordSetsInput struct {
 	// DNS name and type, specify the value of ListResourceRecordSetsResponse$NextRecordIdentifier
 	// from the previous response to get the next resource record set that has the
 	// current DNS name and type.
-	StartRecordIdentifier *string `location:"querystring" locationName:"identifier" type:"string"`
+	StartRecordIdentifier *string `location:"querystring" locationName:"identifier" type:"string" min:"1" max:"128"`
 
 	// The first name in the lexicographic ordering of domain names that you want
 	// the ListResourceRecordSets request to list.
-	StartRecordName *string `location:"querystring" locationName:"name" type:"string"`
+	StartRecordName *string `location:"querystring" locationName:"name" type:"string" max:"1024"`
 
 	// The DNS type at which to begin the listing of resource record sets.
 	//
@@ -2301,7 +2761,7 @@ type ListResourceRecordSetsInput struct {
 	//
 	// Constraint: Specifying type without specifying name returns an InvalidInput
 	// error.
-	StartRecordType *string `location:"querystring" locationName:"type" type:"string"`
+	StartRecordType *string `location:"querystring" locationName:"type" type:"string" enum:"SOA,A,TXT,NS,CNAME,MX,PTR,SRV,SPF,AAAA"`
 
 	metadataListResourceRecordSetsInput `json:"-", xml:"-"`
 }
@@ -2310,6 +2770,11 @@ type metadataListResourceRecordSetsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListResourceRecordSetsInput.
+func (s ListResourceRecordSetsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains information about the resource record sets that
 // are returned by the request and information about the response.
 type ListResourceRecordSetsOutput struct {
@@ -2328,17 +2793,17 @@ type ListResourceRecordSetsOutput struct {
 	// Weighted resource record sets only: If results were truncated for a given
 	// DNS name and type, the value of SetIdentifier for the next resource record
 	// set that has the current DNS name and type.
-	NextRecordIdentifier *string `type:"string"`
+	NextRecordIdentifier *string `type:"string" min:"1" max:"128"`
 
 	// If the results were truncated, the name of the next record in the list. This
 	// element is present only if ListResourceRecordSetsResponse$IsTruncated is
 	// true.
-	NextRecordName *string `type:"string"`
+	NextRecordName *string `type:"string" max:"1024"`
 
 	// If the results were truncated, the type of the next record in the list. This
 	// element is present only if ListResourceRecordSetsResponse$IsTruncated is
 	// true.
-	NextRecordType *string `type:"string"`
+	NextRecordType *string `type:"string" enum:"SOA,A,TXT,NS,CNAME,MX,PTR,SRV,SPF,AAAA"`
 
 	// A complex type that contains information about the resource record sets that
 	// are returned by the request.
@@ -2351,6 +2816,11 @@ type metadataListResourceRecordSetsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListResourceRecordSetsOutput.
+func (s ListResourceRecordSetsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // To retrieve a list of your reusable delegation sets, send a GET request to
 // the 2013-04-01/delegationset resource. The response to this request includes
 // a DelegationSets element with zero or more DelegationSet child elements.
@@ -2365,7 +2835,7 @@ type ListReusableDelegationSetsInput struct {
 	// If the request returned more than one page of results, submit another request
 	// and specify the value of NextMarker from the last response in the marker
 	// parameter to get the next page of results.
-	Marker *string `location:"querystring" locationName:"marker" type:"string"`
+	Marker *string `location:"querystring" locationName:"marker" type:"string" max:"64"`
 
 	// Specify the maximum number of reusable delegation sets to return per page
 	// of results.
@@ -2378,6 +2848,11 @@ type metadataListReusableDelegationSetsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListReusableDelegationSetsInput.
+func (s ListReusableDelegationSetsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains the response for the request.
 type ListReusableDelegationSetsOutput struct {
 	// A complex type that contains information about the reusable delegation sets
@@ -2394,7 +2869,7 @@ type ListReusableDelegationSetsOutput struct {
 	// If the request returned more than one page of results, submit another request
 	// and specify the value of NextMarker from the last response in the marker
 	// parameter to get the next page of results.
-	Marker *string `type:"string" required:"true"`
+	Marker *string `type:"string" max:"64" required:"true"`
 
 	// The maximum number of reusable delegation sets to be included in the response
 	// body. If the number of reusable delegation sets associated with this AWS
@@ -2408,7 +2883,7 @@ type ListReusableDelegationSetsOutput struct {
 	// is true, make another request to ListReusableDelegationSets and include the
 	// value of the NextMarker element in the Marker element to get the next page
 	// of results.
-	NextMarker *string `type:"string"`
+	NextMarker *string `type:"string" max:"64"`
 
 	metadataListReusableDelegationSetsOutput `json:"-", xml:"-"`
 }
@@ -2417,18 +2892,23 @@ type metadataListReusableDelegationSetsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListReusableDelegationSetsOutput.
+func (s ListReusableDelegationSetsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type containing information about a request for a list of the tags
 // that are associated with an individual resource.
 type ListTagsForResourceInput struct {
 	// The ID of the resource for which you want to retrieve tags.
-	ResourceID *string `location:"uri" locationName:"ResourceId" type:"string" required:"true"`
+	ResourceID *string `location:"uri" locationName:"ResourceId" type:"string" max:"64" required:"true"`
 
 	// The type of the resource.
 	//
 	// - The resource type for health checks is healthcheck.
 	//
 	// - The resource type for hosted zones is hostedzone.
-	ResourceType *string `location:"uri" locationName:"ResourceType" type:"string" required:"true"`
+	ResourceType *string `location:"uri" locationName:"ResourceType" type:"string" enum:"healthcheck,hostedzone" required:"true"`
 
 	metadataListTagsForResourceInput `json:"-", xml:"-"`
 }
@@ -2437,6 +2917,11 @@ type metadataListTagsForResourceInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListTagsForResourceInput.
+func (s ListTagsForResourceInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type containing tags for the specified resource.
 type ListTagsForResourceOutput struct {
 	// A ResourceTagSet containing tags associated with the specified resource.
@@ -2449,19 +2934,24 @@ type metadataListTagsForResourceOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListTagsForResourceOutput.
+func (s ListTagsForResourceOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type containing information about a request for a list of the tags
 // that are associated with up to 10 specified resources.
 type ListTagsForResourcesInput struct {
 	// A complex type that contains the ResourceId element for each resource for
 	// which you want to get a list of tags.
-	ResourceIDs []*string `locationName:"ResourceIds" locationNameList:"ResourceId" type:"list" required:"true"`
+	ResourceIDs []*string `locationName:"ResourceIds" locationNameList:"ResourceId" type:"list" min:"1" max:"10" required:"true"`
 
 	// The type of the resources.
 	//
 	// - The resource type for health checks is healthcheck.
 	//
 	// - The resource type for hosted zones is hostedzone.
-	ResourceType *string `location:"uri" locationName:"ResourceType" type:"string" required:"true"`
+	ResourceType *string `location:"uri" locationName:"ResourceType" type:"string" enum:"healthcheck,hostedzone" required:"true"`
 
 	metadataListTagsForResourcesInput `json:"-", xml:"-"`
 }
@@ -2470,6 +2960,11 @@ type metadataListTagsForResourcesInput struct {
 	SDKShapeTraits bool `locationName:"ListTagsForResourcesRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
 }
 
+// String returns the string representation of the ListTagsForResourcesInput.
+func (s ListTagsForResourcesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type containing tags for the specified resources.
 type ListTagsForResourcesOutput struct {
 	// A list of ResourceTagSets containing tags associated with the specified resources.
@@ -2482,11 +2977,66 @@ type metadataListTagsForResourcesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListTagsForResourcesOutput.
+func (s ListTagsForResourcesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// RRType is an enum of the values of RRType members.
+type RRType string
+
+// The values of RRType.
+const (
+	RRTypeSOA   RRType = "SOA"
+	RRTypeA     RRType = "A"
+	RRTypeTXT   RRType = "TXT"
+	RRTypeNS    RRType = "NS"
+	RRTypeCNAME RRType = "CNAME"
+	RRTypeMX    RRType = "MX"
+	RRTypePTR   RRType = "PTR"
+	RRTypeSRV   RRType = "SRV"
+	RRTypeSPF   RRType = "SPF"
+	RRTypeAAAA  RRType = "AAAA"
+)
+
+// RRTypeValues returns the values of RRType known to this
+// version of the SDK. The service may accept values added since.
+func RRTypeValues() []RRType {
+	return []RRType{
+		RRType("SOA"),
+		RRType("A"),
+		RRType("TXT"),
+		RRType("NS"),
+		RRType("CNAME"),
+		RRType("MX"),
+		RRType("PTR"),
+		RRType("SRV"),
+		RRType("SPF"),
+		RRType("AAAA"),
+	}
+}
+
+// String returns the RRType as the string of a member.
+func (v RRType) String() string {
+	return string(v)
+}
+
+// ParseRRType returns the RRType of str, failing if it is not
+// one of the RRTypeValues.
+func ParseRRType(str string) (RRType, error) {
+	for _, v := range RRTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid RRType value %q", str)
+}
+
 // A complex type that contains the value of the Value element for the current
 // resource record set.
 type ResourceRecord struct {
 	// The value of the Value element for the current resource record set.
-	Value *string `type:"string" required:"true"`
+	Value *string `type:"string" max:"4000" required:"true"`
 
 	metadataResourceRecord `json:"-", xml:"-"`
 }
@@ -2495,6 +3045,11 @@ type metadataResourceRecord struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ResourceRecord.
+func (s ResourceRecord) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains information about the current resource record
 // set.
 type ResourceRecordSet struct {
@@ -2518,7 +3073,7 @@ type ResourceRecordSet struct {
 	// set.
 	//
 	// Valid values: PRIMARY | SECONDARY
-	Failover *string `type:"string"`
+	Failover *string `type:"string" enum:"PRIMARY,SECONDARY"`
 
 	// Geo location resource record sets only: Among resource record sets that have
 	// the same combination of DNS name and type, a value that specifies the geo
@@ -2528,36 +3083,36 @@ type ResourceRecordSet struct {
 	// Health Check resource record sets only, not required for alias resource record
 	// sets: An identifier that is used to identify health check associated with
 	// the resource record set.
-	HealthCheckID *string `locationName:"HealthCheckId" type:"string"`
+	HealthCheckID *string `locationName:"HealthCheckId" type:"string" max:"64"`
 
 	// The domain name of the current resource record set.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" max:"1024" required:"true"`
 
 	// Latency-based resource record sets only: Among resource record sets that
 	// have the same combination of DNS name and type, a value that specifies the
 	// AWS region for the current resource record set.
-	Region *string `type:"string"`
+	Region *string `type:"string" min:"1" max:"64" enum:"us-east-1,us-west-1,us-west-2,eu-west-1,eu-central-1,ap-southeast-1,ap-southeast-2,ap-northeast-1,sa-east-1,cn-north-1"`
 
 	// A complex type that contains the resource records for the current resource
 	// record set.
-	ResourceRecords []*ResourceRecord `locationNameList:"ResourceRecord" type:"list"`
+	ResourceRecords []*ResourceRecord `locationNameList:"ResourceRecord" type:"list" min:"1"`
 
 	// Weighted, Latency, Geo, and Failover resource record sets only: An identifier
 	// that differentiates among multiple resource record sets that have the same
 	// combination of DNS name and type.
-	SetIdentifier *string `type:"string"`
+	SetIdentifier *string `type:"string" min:"1" max:"128"`
 
 	// The cache time to live for the current resource record set.
-	TTL *int64 `type:"long"`
+	TTL *int64 `type:"long" max:"2147483647"`
 
 	// The type of the current resource record set.
-	Type *string `type:"string" required:"true"`
+	Type *string `type:"string" enum:"SOA,A,TXT,NS,CNAME,MX,PTR,SRV,SPF,AAAA" required:"true"`
 
 	// Weighted resource record sets only: Among resource record sets that have
 	// the same combination of DNS name and type, a value that determines what portion
 	// of traffic for the current resource record set is routed to the associated
 	// location.
-	Weight *int64 `type:"long"`
+	Weight *int64 `type:"long" max:"255"`
 
 	metadataResourceRecordSet `json:"-", xml:"-"`
 }
@@ -2566,20 +3121,109 @@ type metadataResourceRecordSet struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ResourceRecordSet.
+func (s ResourceRecordSet) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ResourceRecordSetFailover is an enum of the values of ResourceRecordSetFailover members.
+type ResourceRecordSetFailover string
+
+// The values of ResourceRecordSetFailover.
+const (
+	ResourceRecordSetFailoverPRIMARY   ResourceRecordSetFailover = "PRIMARY"
+	ResourceRecordSetFailoverSECONDARY ResourceRecordSetFailover = "SECONDARY"
+)
+
+// ResourceRecordSetFailoverValues returns the values of ResourceRecordSetFailover known to this
+// version of the SDK. The service may accept values added since.
+func ResourceRecordSetFailoverValues() []ResourceRecordSetFailover {
+	return []ResourceRecordSetFailover{
+		ResourceRecordSetFailover("PRIMARY"),
+		ResourceRecordSetFailover("SECONDARY"),
+	}
+}
+
+// String returns the ResourceRecordSetFailover as the string of a member.
+func (v ResourceRecordSetFailover) String() string {
+	return string(v)
+}
+
+// ParseResourceRecordSetFailover returns the ResourceRecordSetFailover of str, failing if it is not
+// one of the ResourceRecordSetFailoverValues.
+func ParseResourceRecordSetFailover(str string) (ResourceRecordSetFailover, error) {
+	for _, v := range ResourceRecordSetFailoverValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ResourceRecordSetFailover value %q", str)
+}
+
+// ResourceRecordSetRegion is an enum of the values of ResourceRecordSetRegion members.
+type ResourceRecordSetRegion string
+
+// The values of ResourceRecordSetRegion.
+const (
+	ResourceRecordSetRegionUsEast1      ResourceRecordSetRegion = "us-east-1"
+	ResourceRecordSetRegionUsWest1      ResourceRecordSetRegion = "us-west-1"
+	ResourceRecordSetRegionUsWest2      ResourceRecordSetRegion = "us-west-2"
+	ResourceRecordSetRegionEuWest1      ResourceRecordSetRegion = "eu-west-1"
+	ResourceRecordSetRegionEuCentral1   ResourceRecordSetRegion = "eu-central-1"
+	ResourceRecordSetRegionApSoutheast1 ResourceRecordSetRegion = "ap-southeast-1"
+	ResourceRecordSetRegionApSoutheast2 ResourceRecordSetRegion = "ap-southeast-2"
+	ResourceRecordSetRegionApNortheast1 ResourceRecordSetRegion = "ap-northeast-1"
+	ResourceRecordSetRegionSaEast1      ResourceRecordSetRegion = "sa-east-1"
+	ResourceRecordSetRegionCnNorth1     ResourceRecordSetRegion = "cn-north-1"
+)
+
+// ResourceRecordSetRegionValues returns the values of ResourceRecordSetRegion known to this
+// version of the SDK. The service may accept values added since.
+func ResourceRecordSetRegionValues() []ResourceRecordSetRegion {
+	return []ResourceRecordSetRegion{
+		ResourceRecordSetRegion("us-east-1"),
+		ResourceRecordSetRegion("us-west-1"),
+		ResourceRecordSetRegion("us-west-2"),
+		ResourceRecordSetRegion("eu-west-1"),
+		ResourceRecordSetRegion("eu-central-1"),
+		ResourceRecordSetRegion("ap-southeast-1"),
+		ResourceRecordSetRegion("ap-southeast-2"),
+		ResourceRecordSetRegion("ap-northeast-1"),
+		ResourceRecordSetRegion("sa-east-1"),
+		ResourceRecordSetRegion("cn-north-1"),
+	}
+}
+
+// String returns the ResourceRecordSetRegion as the string of a member.
+func (v ResourceRecordSetRegion) String() string {
+	return string(v)
+}
+
+// ParseResourceRecordSetRegion returns the ResourceRecordSetRegion of str, failing if it is not
+// one of the ResourceRecordSetRegionValues.
+func ParseResourceRecordSetRegion(str string) (ResourceRecordSetRegion, error) {
+	for _, v := range ResourceRecordSetRegionValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ResourceRecordSetRegion value %q", str)
+}
+
 // A complex type containing a resource and its associated tags.
 type ResourceTagSet struct {
 	// The ID for the specified resource.
-	ResourceID *string `locationName:"ResourceId" type:"string"`
+	ResourceID *string `locationName:"ResourceId" type:"string" max:"64"`
 
 	// The type of the resource.
 	//
 	// - The resource type for health checks is healthcheck.
 	//
 	// - The resource type for hosted zones is hostedzone.
-	ResourceType *string `type:"string"`
+	ResourceType *string `type:"string" enum:"healthcheck,hostedzone"`
 
 	// The tags associated with the specified resource.
-	Tags []*Tag `locationNameList:"Tag" type:"list"`
+	Tags []*Tag `locationNameList:"Tag" type:"list" min:"1" max:"10"`
 
 	metadataResourceTagSet `json:"-", xml:"-"`
 }
@@ -2588,6 +3232,11 @@ type metadataResourceTagSet struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ResourceTagSet.
+func (s ResourceTagSet) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains information about the health check status for
 // the current observation.
 type StatusReport struct {
@@ -2607,13 +3256,18 @@ type metadataStatusReport struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StatusReport.
+func (s StatusReport) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A single tag containing a key and value.
 type Tag struct {
 	// The key for a Tag.
-	Key *string `type:"string"`
+	Key *string `type:"string" max:"128"`
 
 	// The value for a Tag.
-	Value *string `type:"string"`
+	Value *string `type:"string" max:"256"`
 
 	metadataTag `json:"-", xml:"-"`
 }
@@ -2622,6 +3276,45 @@ type metadataTag struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Tag.
+func (s Tag) String() string {
+	return awsutil.StringValue(s)
+}
+
+// TagResourceType is an enum of the values of TagResourceType members.
+type TagResourceType string
+
+// The values of TagResourceType.
+const (
+	TagResourceTypeHealthcheck TagResourceType = "healthcheck"
+	TagResourceTypeHostedzone  TagResourceType = "hostedzone"
+)
+
+// TagResourceTypeValues returns the values of TagResourceType known to this
+// version of the SDK. The service may accept values added since.
+func TagResourceTypeValues() []TagResourceType {
+	return []TagResourceType{
+		TagResourceType("healthcheck"),
+		TagResourceType("hostedzone"),
+	}
+}
+
+// String returns the TagResourceType as the string of a member.
+func (v TagResourceType) String() string {
+	return string(v)
+}
+
+// ParseTagResourceType returns the TagResourceType of str, failing if it is not
+// one of the TagResourceTypeValues.
+func ParseTagResourceType(str string) (TagResourceType, error) {
+	for _, v := range TagResourceTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid TagResourceType value %q", str)
+}
+
 // >A complex type that contains information about the request to update a health
 // check.
 type UpdateHealthCheckInput struct {
@@ -2634,32 +3327,32 @@ type UpdateHealthCheckInput struct {
 	// Route 53 Developer Guide.
 	//
 	// Specify this value only if you want to change it.
-	FailureThreshold *int64 `type:"integer"`
+	FailureThreshold *int64 `type:"integer" min:"1" max:"10"`
 
 	// Fully qualified domain name of the instance to be health checked.
 	//
 	// Specify this value only if you want to change it.
-	FullyQualifiedDomainName *string `type:"string"`
+	FullyQualifiedDomainName *string `type:"string" max:"255"`
 
 	// The ID of the health check to update.
-	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" required:"true"`
+	HealthCheckID *string `location:"uri" locationName:"HealthCheckId" type:"string" max:"64" required:"true"`
 
 	// Optional. When you specify a health check version, Route 53 compares this
 	// value with the current value in the health check, which prevents you from
 	// updating the health check when the versions don't match. Using HealthCheckVersion
 	// lets you prevent overwriting another change to the health check.
-	HealthCheckVersion *int64 `type:"long"`
+	HealthCheckVersion *int64 `type:"long" min:"1"`
 
 	// The IP address of the resource that you want to check.
 	//
 	// Specify this value only if you want to change it.
-	IPAddress *string `type:"string"`
+	IPAddress *string `type:"string" max:"15" pattern:"^(([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])\\.){3}([0-9]|[1-9][0-9]|1[0-9]{2}|2[0-4][0-9]|25[0-5])$"`
 
 	// The port on which you want Route 53 to open a connection to perform health
 	// checks.
 	//
 	// Specify this value only if you want to change it.
-	Port *int64 `type:"integer"`
+	Port *int64 `type:"integer" min:"1" max:"65535"`
 
 	// The path that you want Amazon Route 53 to request when performing health
 	// checks. The path can be any value for which your endpoint will return an
@@ -2667,7 +3360,7 @@ type UpdateHealthCheckInput struct {
 	// the file /docs/route53-health-check.html.
 	//
 	// Specify this value only if you want to change it.
-	ResourcePath *string `type:"string"`
+	ResourcePath *string `type:"string" max:"255"`
 
 	// If the value of Type is HTTP_STR_MATCH or HTTP_STR_MATCH, the string that
 	// you want Route 53 to search for in the response body from the specified resource.
@@ -2675,7 +3368,7 @@ type UpdateHealthCheckInput struct {
 	// healthy.
 	//
 	// Specify this value only if you want to change it.
-	SearchString *string `type:"string"`
+	SearchString *string `type:"string" max:"255"`
 
 	metadataUpdateHealthCheckInput `json:"-", xml:"-"`
 }
@@ -2684,6 +3377,11 @@ type metadataUpdateHealthCheckInput struct {
 	SDKShapeTraits bool `locationName:"UpdateHealthCheckRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
 }
 
+// String returns the string representation of the UpdateHealthCheckInput.
+func (s UpdateHealthCheckInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateHealthCheckOutput struct {
 	// A complex type that contains identifying information about the health check.
 	HealthCheck *HealthCheck `type:"structure" required:"true"`
@@ -2695,14 +3393,19 @@ type metadataUpdateHealthCheckOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateHealthCheckOutput.
+func (s UpdateHealthCheckOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type that contains information about the request to update a hosted
 // zone comment.
 type UpdateHostedZoneCommentInput struct {
 	// A comment about your hosted zone.
-	Comment *string `type:"string"`
+	Comment *string `type:"string" max:"256"`
 
 	// The ID of the hosted zone you want to update.
-	ID *string `location:"uri" locationName:"Id" type:"string" required:"true"`
+	ID *string `location:"uri" locationName:"Id" type:"string" max:"32" required:"true"`
 
 	metadataUpdateHostedZoneCommentInput `json:"-", xml:"-"`
 }
@@ -2711,6 +3414,11 @@ type metadataUpdateHostedZoneCommentInput struct {
 	SDKShapeTraits bool `locationName:"UpdateHostedZoneCommentRequest" type:"structure" xmlURI:"https://route53.amazonaws.com/doc/2013-04-01/"`
 }
 
+// String returns the string representation of the UpdateHostedZoneCommentInput.
+func (s UpdateHostedZoneCommentInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A complex type containing information about the specified hosted zone after
 // the update.
 type UpdateHostedZoneCommentOutput struct {
@@ -2724,15 +3432,75 @@ type metadataUpdateHostedZoneCommentOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateHostedZoneCommentOutput.
+func (s UpdateHostedZoneCommentOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type VPC struct {
 	// A VPC ID
-	VPCID *string `locationName:"VPCId" type:"string"`
+	VPCID *string `locationName:"VPCId" type:"string" max:"1024"`
 
-	VPCRegion *string `type:"string"`
+	VPCRegion *string `type:"string" min:"1" max:"64" enum:"us-east-1,us-west-1,us-west-2,eu-west-1,eu-central-1,ap-southeast-1,ap-southeast-2,ap-northeast-1,sa-east-1,cn-north-1"`
 
 	metadataVPC `json:"-", xml:"-"`
 }
 
 type metadataVPC struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the VPC.
+func (s VPC) String() string {
+	return awsutil.StringValue(s)
+}
+
+// VPCRegion is an enum of the values of VPCRegion members.
+type VPCRegion string
+
+// The values of VPCRegion.
+const (
+	VPCRegionUsEast1      VPCRegion = "us-east-1"
+	VPCRegionUsWest1      VPCRegion = "us-west-1"
+	VPCRegionUsWest2      VPCRegion = "us-west-2"
+	VPCRegionEuWest1      VPCRegion = "eu-west-1"
+	VPCRegionEuCentral1   VPCRegion = "eu-central-1"
+	VPCRegionApSoutheast1 VPCRegion = "ap-southeast-1"
+	VPCRegionApSoutheast2 VPCRegion = "ap-southeast-2"
+	VPCRegionApNortheast1 VPCRegion = "ap-northeast-1"
+	VPCRegionSaEast1      VPCRegion = "sa-east-1"
+	VPCRegionCnNorth1     VPCRegion = "cn-north-1"
+)
+
+// VPCRegionValues returns the values of VPCRegion known to this
+// version of the SDK. The service may accept values added since.
+func VPCRegionValues() []VPCRegion {
+	return []VPCRegion{
+		VPCRegion("us-east-1"),
+		VPCRegion("us-west-1"),
+		VPCRegion("us-west-2"),
+		VPCRegion("eu-west-1"),
+		VPCRegion("eu-central-1"),
+		VPCRegion("ap-southeast-1"),
+		VPCRegion("ap-southeast-2"),
+		VPCRegion("ap-northeast-1"),
+		VPCRegion("sa-east-1"),
+		VPCRegion("cn-north-1"),
+	}
+}
+
+// String returns the VPCRegion as the string of a member.
+func (v VPCRegion) String() string {
+	return string(v)
+}
+
+// ParseVPCRegion returns the VPCRegion of str, failing if it is not
+// one of the VPCRegionValues.
+func ParseVPCRegion(str string) (VPCRegion, error) {
+	for _, v := range VPCRegionValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VPCRegion value %q", str)
 }
\ No newline at end of file
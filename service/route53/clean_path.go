@@ -0,0 +1,19 @@
+package route53
+
+import (
+	"regexp"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+)
+
+// reResourcePrefix matches the escaped type prefix of a resource ID in a
+// request path, such as the /hostedzone/ of /hostedzone/Z1D633PJN98FT9 as
+// the ID is returned by Route 53, with or without its leading slash.
+var reResourcePrefix = regexp.MustCompile(`/(%2F)?(hostedzone|change|delegationset)%2F`)
+
+// cleanPath removes the type prefix of the IDs built into the request's path,
+// which already names the type of the resource, so that either the bare ID or
+// the full one returned by Route 53 can be passed as input.
+func cleanPath(r *aws.Request) {
+	r.HTTPRequest.URL.Opaque = reResourcePrefix.ReplaceAllString(r.HTTPRequest.URL.Opaque, "/")
+}
@@ -0,0 +1,41 @@
+package route53_test
+
+import (
+	"testing"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/service/route53"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildURL(t *testing.T, req *aws.Request) string {
+	assert.NoError(t, req.Build())
+	return req.HTTPRequest.URL.String()
+}
+
+func TestCleanPathHostedZone(t *testing.T) {
+	svc := route53.New(&aws.Config{Region: "us-east-1"})
+	for _, id := range []string{"Z1D633PJN98FT9", "/hostedzone/Z1D633PJN98FT9", "hostedzone/Z1D633PJN98FT9"} {
+		req, _ := svc.GetHostedZoneRequest(&route53.GetHostedZoneInput{ID: aws.String(id)})
+		assert.Equal(t, "https://route53.amazonaws.com/2013-04-01/hostedzone/Z1D633PJN98FT9", buildURL(t, req))
+
+		req, _ = svc.ListResourceRecordSetsRequest(&route53.ListResourceRecordSetsInput{HostedZoneID: aws.String(id)})
+		assert.Equal(t, "https://route53.amazonaws.com/2013-04-01/hostedzone/Z1D633PJN98FT9/rrset", buildURL(t, req))
+	}
+}
+
+func TestCleanPathChange(t *testing.T) {
+	svc := route53.New(&aws.Config{Region: "us-east-1"})
+	for _, id := range []string{"C2682N5HXP0BZ4", "/change/C2682N5HXP0BZ4"} {
+		req, _ := svc.GetChangeRequest(&route53.GetChangeInput{ID: aws.String(id)})
+		assert.Equal(t, "https://route53.amazonaws.com/2013-04-01/change/C2682N5HXP0BZ4", buildURL(t, req))
+	}
+}
+
+func TestCleanPathDelegationSet(t *testing.T) {
+	svc := route53.New(&aws.Config{Region: "us-east-1"})
+	for _, id := range []string{"N1PA6795SAMPLE", "/delegationset/N1PA6795SAMPLE"} {
+		req, _ := svc.GetReusableDelegationSetRequest(&route53.GetReusableDelegationSetInput{ID: aws.String(id)})
+		assert.Equal(t, "https://route53.amazonaws.com/2013-04-01/delegationset/N1PA6795SAMPLE", buildURL(t, req))
+	}
+}
@@ -25,8 +25,9 @@ func New(config *aws.Config) *Route53 {
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restxml.Build)
+	service.Handlers.Build.PushBack(cleanPath)
 	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
 	service.Handlers.UnmarshalError.PushBack(restxml.UnmarshalError)
@@ -4,9 +4,11 @@
 package route53domains
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // CheckDomainAvailabilityRequest generates a request for the CheckDomainAvailability operation.
@@ -256,6 +258,11 @@ func (c *Route53Domains) ListDomainsRequest(input *ListDomainsInput) (req *aws.R
 			Name:       "ListDomains",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"NextPageMarker"},
+				LimitToken:   "MaxItems",
+			},
 		}
 	}
 
@@ -274,6 +281,16 @@ func (c *Route53Domains) ListDomains(input *ListDomainsInput) (output *ListDomai
 	return
 }
 
+// ListDomainsPages iterates over the pages of a ListDomains operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *Route53Domains) ListDomainsPages(input *ListDomainsInput, fn func(p *ListDomainsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListDomainsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListDomainsOutput), lastPage)
+	})
+}
+
 var opListDomains *aws.Operation
 
 // ListOperationsRequest generates a request for the ListOperations operation.
@@ -283,6 +300,11 @@ func (c *Route53Domains) ListOperationsRequest(input *ListOperationsInput) (req
 			Name:       "ListOperations",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"NextPageMarker"},
+				LimitToken:   "MaxItems",
+			},
 		}
 	}
 
@@ -300,6 +322,16 @@ func (c *Route53Domains) ListOperations(input *ListOperationsInput) (output *Lis
 	return
 }
 
+// ListOperationsPages iterates over the pages of a ListOperations operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *Route53Domains) ListOperationsPages(input *ListOperationsInput, fn func(p *ListOperationsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListOperationsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListOperationsOutput), lastPage)
+	})
+}
+
 var opListOperations *aws.Operation
 
 // ListTagsForDomainRequest generates a request for the ListTagsForDomain operation.
@@ -596,10 +628,10 @@ type CheckDomainAvailabilityInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	// Reserved for future use.
-	IDNLangCode *string `locationName:"IdnLangCode" type:"string"`
+	IDNLangCode *string `locationName:"IdnLangCode" type:"string" max:"3"`
 
 	metadataCheckDomainAvailabilityInput `json:"-", xml:"-"`
 }
@@ -608,6 +640,11 @@ type metadataCheckDomainAvailabilityInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CheckDomainAvailabilityInput.
+func (s CheckDomainAvailabilityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The CheckDomainAvailability response includes the following elements.
 type CheckDomainAvailabilityOutput struct {
 	// Whether the domain name is available for registering.
@@ -624,7 +661,7 @@ type CheckDomainAvailabilityOutput struct {
 	// not available.  UNAVAILABLE_PREMIUM – The domain name is not available.
 	// UNAVAILABLE_RESTRICTED – The domain name is forbidden.  RESERVED – The domain
 	// name has been reserved for another person or organization.
-	Availability *string `type:"string" required:"true"`
+	Availability *string `type:"string" enum:"AVAILABLE,AVAILABLE_RESERVED,AVAILABLE_PREORDER,UNAVAILABLE,UNAVAILABLE_PREMIUM,UNAVAILABLE_RESTRICTED,RESERVED" required:"true"`
 
 	metadataCheckDomainAvailabilityOutput `json:"-", xml:"-"`
 }
@@ -633,6 +670,11 @@ type metadataCheckDomainAvailabilityOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CheckDomainAvailabilityOutput.
+func (s CheckDomainAvailabilityOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // ContactDetail includes the following elements.
 type ContactDetail struct {
 	// First line of the contact's address.
@@ -646,7 +688,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: Yes
-	AddressLine1 *string `type:"string"`
+	AddressLine1 *string `type:"string" max:"255"`
 
 	// Second line of contact's address, if any.
 	//
@@ -659,7 +701,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: No
-	AddressLine2 *string `type:"string"`
+	AddressLine2 *string `type:"string" max:"255"`
 
 	// The city of the contact's address.
 	//
@@ -672,7 +714,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: Yes
-	City *string `type:"string"`
+	City *string `type:"string" max:"255"`
 
 	// Indicates whether the contact is a person, company, association, or public
 	// organization. If you choose an option other than PERSON, you must enter an
@@ -689,7 +731,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: Yes
-	ContactType *string `type:"string"`
+	ContactType *string `type:"string" enum:"PERSON,COMPANY,ASSOCIATION,PUBLIC_BODY,RESELLER"`
 
 	// Code for the country of the contact's address.
 	//
@@ -702,7 +744,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: Yes
-	CountryCode *string `type:"string"`
+	CountryCode *string `type:"string" enum:"AD,AE,AF,AG,AI,AL,AM,AN,AO,AQ,AR,AS,AT,AU,AW,AZ,BA,BB,BD,BE,BF,BG,BH,BI,BJ,BL,BM,BN,BO,BR,BS,BT,BW,BY,BZ,CA,CC,CD,CF,CG,CH,CI,CK,CL,CM,CN,CO,CR,CU,CV,CX,CY,CZ,DE,DJ,DK,DM,DO,DZ,EC,EE,EG,ER,ES,ET,FI,FJ,FK,FM,FO,FR,GA,GB,GD,GE,GH,GI,GL,GM,GN,GQ,GR,GT,GU,GW,GY,HK,HN,HR,HT,HU,ID,IE,IL,IM,IN,IQ,IR,IS,IT,JM,JO,JP,KE,KG,KH,KI,KM,KN,KP,KR,KW,KY,KZ,LA,LB,LC,LI,LK,LR,LS,LT,LU,LV,LY,MA,MC,MD,ME,MF,MG,MH,MK,ML,MM,MN,MO,MP,MR,MS,MT,MU,MV,MW,MX,MY,MZ,NA,NC,NE,NG,NI,NL,NO,NP,NR,NU,NZ,OM,PA,PE,PF,PG,PH,PK,PL,PM,PN,PR,PT,PW,PY,QA,RO,RS,RU,RW,SA,SB,SC,SD,SE,SG,SH,SI,SK,SL,SM,SN,SO,SR,ST,SV,SY,SZ,TC,TD,TG,TH,TJ,TK,TL,TM,TN,TO,TR,TT,TV,TW,TZ,UA,UG,US,UY,UZ,VA,VC,VE,VG,VI,VN,VU,WF,WS,YE,YT,ZA,ZM,ZW"`
 
 	// Email address of the contact.
 	//
@@ -715,7 +757,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: Yes
-	Email *string `type:"string"`
+	Email *string `type:"string" max:"254"`
 
 	// A list of name-value pairs for parameters required by certain top-level domains.
 	//
@@ -743,7 +785,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: No
-	Fax *string `type:"string"`
+	Fax *string `type:"string" max:"30"`
 
 	// First name of contact.
 	//
@@ -756,7 +798,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: Yes
-	FirstName *string `type:"string"`
+	FirstName *string `type:"string" max:"255"`
 
 	// Last name of contact.
 	//
@@ -769,7 +811,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: Yes
-	LastName *string `type:"string"`
+	LastName *string `type:"string" max:"255"`
 
 	// Name of the organization for contact types other than PERSON.
 	//
@@ -782,7 +824,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: No
-	OrganizationName *string `type:"string"`
+	OrganizationName *string `type:"string" max:"255"`
 
 	// The phone number of the contact.
 	//
@@ -797,7 +839,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: Yes
-	PhoneNumber *string `type:"string"`
+	PhoneNumber *string `type:"string" max:"30"`
 
 	// The state or province of the contact's city.
 	//
@@ -810,7 +852,7 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: No
-	State *string `type:"string"`
+	State *string `type:"string" max:"255"`
 
 	// The zip or postal code of the contact's address.
 	//
@@ -823,13 +865,546 @@ type ContactDetail struct {
 	// Parents: RegistrantContact, AdminContact, TechContact
 	//
 	// Required: No
-	ZipCode *string `type:"string"`
+	ZipCode *string `type:"string" max:"255"`
 
 	metadataContactDetail `json:"-", xml:"-"`
 }
 
 type metadataContactDetail struct {
-	SDKShapeTraits bool `type:"structure"`
+	SDKShapeTraits bool `type:"structure" sensitive:"true"`
+}
+
+// String returns the string representation of the ContactDetail.
+func (s ContactDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ContactType is an enum of the values of ContactType members.
+type ContactType string
+
+// The values of ContactType.
+const (
+	ContactTypePERSON      ContactType = "PERSON"
+	ContactTypeCOMPANY     ContactType = "COMPANY"
+	ContactTypeASSOCIATION ContactType = "ASSOCIATION"
+	ContactTypePUBLICBODY  ContactType = "PUBLIC_BODY"
+	ContactTypeRESELLER    ContactType = "RESELLER"
+)
+
+// ContactTypeValues returns the values of ContactType known to this
+// version of the SDK. The service may accept values added since.
+func ContactTypeValues() []ContactType {
+	return []ContactType{
+		ContactType("PERSON"),
+		ContactType("COMPANY"),
+		ContactType("ASSOCIATION"),
+		ContactType("PUBLIC_BODY"),
+		ContactType("RESELLER"),
+	}
+}
+
+// String returns the ContactType as the string of a member.
+func (v ContactType) String() string {
+	return string(v)
+}
+
+// ParseContactType returns the ContactType of str, failing if it is not
+// one of the ContactTypeValues.
+func ParseContactType(str string) (ContactType, error) {
+	for _, v := range ContactTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ContactType value %q", str)
+}
+
+// CountryCode is an enum of the values of CountryCode members.
+type CountryCode string
+
+// The values of CountryCode.
+const (
+	CountryCodeAD CountryCode = "AD"
+	CountryCodeAE CountryCode = "AE"
+	CountryCodeAF CountryCode = "AF"
+	CountryCodeAG CountryCode = "AG"
+	CountryCodeAI CountryCode = "AI"
+	CountryCodeAL CountryCode = "AL"
+	CountryCodeAM CountryCode = "AM"
+	CountryCodeAN CountryCode = "AN"
+	CountryCodeAO CountryCode = "AO"
+	CountryCodeAQ CountryCode = "AQ"
+	CountryCodeAR CountryCode = "AR"
+	CountryCodeAS CountryCode = "AS"
+	CountryCodeAT CountryCode = "AT"
+	CountryCodeAU CountryCode = "AU"
+	CountryCodeAW CountryCode = "AW"
+	CountryCodeAZ CountryCode = "AZ"
+	CountryCodeBA CountryCode = "BA"
+	CountryCodeBB CountryCode = "BB"
+	CountryCodeBD CountryCode = "BD"
+	CountryCodeBE CountryCode = "BE"
+	CountryCodeBF CountryCode = "BF"
+	CountryCodeBG CountryCode = "BG"
+	CountryCodeBH CountryCode = "BH"
+	CountryCodeBI CountryCode = "BI"
+	CountryCodeBJ CountryCode = "BJ"
+	CountryCodeBL CountryCode = "BL"
+	CountryCodeBM CountryCode = "BM"
+	CountryCodeBN CountryCode = "BN"
+	CountryCodeBO CountryCode = "BO"
+	CountryCodeBR CountryCode = "BR"
+	CountryCodeBS CountryCode = "BS"
+	CountryCodeBT CountryCode = "BT"
+	CountryCodeBW CountryCode = "BW"
+	CountryCodeBY CountryCode = "BY"
+	CountryCodeBZ CountryCode = "BZ"
+	CountryCodeCA CountryCode = "CA"
+	CountryCodeCC CountryCode = "CC"
+	CountryCodeCD CountryCode = "CD"
+	CountryCodeCF CountryCode = "CF"
+	CountryCodeCG CountryCode = "CG"
+	CountryCodeCH CountryCode = "CH"
+	CountryCodeCI CountryCode = "CI"
+	CountryCodeCK CountryCode = "CK"
+	CountryCodeCL CountryCode = "CL"
+	CountryCodeCM CountryCode = "CM"
+	CountryCodeCN CountryCode = "CN"
+	CountryCodeCO CountryCode = "CO"
+	CountryCodeCR CountryCode = "CR"
+	CountryCodeCU CountryCode = "CU"
+	CountryCodeCV CountryCode = "CV"
+	CountryCodeCX CountryCode = "CX"
+	CountryCodeCY CountryCode = "CY"
+	CountryCodeCZ CountryCode = "CZ"
+	CountryCodeDE CountryCode = "DE"
+	CountryCodeDJ CountryCode = "DJ"
+	CountryCodeDK CountryCode = "DK"
+	CountryCodeDM CountryCode = "DM"
+	CountryCodeDO CountryCode = "DO"
+	CountryCodeDZ CountryCode = "DZ"
+	CountryCodeEC CountryCode = "EC"
+	CountryCodeEE CountryCode = "EE"
+	CountryCodeEG CountryCode = "EG"
+	CountryCodeER CountryCode = "ER"
+	CountryCodeES CountryCode = "ES"
+	CountryCodeET CountryCode = "ET"
+	CountryCodeFI CountryCode = "FI"
+	CountryCodeFJ CountryCode = "FJ"
+	CountryCodeFK CountryCode = "FK"
+	CountryCodeFM CountryCode = "FM"
+	CountryCodeFO CountryCode = "FO"
+	CountryCodeFR CountryCode = "FR"
+	CountryCodeGA CountryCode = "GA"
+	CountryCodeGB CountryCode = "GB"
+	CountryCodeGD CountryCode = "GD"
+	CountryCodeGE CountryCode = "GE"
+	CountryCodeGH CountryCode = "GH"
+	CountryCodeGI CountryCode = "GI"
+	CountryCodeGL CountryCode = "GL"
+	CountryCodeGM CountryCode = "GM"
+	CountryCodeGN CountryCode = "GN"
+	CountryCodeGQ CountryCode = "GQ"
+	CountryCodeGR CountryCode = "GR"
+	CountryCodeGT CountryCode = "GT"
+	CountryCodeGU CountryCode = "GU"
+	CountryCodeGW CountryCode = "GW"
+	CountryCodeGY CountryCode = "GY"
+	CountryCodeHK CountryCode = "HK"
+	CountryCodeHN CountryCode = "HN"
+	CountryCodeHR CountryCode = "HR"
+	CountryCodeHT CountryCode = "HT"
+	CountryCodeHU CountryCode = "HU"
+	CountryCodeID CountryCode = "ID"
+	CountryCodeIE CountryCode = "IE"
+	CountryCodeIL CountryCode = "IL"
+	CountryCodeIM CountryCode = "IM"
+	CountryCodeIN CountryCode = "IN"
+	CountryCodeIQ CountryCode = "IQ"
+	CountryCodeIR CountryCode = "IR"
+	CountryCodeIS CountryCode = "IS"
+	CountryCodeIT CountryCode = "IT"
+	CountryCodeJM CountryCode = "JM"
+	CountryCodeJO CountryCode = "JO"
+	CountryCodeJP CountryCode = "JP"
+	CountryCodeKE CountryCode = "KE"
+	CountryCodeKG CountryCode = "KG"
+	CountryCodeKH CountryCode = "KH"
+	CountryCodeKI CountryCode = "KI"
+	CountryCodeKM CountryCode = "KM"
+	CountryCodeKN CountryCode = "KN"
+	CountryCodeKP CountryCode = "KP"
+	CountryCodeKR CountryCode = "KR"
+	CountryCodeKW CountryCode = "KW"
+	CountryCodeKY CountryCode = "KY"
+	CountryCodeKZ CountryCode = "KZ"
+	CountryCodeLA CountryCode = "LA"
+	CountryCodeLB CountryCode = "LB"
+	CountryCodeLC CountryCode = "LC"
+	CountryCodeLI CountryCode = "LI"
+	CountryCodeLK CountryCode = "LK"
+	CountryCodeLR CountryCode = "LR"
+	CountryCodeLS CountryCode = "LS"
+	CountryCodeLT CountryCode = "LT"
+	CountryCodeLU CountryCode = "LU"
+	CountryCodeLV CountryCode = "LV"
+	CountryCodeLY CountryCode = "LY"
+	CountryCodeMA CountryCode = "MA"
+	CountryCodeMC CountryCode = "MC"
+	CountryCodeMD CountryCode = "MD"
+	CountryCodeME CountryCode = "ME"
+	CountryCodeMF CountryCode = "MF"
+	CountryCodeMG CountryCode = "MG"
+	CountryCodeMH CountryCode = "MH"
+	CountryCodeMK CountryCode = "MK"
+	CountryCodeML CountryCode = "ML"
+	CountryCodeMM CountryCode = "MM"
+	CountryCodeMN CountryCode = "MN"
+	CountryCodeMO CountryCode = "MO"
+	CountryCodeMP CountryCode = "MP"
+	CountryCodeMR CountryCode = "MR"
+	CountryCodeMS CountryCode = "MS"
+	CountryCodeMT CountryCode = "MT"
+	CountryCodeMU CountryCode = "MU"
+	CountryCodeMV CountryCode = "MV"
+	CountryCodeMW CountryCode = "MW"
+	CountryCodeMX CountryCode = "MX"
+	CountryCodeMY CountryCode = "MY"
+	CountryCodeMZ CountryCode = "MZ"
+	CountryCodeNA CountryCode = "NA"
+	CountryCodeNC CountryCode = "NC"
+	CountryCodeNE CountryCode = "NE"
+	CountryCodeNG CountryCode = "NG"
+	CountryCodeNI CountryCode = "NI"
+	CountryCodeNL CountryCode = "NL"
+	CountryCodeNO CountryCode = "NO"
+	CountryCodeNP CountryCode = "NP"
+	CountryCodeNR CountryCode = "NR"
+	CountryCodeNU CountryCode = "NU"
+	CountryCodeNZ CountryCode = "NZ"
+	CountryCodeOM CountryCode = "OM"
+	CountryCodePA CountryCode = "PA"
+	CountryCodePE CountryCode = "PE"
+	CountryCodePF CountryCode = "PF"
+	CountryCodePG CountryCode = "PG"
+	CountryCodePH CountryCode = "PH"
+	CountryCodePK CountryCode = "PK"
+	CountryCodePL CountryCode = "PL"
+	CountryCodePM CountryCode = "PM"
+	CountryCodePN CountryCode = "PN"
+	CountryCodePR CountryCode = "PR"
+	CountryCodePT CountryCode = "PT"
+	CountryCodePW CountryCode = "PW"
+	CountryCodePY CountryCode = "PY"
+	CountryCodeQA CountryCode = "QA"
+	CountryCodeRO CountryCode = "RO"
+	CountryCodeRS CountryCode = "RS"
+	CountryCodeRU CountryCode = "RU"
+	CountryCodeRW CountryCode = "RW"
+	CountryCodeSA CountryCode = "SA"
+	CountryCodeSB CountryCode = "SB"
+	CountryCodeSC CountryCode = "SC"
+	CountryCodeSD CountryCode = "SD"
+	CountryCodeSE CountryCode = "SE"
+	CountryCodeSG CountryCode = "SG"
+	CountryCodeSH CountryCode = "SH"
+	CountryCodeSI CountryCode = "SI"
+	CountryCodeSK CountryCode = "SK"
+	CountryCodeSL CountryCode = "SL"
+	CountryCodeSM CountryCode = "SM"
+	CountryCodeSN CountryCode = "SN"
+	CountryCodeSO CountryCode = "SO"
+	CountryCodeSR CountryCode = "SR"
+	CountryCodeST CountryCode = "ST"
+	CountryCodeSV CountryCode = "SV"
+	CountryCodeSY CountryCode = "SY"
+	CountryCodeSZ CountryCode = "SZ"
+	CountryCodeTC CountryCode = "TC"
+	CountryCodeTD CountryCode = "TD"
+	CountryCodeTG CountryCode = "TG"
+	CountryCodeTH CountryCode = "TH"
+	CountryCodeTJ CountryCode = "TJ"
+	CountryCodeTK CountryCode = "TK"
+	CountryCodeTL CountryCode = "TL"
+	CountryCodeTM CountryCode = "TM"
+	CountryCodeTN CountryCode = "TN"
+	CountryCodeTO CountryCode = "TO"
+	CountryCodeTR CountryCode = "TR"
+	CountryCodeTT CountryCode = "TT"
+	CountryCodeTV CountryCode = "TV"
+	CountryCodeTW CountryCode = "TW"
+	CountryCodeTZ CountryCode = "TZ"
+	CountryCodeUA CountryCode = "UA"
+	CountryCodeUG CountryCode = "UG"
+	CountryCodeUS CountryCode = "US"
+	CountryCodeUY CountryCode = "UY"
+	CountryCodeUZ CountryCode = "UZ"
+	CountryCodeVA CountryCode = "VA"
+	CountryCodeVC CountryCode = "VC"
+	CountryCodeVE CountryCode = "VE"
+	CountryCodeVG CountryCode = "VG"
+	CountryCodeVI CountryCode = "VI"
+	CountryCodeVN CountryCode = "VN"
+	CountryCodeVU CountryCode = "VU"
+	CountryCodeWF CountryCode = "WF"
+	CountryCodeWS CountryCode = "WS"
+	CountryCodeYE CountryCode = "YE"
+	CountryCodeYT CountryCode = "YT"
+	CountryCodeZA CountryCode = "ZA"
+	CountryCodeZM CountryCode = "ZM"
+	CountryCodeZW CountryCode = "ZW"
+)
+
+// CountryCodeValues returns the values of CountryCode known to this
+// version of the SDK. The service may accept values added since.
+func CountryCodeValues() []CountryCode {
+	return []CountryCode{
+		CountryCode("AD"),
+		CountryCode("AE"),
+		CountryCode("AF"),
+		CountryCode("AG"),
+		CountryCode("AI"),
+		CountryCode("AL"),
+		CountryCode("AM"),
+		CountryCode("AN"),
+		CountryCode("AO"),
+		CountryCode("AQ"),
+		CountryCode("AR"),
+		CountryCode("AS"),
+		CountryCode("AT"),
+		CountryCode("AU"),
+		CountryCode("AW"),
+		CountryCode("AZ"),
+		CountryCode("BA"),
+		CountryCode("BB"),
+		CountryCode("BD"),
+		CountryCode("BE"),
+		CountryCode("BF"),
+		CountryCode("BG"),
+		CountryCode("BH"),
+		CountryCode("BI"),
+		CountryCode("BJ"),
+		CountryCode("BL"),
+		CountryCode("BM"),
+		CountryCode("BN"),
+		CountryCode("BO"),
+		CountryCode("BR"),
+		CountryCode("BS"),
+		CountryCode("BT"),
+		CountryCode("BW"),
+		CountryCode("BY"),
+		CountryCode("BZ"),
+		CountryCode("CA"),
+		CountryCode("CC"),
+		CountryCode("CD"),
+		CountryCode("CF"),
+		CountryCode("CG"),
+		CountryCode("CH"),
+		CountryCode("CI"),
+		CountryCode("CK"),
+		CountryCode("CL"),
+		CountryCode("CM"),
+		CountryCode("CN"),
+		CountryCode("CO"),
+		CountryCode("CR"),
+		CountryCode("CU"),
+		CountryCode("CV"),
+		CountryCode("CX"),
+		CountryCode("CY"),
+		CountryCode("CZ"),
+		CountryCode("DE"),
+		CountryCode("DJ"),
+		CountryCode("DK"),
+		CountryCode("DM"),
+		CountryCode("DO"),
+		CountryCode("DZ"),
+		CountryCode("EC"),
+		CountryCode("EE"),
+		CountryCode("EG"),
+		CountryCode("ER"),
+		CountryCode("ES"),
+		CountryCode("ET"),
+		CountryCode("FI"),
+		CountryCode("FJ"),
+		CountryCode("FK"),
+		CountryCode("FM"),
+		CountryCode("FO"),
+		CountryCode("FR"),
+		CountryCode("GA"),
+		CountryCode("GB"),
+		CountryCode("GD"),
+		CountryCode("GE"),
+		CountryCode("GH"),
+		CountryCode("GI"),
+		CountryCode("GL"),
+		CountryCode("GM"),
+		CountryCode("GN"),
+		CountryCode("GQ"),
+		CountryCode("GR"),
+		CountryCode("GT"),
+		CountryCode("GU"),
+		CountryCode("GW"),
+		CountryCode("GY"),
+		CountryCode("HK"),
+		CountryCode("HN"),
+		CountryCode("HR"),
+		CountryCode("HT"),
+		CountryCode("HU"),
+		CountryCode("ID"),
+		CountryCode("IE"),
+		CountryCode("IL"),
+		CountryCode("IM"),
+		CountryCode("IN"),
+		CountryCode("IQ"),
+		CountryCode("IR"),
+		CountryCode("IS"),
+		CountryCode("IT"),
+		CountryCode("JM"),
+		CountryCode("JO"),
+		CountryCode("JP"),
+		CountryCode("KE"),
+		CountryCode("KG"),
+		CountryCode("KH"),
+		CountryCode("KI"),
+		CountryCode("KM"),
+		CountryCode("KN"),
+		CountryCode("KP"),
+		CountryCode("KR"),
+		CountryCode("KW"),
+		CountryCode("KY"),
+		CountryCode("KZ"),
+		CountryCode("LA"),
+		CountryCode("LB"),
+		CountryCode("LC"),
+		CountryCode("LI"),
+		CountryCode("LK"),
+		CountryCode("LR"),
+		CountryCode("LS"),
+		CountryCode("LT"),
+		CountryCode("LU"),
+		CountryCode("LV"),
+		CountryCode("LY"),
+		CountryCode("MA"),
+		CountryCode("MC"),
+		CountryCode("MD"),
+		CountryCode("ME"),
+		CountryCode("MF"),
+		CountryCode("MG"),
+		CountryCode("MH"),
+		CountryCode("MK"),
+		CountryCode("ML"),
+		CountryCode("MM"),
+		CountryCode("MN"),
+		CountryCode("MO"),
+		CountryCode("MP"),
+		CountryCode("MR"),
+		CountryCode("MS"),
+		CountryCode("MT"),
+		CountryCode("MU"),
+		CountryCode("MV"),
+		CountryCode("MW"),
+		CountryCode("MX"),
+		CountryCode("MY"),
+		CountryCode("MZ"),
+		CountryCode("NA"),
+		CountryCode("NC"),
+		CountryCode("NE"),
+		CountryCode("NG"),
+		CountryCode("NI"),
+		CountryCode("NL"),
+		CountryCode("NO"),
+		CountryCode("NP"),
+		CountryCode("NR"),
+		CountryCode("NU"),
+		CountryCode("NZ"),
+		CountryCode("OM"),
+		CountryCode("PA"),
+		CountryCode("PE"),
+		CountryCode("PF"),
+		CountryCode("PG"),
+		CountryCode("PH"),
+		CountryCode("PK"),
+		CountryCode("PL"),
+		CountryCode("PM"),
+		CountryCode("PN"),
+		CountryCode("PR"),
+		CountryCode("PT"),
+		CountryCode("PW"),
+		CountryCode("PY"),
+		CountryCode("QA"),
+		CountryCode("RO"),
+		CountryCode("RS"),
+		CountryCode("RU"),
+		CountryCode("RW"),
+		CountryCode("SA"),
+		CountryCode("SB"),
+		CountryCode("SC"),
+		CountryCode("SD"),
+		CountryCode("SE"),
+		CountryCode("SG"),
+		CountryCode("SH"),
+		CountryCode("SI"),
+		CountryCode("SK"),
+		CountryCode("SL"),
+		CountryCode("SM"),
+		CountryCode("SN"),
+		CountryCode("SO"),
+		CountryCode("SR"),
+		CountryCode("ST"),
+		CountryCode("SV"),
+		CountryCode("SY"),
+		CountryCode("SZ"),
+		CountryCode("TC"),
+		CountryCode("TD"),
+		CountryCode("TG"),
+		CountryCode("TH"),
+		CountryCode("TJ"),
+		CountryCode("TK"),
+		CountryCode("TL"),
+		CountryCode("TM"),
+		CountryCode("TN"),
+		CountryCode("TO"),
+		CountryCode("TR"),
+		CountryCode("TT"),
+		CountryCode("TV"),
+		CountryCode("TW"),
+		CountryCode("TZ"),
+		CountryCode("UA"),
+		CountryCode("UG"),
+		CountryCode("US"),
+		CountryCode("UY"),
+		CountryCode("UZ"),
+		CountryCode("VA"),
+		CountryCode("VC"),
+		CountryCode("VE"),
+		CountryCode("VG"),
+		CountryCode("VI"),
+		CountryCode("VN"),
+		CountryCode("VU"),
+		CountryCode("WF"),
+		CountryCode("WS"),
+		CountryCode("YE"),
+		CountryCode("YT"),
+		CountryCode("ZA"),
+		CountryCode("ZM"),
+		CountryCode("ZW"),
+	}
+}
+
+// String returns the CountryCode as the string of a member.
+func (v CountryCode) String() string {
+	return string(v)
+}
+
+// ParseCountryCode returns the CountryCode of str, failing if it is not
+// one of the CountryCodeValues.
+func ParseCountryCode(str string) (CountryCode, error) {
+	for _, v := range CountryCodeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid CountryCode value %q", str)
 }
 
 // The DeleteTagsForDomainRequest includes the following elements.
@@ -849,7 +1424,7 @@ type DeleteTagsForDomainInput struct {
 	// Domain Name, you must convert the name to Punycode.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	// A list of tag keys to delete.
 	//
@@ -869,6 +1444,11 @@ type metadataDeleteTagsForDomainInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteTagsForDomainInput.
+func (s DeleteTagsForDomainInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteTagsForDomainOutput struct {
 	metadataDeleteTagsForDomainOutput `json:"-", xml:"-"`
 }
@@ -877,8 +1457,13 @@ type metadataDeleteTagsForDomainOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteTagsForDomainOutput.
+func (s DeleteTagsForDomainOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DisableDomainAutoRenewInput struct {
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	metadataDisableDomainAutoRenewInput `json:"-", xml:"-"`
 }
@@ -887,6 +1472,11 @@ type metadataDisableDomainAutoRenewInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DisableDomainAutoRenewInput.
+func (s DisableDomainAutoRenewInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DisableDomainAutoRenewOutput struct {
 	metadataDisableDomainAutoRenewOutput `json:"-", xml:"-"`
 }
@@ -895,6 +1485,11 @@ type metadataDisableDomainAutoRenewOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DisableDomainAutoRenewOutput.
+func (s DisableDomainAutoRenewOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The DisableDomainTransferLock request includes the following element.
 type DisableDomainTransferLockInput struct {
 	// The name of a domain.
@@ -908,7 +1503,7 @@ type DisableDomainTransferLockInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	metadataDisableDomainTransferLockInput `json:"-", xml:"-"`
 }
@@ -917,6 +1512,11 @@ type metadataDisableDomainTransferLockInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DisableDomainTransferLockInput.
+func (s DisableDomainTransferLockInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The DisableDomainTransferLock response includes the following element.
 type DisableDomainTransferLockOutput struct {
 	// Identifier for tracking the progress of the request. To use this ID to query
@@ -927,7 +1527,7 @@ type DisableDomainTransferLockOutput struct {
 	// Default: None
 	//
 	// Constraints: Maximum 255 characters.
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	metadataDisableDomainTransferLockOutput `json:"-", xml:"-"`
 }
@@ -936,6 +1536,55 @@ type metadataDisableDomainTransferLockOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DisableDomainTransferLockOutput.
+func (s DisableDomainTransferLockOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// DomainAvailability is an enum of the values of DomainAvailability members.
+type DomainAvailability string
+
+// The values of DomainAvailability.
+const (
+	DomainAvailabilityAVAILABLE             DomainAvailability = "AVAILABLE"
+	DomainAvailabilityAVAILABLERESERVED     DomainAvailability = "AVAILABLE_RESERVED"
+	DomainAvailabilityAVAILABLEPREORDER     DomainAvailability = "AVAILABLE_PREORDER"
+	DomainAvailabilityUNAVAILABLE           DomainAvailability = "UNAVAILABLE"
+	DomainAvailabilityUNAVAILABLEPREMIUM    DomainAvailability = "UNAVAILABLE_PREMIUM"
+	DomainAvailabilityUNAVAILABLERESTRICTED DomainAvailability = "UNAVAILABLE_RESTRICTED"
+	DomainAvailabilityRESERVED              DomainAvailability = "RESERVED"
+)
+
+// DomainAvailabilityValues returns the values of DomainAvailability known to this
+// version of the SDK. The service may accept values added since.
+func DomainAvailabilityValues() []DomainAvailability {
+	return []DomainAvailability{
+		DomainAvailability("AVAILABLE"),
+		DomainAvailability("AVAILABLE_RESERVED"),
+		DomainAvailability("AVAILABLE_PREORDER"),
+		DomainAvailability("UNAVAILABLE"),
+		DomainAvailability("UNAVAILABLE_PREMIUM"),
+		DomainAvailability("UNAVAILABLE_RESTRICTED"),
+		DomainAvailability("RESERVED"),
+	}
+}
+
+// String returns the DomainAvailability as the string of a member.
+func (v DomainAvailability) String() string {
+	return string(v)
+}
+
+// ParseDomainAvailability returns the DomainAvailability of str, failing if it is not
+// one of the DomainAvailabilityValues.
+func ParseDomainAvailability(str string) (DomainAvailability, error) {
+	for _, v := range DomainAvailabilityValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DomainAvailability value %q", str)
+}
+
 type DomainSummary struct {
 	// Indicates whether the domain is automatically renewed upon expiration.
 	//
@@ -947,7 +1596,7 @@ type DomainSummary struct {
 	// The name of a domain.
 	//
 	// Type: String
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	// Expiration date of the domain in Coordinated Universal Time (UTC).
 	//
@@ -969,8 +1618,13 @@ type metadataDomainSummary struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DomainSummary.
+func (s DomainSummary) String() string {
+	return awsutil.StringValue(s)
+}
+
 type EnableDomainAutoRenewInput struct {
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	metadataEnableDomainAutoRenewInput `json:"-", xml:"-"`
 }
@@ -979,6 +1633,11 @@ type metadataEnableDomainAutoRenewInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EnableDomainAutoRenewInput.
+func (s EnableDomainAutoRenewInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type EnableDomainAutoRenewOutput struct {
 	metadataEnableDomainAutoRenewOutput `json:"-", xml:"-"`
 }
@@ -987,6 +1646,11 @@ type metadataEnableDomainAutoRenewOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EnableDomainAutoRenewOutput.
+func (s EnableDomainAutoRenewOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The EnableDomainTransferLock request includes the following element.
 type EnableDomainTransferLockInput struct {
 	// The name of a domain.
@@ -1000,7 +1664,7 @@ type EnableDomainTransferLockInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	metadataEnableDomainTransferLockInput `json:"-", xml:"-"`
 }
@@ -1009,6 +1673,11 @@ type metadataEnableDomainTransferLockInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EnableDomainTransferLockInput.
+func (s EnableDomainTransferLockInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The EnableDomainTransferLock response includes the following elements.
 type EnableDomainTransferLockOutput struct {
 	// Identifier for tracking the progress of the request. To use this ID to query
@@ -1019,7 +1688,7 @@ type EnableDomainTransferLockOutput struct {
 	// Default: None
 	//
 	// Constraints: Maximum 255 characters.
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	metadataEnableDomainTransferLockOutput `json:"-", xml:"-"`
 }
@@ -1028,6 +1697,11 @@ type metadataEnableDomainTransferLockOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the EnableDomainTransferLockOutput.
+func (s EnableDomainTransferLockOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // ExtraParam includes the following elements.
 type ExtraParam struct {
 	// Name of the additional parameter required by the top-level domain.
@@ -1045,7 +1719,7 @@ type ExtraParam struct {
 	// Parent: ExtraParams
 	//
 	// Required: Yes
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" enum:"DUNS_NUMBER,BRAND_NUMBER,BIRTH_DEPARTMENT,BIRTH_DATE_IN_YYYY_MM_DD,BIRTH_COUNTRY,BIRTH_CITY,DOCUMENT_NUMBER,AU_ID_NUMBER,AU_ID_TYPE,CA_LEGAL_TYPE,ES_IDENTIFICATION,ES_IDENTIFICATION_TYPE,ES_LEGAL_FORM,FI_BUSINESS_NUMBER,FI_ID_NUMBER,IT_PIN,RU_PASSPORT_DATA,SE_ID_NUMBER,SG_ID_NUMBER,VAT_NUMBER" required:"true"`
 
 	// Values corresponding to the additional parameter names required by some top-level
 	// domains.
@@ -1059,7 +1733,7 @@ type ExtraParam struct {
 	// Parent: ExtraParams
 	//
 	// Required: Yes
-	Value *string `type:"string" required:"true"`
+	Value *string `type:"string" max:"2048" required:"true"`
 
 	metadataExtraParam `json:"-", xml:"-"`
 }
@@ -1068,6 +1742,81 @@ type metadataExtraParam struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ExtraParam.
+func (s ExtraParam) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ExtraParamName is an enum of the values of ExtraParamName members.
+type ExtraParamName string
+
+// The values of ExtraParamName.
+const (
+	ExtraParamNameDUNSNUMBER           ExtraParamName = "DUNS_NUMBER"
+	ExtraParamNameBRANDNUMBER          ExtraParamName = "BRAND_NUMBER"
+	ExtraParamNameBIRTHDEPARTMENT      ExtraParamName = "BIRTH_DEPARTMENT"
+	ExtraParamNameBIRTHDATEINYYYYMMDD  ExtraParamName = "BIRTH_DATE_IN_YYYY_MM_DD"
+	ExtraParamNameBIRTHCOUNTRY         ExtraParamName = "BIRTH_COUNTRY"
+	ExtraParamNameBIRTHCITY            ExtraParamName = "BIRTH_CITY"
+	ExtraParamNameDOCUMENTNUMBER       ExtraParamName = "DOCUMENT_NUMBER"
+	ExtraParamNameAUIDNUMBER           ExtraParamName = "AU_ID_NUMBER"
+	ExtraParamNameAUIDTYPE             ExtraParamName = "AU_ID_TYPE"
+	ExtraParamNameCALEGALTYPE          ExtraParamName = "CA_LEGAL_TYPE"
+	ExtraParamNameESIDENTIFICATION     ExtraParamName = "ES_IDENTIFICATION"
+	ExtraParamNameESIDENTIFICATIONTYPE ExtraParamName = "ES_IDENTIFICATION_TYPE"
+	ExtraParamNameESLEGALFORM          ExtraParamName = "ES_LEGAL_FORM"
+	ExtraParamNameFIBUSINESSNUMBER     ExtraParamName = "FI_BUSINESS_NUMBER"
+	ExtraParamNameFIIDNUMBER           ExtraParamName = "FI_ID_NUMBER"
+	ExtraParamNameITPIN                ExtraParamName = "IT_PIN"
+	ExtraParamNameRUPASSPORTDATA       ExtraParamName = "RU_PASSPORT_DATA"
+	ExtraParamNameSEIDNUMBER           ExtraParamName = "SE_ID_NUMBER"
+	ExtraParamNameSGIDNUMBER           ExtraParamName = "SG_ID_NUMBER"
+	ExtraParamNameVATNUMBER            ExtraParamName = "VAT_NUMBER"
+)
+
+// ExtraParamNameValues returns the values of ExtraParamName known to this
+// version of the SDK. The service may accept values added since.
+func ExtraParamNameValues() []ExtraParamName {
+	return []ExtraParamName{
+		ExtraParamName("DUNS_NUMBER"),
+		ExtraParamName("BRAND_NUMBER"),
+		ExtraParamName("BIRTH_DEPARTMENT"),
+		ExtraParamName("BIRTH_DATE_IN_YYYY_MM_DD"),
+		ExtraParamName("BIRTH_COUNTRY"),
+		ExtraParamName("BIRTH_CITY"),
+		ExtraParamName("DOCUMENT_NUMBER"),
+		ExtraParamName("AU_ID_NUMBER"),
+		ExtraParamName("AU_ID_TYPE"),
+		ExtraParamName("CA_LEGAL_TYPE"),
+		ExtraParamName("ES_IDENTIFICATION"),
+		ExtraParamName("ES_IDENTIFICATION_TYPE"),
+		ExtraParamName("ES_LEGAL_FORM"),
+		ExtraParamName("FI_BUSINESS_NUMBER"),
+		ExtraParamName("FI_ID_NUMBER"),
+		ExtraParamName("IT_PIN"),
+		ExtraParamName("RU_PASSPORT_DATA"),
+		ExtraParamName("SE_ID_NUMBER"),
+		ExtraParamName("SG_ID_NUMBER"),
+		ExtraParamName("VAT_NUMBER"),
+	}
+}
+
+// String returns the ExtraParamName as the string of a member.
+func (v ExtraParamName) String() string {
+	return string(v)
+}
+
+// ParseExtraParamName returns the ExtraParamName of str, failing if it is not
+// one of the ExtraParamNameValues.
+func ParseExtraParamName(str string) (ExtraParamName, error) {
+	for _, v := range ExtraParamNameValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ExtraParamName value %q", str)
+}
+
 // The GetDomainDetail request includes the following element.
 type GetDomainDetailInput struct {
 	// The name of a domain.
@@ -1081,7 +1830,7 @@ type GetDomainDetailInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	metadataGetDomainDetailInput `json:"-", xml:"-"`
 }
@@ -1090,6 +1839,11 @@ type metadataGetDomainDetailInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetDomainDetailInput.
+func (s GetDomainDetailInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The GetDomainDetail response includes the following elements.
 type GetDomainDetailOutput struct {
 	// Email address to contact to report incorrect contact information for a domain,
@@ -1097,12 +1851,12 @@ type GetDomainDetailOutput struct {
 	// is cybersquatting on a domain name, or report some other type of abuse.
 	//
 	// Type: String
-	AbuseContactEmail *string `type:"string"`
+	AbuseContactEmail *string `type:"string" max:"254"`
 
 	// Phone number for reporting abuse.
 	//
 	// Type: String
-	AbuseContactPhone *string `type:"string"`
+	AbuseContactPhone *string `type:"string" max:"30"`
 
 	// Provides details about the domain administrative contact.
 	//
@@ -1111,7 +1865,7 @@ type GetDomainDetailOutput struct {
 	// Children: FirstName, MiddleName, LastName, ContactType, OrganizationName,
 	// AddressLine1, AddressLine2, City, State, CountryCode, ZipCode, PhoneNumber,
 	// Email, Fax, ExtraParams
-	AdminContact *ContactDetail `type:"structure" required:"true"`
+	AdminContact *ContactDetail `type:"structure" sensitive:"true" required:"true"`
 
 	// Specifies whether contact information for the admin contact is concealed
 	// from WHOIS queries. If the value is true, WHOIS ("who is") queries will return
@@ -1136,7 +1890,7 @@ type GetDomainDetailOutput struct {
 	// The name of a domain.
 	//
 	// Type: String
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	// The date when the registration for the domain is set to expire. The date
 	// format is Unix time.
@@ -1154,7 +1908,7 @@ type GetDomainDetailOutput struct {
 	// Children: FirstName, MiddleName, LastName, ContactType, OrganizationName,
 	// AddressLine1, AddressLine2, City, State, CountryCode, ZipCode, PhoneNumber,
 	// Email, Fax, ExtraParams
-	RegistrantContact *ContactDetail `type:"structure" required:"true"`
+	RegistrantContact *ContactDetail `type:"structure" sensitive:"true" required:"true"`
 
 	// Specifies whether contact information for the registrant contact is concealed
 	// from WHOIS queries. If the value is true, WHOIS ("who is") queries will return
@@ -1209,7 +1963,7 @@ type GetDomainDetailOutput struct {
 	// Children: FirstName, MiddleName, LastName, ContactType, OrganizationName,
 	// AddressLine1, AddressLine2, City, State, CountryCode, ZipCode, PhoneNumber,
 	// Email, Fax, ExtraParams
-	TechContact *ContactDetail `type:"structure" required:"true"`
+	TechContact *ContactDetail `type:"structure" sensitive:"true" required:"true"`
 
 	// Specifies whether contact information for the tech contact is concealed from
 	// WHOIS queries. If the value is true, WHOIS ("who is") queries will return
@@ -1236,6 +1990,11 @@ type metadataGetDomainDetailOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetDomainDetailOutput.
+func (s GetDomainDetailOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The GetOperationDetail request includes the following element.
 type GetOperationDetailInput struct {
 	// The identifier for the operation for which you want to get the status. Amazon
@@ -1246,7 +2005,7 @@ type GetOperationDetailInput struct {
 	// Default: None
 	//
 	// Required: Yes
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	metadataGetOperationDetailInput `json:"-", xml:"-"`
 }
@@ -1255,12 +2014,17 @@ type metadataGetOperationDetailInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetOperationDetailInput.
+func (s GetOperationDetailInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The GetOperationDetail response includes the following elements.
 type GetOperationDetailOutput struct {
 	// The name of a domain.
 	//
 	// Type: String
-	DomainName *string `type:"string"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*"`
 
 	// Detailed information on the status including possible errors.
 	//
@@ -1270,12 +2034,12 @@ type GetOperationDetailOutput struct {
 	// The identifier for the operation.
 	//
 	// Type: String
-	OperationID *string `locationName:"OperationId" type:"string"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255"`
 
 	// The current status of the requested operation in the system.
 	//
 	// Type: String
-	Status *string `type:"string"`
+	Status *string `type:"string" enum:"SUBMITTED,IN_PROGRESS,ERROR,SUCCESSFUL,FAILED"`
 
 	// The date when the request was submitted.
 	SubmittedDate *time.Time `type:"timestamp" timestampFormat:"unix"`
@@ -1283,7 +2047,7 @@ type GetOperationDetailOutput struct {
 	// The type of operation that was requested.
 	//
 	// Type: String
-	Type *string `type:"string"`
+	Type *string `type:"string" enum:"REGISTER_DOMAIN,DELETE_DOMAIN,TRANSFER_IN_DOMAIN,UPDATE_DOMAIN_CONTACT,UPDATE_NAMESERVER,CHANGE_PRIVACY_PROTECTION,DOMAIN_LOCK"`
 
 	metadataGetOperationDetailOutput `json:"-", xml:"-"`
 }
@@ -1292,6 +2056,11 @@ type metadataGetOperationDetailOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetOperationDetailOutput.
+func (s GetOperationDetailOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The ListDomains request includes the following elements.
 type ListDomainsInput struct {
 	// For an initial request for a list of domains, omit this element. If the number
@@ -1308,7 +2077,7 @@ type ListDomainsInput struct {
 	// Constraints: The marker must match the value specified in the previous request.
 	//
 	// Required: No
-	Marker *string `type:"string"`
+	Marker *string `type:"string" max:"4096"`
 
 	// Number of domains to be returned.
 	//
@@ -1319,7 +2088,7 @@ type ListDomainsInput struct {
 	// Constraints: A numeral between 1 and 100.
 	//
 	// Required: No
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" max:"100"`
 
 	metadataListDomainsInput `json:"-", xml:"-"`
 }
@@ -1328,6 +2097,11 @@ type metadataListDomainsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListDomainsInput.
+func (s ListDomainsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The ListDomains response includes the following elements.
 type ListDomainsOutput struct {
 	// A summary of domains.
@@ -1344,7 +2118,7 @@ type ListDomainsOutput struct {
 	// Type: String
 	//
 	// Parent: Operations
-	NextPageMarker *string `type:"string"`
+	NextPageMarker *string `type:"string" max:"4096"`
 
 	metadataListDomainsOutput `json:"-", xml:"-"`
 }
@@ -1353,6 +2127,11 @@ type metadataListDomainsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListDomainsOutput.
+func (s ListDomainsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The ListOperations request includes the following elements.
 type ListOperationsInput struct {
 	// For an initial request for a list of operations, omit this element. If the
@@ -1367,7 +2146,7 @@ type ListOperationsInput struct {
 	// Default: None
 	//
 	// Required: No
-	Marker *string `type:"string"`
+	Marker *string `type:"string" max:"4096"`
 
 	// Number of domains to be returned.
 	//
@@ -1378,7 +2157,7 @@ type ListOperationsInput struct {
 	// Constraints: A value between 1 and 100.
 	//
 	// Required: No
-	MaxItems *int64 `type:"integer"`
+	MaxItems *int64 `type:"integer" max:"100"`
 
 	metadataListOperationsInput `json:"-", xml:"-"`
 }
@@ -1387,6 +2166,11 @@ type metadataListOperationsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListOperationsInput.
+func (s ListOperationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The ListOperations response includes the following elements.
 type ListOperationsOutput struct {
 	// If there are more operations than you specified for MaxItems in the request,
@@ -1396,7 +2180,7 @@ type ListOperationsOutput struct {
 	// Type: String
 	//
 	// Parent: Operations
-	NextPageMarker *string `type:"string"`
+	NextPageMarker *string `type:"string" max:"4096"`
 
 	// Lists summaries of the operations.
 	//
@@ -1412,10 +2196,15 @@ type metadataListOperationsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListOperationsOutput.
+func (s ListOperationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The ListTagsForDomainRequest includes the following elements.
 type ListTagsForDomainInput struct {
 	// The domain for which you want to get a list of tags.
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	metadataListTagsForDomainInput `json:"-", xml:"-"`
 }
@@ -1424,6 +2213,11 @@ type metadataListTagsForDomainInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListTagsForDomainInput.
+func (s ListTagsForDomainInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The ListTagsForDomain response includes the following elements.
 type ListTagsForDomainOutput struct {
 	// A list of the tags that are associated with the specified domain.
@@ -1452,6 +2246,11 @@ type metadataListTagsForDomainOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListTagsForDomainOutput.
+func (s ListTagsForDomainOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Nameserver includes the following elements.
 type Nameserver struct {
 	// Glue IP address of a name server entry. Glue IP addresses are required only
@@ -1473,7 +2272,7 @@ type Nameserver struct {
 	// Constraint: Maximum 255 characterss
 	//
 	// Parent: Nameservers
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	metadataNameserver `json:"-", xml:"-"`
 }
@@ -1482,17 +2281,62 @@ type metadataNameserver struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Nameserver.
+func (s Nameserver) String() string {
+	return awsutil.StringValue(s)
+}
+
+// OperationStatus is an enum of the values of OperationStatus members.
+type OperationStatus string
+
+// The values of OperationStatus.
+const (
+	OperationStatusSUBMITTED  OperationStatus = "SUBMITTED"
+	OperationStatusINPROGRESS OperationStatus = "IN_PROGRESS"
+	OperationStatusERROR      OperationStatus = "ERROR"
+	OperationStatusSUCCESSFUL OperationStatus = "SUCCESSFUL"
+	OperationStatusFAILED     OperationStatus = "FAILED"
+)
+
+// OperationStatusValues returns the values of OperationStatus known to this
+// version of the SDK. The service may accept values added since.
+func OperationStatusValues() []OperationStatus {
+	return []OperationStatus{
+		OperationStatus("SUBMITTED"),
+		OperationStatus("IN_PROGRESS"),
+		OperationStatus("ERROR"),
+		OperationStatus("SUCCESSFUL"),
+		OperationStatus("FAILED"),
+	}
+}
+
+// String returns the OperationStatus as the string of a member.
+func (v OperationStatus) String() string {
+	return string(v)
+}
+
+// ParseOperationStatus returns the OperationStatus of str, failing if it is not
+// one of the OperationStatusValues.
+func ParseOperationStatus(str string) (OperationStatus, error) {
+	for _, v := range OperationStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid OperationStatus value %q", str)
+}
+
 // OperationSummary includes the following elements.
 type OperationSummary struct {
 	// Identifier returned to track the requested action.
 	//
 	// Type: String
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	// The current status of the requested operation in the system.
 	//
 	// Type: String
-	Status *string `type:"string" required:"true"`
+	Status *string `type:"string" enum:"SUBMITTED,IN_PROGRESS,ERROR,SUCCESSFUL,FAILED" required:"true"`
 
 	// The date when the request was submitted.
 	SubmittedDate *time.Time `type:"timestamp" timestampFormat:"unix" required:"true"`
@@ -1503,7 +2347,7 @@ type OperationSummary struct {
 	//
 	// Valid values: REGISTER_DOMAIN | DELETE_DOMAIN | TRANSFER_IN_DOMAIN | UPDATE_DOMAIN_CONTACT
 	// | UPDATE_NAMESERVER | CHANGE_PRIVACY_PROTECTION | DOMAIN_LOCK
-	Type *string `type:"string" required:"true"`
+	Type *string `type:"string" enum:"REGISTER_DOMAIN,DELETE_DOMAIN,TRANSFER_IN_DOMAIN,UPDATE_DOMAIN_CONTACT,UPDATE_NAMESERVER,CHANGE_PRIVACY_PROTECTION,DOMAIN_LOCK" required:"true"`
 
 	metadataOperationSummary `json:"-", xml:"-"`
 }
@@ -1512,6 +2356,55 @@ type metadataOperationSummary struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the OperationSummary.
+func (s OperationSummary) String() string {
+	return awsutil.StringValue(s)
+}
+
+// OperationType is an enum of the values of OperationType members.
+type OperationType string
+
+// The values of OperationType.
+const (
+	OperationTypeREGISTERDOMAIN          OperationType = "REGISTER_DOMAIN"
+	OperationTypeDELETEDOMAIN            OperationType = "DELETE_DOMAIN"
+	OperationTypeTRANSFERINDOMAIN        OperationType = "TRANSFER_IN_DOMAIN"
+	OperationTypeUPDATEDOMAINCONTACT     OperationType = "UPDATE_DOMAIN_CONTACT"
+	OperationTypeUPDATENAMESERVER        OperationType = "UPDATE_NAMESERVER"
+	OperationTypeCHANGEPRIVACYPROTECTION OperationType = "CHANGE_PRIVACY_PROTECTION"
+	OperationTypeDOMAINLOCK              OperationType = "DOMAIN_LOCK"
+)
+
+// OperationTypeValues returns the values of OperationType known to this
+// version of the SDK. The service may accept values added since.
+func OperationTypeValues() []OperationType {
+	return []OperationType{
+		OperationType("REGISTER_DOMAIN"),
+		OperationType("DELETE_DOMAIN"),
+		OperationType("TRANSFER_IN_DOMAIN"),
+		OperationType("UPDATE_DOMAIN_CONTACT"),
+		OperationType("UPDATE_NAMESERVER"),
+		OperationType("CHANGE_PRIVACY_PROTECTION"),
+		OperationType("DOMAIN_LOCK"),
+	}
+}
+
+// String returns the OperationType as the string of a member.
+func (v OperationType) String() string {
+	return string(v)
+}
+
+// ParseOperationType returns the OperationType of str, failing if it is not
+// one of the OperationTypeValues.
+func ParseOperationType(str string) (OperationType, error) {
+	for _, v := range OperationTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid OperationType value %q", str)
+}
+
 // The RegisterDomain request includes the following elements.
 type RegisterDomainInput struct {
 	// Provides detailed contact information.
@@ -1523,7 +2416,7 @@ type RegisterDomainInput struct {
 	// Email, Fax, ExtraParams
 	//
 	// Required: Yes
-	AdminContact *ContactDetail `type:"structure" required:"true"`
+	AdminContact *ContactDetail `type:"structure" sensitive:"true" required:"true"`
 
 	// Indicates whether the domain will be automatically renewed (true) or not
 	// (false). Autorenewal only takes effect after the account is charged.
@@ -1548,7 +2441,7 @@ type RegisterDomainInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	// The number of years the domain will be registered. Domains are registered
 	// for a minimum of one year. The maximum period depends on the top-level domain.
@@ -1560,10 +2453,10 @@ type RegisterDomainInput struct {
 	// Valid values: Integer from 1 to 10
 	//
 	// Required: Yes
-	DurationInYears *int64 `type:"integer" required:"true"`
+	DurationInYears *int64 `type:"integer" min:"1" max:"10" required:"true"`
 
 	// Reserved for future use.
-	IDNLangCode *string `locationName:"IdnLangCode" type:"string"`
+	IDNLangCode *string `locationName:"IdnLangCode" type:"string" max:"3"`
 
 	// Whether you want to conceal contact information from WHOIS queries. If you
 	// specify true, WHOIS ("who is") queries will return contact information for
@@ -1616,7 +2509,7 @@ type RegisterDomainInput struct {
 	// Email, Fax, ExtraParams
 	//
 	// Required: Yes
-	RegistrantContact *ContactDetail `type:"structure" required:"true"`
+	RegistrantContact *ContactDetail `type:"structure" sensitive:"true" required:"true"`
 
 	// Provides detailed contact information.
 	//
@@ -1627,7 +2520,7 @@ type RegisterDomainInput struct {
 	// Email, Fax, ExtraParams
 	//
 	// Required: Yes
-	TechContact *ContactDetail `type:"structure" required:"true"`
+	TechContact *ContactDetail `type:"structure" sensitive:"true" required:"true"`
 
 	metadataRegisterDomainInput `json:"-", xml:"-"`
 }
@@ -1636,6 +2529,11 @@ type metadataRegisterDomainInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RegisterDomainInput.
+func (s RegisterDomainInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The RegisterDomain response includes the following element.
 type RegisterDomainOutput struct {
 	// Identifier for tracking the progress of the request. To use this ID to query
@@ -1646,7 +2544,7 @@ type RegisterDomainOutput struct {
 	// Default: None
 	//
 	// Constraints: Maximum 255 characters.
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	metadataRegisterDomainOutput `json:"-", xml:"-"`
 }
@@ -1655,6 +2553,11 @@ type metadataRegisterDomainOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RegisterDomainOutput.
+func (s RegisterDomainOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The RetrieveDomainAuthCode request includes the following element.
 type RetrieveDomainAuthCodeInput struct {
 	// The name of a domain.
@@ -1668,7 +2571,7 @@ type RetrieveDomainAuthCodeInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	metadataRetrieveDomainAuthCodeInput `json:"-", xml:"-"`
 }
@@ -1677,12 +2580,17 @@ type metadataRetrieveDomainAuthCodeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RetrieveDomainAuthCodeInput.
+func (s RetrieveDomainAuthCodeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The RetrieveDomainAuthCode response includes the following element.
 type RetrieveDomainAuthCodeOutput struct {
 	// The authorization code for the domain.
 	//
 	// Type: String
-	AuthCode *string `type:"string" required:"true"`
+	AuthCode *string `type:"string" max:"1024" sensitive:"true" required:"true"`
 
 	metadataRetrieveDomainAuthCodeOutput `json:"-", xml:"-"`
 }
@@ -1691,6 +2599,11 @@ type metadataRetrieveDomainAuthCodeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RetrieveDomainAuthCodeOutput.
+func (s RetrieveDomainAuthCodeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Each tag includes the following elements.
 type Tag struct {
 	// The key (name) of a tag.
@@ -1726,6 +2639,11 @@ type metadataTag struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Tag.
+func (s Tag) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The TransferDomain request includes the following elements.
 type TransferDomainInput struct {
 	// Provides detailed contact information.
@@ -1737,7 +2655,7 @@ type TransferDomainInput struct {
 	// Email, Fax, ExtraParams
 	//
 	// Required: Yes
-	AdminContact *ContactDetail `type:"structure" required:"true"`
+	AdminContact *ContactDetail `type:"structure" sensitive:"true" required:"true"`
 
 	// The authorization code for the domain. You get this value from the current
 	// registrar.
@@ -1745,7 +2663,7 @@ type TransferDomainInput struct {
 	// Type: String
 	//
 	// Required: Yes
-	AuthCode *string `type:"string"`
+	AuthCode *string `type:"string" max:"1024" sensitive:"true"`
 
 	// Indicates whether the domain will be automatically renewed (true) or not
 	// (false). Autorenewal only takes effect after the account is charged.
@@ -1770,7 +2688,7 @@ type TransferDomainInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	// The number of years the domain will be registered. Domains are registered
 	// for a minimum of one year. The maximum period depends on the top-level domain.
@@ -1782,10 +2700,10 @@ type TransferDomainInput struct {
 	// Valid values: Integer from 1 to 10
 	//
 	// Required: Yes
-	DurationInYears *int64 `type:"integer" required:"true"`
+	DurationInYears *int64 `type:"integer" min:"1" max:"10" required:"true"`
 
 	// Reserved for future use.
-	IDNLangCode *string `locationName:"IdnLangCode" type:"string"`
+	IDNLangCode *string `locationName:"IdnLangCode" type:"string" max:"3"`
 
 	// Contains details for the host and glue IP addresses.
 	//
@@ -1847,7 +2765,7 @@ type TransferDomainInput struct {
 	// Email, Fax, ExtraParams
 	//
 	// Required: Yes
-	RegistrantContact *ContactDetail `type:"structure" required:"true"`
+	RegistrantContact *ContactDetail `type:"structure" sensitive:"true" required:"true"`
 
 	// Provides detailed contact information.
 	//
@@ -1858,7 +2776,7 @@ type TransferDomainInput struct {
 	// Email, Fax, ExtraParams
 	//
 	// Required: Yes
-	TechContact *ContactDetail `type:"structure" required:"true"`
+	TechContact *ContactDetail `type:"structure" sensitive:"true" required:"true"`
 
 	metadataTransferDomainInput `json:"-", xml:"-"`
 }
@@ -1867,6 +2785,11 @@ type metadataTransferDomainInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TransferDomainInput.
+func (s TransferDomainInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The TranserDomain response includes the following element.
 type TransferDomainOutput struct {
 	// Identifier for tracking the progress of the request. To use this ID to query
@@ -1877,7 +2800,7 @@ type TransferDomainOutput struct {
 	// Default: None
 	//
 	// Constraints: Maximum 255 characters.
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	metadataTransferDomainOutput `json:"-", xml:"-"`
 }
@@ -1886,6 +2809,11 @@ type metadataTransferDomainOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TransferDomainOutput.
+func (s TransferDomainOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The UpdateDomainContact request includes the following elements.
 type UpdateDomainContactInput struct {
 	// Provides detailed contact information.
@@ -1897,7 +2825,7 @@ type UpdateDomainContactInput struct {
 	// Email, Fax, ExtraParams
 	//
 	// Required: Yes
-	AdminContact *ContactDetail `type:"structure"`
+	AdminContact *ContactDetail `type:"structure" sensitive:"true"`
 
 	// The name of a domain.
 	//
@@ -1910,7 +2838,7 @@ type UpdateDomainContactInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	// Provides detailed contact information.
 	//
@@ -1921,7 +2849,7 @@ type UpdateDomainContactInput struct {
 	// Email, Fax, ExtraParams
 	//
 	// Required: Yes
-	RegistrantContact *ContactDetail `type:"structure"`
+	RegistrantContact *ContactDetail `type:"structure" sensitive:"true"`
 
 	// Provides detailed contact information.
 	//
@@ -1932,7 +2860,7 @@ type UpdateDomainContactInput struct {
 	// Email, Fax, ExtraParams
 	//
 	// Required: Yes
-	TechContact *ContactDetail `type:"structure"`
+	TechContact *ContactDetail `type:"structure" sensitive:"true"`
 
 	metadataUpdateDomainContactInput `json:"-", xml:"-"`
 }
@@ -1941,6 +2869,11 @@ type metadataUpdateDomainContactInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateDomainContactInput.
+func (s UpdateDomainContactInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The UpdateDomainContact response includes the following element.
 type UpdateDomainContactOutput struct {
 	// Identifier for tracking the progress of the request. To use this ID to query
@@ -1951,7 +2884,7 @@ type UpdateDomainContactOutput struct {
 	// Default: None
 	//
 	// Constraints: Maximum 255 characters.
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	metadataUpdateDomainContactOutput `json:"-", xml:"-"`
 }
@@ -1960,6 +2893,11 @@ type metadataUpdateDomainContactOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateDomainContactOutput.
+func (s UpdateDomainContactOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The UpdateDomainContactPrivacy request includes the following elements.
 type UpdateDomainContactPrivacyInput struct {
 	// Whether you want to conceal contact information from WHOIS queries. If you
@@ -1987,7 +2925,7 @@ type UpdateDomainContactPrivacyInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	// Whether you want to conceal contact information from WHOIS queries. If you
 	// specify true, WHOIS ("who is") queries will return contact information for
@@ -2024,6 +2962,11 @@ type metadataUpdateDomainContactPrivacyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateDomainContactPrivacyInput.
+func (s UpdateDomainContactPrivacyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The UpdateDomainContactPrivacy response includes the following element.
 type UpdateDomainContactPrivacyOutput struct {
 	// Identifier for tracking the progress of the request. To use this ID to query
@@ -2034,7 +2977,7 @@ type UpdateDomainContactPrivacyOutput struct {
 	// Default: None
 	//
 	// Constraints: Maximum 255 characters.
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	metadataUpdateDomainContactPrivacyOutput `json:"-", xml:"-"`
 }
@@ -2043,6 +2986,11 @@ type metadataUpdateDomainContactPrivacyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateDomainContactPrivacyOutput.
+func (s UpdateDomainContactPrivacyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The UpdateDomainNameserver request includes the following elements.
 type UpdateDomainNameserversInput struct {
 	// The name of a domain.
@@ -2056,7 +3004,7 @@ type UpdateDomainNameserversInput struct {
 	// supported.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	// The authorization key for .fi domains
 	FIAuthKey *string `type:"string"`
@@ -2077,6 +3025,11 @@ type metadataUpdateDomainNameserversInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateDomainNameserversInput.
+func (s UpdateDomainNameserversInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The UpdateDomainNameservers response includes the following element.
 type UpdateDomainNameserversOutput struct {
 	// Identifier for tracking the progress of the request. To use this ID to query
@@ -2087,7 +3040,7 @@ type UpdateDomainNameserversOutput struct {
 	// Default: None
 	//
 	// Constraints: Maximum 255 characters.
-	OperationID *string `locationName:"OperationId" type:"string" required:"true"`
+	OperationID *string `locationName:"OperationId" type:"string" max:"255" required:"true"`
 
 	metadataUpdateDomainNameserversOutput `json:"-", xml:"-"`
 }
@@ -2096,6 +3049,11 @@ type metadataUpdateDomainNameserversOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateDomainNameserversOutput.
+func (s UpdateDomainNameserversOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The UpdateTagsForDomainRequest includes the following elements.
 type UpdateTagsForDomainInput struct {
 	// The domain for which you want to add or update tags.
@@ -2113,7 +3071,7 @@ type UpdateTagsForDomainInput struct {
 	// Domain Name, you must convert the name to Punycode.
 	//
 	// Required: Yes
-	DomainName *string `type:"string" required:"true"`
+	DomainName *string `type:"string" max:"255" pattern:"[a-zA-Z0-9_\\-.]*" required:"true"`
 
 	// A list of the tag keys and values that you want to add or update. If you
 	// specify a key that already exists, the corresponding value will be replaced.
@@ -2162,10 +3120,20 @@ type metadataUpdateTagsForDomainInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateTagsForDomainInput.
+func (s UpdateTagsForDomainInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateTagsForDomainOutput struct {
 	metadataUpdateTagsForDomainOutput `json:"-", xml:"-"`
 }
 
 type metadataUpdateTagsForDomainOutput struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the UpdateTagsForDomainOutput.
+func (s UpdateTagsForDomainOutput) String() string {
+	return awsutil.StringValue(s)
 }
\ No newline at end of file
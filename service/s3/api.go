@@ -4,10 +4,12 @@
 package s3
 
 import (
+	"fmt"
 	"io"
 	"time"
 
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // AbortMultipartUploadRequest generates a request for the AbortMultipartUpload operation.
@@ -865,6 +867,12 @@ func (c *S3) ListMultipartUploadsRequest(input *ListMultipartUploadsInput) (req
 			Name:       "ListMultipartUploads",
 			HTTPMethod: "GET",
 			HTTPPath:   "/{Bucket}?uploads",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"KeyMarker", "UploadIDMarker"},
+				OutputTokens:    []string{"NextKeyMarker", "NextUploadIDMarker"},
+				LimitToken:      "MaxUploads",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -882,6 +890,16 @@ func (c *S3) ListMultipartUploads(input *ListMultipartUploadsInput) (output *Lis
 	return
 }
 
+// ListMultipartUploadsPages iterates over the pages of a ListMultipartUploads operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *S3) ListMultipartUploadsPages(input *ListMultipartUploadsInput, fn func(p *ListMultipartUploadsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListMultipartUploadsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListMultipartUploadsOutput), lastPage)
+	})
+}
+
 var opListMultipartUploads *aws.Operation
 
 // ListObjectVersionsRequest generates a request for the ListObjectVersions operation.
@@ -891,6 +909,12 @@ func (c *S3) ListObjectVersionsRequest(input *ListObjectVersionsInput) (req *aws
 			Name:       "ListObjectVersions",
 			HTTPMethod: "GET",
 			HTTPPath:   "/{Bucket}?versions",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"KeyMarker", "VersionIDMarker"},
+				OutputTokens:    []string{"NextKeyMarker", "NextVersionIDMarker"},
+				LimitToken:      "MaxKeys",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -908,6 +932,16 @@ func (c *S3) ListObjectVersions(input *ListObjectVersionsInput) (output *ListObj
 	return
 }
 
+// ListObjectVersionsPages iterates over the pages of a ListObjectVersions operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *S3) ListObjectVersionsPages(input *ListObjectVersionsInput, fn func(p *ListObjectVersionsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListObjectVersionsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListObjectVersionsOutput), lastPage)
+	})
+}
+
 var opListObjectVersions *aws.Operation
 
 // ListObjectsRequest generates a request for the ListObjects operation.
@@ -917,6 +951,12 @@ func (c *S3) ListObjectsRequest(input *ListObjectsInput) (req *aws.Request, outp
 			Name:       "ListObjects",
 			HTTPMethod: "GET",
 			HTTPPath:   "/{Bucket}",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"Marker"},
+				OutputTokens:    []string{"NextMarker || Contents[-1].Key"},
+				LimitToken:      "MaxKeys",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -936,6 +976,16 @@ func (c *S3) ListObjects(input *ListObjectsInput) (output *ListObjectsOutput, er
 	return
 }
 
+// ListObjectsPages iterates over the pages of a ListObjects operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *S3) ListObjectsPages(input *ListObjectsInput, fn func(p *ListObjectsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListObjectsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListObjectsOutput), lastPage)
+	})
+}
+
 var opListObjects *aws.Operation
 
 // ListPartsRequest generates a request for the ListParts operation.
@@ -945,6 +995,12 @@ func (c *S3) ListPartsRequest(input *ListPartsInput) (req *aws.Request, output *
 			Name:       "ListParts",
 			HTTPMethod: "GET",
 			HTTPPath:   "/{Bucket}/{Key+}",
+			Paginator: &aws.Paginator{
+				InputTokens:     []string{"PartNumberMarker"},
+				OutputTokens:    []string{"NextPartNumberMarker"},
+				LimitToken:      "MaxParts",
+				TruncationToken: "IsTruncated",
+			},
 		}
 	}
 
@@ -962,6 +1018,16 @@ func (c *S3) ListParts(input *ListPartsInput) (output *ListPartsOutput, err erro
 	return
 }
 
+// ListPartsPages iterates over the pages of a ListParts operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *S3) ListPartsPages(input *ListPartsInput, fn func(p *ListPartsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListPartsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListPartsOutput), lastPage)
+	})
+}
+
 var opListParts *aws.Operation
 
 // PutBucketACLRequest generates a request for the PutBucketACL operation.
@@ -1406,7 +1472,7 @@ type AbortMultipartUploadInput struct {
 	// request. Bucket owners need not specify this parameter in their requests.
 	// Documentation on downloading objects from requester pays buckets can be found
 	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
 	UploadID *string `location:"querystring" locationName:"uploadId" type:"string" required:"true"`
 
@@ -1417,10 +1483,15 @@ type metadataAbortMultipartUploadInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AbortMultipartUploadInput.
+func (s AbortMultipartUploadInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AbortMultipartUploadOutput struct {
 	// If present, indicates that the requester was successfully charged for the
 	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
 	metadataAbortMultipartUploadOutput `json:"-", xml:"-"`
 }
@@ -1429,6 +1500,11 @@ type metadataAbortMultipartUploadOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AbortMultipartUploadOutput.
+func (s AbortMultipartUploadOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AccessControlPolicy struct {
 	// A list of grants.
 	Grants []*Grant `locationName:"AccessControlList" locationNameList:"Grant" type:"list"`
@@ -1442,6 +1518,11 @@ type metadataAccessControlPolicy struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AccessControlPolicy.
+func (s AccessControlPolicy) String() string {
+	return awsutil.StringValue(s)
+}
+
 type Bucket struct {
 	// Date the bucket was created.
 	CreationDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -1456,6 +1537,100 @@ type metadataBucket struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Bucket.
+func (s Bucket) String() string {
+	return awsutil.StringValue(s)
+}
+
+// BucketCannedACL is an enum of the values of BucketCannedACL members.
+type BucketCannedACL string
+
+// The values of BucketCannedACL.
+const (
+	BucketCannedACLPrivate           BucketCannedACL = "private"
+	BucketCannedACLPublicRead        BucketCannedACL = "public-read"
+	BucketCannedACLPublicReadWrite   BucketCannedACL = "public-read-write"
+	BucketCannedACLAuthenticatedRead BucketCannedACL = "authenticated-read"
+)
+
+// BucketCannedACLValues returns the values of BucketCannedACL known to this
+// version of the SDK. The service may accept values added since.
+func BucketCannedACLValues() []BucketCannedACL {
+	return []BucketCannedACL{
+		BucketCannedACL("private"),
+		BucketCannedACL("public-read"),
+		BucketCannedACL("public-read-write"),
+		BucketCannedACL("authenticated-read"),
+	}
+}
+
+// String returns the BucketCannedACL as the string of a member.
+func (v BucketCannedACL) String() string {
+	return string(v)
+}
+
+// ParseBucketCannedACL returns the BucketCannedACL of str, failing if it is not
+// one of the BucketCannedACLValues.
+func ParseBucketCannedACL(str string) (BucketCannedACL, error) {
+	for _, v := range BucketCannedACLValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid BucketCannedACL value %q", str)
+}
+
+// BucketLocationConstraint is an enum of the values of BucketLocationConstraint members.
+type BucketLocationConstraint string
+
+// The values of BucketLocationConstraint.
+const (
+	BucketLocationConstraintEU           BucketLocationConstraint = "EU"
+	BucketLocationConstraintEuWest1      BucketLocationConstraint = "eu-west-1"
+	BucketLocationConstraintUsWest1      BucketLocationConstraint = "us-west-1"
+	BucketLocationConstraintUsWest2      BucketLocationConstraint = "us-west-2"
+	BucketLocationConstraintApSoutheast1 BucketLocationConstraint = "ap-southeast-1"
+	BucketLocationConstraintApSoutheast2 BucketLocationConstraint = "ap-southeast-2"
+	BucketLocationConstraintApNortheast1 BucketLocationConstraint = "ap-northeast-1"
+	BucketLocationConstraintSaEast1      BucketLocationConstraint = "sa-east-1"
+	BucketLocationConstraintCnNorth1     BucketLocationConstraint = "cn-north-1"
+	BucketLocationConstraintEuCentral1   BucketLocationConstraint = "eu-central-1"
+)
+
+// BucketLocationConstraintValues returns the values of BucketLocationConstraint known to this
+// version of the SDK. The service may accept values added since.
+func BucketLocationConstraintValues() []BucketLocationConstraint {
+	return []BucketLocationConstraint{
+		BucketLocationConstraint("EU"),
+		BucketLocationConstraint("eu-west-1"),
+		BucketLocationConstraint("us-west-1"),
+		BucketLocationConstraint("us-west-2"),
+		BucketLocationConstraint("ap-southeast-1"),
+		BucketLocationConstraint("ap-southeast-2"),
+		BucketLocationConstraint("ap-northeast-1"),
+		BucketLocationConstraint("sa-east-1"),
+		BucketLocationConstraint(""),
+		BucketLocationConstraint("cn-north-1"),
+		BucketLocationConstraint("eu-central-1"),
+	}
+}
+
+// String returns the BucketLocationConstraint as the string of a member.
+func (v BucketLocationConstraint) String() string {
+	return string(v)
+}
+
+// ParseBucketLocationConstraint returns the BucketLocationConstraint of str, failing if it is not
+// one of the BucketLocationConstraintValues.
+func ParseBucketLocationConstraint(str string) (BucketLocationConstraint, error) {
+	for _, v := range BucketLocationConstraintValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid BucketLocationConstraint value %q", str)
+}
+
 type BucketLoggingStatus struct {
 	LoggingEnabled *LoggingEnabled `type:"structure"`
 
@@ -1466,6 +1641,81 @@ type metadataBucketLoggingStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the BucketLoggingStatus.
+func (s BucketLoggingStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
+// BucketLogsPermission is an enum of the values of BucketLogsPermission members.
+type BucketLogsPermission string
+
+// The values of BucketLogsPermission.
+const (
+	BucketLogsPermissionFULLCONTROL BucketLogsPermission = "FULL_CONTROL"
+	BucketLogsPermissionREAD        BucketLogsPermission = "READ"
+	BucketLogsPermissionWRITE       BucketLogsPermission = "WRITE"
+)
+
+// BucketLogsPermissionValues returns the values of BucketLogsPermission known to this
+// version of the SDK. The service may accept values added since.
+func BucketLogsPermissionValues() []BucketLogsPermission {
+	return []BucketLogsPermission{
+		BucketLogsPermission("FULL_CONTROL"),
+		BucketLogsPermission("READ"),
+		BucketLogsPermission("WRITE"),
+	}
+}
+
+// String returns the BucketLogsPermission as the string of a member.
+func (v BucketLogsPermission) String() string {
+	return string(v)
+}
+
+// ParseBucketLogsPermission returns the BucketLogsPermission of str, failing if it is not
+// one of the BucketLogsPermissionValues.
+func ParseBucketLogsPermission(str string) (BucketLogsPermission, error) {
+	for _, v := range BucketLogsPermissionValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid BucketLogsPermission value %q", str)
+}
+
+// BucketVersioningStatus is an enum of the values of BucketVersioningStatus members.
+type BucketVersioningStatus string
+
+// The values of BucketVersioningStatus.
+const (
+	BucketVersioningStatusEnabled   BucketVersioningStatus = "Enabled"
+	BucketVersioningStatusSuspended BucketVersioningStatus = "Suspended"
+)
+
+// BucketVersioningStatusValues returns the values of BucketVersioningStatus known to this
+// version of the SDK. The service may accept values added since.
+func BucketVersioningStatusValues() []BucketVersioningStatus {
+	return []BucketVersioningStatus{
+		BucketVersioningStatus("Enabled"),
+		BucketVersioningStatus("Suspended"),
+	}
+}
+
+// String returns the BucketVersioningStatus as the string of a member.
+func (v BucketVersioningStatus) String() string {
+	return string(v)
+}
+
+// ParseBucketVersioningStatus returns the BucketVersioningStatus of str, failing if it is not
+// one of the BucketVersioningStatusValues.
+func ParseBucketVersioningStatus(str string) (BucketVersioningStatus, error) {
+	for _, v := range BucketVersioningStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid BucketVersioningStatus value %q", str)
+}
+
 type CORSConfiguration struct {
 	CORSRules []*CORSRule `locationName:"CORSRule" type:"list" flattened:"true"`
 
@@ -1476,6 +1726,11 @@ type metadataCORSConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CORSConfiguration.
+func (s CORSConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CORSRule struct {
 	// Specifies which headers are allowed in a pre-flight OPTIONS request.
 	AllowedHeaders []*string `locationName:"AllowedHeader" type:"list" flattened:"true"`
@@ -1503,10 +1758,15 @@ type metadataCORSRule struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CORSRule.
+func (s CORSRule) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CloudFunctionConfiguration struct {
 	CloudFunction *string `type:"string"`
 
-	Event *string `type:"string"`
+	Event *string `type:"string" enum:"s3:ReducedRedundancyLostObject,s3:ObjectCreated:Put,s3:ObjectCreated:Post,s3:ObjectCreated:Copy,s3:ObjectCreated:CompleteMultipartUpload"`
 
 	Events []*string `locationName:"Event" type:"list" flattened:"true"`
 
@@ -1521,6 +1781,11 @@ type metadataCloudFunctionConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CloudFunctionConfiguration.
+func (s CloudFunctionConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CommonPrefix struct {
 	Prefix *string `type:"string"`
 
@@ -1531,6 +1796,11 @@ type metadataCommonPrefix struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CommonPrefix.
+func (s CommonPrefix) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CompleteMultipartUploadInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -1542,7 +1812,7 @@ type CompleteMultipartUploadInput struct {
 	// request. Bucket owners need not specify this parameter in their requests.
 	// Documentation on downloading objects from requester pays buckets can be found
 	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
 	UploadID *string `location:"querystring" locationName:"uploadId" type:"string" required:"true"`
 
@@ -1553,6 +1823,11 @@ type metadataCompleteMultipartUploadInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"MultipartUpload"`
 }
 
+// String returns the string representation of the CompleteMultipartUploadInput.
+func (s CompleteMultipartUploadInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CompleteMultipartUploadOutput struct {
 	Bucket *string `type:"string"`
 
@@ -1569,15 +1844,15 @@ type CompleteMultipartUploadOutput struct {
 
 	// If present, indicates that the requester was successfully charged for the
 	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
 	// If present, specifies the ID of the AWS Key Management Service (KMS) master
 	// encryption key that was used for the object.
-	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string" sensitive:"true"`
 
 	// The Server-side encryption algorithm used when storing this object in S3
 	// (e.g., AES256, aws:kms).
-	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string"`
+	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string" enum:"AES256"`
 
 	// Version of the object.
 	VersionID *string `location:"header" locationName:"x-amz-version-id" type:"string"`
@@ -1589,6 +1864,11 @@ type metadataCompleteMultipartUploadOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CompleteMultipartUploadOutput.
+func (s CompleteMultipartUploadOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CompletedMultipartUpload struct {
 	Parts []*CompletedPart `locationName:"Part" type:"list" flattened:"true"`
 
@@ -1599,6 +1879,11 @@ type metadataCompletedMultipartUpload struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CompletedMultipartUpload.
+func (s CompletedMultipartUpload) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CompletedPart struct {
 	// Entity tag returned when the part was uploaded.
 	ETag *string `type:"string"`
@@ -1613,6 +1898,11 @@ type metadataCompletedPart struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CompletedPart.
+func (s CompletedPart) String() string {
+	return awsutil.StringValue(s)
+}
+
 type Condition struct {
 	// The HTTP error code when the redirect is applied. In the event of an error,
 	// if the error code equals this value, then the specified redirect is applied.
@@ -1637,9 +1927,14 @@ type metadataCondition struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Condition.
+func (s Condition) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CopyObjectInput struct {
 	// The canned ACL to apply to the object.
-	ACL *string `location:"header" locationName:"x-amz-acl" type:"string"`
+	ACL *string `location:"header" locationName:"x-amz-acl" type:"string" enum:"private,public-read,public-read-write,authenticated-read,bucket-owner-read,bucket-owner-full-control"`
 
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -1662,7 +1957,7 @@ type CopyObjectInput struct {
 
 	// The name of the source bucket and key name of the source object, separated
 	// by a slash (/). Must be URL-encoded.
-	CopySource *string `location:"header" locationName:"x-amz-copy-source" type:"string" required:"true"`
+	CopySource *string `location:"header" locationName:"x-amz-copy-source" type:"string" pattern:"\\/.+\\/.+" required:"true"`
 
 	// Copies the object if its entity tag (ETag) matches the specified tag.
 	CopySourceIfMatch *string `location:"header" locationName:"x-amz-copy-source-if-match" type:"string"`
@@ -1683,7 +1978,7 @@ type CopyObjectInput struct {
 	// Specifies the customer-provided encryption key for Amazon S3 to use to decrypt
 	// the source object. The encryption key provided in this header must be one
 	// that was used when the source object was created.
-	CopySourceSSECustomerKey *string `location:"header" locationName:"x-amz-copy-source-server-side-encryption-customer-key" type:"string"`
+	CopySourceSSECustomerKey *string `location:"header" locationName:"x-amz-copy-source-server-side-encryption-customer-key" type:"string" sensitive:"true"`
 
 	// Specifies the 128-bit MD5 digest of the encryption key according to RFC 1321.
 	// Amazon S3 uses this header for a message integrity check to ensure the encryption
@@ -1712,13 +2007,13 @@ type CopyObjectInput struct {
 
 	// Specifies whether the metadata is copied from the source object or replaced
 	// with metadata provided in the request.
-	MetadataDirective *string `location:"header" locationName:"x-amz-metadata-directive" type:"string"`
+	MetadataDirective *string `location:"header" locationName:"x-amz-metadata-directive" type:"string" enum:"COPY,REPLACE"`
 
 	// Confirms that the requester knows that she or he will be charged for the
 	// request. Bucket owners need not specify this parameter in their requests.
 	// Documentation on downloading objects from requester pays buckets can be found
 	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
 	// Specifies the algorithm to use to when encrypting the object (e.g., AES256,
 	// aws:kms).
@@ -1729,7 +2024,7 @@ type CopyObjectInput struct {
 	// does not store the encryption key. The key must be appropriate for use with
 	// the algorithm specified in the x-amz-server-side​-encryption​-customer-algorithm
 	// header.
-	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string"`
+	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string" sensitive:"true"`
 
 	// Specifies the 128-bit MD5 digest of the encryption key according to RFC 1321.
 	// Amazon S3 uses this header for a message integrity check to ensure the encryption
@@ -1740,14 +2035,14 @@ type CopyObjectInput struct {
 	// requests for an object protected by AWS KMS will fail if not made via SSL
 	// or using SigV4. Documentation on configuring any of the officially supported
 	// AWS SDKs and CLI can be found at http://docs.aws.amazon.com/AmazonS3/latest/dev/UsingAWSSDK.html#specify-signature-version
-	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string" sensitive:"true"`
 
 	// The Server-side encryption algorithm used when storing this object in S3
 	// (e.g., AES256, aws:kms).
-	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string"`
+	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string" enum:"AES256"`
 
 	// The type of storage to use for the object. Defaults to 'STANDARD'.
-	StorageClass *string `location:"header" locationName:"x-amz-storage-class" type:"string"`
+	StorageClass *string `location:"header" locationName:"x-amz-storage-class" type:"string" enum:"STANDARD,REDUCED_REDUNDANCY"`
 
 	// If the bucket is configured as a website, redirects requests for this object
 	// to another object in the same bucket or to an external URL. Amazon S3 stores
@@ -1761,6 +2056,11 @@ type metadataCopyObjectInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CopyObjectInput.
+func (s CopyObjectInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CopyObjectOutput struct {
 	CopyObjectResult *CopyObjectResult `type:"structure"`
 
@@ -1771,7 +2071,7 @@ type CopyObjectOutput struct {
 
 	// If present, indicates that the requester was successfully charged for the
 	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
 	// If server-side encryption with a customer-provided encryption key was requested,
 	// the response will include this header confirming the encryption algorithm
@@ -1785,11 +2085,11 @@ type CopyObjectOutput struct {
 
 	// If present, specifies the ID of the AWS Key Management Service (KMS) master
 	// encryption key that was used for the object.
-	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string" sensitive:"true"`
 
 	// The Server-side encryption algorithm used when storing this object in S3
 	// (e.g., AES256, aws:kms).
-	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string"`
+	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string" enum:"AES256"`
 
 	metadataCopyObjectOutput `json:"-", xml:"-"`
 }
@@ -1798,6 +2098,11 @@ type metadataCopyObjectOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"CopyObjectResult"`
 }
 
+// String returns the string representation of the CopyObjectOutput.
+func (s CopyObjectOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CopyObjectResult struct {
 	ETag *string `type:"string"`
 
@@ -1810,6 +2115,11 @@ type metadataCopyObjectResult struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CopyObjectResult.
+func (s CopyObjectResult) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CopyPartResult struct {
 	// Entity tag of the object.
 	ETag *string `type:"string"`
@@ -1824,9 +2134,14 @@ type metadataCopyPartResult struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CopyPartResult.
+func (s CopyPartResult) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateBucketConfiguration struct {
 	// Specifies the region where the bucket will be created.
-	LocationConstraint *string `type:"string"`
+	LocationConstraint *string `type:"string" enum:"EU,eu-west-1,us-west-1,us-west-2,ap-southeast-1,ap-southeast-2,ap-northeast-1,sa-east-1,,cn-north-1,eu-central-1"`
 
 	metadataCreateBucketConfiguration `json:"-", xml:"-"`
 }
@@ -1835,9 +2150,14 @@ type metadataCreateBucketConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateBucketConfiguration.
+func (s CreateBucketConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateBucketInput struct {
 	// The canned ACL to apply to the bucket.
-	ACL *string `location:"header" locationName:"x-amz-acl" type:"string"`
+	ACL *string `location:"header" locationName:"x-amz-acl" type:"string" enum:"private,public-read,public-read-write,authenticated-read"`
 
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -1866,6 +2186,11 @@ type metadataCreateBucketInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"CreateBucketConfiguration"`
 }
 
+// String returns the string representation of the CreateBucketInput.
+func (s CreateBucketInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateBucketOutput struct {
 	Location *string `location:"header" locationName:"Location" type:"string"`
 
@@ -1876,9 +2201,14 @@ type metadataCreateBucketOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateBucketOutput.
+func (s CreateBucketOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateMultipartUploadInput struct {
 	// The canned ACL to apply to the object.
-	ACL *string `location:"header" locationName:"x-amz-acl" type:"string"`
+	ACL *string `location:"header" locationName:"x-amz-acl" type:"string" enum:"private,public-read,public-read-write,authenticated-read,bucket-owner-read,bucket-owner-full-control"`
 
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -1923,7 +2253,7 @@ type CreateMultipartUploadInput struct {
 	// request. Bucket owners need not specify this parameter in their requests.
 	// Documentation on downloading objects from requester pays buckets can be found
 	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
 	// Specifies the algorithm to use to when encrypting the object (e.g., AES256,
 	// aws:kms).
@@ -1934,7 +2264,7 @@ type CreateMultipartUploadInput struct {
 	// does not store the encryption key. The key must be appropriate for use with
 	// the algorithm specified in the x-amz-server-side​-encryption​-customer-algorithm
 	// header.
-	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string"`
+	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string" sensitive:"true"`
 
 	// Specifies the 128-bit MD5 digest of the encryption key according to RFC 1321.
 	// Amazon S3 uses this header for a message integrity check to ensure the encryption
@@ -1945,14 +2275,14 @@ type CreateMultipartUploadInput struct {
 	// requests for an object protected by AWS KMS will fail if not made via SSL
 	// or using SigV4. Documentation on configuring any of the officially supported
 	// AWS SDKs and CLI can be found at http://docs.aws.amazon.com/AmazonS3/latest/dev/UsingAWSSDK.html#specify-signature-version
-	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string" sensitive:"true"`
 
 	// The Server-side encryption algorithm used when storing this object in S3
 	// (e.g., AES256, aws:kms).
-	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string"`
+	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string" enum:"AES256"`
 
 	// The type of storage to use for the object. Defaults to 'STANDARD'.
-	StorageClass *string `location:"header" locationName:"x-amz-storage-class" type:"string"`
+	StorageClass *string `location:"header" locationName:"x-amz-storage-class" type:"string" enum:"STANDARD,REDUCED_REDUNDANCY"`
 
 	// If the bucket is configured as a website, redirects requests for this object
 	// to another object in the same bucket or to an external URL. Amazon S3 stores
@@ -1966,6 +2296,11 @@ type metadataCreateMultipartUploadInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateMultipartUploadInput.
+func (s CreateMultipartUploadInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateMultipartUploadOutput struct {
 	// Name of the bucket to which the multipart upload was initiated.
 	Bucket *string `locationName:"Bucket" type:"string"`
@@ -1975,7 +2310,7 @@ type CreateMultipartUploadOutput struct {
 
 	// If present, indicates that the requester was successfully charged for the
 	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
 	// If server-side encryption with a customer-provided encryption key was requested,
 	// the response will include this header confirming the encryption algorithm
@@ -1989,11 +2324,11 @@ type CreateMultipartUploadOutput struct {
 
 	// If present, specifies the ID of the AWS Key Management Service (KMS) master
 	// encryption key that was used for the object.
-	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string" sensitive:"true"`
 
 	// The Server-side encryption algorithm used when storing this object in S3
 	// (e.g., AES256, aws:kms).
-	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string"`
+	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string" enum:"AES256"`
 
 	// ID for the initiated multipart upload.
 	UploadID *string `locationName:"UploadId" type:"string"`
@@ -2005,6 +2340,11 @@ type metadataCreateMultipartUploadOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateMultipartUploadOutput.
+func (s CreateMultipartUploadOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type Delete struct {
 	Objects []*ObjectIdentifier `locationName:"Object" type:"list" flattened:"true" required:"true"`
 
@@ -2019,6 +2359,11 @@ type metadataDelete struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Delete.
+func (s Delete) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteBucketCORSInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -2029,6 +2374,11 @@ type metadataDeleteBucketCORSInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteBucketCORSInput.
+func (s DeleteBucketCORSInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteBucketCORSOutput struct {
 	metadataDeleteBucketCORSOutput `json:"-", xml:"-"`
 }
@@ -2037,6 +2387,11 @@ type metadataDeleteBucketCORSOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteBucketCORSOutput.
+func (s DeleteBucketCORSOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteBucketInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -2047,6 +2402,11 @@ type metadataDeleteBucketInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteBucketInput.
+func (s DeleteBucketInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteBucketLifecycleInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -2057,6 +2417,11 @@ type metadataDeleteBucketLifecycleInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteBucketLifecycleInput.
+func (s DeleteBucketLifecycleInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteBucketLifecycleOutput struct {
 	metadataDeleteBucketLifecycleOutput `json:"-", xml:"-"`
 }
@@ -2065,6 +2430,11 @@ type metadataDeleteBucketLifecycleOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteBucketLifecycleOutput.
+func (s DeleteBucketLifecycleOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteBucketOutput struct {
 	metadataDeleteBucketOutput `json:"-", xml:"-"`
 }
@@ -2073,6 +2443,11 @@ type metadataDeleteBucketOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteBucketOutput.
+func (s DeleteBucketOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteBucketPolicyInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -2083,6 +2458,11 @@ type metadataDeleteBucketPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteBucketPolicyInput.
+func (s DeleteBucketPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteBucketPolicyOutput struct {
 	metadataDeleteBucketPolicyOutput `json:"-", xml:"-"`
 }
@@ -2091,6 +2471,11 @@ type metadataDeleteBucketPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteBucketPolicyOutput.
+func (s DeleteBucketPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteBucketReplicationInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -2101,6 +2486,11 @@ type metadataDeleteBucketReplicationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteBucketReplicationInput.
+func (s DeleteBucketReplicationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteBucketReplicationOutput struct {
 	metadataDeleteBucketReplicationOutput `json:"-", xml:"-"`
 }
@@ -2109,6 +2499,11 @@ type metadataDeleteBucketReplicationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteBucketReplicationOutput.
+func (s DeleteBucketReplicationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteBucketTaggingInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -2119,6 +2514,11 @@ type metadataDeleteBucketTaggingInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteBucketTaggingInput.
+func (s DeleteBucketTaggingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteBucketTaggingOutput struct {
 	metadataDeleteBucketTaggingOutput `json:"-", xml:"-"`
 }
@@ -2127,6 +2527,11 @@ type metadataDeleteBucketTaggingOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteBucketTaggingOutput.
+func (s DeleteBucketTaggingOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteBucketWebsiteInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -2137,6 +2542,11 @@ type metadataDeleteBucketWebsiteInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteBucketWebsiteInput.
+func (s DeleteBucketWebsiteInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteBucketWebsiteOutput struct {
 	metadataDeleteBucketWebsiteOutput `json:"-", xml:"-"`
 }
@@ -2145,6 +2555,11 @@ type metadataDeleteBucketWebsiteOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteBucketWebsiteOutput.
+func (s DeleteBucketWebsiteOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteMarkerEntry struct {
 	// Specifies whether the object is (true) or is not (false) the latest version
 	// of an object.
@@ -2168,6 +2583,11 @@ type metadataDeleteMarkerEntry struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteMarkerEntry.
+func (s DeleteMarkerEntry) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteObjectInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -2181,7 +2601,7 @@ type DeleteObjectInput struct {
 	// request. Bucket owners need not specify this parameter in their requests.
 	// Documentation on downloading objects from requester pays buckets can be found
 	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
 	// VersionId used to reference a specific version of the object.
 	VersionID *string `location:"querystring" locationName:"versionId" type:"string"`
@@ -2193,6 +2613,11 @@ type metadataDeleteObjectInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteObjectInput.
+func (s DeleteObjectInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteObjectOutput struct {
 	// Specifies whether the versioned object that was permanently deleted was (true)
 	// or was not (false) a delete marker.
@@ -2200,7 +2625,7 @@ type DeleteObjectOutput struct {
 
 	// If present, indicates that the requester was successfully charged for the
 	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
 	// Returns the version ID of the delete marker created as a result of the DELETE
 	// operation.
@@ -2213,6 +2638,11 @@ type metadataDeleteObjectOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteObjectOutput.
+func (s DeleteObjectOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteObjectsInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -2226,7 +2656,7 @@ type DeleteObjectsInput struct {
 	// request. Bucket owners need not specify this parameter in their requests.
 	// Documentation on downloading objects from requester pays buckets can be found
 	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
 	metadataDeleteObjectsInput `json:"-", xml:"-"`
 }
@@ -2235,6 +2665,11 @@ type metadataDeleteObjectsInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"Delete"`
 }
 
+// String returns the string representation of the DeleteObjectsInput.
+func (s DeleteObjectsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteObjectsOutput struct {
 	Deleted []*DeletedObject `type:"list" flattened:"true"`
 
@@ -2242,7 +2677,7 @@ type DeleteObjectsOutput struct {
 
 	// If present, indicates that the requester was successfully charged for the
 	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
 	metadataDeleteObjectsOutput `json:"-", xml:"-"`
 }
@@ -2251,6 +2686,11 @@ type metadataDeleteObjectsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteObjectsOutput.
+func (s DeleteObjectsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeletedObject struct {
 	DeleteMarker *bool `type:"boolean"`
 
@@ -2267,6 +2707,11 @@ type metadataDeletedObject struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeletedObject.
+func (s DeletedObject) String() string {
+	return awsutil.StringValue(s)
+}
+
 type Destination struct {
 	// Amazon resource name (ARN) of the bucket where you want Amazon S3 to store
 	// replicas of the object identified by the rule.
@@ -2279,6 +2724,43 @@ type metadataDestination struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Destination.
+func (s Destination) String() string {
+	return awsutil.StringValue(s)
+}
+
+// EncodingType is an enum of the values of EncodingType members.
+type EncodingType string
+
+// The values of EncodingType.
+const (
+	EncodingTypeUrl EncodingType = "url"
+)
+
+// EncodingTypeValues returns the values of EncodingType known to this
+// version of the SDK. The service may accept values added since.
+func EncodingTypeValues() []EncodingType {
+	return []EncodingType{
+		EncodingType("url"),
+	}
+}
+
+// String returns the EncodingType as the string of a member.
+func (v EncodingType) String() string {
+	return string(v)
+}
+
+// ParseEncodingType returns the EncodingType of str, failing if it is not
+// one of the EncodingTypeValues.
+func ParseEncodingType(str string) (EncodingType, error) {
+	for _, v := range EncodingTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid EncodingType value %q", str)
+}
+
 type Error struct {
 	Code *string `type:"string"`
 
@@ -2295,6 +2777,11 @@ type metadataError struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Error.
+func (s Error) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ErrorDocument struct {
 	// The object key name to use when a 4XX class error occurs.
 	Key *string `type:"string" required:"true"`
@@ -2306,6 +2793,85 @@ type metadataErrorDocument struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ErrorDocument.
+func (s ErrorDocument) String() string {
+	return awsutil.StringValue(s)
+}
+
+// Event is an enum of the values of Event members.
+type Event string
+
+// The values of Event.
+const (
+	EventS3ReducedRedundancyLostObject          Event = "s3:ReducedRedundancyLostObject"
+	EventS3ObjectCreatedPut                     Event = "s3:ObjectCreated:Put"
+	EventS3ObjectCreatedPost                    Event = "s3:ObjectCreated:Post"
+	EventS3ObjectCreatedCopy                    Event = "s3:ObjectCreated:Copy"
+	EventS3ObjectCreatedCompleteMultipartUpload Event = "s3:ObjectCreated:CompleteMultipartUpload"
+)
+
+// EventValues returns the values of Event known to this
+// version of the SDK. The service may accept values added since.
+func EventValues() []Event {
+	return []Event{
+		Event("s3:ReducedRedundancyLostObject"),
+		Event("s3:ObjectCreated:Put"),
+		Event("s3:ObjectCreated:Post"),
+		Event("s3:ObjectCreated:Copy"),
+		Event("s3:ObjectCreated:CompleteMultipartUpload"),
+	}
+}
+
+// String returns the Event as the string of a member.
+func (v Event) String() string {
+	return string(v)
+}
+
+// ParseEvent returns the Event of str, failing if it is not
+// one of the EventValues.
+func ParseEvent(str string) (Event, error) {
+	for _, v := range EventValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid Event value %q", str)
+}
+
+// ExpirationStatus is an enum of the values of ExpirationStatus members.
+type ExpirationStatus string
+
+// The values of ExpirationStatus.
+const (
+	ExpirationStatusEnabled  ExpirationStatus = "Enabled"
+	ExpirationStatusDisabled ExpirationStatus = "Disabled"
+)
+
+// ExpirationStatusValues returns the values of ExpirationStatus known to this
+// version of the SDK. The service may accept values added since.
+func ExpirationStatusValues() []ExpirationStatus {
+	return []ExpirationStatus{
+		ExpirationStatus("Enabled"),
+		ExpirationStatus("Disabled"),
+	}
+}
+
+// String returns the ExpirationStatus as the string of a member.
+func (v ExpirationStatus) String() string {
+	return string(v)
+}
+
+// ParseExpirationStatus returns the ExpirationStatus of str, failing if it is not
+// one of the ExpirationStatusValues.
+func ParseExpirationStatus(str string) (ExpirationStatus, error) {
+	for _, v := range ExpirationStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ExpirationStatus value %q", str)
+}
+
 type GetBucketACLInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -2316,6 +2882,11 @@ type metadataGetBucketACLInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetBucketACLInput.
+func (s GetBucketACLInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetBucketACLOutput struct {
 	// A list of grants.
 	Grants []*Grant `locationName:"AccessControlList" locationNameList:"Grant" type:"list"`
@@ -2329,6 +2900,11 @@ type metadataGetBucketACLOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetBucketACLOutput.
+func (s GetBucketACLOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetBucketCORSInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -2339,6 +2915,11 @@ type metadataGetBucketCORSInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetBucketCORSInput.
+func (s GetBucketCORSInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetBucketCORSOutput struct {
 	CORSRules []*CORSRule `locationName:"CORSRule" type:"list" flattened:"true"`
 
@@ -2349,6 +2930,11 @@ type metadataGetBucketCORSOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetBucketCORSOutput.
+func (s GetBucketCORSOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetBucketLifecycleInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -2359,6 +2945,11 @@ type metadataGetBucketLifecycleInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetBucketLifecycleInput.
+func (s GetBucketLifecycleInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetBucketLifecycleOutput struct {
 	Rules []*Rule `locationName:"Rule" type:"list" flattened:"true"`
 
@@ -2369,6 +2960,11 @@ type metadataGetBucketLifecycleOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetBucketLifecycleOutput.
+func (s GetBucketLifecycleOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetBucketLocationInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -2379,8 +2975,13 @@ type metadataGetBucketLocationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetBucketLocationInput.
+func (s GetBucketLocationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetBucketLocationOutput struct {
-	LocationConstraint *string `type:"string"`
+	LocationConstraint *string `type:"string" enum:"EU,eu-west-1,us-west-1,us-west-2,ap-southeast-1,ap-southeast-2,ap-northeast-1,sa-east-1,,cn-north-1,eu-central-1"`
 
 	metadataGetBucketLocationOutput `json:"-", xml:"-"`
 }
@@ -2389,6 +2990,11 @@ type metadataGetBucketLocationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetBucketLocationOutput.
+func (s GetBucketLocationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetBucketLoggingInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -2399,6 +3005,11 @@ type metadataGetBucketLoggingInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetBucketLoggingInput.
+func (s GetBucketLoggingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetBucketLoggingOutput struct {
 	LoggingEnabled *LoggingEnabled `type:"structure"`
 
@@ -2409,6 +3020,11 @@ type metadataGetBucketLoggingOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetBucketLoggingOutput.
+func (s GetBucketLoggingOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetBucketNotificationInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -2419,6 +3035,11 @@ type metadataGetBucketNotificationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetBucketNotificationInput.
+func (s GetBucketNotificationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetBucketNotificationOutput struct {
 	CloudFunctionConfiguration *CloudFunctionConfiguration `type:"structure"`
 
@@ -2433,6 +3054,11 @@ type metadataGetBucketNotificationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetBucketNotificationOutput.
+func (s GetBucketNotificationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetBucketPolicyInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -2443,6 +3069,11 @@ type metadataGetBucketPolicyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetBucketPolicyInput.
+func (s GetBucketPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetBucketPolicyOutput struct {
 	// The bucket policy as a JSON document.
 	Policy *string `type:"string"`
@@ -2454,6 +3085,11 @@ type metadataGetBucketPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"Policy"`
 }
 
+// String returns the string representation of the GetBucketPolicyOutput.
+func (s GetBucketPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetBucketReplicationInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -2464,6 +3100,11 @@ type metadataGetBucketReplicationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetBucketReplicationInput.
+func (s GetBucketReplicationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetBucketReplicationOutput struct {
 	// Container for replication rules. You can add as many as 1,000 rules. Total
 	// replication configuration size can be up to 2 MB.
@@ -2476,6 +3117,11 @@ type metadataGetBucketReplicationOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"ReplicationConfiguration"`
 }
 
+// String returns the string representation of the GetBucketReplicationOutput.
+func (s GetBucketReplicationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetBucketRequestPaymentInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -2486,9 +3132,14 @@ type metadataGetBucketRequestPaymentInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetBucketRequestPaymentInput.
+func (s GetBucketRequestPaymentInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetBucketRequestPaymentOutput struct {
 	// Specifies who pays for the download and request fees.
-	Payer *string `type:"string"`
+	Payer *string `type:"string" enum:"Requester,BucketOwner"`
 
 	metadataGetBucketRequestPaymentOutput `json:"-", xml:"-"`
 }
@@ -2497,6 +3148,11 @@ type metadataGetBucketRequestPaymentOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetBucketRequestPaymentOutput.
+func (s GetBucketRequestPaymentOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetBucketTaggingInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -2507,6 +3163,11 @@ type metadataGetBucketTaggingInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetBucketTaggingInput.
+func (s GetBucketTaggingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetBucketTaggingOutput struct {
 	TagSet []*Tag `locationNameList:"Tag" type:"list" required:"true"`
 
@@ -2517,6 +3178,11 @@ type metadataGetBucketTaggingOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetBucketTaggingOutput.
+func (s GetBucketTaggingOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetBucketVersioningInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -2527,14 +3193,19 @@ type metadataGetBucketVersioningInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetBucketVersioningInput.
+func (s GetBucketVersioningInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetBucketVersioningOutput struct {
 	// Specifies whether MFA delete is enabled in the bucket versioning configuration.
 	// This element is only returned if the bucket has been configured with MFA
 	// delete. If the bucket has never been so configured, this element is not returned.
-	MFADelete *string `locationName:"MfaDelete" type:"string"`
+	MFADelete *string `locationName:"MfaDelete" type:"string" enum:"Enabled,Disabled"`
 
 	// The versioning state of the bucket.
-	Status *string `type:"string"`
+	Status *string `type:"string" enum:"Enabled,Suspended"`
 
 	metadataGetBucketVersioningOutput `json:"-", xml:"-"`
 }
@@ -2543,6 +3214,11 @@ type metadataGetBucketVersioningOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetBucketVersioningOutput.
+func (s GetBucketVersioningOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetBucketWebsiteInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -2553,6 +3229,11 @@ type metadataGetBucketWebsiteInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetBucketWebsiteInput.
+func (s GetBucketWebsiteInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetBucketWebsiteOutput struct {
 	ErrorDocument *ErrorDocument `type:"structure"`
 
@@ -2569,6 +3250,11 @@ type metadataGetBucketWebsiteOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetBucketWebsiteOutput.
+func (s GetBucketWebsiteOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetObjectACLInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -2578,7 +3264,7 @@ type GetObjectACLInput struct {
 	// request. Bucket owners need not specify this parameter in their requests.
 	// Documentation on downloading objects from requester pays buckets can be found
 	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
 	// VersionId used to reference a specific version of the object.
 	VersionID *string `location:"querystring" locationName:"versionId" type:"string"`
@@ -2590,6 +3276,11 @@ type metadataGetObjectACLInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetObjectACLInput.
+func (s GetObjectACLInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetObjectACLOutput struct {
 	// A list of grants.
 	Grants []*Grant `locationName:"AccessControlList" locationNameList:"Grant" type:"list"`
@@ -2598,7 +3289,7 @@ type GetObjectACLOutput struct {
 
 	// If present, indicates that the requester was successfully charged for the
 	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
 	metadataGetObjectACLOutput `json:"-", xml:"-"`
 }
@@ -2607,6 +3298,11 @@ type metadataGetObjectACLOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetObjectACLOutput.
+func (s GetObjectACLOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetObjectInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -2636,7 +3332,7 @@ type GetObjectInput struct {
 	// request. Bucket owners need not specify this parameter in their requests.
 	// Documentation on downloading objects from requester pays buckets can be found
 	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
 	// Sets the Cache-Control header of the response.
 	ResponseCacheControl *string `location:"querystring" locationName:"response-cache-control" type:"string"`
@@ -2665,7 +3361,7 @@ type GetObjectInput struct {
 	// does not store the encryption key. The key must be appropriate for use with
 	// the algorithm specified in the x-amz-server-side​-encryption​-customer-algorithm
 	// header.
-	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string"`
+	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string" sensitive:"true"`
 
 	// Specifies the 128-bit MD5 digest of the encryption key according to RFC 1321.
 	// Amazon S3 uses this header for a message integrity check to ensure the encryption
@@ -2682,6 +3378,11 @@ type metadataGetObjectInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetObjectInput.
+func (s GetObjectInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetObjectOutput struct {
 	AcceptRanges *string `location:"header" locationName:"accept-ranges" type:"string"`
 
@@ -2737,11 +3438,11 @@ type GetObjectOutput struct {
 	// you can create metadata whose values are not legal HTTP headers.
 	MissingMeta *int64 `location:"header" locationName:"x-amz-missing-meta" type:"integer"`
 
-	ReplicationStatus *string `location:"header" locationName:"x-amz-replication-status" type:"string"`
+	ReplicationStatus *string `location:"header" locationName:"x-amz-replication-status" type:"string" enum:"COMPLETE,PENDING,FAILED,REPLICA"`
 
 	// If present, indicates that the requester was successfully charged for the
 	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
 	// Provides information about object restoration operation and expiration time
 	// of the restored object copy.
@@ -2759,11 +3460,11 @@ type GetObjectOutput struct {
 
 	// If present, specifies the ID of the AWS Key Management Service (KMS) master
 	// encryption key that was used for the object.
-	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string" sensitive:"true"`
 
 	// The Server-side encryption algorithm used when storing this object in S3
 	// (e.g., AES256, aws:kms).
-	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string"`
+	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string" enum:"AES256"`
 
 	// Version of the object.
 	VersionID *string `location:"header" locationName:"x-amz-version-id" type:"string"`
@@ -2780,6 +3481,11 @@ type metadataGetObjectOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"Body"`
 }
 
+// String returns the string representation of the GetObjectOutput.
+func (s GetObjectOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetObjectTorrentInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -2789,7 +3495,7 @@ type GetObjectTorrentInput struct {
 	// request. Bucket owners need not specify this parameter in their requests.
 	// Documentation on downloading objects from requester pays buckets can be found
 	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
 	metadataGetObjectTorrentInput `json:"-", xml:"-"`
 }
@@ -2798,12 +3504,17 @@ type metadataGetObjectTorrentInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetObjectTorrentInput.
+func (s GetObjectTorrentInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetObjectTorrentOutput struct {
 	Body io.ReadCloser `type:"blob"`
 
 	// If present, indicates that the requester was successfully charged for the
 	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
 	metadataGetObjectTorrentOutput `json:"-", xml:"-"`
 }
@@ -2812,11 +3523,16 @@ type metadataGetObjectTorrentOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"Body"`
 }
 
+// String returns the string representation of the GetObjectTorrentOutput.
+func (s GetObjectTorrentOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type Grant struct {
 	Grantee *Grantee `type:"structure"`
 
 	// Specifies the permission given to the grantee.
-	Permission *string `type:"string"`
+	Permission *string `type:"string" enum:"FULL_CONTROL,WRITE,WRITE_ACP,READ,READ_ACP"`
 
 	metadataGrant `json:"-", xml:"-"`
 }
@@ -2825,6 +3541,11 @@ type metadataGrant struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Grant.
+func (s Grant) String() string {
+	return awsutil.StringValue(s)
+}
+
 type Grantee struct {
 	// Screen name of the grantee.
 	DisplayName *string `type:"string"`
@@ -2836,7 +3557,7 @@ type Grantee struct {
 	ID *string `type:"string"`
 
 	// Type of grantee
-	Type *string `locationName:"xsi:type" type:"string" required:"true"`
+	Type *string `locationName:"xsi:type" type:"string" enum:"CanonicalUser,AmazonCustomerByEmail,Group" required:"true"`
 
 	// URI of the grantee group.
 	URI *string `type:"string"`
@@ -2848,6 +3569,11 @@ type metadataGrantee struct {
 	SDKShapeTraits bool `type:"structure" xmlPrefix:"xsi" xmlURI:"http://www.w3.org/2001/XMLSchema-instance"`
 }
 
+// String returns the string representation of the Grantee.
+func (s Grantee) String() string {
+	return awsutil.StringValue(s)
+}
+
 type HeadBucketInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -2858,6 +3584,11 @@ type metadataHeadBucketInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the HeadBucketInput.
+func (s HeadBucketInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type HeadBucketOutput struct {
 	metadataHeadBucketOutput `json:"-", xml:"-"`
 }
@@ -2866,6 +3597,11 @@ type metadataHeadBucketOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the HeadBucketOutput.
+func (s HeadBucketOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type HeadObjectInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -2895,7 +3631,7 @@ type HeadObjectInput struct {
 	// request. Bucket owners need not specify this parameter in their requests.
 	// Documentation on downloading objects from requester pays buckets can be found
 	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
 	// Specifies the algorithm to use to when encrypting the object (e.g., AES256,
 	// aws:kms).
@@ -2906,7 +3642,7 @@ type HeadObjectInput struct {
 	// does not store the encryption key. The key must be appropriate for use with
 	// the algorithm specified in the x-amz-server-side​-encryption​-customer-algorithm
 	// header.
-	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string"`
+	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string" sensitive:"true"`
 
 	// Specifies the 128-bit MD5 digest of the encryption key according to RFC 1321.
 	// Amazon S3 uses this header for a message integrity check to ensure the encryption
@@ -2923,6 +3659,11 @@ type metadataHeadObjectInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the HeadObjectInput.
+func (s HeadObjectInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type HeadObjectOutput struct {
 	AcceptRanges *string `location:"header" locationName:"accept-ranges" type:"string"`
 
@@ -2975,11 +3716,11 @@ type HeadObjectOutput struct {
 	// you can create metadata whose values are not legal HTTP headers.
 	MissingMeta *int64 `location:"header" locationName:"x-amz-missing-meta" type:"integer"`
 
-	ReplicationStatus *string `location:"header" locationName:"x-amz-replication-status" type:"string"`
+	ReplicationStatus *string `location:"header" locationName:"x-amz-replication-status" type:"string" enum:"COMPLETE,PENDING,FAILED,REPLICA"`
 
 	// If present, indicates that the requester was successfully charged for the
 	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
 	// Provides information about object restoration operation and expiration time
 	// of the restored object copy.
@@ -2997,11 +3738,11 @@ type HeadObjectOutput struct {
 
 	// If present, specifies the ID of the AWS Key Management Service (KMS) master
 	// encryption key that was used for the object.
-	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string" sensitive:"true"`
 
 	// The Server-side encryption algorithm used when storing this object in S3
 	// (e.g., AES256, aws:kms).
-	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string"`
+	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string" enum:"AES256"`
 
 	// Version of the object.
 	VersionID *string `location:"header" locationName:"x-amz-version-id" type:"string"`
@@ -3018,6 +3759,11 @@ type metadataHeadObjectOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the HeadObjectOutput.
+func (s HeadObjectOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type IndexDocument struct {
 	// A suffix that is appended to a request that is for a directory on the website
 	// endpoint (e.g. if the suffix is index.html and you make a request to samplebucket/images/
@@ -3032,7 +3778,12 @@ type metadataIndexDocument struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-type Initiator struct {
+// String returns the string representation of the IndexDocument.
+func (s IndexDocument) String() string {
+	return awsutil.StringValue(s)
+}
+
+type Initiator struct {
 	// Name of the Principal.
 	DisplayName *string `type:"string"`
 
@@ -3047,6 +3798,11 @@ type metadataInitiator struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Initiator.
+func (s Initiator) String() string {
+	return awsutil.StringValue(s)
+}
+
 type LifecycleConfiguration struct {
 	Rules []*Rule `locationName:"Rule" type:"list" flattened:"true" required:"true"`
 
@@ -3057,6 +3813,11 @@ type metadataLifecycleConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the LifecycleConfiguration.
+func (s LifecycleConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
 type LifecycleExpiration struct {
 	// Indicates at what date the object is to be moved or deleted. Should be in
 	// GMT ISO 8601 Format.
@@ -3073,6 +3834,11 @@ type metadataLifecycleExpiration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the LifecycleExpiration.
+func (s LifecycleExpiration) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListBucketsInput struct {
 	metadataListBucketsInput `json:"-", xml:"-"`
 }
@@ -3081,6 +3847,11 @@ type metadataListBucketsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListBucketsInput.
+func (s ListBucketsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListBucketsOutput struct {
 	Buckets []*Bucket `locationNameList:"Bucket" type:"list"`
 
@@ -3093,6 +3864,11 @@ type metadataListBucketsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListBucketsOutput.
+func (s ListBucketsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListMultipartUploadsInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -3105,7 +3881,7 @@ type ListMultipartUploadsInput struct {
 	// with an ASCII value from 0 to 10. For characters that are not supported in
 	// XML 1.0, you can add this parameter to request that Amazon S3 encode the
 	// keys in the response.
-	EncodingType *string `location:"querystring" locationName:"encoding-type" type:"string"`
+	EncodingType *string `location:"querystring" locationName:"encoding-type" type:"string" enum:"url"`
 
 	// Together with upload-id-marker, this parameter specifies the multipart upload
 	// after which listing should begin.
@@ -3132,6 +3908,11 @@ type metadataListMultipartUploadsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListMultipartUploadsInput.
+func (s ListMultipartUploadsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListMultipartUploadsOutput struct {
 	// Name of the bucket to which the multipart upload was initiated.
 	Bucket *string `type:"string"`
@@ -3141,7 +3922,7 @@ type ListMultipartUploadsOutput struct {
 	Delimiter *string `type:"string"`
 
 	// Encoding type used by Amazon S3 to encode object keys in the response.
-	EncodingType *string `type:"string"`
+	EncodingType *string `type:"string" enum:"url"`
 
 	// Indicates whether the returned list of multipart uploads is truncated. A
 	// value of true indicates that the list was truncated. The list can be truncated
@@ -3180,6 +3961,11 @@ type metadataListMultipartUploadsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListMultipartUploadsOutput.
+func (s ListMultipartUploadsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListObjectVersionsInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -3192,7 +3978,7 @@ type ListObjectVersionsInput struct {
 	// with an ASCII value from 0 to 10. For characters that are not supported in
 	// XML 1.0, you can add this parameter to request that Amazon S3 encode the
 	// keys in the response.
-	EncodingType *string `location:"querystring" locationName:"encoding-type" type:"string"`
+	EncodingType *string `location:"querystring" locationName:"encoding-type" type:"string" enum:"url"`
 
 	// Specifies the key to start with when listing objects in a bucket.
 	KeyMarker *string `location:"querystring" locationName:"key-marker" type:"string"`
@@ -3214,6 +4000,11 @@ type metadataListObjectVersionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListObjectVersionsInput.
+func (s ListObjectVersionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListObjectVersionsOutput struct {
 	CommonPrefixes []*CommonPrefix `type:"list" flattened:"true"`
 
@@ -3222,7 +4013,7 @@ type ListObjectVersionsOutput struct {
 	Delimiter *string `type:"string"`
 
 	// Encoding type used by Amazon S3 to encode object keys in the response.
-	EncodingType *string `type:"string"`
+	EncodingType *string `type:"string" enum:"url"`
 
 	// A flag that indicates whether or not Amazon S3 returned all of the results
 	// that satisfied the search criteria. If your results were truncated, you can
@@ -3257,6 +4048,11 @@ type metadataListObjectVersionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListObjectVersionsOutput.
+func (s ListObjectVersionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListObjectsInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -3269,7 +4065,7 @@ type ListObjectsInput struct {
 	// with an ASCII value from 0 to 10. For characters that are not supported in
 	// XML 1.0, you can add this parameter to request that Amazon S3 encode the
 	// keys in the response.
-	EncodingType *string `location:"querystring" locationName:"encoding-type" type:"string"`
+	EncodingType *string `location:"querystring" locationName:"encoding-type" type:"string" enum:"url"`
 
 	// Specifies the key to start with when listing objects in a bucket.
 	Marker *string `location:"querystring" locationName:"marker" type:"string"`
@@ -3288,6 +4084,11 @@ type metadataListObjectsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListObjectsInput.
+func (s ListObjectsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListObjectsOutput struct {
 	CommonPrefixes []*CommonPrefix `type:"list" flattened:"true"`
 
@@ -3296,7 +4097,7 @@ type ListObjectsOutput struct {
 	Delimiter *string `type:"string"`
 
 	// Encoding type used by Amazon S3 to encode object keys in the response.
-	EncodingType *string `type:"string"`
+	EncodingType *string `type:"string" enum:"url"`
 
 	// A flag that indicates whether or not Amazon S3 returned all of the results
 	// that satisfied the search criteria.
@@ -3326,6 +4127,11 @@ type metadataListObjectsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListObjectsOutput.
+func (s ListObjectsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListPartsInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -3342,7 +4148,7 @@ type ListPartsInput struct {
 	// request. Bucket owners need not specify this parameter in their requests.
 	// Documentation on downloading objects from requester pays buckets can be found
 	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
 	// Upload ID identifying the multipart upload whose parts are being listed.
 	UploadID *string `location:"querystring" locationName:"uploadId" type:"string" required:"true"`
@@ -3354,6 +4160,11 @@ type metadataListPartsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListPartsInput.
+func (s ListPartsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListPartsOutput struct {
 	// Name of the bucket to which the multipart upload was initiated.
 	Bucket *string `type:"string"`
@@ -3384,10 +4195,10 @@ type ListPartsOutput struct {
 
 	// If present, indicates that the requester was successfully charged for the
 	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
 	// The class of storage used to store the object.
-	StorageClass *string `type:"string"`
+	StorageClass *string `type:"string" enum:"STANDARD,REDUCED_REDUNDANCY"`
 
 	// Upload ID identifying the multipart upload whose parts are being listed.
 	UploadID *string `locationName:"UploadId" type:"string"`
@@ -3399,6 +4210,11 @@ type metadataListPartsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListPartsOutput.
+func (s ListPartsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type LoggingEnabled struct {
 	// Specifies the bucket where you want Amazon S3 to store server access logs.
 	// You can have your logs delivered to any bucket that you own, including the
@@ -3421,6 +4237,113 @@ type metadataLoggingEnabled struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the LoggingEnabled.
+func (s LoggingEnabled) String() string {
+	return awsutil.StringValue(s)
+}
+
+// MFADelete is an enum of the values of MFADelete members.
+type MFADelete string
+
+// The values of MFADelete.
+const (
+	MFADeleteEnabled  MFADelete = "Enabled"
+	MFADeleteDisabled MFADelete = "Disabled"
+)
+
+// MFADeleteValues returns the values of MFADelete known to this
+// version of the SDK. The service may accept values added since.
+func MFADeleteValues() []MFADelete {
+	return []MFADelete{
+		MFADelete("Enabled"),
+		MFADelete("Disabled"),
+	}
+}
+
+// String returns the MFADelete as the string of a member.
+func (v MFADelete) String() string {
+	return string(v)
+}
+
+// ParseMFADelete returns the MFADelete of str, failing if it is not
+// one of the MFADeleteValues.
+func ParseMFADelete(str string) (MFADelete, error) {
+	for _, v := range MFADeleteValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid MFADelete value %q", str)
+}
+
+// MFADeleteStatus is an enum of the values of MFADeleteStatus members.
+type MFADeleteStatus string
+
+// The values of MFADeleteStatus.
+const (
+	MFADeleteStatusEnabled  MFADeleteStatus = "Enabled"
+	MFADeleteStatusDisabled MFADeleteStatus = "Disabled"
+)
+
+// MFADeleteStatusValues returns the values of MFADeleteStatus known to this
+// version of the SDK. The service may accept values added since.
+func MFADeleteStatusValues() []MFADeleteStatus {
+	return []MFADeleteStatus{
+		MFADeleteStatus("Enabled"),
+		MFADeleteStatus("Disabled"),
+	}
+}
+
+// String returns the MFADeleteStatus as the string of a member.
+func (v MFADeleteStatus) String() string {
+	return string(v)
+}
+
+// ParseMFADeleteStatus returns the MFADeleteStatus of str, failing if it is not
+// one of the MFADeleteStatusValues.
+func ParseMFADeleteStatus(str string) (MFADeleteStatus, error) {
+	for _, v := range MFADeleteStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid MFADeleteStatus value %q", str)
+}
+
+// MetadataDirective is an enum of the values of MetadataDirective members.
+type MetadataDirective string
+
+// The values of MetadataDirective.
+const (
+	MetadataDirectiveCOPY    MetadataDirective = "COPY"
+	MetadataDirectiveREPLACE MetadataDirective = "REPLACE"
+)
+
+// MetadataDirectiveValues returns the values of MetadataDirective known to this
+// version of the SDK. The service may accept values added since.
+func MetadataDirectiveValues() []MetadataDirective {
+	return []MetadataDirective{
+		MetadataDirective("COPY"),
+		MetadataDirective("REPLACE"),
+	}
+}
+
+// String returns the MetadataDirective as the string of a member.
+func (v MetadataDirective) String() string {
+	return string(v)
+}
+
+// ParseMetadataDirective returns the MetadataDirective of str, failing if it is not
+// one of the MetadataDirectiveValues.
+func ParseMetadataDirective(str string) (MetadataDirective, error) {
+	for _, v := range MetadataDirectiveValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid MetadataDirective value %q", str)
+}
+
 type MultipartUpload struct {
 	// Date and time at which the multipart upload was initiated.
 	Initiated *time.Time `type:"timestamp" timestampFormat:"iso8601"`
@@ -3434,7 +4357,7 @@ type MultipartUpload struct {
 	Owner *Owner `type:"structure"`
 
 	// The class of storage used to store the object.
-	StorageClass *string `type:"string"`
+	StorageClass *string `type:"string" enum:"STANDARD,REDUCED_REDUNDANCY"`
 
 	// Upload ID that identifies the multipart upload.
 	UploadID *string `locationName:"UploadId" type:"string"`
@@ -3446,6 +4369,11 @@ type metadataMultipartUpload struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the MultipartUpload.
+func (s MultipartUpload) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Specifies when noncurrent object versions expire. Upon expiration, Amazon
 // S3 permanently deletes the noncurrent object versions. You set this lifecycle
 // configuration action on a bucket that has versioning enabled (or suspended)
@@ -3466,6 +4394,11 @@ type metadataNoncurrentVersionExpiration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the NoncurrentVersionExpiration.
+func (s NoncurrentVersionExpiration) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for the transition rule that describes when noncurrent objects
 // transition to the GLACIER storage class. If your bucket is versioning-enabled
 // (or versioning is suspended), you can set this action to request that Amazon
@@ -3480,7 +4413,7 @@ type NoncurrentVersionTransition struct {
 	NoncurrentDays *int64 `type:"integer"`
 
 	// The class of storage used to store the object.
-	StorageClass *string `type:"string"`
+	StorageClass *string `type:"string" enum:"GLACIER"`
 
 	metadataNoncurrentVersionTransition `json:"-", xml:"-"`
 }
@@ -3489,6 +4422,11 @@ type metadataNoncurrentVersionTransition struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the NoncurrentVersionTransition.
+func (s NoncurrentVersionTransition) String() string {
+	return awsutil.StringValue(s)
+}
+
 type NotificationConfiguration struct {
 	CloudFunctionConfiguration *CloudFunctionConfiguration `type:"structure"`
 
@@ -3503,6 +4441,11 @@ type metadataNotificationConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the NotificationConfiguration.
+func (s NotificationConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
 type Object struct {
 	ETag *string `type:"string"`
 
@@ -3515,7 +4458,7 @@ type Object struct {
 	Size *int64 `type:"integer"`
 
 	// The class of storage used to store the object.
-	StorageClass *string `type:"string"`
+	StorageClass *string `type:"string" enum:"STANDARD,REDUCED_REDUNDANCY,GLACIER"`
 
 	metadataObject `json:"-", xml:"-"`
 }
@@ -3524,6 +4467,53 @@ type metadataObject struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Object.
+func (s Object) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ObjectCannedACL is an enum of the values of ObjectCannedACL members.
+type ObjectCannedACL string
+
+// The values of ObjectCannedACL.
+const (
+	ObjectCannedACLPrivate                ObjectCannedACL = "private"
+	ObjectCannedACLPublicRead             ObjectCannedACL = "public-read"
+	ObjectCannedACLPublicReadWrite        ObjectCannedACL = "public-read-write"
+	ObjectCannedACLAuthenticatedRead      ObjectCannedACL = "authenticated-read"
+	ObjectCannedACLBucketOwnerRead        ObjectCannedACL = "bucket-owner-read"
+	ObjectCannedACLBucketOwnerFullControl ObjectCannedACL = "bucket-owner-full-control"
+)
+
+// ObjectCannedACLValues returns the values of ObjectCannedACL known to this
+// version of the SDK. The service may accept values added since.
+func ObjectCannedACLValues() []ObjectCannedACL {
+	return []ObjectCannedACL{
+		ObjectCannedACL("private"),
+		ObjectCannedACL("public-read"),
+		ObjectCannedACL("public-read-write"),
+		ObjectCannedACL("authenticated-read"),
+		ObjectCannedACL("bucket-owner-read"),
+		ObjectCannedACL("bucket-owner-full-control"),
+	}
+}
+
+// String returns the ObjectCannedACL as the string of a member.
+func (v ObjectCannedACL) String() string {
+	return string(v)
+}
+
+// ParseObjectCannedACL returns the ObjectCannedACL of str, failing if it is not
+// one of the ObjectCannedACLValues.
+func ParseObjectCannedACL(str string) (ObjectCannedACL, error) {
+	for _, v := range ObjectCannedACLValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ObjectCannedACL value %q", str)
+}
+
 type ObjectIdentifier struct {
 	// Key name of the object to delete.
 	Key *string `type:"string" required:"true"`
@@ -3538,6 +4528,47 @@ type metadataObjectIdentifier struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ObjectIdentifier.
+func (s ObjectIdentifier) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ObjectStorageClass is an enum of the values of ObjectStorageClass members.
+type ObjectStorageClass string
+
+// The values of ObjectStorageClass.
+const (
+	ObjectStorageClassSTANDARD          ObjectStorageClass = "STANDARD"
+	ObjectStorageClassREDUCEDREDUNDANCY ObjectStorageClass = "REDUCED_REDUNDANCY"
+	ObjectStorageClassGLACIER           ObjectStorageClass = "GLACIER"
+)
+
+// ObjectStorageClassValues returns the values of ObjectStorageClass known to this
+// version of the SDK. The service may accept values added since.
+func ObjectStorageClassValues() []ObjectStorageClass {
+	return []ObjectStorageClass{
+		ObjectStorageClass("STANDARD"),
+		ObjectStorageClass("REDUCED_REDUNDANCY"),
+		ObjectStorageClass("GLACIER"),
+	}
+}
+
+// String returns the ObjectStorageClass as the string of a member.
+func (v ObjectStorageClass) String() string {
+	return string(v)
+}
+
+// ParseObjectStorageClass returns the ObjectStorageClass of str, failing if it is not
+// one of the ObjectStorageClassValues.
+func ParseObjectStorageClass(str string) (ObjectStorageClass, error) {
+	for _, v := range ObjectStorageClassValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ObjectStorageClass value %q", str)
+}
+
 type ObjectVersion struct {
 	ETag *string `type:"string"`
 
@@ -3557,7 +4588,7 @@ type ObjectVersion struct {
 	Size *int64 `type:"integer"`
 
 	// The class of storage used to store the object.
-	StorageClass *string `type:"string"`
+	StorageClass *string `type:"string" enum:"STANDARD"`
 
 	// Version ID of an object.
 	VersionID *string `locationName:"VersionId" type:"string"`
@@ -3569,6 +4600,43 @@ type metadataObjectVersion struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ObjectVersion.
+func (s ObjectVersion) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ObjectVersionStorageClass is an enum of the values of ObjectVersionStorageClass members.
+type ObjectVersionStorageClass string
+
+// The values of ObjectVersionStorageClass.
+const (
+	ObjectVersionStorageClassSTANDARD ObjectVersionStorageClass = "STANDARD"
+)
+
+// ObjectVersionStorageClassValues returns the values of ObjectVersionStorageClass known to this
+// version of the SDK. The service may accept values added since.
+func ObjectVersionStorageClassValues() []ObjectVersionStorageClass {
+	return []ObjectVersionStorageClass{
+		ObjectVersionStorageClass("STANDARD"),
+	}
+}
+
+// String returns the ObjectVersionStorageClass as the string of a member.
+func (v ObjectVersionStorageClass) String() string {
+	return string(v)
+}
+
+// ParseObjectVersionStorageClass returns the ObjectVersionStorageClass of str, failing if it is not
+// one of the ObjectVersionStorageClassValues.
+func ParseObjectVersionStorageClass(str string) (ObjectVersionStorageClass, error) {
+	for _, v := range ObjectVersionStorageClassValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ObjectVersionStorageClass value %q", str)
+}
+
 type Owner struct {
 	DisplayName *string `type:"string"`
 
@@ -3581,6 +4649,11 @@ type metadataOwner struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Owner.
+func (s Owner) String() string {
+	return awsutil.StringValue(s)
+}
+
 type Part struct {
 	// Entity tag returned when the part was uploaded.
 	ETag *string `type:"string"`
@@ -3601,9 +4674,122 @@ type metadataPart struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Part.
+func (s Part) String() string {
+	return awsutil.StringValue(s)
+}
+
+// Payer is an enum of the values of Payer members.
+type Payer string
+
+// The values of Payer.
+const (
+	PayerRequester   Payer = "Requester"
+	PayerBucketOwner Payer = "BucketOwner"
+)
+
+// PayerValues returns the values of Payer known to this
+// version of the SDK. The service may accept values added since.
+func PayerValues() []Payer {
+	return []Payer{
+		Payer("Requester"),
+		Payer("BucketOwner"),
+	}
+}
+
+// String returns the Payer as the string of a member.
+func (v Payer) String() string {
+	return string(v)
+}
+
+// ParsePayer returns the Payer of str, failing if it is not
+// one of the PayerValues.
+func ParsePayer(str string) (Payer, error) {
+	for _, v := range PayerValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid Payer value %q", str)
+}
+
+// Permission is an enum of the values of Permission members.
+type Permission string
+
+// The values of Permission.
+const (
+	PermissionFULLCONTROL Permission = "FULL_CONTROL"
+	PermissionWRITE       Permission = "WRITE"
+	PermissionWRITEACP    Permission = "WRITE_ACP"
+	PermissionREAD        Permission = "READ"
+	PermissionREADACP     Permission = "READ_ACP"
+)
+
+// PermissionValues returns the values of Permission known to this
+// version of the SDK. The service may accept values added since.
+func PermissionValues() []Permission {
+	return []Permission{
+		Permission("FULL_CONTROL"),
+		Permission("WRITE"),
+		Permission("WRITE_ACP"),
+		Permission("READ"),
+		Permission("READ_ACP"),
+	}
+}
+
+// String returns the Permission as the string of a member.
+func (v Permission) String() string {
+	return string(v)
+}
+
+// ParsePermission returns the Permission of str, failing if it is not
+// one of the PermissionValues.
+func ParsePermission(str string) (Permission, error) {
+	for _, v := range PermissionValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid Permission value %q", str)
+}
+
+// Protocol is an enum of the values of Protocol members.
+type Protocol string
+
+// The values of Protocol.
+const (
+	ProtocolHttp  Protocol = "http"
+	ProtocolHttps Protocol = "https"
+)
+
+// ProtocolValues returns the values of Protocol known to this
+// version of the SDK. The service may accept values added since.
+func ProtocolValues() []Protocol {
+	return []Protocol{
+		Protocol("http"),
+		Protocol("https"),
+	}
+}
+
+// String returns the Protocol as the string of a member.
+func (v Protocol) String() string {
+	return string(v)
+}
+
+// ParseProtocol returns the Protocol of str, failing if it is not
+// one of the ProtocolValues.
+func ParseProtocol(str string) (Protocol, error) {
+	for _, v := range ProtocolValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid Protocol value %q", str)
+}
+
 type PutBucketACLInput struct {
 	// The canned ACL to apply to the bucket.
-	ACL *string `location:"header" locationName:"x-amz-acl" type:"string"`
+	ACL *string `location:"header" locationName:"x-amz-acl" type:"string" enum:"private,public-read,public-read-write,authenticated-read"`
 
 	AccessControlPolicy *AccessControlPolicy `locationName:"AccessControlPolicy" type:"structure"`
 
@@ -3634,6 +4820,11 @@ type metadataPutBucketACLInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"AccessControlPolicy"`
 }
 
+// String returns the string representation of the PutBucketACLInput.
+func (s PutBucketACLInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutBucketACLOutput struct {
 	metadataPutBucketACLOutput `json:"-", xml:"-"`
 }
@@ -3642,6 +4833,11 @@ type metadataPutBucketACLOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutBucketACLOutput.
+func (s PutBucketACLOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutBucketCORSInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -3656,6 +4852,11 @@ type metadataPutBucketCORSInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"CORSConfiguration"`
 }
 
+// String returns the string representation of the PutBucketCORSInput.
+func (s PutBucketCORSInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutBucketCORSOutput struct {
 	metadataPutBucketCORSOutput `json:"-", xml:"-"`
 }
@@ -3664,6 +4865,11 @@ type metadataPutBucketCORSOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutBucketCORSOutput.
+func (s PutBucketCORSOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutBucketLifecycleInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -3678,6 +4884,11 @@ type metadataPutBucketLifecycleInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"LifecycleConfiguration"`
 }
 
+// String returns the string representation of the PutBucketLifecycleInput.
+func (s PutBucketLifecycleInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutBucketLifecycleOutput struct {
 	metadataPutBucketLifecycleOutput `json:"-", xml:"-"`
 }
@@ -3686,6 +4897,11 @@ type metadataPutBucketLifecycleOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutBucketLifecycleOutput.
+func (s PutBucketLifecycleOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutBucketLoggingInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -3700,6 +4916,11 @@ type metadataPutBucketLoggingInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"BucketLoggingStatus"`
 }
 
+// String returns the string representation of the PutBucketLoggingInput.
+func (s PutBucketLoggingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutBucketLoggingOutput struct {
 	metadataPutBucketLoggingOutput `json:"-", xml:"-"`
 }
@@ -3708,6 +4929,11 @@ type metadataPutBucketLoggingOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutBucketLoggingOutput.
+func (s PutBucketLoggingOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutBucketNotificationInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -3722,6 +4948,11 @@ type metadataPutBucketNotificationInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"NotificationConfiguration"`
 }
 
+// String returns the string representation of the PutBucketNotificationInput.
+func (s PutBucketNotificationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutBucketNotificationOutput struct {
 	metadataPutBucketNotificationOutput `json:"-", xml:"-"`
 }
@@ -3730,6 +4961,11 @@ type metadataPutBucketNotificationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutBucketNotificationOutput.
+func (s PutBucketNotificationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutBucketPolicyInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -3745,6 +4981,11 @@ type metadataPutBucketPolicyInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"Policy"`
 }
 
+// String returns the string representation of the PutBucketPolicyInput.
+func (s PutBucketPolicyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutBucketPolicyOutput struct {
 	metadataPutBucketPolicyOutput `json:"-", xml:"-"`
 }
@@ -3753,6 +4994,11 @@ type metadataPutBucketPolicyOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutBucketPolicyOutput.
+func (s PutBucketPolicyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutBucketReplicationInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -3769,6 +5015,11 @@ type metadataPutBucketReplicationInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"ReplicationConfiguration"`
 }
 
+// String returns the string representation of the PutBucketReplicationInput.
+func (s PutBucketReplicationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutBucketReplicationOutput struct {
 	metadataPutBucketReplicationOutput `json:"-", xml:"-"`
 }
@@ -3777,6 +5028,11 @@ type metadataPutBucketReplicationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutBucketReplicationOutput.
+func (s PutBucketReplicationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutBucketRequestPaymentInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -3791,6 +5047,11 @@ type metadataPutBucketRequestPaymentInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"RequestPaymentConfiguration"`
 }
 
+// String returns the string representation of the PutBucketRequestPaymentInput.
+func (s PutBucketRequestPaymentInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutBucketRequestPaymentOutput struct {
 	metadataPutBucketRequestPaymentOutput `json:"-", xml:"-"`
 }
@@ -3799,6 +5060,11 @@ type metadataPutBucketRequestPaymentOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutBucketRequestPaymentOutput.
+func (s PutBucketRequestPaymentOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutBucketTaggingInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -3813,6 +5079,11 @@ type metadataPutBucketTaggingInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"Tagging"`
 }
 
+// String returns the string representation of the PutBucketTaggingInput.
+func (s PutBucketTaggingInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutBucketTaggingOutput struct {
 	metadataPutBucketTaggingOutput `json:"-", xml:"-"`
 }
@@ -3821,6 +5092,11 @@ type metadataPutBucketTaggingOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutBucketTaggingOutput.
+func (s PutBucketTaggingOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutBucketVersioningInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -3839,6 +5115,11 @@ type metadataPutBucketVersioningInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"VersioningConfiguration"`
 }
 
+// String returns the string representation of the PutBucketVersioningInput.
+func (s PutBucketVersioningInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutBucketVersioningOutput struct {
 	metadataPutBucketVersioningOutput `json:"-", xml:"-"`
 }
@@ -3847,6 +5128,11 @@ type metadataPutBucketVersioningOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutBucketVersioningOutput.
+func (s PutBucketVersioningOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutBucketWebsiteInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -3861,6 +5147,11 @@ type metadataPutBucketWebsiteInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"WebsiteConfiguration"`
 }
 
+// String returns the string representation of the PutBucketWebsiteInput.
+func (s PutBucketWebsiteInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutBucketWebsiteOutput struct {
 	metadataPutBucketWebsiteOutput `json:"-", xml:"-"`
 }
@@ -3869,9 +5160,14 @@ type metadataPutBucketWebsiteOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutBucketWebsiteOutput.
+func (s PutBucketWebsiteOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutObjectACLInput struct {
 	// The canned ACL to apply to the object.
-	ACL *string `location:"header" locationName:"x-amz-acl" type:"string"`
+	ACL *string `location:"header" locationName:"x-amz-acl" type:"string" enum:"private,public-read,public-read-write,authenticated-read,bucket-owner-read,bucket-owner-full-control"`
 
 	AccessControlPolicy *AccessControlPolicy `locationName:"AccessControlPolicy" type:"structure"`
 
@@ -3901,7 +5197,7 @@ type PutObjectACLInput struct {
 	// request. Bucket owners need not specify this parameter in their requests.
 	// Documentation on downloading objects from requester pays buckets can be found
 	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
 	metadataPutObjectACLInput `json:"-", xml:"-"`
 }
@@ -3910,10 +5206,15 @@ type metadataPutObjectACLInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"AccessControlPolicy"`
 }
 
+// String returns the string representation of the PutObjectACLInput.
+func (s PutObjectACLInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutObjectACLOutput struct {
 	// If present, indicates that the requester was successfully charged for the
 	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
 	metadataPutObjectACLOutput `json:"-", xml:"-"`
 }
@@ -3922,9 +5223,14 @@ type metadataPutObjectACLOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutObjectACLOutput.
+func (s PutObjectACLOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutObjectInput struct {
 	// The canned ACL to apply to the object.
-	ACL *string `location:"header" locationName:"x-amz-acl" type:"string"`
+	ACL *string `location:"header" locationName:"x-amz-acl" type:"string" enum:"private,public-read,public-read-write,authenticated-read,bucket-owner-read,bucket-owner-full-control"`
 
 	// Object data.
 	Body io.ReadSeeker `type:"blob"`
@@ -3978,7 +5284,7 @@ type PutObjectInput struct {
 	// request. Bucket owners need not specify this parameter in their requests.
 	// Documentation on downloading objects from requester pays buckets can be found
 	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
 	// Specifies the algorithm to use to when encrypting the object (e.g., AES256,
 	// aws:kms).
@@ -3989,7 +5295,7 @@ type PutObjectInput struct {
 	// does not store the encryption key. The key must be appropriate for use with
 	// the algorithm specified in the x-amz-server-side​-encryption​-customer-algorithm
 	// header.
-	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string"`
+	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string" sensitive:"true"`
 
 	// Specifies the 128-bit MD5 digest of the encryption key according to RFC 1321.
 	// Amazon S3 uses this header for a message integrity check to ensure the encryption
@@ -4000,14 +5306,14 @@ type PutObjectInput struct {
 	// requests for an object protected by AWS KMS will fail if not made via SSL
 	// or using SigV4. Documentation on configuring any of the officially supported
 	// AWS SDKs and CLI can be found at http://docs.aws.amazon.com/AmazonS3/latest/dev/UsingAWSSDK.html#specify-signature-version
-	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string" sensitive:"true"`
 
 	// The Server-side encryption algorithm used when storing this object in S3
 	// (e.g., AES256, aws:kms).
-	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string"`
+	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string" enum:"AES256"`
 
 	// The type of storage to use for the object. Defaults to 'STANDARD'.
-	StorageClass *string `location:"header" locationName:"x-amz-storage-class" type:"string"`
+	StorageClass *string `location:"header" locationName:"x-amz-storage-class" type:"string" enum:"STANDARD,REDUCED_REDUNDANCY"`
 
 	// If the bucket is configured as a website, redirects requests for this object
 	// to another object in the same bucket or to an external URL. Amazon S3 stores
@@ -4021,6 +5327,11 @@ type metadataPutObjectInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"Body"`
 }
 
+// String returns the string representation of the PutObjectInput.
+func (s PutObjectInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PutObjectOutput struct {
 	// Entity tag for the uploaded object.
 	ETag *string `location:"header" locationName:"ETag" type:"string"`
@@ -4031,7 +5342,7 @@ type PutObjectOutput struct {
 
 	// If present, indicates that the requester was successfully charged for the
 	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
 	// If server-side encryption with a customer-provided encryption key was requested,
 	// the response will include this header confirming the encryption algorithm
@@ -4045,11 +5356,11 @@ type PutObjectOutput struct {
 
 	// If present, specifies the ID of the AWS Key Management Service (KMS) master
 	// encryption key that was used for the object.
-	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string" sensitive:"true"`
 
 	// The Server-side encryption algorithm used when storing this object in S3
 	// (e.g., AES256, aws:kms).
-	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string"`
+	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string" enum:"AES256"`
 
 	// Version of the object.
 	VersionID *string `location:"header" locationName:"x-amz-version-id" type:"string"`
@@ -4061,8 +5372,13 @@ type metadataPutObjectOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PutObjectOutput.
+func (s PutObjectOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type QueueConfiguration struct {
-	Event *string `type:"string"`
+	Event *string `type:"string" enum:"s3:ReducedRedundancyLostObject,s3:ObjectCreated:Put,s3:ObjectCreated:Post,s3:ObjectCreated:Copy,s3:ObjectCreated:CompleteMultipartUpload"`
 
 	Events []*string `locationName:"Event" type:"list" flattened:"true"`
 
@@ -4077,6 +5393,11 @@ type metadataQueueConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the QueueConfiguration.
+func (s QueueConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
 type Redirect struct {
 	// The HTTP redirect code to use on the response. Not required if one of the
 	// siblings is present.
@@ -4087,7 +5408,7 @@ type Redirect struct {
 
 	// Protocol to use (http, https) when redirecting requests. The default is the
 	// protocol that is used in the original request.
-	Protocol *string `type:"string"`
+	Protocol *string `type:"string" enum:"http,https"`
 
 	// The object key prefix to use in the redirect request. For example, to redirect
 	// requests for all pages with prefix docs/ (objects in the docs/ folder) to
@@ -4109,13 +5430,18 @@ type metadataRedirect struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Redirect.
+func (s Redirect) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RedirectAllRequestsTo struct {
 	// Name of the host where requests will be redirected.
 	HostName *string `type:"string" required:"true"`
 
 	// Protocol to use (http, https) when redirecting requests. The default is the
 	// protocol that is used in the original request.
-	Protocol *string `type:"string"`
+	Protocol *string `type:"string" enum:"http,https"`
 
 	metadataRedirectAllRequestsTo `json:"-", xml:"-"`
 }
@@ -4124,6 +5450,11 @@ type metadataRedirectAllRequestsTo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RedirectAllRequestsTo.
+func (s RedirectAllRequestsTo) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Container for replication rules. You can add as many as 1,000 rules. Total
 // replication configuration size can be up to 2 MB.
 type ReplicationConfiguration struct {
@@ -4142,6 +5473,11 @@ type metadataReplicationConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReplicationConfiguration.
+func (s ReplicationConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ReplicationRule struct {
 	Destination *Destination `type:"structure" required:"true"`
 
@@ -4154,7 +5490,7 @@ type ReplicationRule struct {
 	Prefix *string `type:"string" required:"true"`
 
 	// The rule is ignored if status is not Enabled.
-	Status *string `type:"string" required:"true"`
+	Status *string `type:"string" enum:"Enabled,Disabled" required:"true"`
 
 	metadataReplicationRule `json:"-", xml:"-"`
 }
@@ -4163,9 +5499,150 @@ type metadataReplicationRule struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReplicationRule.
+func (s ReplicationRule) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ReplicationRuleStatus is an enum of the values of ReplicationRuleStatus members.
+type ReplicationRuleStatus string
+
+// The values of ReplicationRuleStatus.
+const (
+	ReplicationRuleStatusEnabled  ReplicationRuleStatus = "Enabled"
+	ReplicationRuleStatusDisabled ReplicationRuleStatus = "Disabled"
+)
+
+// ReplicationRuleStatusValues returns the values of ReplicationRuleStatus known to this
+// version of the SDK. The service may accept values added since.
+func ReplicationRuleStatusValues() []ReplicationRuleStatus {
+	return []ReplicationRuleStatus{
+		ReplicationRuleStatus("Enabled"),
+		ReplicationRuleStatus("Disabled"),
+	}
+}
+
+// String returns the ReplicationRuleStatus as the string of a member.
+func (v ReplicationRuleStatus) String() string {
+	return string(v)
+}
+
+// ParseReplicationRuleStatus returns the ReplicationRuleStatus of str, failing if it is not
+// one of the ReplicationRuleStatusValues.
+func ParseReplicationRuleStatus(str string) (ReplicationRuleStatus, error) {
+	for _, v := range ReplicationRuleStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ReplicationRuleStatus value %q", str)
+}
+
+// ReplicationStatus is an enum of the values of ReplicationStatus members.
+type ReplicationStatus string
+
+// The values of ReplicationStatus.
+const (
+	ReplicationStatusCOMPLETE ReplicationStatus = "COMPLETE"
+	ReplicationStatusPENDING  ReplicationStatus = "PENDING"
+	ReplicationStatusFAILED   ReplicationStatus = "FAILED"
+	ReplicationStatusREPLICA  ReplicationStatus = "REPLICA"
+)
+
+// ReplicationStatusValues returns the values of ReplicationStatus known to this
+// version of the SDK. The service may accept values added since.
+func ReplicationStatusValues() []ReplicationStatus {
+	return []ReplicationStatus{
+		ReplicationStatus("COMPLETE"),
+		ReplicationStatus("PENDING"),
+		ReplicationStatus("FAILED"),
+		ReplicationStatus("REPLICA"),
+	}
+}
+
+// String returns the ReplicationStatus as the string of a member.
+func (v ReplicationStatus) String() string {
+	return string(v)
+}
+
+// ParseReplicationStatus returns the ReplicationStatus of str, failing if it is not
+// one of the ReplicationStatusValues.
+func ParseReplicationStatus(str string) (ReplicationStatus, error) {
+	for _, v := range ReplicationStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ReplicationStatus value %q", str)
+}
+
+// RequestCharged is an enum of the values of RequestCharged members.
+type RequestCharged string
+
+// The values of RequestCharged.
+const (
+	RequestChargedRequester RequestCharged = "requester"
+)
+
+// RequestChargedValues returns the values of RequestCharged known to this
+// version of the SDK. The service may accept values added since.
+func RequestChargedValues() []RequestCharged {
+	return []RequestCharged{
+		RequestCharged("requester"),
+	}
+}
+
+// String returns the RequestCharged as the string of a member.
+func (v RequestCharged) String() string {
+	return string(v)
+}
+
+// ParseRequestCharged returns the RequestCharged of str, failing if it is not
+// one of the RequestChargedValues.
+func ParseRequestCharged(str string) (RequestCharged, error) {
+	for _, v := range RequestChargedValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid RequestCharged value %q", str)
+}
+
+// RequestPayer is an enum of the values of RequestPayer members.
+type RequestPayer string
+
+// The values of RequestPayer.
+const (
+	RequestPayerRequester RequestPayer = "requester"
+)
+
+// RequestPayerValues returns the values of RequestPayer known to this
+// version of the SDK. The service may accept values added since.
+func RequestPayerValues() []RequestPayer {
+	return []RequestPayer{
+		RequestPayer("requester"),
+	}
+}
+
+// String returns the RequestPayer as the string of a member.
+func (v RequestPayer) String() string {
+	return string(v)
+}
+
+// ParseRequestPayer returns the RequestPayer of str, failing if it is not
+// one of the RequestPayerValues.
+func ParseRequestPayer(str string) (RequestPayer, error) {
+	for _, v := range RequestPayerValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid RequestPayer value %q", str)
+}
+
 type RequestPaymentConfiguration struct {
 	// Specifies who pays for the download and request fees.
-	Payer *string `type:"string" required:"true"`
+	Payer *string `type:"string" enum:"Requester,BucketOwner" required:"true"`
 
 	metadataRequestPaymentConfiguration `json:"-", xml:"-"`
 }
@@ -4174,6 +5651,11 @@ type metadataRequestPaymentConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RequestPaymentConfiguration.
+func (s RequestPaymentConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RestoreObjectInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
@@ -4183,7 +5665,7 @@ type RestoreObjectInput struct {
 	// request. Bucket owners need not specify this parameter in their requests.
 	// Documentation on downloading objects from requester pays buckets can be found
 	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
 	RestoreRequest *RestoreRequest `locationName:"RestoreRequest" type:"structure"`
 
@@ -4196,10 +5678,15 @@ type metadataRestoreObjectInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"RestoreRequest"`
 }
 
+// String returns the string representation of the RestoreObjectInput.
+func (s RestoreObjectInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RestoreObjectOutput struct {
 	// If present, indicates that the requester was successfully charged for the
 	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
 	metadataRestoreObjectOutput `json:"-", xml:"-"`
 }
@@ -4208,6 +5695,11 @@ type metadataRestoreObjectOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RestoreObjectOutput.
+func (s RestoreObjectOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RestoreRequest struct {
 	// Lifetime of the active copy in days
 	Days *int64 `type:"integer" required:"true"`
@@ -4219,6 +5711,11 @@ type metadataRestoreRequest struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RestoreRequest.
+func (s RestoreRequest) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RoutingRule struct {
 	// A container for describing a condition that must be met for the specified
 	// redirect to apply. For example, 1. If request is for pages in the /docs folder,
@@ -4238,6 +5735,11 @@ type metadataRoutingRule struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RoutingRule.
+func (s RoutingRule) String() string {
+	return awsutil.StringValue(s)
+}
+
 type Rule struct {
 	Expiration *LifecycleExpiration `type:"structure"`
 
@@ -4263,7 +5765,7 @@ type Rule struct {
 
 	// If 'Enabled', the rule is currently being applied. If 'Disabled', the rule
 	// is not currently being applied.
-	Status *string `type:"string" required:"true"`
+	Status *string `type:"string" enum:"Enabled,Disabled" required:"true"`
 
 	Transition *Transition `type:"structure"`
 
@@ -4274,6 +5776,77 @@ type metadataRule struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Rule.
+func (s Rule) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ServerSideEncryption is an enum of the values of ServerSideEncryption members.
+type ServerSideEncryption string
+
+// The values of ServerSideEncryption.
+const (
+	ServerSideEncryptionAES256 ServerSideEncryption = "AES256"
+)
+
+// ServerSideEncryptionValues returns the values of ServerSideEncryption known to this
+// version of the SDK. The service may accept values added since.
+func ServerSideEncryptionValues() []ServerSideEncryption {
+	return []ServerSideEncryption{
+		ServerSideEncryption("AES256"),
+	}
+}
+
+// String returns the ServerSideEncryption as the string of a member.
+func (v ServerSideEncryption) String() string {
+	return string(v)
+}
+
+// ParseServerSideEncryption returns the ServerSideEncryption of str, failing if it is not
+// one of the ServerSideEncryptionValues.
+func ParseServerSideEncryption(str string) (ServerSideEncryption, error) {
+	for _, v := range ServerSideEncryptionValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ServerSideEncryption value %q", str)
+}
+
+// StorageClass is an enum of the values of StorageClass members.
+type StorageClass string
+
+// The values of StorageClass.
+const (
+	StorageClassSTANDARD          StorageClass = "STANDARD"
+	StorageClassREDUCEDREDUNDANCY StorageClass = "REDUCED_REDUNDANCY"
+)
+
+// StorageClassValues returns the values of StorageClass known to this
+// version of the SDK. The service may accept values added since.
+func StorageClassValues() []StorageClass {
+	return []StorageClass{
+		StorageClass("STANDARD"),
+		StorageClass("REDUCED_REDUNDANCY"),
+	}
+}
+
+// String returns the StorageClass as the string of a member.
+func (v StorageClass) String() string {
+	return string(v)
+}
+
+// ParseStorageClass returns the StorageClass of str, failing if it is not
+// one of the StorageClassValues.
+func ParseStorageClass(str string) (StorageClass, error) {
+	for _, v := range StorageClassValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid StorageClass value %q", str)
+}
+
 type Tag struct {
 	// Name of the tag.
 	Key *string `type:"string" required:"true"`
@@ -4288,6 +5861,11 @@ type metadataTag struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Tag.
+func (s Tag) String() string {
+	return awsutil.StringValue(s)
+}
+
 type Tagging struct {
 	TagSet []*Tag `locationNameList:"Tag" type:"list" required:"true"`
 
@@ -4298,11 +5876,16 @@ type metadataTagging struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Tagging.
+func (s Tagging) String() string {
+	return awsutil.StringValue(s)
+}
+
 type TargetGrant struct {
 	Grantee *Grantee `type:"structure"`
 
 	// Logging permissions assigned to the Grantee for the bucket.
-	Permission *string `type:"string"`
+	Permission *string `type:"string" enum:"FULL_CONTROL,READ,WRITE"`
 
 	metadataTargetGrant `json:"-", xml:"-"`
 }
@@ -4311,9 +5894,14 @@ type metadataTargetGrant struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TargetGrant.
+func (s TargetGrant) String() string {
+	return awsutil.StringValue(s)
+}
+
 type TopicConfiguration struct {
 	// Bucket event for which to send notifications.
-	Event *string `type:"string"`
+	Event *string `type:"string" enum:"s3:ReducedRedundancyLostObject,s3:ObjectCreated:Put,s3:ObjectCreated:Post,s3:ObjectCreated:Copy,s3:ObjectCreated:CompleteMultipartUpload"`
 
 	Events []*string `locationName:"Event" type:"list" flattened:"true"`
 
@@ -4330,6 +5918,11 @@ type metadataTopicConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TopicConfiguration.
+func (s TopicConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
 type Transition struct {
 	// Indicates at what date the object is to be moved or deleted. Should be in
 	// GMT ISO 8601 Format.
@@ -4340,7 +5933,7 @@ type Transition struct {
 	Days *int64 `type:"integer"`
 
 	// The class of storage used to store the object.
-	StorageClass *string `type:"string"`
+	StorageClass *string `type:"string" enum:"GLACIER"`
 
 	metadataTransition `json:"-", xml:"-"`
 }
@@ -4349,12 +5942,85 @@ type metadataTransition struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Transition.
+func (s Transition) String() string {
+	return awsutil.StringValue(s)
+}
+
+// TransitionStorageClass is an enum of the values of TransitionStorageClass members.
+type TransitionStorageClass string
+
+// The values of TransitionStorageClass.
+const (
+	TransitionStorageClassGLACIER TransitionStorageClass = "GLACIER"
+)
+
+// TransitionStorageClassValues returns the values of TransitionStorageClass known to this
+// version of the SDK. The service may accept values added since.
+func TransitionStorageClassValues() []TransitionStorageClass {
+	return []TransitionStorageClass{
+		TransitionStorageClass("GLACIER"),
+	}
+}
+
+// String returns the TransitionStorageClass as the string of a member.
+func (v TransitionStorageClass) String() string {
+	return string(v)
+}
+
+// ParseTransitionStorageClass returns the TransitionStorageClass of str, failing if it is not
+// one of the TransitionStorageClassValues.
+func ParseTransitionStorageClass(str string) (TransitionStorageClass, error) {
+	for _, v := range TransitionStorageClassValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid TransitionStorageClass value %q", str)
+}
+
+// Type is an enum of the values of Type members.
+type Type string
+
+// The values of Type.
+const (
+	TypeCanonicalUser         Type = "CanonicalUser"
+	TypeAmazonCustomerByEmail Type = "AmazonCustomerByEmail"
+	TypeGroup                 Type = "Group"
+)
+
+// TypeValues returns the values of Type known to this
+// version of the SDK. The service may accept values added since.
+func TypeValues() []Type {
+	return []Type{
+		Type("CanonicalUser"),
+		Type("AmazonCustomerByEmail"),
+		Type("Group"),
+	}
+}
+
+// String returns the Type as the string of a member.
+func (v Type) String() string {
+	return string(v)
+}
+
+// ParseType returns the Type of str, failing if it is not
+// one of the TypeValues.
+func ParseType(str string) (Type, error) {
+	for _, v := range TypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid Type value %q", str)
+}
+
 type UploadPartCopyInput struct {
 	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
 
 	// The name of the source bucket and key name of the source object, separated
 	// by a slash (/). Must be URL-encoded.
-	CopySource *string `location:"header" locationName:"x-amz-copy-source" type:"string" required:"true"`
+	CopySource *string `location:"header" locationName:"x-amz-copy-source" type:"string" pattern:"\\/.+\\/.+" required:"true"`
 
 	// Copies the object if its entity tag (ETag) matches the specified tag.
 	CopySourceIfMatch *string `location:"header" locationName:"x-amz-copy-source-if-match" type:"string"`
@@ -4382,7 +6048,7 @@ type UploadPartCopyInput struct {
 	// Specifies the customer-provided encryption key for Amazon S3 to use to decrypt
 	// the source object. The encryption key provided in this header must be one
 	// that was used when the source object was created.
-	CopySourceSSECustomerKey *string `location:"header" locationName:"x-amz-copy-source-server-side-encryption-customer-key" type:"string"`
+	CopySourceSSECustomerKey *string `location:"header" locationName:"x-amz-copy-source-server-side-encryption-customer-key" type:"string" sensitive:"true"`
 
 	// Specifies the 128-bit MD5 digest of the encryption key according to RFC 1321.
 	// Amazon S3 uses this header for a message integrity check to ensure the encryption
@@ -4398,7 +6064,7 @@ type UploadPartCopyInput struct {
 	// request. Bucket owners need not specify this parameter in their requests.
 	// Documentation on downloading objects from requester pays buckets can be found
 	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
 	// Specifies the algorithm to use to when encrypting the object (e.g., AES256,
 	// aws:kms).
@@ -4410,7 +6076,7 @@ type UploadPartCopyInput struct {
 	// the algorithm specified in the x-amz-server-side​-encryption​-customer-algorithm
 	// header. This must be the same encryption key specified in the initiate multipart
 	// upload request.
-	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string"`
+	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string" sensitive:"true"`
 
 	// Specifies the 128-bit MD5 digest of the encryption key according to RFC 1321.
 	// Amazon S3 uses this header for a message integrity check to ensure the encryption
@@ -4427,6 +6093,11 @@ type metadataUploadPartCopyInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UploadPartCopyInput.
+func (s UploadPartCopyInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UploadPartCopyOutput struct {
 	CopyPartResult *CopyPartResult `type:"structure"`
 
@@ -4436,7 +6107,7 @@ type UploadPartCopyOutput struct {
 
 	// If present, indicates that the requester was successfully charged for the
 	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
 	// If server-side encryption with a customer-provided encryption key was requested,
 	// the response will include this header confirming the encryption algorithm
@@ -4450,11 +6121,11 @@ type UploadPartCopyOutput struct {
 
 	// If present, specifies the ID of the AWS Key Management Service (KMS) master
 	// encryption key that was used for the object.
-	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string" sensitive:"true"`
 
 	// The Server-side encryption algorithm used when storing this object in S3
 	// (e.g., AES256, aws:kms).
-	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string"`
+	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string" enum:"AES256"`
 
 	metadataUploadPartCopyOutput `json:"-", xml:"-"`
 }
@@ -4463,6 +6134,11 @@ type metadataUploadPartCopyOutput struct {
 	SDKShapeTraits bool `type:"structure" payload:"CopyPartResult"`
 }
 
+// String returns the string representation of the UploadPartCopyOutput.
+func (s UploadPartCopyOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UploadPartInput struct {
 	Body io.ReadSeeker `type:"blob"`
 
@@ -4483,7 +6159,7 @@ type UploadPartInput struct {
 	// request. Bucket owners need not specify this parameter in their requests.
 	// Documentation on downloading objects from requester pays buckets can be found
 	// at http://docs.aws.amazon.com/AmazonS3/latest/dev/ObjectsinRequesterPaysBuckets.html
-	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string"`
+	RequestPayer *string `location:"header" locationName:"x-amz-request-payer" type:"string" enum:"requester"`
 
 	// Specifies the algorithm to use to when encrypting the object (e.g., AES256,
 	// aws:kms).
@@ -4495,7 +6171,7 @@ type UploadPartInput struct {
 	// the algorithm specified in the x-amz-server-side​-encryption​-customer-algorithm
 	// header. This must be the same encryption key specified in the initiate multipart
 	// upload request.
-	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string"`
+	SSECustomerKey *string `location:"header" locationName:"x-amz-server-side-encryption-customer-key" type:"string" sensitive:"true"`
 
 	// Specifies the 128-bit MD5 digest of the encryption key according to RFC 1321.
 	// Amazon S3 uses this header for a message integrity check to ensure the encryption
@@ -4512,13 +6188,18 @@ type metadataUploadPartInput struct {
 	SDKShapeTraits bool `type:"structure" payload:"Body"`
 }
 
+// String returns the string representation of the UploadPartInput.
+func (s UploadPartInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UploadPartOutput struct {
 	// Entity tag for the uploaded object.
 	ETag *string `location:"header" locationName:"ETag" type:"string"`
 
 	// If present, indicates that the requester was successfully charged for the
 	// request.
-	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string"`
+	RequestCharged *string `location:"header" locationName:"x-amz-request-charged" type:"string" enum:"requester"`
 
 	// If server-side encryption with a customer-provided encryption key was requested,
 	// the response will include this header confirming the encryption algorithm
@@ -4532,11 +6213,11 @@ type UploadPartOutput struct {
 
 	// If present, specifies the ID of the AWS Key Management Service (KMS) master
 	// encryption key that was used for the object.
-	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string"`
+	SSEKMSKeyID *string `location:"header" locationName:"x-amz-server-side-encryption-aws-kms-key-id" type:"string" sensitive:"true"`
 
 	// The Server-side encryption algorithm used when storing this object in S3
 	// (e.g., AES256, aws:kms).
-	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string"`
+	ServerSideEncryption *string `location:"header" locationName:"x-amz-server-side-encryption" type:"string" enum:"AES256"`
 
 	metadataUploadPartOutput `json:"-", xml:"-"`
 }
@@ -4545,14 +6226,19 @@ type metadataUploadPartOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UploadPartOutput.
+func (s UploadPartOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type VersioningConfiguration struct {
 	// Specifies whether MFA delete is enabled in the bucket versioning configuration.
 	// This element is only returned if the bucket has been configured with MFA
 	// delete. If the bucket has never been so configured, this element is not returned.
-	MFADelete *string `locationName:"MfaDelete" type:"string"`
+	MFADelete *string `locationName:"MfaDelete" type:"string" enum:"Enabled,Disabled"`
 
 	// The versioning state of the bucket.
-	Status *string `type:"string"`
+	Status *string `type:"string" enum:"Enabled,Suspended"`
 
 	metadataVersioningConfiguration `json:"-", xml:"-"`
 }
@@ -4561,6 +6247,11 @@ type metadataVersioningConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the VersioningConfiguration.
+func (s VersioningConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
 type WebsiteConfiguration struct {
 	ErrorDocument *ErrorDocument `type:"structure"`
 
@@ -4575,4 +6266,9 @@ type WebsiteConfiguration struct {
 
 type metadataWebsiteConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the WebsiteConfiguration.
+func (s WebsiteConfiguration) String() string {
+	return awsutil.StringValue(s)
 }
\ No newline at end of file
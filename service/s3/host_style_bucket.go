@@ -0,0 +1,81 @@
+package s3
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+)
+
+var reDomain = regexp.MustCompile(`^[a-z0-9][a-z0-9\.\-]{1,61}[a-z0-9]$`)
+var reIPAddress = regexp.MustCompile(`^(\d+\.){3}\d+$`)
+
+// dnsCompatibleBucketName returns whether the bucket name can be used as part
+// of a DNS host name.
+func dnsCompatibleBucketName(bucket string) bool {
+	return reDomain.MatchString(bucket) &&
+		!reIPAddress.MatchString(bucket) &&
+		!strings.Contains(bucket, "..")
+}
+
+// hostCompatibleBucketName returns whether the bucket can be addressed in the
+// request's host. Bucket names with dots do not match the wildcard TLS
+// certificate of S3's endpoints, so they are only used in hosts over HTTP.
+func hostCompatibleBucketName(r *aws.Request, bucket string) bool {
+	if r.HTTPRequest.URL.Scheme == "https" && strings.Contains(bucket, ".") {
+		return false
+	}
+	return dnsCompatibleBucketName(bucket)
+}
+
+// bucketNameOf returns the Bucket member of the request's params, if any.
+func bucketNameOf(r *aws.Request) string {
+	if !r.ParamsFilled() {
+		return ""
+	}
+
+	v := reflect.Indirect(reflect.ValueOf(r.Params))
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+	f := v.FieldByName("Bucket")
+	if !f.IsValid() {
+		return ""
+	}
+	if b, ok := f.Interface().(*string); ok && b != nil {
+		return *b
+	}
+	return ""
+}
+
+// updateHostWithBucket moves the bucket of path-style request URLs, such as
+// https://s3.amazonaws.com/bucket/key, into their host, as in
+// https://bucket.s3.amazonaws.com/key. Requests are left path-style if the
+// Config's S3ForcePathStyle is set or the bucket cannot be part of the host.
+func updateHostWithBucket(r *aws.Request) {
+	if r.Service.Config.S3ForcePathStyle {
+		return
+	}
+
+	bucket := bucketNameOf(r)
+	if bucket == "" || !hostCompatibleBucketName(r, bucket) {
+		return
+	}
+
+	u := r.HTTPRequest.URL
+	prefix := "//" + u.Host + "/" + bucket
+	if !strings.HasPrefix(u.Opaque, prefix) {
+		return
+	}
+
+	path := strings.TrimPrefix(u.Opaque, prefix)
+	if path == "" {
+		path = "/"
+	} else if path[0] != '/' { // the bucket is only a prefix of the first path segment
+		return
+	}
+
+	u.Host = bucket + "." + u.Host
+	u.Opaque = "//" + u.Host + path
+}
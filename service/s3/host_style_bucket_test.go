@@ -0,0 +1,66 @@
+package s3_test
+
+import (
+	"testing"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+)
+
+type bucketURLCase struct {
+	bucket string
+	url    string
+}
+
+func runBucketURLCases(t *testing.T, svc *s3.S3, cases []bucketURLCase) {
+	for _, c := range cases {
+		req, _ := svc.GetObjectRequest(&s3.GetObjectInput{Bucket: aws.String(c.bucket), Key: aws.String("key")})
+		assert.NoError(t, req.Build())
+		assert.Equal(t, c.url, req.HTTPRequest.URL.String())
+	}
+}
+
+func TestHostStyleBucketBuild(t *testing.T) {
+	svc := s3.New(&aws.Config{Region: "us-east-1"})
+	runBucketURLCases(t, svc, []bucketURLCase{
+		{"abc", "https://abc.s3.amazonaws.com/key"},
+		{"a-b-c", "https://a-b-c.s3.amazonaws.com/key"},
+		{"a.b.c", "https://s3.amazonaws.com/a.b.c/key"},
+		{"ABC", "https://s3.amazonaws.com/ABC/key"},
+		{"a$b$c", "https://s3.amazonaws.com/a%24b%24c/key"},
+		{"192.168.1.1", "https://s3.amazonaws.com/192.168.1.1/key"},
+	})
+}
+
+func TestHostStyleBucketBuildNoSSL(t *testing.T) {
+	svc := s3.New(&aws.Config{Region: "us-east-1", DisableSSL: true})
+	runBucketURLCases(t, svc, []bucketURLCase{
+		{"abc", "http://abc.s3.amazonaws.com/key"},
+		{"a.b.c", "http://a.b.c.s3.amazonaws.com/key"},
+		{"a..bc", "http://s3.amazonaws.com/a..bc/key"},
+	})
+}
+
+func TestPathStyleBucketBuild(t *testing.T) {
+	svc := s3.New(&aws.Config{Region: "us-east-1", S3ForcePathStyle: true})
+	runBucketURLCases(t, svc, []bucketURLCase{
+		{"abc", "https://s3.amazonaws.com/abc/key"},
+		{"a-b-c", "https://s3.amazonaws.com/a-b-c/key"},
+		{"a.b.c", "https://s3.amazonaws.com/a.b.c/key"},
+	})
+}
+
+func TestHostStyleBucketWithoutKey(t *testing.T) {
+	svc := s3.New(&aws.Config{Region: "us-east-1"})
+	req, _ := svc.ListObjectsRequest(&s3.ListObjectsInput{Bucket: aws.String("abc")})
+	assert.NoError(t, req.Build())
+	assert.Equal(t, "https://abc.s3.amazonaws.com/", req.HTTPRequest.URL.String())
+}
+
+func TestHostStyleBucketNoBucketParam(t *testing.T) {
+	svc := s3.New(&aws.Config{Region: "us-east-1"})
+	req, _ := svc.ListBucketsRequest(&s3.ListBucketsInput{})
+	assert.NoError(t, req.Build())
+	assert.Equal(t, "https://s3.amazonaws.com/", req.HTTPRequest.URL.String())
+}
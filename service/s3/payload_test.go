@@ -0,0 +1,36 @@
+package s3_test
+
+import (
+	"testing"
+
+	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetBucketPolicyPayload(t *testing.T) {
+	svc := s3.New(&aws.Config{Region: "us-east-1", Credentials: aws.Creds("AKID", "SECRET", "")})
+	svc.Handlers.Send.SwapNamed(aws.StubSendHandler(
+		aws.StubResponse{StatusCode: 200, Body: `{"Statement":[]}`},
+	))
+
+	out, err := svc.GetBucketPolicy(&s3.GetBucketPolicyInput{Bucket: aws.String("bucket")})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"Statement":[]}`, *out.Policy)
+}
+
+func TestGetBucketPolicyErrorResponse(t *testing.T) {
+	svc := s3.New(&aws.Config{Region: "us-east-1", Credentials: aws.Creds("AKID", "SECRET", "")})
+	svc.Handlers.Send.SwapNamed(aws.StubSendHandler(
+		aws.StubResponse{StatusCode: 404, Body: `<Error><Code>NoSuchBucketPolicy</Code><Message>The bucket policy does not exist</Message><RequestId>request-id</RequestId></Error>`},
+	))
+
+	out, err := svc.GetBucketPolicy(&s3.GetBucketPolicyInput{Bucket: aws.String("bucket")})
+	assert.Error(t, err)
+	assert.Nil(t, out.Policy)
+	if e := aws.Error(err); assert.NotNil(t, e) {
+		assert.Equal(t, 404, e.StatusCode)
+		assert.Equal(t, "NoSuchBucketPolicy", e.Code)
+		assert.Equal(t, "The bucket policy does not exist", e.Message)
+	}
+}
@@ -21,12 +21,16 @@ func New(config *aws.Config) *S3 {
 		Config:      aws.DefaultConfig.Merge(config),
 		ServiceName: "s3",
 		APIVersion:  "2006-03-01",
+
+		// S3 does not accept chunked request bodies
+		ContentLengthRequired: true,
 	}
 	service.Initialize()
 
 	// Handlers
-	service.Handlers.Sign.PushBack(v4.Sign)
+	service.Handlers.Sign.PushBackNamed(v4.SignRequestHandler)
 	service.Handlers.Build.PushBack(restxml.Build)
+	service.Handlers.Build.PushBack(updateHostWithBucket)
 	service.Handlers.Unmarshal.PushBack(restxml.Unmarshal)
 	service.Handlers.UnmarshalMeta.PushBack(restxml.UnmarshalMeta)
 
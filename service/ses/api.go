@@ -4,9 +4,11 @@
 package ses
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // DeleteIdentityRequest generates a request for the DeleteIdentity operation.
@@ -238,6 +240,11 @@ func (c *SES) ListIdentitiesRequest(input *ListIdentitiesInput) (req *aws.Reques
 			Name:       "ListIdentities",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextToken"},
+				OutputTokens: []string{"NextToken"},
+				LimitToken:   "MaxItems",
+			},
 		}
 	}
 
@@ -258,6 +265,16 @@ func (c *SES) ListIdentities(input *ListIdentitiesInput) (output *ListIdentities
 	return
 }
 
+// ListIdentitiesPages iterates over the pages of a ListIdentities operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *SES) ListIdentitiesPages(input *ListIdentitiesInput, fn func(p *ListIdentitiesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListIdentitiesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListIdentitiesOutput), lastPage)
+	})
+}
+
 var opListIdentities *aws.Operation
 
 // ListVerifiedEmailAddressesRequest generates a request for the ListVerifiedEmailAddresses operation.
@@ -641,6 +658,11 @@ type metadataBody struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Body.
+func (s Body) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents textual data, plus an optional character set specification.
 //
 // By default, the text must be 7-bit ASCII, due to the constraints of the
@@ -660,6 +682,11 @@ type metadataContent struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Content.
+func (s Content) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents a request instructing the service to delete an identity from the
 // list of identities for the AWS Account.
 type DeleteIdentityInput struct {
@@ -673,6 +700,11 @@ type metadataDeleteIdentityInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteIdentityInput.
+func (s DeleteIdentityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // An empty element. Receiving this element indicates that the request completed
 // successfully.
 type DeleteIdentityOutput struct {
@@ -683,6 +715,11 @@ type metadataDeleteIdentityOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteIdentityOutput.
+func (s DeleteIdentityOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents a request instructing the service to delete an address from the
 // list of verified email addresses.
 type DeleteVerifiedEmailAddressInput struct {
@@ -696,6 +733,11 @@ type metadataDeleteVerifiedEmailAddressInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteVerifiedEmailAddressInput.
+func (s DeleteVerifiedEmailAddressInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteVerifiedEmailAddressOutput struct {
 	metadataDeleteVerifiedEmailAddressOutput `json:"-", xml:"-"`
 }
@@ -704,6 +746,11 @@ type metadataDeleteVerifiedEmailAddressOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteVerifiedEmailAddressOutput.
+func (s DeleteVerifiedEmailAddressOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the destination of the message, consisting of To:, CC:, and BCC:
 // fields.
 //
@@ -728,6 +775,11 @@ type metadataDestination struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Destination.
+func (s Destination) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Given a list of verified identities, describes their DKIM attributes. The
 // DKIM attributes of an email address identity includes whether DKIM signing
 // is individually enabled or disabled for that address. The DKIM attributes
@@ -746,6 +798,11 @@ type metadataGetIdentityDKIMAttributesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetIdentityDKIMAttributesInput.
+func (s GetIdentityDKIMAttributesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents a list of all the DKIM attributes for the specified identity.
 type GetIdentityDKIMAttributesOutput struct {
 	// The DKIM attributes for an email address or a domain.
@@ -758,6 +815,11 @@ type metadataGetIdentityDKIMAttributesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetIdentityDKIMAttributesOutput.
+func (s GetIdentityDKIMAttributesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetIdentityNotificationAttributesInput struct {
 	// A list of one or more identities.
 	Identities []*string `type:"list" required:"true"`
@@ -769,6 +831,11 @@ type metadataGetIdentityNotificationAttributesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetIdentityNotificationAttributesInput.
+func (s GetIdentityNotificationAttributesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes whether an identity has Amazon Simple Notification Service (Amazon
 // SNS) topics set for bounce, complaint, and/or delivery notifications, and
 // specifies whether feedback forwarding is enabled for bounce and complaint
@@ -784,6 +851,11 @@ type metadataGetIdentityNotificationAttributesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetIdentityNotificationAttributesOutput.
+func (s GetIdentityNotificationAttributesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents a request instructing the service to provide the verification
 // attributes for a list of identities.
 type GetIdentityVerificationAttributesInput struct {
@@ -797,6 +869,11 @@ type metadataGetIdentityVerificationAttributesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetIdentityVerificationAttributesInput.
+func (s GetIdentityVerificationAttributesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the verification attributes for a list of identities.
 type GetIdentityVerificationAttributesOutput struct {
 	// A map of Identities to IdentityVerificationAttributes objects.
@@ -809,6 +886,11 @@ type metadataGetIdentityVerificationAttributesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetIdentityVerificationAttributesOutput.
+func (s GetIdentityVerificationAttributesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetSendQuotaInput struct {
 	metadataGetSendQuotaInput `json:"-", xml:"-"`
 }
@@ -817,6 +899,11 @@ type metadataGetSendQuotaInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetSendQuotaInput.
+func (s GetSendQuotaInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the user's current activity limits returned from a successful
 // GetSendQuota request.
 type GetSendQuotaOutput struct {
@@ -836,6 +923,11 @@ type metadataGetSendQuotaOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetSendQuotaOutput.
+func (s GetSendQuotaOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetSendStatisticsInput struct {
 	metadataGetSendStatisticsInput `json:"-", xml:"-"`
 }
@@ -844,6 +936,11 @@ type metadataGetSendStatisticsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetSendStatisticsInput.
+func (s GetSendStatisticsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents a list of SendDataPoint items returned from a successful GetSendStatistics
 // request. This list contains aggregated data from the previous two weeks of
 // sending activity.
@@ -858,6 +955,11 @@ type metadataGetSendStatisticsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetSendStatisticsOutput.
+func (s GetSendStatisticsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the DKIM attributes of a verified email address or a domain.
 type IdentityDKIMAttributes struct {
 	// True if DKIM signing is enabled for email sent from the identity; false otherwise.
@@ -878,7 +980,7 @@ type IdentityDKIMAttributes struct {
 	// Describes whether Amazon SES has successfully verified the DKIM DNS records
 	// (tokens) published in the domain name's DNS. (This only applies to domain
 	// identities, not email address identities.)
-	DKIMVerificationStatus *string `locationName:"DkimVerificationStatus" type:"string" required:"true"`
+	DKIMVerificationStatus *string `locationName:"DkimVerificationStatus" type:"string" enum:"Pending,Success,Failed,TemporaryFailure,NotStarted" required:"true"`
 
 	metadataIdentityDKIMAttributes `json:"-", xml:"-"`
 }
@@ -887,6 +989,11 @@ type metadataIdentityDKIMAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the IdentityDKIMAttributes.
+func (s IdentityDKIMAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the notification attributes of an identity, including whether
 // an identity has Amazon Simple Notification Service (Amazon SNS) topics set
 // for bounce, complaint, and/or delivery notifications, and whether feedback
@@ -917,11 +1024,50 @@ type metadataIdentityNotificationAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the IdentityNotificationAttributes.
+func (s IdentityNotificationAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// IdentityType is an enum of the values of IdentityType members.
+type IdentityType string
+
+// The values of IdentityType.
+const (
+	IdentityTypeEmailAddress IdentityType = "EmailAddress"
+	IdentityTypeDomain       IdentityType = "Domain"
+)
+
+// IdentityTypeValues returns the values of IdentityType known to this
+// version of the SDK. The service may accept values added since.
+func IdentityTypeValues() []IdentityType {
+	return []IdentityType{
+		IdentityType("EmailAddress"),
+		IdentityType("Domain"),
+	}
+}
+
+// String returns the IdentityType as the string of a member.
+func (v IdentityType) String() string {
+	return string(v)
+}
+
+// ParseIdentityType returns the IdentityType of str, failing if it is not
+// one of the IdentityTypeValues.
+func ParseIdentityType(str string) (IdentityType, error) {
+	for _, v := range IdentityTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid IdentityType value %q", str)
+}
+
 // Represents the verification attributes of a single identity.
 type IdentityVerificationAttributes struct {
 	// The verification status of the identity: "Pending", "Success", "Failed",
 	// or "TemporaryFailure".
-	VerificationStatus *string `type:"string" required:"true"`
+	VerificationStatus *string `type:"string" enum:"Pending,Success,Failed,TemporaryFailure,NotStarted" required:"true"`
 
 	// The verification token for a domain identity. Null for email address identities.
 	VerificationToken *string `type:"string"`
@@ -933,12 +1079,17 @@ type metadataIdentityVerificationAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the IdentityVerificationAttributes.
+func (s IdentityVerificationAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents a request instructing the service to list all identities for the
 // AWS Account.
 type ListIdentitiesInput struct {
 	// The type of the identities to list. Possible values are "EmailAddress" and
 	// "Domain". If this parameter is omitted, then all identities will be listed.
-	IdentityType *string `type:"string"`
+	IdentityType *string `type:"string" enum:"EmailAddress,Domain"`
 
 	// The maximum number of identities per page. Possible values are 1-100 inclusive.
 	MaxItems *int64 `type:"integer"`
@@ -953,6 +1104,11 @@ type metadataListIdentitiesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListIdentitiesInput.
+func (s ListIdentitiesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents a list of all verified identities for the AWS Account.
 type ListIdentitiesOutput struct {
 	// A list of identities.
@@ -968,6 +1124,11 @@ type metadataListIdentitiesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListIdentitiesOutput.
+func (s ListIdentitiesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListVerifiedEmailAddressesInput struct {
 	metadataListVerifiedEmailAddressesInput `json:"-", xml:"-"`
 }
@@ -976,6 +1137,11 @@ type metadataListVerifiedEmailAddressesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListVerifiedEmailAddressesInput.
+func (s ListVerifiedEmailAddressesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents a list of all the email addresses verified for the current user.
 type ListVerifiedEmailAddressesOutput struct {
 	// A list of email addresses that have been verified.
@@ -988,6 +1154,11 @@ type metadataListVerifiedEmailAddressesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListVerifiedEmailAddressesOutput.
+func (s ListVerifiedEmailAddressesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the message to be sent, composed of a subject and a body.
 type Message struct {
 	// The message body.
@@ -1004,6 +1175,47 @@ type metadataMessage struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Message.
+func (s Message) String() string {
+	return awsutil.StringValue(s)
+}
+
+// NotificationType is an enum of the values of NotificationType members.
+type NotificationType string
+
+// The values of NotificationType.
+const (
+	NotificationTypeBounce    NotificationType = "Bounce"
+	NotificationTypeComplaint NotificationType = "Complaint"
+	NotificationTypeDelivery  NotificationType = "Delivery"
+)
+
+// NotificationTypeValues returns the values of NotificationType known to this
+// version of the SDK. The service may accept values added since.
+func NotificationTypeValues() []NotificationType {
+	return []NotificationType{
+		NotificationType("Bounce"),
+		NotificationType("Complaint"),
+		NotificationType("Delivery"),
+	}
+}
+
+// String returns the NotificationType as the string of a member.
+func (v NotificationType) String() string {
+	return string(v)
+}
+
+// ParseNotificationType returns the NotificationType of str, failing if it is not
+// one of the NotificationTypeValues.
+func ParseNotificationType(str string) (NotificationType, error) {
+	for _, v := range NotificationTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid NotificationType value %q", str)
+}
+
 // Represents the raw data of the message.
 type RawMessage struct {
 	// The raw data of the message. The client must ensure that the message format
@@ -1022,6 +1234,11 @@ type metadataRawMessage struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RawMessage.
+func (s RawMessage) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents sending statistics data. Each SendDataPoint contains statistics
 // for a 15-minute period of sending activity.
 type SendDataPoint struct {
@@ -1047,6 +1264,11 @@ type metadataSendDataPoint struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SendDataPoint.
+func (s SendDataPoint) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents a request instructing the service to send a single email message.
 //
 // This datatype can be used in application code to compose a message consisting
@@ -1085,6 +1307,11 @@ type metadataSendEmailInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SendEmailInput.
+func (s SendEmailInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents a unique message ID returned from a successful SendEmail request.
 type SendEmailOutput struct {
 	// The unique message identifier returned from the SendEmail action.
@@ -1097,6 +1324,11 @@ type metadataSendEmailOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SendEmailOutput.
+func (s SendEmailOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents a request instructing the service to send a raw email message.
 //
 // This datatype can be used in application code to compose a message consisting
@@ -1132,6 +1364,11 @@ type metadataSendRawEmailInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SendRawEmailInput.
+func (s SendRawEmailInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents a unique message ID returned from a successful SendRawEmail request.
 type SendRawEmailOutput struct {
 	// The unique message identifier returned from the SendRawEmail action.
@@ -1144,6 +1381,11 @@ type metadataSendRawEmailOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SendRawEmailOutput.
+func (s SendRawEmailOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents a request instructing the service to enable or disable DKIM signing
 // for an identity.
 type SetIdentityDKIMEnabledInput struct {
@@ -1161,6 +1403,11 @@ type metadataSetIdentityDKIMEnabledInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetIdentityDKIMEnabledInput.
+func (s SetIdentityDKIMEnabledInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // An empty element. Receiving this element indicates that the request completed
 // successfully.
 type SetIdentityDKIMEnabledOutput struct {
@@ -1171,6 +1418,11 @@ type metadataSetIdentityDKIMEnabledOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetIdentityDKIMEnabledOutput.
+func (s SetIdentityDKIMEnabledOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SetIdentityFeedbackForwardingEnabledInput struct {
 	// Sets whether Amazon SES will forward bounce and complaint notifications as
 	// email. true specifies that Amazon SES will forward bounce and complaint notifications
@@ -1191,6 +1443,11 @@ type metadataSetIdentityFeedbackForwardingEnabledInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetIdentityFeedbackForwardingEnabledInput.
+func (s SetIdentityFeedbackForwardingEnabledInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // An empty element. Receiving this element indicates that the request completed
 // successfully.
 type SetIdentityFeedbackForwardingEnabledOutput struct {
@@ -1201,6 +1458,11 @@ type metadataSetIdentityFeedbackForwardingEnabledOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetIdentityFeedbackForwardingEnabledOutput.
+func (s SetIdentityFeedbackForwardingEnabledOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents a request to set or clear an identity's notification topic.
 type SetIdentityNotificationTopicInput struct {
 	// The identity for which the Amazon SNS topic will be set. Examples: user@example.com,
@@ -1209,7 +1471,7 @@ type SetIdentityNotificationTopicInput struct {
 
 	// The type of notifications that will be published to the specified Amazon
 	// SNS topic.
-	NotificationType *string `type:"string" required:"true"`
+	NotificationType *string `type:"string" enum:"Bounce,Complaint,Delivery" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the Amazon SNS topic. If the parameter
 	// is omitted from the request or a null value is passed, SnsTopic is cleared
@@ -1223,6 +1485,11 @@ type metadataSetIdentityNotificationTopicInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetIdentityNotificationTopicInput.
+func (s SetIdentityNotificationTopicInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // An empty element. Receiving this element indicates that the request completed
 // successfully.
 type SetIdentityNotificationTopicOutput struct {
@@ -1233,6 +1500,51 @@ type metadataSetIdentityNotificationTopicOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetIdentityNotificationTopicOutput.
+func (s SetIdentityNotificationTopicOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// VerificationStatus is an enum of the values of VerificationStatus members.
+type VerificationStatus string
+
+// The values of VerificationStatus.
+const (
+	VerificationStatusPending          VerificationStatus = "Pending"
+	VerificationStatusSuccess          VerificationStatus = "Success"
+	VerificationStatusFailed           VerificationStatus = "Failed"
+	VerificationStatusTemporaryFailure VerificationStatus = "TemporaryFailure"
+	VerificationStatusNotStarted       VerificationStatus = "NotStarted"
+)
+
+// VerificationStatusValues returns the values of VerificationStatus known to this
+// version of the SDK. The service may accept values added since.
+func VerificationStatusValues() []VerificationStatus {
+	return []VerificationStatus{
+		VerificationStatus("Pending"),
+		VerificationStatus("Success"),
+		VerificationStatus("Failed"),
+		VerificationStatus("TemporaryFailure"),
+		VerificationStatus("NotStarted"),
+	}
+}
+
+// String returns the VerificationStatus as the string of a member.
+func (v VerificationStatus) String() string {
+	return string(v)
+}
+
+// ParseVerificationStatus returns the VerificationStatus of str, failing if it is not
+// one of the VerificationStatusValues.
+func ParseVerificationStatus(str string) (VerificationStatus, error) {
+	for _, v := range VerificationStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VerificationStatus value %q", str)
+}
+
 // Represents a request instructing the service to begin DKIM verification for
 // a domain.
 type VerifyDomainDKIMInput struct {
@@ -1246,6 +1558,11 @@ type metadataVerifyDomainDKIMInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the VerifyDomainDKIMInput.
+func (s VerifyDomainDKIMInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents the DNS records that must be published in the domain name's DNS
 // to complete DKIM setup.
 type VerifyDomainDKIMOutput struct {
@@ -1269,6 +1586,11 @@ type metadataVerifyDomainDKIMOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the VerifyDomainDKIMOutput.
+func (s VerifyDomainDKIMOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents a request instructing the service to begin domain verification.
 type VerifyDomainIdentityInput struct {
 	// The domain to be verified.
@@ -1281,6 +1603,11 @@ type metadataVerifyDomainIdentityInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the VerifyDomainIdentityInput.
+func (s VerifyDomainIdentityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents a token used for domain ownership verification.
 type VerifyDomainIdentityOutput struct {
 	// A TXT record that must be placed in the DNS settings for the domain, in order
@@ -1294,6 +1621,11 @@ type metadataVerifyDomainIdentityOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the VerifyDomainIdentityOutput.
+func (s VerifyDomainIdentityOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents a request instructing the service to begin email address verification.
 type VerifyEmailAddressInput struct {
 	// The email address to be verified.
@@ -1306,6 +1638,11 @@ type metadataVerifyEmailAddressInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the VerifyEmailAddressInput.
+func (s VerifyEmailAddressInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type VerifyEmailAddressOutput struct {
 	metadataVerifyEmailAddressOutput `json:"-", xml:"-"`
 }
@@ -1314,6 +1651,11 @@ type metadataVerifyEmailAddressOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the VerifyEmailAddressOutput.
+func (s VerifyEmailAddressOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents a request instructing the service to begin email address verification.
 type VerifyEmailIdentityInput struct {
 	// The email address to be verified.
@@ -1326,6 +1668,11 @@ type metadataVerifyEmailIdentityInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the VerifyEmailIdentityInput.
+func (s VerifyEmailIdentityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // An empty element. Receiving this element indicates that the request completed
 // successfully.
 type VerifyEmailIdentityOutput struct {
@@ -1334,4 +1681,9 @@ type VerifyEmailIdentityOutput struct {
 
 type metadataVerifyEmailIdentityOutput struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the VerifyEmailIdentityOutput.
+func (s VerifyEmailIdentityOutput) String() string {
+	return awsutil.StringValue(s)
 }
\ No newline at end of file
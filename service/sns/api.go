@@ -5,6 +5,7 @@ package sns
 
 import (
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // AddPermissionRequest generates a request for the AddPermission operation.
@@ -371,6 +372,10 @@ func (c *SNS) ListEndpointsByPlatformApplicationRequest(input *ListEndpointsByPl
 			Name:       "ListEndpointsByPlatformApplication",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextToken"},
+				OutputTokens: []string{"NextToken"},
+			},
 		}
 	}
 
@@ -395,6 +400,16 @@ func (c *SNS) ListEndpointsByPlatformApplication(input *ListEndpointsByPlatformA
 	return
 }
 
+// ListEndpointsByPlatformApplicationPages iterates over the pages of a ListEndpointsByPlatformApplication operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *SNS) ListEndpointsByPlatformApplicationPages(input *ListEndpointsByPlatformApplicationInput, fn func(p *ListEndpointsByPlatformApplicationOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListEndpointsByPlatformApplicationRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListEndpointsByPlatformApplicationOutput), lastPage)
+	})
+}
+
 var opListEndpointsByPlatformApplication *aws.Operation
 
 // ListPlatformApplicationsRequest generates a request for the ListPlatformApplications operation.
@@ -404,6 +419,10 @@ func (c *SNS) ListPlatformApplicationsRequest(input *ListPlatformApplicationsInp
 			Name:       "ListPlatformApplications",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextToken"},
+				OutputTokens: []string{"NextToken"},
+			},
 		}
 	}
 
@@ -428,6 +447,16 @@ func (c *SNS) ListPlatformApplications(input *ListPlatformApplicationsInput) (ou
 	return
 }
 
+// ListPlatformApplicationsPages iterates over the pages of a ListPlatformApplications operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *SNS) ListPlatformApplicationsPages(input *ListPlatformApplicationsInput, fn func(p *ListPlatformApplicationsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListPlatformApplicationsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListPlatformApplicationsOutput), lastPage)
+	})
+}
+
 var opListPlatformApplications *aws.Operation
 
 // ListSubscriptionsRequest generates a request for the ListSubscriptions operation.
@@ -437,6 +466,10 @@ func (c *SNS) ListSubscriptionsRequest(input *ListSubscriptionsInput) (req *aws.
 			Name:       "ListSubscriptions",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextToken"},
+				OutputTokens: []string{"NextToken"},
+			},
 		}
 	}
 
@@ -457,6 +490,16 @@ func (c *SNS) ListSubscriptions(input *ListSubscriptionsInput) (output *ListSubs
 	return
 }
 
+// ListSubscriptionsPages iterates over the pages of a ListSubscriptions operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *SNS) ListSubscriptionsPages(input *ListSubscriptionsInput, fn func(p *ListSubscriptionsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListSubscriptionsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListSubscriptionsOutput), lastPage)
+	})
+}
+
 var opListSubscriptions *aws.Operation
 
 // ListSubscriptionsByTopicRequest generates a request for the ListSubscriptionsByTopic operation.
@@ -466,6 +509,10 @@ func (c *SNS) ListSubscriptionsByTopicRequest(input *ListSubscriptionsByTopicInp
 			Name:       "ListSubscriptionsByTopic",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextToken"},
+				OutputTokens: []string{"NextToken"},
+			},
 		}
 	}
 
@@ -486,6 +533,16 @@ func (c *SNS) ListSubscriptionsByTopic(input *ListSubscriptionsByTopicInput) (ou
 	return
 }
 
+// ListSubscriptionsByTopicPages iterates over the pages of a ListSubscriptionsByTopic operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *SNS) ListSubscriptionsByTopicPages(input *ListSubscriptionsByTopicInput, fn func(p *ListSubscriptionsByTopicOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListSubscriptionsByTopicRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListSubscriptionsByTopicOutput), lastPage)
+	})
+}
+
 var opListSubscriptionsByTopic *aws.Operation
 
 // ListTopicsRequest generates a request for the ListTopics operation.
@@ -495,6 +552,10 @@ func (c *SNS) ListTopicsRequest(input *ListTopicsInput) (req *aws.Request, outpu
 			Name:       "ListTopics",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextToken"},
+				OutputTokens: []string{"NextToken"},
+			},
 		}
 	}
 
@@ -514,6 +575,16 @@ func (c *SNS) ListTopics(input *ListTopicsInput) (output *ListTopicsOutput, err
 	return
 }
 
+// ListTopicsPages iterates over the pages of a ListTopics operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *SNS) ListTopicsPages(input *ListTopicsInput, fn func(p *ListTopicsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListTopicsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListTopicsOutput), lastPage)
+	})
+}
+
 var opListTopics *aws.Operation
 
 // PublishRequest generates a request for the Publish operation.
@@ -769,6 +840,11 @@ type metadataAddPermissionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddPermissionInput.
+func (s AddPermissionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AddPermissionOutput struct {
 	metadataAddPermissionOutput `json:"-", xml:"-"`
 }
@@ -777,6 +853,11 @@ type metadataAddPermissionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddPermissionOutput.
+func (s AddPermissionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input for ConfirmSubscription action.
 type ConfirmSubscriptionInput struct {
 	// Disallows unauthenticated unsubscribes of the subscription. If the value
@@ -798,6 +879,11 @@ type metadataConfirmSubscriptionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ConfirmSubscriptionInput.
+func (s ConfirmSubscriptionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Response for ConfirmSubscriptions action.
 type ConfirmSubscriptionOutput struct {
 	// The ARN of the created subscription.
@@ -810,6 +896,11 @@ type metadataConfirmSubscriptionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ConfirmSubscriptionOutput.
+func (s ConfirmSubscriptionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input for CreatePlatformApplication action.
 type CreatePlatformApplicationInput struct {
 	// For a list of attributes, see SetPlatformApplicationAttributes (http://docs.aws.amazon.com/sns/latest/api/API_SetPlatformApplicationAttributes.html)
@@ -831,6 +922,11 @@ type metadataCreatePlatformApplicationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreatePlatformApplicationInput.
+func (s CreatePlatformApplicationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Response from CreatePlatformApplication action.
 type CreatePlatformApplicationOutput struct {
 	// PlatformApplicationArn is returned.
@@ -843,6 +939,11 @@ type metadataCreatePlatformApplicationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreatePlatformApplicationOutput.
+func (s CreatePlatformApplicationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input for CreatePlatformEndpoint action.
 type CreatePlatformEndpointInput struct {
 	// For a list of attributes, see SetEndpointAttributes (http://docs.aws.amazon.com/sns/latest/api/API_SetEndpointAttributes.html).
@@ -870,6 +971,11 @@ type metadataCreatePlatformEndpointInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreatePlatformEndpointInput.
+func (s CreatePlatformEndpointInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Response from CreateEndpoint action.
 type CreatePlatformEndpointOutput struct {
 	// EndpointArn returned from CreateEndpoint action.
@@ -882,6 +988,11 @@ type metadataCreatePlatformEndpointOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreatePlatformEndpointOutput.
+func (s CreatePlatformEndpointOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input for CreateTopic action.
 type CreateTopicInput struct {
 	// The name of the topic you want to create.
@@ -898,6 +1009,11 @@ type metadataCreateTopicInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateTopicInput.
+func (s CreateTopicInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Response from CreateTopic action.
 type CreateTopicOutput struct {
 	// The Amazon Resource Name (ARN) assigned to the created topic.
@@ -910,6 +1026,11 @@ type metadataCreateTopicOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateTopicOutput.
+func (s CreateTopicOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input for DeleteEndpoint action.
 type DeleteEndpointInput struct {
 	// EndpointArn of endpoint to delete.
@@ -922,6 +1043,11 @@ type metadataDeleteEndpointInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteEndpointInput.
+func (s DeleteEndpointInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteEndpointOutput struct {
 	metadataDeleteEndpointOutput `json:"-", xml:"-"`
 }
@@ -930,6 +1056,11 @@ type metadataDeleteEndpointOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteEndpointOutput.
+func (s DeleteEndpointOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input for DeletePlatformApplication action.
 type DeletePlatformApplicationInput struct {
 	// PlatformApplicationArn of platform application object to delete.
@@ -942,6 +1073,11 @@ type metadataDeletePlatformApplicationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeletePlatformApplicationInput.
+func (s DeletePlatformApplicationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeletePlatformApplicationOutput struct {
 	metadataDeletePlatformApplicationOutput `json:"-", xml:"-"`
 }
@@ -950,6 +1086,11 @@ type metadataDeletePlatformApplicationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeletePlatformApplicationOutput.
+func (s DeletePlatformApplicationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteTopicInput struct {
 	// The ARN of the topic you want to delete.
 	TopicARN *string `locationName:"TopicArn" type:"string" required:"true"`
@@ -961,6 +1102,11 @@ type metadataDeleteTopicInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteTopicInput.
+func (s DeleteTopicInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteTopicOutput struct {
 	metadataDeleteTopicOutput `json:"-", xml:"-"`
 }
@@ -969,6 +1115,11 @@ type metadataDeleteTopicOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteTopicOutput.
+func (s DeleteTopicOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Endpoint for mobile app and device.
 type Endpoint struct {
 	// Attributes for endpoint.
@@ -984,6 +1135,11 @@ type metadataEndpoint struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Endpoint.
+func (s Endpoint) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input for GetEndpointAttributes action.
 type GetEndpointAttributesInput struct {
 	// EndpointArn for GetEndpointAttributes input.
@@ -996,6 +1152,11 @@ type metadataGetEndpointAttributesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetEndpointAttributesInput.
+func (s GetEndpointAttributesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Response from GetEndpointAttributes of the EndpointArn.
 type GetEndpointAttributesOutput struct {
 	// Attributes include the following:
@@ -1018,6 +1179,11 @@ type metadataGetEndpointAttributesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetEndpointAttributesOutput.
+func (s GetEndpointAttributesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input for GetPlatformApplicationAttributes action.
 type GetPlatformApplicationAttributesInput struct {
 	// PlatformApplicationArn for GetPlatformApplicationAttributesInput.
@@ -1030,6 +1196,11 @@ type metadataGetPlatformApplicationAttributesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetPlatformApplicationAttributesInput.
+func (s GetPlatformApplicationAttributesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Response for GetPlatformApplicationAttributes action.
 type GetPlatformApplicationAttributesOutput struct {
 	// Attributes include the following:
@@ -1050,6 +1221,11 @@ type metadataGetPlatformApplicationAttributesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetPlatformApplicationAttributesOutput.
+func (s GetPlatformApplicationAttributesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input for GetSubscriptionAttributes.
 type GetSubscriptionAttributesInput struct {
 	// The ARN of the subscription whose properties you want to get.
@@ -1062,6 +1238,11 @@ type metadataGetSubscriptionAttributesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetSubscriptionAttributesInput.
+func (s GetSubscriptionAttributesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Response for GetSubscriptionAttributes action.
 type GetSubscriptionAttributesOutput struct {
 	// A map of the subscription's attributes. Attributes in this map include the
@@ -1083,6 +1264,11 @@ type metadataGetSubscriptionAttributesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetSubscriptionAttributesOutput.
+func (s GetSubscriptionAttributesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input for GetTopicAttributes action.
 type GetTopicAttributesInput struct {
 	// The ARN of the topic whose properties you want to get.
@@ -1095,6 +1281,11 @@ type metadataGetTopicAttributesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetTopicAttributesInput.
+func (s GetTopicAttributesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Response for GetTopicAttributes action.
 type GetTopicAttributesOutput struct {
 	// A map of the topic's attributes. Attributes in this map include the following:
@@ -1118,6 +1309,11 @@ type metadataGetTopicAttributesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetTopicAttributesOutput.
+func (s GetTopicAttributesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input for ListEndpointsByPlatformApplication action.
 type ListEndpointsByPlatformApplicationInput struct {
 	// NextToken string is used when calling ListEndpointsByPlatformApplication
@@ -1135,6 +1331,11 @@ type metadataListEndpointsByPlatformApplicationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListEndpointsByPlatformApplicationInput.
+func (s ListEndpointsByPlatformApplicationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Response for ListEndpointsByPlatformApplication action.
 type ListEndpointsByPlatformApplicationOutput struct {
 	// Endpoints returned for ListEndpointsByPlatformApplication action.
@@ -1151,6 +1352,11 @@ type metadataListEndpointsByPlatformApplicationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListEndpointsByPlatformApplicationOutput.
+func (s ListEndpointsByPlatformApplicationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input for ListPlatformApplications action.
 type ListPlatformApplicationsInput struct {
 	// NextToken string is used when calling ListPlatformApplications action to
@@ -1164,6 +1370,11 @@ type metadataListPlatformApplicationsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListPlatformApplicationsInput.
+func (s ListPlatformApplicationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Response for ListPlatformApplications action.
 type ListPlatformApplicationsOutput struct {
 	// NextToken string is returned when calling ListPlatformApplications action
@@ -1180,6 +1391,11 @@ type metadataListPlatformApplicationsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListPlatformApplicationsOutput.
+func (s ListPlatformApplicationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input for ListSubscriptionsByTopic action.
 type ListSubscriptionsByTopicInput struct {
 	// Token returned by the previous ListSubscriptionsByTopic request.
@@ -1195,6 +1411,11 @@ type metadataListSubscriptionsByTopicInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListSubscriptionsByTopicInput.
+func (s ListSubscriptionsByTopicInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Response for ListSubscriptionsByTopic action.
 type ListSubscriptionsByTopicOutput struct {
 	// Token to pass along to the next ListSubscriptionsByTopic request. This element
@@ -1211,6 +1432,11 @@ type metadataListSubscriptionsByTopicOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListSubscriptionsByTopicOutput.
+func (s ListSubscriptionsByTopicOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input for ListSubscriptions action.
 type ListSubscriptionsInput struct {
 	// Token returned by the previous ListSubscriptions request.
@@ -1223,6 +1449,11 @@ type metadataListSubscriptionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListSubscriptionsInput.
+func (s ListSubscriptionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Response for ListSubscriptions action
 type ListSubscriptionsOutput struct {
 	// Token to pass along to the next ListSubscriptions request. This element is
@@ -1239,6 +1470,11 @@ type metadataListSubscriptionsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListSubscriptionsOutput.
+func (s ListSubscriptionsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListTopicsInput struct {
 	// Token returned by the previous ListTopics request.
 	NextToken *string `type:"string"`
@@ -1250,6 +1486,11 @@ type metadataListTopicsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListTopicsInput.
+func (s ListTopicsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Response for ListTopics action.
 type ListTopicsOutput struct {
 	// Token to pass along to the next ListTopics request. This element is returned
@@ -1266,6 +1507,11 @@ type metadataListTopicsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListTopicsOutput.
+func (s ListTopicsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The user-specified message attribute value. For string data types, the value
 // attribute has the same restrictions on the content as the message body. For
 // more information, see Publish (http://docs.aws.amazon.com/sns/latest/api/API_Publish.html).
@@ -1295,6 +1541,11 @@ type metadataMessageAttributeValue struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the MessageAttributeValue.
+func (s MessageAttributeValue) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Platform application object.
 type PlatformApplication struct {
 	// Attributes for platform application object.
@@ -1310,6 +1561,11 @@ type metadataPlatformApplication struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PlatformApplication.
+func (s PlatformApplication) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input for Publish action.
 type PublishInput struct {
 	// The message you want to send to the topic.
@@ -1381,6 +1637,11 @@ type metadataPublishInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PublishInput.
+func (s PublishInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Response for Publish action.
 type PublishOutput struct {
 	// Unique identifier assigned to the published message.
@@ -1395,6 +1656,11 @@ type metadataPublishOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PublishOutput.
+func (s PublishOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input for RemovePermission action.
 type RemovePermissionInput struct {
 	// The unique label of the statement you want to remove.
@@ -1410,6 +1676,11 @@ type metadataRemovePermissionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RemovePermissionInput.
+func (s RemovePermissionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RemovePermissionOutput struct {
 	metadataRemovePermissionOutput `json:"-", xml:"-"`
 }
@@ -1418,6 +1689,11 @@ type metadataRemovePermissionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RemovePermissionOutput.
+func (s RemovePermissionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input for SetEndpointAttributes action.
 type SetEndpointAttributesInput struct {
 	// A map of the endpoint attributes. Attributes in this map include the following:
@@ -1443,6 +1719,11 @@ type metadataSetEndpointAttributesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetEndpointAttributesInput.
+func (s SetEndpointAttributesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SetEndpointAttributesOutput struct {
 	metadataSetEndpointAttributesOutput `json:"-", xml:"-"`
 }
@@ -1451,6 +1732,11 @@ type metadataSetEndpointAttributesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetEndpointAttributesOutput.
+func (s SetEndpointAttributesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input for SetPlatformApplicationAttributes action.
 type SetPlatformApplicationAttributesInput struct {
 	// A map of the platform application attributes. Attributes in this map include
@@ -1480,6 +1766,11 @@ type metadataSetPlatformApplicationAttributesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetPlatformApplicationAttributesInput.
+func (s SetPlatformApplicationAttributesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SetPlatformApplicationAttributesOutput struct {
 	metadataSetPlatformApplicationAttributesOutput `json:"-", xml:"-"`
 }
@@ -1488,6 +1779,11 @@ type metadataSetPlatformApplicationAttributesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetPlatformApplicationAttributesOutput.
+func (s SetPlatformApplicationAttributesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input for SetSubscriptionAttributes action.
 type SetSubscriptionAttributesInput struct {
 	// The name of the attribute you want to set. Only a subset of the subscriptions
@@ -1509,6 +1805,11 @@ type metadataSetSubscriptionAttributesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetSubscriptionAttributesInput.
+func (s SetSubscriptionAttributesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SetSubscriptionAttributesOutput struct {
 	metadataSetSubscriptionAttributesOutput `json:"-", xml:"-"`
 }
@@ -1517,6 +1818,11 @@ type metadataSetSubscriptionAttributesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetSubscriptionAttributesOutput.
+func (s SetSubscriptionAttributesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input for SetTopicAttributes action.
 type SetTopicAttributesInput struct {
 	// The name of the attribute you want to set. Only a subset of the topic's attributes
@@ -1538,6 +1844,11 @@ type metadataSetTopicAttributesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetTopicAttributesInput.
+func (s SetTopicAttributesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SetTopicAttributesOutput struct {
 	metadataSetTopicAttributesOutput `json:"-", xml:"-"`
 }
@@ -1546,6 +1857,11 @@ type metadataSetTopicAttributesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetTopicAttributesOutput.
+func (s SetTopicAttributesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input for Subscribe action.
 type SubscribeInput struct {
 	// The endpoint that you want to receive notifications. Endpoints vary by protocol:
@@ -1579,6 +1895,11 @@ type metadataSubscribeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SubscribeInput.
+func (s SubscribeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Response for Subscribe action.
 type SubscribeOutput struct {
 	// The ARN of the subscription, if the service was able to create a subscription
@@ -1592,6 +1913,11 @@ type metadataSubscribeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SubscribeOutput.
+func (s SubscribeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A wrapper type for the attributes of an Amazon SNS subscription.
 type Subscription struct {
 	// The subscription's endpoint (format depends on the protocol).
@@ -1616,6 +1942,11 @@ type metadataSubscription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Subscription.
+func (s Subscription) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A wrapper type for the topic's Amazon Resource Name (ARN). To retrieve a
 // topic's attributes, use GetTopicAttributes.
 type Topic struct {
@@ -1629,6 +1960,11 @@ type metadataTopic struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Topic.
+func (s Topic) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Input for Unsubscribe action.
 type UnsubscribeInput struct {
 	// The ARN of the subscription to be deleted.
@@ -1641,10 +1977,20 @@ type metadataUnsubscribeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UnsubscribeInput.
+func (s UnsubscribeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UnsubscribeOutput struct {
 	metadataUnsubscribeOutput `json:"-", xml:"-"`
 }
 
 type metadataUnsubscribeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the UnsubscribeOutput.
+func (s UnsubscribeOutput) String() string {
+	return awsutil.StringValue(s)
 }
\ No newline at end of file
@@ -4,7 +4,10 @@
 package sqs
 
 import (
+	"fmt"
+
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // AddPermissionRequest generates a request for the AddPermission operation.
@@ -752,6 +755,11 @@ type metadataAddPermissionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddPermissionInput.
+func (s AddPermissionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AddPermissionOutput struct {
 	metadataAddPermissionOutput `json:"-", xml:"-"`
 }
@@ -760,6 +768,11 @@ type metadataAddPermissionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddPermissionOutput.
+func (s AddPermissionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // This is used in the responses of batch API to give a detailed description
 // of the result of an action on each entry in the request.
 type BatchResultErrorEntry struct {
@@ -782,6 +795,11 @@ type metadataBatchResultErrorEntry struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the BatchResultErrorEntry.
+func (s BatchResultErrorEntry) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ChangeMessageVisibilityBatchInput struct {
 	// A list of receipt handles of the messages for which the visibility timeout
 	// must be changed.
@@ -797,6 +815,11 @@ type metadataChangeMessageVisibilityBatchInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ChangeMessageVisibilityBatchInput.
+func (s ChangeMessageVisibilityBatchInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // For each message in the batch, the response contains a ChangeMessageVisibilityBatchResultEntry
 // tag if the message succeeds or a BatchResultErrorEntry tag if the message
 // fails.
@@ -814,6 +837,11 @@ type metadataChangeMessageVisibilityBatchOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ChangeMessageVisibilityBatchOutput.
+func (s ChangeMessageVisibilityBatchOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Encloses a receipt handle and an entry id for each message in ChangeMessageVisibilityBatch.
 //
 //  All of the following parameters are list parameters that must be prefixed
@@ -845,6 +873,11 @@ type metadataChangeMessageVisibilityBatchRequestEntry struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ChangeMessageVisibilityBatchRequestEntry.
+func (s ChangeMessageVisibilityBatchRequestEntry) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Encloses the id of an entry in ChangeMessageVisibilityBatch.
 type ChangeMessageVisibilityBatchResultEntry struct {
 	// Represents a message whose visibility timeout has been changed successfully.
@@ -857,6 +890,11 @@ type metadataChangeMessageVisibilityBatchResultEntry struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ChangeMessageVisibilityBatchResultEntry.
+func (s ChangeMessageVisibilityBatchResultEntry) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ChangeMessageVisibilityInput struct {
 	// The URL of the Amazon SQS queue to take action on.
 	QueueURL *string `locationName:"QueueUrl" type:"string" required:"true"`
@@ -876,6 +914,11 @@ type metadataChangeMessageVisibilityInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ChangeMessageVisibilityInput.
+func (s ChangeMessageVisibilityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ChangeMessageVisibilityOutput struct {
 	metadataChangeMessageVisibilityOutput `json:"-", xml:"-"`
 }
@@ -884,6 +927,11 @@ type metadataChangeMessageVisibilityOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ChangeMessageVisibilityOutput.
+func (s ChangeMessageVisibilityOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateQueueInput struct {
 	// A map of attributes with their corresponding values.
 	//
@@ -919,6 +967,11 @@ type metadataCreateQueueInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateQueueInput.
+func (s CreateQueueInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Returns the QueueUrl element of the created queue.
 type CreateQueueOutput struct {
 	// The URL for the created Amazon SQS queue.
@@ -931,6 +984,11 @@ type metadataCreateQueueOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateQueueOutput.
+func (s CreateQueueOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteMessageBatchInput struct {
 	// A list of receipt handles for the messages to be deleted.
 	Entries []*DeleteMessageBatchRequestEntry `locationNameList:"DeleteMessageBatchRequestEntry" type:"list" flattened:"true" required:"true"`
@@ -945,6 +1003,11 @@ type metadataDeleteMessageBatchInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteMessageBatchInput.
+func (s DeleteMessageBatchInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // For each message in the batch, the response contains a DeleteMessageBatchResultEntry
 // tag if the message is deleted or a BatchResultErrorEntry tag if the message
 // cannot be deleted.
@@ -962,6 +1025,11 @@ type metadataDeleteMessageBatchOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteMessageBatchOutput.
+func (s DeleteMessageBatchOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Encloses a receipt handle and an identifier for it.
 type DeleteMessageBatchRequestEntry struct {
 	// An identifier for this particular receipt handle. This is used to communicate
@@ -979,6 +1047,11 @@ type metadataDeleteMessageBatchRequestEntry struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteMessageBatchRequestEntry.
+func (s DeleteMessageBatchRequestEntry) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Encloses the id an entry in DeleteMessageBatch.
 type DeleteMessageBatchResultEntry struct {
 	// Represents a successfully deleted message.
@@ -991,6 +1064,11 @@ type metadataDeleteMessageBatchResultEntry struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteMessageBatchResultEntry.
+func (s DeleteMessageBatchResultEntry) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteMessageInput struct {
 	// The URL of the Amazon SQS queue to take action on.
 	QueueURL *string `locationName:"QueueUrl" type:"string" required:"true"`
@@ -1005,6 +1083,11 @@ type metadataDeleteMessageInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteMessageInput.
+func (s DeleteMessageInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteMessageOutput struct {
 	metadataDeleteMessageOutput `json:"-", xml:"-"`
 }
@@ -1013,6 +1096,11 @@ type metadataDeleteMessageOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteMessageOutput.
+func (s DeleteMessageOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteQueueInput struct {
 	// The URL of the Amazon SQS queue to take action on.
 	QueueURL *string `locationName:"QueueUrl" type:"string" required:"true"`
@@ -1024,6 +1112,11 @@ type metadataDeleteQueueInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteQueueInput.
+func (s DeleteQueueInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteQueueOutput struct {
 	metadataDeleteQueueOutput `json:"-", xml:"-"`
 }
@@ -1032,6 +1125,11 @@ type metadataDeleteQueueOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteQueueOutput.
+func (s DeleteQueueOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetQueueAttributesInput struct {
 	// A list of attributes to retrieve information for.
 	AttributeNames []*string `locationNameList:"AttributeName" type:"list" flattened:"true"`
@@ -1046,6 +1144,11 @@ type metadataGetQueueAttributesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetQueueAttributesInput.
+func (s GetQueueAttributesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A list of returned queue attributes.
 type GetQueueAttributesOutput struct {
 	// A map of attributes to the respective values.
@@ -1058,6 +1161,11 @@ type metadataGetQueueAttributesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetQueueAttributesOutput.
+func (s GetQueueAttributesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetQueueURLInput struct {
 	// The name of the queue whose URL must be fetched. Maximum 80 characters; alphanumeric
 	// characters, hyphens (-), and underscores (_) are allowed.
@@ -1073,6 +1181,11 @@ type metadataGetQueueURLInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetQueueURLInput.
+func (s GetQueueURLInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // For more information, see Responses (http://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/UnderstandingResponses.html)
 // in the Amazon SQS Developer Guide.
 type GetQueueURLOutput struct {
@@ -1086,6 +1199,11 @@ type metadataGetQueueURLOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetQueueURLOutput.
+func (s GetQueueURLOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListDeadLetterSourceQueuesInput struct {
 	// The queue URL of a dead letter queue.
 	QueueURL *string `locationName:"QueueUrl" type:"string" required:"true"`
@@ -1097,6 +1215,11 @@ type metadataListDeadLetterSourceQueuesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListDeadLetterSourceQueuesInput.
+func (s ListDeadLetterSourceQueuesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A list of your dead letter source queues.
 type ListDeadLetterSourceQueuesOutput struct {
 	// A list of source queue URLs that have the RedrivePolicy queue attribute configured
@@ -1110,6 +1233,11 @@ type metadataListDeadLetterSourceQueuesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListDeadLetterSourceQueuesOutput.
+func (s ListDeadLetterSourceQueuesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListQueuesInput struct {
 	// A string to use for filtering the list results. Only those queues whose name
 	// begins with the specified string are returned.
@@ -1122,6 +1250,11 @@ type metadataListQueuesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListQueuesInput.
+func (s ListQueuesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A list of your queues.
 type ListQueuesOutput struct {
 	// A list of queue URLs, up to 1000 entries.
@@ -1134,6 +1267,11 @@ type metadataListQueuesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListQueuesOutput.
+func (s ListQueuesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // An Amazon SQS message.
 type Message struct {
 	// SenderId, SentTimestamp, ApproximateReceiveCount, and/or ApproximateFirstReceiveTimestamp.
@@ -1174,6 +1312,11 @@ type metadataMessage struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Message.
+func (s Message) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The user-specified message attribute value. For string data types, the value
 // attribute has the same restrictions on the content as the message body. For
 // more information, see SendMessage (http://docs.aws.amazon.com/AWSSimpleQueueService/latest/APIReference/API_SendMessage.html).
@@ -1209,6 +1352,11 @@ type metadataMessageAttributeValue struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the MessageAttributeValue.
+func (s MessageAttributeValue) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PurgeQueueInput struct {
 	// The queue URL of the queue to delete the messages from when using the PurgeQueue
 	// API.
@@ -1221,6 +1369,11 @@ type metadataPurgeQueueInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PurgeQueueInput.
+func (s PurgeQueueInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PurgeQueueOutput struct {
 	metadataPurgeQueueOutput `json:"-", xml:"-"`
 }
@@ -1229,6 +1382,67 @@ type metadataPurgeQueueOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PurgeQueueOutput.
+func (s PurgeQueueOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// QueueAttributeName is an enum of the values of QueueAttributeName members.
+type QueueAttributeName string
+
+// The values of QueueAttributeName.
+const (
+	QueueAttributeNamePolicy                                QueueAttributeName = "Policy"
+	QueueAttributeNameVisibilityTimeout                     QueueAttributeName = "VisibilityTimeout"
+	QueueAttributeNameMaximumMessageSize                    QueueAttributeName = "MaximumMessageSize"
+	QueueAttributeNameMessageRetentionPeriod                QueueAttributeName = "MessageRetentionPeriod"
+	QueueAttributeNameApproximateNumberOfMessages           QueueAttributeName = "ApproximateNumberOfMessages"
+	QueueAttributeNameApproximateNumberOfMessagesNotVisible QueueAttributeName = "ApproximateNumberOfMessagesNotVisible"
+	QueueAttributeNameCreatedTimestamp                      QueueAttributeName = "CreatedTimestamp"
+	QueueAttributeNameLastModifiedTimestamp                 QueueAttributeName = "LastModifiedTimestamp"
+	QueueAttributeNameQueueArn                              QueueAttributeName = "QueueArn"
+	QueueAttributeNameApproximateNumberOfMessagesDelayed    QueueAttributeName = "ApproximateNumberOfMessagesDelayed"
+	QueueAttributeNameDelaySeconds                          QueueAttributeName = "DelaySeconds"
+	QueueAttributeNameReceiveMessageWaitTimeSeconds         QueueAttributeName = "ReceiveMessageWaitTimeSeconds"
+	QueueAttributeNameRedrivePolicy                         QueueAttributeName = "RedrivePolicy"
+)
+
+// QueueAttributeNameValues returns the values of QueueAttributeName known to this
+// version of the SDK. The service may accept values added since.
+func QueueAttributeNameValues() []QueueAttributeName {
+	return []QueueAttributeName{
+		QueueAttributeName("Policy"),
+		QueueAttributeName("VisibilityTimeout"),
+		QueueAttributeName("MaximumMessageSize"),
+		QueueAttributeName("MessageRetentionPeriod"),
+		QueueAttributeName("ApproximateNumberOfMessages"),
+		QueueAttributeName("ApproximateNumberOfMessagesNotVisible"),
+		QueueAttributeName("CreatedTimestamp"),
+		QueueAttributeName("LastModifiedTimestamp"),
+		QueueAttributeName("QueueArn"),
+		QueueAttributeName("ApproximateNumberOfMessagesDelayed"),
+		QueueAttributeName("DelaySeconds"),
+		QueueAttributeName("ReceiveMessageWaitTimeSeconds"),
+		QueueAttributeName("RedrivePolicy"),
+	}
+}
+
+// String returns the QueueAttributeName as the string of a member.
+func (v QueueAttributeName) String() string {
+	return string(v)
+}
+
+// ParseQueueAttributeName returns the QueueAttributeName of str, failing if it is not
+// one of the QueueAttributeNameValues.
+func ParseQueueAttributeName(str string) (QueueAttributeName, error) {
+	for _, v := range QueueAttributeNameValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid QueueAttributeName value %q", str)
+}
+
 type ReceiveMessageInput struct {
 	// A list of attributes that need to be returned along with each message.
 	//
@@ -1285,6 +1499,11 @@ type metadataReceiveMessageInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReceiveMessageInput.
+func (s ReceiveMessageInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A list of received messages.
 type ReceiveMessageOutput struct {
 	// A list of messages.
@@ -1297,6 +1516,11 @@ type metadataReceiveMessageOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ReceiveMessageOutput.
+func (s ReceiveMessageOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RemovePermissionInput struct {
 	// The identification of the permission to remove. This is the label added with
 	// the AddPermission action.
@@ -1312,6 +1536,11 @@ type metadataRemovePermissionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RemovePermissionInput.
+func (s RemovePermissionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RemovePermissionOutput struct {
 	metadataRemovePermissionOutput `json:"-", xml:"-"`
 }
@@ -1320,6 +1549,11 @@ type metadataRemovePermissionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RemovePermissionOutput.
+func (s RemovePermissionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SendMessageBatchInput struct {
 	// A list of SendMessageBatchRequestEntry items.
 	Entries []*SendMessageBatchRequestEntry `locationNameList:"SendMessageBatchRequestEntry" type:"list" flattened:"true" required:"true"`
@@ -1334,6 +1568,11 @@ type metadataSendMessageBatchInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SendMessageBatchInput.
+func (s SendMessageBatchInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // For each message in the batch, the response contains a SendMessageBatchResultEntry
 // tag if the message succeeds or a BatchResultErrorEntry tag if the message
 // fails.
@@ -1352,6 +1591,11 @@ type metadataSendMessageBatchOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SendMessageBatchOutput.
+func (s SendMessageBatchOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the details of a single Amazon SQS message along with a Id.
 type SendMessageBatchRequestEntry struct {
 	// The number of seconds for which the message has to be delayed.
@@ -1376,6 +1620,11 @@ type metadataSendMessageBatchRequestEntry struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SendMessageBatchRequestEntry.
+func (s SendMessageBatchRequestEntry) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Encloses a message ID for successfully enqueued message of a SendMessageBatch.
 type SendMessageBatchResultEntry struct {
 	// An identifier for the message in this batch.
@@ -1403,6 +1652,11 @@ type metadataSendMessageBatchResultEntry struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SendMessageBatchResultEntry.
+func (s SendMessageBatchResultEntry) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SendMessageInput struct {
 	// The number of seconds (0 to 900 - 15 minutes) to delay a specific message.
 	// Messages with a positive DelaySeconds value become available for processing
@@ -1428,6 +1682,11 @@ type metadataSendMessageInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SendMessageInput.
+func (s SendMessageInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The MD5OfMessageBody and MessageId elements.
 type SendMessageOutput struct {
 	// An MD5 digest of the non-URL-encoded message attribute string. This can be
@@ -1454,6 +1713,11 @@ type metadataSendMessageOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SendMessageOutput.
+func (s SendMessageOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SetQueueAttributesInput struct {
 	// A map of attributes to set.
 	//
@@ -1491,10 +1755,20 @@ type metadataSetQueueAttributesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SetQueueAttributesInput.
+func (s SetQueueAttributesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SetQueueAttributesOutput struct {
 	metadataSetQueueAttributesOutput `json:"-", xml:"-"`
 }
 
 type metadataSetQueueAttributesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the SetQueueAttributesOutput.
+func (s SetQueueAttributesOutput) String() string {
+	return awsutil.StringValue(s)
 }
\ No newline at end of file
@@ -4,9 +4,11 @@
 package ssm
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // CreateAssociationRequest generates a request for the CreateAssociation operation.
@@ -327,10 +329,10 @@ var opUpdateAssociationStatus *aws.Operation
 // Describes an association of a configuration document and an instance.
 type Association struct {
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"10" max:"10" pattern:"^(?=.{10}$)(i-(\\w){8})"`
 
 	// The name of the configuration document.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$"`
 
 	metadataAssociation `json:"-", xml:"-"`
 }
@@ -339,16 +341,21 @@ type metadataAssociation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Association.
+func (s Association) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes an association.
 type AssociationDescription struct {
 	// The date when the association was made.
 	Date *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"10" max:"10" pattern:"^(?=.{10}$)(i-(\\w){8})"`
 
 	// The name of the configuration document.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$"`
 
 	// The association status.
 	Status *AssociationStatus `type:"structure"`
@@ -360,13 +367,18 @@ type metadataAssociationDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AssociationDescription.
+func (s AssociationDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a filter.
 type AssociationFilter struct {
 	// The name of the filter.
-	Key *string `locationName:"key" type:"string" required:"true"`
+	Key *string `locationName:"key" type:"string" enum:"InstanceId,Name" required:"true"`
 
 	// The filter value.
-	Value *string `locationName:"value" type:"string" required:"true"`
+	Value *string `locationName:"value" type:"string" min:"1" required:"true"`
 
 	metadataAssociationFilter `json:"-", xml:"-"`
 }
@@ -375,19 +387,58 @@ type metadataAssociationFilter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AssociationFilter.
+func (s AssociationFilter) String() string {
+	return awsutil.StringValue(s)
+}
+
+// AssociationFilterKey is an enum of the values of AssociationFilterKey members.
+type AssociationFilterKey string
+
+// The values of AssociationFilterKey.
+const (
+	AssociationFilterKeyInstanceId AssociationFilterKey = "InstanceId"
+	AssociationFilterKeyName       AssociationFilterKey = "Name"
+)
+
+// AssociationFilterKeyValues returns the values of AssociationFilterKey known to this
+// version of the SDK. The service may accept values added since.
+func AssociationFilterKeyValues() []AssociationFilterKey {
+	return []AssociationFilterKey{
+		AssociationFilterKey("InstanceId"),
+		AssociationFilterKey("Name"),
+	}
+}
+
+// String returns the AssociationFilterKey as the string of a member.
+func (v AssociationFilterKey) String() string {
+	return string(v)
+}
+
+// ParseAssociationFilterKey returns the AssociationFilterKey of str, failing if it is not
+// one of the AssociationFilterKeyValues.
+func ParseAssociationFilterKey(str string) (AssociationFilterKey, error) {
+	for _, v := range AssociationFilterKeyValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid AssociationFilterKey value %q", str)
+}
+
 // Describes an association status.
 type AssociationStatus struct {
 	// A user-defined string.
-	AdditionalInfo *string `type:"string"`
+	AdditionalInfo *string `type:"string" max:"1024"`
 
 	// The date when the status changed.
 	Date *time.Time `type:"timestamp" timestampFormat:"unix" required:"true"`
 
 	// The reason for the status.
-	Message *string `type:"string" required:"true"`
+	Message *string `type:"string" max:"1024" required:"true"`
 
 	// The status.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" enum:"Pending,Success,Failed" required:"true"`
 
 	metadataAssociationStatus `json:"-", xml:"-"`
 }
@@ -396,6 +447,47 @@ type metadataAssociationStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AssociationStatus.
+func (s AssociationStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
+// AssociationStatusName is an enum of the values of AssociationStatusName members.
+type AssociationStatusName string
+
+// The values of AssociationStatusName.
+const (
+	AssociationStatusNamePending AssociationStatusName = "Pending"
+	AssociationStatusNameSuccess AssociationStatusName = "Success"
+	AssociationStatusNameFailed  AssociationStatusName = "Failed"
+)
+
+// AssociationStatusNameValues returns the values of AssociationStatusName known to this
+// version of the SDK. The service may accept values added since.
+func AssociationStatusNameValues() []AssociationStatusName {
+	return []AssociationStatusName{
+		AssociationStatusName("Pending"),
+		AssociationStatusName("Success"),
+		AssociationStatusName("Failed"),
+	}
+}
+
+// String returns the AssociationStatusName as the string of a member.
+func (v AssociationStatusName) String() string {
+	return string(v)
+}
+
+// ParseAssociationStatusName returns the AssociationStatusName of str, failing if it is not
+// one of the AssociationStatusNameValues.
+func ParseAssociationStatusName(str string) (AssociationStatusName, error) {
+	for _, v := range AssociationStatusNameValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid AssociationStatusName value %q", str)
+}
+
 type CreateAssociationBatchInput struct {
 	// One or more associations.
 	Entries []*CreateAssociationBatchRequestEntry `locationNameList:"entries" type:"list" required:"true"`
@@ -407,6 +499,11 @@ type metadataCreateAssociationBatchInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateAssociationBatchInput.
+func (s CreateAssociationBatchInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateAssociationBatchOutput struct {
 	// Information about the associations that failed.
 	Failed []*FailedCreateAssociation `locationNameList:"FailedCreateAssociationEntry" type:"list"`
@@ -421,13 +518,18 @@ type metadataCreateAssociationBatchOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateAssociationBatchOutput.
+func (s CreateAssociationBatchOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes the association of a configuration document and an instance.
 type CreateAssociationBatchRequestEntry struct {
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"10" max:"10" pattern:"^(?=.{10}$)(i-(\\w){8})"`
 
 	// The name of the configuration document.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$"`
 
 	metadataCreateAssociationBatchRequestEntry `json:"-", xml:"-"`
 }
@@ -436,12 +538,17 @@ type metadataCreateAssociationBatchRequestEntry struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateAssociationBatchRequestEntry.
+func (s CreateAssociationBatchRequestEntry) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateAssociationInput struct {
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"10" max:"10" pattern:"^(?=.{10}$)(i-(\\w){8})" required:"true"`
 
 	// The name of the configuration document.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$" required:"true"`
 
 	metadataCreateAssociationInput `json:"-", xml:"-"`
 }
@@ -450,6 +557,11 @@ type metadataCreateAssociationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateAssociationInput.
+func (s CreateAssociationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateAssociationOutput struct {
 	// Information about the association.
 	AssociationDescription *AssociationDescription `type:"structure"`
@@ -461,13 +573,18 @@ type metadataCreateAssociationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateAssociationOutput.
+func (s CreateAssociationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateDocumentInput struct {
 	// A valid JSON file. For more information about the contents of this file,
 	// see Configuration Document (http://docs.aws.amazon.com/ssm/latest/APIReference/aws-ssm-document.html).
-	Content *string `type:"string" required:"true"`
+	Content *string `type:"string" min:"1" required:"true"`
 
 	// A name for the configuration document.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$" required:"true"`
 
 	metadataCreateDocumentInput `json:"-", xml:"-"`
 }
@@ -476,6 +593,11 @@ type metadataCreateDocumentInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateDocumentInput.
+func (s CreateDocumentInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateDocumentOutput struct {
 	// Information about the configuration document.
 	DocumentDescription *DocumentDescription `type:"structure"`
@@ -487,12 +609,17 @@ type metadataCreateDocumentOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateDocumentOutput.
+func (s CreateDocumentOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteAssociationInput struct {
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"10" max:"10" pattern:"^(?=.{10}$)(i-(\\w){8})" required:"true"`
 
 	// The name of the configuration document.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$" required:"true"`
 
 	metadataDeleteAssociationInput `json:"-", xml:"-"`
 }
@@ -501,6 +628,11 @@ type metadataDeleteAssociationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteAssociationInput.
+func (s DeleteAssociationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteAssociationOutput struct {
 	metadataDeleteAssociationOutput `json:"-", xml:"-"`
 }
@@ -509,9 +641,14 @@ type metadataDeleteAssociationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteAssociationOutput.
+func (s DeleteAssociationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteDocumentInput struct {
 	// The name of the configuration document.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$" required:"true"`
 
 	metadataDeleteDocumentInput `json:"-", xml:"-"`
 }
@@ -520,6 +657,11 @@ type metadataDeleteDocumentInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteDocumentInput.
+func (s DeleteDocumentInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteDocumentOutput struct {
 	metadataDeleteDocumentOutput `json:"-", xml:"-"`
 }
@@ -528,12 +670,17 @@ type metadataDeleteDocumentOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteDocumentOutput.
+func (s DeleteDocumentOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeAssociationInput struct {
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"10" max:"10" pattern:"^(?=.{10}$)(i-(\\w){8})" required:"true"`
 
 	// The name of the configuration document.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$" required:"true"`
 
 	metadataDescribeAssociationInput `json:"-", xml:"-"`
 }
@@ -542,6 +689,11 @@ type metadataDescribeAssociationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeAssociationInput.
+func (s DescribeAssociationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeAssociationOutput struct {
 	// Information about the association.
 	AssociationDescription *AssociationDescription `type:"structure"`
@@ -553,9 +705,14 @@ type metadataDescribeAssociationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeAssociationOutput.
+func (s DescribeAssociationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeDocumentInput struct {
 	// The name of the configuration document.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$" required:"true"`
 
 	metadataDescribeDocumentInput `json:"-", xml:"-"`
 }
@@ -564,6 +721,11 @@ type metadataDescribeDocumentInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeDocumentInput.
+func (s DescribeDocumentInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeDocumentOutput struct {
 	// Information about the configuration document.
 	Document *DocumentDescription `type:"structure"`
@@ -575,19 +737,24 @@ type metadataDescribeDocumentOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeDocumentOutput.
+func (s DescribeDocumentOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a configuration document.
 type DocumentDescription struct {
 	// The date when the configuration document was created.
 	CreatedDate *time.Time `type:"timestamp" timestampFormat:"unix"`
 
 	// The name of the configuration document.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$"`
 
 	// The SHA1 hash of the document, which you can use for verification purposes.
 	SHA1 *string `locationName:"Sha1" type:"string"`
 
 	// The status of the configuration document.
-	Status *string `type:"string"`
+	Status *string `type:"string" enum:"Creating,Active,Deleting"`
 
 	metadataDocumentDescription `json:"-", xml:"-"`
 }
@@ -596,13 +763,18 @@ type metadataDocumentDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DocumentDescription.
+func (s DocumentDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a filter.
 type DocumentFilter struct {
 	// The name of the filter.
-	Key *string `locationName:"key" type:"string" required:"true"`
+	Key *string `locationName:"key" type:"string" enum:"Name" required:"true"`
 
 	// The value of the filter.
-	Value *string `locationName:"value" type:"string" required:"true"`
+	Value *string `locationName:"value" type:"string" min:"1" required:"true"`
 
 	metadataDocumentFilter `json:"-", xml:"-"`
 }
@@ -611,10 +783,47 @@ type metadataDocumentFilter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DocumentFilter.
+func (s DocumentFilter) String() string {
+	return awsutil.StringValue(s)
+}
+
+// DocumentFilterKey is an enum of the values of DocumentFilterKey members.
+type DocumentFilterKey string
+
+// The values of DocumentFilterKey.
+const (
+	DocumentFilterKeyName DocumentFilterKey = "Name"
+)
+
+// DocumentFilterKeyValues returns the values of DocumentFilterKey known to this
+// version of the SDK. The service may accept values added since.
+func DocumentFilterKeyValues() []DocumentFilterKey {
+	return []DocumentFilterKey{
+		DocumentFilterKey("Name"),
+	}
+}
+
+// String returns the DocumentFilterKey as the string of a member.
+func (v DocumentFilterKey) String() string {
+	return string(v)
+}
+
+// ParseDocumentFilterKey returns the DocumentFilterKey of str, failing if it is not
+// one of the DocumentFilterKeyValues.
+func ParseDocumentFilterKey(str string) (DocumentFilterKey, error) {
+	for _, v := range DocumentFilterKeyValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DocumentFilterKey value %q", str)
+}
+
 // Describes the name of a configuration document.
 type DocumentIdentifier struct {
 	// The name of the configuration document.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$"`
 
 	metadataDocumentIdentifier `json:"-", xml:"-"`
 }
@@ -623,13 +832,54 @@ type metadataDocumentIdentifier struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DocumentIdentifier.
+func (s DocumentIdentifier) String() string {
+	return awsutil.StringValue(s)
+}
+
+// DocumentStatus is an enum of the values of DocumentStatus members.
+type DocumentStatus string
+
+// The values of DocumentStatus.
+const (
+	DocumentStatusCreating DocumentStatus = "Creating"
+	DocumentStatusActive   DocumentStatus = "Active"
+	DocumentStatusDeleting DocumentStatus = "Deleting"
+)
+
+// DocumentStatusValues returns the values of DocumentStatus known to this
+// version of the SDK. The service may accept values added since.
+func DocumentStatusValues() []DocumentStatus {
+	return []DocumentStatus{
+		DocumentStatus("Creating"),
+		DocumentStatus("Active"),
+		DocumentStatus("Deleting"),
+	}
+}
+
+// String returns the DocumentStatus as the string of a member.
+func (v DocumentStatus) String() string {
+	return string(v)
+}
+
+// ParseDocumentStatus returns the DocumentStatus of str, failing if it is not
+// one of the DocumentStatusValues.
+func ParseDocumentStatus(str string) (DocumentStatus, error) {
+	for _, v := range DocumentStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DocumentStatus value %q", str)
+}
+
 // Describes a failed association.
 type FailedCreateAssociation struct {
 	// The association.
 	Entry *CreateAssociationBatchRequestEntry `type:"structure"`
 
 	// The source of the failure.
-	Fault *string `type:"string"`
+	Fault *string `type:"string" enum:"Client,Server,Unknown"`
 
 	// A description of the failure.
 	Message *string `type:"string"`
@@ -641,9 +891,50 @@ type metadataFailedCreateAssociation struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the FailedCreateAssociation.
+func (s FailedCreateAssociation) String() string {
+	return awsutil.StringValue(s)
+}
+
+// Fault is an enum of the values of Fault members.
+type Fault string
+
+// The values of Fault.
+const (
+	FaultClient  Fault = "Client"
+	FaultServer  Fault = "Server"
+	FaultUnknown Fault = "Unknown"
+)
+
+// FaultValues returns the values of Fault known to this
+// version of the SDK. The service may accept values added since.
+func FaultValues() []Fault {
+	return []Fault{
+		Fault("Client"),
+		Fault("Server"),
+		Fault("Unknown"),
+	}
+}
+
+// String returns the Fault as the string of a member.
+func (v Fault) String() string {
+	return string(v)
+}
+
+// ParseFault returns the Fault of str, failing if it is not
+// one of the FaultValues.
+func ParseFault(str string) (Fault, error) {
+	for _, v := range FaultValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid Fault value %q", str)
+}
+
 type GetDocumentInput struct {
 	// The name of the configuration document.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$" required:"true"`
 
 	metadataGetDocumentInput `json:"-", xml:"-"`
 }
@@ -652,12 +943,17 @@ type metadataGetDocumentInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetDocumentInput.
+func (s GetDocumentInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetDocumentOutput struct {
 	// The contents of the configuration document.
-	Content *string `type:"string"`
+	Content *string `type:"string" min:"1"`
 
 	// The name of the configuration document.
-	Name *string `type:"string"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$"`
 
 	metadataGetDocumentOutput `json:"-", xml:"-"`
 }
@@ -666,14 +962,19 @@ type metadataGetDocumentOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetDocumentOutput.
+func (s GetDocumentOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListAssociationsInput struct {
 	// One or more filters. Use a filter to return a more specific list of results.
-	AssociationFilterList []*AssociationFilter `locationNameList:"AssociationFilter" type:"list" required:"true"`
+	AssociationFilterList []*AssociationFilter `locationNameList:"AssociationFilter" type:"list" min:"1" required:"true"`
 
 	// The maximum number of items to return for this call. The call also returns
 	// a token that you can specify in a subsequent call to get the next set of
 	// results.
-	MaxResults *int64 `type:"integer"`
+	MaxResults *int64 `type:"integer" min:"1" max:"25"`
 
 	// The token for the next set of items to return. (You received this token from
 	// a previous call.)
@@ -686,6 +987,11 @@ type metadataListAssociationsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListAssociationsInput.
+func (s ListAssociationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListAssociationsOutput struct {
 	// The associations.
 	Associations []*Association `locationNameList:"Association" type:"list"`
@@ -701,14 +1007,19 @@ type metadataListAssociationsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListAssociationsOutput.
+func (s ListAssociationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListDocumentsInput struct {
 	// One or more filters. Use a filter to return a more specific list of results.
-	DocumentFilterList []*DocumentFilter `locationNameList:"DocumentFilter" type:"list"`
+	DocumentFilterList []*DocumentFilter `locationNameList:"DocumentFilter" type:"list" min:"1"`
 
 	// The maximum number of items to return for this call. The call also returns
 	// a token that you can specify in a subsequent call to get the next set of
 	// results.
-	MaxResults *int64 `type:"integer"`
+	MaxResults *int64 `type:"integer" min:"1" max:"25"`
 
 	// The token for the next set of items to return. (You received this token from
 	// a previous call.)
@@ -721,6 +1032,11 @@ type metadataListDocumentsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListDocumentsInput.
+func (s ListDocumentsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListDocumentsOutput struct {
 	// The names of the configuration documents.
 	DocumentIdentifiers []*DocumentIdentifier `locationNameList:"DocumentIdentifier" type:"list"`
@@ -736,15 +1052,20 @@ type metadataListDocumentsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListDocumentsOutput.
+func (s ListDocumentsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateAssociationStatusInput struct {
 	// The association status.
 	AssociationStatus *AssociationStatus `type:"structure" required:"true"`
 
 	// The ID of the instance.
-	InstanceID *string `locationName:"InstanceId" type:"string" required:"true"`
+	InstanceID *string `locationName:"InstanceId" type:"string" min:"10" max:"10" pattern:"^(?=.{10}$)(i-(\\w){8})" required:"true"`
 
 	// The name of the configuration document.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"3" max:"64" pattern:"^[a-zA-Z0-9_\\-.]{3,64}$" required:"true"`
 
 	metadataUpdateAssociationStatusInput `json:"-", xml:"-"`
 }
@@ -753,6 +1074,11 @@ type metadataUpdateAssociationStatusInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateAssociationStatusInput.
+func (s UpdateAssociationStatusInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateAssociationStatusOutput struct {
 	// Information about the association.
 	AssociationDescription *AssociationDescription `type:"structure"`
@@ -762,4 +1088,9 @@ type UpdateAssociationStatusOutput struct {
 
 type metadataUpdateAssociationStatusOutput struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the UpdateAssociationStatusOutput.
+func (s UpdateAssociationStatusOutput) String() string {
+	return awsutil.StringValue(s)
 }
\ No newline at end of file
@@ -4,9 +4,11 @@
 package storagegateway
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // ActivateGatewayRequest generates a request for the ActivateGateway operation.
@@ -857,6 +859,11 @@ func (c *StorageGateway) DescribeTapeArchivesRequest(input *DescribeTapeArchives
 			Name:       "DescribeTapeArchives",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "Limit",
+			},
 		}
 	}
 
@@ -878,6 +885,16 @@ func (c *StorageGateway) DescribeTapeArchives(input *DescribeTapeArchivesInput)
 	return
 }
 
+// DescribeTapeArchivesPages iterates over the pages of a DescribeTapeArchives operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *StorageGateway) DescribeTapeArchivesPages(input *DescribeTapeArchivesInput, fn func(p *DescribeTapeArchivesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeTapeArchivesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeTapeArchivesOutput), lastPage)
+	})
+}
+
 var opDescribeTapeArchives *aws.Operation
 
 // DescribeTapeRecoveryPointsRequest generates a request for the DescribeTapeRecoveryPoints operation.
@@ -887,6 +904,11 @@ func (c *StorageGateway) DescribeTapeRecoveryPointsRequest(input *DescribeTapeRe
 			Name:       "DescribeTapeRecoveryPoints",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "Limit",
+			},
 		}
 	}
 
@@ -909,6 +931,16 @@ func (c *StorageGateway) DescribeTapeRecoveryPoints(input *DescribeTapeRecoveryP
 	return
 }
 
+// DescribeTapeRecoveryPointsPages iterates over the pages of a DescribeTapeRecoveryPoints operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *StorageGateway) DescribeTapeRecoveryPointsPages(input *DescribeTapeRecoveryPointsInput, fn func(p *DescribeTapeRecoveryPointsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeTapeRecoveryPointsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeTapeRecoveryPointsOutput), lastPage)
+	})
+}
+
 var opDescribeTapeRecoveryPoints *aws.Operation
 
 // DescribeTapesRequest generates a request for the DescribeTapes operation.
@@ -918,6 +950,11 @@ func (c *StorageGateway) DescribeTapesRequest(input *DescribeTapesInput) (req *a
 			Name:       "DescribeTapes",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "Limit",
+			},
 		}
 	}
 
@@ -937,6 +974,16 @@ func (c *StorageGateway) DescribeTapes(input *DescribeTapesInput) (output *Descr
 	return
 }
 
+// DescribeTapesPages iterates over the pages of a DescribeTapes operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *StorageGateway) DescribeTapesPages(input *DescribeTapesInput, fn func(p *DescribeTapesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeTapesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeTapesOutput), lastPage)
+	})
+}
+
 var opDescribeTapes *aws.Operation
 
 // DescribeUploadBufferRequest generates a request for the DescribeUploadBuffer operation.
@@ -977,6 +1024,11 @@ func (c *StorageGateway) DescribeVTLDevicesRequest(input *DescribeVTLDevicesInpu
 			Name:       "DescribeVTLDevices",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "Limit",
+			},
 		}
 	}
 
@@ -997,6 +1049,16 @@ func (c *StorageGateway) DescribeVTLDevices(input *DescribeVTLDevicesInput) (out
 	return
 }
 
+// DescribeVTLDevicesPages iterates over the pages of a DescribeVTLDevices operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *StorageGateway) DescribeVTLDevicesPages(input *DescribeVTLDevicesInput, fn func(p *DescribeVTLDevicesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeVTLDevicesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeVTLDevicesOutput), lastPage)
+	})
+}
+
 var opDescribeVTLDevices *aws.Operation
 
 // DescribeWorkingStorageRequest generates a request for the DescribeWorkingStorage operation.
@@ -1073,6 +1135,11 @@ func (c *StorageGateway) ListGatewaysRequest(input *ListGatewaysInput) (req *aws
 			Name:       "ListGateways",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "Limit",
+			},
 		}
 	}
 
@@ -1100,6 +1167,16 @@ func (c *StorageGateway) ListGateways(input *ListGatewaysInput) (output *ListGat
 	return
 }
 
+// ListGatewaysPages iterates over the pages of a ListGateways operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *StorageGateway) ListGatewaysPages(input *ListGatewaysInput, fn func(p *ListGatewaysOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListGatewaysRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListGatewaysOutput), lastPage)
+	})
+}
+
 var opListGateways *aws.Operation
 
 // ListLocalDisksRequest generates a request for the ListLocalDisks operation.
@@ -1176,6 +1253,11 @@ func (c *StorageGateway) ListVolumesRequest(input *ListVolumesInput) (req *aws.R
 			Name:       "ListVolumes",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"Marker"},
+				OutputTokens: []string{"Marker"},
+				LimitToken:   "Limit",
+			},
 		}
 	}
 
@@ -1202,6 +1284,16 @@ func (c *StorageGateway) ListVolumes(input *ListVolumesInput) (output *ListVolum
 	return
 }
 
+// ListVolumesPages iterates over the pages of a ListVolumes operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *StorageGateway) ListVolumesPages(input *ListVolumesInput, fn func(p *ListVolumesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListVolumesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListVolumesOutput), lastPage)
+	})
+}
+
 var opListVolumes *aws.Operation
 
 // ResetCacheRequest generates a request for the ResetCache operation.
@@ -1615,11 +1707,11 @@ type ActivateGatewayInput struct {
 	// also include other activation-related parameters, however, these are merely
 	// defaults -- the arguments you pass to the ActivateGateway API call determine
 	// the actual configuration of your gateway.
-	ActivationKey *string `type:"string" required:"true"`
+	ActivationKey *string `type:"string" min:"1" max:"50" required:"true"`
 
 	// A unique identifier for your gateway. This name becomes part of the gateway
 	// Amazon Resources Name (ARN) which is what you use as an input to other operations.
-	GatewayName *string `type:"string" required:"true"`
+	GatewayName *string `type:"string" min:"2" max:"255" pattern:"^[ -\\.0-\\[\\]-~]*[!-\\.0-\\[\\]-~][ -\\.0-\\[\\]-~]*$" required:"true"`
 
 	// One of the values that indicates the region where you want to store the snapshot
 	// backups. The gateway region specified must be the same region as the region
@@ -1630,29 +1722,29 @@ type ActivateGatewayInput struct {
 	//
 	// Valid Values: "us-east-1", "us-west-1", "us-west-2", "eu-west-1", "eu-central-1",
 	// "ap-northeast-1", "ap-southeast-1", "ap-southeast-2", "sa-east-1"
-	GatewayRegion *string `type:"string" required:"true"`
+	GatewayRegion *string `type:"string" min:"1" max:"25" required:"true"`
 
 	// One of the values that indicates the time zone you want to set for the gateway.
 	// The time zone is used, for example, for scheduling snapshots and your gateway's
 	// maintenance schedule.
-	GatewayTimezone *string `type:"string" required:"true"`
+	GatewayTimezone *string `type:"string" min:"3" max:"10" required:"true"`
 
 	// One of the values that defines the type of gateway to activate. The type
 	// specified is critical to all later functions of the gateway and cannot be
 	// changed after activation. The default value is STORED.
-	GatewayType *string `type:"string"`
+	GatewayType *string `type:"string" min:"2" max:"20"`
 
 	// The value that indicates the type of medium changer to use for gateway-VTL.
 	// This field is optional.
 	//
 	// Valid Values: "STK-L700", "AWS-Gateway-VTL"
-	MediumChangerType *string `type:"string"`
+	MediumChangerType *string `type:"string" min:"2" max:"50"`
 
 	// The value that indicates the type of tape drive to use for gateway-VTL. This
 	// field is optional.
 	//
 	// Valid Values: "IBM-ULT3580-TD5"
-	TapeDriveType *string `type:"string"`
+	TapeDriveType *string `type:"string" min:"2" max:"50"`
 
 	metadataActivateGatewayInput `json:"-", xml:"-"`
 }
@@ -1661,6 +1753,11 @@ type metadataActivateGatewayInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ActivateGatewayInput.
+func (s ActivateGatewayInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // AWS Storage Gateway returns the Amazon Resource Name (ARN) of the activated
 // gateway. It is a string made of information such as your account, gateway
 // name, and region. This ARN is used to reference the gateway in other API
@@ -1668,7 +1765,7 @@ type metadataActivateGatewayInput struct {
 type ActivateGatewayOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataActivateGatewayOutput `json:"-", xml:"-"`
 }
@@ -1677,12 +1774,17 @@ type metadataActivateGatewayOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ActivateGatewayOutput.
+func (s ActivateGatewayOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AddCacheInput struct {
 	DiskIDs []*string `locationName:"DiskIds" type:"list" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataAddCacheInput `json:"-", xml:"-"`
 }
@@ -1691,10 +1793,15 @@ type metadataAddCacheInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddCacheInput.
+func (s AddCacheInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AddCacheOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataAddCacheOutput `json:"-", xml:"-"`
 }
@@ -1703,12 +1810,17 @@ type metadataAddCacheOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddCacheOutput.
+func (s AddCacheOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AddUploadBufferInput struct {
 	DiskIDs []*string `locationName:"DiskIds" type:"list" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataAddUploadBufferInput `json:"-", xml:"-"`
 }
@@ -1717,10 +1829,15 @@ type metadataAddUploadBufferInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddUploadBufferInput.
+func (s AddUploadBufferInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AddUploadBufferOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataAddUploadBufferOutput `json:"-", xml:"-"`
 }
@@ -1729,6 +1846,11 @@ type metadataAddUploadBufferOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddUploadBufferOutput.
+func (s AddUploadBufferOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing one or more of the following fields:
 //
 //   AddWorkingStorageInput$DiskIds
@@ -1740,7 +1862,7 @@ type AddWorkingStorageInput struct {
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataAddWorkingStorageInput `json:"-", xml:"-"`
 }
@@ -1749,12 +1871,17 @@ type metadataAddWorkingStorageInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddWorkingStorageInput.
+func (s AddWorkingStorageInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing the of the gateway for which working storage was
 // configured.
 type AddWorkingStorageOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataAddWorkingStorageOutput `json:"-", xml:"-"`
 }
@@ -1763,20 +1890,25 @@ type metadataAddWorkingStorageOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddWorkingStorageOutput.
+func (s AddWorkingStorageOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CachediSCSIVolume struct {
-	SourceSnapshotID *string `locationName:"SourceSnapshotId" type:"string"`
+	SourceSnapshotID *string `locationName:"SourceSnapshotId" type:"string" pattern:"\\Asnap-[0-9a-fA-F]{8}\\z"`
 
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
-	VolumeID *string `locationName:"VolumeId" type:"string"`
+	VolumeID *string `locationName:"VolumeId" type:"string" min:"12" max:"30"`
 
 	VolumeProgress *float64 `type:"double"`
 
 	VolumeSizeInBytes *int64 `type:"long"`
 
-	VolumeStatus *string `type:"string"`
+	VolumeStatus *string `type:"string" min:"3" max:"50"`
 
-	VolumeType *string `type:"string"`
+	VolumeType *string `type:"string" min:"3" max:"100"`
 
 	// Lists iSCSI information about a volume.
 	VolumeiSCSIAttributes *VolumeiSCSIAttributes `type:"structure"`
@@ -1788,15 +1920,20 @@ type metadataCachediSCSIVolume struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CachediSCSIVolume.
+func (s CachediSCSIVolume) String() string {
+	return awsutil.StringValue(s)
+}
+
 // CancelArchivalInput
 type CancelArchivalInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the virtual tape you want to cancel archiving
 	// for.
-	TapeARN *string `type:"string" required:"true"`
+	TapeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataCancelArchivalInput `json:"-", xml:"-"`
 }
@@ -1805,11 +1942,16 @@ type metadataCancelArchivalInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CancelArchivalInput.
+func (s CancelArchivalInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // CancelArchivalOutput
 type CancelArchivalOutput struct {
 	// The Amazon Resource Name (ARN) of the virtual tape for which archiving was
 	// canceled.
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataCancelArchivalOutput `json:"-", xml:"-"`
 }
@@ -1818,15 +1960,20 @@ type metadataCancelArchivalOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CancelArchivalOutput.
+func (s CancelArchivalOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // CancelRetrievalInput
 type CancelRetrievalInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the virtual tape you want to cancel retrieval
 	// for.
-	TapeARN *string `type:"string" required:"true"`
+	TapeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataCancelRetrievalInput `json:"-", xml:"-"`
 }
@@ -1835,11 +1982,16 @@ type metadataCancelRetrievalInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CancelRetrievalInput.
+func (s CancelRetrievalInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // CancelRetrievalOutput
 type CancelRetrievalOutput struct {
 	// The Amazon Resource Name (ARN) of the virtual tape for which retrieval was
 	// canceled.
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataCancelRetrievalOutput `json:"-", xml:"-"`
 }
@@ -1848,25 +2000,30 @@ type metadataCancelRetrievalOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CancelRetrievalOutput.
+func (s CancelRetrievalOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes Challenge-Handshake Authentication Protocol (CHAP) information
 // that supports authentication between your gateway and iSCSI initiators.
 type ChapInfo struct {
 	// The iSCSI initiator that connects to the target.
-	InitiatorName *string `type:"string"`
+	InitiatorName *string `type:"string" min:"1" max:"255" pattern:"[0-9a-z:.-]+"`
 
 	// The secret key that the initiator (e.g. Windows client) must provide to participate
 	// in mutual CHAP with the target.
-	SecretToAuthenticateInitiator *string `type:"string"`
+	SecretToAuthenticateInitiator *string `type:"string" min:"12" max:"16"`
 
 	// The secret key that the target must provide to participate in mutual CHAP
 	// with the initiator (e.g. Windows client).
-	SecretToAuthenticateTarget *string `type:"string"`
+	SecretToAuthenticateTarget *string `type:"string" min:"12" max:"16"`
 
 	// The Amazon Resource Name (ARN) of the volume.
 	//
 	// Valid Values: 50 to 500 lowercase letters, numbers, periods (.), and hyphens
 	// (-).
-	TargetARN *string `type:"string"`
+	TargetARN *string `type:"string" min:"50" max:"800"`
 
 	metadataChapInfo `json:"-", xml:"-"`
 }
@@ -1875,18 +2032,23 @@ type metadataChapInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ChapInfo.
+func (s ChapInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateCachediSCSIVolumeInput struct {
-	ClientToken *string `type:"string" required:"true"`
+	ClientToken *string `type:"string" min:"5" max:"100" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
-	NetworkInterfaceID *string `locationName:"NetworkInterfaceId" type:"string" required:"true"`
+	NetworkInterfaceID *string `locationName:"NetworkInterfaceId" type:"string" pattern:"\\A(25[0-5]|2[0-4]\\d|[0-1]?\\d?\\d)(\\.(25[0-5]|2[0-4]\\d|[0-1]?\\d?\\d)){3}\\z" required:"true"`
 
-	SnapshotID *string `locationName:"SnapshotId" type:"string"`
+	SnapshotID *string `locationName:"SnapshotId" type:"string" pattern:"\\Asnap-[0-9a-fA-F]{8}\\z"`
 
-	TargetName *string `type:"string" required:"true"`
+	TargetName *string `type:"string" min:"1" max:"200" pattern:"^[-\\.;a-z0-9]+$" required:"true"`
 
 	VolumeSizeInBytes *int64 `type:"long" required:"true"`
 
@@ -1897,10 +2059,15 @@ type metadataCreateCachediSCSIVolumeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateCachediSCSIVolumeInput.
+func (s CreateCachediSCSIVolumeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateCachediSCSIVolumeOutput struct {
-	TargetARN *string `type:"string"`
+	TargetARN *string `type:"string" min:"50" max:"800"`
 
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataCreateCachediSCSIVolumeOutput `json:"-", xml:"-"`
 }
@@ -1909,10 +2076,15 @@ type metadataCreateCachediSCSIVolumeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateCachediSCSIVolumeOutput.
+func (s CreateCachediSCSIVolumeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateSnapshotFromVolumeRecoveryPointInput struct {
-	SnapshotDescription *string `type:"string" required:"true"`
+	SnapshotDescription *string `type:"string" min:"1" max:"255" required:"true"`
 
-	VolumeARN *string `type:"string" required:"true"`
+	VolumeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataCreateSnapshotFromVolumeRecoveryPointInput `json:"-", xml:"-"`
 }
@@ -1921,10 +2093,15 @@ type metadataCreateSnapshotFromVolumeRecoveryPointInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateSnapshotFromVolumeRecoveryPointInput.
+func (s CreateSnapshotFromVolumeRecoveryPointInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateSnapshotFromVolumeRecoveryPointOutput struct {
-	SnapshotID *string `locationName:"SnapshotId" type:"string"`
+	SnapshotID *string `locationName:"SnapshotId" type:"string" pattern:"\\Asnap-[0-9a-fA-F]{8}\\z"`
 
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	VolumeRecoveryPointTime *string `type:"string"`
 
@@ -1935,6 +2112,11 @@ type metadataCreateSnapshotFromVolumeRecoveryPointOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateSnapshotFromVolumeRecoveryPointOutput.
+func (s CreateSnapshotFromVolumeRecoveryPointOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing one or more of the following fields:
 //
 //   CreateSnapshotInput$SnapshotDescription   CreateSnapshotInput$VolumeARN
@@ -1942,11 +2124,11 @@ type CreateSnapshotInput struct {
 	// Textual description of the snapshot that appears in the Amazon EC2 console,
 	// Elastic Block Store snapshots panel in the Description field, and in the
 	// AWS Storage Gateway snapshot Details pane, Description field
-	SnapshotDescription *string `type:"string" required:"true"`
+	SnapshotDescription *string `type:"string" min:"1" max:"255" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the volume. Use the ListVolumes operation
 	// to return a list of gateway volumes.
-	VolumeARN *string `type:"string" required:"true"`
+	VolumeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataCreateSnapshotInput `json:"-", xml:"-"`
 }
@@ -1955,15 +2137,20 @@ type metadataCreateSnapshotInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateSnapshotInput.
+func (s CreateSnapshotInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing the following fields:
 type CreateSnapshotOutput struct {
 	// The snapshot ID that is used to refer to the snapshot in future operations
 	// such as describing snapshots (Amazon Elastic Compute Cloud API DescribeSnapshots)
 	// or creating a volume from a snapshot (CreateStorediSCSIVolume).
-	SnapshotID *string `locationName:"SnapshotId" type:"string"`
+	SnapshotID *string `locationName:"SnapshotId" type:"string" pattern:"\\Asnap-[0-9a-fA-F]{8}\\z"`
 
 	// The Amazon Resource Name (ARN) of the volume of which the snapshot was taken.
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataCreateSnapshotOutput `json:"-", xml:"-"`
 }
@@ -1972,6 +2159,11 @@ type metadataCreateSnapshotOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateSnapshotOutput.
+func (s CreateSnapshotOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing one or more of the following fields:
 //
 //   CreateStorediSCSIVolumeInput$DiskId   CreateStorediSCSIVolumeInput$NetworkInterfaceId
@@ -1981,18 +2173,18 @@ type CreateStorediSCSIVolumeInput struct {
 	// The unique identifier for the gateway local disk that is configured as a
 	// stored volume. Use ListLocalDisks (http://docs.aws.amazon.com/storagegateway/latest/userguide/API_ListLocalDisks.html)
 	// to list disk IDs for a gateway.
-	DiskID *string `locationName:"DiskId" type:"string" required:"true"`
+	DiskID *string `locationName:"DiskId" type:"string" min:"1" max:"300" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// The network interface of the gateway on which to expose the iSCSI target.
 	// Only IPv4 addresses are accepted. Use DescribeGatewayInformation to get a
 	// list of the network interfaces available on a gateway.
 	//
 	// Valid Values: A valid IP address.
-	NetworkInterfaceID *string `locationName:"NetworkInterfaceId" type:"string" required:"true"`
+	NetworkInterfaceID *string `locationName:"NetworkInterfaceId" type:"string" pattern:"\\A(25[0-5]|2[0-4]\\d|[0-1]?\\d?\\d)(\\.(25[0-5]|2[0-4]\\d|[0-1]?\\d?\\d)){3}\\z" required:"true"`
 
 	// Specify this field as true if you want to preserve the data on the local
 	// disk. Otherwise, specifying this field as false creates an empty volume.
@@ -2005,13 +2197,13 @@ type CreateStorediSCSIVolumeInput struct {
 	// volume from a snapshot otherwise do not include this field. To list snapshots
 	// for your account use DescribeSnapshots (http://docs.aws.amazon.com/AWSEC2/latest/APIReference/ApiReference-query-DescribeSnapshots.html)
 	// in the Amazon Elastic Compute Cloud API Reference.
-	SnapshotID *string `locationName:"SnapshotId" type:"string"`
+	SnapshotID *string `locationName:"SnapshotId" type:"string" pattern:"\\Asnap-[0-9a-fA-F]{8}\\z"`
 
 	// The name of the iSCSI target used by initiators to connect to the target
 	// and as a suffix for the target ARN. For example, specifying TargetName as
 	// myvolume results in the target ARN of arn:aws:storagegateway:us-east-1:111122223333:gateway/mygateway/target/iqn.1997-05.com.amazon:myvolume.
 	// The target name must be unique across all volumes of a gateway.
-	TargetName *string `type:"string" required:"true"`
+	TargetName *string `type:"string" min:"1" max:"200" pattern:"^[-\\.;a-z0-9]+$" required:"true"`
 
 	metadataCreateStorediSCSIVolumeInput `json:"-", xml:"-"`
 }
@@ -2020,14 +2212,19 @@ type metadataCreateStorediSCSIVolumeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateStorediSCSIVolumeInput.
+func (s CreateStorediSCSIVolumeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing the following fields:
 type CreateStorediSCSIVolumeOutput struct {
 	// he Amazon Resource Name (ARN) of the volume target that includes the iSCSI
 	// name that initiators can use to connect to the target.
-	TargetARN *string `type:"string"`
+	TargetARN *string `type:"string" min:"50" max:"800"`
 
 	// The Amazon Resource Name (ARN) of the configured volume.
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	// The size of the volume in bytes.
 	VolumeSizeInBytes *int64 `type:"long"`
@@ -2039,28 +2236,33 @@ type metadataCreateStorediSCSIVolumeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateStorediSCSIVolumeOutput.
+func (s CreateStorediSCSIVolumeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // CreateTapesInput
 type CreateTapesInput struct {
 	// A unique identifier that you use to retry a request. If you retry a request,
 	// use the same ClientToken you specified in the initial request.
 	//
 	// Using the same ClientToken prevents creating the tape multiple times.
-	ClientToken *string `type:"string" required:"true"`
+	ClientToken *string `type:"string" min:"5" max:"100" required:"true"`
 
 	// The unique Amazon Resource Name(ARN) that represents the gateway to associate
 	// the virtual tapes with. Use the ListGateways operation to return a list of
 	// gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// The number of virtual tapes you want to create.
-	NumTapesToCreate *int64 `type:"integer" required:"true"`
+	NumTapesToCreate *int64 `type:"integer" min:"1" max:"10" required:"true"`
 
 	// A prefix you append to the barcode of the virtual tape you are creating.
 	// This makes a barcode unique.
 	//
 	// The prefix must be 1 to 4 characters in length and must be upper-case letters
 	// A-Z.
-	TapeBarcodePrefix *string `type:"string" required:"true"`
+	TapeBarcodePrefix *string `type:"string" min:"1" max:"4" pattern:"^[A-Z]*$" required:"true"`
 
 	// The size, in bytes, of the virtual tapes you want to create.
 	//
@@ -2074,6 +2276,11 @@ type metadataCreateTapesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateTapesInput.
+func (s CreateTapesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // CreateTapeOutput
 type CreateTapesOutput struct {
 	// A list of unique Amazon Resource Named (ARN) the represents the virtual tapes
@@ -2087,12 +2294,17 @@ type metadataCreateTapesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateTapesOutput.
+func (s CreateTapesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteBandwidthRateLimitInput struct {
-	BandwidthType *string `type:"string" required:"true"`
+	BandwidthType *string `type:"string" min:"3" max:"25" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDeleteBandwidthRateLimitInput `json:"-", xml:"-"`
 }
@@ -2101,12 +2313,17 @@ type metadataDeleteBandwidthRateLimitInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteBandwidthRateLimitInput.
+func (s DeleteBandwidthRateLimitInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing the of the gateway whose bandwidth rate information
 // was deleted.
 type DeleteBandwidthRateLimitOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDeleteBandwidthRateLimitOutput `json:"-", xml:"-"`
 }
@@ -2115,16 +2332,21 @@ type metadataDeleteBandwidthRateLimitOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteBandwidthRateLimitOutput.
+func (s DeleteBandwidthRateLimitOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing one or more of the following fields:
 //
 //   DeleteChapCredentialsInput$InitiatorName   DeleteChapCredentialsInput$TargetARN
 type DeleteChapCredentialsInput struct {
 	// The iSCSI initiator that connects to the target.
-	InitiatorName *string `type:"string" required:"true"`
+	InitiatorName *string `type:"string" min:"1" max:"255" pattern:"[0-9a-z:.-]+" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the iSCSI volume target. Use the DescribeStorediSCSIVolumes
 	// operation to return to retrieve the TargetARN for specified VolumeARN.
-	TargetARN *string `type:"string" required:"true"`
+	TargetARN *string `type:"string" min:"50" max:"800" required:"true"`
 
 	metadataDeleteChapCredentialsInput `json:"-", xml:"-"`
 }
@@ -2133,13 +2355,18 @@ type metadataDeleteChapCredentialsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteChapCredentialsInput.
+func (s DeleteChapCredentialsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing the following fields:
 type DeleteChapCredentialsOutput struct {
 	// The iSCSI initiator that connects to the target.
-	InitiatorName *string `type:"string"`
+	InitiatorName *string `type:"string" min:"1" max:"255" pattern:"[0-9a-z:.-]+"`
 
 	// The Amazon Resource Name (ARN) of the target.
-	TargetARN *string `type:"string"`
+	TargetARN *string `type:"string" min:"50" max:"800"`
 
 	metadataDeleteChapCredentialsOutput `json:"-", xml:"-"`
 }
@@ -2148,11 +2375,16 @@ type metadataDeleteChapCredentialsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteChapCredentialsOutput.
+func (s DeleteChapCredentialsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing the of the gateway to delete.
 type DeleteGatewayInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDeleteGatewayInput `json:"-", xml:"-"`
 }
@@ -2161,11 +2393,16 @@ type metadataDeleteGatewayInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteGatewayInput.
+func (s DeleteGatewayInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing the of the deleted gateway.
 type DeleteGatewayOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDeleteGatewayOutput `json:"-", xml:"-"`
 }
@@ -2174,8 +2411,13 @@ type metadataDeleteGatewayOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteGatewayOutput.
+func (s DeleteGatewayOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteSnapshotScheduleInput struct {
-	VolumeARN *string `type:"string" required:"true"`
+	VolumeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDeleteSnapshotScheduleInput `json:"-", xml:"-"`
 }
@@ -2184,8 +2426,13 @@ type metadataDeleteSnapshotScheduleInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteSnapshotScheduleInput.
+func (s DeleteSnapshotScheduleInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeleteSnapshotScheduleOutput struct {
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDeleteSnapshotScheduleOutput `json:"-", xml:"-"`
 }
@@ -2194,11 +2441,16 @@ type metadataDeleteSnapshotScheduleOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteSnapshotScheduleOutput.
+func (s DeleteSnapshotScheduleOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // DeleteTapeArchiveInput
 type DeleteTapeArchiveInput struct {
 	// The Amazon Resource Name (ARN) of the virtual tape to delete from the virtual
 	// tape shelf (VTS).
-	TapeARN *string `type:"string" required:"true"`
+	TapeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDeleteTapeArchiveInput `json:"-", xml:"-"`
 }
@@ -2207,11 +2459,16 @@ type metadataDeleteTapeArchiveInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteTapeArchiveInput.
+func (s DeleteTapeArchiveInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // DeleteTapeArchiveOutput
 type DeleteTapeArchiveOutput struct {
 	// The Amazon Resource Name (ARN) of the virtual tape that was deleted from
 	// the virtual tape shelf (VTS).
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDeleteTapeArchiveOutput `json:"-", xml:"-"`
 }
@@ -2220,15 +2477,20 @@ type metadataDeleteTapeArchiveOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteTapeArchiveOutput.
+func (s DeleteTapeArchiveOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // DeleteTapeInput
 type DeleteTapeInput struct {
 	// The unique Amazon Resource Name (ARN) of the gateway that the virtual tape
 	// to delete is associated with. Use the ListGateways operation to return a
 	// list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the virtual tape to delete.
-	TapeARN *string `type:"string" required:"true"`
+	TapeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDeleteTapeInput `json:"-", xml:"-"`
 }
@@ -2237,10 +2499,15 @@ type metadataDeleteTapeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteTapeInput.
+func (s DeleteTapeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // DeleteTapeOutput
 type DeleteTapeOutput struct {
 	// The Amazon Resource Name (ARN) of the deleted virtual tape.
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDeleteTapeOutput `json:"-", xml:"-"`
 }
@@ -2249,11 +2516,16 @@ type metadataDeleteTapeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteTapeOutput.
+func (s DeleteTapeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing the DeleteVolumeInput$VolumeARN to delete.
 type DeleteVolumeInput struct {
 	// The Amazon Resource Name (ARN) of the volume. Use the ListVolumes operation
 	// to return a list of gateway volumes.
-	VolumeARN *string `type:"string" required:"true"`
+	VolumeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDeleteVolumeInput `json:"-", xml:"-"`
 }
@@ -2262,11 +2534,16 @@ type metadataDeleteVolumeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteVolumeInput.
+func (s DeleteVolumeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing the of the storage volume that was deleted
 type DeleteVolumeOutput struct {
 	// The Amazon Resource Name (ARN) of the storage volume that was deleted. It
 	// is the same ARN you provided in the request.
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDeleteVolumeOutput `json:"-", xml:"-"`
 }
@@ -2275,11 +2552,16 @@ type metadataDeleteVolumeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeleteVolumeOutput.
+func (s DeleteVolumeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing the of the gateway.
 type DescribeBandwidthRateLimitInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDescribeBandwidthRateLimitInput `json:"-", xml:"-"`
 }
@@ -2288,19 +2570,24 @@ type metadataDescribeBandwidthRateLimitInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeBandwidthRateLimitInput.
+func (s DescribeBandwidthRateLimitInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing the following fields:
 type DescribeBandwidthRateLimitOutput struct {
 	// The average download bandwidth rate limit in bits per second. This field
 	// does not appear in the response if the download rate limit is not set.
-	AverageDownloadRateLimitInBitsPerSec *int64 `type:"long"`
+	AverageDownloadRateLimitInBitsPerSec *int64 `type:"long" min:"102400"`
 
 	// The average upload bandwidth rate limit in bits per second. This field does
 	// not appear in the response if the upload rate limit is not set.
-	AverageUploadRateLimitInBitsPerSec *int64 `type:"long"`
+	AverageUploadRateLimitInBitsPerSec *int64 `type:"long" min:"51200"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDescribeBandwidthRateLimitOutput `json:"-", xml:"-"`
 }
@@ -2309,10 +2596,15 @@ type metadataDescribeBandwidthRateLimitOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeBandwidthRateLimitOutput.
+func (s DescribeBandwidthRateLimitOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeCacheInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDescribeCacheInput `json:"-", xml:"-"`
 }
@@ -2321,6 +2613,11 @@ type metadataDescribeCacheInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeCacheInput.
+func (s DescribeCacheInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeCacheOutput struct {
 	CacheAllocatedInBytes *int64 `type:"long"`
 
@@ -2336,7 +2633,7 @@ type DescribeCacheOutput struct {
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDescribeCacheOutput `json:"-", xml:"-"`
 }
@@ -2345,6 +2642,11 @@ type metadataDescribeCacheOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeCacheOutput.
+func (s DescribeCacheOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeCachediSCSIVolumesInput struct {
 	VolumeARNs []*string `type:"list" required:"true"`
 
@@ -2355,6 +2657,11 @@ type metadataDescribeCachediSCSIVolumesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeCachediSCSIVolumesInput.
+func (s DescribeCachediSCSIVolumesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing the following fields:
 type DescribeCachediSCSIVolumesOutput struct {
 	// An array of objects where each object contains metadata about one cached
@@ -2368,12 +2675,17 @@ type metadataDescribeCachediSCSIVolumesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeCachediSCSIVolumesOutput.
+func (s DescribeCachediSCSIVolumesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing the Amazon Resource Name (ARN) of the iSCSI volume
 // target.
 type DescribeChapCredentialsInput struct {
 	// The Amazon Resource Name (ARN) of the iSCSI volume target. Use the DescribeStorediSCSIVolumes
 	// operation to return to retrieve the TargetARN for specified VolumeARN.
-	TargetARN *string `type:"string" required:"true"`
+	TargetARN *string `type:"string" min:"50" max:"800" required:"true"`
 
 	metadataDescribeChapCredentialsInput `json:"-", xml:"-"`
 }
@@ -2382,6 +2694,11 @@ type metadataDescribeChapCredentialsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeChapCredentialsInput.
+func (s DescribeChapCredentialsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing a .
 type DescribeChapCredentialsOutput struct {
 	// An array of ChapInfo objects that represent CHAP credentials. Each object
@@ -2407,11 +2724,16 @@ type metadataDescribeChapCredentialsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeChapCredentialsOutput.
+func (s DescribeChapCredentialsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing the of the gateway.
 type DescribeGatewayInformationInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDescribeGatewayInformationInput `json:"-", xml:"-"`
 }
@@ -2420,32 +2742,37 @@ type metadataDescribeGatewayInformationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeGatewayInformationInput.
+func (s DescribeGatewayInformationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing the following fields:
 type DescribeGatewayInformationOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	// The gateway ID.
-	GatewayID *string `locationName:"GatewayId" type:"string"`
+	GatewayID *string `locationName:"GatewayId" type:"string" min:"12" max:"30"`
 
 	// A NetworkInterface array that contains descriptions of the gateway network
 	// interfaces.
 	GatewayNetworkInterfaces []*NetworkInterface `type:"list"`
 
 	// One of the values that indicates the operating state of the gateway.
-	GatewayState *string `type:"string"`
+	GatewayState *string `type:"string" min:"2" max:"25"`
 
 	// One of the values that indicates the time zone configured for the gateway.
-	GatewayTimezone *string `type:"string"`
+	GatewayTimezone *string `type:"string" min:"3" max:"10"`
 
 	// TBD
-	GatewayType *string `type:"string"`
+	GatewayType *string `type:"string" min:"2" max:"20"`
 
 	// The date at which an update to the gateway is available. This date is in
 	// the time zone of the gateway. If the gateway is not available for an update
 	// this field is not returned in the response.
-	NextUpdateAvailabilityDate *string `type:"string"`
+	NextUpdateAvailabilityDate *string `type:"string" min:"1" max:"25"`
 
 	metadataDescribeGatewayInformationOutput `json:"-", xml:"-"`
 }
@@ -2454,11 +2781,16 @@ type metadataDescribeGatewayInformationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeGatewayInformationOutput.
+func (s DescribeGatewayInformationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing the of the gateway.
 type DescribeMaintenanceStartTimeInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDescribeMaintenanceStartTimeInput `json:"-", xml:"-"`
 }
@@ -2467,18 +2799,23 @@ type metadataDescribeMaintenanceStartTimeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeMaintenanceStartTimeInput.
+func (s DescribeMaintenanceStartTimeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeMaintenanceStartTimeOutput struct {
-	DayOfWeek *int64 `type:"integer"`
+	DayOfWeek *int64 `type:"integer" max:"6"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
-	HourOfDay *int64 `type:"integer"`
+	HourOfDay *int64 `type:"integer" max:"23"`
 
-	MinuteOfHour *int64 `type:"integer"`
+	MinuteOfHour *int64 `type:"integer" max:"59"`
 
-	Timezone *string `type:"string"`
+	Timezone *string `type:"string" min:"3" max:"10"`
 
 	metadataDescribeMaintenanceStartTimeOutput `json:"-", xml:"-"`
 }
@@ -2487,12 +2824,17 @@ type metadataDescribeMaintenanceStartTimeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeMaintenanceStartTimeOutput.
+func (s DescribeMaintenanceStartTimeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing the DescribeSnapshotScheduleInput$VolumeARN of the
 // volume.
 type DescribeSnapshotScheduleInput struct {
 	// The Amazon Resource Name (ARN) of the volume. Use the ListVolumes operation
 	// to return a list of gateway volumes.
-	VolumeARN *string `type:"string" required:"true"`
+	VolumeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDescribeSnapshotScheduleInput `json:"-", xml:"-"`
 }
@@ -2501,16 +2843,21 @@ type metadataDescribeSnapshotScheduleInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeSnapshotScheduleInput.
+func (s DescribeSnapshotScheduleInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeSnapshotScheduleOutput struct {
-	Description *string `type:"string"`
+	Description *string `type:"string" min:"1" max:"255"`
 
-	RecurrenceInHours *int64 `type:"integer"`
+	RecurrenceInHours *int64 `type:"integer" min:"1" max:"24"`
 
-	StartAt *int64 `type:"integer"`
+	StartAt *int64 `type:"integer" max:"23"`
 
-	Timezone *string `type:"string"`
+	Timezone *string `type:"string" min:"3" max:"10"`
 
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDescribeSnapshotScheduleOutput `json:"-", xml:"-"`
 }
@@ -2519,6 +2866,11 @@ type metadataDescribeSnapshotScheduleOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeSnapshotScheduleOutput.
+func (s DescribeSnapshotScheduleOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON Object containing a list of DescribeStorediSCSIVolumesInput$VolumeARNs.
 type DescribeStorediSCSIVolumesInput struct {
 	// An array of strings where each string represents the Amazon Resource Name
@@ -2533,6 +2885,11 @@ type metadataDescribeStorediSCSIVolumesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeStorediSCSIVolumesInput.
+func (s DescribeStorediSCSIVolumesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeStorediSCSIVolumesOutput struct {
 	StorediSCSIVolumes []*StorediSCSIVolume `type:"list"`
 
@@ -2543,15 +2900,20 @@ type metadataDescribeStorediSCSIVolumesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeStorediSCSIVolumesOutput.
+func (s DescribeStorediSCSIVolumesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // DescribeTapeArchivesInput
 type DescribeTapeArchivesInput struct {
 	// Specifies that the number of virtual tapes descried be limited to the specified
 	// number.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1"`
 
 	// An opaque string that indicates the position at which to begin describing
 	// virtual tapes.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	// Specifies one or more unique Amazon Resource Names (ARNs) that represent
 	// the virtual tapes you want to describe.
@@ -2564,6 +2926,11 @@ type metadataDescribeTapeArchivesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeTapeArchivesInput.
+func (s DescribeTapeArchivesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // DescribeTapeArchivesOutput
 type DescribeTapeArchivesOutput struct {
 	// An opaque string that indicates the position at which the virtual tapes that
@@ -2571,7 +2938,7 @@ type DescribeTapeArchivesOutput struct {
 	// to fetch the next set of virtual tapes in the virtual tape shelf (VTS). If
 	// there are no more virtual tapes to describe, this field does not appear in
 	// the response.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	// An array of virtual tape objects in the virtual tape shelf (VTS). The description
 	// includes of the Amazon Resource Name(ARN) of the virtual tapes. The information
@@ -2586,19 +2953,24 @@ type metadataDescribeTapeArchivesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeTapeArchivesOutput.
+func (s DescribeTapeArchivesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // DescribeTapeRecoveryPointsInput
 type DescribeTapeRecoveryPointsInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// Specifies that the number of virtual tape recovery points that are described
 	// be limited to the specified number.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1"`
 
 	// An opaque string that indicates the position at which to begin describing
 	// the virtual tape recovery points.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	metadataDescribeTapeRecoveryPointsInput `json:"-", xml:"-"`
 }
@@ -2607,11 +2979,16 @@ type metadataDescribeTapeRecoveryPointsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeTapeRecoveryPointsInput.
+func (s DescribeTapeRecoveryPointsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // DescribeTapeRecoveryPointsOutput
 type DescribeTapeRecoveryPointsOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	// An opaque string that indicates the position at which the virtual tape recovery
 	// points that were listed for description ended.
@@ -2619,7 +2996,7 @@ type DescribeTapeRecoveryPointsOutput struct {
 	// Use this marker in your next request to list the next set of virtual tape
 	// recovery points in the list. If there are no more recovery points to describe,
 	// this field does not appear in the response.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	// An array of TapeRecoveryPointInfos that are available for the specified gateway.
 	TapeRecoveryPointInfos []*TapeRecoveryPointInfo `type:"list"`
@@ -2631,23 +3008,28 @@ type metadataDescribeTapeRecoveryPointsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeTapeRecoveryPointsOutput.
+func (s DescribeTapeRecoveryPointsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // DescribeTapesInput
 type DescribeTapesInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// Specifies that the number of virtual tapes described be limited to the specified
 	// number.
 	//
 	// Amazon Web Services may impose its own limit, if this field is not set.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1"`
 
 	// A marker value, obtained in a previous call to DescribeTapes. This marker
 	// indicates which page of results to retrieve.
 	//
 	// If not specified, the first page of results is retrieved.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	// Specifies one or more unique Amazon Resource Names (ARNs) that represent
 	// the virtual tapes you want to describe. If this parameter is not specified,
@@ -2662,6 +3044,11 @@ type metadataDescribeTapesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeTapesInput.
+func (s DescribeTapesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // DescribeTapesOutput
 type DescribeTapesOutput struct {
 	// An opaque string which can be used as part of a subsequent DescribeTapes
@@ -2669,7 +3056,7 @@ type DescribeTapesOutput struct {
 	//
 	// If a response does not contain a marker, then there are no more results
 	// to be retrieved.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	// An array of virtual tape descriptions.
 	Tapes []*Tape `type:"list"`
@@ -2681,10 +3068,15 @@ type metadataDescribeTapesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeTapesOutput.
+func (s DescribeTapesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeUploadBufferInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDescribeUploadBufferInput `json:"-", xml:"-"`
 }
@@ -2693,12 +3085,17 @@ type metadataDescribeUploadBufferInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeUploadBufferInput.
+func (s DescribeUploadBufferInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeUploadBufferOutput struct {
 	DiskIDs []*string `locationName:"DiskIds" type:"list"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	UploadBufferAllocatedInBytes *int64 `type:"long"`
 
@@ -2711,19 +3108,24 @@ type metadataDescribeUploadBufferOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeUploadBufferOutput.
+func (s DescribeUploadBufferOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // DescribeVTLDevicesInput
 type DescribeVTLDevicesInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// Specifies that the number of VTL devices described be limited to the specified
 	// number.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1"`
 
 	// An opaque string that indicates the position at which to begin describing
 	// the VTL devices.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	// An array of strings, where each string represents the Amazon Resource Name
 	// (ARN) of a VTL device.
@@ -2740,17 +3142,22 @@ type metadataDescribeVTLDevicesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeVTLDevicesInput.
+func (s DescribeVTLDevicesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // DescribeVTLDevicesOutput
 type DescribeVTLDevicesOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	// An opaque string that indicates the position at which the VTL devices that
 	// were fetched for description ended. Use the marker in your next request to
 	// fetch the next set of VTL devices in the list. If there are no more VTL devices
 	// to describe, this field does not appear in the response.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	// An array of VTL device objects composed of the Amazon Resource Name(ARN)
 	// of the VTL devices.
@@ -2763,11 +3170,16 @@ type metadataDescribeVTLDevicesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeVTLDevicesOutput.
+func (s DescribeVTLDevicesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing the of the gateway.
 type DescribeWorkingStorageInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDescribeWorkingStorageInput `json:"-", xml:"-"`
 }
@@ -2776,6 +3188,11 @@ type metadataDescribeWorkingStorageInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeWorkingStorageInput.
+func (s DescribeWorkingStorageInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing the following fields:
 type DescribeWorkingStorageOutput struct {
 	// An array of the gateway's local disk IDs that are configured as working storage.
@@ -2786,7 +3203,7 @@ type DescribeWorkingStorageOutput struct {
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	// The total working storage in bytes allocated for the gateway. If no working
 	// storage is configured for the gateway, this field returns 0.
@@ -2803,20 +3220,25 @@ type metadataDescribeWorkingStorageOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeWorkingStorageOutput.
+func (s DescribeWorkingStorageOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Lists iSCSI information about a VTL device.
 type DeviceiSCSIAttributes struct {
 	// Indicates whether mutual CHAP is enabled for the iSCSI target.
 	ChapEnabled *bool `type:"boolean"`
 
 	// The network interface identifier of the VTL device.
-	NetworkInterfaceID *string `locationName:"NetworkInterfaceId" type:"string"`
+	NetworkInterfaceID *string `locationName:"NetworkInterfaceId" type:"string" pattern:"\\A(25[0-5]|2[0-4]\\d|[0-1]?\\d?\\d)(\\.(25[0-5]|2[0-4]\\d|[0-1]?\\d?\\d)){3}\\z"`
 
 	// The port used to communicate with iSCSI VTL device targets.
 	NetworkInterfacePort *int64 `type:"integer"`
 
 	// Specifies the unique Amazon Resource Name(ARN) that encodes the iSCSI qualified
 	// name(iqn) of a tape drive or media changer target.
-	TargetARN *string `type:"string"`
+	TargetARN *string `type:"string" min:"50" max:"800"`
 
 	metadataDeviceiSCSIAttributes `json:"-", xml:"-"`
 }
@@ -2825,11 +3247,16 @@ type metadataDeviceiSCSIAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeviceiSCSIAttributes.
+func (s DeviceiSCSIAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // DisableGatewayInput
 type DisableGatewayInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataDisableGatewayInput `json:"-", xml:"-"`
 }
@@ -2838,10 +3265,15 @@ type metadataDisableGatewayInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DisableGatewayInput.
+func (s DisableGatewayInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // DisableGatewayOutput
 type DisableGatewayOutput struct {
 	// The unique Amazon Resource Name of the disabled gateway.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataDisableGatewayOutput `json:"-", xml:"-"`
 }
@@ -2850,12 +3282,17 @@ type metadataDisableGatewayOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DisableGatewayOutput.
+func (s DisableGatewayOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type Disk struct {
 	DiskAllocationResource *string `type:"string"`
 
-	DiskAllocationType *string `type:"string"`
+	DiskAllocationType *string `type:"string" min:"3" max:"100"`
 
-	DiskID *string `locationName:"DiskId" type:"string"`
+	DiskID *string `locationName:"DiskId" type:"string" min:"1" max:"300"`
 
 	DiskNode *string `type:"string"`
 
@@ -2872,14 +3309,171 @@ type metadataDisk struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Disk.
+func (s Disk) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ErrorCode is an enum of the values of ErrorCode members.
+type ErrorCode string
+
+// The values of ErrorCode.
+const (
+	ErrorCodeActivationKeyExpired              ErrorCode = "ActivationKeyExpired"
+	ErrorCodeActivationKeyInvalid              ErrorCode = "ActivationKeyInvalid"
+	ErrorCodeActivationKeyNotFound             ErrorCode = "ActivationKeyNotFound"
+	ErrorCodeGatewayInternalError              ErrorCode = "GatewayInternalError"
+	ErrorCodeGatewayNotConnected               ErrorCode = "GatewayNotConnected"
+	ErrorCodeGatewayNotFound                   ErrorCode = "GatewayNotFound"
+	ErrorCodeGatewayProxyNetworkConnectionBusy ErrorCode = "GatewayProxyNetworkConnectionBusy"
+	ErrorCodeAuthenticationFailure             ErrorCode = "AuthenticationFailure"
+	ErrorCodeBandwidthThrottleScheduleNotFound ErrorCode = "BandwidthThrottleScheduleNotFound"
+	ErrorCodeBlocked                           ErrorCode = "Blocked"
+	ErrorCodeCannotExportSnapshot              ErrorCode = "CannotExportSnapshot"
+	ErrorCodeChapCredentialNotFound            ErrorCode = "ChapCredentialNotFound"
+	ErrorCodeDiskAlreadyAllocated              ErrorCode = "DiskAlreadyAllocated"
+	ErrorCodeDiskDoesNotExist                  ErrorCode = "DiskDoesNotExist"
+	ErrorCodeDiskSizeGreaterThanVolumeMaxSize  ErrorCode = "DiskSizeGreaterThanVolumeMaxSize"
+	ErrorCodeDiskSizeLessThanVolumeSize        ErrorCode = "DiskSizeLessThanVolumeSize"
+	ErrorCodeDiskSizeNotGigAligned             ErrorCode = "DiskSizeNotGigAligned"
+	ErrorCodeDuplicateCertificateInfo          ErrorCode = "DuplicateCertificateInfo"
+	ErrorCodeDuplicateSchedule                 ErrorCode = "DuplicateSchedule"
+	ErrorCodeEndpointNotFound                  ErrorCode = "EndpointNotFound"
+	ErrorCodeIAMNotSupported                   ErrorCode = "IAMNotSupported"
+	ErrorCodeInitiatorInvalid                  ErrorCode = "InitiatorInvalid"
+	ErrorCodeInitiatorNotFound                 ErrorCode = "InitiatorNotFound"
+	ErrorCodeInternalError                     ErrorCode = "InternalError"
+	ErrorCodeInvalidGateway                    ErrorCode = "InvalidGateway"
+	ErrorCodeInvalidEndpoint                   ErrorCode = "InvalidEndpoint"
+	ErrorCodeInvalidParameters                 ErrorCode = "InvalidParameters"
+	ErrorCodeInvalidSchedule                   ErrorCode = "InvalidSchedule"
+	ErrorCodeLocalStorageLimitExceeded         ErrorCode = "LocalStorageLimitExceeded"
+	ErrorCodeLunAlreadyAllocated               ErrorCode = "LunAlreadyAllocated "
+	ErrorCodeLunInvalid                        ErrorCode = "LunInvalid"
+	ErrorCodeMaximumContentLengthExceeded      ErrorCode = "MaximumContentLengthExceeded"
+	ErrorCodeMaximumTapeCartridgeCountExceeded ErrorCode = "MaximumTapeCartridgeCountExceeded"
+	ErrorCodeMaximumVolumeCountExceeded        ErrorCode = "MaximumVolumeCountExceeded"
+	ErrorCodeNetworkConfigurationChanged       ErrorCode = "NetworkConfigurationChanged"
+	ErrorCodeNoDisksAvailable                  ErrorCode = "NoDisksAvailable"
+	ErrorCodeNotImplemented                    ErrorCode = "NotImplemented"
+	ErrorCodeNotSupported                      ErrorCode = "NotSupported"
+	ErrorCodeOperationAborted                  ErrorCode = "OperationAborted"
+	ErrorCodeOutdatedGateway                   ErrorCode = "OutdatedGateway"
+	ErrorCodeParametersNotImplemented          ErrorCode = "ParametersNotImplemented"
+	ErrorCodeRegionInvalid                     ErrorCode = "RegionInvalid"
+	ErrorCodeRequestTimeout                    ErrorCode = "RequestTimeout"
+	ErrorCodeServiceUnavailable                ErrorCode = "ServiceUnavailable"
+	ErrorCodeSnapshotDeleted                   ErrorCode = "SnapshotDeleted"
+	ErrorCodeSnapshotIdInvalid                 ErrorCode = "SnapshotIdInvalid"
+	ErrorCodeSnapshotInProgress                ErrorCode = "SnapshotInProgress"
+	ErrorCodeSnapshotNotFound                  ErrorCode = "SnapshotNotFound"
+	ErrorCodeSnapshotScheduleNotFound          ErrorCode = "SnapshotScheduleNotFound"
+	ErrorCodeStagingAreaFull                   ErrorCode = "StagingAreaFull"
+	ErrorCodeStorageFailure                    ErrorCode = "StorageFailure"
+	ErrorCodeTapeCartridgeNotFound             ErrorCode = "TapeCartridgeNotFound"
+	ErrorCodeTargetAlreadyExists               ErrorCode = "TargetAlreadyExists"
+	ErrorCodeTargetInvalid                     ErrorCode = "TargetInvalid"
+	ErrorCodeTargetNotFound                    ErrorCode = "TargetNotFound"
+	ErrorCodeUnauthorizedOperation             ErrorCode = "UnauthorizedOperation"
+	ErrorCodeVolumeAlreadyExists               ErrorCode = "VolumeAlreadyExists"
+	ErrorCodeVolumeIdInvalid                   ErrorCode = "VolumeIdInvalid"
+	ErrorCodeVolumeInUse                       ErrorCode = "VolumeInUse"
+	ErrorCodeVolumeNotFound                    ErrorCode = "VolumeNotFound"
+	ErrorCodeVolumeNotReady                    ErrorCode = "VolumeNotReady"
+)
+
+// ErrorCodeValues returns the values of ErrorCode known to this
+// version of the SDK. The service may accept values added since.
+func ErrorCodeValues() []ErrorCode {
+	return []ErrorCode{
+		ErrorCode("ActivationKeyExpired"),
+		ErrorCode("ActivationKeyInvalid"),
+		ErrorCode("ActivationKeyNotFound"),
+		ErrorCode("GatewayInternalError"),
+		ErrorCode("GatewayNotConnected"),
+		ErrorCode("GatewayNotFound"),
+		ErrorCode("GatewayProxyNetworkConnectionBusy"),
+		ErrorCode("AuthenticationFailure"),
+		ErrorCode("BandwidthThrottleScheduleNotFound"),
+		ErrorCode("Blocked"),
+		ErrorCode("CannotExportSnapshot"),
+		ErrorCode("ChapCredentialNotFound"),
+		ErrorCode("DiskAlreadyAllocated"),
+		ErrorCode("DiskDoesNotExist"),
+		ErrorCode("DiskSizeGreaterThanVolumeMaxSize"),
+		ErrorCode("DiskSizeLessThanVolumeSize"),
+		ErrorCode("DiskSizeNotGigAligned"),
+		ErrorCode("DuplicateCertificateInfo"),
+		ErrorCode("DuplicateSchedule"),
+		ErrorCode("EndpointNotFound"),
+		ErrorCode("IAMNotSupported"),
+		ErrorCode("InitiatorInvalid"),
+		ErrorCode("InitiatorNotFound"),
+		ErrorCode("InternalError"),
+		ErrorCode("InvalidGateway"),
+		ErrorCode("InvalidEndpoint"),
+		ErrorCode("InvalidParameters"),
+		ErrorCode("InvalidSchedule"),
+		ErrorCode("LocalStorageLimitExceeded"),
+		ErrorCode("LunAlreadyAllocated "),
+		ErrorCode("LunInvalid"),
+		ErrorCode("MaximumContentLengthExceeded"),
+		ErrorCode("MaximumTapeCartridgeCountExceeded"),
+		ErrorCode("MaximumVolumeCountExceeded"),
+		ErrorCode("NetworkConfigurationChanged"),
+		ErrorCode("NoDisksAvailable"),
+		ErrorCode("NotImplemented"),
+		ErrorCode("NotSupported"),
+		ErrorCode("OperationAborted"),
+		ErrorCode("OutdatedGateway"),
+		ErrorCode("ParametersNotImplemented"),
+		ErrorCode("RegionInvalid"),
+		ErrorCode("RequestTimeout"),
+		ErrorCode("ServiceUnavailable"),
+		ErrorCode("SnapshotDeleted"),
+		ErrorCode("SnapshotIdInvalid"),
+		ErrorCode("SnapshotInProgress"),
+		ErrorCode("SnapshotNotFound"),
+		ErrorCode("SnapshotScheduleNotFound"),
+		ErrorCode("StagingAreaFull"),
+		ErrorCode("StorageFailure"),
+		ErrorCode("TapeCartridgeNotFound"),
+		ErrorCode("TargetAlreadyExists"),
+		ErrorCode("TargetInvalid"),
+		ErrorCode("TargetNotFound"),
+		ErrorCode("UnauthorizedOperation"),
+		ErrorCode("VolumeAlreadyExists"),
+		ErrorCode("VolumeIdInvalid"),
+		ErrorCode("VolumeInUse"),
+		ErrorCode("VolumeNotFound"),
+		ErrorCode("VolumeNotReady"),
+	}
+}
+
+// String returns the ErrorCode as the string of a member.
+func (v ErrorCode) String() string {
+	return string(v)
+}
+
+// ParseErrorCode returns the ErrorCode of str, failing if it is not
+// one of the ErrorCodeValues.
+func ParseErrorCode(str string) (ErrorCode, error) {
+	for _, v := range ErrorCodeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ErrorCode value %q", str)
+}
+
 type GatewayInfo struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
-	GatewayOperationalState *string `type:"string"`
+	GatewayOperationalState *string `type:"string" min:"2" max:"25"`
 
-	GatewayType *string `type:"string"`
+	GatewayType *string `type:"string" min:"2" max:"20"`
 
 	metadataGatewayInfo `json:"-", xml:"-"`
 }
@@ -2888,17 +3482,22 @@ type metadataGatewayInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GatewayInfo.
+func (s GatewayInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing zero or more of the following fields:
 //
 //   ListGatewaysInput$Limit   ListGatewaysInput$Marker
 type ListGatewaysInput struct {
 	// Specifies that the list of gateways returned be limited to the specified
 	// number of items.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1"`
 
 	// An opaque string that indicates the position at which to begin the returned
 	// list of gateways.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	metadataListGatewaysInput `json:"-", xml:"-"`
 }
@@ -2907,10 +3506,15 @@ type metadataListGatewaysInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListGatewaysInput.
+func (s ListGatewaysInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListGatewaysOutput struct {
 	Gateways []*GatewayInfo `type:"list"`
 
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	metadataListGatewaysOutput `json:"-", xml:"-"`
 }
@@ -2919,11 +3523,16 @@ type metadataListGatewaysOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListGatewaysOutput.
+func (s ListGatewaysOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing the of the gateway.
 type ListLocalDisksInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataListLocalDisksInput `json:"-", xml:"-"`
 }
@@ -2932,12 +3541,17 @@ type metadataListLocalDisksInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListLocalDisksInput.
+func (s ListLocalDisksInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListLocalDisksOutput struct {
 	Disks []*Disk `type:"list"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataListLocalDisksOutput `json:"-", xml:"-"`
 }
@@ -2946,10 +3560,15 @@ type metadataListLocalDisksOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListLocalDisksOutput.
+func (s ListLocalDisksOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListVolumeRecoveryPointsInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataListVolumeRecoveryPointsInput `json:"-", xml:"-"`
 }
@@ -2958,10 +3577,15 @@ type metadataListVolumeRecoveryPointsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListVolumeRecoveryPointsInput.
+func (s ListVolumeRecoveryPointsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListVolumeRecoveryPointsOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	VolumeRecoveryPointInfos []*VolumeRecoveryPointInfo `type:"list"`
 
@@ -2972,22 +3596,27 @@ type metadataListVolumeRecoveryPointsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListVolumeRecoveryPointsOutput.
+func (s ListVolumeRecoveryPointsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object that contains one or more of the following fields:
 //
 //   ListVolumesInput$Limit   ListVolumesInput$Marker
 type ListVolumesInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// Specifies that the list of volumes returned be limited to the specified number
 	// of items.
-	Limit *int64 `type:"integer"`
+	Limit *int64 `type:"integer" min:"1"`
 
 	// A string that indicates the position at which to begin the returned list
 	// of volumes. Obtain the marker from the response of a previous List iSCSI
 	// Volumes request.
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	metadataListVolumesInput `json:"-", xml:"-"`
 }
@@ -2996,12 +3625,17 @@ type metadataListVolumesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListVolumesInput.
+func (s ListVolumesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListVolumesOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
-	Marker *string `type:"string"`
+	Marker *string `type:"string" min:"1" max:"1000"`
 
 	VolumeInfos []*VolumeInfo `type:"list"`
 
@@ -3012,6 +3646,11 @@ type metadataListVolumesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListVolumesOutput.
+func (s ListVolumesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a gateway's network interface.
 type NetworkInterface struct {
 	// The Internet Protocol version 4 (IPv4) address of the interface.
@@ -3033,10 +3672,15 @@ type metadataNetworkInterface struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the NetworkInterface.
+func (s NetworkInterface) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ResetCacheInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataResetCacheInput `json:"-", xml:"-"`
 }
@@ -3045,10 +3689,15 @@ type metadataResetCacheInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ResetCacheInput.
+func (s ResetCacheInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ResetCacheOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataResetCacheOutput `json:"-", xml:"-"`
 }
@@ -3057,6 +3706,11 @@ type metadataResetCacheOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ResetCacheOutput.
+func (s ResetCacheOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // RetrieveTapeArchiveInput
 type RetrieveTapeArchiveInput struct {
 	// The Amazon Resource Name (ARN) of the gateway you want to retrieve the virtual
@@ -3065,11 +3719,11 @@ type RetrieveTapeArchiveInput struct {
 	//
 	// You retrieve archived virtual tapes to only one gateway and the gateway
 	// must be a gateway-VTL.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the virtual tape you want to retrieve from
 	// the virtual tape shelf (VTS).
-	TapeARN *string `type:"string" required:"true"`
+	TapeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataRetrieveTapeArchiveInput `json:"-", xml:"-"`
 }
@@ -3078,10 +3732,15 @@ type metadataRetrieveTapeArchiveInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RetrieveTapeArchiveInput.
+func (s RetrieveTapeArchiveInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // RetrieveTapeArchiveOutput
 type RetrieveTapeArchiveOutput struct {
 	// The Amazon Resource Name (ARN) of the retrieved virtual tape.
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataRetrieveTapeArchiveOutput `json:"-", xml:"-"`
 }
@@ -3090,15 +3749,20 @@ type metadataRetrieveTapeArchiveOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RetrieveTapeArchiveOutput.
+func (s RetrieveTapeArchiveOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // RetrieveTapeRecoveryPointInput
 type RetrieveTapeRecoveryPointInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the virtual tape for which you want to
 	// retrieve the recovery point.
-	TapeARN *string `type:"string" required:"true"`
+	TapeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataRetrieveTapeRecoveryPointInput `json:"-", xml:"-"`
 }
@@ -3107,11 +3771,16 @@ type metadataRetrieveTapeRecoveryPointInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RetrieveTapeRecoveryPointInput.
+func (s RetrieveTapeRecoveryPointInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // RetrieveTapeRecoveryPointOutput
 type RetrieveTapeRecoveryPointOutput struct {
 	// The Amazon Resource Name (ARN) of the virtual tape for which the recovery
 	// point was retrieved.
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataRetrieveTapeRecoveryPointOutput `json:"-", xml:"-"`
 }
@@ -3120,11 +3789,16 @@ type metadataRetrieveTapeRecoveryPointOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RetrieveTapeRecoveryPointOutput.
+func (s RetrieveTapeRecoveryPointOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing the of the gateway to shut down.
 type ShutdownGatewayInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataShutdownGatewayInput `json:"-", xml:"-"`
 }
@@ -3133,11 +3807,16 @@ type metadataShutdownGatewayInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ShutdownGatewayInput.
+func (s ShutdownGatewayInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing the of the gateway that was shut down.
 type ShutdownGatewayOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataShutdownGatewayOutput `json:"-", xml:"-"`
 }
@@ -3146,11 +3825,16 @@ type metadataShutdownGatewayOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ShutdownGatewayOutput.
+func (s ShutdownGatewayOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing the of the gateway to start.
 type StartGatewayInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataStartGatewayInput `json:"-", xml:"-"`
 }
@@ -3159,11 +3843,16 @@ type metadataStartGatewayInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StartGatewayInput.
+func (s StartGatewayInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing the of the gateway that was restarted.
 type StartGatewayOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataStartGatewayOutput `json:"-", xml:"-"`
 }
@@ -3172,12 +3861,17 @@ type metadataStartGatewayOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StartGatewayOutput.
+func (s StartGatewayOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides additional information about an error that was returned by the service
 // as an or. See the errorCode and errorDetails members for more information
 // about the error.
 type StorageGatewayError struct {
 	// Additional information about the error.
-	ErrorCode *string `locationName:"errorCode" type:"string"`
+	ErrorCode *string `locationName:"errorCode" type:"string" enum:"ActivationKeyExpired,ActivationKeyInvalid,ActivationKeyNotFound,GatewayInternalError,GatewayNotConnected,GatewayNotFound,GatewayProxyNetworkConnectionBusy,AuthenticationFailure,BandwidthThrottleScheduleNotFound,Blocked,CannotExportSnapshot,ChapCredentialNotFound,DiskAlreadyAllocated,DiskDoesNotExist,DiskSizeGreaterThanVolumeMaxSize,DiskSizeLessThanVolumeSize,DiskSizeNotGigAligned,DuplicateCertificateInfo,DuplicateSchedule,EndpointNotFound,IAMNotSupported,InitiatorInvalid,InitiatorNotFound,InternalError,InvalidGateway,InvalidEndpoint,InvalidParameters,InvalidSchedule,LocalStorageLimitExceeded,LunAlreadyAllocated ,LunInvalid,MaximumContentLengthExceeded,MaximumTapeCartridgeCountExceeded,MaximumVolumeCountExceeded,NetworkConfigurationChanged,NoDisksAvailable,NotImplemented,NotSupported,OperationAborted,OutdatedGateway,ParametersNotImplemented,RegionInvalid,RequestTimeout,ServiceUnavailable,SnapshotDeleted,SnapshotIdInvalid,SnapshotInProgress,SnapshotNotFound,SnapshotScheduleNotFound,StagingAreaFull,StorageFailure,TapeCartridgeNotFound,TargetAlreadyExists,TargetInvalid,TargetNotFound,UnauthorizedOperation,VolumeAlreadyExists,VolumeIdInvalid,VolumeInUse,VolumeNotFound,VolumeNotReady"`
 
 	// Human-readable text that provides detail about the error that occurred.
 	ErrorDetails *map[string]*string `locationName:"errorDetails" type:"map"`
@@ -3189,24 +3883,29 @@ type metadataStorageGatewayError struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StorageGatewayError.
+func (s StorageGatewayError) String() string {
+	return awsutil.StringValue(s)
+}
+
 type StorediSCSIVolume struct {
 	PreservedExistingData *bool `type:"boolean"`
 
-	SourceSnapshotID *string `locationName:"SourceSnapshotId" type:"string"`
+	SourceSnapshotID *string `locationName:"SourceSnapshotId" type:"string" pattern:"\\Asnap-[0-9a-fA-F]{8}\\z"`
 
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
-	VolumeDiskID *string `locationName:"VolumeDiskId" type:"string"`
+	VolumeDiskID *string `locationName:"VolumeDiskId" type:"string" min:"1" max:"300"`
 
-	VolumeID *string `locationName:"VolumeId" type:"string"`
+	VolumeID *string `locationName:"VolumeId" type:"string" min:"12" max:"30"`
 
 	VolumeProgress *float64 `type:"double"`
 
 	VolumeSizeInBytes *int64 `type:"long"`
 
-	VolumeStatus *string `type:"string"`
+	VolumeStatus *string `type:"string" min:"3" max:"50"`
 
-	VolumeType *string `type:"string"`
+	VolumeType *string `type:"string" min:"3" max:"100"`
 
 	// Lists iSCSI information about a volume.
 	VolumeiSCSIAttributes *VolumeiSCSIAttributes `type:"structure"`
@@ -3218,6 +3917,11 @@ type metadataStorediSCSIVolume struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StorediSCSIVolume.
+func (s StorediSCSIVolume) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a virtual tape object.
 type Tape struct {
 	// For archiving virtual tapes, indicates how much data remains to be uploaded
@@ -3227,10 +3931,10 @@ type Tape struct {
 	Progress *float64 `type:"double"`
 
 	// The Amazon Resource Name (ARN) of the virtual tape.
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	// The barcode that identifies a specific virtual tape.
-	TapeBarcode *string `type:"string"`
+	TapeBarcode *string `type:"string" min:"8" max:"16" pattern:"^[A-Z0-9]*$"`
 
 	// The size, in bytes, of the virtual tape.
 	TapeSizeInBytes *int64 `type:"long"`
@@ -3240,7 +3944,7 @@ type Tape struct {
 
 	// The virtual tape library (VTL) device that the virtual tape is associated
 	// with.
-	VTLDevice *string `type:"string"`
+	VTLDevice *string `type:"string" min:"50" max:"500"`
 
 	metadataTape `json:"-", xml:"-"`
 }
@@ -3249,6 +3953,11 @@ type metadataTape struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Tape.
+func (s Tape) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents a virtual tape that is archived in the virtual tape shelf (VTS).
 type TapeArchive struct {
 	// The time that the archiving of the virtual tape was completed.
@@ -3261,13 +3970,13 @@ type TapeArchive struct {
 	// being retrieved to.
 	//
 	// The virtual tape is retrieved from the virtual tape shelf (VTS).
-	RetrievedTo *string `type:"string"`
+	RetrievedTo *string `type:"string" min:"50" max:"500"`
 
 	// The Amazon Resource Name (ARN) of an archived virtual tape.
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	// The barcode that identifies the archived virtual tape.
-	TapeBarcode *string `type:"string"`
+	TapeBarcode *string `type:"string" min:"8" max:"16" pattern:"^[A-Z0-9]*$"`
 
 	// The size, in bytes, of the archived virtual tape.
 	TapeSizeInBytes *int64 `type:"long"`
@@ -3282,10 +3991,15 @@ type metadataTapeArchive struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TapeArchive.
+func (s TapeArchive) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Describes a recovery point.
 type TapeRecoveryPointInfo struct {
 	// The Amazon Resource Name (ARN) of the virtual tape.
-	TapeARN *string `type:"string"`
+	TapeARN *string `type:"string" min:"50" max:"500"`
 
 	// The time when the point-in-time view of the virtual tape was replicated for
 	// later recovery.
@@ -3306,19 +4020,24 @@ type metadataTapeRecoveryPointInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TapeRecoveryPointInfo.
+func (s TapeRecoveryPointInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing one or more of the following fields:
 //
 //   UpdateBandwidthRateLimitInput$AverageDownloadRateLimitInBitsPerSec   UpdateBandwidthRateLimitInput$AverageUploadRateLimitInBitsPerSec
 type UpdateBandwidthRateLimitInput struct {
 	// The average download bandwidth rate limit in bits per second.
-	AverageDownloadRateLimitInBitsPerSec *int64 `type:"long"`
+	AverageDownloadRateLimitInBitsPerSec *int64 `type:"long" min:"102400"`
 
 	// The average upload bandwidth rate limit in bits per second.
-	AverageUploadRateLimitInBitsPerSec *int64 `type:"long"`
+	AverageUploadRateLimitInBitsPerSec *int64 `type:"long" min:"51200"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataUpdateBandwidthRateLimitInput `json:"-", xml:"-"`
 }
@@ -3327,12 +4046,17 @@ type metadataUpdateBandwidthRateLimitInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateBandwidthRateLimitInput.
+func (s UpdateBandwidthRateLimitInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing the of the gateway whose throttle information was
 // updated.
 type UpdateBandwidthRateLimitOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataUpdateBandwidthRateLimitOutput `json:"-", xml:"-"`
 }
@@ -3341,25 +4065,30 @@ type metadataUpdateBandwidthRateLimitOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateBandwidthRateLimitOutput.
+func (s UpdateBandwidthRateLimitOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing one or more of the following fields:
 //
 //   UpdateChapCredentialsInput$InitiatorName   UpdateChapCredentialsInput$SecretToAuthenticateInitiator
 //   UpdateChapCredentialsInput$SecretToAuthenticateTarget   UpdateChapCredentialsInput$TargetARN
 type UpdateChapCredentialsInput struct {
 	// The iSCSI initiator that connects to the target.
-	InitiatorName *string `type:"string" required:"true"`
+	InitiatorName *string `type:"string" min:"1" max:"255" pattern:"[0-9a-z:.-]+" required:"true"`
 
 	// The secret key that the initiator (e.g. Windows client) must provide to participate
 	// in mutual CHAP with the target.
-	SecretToAuthenticateInitiator *string `type:"string" required:"true"`
+	SecretToAuthenticateInitiator *string `type:"string" min:"12" max:"16" required:"true"`
 
 	// The secret key that the target must provide to participate in mutual CHAP
 	// with the initiator (e.g. Windows client).
-	SecretToAuthenticateTarget *string `type:"string"`
+	SecretToAuthenticateTarget *string `type:"string" min:"12" max:"16"`
 
 	// The Amazon Resource Name (ARN) of the iSCSI volume target. Use the DescribeStorediSCSIVolumes
 	// operation to return to retrieve the TargetARN for specified VolumeARN.
-	TargetARN *string `type:"string" required:"true"`
+	TargetARN *string `type:"string" min:"50" max:"800" required:"true"`
 
 	metadataUpdateChapCredentialsInput `json:"-", xml:"-"`
 }
@@ -3368,15 +4097,20 @@ type metadataUpdateChapCredentialsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateChapCredentialsInput.
+func (s UpdateChapCredentialsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing the following fields:
 type UpdateChapCredentialsOutput struct {
 	// The iSCSI initiator that connects to the target. This is the same initiator
 	// name specified in the request.
-	InitiatorName *string `type:"string"`
+	InitiatorName *string `type:"string" min:"1" max:"255" pattern:"[0-9a-z:.-]+"`
 
 	// The Amazon Resource Name (ARN) of the target. This is the same target specified
 	// in the request.
-	TargetARN *string `type:"string"`
+	TargetARN *string `type:"string" min:"50" max:"800"`
 
 	metadataUpdateChapCredentialsOutput `json:"-", xml:"-"`
 }
@@ -3385,16 +4119,21 @@ type metadataUpdateChapCredentialsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateChapCredentialsOutput.
+func (s UpdateChapCredentialsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type UpdateGatewayInformationInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// A unique identifier for your gateway. This name becomes part of the gateway
 	// Amazon Resources Name (ARN) which is what you use as an input to other operations.
-	GatewayName *string `type:"string"`
+	GatewayName *string `type:"string" min:"2" max:"255" pattern:"^[ -\\.0-\\[\\]-~]*[!-\\.0-\\[\\]-~][ -\\.0-\\[\\]-~]*$"`
 
-	GatewayTimezone *string `type:"string"`
+	GatewayTimezone *string `type:"string" min:"3" max:"10"`
 
 	metadataUpdateGatewayInformationInput `json:"-", xml:"-"`
 }
@@ -3403,11 +4142,16 @@ type metadataUpdateGatewayInformationInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateGatewayInformationInput.
+func (s UpdateGatewayInformationInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing the of the gateway that was updated.
 type UpdateGatewayInformationOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataUpdateGatewayInformationOutput `json:"-", xml:"-"`
 }
@@ -3416,11 +4160,16 @@ type metadataUpdateGatewayInformationOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateGatewayInformationOutput.
+func (s UpdateGatewayInformationOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing the of the gateway to update.
 type UpdateGatewaySoftwareNowInput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataUpdateGatewaySoftwareNowInput `json:"-", xml:"-"`
 }
@@ -3429,11 +4178,16 @@ type metadataUpdateGatewaySoftwareNowInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateGatewaySoftwareNowInput.
+func (s UpdateGatewaySoftwareNowInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing the of the gateway that was updated.
 type UpdateGatewaySoftwareNowOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataUpdateGatewaySoftwareNowOutput `json:"-", xml:"-"`
 }
@@ -3442,27 +4196,32 @@ type metadataUpdateGatewaySoftwareNowOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateGatewaySoftwareNowOutput.
+func (s UpdateGatewaySoftwareNowOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing the following fields:
 //
 //   UpdateMaintenanceStartTimeInput$DayOfWeek   UpdateMaintenanceStartTimeInput$HourOfDay
 //   UpdateMaintenanceStartTimeInput$MinuteOfHour
 type UpdateMaintenanceStartTimeInput struct {
 	// The maintenance start time day of the week.
-	DayOfWeek *int64 `type:"integer" required:"true"`
+	DayOfWeek *int64 `type:"integer" max:"6" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string" required:"true"`
+	GatewayARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	// The hour component of the maintenance start time represented as hh, where
 	// hh is the hour (00 to 23). The hour of the day is in the time zone of the
 	// gateway.
-	HourOfDay *int64 `type:"integer" required:"true"`
+	HourOfDay *int64 `type:"integer" max:"23" required:"true"`
 
 	// The minute component of the maintenance start time represented as mm, where
 	// mm is the minute (00 to 59). The minute of the hour is in the time zone of
 	// the gateway.
-	MinuteOfHour *int64 `type:"integer" required:"true"`
+	MinuteOfHour *int64 `type:"integer" max:"59" required:"true"`
 
 	metadataUpdateMaintenanceStartTimeInput `json:"-", xml:"-"`
 }
@@ -3471,12 +4230,17 @@ type metadataUpdateMaintenanceStartTimeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateMaintenanceStartTimeInput.
+func (s UpdateMaintenanceStartTimeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing the of the gateway whose maintenance start time
 // is updated.
 type UpdateMaintenanceStartTimeOutput struct {
 	// The Amazon Resource Name (ARN) of the gateway. Use the ListGateways operation
 	// to return a list of gateways for your account and region.
-	GatewayARN *string `type:"string"`
+	GatewayARN *string `type:"string" min:"50" max:"500"`
 
 	metadataUpdateMaintenanceStartTimeOutput `json:"-", xml:"-"`
 }
@@ -3485,25 +4249,30 @@ type metadataUpdateMaintenanceStartTimeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateMaintenanceStartTimeOutput.
+func (s UpdateMaintenanceStartTimeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing one or more of the following fields:
 //
 //   UpdateSnapshotScheduleInput$Description   UpdateSnapshotScheduleInput$RecurrenceInHours
 //   UpdateSnapshotScheduleInput$StartAt   UpdateSnapshotScheduleInput$VolumeARN
 type UpdateSnapshotScheduleInput struct {
 	// Optional description of the snapshot that overwrites the existing description.
-	Description *string `type:"string"`
+	Description *string `type:"string" min:"1" max:"255"`
 
 	// Frequency of snapshots. Specify the number of hours between snapshots.
-	RecurrenceInHours *int64 `type:"integer" required:"true"`
+	RecurrenceInHours *int64 `type:"integer" min:"1" max:"24" required:"true"`
 
 	// The hour of the day at which the snapshot schedule begins represented as
 	// hh, where hh is the hour (0 to 23). The hour of the day is in the time zone
 	// of the gateway.
-	StartAt *int64 `type:"integer" required:"true"`
+	StartAt *int64 `type:"integer" max:"23" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the volume. Use the ListVolumes operation
 	// to return a list of gateway volumes.
-	VolumeARN *string `type:"string" required:"true"`
+	VolumeARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataUpdateSnapshotScheduleInput `json:"-", xml:"-"`
 }
@@ -3512,9 +4281,14 @@ type metadataUpdateSnapshotScheduleInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateSnapshotScheduleInput.
+func (s UpdateSnapshotScheduleInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON object containing the of the updated storage volume.
 type UpdateSnapshotScheduleOutput struct {
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	metadataUpdateSnapshotScheduleOutput `json:"-", xml:"-"`
 }
@@ -3523,15 +4297,20 @@ type metadataUpdateSnapshotScheduleOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateSnapshotScheduleOutput.
+func (s UpdateSnapshotScheduleOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // UpdateVTLDeviceTypeInput
 type UpdateVTLDeviceTypeInput struct {
 	// The type of medium changer you want to select.
 	//
 	// Valid Values: "STK-L700", "AWS-Gateway-VTL"
-	DeviceType *string `type:"string" required:"true"`
+	DeviceType *string `type:"string" min:"2" max:"50" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the medium changer you want to select.
-	VTLDeviceARN *string `type:"string" required:"true"`
+	VTLDeviceARN *string `type:"string" min:"50" max:"500" required:"true"`
 
 	metadataUpdateVTLDeviceTypeInput `json:"-", xml:"-"`
 }
@@ -3540,10 +4319,15 @@ type metadataUpdateVTLDeviceTypeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateVTLDeviceTypeInput.
+func (s UpdateVTLDeviceTypeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // UpdateVTLDeviceTypeOutput
 type UpdateVTLDeviceTypeOutput struct {
 	// The Amazon Resource Name (ARN) of the medium changer you have selected.
-	VTLDeviceARN *string `type:"string"`
+	VTLDeviceARN *string `type:"string" min:"50" max:"500"`
 
 	metadataUpdateVTLDeviceTypeOutput `json:"-", xml:"-"`
 }
@@ -3552,6 +4336,11 @@ type metadataUpdateVTLDeviceTypeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the UpdateVTLDeviceTypeOutput.
+func (s UpdateVTLDeviceTypeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents a device object associated with a gateway-VTL.
 type VTLDevice struct {
 	// A list of iSCSI information about a VTL device.
@@ -3559,7 +4348,7 @@ type VTLDevice struct {
 
 	// Specifies the unique Amazon Resource Name (ARN) of the device (tape drive
 	// or media changer).
-	VTLDeviceARN *string `type:"string"`
+	VTLDeviceARN *string `type:"string" min:"50" max:"500"`
 
 	VTLDeviceProductIdentifier *string `type:"string"`
 
@@ -3574,10 +4363,15 @@ type metadataVTLDevice struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the VTLDevice.
+func (s VTLDevice) String() string {
+	return awsutil.StringValue(s)
+}
+
 type VolumeInfo struct {
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
-	VolumeType *string `type:"string"`
+	VolumeType *string `type:"string" min:"3" max:"100"`
 
 	metadataVolumeInfo `json:"-", xml:"-"`
 }
@@ -3586,8 +4380,13 @@ type metadataVolumeInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the VolumeInfo.
+func (s VolumeInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
 type VolumeRecoveryPointInfo struct {
-	VolumeARN *string `type:"string"`
+	VolumeARN *string `type:"string" min:"50" max:"500"`
 
 	VolumeRecoveryPointTime *string `type:"string"`
 
@@ -3602,26 +4401,36 @@ type metadataVolumeRecoveryPointInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the VolumeRecoveryPointInfo.
+func (s VolumeRecoveryPointInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Lists iSCSI information about a volume.
 type VolumeiSCSIAttributes struct {
 	// Indicates whether mutual CHAP is enabled for the iSCSI target.
 	ChapEnabled *bool `type:"boolean"`
 
 	// The logical disk number.
-	LunNumber *int64 `type:"integer"`
+	LunNumber *int64 `type:"integer" min:"1"`
 
 	// The network interface identifier.
-	NetworkInterfaceID *string `locationName:"NetworkInterfaceId" type:"string"`
+	NetworkInterfaceID *string `locationName:"NetworkInterfaceId" type:"string" pattern:"\\A(25[0-5]|2[0-4]\\d|[0-1]?\\d?\\d)(\\.(25[0-5]|2[0-4]\\d|[0-1]?\\d?\\d)){3}\\z"`
 
 	// The port used to communicate with iSCSI targets.
 	NetworkInterfacePort *int64 `type:"integer"`
 
 	// The Amazon Resource Name (ARN) of the volume target.
-	TargetARN *string `type:"string"`
+	TargetARN *string `type:"string" min:"50" max:"800"`
 
 	metadataVolumeiSCSIAttributes `json:"-", xml:"-"`
 }
 
 type metadataVolumeiSCSIAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the VolumeiSCSIAttributes.
+func (s VolumeiSCSIAttributes) String() string {
+	return awsutil.StringValue(s)
 }
\ No newline at end of file
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // AssumeRoleRequest generates a request for the AssumeRole operation.
@@ -443,7 +444,7 @@ type AssumeRoleInput struct {
 	// The duration, in seconds, of the role session. The value can range from 900
 	// seconds (15 minutes) to 3600 seconds (1 hour). By default, the value is set
 	// to 3600 seconds.
-	DurationSeconds *int64 `type:"integer"`
+	DurationSeconds *int64 `type:"integer" min:"900" max:"3600"`
 
 	// A unique identifier that is used by third parties to assume a role in their
 	// customers' accounts. For each role that the third party can assume, they
@@ -453,7 +454,7 @@ type AssumeRoleInput struct {
 	// to help third parties bind a role to the customer who created it. For more
 	// information about the external ID, see About the External ID (http://docs.aws.amazon.com/STS/latest/UsingSTS/sts-delegating-externalid.html"
 	// target="_blank) in Using Temporary Security Credentials.
-	ExternalID *string `locationName:"ExternalId" type:"string"`
+	ExternalID *string `locationName:"ExternalId" type:"string" min:"2" max:"96" pattern:"[\\w+=,.@:-]*"`
 
 	// An IAM policy in JSON format.
 	//
@@ -466,27 +467,27 @@ type AssumeRoleInput struct {
 	// by the access policy of the role that is being assumed. For more information,
 	// see Permissions for AssumeRole (http://docs.aws.amazon.com/STS/latest/UsingSTS/permissions-assume-role.html)
 	// in Using Temporary Security Credentials.
-	Policy *string `type:"string"`
+	Policy *string `type:"string" min:"1" max:"2048" pattern:"[\\u0009\\u000A\\u000D\\u0020-\\u00FF]+"`
 
 	// The Amazon Resource Name (ARN) of the role that the caller is assuming.
-	RoleARN *string `locationName:"RoleArn" type:"string" required:"true"`
+	RoleARN *string `locationName:"RoleArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// An identifier for the assumed role session. The session name is included
 	// as part of the AssumedRoleUser.
-	RoleSessionName *string `type:"string" required:"true"`
+	RoleSessionName *string `type:"string" min:"2" max:"32" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The identification number of the MFA device that is associated with the user
 	// who is making the AssumeRole call. Specify this value if the trust policy
 	// of the role being assumed includes a condition that requires MFA authentication.
 	// The value is either the serial number for a hardware device (such as GAHT12345678)
 	// or an Amazon Resource Name (ARN) for a virtual device (such as arn:aws:iam::123456789012:mfa/user).
-	SerialNumber *string `type:"string"`
+	SerialNumber *string `type:"string" min:"9" max:"256" pattern:"[\\w+=/:,.@-]*"`
 
 	// The value provided by the MFA device, if the trust policy of the role being
 	// assumed requires MFA (that is, if the policy includes a condition that tests
 	// for MFA). If the role being assumed requires MFA and if the TokenCode value
 	// is missing or expired, the AssumeRole call returns an "access denied" error.
-	TokenCode *string `type:"string"`
+	TokenCode *string `type:"string" min:"6" max:"6" pattern:"[\\d]*"`
 
 	metadataAssumeRoleInput `json:"-", xml:"-"`
 }
@@ -495,6 +496,11 @@ type metadataAssumeRoleInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AssumeRoleInput.
+func (s AssumeRoleInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the result of a successful call to the AssumeRole action, including
 // temporary AWS credentials that can be used to make AWS requests.
 type AssumeRoleOutput struct {
@@ -521,13 +527,18 @@ type metadataAssumeRoleOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AssumeRoleOutput.
+func (s AssumeRoleOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AssumeRoleWithSAMLInput struct {
 	// The duration, in seconds, of the role session. The value can range from 900
 	// seconds (15 minutes) to 3600 seconds (1 hour). By default, the value is set
 	// to 3600 seconds. An expiration can also be specified in the SAML authentication
 	// response's NotOnOrAfter value. The actual expiration time is whichever value
 	// is shorter.
-	DurationSeconds *int64 `type:"integer"`
+	DurationSeconds *int64 `type:"integer" min:"900" max:"129600"`
 
 	// An IAM policy in JSON format.
 	//
@@ -540,21 +551,21 @@ type AssumeRoleWithSAMLInput struct {
 	// by the access policy of the role that is being assumed. For more information,
 	// see Permissions for AssumeRoleWithSAML (http://docs.aws.amazon.com/STS/latest/UsingSTS/permissions-assume-role.html)
 	// in Using Temporary Security Credentials.
-	Policy *string `type:"string"`
+	Policy *string `type:"string" min:"1" max:"2048" pattern:"[\\u0009\\u000A\\u000D\\u0020-\\u00FF]+"`
 
 	// The Amazon Resource Name (ARN) of the SAML provider in IAM that describes
 	// the IdP.
-	PrincipalARN *string `locationName:"PrincipalArn" type:"string" required:"true"`
+	PrincipalARN *string `locationName:"PrincipalArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The Amazon Resource Name (ARN) of the role that the caller is assuming.
-	RoleARN *string `locationName:"RoleArn" type:"string" required:"true"`
+	RoleARN *string `locationName:"RoleArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The base-64 encoded SAML authentication response provided by the IdP.
 	//
 	// For more information, see Configuring a Relying Party and Adding Claims
 	// (http://docs.aws.amazon.com/IAM/latest/UserGuide/create-role-saml-IdP-tasks.html)
 	// in the Using IAM guide.
-	SAMLAssertion *string `type:"string" required:"true"`
+	SAMLAssertion *string `type:"string" min:"4" max:"50000" required:"true"`
 
 	metadataAssumeRoleWithSAMLInput `json:"-", xml:"-"`
 }
@@ -563,6 +574,11 @@ type metadataAssumeRoleWithSAMLInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AssumeRoleWithSAMLInput.
+func (s AssumeRoleWithSAMLInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the result of a successful call to the AssumeRoleWithSAML action,
 // including temporary AWS credentials that can be used to make AWS requests.
 type AssumeRoleWithSAMLOutput struct {
@@ -616,11 +632,16 @@ type metadataAssumeRoleWithSAMLOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AssumeRoleWithSAMLOutput.
+func (s AssumeRoleWithSAMLOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type AssumeRoleWithWebIdentityInput struct {
 	// The duration, in seconds, of the role session. The value can range from 900
 	// seconds (15 minutes) to 3600 seconds (1 hour). By default, the value is set
 	// to 3600 seconds.
-	DurationSeconds *int64 `type:"integer"`
+	DurationSeconds *int64 `type:"integer" min:"900" max:"129600"`
 
 	// An IAM policy in JSON format.
 	//
@@ -633,30 +654,30 @@ type AssumeRoleWithWebIdentityInput struct {
 	// by the access policy of the role that is being assumed. For more information,
 	// see Permissions for AssumeRoleWithWebIdentity (http://docs.aws.amazon.com/STS/latest/UsingSTS/permissions-assume-role.html)
 	// in Using Temporary Security Credentials.
-	Policy *string `type:"string"`
+	Policy *string `type:"string" min:"1" max:"2048" pattern:"[\\u0009\\u000A\\u000D\\u0020-\\u00FF]+"`
 
 	// The fully-qualified host component of the domain name of the identity provider.
 	// Specify this value only for OAuth access tokens. Do not specify this value
 	// for OpenID Connect ID tokens, such as accounts.google.com. Do not include
 	// URL schemes and port numbers. Currently, www.amazon.com and graph.facebook.com
 	// are supported.
-	ProviderID *string `locationName:"ProviderId" type:"string"`
+	ProviderID *string `locationName:"ProviderId" type:"string" min:"4" max:"2048"`
 
 	// The Amazon Resource Name (ARN) of the role that the caller is assuming.
-	RoleARN *string `locationName:"RoleArn" type:"string" required:"true"`
+	RoleARN *string `locationName:"RoleArn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// An identifier for the assumed role session. Typically, you pass the name
 	// or identifier that is associated with the user who is using your application.
 	// That way, the temporary security credentials that your application will use
 	// are associated with that user. This session name is included as part of the
 	// ARN and assumed role ID in the AssumedRoleUser response element.
-	RoleSessionName *string `type:"string" required:"true"`
+	RoleSessionName *string `type:"string" min:"2" max:"32" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// The OAuth 2.0 access token or OpenID Connect ID token that is provided by
 	// the identity provider. Your application must get this token by authenticating
 	// the user who is using your application with a web identity provider before
 	// the application makes an AssumeRoleWithWebIdentity call.
-	WebIdentityToken *string `type:"string" required:"true"`
+	WebIdentityToken *string `type:"string" min:"4" max:"2048" required:"true"`
 
 	metadataAssumeRoleWithWebIdentityInput `json:"-", xml:"-"`
 }
@@ -665,6 +686,11 @@ type metadataAssumeRoleWithWebIdentityInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AssumeRoleWithWebIdentityInput.
+func (s AssumeRoleWithWebIdentityInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the result of a successful call to the AssumeRoleWithWebIdentity
 // action, including temporary AWS credentials that can be used to make AWS
 // requests.
@@ -703,7 +729,7 @@ type AssumeRoleWithWebIdentityOutput struct {
 	// identifier). If an OpenID Connect ID token was submitted in the WebIdentityToken,
 	// this value is returned by the identity provider as the token's sub (Subject)
 	// claim.
-	SubjectFromWebIdentityToken *string `type:"string"`
+	SubjectFromWebIdentityToken *string `type:"string" min:"6" max:"255"`
 
 	metadataAssumeRoleWithWebIdentityOutput `json:"-", xml:"-"`
 }
@@ -712,6 +738,11 @@ type metadataAssumeRoleWithWebIdentityOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AssumeRoleWithWebIdentityOutput.
+func (s AssumeRoleWithWebIdentityOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The identifiers for the temporary security credentials that the operation
 // returns.
 type AssumedRoleUser struct {
@@ -719,12 +750,12 @@ type AssumedRoleUser struct {
 	// AssumeRole action. For more information about ARNs and how to use them in
 	// policies, see  Identifiers for IAM Entities  (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html"
 	// target="_blank) in Using IAM.
-	ARN *string `locationName:"Arn" type:"string" required:"true"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// A unique identifier that contains the role ID and the role session name of
 	// the role that is being assumed. The role ID is generated by AWS when the
 	// role is created.
-	AssumedRoleID *string `locationName:"AssumedRoleId" type:"string" required:"true"`
+	AssumedRoleID *string `locationName:"AssumedRoleId" type:"string" min:"2" max:"96" pattern:"[\\w+=,.@:-]*" required:"true"`
 
 	metadataAssumedRoleUser `json:"-", xml:"-"`
 }
@@ -733,10 +764,15 @@ type metadataAssumedRoleUser struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AssumedRoleUser.
+func (s AssumedRoleUser) String() string {
+	return awsutil.StringValue(s)
+}
+
 // AWS credentials for API authentication.
 type Credentials struct {
 	// The access key ID that identifies the temporary security credentials.
-	AccessKeyID *string `locationName:"AccessKeyId" type:"string" required:"true"`
+	AccessKeyID *string `locationName:"AccessKeyId" type:"string" min:"16" max:"32" pattern:"[\\w]*" required:"true"`
 
 	// The date on which the current credentials expire.
 	Expiration *time.Time `type:"timestamp" timestampFormat:"iso8601" required:"true"`
@@ -754,9 +790,14 @@ type metadataCredentials struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Credentials.
+func (s Credentials) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DecodeAuthorizationMessageInput struct {
 	// The encoded message that was returned with the response.
-	EncodedMessage *string `type:"string" required:"true"`
+	EncodedMessage *string `type:"string" min:"1" max:"10240" required:"true"`
 
 	metadataDecodeAuthorizationMessageInput `json:"-", xml:"-"`
 }
@@ -765,6 +806,11 @@ type metadataDecodeAuthorizationMessageInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DecodeAuthorizationMessageInput.
+func (s DecodeAuthorizationMessageInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A document that contains additional information about the authorization status
 // of a request from an encoded message that is returned in response to an AWS
 // request.
@@ -780,17 +826,22 @@ type metadataDecodeAuthorizationMessageOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DecodeAuthorizationMessageOutput.
+func (s DecodeAuthorizationMessageOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Identifiers for the federated user that is associated with the credentials.
 type FederatedUser struct {
 	// The ARN that specifies the federated user that is associated with the credentials.
 	// For more information about ARNs and how to use them in policies, see Identifiers
 	// for IAM Entities (http://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html"
 	// target="_blank) in Using IAM.
-	ARN *string `locationName:"Arn" type:"string" required:"true"`
+	ARN *string `locationName:"Arn" type:"string" min:"20" max:"2048" required:"true"`
 
 	// The string that identifies the federated user associated with the credentials,
 	// similar to the unique ID of an IAM user.
-	FederatedUserID *string `locationName:"FederatedUserId" type:"string" required:"true"`
+	FederatedUserID *string `locationName:"FederatedUserId" type:"string" min:"2" max:"96" pattern:"[\\w+=,.@\\:-]*" required:"true"`
 
 	metadataFederatedUser `json:"-", xml:"-"`
 }
@@ -799,6 +850,11 @@ type metadataFederatedUser struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the FederatedUser.
+func (s FederatedUser) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetFederationTokenInput struct {
 	// The duration, in seconds, that the session should last. Acceptable durations
 	// for federation sessions range from 900 seconds (15 minutes) to 129600 seconds
@@ -807,13 +863,13 @@ type GetFederationTokenInput struct {
 	// seconds (one hour). If the specified duration is longer than one hour, the
 	// session obtained by using AWS account (root) credentials defaults to one
 	// hour.
-	DurationSeconds *int64 `type:"integer"`
+	DurationSeconds *int64 `type:"integer" min:"900" max:"129600"`
 
 	// The name of the federated user. The name is used as an identifier for the
 	// temporary security credentials (such as Bob). For example, you can reference
 	// the federated user name in a resource-based policy, such as in an Amazon
 	// S3 bucket policy.
-	Name *string `type:"string" required:"true"`
+	Name *string `type:"string" min:"2" max:"32" pattern:"[\\w+=,.@-]*" required:"true"`
 
 	// An IAM policy in JSON format that is passed with the GetFederationToken call
 	// and evaluated along with the policy or policies that are attached to the
@@ -833,7 +889,7 @@ type GetFederationTokenInput struct {
 	// For more information about how permissions work, see Permissions for GetFederationToken
 	// (http://docs.aws.amazon.com/STS/latest/UsingSTS/permissions-get-federation-token.html)
 	// in Using Temporary Security Credentials.
-	Policy *string `type:"string"`
+	Policy *string `type:"string" min:"1" max:"2048" pattern:"[\\u0009\\u000A\\u000D\\u0020-\\u00FF]+"`
 
 	metadataGetFederationTokenInput `json:"-", xml:"-"`
 }
@@ -842,6 +898,11 @@ type metadataGetFederationTokenInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetFederationTokenInput.
+func (s GetFederationTokenInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the result of a successful call to the GetFederationToken action,
 // including temporary AWS credentials that can be used to make AWS requests.
 type GetFederationTokenOutput struct {
@@ -866,6 +927,11 @@ type metadataGetFederationTokenOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetFederationTokenOutput.
+func (s GetFederationTokenOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetSessionTokenInput struct {
 	// The duration, in seconds, that the credentials should remain valid. Acceptable
 	// durations for IAM user sessions range from 900 seconds (15 minutes) to 129600
@@ -873,7 +939,7 @@ type GetSessionTokenInput struct {
 	// for AWS account owners are restricted to a maximum of 3600 seconds (one hour).
 	// If the duration is longer than one hour, the session for AWS account owners
 	// defaults to one hour.
-	DurationSeconds *int64 `type:"integer"`
+	DurationSeconds *int64 `type:"integer" min:"900" max:"129600"`
 
 	// The identification number of the MFA device that is associated with the IAM
 	// user who is making the GetSessionToken call. Specify this value if the IAM
@@ -882,14 +948,14 @@ type GetSessionTokenInput struct {
 	// Name (ARN) for a virtual device (such as arn:aws:iam::123456789012:mfa/user).
 	// You can find the device for an IAM user by going to the AWS Management Console
 	// and viewing the user's security credentials.
-	SerialNumber *string `type:"string"`
+	SerialNumber *string `type:"string" min:"9" max:"256" pattern:"[\\w+=/:,.@-]*"`
 
 	// The value provided by the MFA device, if MFA is required. If any policy requires
 	// the IAM user to submit an MFA code, specify this value. If MFA authentication
 	// is required, and the user does not provide a code when requesting a set of
 	// temporary security credentials, the user will receive an "access denied"
 	// response when requesting resources that require MFA authentication.
-	TokenCode *string `type:"string"`
+	TokenCode *string `type:"string" min:"6" max:"6" pattern:"[\\d]*"`
 
 	metadataGetSessionTokenInput `json:"-", xml:"-"`
 }
@@ -898,6 +964,11 @@ type metadataGetSessionTokenInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetSessionTokenInput.
+func (s GetSessionTokenInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the result of a successful call to the GetSessionToken action, including
 // temporary AWS credentials that can be used to make AWS requests.
 type GetSessionTokenOutput struct {
@@ -909,4 +980,9 @@ type GetSessionTokenOutput struct {
 
 type metadataGetSessionTokenOutput struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the GetSessionTokenOutput.
+func (s GetSessionTokenOutput) String() string {
+	return awsutil.StringValue(s)
 }
\ No newline at end of file
@@ -5,6 +5,7 @@ package support
 
 import (
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // AddAttachmentsToSetRequest generates a request for the AddAttachmentsToSet operation.
@@ -166,6 +167,11 @@ func (c *Support) DescribeCasesRequest(input *DescribeCasesInput) (req *aws.Requ
 			Name:       "DescribeCases",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextToken"},
+				OutputTokens: []string{"NextToken"},
+				LimitToken:   "MaxResults",
+			},
 		}
 	}
 
@@ -196,6 +202,16 @@ func (c *Support) DescribeCases(input *DescribeCasesInput) (output *DescribeCase
 	return
 }
 
+// DescribeCasesPages iterates over the pages of a DescribeCases operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *Support) DescribeCasesPages(input *DescribeCasesInput, fn func(p *DescribeCasesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeCasesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeCasesOutput), lastPage)
+	})
+}
+
 var opDescribeCases *aws.Operation
 
 // DescribeCommunicationsRequest generates a request for the DescribeCommunications operation.
@@ -205,6 +221,11 @@ func (c *Support) DescribeCommunicationsRequest(input *DescribeCommunicationsInp
 			Name:       "DescribeCommunications",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextToken"},
+				OutputTokens: []string{"NextToken"},
+				LimitToken:   "MaxResults",
+			},
 		}
 	}
 
@@ -231,6 +252,16 @@ func (c *Support) DescribeCommunications(input *DescribeCommunicationsInput) (ou
 	return
 }
 
+// DescribeCommunicationsPages iterates over the pages of a DescribeCommunications operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *Support) DescribeCommunicationsPages(input *DescribeCommunicationsInput, fn func(p *DescribeCommunicationsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.DescribeCommunicationsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*DescribeCommunicationsOutput), lastPage)
+	})
+}
+
 var opDescribeCommunications *aws.Operation
 
 // DescribeServicesRequest generates a request for the DescribeServices operation.
@@ -499,6 +530,11 @@ type metadataAddAttachmentsToSetInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddAttachmentsToSetInput.
+func (s AddAttachmentsToSetInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The ID and expiry time of the attachment set returned by the AddAttachmentsToSet
 // operation.
 type AddAttachmentsToSetOutput struct {
@@ -518,6 +554,11 @@ type metadataAddAttachmentsToSetOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddAttachmentsToSetOutput.
+func (s AddAttachmentsToSetOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // To be written.
 type AddCommunicationToCaseInput struct {
 	// The ID of a set of one or more attachments for the communication to add to
@@ -542,6 +583,11 @@ type metadataAddCommunicationToCaseInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddCommunicationToCaseInput.
+func (s AddCommunicationToCaseInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The result of the AddCommunicationToCase operation.
 type AddCommunicationToCaseOutput struct {
 	// True if AddCommunicationToCase succeeds. Otherwise, returns an error.
@@ -554,6 +600,11 @@ type metadataAddCommunicationToCaseOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AddCommunicationToCaseOutput.
+func (s AddCommunicationToCaseOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // An attachment to a case communication. The attachment consists of the file
 // name and the content of the file.
 type Attachment struct {
@@ -570,6 +621,11 @@ type metadataAttachment struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Attachment.
+func (s Attachment) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The file name and ID of an attachment to a case communication. You can use
 // the ID to retrieve the attachment with the DescribeAttachment operation.
 type AttachmentDetails struct {
@@ -586,6 +642,11 @@ type metadataAttachmentDetails struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the AttachmentDetails.
+func (s AttachmentDetails) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON-formatted object that contains the metadata for a support case. It
 // is contained the response from a DescribeCases request. CaseDetails contains
 // the following fields:
@@ -633,7 +694,7 @@ type CaseDetails struct {
 	RecentCommunications *RecentCaseCommunications `locationName:"recentCommunications" type:"structure"`
 
 	// The code for the AWS service returned by the call to DescribeServices.
-	ServiceCode *string `locationName:"serviceCode" type:"string"`
+	ServiceCode *string `locationName:"serviceCode" type:"string" pattern:"[0-9a-z\\-_]+"`
 
 	// The code for the severity level returned by the call to DescribeSeverityLevels.
 	SeverityCode *string `locationName:"severityCode" type:"string"`
@@ -657,6 +718,11 @@ type metadataCaseDetails struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CaseDetails.
+func (s CaseDetails) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A JSON-formatted name/value pair that represents the category name and category
 // code of the problem, selected from the DescribeServices response for each
 // AWS service.
@@ -674,6 +740,11 @@ type metadataCategory struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Category.
+func (s Category) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A communication associated with an AWS Support case. The communication consists
 // of the case ID, the message body, attachment information, the account email
 // address, and the date and time of the communication.
@@ -701,6 +772,11 @@ type metadataCommunication struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Communication.
+func (s Communication) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CreateCaseInput struct {
 	// The ID of a set of one or more attachments for the case. Create the set by
 	// using AddAttachmentsToSet.
@@ -726,7 +802,7 @@ type CreateCaseInput struct {
 	Language *string `locationName:"language" type:"string"`
 
 	// The code for the AWS service returned by the call to DescribeServices.
-	ServiceCode *string `locationName:"serviceCode" type:"string"`
+	ServiceCode *string `locationName:"serviceCode" type:"string" pattern:"[0-9a-z\\-_]+"`
 
 	// The code for the severity level returned by the call to DescribeSeverityLevels.
 	//
@@ -745,6 +821,11 @@ type metadataCreateCaseInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateCaseInput.
+func (s CreateCaseInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The AWS Support case ID returned by a successful completion of the CreateCase
 // operation.
 type CreateCaseOutput struct {
@@ -759,6 +840,11 @@ type metadataCreateCaseOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CreateCaseOutput.
+func (s CreateCaseOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeAttachmentInput struct {
 	// The ID of the attachment to return. Attachment IDs are returned by the DescribeCommunications
 	// operation.
@@ -771,6 +857,11 @@ type metadataDescribeAttachmentInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeAttachmentInput.
+func (s DescribeAttachmentInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The content and file name of the attachment returned by the DescribeAttachment
 // operation.
 type DescribeAttachmentOutput struct {
@@ -784,6 +875,11 @@ type metadataDescribeAttachmentOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeAttachmentOutput.
+func (s DescribeAttachmentOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeCasesInput struct {
 	// The start date for a filtered date search on support case communications.
 	// Case communications are available for 12 months after creation.
@@ -795,7 +891,7 @@ type DescribeCasesInput struct {
 
 	// A list of ID numbers of the support cases you want returned. The maximum
 	// number of cases is 100.
-	CaseIDList []*string `locationName:"caseIdList" type:"list"`
+	CaseIDList []*string `locationName:"caseIdList" type:"list" max:"100"`
 
 	// The ID displayed for a case in the AWS Support Center user interface.
 	DisplayID *string `locationName:"displayId" type:"string"`
@@ -814,7 +910,7 @@ type DescribeCasesInput struct {
 	Language *string `locationName:"language" type:"string"`
 
 	// The maximum number of results to return before paginating.
-	MaxResults *int64 `locationName:"maxResults" type:"integer"`
+	MaxResults *int64 `locationName:"maxResults" type:"integer" min:"10" max:"100"`
 
 	// A resumption point for pagination.
 	NextToken *string `locationName:"nextToken" type:"string"`
@@ -826,6 +922,11 @@ type metadataDescribeCasesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeCasesInput.
+func (s DescribeCasesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Returns an array of CaseDetails objects and a NextToken that defines a point
 // for pagination in the result set.
 type DescribeCasesOutput struct {
@@ -842,6 +943,11 @@ type metadataDescribeCasesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeCasesOutput.
+func (s DescribeCasesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeCommunicationsInput struct {
 	// The start date for a filtered date search on support case communications.
 	// Case communications are available for 12 months after creation.
@@ -856,7 +962,7 @@ type DescribeCommunicationsInput struct {
 	CaseID *string `locationName:"caseId" type:"string" required:"true"`
 
 	// The maximum number of results to return before paginating.
-	MaxResults *int64 `locationName:"maxResults" type:"integer"`
+	MaxResults *int64 `locationName:"maxResults" type:"integer" min:"10" max:"100"`
 
 	// A resumption point for pagination.
 	NextToken *string `locationName:"nextToken" type:"string"`
@@ -868,6 +974,11 @@ type metadataDescribeCommunicationsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeCommunicationsInput.
+func (s DescribeCommunicationsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The communications returned by the DescribeCommunications operation.
 type DescribeCommunicationsOutput struct {
 	// The communications for the case.
@@ -883,6 +994,11 @@ type metadataDescribeCommunicationsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeCommunicationsOutput.
+func (s DescribeCommunicationsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeServicesInput struct {
 	// The ISO 639-1 code for the language in which AWS provides support. AWS Support
 	// currently supports English ("en") and Japanese ("ja"). Language parameters
@@ -890,7 +1006,7 @@ type DescribeServicesInput struct {
 	Language *string `locationName:"language" type:"string"`
 
 	// A JSON-formatted list of service codes available for AWS services.
-	ServiceCodeList []*string `locationName:"serviceCodeList" type:"list"`
+	ServiceCodeList []*string `locationName:"serviceCodeList" type:"list" max:"100"`
 
 	metadataDescribeServicesInput `json:"-", xml:"-"`
 }
@@ -899,6 +1015,11 @@ type metadataDescribeServicesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeServicesInput.
+func (s DescribeServicesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The list of AWS services returned by the DescribeServices operation.
 type DescribeServicesOutput struct {
 	// A JSON-formatted list of AWS services.
@@ -911,6 +1032,11 @@ type metadataDescribeServicesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeServicesOutput.
+func (s DescribeServicesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeSeverityLevelsInput struct {
 	// The ISO 639-1 code for the language in which AWS provides support. AWS Support
 	// currently supports English ("en") and Japanese ("ja"). Language parameters
@@ -924,6 +1050,11 @@ type metadataDescribeSeverityLevelsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeSeverityLevelsInput.
+func (s DescribeSeverityLevelsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The list of severity levels returned by the DescribeSeverityLevels operation.
 type DescribeSeverityLevelsOutput struct {
 	// The available severity levels for the support case. Available severity levels
@@ -937,6 +1068,11 @@ type metadataDescribeSeverityLevelsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeSeverityLevelsOutput.
+func (s DescribeSeverityLevelsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeTrustedAdvisorCheckRefreshStatusesInput struct {
 	// The IDs of the Trusted Advisor checks.
 	CheckIDs []*string `locationName:"checkIds" type:"list" required:"true"`
@@ -948,6 +1084,11 @@ type metadataDescribeTrustedAdvisorCheckRefreshStatusesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeTrustedAdvisorCheckRefreshStatusesInput.
+func (s DescribeTrustedAdvisorCheckRefreshStatusesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The statuses of the Trusted Advisor checks returned by the DescribeTrustedAdvisorCheckRefreshStatuses
 // operation.
 type DescribeTrustedAdvisorCheckRefreshStatusesOutput struct {
@@ -961,6 +1102,11 @@ type metadataDescribeTrustedAdvisorCheckRefreshStatusesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeTrustedAdvisorCheckRefreshStatusesOutput.
+func (s DescribeTrustedAdvisorCheckRefreshStatusesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeTrustedAdvisorCheckResultInput struct {
 	// The unique identifier for the Trusted Advisor check.
 	CheckID *string `locationName:"checkId" type:"string" required:"true"`
@@ -977,6 +1123,11 @@ type metadataDescribeTrustedAdvisorCheckResultInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeTrustedAdvisorCheckResultInput.
+func (s DescribeTrustedAdvisorCheckResultInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The result of the Trusted Advisor check returned by the DescribeTrustedAdvisorCheckResult
 // operation.
 type DescribeTrustedAdvisorCheckResultOutput struct {
@@ -990,6 +1141,11 @@ type metadataDescribeTrustedAdvisorCheckResultOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeTrustedAdvisorCheckResultOutput.
+func (s DescribeTrustedAdvisorCheckResultOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeTrustedAdvisorCheckSummariesInput struct {
 	// The IDs of the Trusted Advisor checks.
 	CheckIDs []*string `locationName:"checkIds" type:"list" required:"true"`
@@ -1001,6 +1157,11 @@ type metadataDescribeTrustedAdvisorCheckSummariesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeTrustedAdvisorCheckSummariesInput.
+func (s DescribeTrustedAdvisorCheckSummariesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The summaries of the Trusted Advisor checks returned by the DescribeTrustedAdvisorCheckSummaries
 // operation.
 type DescribeTrustedAdvisorCheckSummariesOutput struct {
@@ -1014,6 +1175,11 @@ type metadataDescribeTrustedAdvisorCheckSummariesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeTrustedAdvisorCheckSummariesOutput.
+func (s DescribeTrustedAdvisorCheckSummariesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeTrustedAdvisorChecksInput struct {
 	// The ISO 639-1 code for the language in which AWS provides support. AWS Support
 	// currently supports English ("en") and Japanese ("ja"). Language parameters
@@ -1027,6 +1193,11 @@ type metadataDescribeTrustedAdvisorChecksInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeTrustedAdvisorChecksInput.
+func (s DescribeTrustedAdvisorChecksInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Information about the Trusted Advisor checks returned by the DescribeTrustedAdvisorChecks
 // operation.
 type DescribeTrustedAdvisorChecksOutput struct {
@@ -1040,6 +1211,11 @@ type metadataDescribeTrustedAdvisorChecksOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeTrustedAdvisorChecksOutput.
+func (s DescribeTrustedAdvisorChecksOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The five most recent communications associated with the case.
 type RecentCaseCommunications struct {
 	// The five most recent communications associated with the case.
@@ -1055,6 +1231,11 @@ type metadataRecentCaseCommunications struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RecentCaseCommunications.
+func (s RecentCaseCommunications) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RefreshTrustedAdvisorCheckInput struct {
 	// The unique identifier for the Trusted Advisor check.
 	CheckID *string `locationName:"checkId" type:"string" required:"true"`
@@ -1066,6 +1247,11 @@ type metadataRefreshTrustedAdvisorCheckInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RefreshTrustedAdvisorCheckInput.
+func (s RefreshTrustedAdvisorCheckInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The current refresh status of a Trusted Advisor check.
 type RefreshTrustedAdvisorCheckOutput struct {
 	// The current refresh status for a check, including the amount of time until
@@ -1079,6 +1265,11 @@ type metadataRefreshTrustedAdvisorCheckOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RefreshTrustedAdvisorCheckOutput.
+func (s RefreshTrustedAdvisorCheckOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ResolveCaseInput struct {
 	// The AWS Support case ID requested or returned in the call. The case ID is
 	// an alphanumeric string formatted as shown in this example: case-12345678910-2013-c4c1d2bf33c5cf47
@@ -1091,6 +1282,11 @@ type metadataResolveCaseInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ResolveCaseInput.
+func (s ResolveCaseInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The status of the case returned by the ResolveCase operation.
 type ResolveCaseOutput struct {
 	// The status of the case after the ResolveCase request was processed.
@@ -1106,6 +1302,11 @@ type metadataResolveCaseOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ResolveCaseOutput.
+func (s ResolveCaseOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Information about an AWS service returned by the DescribeServices operation.
 type Service struct {
 	// A list of categories that describe the type of support issue a case describes.
@@ -1115,7 +1316,7 @@ type Service struct {
 
 	// The code for an AWS service returned by the DescribeServices response. The
 	// Name element contains the corresponding friendly name.
-	Code *string `locationName:"code" type:"string"`
+	Code *string `locationName:"code" type:"string" pattern:"[0-9a-z\\-_]+"`
 
 	// The friendly name for an AWS service. The Code element contains the corresponding
 	// code.
@@ -1128,6 +1329,11 @@ type metadataService struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Service.
+func (s Service) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A code and name pair that represent a severity level that can be applied
 // to a support case.
 type SeverityLevel struct {
@@ -1145,6 +1351,11 @@ type metadataSeverityLevel struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SeverityLevel.
+func (s SeverityLevel) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The container for summary information that relates to the category of the
 // Trusted Advisor check.
 type TrustedAdvisorCategorySpecificSummary struct {
@@ -1159,6 +1370,11 @@ type metadataTrustedAdvisorCategorySpecificSummary struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TrustedAdvisorCategorySpecificSummary.
+func (s TrustedAdvisorCategorySpecificSummary) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The description and metadata for a Trusted Advisor check.
 type TrustedAdvisorCheckDescription struct {
 	// The category of the Trusted Advisor check.
@@ -1188,6 +1404,11 @@ type metadataTrustedAdvisorCheckDescription struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TrustedAdvisorCheckDescription.
+func (s TrustedAdvisorCheckDescription) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The refresh status of a Trusted Advisor check.
 type TrustedAdvisorCheckRefreshStatus struct {
 	// The unique identifier for the Trusted Advisor check.
@@ -1208,6 +1429,11 @@ type metadataTrustedAdvisorCheckRefreshStatus struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TrustedAdvisorCheckRefreshStatus.
+func (s TrustedAdvisorCheckRefreshStatus) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The results of a Trusted Advisor check returned by DescribeTrustedAdvisorCheckResult.
 type TrustedAdvisorCheckResult struct {
 	// Summary information that relates to the category of the check. Cost Optimizing
@@ -1238,6 +1464,11 @@ type metadataTrustedAdvisorCheckResult struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TrustedAdvisorCheckResult.
+func (s TrustedAdvisorCheckResult) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A summary of a Trusted Advisor check result, including the alert status,
 // last refresh, and number of resources examined.
 type TrustedAdvisorCheckSummary struct {
@@ -1269,6 +1500,11 @@ type metadataTrustedAdvisorCheckSummary struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TrustedAdvisorCheckSummary.
+func (s TrustedAdvisorCheckSummary) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The estimated cost savings that might be realized if the recommended actions
 // are taken.
 type TrustedAdvisorCostOptimizingSummary struct {
@@ -1287,6 +1523,11 @@ type metadataTrustedAdvisorCostOptimizingSummary struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TrustedAdvisorCostOptimizingSummary.
+func (s TrustedAdvisorCostOptimizingSummary) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains information about a resource identified by a Trusted Advisor check.
 type TrustedAdvisorResourceDetail struct {
 	// Specifies whether the AWS resource was ignored by Trusted Advisor because
@@ -1316,6 +1557,11 @@ type metadataTrustedAdvisorResourceDetail struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TrustedAdvisorResourceDetail.
+func (s TrustedAdvisorResourceDetail) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Details about AWS resources that were analyzed in a call to Trusted Advisor
 // DescribeTrustedAdvisorCheckSummaries.
 type TrustedAdvisorResourcesSummary struct {
@@ -1339,4 +1585,9 @@ type TrustedAdvisorResourcesSummary struct {
 
 type metadataTrustedAdvisorResourcesSummary struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the TrustedAdvisorResourcesSummary.
+func (s TrustedAdvisorResourcesSummary) String() string {
+	return awsutil.StringValue(s)
 }
\ No newline at end of file
@@ -4,9 +4,11 @@
 package swf
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/awslabs/aws-sdk-go/aws"
+	"github.com/awslabs/aws-sdk-go/aws/awsutil"
 )
 
 // CountClosedWorkflowExecutionsRequest generates a request for the CountClosedWorkflowExecutions operation.
@@ -502,6 +504,11 @@ func (c *SWF) GetWorkflowExecutionHistoryRequest(input *GetWorkflowExecutionHist
 			Name:       "GetWorkflowExecutionHistory",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextPageToken"},
+				OutputTokens: []string{"NextPageToken"},
+				LimitToken:   "MaximumPageSize",
+			},
 		}
 	}
 
@@ -536,6 +543,16 @@ func (c *SWF) GetWorkflowExecutionHistory(input *GetWorkflowExecutionHistoryInpu
 	return
 }
 
+// GetWorkflowExecutionHistoryPages iterates over the pages of a GetWorkflowExecutionHistory operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *SWF) GetWorkflowExecutionHistoryPages(input *GetWorkflowExecutionHistoryInput, fn func(p *GetWorkflowExecutionHistoryOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.GetWorkflowExecutionHistoryRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*GetWorkflowExecutionHistoryOutput), lastPage)
+	})
+}
+
 var opGetWorkflowExecutionHistory *aws.Operation
 
 // ListActivityTypesRequest generates a request for the ListActivityTypes operation.
@@ -545,6 +562,11 @@ func (c *SWF) ListActivityTypesRequest(input *ListActivityTypesInput) (req *aws.
 			Name:       "ListActivityTypes",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextPageToken"},
+				OutputTokens: []string{"NextPageToken"},
+				LimitToken:   "MaximumPageSize",
+			},
 		}
 	}
 
@@ -580,6 +602,16 @@ func (c *SWF) ListActivityTypes(input *ListActivityTypesInput) (output *ListActi
 	return
 }
 
+// ListActivityTypesPages iterates over the pages of a ListActivityTypes operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *SWF) ListActivityTypesPages(input *ListActivityTypesInput, fn func(p *ListActivityTypesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListActivityTypesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListActivityTypesOutput), lastPage)
+	})
+}
+
 var opListActivityTypes *aws.Operation
 
 // ListClosedWorkflowExecutionsRequest generates a request for the ListClosedWorkflowExecutions operation.
@@ -589,6 +621,11 @@ func (c *SWF) ListClosedWorkflowExecutionsRequest(input *ListClosedWorkflowExecu
 			Name:       "ListClosedWorkflowExecutions",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextPageToken"},
+				OutputTokens: []string{"NextPageToken"},
+				LimitToken:   "MaximumPageSize",
+			},
 		}
 	}
 
@@ -627,6 +664,16 @@ func (c *SWF) ListClosedWorkflowExecutions(input *ListClosedWorkflowExecutionsIn
 	return
 }
 
+// ListClosedWorkflowExecutionsPages iterates over the pages of a ListClosedWorkflowExecutions operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *SWF) ListClosedWorkflowExecutionsPages(input *ListClosedWorkflowExecutionsInput, fn func(p *WorkflowExecutionInfos, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListClosedWorkflowExecutionsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*WorkflowExecutionInfos), lastPage)
+	})
+}
+
 var opListClosedWorkflowExecutions *aws.Operation
 
 // ListDomainsRequest generates a request for the ListDomains operation.
@@ -636,6 +683,11 @@ func (c *SWF) ListDomainsRequest(input *ListDomainsInput) (req *aws.Request, out
 			Name:       "ListDomains",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextPageToken"},
+				OutputTokens: []string{"NextPageToken"},
+				LimitToken:   "MaximumPageSize",
+			},
 		}
 	}
 
@@ -671,6 +723,16 @@ func (c *SWF) ListDomains(input *ListDomainsInput) (output *ListDomainsOutput, e
 	return
 }
 
+// ListDomainsPages iterates over the pages of a ListDomains operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *SWF) ListDomainsPages(input *ListDomainsInput, fn func(p *ListDomainsOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListDomainsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListDomainsOutput), lastPage)
+	})
+}
+
 var opListDomains *aws.Operation
 
 // ListOpenWorkflowExecutionsRequest generates a request for the ListOpenWorkflowExecutions operation.
@@ -680,6 +742,11 @@ func (c *SWF) ListOpenWorkflowExecutionsRequest(input *ListOpenWorkflowExecution
 			Name:       "ListOpenWorkflowExecutions",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextPageToken"},
+				OutputTokens: []string{"NextPageToken"},
+				LimitToken:   "MaximumPageSize",
+			},
 		}
 	}
 
@@ -718,6 +785,16 @@ func (c *SWF) ListOpenWorkflowExecutions(input *ListOpenWorkflowExecutionsInput)
 	return
 }
 
+// ListOpenWorkflowExecutionsPages iterates over the pages of a ListOpenWorkflowExecutions operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *SWF) ListOpenWorkflowExecutionsPages(input *ListOpenWorkflowExecutionsInput, fn func(p *WorkflowExecutionInfos, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListOpenWorkflowExecutionsRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*WorkflowExecutionInfos), lastPage)
+	})
+}
+
 var opListOpenWorkflowExecutions *aws.Operation
 
 // ListWorkflowTypesRequest generates a request for the ListWorkflowTypes operation.
@@ -727,6 +804,11 @@ func (c *SWF) ListWorkflowTypesRequest(input *ListWorkflowTypesInput) (req *aws.
 			Name:       "ListWorkflowTypes",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextPageToken"},
+				OutputTokens: []string{"NextPageToken"},
+				LimitToken:   "MaximumPageSize",
+			},
 		}
 	}
 
@@ -760,6 +842,16 @@ func (c *SWF) ListWorkflowTypes(input *ListWorkflowTypesInput) (output *ListWork
 	return
 }
 
+// ListWorkflowTypesPages iterates over the pages of a ListWorkflowTypes operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *SWF) ListWorkflowTypesPages(input *ListWorkflowTypesInput, fn func(p *ListWorkflowTypesOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.ListWorkflowTypesRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*ListWorkflowTypesOutput), lastPage)
+	})
+}
+
 var opListWorkflowTypes *aws.Operation
 
 // PollForActivityTaskRequest generates a request for the PollForActivityTask operation.
@@ -819,6 +911,11 @@ func (c *SWF) PollForDecisionTaskRequest(input *PollForDecisionTaskInput) (req *
 			Name:       "PollForDecisionTask",
 			HTTPMethod: "POST",
 			HTTPPath:   "/",
+			Paginator: &aws.Paginator{
+				InputTokens:  []string{"NextPageToken"},
+				OutputTokens: []string{"NextPageToken"},
+				LimitToken:   "MaximumPageSize",
+			},
 		}
 	}
 
@@ -868,6 +965,16 @@ func (c *SWF) PollForDecisionTask(input *PollForDecisionTaskInput) (output *Poll
 	return
 }
 
+// PollForDecisionTaskPages iterates over the pages of a PollForDecisionTask operation,
+// calling fn with the output of each page until fn returns false or the last
+// page has been handled.
+func (c *SWF) PollForDecisionTaskPages(input *PollForDecisionTaskInput, fn func(p *PollForDecisionTaskOutput, lastPage bool) (shouldContinue bool)) error {
+	page, _ := c.PollForDecisionTaskRequest(input)
+	return page.EachPage(func(p interface{}, lastPage bool) bool {
+		return fn(p.(*PollForDecisionTaskOutput), lastPage)
+	})
+}
+
 var opPollForDecisionTask *aws.Operation
 
 // RecordActivityTaskHeartbeatRequest generates a request for the RecordActivityTaskHeartbeat operation.
@@ -1455,7 +1562,7 @@ var opTerminateWorkflowExecution *aws.Operation
 // Provides details of the ActivityTaskCancelRequested event.
 type ActivityTaskCancelRequestedEventAttributes struct {
 	// The unique ID of the task.
-	ActivityID *string `locationName:"activityId" type:"string" required:"true"`
+	ActivityID *string `locationName:"activityId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the RequestCancelActivityTask decision for this cancellation
@@ -1470,10 +1577,15 @@ type metadataActivityTaskCancelRequestedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ActivityTaskCancelRequestedEventAttributes.
+func (s ActivityTaskCancelRequestedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the ActivityTaskCanceled event.
 type ActivityTaskCanceledEventAttributes struct {
 	// Details of the cancellation (if any).
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// If set, contains the Id of the last ActivityTaskCancelRequested event recorded
 	// for this activity task. This information can be useful for diagnosing problems
@@ -1497,10 +1609,15 @@ type metadataActivityTaskCanceledEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ActivityTaskCanceledEventAttributes.
+func (s ActivityTaskCanceledEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the ActivityTaskCompleted event.
 type ActivityTaskCompletedEventAttributes struct {
 	// The results of the activity task (if any).
-	Result *string `locationName:"result" type:"string"`
+	Result *string `locationName:"result" type:"string" max:"32768"`
 
 	// The id of the ActivityTaskScheduled event that was recorded when this activity
 	// task was scheduled. This information can be useful for diagnosing problems
@@ -1519,13 +1636,18 @@ type metadataActivityTaskCompletedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ActivityTaskCompletedEventAttributes.
+func (s ActivityTaskCompletedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the ActivityTaskFailed event.
 type ActivityTaskFailedEventAttributes struct {
 	// The details of the failure (if any).
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// The reason provided for the failure (if any).
-	Reason *string `locationName:"reason" type:"string"`
+	Reason *string `locationName:"reason" type:"string" max:"256"`
 
 	// The id of the ActivityTaskScheduled event that was recorded when this activity
 	// task was scheduled. This information can be useful for diagnosing problems
@@ -1544,17 +1666,22 @@ type metadataActivityTaskFailedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ActivityTaskFailedEventAttributes.
+func (s ActivityTaskFailedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the ActivityTaskScheduled event.
 type ActivityTaskScheduledEventAttributes struct {
 	// The unique id of the activity task.
-	ActivityID *string `locationName:"activityId" type:"string" required:"true"`
+	ActivityID *string `locationName:"activityId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The type of the activity task.
 	ActivityType *ActivityType `locationName:"activityType" type:"structure" required:"true"`
 
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// workflow tasks. This data is not sent to the activity.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision that
 	// resulted in the scheduling of this activity task. This information can be
@@ -1566,20 +1693,20 @@ type ActivityTaskScheduledEventAttributes struct {
 	// progress by calling RecordActivityTaskHeartbeat. If the timeout is exceeded,
 	// the activity task is automatically timed out. If the worker subsequently
 	// attempts to record a heartbeat or return a result, it will be ignored.
-	HeartbeatTimeout *string `locationName:"heartbeatTimeout" type:"string"`
+	HeartbeatTimeout *string `locationName:"heartbeatTimeout" type:"string" max:"8"`
 
 	// The input provided to the activity task.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The maximum amount of time for this activity task.
-	ScheduleToCloseTimeout *string `locationName:"scheduleToCloseTimeout" type:"string"`
+	ScheduleToCloseTimeout *string `locationName:"scheduleToCloseTimeout" type:"string" max:"8"`
 
 	// The maximum amount of time the activity task can wait to be assigned to a
 	// worker.
-	ScheduleToStartTimeout *string `locationName:"scheduleToStartTimeout" type:"string"`
+	ScheduleToStartTimeout *string `locationName:"scheduleToStartTimeout" type:"string" max:"8"`
 
 	// The maximum amount of time a worker may take to process the activity task.
-	StartToCloseTimeout *string `locationName:"startToCloseTimeout" type:"string"`
+	StartToCloseTimeout *string `locationName:"startToCloseTimeout" type:"string" max:"8"`
 
 	// The task list in which the activity task has been scheduled.
 	TaskList *TaskList `locationName:"taskList" type:"structure" required:"true"`
@@ -1595,7 +1722,7 @@ type ActivityTaskScheduledEventAttributes struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	metadataActivityTaskScheduledEventAttributes `json:"-", xml:"-"`
 }
@@ -1604,11 +1731,16 @@ type metadataActivityTaskScheduledEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ActivityTaskScheduledEventAttributes.
+func (s ActivityTaskScheduledEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the ActivityTaskStarted event.
 type ActivityTaskStartedEventAttributes struct {
 	// Identity of the worker that was assigned this task. This aids diagnostics
 	// when problems arise. The form of this identity is user defined.
-	Identity *string `locationName:"identity" type:"string"`
+	Identity *string `locationName:"identity" type:"string" max:"256"`
 
 	// The id of the ActivityTaskScheduled event that was recorded when this activity
 	// task was scheduled. This information can be useful for diagnosing problems
@@ -1622,11 +1754,16 @@ type metadataActivityTaskStartedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ActivityTaskStartedEventAttributes.
+func (s ActivityTaskStartedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the ActivityTaskTimedOut event.
 type ActivityTaskTimedOutEventAttributes struct {
 	// Contains the content of the details parameter for the last call made by the
 	// activity to RecordActivityTaskHeartbeat.
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"2048"`
 
 	// The id of the ActivityTaskScheduled event that was recorded when this activity
 	// task was scheduled. This information can be useful for diagnosing problems
@@ -1639,7 +1776,7 @@ type ActivityTaskTimedOutEventAttributes struct {
 	StartedEventID *int64 `locationName:"startedEventId" type:"long" required:"true"`
 
 	// The type of the timeout that caused this event.
-	TimeoutType *string `locationName:"timeoutType" type:"string" required:"true"`
+	TimeoutType *string `locationName:"timeoutType" type:"string" enum:"START_TO_CLOSE,SCHEDULE_TO_START,SCHEDULE_TO_CLOSE,HEARTBEAT" required:"true"`
 
 	metadataActivityTaskTimedOutEventAttributes `json:"-", xml:"-"`
 }
@@ -1648,19 +1785,62 @@ type metadataActivityTaskTimedOutEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ActivityTaskTimedOutEventAttributes.
+func (s ActivityTaskTimedOutEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ActivityTaskTimeoutType is an enum of the values of ActivityTaskTimeoutType members.
+type ActivityTaskTimeoutType string
+
+// The values of ActivityTaskTimeoutType.
+const (
+	ActivityTaskTimeoutTypeSTARTTOCLOSE    ActivityTaskTimeoutType = "START_TO_CLOSE"
+	ActivityTaskTimeoutTypeSCHEDULETOSTART ActivityTaskTimeoutType = "SCHEDULE_TO_START"
+	ActivityTaskTimeoutTypeSCHEDULETOCLOSE ActivityTaskTimeoutType = "SCHEDULE_TO_CLOSE"
+	ActivityTaskTimeoutTypeHEARTBEAT       ActivityTaskTimeoutType = "HEARTBEAT"
+)
+
+// ActivityTaskTimeoutTypeValues returns the values of ActivityTaskTimeoutType known to this
+// version of the SDK. The service may accept values added since.
+func ActivityTaskTimeoutTypeValues() []ActivityTaskTimeoutType {
+	return []ActivityTaskTimeoutType{
+		ActivityTaskTimeoutType("START_TO_CLOSE"),
+		ActivityTaskTimeoutType("SCHEDULE_TO_START"),
+		ActivityTaskTimeoutType("SCHEDULE_TO_CLOSE"),
+		ActivityTaskTimeoutType("HEARTBEAT"),
+	}
+}
+
+// String returns the ActivityTaskTimeoutType as the string of a member.
+func (v ActivityTaskTimeoutType) String() string {
+	return string(v)
+}
+
+// ParseActivityTaskTimeoutType returns the ActivityTaskTimeoutType of str, failing if it is not
+// one of the ActivityTaskTimeoutTypeValues.
+func ParseActivityTaskTimeoutType(str string) (ActivityTaskTimeoutType, error) {
+	for _, v := range ActivityTaskTimeoutTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ActivityTaskTimeoutType value %q", str)
+}
+
 // Represents an activity type.
 type ActivityType struct {
 	// The name of this activity.
 	//
 	// The combination of activity type name and version must be unique within
 	// a domain.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	// The version of this activity.
 	//
 	// The combination of activity type name and version must be unique with in
 	// a domain.
-	Version *string `locationName:"version" type:"string" required:"true"`
+	Version *string `locationName:"version" type:"string" min:"1" max:"64" required:"true"`
 
 	metadataActivityType `json:"-", xml:"-"`
 }
@@ -1669,6 +1849,11 @@ type metadataActivityType struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ActivityType.
+func (s ActivityType) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Configuration settings registered with the activity type.
 type ActivityTypeConfiguration struct {
 	// Optional. The default maximum time, in seconds, before which a worker processing
@@ -1683,7 +1868,7 @@ type ActivityTypeConfiguration struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskHeartbeatTimeout *string `locationName:"defaultTaskHeartbeatTimeout" type:"string"`
+	DefaultTaskHeartbeatTimeout *string `locationName:"defaultTaskHeartbeatTimeout" type:"string" max:"8"`
 
 	// Optional. The default task list specified for this activity type at registration.
 	// This default is used if a task list is not provided when a task is scheduled
@@ -1701,7 +1886,7 @@ type ActivityTypeConfiguration struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	DefaultTaskPriority *string `locationName:"defaultTaskPriority" type:"string"`
+	DefaultTaskPriority *string `locationName:"defaultTaskPriority" type:"string" max:"11"`
 
 	// Optional. The default maximum duration, specified when registering the activity
 	// type, for tasks of this activity type. You can override this default when
@@ -1709,7 +1894,7 @@ type ActivityTypeConfiguration struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskScheduleToCloseTimeout *string `locationName:"defaultTaskScheduleToCloseTimeout" type:"string"`
+	DefaultTaskScheduleToCloseTimeout *string `locationName:"defaultTaskScheduleToCloseTimeout" type:"string" max:"8"`
 
 	// Optional. The default maximum duration, specified when registering the activity
 	// type, that a task of an activity type can wait before being assigned to a
@@ -1718,7 +1903,7 @@ type ActivityTypeConfiguration struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskScheduleToStartTimeout *string `locationName:"defaultTaskScheduleToStartTimeout" type:"string"`
+	DefaultTaskScheduleToStartTimeout *string `locationName:"defaultTaskScheduleToStartTimeout" type:"string" max:"8"`
 
 	// Optional. The default maximum duration for tasks of an activity type specified
 	// when registering the activity type. You can override this default when scheduling
@@ -1726,7 +1911,7 @@ type ActivityTypeConfiguration struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskStartToCloseTimeout *string `locationName:"defaultTaskStartToCloseTimeout" type:"string"`
+	DefaultTaskStartToCloseTimeout *string `locationName:"defaultTaskStartToCloseTimeout" type:"string" max:"8"`
 
 	metadataActivityTypeConfiguration `json:"-", xml:"-"`
 }
@@ -1735,6 +1920,11 @@ type metadataActivityTypeConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ActivityTypeConfiguration.
+func (s ActivityTypeConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Detailed information about an activity type.
 type ActivityTypeInfo struct {
 	// The ActivityType type structure representing the activity type.
@@ -1747,10 +1937,10 @@ type ActivityTypeInfo struct {
 	DeprecationDate *time.Time `locationName:"deprecationDate" type:"timestamp" timestampFormat:"unix"`
 
 	// The description of the activity type provided in RegisterActivityType.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" max:"1024"`
 
 	// The current status of the activity type.
-	Status *string `locationName:"status" type:"string" required:"true"`
+	Status *string `locationName:"status" type:"string" enum:"REGISTERED,DEPRECATED" required:"true"`
 
 	metadataActivityTypeInfo `json:"-", xml:"-"`
 }
@@ -1759,6 +1949,11 @@ type metadataActivityTypeInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ActivityTypeInfo.
+func (s ActivityTypeInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the CancelTimer decision.
 //
 // Access Control
@@ -1776,7 +1971,7 @@ type metadataActivityTypeInfo struct {
 // SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
 type CancelTimerDecisionAttributes struct {
 	// Required. The unique Id of the timer to cancel.
-	TimerID *string `locationName:"timerId" type:"string" required:"true"`
+	TimerID *string `locationName:"timerId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataCancelTimerDecisionAttributes `json:"-", xml:"-"`
 }
@@ -1785,6 +1980,45 @@ type metadataCancelTimerDecisionAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CancelTimerDecisionAttributes.
+func (s CancelTimerDecisionAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// CancelTimerFailedCause is an enum of the values of CancelTimerFailedCause members.
+type CancelTimerFailedCause string
+
+// The values of CancelTimerFailedCause.
+const (
+	CancelTimerFailedCauseTIMERIDUNKNOWN        CancelTimerFailedCause = "TIMER_ID_UNKNOWN"
+	CancelTimerFailedCauseOPERATIONNOTPERMITTED CancelTimerFailedCause = "OPERATION_NOT_PERMITTED"
+)
+
+// CancelTimerFailedCauseValues returns the values of CancelTimerFailedCause known to this
+// version of the SDK. The service may accept values added since.
+func CancelTimerFailedCauseValues() []CancelTimerFailedCause {
+	return []CancelTimerFailedCause{
+		CancelTimerFailedCause("TIMER_ID_UNKNOWN"),
+		CancelTimerFailedCause("OPERATION_NOT_PERMITTED"),
+	}
+}
+
+// String returns the CancelTimerFailedCause as the string of a member.
+func (v CancelTimerFailedCause) String() string {
+	return string(v)
+}
+
+// ParseCancelTimerFailedCause returns the CancelTimerFailedCause of str, failing if it is not
+// one of the CancelTimerFailedCauseValues.
+func ParseCancelTimerFailedCause(str string) (CancelTimerFailedCause, error) {
+	for _, v := range CancelTimerFailedCauseValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid CancelTimerFailedCause value %q", str)
+}
+
 // Provides details of the CancelTimerFailed event.
 type CancelTimerFailedEventAttributes struct {
 	// The cause of the failure. This information is generated by the system and
@@ -1793,7 +2027,7 @@ type CancelTimerFailedEventAttributes struct {
 	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
 	// it lacked sufficient permissions. For details and example IAM policies, see
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+	Cause *string `locationName:"cause" type:"string" enum:"TIMER_ID_UNKNOWN,OPERATION_NOT_PERMITTED" required:"true"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the CancelTimer decision to cancel this timer. This information
@@ -1802,7 +2036,7 @@ type CancelTimerFailedEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// The timerId provided in the CancelTimer decision that failed.
-	TimerID *string `locationName:"timerId" type:"string" required:"true"`
+	TimerID *string `locationName:"timerId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataCancelTimerFailedEventAttributes `json:"-", xml:"-"`
 }
@@ -1811,6 +2045,11 @@ type metadataCancelTimerFailedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CancelTimerFailedEventAttributes.
+func (s CancelTimerFailedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the CancelWorkflowExecution decision.
 //
 // Access Control
@@ -1828,7 +2067,7 @@ type metadataCancelTimerFailedEventAttributes struct {
 // SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
 type CancelWorkflowExecutionDecisionAttributes struct {
 	// Optional. details of the cancellation.
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	metadataCancelWorkflowExecutionDecisionAttributes `json:"-", xml:"-"`
 }
@@ -1837,6 +2076,45 @@ type metadataCancelWorkflowExecutionDecisionAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CancelWorkflowExecutionDecisionAttributes.
+func (s CancelWorkflowExecutionDecisionAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// CancelWorkflowExecutionFailedCause is an enum of the values of CancelWorkflowExecutionFailedCause members.
+type CancelWorkflowExecutionFailedCause string
+
+// The values of CancelWorkflowExecutionFailedCause.
+const (
+	CancelWorkflowExecutionFailedCauseUNHANDLEDDECISION     CancelWorkflowExecutionFailedCause = "UNHANDLED_DECISION"
+	CancelWorkflowExecutionFailedCauseOPERATIONNOTPERMITTED CancelWorkflowExecutionFailedCause = "OPERATION_NOT_PERMITTED"
+)
+
+// CancelWorkflowExecutionFailedCauseValues returns the values of CancelWorkflowExecutionFailedCause known to this
+// version of the SDK. The service may accept values added since.
+func CancelWorkflowExecutionFailedCauseValues() []CancelWorkflowExecutionFailedCause {
+	return []CancelWorkflowExecutionFailedCause{
+		CancelWorkflowExecutionFailedCause("UNHANDLED_DECISION"),
+		CancelWorkflowExecutionFailedCause("OPERATION_NOT_PERMITTED"),
+	}
+}
+
+// String returns the CancelWorkflowExecutionFailedCause as the string of a member.
+func (v CancelWorkflowExecutionFailedCause) String() string {
+	return string(v)
+}
+
+// ParseCancelWorkflowExecutionFailedCause returns the CancelWorkflowExecutionFailedCause of str, failing if it is not
+// one of the CancelWorkflowExecutionFailedCauseValues.
+func ParseCancelWorkflowExecutionFailedCause(str string) (CancelWorkflowExecutionFailedCause, error) {
+	for _, v := range CancelWorkflowExecutionFailedCauseValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid CancelWorkflowExecutionFailedCause value %q", str)
+}
+
 // Provides details of the CancelWorkflowExecutionFailed event.
 type CancelWorkflowExecutionFailedEventAttributes struct {
 	// The cause of the failure. This information is generated by the system and
@@ -1845,7 +2123,7 @@ type CancelWorkflowExecutionFailedEventAttributes struct {
 	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
 	// it lacked sufficient permissions. For details and example IAM policies, see
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+	Cause *string `locationName:"cause" type:"string" enum:"UNHANDLED_DECISION,OPERATION_NOT_PERMITTED" required:"true"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the CancelWorkflowExecution decision for this cancellation
@@ -1860,10 +2138,51 @@ type metadataCancelWorkflowExecutionFailedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CancelWorkflowExecutionFailedEventAttributes.
+func (s CancelWorkflowExecutionFailedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ChildPolicy is an enum of the values of ChildPolicy members.
+type ChildPolicy string
+
+// The values of ChildPolicy.
+const (
+	ChildPolicyTERMINATE     ChildPolicy = "TERMINATE"
+	ChildPolicyREQUESTCANCEL ChildPolicy = "REQUEST_CANCEL"
+	ChildPolicyABANDON       ChildPolicy = "ABANDON"
+)
+
+// ChildPolicyValues returns the values of ChildPolicy known to this
+// version of the SDK. The service may accept values added since.
+func ChildPolicyValues() []ChildPolicy {
+	return []ChildPolicy{
+		ChildPolicy("TERMINATE"),
+		ChildPolicy("REQUEST_CANCEL"),
+		ChildPolicy("ABANDON"),
+	}
+}
+
+// String returns the ChildPolicy as the string of a member.
+func (v ChildPolicy) String() string {
+	return string(v)
+}
+
+// ParseChildPolicy returns the ChildPolicy of str, failing if it is not
+// one of the ChildPolicyValues.
+func ParseChildPolicy(str string) (ChildPolicy, error) {
+	for _, v := range ChildPolicyValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ChildPolicy value %q", str)
+}
+
 // Provide details of the ChildWorkflowExecutionCanceled event.
 type ChildWorkflowExecutionCanceledEventAttributes struct {
 	// Details of the cancellation (if provided).
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// The id of the StartChildWorkflowExecutionInitiated event corresponding to
 	// the StartChildWorkflowExecution Decision to start this child workflow execution.
@@ -1889,6 +2208,11 @@ type metadataChildWorkflowExecutionCanceledEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ChildWorkflowExecutionCanceledEventAttributes.
+func (s ChildWorkflowExecutionCanceledEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the ChildWorkflowExecutionCompleted event.
 type ChildWorkflowExecutionCompletedEventAttributes struct {
 	// The id of the StartChildWorkflowExecutionInitiated event corresponding to
@@ -1898,7 +2222,7 @@ type ChildWorkflowExecutionCompletedEventAttributes struct {
 	InitiatedEventID *int64 `locationName:"initiatedEventId" type:"long" required:"true"`
 
 	// The result of the child workflow execution (if any).
-	Result *string `locationName:"result" type:"string"`
+	Result *string `locationName:"result" type:"string" max:"32768"`
 
 	// The Id of the ChildWorkflowExecutionStarted event recorded when this child
 	// workflow execution was started. This information can be useful for diagnosing
@@ -1918,10 +2242,15 @@ type metadataChildWorkflowExecutionCompletedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ChildWorkflowExecutionCompletedEventAttributes.
+func (s ChildWorkflowExecutionCompletedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the ChildWorkflowExecutionFailed event.
 type ChildWorkflowExecutionFailedEventAttributes struct {
 	// The details of the failure (if provided).
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// The id of the StartChildWorkflowExecutionInitiated event corresponding to
 	// the StartChildWorkflowExecution Decision to start this child workflow execution.
@@ -1930,7 +2259,7 @@ type ChildWorkflowExecutionFailedEventAttributes struct {
 	InitiatedEventID *int64 `locationName:"initiatedEventId" type:"long" required:"true"`
 
 	// The reason for the failure (if provided).
-	Reason *string `locationName:"reason" type:"string"`
+	Reason *string `locationName:"reason" type:"string" max:"256"`
 
 	// The Id of the ChildWorkflowExecutionStarted event recorded when this child
 	// workflow execution was started. This information can be useful for diagnosing
@@ -1950,6 +2279,11 @@ type metadataChildWorkflowExecutionFailedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ChildWorkflowExecutionFailedEventAttributes.
+func (s ChildWorkflowExecutionFailedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the ChildWorkflowExecutionStarted event.
 type ChildWorkflowExecutionStartedEventAttributes struct {
 	// The id of the StartChildWorkflowExecutionInitiated event corresponding to
@@ -1971,6 +2305,11 @@ type metadataChildWorkflowExecutionStartedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ChildWorkflowExecutionStartedEventAttributes.
+func (s ChildWorkflowExecutionStartedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the ChildWorkflowExecutionTerminated event.
 type ChildWorkflowExecutionTerminatedEventAttributes struct {
 	// The id of the StartChildWorkflowExecutionInitiated event corresponding to
@@ -1997,6 +2336,11 @@ type metadataChildWorkflowExecutionTerminatedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ChildWorkflowExecutionTerminatedEventAttributes.
+func (s ChildWorkflowExecutionTerminatedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the ChildWorkflowExecutionTimedOut event.
 type ChildWorkflowExecutionTimedOutEventAttributes struct {
 	// The id of the StartChildWorkflowExecutionInitiated event corresponding to
@@ -2012,7 +2356,7 @@ type ChildWorkflowExecutionTimedOutEventAttributes struct {
 
 	// The type of the timeout that caused the child workflow execution to time
 	// out.
-	TimeoutType *string `locationName:"timeoutType" type:"string" required:"true"`
+	TimeoutType *string `locationName:"timeoutType" type:"string" enum:"START_TO_CLOSE" required:"true"`
 
 	// The child workflow execution that timed out.
 	WorkflowExecution *WorkflowExecution `locationName:"workflowExecution" type:"structure" required:"true"`
@@ -2027,12 +2371,59 @@ type metadataChildWorkflowExecutionTimedOutEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ChildWorkflowExecutionTimedOutEventAttributes.
+func (s ChildWorkflowExecutionTimedOutEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// CloseStatus is an enum of the values of CloseStatus members.
+type CloseStatus string
+
+// The values of CloseStatus.
+const (
+	CloseStatusCOMPLETED      CloseStatus = "COMPLETED"
+	CloseStatusFAILED         CloseStatus = "FAILED"
+	CloseStatusCANCELED       CloseStatus = "CANCELED"
+	CloseStatusTERMINATED     CloseStatus = "TERMINATED"
+	CloseStatusCONTINUEDASNEW CloseStatus = "CONTINUED_AS_NEW"
+	CloseStatusTIMEDOUT       CloseStatus = "TIMED_OUT"
+)
+
+// CloseStatusValues returns the values of CloseStatus known to this
+// version of the SDK. The service may accept values added since.
+func CloseStatusValues() []CloseStatus {
+	return []CloseStatus{
+		CloseStatus("COMPLETED"),
+		CloseStatus("FAILED"),
+		CloseStatus("CANCELED"),
+		CloseStatus("TERMINATED"),
+		CloseStatus("CONTINUED_AS_NEW"),
+		CloseStatus("TIMED_OUT"),
+	}
+}
+
+// String returns the CloseStatus as the string of a member.
+func (v CloseStatus) String() string {
+	return string(v)
+}
+
+// ParseCloseStatus returns the CloseStatus of str, failing if it is not
+// one of the CloseStatusValues.
+func ParseCloseStatus(str string) (CloseStatus, error) {
+	for _, v := range CloseStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid CloseStatus value %q", str)
+}
+
 // Used to filter the closed workflow executions in visibility APIs by their
 // close status.
 type CloseStatusFilter struct {
 	// Required. The close status that must match the close status of an execution
 	// for it to meet the criteria of this filter.
-	Status *string `locationName:"status" type:"string" required:"true"`
+	Status *string `locationName:"status" type:"string" enum:"COMPLETED,FAILED,CANCELED,TERMINATED,CONTINUED_AS_NEW,TIMED_OUT" required:"true"`
 
 	metadataCloseStatusFilter `json:"-", xml:"-"`
 }
@@ -2041,6 +2432,11 @@ type metadataCloseStatusFilter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CloseStatusFilter.
+func (s CloseStatusFilter) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the CompleteWorkflowExecution decision.
 //
 // Access Control
@@ -2059,7 +2455,7 @@ type metadataCloseStatusFilter struct {
 type CompleteWorkflowExecutionDecisionAttributes struct {
 	// The result of the workflow execution. The form of the result is implementation
 	// defined.
-	Result *string `locationName:"result" type:"string"`
+	Result *string `locationName:"result" type:"string" max:"32768"`
 
 	metadataCompleteWorkflowExecutionDecisionAttributes `json:"-", xml:"-"`
 }
@@ -2068,6 +2464,45 @@ type metadataCompleteWorkflowExecutionDecisionAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CompleteWorkflowExecutionDecisionAttributes.
+func (s CompleteWorkflowExecutionDecisionAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// CompleteWorkflowExecutionFailedCause is an enum of the values of CompleteWorkflowExecutionFailedCause members.
+type CompleteWorkflowExecutionFailedCause string
+
+// The values of CompleteWorkflowExecutionFailedCause.
+const (
+	CompleteWorkflowExecutionFailedCauseUNHANDLEDDECISION     CompleteWorkflowExecutionFailedCause = "UNHANDLED_DECISION"
+	CompleteWorkflowExecutionFailedCauseOPERATIONNOTPERMITTED CompleteWorkflowExecutionFailedCause = "OPERATION_NOT_PERMITTED"
+)
+
+// CompleteWorkflowExecutionFailedCauseValues returns the values of CompleteWorkflowExecutionFailedCause known to this
+// version of the SDK. The service may accept values added since.
+func CompleteWorkflowExecutionFailedCauseValues() []CompleteWorkflowExecutionFailedCause {
+	return []CompleteWorkflowExecutionFailedCause{
+		CompleteWorkflowExecutionFailedCause("UNHANDLED_DECISION"),
+		CompleteWorkflowExecutionFailedCause("OPERATION_NOT_PERMITTED"),
+	}
+}
+
+// String returns the CompleteWorkflowExecutionFailedCause as the string of a member.
+func (v CompleteWorkflowExecutionFailedCause) String() string {
+	return string(v)
+}
+
+// ParseCompleteWorkflowExecutionFailedCause returns the CompleteWorkflowExecutionFailedCause of str, failing if it is not
+// one of the CompleteWorkflowExecutionFailedCauseValues.
+func ParseCompleteWorkflowExecutionFailedCause(str string) (CompleteWorkflowExecutionFailedCause, error) {
+	for _, v := range CompleteWorkflowExecutionFailedCauseValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid CompleteWorkflowExecutionFailedCause value %q", str)
+}
+
 // Provides details of the CompleteWorkflowExecutionFailed event.
 type CompleteWorkflowExecutionFailedEventAttributes struct {
 	// The cause of the failure. This information is generated by the system and
@@ -2076,7 +2511,7 @@ type CompleteWorkflowExecutionFailedEventAttributes struct {
 	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
 	// it lacked sufficient permissions. For details and example IAM policies, see
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+	Cause *string `locationName:"cause" type:"string" enum:"UNHANDLED_DECISION,OPERATION_NOT_PERMITTED" required:"true"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the CompleteWorkflowExecution decision to complete this
@@ -2091,6 +2526,11 @@ type metadataCompleteWorkflowExecutionFailedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CompleteWorkflowExecutionFailedEventAttributes.
+func (s CompleteWorkflowExecutionFailedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the ContinueAsNewWorkflowExecution decision.
 //
 // Access Control
@@ -2126,7 +2566,7 @@ type ContinueAsNewWorkflowExecutionDecisionAttributes struct {
 	// workflow type or through this parameter. If neither this parameter is set
 	// nor a default child policy was specified at registration time then a fault
 	// will be returned.
-	ChildPolicy *string `locationName:"childPolicy" type:"string"`
+	ChildPolicy *string `locationName:"childPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON"`
 
 	// If set, specifies the total duration for this workflow execution. This overrides
 	// the defaultExecutionStartToCloseTimeout specified when registering the workflow
@@ -2139,16 +2579,16 @@ type ContinueAsNewWorkflowExecutionDecisionAttributes struct {
 	// specified either as a default for the workflow type or through this field.
 	// If neither this field is set nor a default execution start-to-close timeout
 	// was specified at registration time then a fault will be returned.
-	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string"`
+	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string" max:"8"`
 
 	// The input provided to the new workflow execution.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The list of tags to associate with the new workflow execution. A maximum
 	// of 5 tags can be specified. You can list workflow executions with a specific
 	// tag by calling ListOpenWorkflowExecutions or ListClosedWorkflowExecutions
 	// and specifying a TagFilter.
-	TagList []*string `locationName:"tagList" type:"list"`
+	TagList []*string `locationName:"tagList" type:"list" max:"5"`
 
 	// Represents a task list.
 	TaskList *TaskList `locationName:"taskList" type:"structure"`
@@ -2162,7 +2602,7 @@ type ContinueAsNewWorkflowExecutionDecisionAttributes struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	// Specifies the maximum duration of decision tasks for the new workflow execution.
 	// This parameter overrides the defaultTaskStartToCloseTimout specified when
@@ -2175,9 +2615,9 @@ type ContinueAsNewWorkflowExecutionDecisionAttributes struct {
 	// either as a default for the workflow type or through this parameter. If neither
 	// this parameter is set nor a default task start-to-close timeout was specified
 	// at registration time then a fault will be returned.
-	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string"`
+	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string" max:"8"`
 
-	WorkflowTypeVersion *string `locationName:"workflowTypeVersion" type:"string"`
+	WorkflowTypeVersion *string `locationName:"workflowTypeVersion" type:"string" min:"1" max:"64"`
 
 	metadataContinueAsNewWorkflowExecutionDecisionAttributes `json:"-", xml:"-"`
 }
@@ -2186,6 +2626,59 @@ type metadataContinueAsNewWorkflowExecutionDecisionAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ContinueAsNewWorkflowExecutionDecisionAttributes.
+func (s ContinueAsNewWorkflowExecutionDecisionAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ContinueAsNewWorkflowExecutionFailedCause is an enum of the values of ContinueAsNewWorkflowExecutionFailedCause members.
+type ContinueAsNewWorkflowExecutionFailedCause string
+
+// The values of ContinueAsNewWorkflowExecutionFailedCause.
+const (
+	ContinueAsNewWorkflowExecutionFailedCauseUNHANDLEDDECISION                            ContinueAsNewWorkflowExecutionFailedCause = "UNHANDLED_DECISION"
+	ContinueAsNewWorkflowExecutionFailedCauseWORKFLOWTYPEDEPRECATED                       ContinueAsNewWorkflowExecutionFailedCause = "WORKFLOW_TYPE_DEPRECATED"
+	ContinueAsNewWorkflowExecutionFailedCauseWORKFLOWTYPEDOESNOTEXIST                     ContinueAsNewWorkflowExecutionFailedCause = "WORKFLOW_TYPE_DOES_NOT_EXIST"
+	ContinueAsNewWorkflowExecutionFailedCauseDEFAULTEXECUTIONSTARTTOCLOSETIMEOUTUNDEFINED ContinueAsNewWorkflowExecutionFailedCause = "DEFAULT_EXECUTION_START_TO_CLOSE_TIMEOUT_UNDEFINED"
+	ContinueAsNewWorkflowExecutionFailedCauseDEFAULTTASKSTARTTOCLOSETIMEOUTUNDEFINED      ContinueAsNewWorkflowExecutionFailedCause = "DEFAULT_TASK_START_TO_CLOSE_TIMEOUT_UNDEFINED"
+	ContinueAsNewWorkflowExecutionFailedCauseDEFAULTTASKLISTUNDEFINED                     ContinueAsNewWorkflowExecutionFailedCause = "DEFAULT_TASK_LIST_UNDEFINED"
+	ContinueAsNewWorkflowExecutionFailedCauseDEFAULTCHILDPOLICYUNDEFINED                  ContinueAsNewWorkflowExecutionFailedCause = "DEFAULT_CHILD_POLICY_UNDEFINED"
+	ContinueAsNewWorkflowExecutionFailedCauseCONTINUEASNEWWORKFLOWEXECUTIONRATEEXCEEDED   ContinueAsNewWorkflowExecutionFailedCause = "CONTINUE_AS_NEW_WORKFLOW_EXECUTION_RATE_EXCEEDED"
+	ContinueAsNewWorkflowExecutionFailedCauseOPERATIONNOTPERMITTED                        ContinueAsNewWorkflowExecutionFailedCause = "OPERATION_NOT_PERMITTED"
+)
+
+// ContinueAsNewWorkflowExecutionFailedCauseValues returns the values of ContinueAsNewWorkflowExecutionFailedCause known to this
+// version of the SDK. The service may accept values added since.
+func ContinueAsNewWorkflowExecutionFailedCauseValues() []ContinueAsNewWorkflowExecutionFailedCause {
+	return []ContinueAsNewWorkflowExecutionFailedCause{
+		ContinueAsNewWorkflowExecutionFailedCause("UNHANDLED_DECISION"),
+		ContinueAsNewWorkflowExecutionFailedCause("WORKFLOW_TYPE_DEPRECATED"),
+		ContinueAsNewWorkflowExecutionFailedCause("WORKFLOW_TYPE_DOES_NOT_EXIST"),
+		ContinueAsNewWorkflowExecutionFailedCause("DEFAULT_EXECUTION_START_TO_CLOSE_TIMEOUT_UNDEFINED"),
+		ContinueAsNewWorkflowExecutionFailedCause("DEFAULT_TASK_START_TO_CLOSE_TIMEOUT_UNDEFINED"),
+		ContinueAsNewWorkflowExecutionFailedCause("DEFAULT_TASK_LIST_UNDEFINED"),
+		ContinueAsNewWorkflowExecutionFailedCause("DEFAULT_CHILD_POLICY_UNDEFINED"),
+		ContinueAsNewWorkflowExecutionFailedCause("CONTINUE_AS_NEW_WORKFLOW_EXECUTION_RATE_EXCEEDED"),
+		ContinueAsNewWorkflowExecutionFailedCause("OPERATION_NOT_PERMITTED"),
+	}
+}
+
+// String returns the ContinueAsNewWorkflowExecutionFailedCause as the string of a member.
+func (v ContinueAsNewWorkflowExecutionFailedCause) String() string {
+	return string(v)
+}
+
+// ParseContinueAsNewWorkflowExecutionFailedCause returns the ContinueAsNewWorkflowExecutionFailedCause of str, failing if it is not
+// one of the ContinueAsNewWorkflowExecutionFailedCauseValues.
+func ParseContinueAsNewWorkflowExecutionFailedCause(str string) (ContinueAsNewWorkflowExecutionFailedCause, error) {
+	for _, v := range ContinueAsNewWorkflowExecutionFailedCauseValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ContinueAsNewWorkflowExecutionFailedCause value %q", str)
+}
+
 // Provides details of the ContinueAsNewWorkflowExecutionFailed event.
 type ContinueAsNewWorkflowExecutionFailedEventAttributes struct {
 	// The cause of the failure. This information is generated by the system and
@@ -2194,7 +2687,7 @@ type ContinueAsNewWorkflowExecutionFailedEventAttributes struct {
 	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
 	// it lacked sufficient permissions. For details and example IAM policies, see
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+	Cause *string `locationName:"cause" type:"string" enum:"UNHANDLED_DECISION,WORKFLOW_TYPE_DEPRECATED,WORKFLOW_TYPE_DOES_NOT_EXIST,DEFAULT_EXECUTION_START_TO_CLOSE_TIMEOUT_UNDEFINED,DEFAULT_TASK_START_TO_CLOSE_TIMEOUT_UNDEFINED,DEFAULT_TASK_LIST_UNDEFINED,DEFAULT_CHILD_POLICY_UNDEFINED,CONTINUE_AS_NEW_WORKFLOW_EXECUTION_RATE_EXCEEDED,OPERATION_NOT_PERMITTED" required:"true"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the ContinueAsNewWorkflowExecution decision that started
@@ -2209,6 +2702,11 @@ type metadataContinueAsNewWorkflowExecutionFailedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ContinueAsNewWorkflowExecutionFailedEventAttributes.
+func (s ContinueAsNewWorkflowExecutionFailedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CountClosedWorkflowExecutionsInput struct {
 	// If specified, only workflow executions that match this close status are counted.
 	// This filter has an affect only if executionStatus is specified as CLOSED.
@@ -2225,7 +2723,7 @@ type CountClosedWorkflowExecutionsInput struct {
 	CloseTimeFilter *ExecutionTimeFilter `locationName:"closeTimeFilter" type:"structure"`
 
 	// The name of the domain containing the workflow executions to count.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// If specified, only workflow executions matching the WorkflowId in the filter
 	// are counted.
@@ -2261,9 +2759,14 @@ type metadataCountClosedWorkflowExecutionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CountClosedWorkflowExecutionsInput.
+func (s CountClosedWorkflowExecutionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CountOpenWorkflowExecutionsInput struct {
 	// The name of the domain containing the workflow executions to count.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// If specified, only workflow executions matching the WorkflowId in the filter
 	// are counted.
@@ -2296,9 +2799,14 @@ type metadataCountOpenWorkflowExecutionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CountOpenWorkflowExecutionsInput.
+func (s CountOpenWorkflowExecutionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CountPendingActivityTasksInput struct {
 	// The name of the domain that contains the task list.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The name of the task list.
 	TaskList *TaskList `locationName:"taskList" type:"structure" required:"true"`
@@ -2310,9 +2818,14 @@ type metadataCountPendingActivityTasksInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CountPendingActivityTasksInput.
+func (s CountPendingActivityTasksInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type CountPendingDecisionTasksInput struct {
 	// The name of the domain that contains the task list.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The name of the task list.
 	TaskList *TaskList `locationName:"taskList" type:"structure" required:"true"`
@@ -2324,6 +2837,11 @@ type metadataCountPendingDecisionTasksInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the CountPendingDecisionTasksInput.
+func (s CountPendingDecisionTasksInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Specifies a decision made by the decider. A decision can be one of these
 // types:
 //
@@ -2445,7 +2963,7 @@ type Decision struct {
 	ContinueAsNewWorkflowExecutionDecisionAttributes *ContinueAsNewWorkflowExecutionDecisionAttributes `locationName:"continueAsNewWorkflowExecutionDecisionAttributes" type:"structure"`
 
 	// Specifies the type of the decision.
-	DecisionType *string `locationName:"decisionType" type:"string" required:"true"`
+	DecisionType *string `locationName:"decisionType" type:"string" enum:"ScheduleActivityTask,RequestCancelActivityTask,CompleteWorkflowExecution,FailWorkflowExecution,CancelWorkflowExecution,ContinueAsNewWorkflowExecution,RecordMarker,StartTimer,CancelTimer,SignalExternalWorkflowExecution,RequestCancelExternalWorkflowExecution,StartChildWorkflowExecution" required:"true"`
 
 	// Provides details of the FailWorkflowExecution decision. It is not set for
 	// other decision types.
@@ -2486,10 +3004,15 @@ type metadataDecision struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the Decision.
+func (s Decision) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the DecisionTaskCompleted event.
 type DecisionTaskCompletedEventAttributes struct {
 	// User defined context for the workflow execution.
-	ExecutionContext *string `locationName:"executionContext" type:"string"`
+	ExecutionContext *string `locationName:"executionContext" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskScheduled event that was recorded when this decision
 	// task was scheduled. This information can be useful for diagnosing problems
@@ -2508,6 +3031,11 @@ type metadataDecisionTaskCompletedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DecisionTaskCompletedEventAttributes.
+func (s DecisionTaskCompletedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details about the DecisionTaskScheduled event.
 type DecisionTaskScheduledEventAttributes struct {
 	// The maximum duration for this decision task. The task is considered timed
@@ -2515,7 +3043,7 @@ type DecisionTaskScheduledEventAttributes struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	StartToCloseTimeout *string `locationName:"startToCloseTimeout" type:"string"`
+	StartToCloseTimeout *string `locationName:"startToCloseTimeout" type:"string" max:"8"`
 
 	// The name of the task list in which the decision task was scheduled.
 	TaskList *TaskList `locationName:"taskList" type:"structure" required:"true"`
@@ -2528,7 +3056,7 @@ type DecisionTaskScheduledEventAttributes struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	metadataDecisionTaskScheduledEventAttributes `json:"-", xml:"-"`
 }
@@ -2537,11 +3065,16 @@ type metadataDecisionTaskScheduledEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DecisionTaskScheduledEventAttributes.
+func (s DecisionTaskScheduledEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the DecisionTaskStarted event.
 type DecisionTaskStartedEventAttributes struct {
 	// Identity of the decider making the request. This enables diagnostic tracing
 	// when problems arise. The form of this identity is user defined.
-	Identity *string `locationName:"identity" type:"string"`
+	Identity *string `locationName:"identity" type:"string" max:"256"`
 
 	// The id of the DecisionTaskScheduled event that was recorded when this decision
 	// task was scheduled. This information can be useful for diagnosing problems
@@ -2555,6 +3088,11 @@ type metadataDecisionTaskStartedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DecisionTaskStartedEventAttributes.
+func (s DecisionTaskStartedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the DecisionTaskTimedOut event.
 type DecisionTaskTimedOutEventAttributes struct {
 	// The id of the DecisionTaskScheduled event that was recorded when this decision
@@ -2568,7 +3106,7 @@ type DecisionTaskTimedOutEventAttributes struct {
 	StartedEventID *int64 `locationName:"startedEventId" type:"long" required:"true"`
 
 	// The type of timeout that expired before the decision task could be completed.
-	TimeoutType *string `locationName:"timeoutType" type:"string" required:"true"`
+	TimeoutType *string `locationName:"timeoutType" type:"string" enum:"START_TO_CLOSE" required:"true"`
 
 	metadataDecisionTaskTimedOutEventAttributes `json:"-", xml:"-"`
 }
@@ -2577,12 +3115,103 @@ type metadataDecisionTaskTimedOutEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DecisionTaskTimedOutEventAttributes.
+func (s DecisionTaskTimedOutEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// DecisionTaskTimeoutType is an enum of the values of DecisionTaskTimeoutType members.
+type DecisionTaskTimeoutType string
+
+// The values of DecisionTaskTimeoutType.
+const (
+	DecisionTaskTimeoutTypeSTARTTOCLOSE DecisionTaskTimeoutType = "START_TO_CLOSE"
+)
+
+// DecisionTaskTimeoutTypeValues returns the values of DecisionTaskTimeoutType known to this
+// version of the SDK. The service may accept values added since.
+func DecisionTaskTimeoutTypeValues() []DecisionTaskTimeoutType {
+	return []DecisionTaskTimeoutType{
+		DecisionTaskTimeoutType("START_TO_CLOSE"),
+	}
+}
+
+// String returns the DecisionTaskTimeoutType as the string of a member.
+func (v DecisionTaskTimeoutType) String() string {
+	return string(v)
+}
+
+// ParseDecisionTaskTimeoutType returns the DecisionTaskTimeoutType of str, failing if it is not
+// one of the DecisionTaskTimeoutTypeValues.
+func ParseDecisionTaskTimeoutType(str string) (DecisionTaskTimeoutType, error) {
+	for _, v := range DecisionTaskTimeoutTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DecisionTaskTimeoutType value %q", str)
+}
+
+// DecisionType is an enum of the values of DecisionType members.
+type DecisionType string
+
+// The values of DecisionType.
+const (
+	DecisionTypeScheduleActivityTask                   DecisionType = "ScheduleActivityTask"
+	DecisionTypeRequestCancelActivityTask              DecisionType = "RequestCancelActivityTask"
+	DecisionTypeCompleteWorkflowExecution              DecisionType = "CompleteWorkflowExecution"
+	DecisionTypeFailWorkflowExecution                  DecisionType = "FailWorkflowExecution"
+	DecisionTypeCancelWorkflowExecution                DecisionType = "CancelWorkflowExecution"
+	DecisionTypeContinueAsNewWorkflowExecution         DecisionType = "ContinueAsNewWorkflowExecution"
+	DecisionTypeRecordMarker                           DecisionType = "RecordMarker"
+	DecisionTypeStartTimer                             DecisionType = "StartTimer"
+	DecisionTypeCancelTimer                            DecisionType = "CancelTimer"
+	DecisionTypeSignalExternalWorkflowExecution        DecisionType = "SignalExternalWorkflowExecution"
+	DecisionTypeRequestCancelExternalWorkflowExecution DecisionType = "RequestCancelExternalWorkflowExecution"
+	DecisionTypeStartChildWorkflowExecution            DecisionType = "StartChildWorkflowExecution"
+)
+
+// DecisionTypeValues returns the values of DecisionType known to this
+// version of the SDK. The service may accept values added since.
+func DecisionTypeValues() []DecisionType {
+	return []DecisionType{
+		DecisionType("ScheduleActivityTask"),
+		DecisionType("RequestCancelActivityTask"),
+		DecisionType("CompleteWorkflowExecution"),
+		DecisionType("FailWorkflowExecution"),
+		DecisionType("CancelWorkflowExecution"),
+		DecisionType("ContinueAsNewWorkflowExecution"),
+		DecisionType("RecordMarker"),
+		DecisionType("StartTimer"),
+		DecisionType("CancelTimer"),
+		DecisionType("SignalExternalWorkflowExecution"),
+		DecisionType("RequestCancelExternalWorkflowExecution"),
+		DecisionType("StartChildWorkflowExecution"),
+	}
+}
+
+// String returns the DecisionType as the string of a member.
+func (v DecisionType) String() string {
+	return string(v)
+}
+
+// ParseDecisionType returns the DecisionType of str, failing if it is not
+// one of the DecisionTypeValues.
+func ParseDecisionType(str string) (DecisionType, error) {
+	for _, v := range DecisionTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DecisionType value %q", str)
+}
+
 type DeprecateActivityTypeInput struct {
 	// The activity type to deprecate.
 	ActivityType *ActivityType `locationName:"activityType" type:"structure" required:"true"`
 
 	// The name of the domain in which the activity type is registered.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDeprecateActivityTypeInput `json:"-", xml:"-"`
 }
@@ -2591,6 +3220,11 @@ type metadataDeprecateActivityTypeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeprecateActivityTypeInput.
+func (s DeprecateActivityTypeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeprecateActivityTypeOutput struct {
 	metadataDeprecateActivityTypeOutput `json:"-", xml:"-"`
 }
@@ -2599,9 +3233,14 @@ type metadataDeprecateActivityTypeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeprecateActivityTypeOutput.
+func (s DeprecateActivityTypeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeprecateDomainInput struct {
 	// The name of the domain to deprecate.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDeprecateDomainInput `json:"-", xml:"-"`
 }
@@ -2610,6 +3249,11 @@ type metadataDeprecateDomainInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeprecateDomainInput.
+func (s DeprecateDomainInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeprecateDomainOutput struct {
 	metadataDeprecateDomainOutput `json:"-", xml:"-"`
 }
@@ -2618,9 +3262,14 @@ type metadataDeprecateDomainOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeprecateDomainOutput.
+func (s DeprecateDomainOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeprecateWorkflowTypeInput struct {
 	// The name of the domain in which the workflow type is registered.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The workflow type to deprecate.
 	WorkflowType *WorkflowType `locationName:"workflowType" type:"structure" required:"true"`
@@ -2632,6 +3281,11 @@ type metadataDeprecateWorkflowTypeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeprecateWorkflowTypeInput.
+func (s DeprecateWorkflowTypeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DeprecateWorkflowTypeOutput struct {
 	metadataDeprecateWorkflowTypeOutput `json:"-", xml:"-"`
 }
@@ -2640,13 +3294,18 @@ type metadataDeprecateWorkflowTypeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DeprecateWorkflowTypeOutput.
+func (s DeprecateWorkflowTypeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeActivityTypeInput struct {
 	// The activity type to get information about. Activity types are identified
 	// by the name and version that were supplied when the activity was registered.
 	ActivityType *ActivityType `locationName:"activityType" type:"structure" required:"true"`
 
 	// The name of the domain in which the activity type is registered.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDescribeActivityTypeInput `json:"-", xml:"-"`
 }
@@ -2655,6 +3314,11 @@ type metadataDescribeActivityTypeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeActivityTypeInput.
+func (s DescribeActivityTypeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Detailed information about an activity type.
 type DescribeActivityTypeOutput struct {
 	// The configuration settings registered with the activity type.
@@ -2678,9 +3342,14 @@ type metadataDescribeActivityTypeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeActivityTypeOutput.
+func (s DescribeActivityTypeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeDomainInput struct {
 	// The name of the domain to describe.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataDescribeDomainInput `json:"-", xml:"-"`
 }
@@ -2689,6 +3358,11 @@ type metadataDescribeDomainInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeDomainInput.
+func (s DescribeDomainInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains details of a domain.
 type DescribeDomainOutput struct {
 	// Contains the configuration settings of a domain.
@@ -2704,9 +3378,14 @@ type metadataDescribeDomainOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeDomainOutput.
+func (s DescribeDomainOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeWorkflowExecutionInput struct {
 	// The name of the domain containing the workflow execution.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The workflow execution to describe.
 	Execution *WorkflowExecution `locationName:"execution" type:"structure" required:"true"`
@@ -2718,6 +3397,11 @@ type metadataDescribeWorkflowExecutionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeWorkflowExecutionInput.
+func (s DescribeWorkflowExecutionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains details about a workflow execution.
 type DescribeWorkflowExecutionOutput struct {
 	// The configuration settings for this workflow execution including timeout
@@ -2735,7 +3419,7 @@ type DescribeWorkflowExecutionOutput struct {
 	// The latest executionContext provided by the decider for this workflow execution.
 	// A decider can provide an executionContext (a free-form string) when closing
 	// a decision task using RespondDecisionTaskCompleted.
-	LatestExecutionContext *string `locationName:"latestExecutionContext" type:"string"`
+	LatestExecutionContext *string `locationName:"latestExecutionContext" type:"string" max:"32768"`
 
 	// The number of tasks for this workflow execution. This includes open and closed
 	// tasks of all types.
@@ -2748,9 +3432,14 @@ type metadataDescribeWorkflowExecutionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeWorkflowExecutionOutput.
+func (s DescribeWorkflowExecutionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type DescribeWorkflowTypeInput struct {
 	// The name of the domain in which this workflow type is registered.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The workflow type to describe.
 	WorkflowType *WorkflowType `locationName:"workflowType" type:"structure" required:"true"`
@@ -2762,6 +3451,11 @@ type metadataDescribeWorkflowTypeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeWorkflowTypeInput.
+func (s DescribeWorkflowTypeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains details about a workflow type.
 type DescribeWorkflowTypeOutput struct {
 	// Configuration settings of the workflow type registered through RegisterWorkflowType
@@ -2785,10 +3479,15 @@ type metadataDescribeWorkflowTypeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DescribeWorkflowTypeOutput.
+func (s DescribeWorkflowTypeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the configuration settings of a domain.
 type DomainConfiguration struct {
 	// The retention period for workflow executions in this domain.
-	WorkflowExecutionRetentionPeriodInDays *string `locationName:"workflowExecutionRetentionPeriodInDays" type:"string" required:"true"`
+	WorkflowExecutionRetentionPeriodInDays *string `locationName:"workflowExecutionRetentionPeriodInDays" type:"string" min:"1" max:"8" required:"true"`
 
 	metadataDomainConfiguration `json:"-", xml:"-"`
 }
@@ -2797,13 +3496,18 @@ type metadataDomainConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DomainConfiguration.
+func (s DomainConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains general information about a domain.
 type DomainInfo struct {
 	// The description of the domain provided through RegisterDomain.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" max:"1024"`
 
 	// The name of the domain. This name is unique within the account.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	// The status of the domain:
 	//
@@ -2811,7 +3515,7 @@ type DomainInfo struct {
 	// this domain for registering types and creating new workflow executions.
 	//  DEPRECATED: The domain was deprecated using DeprecateDomain, but is still
 	// in use. You should not create new workflow executions in this domain.
-	Status *string `locationName:"status" type:"string" required:"true"`
+	Status *string `locationName:"status" type:"string" enum:"REGISTERED,DEPRECATED" required:"true"`
 
 	metadataDomainInfo `json:"-", xml:"-"`
 }
@@ -2820,6 +3524,169 @@ type metadataDomainInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the DomainInfo.
+func (s DomainInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
+// EventType is an enum of the values of EventType members.
+type EventType string
+
+// The values of EventType.
+const (
+	EventTypeWorkflowExecutionStarted                        EventType = "WorkflowExecutionStarted"
+	EventTypeWorkflowExecutionCancelRequested                EventType = "WorkflowExecutionCancelRequested"
+	EventTypeWorkflowExecutionCompleted                      EventType = "WorkflowExecutionCompleted"
+	EventTypeCompleteWorkflowExecutionFailed                 EventType = "CompleteWorkflowExecutionFailed"
+	EventTypeWorkflowExecutionFailed                         EventType = "WorkflowExecutionFailed"
+	EventTypeFailWorkflowExecutionFailed                     EventType = "FailWorkflowExecutionFailed"
+	EventTypeWorkflowExecutionTimedOut                       EventType = "WorkflowExecutionTimedOut"
+	EventTypeWorkflowExecutionCanceled                       EventType = "WorkflowExecutionCanceled"
+	EventTypeCancelWorkflowExecutionFailed                   EventType = "CancelWorkflowExecutionFailed"
+	EventTypeWorkflowExecutionContinuedAsNew                 EventType = "WorkflowExecutionContinuedAsNew"
+	EventTypeContinueAsNewWorkflowExecutionFailed            EventType = "ContinueAsNewWorkflowExecutionFailed"
+	EventTypeWorkflowExecutionTerminated                     EventType = "WorkflowExecutionTerminated"
+	EventTypeDecisionTaskScheduled                           EventType = "DecisionTaskScheduled"
+	EventTypeDecisionTaskStarted                             EventType = "DecisionTaskStarted"
+	EventTypeDecisionTaskCompleted                           EventType = "DecisionTaskCompleted"
+	EventTypeDecisionTaskTimedOut                            EventType = "DecisionTaskTimedOut"
+	EventTypeActivityTaskScheduled                           EventType = "ActivityTaskScheduled"
+	EventTypeScheduleActivityTaskFailed                      EventType = "ScheduleActivityTaskFailed"
+	EventTypeActivityTaskStarted                             EventType = "ActivityTaskStarted"
+	EventTypeActivityTaskCompleted                           EventType = "ActivityTaskCompleted"
+	EventTypeActivityTaskFailed                              EventType = "ActivityTaskFailed"
+	EventTypeActivityTaskTimedOut                            EventType = "ActivityTaskTimedOut"
+	EventTypeActivityTaskCanceled                            EventType = "ActivityTaskCanceled"
+	EventTypeActivityTaskCancelRequested                     EventType = "ActivityTaskCancelRequested"
+	EventTypeRequestCancelActivityTaskFailed                 EventType = "RequestCancelActivityTaskFailed"
+	EventTypeWorkflowExecutionSignaled                       EventType = "WorkflowExecutionSignaled"
+	EventTypeMarkerRecorded                                  EventType = "MarkerRecorded"
+	EventTypeRecordMarkerFailed                              EventType = "RecordMarkerFailed"
+	EventTypeTimerStarted                                    EventType = "TimerStarted"
+	EventTypeStartTimerFailed                                EventType = "StartTimerFailed"
+	EventTypeTimerFired                                      EventType = "TimerFired"
+	EventTypeTimerCanceled                                   EventType = "TimerCanceled"
+	EventTypeCancelTimerFailed                               EventType = "CancelTimerFailed"
+	EventTypeStartChildWorkflowExecutionInitiated            EventType = "StartChildWorkflowExecutionInitiated"
+	EventTypeStartChildWorkflowExecutionFailed               EventType = "StartChildWorkflowExecutionFailed"
+	EventTypeChildWorkflowExecutionStarted                   EventType = "ChildWorkflowExecutionStarted"
+	EventTypeChildWorkflowExecutionCompleted                 EventType = "ChildWorkflowExecutionCompleted"
+	EventTypeChildWorkflowExecutionFailed                    EventType = "ChildWorkflowExecutionFailed"
+	EventTypeChildWorkflowExecutionTimedOut                  EventType = "ChildWorkflowExecutionTimedOut"
+	EventTypeChildWorkflowExecutionCanceled                  EventType = "ChildWorkflowExecutionCanceled"
+	EventTypeChildWorkflowExecutionTerminated                EventType = "ChildWorkflowExecutionTerminated"
+	EventTypeSignalExternalWorkflowExecutionInitiated        EventType = "SignalExternalWorkflowExecutionInitiated"
+	EventTypeSignalExternalWorkflowExecutionFailed           EventType = "SignalExternalWorkflowExecutionFailed"
+	EventTypeExternalWorkflowExecutionSignaled               EventType = "ExternalWorkflowExecutionSignaled"
+	EventTypeRequestCancelExternalWorkflowExecutionInitiated EventType = "RequestCancelExternalWorkflowExecutionInitiated"
+	EventTypeRequestCancelExternalWorkflowExecutionFailed    EventType = "RequestCancelExternalWorkflowExecutionFailed"
+	EventTypeExternalWorkflowExecutionCancelRequested        EventType = "ExternalWorkflowExecutionCancelRequested"
+)
+
+// EventTypeValues returns the values of EventType known to this
+// version of the SDK. The service may accept values added since.
+func EventTypeValues() []EventType {
+	return []EventType{
+		EventType("WorkflowExecutionStarted"),
+		EventType("WorkflowExecutionCancelRequested"),
+		EventType("WorkflowExecutionCompleted"),
+		EventType("CompleteWorkflowExecutionFailed"),
+		EventType("WorkflowExecutionFailed"),
+		EventType("FailWorkflowExecutionFailed"),
+		EventType("WorkflowExecutionTimedOut"),
+		EventType("WorkflowExecutionCanceled"),
+		EventType("CancelWorkflowExecutionFailed"),
+		EventType("WorkflowExecutionContinuedAsNew"),
+		EventType("ContinueAsNewWorkflowExecutionFailed"),
+		EventType("WorkflowExecutionTerminated"),
+		EventType("DecisionTaskScheduled"),
+		EventType("DecisionTaskStarted"),
+		EventType("DecisionTaskCompleted"),
+		EventType("DecisionTaskTimedOut"),
+		EventType("ActivityTaskScheduled"),
+		EventType("ScheduleActivityTaskFailed"),
+		EventType("ActivityTaskStarted"),
+		EventType("ActivityTaskCompleted"),
+		EventType("ActivityTaskFailed"),
+		EventType("ActivityTaskTimedOut"),
+		EventType("ActivityTaskCanceled"),
+		EventType("ActivityTaskCancelRequested"),
+		EventType("RequestCancelActivityTaskFailed"),
+		EventType("WorkflowExecutionSignaled"),
+		EventType("MarkerRecorded"),
+		EventType("RecordMarkerFailed"),
+		EventType("TimerStarted"),
+		EventType("StartTimerFailed"),
+		EventType("TimerFired"),
+		EventType("TimerCanceled"),
+		EventType("CancelTimerFailed"),
+		EventType("StartChildWorkflowExecutionInitiated"),
+		EventType("StartChildWorkflowExecutionFailed"),
+		EventType("ChildWorkflowExecutionStarted"),
+		EventType("ChildWorkflowExecutionCompleted"),
+		EventType("ChildWorkflowExecutionFailed"),
+		EventType("ChildWorkflowExecutionTimedOut"),
+		EventType("ChildWorkflowExecutionCanceled"),
+		EventType("ChildWorkflowExecutionTerminated"),
+		EventType("SignalExternalWorkflowExecutionInitiated"),
+		EventType("SignalExternalWorkflowExecutionFailed"),
+		EventType("ExternalWorkflowExecutionSignaled"),
+		EventType("RequestCancelExternalWorkflowExecutionInitiated"),
+		EventType("RequestCancelExternalWorkflowExecutionFailed"),
+		EventType("ExternalWorkflowExecutionCancelRequested"),
+	}
+}
+
+// String returns the EventType as the string of a member.
+func (v EventType) String() string {
+	return string(v)
+}
+
+// ParseEventType returns the EventType of str, failing if it is not
+// one of the EventTypeValues.
+func ParseEventType(str string) (EventType, error) {
+	for _, v := range EventTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid EventType value %q", str)
+}
+
+// ExecutionStatus is an enum of the values of ExecutionStatus members.
+type ExecutionStatus string
+
+// The values of ExecutionStatus.
+const (
+	ExecutionStatusOPEN   ExecutionStatus = "OPEN"
+	ExecutionStatusCLOSED ExecutionStatus = "CLOSED"
+)
+
+// ExecutionStatusValues returns the values of ExecutionStatus known to this
+// version of the SDK. The service may accept values added since.
+func ExecutionStatusValues() []ExecutionStatus {
+	return []ExecutionStatus{
+		ExecutionStatus("OPEN"),
+		ExecutionStatus("CLOSED"),
+	}
+}
+
+// String returns the ExecutionStatus as the string of a member.
+func (v ExecutionStatus) String() string {
+	return string(v)
+}
+
+// ParseExecutionStatus returns the ExecutionStatus of str, failing if it is not
+// one of the ExecutionStatusValues.
+func ParseExecutionStatus(str string) (ExecutionStatus, error) {
+	for _, v := range ExecutionStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ExecutionStatus value %q", str)
+}
+
 // Used to filter the workflow executions in visibility APIs by various time-based
 // rules. Each parameter, if specified, defines a rule that must be satisfied
 // by each returned query result. The parameter values are in the Unix Time
@@ -2839,6 +3706,11 @@ type metadataExecutionTimeFilter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ExecutionTimeFilter.
+func (s ExecutionTimeFilter) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the ExternalWorkflowExecutionCancelRequested event.
 type ExternalWorkflowExecutionCancelRequestedEventAttributes struct {
 	// The id of the RequestCancelExternalWorkflowExecutionInitiated event corresponding
@@ -2857,6 +3729,11 @@ type metadataExternalWorkflowExecutionCancelRequestedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ExternalWorkflowExecutionCancelRequestedEventAttributes.
+func (s ExternalWorkflowExecutionCancelRequestedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the ExternalWorkflowExecutionSignaled event.
 type ExternalWorkflowExecutionSignaledEventAttributes struct {
 	// The id of the SignalExternalWorkflowExecutionInitiated event corresponding
@@ -2875,6 +3752,11 @@ type metadataExternalWorkflowExecutionSignaledEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ExternalWorkflowExecutionSignaledEventAttributes.
+func (s ExternalWorkflowExecutionSignaledEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the FailWorkflowExecution decision.
 //
 // Access Control
@@ -2892,10 +3774,10 @@ type metadataExternalWorkflowExecutionSignaledEventAttributes struct {
 // SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
 type FailWorkflowExecutionDecisionAttributes struct {
 	// Optional. Details of the failure.
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// A descriptive reason for the failure that may help in diagnostics.
-	Reason *string `locationName:"reason" type:"string"`
+	Reason *string `locationName:"reason" type:"string" max:"256"`
 
 	metadataFailWorkflowExecutionDecisionAttributes `json:"-", xml:"-"`
 }
@@ -2904,17 +3786,56 @@ type metadataFailWorkflowExecutionDecisionAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
-// Provides details of the FailWorkflowExecutionFailed event.
-type FailWorkflowExecutionFailedEventAttributes struct {
-	// The cause of the failure. This information is generated by the system and
-	// can be useful for diagnostic purposes.
-	//
-	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
-	// it lacked sufficient permissions. For details and example IAM policies, see
-	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+// String returns the string representation of the FailWorkflowExecutionDecisionAttributes.
+func (s FailWorkflowExecutionDecisionAttributes) String() string {
+	return awsutil.StringValue(s)
+}
 
-	// The id of the DecisionTaskCompleted event corresponding to the decision task
+// FailWorkflowExecutionFailedCause is an enum of the values of FailWorkflowExecutionFailedCause members.
+type FailWorkflowExecutionFailedCause string
+
+// The values of FailWorkflowExecutionFailedCause.
+const (
+	FailWorkflowExecutionFailedCauseUNHANDLEDDECISION     FailWorkflowExecutionFailedCause = "UNHANDLED_DECISION"
+	FailWorkflowExecutionFailedCauseOPERATIONNOTPERMITTED FailWorkflowExecutionFailedCause = "OPERATION_NOT_PERMITTED"
+)
+
+// FailWorkflowExecutionFailedCauseValues returns the values of FailWorkflowExecutionFailedCause known to this
+// version of the SDK. The service may accept values added since.
+func FailWorkflowExecutionFailedCauseValues() []FailWorkflowExecutionFailedCause {
+	return []FailWorkflowExecutionFailedCause{
+		FailWorkflowExecutionFailedCause("UNHANDLED_DECISION"),
+		FailWorkflowExecutionFailedCause("OPERATION_NOT_PERMITTED"),
+	}
+}
+
+// String returns the FailWorkflowExecutionFailedCause as the string of a member.
+func (v FailWorkflowExecutionFailedCause) String() string {
+	return string(v)
+}
+
+// ParseFailWorkflowExecutionFailedCause returns the FailWorkflowExecutionFailedCause of str, failing if it is not
+// one of the FailWorkflowExecutionFailedCauseValues.
+func ParseFailWorkflowExecutionFailedCause(str string) (FailWorkflowExecutionFailedCause, error) {
+	for _, v := range FailWorkflowExecutionFailedCauseValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid FailWorkflowExecutionFailedCause value %q", str)
+}
+
+// Provides details of the FailWorkflowExecutionFailed event.
+type FailWorkflowExecutionFailedEventAttributes struct {
+	// The cause of the failure. This information is generated by the system and
+	// can be useful for diagnostic purposes.
+	//
+	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
+	// it lacked sufficient permissions. For details and example IAM policies, see
+	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
+	Cause *string `locationName:"cause" type:"string" enum:"UNHANDLED_DECISION,OPERATION_NOT_PERMITTED" required:"true"`
+
+	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the FailWorkflowExecution decision to fail this execution.
 	// This information can be useful for diagnosing problems by tracing back the
 	// chain of events leading up to this event.
@@ -2927,9 +3848,14 @@ type metadataFailWorkflowExecutionFailedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the FailWorkflowExecutionFailedEventAttributes.
+func (s FailWorkflowExecutionFailedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 type GetWorkflowExecutionHistoryInput struct {
 	// The name of the domain containing the workflow execution.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// Specifies the workflow execution for which to return the history.
 	Execution *WorkflowExecution `locationName:"execution" type:"structure" required:"true"`
@@ -2941,7 +3867,7 @@ type GetWorkflowExecutionHistoryInput struct {
 	//
 	// This is an upper limit only; the actual number of results returned per call
 	// may be fewer than the specified maximum.
-	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer"`
+	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer" max:"1000"`
 
 	// If a NextPageToken was returned by a previous call, there are more results
 	// available. To retrieve the next page of results, make the call again using
@@ -2949,7 +3875,7 @@ type GetWorkflowExecutionHistoryInput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// When set to true, returns the events in reverse order. By default the results
 	// are returned in ascending order of the eventTimeStamp of the events.
@@ -2962,6 +3888,11 @@ type metadataGetWorkflowExecutionHistoryInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetWorkflowExecutionHistoryInput.
+func (s GetWorkflowExecutionHistoryInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Paginated representation of a workflow history for a workflow execution.
 // This is the up to date, complete and authoritative record of the events related
 // to all tasks and events in the life of the workflow execution.
@@ -2975,7 +3906,7 @@ type GetWorkflowExecutionHistoryOutput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	metadataGetWorkflowExecutionHistoryOutput `json:"-", xml:"-"`
 }
@@ -2984,6 +3915,11 @@ type metadataGetWorkflowExecutionHistoryOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the GetWorkflowExecutionHistoryOutput.
+func (s GetWorkflowExecutionHistoryOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Event within a workflow execution. A history event can be one of these types:
 //
 //   WorkflowExecutionStarted: The workflow execution was started.  WorkflowExecutionCompleted:
@@ -3153,7 +4089,7 @@ type HistoryEvent struct {
 	EventTimestamp *time.Time `locationName:"eventTimestamp" type:"timestamp" timestampFormat:"unix" required:"true"`
 
 	// The type of the history event.
-	EventType *string `locationName:"eventType" type:"string" required:"true"`
+	EventType *string `locationName:"eventType" type:"string" enum:"WorkflowExecutionStarted,WorkflowExecutionCancelRequested,WorkflowExecutionCompleted,CompleteWorkflowExecutionFailed,WorkflowExecutionFailed,FailWorkflowExecutionFailed,WorkflowExecutionTimedOut,WorkflowExecutionCanceled,CancelWorkflowExecutionFailed,WorkflowExecutionContinuedAsNew,ContinueAsNewWorkflowExecutionFailed,WorkflowExecutionTerminated,DecisionTaskScheduled,DecisionTaskStarted,DecisionTaskCompleted,DecisionTaskTimedOut,ActivityTaskScheduled,ScheduleActivityTaskFailed,ActivityTaskStarted,ActivityTaskCompleted,ActivityTaskFailed,ActivityTaskTimedOut,ActivityTaskCanceled,ActivityTaskCancelRequested,RequestCancelActivityTaskFailed,WorkflowExecutionSignaled,MarkerRecorded,RecordMarkerFailed,TimerStarted,StartTimerFailed,TimerFired,TimerCanceled,CancelTimerFailed,StartChildWorkflowExecutionInitiated,StartChildWorkflowExecutionFailed,ChildWorkflowExecutionStarted,ChildWorkflowExecutionCompleted,ChildWorkflowExecutionFailed,ChildWorkflowExecutionTimedOut,ChildWorkflowExecutionCanceled,ChildWorkflowExecutionTerminated,SignalExternalWorkflowExecutionInitiated,SignalExternalWorkflowExecutionFailed,ExternalWorkflowExecutionSignaled,RequestCancelExternalWorkflowExecutionInitiated,RequestCancelExternalWorkflowExecutionFailed,ExternalWorkflowExecutionCancelRequested" required:"true"`
 
 	// If the event is of type ExternalWorkflowExecutionCancelRequested then this
 	// member is set and provides detailed information about the event. It is not
@@ -3286,9 +4222,14 @@ type metadataHistoryEvent struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the HistoryEvent.
+func (s HistoryEvent) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListActivityTypesInput struct {
 	// The name of the domain in which the activity types have been registered.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The maximum number of results that will be returned per call. nextPageToken
 	// can be used to obtain futher pages of results. The default is 100, which
@@ -3297,10 +4238,10 @@ type ListActivityTypesInput struct {
 	//
 	// This is an upper limit only; the actual number of results returned per call
 	// may be fewer than the specified maximum.
-	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer"`
+	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer" max:"1000"`
 
 	// If specified, only lists the activity types that have this name.
-	Name *string `locationName:"name" type:"string"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256"`
 
 	// If a NextPageToken was returned by a previous call, there are more results
 	// available. To retrieve the next page of results, make the call again using
@@ -3308,10 +4249,10 @@ type ListActivityTypesInput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// Specifies the registration status of the activity types to list.
-	RegistrationStatus *string `locationName:"registrationStatus" type:"string" required:"true"`
+	RegistrationStatus *string `locationName:"registrationStatus" type:"string" enum:"REGISTERED,DEPRECATED" required:"true"`
 
 	// When set to true, returns the results in reverse order. By default, the results
 	// are returned in ascending alphabetical order by name of the activity types.
@@ -3324,6 +4265,11 @@ type metadataListActivityTypesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListActivityTypesInput.
+func (s ListActivityTypesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains a paginated list of activity type information structures.
 type ListActivityTypesOutput struct {
 	// If a NextPageToken was returned by a previous call, there are more results
@@ -3332,7 +4278,7 @@ type ListActivityTypesOutput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// List of activity type information.
 	TypeInfos []*ActivityTypeInfo `locationName:"typeInfos" type:"list" required:"true"`
@@ -3344,6 +4290,11 @@ type metadataListActivityTypesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListActivityTypesOutput.
+func (s ListActivityTypesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListClosedWorkflowExecutionsInput struct {
 	// If specified, only workflow executions that match this close status are listed.
 	// For example, if TERMINATED is specified, then only TERMINATED workflow executions
@@ -3363,7 +4314,7 @@ type ListClosedWorkflowExecutionsInput struct {
 	CloseTimeFilter *ExecutionTimeFilter `locationName:"closeTimeFilter" type:"structure"`
 
 	// The name of the domain that contains the workflow executions to list.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// If specified, only workflow executions matching the workflow id specified
 	// in the filter are returned.
@@ -3379,7 +4330,7 @@ type ListClosedWorkflowExecutionsInput struct {
 	//
 	// This is an upper limit only; the actual number of results returned per call
 	// may be fewer than the specified maximum.
-	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer"`
+	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer" max:"1000"`
 
 	// If a NextPageToken was returned by a previous call, there are more results
 	// available. To retrieve the next page of results, make the call again using
@@ -3387,7 +4338,7 @@ type ListClosedWorkflowExecutionsInput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// When set to true, returns the results in reverse order. By default the results
 	// are returned in descending order of the start or the close time of the executions.
@@ -3421,6 +4372,11 @@ type metadataListClosedWorkflowExecutionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListClosedWorkflowExecutionsInput.
+func (s ListClosedWorkflowExecutionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListDomainsInput struct {
 	// The maximum number of results that will be returned per call. nextPageToken
 	// can be used to obtain futher pages of results. The default is 100, which
@@ -3429,7 +4385,7 @@ type ListDomainsInput struct {
 	//
 	// This is an upper limit only; the actual number of results returned per call
 	// may be fewer than the specified maximum.
-	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer"`
+	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer" max:"1000"`
 
 	// If a NextPageToken was returned by a previous call, there are more results
 	// available. To retrieve the next page of results, make the call again using
@@ -3437,10 +4393,10 @@ type ListDomainsInput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// Specifies the registration status of the domains to list.
-	RegistrationStatus *string `locationName:"registrationStatus" type:"string" required:"true"`
+	RegistrationStatus *string `locationName:"registrationStatus" type:"string" enum:"REGISTERED,DEPRECATED" required:"true"`
 
 	// When set to true, returns the results in reverse order. By default, the results
 	// are returned in ascending alphabetical order by name of the domains.
@@ -3453,6 +4409,11 @@ type metadataListDomainsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListDomainsInput.
+func (s ListDomainsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains a paginated collection of DomainInfo structures.
 type ListDomainsOutput struct {
 	// A list of DomainInfo structures.
@@ -3464,7 +4425,7 @@ type ListDomainsOutput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	metadataListDomainsOutput `json:"-", xml:"-"`
 }
@@ -3473,9 +4434,14 @@ type metadataListDomainsOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListDomainsOutput.
+func (s ListDomainsOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListOpenWorkflowExecutionsInput struct {
 	// The name of the domain that contains the workflow executions to list.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// If specified, only workflow executions matching the workflow id specified
 	// in the filter are returned.
@@ -3491,7 +4457,7 @@ type ListOpenWorkflowExecutionsInput struct {
 	//
 	// This is an upper limit only; the actual number of results returned per call
 	// may be fewer than the specified maximum.
-	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer"`
+	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer" max:"1000"`
 
 	// If a NextPageToken was returned by a previous call, there are more results
 	// available. To retrieve the next page of results, make the call again using
@@ -3499,7 +4465,7 @@ type ListOpenWorkflowExecutionsInput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// When set to true, returns the results in reverse order. By default the results
 	// are returned in descending order of the start time of the executions.
@@ -3528,9 +4494,14 @@ type metadataListOpenWorkflowExecutionsInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListOpenWorkflowExecutionsInput.
+func (s ListOpenWorkflowExecutionsInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type ListWorkflowTypesInput struct {
 	// The name of the domain in which the workflow types have been registered.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The maximum number of results that will be returned per call. nextPageToken
 	// can be used to obtain futher pages of results. The default is 100, which
@@ -3539,10 +4510,10 @@ type ListWorkflowTypesInput struct {
 	//
 	// This is an upper limit only; the actual number of results returned per call
 	// may be fewer than the specified maximum.
-	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer"`
+	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer" max:"1000"`
 
 	// If specified, lists the workflow type with this name.
-	Name *string `locationName:"name" type:"string"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256"`
 
 	// If a NextPageToken was returned by a previous call, there are more results
 	// available. To retrieve the next page of results, make the call again using
@@ -3550,10 +4521,10 @@ type ListWorkflowTypesInput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// Specifies the registration status of the workflow types to list.
-	RegistrationStatus *string `locationName:"registrationStatus" type:"string" required:"true"`
+	RegistrationStatus *string `locationName:"registrationStatus" type:"string" enum:"REGISTERED,DEPRECATED" required:"true"`
 
 	// When set to true, returns the results in reverse order. By default the results
 	// are returned in ascending alphabetical order of the name of the workflow
@@ -3567,6 +4538,11 @@ type metadataListWorkflowTypesInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListWorkflowTypesInput.
+func (s ListWorkflowTypesInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains a paginated list of information structures about workflow types.
 type ListWorkflowTypesOutput struct {
 	// If a NextPageToken was returned by a previous call, there are more results
@@ -3575,7 +4551,7 @@ type ListWorkflowTypesOutput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// The list of workflow type information.
 	TypeInfos []*WorkflowTypeInfo `locationName:"typeInfos" type:"list" required:"true"`
@@ -3587,6 +4563,11 @@ type metadataListWorkflowTypesOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ListWorkflowTypesOutput.
+func (s ListWorkflowTypesOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the MarkerRecorded event.
 type MarkerRecordedEventAttributes struct {
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
@@ -3596,10 +4577,10 @@ type MarkerRecordedEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// Details of the marker (if any).
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// The name of the marker.
-	MarkerName *string `locationName:"markerName" type:"string" required:"true"`
+	MarkerName *string `locationName:"markerName" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataMarkerRecordedEventAttributes `json:"-", xml:"-"`
 }
@@ -3608,6 +4589,11 @@ type metadataMarkerRecordedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the MarkerRecordedEventAttributes.
+func (s MarkerRecordedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the count of tasks in a task list.
 type PendingTaskCount struct {
 	// The number of tasks in the task list.
@@ -3624,14 +4610,19 @@ type metadataPendingTaskCount struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PendingTaskCount.
+func (s PendingTaskCount) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PollForActivityTaskInput struct {
 	// The name of the domain that contains the task lists being polled.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// Identity of the worker making the request, recorded in the ActivityTaskStarted
 	// event in the workflow history. This enables diagnostic tracing when problems
 	// arise. The form of this identity is user defined.
-	Identity *string `locationName:"identity" type:"string"`
+	Identity *string `locationName:"identity" type:"string" max:"256"`
 
 	// Specifies the task list to poll for activity tasks.
 	//
@@ -3648,17 +4639,22 @@ type metadataPollForActivityTaskInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PollForActivityTaskInput.
+func (s PollForActivityTaskInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Unit of work sent to an activity worker.
 type PollForActivityTaskOutput struct {
 	// The unique ID of the task.
-	ActivityID *string `locationName:"activityId" type:"string" required:"true"`
+	ActivityID *string `locationName:"activityId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The type of this activity task.
 	ActivityType *ActivityType `locationName:"activityType" type:"structure" required:"true"`
 
 	// The inputs provided when the activity task was scheduled. The form of the
 	// input is user defined and should be meaningful to the activity implementation.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The id of the ActivityTaskStarted event recorded in the history.
 	StartedEventID *int64 `locationName:"startedEventId" type:"long" required:"true"`
@@ -3666,7 +4662,7 @@ type PollForActivityTaskOutput struct {
 	// The opaque string used as a handle on the task. This token is used by workers
 	// to communicate progress and response information back to the system about
 	// the task.
-	TaskToken *string `locationName:"taskToken" type:"string" required:"true"`
+	TaskToken *string `locationName:"taskToken" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The workflow execution that started this activity task.
 	WorkflowExecution *WorkflowExecution `locationName:"workflowExecution" type:"structure" required:"true"`
@@ -3678,14 +4674,19 @@ type metadataPollForActivityTaskOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PollForActivityTaskOutput.
+func (s PollForActivityTaskOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type PollForDecisionTaskInput struct {
 	// The name of the domain containing the task lists to poll.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// Identity of the decider making the request, which is recorded in the DecisionTaskStarted
 	// event in the workflow history. This enables diagnostic tracing when problems
 	// arise. The form of this identity is user defined.
-	Identity *string `locationName:"identity" type:"string"`
+	Identity *string `locationName:"identity" type:"string" max:"256"`
 
 	// The maximum number of results that will be returned per call. nextPageToken
 	// can be used to obtain futher pages of results. The default is 100, which
@@ -3694,7 +4695,7 @@ type PollForDecisionTaskInput struct {
 	//
 	// This is an upper limit only; the actual number of results returned per call
 	// may be fewer than the specified maximum.
-	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer"`
+	MaximumPageSize *int64 `locationName:"maximumPageSize" type:"integer" max:"1000"`
 
 	// If a NextPageToken was returned by a previous call, there are more results
 	// available. To retrieve the next page of results, make the call again using
@@ -3707,7 +4708,7 @@ type PollForDecisionTaskInput struct {
 	// to get the next page. You must call PollForDecisionTask again (with the nextPageToken)
 	// to retrieve the next page of history records. Calling PollForDecisionTask
 	// with a nextPageToken will not return a new decision task..
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// When set to true, returns the events in reverse order. By default the results
 	// are returned in ascending order of the eventTimestamp of the events.
@@ -3728,6 +4729,11 @@ type metadataPollForDecisionTaskInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PollForDecisionTaskInput.
+func (s PollForDecisionTaskInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // A structure that represents a decision task. Decision tasks are sent to deciders
 // in order for them to make decisions.
 type PollForDecisionTaskOutput struct {
@@ -3741,7 +4747,7 @@ type PollForDecisionTaskOutput struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	// The id of the DecisionTaskStarted event of the previous decision task of
 	// this workflow execution that was processed by the decider. This can be used
@@ -3755,7 +4761,7 @@ type PollForDecisionTaskOutput struct {
 	// The opaque string used as a handle on the task. This token is used by workers
 	// to communicate progress and response information back to the system about
 	// the task.
-	TaskToken *string `locationName:"taskToken" type:"string" required:"true"`
+	TaskToken *string `locationName:"taskToken" type:"string" min:"1" max:"1024" required:"true"`
 
 	// The workflow execution for which this decision task was created.
 	WorkflowExecution *WorkflowExecution `locationName:"workflowExecution" type:"structure" required:"true"`
@@ -3770,16 +4776,21 @@ type metadataPollForDecisionTaskOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the PollForDecisionTaskOutput.
+func (s PollForDecisionTaskOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RecordActivityTaskHeartbeatInput struct {
 	// If specified, contains details about the progress of the task.
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"2048"`
 
 	// The taskToken of the ActivityTask.
 	//
 	//  taskToken is generated by the service and should be treated as an opaque
 	// value. If the task is passed to another process, its taskToken must also
 	// be passed. This enables it to provide its progress and respond with results.
-	TaskToken *string `locationName:"taskToken" type:"string" required:"true"`
+	TaskToken *string `locationName:"taskToken" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataRecordActivityTaskHeartbeatInput `json:"-", xml:"-"`
 }
@@ -3788,6 +4799,11 @@ type metadataRecordActivityTaskHeartbeatInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RecordActivityTaskHeartbeatInput.
+func (s RecordActivityTaskHeartbeatInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Status information about an activity task.
 type RecordActivityTaskHeartbeatOutput struct {
 	// Set to true if cancellation of the task is requested.
@@ -3800,6 +4816,11 @@ type metadataRecordActivityTaskHeartbeatOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RecordActivityTaskHeartbeatOutput.
+func (s RecordActivityTaskHeartbeatOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the RecordMarker decision.
 //
 // Access Control
@@ -3817,10 +4838,10 @@ type metadataRecordActivityTaskHeartbeatOutput struct {
 // SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
 type RecordMarkerDecisionAttributes struct {
 	// Optional. details of the marker.
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// Required. The name of the marker.
-	MarkerName *string `locationName:"markerName" type:"string" required:"true"`
+	MarkerName *string `locationName:"markerName" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataRecordMarkerDecisionAttributes `json:"-", xml:"-"`
 }
@@ -3829,6 +4850,43 @@ type metadataRecordMarkerDecisionAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RecordMarkerDecisionAttributes.
+func (s RecordMarkerDecisionAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// RecordMarkerFailedCause is an enum of the values of RecordMarkerFailedCause members.
+type RecordMarkerFailedCause string
+
+// The values of RecordMarkerFailedCause.
+const (
+	RecordMarkerFailedCauseOPERATIONNOTPERMITTED RecordMarkerFailedCause = "OPERATION_NOT_PERMITTED"
+)
+
+// RecordMarkerFailedCauseValues returns the values of RecordMarkerFailedCause known to this
+// version of the SDK. The service may accept values added since.
+func RecordMarkerFailedCauseValues() []RecordMarkerFailedCause {
+	return []RecordMarkerFailedCause{
+		RecordMarkerFailedCause("OPERATION_NOT_PERMITTED"),
+	}
+}
+
+// String returns the RecordMarkerFailedCause as the string of a member.
+func (v RecordMarkerFailedCause) String() string {
+	return string(v)
+}
+
+// ParseRecordMarkerFailedCause returns the RecordMarkerFailedCause of str, failing if it is not
+// one of the RecordMarkerFailedCauseValues.
+func ParseRecordMarkerFailedCause(str string) (RecordMarkerFailedCause, error) {
+	for _, v := range RecordMarkerFailedCauseValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid RecordMarkerFailedCause value %q", str)
+}
+
 // Provides details of the RecordMarkerFailed event.
 type RecordMarkerFailedEventAttributes struct {
 	// The cause of the failure. This information is generated by the system and
@@ -3837,7 +4895,7 @@ type RecordMarkerFailedEventAttributes struct {
 	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
 	// it lacked sufficient permissions. For details and example IAM policies, see
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+	Cause *string `locationName:"cause" type:"string" enum:"OPERATION_NOT_PERMITTED" required:"true"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the RecordMarkerFailed decision for this cancellation request.
@@ -3846,7 +4904,7 @@ type RecordMarkerFailedEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// The marker's name.
-	MarkerName *string `locationName:"markerName" type:"string" required:"true"`
+	MarkerName *string `locationName:"markerName" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataRecordMarkerFailedEventAttributes `json:"-", xml:"-"`
 }
@@ -3855,6 +4913,11 @@ type metadataRecordMarkerFailedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RecordMarkerFailedEventAttributes.
+func (s RecordMarkerFailedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RegisterActivityTypeInput struct {
 	// If set, specifies the default maximum time before which a worker processing
 	// a task of this type must report progress by calling RecordActivityTaskHeartbeat.
@@ -3867,7 +4930,7 @@ type RegisterActivityTypeInput struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskHeartbeatTimeout *string `locationName:"defaultTaskHeartbeatTimeout" type:"string"`
+	DefaultTaskHeartbeatTimeout *string `locationName:"defaultTaskHeartbeatTimeout" type:"string" max:"8"`
 
 	// If set, specifies the default task list to use for scheduling tasks of this
 	// activity type. This default task list is used if a task list is not provided
@@ -3882,7 +4945,7 @@ type RegisterActivityTypeInput struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	DefaultTaskPriority *string `locationName:"defaultTaskPriority" type:"string"`
+	DefaultTaskPriority *string `locationName:"defaultTaskPriority" type:"string" max:"11"`
 
 	// If set, specifies the default maximum duration for a task of this activity
 	// type. This default can be overridden when scheduling an activity task using
@@ -3890,7 +4953,7 @@ type RegisterActivityTypeInput struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskScheduleToCloseTimeout *string `locationName:"defaultTaskScheduleToCloseTimeout" type:"string"`
+	DefaultTaskScheduleToCloseTimeout *string `locationName:"defaultTaskScheduleToCloseTimeout" type:"string" max:"8"`
 
 	// If set, specifies the default maximum duration that a task of this activity
 	// type can wait before being assigned to a worker. This default can be overridden
@@ -3898,7 +4961,7 @@ type RegisterActivityTypeInput struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskScheduleToStartTimeout *string `locationName:"defaultTaskScheduleToStartTimeout" type:"string"`
+	DefaultTaskScheduleToStartTimeout *string `locationName:"defaultTaskScheduleToStartTimeout" type:"string" max:"8"`
 
 	// If set, specifies the default maximum duration that a worker can take to
 	// process tasks of this activity type. This default can be overridden when
@@ -3906,13 +4969,13 @@ type RegisterActivityTypeInput struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskStartToCloseTimeout *string `locationName:"defaultTaskStartToCloseTimeout" type:"string"`
+	DefaultTaskStartToCloseTimeout *string `locationName:"defaultTaskStartToCloseTimeout" type:"string" max:"8"`
 
 	// A textual description of the activity type.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" max:"1024"`
 
 	// The name of the domain in which this activity is to be registered.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The name of the activity type within the domain.
 	//
@@ -3920,7 +4983,7 @@ type RegisterActivityTypeInput struct {
 	// contain a : (colon), / (slash), | (vertical bar), or any control characters
 	// (\u0000-\u001f | \u007f - \u009f). Also, it must not contain the literal
 	// string quotarnquot.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	// The version of the activity type.
 	//
@@ -3929,7 +4992,7 @@ type RegisterActivityTypeInput struct {
 	// end with whitespace. It must not contain a : (colon), / (slash), | (vertical
 	// bar), or any control characters (\u0000-\u001f | \u007f - \u009f). Also,
 	// it must not contain the literal string quotarnquot.
-	Version *string `locationName:"version" type:"string" required:"true"`
+	Version *string `locationName:"version" type:"string" min:"1" max:"64" required:"true"`
 
 	metadataRegisterActivityTypeInput `json:"-", xml:"-"`
 }
@@ -3938,6 +5001,11 @@ type metadataRegisterActivityTypeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RegisterActivityTypeInput.
+func (s RegisterActivityTypeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RegisterActivityTypeOutput struct {
 	metadataRegisterActivityTypeOutput `json:"-", xml:"-"`
 }
@@ -3946,9 +5014,14 @@ type metadataRegisterActivityTypeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RegisterActivityTypeOutput.
+func (s RegisterActivityTypeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RegisterDomainInput struct {
 	// A text description of the domain.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" max:"1024"`
 
 	// Name of the domain to register. The name must be unique in the region that
 	// the domain is registered in.
@@ -3957,7 +5030,7 @@ type RegisterDomainInput struct {
 	// contain a : (colon), / (slash), | (vertical bar), or any control characters
 	// (\u0000-\u001f | \u007f - \u009f). Also, it must not contain the literal
 	// string quotarnquot.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	// The duration (in days) that records and histories of workflow executions
 	// on the domain should be kept by the service. After the retention period,
@@ -3970,7 +5043,7 @@ type RegisterDomainInput struct {
 	// The maximum workflow execution retention period is 90 days. For more information
 	// about Amazon SWF service limits, see: Amazon SWF Service Limits (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dg-limits.html)
 	// in the Amazon SWF Developer Guide.
-	WorkflowExecutionRetentionPeriodInDays *string `locationName:"workflowExecutionRetentionPeriodInDays" type:"string" required:"true"`
+	WorkflowExecutionRetentionPeriodInDays *string `locationName:"workflowExecutionRetentionPeriodInDays" type:"string" min:"1" max:"8" required:"true"`
 
 	metadataRegisterDomainInput `json:"-", xml:"-"`
 }
@@ -3979,6 +5052,11 @@ type metadataRegisterDomainInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RegisterDomainInput.
+func (s RegisterDomainInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RegisterDomainOutput struct {
 	metadataRegisterDomainOutput `json:"-", xml:"-"`
 }
@@ -3987,6 +5065,11 @@ type metadataRegisterDomainOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RegisterDomainOutput.
+func (s RegisterDomainOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RegisterWorkflowTypeInput struct {
 	// If set, specifies the default policy to use for the child workflow executions
 	// when a workflow execution of this type is terminated, by calling the TerminateWorkflowExecution
@@ -4001,7 +5084,7 @@ type RegisterWorkflowTypeInput struct {
 	// event in its history. It is up to the decider to take appropriate actions
 	// when it receives an execution history with this event. ABANDON: no action
 	// will be taken. The child executions will continue to run.
-	DefaultChildPolicy *string `locationName:"defaultChildPolicy" type:"string"`
+	DefaultChildPolicy *string `locationName:"defaultChildPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON"`
 
 	// If set, specifies the default maximum duration for executions of this workflow
 	// type. You can override this default when starting an execution through the
@@ -4012,7 +5095,7 @@ type RegisterWorkflowTypeInput struct {
 	// specify a value of "NONE" for defaultExecutionStartToCloseTimeout; there
 	// is a one-year max limit on the time that a workflow execution can run. Exceeding
 	// this limit will always cause the workflow execution to time out.
-	DefaultExecutionStartToCloseTimeout *string `locationName:"defaultExecutionStartToCloseTimeout" type:"string"`
+	DefaultExecutionStartToCloseTimeout *string `locationName:"defaultExecutionStartToCloseTimeout" type:"string" max:"8"`
 
 	// If set, specifies the default task list to use for scheduling decision tasks
 	// for executions of this workflow type. This default is used only if a task
@@ -4028,7 +5111,7 @@ type RegisterWorkflowTypeInput struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	DefaultTaskPriority *string `locationName:"defaultTaskPriority" type:"string"`
+	DefaultTaskPriority *string `locationName:"defaultTaskPriority" type:"string" max:"11"`
 
 	// If set, specifies the default maximum duration of decision tasks for this
 	// workflow type. This default can be overridden when starting a workflow execution
@@ -4037,13 +5120,13 @@ type RegisterWorkflowTypeInput struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskStartToCloseTimeout *string `locationName:"defaultTaskStartToCloseTimeout" type:"string"`
+	DefaultTaskStartToCloseTimeout *string `locationName:"defaultTaskStartToCloseTimeout" type:"string" max:"8"`
 
 	// Textual description of the workflow type.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" max:"1024"`
 
 	// The name of the domain in which to register the workflow type.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The name of the workflow type.
 	//
@@ -4051,7 +5134,7 @@ type RegisterWorkflowTypeInput struct {
 	// contain a : (colon), / (slash), | (vertical bar), or any control characters
 	// (\u0000-\u001f | \u007f - \u009f). Also, it must not contain the literal
 	// string quotarnquot.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	// The version of the workflow type.
 	//
@@ -4061,7 +5144,7 @@ type RegisterWorkflowTypeInput struct {
 	// not start or end with whitespace. It must not contain a : (colon), / (slash),
 	// | (vertical bar), or any control characters (\u0000-\u001f | \u007f - \u009f).
 	// Also, it must not contain the literal string quotarnquot.
-	Version *string `locationName:"version" type:"string" required:"true"`
+	Version *string `locationName:"version" type:"string" min:"1" max:"64" required:"true"`
 
 	metadataRegisterWorkflowTypeInput `json:"-", xml:"-"`
 }
@@ -4070,6 +5153,11 @@ type metadataRegisterWorkflowTypeInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RegisterWorkflowTypeInput.
+func (s RegisterWorkflowTypeInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RegisterWorkflowTypeOutput struct {
 	metadataRegisterWorkflowTypeOutput `json:"-", xml:"-"`
 }
@@ -4078,6 +5166,45 @@ type metadataRegisterWorkflowTypeOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RegisterWorkflowTypeOutput.
+func (s RegisterWorkflowTypeOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
+// RegistrationStatus is an enum of the values of RegistrationStatus members.
+type RegistrationStatus string
+
+// The values of RegistrationStatus.
+const (
+	RegistrationStatusREGISTERED RegistrationStatus = "REGISTERED"
+	RegistrationStatusDEPRECATED RegistrationStatus = "DEPRECATED"
+)
+
+// RegistrationStatusValues returns the values of RegistrationStatus known to this
+// version of the SDK. The service may accept values added since.
+func RegistrationStatusValues() []RegistrationStatus {
+	return []RegistrationStatus{
+		RegistrationStatus("REGISTERED"),
+		RegistrationStatus("DEPRECATED"),
+	}
+}
+
+// String returns the RegistrationStatus as the string of a member.
+func (v RegistrationStatus) String() string {
+	return string(v)
+}
+
+// ParseRegistrationStatus returns the RegistrationStatus of str, failing if it is not
+// one of the RegistrationStatusValues.
+func ParseRegistrationStatus(str string) (RegistrationStatus, error) {
+	for _, v := range RegistrationStatusValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid RegistrationStatus value %q", str)
+}
+
 // Provides details of the RequestCancelActivityTask decision.
 //
 // Access Control
@@ -4095,7 +5222,7 @@ type metadataRegisterWorkflowTypeOutput struct {
 // SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
 type RequestCancelActivityTaskDecisionAttributes struct {
 	// The activityId of the activity task to be canceled.
-	ActivityID *string `locationName:"activityId" type:"string" required:"true"`
+	ActivityID *string `locationName:"activityId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataRequestCancelActivityTaskDecisionAttributes `json:"-", xml:"-"`
 }
@@ -4104,10 +5231,49 @@ type metadataRequestCancelActivityTaskDecisionAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RequestCancelActivityTaskDecisionAttributes.
+func (s RequestCancelActivityTaskDecisionAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// RequestCancelActivityTaskFailedCause is an enum of the values of RequestCancelActivityTaskFailedCause members.
+type RequestCancelActivityTaskFailedCause string
+
+// The values of RequestCancelActivityTaskFailedCause.
+const (
+	RequestCancelActivityTaskFailedCauseACTIVITYIDUNKNOWN     RequestCancelActivityTaskFailedCause = "ACTIVITY_ID_UNKNOWN"
+	RequestCancelActivityTaskFailedCauseOPERATIONNOTPERMITTED RequestCancelActivityTaskFailedCause = "OPERATION_NOT_PERMITTED"
+)
+
+// RequestCancelActivityTaskFailedCauseValues returns the values of RequestCancelActivityTaskFailedCause known to this
+// version of the SDK. The service may accept values added since.
+func RequestCancelActivityTaskFailedCauseValues() []RequestCancelActivityTaskFailedCause {
+	return []RequestCancelActivityTaskFailedCause{
+		RequestCancelActivityTaskFailedCause("ACTIVITY_ID_UNKNOWN"),
+		RequestCancelActivityTaskFailedCause("OPERATION_NOT_PERMITTED"),
+	}
+}
+
+// String returns the RequestCancelActivityTaskFailedCause as the string of a member.
+func (v RequestCancelActivityTaskFailedCause) String() string {
+	return string(v)
+}
+
+// ParseRequestCancelActivityTaskFailedCause returns the RequestCancelActivityTaskFailedCause of str, failing if it is not
+// one of the RequestCancelActivityTaskFailedCauseValues.
+func ParseRequestCancelActivityTaskFailedCause(str string) (RequestCancelActivityTaskFailedCause, error) {
+	for _, v := range RequestCancelActivityTaskFailedCauseValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid RequestCancelActivityTaskFailedCause value %q", str)
+}
+
 // Provides details of the RequestCancelActivityTaskFailed event.
 type RequestCancelActivityTaskFailedEventAttributes struct {
 	// The activityId provided in the RequestCancelActivityTask decision that failed.
-	ActivityID *string `locationName:"activityId" type:"string" required:"true"`
+	ActivityID *string `locationName:"activityId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The cause of the failure. This information is generated by the system and
 	// can be useful for diagnostic purposes.
@@ -4115,7 +5281,7 @@ type RequestCancelActivityTaskFailedEventAttributes struct {
 	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
 	// it lacked sufficient permissions. For details and example IAM policies, see
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+	Cause *string `locationName:"cause" type:"string" enum:"ACTIVITY_ID_UNKNOWN,OPERATION_NOT_PERMITTED" required:"true"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the RequestCancelActivityTask decision for this cancellation
@@ -4130,6 +5296,11 @@ type metadataRequestCancelActivityTaskFailedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RequestCancelActivityTaskFailedEventAttributes.
+func (s RequestCancelActivityTaskFailedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the RequestCancelExternalWorkflowExecution decision.
 //
 // Access Control
@@ -4148,13 +5319,13 @@ type metadataRequestCancelActivityTaskFailedEventAttributes struct {
 type RequestCancelExternalWorkflowExecutionDecisionAttributes struct {
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// workflow tasks.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The runId of the external workflow execution to cancel.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// Required. The workflowId of the external workflow execution to cancel.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataRequestCancelExternalWorkflowExecutionDecisionAttributes `json:"-", xml:"-"`
 }
@@ -4163,6 +5334,47 @@ type metadataRequestCancelExternalWorkflowExecutionDecisionAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RequestCancelExternalWorkflowExecutionDecisionAttributes.
+func (s RequestCancelExternalWorkflowExecutionDecisionAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// RequestCancelExternalWorkflowExecutionFailedCause is an enum of the values of RequestCancelExternalWorkflowExecutionFailedCause members.
+type RequestCancelExternalWorkflowExecutionFailedCause string
+
+// The values of RequestCancelExternalWorkflowExecutionFailedCause.
+const (
+	RequestCancelExternalWorkflowExecutionFailedCauseUNKNOWNEXTERNALWORKFLOWEXECUTION                   RequestCancelExternalWorkflowExecutionFailedCause = "UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION"
+	RequestCancelExternalWorkflowExecutionFailedCauseREQUESTCANCELEXTERNALWORKFLOWEXECUTIONRATEEXCEEDED RequestCancelExternalWorkflowExecutionFailedCause = "REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_RATE_EXCEEDED"
+	RequestCancelExternalWorkflowExecutionFailedCauseOPERATIONNOTPERMITTED                              RequestCancelExternalWorkflowExecutionFailedCause = "OPERATION_NOT_PERMITTED"
+)
+
+// RequestCancelExternalWorkflowExecutionFailedCauseValues returns the values of RequestCancelExternalWorkflowExecutionFailedCause known to this
+// version of the SDK. The service may accept values added since.
+func RequestCancelExternalWorkflowExecutionFailedCauseValues() []RequestCancelExternalWorkflowExecutionFailedCause {
+	return []RequestCancelExternalWorkflowExecutionFailedCause{
+		RequestCancelExternalWorkflowExecutionFailedCause("UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION"),
+		RequestCancelExternalWorkflowExecutionFailedCause("REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_RATE_EXCEEDED"),
+		RequestCancelExternalWorkflowExecutionFailedCause("OPERATION_NOT_PERMITTED"),
+	}
+}
+
+// String returns the RequestCancelExternalWorkflowExecutionFailedCause as the string of a member.
+func (v RequestCancelExternalWorkflowExecutionFailedCause) String() string {
+	return string(v)
+}
+
+// ParseRequestCancelExternalWorkflowExecutionFailedCause returns the RequestCancelExternalWorkflowExecutionFailedCause of str, failing if it is not
+// one of the RequestCancelExternalWorkflowExecutionFailedCauseValues.
+func ParseRequestCancelExternalWorkflowExecutionFailedCause(str string) (RequestCancelExternalWorkflowExecutionFailedCause, error) {
+	for _, v := range RequestCancelExternalWorkflowExecutionFailedCauseValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid RequestCancelExternalWorkflowExecutionFailedCause value %q", str)
+}
+
 // Provides details of the RequestCancelExternalWorkflowExecutionFailed event.
 type RequestCancelExternalWorkflowExecutionFailedEventAttributes struct {
 	// The cause of the failure. This information is generated by the system and
@@ -4171,9 +5383,9 @@ type RequestCancelExternalWorkflowExecutionFailedEventAttributes struct {
 	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
 	// it lacked sufficient permissions. For details and example IAM policies, see
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+	Cause *string `locationName:"cause" type:"string" enum:"UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_RATE_EXCEEDED,OPERATION_NOT_PERMITTED" required:"true"`
 
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the RequestCancelExternalWorkflowExecution decision for
@@ -4188,11 +5400,11 @@ type RequestCancelExternalWorkflowExecutionFailedEventAttributes struct {
 	InitiatedEventID *int64 `locationName:"initiatedEventId" type:"long" required:"true"`
 
 	// The runId of the external workflow execution.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// The workflowId of the external workflow to which the cancel request was to
 	// be delivered.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataRequestCancelExternalWorkflowExecutionFailedEventAttributes `json:"-", xml:"-"`
 }
@@ -4201,11 +5413,16 @@ type metadataRequestCancelExternalWorkflowExecutionFailedEventAttributes struct
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RequestCancelExternalWorkflowExecutionFailedEventAttributes.
+func (s RequestCancelExternalWorkflowExecutionFailedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the RequestCancelExternalWorkflowExecutionInitiated event.
 type RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct {
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// workflow tasks.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the RequestCancelExternalWorkflowExecution decision for
@@ -4214,10 +5431,10 @@ type RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// The runId of the external workflow execution to be canceled.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// The workflowId of the external workflow execution to be canceled.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataRequestCancelExternalWorkflowExecutionInitiatedEventAttributes `json:"-", xml:"-"`
 }
@@ -4226,15 +5443,20 @@ type metadataRequestCancelExternalWorkflowExecutionInitiatedEventAttributes stru
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RequestCancelExternalWorkflowExecutionInitiatedEventAttributes.
+func (s RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RequestCancelWorkflowExecutionInput struct {
 	// The name of the domain containing the workflow execution to cancel.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The runId of the workflow execution to cancel.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// The workflowId of the workflow execution to cancel.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataRequestCancelWorkflowExecutionInput `json:"-", xml:"-"`
 }
@@ -4243,6 +5465,11 @@ type metadataRequestCancelWorkflowExecutionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RequestCancelWorkflowExecutionInput.
+func (s RequestCancelWorkflowExecutionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RequestCancelWorkflowExecutionOutput struct {
 	metadataRequestCancelWorkflowExecutionOutput `json:"-", xml:"-"`
 }
@@ -4251,16 +5478,21 @@ type metadataRequestCancelWorkflowExecutionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RequestCancelWorkflowExecutionOutput.
+func (s RequestCancelWorkflowExecutionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RespondActivityTaskCanceledInput struct {
 	// Optional. Information about the cancellation.
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// The taskToken of the ActivityTask.
 	//
 	// taskToken is generated by the service and should be treated as an opaque
 	// value. If the task is passed to another process, its taskToken must also
 	// be passed. This enables it to provide its progress and respond with results.
-	TaskToken *string `locationName:"taskToken" type:"string" required:"true"`
+	TaskToken *string `locationName:"taskToken" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataRespondActivityTaskCanceledInput `json:"-", xml:"-"`
 }
@@ -4269,6 +5501,11 @@ type metadataRespondActivityTaskCanceledInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RespondActivityTaskCanceledInput.
+func (s RespondActivityTaskCanceledInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RespondActivityTaskCanceledOutput struct {
 	metadataRespondActivityTaskCanceledOutput `json:"-", xml:"-"`
 }
@@ -4277,17 +5514,22 @@ type metadataRespondActivityTaskCanceledOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RespondActivityTaskCanceledOutput.
+func (s RespondActivityTaskCanceledOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RespondActivityTaskCompletedInput struct {
 	// The result of the activity task. It is a free form string that is implementation
 	// specific.
-	Result *string `locationName:"result" type:"string"`
+	Result *string `locationName:"result" type:"string" max:"32768"`
 
 	// The taskToken of the ActivityTask.
 	//
 	//  taskToken is generated by the service and should be treated as an opaque
 	// value. If the task is passed to another process, its taskToken must also
 	// be passed. This enables it to provide its progress and respond with results.
-	TaskToken *string `locationName:"taskToken" type:"string" required:"true"`
+	TaskToken *string `locationName:"taskToken" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataRespondActivityTaskCompletedInput `json:"-", xml:"-"`
 }
@@ -4296,6 +5538,11 @@ type metadataRespondActivityTaskCompletedInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RespondActivityTaskCompletedInput.
+func (s RespondActivityTaskCompletedInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RespondActivityTaskCompletedOutput struct {
 	metadataRespondActivityTaskCompletedOutput `json:"-", xml:"-"`
 }
@@ -4304,19 +5551,24 @@ type metadataRespondActivityTaskCompletedOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RespondActivityTaskCompletedOutput.
+func (s RespondActivityTaskCompletedOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RespondActivityTaskFailedInput struct {
 	// Optional. Detailed information about the failure.
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// Description of the error that may assist in diagnostics.
-	Reason *string `locationName:"reason" type:"string"`
+	Reason *string `locationName:"reason" type:"string" max:"256"`
 
 	// The taskToken of the ActivityTask.
 	//
 	//  taskToken is generated by the service and should be treated as an opaque
 	// value. If the task is passed to another process, its taskToken must also
 	// be passed. This enables it to provide its progress and respond with results.
-	TaskToken *string `locationName:"taskToken" type:"string" required:"true"`
+	TaskToken *string `locationName:"taskToken" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataRespondActivityTaskFailedInput `json:"-", xml:"-"`
 }
@@ -4325,6 +5577,11 @@ type metadataRespondActivityTaskFailedInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RespondActivityTaskFailedInput.
+func (s RespondActivityTaskFailedInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RespondActivityTaskFailedOutput struct {
 	metadataRespondActivityTaskFailedOutput `json:"-", xml:"-"`
 }
@@ -4333,20 +5590,25 @@ type metadataRespondActivityTaskFailedOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RespondActivityTaskFailedOutput.
+func (s RespondActivityTaskFailedOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RespondDecisionTaskCompletedInput struct {
 	// The list of decisions (possibly empty) made by the decider while processing
 	// this decision task. See the docs for the Decision structure for details.
 	Decisions []*Decision `locationName:"decisions" type:"list"`
 
 	// User defined context to add to workflow execution.
-	ExecutionContext *string `locationName:"executionContext" type:"string"`
+	ExecutionContext *string `locationName:"executionContext" type:"string" max:"32768"`
 
 	// The taskToken from the DecisionTask.
 	//
 	// taskToken is generated by the service and should be treated as an opaque
 	// value. If the task is passed to another process, its taskToken must also
 	// be passed. This enables it to provide its progress and respond with results.
-	TaskToken *string `locationName:"taskToken" type:"string" required:"true"`
+	TaskToken *string `locationName:"taskToken" type:"string" min:"1" max:"1024" required:"true"`
 
 	metadataRespondDecisionTaskCompletedInput `json:"-", xml:"-"`
 }
@@ -4355,6 +5617,11 @@ type metadataRespondDecisionTaskCompletedInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RespondDecisionTaskCompletedInput.
+func (s RespondDecisionTaskCompletedInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type RespondDecisionTaskCompletedOutput struct {
 	metadataRespondDecisionTaskCompletedOutput `json:"-", xml:"-"`
 }
@@ -4363,6 +5630,11 @@ type metadataRespondDecisionTaskCompletedOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the RespondDecisionTaskCompletedOutput.
+func (s RespondDecisionTaskCompletedOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the ScheduleActivityTask decision.
 //
 // Access Control
@@ -4388,14 +5660,14 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	// contain a : (colon), / (slash), | (vertical bar), or any control characters
 	// (\u0000-\u001f | \u007f - \u009f). Also, it must not contain the literal
 	// string quotarnquot.
-	ActivityID *string `locationName:"activityId" type:"string" required:"true"`
+	ActivityID *string `locationName:"activityId" type:"string" min:"1" max:"256" required:"true"`
 
 	// Required. The type of the activity task to schedule.
 	ActivityType *ActivityType `locationName:"activityType" type:"structure" required:"true"`
 
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// workflow tasks. This data is not sent to the activity.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// If set, specifies the maximum time before which a worker processing a task
 	// of this type must report progress by calling RecordActivityTaskHeartbeat.
@@ -4406,10 +5678,10 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	HeartbeatTimeout *string `locationName:"heartbeatTimeout" type:"string"`
+	HeartbeatTimeout *string `locationName:"heartbeatTimeout" type:"string" max:"8"`
 
 	// The input provided to the activity task.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The maximum duration for this activity task.
 	//
@@ -4420,7 +5692,7 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	// as a default for the activity type or through this field. If neither this
 	// field is set nor a default schedule-to-close timeout was specified at registration
 	// time then a fault will be returned.
-	ScheduleToCloseTimeout *string `locationName:"scheduleToCloseTimeout" type:"string"`
+	ScheduleToCloseTimeout *string `locationName:"scheduleToCloseTimeout" type:"string" max:"8"`
 
 	// Optional. If set, specifies the maximum duration the activity task can wait
 	// to be assigned to a worker. This overrides the default schedule-to-start
@@ -4433,7 +5705,7 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	// as a default for the activity type or through this field. If neither this
 	// field is set nor a default schedule-to-start timeout was specified at registration
 	// time then a fault will be returned.
-	ScheduleToStartTimeout *string `locationName:"scheduleToStartTimeout" type:"string"`
+	ScheduleToStartTimeout *string `locationName:"scheduleToStartTimeout" type:"string" max:"8"`
 
 	// If set, specifies the maximum duration a worker may take to process this
 	// activity task. This overrides the default start-to-close timeout specified
@@ -4446,7 +5718,7 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	// as a default for the activity type or through this field. If neither this
 	// field is set nor a default start-to-close timeout was specified at registration
 	// time then a fault will be returned.
-	StartToCloseTimeout *string `locationName:"startToCloseTimeout" type:"string"`
+	StartToCloseTimeout *string `locationName:"startToCloseTimeout" type:"string" max:"8"`
 
 	// If set, specifies the name of the task list in which to schedule the activity
 	// task. If not specified, the defaultTaskList registered with the activity
@@ -4470,7 +5742,7 @@ type ScheduleActivityTaskDecisionAttributes struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	metadataScheduleActivityTaskDecisionAttributes `json:"-", xml:"-"`
 }
@@ -4479,10 +5751,67 @@ type metadataScheduleActivityTaskDecisionAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ScheduleActivityTaskDecisionAttributes.
+func (s ScheduleActivityTaskDecisionAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// ScheduleActivityTaskFailedCause is an enum of the values of ScheduleActivityTaskFailedCause members.
+type ScheduleActivityTaskFailedCause string
+
+// The values of ScheduleActivityTaskFailedCause.
+const (
+	ScheduleActivityTaskFailedCauseACTIVITYTYPEDEPRECATED                 ScheduleActivityTaskFailedCause = "ACTIVITY_TYPE_DEPRECATED"
+	ScheduleActivityTaskFailedCauseACTIVITYTYPEDOESNOTEXIST               ScheduleActivityTaskFailedCause = "ACTIVITY_TYPE_DOES_NOT_EXIST"
+	ScheduleActivityTaskFailedCauseACTIVITYIDALREADYINUSE                 ScheduleActivityTaskFailedCause = "ACTIVITY_ID_ALREADY_IN_USE"
+	ScheduleActivityTaskFailedCauseOPENACTIVITIESLIMITEXCEEDED            ScheduleActivityTaskFailedCause = "OPEN_ACTIVITIES_LIMIT_EXCEEDED"
+	ScheduleActivityTaskFailedCauseACTIVITYCREATIONRATEEXCEEDED           ScheduleActivityTaskFailedCause = "ACTIVITY_CREATION_RATE_EXCEEDED"
+	ScheduleActivityTaskFailedCauseDEFAULTSCHEDULETOCLOSETIMEOUTUNDEFINED ScheduleActivityTaskFailedCause = "DEFAULT_SCHEDULE_TO_CLOSE_TIMEOUT_UNDEFINED"
+	ScheduleActivityTaskFailedCauseDEFAULTTASKLISTUNDEFINED               ScheduleActivityTaskFailedCause = "DEFAULT_TASK_LIST_UNDEFINED"
+	ScheduleActivityTaskFailedCauseDEFAULTSCHEDULETOSTARTTIMEOUTUNDEFINED ScheduleActivityTaskFailedCause = "DEFAULT_SCHEDULE_TO_START_TIMEOUT_UNDEFINED"
+	ScheduleActivityTaskFailedCauseDEFAULTSTARTTOCLOSETIMEOUTUNDEFINED    ScheduleActivityTaskFailedCause = "DEFAULT_START_TO_CLOSE_TIMEOUT_UNDEFINED"
+	ScheduleActivityTaskFailedCauseDEFAULTHEARTBEATTIMEOUTUNDEFINED       ScheduleActivityTaskFailedCause = "DEFAULT_HEARTBEAT_TIMEOUT_UNDEFINED"
+	ScheduleActivityTaskFailedCauseOPERATIONNOTPERMITTED                  ScheduleActivityTaskFailedCause = "OPERATION_NOT_PERMITTED"
+)
+
+// ScheduleActivityTaskFailedCauseValues returns the values of ScheduleActivityTaskFailedCause known to this
+// version of the SDK. The service may accept values added since.
+func ScheduleActivityTaskFailedCauseValues() []ScheduleActivityTaskFailedCause {
+	return []ScheduleActivityTaskFailedCause{
+		ScheduleActivityTaskFailedCause("ACTIVITY_TYPE_DEPRECATED"),
+		ScheduleActivityTaskFailedCause("ACTIVITY_TYPE_DOES_NOT_EXIST"),
+		ScheduleActivityTaskFailedCause("ACTIVITY_ID_ALREADY_IN_USE"),
+		ScheduleActivityTaskFailedCause("OPEN_ACTIVITIES_LIMIT_EXCEEDED"),
+		ScheduleActivityTaskFailedCause("ACTIVITY_CREATION_RATE_EXCEEDED"),
+		ScheduleActivityTaskFailedCause("DEFAULT_SCHEDULE_TO_CLOSE_TIMEOUT_UNDEFINED"),
+		ScheduleActivityTaskFailedCause("DEFAULT_TASK_LIST_UNDEFINED"),
+		ScheduleActivityTaskFailedCause("DEFAULT_SCHEDULE_TO_START_TIMEOUT_UNDEFINED"),
+		ScheduleActivityTaskFailedCause("DEFAULT_START_TO_CLOSE_TIMEOUT_UNDEFINED"),
+		ScheduleActivityTaskFailedCause("DEFAULT_HEARTBEAT_TIMEOUT_UNDEFINED"),
+		ScheduleActivityTaskFailedCause("OPERATION_NOT_PERMITTED"),
+	}
+}
+
+// String returns the ScheduleActivityTaskFailedCause as the string of a member.
+func (v ScheduleActivityTaskFailedCause) String() string {
+	return string(v)
+}
+
+// ParseScheduleActivityTaskFailedCause returns the ScheduleActivityTaskFailedCause of str, failing if it is not
+// one of the ScheduleActivityTaskFailedCauseValues.
+func ParseScheduleActivityTaskFailedCause(str string) (ScheduleActivityTaskFailedCause, error) {
+	for _, v := range ScheduleActivityTaskFailedCauseValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ScheduleActivityTaskFailedCause value %q", str)
+}
+
 // Provides details of the ScheduleActivityTaskFailed event.
 type ScheduleActivityTaskFailedEventAttributes struct {
 	// The activityId provided in the ScheduleActivityTask decision that failed.
-	ActivityID *string `locationName:"activityId" type:"string" required:"true"`
+	ActivityID *string `locationName:"activityId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The activity type provided in the ScheduleActivityTask decision that failed.
 	ActivityType *ActivityType `locationName:"activityType" type:"structure" required:"true"`
@@ -4493,7 +5822,7 @@ type ScheduleActivityTaskFailedEventAttributes struct {
 	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
 	// it lacked sufficient permissions. For details and example IAM policies, see
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+	Cause *string `locationName:"cause" type:"string" enum:"ACTIVITY_TYPE_DEPRECATED,ACTIVITY_TYPE_DOES_NOT_EXIST,ACTIVITY_ID_ALREADY_IN_USE,OPEN_ACTIVITIES_LIMIT_EXCEEDED,ACTIVITY_CREATION_RATE_EXCEEDED,DEFAULT_SCHEDULE_TO_CLOSE_TIMEOUT_UNDEFINED,DEFAULT_TASK_LIST_UNDEFINED,DEFAULT_SCHEDULE_TO_START_TIMEOUT_UNDEFINED,DEFAULT_START_TO_CLOSE_TIMEOUT_UNDEFINED,DEFAULT_HEARTBEAT_TIMEOUT_UNDEFINED,OPERATION_NOT_PERMITTED" required:"true"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision that
 	// resulted in the scheduling of this activity task. This information can be
@@ -4508,6 +5837,11 @@ type metadataScheduleActivityTaskFailedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the ScheduleActivityTaskFailedEventAttributes.
+func (s ScheduleActivityTaskFailedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the SignalExternalWorkflowExecution decision.
 //
 // Access Control
@@ -4526,21 +5860,21 @@ type metadataScheduleActivityTaskFailedEventAttributes struct {
 type SignalExternalWorkflowExecutionDecisionAttributes struct {
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// decision tasks.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// Optional. Input data to be provided with the signal. The target workflow
 	// execution will use the signal name and input data to process the signal.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The runId of the workflow execution to be signaled.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// Required. The name of the signal.The target workflow execution will use the
 	// signal name and input to process the signal.
-	SignalName *string `locationName:"signalName" type:"string" required:"true"`
+	SignalName *string `locationName:"signalName" type:"string" min:"1" max:"256" required:"true"`
 
 	// Required. The workflowId of the workflow execution to be signaled.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataSignalExternalWorkflowExecutionDecisionAttributes `json:"-", xml:"-"`
 }
@@ -4549,6 +5883,47 @@ type metadataSignalExternalWorkflowExecutionDecisionAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SignalExternalWorkflowExecutionDecisionAttributes.
+func (s SignalExternalWorkflowExecutionDecisionAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// SignalExternalWorkflowExecutionFailedCause is an enum of the values of SignalExternalWorkflowExecutionFailedCause members.
+type SignalExternalWorkflowExecutionFailedCause string
+
+// The values of SignalExternalWorkflowExecutionFailedCause.
+const (
+	SignalExternalWorkflowExecutionFailedCauseUNKNOWNEXTERNALWORKFLOWEXECUTION            SignalExternalWorkflowExecutionFailedCause = "UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION"
+	SignalExternalWorkflowExecutionFailedCauseSIGNALEXTERNALWORKFLOWEXECUTIONRATEEXCEEDED SignalExternalWorkflowExecutionFailedCause = "SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_RATE_EXCEEDED"
+	SignalExternalWorkflowExecutionFailedCauseOPERATIONNOTPERMITTED                       SignalExternalWorkflowExecutionFailedCause = "OPERATION_NOT_PERMITTED"
+)
+
+// SignalExternalWorkflowExecutionFailedCauseValues returns the values of SignalExternalWorkflowExecutionFailedCause known to this
+// version of the SDK. The service may accept values added since.
+func SignalExternalWorkflowExecutionFailedCauseValues() []SignalExternalWorkflowExecutionFailedCause {
+	return []SignalExternalWorkflowExecutionFailedCause{
+		SignalExternalWorkflowExecutionFailedCause("UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION"),
+		SignalExternalWorkflowExecutionFailedCause("SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_RATE_EXCEEDED"),
+		SignalExternalWorkflowExecutionFailedCause("OPERATION_NOT_PERMITTED"),
+	}
+}
+
+// String returns the SignalExternalWorkflowExecutionFailedCause as the string of a member.
+func (v SignalExternalWorkflowExecutionFailedCause) String() string {
+	return string(v)
+}
+
+// ParseSignalExternalWorkflowExecutionFailedCause returns the SignalExternalWorkflowExecutionFailedCause of str, failing if it is not
+// one of the SignalExternalWorkflowExecutionFailedCauseValues.
+func ParseSignalExternalWorkflowExecutionFailedCause(str string) (SignalExternalWorkflowExecutionFailedCause, error) {
+	for _, v := range SignalExternalWorkflowExecutionFailedCauseValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid SignalExternalWorkflowExecutionFailedCause value %q", str)
+}
+
 // Provides details of the SignalExternalWorkflowExecutionFailed event.
 type SignalExternalWorkflowExecutionFailedEventAttributes struct {
 	// The cause of the failure. This information is generated by the system and
@@ -4557,9 +5932,9 @@ type SignalExternalWorkflowExecutionFailedEventAttributes struct {
 	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
 	// it lacked sufficient permissions. For details and example IAM policies, see
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+	Cause *string `locationName:"cause" type:"string" enum:"UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_RATE_EXCEEDED,OPERATION_NOT_PERMITTED" required:"true"`
 
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the SignalExternalWorkflowExecution decision for this signal.
@@ -4575,11 +5950,11 @@ type SignalExternalWorkflowExecutionFailedEventAttributes struct {
 
 	// The runId of the external workflow execution that the signal was being delivered
 	// to.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// The workflowId of the external workflow execution that the signal was being
 	// delivered to.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataSignalExternalWorkflowExecutionFailedEventAttributes `json:"-", xml:"-"`
 }
@@ -4588,11 +5963,16 @@ type metadataSignalExternalWorkflowExecutionFailedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SignalExternalWorkflowExecutionFailedEventAttributes.
+func (s SignalExternalWorkflowExecutionFailedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the SignalExternalWorkflowExecutionInitiated event.
 type SignalExternalWorkflowExecutionInitiatedEventAttributes struct {
 	// Optional. data attached to the event that can be used by the decider in subsequent
 	// decision tasks.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the SignalExternalWorkflowExecution decision for this signal.
@@ -4601,16 +5981,16 @@ type SignalExternalWorkflowExecutionInitiatedEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// Input provided to the signal (if any).
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The runId of the external workflow execution to send the signal to.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// The name of the signal.
-	SignalName *string `locationName:"signalName" type:"string" required:"true"`
+	SignalName *string `locationName:"signalName" type:"string" min:"1" max:"256" required:"true"`
 
 	// The workflowId of the external workflow execution.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataSignalExternalWorkflowExecutionInitiatedEventAttributes `json:"-", xml:"-"`
 }
@@ -4619,22 +5999,27 @@ type metadataSignalExternalWorkflowExecutionInitiatedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SignalExternalWorkflowExecutionInitiatedEventAttributes.
+func (s SignalExternalWorkflowExecutionInitiatedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SignalWorkflowExecutionInput struct {
 	// The name of the domain containing the workflow execution to signal.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// Data to attach to the WorkflowExecutionSignaled event in the target workflow
 	// execution's history.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The runId of the workflow execution to signal.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// The name of the signal. This name must be meaningful to the target workflow.
-	SignalName *string `locationName:"signalName" type:"string" required:"true"`
+	SignalName *string `locationName:"signalName" type:"string" min:"1" max:"256" required:"true"`
 
 	// The workflowId of the workflow execution to signal.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataSignalWorkflowExecutionInput `json:"-", xml:"-"`
 }
@@ -4643,6 +6028,11 @@ type metadataSignalWorkflowExecutionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SignalWorkflowExecutionInput.
+func (s SignalWorkflowExecutionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type SignalWorkflowExecutionOutput struct {
 	metadataSignalWorkflowExecutionOutput `json:"-", xml:"-"`
 }
@@ -4651,6 +6041,11 @@ type metadataSignalWorkflowExecutionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the SignalWorkflowExecutionOutput.
+func (s SignalWorkflowExecutionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the StartChildWorkflowExecution decision.
 //
 // Access Control
@@ -4687,11 +6082,11 @@ type StartChildWorkflowExecutionDecisionAttributes struct {
 	// workflow type or through this parameter. If neither this parameter is set
 	// nor a default child policy was specified at registration time then a fault
 	// will be returned.
-	ChildPolicy *string `locationName:"childPolicy" type:"string"`
+	ChildPolicy *string `locationName:"childPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON"`
 
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// workflow tasks. This data is not sent to the child workflow execution.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The total duration for this workflow execution. This overrides the defaultExecutionStartToCloseTimeout
 	// specified when registering the workflow type.
@@ -4703,16 +6098,16 @@ type StartChildWorkflowExecutionDecisionAttributes struct {
 	// specified either as a default for the workflow type or through this parameter.
 	// If neither this parameter is set nor a default execution start-to-close timeout
 	// was specified at registration time then a fault will be returned.
-	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string"`
+	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string" max:"8"`
 
 	// The input to be provided to the workflow execution.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The list of tags to associate with the child workflow execution. A maximum
 	// of 5 tags can be specified. You can list workflow executions with a specific
 	// tag by calling ListOpenWorkflowExecutions or ListClosedWorkflowExecutions
 	// and specifying a TagFilter.
-	TagList []*string `locationName:"tagList" type:"list"`
+	TagList []*string `locationName:"tagList" type:"list" max:"5"`
 
 	// The name of the task list to be used for decision tasks of the child workflow
 	// execution.
@@ -4735,7 +6130,7 @@ type StartChildWorkflowExecutionDecisionAttributes struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	// Specifies the maximum duration of decision tasks for this workflow execution.
 	// This parameter overrides the defaultTaskStartToCloseTimout specified when
@@ -4748,7 +6143,7 @@ type StartChildWorkflowExecutionDecisionAttributes struct {
 	// either as a default for the workflow type or through this parameter. If neither
 	// this parameter is set nor a default task start-to-close timeout was specified
 	// at registration time then a fault will be returned.
-	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string"`
+	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string" max:"8"`
 
 	// Required. The workflowId of the workflow execution.
 	//
@@ -4756,7 +6151,7 @@ type StartChildWorkflowExecutionDecisionAttributes struct {
 	// contain a : (colon), / (slash), | (vertical bar), or any control characters
 	// (\u0000-\u001f | \u007f - \u009f). Also, it must not contain the literal
 	// string quotarnquot.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	// Required. The type of the workflow execution to be started.
 	WorkflowType *WorkflowType `locationName:"workflowType" type:"structure" required:"true"`
@@ -4768,6 +6163,63 @@ type metadataStartChildWorkflowExecutionDecisionAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StartChildWorkflowExecutionDecisionAttributes.
+func (s StartChildWorkflowExecutionDecisionAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// StartChildWorkflowExecutionFailedCause is an enum of the values of StartChildWorkflowExecutionFailedCause members.
+type StartChildWorkflowExecutionFailedCause string
+
+// The values of StartChildWorkflowExecutionFailedCause.
+const (
+	StartChildWorkflowExecutionFailedCauseWORKFLOWTYPEDOESNOTEXIST                     StartChildWorkflowExecutionFailedCause = "WORKFLOW_TYPE_DOES_NOT_EXIST"
+	StartChildWorkflowExecutionFailedCauseWORKFLOWTYPEDEPRECATED                       StartChildWorkflowExecutionFailedCause = "WORKFLOW_TYPE_DEPRECATED"
+	StartChildWorkflowExecutionFailedCauseOPENCHILDRENLIMITEXCEEDED                    StartChildWorkflowExecutionFailedCause = "OPEN_CHILDREN_LIMIT_EXCEEDED"
+	StartChildWorkflowExecutionFailedCauseOPENWORKFLOWSLIMITEXCEEDED                   StartChildWorkflowExecutionFailedCause = "OPEN_WORKFLOWS_LIMIT_EXCEEDED"
+	StartChildWorkflowExecutionFailedCauseCHILDCREATIONRATEEXCEEDED                    StartChildWorkflowExecutionFailedCause = "CHILD_CREATION_RATE_EXCEEDED"
+	StartChildWorkflowExecutionFailedCauseWORKFLOWALREADYRUNNING                       StartChildWorkflowExecutionFailedCause = "WORKFLOW_ALREADY_RUNNING"
+	StartChildWorkflowExecutionFailedCauseDEFAULTEXECUTIONSTARTTOCLOSETIMEOUTUNDEFINED StartChildWorkflowExecutionFailedCause = "DEFAULT_EXECUTION_START_TO_CLOSE_TIMEOUT_UNDEFINED"
+	StartChildWorkflowExecutionFailedCauseDEFAULTTASKLISTUNDEFINED                     StartChildWorkflowExecutionFailedCause = "DEFAULT_TASK_LIST_UNDEFINED"
+	StartChildWorkflowExecutionFailedCauseDEFAULTTASKSTARTTOCLOSETIMEOUTUNDEFINED      StartChildWorkflowExecutionFailedCause = "DEFAULT_TASK_START_TO_CLOSE_TIMEOUT_UNDEFINED"
+	StartChildWorkflowExecutionFailedCauseDEFAULTCHILDPOLICYUNDEFINED                  StartChildWorkflowExecutionFailedCause = "DEFAULT_CHILD_POLICY_UNDEFINED"
+	StartChildWorkflowExecutionFailedCauseOPERATIONNOTPERMITTED                        StartChildWorkflowExecutionFailedCause = "OPERATION_NOT_PERMITTED"
+)
+
+// StartChildWorkflowExecutionFailedCauseValues returns the values of StartChildWorkflowExecutionFailedCause known to this
+// version of the SDK. The service may accept values added since.
+func StartChildWorkflowExecutionFailedCauseValues() []StartChildWorkflowExecutionFailedCause {
+	return []StartChildWorkflowExecutionFailedCause{
+		StartChildWorkflowExecutionFailedCause("WORKFLOW_TYPE_DOES_NOT_EXIST"),
+		StartChildWorkflowExecutionFailedCause("WORKFLOW_TYPE_DEPRECATED"),
+		StartChildWorkflowExecutionFailedCause("OPEN_CHILDREN_LIMIT_EXCEEDED"),
+		StartChildWorkflowExecutionFailedCause("OPEN_WORKFLOWS_LIMIT_EXCEEDED"),
+		StartChildWorkflowExecutionFailedCause("CHILD_CREATION_RATE_EXCEEDED"),
+		StartChildWorkflowExecutionFailedCause("WORKFLOW_ALREADY_RUNNING"),
+		StartChildWorkflowExecutionFailedCause("DEFAULT_EXECUTION_START_TO_CLOSE_TIMEOUT_UNDEFINED"),
+		StartChildWorkflowExecutionFailedCause("DEFAULT_TASK_LIST_UNDEFINED"),
+		StartChildWorkflowExecutionFailedCause("DEFAULT_TASK_START_TO_CLOSE_TIMEOUT_UNDEFINED"),
+		StartChildWorkflowExecutionFailedCause("DEFAULT_CHILD_POLICY_UNDEFINED"),
+		StartChildWorkflowExecutionFailedCause("OPERATION_NOT_PERMITTED"),
+	}
+}
+
+// String returns the StartChildWorkflowExecutionFailedCause as the string of a member.
+func (v StartChildWorkflowExecutionFailedCause) String() string {
+	return string(v)
+}
+
+// ParseStartChildWorkflowExecutionFailedCause returns the StartChildWorkflowExecutionFailedCause of str, failing if it is not
+// one of the StartChildWorkflowExecutionFailedCauseValues.
+func ParseStartChildWorkflowExecutionFailedCause(str string) (StartChildWorkflowExecutionFailedCause, error) {
+	for _, v := range StartChildWorkflowExecutionFailedCauseValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid StartChildWorkflowExecutionFailedCause value %q", str)
+}
+
 // Provides details of the StartChildWorkflowExecutionFailed event.
 type StartChildWorkflowExecutionFailedEventAttributes struct {
 	// The cause of the failure. This information is generated by the system and
@@ -4776,9 +6228,9 @@ type StartChildWorkflowExecutionFailedEventAttributes struct {
 	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
 	// it lacked sufficient permissions. For details and example IAM policies, see
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+	Cause *string `locationName:"cause" type:"string" enum:"WORKFLOW_TYPE_DOES_NOT_EXIST,WORKFLOW_TYPE_DEPRECATED,OPEN_CHILDREN_LIMIT_EXCEEDED,OPEN_WORKFLOWS_LIMIT_EXCEEDED,CHILD_CREATION_RATE_EXCEEDED,WORKFLOW_ALREADY_RUNNING,DEFAULT_EXECUTION_START_TO_CLOSE_TIMEOUT_UNDEFINED,DEFAULT_TASK_LIST_UNDEFINED,DEFAULT_TASK_START_TO_CLOSE_TIMEOUT_UNDEFINED,DEFAULT_CHILD_POLICY_UNDEFINED,OPERATION_NOT_PERMITTED" required:"true"`
 
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the StartChildWorkflowExecution Decision to request this
@@ -4793,7 +6245,7 @@ type StartChildWorkflowExecutionFailedEventAttributes struct {
 	InitiatedEventID *int64 `locationName:"initiatedEventId" type:"long" required:"true"`
 
 	// The workflowId of the child workflow execution.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The workflow type provided in the StartChildWorkflowExecution Decision that
 	// failed.
@@ -4806,6 +6258,11 @@ type metadataStartChildWorkflowExecutionFailedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StartChildWorkflowExecutionFailedEventAttributes.
+func (s StartChildWorkflowExecutionFailedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the StartChildWorkflowExecutionInitiated event.
 type StartChildWorkflowExecutionInitiatedEventAttributes struct {
 	// The policy to use for the child workflow executions if this execution gets
@@ -4819,11 +6276,11 @@ type StartChildWorkflowExecutionInitiatedEventAttributes struct {
 	// event in its history. It is up to the decider to take appropriate actions
 	// when it receives an execution history with this event. ABANDON: no action
 	// will be taken. The child executions will continue to run.
-	ChildPolicy *string `locationName:"childPolicy" type:"string" required:"true"`
+	ChildPolicy *string `locationName:"childPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON" required:"true"`
 
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// decision tasks. This data is not sent to the activity.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the StartChildWorkflowExecution Decision to request this
@@ -4836,13 +6293,13 @@ type StartChildWorkflowExecutionInitiatedEventAttributes struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string"`
+	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string" max:"8"`
 
 	// The inputs provided to the child workflow execution (if any).
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The list of tags to associated with the child workflow execution.
-	TagList []*string `locationName:"tagList" type:"list"`
+	TagList []*string `locationName:"tagList" type:"list" max:"5"`
 
 	// The name of the task list used for the decision tasks of the child workflow
 	// execution.
@@ -4856,16 +6313,16 @@ type StartChildWorkflowExecutionInitiatedEventAttributes struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	// The maximum duration allowed for the decision tasks for this workflow execution.
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string"`
+	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string" max:"8"`
 
 	// The workflowId of the child workflow execution.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The type of the child workflow execution.
 	WorkflowType *WorkflowType `locationName:"workflowType" type:"structure" required:"true"`
@@ -4877,6 +6334,11 @@ type metadataStartChildWorkflowExecutionInitiatedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StartChildWorkflowExecutionInitiatedEventAttributes.
+func (s StartChildWorkflowExecutionInitiatedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the StartTimer decision.
 //
 // Access Control
@@ -4895,13 +6357,13 @@ type metadataStartChildWorkflowExecutionInitiatedEventAttributes struct {
 type StartTimerDecisionAttributes struct {
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// workflow tasks.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// Required. The duration to wait before firing the timer.
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0.
-	StartToFireTimeout *string `locationName:"startToFireTimeout" type:"string" required:"true"`
+	StartToFireTimeout *string `locationName:"startToFireTimeout" type:"string" min:"1" max:"8" required:"true"`
 
 	// Required. The unique Id of the timer.
 	//
@@ -4909,7 +6371,7 @@ type StartTimerDecisionAttributes struct {
 	// contain a : (colon), / (slash), | (vertical bar), or any control characters
 	// (\u0000-\u001f | \u007f - \u009f). Also, it must not contain the literal
 	// string quotarnquot.
-	TimerID *string `locationName:"timerId" type:"string" required:"true"`
+	TimerID *string `locationName:"timerId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataStartTimerDecisionAttributes `json:"-", xml:"-"`
 }
@@ -4918,6 +6380,49 @@ type metadataStartTimerDecisionAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StartTimerDecisionAttributes.
+func (s StartTimerDecisionAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// StartTimerFailedCause is an enum of the values of StartTimerFailedCause members.
+type StartTimerFailedCause string
+
+// The values of StartTimerFailedCause.
+const (
+	StartTimerFailedCauseTIMERIDALREADYINUSE       StartTimerFailedCause = "TIMER_ID_ALREADY_IN_USE"
+	StartTimerFailedCauseOPENTIMERSLIMITEXCEEDED   StartTimerFailedCause = "OPEN_TIMERS_LIMIT_EXCEEDED"
+	StartTimerFailedCauseTIMERCREATIONRATEEXCEEDED StartTimerFailedCause = "TIMER_CREATION_RATE_EXCEEDED"
+	StartTimerFailedCauseOPERATIONNOTPERMITTED     StartTimerFailedCause = "OPERATION_NOT_PERMITTED"
+)
+
+// StartTimerFailedCauseValues returns the values of StartTimerFailedCause known to this
+// version of the SDK. The service may accept values added since.
+func StartTimerFailedCauseValues() []StartTimerFailedCause {
+	return []StartTimerFailedCause{
+		StartTimerFailedCause("TIMER_ID_ALREADY_IN_USE"),
+		StartTimerFailedCause("OPEN_TIMERS_LIMIT_EXCEEDED"),
+		StartTimerFailedCause("TIMER_CREATION_RATE_EXCEEDED"),
+		StartTimerFailedCause("OPERATION_NOT_PERMITTED"),
+	}
+}
+
+// String returns the StartTimerFailedCause as the string of a member.
+func (v StartTimerFailedCause) String() string {
+	return string(v)
+}
+
+// ParseStartTimerFailedCause returns the StartTimerFailedCause of str, failing if it is not
+// one of the StartTimerFailedCauseValues.
+func ParseStartTimerFailedCause(str string) (StartTimerFailedCause, error) {
+	for _, v := range StartTimerFailedCauseValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid StartTimerFailedCause value %q", str)
+}
+
 // Provides details of the StartTimerFailed event.
 type StartTimerFailedEventAttributes struct {
 	// The cause of the failure. This information is generated by the system and
@@ -4926,7 +6431,7 @@ type StartTimerFailedEventAttributes struct {
 	// If cause is set to OPERATION_NOT_PERMITTED, the decision failed because
 	// it lacked sufficient permissions. For details and example IAM policies, see
 	// Using IAM to Manage Access to Amazon SWF Workflows (http://docs.aws.amazon.com/amazonswf/latest/developerguide/swf-dev-iam.html).
-	Cause *string `locationName:"cause" type:"string" required:"true"`
+	Cause *string `locationName:"cause" type:"string" enum:"TIMER_ID_ALREADY_IN_USE,OPEN_TIMERS_LIMIT_EXCEEDED,TIMER_CREATION_RATE_EXCEEDED,OPERATION_NOT_PERMITTED" required:"true"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the StartTimer decision for this activity task. This information
@@ -4935,7 +6440,7 @@ type StartTimerFailedEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// The timerId provided in the StartTimer decision that failed.
-	TimerID *string `locationName:"timerId" type:"string" required:"true"`
+	TimerID *string `locationName:"timerId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataStartTimerFailedEventAttributes `json:"-", xml:"-"`
 }
@@ -4944,6 +6449,11 @@ type metadataStartTimerFailedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StartTimerFailedEventAttributes.
+func (s StartTimerFailedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 type StartWorkflowExecutionInput struct {
 	// If set, specifies the policy to use for the child workflow executions of
 	// this workflow execution if it is terminated, by calling the TerminateWorkflowExecution
@@ -4961,10 +6471,10 @@ type StartWorkflowExecutionInput struct {
 	// workflow type or through this parameter. If neither this parameter is set
 	// nor a default child policy was specified at registration time then a fault
 	// will be returned.
-	ChildPolicy *string `locationName:"childPolicy" type:"string"`
+	ChildPolicy *string `locationName:"childPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON"`
 
 	// The name of the domain in which the workflow execution is created.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// The total duration for this workflow execution. This overrides the defaultExecutionStartToCloseTimeout
 	// specified when registering the workflow type.
@@ -4979,18 +6489,18 @@ type StartWorkflowExecutionInput struct {
 	// parameter or as a default when the workflow type is registered. If neither
 	// this parameter nor a default execution start-to-close timeout is specified,
 	// a fault is returned.
-	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string"`
+	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string" max:"8"`
 
 	// The input for the workflow execution. This is a free form string which should
 	// be meaningful to the workflow you are starting. This input is made available
 	// to the new workflow execution in the WorkflowExecutionStarted history event.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The list of tags to associate with the workflow execution. You can specify
 	// a maximum of 5 tags. You can list workflow executions with a specific tag
 	// by calling ListOpenWorkflowExecutions or ListClosedWorkflowExecutions and
 	// specifying a TagFilter.
-	TagList []*string `locationName:"tagList" type:"list"`
+	TagList []*string `locationName:"tagList" type:"list" max:"5"`
 
 	// The task list to use for the decision tasks generated for this workflow execution.
 	// This overrides the defaultTaskList specified when registering the workflow
@@ -5015,7 +6525,7 @@ type StartWorkflowExecutionInput struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	// Specifies the maximum duration of decision tasks for this workflow execution.
 	// This parameter overrides the defaultTaskStartToCloseTimout specified when
@@ -5028,7 +6538,7 @@ type StartWorkflowExecutionInput struct {
 	// either as a default for the workflow type or through this parameter. If neither
 	// this parameter is set nor a default task start-to-close timeout was specified
 	// at registration time then a fault will be returned.
-	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string"`
+	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string" max:"8"`
 
 	// The user defined identifier associated with the workflow execution. You can
 	// use this to associate a custom identifier with the workflow execution. You
@@ -5040,7 +6550,7 @@ type StartWorkflowExecutionInput struct {
 	// contain a : (colon), / (slash), | (vertical bar), or any control characters
 	// (\u0000-\u001f | \u007f - \u009f). Also, it must not contain the literal
 	// string quotarnquot.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	// The type of the workflow to start.
 	WorkflowType *WorkflowType `locationName:"workflowType" type:"structure" required:"true"`
@@ -5052,11 +6562,16 @@ type metadataStartWorkflowExecutionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StartWorkflowExecutionInput.
+func (s StartWorkflowExecutionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Specifies the runId of a workflow execution.
 type StartWorkflowExecutionOutput struct {
 	// The runId of a workflow execution. This Id is generated by the service and
 	// can be used to uniquely identify the workflow execution within a domain.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" min:"1" max:"64"`
 
 	metadataStartWorkflowExecutionOutput `json:"-", xml:"-"`
 }
@@ -5065,11 +6580,16 @@ type metadataStartWorkflowExecutionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the StartWorkflowExecutionOutput.
+func (s StartWorkflowExecutionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Used to filter the workflow executions in visibility APIs based on a tag.
 type TagFilter struct {
 	// Required. Specifies the tag that must be associated with the execution for
 	// it to meet the filter criteria.
-	Tag *string `locationName:"tag" type:"string" required:"true"`
+	Tag *string `locationName:"tag" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataTagFilter `json:"-", xml:"-"`
 }
@@ -5078,10 +6598,15 @@ type metadataTagFilter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TagFilter.
+func (s TagFilter) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents a task list.
 type TaskList struct {
 	// The name of the task list.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataTaskList `json:"-", xml:"-"`
 }
@@ -5090,6 +6615,11 @@ type metadataTaskList struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TaskList.
+func (s TaskList) String() string {
+	return awsutil.StringValue(s)
+}
+
 type TerminateWorkflowExecutionInput struct {
 	// If set, specifies the policy to use for the child workflow executions of
 	// the workflow execution being terminated. This policy overrides the child
@@ -5107,22 +6637,22 @@ type TerminateWorkflowExecutionInput struct {
 	// workflow type or through this parameter. If neither this parameter is set
 	// nor a default child policy was specified at registration time then a fault
 	// will be returned.
-	ChildPolicy *string `locationName:"childPolicy" type:"string"`
+	ChildPolicy *string `locationName:"childPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON"`
 
 	// Optional. Details for terminating the workflow execution.
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// The domain of the workflow execution to terminate.
-	Domain *string `locationName:"domain" type:"string" required:"true"`
+	Domain *string `locationName:"domain" type:"string" min:"1" max:"256" required:"true"`
 
 	// Optional. A descriptive reason for terminating the workflow execution.
-	Reason *string `locationName:"reason" type:"string"`
+	Reason *string `locationName:"reason" type:"string" max:"256"`
 
 	// The runId of the workflow execution to terminate.
-	RunID *string `locationName:"runId" type:"string"`
+	RunID *string `locationName:"runId" type:"string" max:"64"`
 
 	// The workflowId of the workflow execution to terminate.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataTerminateWorkflowExecutionInput `json:"-", xml:"-"`
 }
@@ -5131,6 +6661,11 @@ type metadataTerminateWorkflowExecutionInput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TerminateWorkflowExecutionInput.
+func (s TerminateWorkflowExecutionInput) String() string {
+	return awsutil.StringValue(s)
+}
+
 type TerminateWorkflowExecutionOutput struct {
 	metadataTerminateWorkflowExecutionOutput `json:"-", xml:"-"`
 }
@@ -5139,6 +6674,11 @@ type metadataTerminateWorkflowExecutionOutput struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TerminateWorkflowExecutionOutput.
+func (s TerminateWorkflowExecutionOutput) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the TimerCanceled event.
 type TimerCanceledEventAttributes struct {
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
@@ -5153,7 +6693,7 @@ type TimerCanceledEventAttributes struct {
 	StartedEventID *int64 `locationName:"startedEventId" type:"long" required:"true"`
 
 	// The unique Id of the timer that was canceled.
-	TimerID *string `locationName:"timerId" type:"string" required:"true"`
+	TimerID *string `locationName:"timerId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataTimerCanceledEventAttributes `json:"-", xml:"-"`
 }
@@ -5162,6 +6702,11 @@ type metadataTimerCanceledEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TimerCanceledEventAttributes.
+func (s TimerCanceledEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the TimerFired event.
 type TimerFiredEventAttributes struct {
 	// The id of the TimerStarted event that was recorded when this timer was started.
@@ -5170,7 +6715,7 @@ type TimerFiredEventAttributes struct {
 	StartedEventID *int64 `locationName:"startedEventId" type:"long" required:"true"`
 
 	// The unique Id of the timer that fired.
-	TimerID *string `locationName:"timerId" type:"string" required:"true"`
+	TimerID *string `locationName:"timerId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataTimerFiredEventAttributes `json:"-", xml:"-"`
 }
@@ -5179,11 +6724,16 @@ type metadataTimerFiredEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TimerFiredEventAttributes.
+func (s TimerFiredEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the TimerStarted event.
 type TimerStartedEventAttributes struct {
 	// Optional. Data attached to the event that can be used by the decider in subsequent
 	// workflow tasks.
-	Control *string `locationName:"control" type:"string"`
+	Control *string `locationName:"control" type:"string" max:"32768"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the StartTimer decision for this activity task. This information
@@ -5195,10 +6745,10 @@ type TimerStartedEventAttributes struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0.
-	StartToFireTimeout *string `locationName:"startToFireTimeout" type:"string" required:"true"`
+	StartToFireTimeout *string `locationName:"startToFireTimeout" type:"string" min:"1" max:"8" required:"true"`
 
 	// The unique Id of the timer that was started.
-	TimerID *string `locationName:"timerId" type:"string" required:"true"`
+	TimerID *string `locationName:"timerId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataTimerStartedEventAttributes `json:"-", xml:"-"`
 }
@@ -5207,13 +6757,18 @@ type metadataTimerStartedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the TimerStartedEventAttributes.
+func (s TimerStartedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Represents a workflow execution.
 type WorkflowExecution struct {
 	// A system-generated unique identifier for the workflow execution.
-	RunID *string `locationName:"runId" type:"string" required:"true"`
+	RunID *string `locationName:"runId" type:"string" min:"1" max:"64" required:"true"`
 
 	// The user defined identifier associated with the workflow execution.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataWorkflowExecution `json:"-", xml:"-"`
 }
@@ -5222,12 +6777,49 @@ type metadataWorkflowExecution struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the WorkflowExecution.
+func (s WorkflowExecution) String() string {
+	return awsutil.StringValue(s)
+}
+
+// WorkflowExecutionCancelRequestedCause is an enum of the values of WorkflowExecutionCancelRequestedCause members.
+type WorkflowExecutionCancelRequestedCause string
+
+// The values of WorkflowExecutionCancelRequestedCause.
+const (
+	WorkflowExecutionCancelRequestedCauseCHILDPOLICYAPPLIED WorkflowExecutionCancelRequestedCause = "CHILD_POLICY_APPLIED"
+)
+
+// WorkflowExecutionCancelRequestedCauseValues returns the values of WorkflowExecutionCancelRequestedCause known to this
+// version of the SDK. The service may accept values added since.
+func WorkflowExecutionCancelRequestedCauseValues() []WorkflowExecutionCancelRequestedCause {
+	return []WorkflowExecutionCancelRequestedCause{
+		WorkflowExecutionCancelRequestedCause("CHILD_POLICY_APPLIED"),
+	}
+}
+
+// String returns the WorkflowExecutionCancelRequestedCause as the string of a member.
+func (v WorkflowExecutionCancelRequestedCause) String() string {
+	return string(v)
+}
+
+// ParseWorkflowExecutionCancelRequestedCause returns the WorkflowExecutionCancelRequestedCause of str, failing if it is not
+// one of the WorkflowExecutionCancelRequestedCauseValues.
+func ParseWorkflowExecutionCancelRequestedCause(str string) (WorkflowExecutionCancelRequestedCause, error) {
+	for _, v := range WorkflowExecutionCancelRequestedCauseValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid WorkflowExecutionCancelRequestedCause value %q", str)
+}
+
 // Provides details of the WorkflowExecutionCancelRequested event.
 type WorkflowExecutionCancelRequestedEventAttributes struct {
 	// If set, indicates that the request to cancel the workflow execution was automatically
 	// generated, and specifies the cause. This happens if the parent workflow execution
 	// times out or is terminated, and the child policy is set to cancel child executions.
-	Cause *string `locationName:"cause" type:"string"`
+	Cause *string `locationName:"cause" type:"string" enum:"CHILD_POLICY_APPLIED"`
 
 	// The id of the RequestCancelExternalWorkflowExecutionInitiated event corresponding
 	// to the RequestCancelExternalWorkflowExecution decision to cancel this workflow
@@ -5246,6 +6838,11 @@ type metadataWorkflowExecutionCancelRequestedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the WorkflowExecutionCancelRequestedEventAttributes.
+func (s WorkflowExecutionCancelRequestedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the WorkflowExecutionCanceled event.
 type WorkflowExecutionCanceledEventAttributes struct {
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
@@ -5255,7 +6852,7 @@ type WorkflowExecutionCanceledEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// Details for the cancellation (if any).
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	metadataWorkflowExecutionCanceledEventAttributes `json:"-", xml:"-"`
 }
@@ -5264,6 +6861,11 @@ type metadataWorkflowExecutionCanceledEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the WorkflowExecutionCanceledEventAttributes.
+func (s WorkflowExecutionCanceledEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the WorkflowExecutionCompleted event.
 type WorkflowExecutionCompletedEventAttributes struct {
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
@@ -5273,7 +6875,7 @@ type WorkflowExecutionCompletedEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// The result produced by the workflow execution upon successful completion.
-	Result *string `locationName:"result" type:"string"`
+	Result *string `locationName:"result" type:"string" max:"32768"`
 
 	metadataWorkflowExecutionCompletedEventAttributes `json:"-", xml:"-"`
 }
@@ -5282,6 +6884,11 @@ type metadataWorkflowExecutionCompletedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the WorkflowExecutionCompletedEventAttributes.
+func (s WorkflowExecutionCompletedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The configuration settings for a workflow execution including timeout values,
 // tasklist etc. These configuration settings are determined from the defaults
 // specified when registering the workflow type and those specified when starting
@@ -5298,13 +6905,13 @@ type WorkflowExecutionConfiguration struct {
 	// event in its history. It is up to the decider to take appropriate actions
 	// when it receives an execution history with this event. ABANDON: no action
 	// will be taken. The child executions will continue to run.
-	ChildPolicy *string `locationName:"childPolicy" type:"string" required:"true"`
+	ChildPolicy *string `locationName:"childPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON" required:"true"`
 
 	// The total duration for this workflow execution.
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string" required:"true"`
+	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string" min:"1" max:"8" required:"true"`
 
 	// The task list used for the decision tasks generated for this workflow execution.
 	TaskList *TaskList `locationName:"taskList" type:"structure" required:"true"`
@@ -5316,13 +6923,13 @@ type WorkflowExecutionConfiguration struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	// The maximum duration allowed for decision tasks for this workflow execution.
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string" required:"true"`
+	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string" min:"1" max:"8" required:"true"`
 
 	metadataWorkflowExecutionConfiguration `json:"-", xml:"-"`
 }
@@ -5331,6 +6938,11 @@ type metadataWorkflowExecutionConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the WorkflowExecutionConfiguration.
+func (s WorkflowExecutionConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the WorkflowExecutionContinuedAsNew event.
 type WorkflowExecutionContinuedAsNewEventAttributes struct {
 	// The policy to use for the child workflow executions of the new execution
@@ -5344,7 +6956,7 @@ type WorkflowExecutionContinuedAsNewEventAttributes struct {
 	// event in its history. It is up to the decider to take appropriate actions
 	// when it receives an execution history with this event. ABANDON: no action
 	// will be taken. The child executions will continue to run.
-	ChildPolicy *string `locationName:"childPolicy" type:"string" required:"true"`
+	ChildPolicy *string `locationName:"childPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON" required:"true"`
 
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
 	// that resulted in the ContinueAsNewWorkflowExecution decision that started
@@ -5356,27 +6968,27 @@ type WorkflowExecutionContinuedAsNewEventAttributes struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string"`
+	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string" max:"8"`
 
 	// The input provided to the new workflow execution.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The runId of the new workflow execution.
-	NewExecutionRunID *string `locationName:"newExecutionRunId" type:"string" required:"true"`
+	NewExecutionRunID *string `locationName:"newExecutionRunId" type:"string" min:"1" max:"64" required:"true"`
 
 	// The list of tags associated with the new workflow execution.
-	TagList []*string `locationName:"tagList" type:"list"`
+	TagList []*string `locationName:"tagList" type:"list" max:"5"`
 
 	// Represents a task list.
 	TaskList *TaskList `locationName:"taskList" type:"structure" required:"true"`
 
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	// The maximum duration of decision tasks for the new workflow execution.
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string"`
+	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string" max:"8"`
 
 	// Represents a workflow type.
 	WorkflowType *WorkflowType `locationName:"workflowType" type:"structure" required:"true"`
@@ -5388,6 +7000,11 @@ type metadataWorkflowExecutionContinuedAsNewEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the WorkflowExecutionContinuedAsNewEventAttributes.
+func (s WorkflowExecutionContinuedAsNewEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the count of workflow executions returned from CountOpenWorkflowExecutions
 // or CountClosedWorkflowExecutions
 type WorkflowExecutionCount struct {
@@ -5405,6 +7022,11 @@ type metadataWorkflowExecutionCount struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the WorkflowExecutionCount.
+func (s WorkflowExecutionCount) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the WorkflowExecutionFailed event.
 type WorkflowExecutionFailedEventAttributes struct {
 	// The id of the DecisionTaskCompleted event corresponding to the decision task
@@ -5414,10 +7036,10 @@ type WorkflowExecutionFailedEventAttributes struct {
 	DecisionTaskCompletedEventID *int64 `locationName:"decisionTaskCompletedEventId" type:"long" required:"true"`
 
 	// The details of the failure (if any).
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// The descriptive reason provided for the failure (if any).
-	Reason *string `locationName:"reason" type:"string"`
+	Reason *string `locationName:"reason" type:"string" max:"256"`
 
 	metadataWorkflowExecutionFailedEventAttributes `json:"-", xml:"-"`
 }
@@ -5426,10 +7048,15 @@ type metadataWorkflowExecutionFailedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the WorkflowExecutionFailedEventAttributes.
+func (s WorkflowExecutionFailedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Used to filter the workflow executions in visibility APIs by their workflowId.
 type WorkflowExecutionFilter struct {
 	// The workflowId to pass of match the criteria of this filter.
-	WorkflowID *string `locationName:"workflowId" type:"string" required:"true"`
+	WorkflowID *string `locationName:"workflowId" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataWorkflowExecutionFilter `json:"-", xml:"-"`
 }
@@ -5438,6 +7065,11 @@ type metadataWorkflowExecutionFilter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the WorkflowExecutionFilter.
+func (s WorkflowExecutionFilter) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains information about a workflow execution.
 type WorkflowExecutionInfo struct {
 	// Set to true if a cancellation is requested for this workflow execution.
@@ -5453,7 +7085,7 @@ type WorkflowExecutionInfo struct {
 	// not complete in the alloted time and was automatically timed out.  CONTINUED_AS_NEW:
 	// the execution is logically continued. This means the current execution was
 	// completed and a new execution was started to carry on the workflow.
-	CloseStatus *string `locationName:"closeStatus" type:"string"`
+	CloseStatus *string `locationName:"closeStatus" type:"string" enum:"COMPLETED,FAILED,CANCELED,TERMINATED,CONTINUED_AS_NEW,TIMED_OUT"`
 
 	// The time when the workflow execution was closed. Set only if the execution
 	// status is CLOSED.
@@ -5463,7 +7095,7 @@ type WorkflowExecutionInfo struct {
 	Execution *WorkflowExecution `locationName:"execution" type:"structure" required:"true"`
 
 	// The current status of the execution.
-	ExecutionStatus *string `locationName:"executionStatus" type:"string" required:"true"`
+	ExecutionStatus *string `locationName:"executionStatus" type:"string" enum:"OPEN,CLOSED" required:"true"`
 
 	// If this workflow execution is a child of another execution then contains
 	// the workflow execution that started this execution.
@@ -5475,7 +7107,7 @@ type WorkflowExecutionInfo struct {
 	// The list of tags associated with the workflow execution. Tags can be used
 	// to identify and list workflow executions of interest through the visibility
 	// APIs. A workflow execution can have a maximum of 5 tags.
-	TagList []*string `locationName:"tagList" type:"list"`
+	TagList []*string `locationName:"tagList" type:"list" max:"5"`
 
 	// The type of the workflow execution.
 	WorkflowType *WorkflowType `locationName:"workflowType" type:"structure" required:"true"`
@@ -5487,6 +7119,11 @@ type metadataWorkflowExecutionInfo struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the WorkflowExecutionInfo.
+func (s WorkflowExecutionInfo) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains a paginated list of information about workflow executions.
 type WorkflowExecutionInfos struct {
 	// The list of workflow information structures.
@@ -5498,7 +7135,7 @@ type WorkflowExecutionInfos struct {
 	//
 	// The configured maximumPageSize determines how many results can be returned
 	// in a single call.
-	NextPageToken *string `locationName:"nextPageToken" type:"string"`
+	NextPageToken *string `locationName:"nextPageToken" type:"string" max:"2048"`
 
 	metadataWorkflowExecutionInfos `json:"-", xml:"-"`
 }
@@ -5507,6 +7144,11 @@ type metadataWorkflowExecutionInfos struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the WorkflowExecutionInfos.
+func (s WorkflowExecutionInfos) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains the counts of open tasks, child workflow executions and timers for
 // a workflow execution.
 type WorkflowExecutionOpenCounts struct {
@@ -5518,7 +7160,7 @@ type WorkflowExecutionOpenCounts struct {
 
 	// The count of decision tasks whose status is OPEN. A workflow execution can
 	// have at most one open decision task.
-	OpenDecisionTasks *int64 `locationName:"openDecisionTasks" type:"integer" required:"true"`
+	OpenDecisionTasks *int64 `locationName:"openDecisionTasks" type:"integer" max:"1" required:"true"`
 
 	// The count of timers started by this workflow execution that have not fired
 	// yet.
@@ -5531,6 +7173,11 @@ type metadataWorkflowExecutionOpenCounts struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the WorkflowExecutionOpenCounts.
+func (s WorkflowExecutionOpenCounts) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the WorkflowExecutionSignaled event.
 type WorkflowExecutionSignaledEventAttributes struct {
 	// The id of the SignalExternalWorkflowExecutionInitiated event corresponding
@@ -5547,11 +7194,11 @@ type WorkflowExecutionSignaledEventAttributes struct {
 
 	// Inputs provided with the signal (if any). The decider can use the signal
 	// name and inputs to determine how to process the signal.
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The name of the signal received. The decider can use the signal name and
 	// inputs to determine how to the process the signal.
-	SignalName *string `locationName:"signalName" type:"string" required:"true"`
+	SignalName *string `locationName:"signalName" type:"string" min:"1" max:"256" required:"true"`
 
 	metadataWorkflowExecutionSignaledEventAttributes `json:"-", xml:"-"`
 }
@@ -5560,6 +7207,11 @@ type metadataWorkflowExecutionSignaledEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the WorkflowExecutionSignaledEventAttributes.
+func (s WorkflowExecutionSignaledEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of WorkflowExecutionStarted event.
 type WorkflowExecutionStartedEventAttributes struct {
 	// The policy to use for the child workflow executions if this workflow execution
@@ -5573,21 +7225,21 @@ type WorkflowExecutionStartedEventAttributes struct {
 	// event in its history. It is up to the decider to take appropriate actions
 	// when it receives an execution history with this event. ABANDON: no action
 	// will be taken. The child executions will continue to run.
-	ChildPolicy *string `locationName:"childPolicy" type:"string" required:"true"`
+	ChildPolicy *string `locationName:"childPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON" required:"true"`
 
 	// If this workflow execution was started due to a ContinueAsNewWorkflowExecution
 	// decision, then it contains the runId of the previous workflow execution that
 	// was closed and continued as this execution.
-	ContinuedExecutionRunID *string `locationName:"continuedExecutionRunId" type:"string"`
+	ContinuedExecutionRunID *string `locationName:"continuedExecutionRunId" type:"string" max:"64"`
 
 	// The maximum duration for this workflow execution.
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string"`
+	ExecutionStartToCloseTimeout *string `locationName:"executionStartToCloseTimeout" type:"string" max:"8"`
 
 	// The input provided to the workflow execution (if any).
-	Input *string `locationName:"input" type:"string"`
+	Input *string `locationName:"input" type:"string" max:"32768"`
 
 	// The id of the StartChildWorkflowExecutionInitiated event corresponding to
 	// the StartChildWorkflowExecution Decision to start this workflow execution.
@@ -5602,19 +7254,19 @@ type WorkflowExecutionStartedEventAttributes struct {
 
 	// The list of tags associated with this workflow execution. An execution can
 	// have up to 5 tags.
-	TagList []*string `locationName:"tagList" type:"list"`
+	TagList []*string `locationName:"tagList" type:"list" max:"5"`
 
 	// The name of the task list for scheduling the decision tasks for this workflow
 	// execution.
 	TaskList *TaskList `locationName:"taskList" type:"structure" required:"true"`
 
-	TaskPriority *string `locationName:"taskPriority" type:"string"`
+	TaskPriority *string `locationName:"taskPriority" type:"string" max:"11"`
 
 	// The maximum duration of decision tasks for this workflow type.
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string"`
+	TaskStartToCloseTimeout *string `locationName:"taskStartToCloseTimeout" type:"string" max:"8"`
 
 	// The workflow type of this execution.
 	WorkflowType *WorkflowType `locationName:"workflowType" type:"structure" required:"true"`
@@ -5626,12 +7278,53 @@ type metadataWorkflowExecutionStartedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the WorkflowExecutionStartedEventAttributes.
+func (s WorkflowExecutionStartedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// WorkflowExecutionTerminatedCause is an enum of the values of WorkflowExecutionTerminatedCause members.
+type WorkflowExecutionTerminatedCause string
+
+// The values of WorkflowExecutionTerminatedCause.
+const (
+	WorkflowExecutionTerminatedCauseCHILDPOLICYAPPLIED WorkflowExecutionTerminatedCause = "CHILD_POLICY_APPLIED"
+	WorkflowExecutionTerminatedCauseEVENTLIMITEXCEEDED WorkflowExecutionTerminatedCause = "EVENT_LIMIT_EXCEEDED"
+	WorkflowExecutionTerminatedCauseOPERATORINITIATED  WorkflowExecutionTerminatedCause = "OPERATOR_INITIATED"
+)
+
+// WorkflowExecutionTerminatedCauseValues returns the values of WorkflowExecutionTerminatedCause known to this
+// version of the SDK. The service may accept values added since.
+func WorkflowExecutionTerminatedCauseValues() []WorkflowExecutionTerminatedCause {
+	return []WorkflowExecutionTerminatedCause{
+		WorkflowExecutionTerminatedCause("CHILD_POLICY_APPLIED"),
+		WorkflowExecutionTerminatedCause("EVENT_LIMIT_EXCEEDED"),
+		WorkflowExecutionTerminatedCause("OPERATOR_INITIATED"),
+	}
+}
+
+// String returns the WorkflowExecutionTerminatedCause as the string of a member.
+func (v WorkflowExecutionTerminatedCause) String() string {
+	return string(v)
+}
+
+// ParseWorkflowExecutionTerminatedCause returns the WorkflowExecutionTerminatedCause of str, failing if it is not
+// one of the WorkflowExecutionTerminatedCauseValues.
+func ParseWorkflowExecutionTerminatedCause(str string) (WorkflowExecutionTerminatedCause, error) {
+	for _, v := range WorkflowExecutionTerminatedCauseValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid WorkflowExecutionTerminatedCause value %q", str)
+}
+
 // Provides details of the WorkflowExecutionTerminated event.
 type WorkflowExecutionTerminatedEventAttributes struct {
 	// If set, indicates that the workflow execution was automatically terminated,
 	// and specifies the cause. This happens if the parent workflow execution times
 	// out or is terminated and the child policy is set to terminate child executions.
-	Cause *string `locationName:"cause" type:"string"`
+	Cause *string `locationName:"cause" type:"string" enum:"CHILD_POLICY_APPLIED,EVENT_LIMIT_EXCEEDED,OPERATOR_INITIATED"`
 
 	// The policy used for the child workflow executions of this workflow execution.
 	//
@@ -5642,13 +7335,13 @@ type WorkflowExecutionTerminatedEventAttributes struct {
 	// event in its history. It is up to the decider to take appropriate actions
 	// when it receives an execution history with this event. ABANDON: no action
 	// will be taken. The child executions will continue to run.
-	ChildPolicy *string `locationName:"childPolicy" type:"string" required:"true"`
+	ChildPolicy *string `locationName:"childPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON" required:"true"`
 
 	// The details provided for the termination (if any).
-	Details *string `locationName:"details" type:"string"`
+	Details *string `locationName:"details" type:"string" max:"32768"`
 
 	// The reason provided for the termination (if any).
-	Reason *string `locationName:"reason" type:"string"`
+	Reason *string `locationName:"reason" type:"string" max:"256"`
 
 	metadataWorkflowExecutionTerminatedEventAttributes `json:"-", xml:"-"`
 }
@@ -5657,6 +7350,11 @@ type metadataWorkflowExecutionTerminatedEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the WorkflowExecutionTerminatedEventAttributes.
+func (s WorkflowExecutionTerminatedEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Provides details of the WorkflowExecutionTimedOut event.
 type WorkflowExecutionTimedOutEventAttributes struct {
 	// The policy used for the child workflow executions of this workflow execution.
@@ -5668,10 +7366,10 @@ type WorkflowExecutionTimedOutEventAttributes struct {
 	// event in its history. It is up to the decider to take appropriate actions
 	// when it receives an execution history with this event. ABANDON: no action
 	// will be taken. The child executions will continue to run.
-	ChildPolicy *string `locationName:"childPolicy" type:"string" required:"true"`
+	ChildPolicy *string `locationName:"childPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON" required:"true"`
 
 	// The type of timeout that caused this event.
-	TimeoutType *string `locationName:"timeoutType" type:"string" required:"true"`
+	TimeoutType *string `locationName:"timeoutType" type:"string" enum:"START_TO_CLOSE" required:"true"`
 
 	metadataWorkflowExecutionTimedOutEventAttributes `json:"-", xml:"-"`
 }
@@ -5680,19 +7378,56 @@ type metadataWorkflowExecutionTimedOutEventAttributes struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the WorkflowExecutionTimedOutEventAttributes.
+func (s WorkflowExecutionTimedOutEventAttributes) String() string {
+	return awsutil.StringValue(s)
+}
+
+// WorkflowExecutionTimeoutType is an enum of the values of WorkflowExecutionTimeoutType members.
+type WorkflowExecutionTimeoutType string
+
+// The values of WorkflowExecutionTimeoutType.
+const (
+	WorkflowExecutionTimeoutTypeSTARTTOCLOSE WorkflowExecutionTimeoutType = "START_TO_CLOSE"
+)
+
+// WorkflowExecutionTimeoutTypeValues returns the values of WorkflowExecutionTimeoutType known to this
+// version of the SDK. The service may accept values added since.
+func WorkflowExecutionTimeoutTypeValues() []WorkflowExecutionTimeoutType {
+	return []WorkflowExecutionTimeoutType{
+		WorkflowExecutionTimeoutType("START_TO_CLOSE"),
+	}
+}
+
+// String returns the WorkflowExecutionTimeoutType as the string of a member.
+func (v WorkflowExecutionTimeoutType) String() string {
+	return string(v)
+}
+
+// ParseWorkflowExecutionTimeoutType returns the WorkflowExecutionTimeoutType of str, failing if it is not
+// one of the WorkflowExecutionTimeoutTypeValues.
+func ParseWorkflowExecutionTimeoutType(str string) (WorkflowExecutionTimeoutType, error) {
+	for _, v := range WorkflowExecutionTimeoutTypeValues() {
+		if string(v) == str {
+			return v, nil
+		}
+	}
+	return "", fmt.Errorf("invalid WorkflowExecutionTimeoutType value %q", str)
+}
+
 // Represents a workflow type.
 type WorkflowType struct {
 	// Required. The name of the workflow type.
 	//
 	// The combination of workflow type name and version must be unique with in
 	// a domain.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	// Required. The version of the workflow type.
 	//
 	// The combination of workflow type name and version must be unique with in
 	// a domain.
-	Version *string `locationName:"version" type:"string" required:"true"`
+	Version *string `locationName:"version" type:"string" min:"1" max:"64" required:"true"`
 
 	metadataWorkflowType `json:"-", xml:"-"`
 }
@@ -5701,6 +7436,11 @@ type metadataWorkflowType struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the WorkflowType.
+func (s WorkflowType) String() string {
+	return awsutil.StringValue(s)
+}
+
 // The configuration settings of a workflow type.
 type WorkflowTypeConfiguration struct {
 	// Optional. The default policy to use for the child workflow executions when
@@ -5716,7 +7456,7 @@ type WorkflowTypeConfiguration struct {
 	// event in its history. It is up to the decider to take appropriate actions
 	// when it receives an execution history with this event. ABANDON: no action
 	// will be taken. The child executions will continue to run.
-	DefaultChildPolicy *string `locationName:"defaultChildPolicy" type:"string"`
+	DefaultChildPolicy *string `locationName:"defaultChildPolicy" type:"string" enum:"TERMINATE,REQUEST_CANCEL,ABANDON"`
 
 	// Optional. The default maximum duration, specified when registering the workflow
 	// type, for executions of this workflow type. This default can be overridden
@@ -5725,7 +7465,7 @@ type WorkflowTypeConfiguration struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultExecutionStartToCloseTimeout *string `locationName:"defaultExecutionStartToCloseTimeout" type:"string"`
+	DefaultExecutionStartToCloseTimeout *string `locationName:"defaultExecutionStartToCloseTimeout" type:"string" max:"8"`
 
 	// Optional. The default task list, specified when registering the workflow
 	// type, for decisions tasks scheduled for workflow executions of this type.
@@ -5744,7 +7484,7 @@ type WorkflowTypeConfiguration struct {
 	// For more information about setting task priority, see Setting Task Priority
 	// (http://docs.aws.amazon.com/amazonswf/latest/developerguide/programming-priority.html)
 	// in the Amazon Simple Workflow Developer Guide.
-	DefaultTaskPriority *string `locationName:"defaultTaskPriority" type:"string"`
+	DefaultTaskPriority *string `locationName:"defaultTaskPriority" type:"string" max:"11"`
 
 	// Optional. The default maximum duration, specified when registering the workflow
 	// type, that a decision task for executions of this workflow type might take
@@ -5756,7 +7496,7 @@ type WorkflowTypeConfiguration struct {
 	//
 	// The duration is specified in seconds; an integer greater than or equal to
 	// 0. The value "NONE" can be used to specify unlimited duration.
-	DefaultTaskStartToCloseTimeout *string `locationName:"defaultTaskStartToCloseTimeout" type:"string"`
+	DefaultTaskStartToCloseTimeout *string `locationName:"defaultTaskStartToCloseTimeout" type:"string" max:"8"`
 
 	metadataWorkflowTypeConfiguration `json:"-", xml:"-"`
 }
@@ -5765,14 +7505,19 @@ type metadataWorkflowTypeConfiguration struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the WorkflowTypeConfiguration.
+func (s WorkflowTypeConfiguration) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Used to filter workflow execution query results by type. Each parameter,
 // if specified, defines a rule that must be satisfied by each returned result.
 type WorkflowTypeFilter struct {
 	// Required. Name of the workflow type.
-	Name *string `locationName:"name" type:"string" required:"true"`
+	Name *string `locationName:"name" type:"string" min:"1" max:"256" required:"true"`
 
 	// Version of the workflow type.
-	Version *string `locationName:"version" type:"string"`
+	Version *string `locationName:"version" type:"string" max:"64"`
 
 	metadataWorkflowTypeFilter `json:"-", xml:"-"`
 }
@@ -5781,6 +7526,11 @@ type metadataWorkflowTypeFilter struct {
 	SDKShapeTraits bool `type:"structure"`
 }
 
+// String returns the string representation of the WorkflowTypeFilter.
+func (s WorkflowTypeFilter) String() string {
+	return awsutil.StringValue(s)
+}
+
 // Contains information about a workflow type.
 type WorkflowTypeInfo struct {
 	// The date when this type was registered.
@@ -5791,10 +7541,10 @@ type WorkflowTypeInfo struct {
 	DeprecationDate *time.Time `locationName:"deprecationDate" type:"timestamp" timestampFormat:"unix"`
 
 	// The description of the type registered through RegisterWorkflowType.
-	Description *string `locationName:"description" type:"string"`
+	Description *string `locationName:"description" type:"string" max:"1024"`
 
 	// The current status of the workflow type.
-	Status *string `locationName:"status" type:"string" required:"true"`
+	Status *string `locationName:"status" type:"string" enum:"REGISTERED,DEPRECATED" required:"true"`
 
 	// The workflow type this information is about.
 	WorkflowType *WorkflowType `locationName:"workflowType" type:"structure" required:"true"`
@@ -5804,4 +7554,9 @@ type WorkflowTypeInfo struct {
 
 type metadataWorkflowTypeInfo struct {
 	SDKShapeTraits bool `type:"structure"`
+}
+
+// String returns the string representation of the WorkflowTypeInfo.
+func (s WorkflowTypeInfo) String() string {
+	return awsutil.StringValue(s)
 }
\ No newline at end of file